@@ -126,6 +126,7 @@ import (
 	"github.com/spf13/cast"
 
 	"github.com/Stride-Labs/stride/v27/utils"
+	"github.com/Stride-Labs/stride/v27/wasmbinding"
 	airdrop "github.com/Stride-Labs/stride/v27/x/airdrop"
 	airdropkeeper "github.com/Stride-Labs/stride/v27/x/airdrop/keeper"
 	airdroptypes "github.com/Stride-Labs/stride/v27/x/airdrop/types"
@@ -135,8 +136,6 @@ import (
 	"github.com/Stride-Labs/stride/v27/x/autopilot"
 	autopilotkeeper "github.com/Stride-Labs/stride/v27/x/autopilot/keeper"
 	autopilottypes "github.com/Stride-Labs/stride/v27/x/autopilot/types"
-	"github.com/Stride-Labs/stride/v27/x/claim"
-	claimkeeper "github.com/Stride-Labs/stride/v27/x/claim/keeper"
 	claimtypes "github.com/Stride-Labs/stride/v27/x/claim/types"
 	claimvesting "github.com/Stride-Labs/stride/v27/x/claim/vesting"
 	claimvestingtypes "github.com/Stride-Labs/stride/v27/x/claim/vesting/types"
@@ -232,7 +231,6 @@ var (
 		recordsmodule.AppModuleBasic{},
 		ratelimit.AppModuleBasic{},
 		icacallbacksmodule.AppModuleBasic{},
-		claim.AppModuleBasic{},
 		ccvconsumer.AppModuleBasic{},
 		autopilot.AppModuleBasic{},
 		icaoracle.AppModuleBasic{},
@@ -264,7 +262,6 @@ var (
 		govtypes.ModuleName:                           {authtypes.Burner},
 		ibctransfertypes.ModuleName:                   {authtypes.Minter, authtypes.Burner},
 		stakeibcmoduletypes.ModuleName:                {authtypes.Minter, authtypes.Burner, authtypes.Staking},
-		claimtypes.ModuleName:                         nil,
 		interchainquerytypes.ModuleName:               nil,
 		icatypes.ModuleName:                           nil,
 		stakeibcmoduletypes.RewardCollectorName:       nil,
@@ -352,21 +349,21 @@ type StrideApp struct {
 
 	StakeibcKeeper stakeibcmodulekeeper.Keeper
 
-	EpochsKeeper          epochsmodulekeeper.Keeper
-	InterchainqueryKeeper interchainquerykeeper.Keeper
-	ScopedRecordsKeeper   capabilitykeeper.ScopedKeeper
-	RecordsKeeper         recordsmodulekeeper.Keeper
-	IcacallbacksKeeper    icacallbacksmodulekeeper.Keeper
-	ScopedratelimitKeeper capabilitykeeper.ScopedKeeper
-	RatelimitKeeper       ratelimitkeeper.Keeper
-	ClaimKeeper           claimkeeper.Keeper
-	ICAOracleKeeper       icaoraclekeeper.Keeper
-	StaketiaKeeper        staketiakeeper.Keeper
-	StakedymKeeper        stakedymkeeper.Keeper
-	AirdropKeeper         airdropkeeper.Keeper
-	ICQOracleKeeper       icqoraclekeeper.Keeper
-	AuctionKeeper         auctionkeeper.Keeper
-	StrdBurnerKeeper      strdburnerkeeper.Keeper
+	EpochsKeeper                epochsmodulekeeper.Keeper
+	InterchainqueryKeeper       interchainquerykeeper.Keeper
+	ScopedInterchainqueryKeeper capabilitykeeper.ScopedKeeper
+	ScopedRecordsKeeper         capabilitykeeper.ScopedKeeper
+	RecordsKeeper               recordsmodulekeeper.Keeper
+	IcacallbacksKeeper          icacallbacksmodulekeeper.Keeper
+	ScopedratelimitKeeper       capabilitykeeper.ScopedKeeper
+	RatelimitKeeper             ratelimitkeeper.Keeper
+	ICAOracleKeeper             icaoraclekeeper.Keeper
+	StaketiaKeeper              staketiakeeper.Keeper
+	StakedymKeeper              stakedymkeeper.Keeper
+	AirdropKeeper               airdropkeeper.Keeper
+	ICQOracleKeeper             icqoraclekeeper.Keeper
+	AuctionKeeper               auctionkeeper.Keeper
+	StrdBurnerKeeper            strdburnerkeeper.Keeper
 
 	mm           *module.Manager
 	sm           *module.SimulationManager
@@ -410,6 +407,8 @@ func NewStrideApp(
 		recordsmoduletypes.StoreKey,
 		ratelimittypes.StoreKey,
 		icacallbacksmoduletypes.StoreKey,
+		// The legacy x/claim store is no longer used by any module, but remains mounted so that
+		// the v28 upgrade can migrate its state to x/airdrop (it should be deleted in the next upgrade)
 		claimtypes.StoreKey,
 		icaoracletypes.StoreKey,
 		ccvconsumertypes.StoreKey,
@@ -473,7 +472,11 @@ func NewStrideApp(
 	app.StakingKeeper = *stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
-	epochsKeeper := epochsmodulekeeper.NewKeeper(appCodec, keys[epochsmoduletypes.StoreKey])
+	epochsKeeper := epochsmodulekeeper.NewKeeper(
+		appCodec,
+		keys[epochsmoduletypes.StoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
 	app.MintKeeper = mintkeeper.NewKeeper(
 		appCodec, keys[minttypes.StoreKey], app.GetSubspace(minttypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.DistrKeeper, app.EpochsKeeper, authtypes.FeeCollectorName,
 	)
@@ -488,29 +491,9 @@ func NewStrideApp(
 		invCheckPeriod, app.BankKeeper, authtypes.FeeCollectorName, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
-	app.ClaimKeeper = *claimkeeper.NewKeeper(
-		appCodec,
-		keys[claimtypes.StoreKey],
-		app.AccountKeeper,
-		app.BankKeeper, app.StakingKeeper, app.DistrKeeper, epochsKeeper)
-
 	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, keys[feegrant.StoreKey], app.AccountKeeper)
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath, app.BaseApp, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
-	// register the staking hooks
-	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
-	app.StakingKeeper.SetHooks(
-		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.ClaimKeeper.Hooks()),
-	)
-
-	// Add airdrop keeper
-	app.AirdropKeeper = airdropkeeper.NewKeeper(
-		appCodec,
-		keys[airdroptypes.StoreKey],
-		app.BankKeeper,
-	)
-	airdropModule := airdrop.NewAppModule(appCodec, app.AirdropKeeper)
-
 	// Add ICS Consumer Keeper
 	app.ConsumerKeeper = ccvconsumerkeeper.NewNonZeroKeeper(
 		appCodec,
@@ -586,7 +569,7 @@ func NewStrideApp(
 
 	// Add wasm keeper and wasm client keeper (must be after IBCKeeper and TransferKeeper)
 	wasmContractMemoryLimit := uint32(32)
-	wasmCapabilities := "iterator,staking,stargate,cosmwasm_1_1,cosmwasm_1_2,cosmwasm_1_3,cosmwasm_1_4"
+	wasmCapabilities := "iterator,staking,stargate,stride,cosmwasm_1_1,cosmwasm_1_2,cosmwasm_1_3,cosmwasm_1_4"
 	wasmDir := filepath.Join(homePath, "wasm")
 	wasmVmDir := filepath.Join(homePath, "wasm", "wasm")
 	wasmConfig, err := wasm.ReadWasmConfig(appOpts)
@@ -606,6 +589,17 @@ func NewStrideApp(
 	}
 	wasmOpts = append(wasmOpts, wasmkeeper.WithWasmEngine(wasmer))
 
+	// Register the stride custom queries and messages
+	// Note: the keepers are referenced by pointer since they're built after the wasm keeper
+	wasmOpts = append(wasmOpts, wasmbinding.RegisterCustomPlugins(
+		appCodec,
+		app.GRPCQueryRouter(),
+		app.MsgServiceRouter(),
+		&app.StakeibcKeeper,
+		&app.RecordsKeeper,
+		&app.ICQOracleKeeper,
+	)...)
+
 	scopedWasmKeeper := app.CapabilityKeeper.ScopeToModule(wasmtypes.ModuleName)
 	app.WasmKeeper = wasmkeeper.NewKeeper(
 		appCodec,
@@ -682,9 +676,18 @@ func NewStrideApp(
 		keys[icacallbacksmoduletypes.MemStoreKey],
 		app.GetSubspace(icacallbacksmoduletypes.ModuleName),
 		*app.IBCKeeper,
+		app.ICAControllerKeeper,
+		app.TransferKeeper,
 	)
 
-	app.InterchainqueryKeeper = interchainquerykeeper.NewKeeper(appCodec, keys[interchainquerytypes.StoreKey], app.IBCKeeper)
+	scopedInterchainqueryKeeper := app.CapabilityKeeper.ScopeToModule(interchainquerytypes.ModuleName)
+	app.ScopedInterchainqueryKeeper = scopedInterchainqueryKeeper
+	app.InterchainqueryKeeper = interchainquerykeeper.NewKeeper(
+		appCodec,
+		keys[interchainquerytypes.StoreKey],
+		app.IBCKeeper,
+		scopedInterchainqueryKeeper,
+	)
 	interchainQueryModule := interchainquery.NewAppModule(appCodec, app.InterchainqueryKeeper)
 
 	app.RecordsKeeper = *recordsmodulekeeper.NewKeeper(
@@ -711,9 +714,41 @@ func NewStrideApp(
 		app.IBCKeeper.ChannelKeeper,
 		app.ICAControllerKeeper,
 		app.IcacallbacksKeeper,
+		&app.InterchainqueryKeeper,
 	)
 	icaoracleModule := icaoracle.NewAppModule(appCodec, app.ICAOracleKeeper)
 
+	// Note: Must be above stakeibc keeper
+	app.ICQOracleKeeper = *icqoraclekeeper.NewKeeper(
+		appCodec,
+		keys[icqoracletypes.StoreKey],
+		&app.InterchainqueryKeeper,
+		app.TransferKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	icqOracleModule := icqoracle.NewAppModule(appCodec, app.ICQOracleKeeper)
+
+	app.StrdBurnerKeeper = *strdburnerkeeper.NewKeeper(
+		appCodec,
+		keys[strdburnertypes.StoreKey],
+		app.AccountKeeper,
+		app.BankKeeper,
+	)
+	strdburnerModule := strdburner.NewAppModule(appCodec, app.StrdBurnerKeeper)
+
+	// Auction Keeper must be initialized before the stakeibc hooks are set
+	app.AuctionKeeper = *auctionkeeper.NewKeeper(
+		appCodec,
+		keys[auctiontypes.StoreKey],
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.ICQOracleKeeper,
+		app.MintKeeper,
+		app.StrdBurnerKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	auctionModule := auction.NewAppModule(appCodec, app.AuctionKeeper)
+
 	stakeibcKeeper := stakeibcmodulekeeper.NewKeeper(
 		appCodec,
 		keys[stakeibcmoduletypes.StoreKey],
@@ -730,21 +765,42 @@ func NewStrideApp(
 		app.IcacallbacksKeeper,
 		app.RatelimitKeeper,
 		app.ICAOracleKeeper,
+		app.ICQOracleKeeper,
 		app.ConsumerKeeper,
 	)
+
+	// Airdrop Keeper must be initialized after StakeibcKeeper, but before the stakeibc hooks are set
+	// NOTE: stakeibcKeeper is passed by reference, so that it will contain the hooks below
+	app.AirdropKeeper = airdropkeeper.NewKeeper(
+		appCodec,
+		keys[airdroptypes.StoreKey],
+		app.BankKeeper,
+		app.DistrKeeper,
+		&stakeibcKeeper,
+		app.StrdBurnerKeeper,
+	)
+	airdropModule := airdrop.NewAppModule(appCodec, app.AirdropKeeper)
+
 	app.StakeibcKeeper = *stakeibcKeeper.SetHooks(
-		stakeibcmoduletypes.NewMultiStakeIBCHooks(app.ClaimKeeper.Hooks()),
+		stakeibcmoduletypes.NewMultiStakeIBCHooks(app.AirdropKeeper.Hooks(), app.AuctionKeeper.Hooks()),
 	)
 
 	stakeibcModule := stakeibcmodule.NewAppModule(appCodec, app.StakeibcKeeper, app.AccountKeeper, app.BankKeeper)
 
+	// register the staking hooks
+	// Staking hooks must be registered after the AirdropKeeper is initialized
+	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
+	app.StakingKeeper.SetHooks(
+		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.AirdropKeeper.Hooks()),
+	)
+
 	app.AutopilotKeeper = *autopilotkeeper.NewKeeper(
 		appCodec,
 		keys[autopilottypes.StoreKey],
 		app.GetSubspace(autopilottypes.ModuleName),
 		app.BankKeeper,
 		app.StakeibcKeeper,
-		app.ClaimKeeper,
+		app.AirdropKeeper,
 		app.TransferKeeper,
 	)
 	autopilotModule := autopilot.NewAppModule(appCodec, app.AutopilotKeeper)
@@ -780,32 +836,6 @@ func NewStrideApp(
 		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, app.StakingKeeper,
 	)
 
-	app.ICQOracleKeeper = *icqoraclekeeper.NewKeeper(
-		appCodec,
-		keys[icqoracletypes.StoreKey],
-		&app.InterchainqueryKeeper,
-		app.TransferKeeper,
-		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-	)
-	icqOracleModule := icqoracle.NewAppModule(appCodec, app.ICQOracleKeeper)
-
-	app.AuctionKeeper = *auctionkeeper.NewKeeper(
-		appCodec,
-		keys[auctiontypes.StoreKey],
-		app.AccountKeeper,
-		app.BankKeeper,
-		app.ICQOracleKeeper,
-	)
-	auctionModule := auction.NewAppModule(appCodec, app.AuctionKeeper)
-
-	app.StrdBurnerKeeper = *strdburnerkeeper.NewKeeper(
-		appCodec,
-		keys[strdburnertypes.StoreKey],
-		app.AccountKeeper,
-		app.BankKeeper,
-	)
-	strdburnerModule := strdburner.NewAppModule(appCodec, app.StrdBurnerKeeper)
-
 	// Register Gov (must be registered after stakeibc)
 	govRouter := govtypesv1beta1.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypesv1beta1.ProposalHandler).
@@ -831,12 +861,23 @@ func NewStrideApp(
 	if err != nil {
 		return nil
 	}
+	err = app.InterchainqueryKeeper.SetCallbackHandler(icaoracletypes.ModuleName, app.ICAOracleKeeper.ICQCallbackHandler())
+	if err != nil {
+		return nil
+	}
+
+	// Register mint hooks (must be set before the mint keeper is copied into the epoch hooks)
+	app.MintKeeper.SetHooks(
+		minttypes.NewMultiMintHooks(
+			app.AuctionKeeper.Hooks(),
+			app.ICAOracleKeeper.Hooks(),
+		),
+	)
 
 	app.EpochsKeeper = *epochsKeeper.SetHooks(
 		epochsmoduletypes.NewMultiEpochHooks(
 			app.StakeibcKeeper.Hooks(),
 			app.MintKeeper.Hooks(),
-			app.ClaimKeeper.Hooks(),
 			app.StaketiaKeeper.Hooks(),
 			app.StakedymKeeper.Hooks(),
 		),
@@ -846,6 +887,14 @@ func NewStrideApp(
 	icacallbacksModule := icacallbacksmodule.NewAppModule(appCodec, app.IcacallbacksKeeper, app.AccountKeeper, app.BankKeeper)
 	icacallbacksIBCModule := icacallbacksmodule.NewIBCModule(app.IcacallbacksKeeper)
 
+	// Register metric producers for the oracles
+	if err := app.ICAOracleKeeper.SetMetricProducers(
+		app.StakeibcKeeper.MetricProducers(),
+		app.ICQOracleKeeper.MetricProducers(),
+	); err != nil {
+		return nil
+	}
+
 	// Register IBC calllbacks
 	if err := app.IcacallbacksKeeper.SetICACallbacks(
 		app.StakeibcKeeper.Callbacks(),
@@ -930,7 +979,9 @@ func NewStrideApp(
 		// Transfer stack
 		AddRoute(ibctransfertypes.ModuleName, transferStack).
 		// Consumer stack
-		AddRoute(ccvconsumertypes.ModuleName, consumerModule)
+		AddRoute(ccvconsumertypes.ModuleName, consumerModule).
+		// Async-icq stack
+		AddRoute(interchainquerytypes.ModuleName, interchainquery.NewIBCModule(app.InterchainqueryKeeper))
 
 	app.IBCKeeper.SetRouter(ibcRouter)
 
@@ -966,7 +1017,6 @@ func NewStrideApp(
 		consensus.NewAppModule(appCodec, app.ConsensusParamsKeeper),
 		ibc.NewAppModule(app.IBCKeeper),
 		params.NewAppModule(app.ParamsKeeper),
-		claim.NewAppModule(appCodec, app.ClaimKeeper),
 		// technically, app.GetSubspace(packetforwardtypes.ModuleName) will never be run https://github.com/cosmos/ibc-apps/issues/146#issuecomment-1839242144
 		packetforward.NewAppModule(app.PacketForwardKeeper, app.GetSubspace(packetforwardtypes.ModuleName)),
 		wasm.NewAppModule(appCodec, &app.WasmKeeper, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.BaseApp.MsgServiceRouter(), app.GetSubspace(wasmtypes.ModuleName)),
@@ -1024,7 +1074,6 @@ func NewStrideApp(
 		recordsmoduletypes.ModuleName,
 		ratelimittypes.ModuleName,
 		icacallbacksmoduletypes.ModuleName,
-		claimtypes.ModuleName,
 		ccvconsumertypes.ModuleName,
 		autopilottypes.ModuleName,
 		icaoracletypes.ModuleName,
@@ -1068,7 +1117,6 @@ func NewStrideApp(
 		recordsmoduletypes.ModuleName,
 		ratelimittypes.ModuleName,
 		icacallbacksmoduletypes.ModuleName,
-		claimtypes.ModuleName,
 		ccvconsumertypes.ModuleName,
 		autopilottypes.ModuleName,
 		icaoracletypes.ModuleName,
@@ -1117,7 +1165,6 @@ func NewStrideApp(
 		recordsmoduletypes.ModuleName,
 		ratelimittypes.ModuleName,
 		icacallbacksmoduletypes.ModuleName,
-		claimtypes.ModuleName,
 		ccvconsumertypes.ModuleName,
 		autopilottypes.ModuleName,
 		icaoracletypes.ModuleName,
@@ -1430,7 +1477,6 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(autopilottypes.ModuleName)
 	paramsKeeper.Subspace(packetforwardtypes.ModuleName)
 	paramsKeeper.Subspace(icaoracletypes.ModuleName)
-	paramsKeeper.Subspace(claimtypes.ModuleName)
 	paramsKeeper.Subspace(wasmtypes.ModuleName)
 	return paramsKeeper
 }
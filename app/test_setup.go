@@ -11,6 +11,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/crypto/secp256k1"
 	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	tmtypes "github.com/cometbft/cometbft/types"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
@@ -27,6 +28,7 @@ import (
 
 	cmdcfg "github.com/Stride-Labs/stride/v27/cmd/strided/config"
 	testutil "github.com/Stride-Labs/stride/v27/testutil"
+	claimtypes "github.com/Stride-Labs/stride/v27/x/claim/types"
 )
 
 const Bech32Prefix = "stride"
@@ -73,6 +75,11 @@ func InitStrideTestApp(initChain bool) *StrideApp {
 				AppStateBytes:   stateBytes,
 			},
 		)
+
+		// The legacy x/claim module is no longer registered with the app, so its params are
+		// initialized directly to mirror the state of existing chains
+		ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+		app.LegacyClaimKeeper().InitGenesis(ctx, *claimtypes.DefaultGenesis())
 	}
 
 	return app
@@ -11,6 +11,7 @@ import (
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
 	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
 	ibchookstypes "github.com/cosmos/ibc-apps/modules/ibc-hooks/v7/types"
@@ -37,6 +38,7 @@ import (
 	v25 "github.com/Stride-Labs/stride/v27/app/upgrades/v25"
 	v26 "github.com/Stride-Labs/stride/v27/app/upgrades/v26"
 	v27 "github.com/Stride-Labs/stride/v27/app/upgrades/v27"
+	v28 "github.com/Stride-Labs/stride/v27/app/upgrades/v28"
 	v3 "github.com/Stride-Labs/stride/v27/app/upgrades/v3"
 	v4 "github.com/Stride-Labs/stride/v27/app/upgrades/v4"
 	v5 "github.com/Stride-Labs/stride/v27/app/upgrades/v5"
@@ -47,10 +49,12 @@ import (
 	airdroptypes "github.com/Stride-Labs/stride/v27/x/airdrop/types"
 	auctiontypes "github.com/Stride-Labs/stride/v27/x/auction/types"
 	autopilottypes "github.com/Stride-Labs/stride/v27/x/autopilot/types"
+	claimkeeper "github.com/Stride-Labs/stride/v27/x/claim/keeper"
 	claimtypes "github.com/Stride-Labs/stride/v27/x/claim/types"
 	icacallbacktypes "github.com/Stride-Labs/stride/v27/x/icacallbacks/types"
 	icaoracletypes "github.com/Stride-Labs/stride/v27/x/icaoracle/types"
 	icqoracletypes "github.com/Stride-Labs/stride/v27/x/icqoracle/types"
+	minttypes "github.com/Stride-Labs/stride/v27/x/mint/types"
 	recordtypes "github.com/Stride-Labs/stride/v27/x/records/types"
 	stakedymtypes "github.com/Stride-Labs/stride/v27/x/stakedym/types"
 	stakeibctypes "github.com/Stride-Labs/stride/v27/x/stakeibc/types"
@@ -58,7 +62,24 @@ import (
 	strdburnertypes "github.com/Stride-Labs/stride/v27/x/strdburner/types"
 )
 
+// Builds a keeper for the legacy x/claim store
+// The claim module is no longer registered with the app, but its state is still read by the
+// historical upgrade handlers and migrated to x/airdrop in v28
+func (app *StrideApp) LegacyClaimKeeper() claimkeeper.Keeper {
+	return *claimkeeper.NewKeeper(
+		app.appCodec,
+		app.keys[claimtypes.StoreKey],
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.StakingKeeper,
+		app.DistrKeeper,
+		app.EpochsKeeper,
+	)
+}
+
 func (app *StrideApp) setupUpgradeHandlers(appOpts servertypes.AppOptions) {
+	claimKeeper := app.LegacyClaimKeeper()
+
 	// v2 upgrade handler
 	app.UpgradeKeeper.SetUpgradeHandler(
 		v2.UpgradeName,
@@ -68,7 +89,7 @@ func (app *StrideApp) setupUpgradeHandlers(appOpts servertypes.AppOptions) {
 	// v3 upgrade handler
 	app.UpgradeKeeper.SetUpgradeHandler(
 		v3.UpgradeName,
-		v3.CreateUpgradeHandler(app.mm, app.configurator, app.ClaimKeeper),
+		v3.CreateUpgradeHandler(app.mm, app.configurator, claimKeeper),
 	)
 
 	// v4 upgrade handler
@@ -100,7 +121,7 @@ func (app *StrideApp) setupUpgradeHandlers(appOpts servertypes.AppOptions) {
 			app.mm,
 			app.configurator,
 			app.appCodec,
-			app.ClaimKeeper,
+			claimKeeper,
 		),
 	)
 
@@ -128,7 +149,7 @@ func (app *StrideApp) setupUpgradeHandlers(appOpts servertypes.AppOptions) {
 			app.mm,
 			app.configurator,
 			app.appCodec,
-			app.ClaimKeeper,
+			claimKeeper,
 			app.AutopilotKeeper,
 		),
 	)
@@ -136,7 +157,7 @@ func (app *StrideApp) setupUpgradeHandlers(appOpts servertypes.AppOptions) {
 	// v9 upgrade handler
 	app.UpgradeKeeper.SetUpgradeHandler(
 		v9.UpgradeName,
-		v9.CreateUpgradeHandler(app.mm, app.configurator, app.ClaimKeeper),
+		v9.CreateUpgradeHandler(app.mm, app.configurator, claimKeeper),
 	)
 
 	// v10 upgrade handler
@@ -147,11 +168,12 @@ func (app *StrideApp) setupUpgradeHandlers(appOpts servertypes.AppOptions) {
 			app.configurator,
 			app.appCodec,
 			app.keys[capabilitytypes.ModuleName],
+			app.keys[paramstypes.StoreKey],
 			app.AccountKeeper,
 			app.BankKeeper,
 			app.CapabilityKeeper,
 			app.IBCKeeper.ChannelKeeper,
-			app.ClaimKeeper,
+			claimKeeper,
 			app.IBCKeeper.ClientKeeper,
 			app.ConsensusParamsKeeper,
 			app.GovKeeper,
@@ -205,7 +227,7 @@ func (app *StrideApp) setupUpgradeHandlers(appOpts servertypes.AppOptions) {
 			app.appCodec,
 			app.AccountKeeper,
 			app.BankKeeper,
-			app.ClaimKeeper,
+			claimKeeper,
 			&app.ConsumerKeeper,
 			app.InterchainqueryKeeper,
 			app.StakeibcKeeper,
@@ -363,6 +385,24 @@ func (app *StrideApp) setupUpgradeHandlers(appOpts servertypes.AppOptions) {
 		),
 	)
 
+	// v28 upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v28.UpgradeName,
+		v28.CreateUpgradeHandler(
+			app.mm,
+			app.configurator,
+			app.AirdropKeeper,
+			app.AuctionKeeper,
+			claimKeeper,
+			app.IcacallbacksKeeper,
+			app.ICQOracleKeeper,
+			app.InterchainqueryKeeper,
+			app.RecordsKeeper,
+			app.StakeibcKeeper,
+			app.GetSubspace(minttypes.ModuleName),
+		),
+	)
+
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
 		panic(fmt.Errorf("Failed to read upgrade info from disk: %w", err))
@@ -7,6 +7,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -33,6 +34,7 @@ import (
 	claimtypes "github.com/Stride-Labs/stride/v27/x/claim/types"
 	icacallbackskeeper "github.com/Stride-Labs/stride/v27/x/icacallbacks/keeper"
 	mintkeeper "github.com/Stride-Labs/stride/v27/x/mint/keeper"
+	oldminttypes "github.com/Stride-Labs/stride/v27/x/mint/migrations/v2/types"
 	minttypes "github.com/Stride-Labs/stride/v27/x/mint/types"
 	recordskeeper "github.com/Stride-Labs/stride/v27/x/records/keeper"
 	recordstypes "github.com/Stride-Labs/stride/v27/x/records/types"
@@ -103,6 +105,7 @@ func CreateUpgradeHandler(
 	configurator module.Configurator,
 	cdc codec.Codec,
 	capabilityStoreKey *storetypes.KVStoreKey,
+	paramsStoreKey *storetypes.KVStoreKey,
 	accountKeeper authkeeper.AccountKeeper,
 	bankKeeper bankkeeper.Keeper,
 	capabilityKeeper *capabilitykeeper.Keeper,
@@ -144,7 +147,7 @@ func CreateUpgradeHandler(
 		}
 
 		ctx.Logger().Info("Reducing STRD staking rewards...")
-		if err := ReduceSTRDStakingRewards(ctx, mintKeeper); err != nil {
+		if err := ReduceSTRDStakingRewards(ctx, mintKeeper, paramsStoreKey); err != nil {
 			return nil, errorsmod.Wrapf(err, "unable to reduce STRD staking rewards")
 		}
 
@@ -183,7 +186,7 @@ func CreateUpgradeHandler(
 
 // Cut STRD staking rewards in half (staking rewards make up 27.64% of total provisions)
 // Reduce epoch provisions by 13.82% from 1,078,767,123 to 929,681,506
-func ReduceSTRDStakingRewards(ctx sdk.Context, k mintkeeper.Keeper) error {
+func ReduceSTRDStakingRewards(ctx sdk.Context, k mintkeeper.Keeper, paramsStoreKey storetypes.StoreKey) error {
 	minter := minttypes.NewMinter(EpochProvisions)
 	k.SetMinter(ctx, minter)
 
@@ -202,16 +205,21 @@ func ReduceSTRDStakingRewards(ctx sdk.Context, k mintkeeper.Keeper) error {
 		return fmt.Errorf("distribution proportions do not sum to 1 (%v)", totalProportions)
 	}
 
-	distributionProperties := minttypes.DistributionProportions{
+	distributionProperties := oldminttypes.DistributionProportions{
 		Staking:                     stakingProportion,
 		CommunityPoolGrowth:         communityPoolGrowthProportion,
 		StrategicReserve:            strategicReserveProportion,
 		CommunityPoolSecurityBudget: communityPoolSecurityBudgetProportion,
 	}
 
-	params := k.GetParams(ctx)
-	params.DistributionProportions = distributionProperties
-	k.SetParams(ctx, params)
+	// The proportions are no longer part of the mint params, so they're written directly
+	// under their original param key (they're converted to distribution recipients in v28)
+	distributionPropertiesBz, err := codec.NewLegacyAmino().MarshalJSON(distributionProperties)
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to serialize distribution proportions")
+	}
+	mintParamsStore := prefix.NewStore(ctx.KVStore(paramsStoreKey), []byte(minttypes.ModuleName+"/"))
+	mintParamsStore.Set(oldminttypes.KeyPoolAllocationRatio, distributionPropertiesBz)
 
 	return nil
 }
@@ -9,6 +9,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
@@ -23,6 +24,7 @@ import (
 	ratelimittypes "github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
 
 	icacallbackstypes "github.com/Stride-Labs/stride/v27/x/icacallbacks/types"
+	oldminttypes "github.com/Stride-Labs/stride/v27/x/mint/migrations/v2/types"
 	recordskeeper "github.com/Stride-Labs/stride/v27/x/records/keeper"
 	recordstypes "github.com/Stride-Labs/stride/v27/x/records/types"
 	stakeibckeeper "github.com/Stride-Labs/stride/v27/x/stakeibc/keeper"
@@ -75,7 +77,10 @@ func (s *UpgradeTestSuite) TestUpgrade() {
 	s.ConfirmUpgradeSucceededs("v10", dummyUpgradeHeight)
 
 	// Check mint parameters after upgrade
-	proportions := s.App.MintKeeper.GetParams(s.Ctx).DistributionProportions
+	var proportions oldminttypes.DistributionProportions
+	proportionsBz := s.App.GetSubspace(minttypes.ModuleName).GetRaw(s.Ctx, oldminttypes.KeyPoolAllocationRatio)
+	err := codec.NewLegacyAmino().UnmarshalJSON(proportionsBz, &proportions)
+	s.Require().NoError(err, "no error expected when deserializing distribution proportions")
 
 	s.Require().Equal(v10.StakingProportion,
 		proportions.Staking.String()[:9], "staking")
@@ -248,7 +253,7 @@ func (s *UpgradeTestSuite) TestMigrateCallbackData() {
 }
 
 func (s *UpgradeTestSuite) TestMigrateDistributorAddress() {
-	ck := s.App.ClaimKeeper
+	ck := s.App.LegacyClaimKeeper()
 
 	// Airdrops
 	strideAirdrop := claimtypes.Airdrop{
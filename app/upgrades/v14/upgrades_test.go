@@ -216,7 +216,7 @@ func (s *UpgradeTestSuite) SetupAirdrops() {
 			},
 		},
 	}
-	err := s.App.ClaimKeeper.SetParams(s.Ctx, params)
+	err := s.App.LegacyClaimKeeper().SetParams(s.Ctx, params)
 	s.Require().NoError(err, "no error expected when setting claim params")
 
 	// Set vesting to 0s
@@ -228,7 +228,7 @@ func (s *UpgradeTestSuite) CheckAirdropsInitialized() {
 	afterCtx := s.Ctx.WithBlockHeight(dummyUpgradeHeight)
 
 	// Check that all airdrops were added, osmosis airdrop wasn't removed
-	claimParams, err := s.App.ClaimKeeper.GetParams(s.Ctx)
+	claimParams, err := s.App.LegacyClaimKeeper().GetParams(s.Ctx)
 	s.Require().NoError(err, "no error expected when getting params")
 	s.Require().Len(claimParams.Airdrops, 5, "there should be exactly 5 airdrops")
 
@@ -265,7 +265,7 @@ func (s *UpgradeTestSuite) CheckAirdropAdded(ctx sdk.Context, airdrop *claimtype
 	s.Require().Equal(v14.AirdropStartTime, airdrop.AirdropStartTime, fmt.Sprintf("%s airdrop start time", identifier))
 	s.Require().Equal(autopilotEnabled, airdrop.AutopilotEnabled, fmt.Sprintf("%s airdrop autopilot enabled", identifier))
 
-	claimRecords := s.App.ClaimKeeper.GetClaimRecords(ctx, identifier)
+	claimRecords := s.App.LegacyClaimKeeper().GetClaimRecords(ctx, identifier)
 	s.Require().Positive(len(claimRecords), fmt.Sprintf("there should be at least one claim record for %s", identifier))
 
 	// Check that an epoch was created
@@ -0,0 +1,316 @@
+package v28
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	airdropkeeper "github.com/Stride-Labs/stride/v27/x/airdrop/keeper"
+	airdroptypes "github.com/Stride-Labs/stride/v27/x/airdrop/types"
+	auctionkeeper "github.com/Stride-Labs/stride/v27/x/auction/keeper"
+	auctiontypes "github.com/Stride-Labs/stride/v27/x/auction/types"
+	claimkeeper "github.com/Stride-Labs/stride/v27/x/claim/keeper"
+	claimtypes "github.com/Stride-Labs/stride/v27/x/claim/types"
+	icacallbackskeeper "github.com/Stride-Labs/stride/v27/x/icacallbacks/keeper"
+	icqoraclekeeper "github.com/Stride-Labs/stride/v27/x/icqoracle/keeper"
+	icqkeeper "github.com/Stride-Labs/stride/v27/x/interchainquery/keeper"
+	mintmigration "github.com/Stride-Labs/stride/v27/x/mint/migrations/v2"
+	recordskeeper "github.com/Stride-Labs/stride/v27/x/records/keeper"
+	stakeibckeeper "github.com/Stride-Labs/stride/v27/x/stakeibc/keeper"
+)
+
+var (
+	UpgradeName = "v28"
+
+	// Prefix added to the identifier of each x/claim airdrop when it is migrated to x/airdrop
+	MigratedAirdropPrefix = "claim-"
+
+	// Each x/claim action is migrated to a tranche in the x/airdrop allocations array,
+	// with the tranche gated by the equivalent x/airdrop action
+	ClaimActions       = []claimtypes.Action{claimtypes.ACTION_FREE, claimtypes.ACTION_LIQUID_STAKE, claimtypes.ACTION_DELEGATE_STAKE}
+	TrancheActions     = []airdroptypes.Action{airdroptypes.ACTION_NONE, airdroptypes.ACTION_LIQUID_STAKE, airdroptypes.ACTION_DELEGATE_STAKE}
+	TranchePercentages = []sdk.Dec{claimtypes.PercentageForFree, claimtypes.PercentageForLiquidStake, claimtypes.PercentageForStake}
+
+	// Authz trade grants that were issued with MsgToggleTradeController before the grantee was
+	// tracked on the trade route, keyed by trade route ID
+	// Recording them allows the grant to be revoked when the route switches to on-chain swaps
+	TradeControllerGrants = map[string]TradeControllerGrant{}
+)
+
+// Grantee of the authz trade permissions on a trade route's trade ICA
+type TradeControllerGrant struct {
+	Address string
+	Legacy  bool
+}
+
+// CreateUpgradeHandler creates an SDK upgrade handler for v28
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	airdropKeeper airdropkeeper.Keeper,
+	auctionKeeper auctionkeeper.Keeper,
+	claimKeeper claimkeeper.Keeper,
+	icacallbacksKeeper icacallbackskeeper.Keeper,
+	icqOracleKeeper icqoraclekeeper.Keeper,
+	interchainqueryKeeper icqkeeper.Keeper,
+	recordsKeeper recordskeeper.Keeper,
+	stakeibcKeeper stakeibckeeper.Keeper,
+	mintParamSpace paramtypes.Subspace,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		ctx.Logger().Info("Starting upgrade v28...")
+
+		// Run migrations first
+		ctx.Logger().Info("Running module migrations...")
+		versionMap, err := mm.RunMigrations(ctx, configurator, vm)
+		if err != nil {
+			return nil, err
+		}
+
+		// The async-icq port is only bound in InitGenesis, so it must be bound here for existing chains
+		ctx.Logger().Info("Binding async-icq port...")
+		if err := interchainqueryKeeper.BindPort(ctx); err != nil {
+			return vm, errorsmod.Wrapf(err, "unable to bind async-icq port")
+		}
+
+		// The auction params were previously empty, so the new fields must be initialized
+		ctx.Logger().Info("Setting auction params...")
+		auctionKeeper.SetParams(ctx, auctiontypes.DefaultParams())
+
+		ctx.Logger().Info("Migrating claim airdrops to x/airdrop...")
+		if err := MigrateClaimAirdrops(ctx, airdropKeeper, claimKeeper); err != nil {
+			return vm, errorsmod.Wrapf(err, "unable to migrate claim airdrops")
+		}
+
+		ctx.Logger().Info("Initializing airdrop clawback state...")
+		MigrateAirdropClawbackState(ctx, airdropKeeper)
+
+		ctx.Logger().Info("Migrating mint distribution proportions to distribution recipients...")
+		if err := mintmigration.MigrateParams(ctx, mintParamSpace); err != nil {
+			return vm, errorsmod.Wrapf(err, "unable to migrate mint params")
+		}
+
+		ctx.Logger().Info("Indexing user redemption records by receiver...")
+		IndexUserRedemptionRecords(ctx, recordsKeeper)
+
+		ctx.Logger().Info("Backfilling callback data creation times...")
+		BackfillCallbackDataCreatedAt(ctx, icacallbacksKeeper)
+
+		ctx.Logger().Info("Backfilling trade controllers...")
+		BackfillTradeControllers(ctx, stakeibcKeeper, TradeControllerGrants)
+
+		ctx.Logger().Info("Registering recurring token price queries...")
+		if err := icqOracleKeeper.RegisterAllOsmosisPriceQueries(ctx); err != nil {
+			return vm, errorsmod.Wrapf(err, "unable to register token price queries")
+		}
+
+		return versionMap, nil
+	}
+}
+
+// Initializes the clawback state of each existing airdrop:
+//   - The forfeited pool is set to the total forfeited across the airdrop's users, since
+//     forfeited rewards were not previously pooled
+//   - The unclaimed budget is set to the remaining allocations plus the forfeited pool
+//     (and any merkle allocations that have not been initialized)
+//   - The daily claimed total is set to the amount claimed by users that did not forfeit rewards
+//
+// The clawback destination is left unchanged, so existing airdrops without a destination
+// are not clawed back until an admin explicitly sets one
+func MigrateAirdropClawbackState(ctx sdk.Context, airdropKeeper airdropkeeper.Keeper) {
+	for _, airdrop := range airdropKeeper.GetAllAirdrops(ctx) {
+		if airdrop.ClawedBack {
+			continue
+		}
+
+		remainingTotal := sdkmath.ZeroInt()
+		forfeitedTotal := sdkmath.ZeroInt()
+		dailyClaimedTotal := sdkmath.ZeroInt()
+		for _, userAllocation := range airdropKeeper.GetUserAllocationsForAirdrop(ctx, airdrop.Id) {
+			if userAllocation.AirdropId != airdrop.Id {
+				continue
+			}
+			remainingTotal = remainingTotal.Add(userAllocation.GetRemainingAllocations())
+			forfeitedTotal = forfeitedTotal.Add(userAllocation.Forfeited)
+			if userAllocation.Forfeited.IsZero() {
+				dailyClaimedTotal = dailyClaimedTotal.Add(userAllocation.Claimed)
+			}
+		}
+
+		airdrop.ForfeitedPool = airdrop.GetForfeitedPool().Add(forfeitedTotal)
+		airdrop.UnclaimedBudget = airdrop.GetForfeitedPool().Add(airdrop.GetMerkleUninitializedAmount()).Add(remainingTotal)
+		airdrop.DailyClaimedTotal = dailyClaimedTotal
+		airdropKeeper.SetAirdrop(ctx, airdrop)
+	}
+}
+
+// Sets the creation time of each pending callback data that was stored before the creation
+// time was tracked to the upgrade block time
+// The upgrade time is a lower bound on the age of the entry, so the min-age query filter
+// only includes these entries once they are at least that old relative to the upgrade
+func BackfillCallbackDataCreatedAt(ctx sdk.Context, icacallbacksKeeper icacallbackskeeper.Keeper) {
+	for _, callbackData := range icacallbacksKeeper.GetAllCallbackData(ctx) {
+		if !callbackData.CreatedAt.IsZero() {
+			continue
+		}
+		callbackData.CreatedAt = ctx.BlockTime()
+		icacallbacksKeeper.SetCallbackData(ctx, callbackData)
+	}
+}
+
+// Records the trade controller on each trade route with a grant issued before the controller
+// was tracked, so that the grant is revoked if a swap config is later added to the route
+// Routes that already track a controller are left unchanged
+func BackfillTradeControllers(ctx sdk.Context, k stakeibckeeper.Keeper, grants map[string]TradeControllerGrant) {
+	for _, tradeRoute := range k.GetAllTradeRoutes(ctx) {
+		grant, found := grants[tradeRoute.GetRouteId()]
+		if !found || tradeRoute.TradeController != "" {
+			continue
+		}
+
+		tradeRoute.TradeController = grant.Address
+		tradeRoute.TradeControllerLegacy = grant.Legacy
+		k.SetTradeRoute(ctx, tradeRoute)
+	}
+}
+
+// Re-writes each user redemption record so that the receiver index is populated
+// for records that were created before the index was introduced
+func IndexUserRedemptionRecords(ctx sdk.Context, recordsKeeper recordskeeper.Keeper) {
+	for _, userRedemptionRecord := range recordsKeeper.GetAllUserRedemptionRecord(ctx) {
+		recordsKeeper.SetUserRedemptionRecord(ctx, userRedemptionRecord)
+	}
+}
+
+// Migrates each remaining x/claim airdrop to an x/airdrop airdrop, and then removes
+// the x/claim airdrop and claim records
+// Airdrops that have already expired in x/claim are removed without being migrated
+func MigrateClaimAirdrops(ctx sdk.Context, airdropKeeper airdropkeeper.Keeper, claimKeeper claimkeeper.Keeper) error {
+	claimParams, err := claimKeeper.GetParams(ctx)
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to get claim params")
+	}
+
+	for _, claimAirdrop := range claimParams.Airdrops {
+		elapsedTime := ctx.BlockTime().Sub(claimAirdrop.AirdropStartTime)
+		if elapsedTime > claimAirdrop.AirdropDuration {
+			ctx.Logger().Info(fmt.Sprintf("Skipping migration of expired claim airdrop %s", claimAirdrop.AirdropIdentifier))
+		} else if err := MigrateClaimAirdrop(ctx, airdropKeeper, claimKeeper, *claimAirdrop); err != nil {
+			return errorsmod.Wrapf(err, "unable to migrate airdrop %s", claimAirdrop.AirdropIdentifier)
+		}
+
+		if err := claimKeeper.EndAirdrop(ctx, claimAirdrop.AirdropIdentifier); err != nil {
+			return errorsmod.Wrapf(err, "unable to end claim airdrop %s", claimAirdrop.AirdropIdentifier)
+		}
+	}
+
+	return nil
+}
+
+// Migrates a single x/claim airdrop to x/airdrop
+// The new airdrop has three tranches (free, liquid stake, and delegate) which are all claimable
+// immediately, provided the user has completed the associated action
+// The distributor account is reused, so no funds need to be moved
+//
+// Two x/claim behaviors are intentionally not carried over:
+//   - Vesting: x/claim vested liquid stake and delegate claims through a StridePeriodicVestingAccount
+//     during the airdrop's initial period, whereas x/airdrop pays out claims directly to the
+//     user's balance (existing vesting accounts are unaffected and continue to vest)
+//   - Claim status resets: x/claim reset each user's completed actions every airdrop epoch,
+//     allowing the pool to be claimed again; instead, the remaining pool is allocated a single
+//     time, and any tranche whose action was already completed in the current period is zero
+func MigrateClaimAirdrop(
+	ctx sdk.Context,
+	airdropKeeper airdropkeeper.Keeper,
+	claimKeeper claimkeeper.Keeper,
+	claimAirdrop claimtypes.Airdrop,
+) error {
+	airdropId := MigratedAirdropPrefix + claimAirdrop.AirdropIdentifier
+	if _, found := airdropKeeper.GetAirdrop(ctx, airdropId); found {
+		return airdroptypes.ErrAirdropAlreadyExists.Wrapf("airdrop %s", airdropId)
+	}
+
+	// Backdate the distribution start so that each of the tranches are already available,
+	// and keep the clawback date aligned with the end of the claim airdrop
+	// Claiming early is not supported since the deadline has already passed
+	periodLength := time.Duration(airdropKeeper.GetParams(ctx).PeriodLengthSeconds) * time.Second
+	numTranches := len(TrancheActions)
+
+	distributionEndDate := ctx.BlockTime()
+	distributionStartDate := distributionEndDate.Add(-1 * time.Duration(numTranches-1) * periodLength)
+	claimTypeDeadlineDate := distributionStartDate.Add(periodLength)
+	clawbackDate := claimAirdrop.AirdropStartTime.Add(claimAirdrop.AirdropDuration)
+	if !clawbackDate.After(distributionEndDate) {
+		clawbackDate = distributionEndDate.Add(periodLength)
+	}
+
+	airdrop := airdroptypes.Airdrop{
+		Id:                    airdropId,
+		RewardDenom:           claimAirdrop.ClaimDenom,
+		DistributionStartDate: &distributionStartDate,
+		DistributionEndDate:   &distributionEndDate,
+		ClawbackDate:          &clawbackDate,
+		ClaimTypeDeadlineDate: &claimTypeDeadlineDate,
+		EarlyClaimPenalty:     sdk.ZeroDec(),
+		ClaimAndStakeBonus:    sdk.ZeroDec(),
+		DistributorAddress:    claimAirdrop.DistributorAddress,
+		ForfeitedPool:         sdkmath.ZeroInt(),
+		ClawbackDestination:   airdroptypes.CLAWBACK_TO_COMMUNITY_POOL,
+		ClawedBackAmount:      sdkmath.ZeroInt(),
+		RedistributedAmount:   sdkmath.ZeroInt(),
+	}
+	airdropKeeper.SetAirdrop(ctx, airdrop)
+
+	// The claimable amount of each action is calculated the same way as in x/claim:
+	//   (distributor balance + claimed so far) * action percentage * user weight / total weight
+	totalWeight, err := claimKeeper.GetTotalWeight(ctx, claimAirdrop.AirdropIdentifier)
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to get total weight")
+	}
+	if totalWeight.IsZero() {
+		return nil
+	}
+
+	distributorBalance, err := claimKeeper.GetDistributorAccountBalance(ctx, claimAirdrop.AirdropIdentifier)
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to get distributor balance")
+	}
+	poolBalance := sdk.NewDecFromInt(distributorBalance.Amount.Add(claimAirdrop.ClaimedSoFar))
+
+	for _, claimRecord := range claimKeeper.GetClaimRecords(ctx, claimAirdrop.AirdropIdentifier) {
+		allocations := make([]sdkmath.Int, numTranches)
+		totalAllocation := sdkmath.ZeroInt()
+		for i, claimAction := range ClaimActions {
+			if int(claimAction) < len(claimRecord.ActionCompleted) && claimRecord.ActionCompleted[claimAction] {
+				allocations[i] = sdkmath.ZeroInt()
+				continue
+			}
+
+			allocations[i] = poolBalance.Mul(TranchePercentages[i]).Mul(claimRecord.Weight).Quo(totalWeight).RoundInt()
+			totalAllocation = totalAllocation.Add(allocations[i])
+		}
+
+		if totalAllocation.IsZero() {
+			continue
+		}
+
+		airdropKeeper.SetUserAllocation(ctx, airdroptypes.UserAllocation{
+			AirdropId:       airdropId,
+			Address:         claimRecord.Address,
+			Claimed:         sdkmath.ZeroInt(),
+			Forfeited:       sdkmath.ZeroInt(),
+			Allocations:     allocations,
+			RequiredActions: TrancheActions,
+		})
+	}
+
+	ctx.Logger().Info(fmt.Sprintf("Migrated claim airdrop %s to %s", claimAirdrop.AirdropIdentifier, airdropId))
+
+	return nil
+}
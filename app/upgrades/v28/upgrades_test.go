@@ -0,0 +1,455 @@
+package v28_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Stride-Labs/stride/v27/app/apptesting"
+	v28 "github.com/Stride-Labs/stride/v27/app/upgrades/v28"
+	airdroptypes "github.com/Stride-Labs/stride/v27/x/airdrop/types"
+	auctiontypes "github.com/Stride-Labs/stride/v27/x/auction/types"
+	claimtypes "github.com/Stride-Labs/stride/v27/x/claim/types"
+	claimvestingtypes "github.com/Stride-Labs/stride/v27/x/claim/vesting/types"
+	epochstypes "github.com/Stride-Labs/stride/v27/x/epochs/types"
+	icacallbackstypes "github.com/Stride-Labs/stride/v27/x/icacallbacks/types"
+	icqoracletypes "github.com/Stride-Labs/stride/v27/x/icqoracle/types"
+	icqtypes "github.com/Stride-Labs/stride/v27/x/interchainquery/types"
+	oldminttypes "github.com/Stride-Labs/stride/v27/x/mint/migrations/v2/types"
+	minttypes "github.com/Stride-Labs/stride/v27/x/mint/types"
+	recordstypes "github.com/Stride-Labs/stride/v27/x/records/types"
+	stakeibctypes "github.com/Stride-Labs/stride/v27/x/stakeibc/types"
+)
+
+type UpgradeTestSuite struct {
+	apptesting.AppTestHelper
+}
+
+func (s *UpgradeTestSuite) SetupTest() {
+	s.Setup()
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(UpgradeTestSuite))
+}
+
+func (s *UpgradeTestSuite) TestUpgrade() {
+	upgradeHeight := int64(4)
+
+	// Set state before upgrade
+	checkAuctionParamsSet := s.SetupTestSetAuctionParams()
+	checkClaimAirdropsMigrated := s.SetupTestMigrateClaimAirdrops()
+	checkMintParamsMigrated := s.SetupTestMigrateMintParams()
+	checkPriceQueriesRegistered := s.SetupTestRegisterPriceQueries()
+	checkRedemptionRecordsIndexed := s.SetupTestIndexUserRedemptionRecords()
+	checkAirdropClawbackStateMigrated := s.SetupTestMigrateAirdropClawbackState()
+	checkCallbackDataBackfilled := s.SetupTestBackfillCallbackDataCreatedAt()
+
+	// Run upgrade
+	s.ConfirmUpgradeSucceededs(v28.UpgradeName, upgradeHeight)
+
+	// Confirm state after upgrade
+	checkAuctionParamsSet()
+	checkClaimAirdropsMigrated()
+	checkMintParamsMigrated()
+	checkPriceQueriesRegistered()
+	checkRedemptionRecordsIndexed()
+	checkAirdropClawbackStateMigrated()
+	checkCallbackDataBackfilled()
+
+	// Confirm the async-icq port is bound
+	_, found := s.App.ScopedInterchainqueryKeeper.GetCapability(s.Ctx, host.PortPath(icqtypes.PortID))
+	s.Require().True(found, "async-icq port should be bound")
+}
+
+func (s *UpgradeTestSuite) SetupTestSetAuctionParams() func() {
+	// Store the params from before the upgrade, when the params message was empty
+	auctionStore := s.Ctx.KVStore(s.App.GetKey(auctiontypes.StoreKey))
+	auctionStore.Set(auctiontypes.ParamsKey, []byte{})
+	s.Require().Error(s.App.AuctionKeeper.GetParams(s.Ctx).Validate(), "params should be invalid before the upgrade")
+
+	return func() {
+		params := s.App.AuctionKeeper.GetParams(s.Ctx)
+		s.Require().NoError(params.Validate(), "params should be valid after the upgrade")
+		s.Require().Equal(auctiontypes.DefaultParams(), params, "auction params")
+	}
+}
+
+func (s *UpgradeTestSuite) SetupTestMigrateClaimAirdrops() func() {
+	claimAirdropId := "stride"
+	expiredClaimAirdropId := "evmos"
+	migratedAirdropId := v28.MigratedAirdropPrefix + claimAirdropId
+	claimDenom := "ustrd"
+
+	distributor := s.TestAccs[0]
+	userA := s.TestAccs[1]
+	userB := s.TestAccs[2]
+
+	// Fund the distributor with 907 tokens, with 100 tokens already claimed in this round
+	// The pool balance used in the calculation is therefore 1007
+	s.FundAccount(distributor, sdk.NewCoin(claimDenom, sdkmath.NewInt(907)))
+
+	airdropStartTime := s.Ctx.BlockTime().Add(-1 * time.Hour)
+	airdropDuration := time.Hour * 24 * 365
+	err := s.App.LegacyClaimKeeper().SetParams(s.Ctx, claimtypes.Params{
+		Airdrops: []*claimtypes.Airdrop{
+			{
+				AirdropIdentifier:  claimAirdropId,
+				ChainId:            "stride-1",
+				AirdropStartTime:   airdropStartTime,
+				AirdropDuration:    airdropDuration,
+				ClaimDenom:         claimDenom,
+				DistributorAddress: distributor.String(),
+				ClaimedSoFar:       sdkmath.NewInt(100),
+			},
+			{
+				AirdropIdentifier:  expiredClaimAirdropId,
+				ChainId:            "evmos_9001-2",
+				AirdropStartTime:   airdropStartTime.Add(-2 * airdropDuration),
+				AirdropDuration:    airdropDuration,
+				ClaimDenom:         claimDenom,
+				DistributorAddress: s.TestAccs[3].String(),
+				ClaimedSoFar:       sdkmath.ZeroInt(),
+			},
+		},
+	})
+	s.Require().NoError(err, "no error expected when setting claim params")
+
+	// User A has not completed any actions, user B has already claimed the free tranche
+	// Each user has half the total weight
+	claimRecords := []claimtypes.ClaimRecord{
+		{
+			AirdropIdentifier: claimAirdropId,
+			Address:           userA.String(),
+			Weight:            sdk.MustNewDecFromStr("0.5"),
+			ActionCompleted:   []bool{false, false, false},
+		},
+		{
+			AirdropIdentifier: claimAirdropId,
+			Address:           userB.String(),
+			Weight:            sdk.MustNewDecFromStr("0.5"),
+			ActionCompleted:   []bool{true, false, false},
+		},
+		{
+			AirdropIdentifier: expiredClaimAirdropId,
+			Address:           userA.String(),
+			Weight:            sdk.OneDec(),
+			ActionCompleted:   []bool{false, false, false},
+		},
+	}
+	err = s.App.LegacyClaimKeeper().SetClaimRecordsWithWeights(s.Ctx, claimRecords)
+	s.Require().NoError(err, "no error expected when setting claim records")
+
+	// Return callback to check store after upgrade
+	return func() {
+		// Confirm the claim airdrop and records were removed
+		claimParams, err := s.App.LegacyClaimKeeper().GetParams(s.Ctx)
+		s.Require().NoError(err, "no error expected when getting claim params")
+		s.Require().Empty(claimParams.Airdrops, "claim airdrops should be removed")
+		s.Require().Empty(s.App.LegacyClaimKeeper().GetClaimRecords(s.Ctx, claimAirdropId), "claim records should be removed")
+		s.Require().Empty(s.App.LegacyClaimKeeper().GetClaimRecords(s.Ctx, expiredClaimAirdropId),
+			"expired claim records should be removed")
+
+		// Confirm the expired airdrop was not migrated
+		_, expiredFound := s.App.AirdropKeeper.GetAirdrop(s.Ctx, v28.MigratedAirdropPrefix+expiredClaimAirdropId)
+		s.Require().False(expiredFound, "expired airdrop should not be migrated")
+
+		// Confirm the new airdrop was created with the same distributor and denom
+		airdrop, found := s.App.AirdropKeeper.GetAirdrop(s.Ctx, migratedAirdropId)
+		s.Require().True(found, "migrated airdrop should exist")
+		s.Require().Equal(claimDenom, airdrop.RewardDenom, "reward denom")
+		s.Require().Equal(distributor.String(), airdrop.DistributorAddress, "distributor")
+		s.Require().Equal(airdropStartTime.Add(airdropDuration), *airdrop.ClawbackDate, "clawback date")
+
+		// Confirm all tranches are immediately claimable
+		periodLengthSeconds := s.App.AirdropKeeper.GetParams(s.Ctx).PeriodLengthSeconds
+		s.Require().Equal(int64(3), airdrop.GetAirdropPeriods(periodLengthSeconds), "airdrop periods")
+		dateIndex, err := airdrop.GetCurrentDateIndex(s.Ctx, periodLengthSeconds)
+		s.Require().NoError(err, "no error expected when getting date index")
+		s.Require().Equal(2, dateIndex, "date index")
+
+		// Confirm the user allocations, with each tranche gated by the claim action
+		// Free: 20%, Liquid Stake: 60%, Delegate: 20%
+		// Each allocation is rounded to the nearest integer (e.g. 1007 * 20% * 0.5 = 100.7 => 101)
+		expectedActions := []airdroptypes.Action{
+			airdroptypes.ACTION_NONE,
+			airdroptypes.ACTION_LIQUID_STAKE,
+			airdroptypes.ACTION_DELEGATE_STAKE,
+		}
+		expectedAllocations := map[string][]int64{
+			userA.String(): {101, 302, 101},
+			userB.String(): {0, 302, 101},
+		}
+		for address, expectedAmounts := range expectedAllocations {
+			userAllocation, found := s.App.AirdropKeeper.GetUserAllocation(s.Ctx, migratedAirdropId, address)
+			s.Require().True(found, "user allocation for %s should exist", address)
+			s.Require().Equal(expectedActions, userAllocation.RequiredActions, "required actions for %s", address)
+
+			actualAmounts := []int64{}
+			for _, allocation := range userAllocation.Allocations {
+				actualAmounts = append(actualAmounts, allocation.Int64())
+			}
+			s.Require().Equal(expectedAmounts, actualAmounts, "allocations for %s", address)
+		}
+
+		// Confirm the budget includes all the migrated allocations
+		s.Require().Equal(int64(101+302+101+302+101), airdrop.UnclaimedBudget.Int64(), "unclaimed budget")
+		s.Require().Equal(airdroptypes.CLAWBACK_TO_COMMUNITY_POOL, airdrop.ClawbackDestination, "clawback destination")
+
+		// Confirm the x/claim airdrop epoch no longer resets the user's completed actions,
+		// so user B's already claimed free tranche is not re-allocated
+		s.App.EpochsKeeper.AfterEpochEnd(s.Ctx, epochstypes.EpochInfo{Identifier: "airdrop-" + claimAirdropId})
+		userBAllocation, found := s.App.AirdropKeeper.GetUserAllocation(s.Ctx, migratedAirdropId, userB.String())
+		s.Require().True(found, "user B allocation should exist")
+		s.Require().Equal(int64(0), userBAllocation.Allocations[0].Int64(), "user B free allocation after epoch")
+
+		// Confirm the liquid stake tranche is paid out directly instead of vesting
+		// (x/claim would have vested it since the airdrop is still in its initial period)
+		initialBalance := s.App.BankKeeper.SpendableCoins(s.Ctx, userA).AmountOf(claimDenom)
+		s.App.AirdropKeeper.CompleteActionForUser(s.Ctx, userA.String(), airdroptypes.ACTION_LIQUID_STAKE)
+		err = s.App.AirdropKeeper.ClaimDaily(s.Ctx, migratedAirdropId, userA.String())
+		s.Require().NoError(err, "no error expected when claiming migrated airdrop")
+
+		_, isVestingAccount := s.App.AccountKeeper.GetAccount(s.Ctx, userA).(*claimvestingtypes.StridePeriodicVestingAccount)
+		s.Require().False(isVestingAccount, "user A should not have a vesting account")
+		spendableBalance := s.App.BankKeeper.SpendableCoins(s.Ctx, userA).AmountOf(claimDenom)
+		s.Require().Equal(int64(101+302), spendableBalance.Sub(initialBalance).Int64(), "user A spendable balance change")
+	}
+}
+
+func (s *UpgradeTestSuite) SetupTestMigrateMintParams() func() {
+	// Store the legacy distribution proportions under the old param key
+	oldProportions := oldminttypes.DistributionProportions{
+		Staking:                     sdk.MustNewDecFromStr("0.1"),
+		CommunityPoolGrowth:         sdk.MustNewDecFromStr("0.2"),
+		CommunityPoolSecurityBudget: sdk.MustNewDecFromStr("0.3"),
+		StrategicReserve:            sdk.MustNewDecFromStr("0.4"),
+	}
+	oldProportionsBz, err := codec.NewLegacyAmino().MarshalJSON(oldProportions)
+	s.Require().NoError(err, "no error expected when serializing old proportions")
+
+	paramsStore := prefix.NewStore(s.Ctx.KVStore(s.App.GetKey(paramstypes.StoreKey)), []byte(minttypes.ModuleName+"/"))
+	paramsStore.Set(oldminttypes.KeyPoolAllocationRatio, oldProportionsBz)
+
+	// Return callback to check store after upgrade
+	return func() {
+		expectedRecipients := []minttypes.DistributionRecipient{
+			{
+				RecipientType: minttypes.RECIPIENT_TYPE_MODULE_ACCOUNT,
+				Recipient:     authtypes.FeeCollectorName,
+				Weight:        sdk.MustNewDecFromStr("0.1"),
+			},
+			{
+				RecipientType: minttypes.RECIPIENT_TYPE_ADDRESS,
+				Recipient:     minttypes.StrategicReserveAddress,
+				Weight:        sdk.MustNewDecFromStr("0.4"),
+			},
+			{
+				RecipientType: minttypes.RECIPIENT_TYPE_SUBMODULE,
+				Recipient:     minttypes.CommunitySecurityBudgetSubmoduleName,
+				Weight:        sdk.MustNewDecFromStr("0.3"),
+			},
+			{
+				RecipientType: minttypes.RECIPIENT_TYPE_SUBMODULE,
+				Recipient:     minttypes.CommunityGrowthSubmoduleName,
+				Weight:        sdk.MustNewDecFromStr("0.2"),
+			},
+		}
+		actualRecipients := s.App.MintKeeper.GetParams(s.Ctx).DistributionRecipients
+		s.Require().Equal(expectedRecipients, actualRecipients, "distribution recipients")
+	}
+}
+
+func (s *UpgradeTestSuite) SetupTestRegisterPriceQueries() func() {
+	// Store the icqoracle params and a few existing token prices
+	s.App.ICQOracleKeeper.SetParams(s.Ctx, icqoracletypes.Params{
+		OsmosisChainId:      "osmosis-1",
+		OsmosisConnectionId: "connection-0",
+		UpdateIntervalSec:   60,
+	})
+
+	tokenPrices := []icqoracletypes.TokenPrice{
+		{BaseDenom: "uatom", QuoteDenom: "uusdc", OsmosisPoolId: 1},
+		{BaseDenom: "uosmo", QuoteDenom: "uusdc", OsmosisPoolId: 2},
+	}
+	for _, tokenPrice := range tokenPrices {
+		s.App.ICQOracleKeeper.SetTokenPrice(s.Ctx, tokenPrice)
+	}
+
+	// Return callback to check store after upgrade
+	return func() {
+		for _, tokenPrice := range tokenPrices {
+			queryId := icqoracletypes.TokenPriceQueryId(tokenPrice.BaseDenom, tokenPrice.QuoteDenom, tokenPrice.OsmosisPoolId)
+			recurringQuery, found := s.App.InterchainqueryKeeper.GetRecurringQuery(s.Ctx, icqoracletypes.ModuleName, queryId)
+			s.Require().True(found, "price query %s should have been registered", queryId)
+			s.Require().Equal(time.Minute, recurringQuery.Interval, "price query %s interval", queryId)
+		}
+	}
+}
+
+func (s *UpgradeTestSuite) SetupTestIndexUserRedemptionRecords() func() {
+	receiver := "receiver"
+	records := []recordstypes.UserRedemptionRecord{
+		{Id: "chain-0.1.receiver", HostZoneId: "chain-0", EpochNumber: 1, Receiver: receiver},
+		{Id: "chain-1.1.receiver", HostZoneId: "chain-1", EpochNumber: 1, Receiver: receiver},
+		{Id: "chain-0.2.other", HostZoneId: "chain-0", EpochNumber: 2, Receiver: "other"},
+	}
+
+	// Write the records directly to the store so that the receiver index is not populated
+	recordsStore := s.Ctx.KVStore(s.App.GetKey(recordstypes.StoreKey))
+	recordStore := prefix.NewStore(recordsStore, recordstypes.KeyPrefix(recordstypes.UserRedemptionRecordKey))
+	for _, record := range records {
+		record.NativeTokenAmount = sdkmath.ZeroInt()
+		record.StTokenAmount = sdkmath.ZeroInt()
+		recordStore.Set([]byte(record.Id), s.App.AppCodec().MustMarshal(&record))
+	}
+
+	// Confirm the records can't be found from the index
+	indexedRecords, _ := s.App.RecordsKeeper.GetUserRedemptionRecordsForReceiver(s.Ctx, receiver, "", "", 10)
+	s.Require().Empty(indexedRecords, "records should not be indexed before the upgrade")
+
+	return func() {
+		indexedRecords, _ := s.App.RecordsKeeper.GetUserRedemptionRecordsForReceiver(s.Ctx, receiver, "", "", 10)
+		s.Require().Len(indexedRecords, 2, "number of indexed records for receiver")
+		s.Require().Equal(records[0].Id, indexedRecords[0].Id, "first indexed record")
+		s.Require().Equal(records[1].Id, indexedRecords[1].Id, "second indexed record")
+
+		indexedRecords, _ = s.App.RecordsKeeper.GetUserRedemptionRecordsForReceiver(s.Ctx, "other", "", "", 10)
+		s.Require().Len(indexedRecords, 1, "number of indexed records for other receiver")
+	}
+}
+
+func (s *UpgradeTestSuite) SetupTestBackfillCallbackDataCreatedAt() func() {
+	createdAt := time.Unix(1_000_000, 0).UTC()
+
+	// Store one legacy callback data without a creation time, and one with a creation time
+	legacyCallbackKey := icacallbackstypes.PacketID("port-0", "channel-0", 1)
+	s.App.IcacallbacksKeeper.SetCallbackData(s.Ctx, icacallbackstypes.CallbackData{
+		CallbackKey: legacyCallbackKey,
+		PortId:      "port-0",
+		ChannelId:   "channel-0",
+		Sequence:    1,
+	})
+	recentCallbackKey := icacallbackstypes.PacketID("port-0", "channel-0", 2)
+	s.App.IcacallbacksKeeper.SetCallbackData(s.Ctx, icacallbackstypes.CallbackData{
+		CallbackKey: recentCallbackKey,
+		PortId:      "port-0",
+		ChannelId:   "channel-0",
+		Sequence:    2,
+		CreatedAt:   createdAt,
+	})
+
+	return func() {
+		legacyCallbackData, found := s.App.IcacallbacksKeeper.GetCallbackData(s.Ctx, legacyCallbackKey)
+		s.Require().True(found, "legacy callback data should be found")
+		s.Require().False(legacyCallbackData.CreatedAt.IsZero(), "legacy callback data created at should be backfilled")
+		s.Require().False(legacyCallbackData.CreatedAt.After(s.Ctx.BlockTime()), "legacy created at should not be after the upgrade")
+
+		recentCallbackData, found := s.App.IcacallbacksKeeper.GetCallbackData(s.Ctx, recentCallbackKey)
+		s.Require().True(found, "recent callback data should be found")
+		s.Require().Equal(createdAt, recentCallbackData.CreatedAt.UTC(), "recent callback data created at should be unchanged")
+	}
+}
+
+func (s *UpgradeTestSuite) SetupTestMigrateAirdropClawbackState() func() {
+	airdropId := "existing-airdrop"
+	clawedBackAirdropId := "clawed-back-airdrop"
+
+	// Create an airdrop without a clawback destination, and an airdrop that's already been clawed back
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, airdroptypes.Airdrop{
+		Id: airdropId,
+	})
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, airdroptypes.Airdrop{
+		Id:         clawedBackAirdropId,
+		ClawedBack: true,
+	})
+
+	// Add a user that claimed daily and a user that claimed early
+	userAllocations := []airdroptypes.UserAllocation{
+		{
+			AirdropId:   airdropId,
+			Address:     "daily-claimer",
+			Claimed:     sdkmath.NewInt(10),
+			Forfeited:   sdkmath.ZeroInt(),
+			Allocations: []sdkmath.Int{sdkmath.ZeroInt(), sdkmath.NewInt(10), sdkmath.NewInt(10)},
+		},
+		{
+			AirdropId:   airdropId,
+			Address:     "early-claimer",
+			Claimed:     sdkmath.NewInt(5),
+			Forfeited:   sdkmath.NewInt(5),
+			Allocations: []sdkmath.Int{sdkmath.ZeroInt(), sdkmath.ZeroInt(), sdkmath.ZeroInt()},
+		},
+		{
+			AirdropId:   clawedBackAirdropId,
+			Address:     "daily-claimer",
+			Claimed:     sdkmath.NewInt(10),
+			Forfeited:   sdkmath.ZeroInt(),
+			Allocations: []sdkmath.Int{sdkmath.NewInt(10)},
+		},
+	}
+	for _, userAllocation := range userAllocations {
+		s.App.AirdropKeeper.SetUserAllocation(s.Ctx, userAllocation)
+	}
+
+	return func() {
+		airdrop, found := s.App.AirdropKeeper.GetAirdrop(s.Ctx, airdropId)
+		s.Require().True(found, "airdrop should exist")
+		s.Require().Equal(airdroptypes.CLAWBACK_DESTINATION_UNSPECIFIED, airdrop.ClawbackDestination, "clawback destination")
+		s.Require().Equal(int64(5), airdrop.ForfeitedPool.Int64(), "forfeited pool")
+		s.Require().Equal(int64(20+5), airdrop.UnclaimedBudget.Int64(), "unclaimed budget")
+		s.Require().Equal(int64(10), airdrop.DailyClaimedTotal.Int64(), "daily claimed total")
+
+		// The airdrop that was already clawed back should not be modified
+		clawedBackAirdrop, found := s.App.AirdropKeeper.GetAirdrop(s.Ctx, clawedBackAirdropId)
+		s.Require().True(found, "clawed back airdrop should exist")
+		s.Require().Equal(airdroptypes.CLAWBACK_DESTINATION_UNSPECIFIED, clawedBackAirdrop.ClawbackDestination,
+			"clawed back airdrop destination")
+		s.Require().True(clawedBackAirdrop.GetUnclaimedBudget().IsZero(), "clawed back airdrop budget")
+	}
+}
+
+func (s *UpgradeTestSuite) TestBackfillTradeControllers() {
+	// Create a route with an untracked grant, a route that already tracks a controller,
+	// and a route without a grant
+	untrackedRoute := stakeibctypes.TradeRoute{RewardDenomOnRewardZone: "uusdc", HostDenomOnHostZone: "adydx"}
+	trackedRoute := stakeibctypes.TradeRoute{
+		RewardDenomOnRewardZone: "uusdc",
+		HostDenomOnHostZone:     "uatom",
+		TradeController:         "current-controller",
+	}
+	noGrantRoute := stakeibctypes.TradeRoute{RewardDenomOnRewardZone: "uusdc", HostDenomOnHostZone: "uosmo"}
+	for _, route := range []stakeibctypes.TradeRoute{untrackedRoute, trackedRoute, noGrantRoute} {
+		s.App.StakeibcKeeper.SetTradeRoute(s.Ctx, route)
+	}
+
+	grants := map[string]v28.TradeControllerGrant{
+		untrackedRoute.GetRouteId(): {Address: "legacy-controller", Legacy: true},
+		trackedRoute.GetRouteId():   {Address: "old-controller"},
+	}
+	v28.BackfillTradeControllers(s.Ctx, s.App.StakeibcKeeper, grants)
+
+	// The untracked grant should be recorded
+	route, found := s.App.StakeibcKeeper.GetTradeRoute(s.Ctx, "uusdc", "adydx")
+	s.Require().True(found, "untracked route should exist")
+	s.Require().Equal("legacy-controller", route.TradeController, "untracked route controller")
+	s.Require().True(route.TradeControllerLegacy, "untracked route controller legacy")
+
+	// The route that already tracks a controller should not change
+	route, found = s.App.StakeibcKeeper.GetTradeRoute(s.Ctx, "uusdc", "uatom")
+	s.Require().True(found, "tracked route should exist")
+	s.Require().Equal("current-controller", route.TradeController, "tracked route controller")
+
+	// The route without a grant should not have a controller
+	route, found = s.App.StakeibcKeeper.GetTradeRoute(s.Ctx, "uusdc", "uosmo")
+	s.Require().True(found, "route without grant should exist")
+	s.Require().Empty(route.TradeController, "route without grant controller")
+}
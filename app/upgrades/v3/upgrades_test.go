@@ -32,7 +32,7 @@ func (s *UpgradeTestSuite) TestUpgrade() {
 	// make sure claim record was set
 	afterCtx := s.Ctx.WithBlockHeight(dummyUpgradeHeight)
 	for _, identifier := range airdropIdentifiers {
-		claimRecords := s.App.ClaimKeeper.GetClaimRecords(afterCtx, identifier)
+		claimRecords := s.App.LegacyClaimKeeper().GetClaimRecords(afterCtx, identifier)
 		s.Require().NotEqual(0, len(claimRecords))
 	}
 }
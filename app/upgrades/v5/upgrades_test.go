@@ -92,7 +92,7 @@ func (s *UpgradeTestSuite) SetupOldClaimStore(codec codec.Codec) func() {
 
 	// Callback to check claim store after migration
 	return func() {
-		claimParams, err := s.App.ClaimKeeper.GetParams(s.Ctx)
+		claimParams, err := s.App.LegacyClaimKeeper().GetParams(s.Ctx)
 		s.Require().NoError(err, "no error expected when getting claims")
 		s.Require().Equal(claimParams.Airdrops[0].AirdropIdentifier, airdropId, "airdrop identifier")
 		s.Require().Equal(claimParams.Airdrops[0].ClaimedSoFar, sdkmath.NewInt(1000000), "claimed so far")
@@ -87,7 +87,7 @@ func (s *UpgradeTestSuite) SetupClaimStore(codec codec.Codec) func() {
 		ActionCompleted:   []bool{false, false, false},
 	}
 	claimRecords = append(claimRecords, claimRecord3)
-	err = s.App.ClaimKeeper.SetClaimRecords(s.Ctx, claimRecords)
+	err = s.App.LegacyClaimKeeper().SetClaimRecords(s.Ctx, claimRecords)
 	s.Require().NoError(err, "no error expected when setting claim records")
 
 	types.DefaultVestingInitialPeriod, err = time.ParseDuration("0s")
@@ -95,8 +95,8 @@ func (s *UpgradeTestSuite) SetupClaimStore(codec codec.Codec) func() {
 
 	// Callback to check claim store after migration
 	return func() {
-		claimParams, err := s.App.ClaimKeeper.GetParams(s.Ctx)
-		claimRecords := s.App.ClaimKeeper.GetClaimRecords(s.Ctx, airdropId)
+		claimParams, err := s.App.LegacyClaimKeeper().GetParams(s.Ctx)
+		claimRecords := s.App.LegacyClaimKeeper().GetClaimRecords(s.Ctx, airdropId)
 
 		s.Require().NoError(err, "no error expected when getting claims")
 		s.Require().Equal(claimParams.Airdrops[0].AirdropIdentifier, airdropId, "airdrop identifier")
@@ -65,7 +65,7 @@ func (s *UpgradeTestSuite) SetupStoreBeforeUpgrade() {
 			},
 		},
 	}
-	err := s.App.ClaimKeeper.SetParams(s.Ctx, params)
+	err := s.App.LegacyClaimKeeper().SetParams(s.Ctx, params)
 	s.Require().NoError(err, "no error expected when setting claim params")
 
 	// Add claim records for the airdrop
@@ -89,7 +89,7 @@ func (s *UpgradeTestSuite) SetupStoreBeforeUpgrade() {
 			ActionCompleted:   []bool{false, false, false},
 		},
 	}
-	err = s.App.ClaimKeeper.SetClaimRecords(s.Ctx, claimRecords)
+	err = s.App.LegacyClaimKeeper().SetClaimRecords(s.Ctx, claimRecords)
 	s.Require().NoError(err, "no error expected when setting claim record")
 
 	// Set vesting to 0s
@@ -101,7 +101,7 @@ func (s *UpgradeTestSuite) CheckStoreAfterUpgrade() {
 	afterCtx := s.Ctx.WithBlockHeight(dummyUpgradeHeight)
 
 	// Check that the evmos airdrop was added and the unofficial airdrop was removed
-	claimParams, err := s.App.ClaimKeeper.GetParams(s.Ctx)
+	claimParams, err := s.App.LegacyClaimKeeper().GetParams(s.Ctx)
 	s.Require().NoError(err, "no error expected when getting params")
 	s.Require().Len(claimParams.Airdrops, 2, "there should be only two airdrops (evmos and osmo)")
 	osmoAirdrop := claimParams.Airdrops[0]
@@ -120,11 +120,11 @@ func (s *UpgradeTestSuite) CheckStoreAfterUpgrade() {
 	s.Require().Equal(v8.AirdropStartTime, evmosAirdrop.AirdropStartTime, "evmos airdrop start time")
 
 	// Check that the evmos claims records were added
-	evmosClaimRecords := s.App.ClaimKeeper.GetClaimRecords(afterCtx, v8.EvmosAirdropIdentifier)
+	evmosClaimRecords := s.App.LegacyClaimKeeper().GetClaimRecords(afterCtx, v8.EvmosAirdropIdentifier)
 	s.Require().Positive(len(evmosClaimRecords))
 
 	// Check that the osmo claim actions were reset
-	osmoClaimRecords := s.App.ClaimKeeper.GetClaimRecords(s.Ctx, osmoAirdropId)
+	osmoClaimRecords := s.App.LegacyClaimKeeper().GetClaimRecords(s.Ctx, osmoAirdropId)
 	s.Require().Equal(len(osmoClaimRecords), 3, "claim records length")
 
 	fullyResetAction := []bool{false, false, false}
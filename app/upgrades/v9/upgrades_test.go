@@ -66,7 +66,7 @@ func (s *UpgradeTestSuite) SetupAirdropsBeforeUpgrade() {
 
 func (s *UpgradeTestSuite) CheckAirdropsAfterUpgrade() {
 	// Read in the airdrops using the new schema - which should include chainId and AirdropEnabled
-	claimParams, err := s.App.ClaimKeeper.GetParams(s.Ctx)
+	claimParams, err := s.App.LegacyClaimKeeper().GetParams(s.Ctx)
 	s.Require().NoError(err, "no error expected when getting claims params")
 	s.Require().Len(claimParams.Airdrops, len(v9.AirdropChainIds)+1, "number of airdrops after migration")
 
@@ -92,7 +92,7 @@ func (s *UpgradeTestSuite) CheckAirdropsAfterUpgrade() {
 func (s *UpgradeTestSuite) TestAddFieldsToAirdropType() {
 	s.SetupAirdropsBeforeUpgrade()
 
-	err := v9.AddFieldsToAirdropType(s.Ctx, s.App.ClaimKeeper)
+	err := v9.AddFieldsToAirdropType(s.Ctx, s.App.LegacyClaimKeeper())
 	s.Require().NoError(err, "no error expected when migrating airdrop schema")
 
 	s.CheckAirdropsAfterUpgrade()
@@ -13,7 +13,7 @@ import (
 
 func ClaimKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 	app := strideapp.InitStrideTestApp(true)
-	claimKeeper := app.ClaimKeeper
+	claimKeeper := app.LegacyClaimKeeper()
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "stride-1", Time: time.Now().UTC()})
 
 	return &claimKeeper, ctx
@@ -0,0 +1,32 @@
+package bindings
+
+import (
+	sdkmath "cosmossdk.io/math"
+)
+
+// StrideMsg contains the custom messages that can be dispatched by contracts
+// The contract is used as the sender of each message
+// Exactly one field should be set
+type StrideMsg struct {
+	// Liquid stakes native tokens held by the contract
+	LiquidStake *LiquidStake `json:"liquid_stake,omitempty"`
+	// Redeems stTokens held by the contract
+	RedeemStake *RedeemStake `json:"redeem_stake,omitempty"`
+	// Claims the contract's daily airdrop rewards
+	ClaimAirdrop *ClaimAirdrop `json:"claim_airdrop,omitempty"`
+}
+
+type LiquidStake struct {
+	HostDenom string      `json:"host_denom"`
+	Amount    sdkmath.Int `json:"amount"`
+}
+
+type RedeemStake struct {
+	HostZone string      `json:"host_zone"`
+	Amount   sdkmath.Int `json:"amount"`
+	Receiver string      `json:"receiver"`
+}
+
+type ClaimAirdrop struct {
+	AirdropId string `json:"airdrop_id"`
+}
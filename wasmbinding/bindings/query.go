@@ -0,0 +1,78 @@
+package bindings
+
+import (
+	sdkmath "cosmossdk.io/math"
+)
+
+// StrideQuery contains the custom queries that can be dispatched by contracts
+// Exactly one field should be set
+type StrideQuery struct {
+	// Returns the host zone for the given chain ID
+	HostZone *HostZone `json:"host_zone,omitempty"`
+	// Returns the redemption rate of the stToken for the given chain ID
+	RedemptionRate *RedemptionRate `json:"redemption_rate,omitempty"`
+	// Returns the price of the base denom in terms of the quote denom, from the icqoracle
+	TokenPrice *TokenPrice `json:"token_price,omitempty"`
+	// Returns the redemption records for a receiver on the host zone
+	UserRedemptionRecords *UserRedemptionRecords `json:"user_redemption_records,omitempty"`
+}
+
+type HostZone struct {
+	ChainId string `json:"chain_id"`
+}
+
+type HostZoneResponse struct {
+	ChainId          string            `json:"chain_id"`
+	HostDenom        string            `json:"host_denom"`
+	IbcDenom         string            `json:"ibc_denom"`
+	StDenom          string            `json:"st_denom"`
+	RedemptionRate   sdkmath.LegacyDec `json:"redemption_rate"`
+	TotalDelegations sdkmath.Int       `json:"total_delegations"`
+	UnbondingPeriod  uint64            `json:"unbonding_period"`
+	Halted           bool              `json:"halted"`
+}
+
+type RedemptionRate struct {
+	ChainId string `json:"chain_id"`
+}
+
+type RedemptionRateResponse struct {
+	RedemptionRate sdkmath.LegacyDec `json:"redemption_rate"`
+}
+
+type TokenPrice struct {
+	BaseDenom  string `json:"base_denom"`
+	QuoteDenom string `json:"quote_denom"`
+}
+
+type TokenPriceResponse struct {
+	Price sdkmath.LegacyDec `json:"price"`
+}
+
+type UserRedemptionRecords struct {
+	// Address on the host zone that receives the unbonded tokens
+	Receiver string `json:"receiver"`
+	// Optional - if specified, only records for the given host zone are returned
+	ChainId string `json:"chain_id,omitempty"`
+	// Optional - if specified, only records after the given record ID are returned
+	StartAfter string `json:"start_after,omitempty"`
+	// Optional - max number of records to return (defaults to, and capped at, the max page size)
+	Limit uint64 `json:"limit,omitempty"`
+}
+
+type UserRedemptionRecordsResponse struct {
+	Records []UserRedemptionRecord `json:"records"`
+	// ID of the last record returned if there are more records remaining
+	// This should be passed as start_after to fetch the next page
+	NextKey string `json:"next_key,omitempty"`
+}
+
+type UserRedemptionRecord struct {
+	Id                string      `json:"id"`
+	HostZoneId        string      `json:"host_zone_id"`
+	EpochNumber       uint64      `json:"epoch_number"`
+	Denom             string      `json:"denom"`
+	NativeTokenAmount sdkmath.Int `json:"native_token_amount"`
+	StTokenAmount     sdkmath.Int `json:"st_token_amount"`
+	ClaimIsPending    bool        `json:"claim_is_pending"`
+}
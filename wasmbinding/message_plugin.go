@@ -0,0 +1,98 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/v27/wasmbinding/bindings"
+	airdroptypes "github.com/Stride-Labs/stride/v27/x/airdrop/types"
+	stakeibctypes "github.com/Stride-Labs/stride/v27/x/stakeibc/types"
+)
+
+// CustomMessenger handles the custom stride messages dispatched by contracts,
+// and passes all other messages to the wrapped messenger
+type CustomMessenger struct {
+	wrapped wasmkeeper.Messenger
+	router  wasmkeeper.MessageRouter
+}
+
+var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
+
+// Returns a decorator for the default wasm messenger that's registered with the wasm keeper
+func CustomMessageDecorator(router wasmkeeper.MessageRouter) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &CustomMessenger{
+			wrapped: old,
+			router:  router,
+		}
+	}
+}
+
+// Dispatches a custom stride message using the contract as the sender
+// Non-custom messages are passed to the wrapped messenger
+func (m *CustomMessenger) DispatchMsg(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	contractIBCPortID string,
+	msg wasmvmtypes.CosmosMsg,
+) ([]sdk.Event, [][]byte, error) {
+	if msg.Custom == nil {
+		return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	}
+
+	sdkMsg, err := EncodeStrideMsg(contractAddr, msg.Custom)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, nil, err
+	}
+
+	handler := m.router.Handler(sdkMsg)
+	if handler == nil {
+		return nil, nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "no handler found for %s", sdk.MsgTypeURL(sdkMsg))
+	}
+	res, err := handler(ctx, sdkMsg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	events := make([]sdk.Event, len(res.Events))
+	for i := range res.Events {
+		events[i] = sdk.Event(res.Events[i])
+	}
+	return events, [][]byte{res.Data}, nil
+}
+
+// Converts a custom stride message into the equivalent sdk message, with the contract as the sender
+func EncodeStrideMsg(contractAddr sdk.AccAddress, customMsg json.RawMessage) (sdk.Msg, error) {
+	var strideMsg bindings.StrideMsg
+	if err := json.Unmarshal(customMsg, &strideMsg); err != nil {
+		return nil, errorsmod.Wrap(err, "unable to parse stride msg")
+	}
+
+	sender := contractAddr.String()
+	switch {
+	case strideMsg.LiquidStake != nil:
+		liquidStake := strideMsg.LiquidStake
+		if liquidStake.Amount.IsNil() {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "liquid stake amount must be specified")
+		}
+		return stakeibctypes.NewMsgLiquidStake(sender, liquidStake.Amount, liquidStake.HostDenom), nil
+	case strideMsg.RedeemStake != nil:
+		redeemStake := strideMsg.RedeemStake
+		if redeemStake.Amount.IsNil() {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "redeem stake amount must be specified")
+		}
+		return stakeibctypes.NewMsgRedeemStake(sender, redeemStake.Amount, redeemStake.HostZone, redeemStake.Receiver), nil
+	case strideMsg.ClaimAirdrop != nil:
+		return airdroptypes.NewMsgClaimDaily(sender, strideMsg.ClaimAirdrop.AirdropId), nil
+	default:
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown stride msg variant"}
+	}
+}
@@ -0,0 +1,148 @@
+package wasmbinding_test
+
+import (
+	"encoding/json"
+
+	sdkmath "cosmossdk.io/math"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v27/wasmbinding"
+	"github.com/Stride-Labs/stride/v27/wasmbinding/bindings"
+	epochtypes "github.com/Stride-Labs/stride/v27/x/epochs/types"
+	recordstypes "github.com/Stride-Labs/stride/v27/x/records/types"
+	stakeibctypes "github.com/Stride-Labs/stride/v27/x/stakeibc/types"
+)
+
+// Mock messenger that records the messages that were passed through
+type MockMessenger struct {
+	dispatchedMsgs []wasmvmtypes.CosmosMsg
+}
+
+func (m *MockMessenger) DispatchMsg(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	contractIBCPortID string,
+	msg wasmvmtypes.CosmosMsg,
+) ([]sdk.Event, [][]byte, error) {
+	m.dispatchedMsgs = append(m.dispatchedMsgs, msg)
+	return nil, nil, nil
+}
+
+// Builds the custom messenger, wrapping the mock messenger
+func (s *WasmBindingTestSuite) CustomMessenger() (*MockMessenger, *wasmbinding.CustomMessenger) {
+	mockMessenger := &MockMessenger{}
+	decorator := wasmbinding.CustomMessageDecorator(s.App.MsgServiceRouter())
+	return mockMessenger, decorator(mockMessenger).(*wasmbinding.CustomMessenger)
+}
+
+// Serializes a stride message as a custom cosmos msg
+func (s *WasmBindingTestSuite) CustomMsg(strideMsg bindings.StrideMsg) wasmvmtypes.CosmosMsg {
+	msgBz, err := json.Marshal(strideMsg)
+	s.Require().NoError(err, "no error expected when marshalling msg")
+	return wasmvmtypes.CosmosMsg{Custom: msgBz}
+}
+
+func (s *WasmBindingTestSuite) TestEncodeStrideMsg() {
+	contract := s.TestAccs[0]
+	amount := sdkmath.NewInt(1000)
+
+	// Liquid stake
+	msg, err := wasmbinding.EncodeStrideMsg(contract, s.CustomMsg(bindings.StrideMsg{
+		LiquidStake: &bindings.LiquidStake{HostDenom: Atom, Amount: amount},
+	}).Custom)
+	s.Require().NoError(err, "no error expected when encoding liquid stake")
+	s.Require().Equal(stakeibctypes.NewMsgLiquidStake(contract.String(), amount, Atom), msg, "liquid stake msg")
+
+	// Redeem stake
+	msg, err = wasmbinding.EncodeStrideMsg(contract, s.CustomMsg(bindings.StrideMsg{
+		RedeemStake: &bindings.RedeemStake{HostZone: HostChainId, Amount: amount, Receiver: "receiver"},
+	}).Custom)
+	s.Require().NoError(err, "no error expected when encoding redeem stake")
+	s.Require().Equal(stakeibctypes.NewMsgRedeemStake(contract.String(), amount, HostChainId, "receiver"), msg, "redeem stake msg")
+
+	// Claim airdrop
+	msg, err = wasmbinding.EncodeStrideMsg(contract, s.CustomMsg(bindings.StrideMsg{
+		ClaimAirdrop: &bindings.ClaimAirdrop{AirdropId: "airdrop"},
+	}).Custom)
+	s.Require().NoError(err, "no error expected when encoding claim airdrop")
+	s.Require().Equal(contract.String(), msg.GetSigners()[0].String(), "claim airdrop signer")
+
+	// Missing amount
+	_, err = wasmbinding.EncodeStrideMsg(contract, []byte(`{"liquid_stake":{"host_denom":"uatom"}}`))
+	s.Require().ErrorContains(err, "liquid stake amount must be specified")
+
+	_, err = wasmbinding.EncodeStrideMsg(contract, []byte(`{"redeem_stake":{"host_zone":"GAIA"}}`))
+	s.Require().ErrorContains(err, "redeem stake amount must be specified")
+
+	// Unknown variant
+	_, err = wasmbinding.EncodeStrideMsg(contract, []byte(`{}`))
+	s.Require().ErrorContains(err, "unknown stride msg variant")
+
+	// Invalid json
+	_, err = wasmbinding.EncodeStrideMsg(contract, []byte(`invalid`))
+	s.Require().ErrorContains(err, "unable to parse stride msg")
+}
+
+func (s *WasmBindingTestSuite) TestDispatchMsg_LiquidStake() {
+	contract := s.TestAccs[0]
+	stakeAmount := sdkmath.NewInt(1000)
+
+	// Setup a host zone so that the liquid stake succeeds
+	depositAddress := stakeibctypes.NewHostZoneDepositAddress(HostChainId)
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{
+		ChainId:        HostChainId,
+		HostDenom:      Atom,
+		IbcDenom:       IbcAtom,
+		RedemptionRate: sdk.OneDec(),
+		DepositAddress: depositAddress.String(),
+	})
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, stakeibctypes.EpochTracker{
+		EpochIdentifier: epochtypes.STRIDE_EPOCH,
+		EpochNumber:     1,
+	})
+	s.App.RecordsKeeper.SetDepositRecord(s.Ctx, recordstypes.DepositRecord{
+		Id:                 1,
+		DepositEpochNumber: 1,
+		HostZoneId:         HostChainId,
+		Amount:             sdkmath.ZeroInt(),
+		Status:             recordstypes.DepositRecord_TRANSFER_QUEUE,
+	})
+	s.FundAccount(contract, sdk.NewCoin(IbcAtom, stakeAmount))
+
+	// Dispatch the liquid stake from the contract
+	mockMessenger, messenger := s.CustomMessenger()
+	msg := s.CustomMsg(bindings.StrideMsg{
+		LiquidStake: &bindings.LiquidStake{HostDenom: Atom, Amount: stakeAmount},
+	})
+	events, data, err := messenger.DispatchMsg(s.Ctx, contract, "", msg)
+	s.Require().NoError(err, "no error expected when dispatching liquid stake")
+	s.Require().NotEmpty(events, "events should be emitted")
+	s.Require().Len(data, 1, "msg response data")
+	s.Require().Empty(mockMessenger.dispatchedMsgs, "custom msg should not be passed to wrapped messenger")
+
+	// Confirm the contract received stTokens
+	s.Require().Equal(stakeAmount, s.App.BankKeeper.GetBalance(s.Ctx, contract, StAtom).Amount, "contract stToken balance")
+	s.Require().Zero(s.App.BankKeeper.GetBalance(s.Ctx, contract, IbcAtom).Amount.Int64(), "contract native balance")
+
+	// A failed liquid stake should return the error
+	_, _, err = messenger.DispatchMsg(s.Ctx, contract, "", msg)
+	s.Require().ErrorContains(err, "insufficient funds")
+
+	// An invalid msg should fail validation
+	invalidMsg := s.CustomMsg(bindings.StrideMsg{
+		LiquidStake: &bindings.LiquidStake{HostDenom: Atom, Amount: sdkmath.ZeroInt()},
+	})
+	_, _, err = messenger.DispatchMsg(s.Ctx, contract, "", invalidMsg)
+	s.Require().ErrorContains(err, "amount liquid staked must be positive and nonzero")
+}
+
+func (s *WasmBindingTestSuite) TestDispatchMsg_NonCustomMsg() {
+	mockMessenger, messenger := s.CustomMessenger()
+
+	// Non custom messages should be passed through to the wrapped messenger
+	msg := wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Burn: &wasmvmtypes.BurnMsg{}}}
+	_, _, err := messenger.DispatchMsg(s.Ctx, s.TestAccs[0], "", msg)
+	s.Require().NoError(err, "no error expected when dispatching non-custom msg")
+	s.Require().Equal([]wasmvmtypes.CosmosMsg{msg}, mockMessenger.dispatchedMsgs, "dispatched msgs")
+}
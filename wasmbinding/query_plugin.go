@@ -0,0 +1,145 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/v27/wasmbinding/bindings"
+	icqoraclekeeper "github.com/Stride-Labs/stride/v27/x/icqoracle/keeper"
+	recordskeeper "github.com/Stride-Labs/stride/v27/x/records/keeper"
+	stakeibckeeper "github.com/Stride-Labs/stride/v27/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/v27/x/stakeibc/types"
+)
+
+// Max number of user redemption records returned in a single query
+const MaxUserRedemptionRecordsPageSize = 50
+
+// QueryPlugin handles the custom stride queries dispatched by contracts
+type QueryPlugin struct {
+	stakeibcKeeper  *stakeibckeeper.Keeper
+	recordsKeeper   *recordskeeper.Keeper
+	icqOracleKeeper *icqoraclekeeper.Keeper
+}
+
+func NewQueryPlugin(
+	stakeibcKeeper *stakeibckeeper.Keeper,
+	recordsKeeper *recordskeeper.Keeper,
+	icqOracleKeeper *icqoraclekeeper.Keeper,
+) *QueryPlugin {
+	return &QueryPlugin{
+		stakeibcKeeper:  stakeibcKeeper,
+		recordsKeeper:   recordsKeeper,
+		icqOracleKeeper: icqOracleKeeper,
+	}
+}
+
+// Returns the custom querier that's registered with the wasm keeper
+// The request is deserialized into a StrideQuery, and the response is JSON encoded
+func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query bindings.StrideQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, errorsmod.Wrap(err, "unable to parse stride query")
+		}
+
+		var response any
+		var err error
+		switch {
+		case query.HostZone != nil:
+			response, err = qp.GetHostZone(ctx, query.HostZone.ChainId)
+		case query.RedemptionRate != nil:
+			response, err = qp.GetRedemptionRate(ctx, query.RedemptionRate.ChainId)
+		case query.TokenPrice != nil:
+			response, err = qp.GetTokenPrice(ctx, query.TokenPrice.BaseDenom, query.TokenPrice.QuoteDenom)
+		case query.UserRedemptionRecords != nil:
+			response, err = qp.GetUserRedemptionRecords(ctx, *query.UserRedemptionRecords)
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown stride query variant"}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := json.Marshal(response)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "unable to marshal stride query response")
+		}
+		return bz, nil
+	}
+}
+
+// Returns the host zone for the given chain ID
+func (qp QueryPlugin) GetHostZone(ctx sdk.Context, chainId string) (*bindings.HostZoneResponse, error) {
+	hostZone, found := qp.stakeibcKeeper.GetHostZone(ctx, chainId)
+	if !found {
+		return nil, errorsmod.Wrapf(stakeibctypes.ErrHostZoneNotFound, "host zone %s not found", chainId)
+	}
+
+	return &bindings.HostZoneResponse{
+		ChainId:          hostZone.ChainId,
+		HostDenom:        hostZone.HostDenom,
+		IbcDenom:         hostZone.IbcDenom,
+		StDenom:          stakeibctypes.StAssetDenomFromHostZoneDenom(hostZone.HostDenom),
+		RedemptionRate:   hostZone.RedemptionRate,
+		TotalDelegations: hostZone.TotalDelegations,
+		UnbondingPeriod:  hostZone.UnbondingPeriod,
+		Halted:           hostZone.Halted,
+	}, nil
+}
+
+// Returns the redemption rate of the stToken for the given chain ID
+func (qp QueryPlugin) GetRedemptionRate(ctx sdk.Context, chainId string) (*bindings.RedemptionRateResponse, error) {
+	hostZone, found := qp.stakeibcKeeper.GetHostZone(ctx, chainId)
+	if !found {
+		return nil, errorsmod.Wrapf(stakeibctypes.ErrHostZoneNotFound, "host zone %s not found", chainId)
+	}
+	return &bindings.RedemptionRateResponse{RedemptionRate: hostZone.RedemptionRate}, nil
+}
+
+// Returns the price of the base denom in terms of the quote denom
+func (qp QueryPlugin) GetTokenPrice(ctx sdk.Context, baseDenom, quoteDenom string) (*bindings.TokenPriceResponse, error) {
+	price, err := qp.icqOracleKeeper.GetTokenPriceForQuoteDenom(ctx, baseDenom, quoteDenom)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "unable to get price of %s in terms of %s", baseDenom, quoteDenom)
+	}
+	return &bindings.TokenPriceResponse{Price: price}, nil
+}
+
+// Returns a page of redemption records for a receiver (on the host zone), optionally filtered by host zone
+func (qp QueryPlugin) GetUserRedemptionRecords(ctx sdk.Context, query bindings.UserRedemptionRecords) (*bindings.UserRedemptionRecordsResponse, error) {
+	if query.Receiver == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "receiver must be specified")
+	}
+
+	limit := query.Limit
+	if limit == 0 || limit > MaxUserRedemptionRecordsPageSize {
+		limit = MaxUserRedemptionRecordsPageSize
+	}
+
+	userRedemptionRecords, nextKey := qp.recordsKeeper.GetUserRedemptionRecordsForReceiver(
+		ctx,
+		query.Receiver,
+		query.ChainId,
+		query.StartAfter,
+		limit,
+	)
+
+	records := []bindings.UserRedemptionRecord{}
+	for _, record := range userRedemptionRecords {
+		records = append(records, bindings.UserRedemptionRecord{
+			Id:                record.Id,
+			HostZoneId:        record.HostZoneId,
+			EpochNumber:       record.EpochNumber,
+			Denom:             record.Denom,
+			NativeTokenAmount: record.NativeTokenAmount,
+			StTokenAmount:     record.StTokenAmount,
+			ClaimIsPending:    record.ClaimIsPending,
+		})
+	}
+
+	return &bindings.UserRedemptionRecordsResponse{Records: records, NextKey: nextKey}, nil
+}
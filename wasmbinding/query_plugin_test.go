@@ -0,0 +1,145 @@
+package wasmbinding_test
+
+import (
+	"encoding/json"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v27/wasmbinding"
+	"github.com/Stride-Labs/stride/v27/wasmbinding/bindings"
+	recordstypes "github.com/Stride-Labs/stride/v27/x/records/types"
+	stakeibctypes "github.com/Stride-Labs/stride/v27/x/stakeibc/types"
+)
+
+// Dispatches a custom query and unmarshals the response
+func (s *WasmBindingTestSuite) CustomQuery(query bindings.StrideQuery, response any) error {
+	queryPlugin := wasmbinding.NewQueryPlugin(&s.App.StakeibcKeeper, &s.App.RecordsKeeper, &s.App.ICQOracleKeeper)
+	querier := wasmbinding.CustomQuerier(queryPlugin)
+
+	queryBz, err := json.Marshal(query)
+	s.Require().NoError(err, "no error expected when marshalling query")
+
+	responseBz, err := querier(s.Ctx, queryBz)
+	if err != nil {
+		return err
+	}
+	s.Require().NoError(json.Unmarshal(responseBz, response), "no error expected when unmarshalling response")
+	return nil
+}
+
+func (s *WasmBindingTestSuite) TestQueryHostZone() {
+	hostZone := stakeibctypes.HostZone{
+		ChainId:          HostChainId,
+		HostDenom:        Atom,
+		IbcDenom:         IbcAtom,
+		RedemptionRate:   sdk.MustNewDecFromStr("1.25"),
+		TotalDelegations: sdkmath.NewInt(1000),
+		UnbondingPeriod:  21,
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	// Query the host zone
+	var hostZoneResponse bindings.HostZoneResponse
+	err := s.CustomQuery(bindings.StrideQuery{HostZone: &bindings.HostZone{ChainId: HostChainId}}, &hostZoneResponse)
+	s.Require().NoError(err, "no error expected when querying host zone")
+
+	expectedResponse := bindings.HostZoneResponse{
+		ChainId:          HostChainId,
+		HostDenom:        Atom,
+		IbcDenom:         IbcAtom,
+		StDenom:          StAtom,
+		RedemptionRate:   hostZone.RedemptionRate,
+		TotalDelegations: hostZone.TotalDelegations,
+		UnbondingPeriod:  21,
+		Halted:           false,
+	}
+	s.Require().Equal(expectedResponse, hostZoneResponse, "host zone response")
+
+	// Query the redemption rate
+	var redemptionRateResponse bindings.RedemptionRateResponse
+	err = s.CustomQuery(bindings.StrideQuery{RedemptionRate: &bindings.RedemptionRate{ChainId: HostChainId}}, &redemptionRateResponse)
+	s.Require().NoError(err, "no error expected when querying redemption rate")
+	s.Require().Equal(hostZone.RedemptionRate, redemptionRateResponse.RedemptionRate, "redemption rate")
+
+	// Query a host zone that does not exist
+	err = s.CustomQuery(bindings.StrideQuery{HostZone: &bindings.HostZone{ChainId: "fake"}}, &hostZoneResponse)
+	s.Require().ErrorContains(err, "host zone not found")
+
+	err = s.CustomQuery(bindings.StrideQuery{RedemptionRate: &bindings.RedemptionRate{ChainId: "fake"}}, &redemptionRateResponse)
+	s.Require().ErrorContains(err, "host zone not found")
+}
+
+func (s *WasmBindingTestSuite) TestQueryTokenPrice() {
+	// No prices have been stored
+	var response bindings.TokenPriceResponse
+	err := s.CustomQuery(bindings.StrideQuery{TokenPrice: &bindings.TokenPrice{BaseDenom: "base", QuoteDenom: "quote"}}, &response)
+	s.Require().ErrorContains(err, "unable to get price of base in terms of quote")
+}
+
+func (s *WasmBindingTestSuite) TestQueryUserRedemptionRecords() {
+	receiver := "cosmosXXX"
+	records := []recordstypes.UserRedemptionRecord{
+		{Id: "A.1.receiver", Receiver: receiver, HostZoneId: "A", EpochNumber: 1},
+		{Id: "B.1.receiver", Receiver: receiver, HostZoneId: "B", EpochNumber: 1, ClaimIsPending: true},
+		{Id: "A.2.other", Receiver: "other", HostZoneId: "A", EpochNumber: 2},
+	}
+	for _, record := range records {
+		record.NativeTokenAmount = sdkmath.NewInt(10)
+		record.StTokenAmount = sdkmath.NewInt(5)
+		s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, record)
+	}
+
+	// Query all records for the receiver
+	var response bindings.UserRedemptionRecordsResponse
+	query := bindings.StrideQuery{UserRedemptionRecords: &bindings.UserRedemptionRecords{Receiver: receiver}}
+	err := s.CustomQuery(query, &response)
+	s.Require().NoError(err, "no error expected when querying records")
+
+	s.Require().Len(response.Records, 2, "number of records")
+	s.Require().Equal("A.1.receiver", response.Records[0].Id, "first record")
+	s.Require().Equal("B.1.receiver", response.Records[1].Id, "second record")
+	s.Require().True(response.Records[1].ClaimIsPending, "second record claim pending")
+	s.Require().Equal(int64(10), response.Records[0].NativeTokenAmount.Int64(), "native amount")
+	s.Require().Equal(int64(5), response.Records[0].StTokenAmount.Int64(), "st amount")
+
+	// Filter by host zone
+	query.UserRedemptionRecords.ChainId = "B"
+	err = s.CustomQuery(query, &response)
+	s.Require().NoError(err, "no error expected when querying records by host")
+	s.Require().Len(response.Records, 1, "number of records for host")
+	s.Require().Equal("B.1.receiver", response.Records[0].Id, "record for host")
+
+	// Paginate through the receiver's records
+	query.UserRedemptionRecords.ChainId = ""
+	query.UserRedemptionRecords.Limit = 1
+	response = bindings.UserRedemptionRecordsResponse{}
+	err = s.CustomQuery(query, &response)
+	s.Require().NoError(err, "no error expected when querying first page")
+	s.Require().Len(response.Records, 1, "number of records in first page")
+	s.Require().Equal("A.1.receiver", response.Records[0].Id, "record in first page")
+	s.Require().Equal("A.1.receiver", response.NextKey, "next key after first page")
+
+	query.UserRedemptionRecords.StartAfter = response.NextKey
+	response = bindings.UserRedemptionRecordsResponse{}
+	err = s.CustomQuery(query, &response)
+	s.Require().NoError(err, "no error expected when querying second page")
+	s.Require().Len(response.Records, 1, "number of records in second page")
+	s.Require().Equal("B.1.receiver", response.Records[0].Id, "record in second page")
+	s.Require().Empty(response.NextKey, "no next key after last page")
+
+	// Missing receiver
+	query.UserRedemptionRecords.Receiver = ""
+	err = s.CustomQuery(query, &response)
+	s.Require().ErrorContains(err, "receiver must be specified")
+}
+
+func (s *WasmBindingTestSuite) TestQueryUnknownVariant() {
+	querier := wasmbinding.CustomQuerier(wasmbinding.NewQueryPlugin(&s.App.StakeibcKeeper, &s.App.RecordsKeeper, &s.App.ICQOracleKeeper))
+
+	_, err := querier(s.Ctx, []byte(`{}`))
+	s.Require().ErrorContains(err, "unknown stride query variant")
+
+	_, err = querier(s.Ctx, []byte(`invalid`))
+	s.Require().ErrorContains(err, "unable to parse stride query")
+}
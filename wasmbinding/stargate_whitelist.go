@@ -0,0 +1,59 @@
+package wasmbinding
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	airdroptypes "github.com/Stride-Labs/stride/v27/x/airdrop/types"
+	icqoracletypes "github.com/Stride-Labs/stride/v27/x/icqoracle/types"
+	recordstypes "github.com/Stride-Labs/stride/v27/x/records/types"
+	stakeibctypes "github.com/Stride-Labs/stride/v27/x/stakeibc/types"
+)
+
+// Stargate queries that contracts are allowed to make, mapped to their response type
+// Only deterministic queries with bounded gas cost should be added here, since the query
+// is executed during contract execution
+//
+// Note: the response types are used to re-encode the response as proto, so this must be
+// updated if the response type of a query changes
+func AcceptedStargateQueries() wasmkeeper.AcceptedStargateQueries {
+	return wasmkeeper.AcceptedStargateQueries{
+		// auth
+		"/cosmos.auth.v1beta1.Query/Account": &authtypes.QueryAccountResponse{},
+		"/cosmos.auth.v1beta1.Query/Params":  &authtypes.QueryParamsResponse{},
+
+		// bank
+		"/cosmos.bank.v1beta1.Query/Balance":       &banktypes.QueryBalanceResponse{},
+		"/cosmos.bank.v1beta1.Query/DenomMetadata": &banktypes.QueryDenomMetadataResponse{},
+		"/cosmos.bank.v1beta1.Query/Params":        &banktypes.QueryParamsResponse{},
+		"/cosmos.bank.v1beta1.Query/SupplyOf":      &banktypes.QuerySupplyOfResponse{},
+
+		// distribution
+		"/cosmos.distribution.v1beta1.Query/DelegationRewards": &distrtypes.QueryDelegationRewardsResponse{},
+
+		// staking
+		"/cosmos.staking.v1beta1.Query/Delegation": &stakingtypes.QueryDelegationResponse{},
+		"/cosmos.staking.v1beta1.Query/Params":     &stakingtypes.QueryParamsResponse{},
+		"/cosmos.staking.v1beta1.Query/Validator":  &stakingtypes.QueryValidatorResponse{},
+
+		// stakeibc
+		"/stride.stakeibc.Query/HostZone":          &stakeibctypes.QueryGetHostZoneResponse{},
+		"/stride.stakeibc.Query/Params":            &stakeibctypes.QueryParamsResponse{},
+		"/stride.stakeibc.Query/AddressUnbondings": &stakeibctypes.QueryAddressUnbondingsResponse{},
+
+		// records
+		"/stride.records.Query/UserRedemptionRecord": &recordstypes.QueryGetUserRedemptionRecordResponse{},
+
+		// icqoracle
+		"/stride.icqoracle.Query/TokenPrice":              &icqoracletypes.TokenPriceResponse{},
+		"/stride.icqoracle.Query/TokenPriceForQuoteDenom": &icqoracletypes.QueryTokenPriceForQuoteDenomResponse{},
+
+		// airdrop
+		"/stride.airdrop.Query/Airdrop":        &airdroptypes.QueryAirdropResponse{},
+		"/stride.airdrop.Query/UserAllocation": &airdroptypes.QueryUserAllocationResponse{},
+		"/stride.airdrop.Query/UserSummary":    &airdroptypes.QueryUserSummaryResponse{},
+	}
+}
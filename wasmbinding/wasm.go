@@ -0,0 +1,36 @@
+package wasmbinding
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	icqoraclekeeper "github.com/Stride-Labs/stride/v27/x/icqoracle/keeper"
+	recordskeeper "github.com/Stride-Labs/stride/v27/x/records/keeper"
+	stakeibckeeper "github.com/Stride-Labs/stride/v27/x/stakeibc/keeper"
+)
+
+// Returns the wasm keeper options that register the stride custom queries and messages,
+// as well as the stargate query allowlist
+// The keepers are passed by reference since the wasm keeper is built before they are initialized
+func RegisterCustomPlugins(
+	cdc codec.Codec,
+	queryRouter *baseapp.GRPCQueryRouter,
+	msgRouter wasmkeeper.MessageRouter,
+	stakeibcKeeper *stakeibckeeper.Keeper,
+	recordsKeeper *recordskeeper.Keeper,
+	icqOracleKeeper *icqoraclekeeper.Keeper,
+) []wasmkeeper.Option {
+	queryPlugin := NewQueryPlugin(stakeibcKeeper, recordsKeeper, icqOracleKeeper)
+
+	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
+		Custom:   CustomQuerier(queryPlugin),
+		Stargate: wasmkeeper.AcceptListStargateQuerier(AcceptedStargateQueries(), queryRouter, cdc),
+	})
+	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(CustomMessageDecorator(msgRouter))
+
+	return []wasmkeeper.Option{
+		queryPluginOpt,
+		messengerDecoratorOpt,
+	}
+}
@@ -0,0 +1,28 @@
+package wasmbinding_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Stride-Labs/stride/v27/app/apptesting"
+)
+
+const (
+	HostChainId = "GAIA"
+	Atom        = "uatom"
+	StAtom      = "stuatom"
+	IbcAtom     = "ibc/uatom"
+)
+
+type WasmBindingTestSuite struct {
+	apptesting.AppTestHelper
+}
+
+func (s *WasmBindingTestSuite) SetupTest() {
+	s.Setup()
+}
+
+func TestWasmBindingTestSuite(t *testing.T) {
+	suite.Run(t, new(WasmBindingTestSuite))
+}
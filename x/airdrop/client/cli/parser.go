@@ -2,6 +2,7 @@ package cli
 
 import (
 	"encoding/csv"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -56,3 +57,92 @@ func ParseUserAllocations(fileName string) ([]types.RawAllocation, error) {
 
 	return allAllocations, nil
 }
+
+// Merkle tree file generated from an allocations CSV, containing the root
+// to be registered on the airdrop and the proof for each address
+//
+// Example Schema:
+//
+//	{
+//	  "merkle_root": "1a2b...",
+//	  "proofs": {
+//	    "strideXXX": {"allocations": ["10", "10", "20"], "proof": ["3c4d...", "5e6f..."]}
+//	  }
+//	}
+type MerkleTreeFile struct {
+	MerkleRoot     string                           `json:"merkle_root"`
+	TotalAllocated sdkmath.Int                      `json:"total_allocated"`
+	Proofs         map[string]types.AllocationProof `json:"proofs"`
+}
+
+// Builds the merkle tree from an allocations CSV file and writes the root, total allocated,
+// and proofs to the output JSON file
+func WriteAllocationsMerkleTree(
+	allocationsFileName string,
+	outputFileName string,
+) (merkleRoot string, totalAllocated sdkmath.Int, err error) {
+	allocations, err := ParseUserAllocations(allocationsFileName)
+	if err != nil {
+		return "", totalAllocated, err
+	}
+
+	merkleRoot, proofs, err := types.BuildAllocationMerkleTree(allocations)
+	if err != nil {
+		return "", totalAllocated, err
+	}
+
+	totalAllocated = sdkmath.ZeroInt()
+	for _, allocation := range allocations {
+		for _, amount := range allocation.Allocations {
+			totalAllocated = totalAllocated.Add(amount)
+		}
+	}
+
+	tree := MerkleTreeFile{MerkleRoot: merkleRoot, TotalAllocated: totalAllocated, Proofs: proofs}
+	treeBz, err := json.MarshalIndent(tree, "", "  ")
+	if err != nil {
+		return "", totalAllocated, err
+	}
+	if err := os.WriteFile(outputFileName, treeBz, 0o600); err != nil {
+		return "", totalAllocated, err
+	}
+
+	return merkleRoot, totalAllocated, nil
+}
+
+// Parses a merkle tree JSON file (generated from WriteAllocationsMerkleTree)
+// and returns the proof for the given address
+func ParseAllocationProof(fileName, address string) (*types.AllocationProof, error) {
+	treeBz, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	var tree MerkleTreeFile
+	if err := json.Unmarshal(treeBz, &tree); err != nil {
+		return nil, err
+	}
+
+	proof, ok := tree.Proofs[address]
+	if !ok {
+		return nil, fmt.Errorf("no proof found for address %s", address)
+	}
+
+	return &proof, nil
+}
+
+// Parses a merkle tree JSON file (generated from WriteAllocationsMerkleTree) and returns
+// the proofs for the host and stride address when linking. Either proof may be nil if
+// the address is not in the merkle tree, but at least one must be found
+func ParseLinkAllocationProofs(
+	fileName string,
+	strideAddress string,
+	hostAddress string,
+) (hostProof, strideProof *types.AllocationProof, err error) {
+	hostProof, hostErr := ParseAllocationProof(fileName, hostAddress)
+	strideProof, strideErr := ParseAllocationProof(fileName, strideAddress)
+	if hostErr != nil && strideErr != nil {
+		return nil, nil, errors.Join(hostErr, strideErr)
+	}
+	return hostProof, strideProof, nil
+}
@@ -2,6 +2,7 @@ package cli_test
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
@@ -49,3 +50,68 @@ strideZZZ,5,100,6`
 	// Validate the allocations match expectations
 	require.Equal(t, expectedAllocations, actualAllocations)
 }
+
+func TestWriteAndParseAllocationsMerkleTree(t *testing.T) {
+	inputCSVContents := `strideXXX,10,10,20
+strideYYY,0,10,0
+strideZZZ,5,100,6`
+
+	tmpDir := t.TempDir()
+	allocationsFileName := filepath.Join(tmpDir, "allocations.csv")
+	treeFileName := filepath.Join(tmpDir, "merkle-tree.json")
+
+	err := os.WriteFile(allocationsFileName, []byte(inputCSVContents), 0o600)
+	require.NoError(t, err)
+
+	// Build the tree and write it to the output file
+	merkleRoot, totalAllocated, err := cli.WriteAllocationsMerkleTree(allocationsFileName, treeFileName)
+	require.NoError(t, err)
+	require.Equal(t, int64(161), totalAllocated.Int64(), "total allocated")
+
+	// Parse each user's proof from the file, and confirm it's valid against the root
+	allocations, err := cli.ParseUserAllocations(allocationsFileName)
+	require.NoError(t, err)
+	for _, allocation := range allocations {
+		proof, err := cli.ParseAllocationProof(treeFileName, allocation.UserAddress)
+		require.NoError(t, err)
+		require.Equal(t, allocation.Allocations, proof.Allocations, "allocations for %s", allocation.UserAddress)
+
+		err = types.VerifyAllocationProof(merkleRoot, allocation.UserAddress, proof.Allocations, proof.Proof)
+		require.NoError(t, err, "proof should be valid for %s", allocation.UserAddress)
+	}
+
+	// Parse an address that's not in the tree
+	_, err = cli.ParseAllocationProof(treeFileName, "strideAAA")
+	require.ErrorContains(t, err, "no proof found for address strideAAA")
+}
+
+func TestParseLinkAllocationProofs(t *testing.T) {
+	inputCSVContents := `strideXXX,10,10,20
+dymXXX,0,10,0`
+
+	tmpDir := t.TempDir()
+	allocationsFileName := filepath.Join(tmpDir, "allocations.csv")
+	treeFileName := filepath.Join(tmpDir, "merkle-tree.json")
+
+	err := os.WriteFile(allocationsFileName, []byte(inputCSVContents), 0o600)
+	require.NoError(t, err)
+
+	_, _, err = cli.WriteAllocationsMerkleTree(allocationsFileName, treeFileName)
+	require.NoError(t, err)
+
+	// Both addresses in the tree
+	hostProof, strideProof, err := cli.ParseLinkAllocationProofs(treeFileName, "strideXXX", "dymXXX")
+	require.NoError(t, err)
+	require.NotNil(t, hostProof, "host proof")
+	require.NotNil(t, strideProof, "stride proof")
+
+	// Only the host address in the tree
+	hostProof, strideProof, err = cli.ParseLinkAllocationProofs(treeFileName, "strideYYY", "dymXXX")
+	require.NoError(t, err)
+	require.NotNil(t, hostProof, "host proof")
+	require.Nil(t, strideProof, "stride proof")
+
+	// Neither address in the tree
+	_, _, err = cli.ParseLinkAllocationProofs(treeFileName, "strideYYY", "dymYYY")
+	require.ErrorContains(t, err, "no proof found for address")
+}
@@ -29,6 +29,7 @@ func GetQueryCmd() *cobra.Command {
 		CmdQueryUserAllocations(),
 		CmdQueryAllAllocations(),
 		CmdQueryUserSummary(),
+		CmdQueryAirdropAccounting(),
 	)
 
 	return cmd
@@ -217,3 +218,36 @@ func CmdQueryUserSummary() *cobra.Command {
 
 	return cmd
 }
+
+// Queries the full accounting of an airdrop's rewards
+func CmdQueryAirdropAccounting() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "airdrop-accounting [airdrop-id]",
+		Short: "Queries the accounting of an airdrop's rewards",
+		Long: strings.TrimSpace(
+			`Queries the total rewards allocated, claimed, forfeited, redistributed, and clawed back for an airdrop`,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			airdropId := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAirdropAccountingRequest{
+				AirdropId: airdropId,
+			}
+			res, err := queryClient.AirdropAccounting(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	return cmd
+}
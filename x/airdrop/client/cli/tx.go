@@ -7,6 +7,7 @@ import (
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -26,6 +27,11 @@ const (
 	FlagDistributorAddress    = "distributor-address"
 	FlagAllocatorAddress      = "allocator-address"
 	FlagLinkerAddress         = "linker-address"
+	FlagClaimAndStakeBonus    = "claim-and-stake-bonus"
+	FlagClawbackDestination   = "clawback-destination"
+	FlagClawbackAirdropId     = "clawback-airdrop-id"
+	FlagRedistributeForfeited = "redistribute-forfeited"
+	FlagProofFile             = "proof-file"
 
 	FlagRewardDenom    = "reward-denom"
 	DefaultRewardDenom = "ustrd"
@@ -46,11 +52,14 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		CmdClaimDaily(),
 		CmdClaimEarly(),
+		CmdClaimAndStake(),
 		CmdCreateAirdrop(),
 		CmdUpdateAirdrop(),
 		CmdAddAllocations(),
 		CmdUpdateUserAllocation(),
 		CmdLinkAddresses(),
+		CmdSetMerkleRoot(),
+		CmdBuildMerkleTree(),
 	)
 
 	return cmd
@@ -67,6 +76,10 @@ This option is only available if the user has not already elected to claim early
 
 Example:
   $ %[1]s tx %[2]s claim-daily airdrop-1 --from user
+
+If the airdrop's allocations were registered with a merkle root, the proof
+must be provided on the first claim:
+  $ %[1]s tx %[2]s claim-daily airdrop-1 --proof-file merkle-tree.json --from user
 `, version.AppName, types.ModuleName),
 		),
 		Args: cobra.ExactArgs(1),
@@ -83,6 +96,17 @@ Example:
 				airdropId,
 			)
 
+			proofFileName, err := cmd.Flags().GetString(FlagProofFile)
+			if err != nil {
+				return errorsmod.Wrapf(err, "unable to parse proof file")
+			}
+			if proofFileName != "" {
+				msg.AllocationProof, err = ParseAllocationProof(proofFileName, msg.Claimer)
+				if err != nil {
+					return errorsmod.Wrapf(err, "unable to parse allocation proof")
+				}
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -91,6 +115,7 @@ Example:
 		},
 	}
 
+	cmd.Flags().String(FlagProofFile, "", "Merkle tree file containing the claimer's allocation proof")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -107,6 +132,10 @@ claim penalty causing a portion of the total to be clawed back.
 
 Example:
   $ %[1]s tx %[2]s claim-early airdrop-1 --from user
+
+If the airdrop's allocations were registered with a merkle root, the proof
+must be provided on the first claim:
+  $ %[1]s tx %[2]s claim-early airdrop-1 --proof-file merkle-tree.json --from user
 `, version.AppName, types.ModuleName),
 		),
 		Args: cobra.ExactArgs(1),
@@ -123,6 +152,70 @@ Example:
 				airdropId,
 			)
 
+			proofFileName, err := cmd.Flags().GetString(FlagProofFile)
+			if err != nil {
+				return errorsmod.Wrapf(err, "unable to parse proof file")
+			}
+			if proofFileName != "" {
+				msg.AllocationProof, err = ParseAllocationProof(proofFileName, msg.Claimer)
+				if err != nil {
+					return errorsmod.Wrapf(err, "unable to parse allocation proof")
+				}
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagProofFile, "", "Merkle tree file containing the claimer's allocation proof")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// User transaction to claim all the pending airdrop rewards up to the current day, and liquid stake them
+func CmdClaimAndStake() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-and-stake [airdrop-id]",
+		Short: "Claims all the pending airdrop rewards up to the current day and liquid stakes them",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claims all pending airdrop rewards up to the current day, and liquid stakes them in
+the same transaction so that stTokens are received. A bonus may be paid on top of the claimed rewards.
+This is only available if the reward denom can be liquid staked.
+
+Example:
+  $ %[1]s tx %[2]s claim-and-stake airdrop-1 --from user
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			airdropId := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimAndStake(
+				clientCtx.GetFromAddress().String(),
+				airdropId,
+			)
+
+			proofFileName, err := cmd.Flags().GetString(FlagProofFile)
+			if err != nil {
+				return errorsmod.Wrapf(err, "unable to parse proof file")
+			}
+			if proofFileName != "" {
+				msg.AllocationProof, err = ParseAllocationProof(proofFileName, msg.Claimer)
+				if err != nil {
+					return errorsmod.Wrapf(err, "unable to parse allocation proof")
+				}
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -131,6 +224,7 @@ Example:
 		},
 	}
 
+	cmd.Flags().String(FlagProofFile, "", "Merkle tree file containing the claimer's allocation proof")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -154,6 +248,7 @@ Example:
 	--distributor-address     strideXXX \
 	--allocator-address       strideYYY \
 	--linker-address          strideZZZ \
+	--clawback-destination    CLAWBACK_TO_COMMUNITY_POOL \
 	--from admin
 `, version.AppName, types.ModuleName),
 		),
@@ -187,6 +282,10 @@ Example:
 			if err != nil {
 				return errorsmod.Wrapf(err, "unable to parse early claim penalty address")
 			}
+			claimAndStakeBonusString, err := cmd.Flags().GetString(FlagClaimAndStakeBonus)
+			if err != nil {
+				return errorsmod.Wrapf(err, "unable to parse claim and stake bonus")
+			}
 			distributorAddress, err := cmd.Flags().GetString(FlagDistributorAddress)
 			if err != nil {
 				return errorsmod.Wrapf(err, "unable to parse distribution address")
@@ -199,6 +298,18 @@ Example:
 			if err != nil {
 				return errorsmod.Wrapf(err, "unable to parse linker address")
 			}
+			clawbackDestinationString, err := cmd.Flags().GetString(FlagClawbackDestination)
+			if err != nil {
+				return errorsmod.Wrapf(err, "unable to parse clawback destination")
+			}
+			clawbackAirdropId, err := cmd.Flags().GetString(FlagClawbackAirdropId)
+			if err != nil {
+				return errorsmod.Wrapf(err, "unable to parse clawback airdrop id")
+			}
+			redistributeForfeited, err := cmd.Flags().GetBool(FlagRedistributeForfeited)
+			if err != nil {
+				return errorsmod.Wrapf(err, "unable to parse redistribute forfeited")
+			}
 
 			distributionStartDate, err := time.Parse(DateLayout, distributionStartDateString)
 			if err != nil {
@@ -221,6 +332,14 @@ Example:
 			if err != nil {
 				return errorsmod.Wrapf(err, "unable to parse early penalty")
 			}
+			claimAndStakeBonus, err := sdk.NewDecFromStr(claimAndStakeBonusString)
+			if err != nil {
+				return errorsmod.Wrapf(err, "unable to parse claim and stake bonus")
+			}
+			clawbackDestination, ok := types.ClawbackDestination_value[clawbackDestinationString]
+			if !ok {
+				return fmt.Errorf("invalid clawback destination %s", clawbackDestinationString)
+			}
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -236,9 +355,13 @@ Example:
 				&clawbackDate,
 				&deadlineDate,
 				earlyClaimPenalty,
+				claimAndStakeBonus,
 				distributorAddress,
 				allocatorAddress,
 				linkerAddress,
+				types.ClawbackDestination(clawbackDestination),
+				clawbackAirdropId,
+				redistributeForfeited,
 			)
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -255,9 +378,15 @@ Example:
 	cmd.Flags().String(FlagClawbackDate, "", "Date when rewards are clawed back (after distribution end date)")
 	cmd.Flags().String(FlagClaimTypeDeadlineDate, "", "Deadline to decide on the claim type")
 	cmd.Flags().String(FlagEarlyClaimPenalty, "", "Decimal (0 to 1) representing the penalty for claiming early")
+	cmd.Flags().String(FlagClaimAndStakeBonus, "0", "Decimal (0 to 1) representing the bonus for claiming and staking")
 	cmd.Flags().String(FlagDistributorAddress, "", "Address of the distributor account")
 	cmd.Flags().String(FlagAllocatorAddress, "", "Address of the allocator account")
 	cmd.Flags().String(FlagLinkerAddress, "", "Address of the linker account")
+	cmd.Flags().String(FlagClawbackDestination, types.CLAWBACK_TO_COMMUNITY_POOL.String(),
+		"Destination of the remaining rewards at the clawback date (CLAWBACK_TO_COMMUNITY_POOL, CLAWBACK_TO_STRD_BURNER, "+
+			"CLAWBACK_TO_AIRDROP, or CLAWBACK_DESTINATION_UNSPECIFIED to disable the clawback)")
+	cmd.Flags().String(FlagClawbackAirdropId, "", "Airdrop that receives the remaining rewards if clawing back to an airdrop")
+	cmd.Flags().Bool(FlagRedistributeForfeited, false, "Redistribute forfeited rewards to daily claimers at the clawback date")
 
 	requiredFlags := []string{
 		FlagDistributionStartDate,
@@ -298,6 +427,7 @@ Example:
 	--distributor-address     strideXXX \
 	--allocator-address       strideYYY \
 	--linker-address          strideZZZ \
+	--clawback-destination    CLAWBACK_TO_COMMUNITY_POOL \
 	--from admin
 `, version.AppName, types.ModuleName),
 		),
@@ -331,6 +461,10 @@ Example:
 			if err != nil {
 				return errorsmod.Wrapf(err, "unable to parse early claim penalty address")
 			}
+			claimAndStakeBonusString, err := cmd.Flags().GetString(FlagClaimAndStakeBonus)
+			if err != nil {
+				return errorsmod.Wrapf(err, "unable to parse claim and stake bonus")
+			}
 			distributorAddress, err := cmd.Flags().GetString(FlagDistributorAddress)
 			if err != nil {
 				return errorsmod.Wrapf(err, "unable to parse distribution address")
@@ -343,6 +477,18 @@ Example:
 			if err != nil {
 				return errorsmod.Wrapf(err, "unable to parse linker address")
 			}
+			clawbackDestinationString, err := cmd.Flags().GetString(FlagClawbackDestination)
+			if err != nil {
+				return errorsmod.Wrapf(err, "unable to parse clawback destination")
+			}
+			clawbackAirdropId, err := cmd.Flags().GetString(FlagClawbackAirdropId)
+			if err != nil {
+				return errorsmod.Wrapf(err, "unable to parse clawback airdrop id")
+			}
+			redistributeForfeited, err := cmd.Flags().GetBool(FlagRedistributeForfeited)
+			if err != nil {
+				return errorsmod.Wrapf(err, "unable to parse redistribute forfeited")
+			}
 
 			distributionStartDate, err := time.Parse(DateLayout, distributionStartDateString)
 			if err != nil {
@@ -365,6 +511,14 @@ Example:
 			if err != nil {
 				return errorsmod.Wrapf(err, "unable to parse early penalty")
 			}
+			claimAndStakeBonus, err := sdk.NewDecFromStr(claimAndStakeBonusString)
+			if err != nil {
+				return errorsmod.Wrapf(err, "unable to parse claim and stake bonus")
+			}
+			clawbackDestination, ok := types.ClawbackDestination_value[clawbackDestinationString]
+			if !ok {
+				return fmt.Errorf("invalid clawback destination %s", clawbackDestinationString)
+			}
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -380,9 +534,13 @@ Example:
 				&clawbackDate,
 				&deadlineDate,
 				earlyClaimPenalty,
+				claimAndStakeBonus,
 				distributorAddress,
 				allocatorAddress,
 				linkerAddress,
+				types.ClawbackDestination(clawbackDestination),
+				clawbackAirdropId,
+				redistributeForfeited,
 			)
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -400,9 +558,15 @@ Example:
 	cmd.Flags().String(FlagClawbackDate, "", "Date when rewards are clawed back (after distribution end date)")
 	cmd.Flags().String(FlagClaimTypeDeadlineDate, "", "Deadline to decide on the claim type")
 	cmd.Flags().String(FlagEarlyClaimPenalty, "", "Decimal (0 to 1) representing the penalty for claiming early")
+	cmd.Flags().String(FlagClaimAndStakeBonus, "0", "Decimal (0 to 1) representing the bonus for claiming and staking")
 	cmd.Flags().String(FlagDistributorAddress, "", "Address of the distributor account")
 	cmd.Flags().String(FlagAllocatorAddress, "", "Address of the allocator account")
 	cmd.Flags().String(FlagLinkerAddress, "", "Address of the linker account")
+	cmd.Flags().String(FlagClawbackDestination, types.CLAWBACK_TO_COMMUNITY_POOL.String(),
+		"Destination of the remaining rewards at the clawback date (CLAWBACK_TO_COMMUNITY_POOL, CLAWBACK_TO_STRD_BURNER, "+
+			"CLAWBACK_TO_AIRDROP, or CLAWBACK_DESTINATION_UNSPECIFIED to disable the clawback)")
+	cmd.Flags().String(FlagClawbackAirdropId, "", "Airdrop that receives the remaining rewards if clawing back to an airdrop")
+	cmd.Flags().Bool(FlagRedistributeForfeited, false, "Redistribute forfeited rewards to daily claimers at the clawback date")
 
 	requiredFlags := []string{
 		FlagDistributionStartDate,
@@ -539,6 +703,10 @@ func CmdLinkAddresses() *cobra.Command {
 
 Example Command:
   $ %[1]s tx %[2]s link-addresses airdrop-1 strideXXX dymXXX --from admin
+
+If the airdrop's allocations were registered with a merkle root, and either address has not
+yet claimed, the proofs must be provided so that their merkle allocations are included:
+  $ %[1]s tx %[2]s link-addresses airdrop-1 strideXXX dymXXX --proof-file merkle-tree.json --from admin
 `, version.AppName, types.ModuleName),
 		),
 		Args: cobra.ExactArgs(3),
@@ -559,6 +727,69 @@ Example Command:
 				hostAddress,
 			)
 
+			proofFileName, err := cmd.Flags().GetString(FlagProofFile)
+			if err != nil {
+				return errorsmod.Wrapf(err, "unable to parse proof file")
+			}
+			if proofFileName != "" {
+				msg.HostAllocationProof, msg.StrideAllocationProof, err = ParseLinkAllocationProofs(
+					proofFileName, strideAddress, hostAddress)
+				if err != nil {
+					return errorsmod.Wrapf(err, "unable to parse allocation proofs")
+				}
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagProofFile, "", "Merkle tree file containing the allocation proofs of the linked addresses")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Admin transaction to commit to an airdrop's allocations with a merkle root
+func CmdSetMerkleRoot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-merkle-root [airdrop-id] [merkle-root] [merkle-total-allocated]",
+		Short: "Sets the merkle root of the allocations for a given airdrop",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sets the merkle root of the allocations for a given airdrop. Users that were not
+added with add-allocations can then claim by providing their proof from the merkle tree.
+The merkle root and the total allocated (the sum of all allocations in the tree) can be
+generated with the build-merkle-tree command. The total is not verified against the tree, but
+claims from proofs are rejected once the initialized allocations would exceed it.
+
+Example Command:
+  $ %[1]s tx %[2]s set-merkle-root airdrop-1 1a2b... 1000000 --from admin
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			airdropId := args[0]
+			merkleRoot := args[1]
+			merkleTotalAllocated, ok := sdkmath.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("unable to parse merkle total allocated %s", args[2])
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetMerkleRoot(
+				clientCtx.GetFromAddress().String(),
+				airdropId,
+				merkleRoot,
+				merkleTotalAllocated,
+			)
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -571,3 +802,37 @@ Example Command:
 
 	return cmd
 }
+
+// Offline helper to build the allocations merkle tree from an allocations CSV
+func CmdBuildMerkleTree() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "build-merkle-tree [allocations-csv-file] [output-json-file]",
+		Short: "Builds the allocations merkle tree from a CSV file",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Builds the allocations merkle tree from a CSV file (with the same schema as add-allocations),
+and writes the merkle root and each user's proof to the output file. The merkle root should be
+registered with set-merkle-root, and the output file distributed to users to claim with --proof-file.
+This command does not submit a transaction.
+
+Example Command:
+  $ %[1]s tx %[2]s build-merkle-tree allocations.csv merkle-tree.json
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			allocationsFileName := args[0]
+			outputFileName := args[1]
+
+			merkleRoot, totalAllocated, err := WriteAllocationsMerkleTree(allocationsFileName, outputFileName)
+			if err != nil {
+				return errorsmod.Wrapf(err, "unable to build merkle tree")
+			}
+
+			cmd.Printf("Merkle root: %s\n", merkleRoot)
+			cmd.Printf("Total allocated: %s\n", totalAllocated)
+			return nil
+		},
+	}
+
+	return cmd
+}
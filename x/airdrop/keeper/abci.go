@@ -0,0 +1,10 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	// Claw back the remaining rewards from any airdrops that have passed their clawback date
+	k.ClawbackExpiredAirdrops(ctx)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/Stride-Labs/stride/v27/utils"
 	"github.com/Stride-Labs/stride/v27/x/airdrop/types"
 )
 
@@ -37,6 +38,10 @@ func (k Keeper) RemoveAirdrop(ctx sdk.Context, airdropId string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AirdropKeyPrefix)
 	key := types.KeyPrefix(airdropId)
 	store.Delete(key)
+
+	for _, action := range utils.Int32MapKeys(types.Action_name) {
+		k.RemoveActionAirdropIndex(ctx, types.Action(action), airdropId)
+	}
 }
 
 // Retrieves all airdrop configurations from the store
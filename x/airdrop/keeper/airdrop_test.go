@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/Stride-Labs/stride/v27/x/airdrop/types"
@@ -11,8 +12,16 @@ import (
 func (s *KeeperTestSuite) addAirdrops() (airdrops []types.Airdrop) {
 	for i := 0; i <= 4; i++ {
 		airdrop := types.Airdrop{
-			Id:                fmt.Sprintf("airdrop-%d", i),
-			EarlyClaimPenalty: sdk.ZeroDec(),
+			Id:                      fmt.Sprintf("airdrop-%d", i),
+			EarlyClaimPenalty:       sdk.ZeroDec(),
+			ClaimAndStakeBonus:      sdk.ZeroDec(),
+			ForfeitedPool:           sdkmath.ZeroInt(),
+			ClawedBackAmount:        sdkmath.ZeroInt(),
+			RedistributedAmount:     sdkmath.ZeroInt(),
+			MerkleTotalAllocated:    sdkmath.ZeroInt(),
+			MerkleInitializedAmount: sdkmath.ZeroInt(),
+			UnclaimedBudget:         sdkmath.ZeroInt(),
+			DailyClaimedTotal:       sdkmath.ZeroInt(),
 		}
 		airdrops = append(airdrops, airdrop)
 		s.App.AirdropKeeper.SetAirdrop(s.Ctx, airdrop)
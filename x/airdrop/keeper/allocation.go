@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -15,6 +18,14 @@ func (k Keeper) SetUserAllocation(ctx sdk.Context, userAllocation types.UserAllo
 	allocationBz := k.cdc.MustMarshal(&userAllocation)
 
 	store.Set(key, allocationBz)
+
+	// Index the airdrop under each action that gates one of the user's tranches so that
+	// the action hooks only need to check the relevant airdrops
+	for _, action := range userAllocation.RequiredActions {
+		if action != types.ACTION_NONE {
+			k.SetActionAirdropIndex(ctx, action, userAllocation.AirdropId)
+		}
+	}
 }
 
 // Retrieves a user allocation record from the store
@@ -81,3 +92,138 @@ func (k Keeper) GetUserAllocationsForAddress(ctx sdk.Context, address string) (u
 	}
 	return userAllocations
 }
+
+// Records that an address's merkle allocation has been initialized from a proof
+func (k Keeper) SetMerkleAllocationInitialized(ctx sdk.Context, airdropId, address string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MerkleAllocationInitializationKeyPrefix)
+	store.Set(types.UserAllocationKey(airdropId, address), []byte{1})
+}
+
+// Checks if an address's merkle allocation has already been initialized from a proof
+func (k Keeper) IsMerkleAllocationInitialized(ctx sdk.Context, airdropId, address string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MerkleAllocationInitializationKeyPrefix)
+	return store.Has(types.UserAllocationKey(airdropId, address))
+}
+
+// Retrieves all addresses whose merkle allocations have been initialized across all airdrops
+func (k Keeper) GetAllMerkleAllocationInitializations(ctx sdk.Context) (initializations []types.MerkleAllocationInitialization) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MerkleAllocationInitializationKeyPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		airdropId, address, found := strings.Cut(string(iterator.Key()), "/")
+		if !found {
+			continue
+		}
+		initializations = append(initializations, types.MerkleAllocationInitialization{
+			AirdropId: airdropId,
+			Address:   address,
+		})
+	}
+
+	return initializations
+}
+
+// Initializes a user's allocation from a merkle proof, if the merkle allocation has not
+// already been initialized. This allows allocations to be committed to with a single merkle
+// root instead of being uploaded in bulk; the record is created lazily on the user's first claim
+// If the user already has an allocation record (e.g. from a linked address), the merkle
+// allocations are merged into the existing record so that they are not lost
+// If the merkle allocation was already initialized, the proof is ignored
+func (k Keeper) InitializeUserAllocationFromProof(
+	ctx sdk.Context,
+	airdropId string,
+	address string,
+	proof types.AllocationProof,
+) error {
+	if k.IsMerkleAllocationInitialized(ctx, airdropId, address) {
+		return nil
+	}
+
+	airdrop, found := k.GetAirdrop(ctx, airdropId)
+	if !found {
+		return types.ErrAirdropNotFound.Wrapf("airdrop %s", airdropId)
+	}
+	if airdrop.MerkleRoot == "" {
+		return types.ErrInvalidMerkleRoot.Wrapf("airdrop %s does not have a merkle root", airdropId)
+	}
+
+	periodLengthSeconds := k.GetParams(ctx).PeriodLengthSeconds
+	expectedDays := airdrop.GetAirdropPeriods(periodLengthSeconds)
+	if len(proof.Allocations) != int(expectedDays) {
+		return types.ErrInvalidAllocationListLength.Wrapf("expected %d, provided %d",
+			expectedDays, len(proof.Allocations))
+	}
+
+	if err := types.VerifyAllocationProof(airdrop.MerkleRoot, address, proof.Allocations, proof.Proof); err != nil {
+		return err
+	}
+
+	userAllocation, found := k.GetUserAllocation(ctx, airdropId, address)
+	if !found {
+		userAllocation = types.UserAllocation{
+			AirdropId:   airdropId,
+			Address:     address,
+			Claimed:     sdkmath.ZeroInt(),
+			Forfeited:   sdkmath.ZeroInt(),
+			Allocations: make([]sdkmath.Int, len(proof.Allocations)),
+		}
+		for i := range userAllocation.Allocations {
+			userAllocation.Allocations[i] = sdkmath.ZeroInt()
+		}
+	}
+	if len(userAllocation.Allocations) != len(proof.Allocations) {
+		return types.ErrInvalidAllocationListLength.Wrapf("existing allocation for %s has length %d, proof has length %d",
+			address, len(userAllocation.Allocations), len(proof.Allocations))
+	}
+
+	totalInitialized := sdkmath.ZeroInt()
+	for i, allocation := range proof.Allocations {
+		userAllocation.Allocations[i] = userAllocation.Allocations[i].Add(allocation)
+		totalInitialized = totalInitialized.Add(allocation)
+	}
+
+	// The merkle total is asserted by the admin and can't be checked against the leaves when the
+	// root is set, so the initialized amount is capped here to keep the airdrop's budget accurate
+	merkleInitializedAmount := airdrop.GetMerkleInitializedAmount().Add(totalInitialized)
+	if merkleInitializedAmount.GT(airdrop.GetMerkleTotalAllocated()) {
+		return types.ErrMerkleTotalExceeded.Wrapf("initialized %v, merkle total allocated %v",
+			merkleInitializedAmount, airdrop.GetMerkleTotalAllocated())
+	}
+
+	k.SetUserAllocation(ctx, userAllocation)
+	k.SetMerkleAllocationInitialized(ctx, airdropId, address)
+
+	airdrop.MerkleInitializedAmount = merkleInitializedAmount
+	k.SetAirdrop(ctx, airdrop)
+
+	return nil
+}
+
+// Records that an airdrop has at least one allocation gated by the given action
+func (k Keeper) SetActionAirdropIndex(ctx sdk.Context, action types.Action, airdropId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ActionAirdropIndexKeyPrefix)
+	store.Set(types.ActionAirdropIndexKey(action, airdropId), []byte{1})
+}
+
+// Removes an airdrop from the action index
+func (k Keeper) RemoveActionAirdropIndex(ctx sdk.Context, action types.Action, airdropId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ActionAirdropIndexKeyPrefix)
+	store.Delete(types.ActionAirdropIndexKey(action, airdropId))
+}
+
+// Returns the IDs of the airdrops that have at least one allocation gated by the given action
+func (k Keeper) GetAirdropIdsForAction(ctx sdk.Context, action types.Action) (airdropIds []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ActionAirdropIndexKeyPrefix)
+
+	iterator := prefix.NewStore(store, types.ActionAirdropIndexPrefix(action)).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		airdropIds = append(airdropIds, string(iterator.Key()))
+	}
+
+	return airdropIds
+}
@@ -138,3 +138,182 @@ func (s *KeeperTestSuite) TestGetUserAllocationsForAddress() {
 		s.Require().Equal(expectedAllocations, actualAllocations, "allocations for %s", address)
 	}
 }
+
+func (s *KeeperTestSuite) TestInitializeUserAllocationFromProof() {
+	// Build a merkle tree with three users, each with 10 days of allocations
+	// (matching the length of the test airdrop)
+	rawAllocations := []types.RawAllocation{}
+	for i := int64(1); i <= 3; i++ {
+		allocations := []sdkmath.Int{}
+		for day := int64(0); day < 10; day++ {
+			allocations = append(allocations, sdkmath.NewInt(i*10))
+		}
+		rawAllocations = append(rawAllocations, types.RawAllocation{
+			UserAddress: fmt.Sprintf("user-%d", i),
+			Allocations: allocations,
+		})
+	}
+	merkleRoot, proofs, err := types.BuildAllocationMerkleTree(rawAllocations)
+	s.Require().NoError(err, "no error expected when building merkle tree")
+
+	user := rawAllocations[1].UserAddress
+	validProof := proofs[user]
+
+	testCases := []struct {
+		name               string
+		merkleRoot         string
+		merkleTotal        int64
+		existing           bool
+		existingLength     int
+		alreadyInitialized bool
+		proof              types.AllocationProof
+		expectedError      string
+	}{
+		{
+			name:       "successful initialization",
+			merkleRoot: merkleRoot,
+			proof:      validProof,
+		},
+		{
+			name:           "successful initialization merged with existing allocation",
+			merkleRoot:     merkleRoot,
+			existing:       true,
+			existingLength: 10,
+			proof:          validProof,
+		},
+		{
+			name:               "merkle allocation already initialized",
+			merkleRoot:         merkleRoot,
+			existing:           true,
+			existingLength:     10,
+			alreadyInitialized: true,
+			proof:              types.AllocationProof{},
+		},
+		{
+			name:          "no merkle root",
+			merkleRoot:    "",
+			proof:         validProof,
+			expectedError: "does not have a merkle root",
+		},
+		{
+			name:       "invalid allocations length",
+			merkleRoot: merkleRoot,
+			proof: types.AllocationProof{
+				Allocations: validProof.Allocations[1:],
+				Proof:       validProof.Proof,
+			},
+			expectedError: "expected 10, provided 9",
+		},
+		{
+			name:          "invalid proof",
+			merkleRoot:    merkleRoot,
+			proof:         proofs[rawAllocations[0].UserAddress],
+			expectedError: "do not match the merkle root",
+		},
+		{
+			name:          "exceeds merkle total allocated",
+			merkleRoot:    merkleRoot,
+			merkleTotal:   199,
+			proof:         validProof,
+			expectedError: "merkle allocations exceed the merkle total allocated",
+		},
+		{
+			name:           "existing allocation has a different length",
+			merkleRoot:     merkleRoot,
+			existing:       true,
+			existingLength: 1,
+			proof:          validProof,
+			expectedError:  "existing allocation for user-2 has length 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			// By default, the merkle total is the sum of all allocations in the tree (10 days of 10, 20, and 30)
+			merkleTotal := tc.merkleTotal
+			if merkleTotal == 0 {
+				merkleTotal = 600
+			}
+			s.App.AirdropKeeper.SetAirdrop(s.Ctx, types.Airdrop{
+				Id:                    AirdropId,
+				DistributionStartDate: &DistributionStartDate,
+				DistributionEndDate:   &DistributionEndDate,
+				MerkleRoot:            tc.merkleRoot,
+				MerkleTotalAllocated:  sdkmath.NewInt(merkleTotal),
+			})
+
+			existingAllocations := []sdkmath.Int{}
+			for i := 0; i < tc.existingLength; i++ {
+				existingAllocations = append(existingAllocations, sdkmath.NewInt(1))
+			}
+			existingAllocation := types.UserAllocation{
+				AirdropId:   AirdropId,
+				Address:     user,
+				Claimed:     sdkmath.NewInt(5),
+				Forfeited:   sdkmath.ZeroInt(),
+				Allocations: existingAllocations,
+			}
+			if tc.existing {
+				s.App.AirdropKeeper.SetUserAllocation(s.Ctx, existingAllocation)
+			}
+			if tc.alreadyInitialized {
+				s.App.AirdropKeeper.SetMerkleAllocationInitialized(s.Ctx, AirdropId, user)
+			}
+
+			err := s.App.AirdropKeeper.InitializeUserAllocationFromProof(s.Ctx, AirdropId, user, tc.proof)
+			if tc.expectedError != "" {
+				s.Require().ErrorContains(err, tc.expectedError)
+
+				actualAllocation, found := s.App.AirdropKeeper.GetUserAllocation(s.Ctx, AirdropId, user)
+				s.Require().Equal(tc.existing, found, "user allocation found")
+				if tc.existing {
+					s.Require().Equal(existingAllocation, actualAllocation, "existing user allocation")
+				}
+				s.Require().False(s.App.AirdropKeeper.IsMerkleAllocationInitialized(s.Ctx, AirdropId, user),
+					"merkle allocation should not be marked as initialized")
+				return
+			}
+			s.Require().NoError(err, "no error expected when initializing allocation")
+			s.Require().True(s.App.AirdropKeeper.IsMerkleAllocationInitialized(s.Ctx, AirdropId, user),
+				"merkle allocation should be marked as initialized")
+
+			// If the merkle allocation was already initialized, it should not have been modified
+			actualAllocation := s.MustGetUserAllocation(AirdropId, user)
+			if tc.alreadyInitialized {
+				s.Require().Equal(existingAllocation, actualAllocation, "existing user allocation")
+				airdrop := s.MustGetAirdrop(AirdropId)
+				s.Require().Equal(int64(0), airdrop.GetMerkleInitializedAmount().Int64(), "merkle initialized amount")
+				return
+			}
+
+			// Otherwise, the proof's allocations should have been added to the existing allocation
+			expectedAllocation := types.UserAllocation{
+				AirdropId: AirdropId,
+				Address:   user,
+				Claimed:   sdkmath.ZeroInt(),
+				Forfeited: sdkmath.ZeroInt(),
+			}
+			for i, allocation := range validProof.Allocations {
+				if tc.existing {
+					allocation = allocation.Add(existingAllocations[i])
+				}
+				expectedAllocation.Allocations = append(expectedAllocation.Allocations, allocation)
+			}
+			if tc.existing {
+				expectedAllocation.Claimed = existingAllocation.Claimed
+			}
+			s.Require().Equal(expectedAllocation, actualAllocation, "user allocation")
+
+			// The airdrop should track the amount that was initialized (20 per day for 10 days)
+			airdrop := s.MustGetAirdrop(AirdropId)
+			s.Require().Equal(int64(200), airdrop.GetMerkleInitializedAmount().Int64(), "merkle initialized amount")
+
+			// Re-initializing should have no effect
+			err = s.App.AirdropKeeper.InitializeUserAllocationFromProof(s.Ctx, AirdropId, user, tc.proof)
+			s.Require().NoError(err, "no error expected when re-initializing allocation")
+			s.Require().Equal(expectedAllocation, s.MustGetUserAllocation(AirdropId, user), "user allocation after re-init")
+		})
+	}
+}
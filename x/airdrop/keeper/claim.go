@@ -7,29 +7,40 @@ import (
 
 	"github.com/Stride-Labs/stride/v27/utils"
 	"github.com/Stride-Labs/stride/v27/x/airdrop/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/v27/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/v27/x/stakeibc/types"
 )
 
 // User transaction to claim all the pending airdrop rewards up to the current day
 func (k Keeper) ClaimDaily(ctx sdk.Context, airdropId, claimer string) error {
-	// Fetch the airdrop and user's allocations
+	// Fetch the airdrop
 	airdrop, airdropFound := k.GetAirdrop(ctx, airdropId)
 	if !airdropFound {
 		return types.ErrAirdropNotFound.Wrapf("airdrop %s", airdropId)
 	}
-	userAllocation, userFound := k.GetUserAllocation(ctx, airdropId, claimer)
+
+	_, err := k.claimDaily(ctx, &airdrop, claimer)
+	return err
+}
+
+// Claims all the pending airdrop rewards up to the current day, and returns the amount claimed
+// The airdrop's budget and claimed totals are updated on the passed in airdrop and stored
+func (k Keeper) claimDaily(ctx sdk.Context, airdrop *types.Airdrop, claimer string) (claimedRewards sdkmath.Int, err error) {
+	// Fetch the user's allocations
+	userAllocation, userFound := k.GetUserAllocation(ctx, airdrop.Id, claimer)
 	if !userFound {
-		return types.ErrUserAllocationNotFound.Wrapf("user %s for airdrop %s", claimer, airdropId)
+		return claimedRewards, types.ErrUserAllocationNotFound.Wrapf("user %s for airdrop %s", claimer, airdrop.Id)
 	}
 
 	// Confirm the airdrop started
 	currentTime := ctx.BlockTime().Unix()
 	if currentTime < airdrop.DistributionStartDate.Unix() {
-		return types.ErrAirdropNotStarted
+		return claimedRewards, types.ErrAirdropNotStarted
 	}
 
 	// Confirm we're not passed the clawback date
 	if currentTime >= airdrop.ClawbackDate.Unix() {
-		return types.ErrAirdropEnded
+		return claimedRewards, types.ErrAirdropEnded
 	}
 
 	// Get the index in the allocations array from the current date
@@ -37,12 +48,16 @@ func (k Keeper) ClaimDaily(ctx sdk.Context, airdropId, claimer string) error {
 	periodLengthSeconds := k.GetParams(ctx).PeriodLengthSeconds
 	todaysIndex, err := airdrop.GetCurrentDateIndex(ctx, periodLengthSeconds)
 	if err != nil {
-		return err
+		return claimedRewards, err
 	}
 
 	// Sum the rewards up to that date and 0 them out in the process
+	// Tranches that require an action that the user has not yet completed are left in place
 	todaysRewards := sdkmath.ZeroInt()
 	for i := 0; i <= todaysIndex; i++ {
+		if !userAllocation.IsTrancheUnlocked(i) {
+			continue
+		}
 		rewardsOnDate := userAllocation.Allocations[i]
 		todaysRewards = todaysRewards.Add(rewardsOnDate)
 		userAllocation.Allocations[i] = sdkmath.ZeroInt()
@@ -50,12 +65,20 @@ func (k Keeper) ClaimDaily(ctx sdk.Context, airdropId, claimer string) error {
 
 	// If there are no rewards, alert the user with an error
 	if todaysRewards.IsZero() {
-		return types.ErrNoUnclaimedRewards
+		return claimedRewards, types.ErrNoUnclaimedRewards
 	}
 
 	// Update the amount claimed on the allocation record
 	userAllocation.Claimed = userAllocation.Claimed.Add(todaysRewards)
 
+	// Update the airdrop's remaining budget, and if the user hasn't forfeited any rewards,
+	// include the claim in the total used for redistribution
+	airdrop.UnclaimedBudget = airdrop.GetUnclaimedBudget().Sub(todaysRewards)
+	if userAllocation.Forfeited.IsZero() {
+		airdrop.DailyClaimedTotal = airdrop.GetDailyClaimedTotal().Add(todaysRewards)
+	}
+	k.SetAirdrop(ctx, *airdrop)
+
 	// Distribute rewards from the distributor
 	distributorAccount := sdk.MustAccAddressFromBech32(airdrop.DistributorAddress)
 	claimerAccount := sdk.MustAccAddressFromBech32(userAllocation.Address)
@@ -65,10 +88,10 @@ func (k Keeper) ClaimDaily(ctx sdk.Context, airdropId, claimer string) error {
 	k.SetUserAllocation(ctx, userAllocation)
 
 	if err := utils.SafeSendCoins(true, k.bankKeeper, ctx, distributorAccount, claimerAccount, sdk.NewCoins(rewardsCoin)); err != nil {
-		return errorsmod.Wrapf(err, "unable to distribute rewards")
+		return claimedRewards, errorsmod.Wrapf(err, "unable to distribute rewards")
 	}
 
-	return nil
+	return todaysRewards, nil
 }
 
 // User transaction to claim a portion of their total amount now, and forfeit the
@@ -96,8 +119,13 @@ func (k Keeper) ClaimEarly(ctx sdk.Context, airdropId, claimer string) error {
 	}
 
 	// Sum the total rewards 0 them out in the process
+	// Tranches that require an action that the user has not yet completed are left in place,
+	// and can be claimed once the action is completed
 	totalAccruedRewards := sdkmath.ZeroInt()
 	for i, rewardsOnDate := range userAllocation.Allocations {
+		if !userAllocation.IsTrancheUnlocked(i) {
+			continue
+		}
 		totalAccruedRewards = totalAccruedRewards.Add(rewardsOnDate)
 		userAllocation.Allocations[i] = sdkmath.ZeroInt()
 	}
@@ -111,6 +139,11 @@ func (k Keeper) ClaimEarly(ctx sdk.Context, airdropId, claimer string) error {
 	rewardsRemainingRate := sdk.OneDec().Sub(airdrop.EarlyClaimPenalty)
 	distributedRewards := sdk.NewDecFromInt(totalAccruedRewards).Mul(rewardsRemainingRate).TruncateInt()
 
+	// If the user previously claimed daily, their claims are no longer eligible for redistribution
+	if userAllocation.Forfeited.IsZero() {
+		airdrop.DailyClaimedTotal = airdrop.GetDailyClaimedTotal().Sub(userAllocation.Claimed)
+	}
+
 	// Update the amount claimed on the allocation record by the amount distributed
 	userAllocation.Claimed = userAllocation.Claimed.Add(distributedRewards)
 
@@ -120,6 +153,15 @@ func (k Keeper) ClaimEarly(ctx sdk.Context, airdropId, claimer string) error {
 	forfeitedRewards := totalAccruedRewards.Sub(distributedRewards)
 	userAllocation.Forfeited = userAllocation.Forfeited.Add(forfeitedRewards)
 
+	// Add the forfeited rewards to the airdrop's pool so they can be used for claim and stake bonuses
+	// The forfeited rewards remain in the budget since they are still held by the distributor
+	airdrop.ForfeitedPool = airdrop.GetForfeitedPool().Add(forfeitedRewards)
+	airdrop.UnclaimedBudget = airdrop.GetUnclaimedBudget().Sub(distributedRewards)
+	if userAllocation.Forfeited.IsZero() {
+		airdrop.DailyClaimedTotal = airdrop.GetDailyClaimedTotal().Add(userAllocation.Claimed)
+	}
+	k.SetAirdrop(ctx, airdrop)
+
 	// Distribute rewards from the distributor, deducting the early penalty
 	distributorAccount := sdk.MustAccAddressFromBech32(airdrop.DistributorAddress)
 	claimerAccount := sdk.MustAccAddressFromBech32(userAllocation.Address)
@@ -135,6 +177,67 @@ func (k Keeper) ClaimEarly(ctx sdk.Context, airdropId, claimer string) error {
 	return nil
 }
 
+// User transaction to claim all the pending airdrop rewards up to the current day, and liquid
+// stake them so that the user receives stTokens
+// The reward denom must be the IBC denom of a stakeibc host zone (staketia host zones are also
+// liquid staked through stakeibc)
+// If the airdrop has a claim and stake bonus, the bonus is paid from the forfeited pool (capped
+// at the remaining pool balance) and is liquid staked alongside the claimed rewards
+func (k Keeper) ClaimAndStake(ctx sdk.Context, airdropId, claimer string) (stToken sdk.Coin, err error) {
+	// Fetch the airdrop
+	airdrop, airdropFound := k.GetAirdrop(ctx, airdropId)
+	if !airdropFound {
+		return stToken, types.ErrAirdropNotFound.Wrapf("airdrop %s", airdropId)
+	}
+
+	// Confirm the rewards can be liquid staked before claiming
+	hostZone, err := k.stakeibcKeeper.GetHostZoneFromIBCDenom(ctx, airdrop.RewardDenom)
+	if err != nil {
+		return stToken, types.ErrRewardDenomNotStakeable.Wrapf("no host zone found for reward denom %s", airdrop.RewardDenom)
+	}
+
+	// Claim the rewards up to the current day
+	claimedRewards, err := k.claimDaily(ctx, &airdrop, claimer)
+	if err != nil {
+		return stToken, err
+	}
+
+	// Pay out the bonus from the forfeited pool
+	bonus := sdk.NewDecFromInt(claimedRewards).Mul(airdrop.GetClaimAndStakeBonus()).TruncateInt()
+	bonus = sdkmath.MinInt(bonus, airdrop.GetForfeitedPool())
+	if bonus.IsPositive() {
+		airdrop.ForfeitedPool = airdrop.GetForfeitedPool().Sub(bonus)
+		airdrop.UnclaimedBudget = airdrop.GetUnclaimedBudget().Sub(bonus)
+		k.SetAirdrop(ctx, airdrop)
+
+		distributorAccount := sdk.MustAccAddressFromBech32(airdrop.DistributorAddress)
+		claimerAccount := sdk.MustAccAddressFromBech32(claimer)
+		bonusCoin := sdk.NewCoin(airdrop.RewardDenom, bonus)
+
+		if err := utils.SafeSendCoins(true, k.bankKeeper, ctx, distributorAccount, claimerAccount, sdk.NewCoins(bonusCoin)); err != nil {
+			return stToken, errorsmod.Wrapf(err, "unable to distribute claim and stake bonus")
+		}
+	}
+
+	// Liquid stake the claimed rewards and bonus from the claimer's account
+	liquidStakeMsg := &stakeibctypes.MsgLiquidStake{
+		Creator:   claimer,
+		Amount:    claimedRewards.Add(bonus),
+		HostDenom: hostZone.HostDenom,
+	}
+	if err := liquidStakeMsg.ValidateBasic(); err != nil {
+		return stToken, err
+	}
+
+	msgServer := stakeibckeeper.NewMsgServerImpl(*k.stakeibcKeeper)
+	liquidStakeResponse, err := msgServer.LiquidStake(sdk.WrapSDKContext(ctx), liquidStakeMsg)
+	if err != nil {
+		return stToken, errorsmod.Wrapf(err, "failed to liquid stake claimed rewards")
+	}
+
+	return liquidStakeResponse.StToken, nil
+}
+
 // Admin transaction to merge allocations between a stride and non-stride address
 // If the stride address does not yet have an allocation, the host allocation will be overwritten
 // with the stride address
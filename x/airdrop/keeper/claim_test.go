@@ -7,6 +7,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/Stride-Labs/stride/v27/x/airdrop/types"
+	epochtypes "github.com/Stride-Labs/stride/v27/x/epochs/types"
+	recordstypes "github.com/Stride-Labs/stride/v27/x/records/types"
+	stakeibctypes "github.com/Stride-Labs/stride/v27/x/stakeibc/types"
 )
 
 // Helper function to cast an array of allocations as int64's into sdkmath.Ints
@@ -169,6 +172,8 @@ func (s *KeeperTestSuite) TestClaimDaily() {
 				DistributionStartDate: &DistributionStartDate,
 				DistributionEndDate:   &DistributionEndDate,
 				ClawbackDate:          &ClawbackDate,
+				UnclaimedBudget:       initialDistributorBalance,
+				DailyClaimedTotal:     sdkmath.NewInt(tc.initialClaimed),
 			})
 
 			// Set the block time to the distribution start time plus the offset
@@ -206,10 +211,75 @@ func (s *KeeperTestSuite) TestClaimDaily() {
 			// Confirm funds were sent to the user
 			claimerBalance := s.App.BankKeeper.GetBalance(s.Ctx, claimer, RewardDenom).Amount
 			s.Require().Equal(tc.expectedNewRewards, claimerBalance.Int64(), "claimer balance")
+
+			// Confirm the airdrop's budget was decremented and the claim was tracked for redistribution
+			airdrop := s.MustGetAirdrop(AirdropId)
+			expectedBudget := initialDistributorBalance.Int64() - tc.expectedNewRewards
+			s.Require().Equal(expectedBudget, airdrop.UnclaimedBudget.Int64(), "unclaimed budget")
+
+			expectedDailyClaimedTotal := tc.initialClaimed
+			if tc.expectedForfeited == 0 {
+				expectedDailyClaimedTotal += tc.expectedNewRewards
+			}
+			s.Require().Equal(expectedDailyClaimedTotal, airdrop.DailyClaimedTotal.Int64(), "daily claimed total")
 		})
 	}
 }
 
+func (s *KeeperTestSuite) TestClaimDaily_RequiredActions() {
+	claimer := s.TestAccs[0]
+	distributor := s.TestAccs[1]
+
+	// Fund the distributor
+	initialDistributorBalance := sdk.NewInt(1000)
+	s.FundAccount(distributor, sdk.NewCoin(RewardDenom, initialDistributorBalance))
+
+	// Create the initial airdrop config
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, types.Airdrop{
+		Id:                    AirdropId,
+		RewardDenom:           RewardDenom,
+		DistributorAddress:    distributor.String(),
+		DistributionStartDate: &DistributionStartDate,
+		DistributionEndDate:   &DistributionEndDate,
+		ClawbackDate:          &ClawbackDate,
+	})
+
+	// Create a user whose second tranche requires a liquid stake
+	s.App.AirdropKeeper.SetUserAllocation(s.Ctx, types.UserAllocation{
+		AirdropId:       AirdropId,
+		Address:         claimer.String(),
+		Claimed:         sdkmath.ZeroInt(),
+		Forfeited:       sdkmath.ZeroInt(),
+		Allocations:     allocationsToSdkInt([]int64{10, 20, 30}),
+		RequiredActions: []types.Action{types.ACTION_NONE, types.ACTION_LIQUID_STAKE, types.ACTION_NONE},
+	})
+
+	// Claim on the third day - the locked tranche should be left in place
+	s.Ctx = s.Ctx.WithBlockTime(DistributionStartDate.Add(time.Hour * 49))
+	err := s.App.AirdropKeeper.ClaimDaily(s.Ctx, AirdropId, claimer.String())
+	s.Require().NoError(err, "no error expected when claiming daily")
+
+	userAllocation := s.MustGetUserAllocation(AirdropId, claimer.String())
+	s.Require().Equal([]int64{0, 20, 0}, allocationsToInt64(userAllocation.Allocations), "allocations after first claim")
+	s.Require().Equal(int64(40), userAllocation.Claimed.Int64(), "claimed after first claim")
+
+	// Claiming again before completing the action should fail
+	err = s.App.AirdropKeeper.ClaimDaily(s.Ctx, AirdropId, claimer.String())
+	s.Require().ErrorContains(err, "no unclaimed rewards")
+
+	// Complete the action and claim the remaining tranche
+	s.App.AirdropKeeper.Hooks().AfterLiquidStake(s.Ctx, claimer)
+	err = s.App.AirdropKeeper.ClaimDaily(s.Ctx, AirdropId, claimer.String())
+	s.Require().NoError(err, "no error expected when claiming after action")
+
+	userAllocation = s.MustGetUserAllocation(AirdropId, claimer.String())
+	s.Require().Equal([]int64{0, 0, 0}, allocationsToInt64(userAllocation.Allocations), "allocations after second claim")
+	s.Require().Equal(int64(60), userAllocation.Claimed.Int64(), "claimed after second claim")
+
+	claimerBalance := s.App.BankKeeper.GetBalance(s.Ctx, claimer, RewardDenom).Amount
+	s.Require().Equal(int64(60), claimerBalance.Int64(), "claimer balance")
+}
+
 func (s *KeeperTestSuite) TestClaimEarly() {
 	testCases := []struct {
 		name                      string
@@ -316,6 +386,8 @@ func (s *KeeperTestSuite) TestClaimEarly() {
 				DistributionStartDate: &DistributionStartDate,
 				ClaimTypeDeadlineDate: &DeadlineDate,
 				EarlyClaimPenalty:     tc.penalty,
+				UnclaimedBudget:       initialDistributorBalance,
+				DailyClaimedTotal:     sdkmath.NewInt(tc.initialClaimed),
 			})
 
 			// Set the block time to the distribution start time plus the offset
@@ -347,6 +419,21 @@ func (s *KeeperTestSuite) TestClaimEarly() {
 				s.Require().Zero(allocation.Int64(), "allocations should be 0")
 			}
 
+			// Check that the forfeited amount was added to the airdrop's pool
+			airdrop := s.MustGetAirdrop(AirdropId)
+			s.Require().Equal(tc.expectedForfeited, airdrop.ForfeitedPool.Int64(), "forfeited pool")
+
+			// Check that the budget was decremented by the distributed amount and that the user's
+			// claims are no longer eligible for redistribution (unless nothing was forfeited)
+			expectedBudget := initialDistributorBalance.Int64() - tc.expectedUserBalanceChange
+			s.Require().Equal(expectedBudget, airdrop.UnclaimedBudget.Int64(), "unclaimed budget")
+
+			expectedDailyClaimedTotal := int64(0)
+			if tc.expectedForfeited == 0 {
+				expectedDailyClaimedTotal = tc.expectedClaimed
+			}
+			s.Require().Equal(expectedDailyClaimedTotal, airdrop.DailyClaimedTotal.Int64(), "daily claimed total")
+
 			// Confirm funds were decremented from the distributor
 			expectedDistributorBalance := initialDistributorBalance.Sub(sdkmath.NewInt(tc.expectedUserBalanceChange))
 			actualDistributorBalance := s.App.BankKeeper.GetBalance(s.Ctx, distributor, RewardDenom).Amount
@@ -359,6 +446,158 @@ func (s *KeeperTestSuite) TestClaimEarly() {
 	}
 }
 
+func (s *KeeperTestSuite) TestClaimAndStake() {
+	stakeibcHostDenom := "uatom"
+	stakeibcIbcDenom := "ibc/uatom"
+
+	testCases := []struct {
+		name                  string
+		rewardDenom           string
+		claimAndStakeBonus    sdk.Dec
+		initialForfeitedPool  int64
+		expectedForfeitedPool int64
+		expectedStTokens      int64
+		expectedError         string
+	}{
+		{
+			// 10 rewards accrued on each of the first 2 days, no bonus
+			name:                  "claim and stake without bonus",
+			rewardDenom:           stakeibcIbcDenom,
+			claimAndStakeBonus:    sdk.ZeroDec(),
+			initialForfeitedPool:  100,
+			expectedForfeitedPool: 100,
+			expectedStTokens:      20,
+		},
+		{
+			// 20 rewards claimed, with a 10% bonus paid from the pool
+			name:                  "claim and stake with bonus",
+			rewardDenom:           stakeibcIbcDenom,
+			claimAndStakeBonus:    sdk.MustNewDecFromStr("0.1"),
+			initialForfeitedPool:  100,
+			expectedForfeitedPool: 100 - 2,
+			expectedStTokens:      20 + 2,
+		},
+		{
+			// 20 rewards claimed, with a 50% bonus capped at the remaining pool
+			name:                  "bonus capped at forfeited pool",
+			rewardDenom:           stakeibcIbcDenom,
+			claimAndStakeBonus:    sdk.MustNewDecFromStr("0.5"),
+			initialForfeitedPool:  4,
+			expectedForfeitedPool: 0,
+			expectedStTokens:      20 + 4,
+		},
+		{
+			// Bonus configured but no forfeited rewards yet
+			name:                  "empty forfeited pool",
+			rewardDenom:           stakeibcIbcDenom,
+			claimAndStakeBonus:    sdk.MustNewDecFromStr("0.5"),
+			initialForfeitedPool:  0,
+			expectedForfeitedPool: 0,
+			expectedStTokens:      20,
+		},
+		{
+			name:                 "reward denom not stakeable",
+			rewardDenom:          RewardDenom,
+			claimAndStakeBonus:   sdk.ZeroDec(),
+			initialForfeitedPool: 0,
+			expectedError:        "reward denom cannot be liquid staked",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset state
+
+			claimer := s.TestAccs[0]
+			distributor := s.TestAccs[1]
+			depositAddress := s.TestAccs[2]
+
+			// Fund the distributor
+			initialDistributorBalance := sdk.NewInt(1000)
+			s.FundAccount(distributor, sdk.NewCoin(tc.rewardDenom, initialDistributorBalance))
+
+			// Create the stakeibc host zone and the records needed to liquid stake
+			s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{
+				ChainId:        HostChainId,
+				HostDenom:      stakeibcHostDenom,
+				IbcDenom:       stakeibcIbcDenom,
+				RedemptionRate: sdk.OneDec(),
+				DepositAddress: depositAddress.String(),
+			})
+			s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, stakeibctypes.EpochTracker{
+				EpochIdentifier: epochtypes.STRIDE_EPOCH,
+				EpochNumber:     1,
+			})
+			s.App.RecordsKeeper.SetDepositRecord(s.Ctx, recordstypes.DepositRecord{
+				Id:                 1,
+				DepositEpochNumber: 1,
+				Amount:             sdkmath.ZeroInt(),
+				HostZoneId:         HostChainId,
+				Status:             recordstypes.DepositRecord_TRANSFER_QUEUE,
+			})
+
+			// Create the initial airdrop config
+			s.App.AirdropKeeper.SetAirdrop(s.Ctx, types.Airdrop{
+				Id:                    AirdropId,
+				RewardDenom:           tc.rewardDenom,
+				DistributorAddress:    distributor.String(),
+				DistributionStartDate: &DistributionStartDate,
+				DistributionEndDate:   &DistributionEndDate,
+				ClawbackDate:          &ClawbackDate,
+				ClaimAndStakeBonus:    tc.claimAndStakeBonus,
+				ForfeitedPool:         sdkmath.NewInt(tc.initialForfeitedPool),
+			})
+
+			// Set the block time to the second day of the airdrop
+			s.Ctx = s.Ctx.WithBlockTime(DistributionStartDate.Add(time.Hour * 25))
+
+			// Create the initial user and allocations
+			s.App.AirdropKeeper.SetUserAllocation(s.Ctx, types.UserAllocation{
+				AirdropId:   AirdropId,
+				Address:     claimer.String(),
+				Claimed:     sdkmath.ZeroInt(),
+				Forfeited:   sdkmath.ZeroInt(),
+				Allocations: allocationsToSdkInt([]int64{10, 10, 10}),
+			})
+
+			// Call claim and stake
+			stToken, actualError := s.App.AirdropKeeper.ClaimAndStake(s.Ctx, AirdropId, claimer.String())
+			if tc.expectedError != "" {
+				s.Require().ErrorContains(actualError, tc.expectedError)
+				return
+			}
+			s.Require().NoError(actualError, "no error expected when claiming and staking")
+
+			// Check that the user's claimed amount excludes the bonus
+			userAllocation := s.MustGetUserAllocation(AirdropId, claimer.String())
+			s.Require().Equal(int64(20), userAllocation.Claimed.Int64(), "claimed")
+			s.Require().Equal([]int64{0, 0, 10}, allocationsToInt64(userAllocation.Allocations), "allocations")
+
+			// Check the bonus was removed from the pool
+			airdrop := s.MustGetAirdrop(AirdropId)
+			s.Require().Equal(tc.expectedForfeitedPool, airdrop.ForfeitedPool.Int64(), "forfeited pool")
+
+			// Confirm the stTokens were sent to the user, and the native tokens were staked
+			s.Require().Equal("st"+stakeibcHostDenom, stToken.Denom, "st token denom")
+			s.Require().Equal(tc.expectedStTokens, stToken.Amount.Int64(), "st token amount")
+
+			stBalance := s.App.BankKeeper.GetBalance(s.Ctx, claimer, "st"+stakeibcHostDenom).Amount
+			s.Require().Equal(tc.expectedStTokens, stBalance.Int64(), "claimer st balance")
+
+			nativeBalance := s.App.BankKeeper.GetBalance(s.Ctx, claimer, tc.rewardDenom).Amount
+			s.Require().Zero(nativeBalance.Int64(), "claimer native balance")
+
+			depositBalance := s.App.BankKeeper.GetBalance(s.Ctx, depositAddress, tc.rewardDenom).Amount
+			s.Require().Equal(tc.expectedStTokens, depositBalance.Int64(), "deposit balance")
+
+			// Confirm the claimed rewards and bonus were decremented from the distributor
+			expectedDistributorBalance := initialDistributorBalance.Sub(sdkmath.NewInt(tc.expectedStTokens))
+			actualDistributorBalance := s.App.BankKeeper.GetBalance(s.Ctx, distributor, tc.rewardDenom).Amount
+			s.Require().Equal(expectedDistributorBalance.Int64(), actualDistributorBalance.Int64(), "distributor balance")
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestLinkAddresses() {
 	testCases := []struct {
 		name                string
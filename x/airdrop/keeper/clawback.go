@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v27/utils"
+	"github.com/Stride-Labs/stride/v27/x/airdrop/types"
+	strdburnertypes "github.com/Stride-Labs/stride/v27/x/strdburner/types"
+)
+
+// Claws back the remaining rewards from each airdrop that has passed its clawback date
+// If the clawback fails for an airdrop, the state changes are reverted and the airdrop is
+// marked as failed so that it is not retried every block. The clawback will be retried once
+// the airdrop is updated
+func (k Keeper) ClawbackExpiredAirdrops(ctx sdk.Context) {
+	for _, airdrop := range k.GetAllAirdrops(ctx) {
+		if airdrop.ClawedBack || airdrop.ClawbackFailed || airdrop.ClawbackDestination == types.CLAWBACK_DESTINATION_UNSPECIFIED {
+			continue
+		}
+		if airdrop.ClawbackDate == nil || ctx.BlockTime().Before(*airdrop.ClawbackDate) {
+			continue
+		}
+
+		err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.ClawbackAirdrop(ctx, airdrop)
+		})
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to clawback airdrop %s: %s", airdrop.Id, err.Error()))
+
+			airdrop.ClawbackFailed = true
+			k.SetAirdrop(ctx, airdrop)
+		}
+	}
+}
+
+// Processes the clawback for an airdrop by first (optionally) redistributing the forfeited
+// pool to daily claimers, and then sending the airdrop's unclaimed budget from the distributor
+// to the configured clawback destination
+// The redistribution is processed across multiple blocks, and the clawback is only sent once
+// the redistribution is complete
+// Only the unclaimed budget is clawed back (capped at the distributor's balance), since the
+// distributor account may be shared with other airdrops
+func (k Keeper) ClawbackAirdrop(ctx sdk.Context, airdrop types.Airdrop) error {
+	if airdrop.ClawedBack {
+		return types.ErrClawbackFailed.Wrapf("airdrop %s has already been clawed back", airdrop.Id)
+	}
+	if airdrop.ClawbackDestination == types.CLAWBACK_DESTINATION_UNSPECIFIED {
+		return types.ErrClawbackFailed.Wrapf("clawback is disabled for airdrop %s", airdrop.Id)
+	}
+
+	distributorAddress, err := sdk.AccAddressFromBech32(airdrop.DistributorAddress)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid distributor address")
+	}
+
+	// Optionally redistribute the forfeited pool back to the users that claimed daily
+	// If there are still users remaining, the clawback will continue in the next block
+	if airdrop.RedistributeForfeited && !airdrop.RedistributionComplete {
+		airdrop.RedistributionComplete = k.RedistributeForfeitedRewards(ctx, &airdrop, distributorAddress)
+		if !airdrop.RedistributionComplete {
+			k.SetAirdrop(ctx, airdrop)
+			return nil
+		}
+	}
+
+	// Send the remaining budget to the clawback destination
+	distributorBalance := k.bankKeeper.GetBalance(ctx, distributorAddress, airdrop.RewardDenom)
+	clawbackAmount := sdkmath.MinInt(airdrop.GetUnclaimedBudget(), distributorBalance.Amount)
+	if clawbackAmount.IsPositive() {
+		clawbackCoin := sdk.NewCoin(airdrop.RewardDenom, clawbackAmount)
+		if err := k.sendToClawbackDestination(ctx, airdrop, distributorAddress, clawbackCoin); err != nil {
+			return err
+		}
+	} else {
+		clawbackAmount = sdkmath.ZeroInt()
+	}
+
+	// Mark the airdrop as complete so the clawback is not processed again
+	// Any forfeited rewards that were not redistributed were included in the clawback
+	airdrop.ClawedBack = true
+	airdrop.ClawedBackAmount = airdrop.GetClawedBackAmount().Add(clawbackAmount)
+	airdrop.ForfeitedPool = sdkmath.ZeroInt()
+	airdrop.UnclaimedBudget = sdkmath.ZeroInt()
+	k.SetAirdrop(ctx, airdrop)
+
+	return nil
+}
+
+// Sends the clawed back rewards from the distributor to the airdrop's clawback destination
+func (k Keeper) sendToClawbackDestination(
+	ctx sdk.Context,
+	airdrop types.Airdrop,
+	distributorAddress sdk.AccAddress,
+	clawbackCoin sdk.Coin,
+) error {
+	clawbackCoins := sdk.NewCoins(clawbackCoin)
+
+	switch airdrop.ClawbackDestination {
+	case types.CLAWBACK_TO_COMMUNITY_POOL:
+		if err := k.distributionKeeper.FundCommunityPool(ctx, clawbackCoins, distributorAddress); err != nil {
+			return errorsmod.Wrapf(err, "unable to fund community pool")
+		}
+
+	case types.CLAWBACK_TO_STRD_BURNER:
+		if airdrop.RewardDenom != types.StrdDenom {
+			return types.ErrInvalidClawbackDestination.Wrapf("cannot burn non-%s reward denom %s", types.StrdDenom, airdrop.RewardDenom)
+		}
+		err := k.strdBurnerKeeper.Burn(ctx, distributorAddress, clawbackCoin.Amount, strdburnertypes.BURN_SOURCE_AIRDROP_CLAWBACK)
+		if err != nil {
+			return errorsmod.Wrapf(err, "unable to burn rewards with the strd burner")
+		}
+
+	case types.CLAWBACK_TO_AIRDROP:
+		recipientAirdrop, found := k.GetAirdrop(ctx, airdrop.ClawbackAirdropId)
+		if !found {
+			return types.ErrAirdropNotFound.Wrapf("clawback airdrop %s", airdrop.ClawbackAirdropId)
+		}
+		if recipientAirdrop.RewardDenom != airdrop.RewardDenom {
+			return types.ErrInvalidClawbackDestination.Wrapf("clawback airdrop %s has a different reward denom (%s)",
+				recipientAirdrop.Id, recipientAirdrop.RewardDenom)
+		}
+		if recipientAirdrop.ClawedBack {
+			return types.ErrInvalidClawbackDestination.Wrapf("clawback airdrop %s has already ended", recipientAirdrop.Id)
+		}
+		recipientAddress := sdk.MustAccAddressFromBech32(recipientAirdrop.DistributorAddress)
+		if err := utils.SafeSendCoins(true, k.bankKeeper, ctx, distributorAddress, recipientAddress, clawbackCoins); err != nil {
+			return errorsmod.Wrapf(err, "unable to send rewards to the clawback airdrop distributor")
+		}
+
+	default:
+		return types.ErrInvalidClawbackDestination.Wrapf("%s", airdrop.ClawbackDestination.String())
+	}
+
+	return nil
+}
+
+// Redistributes the forfeited pool of an airdrop to users that claimed daily, pro rata to
+// the amount that they claimed
+// At most MaxRedistributionsPerBlock users are processed per call, starting after the airdrop's
+// redistribution cursor. Returns true once all users have been processed
+// If the transfer to a user fails, the user is skipped and their share remains in the
+// distributor to be clawed back. Any remainder from rounding is also clawed back
+func (k Keeper) RedistributeForfeitedRewards(
+	ctx sdk.Context,
+	airdrop *types.Airdrop,
+	distributorAddress sdk.AccAddress,
+) (complete bool) {
+	// The forfeited pool and daily claimed total are fixed after the clawback date,
+	// so each user's share is consistent across blocks
+	forfeitedPool := airdrop.GetForfeitedPool()
+	totalClaimed := airdrop.GetDailyClaimedTotal()
+	if !forfeitedPool.IsPositive() || !totalClaimed.IsPositive() {
+		return true
+	}
+
+	// Iterate the airdrop's allocations, keyed by user address, starting after the cursor
+	airdropPrefix := types.UserAllocationKey(airdrop.Id, "")
+	store := prefix.NewStore(prefix.NewStore(ctx.KVStore(k.storeKey), types.UserAllocationKeyPrefix), airdropPrefix)
+
+	var start []byte
+	if airdrop.RedistributionCursor != "" {
+		start = append([]byte(airdrop.RedistributionCursor), 0x00)
+	}
+	iterator := store.Iterator(start, nil)
+	defer iterator.Close()
+
+	numProcessed := 0
+	for ; iterator.Valid(); iterator.Next() {
+		if numProcessed >= types.MaxRedistributionsPerBlock {
+			return false
+		}
+		numProcessed++
+
+		userAllocation := types.UserAllocation{}
+		k.cdc.MustUnmarshal(iterator.Value(), &userAllocation)
+		airdrop.RedistributionCursor = userAllocation.Address
+
+		// Only users that claimed daily (and thus have nothing forfeited) are eligible
+		if !userAllocation.Forfeited.IsZero() || !userAllocation.Claimed.IsPositive() {
+			continue
+		}
+
+		userShare := forfeitedPool.Mul(userAllocation.Claimed).Quo(totalClaimed)
+		if userShare.IsZero() {
+			continue
+		}
+
+		err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			userAddress, err := sdk.AccAddressFromBech32(userAllocation.Address)
+			if err != nil {
+				return err
+			}
+			rewardCoins := sdk.NewCoins(sdk.NewCoin(airdrop.RewardDenom, userShare))
+			return utils.SafeSendCoins(true, k.bankKeeper, ctx, distributorAddress, userAddress, rewardCoins)
+		})
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to redistribute forfeited rewards to %s for airdrop %s: %s",
+				userAllocation.Address, airdrop.Id, err.Error()))
+			continue
+		}
+
+		airdrop.RedistributedAmount = airdrop.GetRedistributedAmount().Add(userShare)
+		airdrop.UnclaimedBudget = airdrop.GetUnclaimedBudget().Sub(userShare)
+	}
+
+	return true
+}
@@ -0,0 +1,301 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v27/app/apptesting"
+	"github.com/Stride-Labs/stride/v27/x/airdrop/types"
+	strdburnertypes "github.com/Stride-Labs/stride/v27/x/strdburner/types"
+)
+
+// Helper function to create an airdrop with a funded distributor that is ready to be clawed back
+func (s *KeeperTestSuite) setupClawbackAirdrop(rewardDenom string, distributorBalance int64) (airdrop types.Airdrop, distributor sdk.AccAddress) {
+	distributor = s.TestAccs[0]
+	s.FundAccount(distributor, sdk.NewCoin(rewardDenom, sdkmath.NewInt(distributorBalance)))
+
+	airdrop = types.Airdrop{
+		Id:                    AirdropId,
+		RewardDenom:           rewardDenom,
+		DistributionStartDate: &DistributionStartDate,
+		DistributionEndDate:   &DistributionEndDate,
+		ClawbackDate:          &ClawbackDate,
+		ClaimTypeDeadlineDate: &DeadlineDate,
+		DistributorAddress:    distributor.String(),
+		ClawbackDestination:   types.CLAWBACK_TO_COMMUNITY_POOL,
+		ForfeitedPool:         sdkmath.ZeroInt(),
+		ClawedBackAmount:      sdkmath.ZeroInt(),
+		RedistributedAmount:   sdkmath.ZeroInt(),
+		UnclaimedBudget:       sdkmath.NewInt(distributorBalance),
+		DailyClaimedTotal:     sdkmath.ZeroInt(),
+	}
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, airdrop)
+
+	return airdrop, distributor
+}
+
+func (s *KeeperTestSuite) TestClawbackAirdrop_CommunityPool() {
+	airdrop, distributor := s.setupClawbackAirdrop(RewardDenom, 1000)
+	airdrop.ClawbackDestination = types.CLAWBACK_TO_COMMUNITY_POOL
+
+	err := s.App.AirdropKeeper.ClawbackAirdrop(s.Ctx, airdrop)
+	s.Require().NoError(err, "no error expected when clawing back")
+
+	// Confirm the distributor was drained and the community pool was funded
+	s.Require().Zero(s.App.BankKeeper.GetBalance(s.Ctx, distributor, RewardDenom).Amount.Int64(), "distributor balance")
+	communityPool := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx)
+	s.Require().Equal(int64(1000), communityPool.AmountOf(RewardDenom).TruncateInt64(), "community pool balance")
+
+	// Confirm the airdrop was marked as clawed back
+	airdrop = s.MustGetAirdrop(AirdropId)
+	s.Require().True(airdrop.ClawedBack, "clawed back")
+	s.Require().Equal(int64(1000), airdrop.ClawedBackAmount.Int64(), "clawed back amount")
+
+	// Attempting to clawback again should fail
+	err = s.App.AirdropKeeper.ClawbackAirdrop(s.Ctx, airdrop)
+	s.Require().ErrorContains(err, "has already been clawed back")
+}
+
+func (s *KeeperTestSuite) TestClawbackAirdrop_StrdBurner() {
+	airdrop, distributor := s.setupClawbackAirdrop(types.StrdDenom, 1000)
+	airdrop.ClawbackDestination = types.CLAWBACK_TO_STRD_BURNER
+
+	err := s.App.AirdropKeeper.ClawbackAirdrop(s.Ctx, airdrop)
+	s.Require().NoError(err, "no error expected when clawing back")
+
+	// Confirm the funds were burned and attributed to the airdrop clawback
+	burnerAddress := s.App.StrdBurnerKeeper.GetStrdBurnerAddress()
+	s.Require().Zero(s.App.BankKeeper.GetBalance(s.Ctx, distributor, types.StrdDenom).Amount.Int64(), "distributor balance")
+	s.Require().Zero(s.App.BankKeeper.GetBalance(s.Ctx, burnerAddress, types.StrdDenom).Amount.Int64(), "burner balance")
+	s.Require().Equal(int64(1000), s.App.StrdBurnerKeeper.GetTotalStrdBurned(s.Ctx).Int64(), "total burned")
+	s.Require().Equal(int64(1000), s.App.StrdBurnerKeeper.GetSourceBurned(s.Ctx,
+		strdburnertypes.BURN_SOURCE_AIRDROP_CLAWBACK).Int64(), "airdrop clawback burned")
+	s.Require().Zero(s.App.StrdBurnerKeeper.GetSourceBurned(s.Ctx,
+		strdburnertypes.BURN_SOURCE_UNATTRIBUTED).Int64(), "unattributed burned")
+	s.Require().Equal(int64(1000), s.App.StrdBurnerKeeper.GetAddressBurned(s.Ctx, distributor.String()).Int64(),
+		"distributor burned")
+
+	// Clawing back a non-strd airdrop to the burner should fail
+	otherAirdrop, _ := s.setupClawbackAirdrop(RewardDenom, 1000)
+	otherAirdrop.ClawbackDestination = types.CLAWBACK_TO_STRD_BURNER
+	err = s.App.AirdropKeeper.ClawbackAirdrop(s.Ctx, otherAirdrop)
+	s.Require().ErrorIs(err, types.ErrInvalidClawbackDestination)
+}
+
+func (s *KeeperTestSuite) TestClawbackAirdrop_Airdrop() {
+	airdrop, distributor := s.setupClawbackAirdrop(RewardDenom, 1000)
+	airdrop.ClawbackDestination = types.CLAWBACK_TO_AIRDROP
+	airdrop.ClawbackAirdropId = "airdrop-2"
+
+	// Attempt to clawback before the recipient airdrop exists, it should fail
+	err := s.App.AirdropKeeper.ClawbackAirdrop(s.Ctx, airdrop)
+	s.Require().ErrorIs(err, types.ErrAirdropNotFound)
+
+	// Create the recipient airdrop with a different denom, it should fail
+	recipientDistributor := s.TestAccs[1]
+	recipientAirdrop := types.Airdrop{
+		Id:                 "airdrop-2",
+		RewardDenom:        "different-denom",
+		DistributorAddress: recipientDistributor.String(),
+	}
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, recipientAirdrop)
+
+	err = s.App.AirdropKeeper.ClawbackAirdrop(s.Ctx, airdrop)
+	s.Require().ErrorContains(err, "has a different reward denom")
+
+	// Fix the denom and try again, it should succeed
+	recipientAirdrop.RewardDenom = RewardDenom
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, recipientAirdrop)
+
+	err = s.App.AirdropKeeper.ClawbackAirdrop(s.Ctx, airdrop)
+	s.Require().NoError(err, "no error expected when clawing back")
+
+	s.Require().Zero(s.App.BankKeeper.GetBalance(s.Ctx, distributor, RewardDenom).Amount.Int64(), "distributor balance")
+	s.Require().Equal(int64(1000), s.App.BankKeeper.GetBalance(s.Ctx, recipientDistributor, RewardDenom).Amount.Int64(),
+		"recipient distributor balance")
+}
+
+func (s *KeeperTestSuite) TestClawbackAirdrop_RedistributeForfeited() {
+	// Distributor has 100 in forfeited rewards + 50 in unclaimed rewards
+	airdrop, distributor := s.setupClawbackAirdrop(RewardDenom, 150)
+	airdrop.RedistributeForfeited = true
+	airdrop.ForfeitedPool = sdkmath.NewInt(100)
+	airdrop.DailyClaimedTotal = sdkmath.NewInt(40)
+
+	// Users 1 and 2 claimed daily, user 3 claimed early and user 4 never claimed
+	// The forfeited pool should be split 1:3 between users 1 and 2
+	dailyClaimer1 := s.TestAccs[1]
+	dailyClaimer2 := s.TestAccs[2]
+	earlyClaimer := s.TestAccs[3]
+	nonClaimer := apptesting.CreateRandomAccounts(1)[0]
+
+	userAllocations := []types.UserAllocation{
+		{Address: dailyClaimer1.String(), Claimed: sdkmath.NewInt(10), Forfeited: sdkmath.ZeroInt()},
+		{Address: dailyClaimer2.String(), Claimed: sdkmath.NewInt(30), Forfeited: sdkmath.ZeroInt()},
+		{Address: earlyClaimer.String(), Claimed: sdkmath.NewInt(100), Forfeited: sdkmath.NewInt(100)},
+		{Address: nonClaimer.String(), Claimed: sdkmath.ZeroInt(), Forfeited: sdkmath.ZeroInt()},
+	}
+	for _, userAllocation := range userAllocations {
+		userAllocation.AirdropId = AirdropId
+		userAllocation.Allocations = []sdkmath.Int{sdkmath.ZeroInt()}
+		s.App.AirdropKeeper.SetUserAllocation(s.Ctx, userAllocation)
+	}
+
+	err := s.App.AirdropKeeper.ClawbackAirdrop(s.Ctx, airdrop)
+	s.Require().NoError(err, "no error expected when clawing back")
+
+	// Confirm the forfeited rewards were redistributed
+	s.Require().Equal(int64(25), s.App.BankKeeper.GetBalance(s.Ctx, dailyClaimer1, RewardDenom).Amount.Int64(), "daily claimer 1")
+	s.Require().Equal(int64(75), s.App.BankKeeper.GetBalance(s.Ctx, dailyClaimer2, RewardDenom).Amount.Int64(), "daily claimer 2")
+	s.Require().Zero(s.App.BankKeeper.GetBalance(s.Ctx, earlyClaimer, RewardDenom).Amount.Int64(), "early claimer")
+	s.Require().Zero(s.App.BankKeeper.GetBalance(s.Ctx, nonClaimer, RewardDenom).Amount.Int64(), "non claimer")
+
+	// Confirm the remainder was clawed back
+	s.Require().Zero(s.App.BankKeeper.GetBalance(s.Ctx, distributor, RewardDenom).Amount.Int64(), "distributor balance")
+
+	airdrop = s.MustGetAirdrop(AirdropId)
+	s.Require().Equal(int64(100), airdrop.RedistributedAmount.Int64(), "redistributed amount")
+	s.Require().Equal(int64(50), airdrop.ClawedBackAmount.Int64(), "clawed back amount")
+	s.Require().Zero(airdrop.ForfeitedPool.Int64(), "forfeited pool")
+	s.Require().Zero(airdrop.UnclaimedBudget.Int64(), "unclaimed budget")
+	s.Require().True(airdrop.RedistributionComplete, "redistribution complete")
+}
+
+func (s *KeeperTestSuite) TestClawbackAirdrop_RedistributeForfeitedAcrossBlocks() {
+	// Create more daily claimers than can be processed in a single block, each of which
+	// claimed 1 and should receive 1 from the forfeited pool
+	numUsers := types.MaxRedistributionsPerBlock + 50
+	forfeitedPool := int64(numUsers)
+	unclaimed := int64(50)
+
+	airdrop, distributor := s.setupClawbackAirdrop(RewardDenom, forfeitedPool+unclaimed)
+	airdrop.RedistributeForfeited = true
+	airdrop.ForfeitedPool = sdkmath.NewInt(forfeitedPool)
+	airdrop.DailyClaimedTotal = sdkmath.NewInt(int64(numUsers))
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, airdrop)
+
+	users := apptesting.CreateRandomAccounts(numUsers)
+	for _, user := range users {
+		s.App.AirdropKeeper.SetUserAllocation(s.Ctx, types.UserAllocation{
+			AirdropId:   AirdropId,
+			Address:     user.String(),
+			Claimed:     sdkmath.OneInt(),
+			Forfeited:   sdkmath.ZeroInt(),
+			Allocations: []sdkmath.Int{sdkmath.ZeroInt()},
+		})
+	}
+
+	// The first call should only process the first batch of users and should not clawback yet
+	err := s.App.AirdropKeeper.ClawbackAirdrop(s.Ctx, airdrop)
+	s.Require().NoError(err, "no error expected during first redistribution batch")
+
+	airdrop = s.MustGetAirdrop(AirdropId)
+	s.Require().False(airdrop.ClawedBack, "should not be clawed back after the first batch")
+	s.Require().False(airdrop.RedistributionComplete, "redistribution should not be complete after the first batch")
+	s.Require().NotEmpty(airdrop.RedistributionCursor, "cursor should be set after the first batch")
+	s.Require().Equal(int64(types.MaxRedistributionsPerBlock), airdrop.RedistributedAmount.Int64(),
+		"redistributed amount after the first batch")
+
+	// The second call should process the remaining users and then clawback the unclaimed rewards
+	err = s.App.AirdropKeeper.ClawbackAirdrop(s.Ctx, airdrop)
+	s.Require().NoError(err, "no error expected during second redistribution batch")
+
+	airdrop = s.MustGetAirdrop(AirdropId)
+	s.Require().True(airdrop.ClawedBack, "should be clawed back after the second batch")
+	s.Require().True(airdrop.RedistributionComplete, "redistribution should be complete after the second batch")
+	s.Require().Equal(forfeitedPool, airdrop.RedistributedAmount.Int64(), "total redistributed amount")
+	s.Require().Equal(unclaimed, airdrop.ClawedBackAmount.Int64(), "clawed back amount")
+
+	// Each user should have received exactly one share
+	for _, user := range users {
+		s.Require().Equal(int64(1), s.App.BankKeeper.GetBalance(s.Ctx, user, RewardDenom).Amount.Int64(),
+			"user %s balance", user.String())
+	}
+	s.Require().Zero(s.App.BankKeeper.GetBalance(s.Ctx, distributor, RewardDenom).Amount.Int64(), "distributor balance")
+}
+
+func (s *KeeperTestSuite) TestClawbackAirdrop_SharedDistributor() {
+	// The distributor holds funds for other airdrops, only this airdrop's budget should be clawed back
+	airdrop, distributor := s.setupClawbackAirdrop(RewardDenom, 1000)
+	airdrop.UnclaimedBudget = sdkmath.NewInt(300)
+
+	err := s.App.AirdropKeeper.ClawbackAirdrop(s.Ctx, airdrop)
+	s.Require().NoError(err, "no error expected when clawing back")
+
+	s.Require().Equal(int64(700), s.App.BankKeeper.GetBalance(s.Ctx, distributor, RewardDenom).Amount.Int64(),
+		"distributor balance")
+	communityPool := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx)
+	s.Require().Equal(int64(300), communityPool.AmountOf(RewardDenom).TruncateInt64(), "community pool balance")
+
+	airdrop = s.MustGetAirdrop(AirdropId)
+	s.Require().Equal(int64(300), airdrop.ClawedBackAmount.Int64(), "clawed back amount")
+	s.Require().Zero(airdrop.UnclaimedBudget.Int64(), "unclaimed budget")
+}
+
+func (s *KeeperTestSuite) TestClawbackAirdrop_Unspecified() {
+	airdrop, _ := s.setupClawbackAirdrop(RewardDenom, 1000)
+	airdrop.ClawbackDestination = types.CLAWBACK_DESTINATION_UNSPECIFIED
+
+	err := s.App.AirdropKeeper.ClawbackAirdrop(s.Ctx, airdrop)
+	s.Require().ErrorContains(err, "clawback is disabled")
+}
+
+func (s *KeeperTestSuite) TestClawbackExpiredAirdrops() {
+	airdrop, distributor := s.setupClawbackAirdrop(RewardDenom, 1000)
+
+	// Add a second airdrop with an invalid clawback destination that should fail
+	// without impacting the first airdrop
+	failedAirdrop := airdrop
+	failedAirdrop.Id = "airdrop-failed"
+	failedAirdrop.DistributorAddress = s.TestAccs[1].String()
+	s.FundAccount(s.TestAccs[1], sdk.NewCoin(RewardDenom, sdkmath.NewInt(1000)))
+	failedAirdrop.ClawbackDestination = types.CLAWBACK_TO_AIRDROP
+	failedAirdrop.ClawbackAirdropId = "does-not-exist"
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, failedAirdrop)
+
+	// Add a third airdrop with clawback disabled that should be skipped
+	disabledAirdrop := airdrop
+	disabledAirdrop.Id = "airdrop-disabled"
+	disabledAirdrop.DistributorAddress = s.TestAccs[2].String()
+	s.FundAccount(s.TestAccs[2], sdk.NewCoin(RewardDenom, sdkmath.NewInt(1000)))
+	disabledAirdrop.ClawbackDestination = types.CLAWBACK_DESTINATION_UNSPECIFIED
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, disabledAirdrop)
+
+	// Call the EndBlocker before the clawback date, nothing should happen
+	s.Ctx = s.Ctx.WithBlockTime(ClawbackDate.Add(-1 * time.Second))
+	s.App.AirdropKeeper.EndBlocker(s.Ctx)
+
+	s.Require().False(s.MustGetAirdrop(AirdropId).ClawedBack, "airdrop should not be clawed back before the date")
+	s.Require().Equal(int64(1000), s.App.BankKeeper.GetBalance(s.Ctx, distributor, RewardDenom).Amount.Int64(),
+		"distributor balance before clawback")
+
+	// Call the EndBlocker at the clawback date, the first airdrop should be clawed back
+	s.Ctx = s.Ctx.WithBlockTime(ClawbackDate)
+	s.App.AirdropKeeper.EndBlocker(s.Ctx)
+
+	s.Require().True(s.MustGetAirdrop(AirdropId).ClawedBack, "airdrop should be clawed back")
+	s.Require().False(s.MustGetAirdrop(failedAirdrop.Id).ClawedBack, "failed airdrop should not be clawed back")
+	s.Require().True(s.MustGetAirdrop(failedAirdrop.Id).ClawbackFailed, "failed airdrop should be marked as failed")
+	s.Require().False(s.MustGetAirdrop(disabledAirdrop.Id).ClawedBack, "disabled airdrop should not be clawed back")
+	s.Require().Zero(s.App.BankKeeper.GetBalance(s.Ctx, distributor, RewardDenom).Amount.Int64(),
+		"distributor balance after clawback")
+	s.Require().Equal(int64(1000), s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[2], RewardDenom).Amount.Int64(),
+		"disabled airdrop distributor balance")
+
+	// Once the recipient airdrop exists, the failed airdrop should not be retried until it's updated
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, types.Airdrop{
+		Id:                 "does-not-exist",
+		RewardDenom:        RewardDenom,
+		DistributorAddress: s.TestAccs[3].String(),
+	})
+	s.App.AirdropKeeper.EndBlocker(s.Ctx)
+	s.Require().False(s.MustGetAirdrop(failedAirdrop.Id).ClawedBack, "failed airdrop should not be retried")
+
+	failedAirdrop = s.MustGetAirdrop(failedAirdrop.Id)
+	failedAirdrop.ClawbackFailed = false
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, failedAirdrop)
+	s.App.AirdropKeeper.EndBlocker(s.Ctx)
+	s.Require().True(s.MustGetAirdrop(failedAirdrop.Id).ClawedBack, "failed airdrop should be clawed back after reset")
+}
@@ -15,6 +15,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	for _, allocation := range genState.UserAllocations {
 		k.SetUserAllocation(ctx, allocation)
 	}
+	for _, initialization := range genState.MerkleAllocationInitializations {
+		k.SetMerkleAllocationInitialized(ctx, initialization.AirdropId, initialization.Address)
+	}
 }
 
 // Export's module state into genesis file
@@ -22,6 +25,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Airdrops = k.GetAllAirdrops(ctx)
 	genesis.UserAllocations = k.GetAllUserAllocations(ctx)
+	genesis.MerkleAllocationInitializations = k.GetAllMerkleAllocationInitializations(ctx)
 	genesis.Params = k.GetParams(ctx)
 	return genesis
 }
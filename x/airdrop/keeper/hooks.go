@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/Stride-Labs/stride/v27/x/airdrop/types"
+	stakeibctypes "github.com/Stride-Labs/stride/v27/x/stakeibc/types"
+)
+
+// Marks an action as completed on each of the user's allocations that require it,
+// unlocking the associated tranches
+// Only airdrops that have allocations gated by the action are checked, and airdrops that
+// have ended are skipped
+func (k Keeper) CompleteActionForUser(ctx sdk.Context, address string, action types.Action) {
+	for _, airdropId := range k.GetAirdropIdsForAction(ctx, action) {
+		airdrop, found := k.GetAirdrop(ctx, airdropId)
+		if !found || airdrop.ClawedBack {
+			continue
+		}
+		if airdrop.ClawbackDate != nil && !ctx.BlockTime().Before(*airdrop.ClawbackDate) {
+			continue
+		}
+
+		userAllocation, found := k.GetUserAllocation(ctx, airdrop.Id, address)
+		if !found || !userAllocation.RequiresAction(action) || userAllocation.HasCompletedAction(action) {
+			continue
+		}
+
+		userAllocation.CompletedActions = append(userAllocation.CompletedActions, action)
+		k.SetUserAllocation(ctx, userAllocation)
+	}
+}
+
+func (k Keeper) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	k.CompleteActionForUser(ctx, delAddr.String(), types.ACTION_DELEGATE_STAKE)
+	return nil
+}
+
+func (k Keeper) AfterLiquidStake(ctx sdk.Context, addr sdk.AccAddress) {
+	k.CompleteActionForUser(ctx, addr.String(), types.ACTION_LIQUID_STAKE)
+}
+
+// ________________________________________________________________________________________
+
+// Hooks wrapper struct for airdrop keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ stakingtypes.StakingHooks = Hooks{}
+var _ stakeibctypes.StakeIBCHooks = Hooks{}
+
+// Return the wrapper struct
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// stakeibc hooks
+func (h Hooks) AfterLiquidStake(ctx sdk.Context, addr sdk.AccAddress) {
+	h.k.AfterLiquidStake(ctx, addr)
+}
+func (h Hooks) AfterRewardCollectorAuctioned(ctx sdk.Context) {}
+
+// staking hooks
+func (h Hooks) AfterUnbondingInitiated(ctx sdk.Context, id uint64) error {
+	return nil
+}
+func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	return nil
+}
+func (h Hooks) BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	return nil
+}
+func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+func (h Hooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+func (h Hooks) AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+func (h Hooks) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+func (h Hooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+func (h Hooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.k.AfterDelegationModified(ctx, delAddr, valAddr)
+}
+func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error {
+	return nil
+}
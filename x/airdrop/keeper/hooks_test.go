@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v27/x/airdrop/types"
+)
+
+func (s *KeeperTestSuite) TestCompleteActionForUser() {
+	user := s.TestAccs[0].String()
+
+	// Create four airdrops, one of which has already been clawed back and one of which
+	// has passed its clawback date
+	pastClawbackDate := s.Ctx.BlockTime().Add(-1 * time.Hour)
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, types.Airdrop{Id: "airdrop-1"})
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, types.Airdrop{Id: "airdrop-2"})
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, types.Airdrop{Id: "airdrop-3", ClawedBack: true})
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, types.Airdrop{Id: "airdrop-4", ClawbackDate: &pastClawbackDate})
+
+	// Airdrop 1 requires a liquid stake, airdrop 2 requires a delegation,
+	// airdrops 3 and 4 require a liquid stake but have ended
+	newAllocation := func(airdropId string, actions ...types.Action) types.UserAllocation {
+		return types.UserAllocation{
+			AirdropId:       airdropId,
+			Address:         user,
+			Claimed:         sdkmath.ZeroInt(),
+			Forfeited:       sdkmath.ZeroInt(),
+			Allocations:     allocationsToSdkInt([]int64{10, 10}),
+			RequiredActions: actions,
+		}
+	}
+	s.App.AirdropKeeper.SetUserAllocation(s.Ctx, newAllocation("airdrop-1", types.ACTION_NONE, types.ACTION_LIQUID_STAKE))
+	s.App.AirdropKeeper.SetUserAllocation(s.Ctx, newAllocation("airdrop-2", types.ACTION_NONE, types.ACTION_DELEGATE_STAKE))
+	s.App.AirdropKeeper.SetUserAllocation(s.Ctx, newAllocation("airdrop-3", types.ACTION_NONE, types.ACTION_LIQUID_STAKE))
+	s.App.AirdropKeeper.SetUserAllocation(s.Ctx, newAllocation("airdrop-4", types.ACTION_NONE, types.ACTION_LIQUID_STAKE))
+
+	// Confirm the airdrops were indexed by action
+	s.Require().Equal([]string{"airdrop-1", "airdrop-3", "airdrop-4"},
+		s.App.AirdropKeeper.GetAirdropIdsForAction(s.Ctx, types.ACTION_LIQUID_STAKE), "liquid stake airdrops")
+	s.Require().Equal([]string{"airdrop-2"},
+		s.App.AirdropKeeper.GetAirdropIdsForAction(s.Ctx, types.ACTION_DELEGATE_STAKE), "delegate airdrops")
+	s.Require().Empty(s.App.AirdropKeeper.GetAirdropIdsForAction(s.Ctx, types.ACTION_NONE), "no action airdrops")
+
+	// Liquid stake twice - it should only be recorded once, and only on airdrop 1
+	hooks := s.App.AirdropKeeper.Hooks()
+	hooks.AfterLiquidStake(s.Ctx, s.TestAccs[0])
+	hooks.AfterLiquidStake(s.Ctx, s.TestAccs[0])
+
+	s.Require().Equal([]types.Action{types.ACTION_LIQUID_STAKE},
+		s.MustGetUserAllocation("airdrop-1", user).CompletedActions, "airdrop 1 after liquid stake")
+	s.Require().Empty(s.MustGetUserAllocation("airdrop-2", user).CompletedActions, "airdrop 2 after liquid stake")
+	s.Require().Empty(s.MustGetUserAllocation("airdrop-3", user).CompletedActions, "airdrop 3 after liquid stake")
+	s.Require().Empty(s.MustGetUserAllocation("airdrop-4", user).CompletedActions, "airdrop 4 after liquid stake")
+
+	// Delegate - only airdrop 2 should be updated
+	err := hooks.AfterDelegationModified(s.Ctx, s.TestAccs[0], sdk.ValAddress(s.TestAccs[1]))
+	s.Require().NoError(err, "no error expected after delegation")
+
+	s.Require().Equal([]types.Action{types.ACTION_LIQUID_STAKE},
+		s.MustGetUserAllocation("airdrop-1", user).CompletedActions, "airdrop 1 after delegation")
+	s.Require().Equal([]types.Action{types.ACTION_DELEGATE_STAKE},
+		s.MustGetUserAllocation("airdrop-2", user).CompletedActions, "airdrop 2 after delegation")
+	s.Require().Empty(s.MustGetUserAllocation("airdrop-3", user).CompletedActions, "airdrop 3 after delegation")
+
+	// A user without an allocation should be a no-op
+	hooks.AfterLiquidStake(s.Ctx, s.TestAccs[2])
+	_, found := s.App.AirdropKeeper.GetUserAllocation(s.Ctx, "airdrop-1", s.TestAccs[2].String())
+	s.Require().False(found, "no allocation should be created for a new user")
+
+	// Removing an airdrop should remove it from the index
+	s.App.AirdropKeeper.RemoveAirdrop(s.Ctx, "airdrop-1")
+	s.Require().Equal([]string{"airdrop-3", "airdrop-4"},
+		s.App.AirdropKeeper.GetAirdropIdsForAction(s.Ctx, types.ACTION_LIQUID_STAKE), "liquid stake airdrops after removal")
+}
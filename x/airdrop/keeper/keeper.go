@@ -9,13 +9,17 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/Stride-Labs/stride/v27/x/airdrop/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/v27/x/stakeibc/keeper"
 )
 
 type (
 	Keeper struct {
-		cdc        codec.BinaryCodec
-		storeKey   storetypes.StoreKey
-		bankKeeper types.BankKeeper
+		cdc                codec.BinaryCodec
+		storeKey           storetypes.StoreKey
+		bankKeeper         types.BankKeeper
+		distributionKeeper types.DistributionKeeper
+		stakeibcKeeper     *stakeibckeeper.Keeper
+		strdBurnerKeeper   types.StrdBurnerKeeper
 	}
 )
 
@@ -23,11 +27,17 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
 	bankKeeper types.BankKeeper,
+	distributionKeeper types.DistributionKeeper,
+	stakeibcKeeper *stakeibckeeper.Keeper,
+	strdBurnerKeeper types.StrdBurnerKeeper,
 ) Keeper {
 	return Keeper{
-		cdc:        cdc,
-		storeKey:   storeKey,
-		bankKeeper: bankKeeper,
+		cdc:                cdc,
+		storeKey:           storeKey,
+		bankKeeper:         bankKeeper,
+		distributionKeeper: distributionKeeper,
+		stakeibcKeeper:     stakeibcKeeper,
+		strdBurnerKeeper:   strdBurnerKeeper,
 	}
 }
 
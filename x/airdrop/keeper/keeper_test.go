@@ -15,6 +15,7 @@ var (
 	UserAddress = "address"
 	AirdropId   = "airdrop"
 	RewardDenom = "denom"
+	HostChainId = "chain-0"
 
 	// 1/1 - Start
 	// 1/5 - Decision Date
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -25,6 +26,15 @@ var _ types.MsgServer = msgServer{}
 func (ms msgServer) ClaimDaily(goCtx context.Context, msg *types.MsgClaimDaily) (*types.MsgClaimDailyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// If the allocations were committed to with a merkle root, the user's allocation
+	// record is created from their proof on their first claim
+	if msg.AllocationProof != nil {
+		err := ms.Keeper.InitializeUserAllocationFromProof(ctx, msg.AirdropId, msg.Claimer, *msg.AllocationProof)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err := ms.Keeper.ClaimDaily(ctx, msg.AirdropId, msg.Claimer)
 	if err != nil {
 		return nil, err
@@ -37,6 +47,15 @@ func (ms msgServer) ClaimDaily(goCtx context.Context, msg *types.MsgClaimDaily)
 func (ms msgServer) ClaimEarly(goCtx context.Context, msg *types.MsgClaimEarly) (*types.MsgClaimEarlyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// If the allocations were committed to with a merkle root, the user's allocation
+	// record is created from their proof on their first claim
+	if msg.AllocationProof != nil {
+		err := ms.Keeper.InitializeUserAllocationFromProof(ctx, msg.AirdropId, msg.Claimer, *msg.AllocationProof)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err := ms.Keeper.ClaimEarly(ctx, msg.AirdropId, msg.Claimer)
 	if err != nil {
 		return nil, err
@@ -45,6 +64,27 @@ func (ms msgServer) ClaimEarly(goCtx context.Context, msg *types.MsgClaimEarly)
 	return &types.MsgClaimEarlyResponse{}, nil
 }
 
+// User transaction to claim all the pending airdrop rewards up to the current day and liquid stake them
+func (ms msgServer) ClaimAndStake(goCtx context.Context, msg *types.MsgClaimAndStake) (*types.MsgClaimAndStakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// If the allocations were committed to with a merkle root, the user's allocation
+	// record is created from their proof on their first claim
+	if msg.AllocationProof != nil {
+		err := ms.Keeper.InitializeUserAllocationFromProof(ctx, msg.AirdropId, msg.Claimer, *msg.AllocationProof)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	stToken, err := ms.Keeper.ClaimAndStake(ctx, msg.AirdropId, msg.Claimer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimAndStakeResponse{StToken: stToken}, nil
+}
+
 // Admin transaction to create a new airdrop
 func (ms msgServer) CreateAirdrop(goCtx context.Context, msg *types.MsgCreateAirdrop) (*types.MsgCreateAirdropResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -53,6 +93,12 @@ func (ms msgServer) CreateAirdrop(goCtx context.Context, msg *types.MsgCreateAir
 		return nil, types.ErrAirdropAlreadyExists.Wrapf("airdrop %s", msg.AirdropId)
 	}
 
+	// The claim and stake bonus is optional and defaults to zero
+	claimAndStakeBonus := msg.ClaimAndStakeBonus
+	if claimAndStakeBonus.IsNil() {
+		claimAndStakeBonus = sdk.ZeroDec()
+	}
+
 	airdrop := types.Airdrop{
 		Id:                    msg.AirdropId,
 		RewardDenom:           msg.RewardDenom,
@@ -61,9 +107,21 @@ func (ms msgServer) CreateAirdrop(goCtx context.Context, msg *types.MsgCreateAir
 		ClawbackDate:          msg.ClawbackDate,
 		ClaimTypeDeadlineDate: msg.ClaimTypeDeadlineDate,
 		EarlyClaimPenalty:     msg.EarlyClaimPenalty,
+		ClaimAndStakeBonus:    claimAndStakeBonus,
 		DistributorAddress:    msg.DistributorAddress,
 		AllocatorAddress:      msg.AllocatorAddress,
 		LinkerAddress:         msg.LinkerAddress,
+		ForfeitedPool:         sdkmath.ZeroInt(),
+		ClawbackDestination:   msg.ClawbackDestination,
+		ClawbackAirdropId:     msg.ClawbackAirdropId,
+		RedistributeForfeited: msg.RedistributeForfeited,
+		ClawedBackAmount:      sdkmath.ZeroInt(),
+		RedistributedAmount:   sdkmath.ZeroInt(),
+
+		MerkleTotalAllocated:    sdkmath.ZeroInt(),
+		MerkleInitializedAmount: sdkmath.ZeroInt(),
+		UnclaimedBudget:         sdkmath.ZeroInt(),
+		DailyClaimedTotal:       sdkmath.ZeroInt(),
 	}
 	ms.Keeper.SetAirdrop(ctx, airdrop)
 
@@ -74,10 +132,17 @@ func (ms msgServer) CreateAirdrop(goCtx context.Context, msg *types.MsgCreateAir
 func (ms msgServer) UpdateAirdrop(goCtx context.Context, msg *types.MsgUpdateAirdrop) (*types.MsgUpdateAirdropResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if _, found := ms.Keeper.GetAirdrop(ctx, msg.AirdropId); !found {
+	existingAirdrop, found := ms.Keeper.GetAirdrop(ctx, msg.AirdropId)
+	if !found {
 		return nil, types.ErrAirdropNotFound.Wrapf("airdrop %s", msg.AirdropId)
 	}
 
+	// The claim and stake bonus is optional and defaults to zero
+	claimAndStakeBonus := msg.ClaimAndStakeBonus
+	if claimAndStakeBonus.IsNil() {
+		claimAndStakeBonus = sdk.ZeroDec()
+	}
+
 	airdrop := types.Airdrop{
 		Id:                    msg.AirdropId,
 		RewardDenom:           msg.RewardDenom,
@@ -86,9 +151,28 @@ func (ms msgServer) UpdateAirdrop(goCtx context.Context, msg *types.MsgUpdateAir
 		ClawbackDate:          msg.ClawbackDate,
 		ClaimTypeDeadlineDate: msg.ClaimTypeDeadlineDate,
 		EarlyClaimPenalty:     msg.EarlyClaimPenalty,
+		ClaimAndStakeBonus:    claimAndStakeBonus,
 		DistributorAddress:    msg.DistributorAddress,
 		AllocatorAddress:      msg.AllocatorAddress,
 		LinkerAddress:         msg.LinkerAddress,
+		MerkleRoot:            existingAirdrop.MerkleRoot,
+		ForfeitedPool:         existingAirdrop.GetForfeitedPool(),
+		ClawbackDestination:   msg.ClawbackDestination,
+		ClawbackAirdropId:     msg.ClawbackAirdropId,
+		RedistributeForfeited: msg.RedistributeForfeited,
+		ClawedBack:            existingAirdrop.ClawedBack,
+		ClawedBackAmount:      existingAirdrop.GetClawedBackAmount(),
+		RedistributedAmount:   existingAirdrop.GetRedistributedAmount(),
+
+		MerkleTotalAllocated:    existingAirdrop.GetMerkleTotalAllocated(),
+		MerkleInitializedAmount: existingAirdrop.GetMerkleInitializedAmount(),
+		UnclaimedBudget:         existingAirdrop.GetUnclaimedBudget(),
+		DailyClaimedTotal:       existingAirdrop.GetDailyClaimedTotal(),
+		RedistributionCursor:    existingAirdrop.RedistributionCursor,
+		RedistributionComplete:  existingAirdrop.RedistributionComplete,
+
+		// If the clawback previously failed, it will be retried with the updated config
+		ClawbackFailed: false,
 	}
 	ms.Keeper.SetAirdrop(ctx, airdrop)
 
@@ -121,14 +205,18 @@ func (ms msgServer) AddAllocations(goCtx context.Context, msg *types.MsgAddAlloc
 		}
 
 		userAllocation := types.UserAllocation{
-			AirdropId:   msg.AirdropId,
-			Address:     rawAllocation.UserAddress,
-			Claimed:     sdkmath.ZeroInt(),
-			Forfeited:   sdkmath.ZeroInt(),
-			Allocations: rawAllocation.Allocations,
+			AirdropId:       msg.AirdropId,
+			Address:         rawAllocation.UserAddress,
+			Claimed:         sdkmath.ZeroInt(),
+			Forfeited:       sdkmath.ZeroInt(),
+			Allocations:     rawAllocation.Allocations,
+			RequiredActions: rawAllocation.RequiredActions,
 		}
 		ms.Keeper.SetUserAllocation(ctx, userAllocation)
+
+		airdrop.UnclaimedBudget = airdrop.GetUnclaimedBudget().Add(userAllocation.GetRemainingAllocations())
 	}
+	ms.Keeper.SetAirdrop(ctx, airdrop)
 
 	return &types.MsgAddAllocationsResponse{}, nil
 }
@@ -155,9 +243,15 @@ func (ms msgServer) UpdateUserAllocation(goCtx context.Context, msg *types.MsgUp
 			len(userAllocation.Allocations), len(msg.Allocations))
 	}
 
+	// Adjust the airdrop's budget by the change in the user's remaining allocations
+	airdrop.UnclaimedBudget = airdrop.GetUnclaimedBudget().Sub(userAllocation.GetRemainingAllocations())
+
 	userAllocation.Allocations = msg.Allocations
 	ms.Keeper.SetUserAllocation(ctx, userAllocation)
 
+	airdrop.UnclaimedBudget = airdrop.GetUnclaimedBudget().Add(userAllocation.GetRemainingAllocations())
+	ms.Keeper.SetAirdrop(ctx, airdrop)
+
 	return &types.MsgUpdateUserAllocationResponse{}, nil
 }
 
@@ -173,9 +267,55 @@ func (ms msgServer) LinkAddresses(goCtx context.Context, msg *types.MsgLinkAddre
 		return nil, types.ErrInvalidAdminAddress.Wrapf("linking can only be performed by the linkor admin")
 	}
 
+	// If either address is only in the merkle root, initialize their allocation from the proof
+	// before linking so that the merkle allocations are included in the merged record
+	if msg.HostAllocationProof != nil {
+		err := ms.Keeper.InitializeUserAllocationFromProof(ctx, msg.AirdropId, msg.HostAddress, *msg.HostAllocationProof)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "unable to initialize host allocation")
+		}
+	}
+	if msg.StrideAllocationProof != nil {
+		err := ms.Keeper.InitializeUserAllocationFromProof(ctx, msg.AirdropId, msg.StrideAddress, *msg.StrideAllocationProof)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "unable to initialize stride allocation")
+		}
+	}
+
 	if err := ms.Keeper.LinkAddresses(ctx, msg.AirdropId, msg.StrideAddress, msg.HostAddress); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgLinkAddressesResponse{}, nil
 }
+
+// Admin transaction to commit to the airdrop's allocations with a merkle root
+// Users not already in the allocations store can then claim by providing a proof
+func (ms msgServer) SetMerkleRoot(goCtx context.Context, msg *types.MsgSetMerkleRoot) (*types.MsgSetMerkleRootResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	airdrop, found := ms.Keeper.GetAirdrop(ctx, msg.AirdropId)
+	if !found {
+		return nil, types.ErrAirdropNotFound.Wrapf("airdrop %s", msg.AirdropId)
+	}
+	if msg.Admin != airdrop.AllocatorAddress {
+		return nil, types.ErrInvalidAdminAddress.Wrapf("the merkle root can only be set by the allocator admin")
+	}
+
+	// Once allocations have been initialized from the merkle root, it can no longer be replaced
+	// since the initialized allocations would be inconsistent with the new root
+	if airdrop.GetMerkleInitializedAmount().IsPositive() {
+		return nil, types.ErrInvalidMerkleRoot.Wrapf("merkle root cannot be updated after allocations have been initialized")
+	}
+
+	// The merkle total is asserted by the admin and is not checked against the leaves of the tree
+	// Instead, the amount initialized from proofs is capped at the total as users claim
+	// The budget is adjusted by the change in the merkle total, in case a previous root was replaced
+	airdrop.UnclaimedBudget = airdrop.GetUnclaimedBudget().Sub(airdrop.GetMerkleTotalAllocated()).Add(msg.MerkleTotalAllocated)
+
+	airdrop.MerkleRoot = msg.MerkleRoot
+	airdrop.MerkleTotalAllocated = msg.MerkleTotalAllocated
+	ms.Keeper.SetAirdrop(ctx, airdrop)
+
+	return &types.MsgSetMerkleRootResponse{}, nil
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"strings"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
@@ -18,9 +19,13 @@ func (s *KeeperTestSuite) TestCreateAirdrop() {
 		ClawbackDate:          &ClawbackDate,
 		ClaimTypeDeadlineDate: &DeadlineDate,
 		EarlyClaimPenalty:     sdk.MustNewDecFromStr("0.5"),
+		ClaimAndStakeBonus:    sdk.MustNewDecFromStr("0.1"),
 		DistributorAddress:    "distributor",
 		AllocatorAddress:      "allocator",
 		LinkerAddress:         "linker",
+		ClawbackDestination:   types.CLAWBACK_TO_AIRDROP,
+		ClawbackAirdropId:     "airdrop-2",
+		RedistributeForfeited: true,
 	}
 	_, err := s.GetMsgServer().CreateAirdrop(sdk.UnwrapSDKContext(s.Ctx), &msg)
 	s.Require().NoError(err, "no error expected when creating airdrop")
@@ -33,9 +38,15 @@ func (s *KeeperTestSuite) TestCreateAirdrop() {
 	s.Require().Equal(msg.ClawbackDate, airdrop.ClawbackDate, "clawback date")
 	s.Require().Equal(msg.ClaimTypeDeadlineDate, airdrop.ClaimTypeDeadlineDate, "deadline date")
 	s.Require().Equal(msg.EarlyClaimPenalty, airdrop.EarlyClaimPenalty, "early claim penalty")
+	s.Require().Equal(msg.ClaimAndStakeBonus, airdrop.ClaimAndStakeBonus, "claim and stake bonus")
 	s.Require().Equal(msg.DistributorAddress, airdrop.DistributorAddress, "distributor address")
 	s.Require().Equal(msg.AllocatorAddress, airdrop.AllocatorAddress, "allocator address")
 	s.Require().Equal(msg.LinkerAddress, airdrop.LinkerAddress, "linker address")
+	s.Require().Equal(int64(0), airdrop.ForfeitedPool.Int64(), "forfeited pool")
+	s.Require().Equal(msg.ClawbackDestination, airdrop.ClawbackDestination, "clawback destination")
+	s.Require().Equal(msg.ClawbackAirdropId, airdrop.ClawbackAirdropId, "clawback airdrop id")
+	s.Require().True(airdrop.RedistributeForfeited, "redistribute forfeited")
+	s.Require().False(airdrop.ClawedBack, "clawed back")
 
 	// Attempt to create it again, it should fail
 	_, err = s.GetMsgServer().CreateAirdrop(sdk.UnwrapSDKContext(s.Ctx), &msg)
@@ -43,9 +54,16 @@ func (s *KeeperTestSuite) TestCreateAirdrop() {
 }
 
 func (s *KeeperTestSuite) TestUpdateAirdrop() {
-	// Create an airdrop
+	// Create an airdrop with some forfeited rewards that has already been clawed back
 	s.App.AirdropKeeper.SetAirdrop(s.Ctx, types.Airdrop{
-		Id: AirdropId,
+		Id:                  AirdropId,
+		ForfeitedPool:       sdkmath.NewInt(100),
+		ClawedBack:          true,
+		ClawedBackAmount:    sdkmath.NewInt(200),
+		RedistributedAmount: sdkmath.NewInt(300),
+		UnclaimedBudget:     sdkmath.NewInt(400),
+		DailyClaimedTotal:   sdkmath.NewInt(500),
+		ClawbackFailed:      true,
 	})
 
 	// Update the airdrop
@@ -56,9 +74,11 @@ func (s *KeeperTestSuite) TestUpdateAirdrop() {
 		ClawbackDate:          &ClawbackDate,
 		ClaimTypeDeadlineDate: &DeadlineDate,
 		EarlyClaimPenalty:     sdk.MustNewDecFromStr("0.8"),
+		ClaimAndStakeBonus:    sdk.MustNewDecFromStr("0.2"),
 		DistributorAddress:    "distributor2",
 		AllocatorAddress:      "allocator2",
 		LinkerAddress:         "linker2",
+		ClawbackDestination:   types.CLAWBACK_TO_STRD_BURNER,
 	}
 	_, err := s.GetMsgServer().UpdateAirdrop(sdk.UnwrapSDKContext(s.Ctx), &msg)
 	s.Require().NoError(err, "no error expected when updating airdrop")
@@ -71,9 +91,18 @@ func (s *KeeperTestSuite) TestUpdateAirdrop() {
 	s.Require().Equal(msg.ClawbackDate, airdrop.ClawbackDate, "clawback date")
 	s.Require().Equal(msg.ClaimTypeDeadlineDate, airdrop.ClaimTypeDeadlineDate, "deadline date")
 	s.Require().Equal(msg.EarlyClaimPenalty, airdrop.EarlyClaimPenalty, "early claim penalty")
+	s.Require().Equal(msg.ClaimAndStakeBonus, airdrop.ClaimAndStakeBonus, "claim and stake bonus")
 	s.Require().Equal(msg.DistributorAddress, airdrop.DistributorAddress, "distributor address")
 	s.Require().Equal(msg.AllocatorAddress, airdrop.AllocatorAddress, "allocator address")
 	s.Require().Equal(msg.LinkerAddress, airdrop.LinkerAddress, "linker address")
+	s.Require().Equal(int64(100), airdrop.ForfeitedPool.Int64(), "forfeited pool should be preserved")
+	s.Require().Equal(msg.ClawbackDestination, airdrop.ClawbackDestination, "clawback destination")
+	s.Require().True(airdrop.ClawedBack, "clawed back should be preserved")
+	s.Require().Equal(int64(200), airdrop.ClawedBackAmount.Int64(), "clawed back amount should be preserved")
+	s.Require().Equal(int64(300), airdrop.RedistributedAmount.Int64(), "redistributed amount should be preserved")
+	s.Require().Equal(int64(400), airdrop.UnclaimedBudget.Int64(), "unclaimed budget should be preserved")
+	s.Require().Equal(int64(500), airdrop.DailyClaimedTotal.Int64(), "daily claimed total should be preserved")
+	s.Require().False(airdrop.ClawbackFailed, "clawback failed should be reset")
 
 	// Remove the airdrop and try it again, it should error saying the airdrop doesn't exist
 	s.App.AirdropKeeper.RemoveAirdrop(s.Ctx, AirdropId)
@@ -130,6 +159,10 @@ func (s *KeeperTestSuite) TestAddAllocations() {
 		s.Require().Equal(sdkmath.ZeroInt(), userAllocation.Forfeited, "forfeited")
 	}
 
+	// Confirm the allocations were added to the airdrop's budget (1+2+3+4 + 4+5+6+7)
+	airdrop := s.MustGetAirdrop(AirdropId)
+	s.Require().Equal(int64(32), airdrop.UnclaimedBudget.Int64(), "unclaimed budget")
+
 	// Attempt to create the allocations again, it should error since the allocations already exist
 	_, err = s.GetMsgServer().AddAllocations(sdk.UnwrapSDKContext(s.Ctx), &msg)
 	s.Require().ErrorIs(err, types.ErrUserAllocationAlreadyExists)
@@ -173,6 +206,7 @@ func (s *KeeperTestSuite) TestUpdateUserAllocation() {
 	s.App.AirdropKeeper.SetAirdrop(s.Ctx, types.Airdrop{
 		Id:               AirdropId,
 		AllocatorAddress: allocator.String(),
+		UnclaimedBudget:  sdkmath.NewInt(3),
 	})
 	s.App.AirdropKeeper.SetUserAllocation(s.Ctx, types.UserAllocation{
 		AirdropId:   AirdropId,
@@ -190,6 +224,10 @@ func (s *KeeperTestSuite) TestUpdateUserAllocation() {
 	_, err := s.GetMsgServer().UpdateUserAllocation(sdk.UnwrapSDKContext(s.Ctx), &msg)
 	s.Require().NoError(err, "no error expected when updating allocation")
 
+	// Confirm the budget was adjusted by the change in allocations (1+2 -> 3+4)
+	airdrop := s.MustGetAirdrop(AirdropId)
+	s.Require().Equal(int64(7), airdrop.UnclaimedBudget.Int64(), "unclaimed budget")
+
 	// Try to update again to a different allocation length, it should fail
 	invalidMsg := msg
 	invalidMsg.Allocations = updatedAllocations[1:] // trimmed first element
@@ -281,3 +319,265 @@ func (s *KeeperTestSuite) TestMsgLinkAddresses() {
 	_, err = s.GetMsgServer().LinkAddresses(sdk.UnwrapSDKContext(s.Ctx), &invalidMsg)
 	s.Require().ErrorIs(err, types.ErrAirdropNotFound)
 }
+
+func (s *KeeperTestSuite) TestMsgLinkAddressesWithProofs() {
+	strideAddress := "stride"
+	hostAddress := "host"
+	linkerAddress := "linker"
+
+	// Build a merkle tree with 10 days of allocations for both the stride and host address
+	rawAllocations := []types.RawAllocation{}
+	for i, address := range []string{strideAddress, hostAddress} {
+		allocations := []sdkmath.Int{}
+		for day := 0; day < 10; day++ {
+			allocations = append(allocations, sdkmath.NewInt(int64(i+1)))
+		}
+		rawAllocations = append(rawAllocations, types.RawAllocation{
+			UserAddress: address,
+			Allocations: allocations,
+		})
+	}
+	merkleRoot, proofs, err := types.BuildAllocationMerkleTree(rawAllocations)
+	s.Require().NoError(err, "no error expected when building merkle tree")
+
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, types.Airdrop{
+		Id:                    AirdropId,
+		LinkerAddress:         linkerAddress,
+		DistributionStartDate: &DistributionStartDate,
+		DistributionEndDate:   &DistributionEndDate,
+		MerkleRoot:            merkleRoot,
+		MerkleTotalAllocated:  sdkmath.NewInt(30),
+	})
+
+	// Linking without the host proof should fail since the host allocation isn't in the store
+	msg := types.MsgLinkAddresses{
+		Admin:         linkerAddress,
+		AirdropId:     AirdropId,
+		StrideAddress: strideAddress,
+		HostAddress:   hostAddress,
+	}
+	_, err = s.GetMsgServer().LinkAddresses(sdk.UnwrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorIs(err, types.ErrUserAllocationNotFound)
+
+	// Link with just the host proof, the host allocation should be moved to the stride address
+	hostProof := proofs[hostAddress]
+	msg.HostAllocationProof = &hostProof
+	_, err = s.GetMsgServer().LinkAddresses(sdk.UnwrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when linking with host proof")
+
+	strideAllocation := s.MustGetUserAllocation(AirdropId, strideAddress)
+	s.Require().Equal(hostProof.Allocations, strideAllocation.Allocations, "stride allocations after link")
+
+	_, found := s.App.AirdropKeeper.GetUserAllocation(s.Ctx, AirdropId, hostAddress)
+	s.Require().False(found, "host allocation should have been removed")
+
+	// The host proof can't be used to re-create the host allocation
+	err = s.App.AirdropKeeper.InitializeUserAllocationFromProof(s.Ctx, AirdropId, hostAddress, hostProof)
+	s.Require().NoError(err, "no error expected when re-initializing host allocation")
+	_, found = s.App.AirdropKeeper.GetUserAllocation(s.Ctx, AirdropId, hostAddress)
+	s.Require().False(found, "host allocation should not have been re-created")
+
+	// The stride address's own merkle allocation should still be claimable, and merged
+	// into the linked allocation
+	strideProof := proofs[strideAddress]
+	err = s.App.AirdropKeeper.InitializeUserAllocationFromProof(s.Ctx, AirdropId, strideAddress, strideProof)
+	s.Require().NoError(err, "no error expected when initializing stride allocation")
+
+	strideAllocation = s.MustGetUserAllocation(AirdropId, strideAddress)
+	for i, allocation := range strideAllocation.Allocations {
+		s.Require().Equal(int64(3), allocation.Int64(), "stride allocation on day %d", i)
+	}
+
+	// All the merkle allocations should now be initialized
+	airdrop := s.MustGetAirdrop(AirdropId)
+	s.Require().Equal(int64(30), airdrop.GetMerkleInitializedAmount().Int64(), "merkle initialized amount")
+	s.Require().Equal(int64(0), airdrop.GetMerkleUninitializedAmount().Int64(), "merkle uninitialized amount")
+}
+
+func (s *KeeperTestSuite) TestMsgLinkAddressesWithProofs_BothMerkleOnly() {
+	strideAddress := "stride"
+	hostAddress := "host"
+	linkerAddress := "linker"
+
+	rawAllocations := []types.RawAllocation{}
+	for _, address := range []string{strideAddress, hostAddress} {
+		allocations := []sdkmath.Int{}
+		for day := 0; day < 10; day++ {
+			allocations = append(allocations, sdkmath.NewInt(5))
+		}
+		rawAllocations = append(rawAllocations, types.RawAllocation{
+			UserAddress: address,
+			Allocations: allocations,
+		})
+	}
+	merkleRoot, proofs, err := types.BuildAllocationMerkleTree(rawAllocations)
+	s.Require().NoError(err, "no error expected when building merkle tree")
+
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, types.Airdrop{
+		Id:                    AirdropId,
+		LinkerAddress:         linkerAddress,
+		DistributionStartDate: &DistributionStartDate,
+		DistributionEndDate:   &DistributionEndDate,
+		MerkleRoot:            merkleRoot,
+		MerkleTotalAllocated:  sdkmath.NewInt(100),
+	})
+
+	// Link with both proofs, the allocations should be initialized and then merged
+	hostProof := proofs[hostAddress]
+	strideProof := proofs[strideAddress]
+	msg := types.MsgLinkAddresses{
+		Admin:                 linkerAddress,
+		AirdropId:             AirdropId,
+		StrideAddress:         strideAddress,
+		HostAddress:           hostAddress,
+		HostAllocationProof:   &hostProof,
+		StrideAllocationProof: &strideProof,
+	}
+	_, err = s.GetMsgServer().LinkAddresses(sdk.UnwrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when linking with both proofs")
+
+	strideAllocation := s.MustGetUserAllocation(AirdropId, strideAddress)
+	for i, allocation := range strideAllocation.Allocations {
+		s.Require().Equal(int64(10), allocation.Int64(), "stride allocation on day %d", i)
+	}
+
+	// An invalid proof should fail the link
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, types.Airdrop{
+		Id:                    AirdropId,
+		LinkerAddress:         linkerAddress,
+		DistributionStartDate: &DistributionStartDate,
+		DistributionEndDate:   &DistributionEndDate,
+		MerkleRoot:            merkleRoot,
+	})
+	msg.HostAddress = "other-host"
+	_, err = s.GetMsgServer().LinkAddresses(sdk.UnwrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "unable to initialize host allocation")
+}
+
+func (s *KeeperTestSuite) TestMsgSetMerkleRoot() {
+	allocatorAddress := "allocator"
+	merkleRoot := strings.Repeat("ab", 32)
+
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, types.Airdrop{
+		Id:               AirdropId,
+		AllocatorAddress: allocatorAddress,
+	})
+
+	// Set the merkle root
+	msg := types.MsgSetMerkleRoot{
+		Admin:                allocatorAddress,
+		AirdropId:            AirdropId,
+		MerkleRoot:           merkleRoot,
+		MerkleTotalAllocated: sdkmath.NewInt(1000),
+	}
+	_, err := s.GetMsgServer().SetMerkleRoot(sdk.UnwrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when setting merkle root")
+	s.Require().Equal(merkleRoot, s.MustGetAirdrop(AirdropId).MerkleRoot, "merkle root")
+	s.Require().Equal(int64(1000), s.MustGetAirdrop(AirdropId).MerkleTotalAllocated.Int64(), "merkle total allocated")
+
+	// Update the airdrop, the merkle root should be preserved
+	updateMsg := types.MsgUpdateAirdrop{
+		AirdropId:             AirdropId,
+		DistributionStartDate: &DistributionStartDate,
+		DistributionEndDate:   &DistributionEndDate,
+		ClawbackDate:          &ClawbackDate,
+		ClaimTypeDeadlineDate: &DeadlineDate,
+		EarlyClaimPenalty:     sdk.MustNewDecFromStr("0.5"),
+		AllocatorAddress:      allocatorAddress,
+	}
+	_, err = s.GetMsgServer().UpdateAirdrop(sdk.UnwrapSDKContext(s.Ctx), &updateMsg)
+	s.Require().NoError(err, "no error expected when updating airdrop")
+	s.Require().Equal(merkleRoot, s.MustGetAirdrop(AirdropId).MerkleRoot, "merkle root after update")
+	s.Require().Equal(int64(1000), s.MustGetAirdrop(AirdropId).MerkleTotalAllocated.Int64(), "merkle total after update")
+
+	// Once an allocation has been initialized from the root, the root can no longer be replaced
+	airdrop := s.MustGetAirdrop(AirdropId)
+	airdrop.MerkleInitializedAmount = sdkmath.NewInt(10)
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, airdrop)
+
+	msg.MerkleRoot = strings.Repeat("cd", 32)
+	_, err = s.GetMsgServer().SetMerkleRoot(sdk.UnwrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorIs(err, types.ErrInvalidMerkleRoot)
+	s.Require().Equal(merkleRoot, s.MustGetAirdrop(AirdropId).MerkleRoot, "merkle root after failed update")
+
+	// Attempt to call it again with a non-admin address, it should fail
+	invalidMsg := msg
+	invalidMsg.Admin = "different"
+	_, err = s.GetMsgServer().SetMerkleRoot(sdk.UnwrapSDKContext(s.Ctx), &invalidMsg)
+	s.Require().ErrorIs(err, types.ErrInvalidAdminAddress)
+
+	// Remove the airdrop and try it again, it should error even sooner
+	s.App.AirdropKeeper.RemoveAirdrop(s.Ctx, AirdropId)
+	_, err = s.GetMsgServer().SetMerkleRoot(sdk.UnwrapSDKContext(s.Ctx), &invalidMsg)
+	s.Require().ErrorIs(err, types.ErrAirdropNotFound)
+}
+
+func (s *KeeperTestSuite) TestMsgClaimDailyWithProof() {
+	// Most test cases are covered in the keeper functions
+	// This is meant mainly to test that the proof is used to create the allocation
+	distributor := s.TestAccs[0]
+	claimer := s.TestAccs[1]
+	otherUser := s.TestAccs[2]
+
+	s.FundAccount(distributor, sdk.NewCoin(RewardDenom, sdkmath.NewInt(1000)))
+
+	// Build a merkle tree with 10 days of allocations for each user
+	rawAllocations := []types.RawAllocation{}
+	for _, user := range []sdk.AccAddress{claimer, otherUser} {
+		allocations := []sdkmath.Int{}
+		for day := 0; day < 10; day++ {
+			allocations = append(allocations, sdkmath.NewInt(10))
+		}
+		rawAllocations = append(rawAllocations, types.RawAllocation{
+			UserAddress: user.String(),
+			Allocations: allocations,
+		})
+	}
+	merkleRoot, proofs, err := types.BuildAllocationMerkleTree(rawAllocations)
+	s.Require().NoError(err, "no error expected when building merkle tree")
+
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, types.Airdrop{
+		Id:                    AirdropId,
+		RewardDenom:           RewardDenom,
+		DistributorAddress:    distributor.String(),
+		DistributionStartDate: &DistributionStartDate,
+		DistributionEndDate:   &DistributionEndDate,
+		ClawbackDate:          &ClawbackDate,
+		MerkleRoot:            merkleRoot,
+		MerkleTotalAllocated:  sdkmath.NewInt(200),
+	})
+
+	// Claim on the second day
+	s.Ctx = s.Ctx.WithBlockTime(DistributionStartDate.Add(time.Hour * 25))
+
+	// Attempt to claim with another user's proof, it should fail
+	otherProof := proofs[otherUser.String()]
+	msg := types.MsgClaimDaily{
+		Claimer:         claimer.String(),
+		AirdropId:       AirdropId,
+		AllocationProof: &otherProof,
+	}
+	_, err = s.GetMsgServer().ClaimDaily(sdk.UnwrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorIs(err, types.ErrInvalidMerkleProof)
+
+	// Claim with the valid proof, the allocation should be created and the first two days claimed
+	validProof := proofs[claimer.String()]
+	msg.AllocationProof = &validProof
+	_, err = s.GetMsgServer().ClaimDaily(sdk.UnwrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when claiming with proof")
+
+	userAllocation := s.MustGetUserAllocation(AirdropId, claimer.String())
+	s.Require().Equal(int64(20), userAllocation.Claimed.Int64(), "claimed")
+	s.Require().Equal(int64(20), s.App.BankKeeper.GetBalance(s.Ctx, claimer, RewardDenom).Amount.Int64(), "claimer balance")
+
+	// Claiming again with the same proof should not reset the allocation
+	_, err = s.GetMsgServer().ClaimDaily(sdk.UnwrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorIs(err, types.ErrNoUnclaimedRewards)
+
+	// Claiming without a proof for a user that's not in the store should fail
+	_, err = s.GetMsgServer().ClaimDaily(sdk.UnwrapSDKContext(s.Ctx), &types.MsgClaimDaily{
+		Claimer:   otherUser.String(),
+		AirdropId: AirdropId,
+	})
+	s.Require().ErrorIs(err, types.ErrUserAllocationNotFound)
+}
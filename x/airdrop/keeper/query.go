@@ -46,6 +46,13 @@ func (k Keeper) Airdrop(goCtx context.Context, req *types.QueryAirdropRequest) (
 		DistributorAddress:    airdrop.DistributorAddress,
 		AllocatorAddress:      airdrop.AllocatorAddress,
 		LinkerAddress:         airdrop.LinkerAddress,
+		MerkleRoot:            airdrop.MerkleRoot,
+		ClaimAndStakeBonus:    airdrop.GetClaimAndStakeBonus(),
+		ForfeitedPool:         airdrop.GetForfeitedPool(),
+		ClawbackDestination:   airdrop.ClawbackDestination,
+		ClawbackAirdropId:     airdrop.ClawbackAirdropId,
+		RedistributeForfeited: airdrop.RedistributeForfeited,
+		ClawedBack:            airdrop.ClawedBack,
 		CurrentDateIndex:      int64(currentDateIndex),
 		AirdropLength:         airdrop.GetAirdropPeriods(periodLengthSeconds),
 	}
@@ -166,3 +173,63 @@ func (k Keeper) UserSummary(goCtx context.Context, req *types.QueryUserSummaryRe
 
 	return summary, nil
 }
+
+// Queries the full accounting of an airdrop's rewards
+func (k Keeper) AirdropAccounting(goCtx context.Context, req *types.QueryAirdropAccountingRequest) (*types.QueryAirdropAccountingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	airdrop, found := k.GetAirdrop(ctx, req.AirdropId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "airdrop %s not found", req.AirdropId)
+	}
+
+	// Sum the claimed, forfeited, and remaining rewards across each user
+	totalClaimed := sdkmath.ZeroInt()
+	totalForfeited := sdkmath.ZeroInt()
+	totalUnclaimed := sdkmath.ZeroInt()
+	for _, userAllocation := range k.GetUserAllocationsForAirdrop(ctx, airdrop.Id) {
+		if userAllocation.AirdropId != airdrop.Id {
+			continue
+		}
+		totalClaimed = totalClaimed.Add(userAllocation.Claimed)
+		totalForfeited = totalForfeited.Add(userAllocation.Forfeited)
+		for _, allocation := range userAllocation.Allocations {
+			totalUnclaimed = totalUnclaimed.Add(allocation)
+		}
+	}
+
+	// Merkle allocations that have not yet been initialized into user records are still owed
+	merkleUninitialized := airdrop.GetMerkleUninitializedAmount()
+	totalUnclaimed = totalUnclaimed.Add(merkleUninitialized)
+
+	totalAllocated := totalClaimed.Add(totalForfeited).Add(totalUnclaimed)
+
+	distributorAddress, err := sdk.AccAddressFromBech32(airdrop.DistributorAddress)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	distributorBalance := k.bankKeeper.GetBalance(ctx, distributorAddress, airdrop.RewardDenom)
+
+	return &types.QueryAirdropAccountingResponse{
+		AirdropId:           airdrop.Id,
+		RewardDenom:         airdrop.RewardDenom,
+		TotalAllocated:      totalAllocated,
+		TotalClaimed:        totalClaimed,
+		TotalForfeited:      totalForfeited,
+		TotalUnclaimed:      totalUnclaimed,
+		ForfeitedPool:       airdrop.GetForfeitedPool(),
+		RedistributedAmount: airdrop.GetRedistributedAmount(),
+		ClawedBackAmount:    airdrop.GetClawedBackAmount(),
+		DistributorBalance:  distributorBalance.Amount,
+		ClawbackDestination: airdrop.ClawbackDestination,
+		ClawedBack:          airdrop.ClawedBack,
+
+		MerkleTotalAllocated: airdrop.GetMerkleTotalAllocated(),
+		MerkleUninitialized:  merkleUninitialized,
+		UnclaimedBudget:      airdrop.GetUnclaimedBudget(),
+		ClawbackFailed:       airdrop.ClawbackFailed,
+	}, nil
+}
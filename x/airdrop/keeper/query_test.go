@@ -242,3 +242,75 @@ func (s *KeeperTestSuite) TestQueryUserSummary() {
 	s.Require().NoError(err, "no error expected when querying user summary after airdrop")
 	s.Require().Equal(int64(0), resp.Claimable.Int64(), "claimable after airdrop")
 }
+
+func (s *KeeperTestSuite) TestQueryAirdropAccounting() {
+	distributor := s.TestAccs[0]
+	s.FundAccount(distributor, sdk.NewCoin(RewardDenom, sdkmath.NewInt(500)))
+
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, types.Airdrop{
+		Id:                  AirdropId,
+		RewardDenom:         RewardDenom,
+		DistributorAddress:  distributor.String(),
+		ForfeitedPool:       sdkmath.NewInt(20),
+		ClawedBackAmount:    sdkmath.ZeroInt(),
+		RedistributedAmount: sdkmath.NewInt(5),
+		ClawbackDestination: types.CLAWBACK_TO_STRD_BURNER,
+
+		// 100 tokens were committed to in the merkle root, 30 of which have already been
+		// initialized into user allocations
+		MerkleTotalAllocated:    sdkmath.NewInt(100),
+		MerkleInitializedAmount: sdkmath.NewInt(30),
+	})
+
+	// Add allocations to the airdrop, as well as an allocation for an airdrop with the same prefix
+	userAllocations := []types.UserAllocation{
+		{
+			AirdropId:   AirdropId,
+			Address:     "user-1",
+			Claimed:     sdkmath.NewInt(10),
+			Forfeited:   sdkmath.ZeroInt(),
+			Allocations: []sdkmath.Int{sdkmath.NewInt(0), sdkmath.NewInt(10), sdkmath.NewInt(10)},
+		},
+		{
+			AirdropId:   AirdropId,
+			Address:     "user-2",
+			Claimed:     sdkmath.NewInt(15),
+			Forfeited:   sdkmath.NewInt(15),
+			Allocations: []sdkmath.Int{sdkmath.NewInt(0), sdkmath.NewInt(0), sdkmath.NewInt(0)},
+		},
+		{
+			AirdropId:   AirdropId + "-2",
+			Address:     "user-1",
+			Claimed:     sdkmath.NewInt(1000),
+			Forfeited:   sdkmath.NewInt(1000),
+			Allocations: []sdkmath.Int{sdkmath.NewInt(1000)},
+		},
+	}
+	for _, userAllocation := range userAllocations {
+		s.App.AirdropKeeper.SetUserAllocation(s.Ctx, userAllocation)
+	}
+
+	req := &types.QueryAirdropAccountingRequest{AirdropId: AirdropId}
+	resp, err := s.App.AirdropKeeper.AirdropAccounting(sdk.WrapSDKContext(s.Ctx), req)
+	s.Require().NoError(err, "no error expected when querying airdrop accounting")
+
+	s.Require().Equal(AirdropId, resp.AirdropId, "airdrop id")
+	s.Require().Equal(RewardDenom, resp.RewardDenom, "reward denom")
+	s.Require().Equal(int64(10+15+15+20+70), resp.TotalAllocated.Int64(), "total allocated")
+	s.Require().Equal(int64(10+15), resp.TotalClaimed.Int64(), "total claimed")
+	s.Require().Equal(int64(15), resp.TotalForfeited.Int64(), "total forfeited")
+	s.Require().Equal(int64(20+70), resp.TotalUnclaimed.Int64(), "total unclaimed")
+	s.Require().Equal(int64(100), resp.MerkleTotalAllocated.Int64(), "merkle total allocated")
+	s.Require().Equal(int64(70), resp.MerkleUninitialized.Int64(), "merkle uninitialized")
+	s.Require().Equal(int64(20), resp.ForfeitedPool.Int64(), "forfeited pool")
+	s.Require().Equal(int64(5), resp.RedistributedAmount.Int64(), "redistributed amount")
+	s.Require().Equal(int64(0), resp.ClawedBackAmount.Int64(), "clawed back amount")
+	s.Require().Equal(int64(500), resp.DistributorBalance.Int64(), "distributor balance")
+	s.Require().Equal(types.CLAWBACK_TO_STRD_BURNER, resp.ClawbackDestination, "clawback destination")
+	s.Require().False(resp.ClawedBack, "clawed back")
+
+	// Query an airdrop that doesn't exist, it should fail
+	req = &types.QueryAirdropAccountingRequest{AirdropId: "does-not-exist"}
+	_, err = s.App.AirdropKeeper.AirdropAccounting(sdk.WrapSDKContext(s.Ctx), req)
+	s.Require().ErrorContains(err, "airdrop does-not-exist not found")
+}
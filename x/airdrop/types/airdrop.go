@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -42,3 +43,72 @@ func (a *Airdrop) GetAirdropPeriods(periodLengthSeconds int64) int64 {
 	numberOfDays := (airdropLengthSeconds / (periodLengthSeconds)) + 1
 	return numberOfDays
 }
+
+// Returns the claim and stake bonus rate, defaulting to zero if it was never set
+func (a *Airdrop) GetClaimAndStakeBonus() sdk.Dec {
+	if a.ClaimAndStakeBonus.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return a.ClaimAndStakeBonus
+}
+
+// Returns the forfeited rewards available for bonuses, defaulting to zero if it was never set
+func (a *Airdrop) GetForfeitedPool() sdkmath.Int {
+	if a.ForfeitedPool.IsNil() {
+		return sdkmath.ZeroInt()
+	}
+	return a.ForfeitedPool
+}
+
+// Returns the total rewards sent to the clawback destination, defaulting to zero if it was never set
+func (a *Airdrop) GetClawedBackAmount() sdkmath.Int {
+	if a.ClawedBackAmount.IsNil() {
+		return sdkmath.ZeroInt()
+	}
+	return a.ClawedBackAmount
+}
+
+// Returns the sum of the allocations in the merkle root, defaulting to zero if it was never set
+func (a *Airdrop) GetMerkleTotalAllocated() sdkmath.Int {
+	if a.MerkleTotalAllocated.IsNil() {
+		return sdkmath.ZeroInt()
+	}
+	return a.MerkleTotalAllocated
+}
+
+// Returns the sum of the merkle allocations that have been initialized, defaulting to zero if it was never set
+func (a *Airdrop) GetMerkleInitializedAmount() sdkmath.Int {
+	if a.MerkleInitializedAmount.IsNil() {
+		return sdkmath.ZeroInt()
+	}
+	return a.MerkleInitializedAmount
+}
+
+// Returns the merkle allocations that have not yet been initialized into user allocation records
+func (a *Airdrop) GetMerkleUninitializedAmount() sdkmath.Int {
+	return sdkmath.MaxInt(a.GetMerkleTotalAllocated().Sub(a.GetMerkleInitializedAmount()), sdkmath.ZeroInt())
+}
+
+// Returns the rewards owed by the distributor for the airdrop, defaulting to zero if it was never set
+func (a *Airdrop) GetUnclaimedBudget() sdkmath.Int {
+	if a.UnclaimedBudget.IsNil() {
+		return sdkmath.ZeroInt()
+	}
+	return a.UnclaimedBudget
+}
+
+// Returns the total claimed by users that have not forfeited rewards, defaulting to zero if it was never set
+func (a *Airdrop) GetDailyClaimedTotal() sdkmath.Int {
+	if a.DailyClaimedTotal.IsNil() {
+		return sdkmath.ZeroInt()
+	}
+	return a.DailyClaimedTotal
+}
+
+// Returns the total forfeited rewards redistributed to daily claimers, defaulting to zero if it was never set
+func (a *Airdrop) GetRedistributedAmount() sdkmath.Int {
+	if a.RedistributedAmount.IsNil() {
+		return sdkmath.ZeroInt()
+	}
+	return a.RedistributedAmount
+}
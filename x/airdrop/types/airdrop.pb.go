@@ -63,6 +63,80 @@ func (ClaimType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_49e89994d4a2aee3, []int{0}
 }
 
+// Action enum represents an on-chain action that a user must complete before
+// they can claim a tranche of their allocation
+type Action int32
+
+const (
+	// ACTION_NONE indicates the tranche does not have an action requirement
+	ACTION_NONE Action = 0
+	// ACTION_LIQUID_STAKE indicates the user must liquid stake with stakeibc
+	ACTION_LIQUID_STAKE Action = 1
+	// ACTION_DELEGATE_STAKE indicates the user must delegate native stake
+	ACTION_DELEGATE_STAKE Action = 2
+)
+
+var Action_name = map[int32]string{
+	0: "ACTION_NONE",
+	1: "ACTION_LIQUID_STAKE",
+	2: "ACTION_DELEGATE_STAKE",
+}
+
+var Action_value = map[string]int32{
+	"ACTION_NONE":           0,
+	"ACTION_LIQUID_STAKE":   1,
+	"ACTION_DELEGATE_STAKE": 2,
+}
+
+func (x Action) String() string {
+	return proto.EnumName(Action_name, int32(x))
+}
+
+func (Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_49e89994d4a2aee3, []int{1}
+}
+
+// ClawbackDestination enum represents where the remaining rewards of an
+// airdrop are sent once the clawback date is reached
+type ClawbackDestination int32
+
+const (
+	// CLAWBACK_DESTINATION_UNSPECIFIED indicates the clawback is disabled and
+	// the remaining rewards are left in the distributor
+	CLAWBACK_DESTINATION_UNSPECIFIED ClawbackDestination = 0
+	// CLAWBACK_TO_COMMUNITY_POOL indicates the remaining rewards are sent to the
+	// community pool
+	CLAWBACK_TO_COMMUNITY_POOL ClawbackDestination = 1
+	// CLAWBACK_TO_STRD_BURNER indicates the remaining rewards are sent to the
+	// strdburner module to be burned (only valid for ustrd airdrops)
+	CLAWBACK_TO_STRD_BURNER ClawbackDestination = 2
+	// CLAWBACK_TO_AIRDROP indicates the remaining rewards are sent to the
+	// distributor of another airdrop with the same reward denom
+	CLAWBACK_TO_AIRDROP ClawbackDestination = 3
+)
+
+var ClawbackDestination_name = map[int32]string{
+	0: "CLAWBACK_DESTINATION_UNSPECIFIED",
+	1: "CLAWBACK_TO_COMMUNITY_POOL",
+	2: "CLAWBACK_TO_STRD_BURNER",
+	3: "CLAWBACK_TO_AIRDROP",
+}
+
+var ClawbackDestination_value = map[string]int32{
+	"CLAWBACK_DESTINATION_UNSPECIFIED": 0,
+	"CLAWBACK_TO_COMMUNITY_POOL":       1,
+	"CLAWBACK_TO_STRD_BURNER":          2,
+	"CLAWBACK_TO_AIRDROP":              3,
+}
+
+func (x ClawbackDestination) String() string {
+	return proto.EnumName(ClawbackDestination_name, int32(x))
+}
+
+func (ClawbackDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_49e89994d4a2aee3, []int{2}
+}
+
 // Airdrop module parameters
 type Params struct {
 	// The number of seconds between each element in the allocations array
@@ -138,6 +212,16 @@ type UserAllocation struct {
 	//   *MsgClaimEarly*
 	//   Day 1: {claimed:15, forfeited:15, allocations:[0,0,0]}
 	Allocations []cosmossdk_io_math.Int `protobuf:"bytes,5,rep,name=allocations,proto3,customtype=cosmossdk.io/math.Int" json:"allocations"`
+	// Optional action requirement for each tranche in the allocations array
+	// If specified, it must be the same length as the allocations array, and
+	// each tranche can only be claimed once the action has been completed
+	//
+	// Ex:
+	//   {allocations:[10,10], required_actions:[ACTION_NONE,ACTION_LIQUID_STAKE]}
+	//   Only the first tranche can be claimed until the user liquid stakes
+	RequiredActions []Action `protobuf:"varint,6,rep,packed,name=required_actions,json=requiredActions,proto3,enum=stride.airdrop.Action" json:"required_actions,omitempty"`
+	// The actions that have been completed by the user
+	CompletedActions []Action `protobuf:"varint,7,rep,packed,name=completed_actions,json=completedActions,proto3,enum=stride.airdrop.Action" json:"completed_actions,omitempty"`
 }
 
 func (m *UserAllocation) Reset()         { *m = UserAllocation{} }
@@ -187,6 +271,20 @@ func (m *UserAllocation) GetAddress() string {
 	return ""
 }
 
+func (m *UserAllocation) GetRequiredActions() []Action {
+	if m != nil {
+		return m.RequiredActions
+	}
+	return nil
+}
+
+func (m *UserAllocation) GetCompletedActions() []Action {
+	if m != nil {
+		return m.CompletedActions
+	}
+	return nil
+}
+
 // Airdrop track the aggregate unbondings across an epoch
 type Airdrop struct {
 	// Airdrop ID
@@ -213,6 +311,53 @@ type Airdrop struct {
 	AllocatorAddress string `protobuf:"bytes,9,opt,name=allocator_address,json=allocatorAddress,proto3" json:"allocator_address,omitempty"`
 	// Admin account with permissions to link addresseses
 	LinkerAddress string `protobuf:"bytes,10,opt,name=linker_address,json=linkerAddress,proto3" json:"linker_address,omitempty"`
+	// Optional hex encoded merkle root of the (address, allocations) of each
+	// user. If set, users that do not have an allocation in the store can claim
+	// by providing a merkle proof of their allocations
+	MerkleRoot string `protobuf:"bytes,11,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// Bonus paid on top of the rewards claimed with MsgClaimAndStake, funded
+	// from the forfeited pool - e.g. 0.1 means claiming and staking will
+	// result in an extra 10% of rewards (up to the remaining forfeited pool)
+	ClaimAndStakeBonus cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=claim_and_stake_bonus,json=claimAndStakeBonus,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"claim_and_stake_bonus"`
+	// Rewards that were forfeited from early claims, and have not yet been paid
+	// out as claim and stake bonuses
+	ForfeitedPool cosmossdk_io_math.Int `protobuf:"bytes,13,opt,name=forfeited_pool,json=forfeitedPool,proto3,customtype=cosmossdk.io/math.Int" json:"forfeited_pool"`
+	// Destination of the remaining rewards in the distributor account once the
+	// clawback date is reached
+	ClawbackDestination ClawbackDestination `protobuf:"varint,14,opt,name=clawback_destination,json=clawbackDestination,proto3,enum=stride.airdrop.ClawbackDestination" json:"clawback_destination,omitempty"`
+	// ID of the airdrop that receives the remaining rewards if the clawback
+	// destination is CLAWBACK_TO_AIRDROP
+	ClawbackAirdropId string `protobuf:"bytes,15,opt,name=clawback_airdrop_id,json=clawbackAirdropId,proto3" json:"clawback_airdrop_id,omitempty"`
+	// If true, the forfeited pool is redistributed to users that claimed daily
+	// (pro rata to the amount they claimed) before the clawback occurs
+	RedistributeForfeited bool `protobuf:"varint,16,opt,name=redistribute_forfeited,json=redistributeForfeited,proto3" json:"redistribute_forfeited,omitempty"`
+	// Indicates whether the clawback has already been processed
+	ClawedBack bool `protobuf:"varint,17,opt,name=clawed_back,json=clawedBack,proto3" json:"clawed_back,omitempty"`
+	// The total rewards sent to the clawback destination
+	ClawedBackAmount cosmossdk_io_math.Int `protobuf:"bytes,18,opt,name=clawed_back_amount,json=clawedBackAmount,proto3,customtype=cosmossdk.io/math.Int" json:"clawed_back_amount"`
+	// The total forfeited rewards that were redistributed to daily claimers
+	RedistributedAmount cosmossdk_io_math.Int `protobuf:"bytes,19,opt,name=redistributed_amount,json=redistributedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"redistributed_amount"`
+	// The sum of all allocations committed to in the merkle root, as asserted by
+	// the admin (the amount initialized from proofs is capped at this total)
+	MerkleTotalAllocated cosmossdk_io_math.Int `protobuf:"bytes,20,opt,name=merkle_total_allocated,json=merkleTotalAllocated,proto3,customtype=cosmossdk.io/math.Int" json:"merkle_total_allocated"`
+	// The sum of the merkle allocations that have been initialized into user
+	// allocation records from a proof
+	MerkleInitializedAmount cosmossdk_io_math.Int `protobuf:"bytes,21,opt,name=merkle_initialized_amount,json=merkleInitializedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"merkle_initialized_amount"`
+	// The rewards owed by the distributor for this airdrop (total allocated minus
+	// total paid out). Only this amount is clawed back, since the distributor
+	// account may be shared with other airdrops
+	UnclaimedBudget cosmossdk_io_math.Int `protobuf:"bytes,22,opt,name=unclaimed_budget,json=unclaimedBudget,proto3,customtype=cosmossdk.io/math.Int" json:"unclaimed_budget"`
+	// The total claimed by users that have not forfeited any rewards, used to
+	// calculate each user's share when redistributing the forfeited pool
+	DailyClaimedTotal cosmossdk_io_math.Int `protobuf:"bytes,23,opt,name=daily_claimed_total,json=dailyClaimedTotal,proto3,customtype=cosmossdk.io/math.Int" json:"daily_claimed_total"`
+	// Address of the last user that the forfeited pool was redistributed to, so
+	// that the redistribution can be processed across multiple blocks
+	RedistributionCursor string `protobuf:"bytes,24,opt,name=redistribution_cursor,json=redistributionCursor,proto3" json:"redistribution_cursor,omitempty"`
+	// Indicates whether the forfeited pool has been redistributed to all users
+	RedistributionComplete bool `protobuf:"varint,25,opt,name=redistribution_complete,json=redistributionComplete,proto3" json:"redistribution_complete,omitempty"`
+	// Indicates the clawback failed and will not be retried until the airdrop
+	// is updated
+	ClawbackFailed bool `protobuf:"varint,26,opt,name=clawback_failed,json=clawbackFailed,proto3" json:"clawback_failed,omitempty"`
 }
 
 func (m *Airdrop) Reset()         { *m = Airdrop{} }
@@ -311,58 +456,262 @@ func (m *Airdrop) GetLinkerAddress() string {
 	return ""
 }
 
+func (m *Airdrop) GetMerkleRoot() string {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return ""
+}
+
+func (m *Airdrop) GetClawbackDestination() ClawbackDestination {
+	if m != nil {
+		return m.ClawbackDestination
+	}
+	return CLAWBACK_DESTINATION_UNSPECIFIED
+}
+
+func (m *Airdrop) GetClawbackAirdropId() string {
+	if m != nil {
+		return m.ClawbackAirdropId
+	}
+	return ""
+}
+
+func (m *Airdrop) GetRedistributeForfeited() bool {
+	if m != nil {
+		return m.RedistributeForfeited
+	}
+	return false
+}
+
+func (m *Airdrop) GetClawedBack() bool {
+	if m != nil {
+		return m.ClawedBack
+	}
+	return false
+}
+
+func (m *Airdrop) GetRedistributionCursor() string {
+	if m != nil {
+		return m.RedistributionCursor
+	}
+	return ""
+}
+
+func (m *Airdrop) GetRedistributionComplete() bool {
+	if m != nil {
+		return m.RedistributionComplete
+	}
+	return false
+}
+
+func (m *Airdrop) GetClawbackFailed() bool {
+	if m != nil {
+		return m.ClawbackFailed
+	}
+	return false
+}
+
+// AllocationProof is provided by a user on their first claim from an airdrop
+// with a merkle root, to prove their allocations are included in the root
+type AllocationProof struct {
+	// The user's allocations, where each element represents the rewards for a
+	// day
+	Allocations []cosmossdk_io_math.Int `protobuf:"bytes,1,rep,name=allocations,proto3,customtype=cosmossdk.io/math.Int" json:"allocations"`
+	// Hex encoded sibling hashes from the user's leaf up to the merkle root
+	Proof []string `protobuf:"bytes,2,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *AllocationProof) Reset()         { *m = AllocationProof{} }
+func (m *AllocationProof) String() string { return proto.CompactTextString(m) }
+func (*AllocationProof) ProtoMessage()    {}
+func (*AllocationProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49e89994d4a2aee3, []int{3}
+}
+func (m *AllocationProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllocationProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllocationProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllocationProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllocationProof.Merge(m, src)
+}
+func (m *AllocationProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllocationProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllocationProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllocationProof proto.InternalMessageInfo
+
+func (m *AllocationProof) GetProof() []string {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// MerkleAllocationInitialization records that an address's merkle allocation
+// has already been initialized from a proof, so that the proof cannot be
+// reused (e.g. after the address is linked)
+type MerkleAllocationInitialization struct {
+	// Airdrop ID
+	AirdropId string `protobuf:"bytes,1,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+	// Address whose merkle allocation was initialized
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MerkleAllocationInitialization) Reset()         { *m = MerkleAllocationInitialization{} }
+func (m *MerkleAllocationInitialization) String() string { return proto.CompactTextString(m) }
+func (*MerkleAllocationInitialization) ProtoMessage()    {}
+func (*MerkleAllocationInitialization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49e89994d4a2aee3, []int{4}
+}
+func (m *MerkleAllocationInitialization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MerkleAllocationInitialization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MerkleAllocationInitialization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MerkleAllocationInitialization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerkleAllocationInitialization.Merge(m, src)
+}
+func (m *MerkleAllocationInitialization) XXX_Size() int {
+	return m.Size()
+}
+func (m *MerkleAllocationInitialization) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerkleAllocationInitialization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerkleAllocationInitialization proto.InternalMessageInfo
+
+func (m *MerkleAllocationInitialization) GetAirdropId() string {
+	if m != nil {
+		return m.AirdropId
+	}
+	return ""
+}
+
+func (m *MerkleAllocationInitialization) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("stride.airdrop.ClaimType", ClaimType_name, ClaimType_value)
+	proto.RegisterEnum("stride.airdrop.Action", Action_name, Action_value)
+	proto.RegisterEnum("stride.airdrop.ClawbackDestination", ClawbackDestination_name, ClawbackDestination_value)
 	proto.RegisterType((*Params)(nil), "stride.airdrop.Params")
 	proto.RegisterType((*UserAllocation)(nil), "stride.airdrop.UserAllocation")
 	proto.RegisterType((*Airdrop)(nil), "stride.airdrop.Airdrop")
+	proto.RegisterType((*AllocationProof)(nil), "stride.airdrop.AllocationProof")
+	proto.RegisterType((*MerkleAllocationInitialization)(nil), "stride.airdrop.MerkleAllocationInitialization")
 }
 
 func init() { proto.RegisterFile("stride/airdrop/airdrop.proto", fileDescriptor_49e89994d4a2aee3) }
 
 var fileDescriptor_49e89994d4a2aee3 = []byte{
-	// 654 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xc1, 0x4e, 0xdb, 0x4a,
-	0x14, 0x86, 0xe3, 0x10, 0xc8, 0xcd, 0x04, 0x72, 0x61, 0x00, 0x5d, 0xdf, 0xdc, 0x8b, 0x43, 0xe9,
-	0xa6, 0xaa, 0x84, 0xad, 0x86, 0x05, 0x8b, 0x56, 0x42, 0x81, 0x64, 0x11, 0x35, 0x95, 0x90, 0x43,
-	0xa5, 0xd2, 0xcd, 0x68, 0xe2, 0x19, 0xcc, 0x08, 0xdb, 0x13, 0xcd, 0x0c, 0xa5, 0x79, 0x83, 0x2e,
-	0x59, 0x77, 0xdb, 0x57, 0xa8, 0xfa, 0x0c, 0x2c, 0x51, 0x57, 0x55, 0x17, 0xb4, 0x82, 0x17, 0xa9,
-	0x3c, 0x63, 0x27, 0xa9, 0xba, 0x48, 0x56, 0x89, 0xcf, 0x7f, 0xfe, 0xcf, 0x9e, 0xf3, 0x1f, 0x0d,
-	0xf8, 0x5f, 0x2a, 0xc1, 0x08, 0xf5, 0x30, 0x13, 0x44, 0xf0, 0x61, 0xfe, 0xeb, 0x0e, 0x05, 0x57,
-	0x1c, 0xd6, 0x8c, 0xea, 0x66, 0xd5, 0xfa, 0xbf, 0x01, 0x97, 0x31, 0x97, 0x48, 0xab, 0x9e, 0x79,
-	0x30, 0xad, 0xf5, 0x8d, 0x90, 0x87, 0xdc, 0xd4, 0xd3, 0x7f, 0x59, 0xb5, 0x11, 0x72, 0x1e, 0x46,
-	0xd4, 0xd3, 0x4f, 0x83, 0xcb, 0x33, 0x4f, 0xb1, 0x98, 0x4a, 0x85, 0xe3, 0xec, 0x0d, 0x3b, 0x2f,
-	0xc0, 0xd2, 0x31, 0x16, 0x38, 0x96, 0xb0, 0x09, 0x36, 0x87, 0x54, 0x30, 0x4e, 0x50, 0x44, 0x93,
-	0x50, 0x9d, 0x23, 0x49, 0x03, 0x9e, 0x10, 0x69, 0x5b, 0xdb, 0xd6, 0x93, 0x05, 0x7f, 0xdd, 0x88,
-	0x3d, 0xad, 0xf5, 0x8d, 0xb4, 0xf3, 0xb1, 0x08, 0x6a, 0xaf, 0x25, 0x15, 0xad, 0x28, 0xe2, 0x01,
-	0x56, 0x8c, 0x27, 0x70, 0x0b, 0x80, 0xec, 0x6b, 0x11, 0x23, 0xda, 0x5b, 0xf1, 0x2b, 0x59, 0xa5,
-	0x4b, 0x60, 0x13, 0x94, 0x31, 0x21, 0x82, 0x4a, 0x69, 0x17, 0x53, 0xed, 0xd0, 0xfe, 0xfa, 0x79,
-	0x77, 0x23, 0x3b, 0x49, 0xcb, 0x28, 0x7d, 0x25, 0x58, 0x12, 0xfa, 0x79, 0x23, 0xdc, 0x07, 0xe5,
-	0x20, 0xc2, 0x2c, 0xa6, 0xc4, 0x5e, 0xd0, 0x9e, 0xad, 0x9b, 0xbb, 0x46, 0xe1, 0xfb, 0x5d, 0x63,
-	0xd3, 0xf8, 0x24, 0xb9, 0x70, 0x19, 0xf7, 0x62, 0xac, 0xce, 0xdd, 0x6e, 0xa2, 0xfc, 0xbc, 0x1b,
-	0x3e, 0x07, 0x95, 0x33, 0x2e, 0xce, 0x28, 0x53, 0x94, 0xd8, 0xa5, 0x79, 0xac, 0x93, 0x7e, 0x78,
-	0x00, 0xaa, 0x78, 0x7c, 0x2c, 0x69, 0x2f, 0x6e, 0x2f, 0xcc, 0xb6, 0x4f, 0x3b, 0x76, 0xbe, 0x2c,
-	0x82, 0x72, 0xcb, 0x1c, 0x1c, 0xd6, 0x40, 0x71, 0x3c, 0x8d, 0x22, 0x23, 0xf0, 0x11, 0x58, 0x16,
-	0xf4, 0x0a, 0x0b, 0x82, 0x08, 0x4d, 0x78, 0x6c, 0x66, 0xe1, 0x57, 0x4d, 0xad, 0x9d, 0x96, 0xe0,
-	0x1b, 0xf0, 0x0f, 0x61, 0x69, 0xfe, 0x83, 0xcb, 0x94, 0x87, 0xa4, 0xc2, 0x42, 0x21, 0x82, 0x15,
-	0xd5, 0x53, 0xa8, 0x36, 0xeb, 0xae, 0x09, 0xd7, 0xcd, 0xc3, 0x75, 0x4f, 0xf2, 0x70, 0x0f, 0x4b,
-	0xd7, 0x3f, 0x1a, 0x96, 0xbf, 0x39, 0x0d, 0xe8, 0xa7, 0xfe, 0x36, 0x56, 0x14, 0x9e, 0x80, 0xdf,
-	0x04, 0x44, 0x13, 0x62, 0xb8, 0xa5, 0x39, 0xb9, 0xeb, 0xd3, 0xf6, 0x4e, 0x42, 0x34, 0xb5, 0x03,
-	0x56, 0x82, 0x08, 0x5f, 0x0d, 0x70, 0x70, 0x61, 0x68, 0x8b, 0x73, 0xd2, 0x96, 0x73, 0x9b, 0xc6,
-	0x9c, 0x02, 0x5b, 0xc7, 0x87, 0xd4, 0x68, 0x48, 0x11, 0xa1, 0x98, 0x44, 0x2c, 0xa1, 0x86, 0xb8,
-	0x34, 0xef, 0xb9, 0x35, 0xe1, 0x64, 0x34, 0xa4, 0xed, 0xcc, 0xaf, 0xd1, 0x7d, 0xb0, 0x4e, 0xb1,
-	0x88, 0x46, 0xc8, 0xbc, 0x60, 0x48, 0x13, 0x1c, 0xa9, 0x91, 0x5d, 0xd6, 0x8b, 0xf1, 0x38, 0x4b,
-	0xf6, 0xbf, 0x3f, 0x93, 0xed, 0xd1, 0x10, 0x07, 0xa3, 0x36, 0x0d, 0xfc, 0x35, 0xed, 0x3f, 0x4a,
-	0xed, 0xc7, 0xc6, 0x0d, 0xbb, 0x60, 0x32, 0x0d, 0x2e, 0x50, 0xbe, 0xdc, 0x7f, 0xcd, 0x58, 0x6e,
-	0x38, 0x65, 0xca, 0x14, 0xd8, 0x01, 0x6b, 0xd9, 0xfe, 0x4c, 0x81, 0x2a, 0x33, 0x40, 0xab, 0x63,
-	0x4b, 0x8e, 0x39, 0x00, 0xb5, 0x88, 0x25, 0x17, 0x74, 0xc2, 0x00, 0x33, 0x18, 0x2b, 0xa6, 0x3f,
-	0x2b, 0x3e, 0xdd, 0x03, 0x95, 0xa3, 0x7c, 0x80, 0xf0, 0x6f, 0x50, 0x3d, 0xea, 0xb5, 0xba, 0xaf,
-	0x50, 0xbb, 0xd5, 0xed, 0x9d, 0xae, 0x16, 0x26, 0x85, 0x4e, 0xcb, 0xef, 0x9d, 0xae, 0x5a, 0xf5,
-	0xd2, 0x87, 0x4f, 0x4e, 0xe1, 0xf0, 0xe5, 0xcd, 0xbd, 0x63, 0xdd, 0xde, 0x3b, 0xd6, 0xcf, 0x7b,
-	0xc7, 0xba, 0x7e, 0x70, 0x0a, 0xb7, 0x0f, 0x4e, 0xe1, 0xdb, 0x83, 0x53, 0x78, 0xfb, 0x2c, 0x64,
-	0xea, 0xfc, 0x72, 0xe0, 0x06, 0x3c, 0xf6, 0xfa, 0xfa, 0x3e, 0xdb, 0xed, 0xe1, 0x81, 0xf4, 0xb2,
-	0x9b, 0xef, 0x5d, 0x73, 0xdf, 0x7b, 0x3f, 0xbe, 0xff, 0xd2, 0xd4, 0xe5, 0x60, 0x49, 0x47, 0xbb,
-	0xf7, 0x2b, 0x00, 0x00, 0xff, 0xff, 0xcc, 0x2f, 0x43, 0xcc, 0x1e, 0x05, 0x00, 0x00,
+	// 1233 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xcf, 0x6e, 0xdb, 0xc6,
+	0x16, 0xc6, 0x25, 0xdb, 0xb1, 0xe3, 0xe3, 0x58, 0xa6, 0x47, 0xb2, 0x4d, 0x3b, 0x37, 0xb2, 0xaf,
+	0x53, 0xa0, 0x46, 0x80, 0x48, 0xa8, 0x83, 0x22, 0x8b, 0x16, 0x08, 0x28, 0x89, 0x69, 0x09, 0xcb,
+	0x92, 0x4a, 0xc9, 0x69, 0xdd, 0xcd, 0x60, 0xc4, 0x19, 0xcb, 0x84, 0x29, 0x8e, 0x4a, 0x8e, 0x9a,
+	0xba, 0x4f, 0xd0, 0x65, 0x76, 0xdd, 0xb7, 0xaf, 0xd0, 0x87, 0xc8, 0x32, 0x28, 0xba, 0x28, 0xba,
+	0x48, 0x8b, 0xf8, 0x45, 0x0a, 0xce, 0x90, 0x14, 0xed, 0x14, 0x35, 0xbb, 0x92, 0x78, 0xbe, 0xf3,
+	0xfd, 0x38, 0x7f, 0x0e, 0xcf, 0x0c, 0xfc, 0x2f, 0x14, 0x81, 0x4b, 0x59, 0x9d, 0xb8, 0x01, 0x0d,
+	0xf8, 0x24, 0xf9, 0xad, 0x4d, 0x02, 0x2e, 0x38, 0x2a, 0x29, 0xb5, 0x16, 0x47, 0x77, 0xb6, 0x1d,
+	0x1e, 0x8e, 0x79, 0x88, 0xa5, 0x5a, 0x57, 0x0f, 0x2a, 0x75, 0xa7, 0x32, 0xe2, 0x23, 0xae, 0xe2,
+	0xd1, 0xbf, 0x38, 0xba, 0x3b, 0xe2, 0x7c, 0xe4, 0xb1, 0xba, 0x7c, 0x1a, 0x4e, 0xcf, 0xea, 0xc2,
+	0x1d, 0xb3, 0x50, 0x90, 0x71, 0xfc, 0x86, 0xfd, 0x4f, 0x61, 0xb1, 0x47, 0x02, 0x32, 0x0e, 0xd1,
+	0x21, 0x6c, 0x4c, 0x58, 0xe0, 0x72, 0x8a, 0x3d, 0xe6, 0x8f, 0xc4, 0x39, 0x0e, 0x99, 0xc3, 0x7d,
+	0x1a, 0xea, 0xc5, 0xbd, 0xe2, 0xc1, 0xbc, 0x5d, 0x56, 0x62, 0x5b, 0x6a, 0x7d, 0x25, 0xed, 0xff,
+	0x34, 0x0f, 0xa5, 0x93, 0x90, 0x05, 0x86, 0xe7, 0x71, 0x87, 0x08, 0x97, 0xfb, 0xe8, 0x01, 0x40,
+	0x3c, 0x5a, 0xec, 0x52, 0xe9, 0x5d, 0xb6, 0x97, 0xe3, 0x88, 0x45, 0xd1, 0x21, 0x2c, 0x11, 0x4a,
+	0x03, 0x16, 0x86, 0xfa, 0x5c, 0xa4, 0x35, 0xf4, 0x5f, 0x7f, 0x79, 0x5c, 0x89, 0x67, 0x62, 0x28,
+	0xa5, 0x2f, 0x02, 0xd7, 0x1f, 0xd9, 0x49, 0x22, 0x7a, 0x0a, 0x4b, 0x8e, 0x47, 0xdc, 0x31, 0xa3,
+	0xfa, 0xbc, 0xf4, 0x3c, 0x78, 0xfd, 0x76, 0xb7, 0xf0, 0xc7, 0xdb, 0xdd, 0x0d, 0xe5, 0x0b, 0xe9,
+	0x45, 0xcd, 0xe5, 0xf5, 0x31, 0x11, 0xe7, 0x35, 0xcb, 0x17, 0x76, 0x92, 0x8d, 0x3e, 0x81, 0xe5,
+	0x33, 0x1e, 0x9c, 0x31, 0x57, 0x30, 0xaa, 0x2f, 0xe4, 0xb1, 0xce, 0xf2, 0xd1, 0x33, 0x58, 0x21,
+	0xe9, 0xb4, 0x42, 0xfd, 0xce, 0xde, 0xfc, 0xed, 0xf6, 0xac, 0x03, 0x19, 0xa0, 0x05, 0xec, 0x9b,
+	0xa9, 0x1b, 0x30, 0x8a, 0x89, 0xa3, 0x28, 0x8b, 0x7b, 0xf3, 0x07, 0xa5, 0xc3, 0xcd, 0xda, 0xf5,
+	0x7d, 0xad, 0x19, 0x52, 0xb6, 0xd7, 0x92, 0x7c, 0xf5, 0x1c, 0xa2, 0x26, 0xac, 0x3b, 0x7c, 0x3c,
+	0xf1, 0x98, 0xc8, 0x30, 0x96, 0xfe, 0x95, 0xa1, 0xa5, 0x86, 0x18, 0xb2, 0xff, 0xdb, 0x2a, 0x2c,
+	0x19, 0x2a, 0x09, 0x95, 0x60, 0x2e, 0xdd, 0x95, 0x39, 0x97, 0xa2, 0xff, 0xc3, 0xbd, 0x80, 0xbd,
+	0x24, 0x01, 0xc5, 0x94, 0xf9, 0x7c, 0xac, 0xf6, 0xc4, 0x5e, 0x51, 0xb1, 0x56, 0x14, 0x42, 0x5f,
+	0xc1, 0x16, 0x75, 0xa3, 0x77, 0x0d, 0xa7, 0x11, 0x0f, 0x87, 0x82, 0x04, 0x02, 0x53, 0x22, 0x98,
+	0xdc, 0x8d, 0x95, 0xc3, 0x9d, 0x9a, 0x2a, 0xb2, 0x5a, 0x52, 0x64, 0xb5, 0x41, 0x52, 0x64, 0x8d,
+	0x85, 0x57, 0x7f, 0xee, 0x16, 0xed, 0x8d, 0x2c, 0xa0, 0x1f, 0xf9, 0x5b, 0x44, 0x30, 0x34, 0x80,
+	0x6b, 0x02, 0x66, 0x3e, 0x55, 0xdc, 0x85, 0x9c, 0xdc, 0x72, 0xd6, 0x6e, 0xfa, 0x54, 0x52, 0x4d,
+	0x58, 0x75, 0x3c, 0xf2, 0x72, 0x48, 0x9c, 0x0b, 0x45, 0xbb, 0x93, 0x93, 0x76, 0x2f, 0xb1, 0x49,
+	0xcc, 0x29, 0xe8, 0xb2, 0x8c, 0xb0, 0xb8, 0x9c, 0x30, 0x4c, 0x19, 0xa1, 0x9e, 0xeb, 0x33, 0x45,
+	0x5c, 0xcc, 0x3b, 0x6f, 0x49, 0x18, 0x5c, 0x4e, 0x58, 0x2b, 0xf6, 0x4b, 0x74, 0x1f, 0xca, 0x8c,
+	0x04, 0xde, 0x25, 0x56, 0x2f, 0x98, 0x30, 0x9f, 0x78, 0xe2, 0x52, 0x5f, 0x92, 0x05, 0xfa, 0x30,
+	0xae, 0xb0, 0xfb, 0xef, 0x57, 0x58, 0x9b, 0x8d, 0x88, 0x73, 0xd9, 0x62, 0x8e, 0xbd, 0x2e, 0xfd,
+	0xcd, 0xc8, 0xde, 0x53, 0x6e, 0x64, 0xc1, 0x6c, 0x35, 0x78, 0x80, 0x93, 0x8f, 0xec, 0xee, 0x2d,
+	0x1f, 0x19, 0xca, 0x98, 0x62, 0x05, 0x99, 0xb0, 0x1e, 0xd7, 0x71, 0x06, 0xb4, 0x7c, 0x0b, 0x48,
+	0x4b, 0x2d, 0x09, 0xe6, 0x19, 0x94, 0x3c, 0xd7, 0xbf, 0x60, 0x33, 0x06, 0xdc, 0xc2, 0x58, 0x55,
+	0xf9, 0x09, 0x60, 0x17, 0x56, 0xc6, 0x2c, 0xb8, 0xf0, 0x18, 0x0e, 0x38, 0x17, 0xfa, 0x8a, 0xac,
+	0x4d, 0x50, 0x21, 0x9b, 0x73, 0x81, 0x5e, 0x80, 0x5a, 0x61, 0x4c, 0x7c, 0x1a, 0xd5, 0xe5, 0x05,
+	0xc3, 0x43, 0xee, 0x4f, 0x43, 0xfd, 0x5e, 0xfe, 0xa5, 0x44, 0x92, 0x60, 0xf8, 0xb4, 0x1f, 0xf9,
+	0x1b, 0x91, 0x1d, 0xb5, 0xa0, 0x94, 0xf6, 0x01, 0x3c, 0xe1, 0xdc, 0xd3, 0x57, 0xf3, 0x34, 0x8f,
+	0xd5, 0xd4, 0xd4, 0xe3, 0xdc, 0x43, 0x2f, 0xa0, 0x32, 0x2b, 0x44, 0x16, 0x0a, 0xd7, 0x97, 0x8d,
+	0x41, 0x2f, 0xed, 0x15, 0x0f, 0x4a, 0x87, 0x0f, 0x6f, 0x7e, 0xbf, 0xcd, 0xa4, 0xfa, 0x66, 0xa9,
+	0x76, 0xd9, 0x79, 0x3f, 0x88, 0x6a, 0x90, 0x86, 0x71, 0xa6, 0xd5, 0xae, 0xc9, 0xe5, 0x59, 0x4f,
+	0x24, 0x23, 0x6d, 0xb9, 0x1f, 0xc3, 0x66, 0xc0, 0xd2, 0x6d, 0x66, 0x78, 0xd6, 0x12, 0xb5, 0xbd,
+	0xe2, 0xc1, 0x5d, 0x7b, 0x23, 0xab, 0x3e, 0x4f, 0xc4, 0x68, 0xf5, 0x23, 0x16, 0xa3, 0x38, 0xc2,
+	0xe9, 0xeb, 0x32, 0x17, 0x54, 0xa8, 0x41, 0x9c, 0x0b, 0x74, 0x04, 0x28, 0x93, 0x80, 0xc9, 0x98,
+	0x4f, 0x7d, 0xa1, 0xa3, 0x3c, 0x2b, 0xa5, 0xcd, 0x30, 0x86, 0xb4, 0xa1, 0x1e, 0x54, 0xb2, 0xc3,
+	0xa0, 0x09, 0xae, 0x9c, 0x07, 0x57, 0xbe, 0x66, 0x8d, 0x89, 0x7d, 0xd8, 0x8c, 0xab, 0x47, 0x70,
+	0x41, 0x3c, 0x1c, 0xd7, 0x27, 0xa3, 0x7a, 0x25, 0x0f, 0xb3, 0xa2, 0xcc, 0x83, 0xc8, 0x6b, 0x24,
+	0x56, 0x74, 0x0a, 0xdb, 0x31, 0xd4, 0xf5, 0x5d, 0xe1, 0x12, 0xcf, 0xfd, 0x7e, 0x36, 0xd6, 0x8d,
+	0x3c, 0xdc, 0x2d, 0xe5, 0xb7, 0x66, 0xf6, 0x78, 0xbc, 0x9f, 0x83, 0x36, 0xf5, 0xe3, 0x93, 0x0b,
+	0x0f, 0xa7, 0x74, 0xc4, 0x84, 0xbe, 0x99, 0x87, 0xb8, 0x96, 0xda, 0x1a, 0xd2, 0x85, 0x8e, 0xa1,
+	0x4c, 0x89, 0x9b, 0xf4, 0x17, 0x46, 0xd5, 0x02, 0xe8, 0x5b, 0x79, 0x60, 0xeb, 0xd2, 0xd9, 0x54,
+	0x46, 0x39, 0x79, 0xf4, 0x04, 0xb2, 0x15, 0x12, 0x35, 0x6a, 0x67, 0x1a, 0x84, 0x3c, 0xd0, 0x75,
+	0x59, 0x71, 0x95, 0xeb, 0x62, 0x53, 0x6a, 0xe8, 0x29, 0x6c, 0xdd, 0x34, 0xc5, 0xe7, 0x92, 0xbe,
+	0x2d, 0x2b, 0x69, 0xf3, 0x86, 0x2d, 0x56, 0xd1, 0x87, 0xb0, 0x96, 0x56, 0xf7, 0x19, 0x71, 0x3d,
+	0x46, 0xf5, 0x1d, 0x69, 0x28, 0x25, 0xe1, 0xe7, 0x32, 0xba, 0x7f, 0x0e, 0x6b, 0xb3, 0x6b, 0x47,
+	0x2f, 0xe0, 0xfc, 0xec, 0xe6, 0x91, 0x5d, 0xfc, 0xcf, 0x47, 0x76, 0x05, 0xee, 0x4c, 0x22, 0x92,
+	0x3e, 0x17, 0x59, 0x6d, 0xf5, 0xb0, 0x7f, 0x0a, 0xd5, 0x63, 0xb9, 0x69, 0xb3, 0xf7, 0xa5, 0xdb,
+	0x97, 0xeb, 0xd2, 0xa3, 0xdf, 0xb8, 0xf4, 0xa4, 0x57, 0x9b, 0x47, 0x4f, 0x60, 0xb9, 0x99, 0x9c,
+	0x11, 0x68, 0x0d, 0x56, 0x9a, 0x6d, 0xc3, 0x3a, 0xc6, 0x2d, 0xc3, 0x6a, 0x9f, 0x6a, 0x85, 0x59,
+	0xc0, 0x34, 0xec, 0xf6, 0xa9, 0x56, 0xdc, 0x59, 0xf8, 0xe1, 0xe7, 0x6a, 0xe1, 0x51, 0x1f, 0x16,
+	0xd5, 0xd9, 0x1e, 0x25, 0x18, 0xcd, 0x81, 0xd5, 0xed, 0xe0, 0x4e, 0xb7, 0x63, 0x6a, 0x05, 0xb4,
+	0x05, 0xe5, 0x38, 0xd0, 0xb6, 0xbe, 0x38, 0xb1, 0x5a, 0xb8, 0x3f, 0x30, 0x8e, 0x4c, 0xad, 0x88,
+	0xb6, 0x61, 0x23, 0x16, 0x5a, 0x66, 0xdb, 0xfc, 0xcc, 0x18, 0x98, 0xb1, 0x34, 0x17, 0x43, 0x7f,
+	0x2c, 0x42, 0xf9, 0x1f, 0x5a, 0x10, 0xfa, 0x00, 0xf6, 0x9a, 0x6d, 0xe3, 0xcb, 0x86, 0xd1, 0x3c,
+	0xc2, 0x2d, 0xb3, 0x3f, 0xb0, 0x3a, 0x86, 0xc4, 0x9c, 0x74, 0xfa, 0x3d, 0xb3, 0x69, 0x3d, 0xb7,
+	0xcc, 0x96, 0x56, 0x40, 0x55, 0xd8, 0x49, 0xb3, 0x06, 0x5d, 0xdc, 0xec, 0x1e, 0x1f, 0x9f, 0x74,
+	0xac, 0xc1, 0x29, 0xee, 0x75, 0xbb, 0x6d, 0xad, 0x88, 0xee, 0xc3, 0x56, 0x56, 0xef, 0x0f, 0xec,
+	0x16, 0x6e, 0x9c, 0xd8, 0x1d, 0xd3, 0xd6, 0xe6, 0xa2, 0x41, 0x67, 0x45, 0xc3, 0xb2, 0x5b, 0x76,
+	0xb7, 0xa7, 0xcd, 0xab, 0x91, 0x35, 0x8e, 0x5e, 0xbf, 0xab, 0x16, 0xdf, 0xbc, 0xab, 0x16, 0xff,
+	0x7a, 0x57, 0x2d, 0xbe, 0xba, 0xaa, 0x16, 0xde, 0x5c, 0x55, 0x0b, 0xbf, 0x5f, 0x55, 0x0b, 0x5f,
+	0x7f, 0x34, 0x72, 0xc5, 0xf9, 0x74, 0x58, 0x73, 0xf8, 0xb8, 0xde, 0x97, 0xdd, 0xf4, 0x71, 0x9b,
+	0x0c, 0xc3, 0x7a, 0x7c, 0xa7, 0xfe, 0xf6, 0xf0, 0x69, 0xfd, 0xbb, 0xf4, 0x66, 0x1d, 0x9d, 0xe3,
+	0xe1, 0x70, 0x51, 0x1e, 0xd6, 0x4f, 0xfe, 0x1e, 0x00, 0xc6, 0x3e, 0xb2, 0xac, 0x78, 0x0b, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -413,6 +762,42 @@ func (m *UserAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CompletedActions) > 0 {
+		dAtA2 := make([]byte, len(m.CompletedActions)*10)
+		var j1 int
+		for _, num := range m.CompletedActions {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintAirdrop(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.RequiredActions) > 0 {
+		dAtA4 := make([]byte, len(m.RequiredActions)*10)
+		var j3 int
+		for _, num := range m.RequiredActions {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintAirdrop(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Allocations) > 0 {
 		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -484,6 +869,174 @@ func (m *Airdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ClawbackFailed {
+		i--
+		if m.ClawbackFailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.RedistributionComplete {
+		i--
+		if m.RedistributionComplete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if len(m.RedistributionCursor) > 0 {
+		i -= len(m.RedistributionCursor)
+		copy(dAtA[i:], m.RedistributionCursor)
+		i = encodeVarintAirdrop(dAtA, i, uint64(len(m.RedistributionCursor)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	{
+		size := m.DailyClaimedTotal.Size()
+		i -= size
+		if _, err := m.DailyClaimedTotal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAirdrop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	{
+		size := m.UnclaimedBudget.Size()
+		i -= size
+		if _, err := m.UnclaimedBudget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAirdrop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	{
+		size := m.MerkleInitializedAmount.Size()
+		i -= size
+		if _, err := m.MerkleInitializedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAirdrop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	{
+		size := m.MerkleTotalAllocated.Size()
+		i -= size
+		if _, err := m.MerkleTotalAllocated.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAirdrop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	{
+		size := m.RedistributedAmount.Size()
+		i -= size
+		if _, err := m.RedistributedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAirdrop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	{
+		size := m.ClawedBackAmount.Size()
+		i -= size
+		if _, err := m.ClawedBackAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAirdrop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if m.ClawedBack {
+		i--
+		if m.ClawedBack {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.RedistributeForfeited {
+		i--
+		if m.RedistributeForfeited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.ClawbackAirdropId) > 0 {
+		i -= len(m.ClawbackAirdropId)
+		copy(dAtA[i:], m.ClawbackAirdropId)
+		i = encodeVarintAirdrop(dAtA, i, uint64(len(m.ClawbackAirdropId)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.ClawbackDestination != 0 {
+		i = encodeVarintAirdrop(dAtA, i, uint64(m.ClawbackDestination))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size := m.ForfeitedPool.Size()
+		i -= size
+		if _, err := m.ForfeitedPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAirdrop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.ClaimAndStakeBonus.Size()
+		i -= size
+		if _, err := m.ClaimAndStakeBonus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAirdrop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintAirdrop(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.LinkerAddress) > 0 {
 		i -= len(m.LinkerAddress)
 		copy(dAtA[i:], m.LinkerAddress)
@@ -516,42 +1069,42 @@ func (m *Airdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x3a
 	if m.ClaimTypeDeadlineDate != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ClaimTypeDeadlineDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClaimTypeDeadlineDate):])
-		if err1 != nil {
-			return 0, err1
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ClaimTypeDeadlineDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClaimTypeDeadlineDate):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n1
-		i = encodeVarintAirdrop(dAtA, i, uint64(n1))
+		i -= n5
+		i = encodeVarintAirdrop(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x32
 	}
 	if m.ClawbackDate != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ClawbackDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClawbackDate):])
-		if err2 != nil {
-			return 0, err2
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ClawbackDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClawbackDate):])
+		if err6 != nil {
+			return 0, err6
 		}
-		i -= n2
-		i = encodeVarintAirdrop(dAtA, i, uint64(n2))
+		i -= n6
+		i = encodeVarintAirdrop(dAtA, i, uint64(n6))
 		i--
 		dAtA[i] = 0x2a
 	}
 	if m.DistributionEndDate != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DistributionEndDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionEndDate):])
-		if err3 != nil {
-			return 0, err3
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DistributionEndDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionEndDate):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n3
-		i = encodeVarintAirdrop(dAtA, i, uint64(n3))
+		i -= n7
+		i = encodeVarintAirdrop(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0x22
 	}
 	if m.DistributionStartDate != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DistributionStartDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionStartDate):])
-		if err4 != nil {
-			return 0, err4
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DistributionStartDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionStartDate):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n4
-		i = encodeVarintAirdrop(dAtA, i, uint64(n4))
+		i -= n8
+		i = encodeVarintAirdrop(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -572,26 +1125,109 @@ func (m *Airdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAirdrop(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAirdrop(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AllocationProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PeriodLengthSeconds != 0 {
-		n += 1 + sovAirdrop(uint64(m.PeriodLengthSeconds))
-	}
+
+func (m *AllocationProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllocationProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintAirdrop(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Allocations) > 0 {
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Allocations[iNdEx].Size()
+				i -= size
+				if _, err := m.Allocations[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintAirdrop(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MerkleAllocationInitialization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MerkleAllocationInitialization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MerkleAllocationInitialization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAirdrop(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AirdropId) > 0 {
+		i -= len(m.AirdropId)
+		copy(dAtA[i:], m.AirdropId)
+		i = encodeVarintAirdrop(dAtA, i, uint64(len(m.AirdropId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAirdrop(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAirdrop(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PeriodLengthSeconds != 0 {
+		n += 1 + sovAirdrop(uint64(m.PeriodLengthSeconds))
+	}
 	return n
 }
 
@@ -619,6 +1255,20 @@ func (m *UserAllocation) Size() (n int) {
 			n += 1 + l + sovAirdrop(uint64(l))
 		}
 	}
+	if len(m.RequiredActions) > 0 {
+		l = 0
+		for _, e := range m.RequiredActions {
+			l += sovAirdrop(uint64(e))
+		}
+		n += 1 + sovAirdrop(uint64(l)) + l
+	}
+	if len(m.CompletedActions) > 0 {
+		l = 0
+		for _, e := range m.CompletedActions {
+			l += sovAirdrop(uint64(e))
+		}
+		n += 1 + sovAirdrop(uint64(l)) + l
+	}
 	return n
 }
 
@@ -666,6 +1316,87 @@ func (m *Airdrop) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAirdrop(uint64(l))
 	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovAirdrop(uint64(l))
+	}
+	l = m.ClaimAndStakeBonus.Size()
+	n += 1 + l + sovAirdrop(uint64(l))
+	l = m.ForfeitedPool.Size()
+	n += 1 + l + sovAirdrop(uint64(l))
+	if m.ClawbackDestination != 0 {
+		n += 1 + sovAirdrop(uint64(m.ClawbackDestination))
+	}
+	l = len(m.ClawbackAirdropId)
+	if l > 0 {
+		n += 1 + l + sovAirdrop(uint64(l))
+	}
+	if m.RedistributeForfeited {
+		n += 3
+	}
+	if m.ClawedBack {
+		n += 3
+	}
+	l = m.ClawedBackAmount.Size()
+	n += 2 + l + sovAirdrop(uint64(l))
+	l = m.RedistributedAmount.Size()
+	n += 2 + l + sovAirdrop(uint64(l))
+	l = m.MerkleTotalAllocated.Size()
+	n += 2 + l + sovAirdrop(uint64(l))
+	l = m.MerkleInitializedAmount.Size()
+	n += 2 + l + sovAirdrop(uint64(l))
+	l = m.UnclaimedBudget.Size()
+	n += 2 + l + sovAirdrop(uint64(l))
+	l = m.DailyClaimedTotal.Size()
+	n += 2 + l + sovAirdrop(uint64(l))
+	l = len(m.RedistributionCursor)
+	if l > 0 {
+		n += 2 + l + sovAirdrop(uint64(l))
+	}
+	if m.RedistributionComplete {
+		n += 3
+	}
+	if m.ClawbackFailed {
+		n += 3
+	}
+	return n
+}
+
+func (m *AllocationProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allocations) > 0 {
+		for _, e := range m.Allocations {
+			l = e.Size()
+			n += 1 + l + sovAirdrop(uint64(l))
+		}
+	}
+	if len(m.Proof) > 0 {
+		for _, s := range m.Proof {
+			l = len(s)
+			n += 1 + l + sovAirdrop(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MerkleAllocationInitialization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AirdropId)
+	if l > 0 {
+		n += 1 + l + sovAirdrop(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAirdrop(uint64(l))
+	}
 	return n
 }
 
@@ -941,6 +1672,144 @@ func (m *UserAllocation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v Action
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAirdrop
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Action(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RequiredActions = append(m.RequiredActions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAirdrop
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAirdrop
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAirdrop
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.RequiredActions) == 0 {
+					m.RequiredActions = make([]Action, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Action
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAirdrop
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Action(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RequiredActions = append(m.RequiredActions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredActions", wireType)
+			}
+		case 7:
+			if wireType == 0 {
+				var v Action
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAirdrop
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Action(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CompletedActions = append(m.CompletedActions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAirdrop
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAirdrop
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAirdrop
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.CompletedActions) == 0 {
+					m.CompletedActions = make([]Action, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Action
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAirdrop
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Action(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CompletedActions = append(m.CompletedActions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedActions", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAirdrop(dAtA[iNdEx:])
@@ -1329,6 +2198,705 @@ func (m *Airdrop) Unmarshal(dAtA []byte) error {
 			}
 			m.LinkerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimAndStakeBonus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimAndStakeBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitedPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ForfeitedPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackDestination", wireType)
+			}
+			m.ClawbackDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClawbackDestination |= ClawbackDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackAirdropId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClawbackAirdropId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedistributeForfeited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RedistributeForfeited = bool(v != 0)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawedBack", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClawedBack = bool(v != 0)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawedBackAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClawedBackAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedistributedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedistributedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleTotalAllocated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MerkleTotalAllocated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleInitializedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MerkleInitializedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnclaimedBudget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnclaimedBudget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyClaimedTotal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DailyClaimedTotal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedistributionCursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedistributionCursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedistributionComplete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RedistributionComplete = bool(v != 0)
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackFailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClawbackFailed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAirdrop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllocationProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAirdrop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllocationProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllocationProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Allocations = append(m.Allocations, v)
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAirdrop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MerkleAllocationInitialization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAirdrop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MerkleAllocationInitialization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MerkleAllocationInitialization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AirdropId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAirdrop(dAtA[iNdEx:])
@@ -20,7 +20,40 @@ func (u UserAllocation) GetClaimableAllocation(currentDateIndex int) sdkmath.Int
 
 	claimable := sdkmath.ZeroInt()
 	for i := 0; i <= currentDateIndex; i++ {
+		if !u.IsTrancheUnlocked(i) {
+			continue
+		}
 		claimable = claimable.Add(u.Allocations[i])
 	}
 	return claimable
 }
+
+// Checks whether the user has completed a given action
+func (u UserAllocation) HasCompletedAction(action Action) bool {
+	for _, completedAction := range u.CompletedActions {
+		if completedAction == action {
+			return true
+		}
+	}
+	return false
+}
+
+// Checks whether the action required for a given tranche of the allocations has been
+// completed (or if the tranche does not have a requirement)
+func (u UserAllocation) IsTrancheUnlocked(trancheIndex int) bool {
+	if trancheIndex >= len(u.RequiredActions) {
+		return true
+	}
+	requiredAction := u.RequiredActions[trancheIndex]
+	return requiredAction == ACTION_NONE || u.HasCompletedAction(requiredAction)
+}
+
+// Checks whether any of the tranches in the allocation require the given action
+func (u UserAllocation) RequiresAction(action Action) bool {
+	for _, requiredAction := range u.RequiredActions {
+		if requiredAction == action {
+			return true
+		}
+	}
+	return false
+}
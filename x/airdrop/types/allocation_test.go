@@ -70,3 +70,55 @@ func TestGetClaimableAllocations(t *testing.T) {
 	userAllocation = types.UserAllocation{}
 	require.Equal(t, int64(0), userAllocation.GetClaimableAllocation(3).Int64())
 }
+
+func TestGetClaimableAllocationsWithRequiredActions(t *testing.T) {
+	// The second and third tranches require actions, only liquid stake has been completed
+	userAllocation := types.UserAllocation{
+		Allocations: []sdkmath.Int{
+			sdkmath.NewInt(1),
+			sdkmath.NewInt(2),
+			sdkmath.NewInt(4),
+		},
+		RequiredActions: []types.Action{
+			types.ACTION_NONE,
+			types.ACTION_LIQUID_STAKE,
+			types.ACTION_DELEGATE_STAKE,
+		},
+		CompletedActions: []types.Action{
+			types.ACTION_LIQUID_STAKE,
+		},
+	}
+	require.Equal(t, int64(1), userAllocation.GetClaimableAllocation(0).Int64())
+	require.Equal(t, int64(3), userAllocation.GetClaimableAllocation(1).Int64())
+	require.Equal(t, int64(3), userAllocation.GetClaimableAllocation(2).Int64())
+
+	// Complete the delegation action, the last tranche should now be claimable
+	userAllocation.CompletedActions = append(userAllocation.CompletedActions, types.ACTION_DELEGATE_STAKE)
+	require.Equal(t, int64(7), userAllocation.GetClaimableAllocation(2).Int64())
+}
+
+func TestIsTrancheUnlocked(t *testing.T) {
+	userAllocation := types.UserAllocation{
+		RequiredActions: []types.Action{
+			types.ACTION_NONE,
+			types.ACTION_LIQUID_STAKE,
+			types.ACTION_DELEGATE_STAKE,
+		},
+		CompletedActions: []types.Action{
+			types.ACTION_DELEGATE_STAKE,
+		},
+	}
+	require.True(t, userAllocation.IsTrancheUnlocked(0), "no action required")
+	require.False(t, userAllocation.IsTrancheUnlocked(1), "liquid stake not completed")
+	require.True(t, userAllocation.IsTrancheUnlocked(2), "delegation completed")
+	require.True(t, userAllocation.IsTrancheUnlocked(3), "index without a requirement")
+
+	require.True(t, userAllocation.RequiresAction(types.ACTION_LIQUID_STAKE), "requires liquid stake")
+	require.False(t, userAllocation.HasCompletedAction(types.ACTION_LIQUID_STAKE), "has not liquid staked")
+	require.True(t, userAllocation.HasCompletedAction(types.ACTION_DELEGATE_STAKE), "has delegated")
+
+	// Allocations without any requirements should always be unlocked
+	userAllocation = types.UserAllocation{}
+	require.True(t, userAllocation.IsTrancheUnlocked(0), "uninitialized requirements")
+	require.False(t, userAllocation.RequiresAction(types.ACTION_LIQUID_STAKE), "uninitialized requires action")
+}
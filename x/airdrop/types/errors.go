@@ -20,4 +20,10 @@ var (
 	ErrFailedToLinkAddresses       = sdkerrors.Register(ModuleName, 2010, "unable to link addresses")
 	ErrInvalidAllocationListLength = sdkerrors.Register(ModuleName, 2011, "invalid allocations list length")
 	ErrInvalidAdminAddress         = sdkerrors.Register(ModuleName, 2012, "invalid admin address")
+	ErrInvalidMerkleProof          = sdkerrors.Register(ModuleName, 2013, "invalid merkle proof")
+	ErrInvalidMerkleRoot           = sdkerrors.Register(ModuleName, 2014, "invalid merkle root")
+	ErrRewardDenomNotStakeable     = sdkerrors.Register(ModuleName, 2015, "reward denom cannot be liquid staked")
+	ErrInvalidClawbackDestination  = sdkerrors.Register(ModuleName, 2016, "invalid clawback destination")
+	ErrClawbackFailed              = sdkerrors.Register(ModuleName, 2017, "unable to clawback airdrop rewards")
+	ErrMerkleTotalExceeded         = sdkerrors.Register(ModuleName, 2018, "merkle allocations exceed the merkle total allocated")
 )
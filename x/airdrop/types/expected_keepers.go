@@ -1,11 +1,26 @@
 package types
 
 import (
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	strdburnertypes "github.com/Stride-Labs/stride/v27/x/strdburner/types"
 )
 
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	BlockedAddr(addr sdk.AccAddress) bool
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
+
+// DistributionKeeper defines the expected interface needed to fund the community pool
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// StrdBurnerKeeper defines the expected interface needed to burn clawed back STRD
+type StrdBurnerKeeper interface {
+	Burn(ctx sdk.Context, burner sdk.AccAddress, amount math.Int, source strdburnertypes.BurnSource) error
 }
@@ -5,8 +5,9 @@ import "errors"
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Airdrops:        []Airdrop{},
-		UserAllocations: []UserAllocation{},
+		Airdrops:                        []Airdrop{},
+		UserAllocations:                 []UserAllocation{},
+		MerkleAllocationInitializations: []MerkleAllocationInitialization{},
 		Params: Params{
 			PeriodLengthSeconds: 24 * 60 * 60, // 1 day
 		},
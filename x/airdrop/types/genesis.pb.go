@@ -31,6 +31,8 @@ type GenesisState struct {
 	Airdrops []Airdrop `protobuf:"bytes,2,rep,name=airdrops,proto3" json:"airdrops" yaml:"airdrops"`
 	// All allocation records across all airdrops
 	UserAllocations []UserAllocation `protobuf:"bytes,3,rep,name=user_allocations,json=userAllocations,proto3" json:"user_allocations" yaml:"user_allocations"`
+	// All addresses whose merkle allocations have been initialized
+	MerkleAllocationInitializations []MerkleAllocationInitialization `protobuf:"bytes,4,rep,name=merkle_allocation_initializations,json=merkleAllocationInitializations,proto3" json:"merkle_allocation_initializations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +89,13 @@ func (m *GenesisState) GetUserAllocations() []UserAllocation {
 	return nil
 }
 
+func (m *GenesisState) GetMerkleAllocationInitializations() []MerkleAllocationInitialization {
+	if m != nil {
+		return m.MerkleAllocationInitializations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "stride.airdrop.GenesisState")
 }
@@ -94,26 +103,29 @@ func init() {
 func init() { proto.RegisterFile("stride/airdrop/genesis.proto", fileDescriptor_bd8a2f92a6e82560) }
 
 var fileDescriptor_bd8a2f92a6e82560 = []byte{
-	// 295 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x29, 0x2e, 0x29, 0xca,
-	0x4c, 0x49, 0xd5, 0x4f, 0xcc, 0x2c, 0x4a, 0x29, 0xca, 0x2f, 0xd0, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xc8, 0xea, 0x41, 0x65, 0xa5,
-	0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x52, 0xfa, 0x20, 0x16, 0x44, 0x95, 0x14, 0xba, 0x19, 0x50,
-	0x1a, 0x22, 0xab, 0xd4, 0xcf, 0xc4, 0xc5, 0xe3, 0x0e, 0x31, 0x35, 0xb8, 0x24, 0xb1, 0x24, 0x55,
-	0xc8, 0x95, 0x8b, 0xad, 0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb,
-	0x48, 0x4c, 0x0f, 0xd5, 0x16, 0xbd, 0x00, 0xb0, 0xac, 0x93, 0xe8, 0x89, 0x7b, 0xf2, 0x0c, 0x9f,
-	0xee, 0xc9, 0xf3, 0x56, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0x41, 0xf4, 0x28, 0x05, 0x41, 0x35, 0x0b,
-	0xf9, 0x70, 0x71, 0x40, 0x35, 0x14, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0x89, 0xa3, 0x1b,
-	0xe4, 0x08, 0xa1, 0x9d, 0xc4, 0xa1, 0x26, 0xf1, 0x43, 0x4c, 0x82, 0x69, 0x53, 0x0a, 0x82, 0x9b,
-	0x20, 0x94, 0xc5, 0x25, 0x50, 0x5a, 0x9c, 0x5a, 0x14, 0x9f, 0x98, 0x93, 0x93, 0x9f, 0x9c, 0x58,
-	0x92, 0x99, 0x9f, 0x57, 0x2c, 0xc1, 0x0c, 0x36, 0x55, 0x0e, 0xdd, 0xd4, 0xd0, 0xe2, 0xd4, 0x22,
-	0x47, 0xb8, 0x32, 0x27, 0x79, 0xa8, 0xe1, 0xe2, 0x10, 0xc3, 0xd1, 0x4d, 0x51, 0x0a, 0xe2, 0x2f,
-	0x45, 0xd1, 0x50, 0xec, 0xe4, 0x7d, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e,
-	0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51,
-	0x86, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xc1, 0x60, 0x5b, 0x75,
-	0x7d, 0x12, 0x93, 0x8a, 0xf5, 0xa1, 0x01, 0x5c, 0x66, 0x64, 0xae, 0x5f, 0x01, 0x0f, 0xe6, 0x92,
-	0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0x28, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xc5,
-	0x6c, 0x70, 0xce, 0xc9, 0x01, 0x00, 0x00,
+	// 342 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4a, 0xeb, 0x40,
+	0x18, 0x85, 0x93, 0xdb, 0x52, 0x2e, 0xa9, 0x5a, 0x09, 0x6a, 0x4b, 0x91, 0x49, 0xcd, 0xaa, 0x1b,
+	0x13, 0xac, 0x0b, 0xc1, 0x5d, 0x03, 0x22, 0x62, 0x05, 0x49, 0x71, 0xe3, 0xa6, 0x4c, 0xdb, 0x21,
+	0x8e, 0x26, 0x9d, 0x30, 0xff, 0x54, 0xac, 0x2b, 0x1f, 0x41, 0x7c, 0xaa, 0x2e, 0xbb, 0x74, 0x55,
+	0xa4, 0x7d, 0x03, 0x9f, 0x40, 0x3a, 0x33, 0x56, 0x93, 0x85, 0xab, 0x09, 0x9c, 0xf3, 0x7d, 0x27,
+	0xf0, 0x5b, 0xfb, 0x20, 0x38, 0x1d, 0x12, 0x1f, 0x53, 0x3e, 0xe4, 0x2c, 0xf5, 0x23, 0x32, 0x22,
+	0x40, 0xc1, 0x4b, 0x39, 0x13, 0xcc, 0xde, 0x52, 0xa9, 0xa7, 0xd3, 0xfa, 0x4e, 0xc4, 0x22, 0x26,
+	0x23, 0x7f, 0xf5, 0xa5, 0x5a, 0xf5, 0xbc, 0x43, 0xbf, 0x2a, 0x75, 0xdf, 0x0a, 0xd6, 0xc6, 0xb9,
+	0xb2, 0x76, 0x05, 0x16, 0xc4, 0x3e, 0xb3, 0x4a, 0x29, 0xe6, 0x38, 0x81, 0x9a, 0xd9, 0x30, 0x9b,
+	0xe5, 0xd6, 0x9e, 0x97, 0x5d, 0xf1, 0xae, 0x65, 0x1a, 0xec, 0x4e, 0xe7, 0x8e, 0xf1, 0x39, 0x77,
+	0x36, 0x27, 0x38, 0x89, 0x4f, 0x5d, 0xc5, 0xb8, 0xa1, 0x86, 0xed, 0x8e, 0xf5, 0x5f, 0x03, 0x50,
+	0xfb, 0xd7, 0x28, 0x34, 0xcb, 0xad, 0x6a, 0x5e, 0xd4, 0x56, 0x6f, 0x50, 0xd5, 0xa6, 0x8a, 0x32,
+	0x7d, 0x63, 0x6e, 0xb8, 0x36, 0xd8, 0xf7, 0xd6, 0xf6, 0x18, 0x08, 0xef, 0xe1, 0x38, 0x66, 0x03,
+	0x2c, 0x28, 0x1b, 0x41, 0xad, 0x20, 0xad, 0x28, 0x6f, 0xbd, 0x01, 0xc2, 0xdb, 0xeb, 0x5a, 0xe0,
+	0x68, 0x79, 0x55, 0xc9, 0xf3, 0x16, 0x37, 0xac, 0x8c, 0x33, 0x00, 0xd8, 0x2f, 0xa6, 0x75, 0x90,
+	0x10, 0xfe, 0x10, 0x93, 0x5f, 0xc5, 0x1e, 0x1d, 0x51, 0x41, 0x71, 0x4c, 0x9f, 0xf5, 0x7a, 0x51,
+	0xae, 0x7b, 0xf9, 0xf5, 0x2b, 0x09, 0xfe, 0xe8, 0x2e, 0x32, 0x58, 0x50, 0x5c, 0xfd, 0x4d, 0xe8,
+	0x24, 0x7f, 0xb6, 0x20, 0xb8, 0x9c, 0x2e, 0x90, 0x39, 0x5b, 0x20, 0xf3, 0x63, 0x81, 0xcc, 0xd7,
+	0x25, 0x32, 0x66, 0x4b, 0x64, 0xbc, 0x2f, 0x91, 0x71, 0x7b, 0x14, 0x51, 0x71, 0x37, 0xee, 0x7b,
+	0x03, 0x96, 0xf8, 0x5d, 0x39, 0x7d, 0xd8, 0xc1, 0x7d, 0xf0, 0xf5, 0x8d, 0x1f, 0x5b, 0x27, 0xfe,
+	0xd3, 0xfa, 0xd2, 0x62, 0x92, 0x12, 0xe8, 0x97, 0xe4, 0xa1, 0x8f, 0xbf, 0x06, 0x00, 0x84, 0x75,
+	0xae, 0x93, 0x4c, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -136,6 +148,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MerkleAllocationInitializations) > 0 {
+		for iNdEx := len(m.MerkleAllocationInitializations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerkleAllocationInitializations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.UserAllocations) > 0 {
 		for iNdEx := len(m.UserAllocations) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -208,6 +234,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.MerkleAllocationInitializations) > 0 {
+		for _, e := range m.MerkleAllocationInitializations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -347,6 +379,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleAllocationInitializations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleAllocationInitializations = append(m.MerkleAllocationInitializations, MerkleAllocationInitialization{})
+			if err := m.MerkleAllocationInitializations[len(m.MerkleAllocationInitializations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
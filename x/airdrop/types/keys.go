@@ -13,12 +13,22 @@ const (
 
 	// RouterKey defines the routing key
 	RouterKey = ModuleName
+
+	// Native denom of the chain, the only denom that can be sent to the strdburner
+	StrdDenom = "ustrd"
+
+	// Max number of user allocations processed in a single block when redistributing
+	// the forfeited pool at the clawback date
+	MaxRedistributionsPerBlock = 100
 )
 
 var (
 	ParamsPrefix            = KeyPrefix("params")
 	AirdropKeyPrefix        = KeyPrefix("airdrops")
 	UserAllocationKeyPrefix = KeyPrefix("user-allocations")
+
+	MerkleAllocationInitializationKeyPrefix = KeyPrefix("merkle-initializations")
+	ActionAirdropIndexKeyPrefix             = KeyPrefix("action-airdrops")
 )
 
 // Generates a key byte prefix from a string
@@ -29,3 +39,12 @@ func KeyPrefix(p string) []byte {
 func UserAllocationKey(airdropId string, userAddress string) []byte {
 	return KeyPrefix(fmt.Sprintf("%s/%s", airdropId, userAddress))
 }
+
+// Prefix of the index of airdrops that have allocations gated by the given action
+func ActionAirdropIndexPrefix(action Action) []byte {
+	return KeyPrefix(fmt.Sprintf("%d/", action))
+}
+
+func ActionAirdropIndexKey(action Action, airdropId string) []byte {
+	return append(ActionAirdropIndexPrefix(action), KeyPrefix(airdropId)...)
+}
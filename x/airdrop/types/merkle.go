@@ -0,0 +1,167 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+)
+
+// Domain separators for the allocations merkle tree, to prevent a leaf from being
+// interpreted as an inner node (and vice versa)
+var (
+	MerkleLeafPrefix  = []byte{0x00}
+	MerkleInnerPrefix = []byte{0x01}
+)
+
+// Returns the hash of a leaf in the allocations merkle tree
+// The leaf is the sha256 hash of: 0x00 | "{address},{allocation1},{allocation2},..."
+func AllocationLeafHash(address string, allocations []sdkmath.Int) []byte {
+	allocationStrings := make([]string, len(allocations))
+	for i, allocation := range allocations {
+		allocationStrings[i] = allocation.String()
+	}
+	leafData := fmt.Sprintf("%s,%s", address, strings.Join(allocationStrings, ","))
+
+	hash := sha256.Sum256(append(append([]byte{}, MerkleLeafPrefix...), []byte(leafData)...))
+	return hash[:]
+}
+
+// Returns the hash of two sibling nodes in the allocations merkle tree
+// The nodes are sorted before hashing so that the proof does not need to specify
+// whether each sibling is on the left or right
+func hashMerkleNodes(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	data := append(append([]byte{}, MerkleInnerPrefix...), a...)
+	data = append(data, b...)
+	hash := sha256.Sum256(data)
+	return hash[:]
+}
+
+// Confirms a user's allocations are included in the airdrop's merkle tree,
+// given the hex encoded root and the hex encoded sibling hashes from the leaf up to the root
+func VerifyAllocationProof(merkleRoot string, address string, allocations []sdkmath.Int, proof []string) error {
+	rootBz, err := hex.DecodeString(merkleRoot)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidMerkleProof, "invalid merkle root %s", merkleRoot)
+	}
+
+	hash := AllocationLeafHash(address, allocations)
+	for _, siblingHex := range proof {
+		sibling, err := hex.DecodeString(siblingHex)
+		if err != nil || len(sibling) != sha256.Size {
+			return errorsmod.Wrapf(ErrInvalidMerkleProof, "invalid proof element %s", siblingHex)
+		}
+		hash = hashMerkleNodes(hash, sibling)
+	}
+
+	if !bytes.Equal(hash, rootBz) {
+		return errorsmod.Wrapf(ErrInvalidMerkleProof, "allocations for %s do not match the merkle root", address)
+	}
+	return nil
+}
+
+// Builds the allocations merkle tree, returning the hex encoded root and the proof for each address
+// The leaves are sorted so that the tree is deterministic regardless of the order of the allocations
+// If a level has an odd number of nodes, the last node is promoted to the next level unchanged
+func BuildAllocationMerkleTree(rawAllocations []RawAllocation) (root string, proofs map[string]AllocationProof, err error) {
+	if len(rawAllocations) == 0 {
+		return "", nil, fmt.Errorf("at least one allocation must be specified")
+	}
+
+	// Hash each leaf, and track the index of each address' leaf as we build up the tree
+	type leaf struct {
+		hash       []byte
+		allocation RawAllocation
+	}
+	leaves := []leaf{}
+	seenAddresses := map[string]bool{}
+	for _, allocation := range rawAllocations {
+		if seenAddresses[allocation.UserAddress] {
+			return "", nil, fmt.Errorf("duplicate address %s", allocation.UserAddress)
+		}
+		seenAddresses[allocation.UserAddress] = true
+		leaves = append(leaves, leaf{
+			hash:       AllocationLeafHash(allocation.UserAddress, allocation.Allocations),
+			allocation: allocation,
+		})
+	}
+	sort.Slice(leaves, func(i, j int) bool {
+		return bytes.Compare(leaves[i].hash, leaves[j].hash) < 0
+	})
+
+	// Build each level of the tree, appending each address' sibling to it's proof
+	level := make([][]byte, len(leaves))
+	positions := make([]int, len(leaves)) // position of each leaf's ancestor in the current level
+	siblings := make([][]string, len(leaves))
+	for i, leaf := range leaves {
+		level[i] = leaf.hash
+		positions[i] = i
+		siblings[i] = []string{}
+	}
+
+	for len(level) > 1 {
+		for i := range leaves {
+			position := positions[i]
+			siblingPosition := position ^ 1
+			if siblingPosition < len(level) {
+				siblings[i] = append(siblings[i], hex.EncodeToString(level[siblingPosition]))
+			}
+			positions[i] = position / 2
+		}
+
+		nextLevel := [][]byte{}
+		for i := 0; i < len(level); i += 2 {
+			if i+1 < len(level) {
+				nextLevel = append(nextLevel, hashMerkleNodes(level[i], level[i+1]))
+			} else {
+				nextLevel = append(nextLevel, level[i])
+			}
+		}
+		level = nextLevel
+	}
+
+	proofs = map[string]AllocationProof{}
+	for i, leaf := range leaves {
+		proofs[leaf.allocation.UserAddress] = AllocationProof{
+			Allocations: leaf.allocation.Allocations,
+			Proof:       siblings[i],
+		}
+	}
+
+	return hex.EncodeToString(level[0]), proofs, nil
+}
+
+// Confirms the merkle root is a hex encoded sha256 hash
+func ValidateMerkleRoot(merkleRoot string) error {
+	rootBz, err := hex.DecodeString(merkleRoot)
+	if err != nil || len(rootBz) != sha256.Size {
+		return errorsmod.Wrapf(ErrInvalidMerkleRoot, "merkle root must be a hex encoded sha256 hash")
+	}
+	return nil
+}
+
+// Validates the allocations and proof elements are specified
+func (p AllocationProof) ValidateBasic() error {
+	if len(p.Allocations) == 0 {
+		return errorsmod.Wrapf(ErrInvalidMerkleProof, "allocations must be specified with the proof")
+	}
+	for _, allocation := range p.Allocations {
+		if allocation.IsNil() || allocation.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidMerkleProof, "all allocation amounts must be specified and positive")
+		}
+	}
+	for _, element := range p.Proof {
+		if element == "" {
+			return errorsmod.Wrapf(ErrInvalidMerkleProof, "proof elements cannot be empty")
+		}
+	}
+	return nil
+}
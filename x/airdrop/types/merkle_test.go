@@ -0,0 +1,106 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v27/x/airdrop/types"
+)
+
+// Helper function to build a list of raw allocations with different amounts
+func createRawAllocations(numUsers int) []types.RawAllocation {
+	rawAllocations := []types.RawAllocation{}
+	for i := 0; i < numUsers; i++ {
+		rawAllocations = append(rawAllocations, types.RawAllocation{
+			UserAddress: fmt.Sprintf("user-%d", i),
+			Allocations: []sdkmath.Int{sdkmath.NewInt(int64(i)), sdkmath.NewInt(10), sdkmath.NewInt(int64(i * 2))},
+		})
+	}
+	return rawAllocations
+}
+
+func TestBuildAndVerifyAllocationMerkleTree(t *testing.T) {
+	// Test trees with even and odd numbers of leaves at each level
+	for _, numUsers := range []int{1, 2, 3, 4, 5, 8, 11} {
+		t.Run(fmt.Sprintf("%d users", numUsers), func(t *testing.T) {
+			rawAllocations := createRawAllocations(numUsers)
+
+			root, proofs, err := types.BuildAllocationMerkleTree(rawAllocations)
+			require.NoError(t, err, "no error expected when building tree")
+			require.NoError(t, types.ValidateMerkleRoot(root), "root should be a valid hash")
+			require.Len(t, proofs, numUsers, "number of proofs")
+
+			for _, rawAllocation := range rawAllocations {
+				proof, ok := proofs[rawAllocation.UserAddress]
+				require.True(t, ok, "proof should exist for %s", rawAllocation.UserAddress)
+				require.Equal(t, rawAllocation.Allocations, proof.Allocations, "proof allocations")
+
+				err := types.VerifyAllocationProof(root, rawAllocation.UserAddress, proof.Allocations, proof.Proof)
+				require.NoError(t, err, "proof should be valid for %s", rawAllocation.UserAddress)
+			}
+		})
+	}
+}
+
+func TestBuildAllocationMerkleTree_Deterministic(t *testing.T) {
+	rawAllocations := createRawAllocations(5)
+	root, _, err := types.BuildAllocationMerkleTree(rawAllocations)
+	require.NoError(t, err)
+
+	// Reverse the order of the allocations, the root should not change
+	reversedAllocations := []types.RawAllocation{}
+	for i := len(rawAllocations) - 1; i >= 0; i-- {
+		reversedAllocations = append(reversedAllocations, rawAllocations[i])
+	}
+	reversedRoot, _, err := types.BuildAllocationMerkleTree(reversedAllocations)
+	require.NoError(t, err)
+
+	require.Equal(t, root, reversedRoot, "root should not depend on the allocation order")
+}
+
+func TestBuildAllocationMerkleTree_Errors(t *testing.T) {
+	_, _, err := types.BuildAllocationMerkleTree([]types.RawAllocation{})
+	require.ErrorContains(t, err, "at least one allocation must be specified")
+
+	rawAllocations := createRawAllocations(3)
+	rawAllocations = append(rawAllocations, rawAllocations[0])
+	_, _, err = types.BuildAllocationMerkleTree(rawAllocations)
+	require.ErrorContains(t, err, "duplicate address user-0")
+}
+
+func TestVerifyAllocationProof_Invalid(t *testing.T) {
+	rawAllocations := createRawAllocations(5)
+	root, proofs, err := types.BuildAllocationMerkleTree(rawAllocations)
+	require.NoError(t, err)
+
+	address := rawAllocations[1].UserAddress
+	proof := proofs[address]
+
+	// Modified allocations
+	modifiedAllocations := []sdkmath.Int{proof.Allocations[0], proof.Allocations[1], proof.Allocations[2].AddRaw(1)}
+	err = types.VerifyAllocationProof(root, address, modifiedAllocations, proof.Proof)
+	require.ErrorIs(t, err, types.ErrInvalidMerkleProof, "modified allocations")
+
+	// Different address
+	err = types.VerifyAllocationProof(root, "user-99", proof.Allocations, proof.Proof)
+	require.ErrorIs(t, err, types.ErrInvalidMerkleProof, "different address")
+
+	// Proof from another user
+	err = types.VerifyAllocationProof(root, address, proof.Allocations, proofs[rawAllocations[2].UserAddress].Proof)
+	require.ErrorIs(t, err, types.ErrInvalidMerkleProof, "other user's proof")
+
+	// Missing proof element
+	err = types.VerifyAllocationProof(root, address, proof.Allocations, proof.Proof[1:])
+	require.ErrorIs(t, err, types.ErrInvalidMerkleProof, "truncated proof")
+
+	// Non-hex proof element
+	err = types.VerifyAllocationProof(root, address, proof.Allocations, []string{"not-hex"})
+	require.ErrorContains(t, err, "invalid proof element")
+
+	// Invalid root
+	err = types.VerifyAllocationProof("not-hex", address, proof.Allocations, proof.Proof)
+	require.ErrorContains(t, err, "invalid merkle root")
+}
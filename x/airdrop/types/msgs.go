@@ -24,6 +24,8 @@ const (
 	TypeMsgAddAllocations       = "add_allocations"
 	TypeMsgUpdateUserAllocation = "update_user_allocation"
 	TypeMsgLinkAddresses        = "link_addresses"
+	TypeMsgSetMerkleRoot        = "set_merkle_root"
+	TypeMsgClaimAndStake        = "claim_and_stake"
 )
 
 var (
@@ -35,6 +37,8 @@ var (
 	_ sdk.Msg = &MsgAddAllocations{}
 	_ sdk.Msg = &MsgUpdateUserAllocation{}
 	_ sdk.Msg = &MsgLinkAddresses{}
+	_ sdk.Msg = &MsgSetMerkleRoot{}
+	_ sdk.Msg = &MsgClaimAndStake{}
 
 	// Implement legacy interface for ledger support
 	_ legacytx.LegacyMsg = &MsgClaimDaily{}
@@ -45,6 +49,8 @@ var (
 	_ legacytx.LegacyMsg = &MsgAddAllocations{}
 	_ legacytx.LegacyMsg = &MsgUpdateUserAllocation{}
 	_ legacytx.LegacyMsg = &MsgLinkAddresses{}
+	_ legacytx.LegacyMsg = &MsgSetMerkleRoot{}
+	_ legacytx.LegacyMsg = &MsgClaimAndStake{}
 )
 
 // ----------------------------------------------
@@ -86,6 +92,11 @@ func (msg *MsgClaimDaily) ValidateBasic() error {
 	if msg.AirdropId == "" {
 		return errors.New("airdrop-id must be specified")
 	}
+	if msg.AllocationProof != nil {
+		if err := msg.AllocationProof.ValidateBasic(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -129,6 +140,11 @@ func (msg *MsgClaimEarly) ValidateBasic() error {
 	if msg.AirdropId == "" {
 		return errors.New("airdrop-id must be specified")
 	}
+	if msg.AllocationProof != nil {
+		if err := msg.AllocationProof.ValidateBasic(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -146,9 +162,13 @@ func NewMsgCreateAirdrop(
 	clawbackDate *time.Time,
 	claimDeadlineDate *time.Time,
 	earlyClaimPenalty sdk.Dec,
+	claimAndStakeBonus sdk.Dec,
 	distributorAddress string,
 	allocatorAddress string,
 	linkerAddress string,
+	clawbackDestination ClawbackDestination,
+	clawbackAirdropId string,
+	redistributeForfeited bool,
 ) *MsgCreateAirdrop {
 	return &MsgCreateAirdrop{
 		Admin:                 admin,
@@ -159,9 +179,13 @@ func NewMsgCreateAirdrop(
 		ClawbackDate:          clawbackDate,
 		ClaimTypeDeadlineDate: claimDeadlineDate,
 		EarlyClaimPenalty:     earlyClaimPenalty,
+		ClaimAndStakeBonus:    claimAndStakeBonus,
 		DistributorAddress:    distributorAddress,
 		AllocatorAddress:      allocatorAddress,
 		LinkerAddress:         linkerAddress,
+		ClawbackDestination:   clawbackDestination,
+		ClawbackAirdropId:     clawbackAirdropId,
+		RedistributeForfeited: redistributeForfeited,
 	}
 }
 
@@ -194,7 +218,7 @@ func (msg *MsgCreateAirdrop) ValidateBasic() error {
 		return err
 	}
 
-	return AirdropConfigValidateBasic(
+	if err := AirdropConfigValidateBasic(
 		msg.AirdropId,
 		msg.RewardDenom,
 		msg.DistributionStartDate,
@@ -202,9 +226,20 @@ func (msg *MsgCreateAirdrop) ValidateBasic() error {
 		msg.ClawbackDate,
 		msg.ClaimTypeDeadlineDate,
 		msg.EarlyClaimPenalty,
+		msg.ClaimAndStakeBonus,
 		msg.DistributorAddress,
 		msg.AllocatorAddress,
 		msg.LinkerAddress,
+	); err != nil {
+		return err
+	}
+
+	return ClawbackConfigValidateBasic(
+		msg.AirdropId,
+		msg.RewardDenom,
+		msg.ClawbackDestination,
+		msg.ClawbackAirdropId,
+		msg.RedistributeForfeited,
 	)
 }
 
@@ -221,9 +256,13 @@ func NewMsgUpdateAirdrop(
 	clawbackDate *time.Time,
 	claimDeadlineDate *time.Time,
 	earlyClaimPenalty sdk.Dec,
+	claimAndStakeBonus sdk.Dec,
 	distributorAddress string,
 	allocatorAddress string,
 	linkerAddress string,
+	clawbackDestination ClawbackDestination,
+	clawbackAirdropId string,
+	redistributeForfeited bool,
 ) *MsgUpdateAirdrop {
 	return &MsgUpdateAirdrop{
 		Admin:                 admin,
@@ -234,9 +273,13 @@ func NewMsgUpdateAirdrop(
 		ClawbackDate:          clawbackDate,
 		ClaimTypeDeadlineDate: claimDeadlineDate,
 		EarlyClaimPenalty:     earlyClaimPenalty,
+		ClaimAndStakeBonus:    claimAndStakeBonus,
 		DistributorAddress:    distributorAddress,
 		AllocatorAddress:      allocatorAddress,
 		LinkerAddress:         linkerAddress,
+		ClawbackDestination:   clawbackDestination,
+		ClawbackAirdropId:     clawbackAirdropId,
+		RedistributeForfeited: redistributeForfeited,
 	}
 }
 
@@ -269,7 +312,7 @@ func (msg *MsgUpdateAirdrop) ValidateBasic() error {
 		return err
 	}
 
-	return AirdropConfigValidateBasic(
+	if err := AirdropConfigValidateBasic(
 		msg.AirdropId,
 		msg.RewardDenom,
 		msg.DistributionStartDate,
@@ -277,9 +320,20 @@ func (msg *MsgUpdateAirdrop) ValidateBasic() error {
 		msg.ClawbackDate,
 		msg.ClaimTypeDeadlineDate,
 		msg.EarlyClaimPenalty,
+		msg.ClaimAndStakeBonus,
 		msg.DistributorAddress,
 		msg.AllocatorAddress,
 		msg.LinkerAddress,
+	); err != nil {
+		return err
+	}
+
+	return ClawbackConfigValidateBasic(
+		msg.AirdropId,
+		msg.RewardDenom,
+		msg.ClawbackDestination,
+		msg.ClawbackAirdropId,
+		msg.RedistributeForfeited,
 	)
 }
 
@@ -350,6 +404,16 @@ func (msg *MsgAddAllocations) ValidateBasic() error {
 				return errors.New("all allocation amounts must be specified and positive")
 			}
 		}
+
+		// The action requirements are optional, but if specified, there must be one per tranche
+		if len(allocation.RequiredActions) > 0 && len(allocation.RequiredActions) != len(allocation.Allocations) {
+			return fmt.Errorf("address %s must have one required action per allocation", allocation.UserAddress)
+		}
+		for _, action := range allocation.RequiredActions {
+			if _, ok := Action_name[int32(action)]; !ok {
+				return fmt.Errorf("address %s has an invalid required action (%d)", allocation.UserAddress, action)
+			}
+		}
 	}
 
 	return nil
@@ -461,6 +525,112 @@ func (msg *MsgLinkAddresses) ValidateBasic() error {
 	if strings.HasPrefix(msg.HostAddress, "stride") {
 		return errors.New("linked address cannot be a stride address")
 	}
+	if msg.HostAllocationProof != nil {
+		if err := msg.HostAllocationProof.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid host allocation proof")
+		}
+	}
+	if msg.StrideAllocationProof != nil {
+		if err := msg.StrideAllocationProof.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid stride allocation proof")
+		}
+	}
+
+	return nil
+}
+
+// ----------------------------------------------
+//             MsgSetMerkleRoot
+// ----------------------------------------------
+
+func NewMsgSetMerkleRoot(admin, airdropId, merkleRoot string, merkleTotalAllocated sdkmath.Int) *MsgSetMerkleRoot {
+	return &MsgSetMerkleRoot{
+		Admin:                admin,
+		AirdropId:            airdropId,
+		MerkleRoot:           merkleRoot,
+		MerkleTotalAllocated: merkleTotalAllocated,
+	}
+}
+
+func (msg MsgSetMerkleRoot) Type() string {
+	return TypeMsgSetMerkleRoot
+}
+
+func (msg MsgSetMerkleRoot) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetMerkleRoot) GetSigners() []sdk.AccAddress {
+	admin, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{admin}
+}
+
+func (msg *MsgSetMerkleRoot) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetMerkleRoot) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	if msg.AirdropId == "" {
+		return errors.New("airdrop-id must be specified")
+	}
+	if msg.MerkleTotalAllocated.IsNil() || !msg.MerkleTotalAllocated.IsPositive() {
+		return errors.New("merkle total allocated must be positive")
+	}
+
+	return ValidateMerkleRoot(msg.MerkleRoot)
+}
+
+// ----------------------------------------------
+//               MsgClaimAndStake
+// ----------------------------------------------
+
+func NewMsgClaimAndStake(claimer, airdropId string) *MsgClaimAndStake {
+	return &MsgClaimAndStake{
+		Claimer:   claimer,
+		AirdropId: airdropId,
+	}
+}
+
+func (msg MsgClaimAndStake) Type() string {
+	return TypeMsgClaimAndStake
+}
+
+func (msg MsgClaimAndStake) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgClaimAndStake) GetSigners() []sdk.AccAddress {
+	claimer, err := sdk.AccAddressFromBech32(msg.Claimer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{claimer}
+}
+
+func (msg *MsgClaimAndStake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgClaimAndStake) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Claimer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	if msg.AirdropId == "" {
+		return errors.New("airdrop-id must be specified")
+	}
+	if msg.AllocationProof != nil {
+		if err := msg.AllocationProof.ValidateBasic(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
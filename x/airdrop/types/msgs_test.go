@@ -52,6 +52,37 @@ func TestMsgClaimDaily_ValidateBasic(t *testing.T) {
 			},
 			expectedError: "airdrop-id must be specified",
 		},
+		{
+			name: "valid message with proof",
+			msg: types.MsgClaimDaily{
+				Claimer:   validAddress,
+				AirdropId: validAirdropId,
+				AllocationProof: &types.AllocationProof{
+					Allocations: []sdkmath.Int{sdkmath.NewInt(10), sdkmath.ZeroInt()},
+					Proof:       []string{"aa"},
+				},
+			},
+		},
+		{
+			name: "proof without allocations",
+			msg: types.MsgClaimDaily{
+				Claimer:         validAddress,
+				AirdropId:       validAirdropId,
+				AllocationProof: &types.AllocationProof{Proof: []string{"aa"}},
+			},
+			expectedError: "allocations must be specified with the proof",
+		},
+		{
+			name: "proof with negative allocation",
+			msg: types.MsgClaimDaily{
+				Claimer:   validAddress,
+				AirdropId: validAirdropId,
+				AllocationProof: &types.AllocationProof{
+					Allocations: []sdkmath.Int{sdkmath.NewInt(-1)},
+				},
+			},
+			expectedError: "all allocation amounts must be specified and positive",
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -111,6 +142,29 @@ func TestMsgClaimEarly_ValidateBasic(t *testing.T) {
 			},
 			expectedError: "airdrop-id must be specified",
 		},
+		{
+			name: "proof with nil allocation",
+			msg: types.MsgClaimEarly{
+				Claimer:   validAddress,
+				AirdropId: validAirdropId,
+				AllocationProof: &types.AllocationProof{
+					Allocations: []sdkmath.Int{{}},
+				},
+			},
+			expectedError: "all allocation amounts must be specified and positive",
+		},
+		{
+			name: "proof with empty element",
+			msg: types.MsgClaimEarly{
+				Claimer:   validAddress,
+				AirdropId: validAirdropId,
+				AllocationProof: &types.AllocationProof{
+					Allocations: []sdkmath.Int{sdkmath.NewInt(10)},
+					Proof:       []string{""},
+				},
+			},
+			expectedError: "proof elements cannot be empty",
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -229,6 +283,24 @@ func TestMsgCreateAirdrop_ValidateBasic(t *testing.T) {
 			},
 			expectedError: "airdrop-id must be specified",
 		},
+		{
+			name: "failed clawback validate basic",
+			msg: types.MsgCreateAirdrop{
+				Admin:                 adminAddress,
+				AirdropId:             validAirdropId,
+				RewardDenom:           validRewardDenom,
+				DistributionStartDate: &validDistributionStartDate,
+				DistributionEndDate:   &validDistributionEndDate,
+				ClawbackDate:          &validClawbackDate,
+				ClaimTypeDeadlineDate: &validDeadlineDate,
+				EarlyClaimPenalty:     validEarlyClaimPenalty,
+				DistributorAddress:    validDistributorAddress,
+				AllocatorAddress:      validAllocatorAddress,
+				LinkerAddress:         validLinkerAddress,
+				ClawbackDestination:   types.CLAWBACK_TO_AIRDROP,
+			},
+			expectedError: "clawback airdrop-id must be specified",
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -256,6 +328,7 @@ func TestMsgCreateAirdrop_GetSignBytes(t *testing.T) {
 	deadlineDate := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
 
 	earlyClaimPenalty := sdk.MustNewDecFromStr("0.5")
+	claimAndStakeBonus := sdk.MustNewDecFromStr("0.1")
 
 	msg := types.NewMsgCreateAirdrop(
 		admin,
@@ -266,9 +339,13 @@ func TestMsgCreateAirdrop_GetSignBytes(t *testing.T) {
 		&clawbackDate,
 		&deadlineDate,
 		earlyClaimPenalty,
+		claimAndStakeBonus,
 		distributorAddress,
 		allocatorAddress,
 		linkerAddress,
+		types.CLAWBACK_TO_AIRDROP,
+		"airdrop-2",
+		true,
 	)
 	res := msg.GetSignBytes()
 
@@ -277,13 +354,17 @@ func TestMsgCreateAirdrop_GetSignBytes(t *testing.T) {
 		"value":{"admin":"admin",
 		"airdrop_id":"airdrop",
 		"allocator_address":"allocator",
+		"claim_and_stake_bonus":"0.100000000000000000",
 		"claim_type_deadline_date":"2024-02-01T00:00:00Z",
+		"clawback_airdrop_id":"airdrop-2",
 		"clawback_date":"2024-07-01T00:00:00Z",
+		"clawback_destination":3,
 		"distribution_end_date":"2024-06-01T00:00:00Z",
 		"distribution_start_date":"2024-01-01T00:00:00Z",
 		"distributor_address":"distributor",
 		"early_claim_penalty":"0.500000000000000000",
 		"linker_address":"linker",
+		"redistribute_forfeited":true,
 		"reward_denom":"denom"}}`)
 
 	re := regexp.MustCompile(`\s+`)
@@ -386,6 +467,24 @@ func TestMsgUpdateAirdrop_ValidateBasic(t *testing.T) {
 			},
 			expectedError: "airdrop-id must be specified",
 		},
+		{
+			name: "failed clawback validate basic",
+			msg: types.MsgUpdateAirdrop{
+				Admin:                 adminAddress,
+				AirdropId:             validAirdropId,
+				RewardDenom:           validRewardDenom,
+				DistributionStartDate: &validDistributionStartDate,
+				DistributionEndDate:   &validDistributionEndDate,
+				ClawbackDate:          &validClawbackDate,
+				ClaimTypeDeadlineDate: &validDeadlineDate,
+				EarlyClaimPenalty:     validEarlyClaimPenalty,
+				DistributorAddress:    validDistributorAddress,
+				AllocatorAddress:      validAllocatorAddress,
+				LinkerAddress:         validLinkerAddress,
+				ClawbackDestination:   types.CLAWBACK_TO_AIRDROP,
+			},
+			expectedError: "clawback airdrop-id must be specified",
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -413,6 +512,7 @@ func TestMsgUpdateAirdrop_GetSignBytes(t *testing.T) {
 	deadlineDate := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
 
 	earlyClaimPenalty := sdk.MustNewDecFromStr("0.5")
+	claimAndStakeBonus := sdk.MustNewDecFromStr("0.1")
 
 	msg := types.NewMsgUpdateAirdrop(
 		admin,
@@ -423,9 +523,13 @@ func TestMsgUpdateAirdrop_GetSignBytes(t *testing.T) {
 		&clawbackDate,
 		&deadlineDate,
 		earlyClaimPenalty,
+		claimAndStakeBonus,
 		distributorAddress,
 		allocatorAddress,
 		linkerAddress,
+		types.CLAWBACK_TO_AIRDROP,
+		"airdrop-2",
+		true,
 	)
 	res := msg.GetSignBytes()
 
@@ -434,13 +538,17 @@ func TestMsgUpdateAirdrop_GetSignBytes(t *testing.T) {
 		"value":{"admin":"admin",
 		"airdrop_id":"airdrop",
 		"allocator_address":"allocator",
+		"claim_and_stake_bonus":"0.100000000000000000",
 		"claim_type_deadline_date":"2024-02-01T00:00:00Z",
+		"clawback_airdrop_id":"airdrop-2",
 		"clawback_date":"2024-07-01T00:00:00Z",
+		"clawback_destination":3,
 		"distribution_end_date":"2024-06-01T00:00:00Z",
 		"distribution_start_date":"2024-01-01T00:00:00Z",
 		"distributor_address":"distributor",
 		"early_claim_penalty":"0.500000000000000000",
 		"linker_address":"linker",
+		"redistribute_forfeited":true,
 		"reward_denom":"denom"}}`)
 
 	re := regexp.MustCompile(`\s+`)
@@ -596,6 +704,50 @@ func TestMsgAddAllocations_ValidateBasic(t *testing.T) {
 			},
 			expectedError: "address user-3 has an inconsistent number of allocations",
 		},
+		{
+			name: "valid required actions",
+			msg: types.MsgAddAllocations{
+				Admin:     validAddress,
+				AirdropId: validAirdropId,
+				Allocations: []types.RawAllocation{
+					{
+						UserAddress:     "user-1",
+						Allocations:     []sdkmath.Int{sdkmath.NewInt(1), sdkmath.NewInt(2)},
+						RequiredActions: []types.Action{types.ACTION_NONE, types.ACTION_LIQUID_STAKE},
+					},
+				},
+			},
+		},
+		{
+			name: "inconsistent required actions length",
+			msg: types.MsgAddAllocations{
+				Admin:     validAddress,
+				AirdropId: validAirdropId,
+				Allocations: []types.RawAllocation{
+					{
+						UserAddress:     "user-1",
+						Allocations:     []sdkmath.Int{sdkmath.NewInt(1), sdkmath.NewInt(2)},
+						RequiredActions: []types.Action{types.ACTION_LIQUID_STAKE},
+					},
+				},
+			},
+			expectedError: "address user-1 must have one required action per allocation",
+		},
+		{
+			name: "invalid required action",
+			msg: types.MsgAddAllocations{
+				Admin:     validAddress,
+				AirdropId: validAirdropId,
+				Allocations: []types.RawAllocation{
+					{
+						UserAddress:     "user-1",
+						Allocations:     []sdkmath.Int{sdkmath.NewInt(1)},
+						RequiredActions: []types.Action{types.Action(99)},
+					},
+				},
+			},
+			expectedError: "address user-1 has an invalid required action",
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -773,6 +925,45 @@ func TestMsgLinkAddresses_ValidateBasic(t *testing.T) {
 				HostAddress:   validHostAddress,
 			},
 		},
+		{
+			name: "valid message with proofs",
+			msg: types.MsgLinkAddresses{
+				Admin:         adminAddress,
+				AirdropId:     validAirdropId,
+				StrideAddress: validStrideAddress,
+				HostAddress:   validHostAddress,
+				HostAllocationProof: &types.AllocationProof{
+					Allocations: []sdkmath.Int{sdkmath.NewInt(10)},
+					Proof:       []string{"aa"},
+				},
+				StrideAllocationProof: &types.AllocationProof{
+					Allocations: []sdkmath.Int{sdkmath.NewInt(10)},
+					Proof:       []string{"aa"},
+				},
+			},
+		},
+		{
+			name: "invalid host proof",
+			msg: types.MsgLinkAddresses{
+				Admin:               adminAddress,
+				AirdropId:           validAirdropId,
+				StrideAddress:       validStrideAddress,
+				HostAddress:         validHostAddress,
+				HostAllocationProof: &types.AllocationProof{Proof: []string{"aa"}},
+			},
+			expectedError: "invalid host allocation proof",
+		},
+		{
+			name: "invalid stride proof",
+			msg: types.MsgLinkAddresses{
+				Admin:                 adminAddress,
+				AirdropId:             validAirdropId,
+				StrideAddress:         validStrideAddress,
+				HostAddress:           validHostAddress,
+				StrideAllocationProof: &types.AllocationProof{Proof: []string{"aa"}},
+			},
+			expectedError: "invalid stride allocation proof",
+		},
 		{
 			name: "invalid address",
 			msg: types.MsgLinkAddresses{
@@ -856,3 +1047,210 @@ func TestMsgLinkAddresses_GetSignBytes(t *testing.T) {
 	expected = re.ReplaceAllString(expected, "")
 	require.Equal(t, expected, string(res))
 }
+
+// ----------------------------------------------
+//               MsgSetMerkleRoot
+// ----------------------------------------------
+
+func TestMsgSetMerkleRoot_ValidateBasic(t *testing.T) {
+	apptesting.SetupConfig()
+
+	validAddress, invalidAddress := apptesting.GenerateTestAddrs()
+	validAirdropId := "airdrop-1"
+	validMerkleRoot := strings.Repeat("ab", 32)
+
+	tests := []struct {
+		name          string
+		msg           types.MsgSetMerkleRoot
+		expectedError string
+	}{
+		{
+			name: "valid message",
+			msg: types.MsgSetMerkleRoot{
+				Admin:      validAddress,
+				AirdropId:  validAirdropId,
+				MerkleRoot: validMerkleRoot,
+
+				MerkleTotalAllocated: sdkmath.NewInt(1000),
+			},
+		},
+		{
+			name: "invalid address",
+			msg: types.MsgSetMerkleRoot{
+				Admin:      invalidAddress,
+				AirdropId:  validAirdropId,
+				MerkleRoot: validMerkleRoot,
+
+				MerkleTotalAllocated: sdkmath.NewInt(1000),
+			},
+			expectedError: "invalid address",
+		},
+		{
+			name: "missing airdrop id",
+			msg: types.MsgSetMerkleRoot{
+				Admin:      validAddress,
+				AirdropId:  "",
+				MerkleRoot: validMerkleRoot,
+
+				MerkleTotalAllocated: sdkmath.NewInt(1000),
+			},
+			expectedError: "airdrop-id must be specified",
+		},
+		{
+			name: "missing merkle total allocated",
+			msg: types.MsgSetMerkleRoot{
+				Admin:      validAddress,
+				AirdropId:  validAirdropId,
+				MerkleRoot: validMerkleRoot,
+			},
+			expectedError: "merkle total allocated must be positive",
+		},
+		{
+			name: "zero merkle total allocated",
+			msg: types.MsgSetMerkleRoot{
+				Admin:      validAddress,
+				AirdropId:  validAirdropId,
+				MerkleRoot: validMerkleRoot,
+
+				MerkleTotalAllocated: sdkmath.ZeroInt(),
+			},
+			expectedError: "merkle total allocated must be positive",
+		},
+		{
+			name: "missing merkle root",
+			msg: types.MsgSetMerkleRoot{
+				Admin:      validAddress,
+				AirdropId:  validAirdropId,
+				MerkleRoot: "",
+
+				MerkleTotalAllocated: sdkmath.NewInt(1000),
+			},
+			expectedError: "merkle root must be a hex encoded sha256 hash",
+		},
+		{
+			name: "non-hex merkle root",
+			msg: types.MsgSetMerkleRoot{
+				Admin:      validAddress,
+				AirdropId:  validAirdropId,
+				MerkleRoot: strings.Repeat("zz", 32),
+
+				MerkleTotalAllocated: sdkmath.NewInt(1000),
+			},
+			expectedError: "merkle root must be a hex encoded sha256 hash",
+		},
+		{
+			name: "invalid merkle root length",
+			msg: types.MsgSetMerkleRoot{
+				Admin:      validAddress,
+				AirdropId:  validAirdropId,
+				MerkleRoot: "abab",
+
+				MerkleTotalAllocated: sdkmath.NewInt(1000),
+			},
+			expectedError: "merkle root must be a hex encoded sha256 hash",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actualError := tc.msg.ValidateBasic()
+			if tc.expectedError != "" {
+				require.ErrorContains(t, actualError, tc.expectedError)
+				return
+			}
+			require.NoError(t, actualError)
+		})
+	}
+}
+
+func TestMsgSetMerkleRoot_GetSignBytes(t *testing.T) {
+	admin := "admin"
+	airdropId := "airdrop"
+	merkleRoot := "root"
+
+	msg := types.NewMsgSetMerkleRoot(admin, airdropId, merkleRoot, sdkmath.NewInt(1000))
+	res := msg.GetSignBytes()
+
+	expected := `{"type":"airdrop/MsgSetMerkleRoot","value":{"admin":"admin","airdrop_id":"airdrop","merkle_root":"root","merkle_total_allocated":"1000"}}`
+	require.Equal(t, expected, string(res))
+}
+
+// ----------------------------------------------
+//               MsgClaimAndStake
+// ----------------------------------------------
+
+func TestMsgClaimAndStake_ValidateBasic(t *testing.T) {
+	apptesting.SetupConfig()
+
+	validAddress, invalidAddress := apptesting.GenerateTestAddrs()
+	validAirdropId := "airdrop-1"
+
+	tests := []struct {
+		name          string
+		msg           types.MsgClaimAndStake
+		expectedError string
+	}{
+		{
+			name: "valid message",
+			msg: types.MsgClaimAndStake{
+				Claimer:   validAddress,
+				AirdropId: validAirdropId,
+			},
+		},
+		{
+			name: "valid message with proof",
+			msg: types.MsgClaimAndStake{
+				Claimer:   validAddress,
+				AirdropId: validAirdropId,
+				AllocationProof: &types.AllocationProof{
+					Allocations: []sdkmath.Int{sdkmath.NewInt(10)},
+					Proof:       []string{"aa"},
+				},
+			},
+		},
+		{
+			name: "invalid address",
+			msg: types.MsgClaimAndStake{
+				Claimer:   invalidAddress,
+				AirdropId: validAirdropId,
+			},
+			expectedError: "invalid address",
+		},
+		{
+			name: "missing airdrop id",
+			msg: types.MsgClaimAndStake{
+				Claimer:   validAddress,
+				AirdropId: "",
+			},
+			expectedError: "airdrop-id must be specified",
+		},
+		{
+			name: "invalid proof",
+			msg: types.MsgClaimAndStake{
+				Claimer:         validAddress,
+				AirdropId:       validAirdropId,
+				AllocationProof: &types.AllocationProof{},
+			},
+			expectedError: "allocations must be specified with the proof",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actualError := tc.msg.ValidateBasic()
+			if tc.expectedError != "" {
+				require.ErrorContains(t, actualError, tc.expectedError)
+				return
+			}
+			require.NoError(t, actualError)
+		})
+	}
+}
+
+func TestMsgClaimAndStake_GetSignBytes(t *testing.T) {
+	addr := "strideXXX"
+	airdropId := "airdrop"
+	msg := types.NewMsgClaimAndStake(addr, airdropId)
+	res := msg.GetSignBytes()
+
+	expected := `{"type":"airdrop/MsgClaimAndStake","value":{"airdrop_id":"airdrop","claimer":"strideXXX"}}`
+	require.Equal(t, expected, string(res))
+}
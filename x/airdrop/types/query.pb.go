@@ -111,6 +111,20 @@ type QueryAirdropResponse struct {
 	CurrentDateIndex int64 `protobuf:"varint,11,opt,name=current_date_index,json=currentDateIndex,proto3" json:"current_date_index,omitempty"`
 	// The length of the airdrop (i.e. number of periods in the airdrop array)
 	AirdropLength int64 `protobuf:"varint,12,opt,name=airdrop_length,json=airdropLength,proto3" json:"airdrop_length,omitempty"`
+	// Hex encoded merkle root of the user allocations (if applicable)
+	MerkleRoot string `protobuf:"bytes,13,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// Bonus paid on rewards claimed with MsgClaimAndStake
+	ClaimAndStakeBonus cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=claim_and_stake_bonus,json=claimAndStakeBonus,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"claim_and_stake_bonus"`
+	// Forfeited rewards that have not yet been paid out as bonuses
+	ForfeitedPool cosmossdk_io_math.Int `protobuf:"bytes,15,opt,name=forfeited_pool,json=forfeitedPool,proto3,customtype=cosmossdk.io/math.Int" json:"forfeited_pool"`
+	// Destination of the remaining rewards once the clawback date is reached
+	ClawbackDestination ClawbackDestination `protobuf:"varint,16,opt,name=clawback_destination,json=clawbackDestination,proto3,enum=stride.airdrop.ClawbackDestination" json:"clawback_destination,omitempty"`
+	// ID of the airdrop that receives the remaining rewards (if applicable)
+	ClawbackAirdropId string `protobuf:"bytes,17,opt,name=clawback_airdrop_id,json=clawbackAirdropId,proto3" json:"clawback_airdrop_id,omitempty"`
+	// Whether forfeited rewards are redistributed to daily claimers at clawback
+	RedistributeForfeited bool `protobuf:"varint,18,opt,name=redistribute_forfeited,json=redistributeForfeited,proto3" json:"redistribute_forfeited,omitempty"`
+	// Indicates whether the clawback has already been processed
+	ClawedBack bool `protobuf:"varint,19,opt,name=clawed_back,json=clawedBack,proto3" json:"clawed_back,omitempty"`
 }
 
 func (m *QueryAirdropResponse) Reset()         { *m = QueryAirdropResponse{} }
@@ -223,6 +237,41 @@ func (m *QueryAirdropResponse) GetAirdropLength() int64 {
 	return 0
 }
 
+func (m *QueryAirdropResponse) GetMerkleRoot() string {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return ""
+}
+
+func (m *QueryAirdropResponse) GetClawbackDestination() ClawbackDestination {
+	if m != nil {
+		return m.ClawbackDestination
+	}
+	return CLAWBACK_DESTINATION_UNSPECIFIED
+}
+
+func (m *QueryAirdropResponse) GetClawbackAirdropId() string {
+	if m != nil {
+		return m.ClawbackAirdropId
+	}
+	return ""
+}
+
+func (m *QueryAirdropResponse) GetRedistributeForfeited() bool {
+	if m != nil {
+		return m.RedistributeForfeited
+	}
+	return false
+}
+
+func (m *QueryAirdropResponse) GetClawedBack() bool {
+	if m != nil {
+		return m.ClawedBack
+	}
+	return false
+}
+
 // Airdrops
 type QueryAllAirdropsRequest struct {
 }
@@ -701,6 +750,158 @@ func (m *QueryUserSummaryResponse) GetClaimType() string {
 	return ""
 }
 
+// AirdropAccounting
+type QueryAirdropAccountingRequest struct {
+	AirdropId string `protobuf:"bytes,1,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+}
+
+func (m *QueryAirdropAccountingRequest) Reset()         { *m = QueryAirdropAccountingRequest{} }
+func (m *QueryAirdropAccountingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAirdropAccountingRequest) ProtoMessage()    {}
+func (*QueryAirdropAccountingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28cd033986bfea74, []int{12}
+}
+func (m *QueryAirdropAccountingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAirdropAccountingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAirdropAccountingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAirdropAccountingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAirdropAccountingRequest.Merge(m, src)
+}
+func (m *QueryAirdropAccountingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAirdropAccountingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAirdropAccountingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAirdropAccountingRequest proto.InternalMessageInfo
+
+func (m *QueryAirdropAccountingRequest) GetAirdropId() string {
+	if m != nil {
+		return m.AirdropId
+	}
+	return ""
+}
+
+type QueryAirdropAccountingResponse struct {
+	// Airdrop ID
+	AirdropId string `protobuf:"bytes,1,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+	// Denom used when distributing rewards
+	RewardDenom string `protobuf:"bytes,2,opt,name=reward_denom,json=rewardDenom,proto3" json:"reward_denom,omitempty"`
+	// The total rewards allocated to users with an allocation record
+	TotalAllocated cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=total_allocated,json=totalAllocated,proto3,customtype=cosmossdk.io/math.Int" json:"total_allocated"`
+	// The total rewards claimed by users
+	TotalClaimed cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=total_claimed,json=totalClaimed,proto3,customtype=cosmossdk.io/math.Int" json:"total_claimed"`
+	// The total rewards forfeited by users that claimed early
+	TotalForfeited cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=total_forfeited,json=totalForfeited,proto3,customtype=cosmossdk.io/math.Int" json:"total_forfeited"`
+	// The total rewards that have not yet been claimed or forfeited
+	TotalUnclaimed cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=total_unclaimed,json=totalUnclaimed,proto3,customtype=cosmossdk.io/math.Int" json:"total_unclaimed"`
+	// Forfeited rewards that have not yet been paid out as bonuses or
+	// redistributed
+	ForfeitedPool cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=forfeited_pool,json=forfeitedPool,proto3,customtype=cosmossdk.io/math.Int" json:"forfeited_pool"`
+	// The total forfeited rewards that were redistributed to daily claimers
+	RedistributedAmount cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=redistributed_amount,json=redistributedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"redistributed_amount"`
+	// The total rewards sent to the clawback destination
+	ClawedBackAmount cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=clawed_back_amount,json=clawedBackAmount,proto3,customtype=cosmossdk.io/math.Int" json:"clawed_back_amount"`
+	// The current reward denom balance of the distributor account
+	DistributorBalance cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=distributor_balance,json=distributorBalance,proto3,customtype=cosmossdk.io/math.Int" json:"distributor_balance"`
+	// Destination of the remaining rewards once the clawback date is reached
+	ClawbackDestination ClawbackDestination `protobuf:"varint,11,opt,name=clawback_destination,json=clawbackDestination,proto3,enum=stride.airdrop.ClawbackDestination" json:"clawback_destination,omitempty"`
+	// Indicates whether the clawback has already been processed
+	ClawedBack bool `protobuf:"varint,12,opt,name=clawed_back,json=clawedBack,proto3" json:"clawed_back,omitempty"`
+	// The sum of all allocations committed to in the merkle root
+	MerkleTotalAllocated cosmossdk_io_math.Int `protobuf:"bytes,13,opt,name=merkle_total_allocated,json=merkleTotalAllocated,proto3,customtype=cosmossdk.io/math.Int" json:"merkle_total_allocated"`
+	// Merkle allocations that have not yet been initialized from a proof (these
+	// are included in the total allocated and total unclaimed)
+	MerkleUninitialized cosmossdk_io_math.Int `protobuf:"bytes,14,opt,name=merkle_uninitialized,json=merkleUninitialized,proto3,customtype=cosmossdk.io/math.Int" json:"merkle_uninitialized"`
+	// The rewards owed by the distributor for this airdrop, which is the amount
+	// that will be clawed back
+	UnclaimedBudget cosmossdk_io_math.Int `protobuf:"bytes,15,opt,name=unclaimed_budget,json=unclaimedBudget,proto3,customtype=cosmossdk.io/math.Int" json:"unclaimed_budget"`
+	// Indicates the clawback failed and will not be retried until the airdrop
+	// is updated
+	ClawbackFailed bool `protobuf:"varint,16,opt,name=clawback_failed,json=clawbackFailed,proto3" json:"clawback_failed,omitempty"`
+}
+
+func (m *QueryAirdropAccountingResponse) Reset()         { *m = QueryAirdropAccountingResponse{} }
+func (m *QueryAirdropAccountingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAirdropAccountingResponse) ProtoMessage()    {}
+func (*QueryAirdropAccountingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28cd033986bfea74, []int{13}
+}
+func (m *QueryAirdropAccountingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAirdropAccountingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAirdropAccountingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAirdropAccountingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAirdropAccountingResponse.Merge(m, src)
+}
+func (m *QueryAirdropAccountingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAirdropAccountingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAirdropAccountingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAirdropAccountingResponse proto.InternalMessageInfo
+
+func (m *QueryAirdropAccountingResponse) GetAirdropId() string {
+	if m != nil {
+		return m.AirdropId
+	}
+	return ""
+}
+
+func (m *QueryAirdropAccountingResponse) GetRewardDenom() string {
+	if m != nil {
+		return m.RewardDenom
+	}
+	return ""
+}
+
+func (m *QueryAirdropAccountingResponse) GetClawbackDestination() ClawbackDestination {
+	if m != nil {
+		return m.ClawbackDestination
+	}
+	return CLAWBACK_DESTINATION_UNSPECIFIED
+}
+
+func (m *QueryAirdropAccountingResponse) GetClawedBack() bool {
+	if m != nil {
+		return m.ClawedBack
+	}
+	return false
+}
+
+func (m *QueryAirdropAccountingResponse) GetClawbackFailed() bool {
+	if m != nil {
+		return m.ClawbackFailed
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryAirdropRequest)(nil), "stride.airdrop.QueryAirdropRequest")
 	proto.RegisterType((*QueryAirdropResponse)(nil), "stride.airdrop.QueryAirdropResponse")
@@ -714,85 +915,114 @@ func init() {
 	proto.RegisterType((*QueryAllAllocationsResponse)(nil), "stride.airdrop.QueryAllAllocationsResponse")
 	proto.RegisterType((*QueryUserSummaryRequest)(nil), "stride.airdrop.QueryUserSummaryRequest")
 	proto.RegisterType((*QueryUserSummaryResponse)(nil), "stride.airdrop.QueryUserSummaryResponse")
+	proto.RegisterType((*QueryAirdropAccountingRequest)(nil), "stride.airdrop.QueryAirdropAccountingRequest")
+	proto.RegisterType((*QueryAirdropAccountingResponse)(nil), "stride.airdrop.QueryAirdropAccountingResponse")
 }
 
 func init() { proto.RegisterFile("stride/airdrop/query.proto", fileDescriptor_28cd033986bfea74) }
 
 var fileDescriptor_28cd033986bfea74 = []byte{
-	// 1155 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0x3a, 0x69, 0xd2, 0x3c, 0x27, 0x4e, 0x3a, 0xc9, 0x5f, 0xd9, 0xba, 0xad, 0x93, 0xff,
-	0xb6, 0x4d, 0xad, 0x90, 0xec, 0x12, 0x23, 0x41, 0xe1, 0x40, 0x49, 0x48, 0x1a, 0x02, 0x91, 0x68,
-	0x9d, 0x54, 0x02, 0x2e, 0xab, 0xb1, 0x77, 0xb2, 0x19, 0x65, 0xbd, 0xe3, 0xee, 0xac, 0xdb, 0x5a,
-	0x51, 0x2e, 0x20, 0x71, 0x44, 0x95, 0xb8, 0xf0, 0x15, 0x10, 0x88, 0x13, 0x7c, 0x87, 0x1e, 0x2b,
-	0xb8, 0x20, 0x0e, 0x05, 0x25, 0x5c, 0xf9, 0x0e, 0x68, 0x67, 0x66, 0xed, 0xb5, 0xb3, 0x8e, 0xdd,
-	0x48, 0x9c, 0xec, 0x99, 0xf7, 0xde, 0xef, 0xfd, 0xde, 0x9b, 0x79, 0xbf, 0x59, 0xc8, 0xf3, 0x30,
-	0xa0, 0x0e, 0xb1, 0x30, 0x0d, 0x9c, 0x80, 0xd5, 0xad, 0xc7, 0x0d, 0x12, 0x34, 0xcd, 0x7a, 0xc0,
-	0x42, 0x86, 0x72, 0xd2, 0x66, 0x2a, 0x5b, 0xfe, 0x6a, 0x95, 0xf1, 0x1a, 0xe3, 0xb6, 0xb0, 0x5a,
-	0x72, 0x21, 0x5d, 0xf3, 0x4b, 0x72, 0x65, 0x55, 0x30, 0x27, 0x12, 0xc3, 0x7a, 0xb2, 0x5a, 0x21,
-	0x21, 0x5e, 0xb5, 0xea, 0xd8, 0xa5, 0x3e, 0x0e, 0x29, 0xf3, 0x95, 0xef, 0xac, 0xcb, 0x5c, 0x26,
-	0x31, 0xa2, 0x7f, 0x6a, 0xf7, 0xba, 0xcb, 0x98, 0xeb, 0x11, 0x0b, 0xd7, 0xa9, 0x85, 0x7d, 0x9f,
-	0x85, 0x22, 0x24, 0xc6, 0x9f, 0x57, 0x56, 0xb1, 0xaa, 0x34, 0xf6, 0xad, 0x90, 0xd6, 0x08, 0x0f,
-	0x71, 0xad, 0x1e, 0x87, 0x77, 0xd5, 0xa1, 0x7e, 0xa5, 0xd5, 0xb8, 0x0d, 0x33, 0x0f, 0x23, 0x52,
-	0x6b, 0x72, 0xb7, 0x4c, 0x1e, 0x37, 0x08, 0x0f, 0x51, 0x0e, 0x32, 0xd4, 0xd1, 0xb5, 0x05, 0xad,
-	0x38, 0x5e, 0xce, 0x50, 0xc7, 0xf8, 0x6e, 0x14, 0x66, 0x3b, 0xfd, 0x78, 0x9d, 0xf9, 0x9c, 0x74,
-	0x3b, 0xa2, 0xff, 0xc3, 0x44, 0x40, 0x9e, 0xe2, 0xc0, 0xb1, 0x1d, 0xe2, 0xb3, 0x9a, 0x9e, 0x11,
-	0x96, 0xac, 0xdc, 0xdb, 0x88, 0xb6, 0xd0, 0x67, 0x30, 0xe7, 0xd0, 0x88, 0x54, 0xa5, 0x11, 0x15,
-	0x62, 0xf3, 0x10, 0x07, 0xa1, 0xed, 0xe0, 0x90, 0xe8, 0xc3, 0x0b, 0x5a, 0x31, 0x5b, 0xca, 0x9b,
-	0xb2, 0x26, 0x33, 0xae, 0xc9, 0xdc, 0x8b, 0x6b, 0x5a, 0x1f, 0x79, 0xfe, 0xe7, 0xbc, 0x56, 0xfe,
-	0x5f, 0x12, 0x60, 0x37, 0x8a, 0xdf, 0xc0, 0x21, 0x41, 0x7b, 0xd0, 0x61, 0xb0, 0x89, 0xef, 0x48,
-	0xdc, 0x91, 0x01, 0x71, 0x67, 0x92, 0xe1, 0x9b, 0xbe, 0x23, 0x50, 0x37, 0x61, 0xb2, 0xea, 0xe1,
-	0xa7, 0x15, 0x5c, 0x3d, 0x94, 0x68, 0x97, 0x06, 0x44, 0x9b, 0x88, 0xc3, 0x04, 0xcc, 0xe7, 0xa0,
-	0x57, 0x3d, 0x4c, 0x6b, 0x76, 0xd8, 0xac, 0x13, 0xdb, 0x21, 0xd8, 0xf1, 0xa8, 0x4f, 0x24, 0xe2,
-	0xe8, 0xa0, 0x75, 0x0b, 0x84, 0xbd, 0x66, 0x9d, 0x6c, 0xa8, 0x78, 0x01, 0xbd, 0x0b, 0x33, 0x04,
-	0x07, 0x5e, 0xd3, 0x96, 0x09, 0xea, 0xc4, 0xc7, 0x5e, 0xd8, 0xd4, 0xc7, 0xa2, 0xde, 0xaf, 0xdf,
-	0x7c, 0xf1, 0x6a, 0x7e, 0xe8, 0x8f, 0x57, 0xf3, 0xd7, 0xe4, 0x45, 0xe4, 0xce, 0xa1, 0x49, 0x99,
-	0x55, 0xc3, 0xe1, 0x81, 0xb9, 0x43, 0x5c, 0x5c, 0x6d, 0x6e, 0x90, 0x6a, 0xf9, 0x8a, 0x88, 0xff,
-	0x30, 0x0a, 0x7f, 0x20, 0xa3, 0xd1, 0x36, 0xb4, 0xbb, 0xc1, 0x02, 0x1b, 0x3b, 0x4e, 0x40, 0x38,
-	0xd7, 0x2f, 0x0b, 0x50, 0xfd, 0xd7, 0x9f, 0x57, 0x66, 0xd5, 0x3d, 0x5f, 0x93, 0x96, 0xdd, 0x30,
-	0xa0, 0xbe, 0x5b, 0x46, 0x89, 0x20, 0x65, 0x41, 0x9b, 0x70, 0x05, 0x7b, 0x1e, 0xab, 0xe2, 0x24,
-	0xd0, 0x78, 0x1f, 0xa0, 0xe9, 0x56, 0x48, 0x0c, 0x73, 0x0f, 0x72, 0x1e, 0xf5, 0x0f, 0x49, 0x1b,
-	0x03, 0xfa, 0x60, 0x4c, 0x4a, 0xff, 0x18, 0x60, 0x19, 0x50, 0xb5, 0x11, 0x04, 0xc4, 0x97, 0xd7,
-	0xcd, 0xa6, 0xbe, 0x43, 0x9e, 0xe9, 0xd9, 0x05, 0xad, 0x38, 0x5c, 0x9e, 0x56, 0x96, 0xa8, 0xa1,
-	0xdb, 0xd1, 0x3e, 0xba, 0x0d, 0x39, 0x35, 0x2b, 0xb6, 0x47, 0x7c, 0x37, 0x3c, 0xd0, 0x27, 0x84,
-	0xe7, 0xa4, 0xda, 0xdd, 0x11, 0x9b, 0xc6, 0x55, 0x98, 0x93, 0x93, 0xe1, 0x79, 0x6a, 0x38, 0xb8,
-	0x9a, 0x22, 0xe3, 0x11, 0xe8, 0x67, 0x4d, 0x6a, 0x70, 0xde, 0x85, 0xcb, 0x0a, 0x87, 0xeb, 0xda,
-	0xc2, 0x70, 0x31, 0x5b, 0x9a, 0x33, 0x3b, 0x55, 0xc5, 0x54, 0x31, 0xeb, 0x23, 0xd1, 0x09, 0x96,
-	0x5b, 0xee, 0x06, 0x83, 0xbc, 0x80, 0x7d, 0xc4, 0x49, 0xb0, 0x26, 0x9b, 0x44, 0x99, 0x1f, 0x8f,
-	0xee, 0x0d, 0x80, 0x98, 0x76, 0x6b, 0x32, 0xc7, 0xd5, 0xce, 0xb6, 0x83, 0x4a, 0x30, 0x16, 0x77,
-	0x2f, 0xd3, 0xa7, 0x7b, 0xb1, 0xa3, 0xb1, 0x0f, 0xd7, 0x52, 0x13, 0xaa, 0x52, 0xb6, 0x60, 0xaa,
-	0xc1, 0xa3, 0x53, 0x69, 0x99, 0x44, 0xda, 0x6c, 0xa9, 0xd0, 0x5d, 0x51, 0x17, 0x40, 0xae, 0xd1,
-	0xb1, 0x36, 0x1e, 0xa6, 0xe6, 0x89, 0xdb, 0x99, 0xa4, 0xae, 0x0d, 0x4a, 0x9d, 0xc1, 0xf5, 0x74,
-	0x48, 0xc5, 0xfd, 0x53, 0x98, 0xee, 0xe2, 0x1e, 0x1f, 0x47, 0x1f, 0xf2, 0xea, 0x54, 0xa6, 0x3a,
-	0x4b, 0xe0, 0xc6, 0x57, 0x9a, 0x3a, 0x9d, 0xe8, 0xd0, 0xcf, 0xd6, 0xd0, 0xe7, 0x74, 0xee, 0x03,
-	0xb4, 0x5f, 0x05, 0x71, 0x40, 0xd9, 0xd2, 0xa2, 0xa9, 0x4a, 0x8c, 0x9e, 0x10, 0x53, 0x3e, 0x43,
-	0xea, 0x09, 0x31, 0x1f, 0x60, 0x97, 0x28, 0xe8, 0x72, 0x22, 0xd2, 0xf8, 0x49, 0x53, 0xad, 0xec,
-	0x66, 0xa1, 0xca, 0xbe, 0x0f, 0xd9, 0x8b, 0x56, 0x9c, 0x0c, 0x44, 0x5b, 0x29, 0x7c, 0xef, 0xf4,
-	0xe5, 0x2b, 0x49, 0x74, 0x10, 0xf6, 0xd4, 0x14, 0x45, 0x29, 0x77, 0x1b, 0xb5, 0x1a, 0x0e, 0x9a,
-	0xff, 0xe1, 0x85, 0xfe, 0x27, 0xa3, 0x26, 0xb3, 0x23, 0x9d, 0xea, 0xcd, 0x0d, 0x80, 0xb6, 0x50,
-	0xc7, 0xf9, 0x5a, 0xc2, 0x8b, 0x3e, 0x82, 0x31, 0xb1, 0x20, 0x8e, 0xca, 0x67, 0x2a, 0x81, 0x5d,
-	0x74, 0x69, 0x78, 0xd0, 0xa8, 0x98, 0x55, 0x56, 0x53, 0x9f, 0x00, 0xea, 0x67, 0x85, 0x3b, 0x87,
-	0x56, 0x04, 0xc6, 0xcd, 0x6d, 0x3f, 0x2c, 0xc7, 0xe1, 0x68, 0x07, 0xc6, 0xf7, 0x59, 0xb0, 0x4f,
-	0x68, 0x48, 0x1c, 0xf1, 0xf4, 0xbd, 0x3e, 0x56, 0x1b, 0x20, 0x42, 0x0b, 0x48, 0x0d, 0x53, 0x9f,
-	0xfa, 0xae, 0x78, 0xf0, 0x2e, 0x80, 0xd6, 0x02, 0x88, 0xd0, 0x04, 0x4d, 0x5c, 0xf1, 0xe4, 0x83,
-	0x77, 0x01, 0xb4, 0x16, 0x40, 0xe9, 0x64, 0x0c, 0x2e, 0x89, 0x7e, 0xa3, 0xaf, 0x35, 0x18, 0x53,
-	0xba, 0x86, 0x6e, 0x76, 0xdf, 0xb7, 0x94, 0x2f, 0x91, 0xfc, 0xad, 0xf3, 0x9d, 0xe4, 0x99, 0x19,
-	0x6f, 0x7e, 0xf9, 0xdb, 0xdf, 0xdf, 0x66, 0x96, 0x50, 0xd1, 0xda, 0x15, 0xde, 0x2b, 0x3b, 0xb8,
-	0xc2, 0xad, 0xf4, 0x2f, 0x1f, 0xeb, 0x88, 0x3a, 0xc7, 0xe8, 0x1b, 0x0d, 0xb2, 0x09, 0x5d, 0x46,
-	0x77, 0xd2, 0xf3, 0x9c, 0x11, 0xf5, 0x7c, 0xb1, 0xbf, 0xa3, 0x22, 0xb5, 0x2c, 0x48, 0x2d, 0xa2,
-	0x5b, 0x03, 0x90, 0xe2, 0xe8, 0x17, 0x0d, 0x72, 0x9d, 0x03, 0x87, 0x96, 0x52, 0x53, 0xa5, 0xca,
-	0x7e, 0xfe, 0x8d, 0x81, 0x7c, 0x15, 0xb3, 0x8f, 0x05, 0xb3, 0x0d, 0xb4, 0x7e, 0x1e, 0xb3, 0x2e,
-	0x5d, 0xb4, 0x8e, 0xda, 0x53, 0x78, 0x6c, 0x1d, 0xa9, 0x51, 0x3a, 0x46, 0x3f, 0x6a, 0x30, 0xd5,
-	0xa5, 0xae, 0x68, 0x10, 0x32, 0xad, 0x86, 0x2e, 0x0f, 0xe6, 0xac, 0xa8, 0xbf, 0x2f, 0xa8, 0xdf,
-	0x45, 0x6f, 0xbf, 0x06, 0x75, 0x9e, 0xa0, 0xfb, 0x83, 0x06, 0xb9, 0x4e, 0x51, 0xec, 0xd1, 0xe6,
-	0x54, 0xfd, 0xee, 0xd1, 0xe6, 0x74, 0x95, 0x35, 0x3e, 0x10, 0x5c, 0xdf, 0x43, 0x77, 0xcf, 0xbd,
-	0x00, 0x9e, 0xd7, 0x45, 0xb5, 0xdd, 0x66, 0xf4, 0xbd, 0x06, 0xd9, 0x84, 0x46, 0xf5, 0xb8, 0xa5,
-	0x67, 0x45, 0xb3, 0xc7, 0x2d, 0x4d, 0x91, 0x3b, 0x63, 0x4b, 0x90, 0x5c, 0x43, 0xf7, 0xfa, 0x36,
-	0x94, 0xcb, 0xc8, 0x1e, 0x17, 0x61, 0xfd, 0x93, 0x17, 0x27, 0x05, 0xed, 0xe5, 0x49, 0x41, 0xfb,
-	0xeb, 0xa4, 0xa0, 0x3d, 0x3f, 0x2d, 0x0c, 0xbd, 0x3c, 0x2d, 0x0c, 0xfd, 0x7e, 0x5a, 0x18, 0xfa,
-	0x62, 0x35, 0xa1, 0x18, 0x29, 0x49, 0x9e, 0x94, 0xde, 0xb1, 0x9e, 0xb5, 0x52, 0x09, 0x01, 0xa9,
-	0x8c, 0x8a, 0x6f, 0xe0, 0xb7, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x05, 0x21, 0x08, 0xc4, 0x86,
-	0x0d, 0x00, 0x00,
+	// 1592 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x5f, 0x6f, 0x1b, 0xc5,
+	0x16, 0xcf, 0x26, 0x69, 0x93, 0x1c, 0x27, 0x4e, 0x32, 0x49, 0xdb, 0xad, 0xdb, 0x38, 0xb9, 0xee,
+	0x3f, 0xab, 0x6d, 0x76, 0x6f, 0x7d, 0x75, 0xef, 0x2d, 0x20, 0x5a, 0xec, 0xa4, 0x69, 0x43, 0x23,
+	0x48, 0x9d, 0xa4, 0x02, 0x5e, 0x56, 0x63, 0xcf, 0xc4, 0x5d, 0x65, 0xbd, 0xe3, 0xee, 0x8e, 0xdb,
+	0x9a, 0xaa, 0x2f, 0x20, 0xf1, 0x88, 0x2a, 0xf1, 0xca, 0x17, 0x40, 0x20, 0x9e, 0x40, 0xfd, 0x06,
+	0xa8, 0x8f, 0x15, 0xbc, 0x20, 0x1e, 0x0a, 0x6a, 0x79, 0xe5, 0x3b, 0xa0, 0x9d, 0x99, 0x5d, 0xaf,
+	0xff, 0x24, 0x5e, 0x82, 0x78, 0x72, 0x76, 0xce, 0xf9, 0xfd, 0xe6, 0x77, 0xce, 0x9c, 0x3d, 0x73,
+	0x36, 0x90, 0xf1, 0xb9, 0x67, 0x13, 0x6a, 0x62, 0xdb, 0x23, 0x1e, 0x6b, 0x98, 0xf7, 0x9b, 0xd4,
+	0x6b, 0x19, 0x0d, 0x8f, 0x71, 0x86, 0xd2, 0xd2, 0x66, 0x28, 0x5b, 0xe6, 0x64, 0x95, 0xf9, 0x75,
+	0xe6, 0x5b, 0xc2, 0x6a, 0xca, 0x07, 0xe9, 0x9a, 0xb9, 0x28, 0x9f, 0xcc, 0x0a, 0xf6, 0xa9, 0xe4,
+	0x30, 0x1f, 0x5c, 0xa9, 0x50, 0x8e, 0xaf, 0x98, 0x0d, 0x5c, 0xb3, 0x5d, 0xcc, 0x6d, 0xe6, 0x2a,
+	0xdf, 0xf9, 0x1a, 0xab, 0x31, 0xc9, 0x11, 0xfc, 0xa5, 0x56, 0x4f, 0xd7, 0x18, 0xab, 0x39, 0xd4,
+	0xc4, 0x0d, 0xdb, 0xc4, 0xae, 0xcb, 0xb8, 0x80, 0x84, 0xfc, 0x8b, 0xca, 0x2a, 0x9e, 0x2a, 0xcd,
+	0x5d, 0x93, 0xdb, 0x75, 0xea, 0x73, 0x5c, 0x6f, 0x84, 0xf0, 0xae, 0x38, 0xd4, 0xaf, 0xb4, 0xe6,
+	0xce, 0xc1, 0xdc, 0x9d, 0x40, 0x54, 0x51, 0xae, 0x96, 0xe9, 0xfd, 0x26, 0xf5, 0x39, 0x4a, 0xc3,
+	0xb0, 0x4d, 0x74, 0x6d, 0x49, 0xcb, 0x4f, 0x94, 0x87, 0x6d, 0x92, 0x7b, 0x36, 0x01, 0xf3, 0x9d,
+	0x7e, 0x7e, 0x83, 0xb9, 0x3e, 0xed, 0x76, 0x44, 0xff, 0x82, 0x49, 0x8f, 0x3e, 0xc4, 0x1e, 0xb1,
+	0x08, 0x75, 0x59, 0x5d, 0x1f, 0x16, 0x96, 0x94, 0x5c, 0x5b, 0x0d, 0x96, 0xd0, 0x07, 0x70, 0x82,
+	0xd8, 0x81, 0xa8, 0x4a, 0x33, 0x08, 0xc4, 0xf2, 0x39, 0xf6, 0xb8, 0x45, 0x30, 0xa7, 0xfa, 0xc8,
+	0x92, 0x96, 0x4f, 0x15, 0x32, 0x86, 0x8c, 0xc9, 0x08, 0x63, 0x32, 0xb6, 0xc3, 0x98, 0x4a, 0xa3,
+	0x4f, 0x7f, 0x5d, 0xd4, 0xca, 0xc7, 0xe2, 0x04, 0x5b, 0x01, 0x7e, 0x15, 0x73, 0x8a, 0xb6, 0xa1,
+	0xc3, 0x60, 0x51, 0x97, 0x48, 0xde, 0xd1, 0x84, 0xbc, 0x73, 0x71, 0xf8, 0x0d, 0x97, 0x08, 0xd6,
+	0x1b, 0x30, 0x55, 0x75, 0xf0, 0xc3, 0x0a, 0xae, 0xee, 0x49, 0xb6, 0x23, 0x09, 0xd9, 0x26, 0x43,
+	0x98, 0xa0, 0xf9, 0x10, 0xf4, 0xaa, 0x83, 0xed, 0xba, 0xc5, 0x5b, 0x0d, 0x6a, 0x11, 0x8a, 0x89,
+	0x63, 0xbb, 0x54, 0x32, 0x1e, 0x4d, 0x1a, 0xb7, 0x60, 0xd8, 0x6e, 0x35, 0xe8, 0xaa, 0xc2, 0x0b,
+	0xea, 0x2d, 0x98, 0xa3, 0xd8, 0x73, 0x5a, 0x96, 0xdc, 0xa0, 0x41, 0x5d, 0xec, 0xf0, 0x96, 0x3e,
+	0x16, 0xe4, 0xbe, 0x74, 0xe6, 0xf9, 0xcb, 0xc5, 0xa1, 0x5f, 0x5e, 0x2e, 0x9e, 0x92, 0x85, 0xe8,
+	0x93, 0x3d, 0xc3, 0x66, 0x66, 0x1d, 0xf3, 0x7b, 0xc6, 0x06, 0xad, 0xe1, 0x6a, 0x6b, 0x95, 0x56,
+	0xcb, 0xb3, 0x02, 0xbf, 0x12, 0xc0, 0x37, 0x25, 0x1a, 0xad, 0x43, 0x3b, 0x1b, 0xcc, 0xb3, 0x30,
+	0x21, 0x1e, 0xf5, 0x7d, 0x7d, 0x5c, 0x90, 0xea, 0x3f, 0x7e, 0xb7, 0x3c, 0xaf, 0xea, 0xbc, 0x28,
+	0x2d, 0x5b, 0xdc, 0xb3, 0xdd, 0x5a, 0x19, 0xc5, 0x40, 0xca, 0x82, 0x6e, 0xc0, 0x2c, 0x76, 0x1c,
+	0x56, 0xc5, 0x71, 0xa2, 0x89, 0x01, 0x44, 0x33, 0x11, 0x24, 0xa4, 0xb9, 0x0e, 0x69, 0xc7, 0x76,
+	0xf7, 0x68, 0x9b, 0x03, 0x06, 0x70, 0x4c, 0x49, 0xff, 0x90, 0xe0, 0x32, 0xa0, 0x6a, 0xd3, 0xf3,
+	0xa8, 0x2b, 0xcb, 0xcd, 0xb2, 0x5d, 0x42, 0x1f, 0xe9, 0xa9, 0x25, 0x2d, 0x3f, 0x52, 0x9e, 0x51,
+	0x96, 0x20, 0xa1, 0xeb, 0xc1, 0x3a, 0x3a, 0x07, 0x69, 0xf5, 0xae, 0x58, 0x0e, 0x75, 0x6b, 0xfc,
+	0x9e, 0x3e, 0x29, 0x3c, 0xa7, 0xd4, 0xea, 0x86, 0x58, 0x44, 0x8b, 0x90, 0xaa, 0x53, 0x6f, 0xcf,
+	0xa1, 0x96, 0xc7, 0x18, 0xd7, 0xa7, 0x44, 0xc1, 0x83, 0x5c, 0x2a, 0x33, 0xc6, 0xd1, 0x5d, 0x90,
+	0xc7, 0x66, 0x61, 0x97, 0x04, 0xc5, 0xbe, 0x47, 0xad, 0x0a, 0x73, 0x9b, 0xbe, 0x9e, 0x4e, 0x7e,
+	0x3e, 0x48, 0x30, 0x14, 0x5d, 0xb2, 0x15, 0xe0, 0x4b, 0x01, 0x1c, 0xad, 0x42, 0x7a, 0x97, 0x79,
+	0xbb, 0xd4, 0xe6, 0x94, 0x58, 0x0d, 0xc6, 0x1c, 0x7d, 0x5a, 0x10, 0x2e, 0x28, 0xc2, 0x63, 0xbd,
+	0x84, 0xeb, 0x2e, 0x2f, 0x4f, 0x45, 0xa0, 0x4d, 0xc6, 0x1c, 0x74, 0x17, 0xe6, 0xdb, 0xd5, 0x4d,
+	0x7d, 0xae, 0x3a, 0x92, 0x3e, 0xb3, 0xa4, 0xe5, 0xd3, 0x85, 0x33, 0x46, 0x67, 0xa7, 0x33, 0x56,
+	0xc2, 0x92, 0x6e, 0xbb, 0x96, 0xe7, 0xaa, 0xbd, 0x8b, 0xc8, 0x80, 0x68, 0xd9, 0x0a, 0xd3, 0x68,
+	0x13, 0x7d, 0x56, 0xa4, 0x67, 0x36, 0x34, 0xa9, 0x76, 0xb2, 0x4e, 0xd0, 0x7f, 0xe1, 0xb8, 0x47,
+	0xa3, 0xda, 0xa1, 0x56, 0xa4, 0x52, 0x47, 0x4b, 0x5a, 0x7e, 0xbc, 0x7c, 0x2c, 0x6e, 0x5d, 0x0b,
+	0x8d, 0x41, 0xf6, 0x03, 0x2e, 0x4a, 0xac, 0x80, 0x4e, 0x9f, 0x13, 0xbe, 0x20, 0x97, 0x4a, 0xb8,
+	0xba, 0x97, 0x3b, 0x09, 0x27, 0x64, 0xe3, 0x72, 0x1c, 0xb5, 0x99, 0xaf, 0x9a, 0x5c, 0x6e, 0x07,
+	0xf4, 0x5e, 0x93, 0xea, 0x6b, 0x6f, 0xc0, 0xb8, 0x52, 0xed, 0xeb, 0xda, 0xd2, 0x48, 0x3e, 0x55,
+	0x38, 0xd1, 0x9d, 0x0a, 0x85, 0x29, 0x8d, 0x06, 0xf9, 0x2e, 0x47, 0xee, 0x39, 0x06, 0x19, 0x41,
+	0xbb, 0xe3, 0x53, 0xaf, 0x28, 0x6b, 0x38, 0xc8, 0x92, 0xea, 0xac, 0x0b, 0x00, 0xb1, 0x74, 0xc8,
+	0xc6, 0x39, 0x81, 0xa3, 0x34, 0x14, 0x60, 0x2c, 0x2c, 0xee, 0xe1, 0x01, 0xc5, 0x1d, 0x3a, 0xe6,
+	0x76, 0xe1, 0x54, 0xdf, 0x0d, 0x55, 0x28, 0x37, 0x61, 0xba, 0xe9, 0x07, 0x2f, 0x4d, 0x64, 0x12,
+	0xdb, 0xa6, 0x0a, 0xd9, 0xee, 0x88, 0xba, 0x08, 0xd2, 0xcd, 0x8e, 0xe7, 0xdc, 0x9d, 0xbe, 0xfb,
+	0x84, 0xe9, 0x8c, 0x4b, 0xd7, 0x92, 0x4a, 0x67, 0x70, 0xba, 0x3f, 0xa5, 0xd2, 0xfe, 0x3e, 0xcc,
+	0x74, 0x69, 0x0f, 0x8f, 0x63, 0x80, 0x78, 0x75, 0x2a, 0xd3, 0x9d, 0x21, 0xf8, 0xb9, 0x4f, 0x35,
+	0xc8, 0x44, 0x87, 0xde, 0x1b, 0xc3, 0x80, 0xd3, 0x59, 0x03, 0x68, 0x5f, 0xda, 0xe2, 0x80, 0x52,
+	0x85, 0xf3, 0x86, 0x0a, 0x31, 0xb8, 0xe1, 0x0d, 0x39, 0x25, 0xa8, 0x1b, 0xde, 0xd8, 0xc4, 0x35,
+	0xaa, 0xa8, 0xcb, 0x31, 0x64, 0xee, 0x5b, 0x0d, 0x4e, 0xf5, 0x55, 0xa1, 0xc2, 0x5e, 0x83, 0xd4,
+	0x61, 0x23, 0x8e, 0x03, 0xd1, 0xcd, 0x3e, 0x7a, 0x2f, 0x0c, 0xd4, 0x2b, 0x45, 0x74, 0x08, 0x76,
+	0xd4, 0x5b, 0x14, 0x6c, 0xb9, 0xd5, 0xac, 0xd7, 0xb1, 0xd7, 0xfa, 0x07, 0x0b, 0xfa, 0x8f, 0x61,
+	0xd0, 0x7b, 0xb7, 0x53, 0xb9, 0x59, 0x00, 0x68, 0xdf, 0xa3, 0xe1, 0x7e, 0xd1, 0xbd, 0x88, 0x6e,
+	0xc1, 0x98, 0x78, 0xa0, 0x44, 0xed, 0x67, 0xa8, 0x76, 0x78, 0xbe, 0x66, 0xf3, 0x7b, 0xcd, 0x8a,
+	0x51, 0x65, 0x75, 0x35, 0xa1, 0xa9, 0x9f, 0x65, 0x9f, 0xec, 0x99, 0x01, 0x99, 0x2f, 0xfa, 0x63,
+	0x08, 0x47, 0x1b, 0x30, 0xd1, 0x6e, 0x42, 0x23, 0x87, 0xe2, 0x6a, 0x13, 0x04, 0x6c, 0x1e, 0xad,
+	0x63, 0xdb, 0xb5, 0xdd, 0x9a, 0x3e, 0x7a, 0x38, 0xb6, 0x88, 0x20, 0x60, 0x13, 0x32, 0x71, 0xc5,
+	0x91, 0xf3, 0xc8, 0x21, 0xd8, 0x22, 0x82, 0xdc, 0x35, 0x58, 0x88, 0x0f, 0x77, 0xc5, 0x6a, 0x95,
+	0x35, 0x5d, 0x1e, 0x1c, 0x49, 0xa2, 0x33, 0xce, 0xfd, 0x30, 0x0e, 0xd9, 0xfd, 0x08, 0xda, 0xa7,
+	0x76, 0x50, 0x95, 0x24, 0x18, 0x1b, 0xd7, 0x60, 0x9a, 0x33, 0x8e, 0x9d, 0xb0, 0x17, 0x44, 0x87,
+	0x32, 0xe0, 0xbe, 0x4b, 0x0b, 0x54, 0x31, 0x04, 0xa1, 0x12, 0x4c, 0x49, 0x9e, 0xb0, 0x4c, 0x46,
+	0x93, 0xb0, 0x4c, 0x0a, 0xcc, 0x8a, 0x2a, 0x8d, 0x48, 0x4b, 0xbb, 0x40, 0x8e, 0x24, 0xd7, 0xd2,
+	0xbe, 0xbd, 0x22, 0x9e, 0xa6, 0x1b, 0xaa, 0x39, 0x9a, 0x9c, 0x67, 0x27, 0x04, 0xf5, 0x19, 0x05,
+	0xc6, 0x0e, 0x31, 0x0a, 0x6c, 0xc2, 0x7c, 0xfc, 0x92, 0x25, 0x16, 0xae, 0x07, 0xc7, 0xa8, 0x8f,
+	0x27, 0xe1, 0x9a, 0xeb, 0x80, 0x16, 0x05, 0x12, 0xdd, 0x06, 0x14, 0xbb, 0x9d, 0x43, 0xbe, 0x89,
+	0x24, 0x7c, 0x33, 0xed, 0x3b, 0x5c, 0x91, 0xbd, 0xd7, 0x39, 0x90, 0x56, 0xb0, 0x83, 0xdd, 0x2a,
+	0xd5, 0x21, 0x09, 0x5b, 0x7c, 0x2a, 0x2d, 0x49, 0xe0, 0xbe, 0x93, 0x4f, 0xea, 0x6f, 0x4e, 0x3e,
+	0x5d, 0x23, 0xc9, 0x64, 0xf7, 0x48, 0x82, 0xb6, 0xe0, 0xb8, 0x9a, 0x18, 0xbb, 0x0b, 0x7a, 0x2a,
+	0x49, 0x2c, 0xf3, 0x12, 0xbc, 0xdd, 0x59, 0xd6, 0x9b, 0xa0, 0xd6, 0xad, 0xa6, 0x6b, 0xbb, 0x36,
+	0xb7, 0xb1, 0x63, 0x7f, 0x4c, 0x89, 0x9e, 0x4e, 0x42, 0x39, 0x27, 0xa1, 0x3b, 0x71, 0x24, 0xba,
+	0x05, 0x33, 0x51, 0x59, 0x5a, 0x95, 0x26, 0xa9, 0x51, 0x9e, 0x6c, 0xc2, 0x9c, 0x8e, 0x60, 0x25,
+	0x81, 0x42, 0x17, 0x60, 0x3a, 0xca, 0xf4, 0x2e, 0xb6, 0x1d, 0x4a, 0xc4, 0x78, 0x39, 0x5e, 0x4e,
+	0x87, 0xcb, 0x6b, 0x62, 0xb5, 0xf0, 0xe5, 0x04, 0x1c, 0x11, 0x8d, 0x04, 0x7d, 0xa6, 0xc1, 0x98,
+	0xea, 0x26, 0xa8, 0xe7, 0x28, 0xfa, 0x7c, 0xb1, 0x66, 0xce, 0x1e, 0xec, 0x24, 0xdb, 0x50, 0xee,
+	0xdf, 0x9f, 0xfc, 0xf4, 0xfb, 0x17, 0xc3, 0x17, 0x51, 0xde, 0xdc, 0x12, 0xde, 0xcb, 0x1b, 0xb8,
+	0xe2, 0x9b, 0xfd, 0xbf, 0x90, 0xcd, 0xc7, 0x36, 0x79, 0x82, 0x3e, 0xd7, 0x20, 0x15, 0x1b, 0x10,
+	0xd1, 0x85, 0xfe, 0xfb, 0xf4, 0x4c, 0x97, 0x99, 0xfc, 0x60, 0x47, 0x25, 0xea, 0xb2, 0x10, 0x75,
+	0x1e, 0x9d, 0x4d, 0x20, 0xca, 0x47, 0xdf, 0x6b, 0x90, 0xee, 0xbc, 0xf9, 0xd1, 0xc5, 0xbe, 0x5b,
+	0xf5, 0x9d, 0x3f, 0x33, 0x97, 0x12, 0xf9, 0x2a, 0x65, 0xef, 0x0a, 0x65, 0xab, 0xa8, 0x74, 0x90,
+	0xb2, 0xae, 0x01, 0xcd, 0x7c, 0xdc, 0x6e, 0xf4, 0x4f, 0xcc, 0xc7, 0xea, 0x4e, 0x7f, 0x82, 0xbe,
+	0xd1, 0x60, 0xba, 0x73, 0x1b, 0x1f, 0x25, 0x11, 0x13, 0x25, 0xf4, 0x72, 0x32, 0x67, 0x25, 0xfd,
+	0x9a, 0x90, 0x7e, 0x15, 0xfd, 0xef, 0x2f, 0x48, 0xf7, 0x63, 0x72, 0xbf, 0xd6, 0x20, 0xdd, 0x39,
+	0x9d, 0xed, 0x93, 0xe6, 0xbe, 0x83, 0x64, 0xe6, 0x52, 0x22, 0x5f, 0xa5, 0xf5, 0x1d, 0xa1, 0xf5,
+	0x4d, 0x74, 0xf5, 0xc0, 0x02, 0x70, 0x9c, 0x2e, 0xa9, 0xed, 0x34, 0xa3, 0xaf, 0x34, 0x48, 0xc5,
+	0x86, 0xa5, 0x7d, 0xaa, 0xb4, 0x77, 0x7a, 0xcb, 0xe4, 0x07, 0x3b, 0x2a, 0x91, 0x37, 0x85, 0xc8,
+	0x22, 0xba, 0x3e, 0x30, 0xa1, 0xbe, 0x44, 0xee, 0x57, 0x08, 0xcf, 0x34, 0x98, 0xed, 0x19, 0x14,
+	0xd0, 0xf2, 0x41, 0xef, 0x6f, 0xcf, 0x44, 0x92, 0x31, 0x92, 0xba, 0x2b, 0xf5, 0x2b, 0x42, 0xfd,
+	0xdb, 0xe8, 0xad, 0x04, 0xef, 0x98, 0x85, 0x23, 0x7c, 0x47, 0x0c, 0xa5, 0xdb, 0xcf, 0x5f, 0x65,
+	0xb5, 0x17, 0xaf, 0xb2, 0xda, 0x6f, 0xaf, 0xb2, 0xda, 0xd3, 0xd7, 0xd9, 0xa1, 0x17, 0xaf, 0xb3,
+	0x43, 0x3f, 0xbf, 0xce, 0x0e, 0x7d, 0x74, 0x25, 0x36, 0x74, 0xf5, 0xd9, 0xe0, 0x41, 0xe1, 0xff,
+	0xe6, 0xa3, 0x68, 0x1b, 0x31, 0x83, 0x55, 0x8e, 0x8a, 0xff, 0xf2, 0xfc, 0xe7, 0xcf, 0x01, 0x00,
+	0xca, 0x75, 0xf8, 0x12, 0x68, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -820,6 +1050,9 @@ type QueryClient interface {
 	// Queries the state of an address for an airdrop including the claim type,
 	// amount claimed so far, and unclaimed amount
 	UserSummary(ctx context.Context, in *QueryUserSummaryRequest, opts ...grpc.CallOption) (*QueryUserSummaryResponse, error)
+	// Queries the full accounting of an airdrop's rewards, including the amount
+	// claimed, forfeited, redistributed, and clawed back
+	AirdropAccounting(ctx context.Context, in *QueryAirdropAccountingRequest, opts ...grpc.CallOption) (*QueryAirdropAccountingResponse, error)
 }
 
 type queryClient struct {
@@ -884,6 +1117,15 @@ func (c *queryClient) UserSummary(ctx context.Context, in *QueryUserSummaryReque
 	return out, nil
 }
 
+func (c *queryClient) AirdropAccounting(ctx context.Context, in *QueryAirdropAccountingRequest, opts ...grpc.CallOption) (*QueryAirdropAccountingResponse, error) {
+	out := new(QueryAirdropAccountingResponse)
+	err := c.cc.Invoke(ctx, "/stride.airdrop.Query/AirdropAccounting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Queries the configuration for a given airdrop
@@ -899,6 +1141,9 @@ type QueryServer interface {
 	// Queries the state of an address for an airdrop including the claim type,
 	// amount claimed so far, and unclaimed amount
 	UserSummary(context.Context, *QueryUserSummaryRequest) (*QueryUserSummaryResponse, error)
+	// Queries the full accounting of an airdrop's rewards, including the amount
+	// claimed, forfeited, redistributed, and clawed back
+	AirdropAccounting(context.Context, *QueryAirdropAccountingRequest) (*QueryAirdropAccountingResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -923,6 +1168,9 @@ func (*UnimplementedQueryServer) AllAllocations(ctx context.Context, req *QueryA
 func (*UnimplementedQueryServer) UserSummary(ctx context.Context, req *QueryUserSummaryRequest) (*QueryUserSummaryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserSummary not implemented")
 }
+func (*UnimplementedQueryServer) AirdropAccounting(ctx context.Context, req *QueryAirdropAccountingRequest) (*QueryAirdropAccountingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AirdropAccounting not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1036,6 +1284,24 @@ func _Query_UserSummary_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AirdropAccounting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAirdropAccountingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AirdropAccounting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.airdrop.Query/AirdropAccounting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AirdropAccounting(ctx, req.(*QueryAirdropAccountingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.airdrop.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1064,6 +1330,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UserSummary",
 			Handler:    _Query_UserSummary_Handler,
 		},
+		{
+			MethodName: "AirdropAccounting",
+			Handler:    _Query_AirdropAccounting_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stride/airdrop/query.proto",
@@ -1119,6 +1389,73 @@ func (m *QueryAirdropResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ClawedBack {
+		i--
+		if m.ClawedBack {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.RedistributeForfeited {
+		i--
+		if m.RedistributeForfeited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.ClawbackAirdropId) > 0 {
+		i -= len(m.ClawbackAirdropId)
+		copy(dAtA[i:], m.ClawbackAirdropId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClawbackAirdropId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.ClawbackDestination != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClawbackDestination))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	{
+		size := m.ForfeitedPool.Size()
+		i -= size
+		if _, err := m.ForfeitedPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.ClaimAndStakeBonus.Size()
+		i -= size
+		if _, err := m.ClaimAndStakeBonus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x6a
+	}
 	if m.AirdropLength != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.AirdropLength))
 		i--
@@ -1614,65 +1951,269 @@ func (m *QueryUserSummaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryAirdropAccountingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAirdropRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryAirdropAccountingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAirdropAccountingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.AirdropId) > 0 {
+		i -= len(m.AirdropId)
+		copy(dAtA[i:], m.AirdropId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AirdropId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAirdropResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAirdropAccountingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAirdropAccountingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAirdropAccountingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.RewardDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ClawbackFailed {
+		i--
+		if m.ClawbackFailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	if m.DistributionStartDate != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionStartDate)
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.UnclaimedBudget.Size()
+		i -= size
+		if _, err := m.UnclaimedBudget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.DistributionEndDate != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionEndDate)
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.MerkleUninitialized.Size()
+		i -= size
+		if _, err := m.MerkleUninitialized.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.ClawbackDate != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClawbackDate)
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.MerkleTotalAllocated.Size()
+		i -= size
+		if _, err := m.MerkleTotalAllocated.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.ClaimTypeDeadlineDate != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClaimTypeDeadlineDate)
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x6a
+	if m.ClawedBack {
+		i--
+		if m.ClawedBack {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
 	}
-	l = m.EarlyClaimPenalty.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.DistributorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ClawbackDestination != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClawbackDestination))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.DistributorBalance.Size()
+		i -= size
+		if _, err := m.DistributorBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.ClawedBackAmount.Size()
+		i -= size
+		if _, err := m.ClawedBackAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.RedistributedAmount.Size()
+		i -= size
+		if _, err := m.RedistributedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ForfeitedPool.Size()
+		i -= size
+		if _, err := m.ForfeitedPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TotalUnclaimed.Size()
+		i -= size
+		if _, err := m.TotalUnclaimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TotalForfeited.Size()
+		i -= size
+		if _, err := m.TotalForfeited.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TotalClaimed.Size()
+		i -= size
+		if _, err := m.TotalClaimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TotalAllocated.Size()
+		i -= size
+		if _, err := m.TotalAllocated.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.RewardDenom) > 0 {
+		i -= len(m.RewardDenom)
+		copy(dAtA[i:], m.RewardDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RewardDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AirdropId) > 0 {
+		i -= len(m.AirdropId)
+		copy(dAtA[i:], m.AirdropId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AirdropId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAirdropRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAirdropResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RewardDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DistributionStartDate != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionStartDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DistributionEndDate != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionEndDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ClawbackDate != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClawbackDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ClaimTypeDeadlineDate != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClaimTypeDeadlineDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.EarlyClaimPenalty.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.DistributorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	l = len(m.AllocatorAddress)
 	if l > 0 {
@@ -1688,6 +2229,27 @@ func (m *QueryAirdropResponse) Size() (n int) {
 	if m.AirdropLength != 0 {
 		n += 1 + sovQuery(uint64(m.AirdropLength))
 	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ClaimAndStakeBonus.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ForfeitedPool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ClawbackDestination != 0 {
+		n += 2 + sovQuery(uint64(m.ClawbackDestination))
+	}
+	l = len(m.ClawbackAirdropId)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.RedistributeForfeited {
+		n += 3
+	}
+	if m.ClawedBack {
+		n += 3
+	}
 	return n
 }
 
@@ -1847,6 +2409,67 @@ func (m *QueryUserSummaryResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAirdropAccountingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AirdropId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAirdropAccountingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AirdropId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RewardDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TotalAllocated.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalClaimed.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalForfeited.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalUnclaimed.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ForfeitedPool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RedistributedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ClawedBackAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DistributorBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ClawbackDestination != 0 {
+		n += 1 + sovQuery(uint64(m.ClawbackDestination))
+	}
+	if m.ClawedBack {
+		n += 2
+	}
+	l = m.MerkleTotalAllocated.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MerkleUninitialized.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.UnclaimedBudget.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ClawbackFailed {
+		n += 3
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2340,56 +2963,247 @@ func (m *QueryAirdropResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllAirdropsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimAndStakeBonus", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllAirdropsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllAirdropsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimAndStakeBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitedPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ForfeitedPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackDestination", wireType)
+			}
+			m.ClawbackDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClawbackDestination |= ClawbackDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackAirdropId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClawbackAirdropId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedistributeForfeited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RedistributeForfeited = bool(v != 0)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawedBack", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClawedBack = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAirdropsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAirdropsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAirdropsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3431,6 +4245,635 @@ func (m *QueryUserSummaryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAirdropAccountingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAirdropAccountingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAirdropAccountingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AirdropId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAirdropAccountingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAirdropAccountingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAirdropAccountingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AirdropId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAllocated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAllocated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalClaimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalClaimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalForfeited", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalForfeited.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUnclaimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalUnclaimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitedPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ForfeitedPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedistributedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedistributedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawedBackAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClawedBackAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributorBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistributorBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackDestination", wireType)
+			}
+			m.ClawbackDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClawbackDestination |= ClawbackDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawedBack", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClawedBack = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleTotalAllocated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MerkleTotalAllocated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleUninitialized", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MerkleUninitialized.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnclaimedBudget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnclaimedBudget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackFailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClawbackFailed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
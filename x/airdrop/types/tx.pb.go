@@ -8,6 +8,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -42,6 +43,9 @@ type MsgClaimDaily struct {
 	Claimer string `protobuf:"bytes,1,opt,name=claimer,proto3" json:"claimer,omitempty"`
 	// Airdrop ID
 	AirdropId string `protobuf:"bytes,2,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+	// Proof of the user's allocations, only required on the user's first claim
+	// if their allocations were committed with a merkle root
+	AllocationProof *AllocationProof `protobuf:"bytes,3,opt,name=allocation_proof,json=allocationProof,proto3" json:"allocation_proof,omitempty"`
 }
 
 func (m *MsgClaimDaily) Reset()         { *m = MsgClaimDaily{} }
@@ -91,6 +95,13 @@ func (m *MsgClaimDaily) GetAirdropId() string {
 	return ""
 }
 
+func (m *MsgClaimDaily) GetAllocationProof() *AllocationProof {
+	if m != nil {
+		return m.AllocationProof
+	}
+	return nil
+}
+
 type MsgClaimDailyResponse struct {
 }
 
@@ -133,6 +144,9 @@ type MsgClaimEarly struct {
 	Claimer string `protobuf:"bytes,1,opt,name=claimer,proto3" json:"claimer,omitempty"`
 	// Airdrop ID
 	AirdropId string `protobuf:"bytes,2,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+	// Proof of the user's allocations, only required on the user's first claim
+	// if their allocations were committed with a merkle root
+	AllocationProof *AllocationProof `protobuf:"bytes,3,opt,name=allocation_proof,json=allocationProof,proto3" json:"allocation_proof,omitempty"`
 }
 
 func (m *MsgClaimEarly) Reset()         { *m = MsgClaimEarly{} }
@@ -182,6 +196,13 @@ func (m *MsgClaimEarly) GetAirdropId() string {
 	return ""
 }
 
+func (m *MsgClaimEarly) GetAllocationProof() *AllocationProof {
+	if m != nil {
+		return m.AllocationProof
+	}
+	return nil
+}
+
 type MsgClaimEarlyResponse struct {
 }
 
@@ -218,6 +239,116 @@ func (m *MsgClaimEarlyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClaimEarlyResponse proto.InternalMessageInfo
 
+// ClaimAndStake
+type MsgClaimAndStake struct {
+	// Address of the claimer
+	Claimer string `protobuf:"bytes,1,opt,name=claimer,proto3" json:"claimer,omitempty"`
+	// Airdrop ID
+	AirdropId string `protobuf:"bytes,2,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+	// Proof of the user's allocations, only required on the user's first claim
+	// if their allocations were committed with a merkle root
+	AllocationProof *AllocationProof `protobuf:"bytes,3,opt,name=allocation_proof,json=allocationProof,proto3" json:"allocation_proof,omitempty"`
+}
+
+func (m *MsgClaimAndStake) Reset()         { *m = MsgClaimAndStake{} }
+func (m *MsgClaimAndStake) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAndStake) ProtoMessage()    {}
+func (*MsgClaimAndStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40a6837f542f43b8, []int{4}
+}
+func (m *MsgClaimAndStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAndStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAndStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimAndStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAndStake.Merge(m, src)
+}
+func (m *MsgClaimAndStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAndStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAndStake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimAndStake proto.InternalMessageInfo
+
+func (m *MsgClaimAndStake) GetClaimer() string {
+	if m != nil {
+		return m.Claimer
+	}
+	return ""
+}
+
+func (m *MsgClaimAndStake) GetAirdropId() string {
+	if m != nil {
+		return m.AirdropId
+	}
+	return ""
+}
+
+func (m *MsgClaimAndStake) GetAllocationProof() *AllocationProof {
+	if m != nil {
+		return m.AllocationProof
+	}
+	return nil
+}
+
+type MsgClaimAndStakeResponse struct {
+	// The stTokens received from liquid staking the claimed rewards
+	StToken types.Coin `protobuf:"bytes,1,opt,name=st_token,json=stToken,proto3" json:"st_token"`
+}
+
+func (m *MsgClaimAndStakeResponse) Reset()         { *m = MsgClaimAndStakeResponse{} }
+func (m *MsgClaimAndStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAndStakeResponse) ProtoMessage()    {}
+func (*MsgClaimAndStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40a6837f542f43b8, []int{5}
+}
+func (m *MsgClaimAndStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAndStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAndStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimAndStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAndStakeResponse.Merge(m, src)
+}
+func (m *MsgClaimAndStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAndStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAndStakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimAndStakeResponse proto.InternalMessageInfo
+
+func (m *MsgClaimAndStakeResponse) GetStToken() types.Coin {
+	if m != nil {
+		return m.StToken
+	}
+	return types.Coin{}
+}
+
 // CreateAirdrop
 type MsgCreateAirdrop struct {
 	// Airdrop admin address
@@ -246,13 +377,24 @@ type MsgCreateAirdrop struct {
 	AllocatorAddress string `protobuf:"bytes,10,opt,name=allocator_address,json=allocatorAddress,proto3" json:"allocator_address,omitempty"`
 	// Admin account with permissions to link addresseses
 	LinkerAddress string `protobuf:"bytes,11,opt,name=linker_address,json=linkerAddress,proto3" json:"linker_address,omitempty"`
+	// Bonus paid on rewards claimed with MsgClaimAndStake, funded from the
+	// forfeited pool
+	ClaimAndStakeBonus cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=claim_and_stake_bonus,json=claimAndStakeBonus,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"claim_and_stake_bonus"`
+	// Destination of the remaining rewards once the clawback date is reached
+	ClawbackDestination ClawbackDestination `protobuf:"varint,13,opt,name=clawback_destination,json=clawbackDestination,proto3,enum=stride.airdrop.ClawbackDestination" json:"clawback_destination,omitempty"`
+	// ID of the airdrop that receives the remaining rewards if the clawback
+	// destination is CLAWBACK_TO_AIRDROP
+	ClawbackAirdropId string `protobuf:"bytes,14,opt,name=clawback_airdrop_id,json=clawbackAirdropId,proto3" json:"clawback_airdrop_id,omitempty"`
+	// If true, the forfeited pool is redistributed to users that claimed daily
+	// before the clawback occurs
+	RedistributeForfeited bool `protobuf:"varint,15,opt,name=redistribute_forfeited,json=redistributeForfeited,proto3" json:"redistribute_forfeited,omitempty"`
 }
 
 func (m *MsgCreateAirdrop) Reset()         { *m = MsgCreateAirdrop{} }
 func (m *MsgCreateAirdrop) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateAirdrop) ProtoMessage()    {}
 func (*MsgCreateAirdrop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40a6837f542f43b8, []int{4}
+	return fileDescriptor_40a6837f542f43b8, []int{6}
 }
 func (m *MsgCreateAirdrop) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -351,6 +493,27 @@ func (m *MsgCreateAirdrop) GetLinkerAddress() string {
 	return ""
 }
 
+func (m *MsgCreateAirdrop) GetClawbackDestination() ClawbackDestination {
+	if m != nil {
+		return m.ClawbackDestination
+	}
+	return CLAWBACK_DESTINATION_UNSPECIFIED
+}
+
+func (m *MsgCreateAirdrop) GetClawbackAirdropId() string {
+	if m != nil {
+		return m.ClawbackAirdropId
+	}
+	return ""
+}
+
+func (m *MsgCreateAirdrop) GetRedistributeForfeited() bool {
+	if m != nil {
+		return m.RedistributeForfeited
+	}
+	return false
+}
+
 type MsgCreateAirdropResponse struct {
 }
 
@@ -358,7 +521,7 @@ func (m *MsgCreateAirdropResponse) Reset()         { *m = MsgCreateAirdropRespon
 func (m *MsgCreateAirdropResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateAirdropResponse) ProtoMessage()    {}
 func (*MsgCreateAirdropResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40a6837f542f43b8, []int{5}
+	return fileDescriptor_40a6837f542f43b8, []int{7}
 }
 func (m *MsgCreateAirdropResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -415,13 +578,24 @@ type MsgUpdateAirdrop struct {
 	AllocatorAddress string `protobuf:"bytes,10,opt,name=allocator_address,json=allocatorAddress,proto3" json:"allocator_address,omitempty"`
 	// Admin account with permissions to link addresseses
 	LinkerAddress string `protobuf:"bytes,11,opt,name=linker_address,json=linkerAddress,proto3" json:"linker_address,omitempty"`
+	// Bonus paid on rewards claimed with MsgClaimAndStake, funded from the
+	// forfeited pool
+	ClaimAndStakeBonus cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=claim_and_stake_bonus,json=claimAndStakeBonus,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"claim_and_stake_bonus"`
+	// Destination of the remaining rewards once the clawback date is reached
+	ClawbackDestination ClawbackDestination `protobuf:"varint,13,opt,name=clawback_destination,json=clawbackDestination,proto3,enum=stride.airdrop.ClawbackDestination" json:"clawback_destination,omitempty"`
+	// ID of the airdrop that receives the remaining rewards if the clawback
+	// destination is CLAWBACK_TO_AIRDROP
+	ClawbackAirdropId string `protobuf:"bytes,14,opt,name=clawback_airdrop_id,json=clawbackAirdropId,proto3" json:"clawback_airdrop_id,omitempty"`
+	// If true, the forfeited pool is redistributed to users that claimed daily
+	// before the clawback occurs
+	RedistributeForfeited bool `protobuf:"varint,15,opt,name=redistribute_forfeited,json=redistributeForfeited,proto3" json:"redistribute_forfeited,omitempty"`
 }
 
 func (m *MsgUpdateAirdrop) Reset()         { *m = MsgUpdateAirdrop{} }
 func (m *MsgUpdateAirdrop) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateAirdrop) ProtoMessage()    {}
 func (*MsgUpdateAirdrop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40a6837f542f43b8, []int{6}
+	return fileDescriptor_40a6837f542f43b8, []int{8}
 }
 func (m *MsgUpdateAirdrop) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -520,6 +694,27 @@ func (m *MsgUpdateAirdrop) GetLinkerAddress() string {
 	return ""
 }
 
+func (m *MsgUpdateAirdrop) GetClawbackDestination() ClawbackDestination {
+	if m != nil {
+		return m.ClawbackDestination
+	}
+	return CLAWBACK_DESTINATION_UNSPECIFIED
+}
+
+func (m *MsgUpdateAirdrop) GetClawbackAirdropId() string {
+	if m != nil {
+		return m.ClawbackAirdropId
+	}
+	return ""
+}
+
+func (m *MsgUpdateAirdrop) GetRedistributeForfeited() bool {
+	if m != nil {
+		return m.RedistributeForfeited
+	}
+	return false
+}
+
 type MsgUpdateAirdropResponse struct {
 }
 
@@ -527,7 +722,7 @@ func (m *MsgUpdateAirdropResponse) Reset()         { *m = MsgUpdateAirdropRespon
 func (m *MsgUpdateAirdropResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateAirdropResponse) ProtoMessage()    {}
 func (*MsgUpdateAirdropResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40a6837f542f43b8, []int{7}
+	return fileDescriptor_40a6837f542f43b8, []int{9}
 }
 func (m *MsgUpdateAirdropResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -560,13 +755,15 @@ var xxx_messageInfo_MsgUpdateAirdropResponse proto.InternalMessageInfo
 type RawAllocation struct {
 	UserAddress string                  `protobuf:"bytes,1,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
 	Allocations []cosmossdk_io_math.Int `protobuf:"bytes,4,rep,name=allocations,proto3,customtype=cosmossdk.io/math.Int" json:"allocations"`
+	// Optional action requirement for each tranche in the allocations array
+	RequiredActions []Action `protobuf:"varint,5,rep,packed,name=required_actions,json=requiredActions,proto3,enum=stride.airdrop.Action" json:"required_actions,omitempty"`
 }
 
 func (m *RawAllocation) Reset()         { *m = RawAllocation{} }
 func (m *RawAllocation) String() string { return proto.CompactTextString(m) }
 func (*RawAllocation) ProtoMessage()    {}
 func (*RawAllocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40a6837f542f43b8, []int{8}
+	return fileDescriptor_40a6837f542f43b8, []int{10}
 }
 func (m *RawAllocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -602,6 +799,13 @@ func (m *RawAllocation) GetUserAddress() string {
 	return ""
 }
 
+func (m *RawAllocation) GetRequiredActions() []Action {
+	if m != nil {
+		return m.RequiredActions
+	}
+	return nil
+}
+
 // AddAllocations
 type MsgAddAllocations struct {
 	// Airdrop admin address
@@ -616,7 +820,7 @@ func (m *MsgAddAllocations) Reset()         { *m = MsgAddAllocations{} }
 func (m *MsgAddAllocations) String() string { return proto.CompactTextString(m) }
 func (*MsgAddAllocations) ProtoMessage()    {}
 func (*MsgAddAllocations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40a6837f542f43b8, []int{9}
+	return fileDescriptor_40a6837f542f43b8, []int{11}
 }
 func (m *MsgAddAllocations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -673,7 +877,7 @@ func (m *MsgAddAllocationsResponse) Reset()         { *m = MsgAddAllocationsResp
 func (m *MsgAddAllocationsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddAllocationsResponse) ProtoMessage()    {}
 func (*MsgAddAllocationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40a6837f542f43b8, []int{10}
+	return fileDescriptor_40a6837f542f43b8, []int{12}
 }
 func (m *MsgAddAllocationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -719,7 +923,7 @@ func (m *MsgUpdateUserAllocation) Reset()         { *m = MsgUpdateUserAllocation
 func (m *MsgUpdateUserAllocation) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateUserAllocation) ProtoMessage()    {}
 func (*MsgUpdateUserAllocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40a6837f542f43b8, []int{11}
+	return fileDescriptor_40a6837f542f43b8, []int{13}
 }
 func (m *MsgUpdateUserAllocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -776,7 +980,7 @@ func (m *MsgUpdateUserAllocationResponse) Reset()         { *m = MsgUpdateUserAl
 func (m *MsgUpdateUserAllocationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateUserAllocationResponse) ProtoMessage()    {}
 func (*MsgUpdateUserAllocationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40a6837f542f43b8, []int{12}
+	return fileDescriptor_40a6837f542f43b8, []int{14}
 }
 func (m *MsgUpdateUserAllocationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -813,15 +1017,22 @@ type MsgLinkAddresses struct {
 	AirdropId string `protobuf:"bytes,2,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
 	// Stride address - this address may or may not exist in allocations yet
 	StrideAddress string `protobuf:"bytes,3,opt,name=stride_address,json=strideAddress,proto3" json:"stride_address,omitempty"`
-	// Host address - this address must exist
+	// Host address - this address must exist, either in the allocations store
+	// or in the merkle root (in which case the host allocation proof is required)
 	HostAddress string `protobuf:"bytes,4,opt,name=host_address,json=hostAddress,proto3" json:"host_address,omitempty"`
+	// Optional proof of the host address's allocations, required if the airdrop
+	// has a merkle root and the host allocation has not yet been initialized
+	HostAllocationProof *AllocationProof `protobuf:"bytes,5,opt,name=host_allocation_proof,json=hostAllocationProof,proto3" json:"host_allocation_proof,omitempty"`
+	// Optional proof of the stride address's allocations, used to initialize the
+	// stride allocation before merging if it's only in the merkle root
+	StrideAllocationProof *AllocationProof `protobuf:"bytes,6,opt,name=stride_allocation_proof,json=strideAllocationProof,proto3" json:"stride_allocation_proof,omitempty"`
 }
 
 func (m *MsgLinkAddresses) Reset()         { *m = MsgLinkAddresses{} }
 func (m *MsgLinkAddresses) String() string { return proto.CompactTextString(m) }
 func (*MsgLinkAddresses) ProtoMessage()    {}
 func (*MsgLinkAddresses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40a6837f542f43b8, []int{13}
+	return fileDescriptor_40a6837f542f43b8, []int{15}
 }
 func (m *MsgLinkAddresses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -878,6 +1089,20 @@ func (m *MsgLinkAddresses) GetHostAddress() string {
 	return ""
 }
 
+func (m *MsgLinkAddresses) GetHostAllocationProof() *AllocationProof {
+	if m != nil {
+		return m.HostAllocationProof
+	}
+	return nil
+}
+
+func (m *MsgLinkAddresses) GetStrideAllocationProof() *AllocationProof {
+	if m != nil {
+		return m.StrideAllocationProof
+	}
+	return nil
+}
+
 type MsgLinkAddressesResponse struct {
 }
 
@@ -885,7 +1110,7 @@ func (m *MsgLinkAddressesResponse) Reset()         { *m = MsgLinkAddressesRespon
 func (m *MsgLinkAddressesResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLinkAddressesResponse) ProtoMessage()    {}
 func (*MsgLinkAddressesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40a6837f542f43b8, []int{14}
+	return fileDescriptor_40a6837f542f43b8, []int{16}
 }
 func (m *MsgLinkAddressesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -914,11 +1139,117 @@ func (m *MsgLinkAddressesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLinkAddressesResponse proto.InternalMessageInfo
 
+// SetMerkleRoot
+type MsgSetMerkleRoot struct {
+	// Airdrop admin address
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// Airdrop ID
+	AirdropId string `protobuf:"bytes,2,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+	// Hex encoded merkle root of the user allocations
+	MerkleRoot string `protobuf:"bytes,3,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// Sum of all allocations committed to in the merkle root
+	// This is asserted by the admin and is not verified against the tree, but
+	// allocations initialized from proofs are capped at this total
+	MerkleTotalAllocated cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=merkle_total_allocated,json=merkleTotalAllocated,proto3,customtype=cosmossdk.io/math.Int" json:"merkle_total_allocated"`
+}
+
+func (m *MsgSetMerkleRoot) Reset()         { *m = MsgSetMerkleRoot{} }
+func (m *MsgSetMerkleRoot) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMerkleRoot) ProtoMessage()    {}
+func (*MsgSetMerkleRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40a6837f542f43b8, []int{17}
+}
+func (m *MsgSetMerkleRoot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMerkleRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMerkleRoot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMerkleRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMerkleRoot.Merge(m, src)
+}
+func (m *MsgSetMerkleRoot) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMerkleRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMerkleRoot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMerkleRoot proto.InternalMessageInfo
+
+func (m *MsgSetMerkleRoot) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgSetMerkleRoot) GetAirdropId() string {
+	if m != nil {
+		return m.AirdropId
+	}
+	return ""
+}
+
+func (m *MsgSetMerkleRoot) GetMerkleRoot() string {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return ""
+}
+
+type MsgSetMerkleRootResponse struct {
+}
+
+func (m *MsgSetMerkleRootResponse) Reset()         { *m = MsgSetMerkleRootResponse{} }
+func (m *MsgSetMerkleRootResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMerkleRootResponse) ProtoMessage()    {}
+func (*MsgSetMerkleRootResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40a6837f542f43b8, []int{18}
+}
+func (m *MsgSetMerkleRootResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMerkleRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMerkleRootResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMerkleRootResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMerkleRootResponse.Merge(m, src)
+}
+func (m *MsgSetMerkleRootResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMerkleRootResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMerkleRootResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMerkleRootResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgClaimDaily)(nil), "stride.airdrop.MsgClaimDaily")
 	proto.RegisterType((*MsgClaimDailyResponse)(nil), "stride.airdrop.MsgClaimDailyResponse")
 	proto.RegisterType((*MsgClaimEarly)(nil), "stride.airdrop.MsgClaimEarly")
 	proto.RegisterType((*MsgClaimEarlyResponse)(nil), "stride.airdrop.MsgClaimEarlyResponse")
+	proto.RegisterType((*MsgClaimAndStake)(nil), "stride.airdrop.MsgClaimAndStake")
+	proto.RegisterType((*MsgClaimAndStakeResponse)(nil), "stride.airdrop.MsgClaimAndStakeResponse")
 	proto.RegisterType((*MsgCreateAirdrop)(nil), "stride.airdrop.MsgCreateAirdrop")
 	proto.RegisterType((*MsgCreateAirdropResponse)(nil), "stride.airdrop.MsgCreateAirdropResponse")
 	proto.RegisterType((*MsgUpdateAirdrop)(nil), "stride.airdrop.MsgUpdateAirdrop")
@@ -930,73 +1261,99 @@ func init() {
 	proto.RegisterType((*MsgUpdateUserAllocationResponse)(nil), "stride.airdrop.MsgUpdateUserAllocationResponse")
 	proto.RegisterType((*MsgLinkAddresses)(nil), "stride.airdrop.MsgLinkAddresses")
 	proto.RegisterType((*MsgLinkAddressesResponse)(nil), "stride.airdrop.MsgLinkAddressesResponse")
+	proto.RegisterType((*MsgSetMerkleRoot)(nil), "stride.airdrop.MsgSetMerkleRoot")
+	proto.RegisterType((*MsgSetMerkleRootResponse)(nil), "stride.airdrop.MsgSetMerkleRootResponse")
 }
 
 func init() { proto.RegisterFile("stride/airdrop/tx.proto", fileDescriptor_40a6837f542f43b8) }
 
 var fileDescriptor_40a6837f542f43b8 = []byte{
-	// 971 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x3f, 0x6f, 0xdb, 0x46,
-	0x14, 0x37, 0x2d, 0xd9, 0xa9, 0x4f, 0x96, 0x11, 0x33, 0x36, 0x44, 0x2b, 0xb0, 0x24, 0xab, 0x68,
-	0xa3, 0x06, 0x08, 0x89, 0xa8, 0x43, 0x01, 0x67, 0x08, 0xac, 0x4a, 0x83, 0x51, 0x1b, 0x28, 0x64,
-	0x07, 0xe8, 0x1f, 0xa0, 0xc4, 0x49, 0x77, 0xa5, 0x0f, 0x26, 0x79, 0x04, 0xef, 0x1c, 0x47, 0x6b,
-	0xa7, 0x22, 0x53, 0xa6, 0x7c, 0x85, 0xae, 0x19, 0xfa, 0x21, 0x32, 0x06, 0xed, 0x52, 0x74, 0x48,
-	0x0b, 0x7b, 0xc8, 0xd7, 0x28, 0x78, 0x47, 0x52, 0x3c, 0x9b, 0x2e, 0x35, 0x08, 0xc8, 0xe2, 0xc5,
-	0xd6, 0xbd, 0xf7, 0x7b, 0x3f, 0xbe, 0x7b, 0xef, 0xfd, 0x88, 0x47, 0x50, 0x63, 0x3c, 0x24, 0x08,
-	0x5b, 0x90, 0x84, 0x28, 0xa4, 0x81, 0xc5, 0x5f, 0x98, 0x41, 0x48, 0x39, 0xd5, 0xd7, 0xa4, 0xc3,
-	0x8c, 0x1d, 0xf5, 0x75, 0xe8, 0x11, 0x9f, 0x5a, 0xe2, 0xaf, 0x84, 0xd4, 0xb7, 0xc6, 0x94, 0x79,
-	0x94, 0xd9, 0xe2, 0x64, 0xc9, 0x43, 0xec, 0xaa, 0xc9, 0x93, 0xe5, 0x31, 0xc7, 0x7a, 0xfe, 0x38,
-	0xfa, 0x17, 0x3b, 0x36, 0x1c, 0xea, 0x50, 0x19, 0x10, 0xfd, 0x8a, 0xad, 0x4d, 0x87, 0x52, 0xc7,
-	0xc5, 0x96, 0x38, 0x8d, 0xce, 0x7e, 0xb6, 0x38, 0xf1, 0x30, 0xe3, 0xd0, 0x0b, 0x24, 0xa0, 0xfd,
-	0x52, 0x03, 0xd5, 0x43, 0xe6, 0x7c, 0xed, 0x42, 0xe2, 0xf5, 0x21, 0x71, 0x27, 0x7a, 0x17, 0xdc,
-	0x19, 0x47, 0x27, 0x1c, 0x1a, 0x5a, 0x4b, 0xeb, 0xac, 0xf4, 0x8c, 0x3f, 0x7e, 0x7f, 0xb4, 0x11,
-	0x27, 0xb1, 0x87, 0x50, 0x88, 0x19, 0x3b, 0xe2, 0x21, 0xf1, 0x9d, 0x61, 0x02, 0xd4, 0xb7, 0x01,
-	0x88, 0xaf, 0x63, 0x13, 0x64, 0x2c, 0x46, 0x61, 0xc3, 0x95, 0xd8, 0xb2, 0x8f, 0x76, 0x3f, 0xff,
-	0xe5, 0xc3, 0x9b, 0x87, 0x09, 0xf8, 0xe5, 0x87, 0x37, 0x0f, 0x37, 0x93, 0xaa, 0x28, 0x8f, 0x6e,
-	0xd7, 0xc0, 0xa6, 0x62, 0x18, 0x62, 0x16, 0x50, 0x9f, 0x61, 0x25, 0xcb, 0x01, 0x0c, 0x3f, 0x56,
-	0x96, 0xe2, 0xd1, 0xd9, 0x2c, 0x85, 0x21, 0xcd, 0xf2, 0xb7, 0x65, 0x70, 0x37, 0xf2, 0x84, 0x18,
-	0x72, 0xbc, 0x27, 0x63, 0x75, 0x13, 0x2c, 0x41, 0xe4, 0x11, 0xbf, 0x30, 0x4d, 0x09, 0x2b, 0x48,
-	0x52, 0xdf, 0x01, 0xab, 0x21, 0x3e, 0x87, 0x21, 0xb2, 0x11, 0xf6, 0xa9, 0x67, 0x94, 0x04, 0xa0,
-	0x22, 0x6d, 0xfd, 0xc8, 0xa4, 0x7f, 0x07, 0x6a, 0x88, 0x44, 0x43, 0x36, 0x3a, 0xe3, 0x84, 0xfa,
-	0x36, 0xe3, 0x30, 0xe4, 0x36, 0x82, 0x1c, 0x1b, 0xe5, 0x96, 0xd6, 0xa9, 0x74, 0xeb, 0xa6, 0x9c,
-	0x0a, 0x33, 0x99, 0x0a, 0xf3, 0x38, 0x99, 0x8a, 0x5e, 0xf9, 0xd5, 0x3f, 0x4d, 0x6d, 0xb8, 0x99,
-	0x25, 0x38, 0x8a, 0xe2, 0xfb, 0x90, 0x63, 0xfd, 0x18, 0x28, 0x0e, 0x1b, 0xfb, 0x48, 0xf2, 0x2e,
-	0xcd, 0xc8, 0x7b, 0x2f, 0x1b, 0x3e, 0xf0, 0x91, 0x60, 0x1d, 0x80, 0xea, 0xd8, 0x85, 0xe7, 0x23,
-	0x38, 0x3e, 0x95, 0x6c, 0xcb, 0x33, 0xb2, 0xad, 0x26, 0x61, 0x82, 0xe6, 0x7b, 0x60, 0x88, 0xde,
-	0xd9, 0x7c, 0x12, 0x60, 0x1b, 0x61, 0x88, 0x5c, 0xe2, 0x63, 0xc9, 0x78, 0x67, 0xd6, 0x7b, 0x0b,
-	0x86, 0xe3, 0x49, 0x80, 0xfb, 0x71, 0xbc, 0xa0, 0x3e, 0x02, 0xf7, 0x70, 0xd4, 0x69, 0x5b, 0x3e,
-	0x20, 0xc0, 0x3e, 0x74, 0xf9, 0xc4, 0xf8, 0x44, 0x74, 0xf4, 0xd3, 0xb7, 0xef, 0x9b, 0x0b, 0x7f,
-	0xbf, 0x6f, 0xde, 0x97, 0x5d, 0x65, 0xe8, 0xd4, 0x24, 0xd4, 0xf2, 0x20, 0x3f, 0x31, 0x0f, 0xb0,
-	0x03, 0xc7, 0x93, 0x3e, 0x1e, 0x0f, 0xd7, 0x45, 0xbc, 0x98, 0x99, 0x6f, 0x65, 0xb4, 0xbe, 0x0f,
-	0xa6, 0xd5, 0xa0, 0xa1, 0x0d, 0xe5, 0x30, 0x18, 0x2b, 0x05, 0x63, 0xa2, 0x67, 0x82, 0x62, 0x8f,
-	0x3e, 0x00, 0xeb, 0xd0, 0x75, 0xe9, 0x18, 0x66, 0x89, 0x40, 0x01, 0xd1, 0xdd, 0x34, 0x24, 0xa1,
-	0x79, 0x0a, 0xd6, 0x5c, 0xe2, 0x9f, 0xe2, 0x29, 0x47, 0xa5, 0x80, 0xa3, 0x2a, 0xf1, 0xb1, 0x71,
-	0xf7, 0x41, 0xa4, 0x20, 0x39, 0xc7, 0x91, 0x7e, 0x8c, 0xac, 0x7e, 0xb2, 0xa2, 0x68, 0xd7, 0x81,
-	0x71, 0xd5, 0x76, 0x55, 0x45, 0xcf, 0x02, 0x74, 0xab, 0xa2, 0x5b, 0x15, 0xdd, 0xaa, 0x28, 0xab,
-	0x22, 0x45, 0x14, 0xb1, 0x8a, 0x14, 0x5b, 0xaa, 0x22, 0x06, 0xaa, 0x43, 0x78, 0xbe, 0x27, 0x93,
-	0x23, 0xd4, 0x8f, 0x46, 0xfe, 0x8c, 0x65, 0x92, 0xd2, 0xe4, 0xc8, 0x47, 0xb6, 0x69, 0xe6, 0x15,
-	0x98, 0x06, 0x30, 0xa3, 0xdc, 0x2a, 0x75, 0x56, 0x7a, 0xdb, 0x71, 0x63, 0x36, 0xaf, 0x37, 0x66,
-	0xdf, 0xe7, 0xc3, 0x6c, 0x44, 0xfb, 0x4f, 0x0d, 0xac, 0x1f, 0x32, 0x67, 0x0f, 0xa1, 0xe9, 0x83,
-	0xd9, 0xbc, 0xb5, 0x3b, 0x50, 0xb3, 0x2c, 0xb5, 0x4a, 0x9d, 0x4a, 0x77, 0xdb, 0x54, 0xb7, 0x2a,
-	0x53, 0xb9, 0x7c, 0xaf, 0x1c, 0x5d, 0x42, 0xc9, 0x75, 0xb7, 0xa3, 0x56, 0x79, 0x2b, 0x53, 0x65,
-	0x35, 0xff, 0xf6, 0x7d, 0xb0, 0x75, 0xcd, 0x98, 0xd6, 0xf9, 0xf5, 0x22, 0xa8, 0xa5, 0x4d, 0x78,
-	0x16, 0x15, 0x73, 0x5a, 0xf2, 0x39, 0x5f, 0xfc, 0xc9, 0x95, 0x0e, 0x96, 0x0a, 0x58, 0xe7, 0xda,
-	0xdb, 0x5d, 0x53, 0xad, 0x57, 0xf3, 0xda, 0x54, 0xaa, 0x97, 0x6f, 0xef, 0x80, 0xe6, 0x0d, 0xae,
-	0xb4, 0x76, 0xbf, 0x2e, 0x8a, 0x37, 0xfd, 0x01, 0xf1, 0x4f, 0xe3, 0x34, 0xf1, 0xdc, 0xa7, 0xe5,
-	0x29, 0x88, 0xf7, 0xed, 0x99, 0xcb, 0x56, 0x95, 0xf8, 0xa4, 0x70, 0x4f, 0xc0, 0xea, 0x09, 0x65,
-	0x3c, 0x0d, 0x2f, 0x17, 0x55, 0x3d, 0x42, 0xcf, 0x20, 0x65, 0xe5, 0xd6, 0xb1, 0x94, 0x15, 0x5b,
-	0x52, 0xa6, 0xee, 0xeb, 0x25, 0x50, 0x3a, 0x64, 0x8e, 0x3e, 0x04, 0x20, 0xb3, 0xa6, 0x5f, 0x9b,
-	0x78, 0x65, 0x73, 0xae, 0x7f, 0xf6, 0xbf, 0xee, 0x84, 0x3b, 0xe5, 0x94, 0x4b, 0xf5, 0x8d, 0x9c,
-	0xc2, 0x7d, 0x33, 0xa7, 0xb2, 0x06, 0xeb, 0x3f, 0x82, 0xaa, 0xba, 0x02, 0xb7, 0xf2, 0xe2, 0xb2,
-	0x88, 0x7a, 0xa7, 0x08, 0x91, 0x25, 0x57, 0x37, 0x83, 0x3c, 0x72, 0x05, 0x91, 0x4b, 0x9e, 0xfb,
-	0xd2, 0xd4, 0x7f, 0x02, 0x6b, 0x57, 0xde, 0x5d, 0x3b, 0x39, 0xb1, 0x2a, 0xa4, 0xfe, 0x45, 0x21,
-	0x24, 0xe5, 0x0f, 0xc0, 0x46, 0xee, 0x8b, 0xe2, 0xc1, 0x8d, 0x19, 0xaa, 0xc0, 0xba, 0x35, 0x23,
-	0x30, 0x5b, 0x2e, 0x55, 0x5e, 0x79, 0xe5, 0x52, 0x10, 0xb9, 0xe5, 0xca, 0x1d, 0xcc, 0xde, 0x37,
-	0x6f, 0x2f, 0x1a, 0xda, 0xbb, 0x8b, 0x86, 0xf6, 0xef, 0x45, 0x43, 0x7b, 0x75, 0xd9, 0x58, 0x78,
-	0x77, 0xd9, 0x58, 0xf8, 0xeb, 0xb2, 0xb1, 0xf0, 0xc3, 0x63, 0x87, 0xf0, 0x93, 0xb3, 0x91, 0x39,
-	0xa6, 0x9e, 0x75, 0x24, 0xd8, 0x1e, 0x1d, 0xc0, 0x11, 0xb3, 0xe2, 0x6f, 0xe2, 0xe7, 0xdd, 0xaf,
-	0xac, 0x17, 0xd3, 0x2f, 0xe3, 0x49, 0x80, 0xd9, 0x68, 0x59, 0xac, 0x13, 0x5f, 0xfe, 0x17, 0x00,
-	0x00, 0xff, 0xff, 0xd5, 0xb2, 0xe5, 0xa0, 0x38, 0x0f, 0x00, 0x00,
+	// 1349 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0x13, 0xc7,
+	0x1b, 0xce, 0xe2, 0x24, 0x24, 0xe3, 0x38, 0x24, 0x9b, 0x98, 0x6c, 0x96, 0x1f, 0xb6, 0x31, 0xe2,
+	0xc7, 0x82, 0xc4, 0xae, 0xe2, 0xaa, 0xaa, 0x14, 0x0e, 0xc8, 0x21, 0xa9, 0x44, 0x4b, 0x24, 0xb4,
+	0x0e, 0xf4, 0x4b, 0xea, 0x6a, 0xec, 0x9d, 0x98, 0x95, 0xed, 0x1d, 0x77, 0x67, 0x0c, 0xe4, 0x56,
+	0xf5, 0xd8, 0x13, 0xa7, 0xfe, 0x1d, 0x1c, 0xaa, 0x1e, 0x7b, 0xe6, 0x88, 0x5a, 0xa9, 0xaa, 0xaa,
+	0x8a, 0x56, 0xe4, 0xc0, 0xbf, 0x51, 0xed, 0x7c, 0xac, 0x77, 0x6c, 0x27, 0xeb, 0x43, 0x0e, 0x55,
+	0x95, 0x0b, 0x61, 0xdf, 0xf7, 0x79, 0x9f, 0xdd, 0xf7, 0x63, 0x1e, 0xcf, 0x0c, 0xd8, 0x20, 0x34,
+	0x0a, 0x7c, 0xe4, 0xc0, 0x20, 0xf2, 0x23, 0xdc, 0x77, 0xe8, 0x0b, 0xbb, 0x1f, 0x61, 0x8a, 0xf5,
+	0x65, 0xee, 0xb0, 0x85, 0xc3, 0x5c, 0x85, 0xbd, 0x20, 0xc4, 0x0e, 0xfb, 0x97, 0x43, 0xcc, 0xcd,
+	0x16, 0x26, 0x3d, 0x4c, 0x3c, 0xf6, 0xe4, 0xf0, 0x07, 0xe1, 0x2a, 0xf1, 0x27, 0xa7, 0x09, 0x09,
+	0x72, 0x9e, 0x6d, 0x35, 0x11, 0x85, 0x5b, 0x4e, 0x0b, 0x07, 0xa1, 0xf0, 0x6f, 0x08, 0x7f, 0x8f,
+	0xb4, 0x9d, 0x67, 0x5b, 0xf1, 0x1f, 0xe1, 0x58, 0x6f, 0xe3, 0x36, 0xe6, 0x84, 0xf1, 0xff, 0x84,
+	0xb5, 0xdc, 0xc6, 0xb8, 0xdd, 0x45, 0x0e, 0x7b, 0x6a, 0x0e, 0x0e, 0x1d, 0x1a, 0xf4, 0x10, 0xa1,
+	0xb0, 0xd7, 0x17, 0x80, 0xff, 0x8d, 0xa4, 0x21, 0xfe, 0x72, 0x6f, 0xf5, 0x37, 0x0d, 0x14, 0xf6,
+	0x49, 0xfb, 0x7e, 0x17, 0x06, 0xbd, 0x5d, 0x18, 0x74, 0x8f, 0xf4, 0x1a, 0xb8, 0xd8, 0x8a, 0x9f,
+	0x50, 0x64, 0x68, 0x15, 0xcd, 0x5a, 0xdc, 0x31, 0x7e, 0xf9, 0xf1, 0xce, 0xba, 0x48, 0xa1, 0xee,
+	0xfb, 0x11, 0x22, 0xa4, 0x41, 0xa3, 0x20, 0x6c, 0xbb, 0x12, 0xa8, 0x5f, 0x05, 0x40, 0xd0, 0x7a,
+	0x81, 0x6f, 0x5c, 0x88, 0xc3, 0xdc, 0x45, 0x61, 0x79, 0xe0, 0xeb, 0x9f, 0x80, 0x15, 0xd8, 0xed,
+	0xe2, 0x16, 0xa4, 0x01, 0x0e, 0xe3, 0x9a, 0xe0, 0x43, 0x23, 0x57, 0xd1, 0xac, 0x7c, 0xad, 0x6c,
+	0xab, 0xb5, 0xb4, 0xeb, 0x09, 0xee, 0x51, 0x0c, 0x73, 0x2f, 0x41, 0xd5, 0xb0, 0xfd, 0xff, 0xef,
+	0xde, 0xbf, 0xba, 0x2d, 0x5f, 0xfc, 0xfd, 0xfb, 0x57, 0xb7, 0x8b, 0x32, 0x31, 0x25, 0x8d, 0xea,
+	0x06, 0x28, 0x2a, 0x06, 0x17, 0x91, 0x3e, 0x0e, 0x09, 0x52, 0x32, 0xde, 0x83, 0xd1, 0x7f, 0x21,
+	0x63, 0x96, 0x46, 0x3a, 0x63, 0x66, 0x48, 0x32, 0xfe, 0x53, 0x03, 0x2b, 0xd2, 0x53, 0x0f, 0xfd,
+	0x06, 0x85, 0x1d, 0xf4, 0x6f, 0x4f, 0xfa, 0xd6, 0x68, 0xd2, 0xc6, 0x68, 0xd2, 0x32, 0x93, 0xea,
+	0x13, 0x60, 0x8c, 0xda, 0x64, 0xea, 0xfa, 0x36, 0x58, 0x20, 0xd4, 0xa3, 0xb8, 0x83, 0x42, 0x96,
+	0x66, 0xbe, 0xb6, 0x69, 0x8b, 0x1c, 0xe3, 0xf5, 0x67, 0x8b, 0xf5, 0x67, 0xdf, 0xc7, 0x41, 0xb8,
+	0x33, 0xfb, 0xfa, 0x6d, 0x79, 0xc6, 0xbd, 0x48, 0xe8, 0x41, 0x8c, 0xaf, 0xfe, 0xbc, 0xc0, 0xcb,
+	0x16, 0x21, 0x48, 0x51, 0x9d, 0xbf, 0x5d, 0xb7, 0xc1, 0x1c, 0xf4, 0x7b, 0x41, 0x98, 0x59, 0x34,
+	0x0e, 0xcb, 0x2a, 0xd9, 0x35, 0xb0, 0x14, 0xa1, 0xe7, 0x30, 0xf2, 0x3d, 0x1f, 0x85, 0xb8, 0xc7,
+	0xca, 0xb5, 0xe8, 0xe6, 0xb9, 0x6d, 0x37, 0x36, 0xe9, 0x9f, 0x83, 0x0d, 0x3f, 0x88, 0xcb, 0xd7,
+	0x1c, 0xb0, 0xba, 0x12, 0x0a, 0x23, 0xea, 0xf9, 0x90, 0x22, 0x63, 0x96, 0x65, 0x64, 0xda, 0x5c,
+	0x02, 0x6c, 0x29, 0x01, 0xf6, 0x81, 0x94, 0x80, 0x9d, 0xd9, 0x97, 0x7f, 0x95, 0x35, 0xb7, 0x98,
+	0x26, 0x68, 0xc4, 0xf1, 0xbb, 0x90, 0x22, 0xfd, 0x00, 0x28, 0x0e, 0x0f, 0x85, 0x3e, 0xe7, 0x9d,
+	0x9b, 0x92, 0x77, 0x2d, 0x1d, 0xbe, 0x17, 0xfa, 0x8c, 0x75, 0x0f, 0x14, 0x5a, 0x5d, 0xf8, 0xbc,
+	0x09, 0x5b, 0x1d, 0xce, 0x36, 0x3f, 0x25, 0xdb, 0x92, 0x0c, 0x63, 0x34, 0x5f, 0x00, 0x83, 0x75,
+	0xdf, 0xa3, 0x47, 0x7d, 0xe4, 0xf9, 0x08, 0xfa, 0xdd, 0x20, 0x44, 0x9c, 0xf1, 0xe2, 0xb4, 0x79,
+	0x33, 0x86, 0x83, 0xa3, 0x3e, 0xda, 0x15, 0xf1, 0x8c, 0xba, 0x01, 0xd6, 0x50, 0xbc, 0x40, 0x3c,
+	0xfe, 0x82, 0x3e, 0x0a, 0x61, 0x97, 0x1e, 0x19, 0x0b, 0xac, 0xa3, 0xd7, 0xe3, 0x21, 0xf8, 0xe3,
+	0x6d, 0xf9, 0x0a, 0xef, 0x2a, 0xf1, 0x3b, 0x76, 0x80, 0x9d, 0x1e, 0xa4, 0x4f, 0xed, 0x87, 0xa8,
+	0x0d, 0x5b, 0x47, 0xbb, 0xa8, 0xe5, 0xae, 0xb2, 0x78, 0x36, 0x72, 0x8f, 0x78, 0xb4, 0xfe, 0x00,
+	0x0c, 0xab, 0x81, 0x23, 0x0f, 0xf2, 0x61, 0x30, 0x16, 0x33, 0xc6, 0x44, 0x4f, 0x05, 0x09, 0x8f,
+	0xbe, 0x07, 0x56, 0xc5, 0x72, 0x48, 0x11, 0x81, 0x0c, 0xa2, 0x95, 0x24, 0x44, 0xd2, 0xdc, 0x03,
+	0xcb, 0xdd, 0x20, 0xec, 0xa0, 0x21, 0x47, 0x3e, 0x83, 0xa3, 0xc0, 0xf1, 0x92, 0xe0, 0x09, 0xe0,
+	0x05, 0xf4, 0x60, 0xe8, 0xc7, 0x63, 0xd7, 0x41, 0x5e, 0x13, 0x87, 0x03, 0x62, 0x2c, 0x4d, 0x5f,
+	0x29, 0xbd, 0x95, 0x5e, 0x97, 0x3b, 0x71, 0xb8, 0xfe, 0x04, 0xac, 0x0f, 0x27, 0x04, 0x11, 0x1a,
+	0x84, 0x6c, 0xe1, 0x1b, 0x85, 0x8a, 0x66, 0x2d, 0xd7, 0xae, 0x8f, 0x6a, 0xc5, 0x7d, 0x39, 0x16,
+	0x43, 0xa8, 0xbb, 0xd6, 0x1a, 0x37, 0xea, 0x36, 0x48, 0xcc, 0x5e, 0x6a, 0xd1, 0x2d, 0xb3, 0x35,
+	0xb5, 0x2a, 0x5d, 0xf5, 0x64, 0xf1, 0x7d, 0x08, 0x2e, 0x47, 0x28, 0xa9, 0x3f, 0xf2, 0x0e, 0x71,
+	0x74, 0x88, 0x02, 0x8a, 0x7c, 0xe3, 0x52, 0x45, 0xb3, 0x16, 0xdc, 0x62, 0xda, 0xfb, 0xb1, 0x74,
+	0x6e, 0xdf, 0x8c, 0xa5, 0x89, 0x2f, 0xef, 0x31, 0x61, 0x4a, 0x6b, 0x45, 0xd5, 0x04, 0xc6, 0xa8,
+	0x2d, 0xd1, 0x64, 0x21, 0x2e, 0x8f, 0xfb, 0xfe, 0xb9, 0xb8, 0x9c, 0x8b, 0xcb, 0xb9, 0xb8, 0x9c,
+	0x8b, 0x4b, 0xa6, 0xb8, 0x28, 0x5a, 0x21, 0xc4, 0x45, 0xb1, 0x25, 0xe2, 0xf2, 0x93, 0x06, 0x0a,
+	0x2e, 0x7c, 0x3e, 0xdc, 0x64, 0xc5, 0x52, 0x30, 0x20, 0xa9, 0x66, 0x69, 0x5c, 0x0a, 0x06, 0x64,
+	0xd8, 0x90, 0x7b, 0x20, 0x3f, 0xdc, 0x84, 0x11, 0x63, 0xb6, 0x92, 0xb3, 0x16, 0x77, 0xae, 0x8a,
+	0x36, 0x14, 0xc7, 0xdb, 0xf0, 0x20, 0xa4, 0x6e, 0x3a, 0x42, 0xaf, 0x83, 0x95, 0x08, 0x7d, 0x33,
+	0x08, 0x22, 0xe4, 0x7b, 0xb0, 0xc5, 0x59, 0xe6, 0x2a, 0x39, 0x6b, 0xb9, 0x76, 0x79, 0x6c, 0xfb,
+	0xc7, 0xdc, 0xee, 0x25, 0x89, 0xe7, 0xcf, 0xa4, 0xfa, 0xab, 0x06, 0x56, 0xf7, 0x49, 0xbb, 0xee,
+	0xfb, 0xf5, 0x14, 0xf1, 0x19, 0xcb, 0xe2, 0x9e, 0x9a, 0x68, 0xae, 0x92, 0xb3, 0xf2, 0xb5, 0xab,
+	0xa3, 0x9f, 0xa8, 0xd4, 0x4f, 0x6c, 0x0d, 0xd3, 0x71, 0xdb, 0x96, 0xda, 0xa9, 0xcd, 0x54, 0xa7,
+	0xd4, 0xef, 0xaf, 0x5e, 0x01, 0x9b, 0x63, 0xc6, 0xa4, 0x57, 0x3f, 0x5c, 0x00, 0x1b, 0x49, 0x23,
+	0x1f, 0xc7, 0xfd, 0x18, 0x76, 0xed, 0x8c, 0x13, 0xbf, 0x3b, 0x32, 0x04, 0xb9, 0x0c, 0xd6, 0x33,
+	0x1d, 0x8f, 0x6d, 0x5b, 0xad, 0x57, 0x79, 0x6c, 0xb2, 0xd5, 0xe4, 0xab, 0xd7, 0x40, 0xf9, 0x04,
+	0xd7, 0x70, 0xce, 0x73, 0xec, 0x47, 0xf4, 0x61, 0x10, 0x76, 0xc4, 0x67, 0xa2, 0x33, 0x9f, 0x96,
+	0x7b, 0x40, 0x1c, 0xf7, 0xa7, 0x2e, 0x5b, 0x81, 0xe3, 0x65, 0xe1, 0xee, 0x82, 0xa5, 0xa7, 0x98,
+	0xd0, 0x24, 0x7c, 0x36, 0xab, 0xea, 0x31, 0x5a, 0x06, 0x37, 0x40, 0x91, 0x07, 0x8f, 0x9e, 0xab,
+	0xe6, 0xa6, 0x3b, 0x57, 0xad, 0x31, 0x32, 0xd5, 0xa8, 0x7f, 0x26, 0xaf, 0x36, 0xc6, 0x69, 0xe7,
+	0xa7, 0xa3, 0x2d, 0x8a, 0x14, 0x47, 0x0e, 0x6d, 0xa7, 0x88, 0x97, 0xd2, 0x23, 0x21, 0x5e, 0x8a,
+	0x2d, 0x69, 0xea, 0xb7, 0x17, 0x58, 0x53, 0x1b, 0x88, 0xee, 0xa3, 0xa8, 0xd3, 0x45, 0x2e, 0xc6,
+	0xf4, 0xac, 0x9b, 0x5a, 0x06, 0xf9, 0x1e, 0x23, 0xf7, 0x22, 0x8c, 0xa9, 0xd8, 0x18, 0x81, 0xde,
+	0xf0, 0x7d, 0x0d, 0x70, 0x59, 0x00, 0x28, 0xa6, 0xb0, 0x2b, 0x0b, 0x85, 0x7c, 0xd1, 0xbe, 0x8c,
+	0xc1, 0x5f, 0xe7, 0xc1, 0x07, 0x71, 0x6c, 0x5d, 0x86, 0x9e, 0x56, 0x1e, 0x25, 0x5b, 0x51, 0x1e,
+	0xc5, 0x26, 0xcb, 0x53, 0x7b, 0x33, 0x0f, 0x72, 0xfb, 0xa4, 0xad, 0xbb, 0x00, 0xa4, 0x2e, 0x6d,
+	0xc6, 0xe4, 0x4b, 0xb9, 0xfb, 0x30, 0x6f, 0x9c, 0xea, 0x4e, 0x4e, 0xcb, 0x92, 0x93, 0x5f, 0x8b,
+	0x9c, 0xc8, 0xc9, 0xdc, 0xe6, 0x8d, 0x53, 0xdd, 0x09, 0xe7, 0x57, 0xa0, 0xa0, 0x9e, 0xa0, 0x2b,
+	0x93, 0xe2, 0xd2, 0x08, 0xd3, 0xca, 0x42, 0xa4, 0xc9, 0xd5, 0x1d, 0xf4, 0x24, 0x72, 0x05, 0x61,
+	0x5a, 0x59, 0x88, 0x84, 0xfc, 0x6b, 0xb0, 0x3c, 0xf2, 0x43, 0x74, 0x6d, 0x42, 0xac, 0x0a, 0x31,
+	0x6f, 0x65, 0x42, 0x12, 0xfe, 0x3e, 0x58, 0x9f, 0xa8, 0xfa, 0x37, 0x4f, 0xfc, 0x42, 0x15, 0x68,
+	0x3a, 0x53, 0x02, 0xd3, 0xe5, 0x52, 0xb5, 0x72, 0x52, 0xb9, 0x14, 0x84, 0x69, 0x65, 0x21, 0xd2,
+	0xe4, 0xea, 0x9a, 0x9d, 0x44, 0xae, 0x20, 0x4c, 0x2b, 0x0b, 0xa1, 0x4c, 0x91, 0x72, 0x7d, 0x55,
+	0x39, 0x69, 0xfa, 0x24, 0xc2, 0xb4, 0xb2, 0x10, 0x92, 0x7c, 0xe7, 0xd3, 0xd7, 0xef, 0x4a, 0xda,
+	0x9b, 0x77, 0x25, 0xed, 0xef, 0x77, 0x25, 0xed, 0xe5, 0x71, 0x69, 0xe6, 0xcd, 0x71, 0x69, 0xe6,
+	0xf7, 0xe3, 0xd2, 0xcc, 0x97, 0x5b, 0xed, 0x80, 0x3e, 0x1d, 0x34, 0xed, 0x16, 0xee, 0x39, 0x0d,
+	0xc6, 0x76, 0xe7, 0x21, 0x6c, 0x12, 0x47, 0x5c, 0xa9, 0x3e, 0xab, 0x7d, 0xe4, 0xbc, 0x18, 0xde,
+	0x0f, 0x1f, 0xf5, 0x11, 0x69, 0xce, 0xb3, 0x03, 0xc3, 0x07, 0xff, 0x0c, 0x00, 0x90, 0x74, 0x8a,
+	0x59, 0x3e, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1027,6 +1384,12 @@ type MsgClient interface {
 	// Admin address to link a stride and non-stride address, merging their
 	// allocations
 	LinkAddresses(ctx context.Context, in *MsgLinkAddresses, opts ...grpc.CallOption) (*MsgLinkAddressesResponse, error)
+	// Admin transaction to set the merkle root of the user allocations, allowing
+	// users to claim with a proof instead of having their allocations added
+	SetMerkleRoot(ctx context.Context, in *MsgSetMerkleRoot, opts ...grpc.CallOption) (*MsgSetMerkleRootResponse, error)
+	// User transaction to claim all the pending daily airdrop rewards and
+	// liquid stake them in the same transaction, receiving stTokens
+	ClaimAndStake(ctx context.Context, in *MsgClaimAndStake, opts ...grpc.CallOption) (*MsgClaimAndStakeResponse, error)
 }
 
 type msgClient struct {
@@ -1100,6 +1463,24 @@ func (c *msgClient) LinkAddresses(ctx context.Context, in *MsgLinkAddresses, opt
 	return out, nil
 }
 
+func (c *msgClient) SetMerkleRoot(ctx context.Context, in *MsgSetMerkleRoot, opts ...grpc.CallOption) (*MsgSetMerkleRootResponse, error) {
+	out := new(MsgSetMerkleRootResponse)
+	err := c.cc.Invoke(ctx, "/stride.airdrop.Msg/SetMerkleRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimAndStake(ctx context.Context, in *MsgClaimAndStake, opts ...grpc.CallOption) (*MsgClaimAndStakeResponse, error) {
+	out := new(MsgClaimAndStakeResponse)
+	err := c.cc.Invoke(ctx, "/stride.airdrop.Msg/ClaimAndStake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// User transaction to claim all the pending daily airdrop rewards
@@ -1118,6 +1499,12 @@ type MsgServer interface {
 	// Admin address to link a stride and non-stride address, merging their
 	// allocations
 	LinkAddresses(context.Context, *MsgLinkAddresses) (*MsgLinkAddressesResponse, error)
+	// Admin transaction to set the merkle root of the user allocations, allowing
+	// users to claim with a proof instead of having their allocations added
+	SetMerkleRoot(context.Context, *MsgSetMerkleRoot) (*MsgSetMerkleRootResponse, error)
+	// User transaction to claim all the pending daily airdrop rewards and
+	// liquid stake them in the same transaction, receiving stTokens
+	ClaimAndStake(context.Context, *MsgClaimAndStake) (*MsgClaimAndStakeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1145,6 +1532,12 @@ func (*UnimplementedMsgServer) UpdateUserAllocation(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) LinkAddresses(ctx context.Context, req *MsgLinkAddresses) (*MsgLinkAddressesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LinkAddresses not implemented")
 }
+func (*UnimplementedMsgServer) SetMerkleRoot(ctx context.Context, req *MsgSetMerkleRoot) (*MsgSetMerkleRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMerkleRoot not implemented")
+}
+func (*UnimplementedMsgServer) ClaimAndStake(ctx context.Context, req *MsgClaimAndStake) (*MsgClaimAndStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAndStake not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1276,6 +1669,42 @@ func _Msg_LinkAddresses_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetMerkleRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMerkleRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMerkleRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.airdrop.Msg/SetMerkleRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMerkleRoot(ctx, req.(*MsgSetMerkleRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimAndStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimAndStake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimAndStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.airdrop.Msg/ClaimAndStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimAndStake(ctx, req.(*MsgClaimAndStake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.airdrop.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1308,6 +1737,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LinkAddresses",
 			Handler:    _Msg_LinkAddresses_Handler,
 		},
+		{
+			MethodName: "SetMerkleRoot",
+			Handler:    _Msg_SetMerkleRoot_Handler,
+		},
+		{
+			MethodName: "ClaimAndStake",
+			Handler:    _Msg_ClaimAndStake_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stride/airdrop/tx.proto",
@@ -1333,6 +1770,18 @@ func (m *MsgClaimDaily) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AllocationProof != nil {
+		{
+			size, err := m.AllocationProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.AirdropId) > 0 {
 		i -= len(m.AirdropId)
 		copy(dAtA[i:], m.AirdropId)
@@ -1393,8 +1842,20 @@ func (m *MsgClaimEarly) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.AirdropId) > 0 {
-		i -= len(m.AirdropId)
+	if m.AllocationProof != nil {
+		{
+			size, err := m.AllocationProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AirdropId) > 0 {
+		i -= len(m.AirdropId)
 		copy(dAtA[i:], m.AirdropId)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.AirdropId)))
 		i--
@@ -1433,6 +1894,88 @@ func (m *MsgClaimEarlyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgClaimAndStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAndStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAndStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllocationProof != nil {
+		{
+			size, err := m.AllocationProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AirdropId) > 0 {
+		i -= len(m.AirdropId)
+		copy(dAtA[i:], m.AirdropId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AirdropId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Claimer) > 0 {
+		i -= len(m.Claimer)
+		copy(dAtA[i:], m.Claimer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Claimer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAndStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAndStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAndStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.StToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgCreateAirdrop) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1453,6 +1996,38 @@ func (m *MsgCreateAirdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RedistributeForfeited {
+		i--
+		if m.RedistributeForfeited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.ClawbackAirdropId) > 0 {
+		i -= len(m.ClawbackAirdropId)
+		copy(dAtA[i:], m.ClawbackAirdropId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClawbackAirdropId)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.ClawbackDestination != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ClawbackDestination))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.ClaimAndStakeBonus.Size()
+		i -= size
+		if _, err := m.ClaimAndStakeBonus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
 	if len(m.LinkerAddress) > 0 {
 		i -= len(m.LinkerAddress)
 		copy(dAtA[i:], m.LinkerAddress)
@@ -1485,42 +2060,42 @@ func (m *MsgCreateAirdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x42
 	if m.ClaimTypeDeadlineDate != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ClaimTypeDeadlineDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClaimTypeDeadlineDate):])
-		if err1 != nil {
-			return 0, err1
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ClaimTypeDeadlineDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClaimTypeDeadlineDate):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n1
-		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i -= n5
+		i = encodeVarintTx(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x3a
 	}
 	if m.ClawbackDate != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ClawbackDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClawbackDate):])
-		if err2 != nil {
-			return 0, err2
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ClawbackDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClawbackDate):])
+		if err6 != nil {
+			return 0, err6
 		}
-		i -= n2
-		i = encodeVarintTx(dAtA, i, uint64(n2))
+		i -= n6
+		i = encodeVarintTx(dAtA, i, uint64(n6))
 		i--
 		dAtA[i] = 0x32
 	}
 	if m.DistributionEndDate != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DistributionEndDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionEndDate):])
-		if err3 != nil {
-			return 0, err3
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DistributionEndDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionEndDate):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n3
-		i = encodeVarintTx(dAtA, i, uint64(n3))
+		i -= n7
+		i = encodeVarintTx(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0x2a
 	}
 	if m.DistributionStartDate != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DistributionStartDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionStartDate):])
-		if err4 != nil {
-			return 0, err4
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DistributionStartDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionStartDate):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n4
-		i = encodeVarintTx(dAtA, i, uint64(n4))
+		i -= n8
+		i = encodeVarintTx(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -1591,6 +2166,38 @@ func (m *MsgUpdateAirdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RedistributeForfeited {
+		i--
+		if m.RedistributeForfeited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.ClawbackAirdropId) > 0 {
+		i -= len(m.ClawbackAirdropId)
+		copy(dAtA[i:], m.ClawbackAirdropId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClawbackAirdropId)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.ClawbackDestination != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ClawbackDestination))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.ClaimAndStakeBonus.Size()
+		i -= size
+		if _, err := m.ClaimAndStakeBonus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
 	if len(m.LinkerAddress) > 0 {
 		i -= len(m.LinkerAddress)
 		copy(dAtA[i:], m.LinkerAddress)
@@ -1623,42 +2230,42 @@ func (m *MsgUpdateAirdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x42
 	if m.ClaimTypeDeadlineDate != nil {
-		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ClaimTypeDeadlineDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClaimTypeDeadlineDate):])
-		if err5 != nil {
-			return 0, err5
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ClaimTypeDeadlineDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClaimTypeDeadlineDate):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n5
-		i = encodeVarintTx(dAtA, i, uint64(n5))
+		i -= n9
+		i = encodeVarintTx(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0x3a
 	}
 	if m.ClawbackDate != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ClawbackDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClawbackDate):])
-		if err6 != nil {
-			return 0, err6
+		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ClawbackDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClawbackDate):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n6
-		i = encodeVarintTx(dAtA, i, uint64(n6))
+		i -= n10
+		i = encodeVarintTx(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x32
 	}
 	if m.DistributionEndDate != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DistributionEndDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionEndDate):])
-		if err7 != nil {
-			return 0, err7
+		n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DistributionEndDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionEndDate):])
+		if err11 != nil {
+			return 0, err11
 		}
-		i -= n7
-		i = encodeVarintTx(dAtA, i, uint64(n7))
+		i -= n11
+		i = encodeVarintTx(dAtA, i, uint64(n11))
 		i--
 		dAtA[i] = 0x2a
 	}
 	if m.DistributionStartDate != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DistributionStartDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionStartDate):])
-		if err8 != nil {
-			return 0, err8
+		n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DistributionStartDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionStartDate):])
+		if err12 != nil {
+			return 0, err12
 		}
-		i -= n8
-		i = encodeVarintTx(dAtA, i, uint64(n8))
+		i -= n12
+		i = encodeVarintTx(dAtA, i, uint64(n12))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -1729,6 +2336,24 @@ func (m *RawAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RequiredActions) > 0 {
+		dAtA14 := make([]byte, len(m.RequiredActions)*10)
+		var j13 int
+		for _, num := range m.RequiredActions {
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintTx(dAtA, i, uint64(j13))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Allocations) > 0 {
 		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1928,6 +2553,30 @@ func (m *MsgLinkAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StrideAllocationProof != nil {
+		{
+			size, err := m.StrideAllocationProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.HostAllocationProof != nil {
+		{
+			size, err := m.HostAllocationProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.HostAddress) > 0 {
 		i -= len(m.HostAddress)
 		copy(dAtA[i:], m.HostAddress)
@@ -1982,6 +2631,83 @@ func (m *MsgLinkAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetMerkleRoot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMerkleRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMerkleRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MerkleTotalAllocated.Size()
+		i -= size
+		if _, err := m.MerkleTotalAllocated.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AirdropId) > 0 {
+		i -= len(m.AirdropId)
+		copy(dAtA[i:], m.AirdropId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AirdropId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMerkleRootResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMerkleRootResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMerkleRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2007,6 +2733,10 @@ func (m *MsgClaimDaily) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.AllocationProof != nil {
+		l = m.AllocationProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2033,6 +2763,10 @@ func (m *MsgClaimEarly) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.AllocationProof != nil {
+		l = m.AllocationProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2045,13 +2779,13 @@ func (m *MsgClaimEarlyResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgCreateAirdrop) Size() (n int) {
+func (m *MsgClaimAndStake) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Admin)
+	l = len(m.Claimer)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -2059,23 +2793,55 @@ func (m *MsgCreateAirdrop) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.RewardDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DistributionStartDate != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionStartDate)
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DistributionEndDate != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionEndDate)
+	if m.AllocationProof != nil {
+		l = m.AllocationProof.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.ClawbackDate != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClawbackDate)
-		n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgClaimAndStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.ClaimTypeDeadlineDate != nil {
+	var l int
+	_ = l
+	l = m.StToken.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateAirdrop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AirdropId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RewardDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DistributionStartDate != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionStartDate)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DistributionEndDate != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionEndDate)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ClawbackDate != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClawbackDate)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ClaimTypeDeadlineDate != nil {
 		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClaimTypeDeadlineDate)
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -2093,6 +2859,18 @@ func (m *MsgCreateAirdrop) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = m.ClaimAndStakeBonus.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ClawbackDestination != 0 {
+		n += 1 + sovTx(uint64(m.ClawbackDestination))
+	}
+	l = len(m.ClawbackAirdropId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RedistributeForfeited {
+		n += 2
+	}
 	return n
 }
 
@@ -2153,6 +2931,18 @@ func (m *MsgUpdateAirdrop) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = m.ClaimAndStakeBonus.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ClawbackDestination != 0 {
+		n += 1 + sovTx(uint64(m.ClawbackDestination))
+	}
+	l = len(m.ClawbackAirdropId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RedistributeForfeited {
+		n += 2
+	}
 	return n
 }
 
@@ -2181,6 +2971,13 @@ func (m *RawAllocation) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if len(m.RequiredActions) > 0 {
+		l = 0
+		for _, e := range m.RequiredActions {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
 	return n
 }
 
@@ -2274,6 +3071,14 @@ func (m *MsgLinkAddresses) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.HostAllocationProof != nil {
+		l = m.HostAllocationProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StrideAllocationProof != nil {
+		l = m.StrideAllocationProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2286,6 +3091,38 @@ func (m *MsgLinkAddressesResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetMerkleRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AirdropId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MerkleTotalAllocated.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetMerkleRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2385,6 +3222,42 @@ func (m *MsgClaimDaily) Unmarshal(dAtA []byte) error {
 			}
 			m.AirdropId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllocationProof == nil {
+				m.AllocationProof = &AllocationProof{}
+			}
+			if err := m.AllocationProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2549,6 +3422,42 @@ func (m *MsgClaimEarly) Unmarshal(dAtA []byte) error {
 			}
 			m.AirdropId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllocationProof == nil {
+				m.AllocationProof = &AllocationProof{}
+			}
+			if err := m.AllocationProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2620,7 +3529,7 @@ func (m *MsgClaimEarlyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateAirdrop) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimAndStake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2643,15 +3552,15 @@ func (m *MsgCreateAirdrop) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateAirdrop: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimAndStake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateAirdrop: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimAndStake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2679,7 +3588,7 @@ func (m *MsgCreateAirdrop) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Claimer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2715,9 +3624,9 @@ func (m *MsgCreateAirdrop) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationProof", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2727,63 +3636,81 @@ func (m *MsgCreateAirdrop) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionStartDate", wireType)
+			if m.AllocationProof == nil {
+				m.AllocationProof = &AllocationProof{}
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.AllocationProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DistributionStartDate == nil {
-				m.DistributionStartDate = new(time.Time)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimAndStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.DistributionStartDate, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAndStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAndStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionEndDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StToken", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2810,270 +3737,15 @@ func (m *MsgCreateAirdrop) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DistributionEndDate == nil {
-				m.DistributionEndDate = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.DistributionEndDate, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackDate", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ClawbackDate == nil {
-				m.ClawbackDate = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ClawbackDate, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimTypeDeadlineDate", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ClaimTypeDeadlineDate == nil {
-				m.ClaimTypeDeadlineDate = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ClaimTypeDeadlineDate, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EarlyClaimPenalty", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.EarlyClaimPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DistributorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllocatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AllocatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinkerAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LinkerAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCreateAirdropResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateAirdropResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateAirdropResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
@@ -3090,7 +3762,7 @@ func (m *MsgCreateAirdropResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAirdrop) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateAirdrop) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3113,10 +3785,10 @@ func (m *MsgUpdateAirdrop) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAirdrop: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateAirdrop: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAirdrop: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateAirdrop: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3395,7 +4067,1070 @@ func (m *MsgUpdateAirdrop) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllocatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimAndStakeBonus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimAndStakeBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackDestination", wireType)
+			}
+			m.ClawbackDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClawbackDestination |= ClawbackDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackAirdropId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClawbackAirdropId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedistributeForfeited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RedistributeForfeited = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateAirdropResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateAirdropResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateAirdropResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAirdrop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAirdrop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAirdrop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AirdropId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionStartDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DistributionStartDate == nil {
+				m.DistributionStartDate = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.DistributionStartDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionEndDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DistributionEndDate == nil {
+				m.DistributionEndDate = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.DistributionEndDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClawbackDate == nil {
+				m.ClawbackDate = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ClawbackDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimTypeDeadlineDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClaimTypeDeadlineDate == nil {
+				m.ClaimTypeDeadlineDate = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ClaimTypeDeadlineDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyClaimPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EarlyClaimPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllocatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimAndStakeBonus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimAndStakeBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackDestination", wireType)
+			}
+			m.ClawbackDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClawbackDestination |= ClawbackDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackAirdropId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClawbackAirdropId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedistributeForfeited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RedistributeForfeited = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAirdropResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAirdropResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAirdropResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RawAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RawAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RawAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Allocations = append(m.Allocations, v)
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v Action
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Action(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RequiredActions = append(m.RequiredActions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.RequiredActions) == 0 {
+					m.RequiredActions = make([]Action, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Action
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Action(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RequiredActions = append(m.RequiredActions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredActions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAllocations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAllocations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAllocations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3423,11 +5158,11 @@ func (m *MsgUpdateAirdrop) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DistributorAddress = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllocatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3455,13 +5190,13 @@ func (m *MsgUpdateAirdrop) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllocatorAddress = string(dAtA[iNdEx:postIndex])
+			m.AirdropId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinkerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3471,23 +5206,25 @@ func (m *MsgUpdateAirdrop) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LinkerAddress = string(dAtA[iNdEx:postIndex])
+			m.Allocations = append(m.Allocations, RawAllocation{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3510,7 +5247,7 @@ func (m *MsgUpdateAirdrop) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAirdropResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddAllocationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3533,10 +5270,10 @@ func (m *MsgUpdateAirdropResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAirdropResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddAllocationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAirdropResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddAllocationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3560,7 +5297,7 @@ func (m *MsgUpdateAirdropResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawAllocation) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateUserAllocation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3583,15 +5320,15 @@ func (m *RawAllocation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawAllocation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateUserAllocation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateUserAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3619,11 +5356,11 @@ func (m *RawAllocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserAddress = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3651,65 +5388,11 @@ func (m *RawAllocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.Allocations = append(m.Allocations, v)
-			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AirdropId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgAddAllocations) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAllocations: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAllocations: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3737,11 +5420,11 @@ func (m *MsgAddAllocations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.UserAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3769,38 +5452,8 @@ func (m *MsgAddAllocations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AirdropId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Allocations = append(m.Allocations, RawAllocation{})
+			var v cosmossdk_io_math.Int
+			m.Allocations = append(m.Allocations, v)
 			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -3826,7 +5479,7 @@ func (m *MsgAddAllocations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddAllocationsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateUserAllocationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3849,10 +5502,10 @@ func (m *MsgAddAllocationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAllocationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateUserAllocationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAllocationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateUserAllocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3876,7 +5529,7 @@ func (m *MsgAddAllocationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateUserAllocation) Unmarshal(dAtA []byte) error {
+func (m *MsgLinkAddresses) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3899,10 +5552,10 @@ func (m *MsgUpdateUserAllocation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateUserAllocation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLinkAddresses: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateUserAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLinkAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3971,7 +5624,7 @@ func (m *MsgUpdateUserAllocation) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StrideAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3999,11 +5652,11 @@ func (m *MsgUpdateUserAllocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserAddress = string(dAtA[iNdEx:postIndex])
+			m.StrideAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4031,9 +5684,77 @@ func (m *MsgUpdateUserAllocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.Allocations = append(m.Allocations, v)
-			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAllocationProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HostAllocationProof == nil {
+				m.HostAllocationProof = &AllocationProof{}
+			}
+			if err := m.HostAllocationProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrideAllocationProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StrideAllocationProof == nil {
+				m.StrideAllocationProof = &AllocationProof{}
+			}
+			if err := m.StrideAllocationProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4058,7 +5779,7 @@ func (m *MsgUpdateUserAllocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateUserAllocationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLinkAddressesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4081,10 +5802,10 @@ func (m *MsgUpdateUserAllocationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateUserAllocationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLinkAddressesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateUserAllocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLinkAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4108,7 +5829,7 @@ func (m *MsgUpdateUserAllocationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLinkAddresses) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMerkleRoot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4131,10 +5852,10 @@ func (m *MsgLinkAddresses) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLinkAddresses: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMerkleRoot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLinkAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMerkleRoot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4203,7 +5924,7 @@ func (m *MsgLinkAddresses) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StrideAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4231,11 +5952,11 @@ func (m *MsgLinkAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StrideAddress = string(dAtA[iNdEx:postIndex])
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleTotalAllocated", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4263,7 +5984,9 @@ func (m *MsgLinkAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.MerkleTotalAllocated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4286,7 +6009,7 @@ func (m *MsgLinkAddresses) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLinkAddressesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMerkleRootResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4309,10 +6032,10 @@ func (m *MsgLinkAddressesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLinkAddressesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMerkleRootResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLinkAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMerkleRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
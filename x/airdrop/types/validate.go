@@ -18,6 +18,7 @@ func AirdropConfigValidateBasic(
 	clawbackDate *time.Time,
 	claimTypeDeadlineDate *time.Time,
 	earlyClaimPenalty sdk.Dec,
+	claimAndStakeBonus sdk.Dec,
 	distributorAddress string,
 	allocatorAddress string,
 	linkerAddress string,
@@ -62,6 +63,11 @@ func AirdropConfigValidateBasic(
 		return errors.New("early claim penalty must be between 0 and 1")
 	}
 
+	// The claim and stake bonus is optional, but must be a valid rate if specified
+	if !claimAndStakeBonus.IsNil() && (claimAndStakeBonus.LT(sdk.ZeroDec()) || claimAndStakeBonus.GT(sdk.OneDec())) {
+		return errors.New("claim and stake bonus must be between 0 and 1")
+	}
+
 	if _, err := sdk.AccAddressFromBech32(distributorAddress); err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid distributor address (%s)", err)
 	}
@@ -74,3 +80,39 @@ func AirdropConfigValidateBasic(
 
 	return nil
 }
+
+// Validates the clawback configuration on an airdrop creation or update message
+func ClawbackConfigValidateBasic(
+	airdropId string,
+	rewardDenom string,
+	clawbackDestination ClawbackDestination,
+	clawbackAirdropId string,
+	redistributeForfeited bool,
+) error {
+	if _, ok := ClawbackDestination_name[int32(clawbackDestination)]; !ok {
+		return errors.New("invalid clawback destination")
+	}
+
+	// The forfeited pool is redistributed as part of the clawback, so it requires the clawback to be enabled
+	if clawbackDestination == CLAWBACK_DESTINATION_UNSPECIFIED && redistributeForfeited {
+		return errors.New("forfeited rewards can only be redistributed if a clawback destination is specified")
+	}
+
+	if clawbackDestination == CLAWBACK_TO_AIRDROP {
+		if clawbackAirdropId == "" {
+			return errors.New("clawback airdrop-id must be specified when clawing back to an airdrop")
+		}
+		if clawbackAirdropId == airdropId {
+			return errors.New("clawback airdrop-id cannot be the same as the airdrop-id")
+		}
+	} else if clawbackAirdropId != "" {
+		return errors.New("clawback airdrop-id can only be specified when clawing back to an airdrop")
+	}
+
+	// The strdburner only burns STRD, so any other denom would be stuck in the module account
+	if clawbackDestination == CLAWBACK_TO_STRD_BURNER && rewardDenom != StrdDenom {
+		return errors.New("only ustrd airdrops can be clawed back to the strd burner")
+	}
+
+	return nil
+}
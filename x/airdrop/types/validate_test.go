@@ -40,6 +40,7 @@ func TestAirdropConfigValidateBasic(t *testing.T) {
 		clawbackDate          *time.Time
 		claimTypeDeadlineDate *time.Time
 		earlyClaimPenalty     sdk.Dec
+		claimAndStakeBonus    sdk.Dec
 		distributorAddress    string
 		allocatorAddress      string
 		linkerAddress         string
@@ -348,6 +349,50 @@ func TestAirdropConfigValidateBasic(t *testing.T) {
 			linkerAddress:         validLinkerAddress,
 			expectedError:         "early claim penalty must be between 0 and 1",
 		},
+		{
+			name:                  "valid claim and stake bonus",
+			airdropId:             validAirdropId,
+			rewardDenom:           validRewardDenom,
+			distributionStartDate: &validDistributionStartDate,
+			distributionEndDate:   &validDistributionEndDate,
+			clawbackDate:          &validClawbackDate,
+			claimTypeDeadlineDate: &validDeadlineDate,
+			earlyClaimPenalty:     validEarlyClaimPenalty,
+			claimAndStakeBonus:    sdk.MustNewDecFromStr("0.1"),
+			distributorAddress:    validDistributorAddress,
+			allocatorAddress:      validAllocatorAddress,
+			linkerAddress:         validLinkerAddress,
+		},
+		{
+			name:                  "claim and stake bonus less than 0",
+			airdropId:             validAirdropId,
+			rewardDenom:           validRewardDenom,
+			distributionStartDate: &validDistributionStartDate,
+			distributionEndDate:   &validDistributionEndDate,
+			clawbackDate:          &validClawbackDate,
+			claimTypeDeadlineDate: &validDeadlineDate,
+			earlyClaimPenalty:     validEarlyClaimPenalty,
+			claimAndStakeBonus:    sdk.NewDec(-1),
+			distributorAddress:    validDistributorAddress,
+			allocatorAddress:      validAllocatorAddress,
+			linkerAddress:         validLinkerAddress,
+			expectedError:         "claim and stake bonus must be between 0 and 1",
+		},
+		{
+			name:                  "claim and stake bonus greater than 1",
+			airdropId:             validAirdropId,
+			rewardDenom:           validRewardDenom,
+			distributionStartDate: &validDistributionStartDate,
+			distributionEndDate:   &validDistributionEndDate,
+			clawbackDate:          &validClawbackDate,
+			claimTypeDeadlineDate: &validDeadlineDate,
+			earlyClaimPenalty:     validEarlyClaimPenalty,
+			claimAndStakeBonus:    sdk.NewDec(2),
+			distributorAddress:    validDistributorAddress,
+			allocatorAddress:      validAllocatorAddress,
+			linkerAddress:         validLinkerAddress,
+			expectedError:         "claim and stake bonus must be between 0 and 1",
+		},
 		{
 			name:                  "invalid distributor address",
 			airdropId:             validAirdropId,
@@ -402,6 +447,7 @@ func TestAirdropConfigValidateBasic(t *testing.T) {
 				tc.clawbackDate,
 				tc.claimTypeDeadlineDate,
 				tc.earlyClaimPenalty,
+				tc.claimAndStakeBonus,
 				tc.distributorAddress,
 				tc.allocatorAddress,
 				tc.linkerAddress,
@@ -414,3 +460,93 @@ func TestAirdropConfigValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestClawbackConfigValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name                string
+		rewardDenom         string
+		clawbackDestination types.ClawbackDestination
+		clawbackAirdropId   string
+		redistribute        bool
+		expectedError       string
+	}{
+		{
+			name:                "valid clawback disabled",
+			rewardDenom:         "denom",
+			clawbackDestination: types.CLAWBACK_DESTINATION_UNSPECIFIED,
+		},
+		{
+			name:                "valid community pool clawback",
+			rewardDenom:         "denom",
+			clawbackDestination: types.CLAWBACK_TO_COMMUNITY_POOL,
+		},
+		{
+			name:                "valid strd burner clawback",
+			rewardDenom:         "ustrd",
+			clawbackDestination: types.CLAWBACK_TO_STRD_BURNER,
+		},
+		{
+			name:                "valid airdrop clawback",
+			rewardDenom:         "denom",
+			clawbackDestination: types.CLAWBACK_TO_AIRDROP,
+			clawbackAirdropId:   "airdrop-2",
+		},
+		{
+			name:                "invalid clawback destination",
+			rewardDenom:         "denom",
+			clawbackDestination: types.ClawbackDestination(99),
+			expectedError:       "invalid clawback destination",
+		},
+		{
+			name:                "strd burner clawback with non-strd denom",
+			rewardDenom:         "denom",
+			clawbackDestination: types.CLAWBACK_TO_STRD_BURNER,
+			expectedError:       "only ustrd airdrops can be clawed back to the strd burner",
+		},
+		{
+			name:                "airdrop clawback missing airdrop id",
+			rewardDenom:         "denom",
+			clawbackDestination: types.CLAWBACK_TO_AIRDROP,
+			expectedError:       "clawback airdrop-id must be specified",
+		},
+		{
+			name:                "airdrop clawback to itself",
+			rewardDenom:         "denom",
+			clawbackDestination: types.CLAWBACK_TO_AIRDROP,
+			clawbackAirdropId:   "airdrop-1",
+			expectedError:       "clawback airdrop-id cannot be the same as the airdrop-id",
+		},
+		{
+			name:                "airdrop id specified without airdrop clawback",
+			rewardDenom:         "denom",
+			clawbackDestination: types.CLAWBACK_TO_COMMUNITY_POOL,
+			clawbackAirdropId:   "airdrop-2",
+			expectedError:       "clawback airdrop-id can only be specified when clawing back to an airdrop",
+		},
+		{
+			name:                "valid redistribution with clawback",
+			rewardDenom:         "denom",
+			clawbackDestination: types.CLAWBACK_TO_COMMUNITY_POOL,
+			redistribute:        true,
+		},
+		{
+			name:                "redistribution with clawback disabled",
+			rewardDenom:         "denom",
+			clawbackDestination: types.CLAWBACK_DESTINATION_UNSPECIFIED,
+			redistribute:        true,
+			expectedError:       "forfeited rewards can only be redistributed if a clawback destination is specified",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actualError := types.ClawbackConfigValidateBasic(
+				"airdrop-1", tc.rewardDenom, tc.clawbackDestination, tc.clawbackAirdropId, tc.redistribute)
+			if tc.expectedError != "" {
+				require.ErrorContains(t, actualError, tc.expectedError)
+				return
+			}
+			require.NoError(t, actualError)
+		})
+	}
+}
@@ -24,6 +24,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		CmdQueryAuction(),
 		CmdQueryAuctions(),
+		CmdQueryUnauctionedBalances(),
 	)
 
 	return cmd
@@ -76,3 +77,26 @@ func CmdQueryAuctions() *cobra.Command {
 	}
 	return cmd
 }
+
+func CmdQueryUnauctionedBalances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unauctioned-balances",
+		Short: "Get the balances in the auction module account that do not have an enabled auction",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryUnauctionedBalancesRequest{}
+			res, err := queryClient.UnauctionedBalances(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,10 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	// Enable the auto-provisioned auctions that now have a price
+	k.EnableAuctionsAwaitingPrice(ctx)
+}
@@ -4,10 +4,12 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/Stride-Labs/stride/v27/utils"
 	"github.com/Stride-Labs/stride/v27/x/auction/types"
+	strdburnertypes "github.com/Stride-Labs/stride/v27/x/strdburner/types"
 )
 
 // Define a type for bid handler functions
@@ -18,6 +20,33 @@ var bidHandlers = map[types.AuctionType]AuctionBidHandler{
 	types.AuctionType_AUCTION_TYPE_FCFS: fcfsBidHandler,
 }
 
+// Sends the payment for a bid to the auction's beneficiary
+// If the beneficiary is the strdburner, the STRD is first collected by the auction module
+// and then burned, so that the burn is attributed to the auction module rather than the bidder
+func (k Keeper) payBeneficiary(ctx sdk.Context, auction *types.Auction, bidder sdk.AccAddress, paymentAmount math.Int) error {
+	beneficiary := sdk.MustAccAddressFromBech32(auction.Beneficiary)
+
+	if beneficiary.Equals(k.strdBurnerKeeper.GetStrdBurnerAddress()) && auction.PaymentDenom == strdburnertypes.StrdDenom {
+		paymentCoins := sdk.NewCoins(sdk.NewCoin(auction.PaymentDenom, paymentAmount))
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.ModuleName, paymentCoins); err != nil {
+			return errorsmod.Wrapf(err, "unable to collect payment of %v from bidder", paymentCoins)
+		}
+
+		auctionAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		return k.strdBurnerKeeper.Burn(ctx, auctionAddress, paymentAmount, strdburnertypes.BURN_SOURCE_AUCTION)
+	}
+
+	// Note: checkBlockedAddr=false because beneficiary can be a module
+	return utils.SafeSendCoins(
+		false,
+		k.bankKeeper,
+		ctx,
+		bidder,
+		beneficiary,
+		sdk.NewCoins(sdk.NewCoin(auction.PaymentDenom, paymentAmount)),
+	)
+}
+
 // fcfsBidHandler handles bids for First Come First Serve auctions
 func fcfsBidHandler(ctx sdk.Context, k Keeper, auction *types.Auction, bid *types.MsgPlaceBid) error {
 	// Get token amount being auctioned off
@@ -65,16 +94,7 @@ func fcfsBidHandler(ctx sdk.Context, k Keeper, auction *types.Auction, bid *type
 	bidder := sdk.MustAccAddressFromBech32(bid.Bidder)
 
 	// Send paymentToken to beneficiary
-	// Note: checkBlockedAddr=false because beneficiary can be a module
-	err = utils.SafeSendCoins(
-		false,
-		k.bankKeeper,
-		ctx,
-		bidder,
-		sdk.MustAccAddressFromBech32(auction.Beneficiary),
-		sdk.NewCoins(sdk.NewCoin(auction.PaymentDenom, bid.PaymentTokenAmount)),
-	)
-	if err != nil {
+	if err := k.payBeneficiary(ctx, auction, bidder, bid.PaymentTokenAmount); err != nil {
 		return fmt.Errorf("failed to send payment tokens from bidder '%s' to beneficiary '%s': %w",
 			bid.Bidder,
 			auction.Beneficiary,
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v27/utils"
+	"github.com/Stride-Labs/stride/v27/x/auction/types"
+	minttypes "github.com/Stride-Labs/stride/v27/x/mint/types"
+	stakeibctypes "github.com/Stride-Labs/stride/v27/x/stakeibc/types"
+	strdburnertypes "github.com/Stride-Labs/stride/v27/x/strdburner/types"
+)
+
+// Streams a portion of the community growth share of a mint to the configured destination
+// (currently only the strdburner, where it is burned and attributed to the community growth account)
+// The streamed amount is: minted amount * community growth weight * mint stream rate
+func (k Keeper) StreamCommunityGrowthMint(ctx sdk.Context, mintedCoin sdk.Coin) error {
+	params := k.GetParams(ctx)
+	if !params.IsMintStreamEnabled() {
+		return nil
+	}
+
+	// Determine the community growth share of the mint
+	communityGrowthWeight := sdk.ZeroDec()
+	for _, recipient := range k.mintKeeper.GetParams(ctx).DistributionRecipients {
+		if recipient.RecipientType == minttypes.RECIPIENT_TYPE_SUBMODULE &&
+			recipient.Recipient == minttypes.CommunityGrowthSubmoduleName {
+			communityGrowthWeight = recipient.Weight
+			break
+		}
+	}
+
+	streamAmount := sdk.NewDecFromInt(mintedCoin.Amount).Mul(communityGrowthWeight).Mul(params.MintStreamRate).TruncateInt()
+	if streamAmount.IsZero() {
+		return nil
+	}
+
+	communityGrowthAddress := k.mintKeeper.GetSubmoduleAddress(
+		minttypes.CommunityGrowthSubmoduleName,
+		minttypes.SubmoduleCommunityNamespaceKey,
+	)
+	streamCoin := sdk.NewCoin(mintedCoin.Denom, streamAmount)
+
+	var destinationModule string
+	switch params.MintStreamDestination {
+	case types.MintStreamDestination_MINT_STREAM_DESTINATION_STRDBURNER:
+		if mintedCoin.Denom != strdburnertypes.StrdDenom {
+			return fmt.Errorf("cannot stream non-%s denom %s to the strdburner", strdburnertypes.StrdDenom, mintedCoin.Denom)
+		}
+		err := k.strdBurnerKeeper.Burn(ctx, communityGrowthAddress, streamAmount, strdburnertypes.BURN_SOURCE_MINT_STREAM)
+		if err != nil {
+			return errorsmod.Wrapf(err, "unable to burn %v from the community growth pool", streamCoin)
+		}
+		destinationModule = strdburnertypes.ModuleName
+	default:
+		return fmt.Errorf("invalid mint stream destination: %d", params.MintStreamDestination)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMintStream,
+			sdk.NewAttribute(types.AttributeKeyStreamAmount, streamCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyDestination, destinationModule),
+		),
+	)
+
+	return nil
+}
+
+// After each mint, stream a portion of the community growth share to be burned
+// Failures are isolated so that they never block the mint
+func (k Keeper) AfterDistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) {
+	err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		return k.StreamCommunityGrowthMint(ctx, mintedCoin)
+	})
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Unable to stream minted coins: %s", err.Error()))
+	}
+}
+
+// ________________________________________________________________________________________
+
+// Hooks wrapper struct for auction keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ minttypes.MintHooks = Hooks{}
+var _ stakeibctypes.StakeIBCHooks = Hooks{}
+
+// Return the wrapper struct
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// mint hooks
+func (h Hooks) AfterDistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) {
+	h.k.AfterDistributeMintedCoin(ctx, mintedCoin)
+}
+
+// stakeibc hooks
+func (h Hooks) AfterLiquidStake(ctx sdk.Context, addr sdk.AccAddress) {}
+
+// After the reward collector balance is swept into the auction module each mint epoch,
+// provision auctions for any new denoms
+func (h Hooks) AfterRewardCollectorAuctioned(ctx sdk.Context) {
+	h.k.AutoProvisionAuctions(ctx)
+}
@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v27/x/auction/types"
+	minttypes "github.com/Stride-Labs/stride/v27/x/mint/types"
+	strdburnertypes "github.com/Stride-Labs/stride/v27/x/strdburner/types"
+)
+
+func (s *KeeperTestSuite) TestStreamCommunityGrowthMint() {
+	mintDenom := "ustrd"
+	communityGrowthAddress := s.App.MintKeeper.GetSubmoduleAddress(
+		minttypes.CommunityGrowthSubmoduleName,
+		minttypes.SubmoduleCommunityNamespaceKey,
+	)
+
+	testCases := []struct {
+		name             string
+		streamRate       string
+		destination      types.MintStreamDestination
+		removeGrowthPool bool
+		expectedStreamed int64
+	}{
+		{
+			// 1000 minted * 0.4 growth weight * 0.5 stream rate = 200
+			name:             "stream to strdburner with default destination",
+			streamRate:       "0.5",
+			expectedStreamed: 200,
+		},
+		{
+			// 1000 minted * 0.4 growth weight * 0.25 stream rate = 100
+			name:             "stream to strdburner",
+			streamRate:       "0.25",
+			destination:      types.MintStreamDestination_MINT_STREAM_DESTINATION_STRDBURNER,
+			expectedStreamed: 100,
+		},
+		{
+			name:             "stream disabled",
+			streamRate:       "0",
+			destination:      types.MintStreamDestination_MINT_STREAM_DESTINATION_STRDBURNER,
+			expectedStreamed: 0,
+		},
+		{
+			name:             "community growth pool not a recipient",
+			streamRate:       "0.5",
+			destination:      types.MintStreamDestination_MINT_STREAM_DESTINATION_STRDBURNER,
+			removeGrowthPool: true,
+			expectedStreamed: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			// Configure the community growth pool to receive 40% of each mint
+			recipients := []minttypes.DistributionRecipient{
+				{RecipientType: minttypes.RECIPIENT_TYPE_SUBMODULE, Recipient: minttypes.CommunitySecurityBudgetSubmoduleName, Weight: sdk.MustNewDecFromStr("0.6")},
+				{RecipientType: minttypes.RECIPIENT_TYPE_SUBMODULE, Recipient: minttypes.CommunityGrowthSubmoduleName, Weight: sdk.MustNewDecFromStr("0.4")},
+			}
+			if tc.removeGrowthPool {
+				recipients = []minttypes.DistributionRecipient{
+					{RecipientType: minttypes.RECIPIENT_TYPE_SUBMODULE, Recipient: minttypes.CommunitySecurityBudgetSubmoduleName, Weight: sdk.OneDec()},
+				}
+			}
+			mintParams := s.App.MintKeeper.GetParams(s.Ctx)
+			mintParams.MintDenom = mintDenom
+			mintParams.DistributionRecipients = recipients
+			s.App.MintKeeper.SetParams(s.Ctx, mintParams)
+
+			s.App.AuctionKeeper.SetParams(s.Ctx, types.Params{
+				MintStreamRate:        sdkmath.LegacyMustNewDecFromStr(tc.streamRate),
+				MintStreamDestination: tc.destination,
+			})
+
+			initialGrowthBalance := s.App.BankKeeper.GetBalance(s.Ctx, communityGrowthAddress, mintDenom).Amount
+
+			// Mint and distribute through the mint keeper so that the hook is triggered
+			mintedCoin := sdk.NewCoin(mintDenom, sdkmath.NewInt(1000))
+			err := s.App.MintKeeper.MintCoins(s.Ctx, sdk.NewCoins(mintedCoin))
+			s.Require().NoError(err, "no error expected when minting")
+			err = s.App.MintKeeper.DistributeMintedCoin(s.Ctx, mintedCoin)
+			s.Require().NoError(err, "no error expected when distributing")
+
+			// Confirm the streamed amount was burned and attributed to the community growth pool
+			mintStreamBurned := s.App.StrdBurnerKeeper.GetSourceBurned(s.Ctx, strdburnertypes.BURN_SOURCE_MINT_STREAM)
+			s.Require().Equal(tc.expectedStreamed, mintStreamBurned.Int64(), "mint stream burns")
+			growthBurned := s.App.StrdBurnerKeeper.GetAddressBurned(s.Ctx, communityGrowthAddress.String())
+			s.Require().Equal(tc.expectedStreamed, growthBurned.Int64(), "community growth burns")
+			s.Require().Zero(s.App.StrdBurnerKeeper.GetSourceBurned(s.Ctx, strdburnertypes.BURN_SOURCE_UNATTRIBUTED).Int64(),
+				"unattributed burns")
+
+			// Confirm the community growth pool kept the remainder of its share
+			expectedGrowthChange := int64(0)
+			if !tc.removeGrowthPool {
+				expectedGrowthChange = 400 - tc.expectedStreamed
+			}
+			growthBalance := s.App.BankKeeper.GetBalance(s.Ctx, communityGrowthAddress, mintDenom).Amount
+			s.Require().Equal(expectedGrowthChange, growthBalance.Sub(initialGrowthBalance).Int64(), "community growth balance change")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestStreamCommunityGrowthMint_FailureIsolated() {
+	mintDenom := "ustrd"
+
+	// Enable the stream, but don't fund the community growth pool
+	s.App.AuctionKeeper.SetParams(s.Ctx, types.Params{
+		MintStreamRate:        sdkmath.LegacyOneDec(),
+		MintStreamDestination: types.MintStreamDestination_MINT_STREAM_DESTINATION_STRDBURNER,
+	})
+
+	// Calling the hook directly should not panic, and should log the error
+	s.App.AuctionKeeper.Hooks().AfterDistributeMintedCoin(s.Ctx, sdk.NewCoin(mintDenom, sdkmath.NewInt(1000)))
+	s.Require().Contains(s.logBuffer.String(), "Unable to stream minted coins")
+
+	burnerAddress := s.App.StrdBurnerKeeper.GetStrdBurnerAddress()
+	s.Require().Zero(s.App.BankKeeper.GetBalance(s.Ctx, burnerAddress, mintDenom).Amount.Int64(), "strdburner balance")
+}
+
+func (s *KeeperTestSuite) TestStreamCommunityGrowthMint_NonStrdDenom() {
+	s.App.AuctionKeeper.SetParams(s.Ctx, types.Params{
+		MintStreamRate:        sdkmath.LegacyOneDec(),
+		MintStreamDestination: types.MintStreamDestination_MINT_STREAM_DESTINATION_STRDBURNER,
+	})
+
+	// Only STRD can be streamed to the strdburner
+	err := s.App.AuctionKeeper.StreamCommunityGrowthMint(s.Ctx, sdk.NewCoin("uatom", sdkmath.NewInt(1000)))
+	s.Require().ErrorContains(err, "cannot stream non-ustrd denom uatom to the strdburner")
+}
@@ -1,18 +1,27 @@
 package keeper
 
 import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/Stride-Labs/stride/v27/x/auction/types"
 )
 
 type Keeper struct {
-	cdc             codec.Codec
-	storeKey        storetypes.StoreKey
-	accountKeeper   types.AccountKeeper
-	bankKeeper      types.BankKeeper
-	icqoracleKeeper types.IcqOracleKeeper
+	cdc              codec.Codec
+	storeKey         storetypes.StoreKey
+	accountKeeper    types.AccountKeeper
+	bankKeeper       types.BankKeeper
+	icqoracleKeeper  types.IcqOracleKeeper
+	mintKeeper       types.MintKeeper
+	strdBurnerKeeper types.StrdBurnerKeeper
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 func NewKeeper(
@@ -21,12 +30,27 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	icqoracleKeeper types.IcqOracleKeeper,
+	mintKeeper types.MintKeeper,
+	strdBurnerKeeper types.StrdBurnerKeeper,
+	authority string,
 ) *Keeper {
 	return &Keeper{
-		cdc:             cdc,
-		storeKey:        storeKey,
-		accountKeeper:   accountKeeper,
-		bankKeeper:      bankKeeper,
-		icqoracleKeeper: icqoracleKeeper,
+		cdc:              cdc,
+		storeKey:         storeKey,
+		accountKeeper:    accountKeeper,
+		bankKeeper:       bankKeeper,
+		icqoracleKeeper:  icqoracleKeeper,
+		mintKeeper:       mintKeeper,
+		strdBurnerKeeper: strdBurnerKeeper,
+		authority:        authority,
 	}
 }
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthority returns the x/auction module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
@@ -3,8 +3,10 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/Stride-Labs/stride/v27/x/auction/types"
 )
@@ -73,7 +75,20 @@ func (ms msgServer) UpdateAuction(goCtx context.Context, msg *types.MsgUpdateAuc
 	auction.MinBidAmount = msg.MinBidAmount
 	auction.MinPriceMultiplier = msg.MinPriceMultiplier
 	auction.Beneficiary = msg.Beneficiary
+	auction.AwaitingPrice = false // the admin now controls whether the auction is enabled
 	ms.Keeper.SetAuction(ctx, auction)
 
 	return &types.MsgUpdateAuctionResponse{}, nil
 }
+
+// UpdateParams updates the module parameters (governance only)
+func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.Keeper.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.Keeper.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ms.Keeper.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -4,10 +4,13 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/Stride-Labs/stride/v27/x/auction/types"
 	icqoracletypes "github.com/Stride-Labs/stride/v27/x/icqoracle/types"
+	strdburnertypes "github.com/Stride-Labs/stride/v27/x/strdburner/types"
 )
 
 func (s *KeeperTestSuite) TestCreateAuction() {
@@ -105,7 +108,6 @@ func (s *KeeperTestSuite) TestFcfsPlaceBidHappyPath() {
 		OsmosisPoolId:    1,
 		SpotPrice:        sdkmath.LegacyNewDec(1),
 		LastResponseTime: s.Ctx.BlockTime(),
-		QueryInProgress:  false,
 	}
 	s.App.ICQOracleKeeper.SetTokenPrice(s.Ctx, tokenPrice)
 
@@ -129,12 +131,22 @@ func (s *KeeperTestSuite) TestFcfsPlaceBidHappyPath() {
 	s.Require().NoError(err, "no error expected when placing bid")
 
 	// Check payment token to beneficiary
+	// Since the beneficiary is the strdburner, the payment is collected by the auction module before it's burned
+	paymentCoins := sdk.NewCoins(sdk.NewCoin(auction.PaymentDenom, msg.PaymentTokenAmount)).String()
 	s.Require().Contains(s.Ctx.EventManager().Events(),
 		sdk.NewEvent(
 			banktypes.EventTypeTransfer,
-			sdk.NewAttribute(banktypes.AttributeKeyRecipient, s.App.StrdBurnerKeeper.GetStrdBurnerAddress().String()),
+			sdk.NewAttribute(banktypes.AttributeKeyRecipient, s.App.AccountKeeper.GetModuleAddress(types.ModuleName).String()),
 			sdk.NewAttribute(banktypes.AttributeKeySender, msg.Bidder),
-			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoins(sdk.NewCoin(auction.PaymentDenom, msg.PaymentTokenAmount)).String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, paymentCoins),
+		),
+	)
+	s.Require().Contains(s.Ctx.EventManager().Events(),
+		sdk.NewEvent(
+			banktypes.EventTypeTransfer,
+			sdk.NewAttribute(banktypes.AttributeKeyRecipient, s.App.StrdBurnerKeeper.GetStrdBurnerAddress().String()),
+			sdk.NewAttribute(banktypes.AttributeKeySender, s.App.AccountKeeper.GetModuleAddress(types.ModuleName).String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, paymentCoins),
 		),
 	)
 
@@ -161,6 +173,15 @@ func (s *KeeperTestSuite) TestFcfsPlaceBidHappyPath() {
 			sdk.NewAttribute(types.AttributeKeyPrice, sdkmath.LegacyNewDec(1).String()),
 		),
 	)
+
+	// Since the beneficiary is the strdburner, the payment should be burned and attributed to the auction
+	burnerAddress := s.App.StrdBurnerKeeper.GetStrdBurnerAddress()
+	s.Require().Zero(s.App.BankKeeper.GetBalance(s.Ctx, burnerAddress, auction.PaymentDenom).Amount.Int64(), "strdburner balance")
+	s.Require().Equal(msg.PaymentTokenAmount, s.App.StrdBurnerKeeper.GetSourceBurned(s.Ctx, strdburnertypes.BURN_SOURCE_AUCTION), "auction burns")
+	auctionAddress := s.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+	s.Require().Equal(msg.PaymentTokenAmount, s.App.StrdBurnerKeeper.GetAddressBurned(s.Ctx, auctionAddress.String()), "auction module burns")
+	s.Require().Zero(s.App.StrdBurnerKeeper.GetAddressBurned(s.Ctx, msg.Bidder).Int64(), "bidder burns")
+	s.Require().Zero(s.App.BankKeeper.GetBalance(s.Ctx, auctionAddress, auction.PaymentDenom).Amount.Int64(), "auction payment balance")
 }
 
 func (s *KeeperTestSuite) TestFcfsPlaceBidUnsupportedAuctionType() {
@@ -286,7 +307,6 @@ func (s *KeeperTestSuite) TestFcfsPlaceBidNoPriceForPaymentDenom() {
 		OsmosisPoolId:    1,
 		SpotPrice:        sdkmath.LegacyNewDec(1),
 		LastResponseTime: s.Ctx.BlockTime(),
-		QueryInProgress:  false,
 	}
 	s.App.ICQOracleKeeper.SetTokenPrice(s.Ctx, tokenPrice)
 
@@ -328,7 +348,6 @@ func (s *KeeperTestSuite) TestFcfsPlaceBidTooLowPrice() {
 		OsmosisPoolId:    1,
 		SpotPrice:        sdkmath.LegacyNewDec(1),
 		LastResponseTime: s.Ctx.BlockTime(),
-		QueryInProgress:  false,
 	}
 	s.App.ICQOracleKeeper.SetTokenPrice(s.Ctx, tokenPrice)
 
@@ -376,7 +395,6 @@ func (s *KeeperTestSuite) TestFcfsPlaceBidNotEnoughPaymentTokens() {
 		OsmosisPoolId:    1,
 		SpotPrice:        sdkmath.LegacyNewDec(1),
 		LastResponseTime: s.Ctx.BlockTime(),
-		QueryInProgress:  false,
 	}
 	s.App.ICQOracleKeeper.SetTokenPrice(s.Ctx, tokenPrice)
 
@@ -448,7 +466,6 @@ func (s *KeeperTestSuite) TestPlaceBidExactMinBidAmount() {
 		OsmosisPoolId:    1,
 		SpotPrice:        sdkmath.LegacyNewDec(1),
 		LastResponseTime: s.Ctx.BlockTime(),
-		QueryInProgress:  false,
 	}
 	s.App.ICQOracleKeeper.SetTokenPrice(s.Ctx, tokenPrice)
 
@@ -471,3 +488,28 @@ func (s *KeeperTestSuite) TestPlaceBidExactMinBidAmount() {
 	_, err := s.GetMsgServer().PlaceBid(sdk.UnwrapSDKContext(s.Ctx), &msg)
 	s.Require().NoError(err, "no error expected when placing bid")
 }
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	newParams := types.Params{
+		MintStreamRate:            sdkmath.LegacyMustNewDecFromStr("0.25"),
+		MintStreamDestination:     types.MintStreamDestination_MINT_STREAM_DESTINATION_STRDBURNER,
+		AutoProvisionEnabled:      true,
+		DefaultAuctionType:        types.AuctionType_AUCTION_TYPE_FCFS,
+		DefaultPaymentDenom:       "ustrd",
+		DefaultMinPriceMultiplier: sdkmath.LegacyMustNewDecFromStr("0.9"),
+		AutoProvisionMinBalance:   sdkmath.NewInt(1000),
+	}
+
+	// Update the params from the gov authority
+	msg := types.MsgUpdateParams{Authority: authority, Params: newParams}
+	_, err := s.GetMsgServer().UpdateParams(sdk.UnwrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when updating params")
+	s.Require().Equal(newParams, s.App.AuctionKeeper.GetParams(s.Ctx), "params after update")
+
+	// Attempt to update the params from a different address, it should fail
+	invalidMsg := types.MsgUpdateParams{Authority: s.TestAccs[0].String(), Params: types.DefaultParams()}
+	_, err = s.GetMsgServer().UpdateParams(sdk.UnwrapSDKContext(s.Ctx), &invalidMsg)
+	s.Require().ErrorContains(err, "invalid authority")
+	s.Require().Equal(newParams, s.App.AuctionKeeper.GetParams(s.Ctx), "params should not have changed")
+}
@@ -1,9 +1,21 @@
 package keeper_test
 
-import "github.com/Stride-Labs/stride/v27/x/auction/types"
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/Stride-Labs/stride/v27/x/auction/types"
+)
 
 func (s *KeeperTestSuite) TestParams() {
-	expectedParams := types.Params{}
+	expectedParams := types.Params{
+		MintStreamRate:            sdkmath.LegacyMustNewDecFromStr("0.1"),
+		MintStreamDestination:     types.MintStreamDestination_MINT_STREAM_DESTINATION_STRDBURNER,
+		AutoProvisionEnabled:      true,
+		DefaultAuctionType:        types.AuctionType_AUCTION_TYPE_FCFS,
+		DefaultPaymentDenom:       "ustrd",
+		DefaultMinPriceMultiplier: sdkmath.LegacyMustNewDecFromStr("0.9"),
+		AutoProvisionMinBalance:   sdkmath.NewInt(1000),
+	}
 	s.App.AuctionKeeper.SetParams(s.Ctx, expectedParams)
 
 	actualParams := s.App.AuctionKeeper.GetParams(s.Ctx)
@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v27/x/auction/types"
+)
+
+// Returns the selling denoms that have an auction, mapped to whether any of those auctions are enabled
+func (k Keeper) GetAuctionedDenoms(ctx sdk.Context) map[string]bool {
+	auctionedDenoms := map[string]bool{}
+	for _, auction := range k.GetAllAuctions(ctx) {
+		auctionedDenoms[auction.SellingDenom] = auctionedDenoms[auction.SellingDenom] || auction.Enabled
+	}
+	return auctionedDenoms
+}
+
+// Returns the balances in the auction module account that do not have an enabled auction
+func (k Keeper) GetUnauctionedBalances(ctx sdk.Context) sdk.Coins {
+	auctionedDenoms := k.GetAuctionedDenoms(ctx)
+	moduleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
+
+	unauctionedBalances := sdk.NewCoins()
+	for _, balance := range k.bankKeeper.GetAllBalances(ctx, moduleAddress) {
+		if !auctionedDenoms[balance.Denom] {
+			unauctionedBalances = unauctionedBalances.Add(balance)
+		}
+	}
+	return unauctionedBalances
+}
+
+// Creates a disabled auction, using the default params, for each denom in the auction
+// module account that does not already have an auction, has a price configured in the
+// oracle, and has a balance of at least the auto-provision minimum
+// This is run after the reward collector balance is swept into the auction module each mint epoch
+// The auctions are enabled once there is a fresh price (see EnableAuctionsAwaitingPrice)
+func (k Keeper) AutoProvisionAuctions(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if !params.AutoProvisionEnabled {
+		return
+	}
+
+	// If the beneficiary isn't specified, send the proceeds to the strdburner
+	beneficiary := params.DefaultBeneficiary
+	if beneficiary == "" {
+		beneficiary = k.strdBurnerKeeper.GetStrdBurnerAddress().String()
+	}
+
+	auctionedDenoms := k.GetAuctionedDenoms(ctx)
+	moduleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
+
+	for _, balance := range k.bankKeeper.GetAllBalances(ctx, moduleAddress) {
+		// The payment denom can't be sold for itself
+		if balance.Denom == params.DefaultPaymentDenom {
+			continue
+		}
+		if _, hasAuction := auctionedDenoms[balance.Denom]; hasAuction {
+			continue
+		}
+		if balance.Amount.LT(params.AutoProvisionMinBalance) {
+			continue
+		}
+
+		// Only provision denoms that the oracle is able to price
+		tokenPrices, err := k.icqoracleKeeper.GetTokenPricesByDenom(ctx, balance.Denom)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to get token prices for %s: %s", balance.Denom, err.Error()))
+			continue
+		}
+		if len(tokenPrices) == 0 {
+			continue
+		}
+
+		auctionName := types.AutoProvisionedAuctionName(balance.Denom)
+		if _, err := k.GetAuction(ctx, auctionName); err == nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to auto-provision auction for %s, auction %s already exists",
+				balance.Denom, auctionName))
+			continue
+		}
+
+		auction := types.Auction{
+			Type:                      params.DefaultAuctionType,
+			Name:                      auctionName,
+			SellingDenom:              balance.Denom,
+			PaymentDenom:              params.DefaultPaymentDenom,
+			Enabled:                   false,
+			MinPriceMultiplier:        params.DefaultMinPriceMultiplier,
+			MinBidAmount:              math.ZeroInt(),
+			Beneficiary:               beneficiary,
+			TotalPaymentTokenReceived: math.ZeroInt(),
+			TotalSellingTokenSold:     math.ZeroInt(),
+			AutoProvisioned:           true,
+			AwaitingPrice:             true,
+		}
+		k.SetAuction(ctx, &auction)
+
+		k.Logger(ctx).Info(fmt.Sprintf("Auto-provisioned auction %s", auctionName))
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAuctionProvisioned,
+				sdk.NewAttribute(types.AttributeKeyAuctionName, auction.Name),
+				sdk.NewAttribute(types.AttributeKeySellingDenom, auction.SellingDenom),
+				sdk.NewAttribute(types.AttributeKeyPaymentDenom, auction.PaymentDenom),
+			),
+		)
+	}
+}
+
+// Enables each auto-provisioned auction that is waiting on a price, once the oracle
+// has a fresh price for the selling denom
+func (k Keeper) EnableAuctionsAwaitingPrice(ctx sdk.Context) {
+	for _, auction := range k.GetAllAuctions(ctx) {
+		if !auction.AwaitingPrice {
+			continue
+		}
+
+		// The oracle only returns a price if it has not expired
+		if _, err := k.icqoracleKeeper.GetTokenPriceForQuoteDenom(ctx, auction.SellingDenom, auction.PaymentDenom); err != nil {
+			continue
+		}
+
+		auction.Enabled = true
+		auction.AwaitingPrice = false
+		k.SetAuction(ctx, &auction)
+
+		k.Logger(ctx).Info(fmt.Sprintf("Enabled auction %s", auction.Name))
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAuctionEnabled,
+				sdk.NewAttribute(types.AttributeKeyAuctionName, auction.Name),
+				sdk.NewAttribute(types.AttributeKeySellingDenom, auction.SellingDenom),
+			),
+		)
+	}
+}
@@ -0,0 +1,212 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v27/x/auction/types"
+	icqoracletypes "github.com/Stride-Labs/stride/v27/x/icqoracle/types"
+	stakeibctypes "github.com/Stride-Labs/stride/v27/x/stakeibc/types"
+)
+
+// Helper function to enable auto-provisioning with the default params
+func (s *KeeperTestSuite) enableAutoProvisioning(beneficiary string) types.Params {
+	params := types.DefaultParams()
+	params.AutoProvisionEnabled = true
+	params.DefaultBeneficiary = beneficiary
+	s.App.AuctionKeeper.SetParams(s.Ctx, params)
+	return params
+}
+
+// Helper function to store an oracle price for a denom, quoted in ustrd
+func (s *KeeperTestSuite) setTokenPrice(denom string, lastResponseTime time.Time) {
+	s.App.ICQOracleKeeper.SetTokenPrice(s.Ctx, icqoracletypes.TokenPrice{
+		BaseDenom:        denom,
+		QuoteDenom:       "ustrd",
+		OsmosisPoolId:    1,
+		SpotPrice:        sdkmath.LegacyNewDec(1),
+		LastResponseTime: lastResponseTime,
+	})
+}
+
+// Helper function to get a timestamp at which the oracle price is considered stale
+func (s *KeeperTestSuite) getStalePriceTime() time.Time {
+	expirationTimeout := s.App.ICQOracleKeeper.GetParams(s.Ctx).PriceExpirationTimeoutSec
+	return s.Ctx.BlockTime().Add(-time.Duration(expirationTimeout+1) * time.Second)
+}
+
+func (s *KeeperTestSuite) TestAutoProvisionAuctions() {
+	params := s.enableAutoProvisioning("")
+
+	// Create an existing auction for uatom
+	existingAuction := types.Auction{
+		Type:                      types.AuctionType_AUCTION_TYPE_FCFS,
+		Name:                      "atom-auction",
+		SellingDenom:              "uatom",
+		PaymentDenom:              "ustrd",
+		Enabled:                   true,
+		MinPriceMultiplier:        sdkmath.LegacyNewDec(1),
+		MinBidAmount:              sdkmath.NewInt(1000),
+		Beneficiary:               "beneficiary",
+		TotalSellingTokenSold:     sdkmath.ZeroInt(),
+		TotalPaymentTokenReceived: sdkmath.ZeroInt(),
+	}
+	s.App.AuctionKeeper.SetAuction(s.Ctx, &existingAuction)
+
+	// Fund the module account with the auctioned denom, a new denom, and the payment denom
+	minBalance := params.AutoProvisionMinBalance
+	s.FundModuleAccount(types.ModuleName, sdk.NewCoin("uatom", minBalance))
+	s.FundModuleAccount(types.ModuleName, sdk.NewCoin("uosmo", minBalance))
+	s.FundModuleAccount(types.ModuleName, sdk.NewCoin("ustrd", minBalance))
+
+	// Fund a denom that has no oracle price, and a denom with a balance below the minimum
+	s.FundModuleAccount(types.ModuleName, sdk.NewCoin("ujuno", minBalance))
+	s.FundModuleAccount(types.ModuleName, sdk.NewCoin("uevmos", minBalance.SubRaw(1)))
+
+	// Add prices for each denom except juno
+	// A stale price is still sufficient to provision the auction
+	s.setTokenPrice("uatom", s.Ctx.BlockTime())
+	s.setTokenPrice("uosmo", s.getStalePriceTime())
+	s.setTokenPrice("uevmos", s.Ctx.BlockTime())
+
+	s.App.AuctionKeeper.AutoProvisionAuctions(s.Ctx)
+
+	// Only the osmo auction should have been created
+	s.Require().Len(s.App.AuctionKeeper.GetAllAuctions(s.Ctx), 2, "number of auctions")
+
+	expectedAuction := types.Auction{
+		Type:                      params.DefaultAuctionType,
+		Name:                      "auto-uosmo",
+		SellingDenom:              "uosmo",
+		PaymentDenom:              params.DefaultPaymentDenom,
+		Enabled:                   false,
+		MinPriceMultiplier:        params.DefaultMinPriceMultiplier,
+		MinBidAmount:              sdkmath.ZeroInt(),
+		Beneficiary:               s.App.StrdBurnerKeeper.GetStrdBurnerAddress().String(),
+		TotalSellingTokenSold:     sdkmath.ZeroInt(),
+		TotalPaymentTokenReceived: sdkmath.ZeroInt(),
+		AutoProvisioned:           true,
+		AwaitingPrice:             true,
+	}
+	s.Require().Equal(expectedAuction, s.MustGetAuction("auto-uosmo"), "auto-provisioned auction")
+	s.Require().Equal(existingAuction, s.MustGetAuction("atom-auction"), "existing auction should not change")
+
+	// Running again should not create any new auctions
+	s.App.AuctionKeeper.AutoProvisionAuctions(s.Ctx)
+	s.Require().Len(s.App.AuctionKeeper.GetAllAuctions(s.Ctx), 2, "number of auctions after second run")
+
+	// Once evmos reaches the minimum balance, it should be provisioned
+	s.FundModuleAccount(types.ModuleName, sdk.NewInt64Coin("uevmos", 1))
+	s.App.AuctionKeeper.AutoProvisionAuctions(s.Ctx)
+	s.Require().Len(s.App.AuctionKeeper.GetAllAuctions(s.Ctx), 3, "number of auctions after evmos is funded")
+	s.MustGetAuction("auto-uevmos")
+}
+
+func (s *KeeperTestSuite) TestAutoProvisionAuctions_AfterRewardCollectorAuctioned() {
+	params := s.enableAutoProvisioning("")
+	s.setTokenPrice("uosmo", s.Ctx.BlockTime())
+
+	// Fund the reward collector, which is swept into the auction module each mint epoch
+	s.FundModuleAccount(stakeibctypes.RewardCollectorName, sdk.NewCoin("uosmo", params.AutoProvisionMinBalance))
+
+	// The auction should not be provisioned from the end blocker
+	s.App.AuctionKeeper.EndBlocker(s.Ctx)
+	s.Require().Empty(s.App.AuctionKeeper.GetAllAuctions(s.Ctx), "no auctions should be created from the end blocker")
+
+	// Sweeping the reward collector should provision the auction
+	s.App.StakeibcKeeper.AuctionOffRewardCollectorBalance(s.Ctx)
+
+	auction := s.MustGetAuction("auto-uosmo")
+	s.Require().True(auction.AutoProvisioned, "auction auto-provisioned")
+	s.Require().True(auction.AwaitingPrice, "auction awaiting price")
+
+	// Balances that arrive outside of the sweep are provisioned on the next sweep, even if
+	// the reward collector is empty
+	s.setTokenPrice("uatom", s.Ctx.BlockTime())
+	s.FundModuleAccount(types.ModuleName, sdk.NewCoin("uatom", params.AutoProvisionMinBalance))
+
+	s.App.StakeibcKeeper.AuctionOffRewardCollectorBalance(s.Ctx)
+	s.MustGetAuction("auto-uatom")
+}
+
+func (s *KeeperTestSuite) TestAutoProvisionAuctions_CustomBeneficiary() {
+	params := s.enableAutoProvisioning("beneficiary")
+	s.setTokenPrice("uosmo", s.Ctx.BlockTime())
+	s.FundModuleAccount(types.ModuleName, sdk.NewCoin("uosmo", params.AutoProvisionMinBalance))
+
+	s.App.AuctionKeeper.AutoProvisionAuctions(s.Ctx)
+
+	auction := s.MustGetAuction("auto-uosmo")
+	s.Require().Equal("beneficiary", auction.Beneficiary, "beneficiary")
+}
+
+func (s *KeeperTestSuite) TestAutoProvisionAuctions_Disabled() {
+	params := types.DefaultParams()
+	params.AutoProvisionEnabled = false
+	s.App.AuctionKeeper.SetParams(s.Ctx, params)
+
+	s.setTokenPrice("uosmo", s.Ctx.BlockTime())
+	s.FundModuleAccount(types.ModuleName, sdk.NewCoin("uosmo", params.AutoProvisionMinBalance))
+
+	s.App.AuctionKeeper.AutoProvisionAuctions(s.Ctx)
+	s.Require().Empty(s.App.AuctionKeeper.GetAllAuctions(s.Ctx), "no auctions should be created")
+}
+
+func (s *KeeperTestSuite) TestEnableAuctionsAwaitingPrice() {
+	params := s.enableAutoProvisioning("")
+	s.FundModuleAccount(types.ModuleName, sdk.NewCoin("uosmo", params.AutoProvisionMinBalance))
+	s.FundModuleAccount(types.ModuleName, sdk.NewCoin("uatom", params.AutoProvisionMinBalance))
+
+	// Start with stale prices for both denoms so that the auctions are provisioned but not enabled
+	s.setTokenPrice("uosmo", s.getStalePriceTime())
+	s.setTokenPrice("uatom", s.getStalePriceTime())
+
+	s.App.AuctionKeeper.AutoProvisionAuctions(s.Ctx)
+	s.App.AuctionKeeper.EnableAuctionsAwaitingPrice(s.Ctx)
+	s.Require().False(s.MustGetAuction("auto-uosmo").Enabled, "osmo auction enabled with stale price")
+
+	// Refresh the price for osmo only
+	s.setTokenPrice("uosmo", s.Ctx.BlockTime())
+
+	s.App.AuctionKeeper.EnableAuctionsAwaitingPrice(s.Ctx)
+
+	// The osmo auction should be enabled
+	osmoAuction := s.MustGetAuction("auto-uosmo")
+	s.Require().True(osmoAuction.Enabled, "osmo auction enabled")
+	s.Require().False(osmoAuction.AwaitingPrice, "osmo auction awaiting price")
+
+	// The atom auction should still be waiting on a price
+	atomAuction := s.MustGetAuction("auto-uatom")
+	s.Require().False(atomAuction.Enabled, "atom auction enabled")
+	s.Require().True(atomAuction.AwaitingPrice, "atom auction awaiting price")
+}
+
+func (s *KeeperTestSuite) TestEnableAuctionsAwaitingPrice_ManuallyDisabled() {
+	params := s.enableAutoProvisioning("")
+	s.FundModuleAccount(types.ModuleName, sdk.NewCoin("uosmo", params.AutoProvisionMinBalance))
+	s.setTokenPrice("uosmo", s.getStalePriceTime())
+
+	s.App.AuctionKeeper.AutoProvisionAuctions(s.Ctx)
+
+	// The admin updates the auction, keeping it disabled
+	auction := s.MustGetAuction("auto-uosmo")
+	_, err := s.GetMsgServer().UpdateAuction(s.Ctx, &types.MsgUpdateAuction{
+		Admin:              s.App.AuctionKeeper.GetAuthority(),
+		AuctionName:        auction.Name,
+		AuctionType:        auction.Type,
+		Enabled:            false,
+		MinPriceMultiplier: auction.MinPriceMultiplier,
+		MinBidAmount:       auction.MinBidAmount,
+		Beneficiary:        auction.Beneficiary,
+	})
+	s.Require().NoError(err, "no error expected when updating auction")
+
+	// Once there's a fresh price, the auction should stay disabled
+	s.setTokenPrice("uosmo", s.Ctx.BlockTime())
+	s.App.AuctionKeeper.EnableAuctionsAwaitingPrice(s.Ctx)
+
+	auction = s.MustGetAuction("auto-uosmo")
+	s.Require().False(auction.Enabled, "auction enabled")
+}
@@ -63,3 +63,16 @@ func (k Keeper) Auctions(goCtx context.Context, req *types.QueryAuctionsRequest)
 		Pagination: pageRes,
 	}, nil
 }
+
+// UnauctionedBalances queries the balances in the auction module account that do not have an enabled auction
+func (k Keeper) UnauctionedBalances(goCtx context.Context, req *types.QueryUnauctionedBalancesRequest) (*types.QueryUnauctionedBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryUnauctionedBalancesResponse{
+		Balances: k.GetUnauctionedBalances(ctx),
+	}, nil
+}
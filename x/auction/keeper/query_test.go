@@ -158,3 +158,27 @@ func (s *KeeperTestSuite) TestQueryAuctionsPagination() {
 	s.Require().Equal(expectedAuctions[2:], resp.Auctions, "second page auctions")
 	s.Require().Nil(resp.Pagination.NextKey, "next key should be nil")
 }
+
+func (s *KeeperTestSuite) TestQueryUnauctionedBalances() {
+	// Create an enabled auction for uatom and a disabled auction for uosmo
+	for _, auction := range []types.Auction{
+		{Name: "atom-auction", SellingDenom: "uatom", Enabled: true},
+		{Name: "osmo-auction", SellingDenom: "uosmo", Enabled: false},
+	} {
+		s.App.AuctionKeeper.SetAuction(s.Ctx, &auction)
+	}
+
+	s.FundModuleAccount(types.ModuleName, sdk.NewInt64Coin("uatom", 1000))
+	s.FundModuleAccount(types.ModuleName, sdk.NewInt64Coin("uosmo", 2000))
+	s.FundModuleAccount(types.ModuleName, sdk.NewInt64Coin("ujuno", 3000))
+
+	resp, err := s.App.AuctionKeeper.UnauctionedBalances(sdk.WrapSDKContext(s.Ctx), &types.QueryUnauctionedBalancesRequest{})
+	s.Require().NoError(err, "no error expected when querying unauctioned balances")
+
+	expectedBalances := sdk.NewCoins(sdk.NewInt64Coin("ujuno", 3000), sdk.NewInt64Coin("uosmo", 2000))
+	s.Require().Equal(expectedBalances, resp.Balances, "unauctioned balances")
+
+	// Query with invalid request
+	_, err = s.App.AuctionKeeper.UnauctionedBalances(sdk.WrapSDKContext(s.Ctx), nil)
+	s.Require().Error(err, "error expected when querying with nil request")
+}
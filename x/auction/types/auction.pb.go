@@ -52,7 +52,52 @@ func (AuctionType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_739480caccbf7be9, []int{0}
 }
 
+// Module that receives the tokens streamed from each mint
+type MintStreamDestination int32
+
+const (
+	// Stream to the strdburner module account, to be burned
+	MintStreamDestination_MINT_STREAM_DESTINATION_STRDBURNER MintStreamDestination = 0
+)
+
+var MintStreamDestination_name = map[int32]string{
+	0: "MINT_STREAM_DESTINATION_STRDBURNER",
+}
+
+var MintStreamDestination_value = map[string]int32{
+	"MINT_STREAM_DESTINATION_STRDBURNER": 0,
+}
+
+func (x MintStreamDestination) String() string {
+	return proto.EnumName(MintStreamDestination_name, int32(x))
+}
+
+func (MintStreamDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_739480caccbf7be9, []int{1}
+}
+
 type Params struct {
+	// Portion of the community growth share of each mint that is streamed to
+	// the mint stream destination (e.g. 0.1 to stream 10%)
+	// A rate of 0 disables the stream
+	MintStreamRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=mint_stream_rate,json=mintStreamRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"mint_stream_rate"`
+	// Module that receives the streamed tokens
+	MintStreamDestination MintStreamDestination `protobuf:"varint,2,opt,name=mint_stream_destination,json=mintStreamDestination,proto3,enum=stride.auction.MintStreamDestination" json:"mint_stream_destination,omitempty"`
+	// Whether an auction should be automatically created for any denom in the
+	// auction module account that does not already have an auction
+	AutoProvisionEnabled bool `protobuf:"varint,3,opt,name=auto_provision_enabled,json=autoProvisionEnabled,proto3" json:"auto_provision_enabled,omitempty"`
+	// Auction type used for auto-provisioned auctions
+	DefaultAuctionType AuctionType `protobuf:"varint,4,opt,name=default_auction_type,json=defaultAuctionType,proto3,enum=stride.auction.AuctionType" json:"default_auction_type,omitempty"`
+	// Payment denom used for auto-provisioned auctions
+	DefaultPaymentDenom string `protobuf:"bytes,5,opt,name=default_payment_denom,json=defaultPaymentDenom,proto3" json:"default_payment_denom,omitempty"`
+	// Beneficiary of auto-provisioned auctions
+	// If empty, the proceeds are sent to the strdburner
+	DefaultBeneficiary string `protobuf:"bytes,6,opt,name=default_beneficiary,json=defaultBeneficiary,proto3" json:"default_beneficiary,omitempty"`
+	// Minimum price multiplier used for auto-provisioned auctions
+	DefaultMinPriceMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=default_min_price_multiplier,json=defaultMinPriceMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"default_min_price_multiplier"`
+	// Minimum balance of a denom in the auction module account required
+	// before an auction is auto-provisioned for it
+	AutoProvisionMinBalance cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=auto_provision_min_balance,json=autoProvisionMinBalance,proto3,customtype=cosmossdk.io/math.Int" json:"auto_provision_min_balance"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -88,6 +133,41 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMintStreamDestination() MintStreamDestination {
+	if m != nil {
+		return m.MintStreamDestination
+	}
+	return MintStreamDestination_MINT_STREAM_DESTINATION_STRDBURNER
+}
+
+func (m *Params) GetAutoProvisionEnabled() bool {
+	if m != nil {
+		return m.AutoProvisionEnabled
+	}
+	return false
+}
+
+func (m *Params) GetDefaultAuctionType() AuctionType {
+	if m != nil {
+		return m.DefaultAuctionType
+	}
+	return AuctionType_AUCTION_TYPE_UNSPECIFIED
+}
+
+func (m *Params) GetDefaultPaymentDenom() string {
+	if m != nil {
+		return m.DefaultPaymentDenom
+	}
+	return ""
+}
+
+func (m *Params) GetDefaultBeneficiary() string {
+	if m != nil {
+		return m.DefaultBeneficiary
+	}
+	return ""
+}
+
 type Auction struct {
 	// Auction type
 	Type AuctionType `protobuf:"varint,1,opt,name=type,proto3,enum=stride.auction.AuctionType" json:"type,omitempty"`
@@ -110,6 +190,12 @@ type Auction struct {
 	TotalPaymentTokenReceived cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=total_payment_token_received,json=totalPaymentTokenReceived,proto3,customtype=cosmossdk.io/math.Int" json:"total_payment_token_received"`
 	// Total amount of selling token sold
 	TotalSellingTokenSold cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=total_selling_token_sold,json=totalSellingTokenSold,proto3,customtype=cosmossdk.io/math.Int" json:"total_selling_token_sold"`
+	// Whether the auction was automatically created when the selling denom
+	// arrived in the auction module account
+	AutoProvisioned bool `protobuf:"varint,11,opt,name=auto_provisioned,json=autoProvisioned,proto3" json:"auto_provisioned,omitempty"`
+	// Whether the auction is waiting on a fresh oracle price before it's enabled
+	// Cleared once the auction is enabled, or when an admin updates the auction
+	AwaitingPrice bool `protobuf:"varint,12,opt,name=awaiting_price,json=awaitingPrice,proto3" json:"awaiting_price,omitempty"`
 }
 
 func (m *Auction) Reset()         { *m = Auction{} }
@@ -187,8 +273,23 @@ func (m *Auction) GetBeneficiary() string {
 	return ""
 }
 
+func (m *Auction) GetAutoProvisioned() bool {
+	if m != nil {
+		return m.AutoProvisioned
+	}
+	return false
+}
+
+func (m *Auction) GetAwaitingPrice() bool {
+	if m != nil {
+		return m.AwaitingPrice
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("stride.auction.AuctionType", AuctionType_name, AuctionType_value)
+	proto.RegisterEnum("stride.auction.MintStreamDestination", MintStreamDestination_name, MintStreamDestination_value)
 	proto.RegisterType((*Params)(nil), "stride.auction.Params")
 	proto.RegisterType((*Auction)(nil), "stride.auction.Auction")
 }
@@ -196,40 +297,56 @@ func init() {
 func init() { proto.RegisterFile("stride/auction/auction.proto", fileDescriptor_739480caccbf7be9) }
 
 var fileDescriptor_739480caccbf7be9 = []byte{
-	// 525 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x6b, 0xdb, 0x3e,
-	0x18, 0xc7, 0xe3, 0xdf, 0x2f, 0x6b, 0x53, 0xb5, 0x0b, 0x9d, 0x48, 0x40, 0x6d, 0x33, 0x37, 0xb4,
-	0x97, 0x30, 0xa8, 0xcd, 0xba, 0xc3, 0x60, 0xb7, 0xfc, 0x85, 0xb0, 0xae, 0x0b, 0x76, 0x32, 0xd8,
-	0x0e, 0x33, 0xb2, 0xad, 0xb9, 0xa2, 0x96, 0x64, 0x2c, 0xa5, 0x2c, 0xef, 0x62, 0x2f, 0x66, 0xaf,
-	0x60, 0xa7, 0x1e, 0xcb, 0x4e, 0x63, 0x87, 0x32, 0x92, 0x37, 0x32, 0x2c, 0x39, 0xa5, 0x65, 0x97,
-	0x9e, 0x2c, 0x7d, 0x9f, 0xef, 0xf7, 0x63, 0x21, 0x3d, 0x0f, 0x68, 0x49, 0x95, 0xd3, 0x98, 0xb8,
-	0x78, 0x1e, 0x29, 0x2a, 0xf8, 0xfa, 0xeb, 0x64, 0xb9, 0x50, 0x02, 0xd6, 0x4d, 0xd5, 0x29, 0xd5,
-	0xfd, 0xbd, 0x48, 0x48, 0x26, 0x64, 0xa0, 0xab, 0xae, 0xd9, 0x18, 0xeb, 0x7e, 0x23, 0x11, 0x89,
-	0x30, 0x7a, 0xb1, 0x32, 0xea, 0x51, 0x0d, 0x6c, 0x4c, 0x70, 0x8e, 0x99, 0x3c, 0xfa, 0x51, 0x05,
-	0x9b, 0x5d, 0x83, 0x81, 0x2e, 0xa8, 0xaa, 0x45, 0x46, 0x90, 0xd5, 0xb6, 0x3a, 0xf5, 0xd3, 0x03,
-	0xe7, 0xe1, 0x5f, 0x9c, 0xd2, 0x36, 0x5d, 0x64, 0xc4, 0xd3, 0x46, 0x08, 0x41, 0x95, 0x63, 0x46,
-	0xd0, 0x7f, 0x6d, 0xab, 0xb3, 0xe5, 0xe9, 0x35, 0x3c, 0x06, 0x4f, 0x25, 0x49, 0x53, 0xca, 0x93,
-	0x20, 0x26, 0x5c, 0x30, 0xf4, 0xbf, 0x2e, 0xee, 0x94, 0xe2, 0xa0, 0xd0, 0x0a, 0x53, 0x86, 0x17,
-	0x8c, 0x70, 0x55, 0x9a, 0xaa, 0xc6, 0x54, 0x8a, 0xc6, 0x84, 0xc0, 0x26, 0xe1, 0x38, 0x4c, 0x49,
-	0x8c, 0x9e, 0xb4, 0xad, 0x4e, 0xcd, 0x5b, 0x6f, 0xe1, 0x0c, 0x34, 0x18, 0xe5, 0x41, 0x96, 0xd3,
-	0x88, 0x04, 0x6c, 0x9e, 0x2a, 0x9a, 0xa5, 0x94, 0xe4, 0x68, 0xa3, 0xa0, 0xf4, 0x8e, 0xaf, 0x6f,
-	0x0f, 0x2b, 0xbf, 0x6f, 0x0f, 0x0f, 0xcc, 0x45, 0xc8, 0xf8, 0xd2, 0xa1, 0xc2, 0x65, 0x58, 0x5d,
-	0x38, 0x67, 0x24, 0xc1, 0xd1, 0x62, 0x40, 0x22, 0x0f, 0x32, 0xca, 0x27, 0x45, 0xfe, 0xdd, 0x5d,
-	0x1c, 0xf6, 0x41, 0xbd, 0xc0, 0x86, 0x34, 0x0e, 0x30, 0x13, 0x73, 0xae, 0xd0, 0xa6, 0x06, 0x3e,
-	0x2f, 0x81, 0xcd, 0x7f, 0x81, 0x63, 0xae, 0xbc, 0x1d, 0x46, 0x79, 0x8f, 0xc6, 0x5d, 0x1d, 0x81,
-	0x6f, 0xc0, 0x76, 0x48, 0x38, 0xf9, 0x42, 0x23, 0x8a, 0xf3, 0x05, 0xaa, 0x69, 0x02, 0xfa, 0xf9,
-	0xfd, 0xa4, 0x51, 0xbe, 0x4b, 0x37, 0x8e, 0x73, 0x22, 0xa5, 0xaf, 0x72, 0xca, 0x13, 0xef, 0xbe,
-	0x19, 0x7e, 0x06, 0x2d, 0x25, 0x14, 0x4e, 0x83, 0xf5, 0xe5, 0x28, 0x71, 0x49, 0x78, 0x90, 0x93,
-	0x88, 0xd0, 0x2b, 0x12, 0xa3, 0xad, 0xc7, 0x1c, 0x67, 0x4f, 0x23, 0x26, 0x86, 0x30, 0x2d, 0x00,
-	0x5e, 0x99, 0x87, 0x1f, 0x00, 0x32, 0xfc, 0xf5, 0x0b, 0x19, 0xbe, 0x14, 0x69, 0x8c, 0xc0, 0x63,
-	0xd8, 0x4d, 0x1d, 0xf7, 0x4d, 0x5a, 0xb3, 0x7d, 0x91, 0xc6, 0x2f, 0x7a, 0x60, 0xfb, 0x5e, 0x73,
-	0xc0, 0x16, 0x40, 0xdd, 0x59, 0x7f, 0x3a, 0x7e, 0x7f, 0x1e, 0x4c, 0x3f, 0x4e, 0x86, 0xc1, 0xec,
-	0xdc, 0x9f, 0x0c, 0xfb, 0xe3, 0xd1, 0x78, 0x38, 0xd8, 0xad, 0xc0, 0x26, 0x78, 0xf6, 0xa0, 0x3a,
-	0xea, 0x8f, 0xfc, 0x5d, 0xab, 0xf7, 0xf6, 0x7a, 0x69, 0x5b, 0x37, 0x4b, 0xdb, 0xfa, 0xb3, 0xb4,
-	0xad, 0x6f, 0x2b, 0xbb, 0x72, 0xb3, 0xb2, 0x2b, 0xbf, 0x56, 0x76, 0xe5, 0xd3, 0xcb, 0x84, 0xaa,
-	0x8b, 0x79, 0xe8, 0x44, 0x82, 0xb9, 0xbe, 0x6e, 0xc9, 0x93, 0x33, 0x1c, 0x4a, 0xb7, 0x1c, 0x91,
-	0xab, 0xd3, 0xd7, 0xee, 0xd7, 0xbb, 0x41, 0x29, 0xfa, 0x52, 0x86, 0x1b, 0xba, 0xcd, 0x5f, 0xfd,
-	0x0d, 0x00, 0x00, 0xff, 0xff, 0x3a, 0xcb, 0x25, 0x85, 0x47, 0x03, 0x00, 0x00,
+	// 782 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x4e, 0xf3, 0x46,
+	0x14, 0x8d, 0xfb, 0x85, 0x04, 0x06, 0x48, 0xd3, 0x69, 0xd2, 0x6f, 0x80, 0x34, 0x20, 0x10, 0x15,
+	0x45, 0x22, 0x51, 0x69, 0xa5, 0x4a, 0xdd, 0x54, 0xf9, 0x43, 0x8a, 0x8a, 0xd3, 0xc8, 0x76, 0x2a,
+	0x15, 0xa9, 0x1d, 0x4d, 0x3c, 0x43, 0x18, 0x61, 0xcf, 0x44, 0xf6, 0x84, 0x36, 0x6f, 0xd1, 0x45,
+	0x1f, 0xa5, 0x0f, 0xc1, 0x12, 0x75, 0x55, 0x75, 0x81, 0x2a, 0x78, 0x8d, 0x2e, 0x3e, 0x79, 0x6c,
+	0x87, 0x04, 0x90, 0xc8, 0xca, 0xf6, 0x3d, 0xf7, 0x9c, 0x7b, 0x7d, 0xcf, 0x1d, 0x1b, 0x54, 0x42,
+	0x15, 0x70, 0xca, 0xea, 0x64, 0xe2, 0x2a, 0x2e, 0x45, 0x7a, 0xad, 0x8d, 0x03, 0xa9, 0x24, 0x2c,
+	0xc4, 0x68, 0x2d, 0x89, 0x6e, 0x6f, 0xb9, 0x32, 0xf4, 0x65, 0x88, 0x35, 0x5a, 0x8f, 0x1f, 0xe2,
+	0xd4, 0xed, 0xd2, 0x48, 0x8e, 0x64, 0x1c, 0x8f, 0xee, 0xe2, 0xe8, 0xfe, 0xff, 0x59, 0x90, 0xeb,
+	0x93, 0x80, 0xf8, 0x21, 0x34, 0x41, 0xd1, 0xe7, 0x42, 0xe1, 0x50, 0x05, 0x8c, 0xf8, 0x38, 0x20,
+	0x8a, 0x21, 0x63, 0xcf, 0x38, 0x5a, 0x6b, 0x1e, 0xdc, 0xde, 0xef, 0x66, 0xfe, 0xbd, 0xdf, 0xdd,
+	0x89, 0x05, 0x43, 0x7a, 0x5d, 0xe3, 0xb2, 0xee, 0x13, 0x75, 0x55, 0x3b, 0x67, 0x23, 0xe2, 0x4e,
+	0xdb, 0xcc, 0xb5, 0x0a, 0x11, 0xd9, 0xd6, 0x5c, 0x8b, 0x28, 0x06, 0x7f, 0x01, 0xef, 0xe7, 0xe5,
+	0x28, 0x0b, 0x15, 0x17, 0x24, 0xea, 0x12, 0x7d, 0xb4, 0x67, 0x1c, 0x15, 0x4e, 0x0f, 0x6b, 0x8b,
+	0xcd, 0xd7, 0xcc, 0x99, 0x40, 0xfb, 0x29, 0xd9, 0x2a, 0xfb, 0xaf, 0x85, 0xe1, 0x37, 0xe0, 0x33,
+	0x32, 0x51, 0x32, 0x7a, 0xd3, 0x1b, 0x1e, 0x72, 0x29, 0x30, 0x13, 0x64, 0xe8, 0x31, 0x8a, 0xde,
+	0xed, 0x19, 0x47, 0xab, 0x56, 0x29, 0x42, 0xfb, 0x29, 0xd8, 0x89, 0x31, 0x68, 0x82, 0x12, 0x65,
+	0x97, 0x64, 0xe2, 0x29, 0x9c, 0x54, 0xc5, 0x6a, 0x3a, 0x66, 0x28, 0xab, 0x3b, 0xda, 0x79, 0xde,
+	0x51, 0x23, 0xbe, 0x3a, 0xd3, 0x31, 0xb3, 0x60, 0x42, 0x9c, 0x8b, 0xc1, 0x53, 0x50, 0x4e, 0xe5,
+	0xc6, 0x64, 0xea, 0x33, 0xa1, 0x30, 0x65, 0x42, 0xfa, 0x68, 0x25, 0x9a, 0x9b, 0xf5, 0x69, 0x02,
+	0xf6, 0x63, 0xac, 0x1d, 0x41, 0xb0, 0x0b, 0xd2, 0x30, 0x1e, 0x32, 0xc1, 0x2e, 0xb9, 0xcb, 0x49,
+	0x30, 0x45, 0x39, 0x3d, 0x69, 0xf4, 0xf7, 0x5f, 0x27, 0xa5, 0xc4, 0xb6, 0x06, 0xa5, 0x01, 0x0b,
+	0x43, 0x5b, 0x05, 0x5c, 0x8c, 0x66, 0xe5, 0x9b, 0x4f, 0x1c, 0x48, 0x41, 0x25, 0x95, 0xf2, 0xb9,
+	0xc0, 0xe3, 0x80, 0xbb, 0x0c, 0xfb, 0x13, 0x4f, 0xf1, 0xb1, 0xc7, 0x59, 0x80, 0xf2, 0xcb, 0xbb,
+	0xb7, 0x95, 0x08, 0x99, 0x5c, 0xf4, 0x23, 0x19, 0x73, 0xa6, 0x02, 0x2f, 0xc0, 0xf6, 0xb3, 0x49,
+	0x47, 0xc5, 0x86, 0xc4, 0x23, 0xc2, 0x65, 0x68, 0x55, 0xd7, 0xf8, 0x3c, 0xa9, 0x51, 0x7e, 0x59,
+	0xa3, 0x2b, 0x94, 0xf5, 0x7e, 0xc1, 0x0c, 0x93, 0x8b, 0x66, 0xcc, 0xde, 0xff, 0x73, 0x05, 0xe4,
+	0x93, 0x81, 0xc2, 0x3a, 0xc8, 0x6a, 0x2f, 0x8c, 0xb7, 0xbd, 0xd0, 0x89, 0x10, 0x82, 0xac, 0x20,
+	0x3e, 0xd3, 0xeb, 0xb4, 0x66, 0xe9, 0x7b, 0x78, 0x00, 0x36, 0x43, 0xe6, 0x79, 0x5c, 0x8c, 0x12,
+	0x27, 0xde, 0x69, 0x70, 0x23, 0x09, 0xc6, 0x16, 0x1c, 0x80, 0xcd, 0x45, 0xbb, 0xb2, 0x71, 0xd2,
+	0x78, 0xde, 0x27, 0x04, 0xf2, 0xe9, 0x46, 0xad, 0xe8, 0x8d, 0x4a, 0x1f, 0xe1, 0x00, 0x94, 0x5e,
+	0x1d, 0x77, 0x6e, 0xf9, 0x71, 0x43, 0xff, 0xe5, 0x9c, 0x5b, 0xa0, 0xa0, 0x07, 0xcb, 0x29, 0x26,
+	0xbe, 0x9c, 0x08, 0x85, 0xf2, 0xcb, 0xcc, 0x76, 0xc3, 0xe7, 0xa2, 0xc9, 0x69, 0x43, 0x53, 0xe0,
+	0x77, 0x60, 0x7d, 0x7e, 0xab, 0x56, 0xdf, 0xd8, 0xaa, 0xf9, 0x64, 0xf8, 0x2b, 0xa8, 0x28, 0xa9,
+	0x88, 0x37, 0xdb, 0x65, 0x25, 0xaf, 0x99, 0xc0, 0x01, 0x73, 0x19, 0xbf, 0x61, 0x14, 0xad, 0x2d,
+	0xd3, 0xce, 0x96, 0x96, 0x48, 0x36, 0xde, 0x89, 0x04, 0xac, 0x84, 0x0f, 0x7f, 0x02, 0x28, 0xd6,
+	0x4f, 0x1d, 0x8a, 0xf5, 0x43, 0xe9, 0x51, 0x04, 0x96, 0xd1, 0x2e, 0x6b, 0xba, 0x1d, 0xb3, 0xb5,
+	0xb6, 0x2d, 0x3d, 0x0a, 0xbf, 0x04, 0xc5, 0xc5, 0x05, 0x65, 0x14, 0xad, 0x6b, 0xcb, 0x3e, 0x5e,
+	0xd8, 0x3b, 0x46, 0xe1, 0x21, 0x28, 0x90, 0xdf, 0x08, 0x57, 0x51, 0x75, 0xed, 0x1f, 0xda, 0xd0,
+	0x89, 0x9b, 0x69, 0x54, 0x9b, 0x72, 0xdc, 0x04, 0xeb, 0xf3, 0xc7, 0xbc, 0x02, 0x50, 0x63, 0xd0,
+	0x72, 0xba, 0x3f, 0xf6, 0xb0, 0xf3, 0x73, 0xbf, 0x83, 0x07, 0x3d, 0xbb, 0xdf, 0x69, 0x75, 0xcf,
+	0xba, 0x9d, 0x76, 0x31, 0x03, 0xcb, 0xe0, 0x93, 0x05, 0xf4, 0xac, 0x75, 0x66, 0x17, 0x8d, 0xe3,
+	0xef, 0x41, 0xf9, 0xd5, 0x0f, 0x1a, 0xfc, 0x02, 0xec, 0x9b, 0xdd, 0x9e, 0x83, 0x6d, 0xc7, 0xea,
+	0x34, 0x4c, 0xdc, 0xee, 0xd8, 0x4e, 0xb7, 0xd7, 0xd0, 0x7c, 0xdb, 0xb1, 0xda, 0xcd, 0x81, 0xd5,
+	0xeb, 0x58, 0xc5, 0x4c, 0xf3, 0x87, 0xdb, 0x87, 0xaa, 0x71, 0xf7, 0x50, 0x35, 0xfe, 0x7b, 0xa8,
+	0x1a, 0x7f, 0x3c, 0x56, 0x33, 0x77, 0x8f, 0xd5, 0xcc, 0x3f, 0x8f, 0xd5, 0xcc, 0xc5, 0x57, 0x23,
+	0xae, 0xae, 0x26, 0xc3, 0x9a, 0x2b, 0xfd, 0xba, 0xad, 0x4f, 0xc9, 0xc9, 0x39, 0x19, 0x86, 0xf5,
+	0xe4, 0x57, 0x71, 0x73, 0xfa, 0x6d, 0xfd, 0xf7, 0xd9, 0x0f, 0x23, 0x3a, 0x2a, 0xe1, 0x30, 0xa7,
+	0x3f, 0xf7, 0x5f, 0x7f, 0x18, 0x00, 0x93, 0x0a, 0x00, 0x99, 0x4f, 0x06, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -252,6 +369,70 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.AutoProvisionMinBalance.Size()
+		i -= size
+		if _, err := m.AutoProvisionMinBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.DefaultMinPriceMultiplier.Size()
+		i -= size
+		if _, err := m.DefaultMinPriceMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.DefaultBeneficiary) > 0 {
+		i -= len(m.DefaultBeneficiary)
+		copy(dAtA[i:], m.DefaultBeneficiary)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.DefaultBeneficiary)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DefaultPaymentDenom) > 0 {
+		i -= len(m.DefaultPaymentDenom)
+		copy(dAtA[i:], m.DefaultPaymentDenom)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.DefaultPaymentDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.DefaultAuctionType != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.DefaultAuctionType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AutoProvisionEnabled {
+		i--
+		if m.AutoProvisionEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MintStreamDestination != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.MintStreamDestination))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MintStreamRate.Size()
+		i -= size
+		if _, err := m.MintStreamRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -275,6 +456,26 @@ func (m *Auction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AwaitingPrice {
+		i--
+		if m.AwaitingPrice {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.AutoProvisioned {
+		i--
+		if m.AutoProvisioned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
 	{
 		size := m.TotalSellingTokenSold.Size()
 		i -= size
@@ -378,6 +579,29 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = m.MintStreamRate.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	if m.MintStreamDestination != 0 {
+		n += 1 + sovAuction(uint64(m.MintStreamDestination))
+	}
+	if m.AutoProvisionEnabled {
+		n += 2
+	}
+	if m.DefaultAuctionType != 0 {
+		n += 1 + sovAuction(uint64(m.DefaultAuctionType))
+	}
+	l = len(m.DefaultPaymentDenom)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = len(m.DefaultBeneficiary)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.DefaultMinPriceMultiplier.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.AutoProvisionMinBalance.Size()
+	n += 1 + l + sovAuction(uint64(l))
 	return n
 }
 
@@ -417,6 +641,12 @@ func (m *Auction) Size() (n int) {
 	n += 1 + l + sovAuction(uint64(l))
 	l = m.TotalSellingTokenSold.Size()
 	n += 1 + l + sovAuction(uint64(l))
+	if m.AutoProvisioned {
+		n += 2
+	}
+	if m.AwaitingPrice {
+		n += 2
+	}
 	return n
 }
 
@@ -455,6 +685,230 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintStreamRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintStreamRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintStreamDestination", wireType)
+			}
+			m.MintStreamDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintStreamDestination |= MintStreamDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoProvisionEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoProvisionEnabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultAuctionType", wireType)
+			}
+			m.DefaultAuctionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultAuctionType |= AuctionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultPaymentDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultPaymentDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultBeneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultBeneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultMinPriceMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultMinPriceMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoProvisionMinBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AutoProvisionMinBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuction(dAtA[iNdEx:])
@@ -808,6 +1262,46 @@ func (m *Auction) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoProvisioned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoProvisioned = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AwaitingPrice", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AwaitingPrice = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuction(dAtA[iNdEx:])
@@ -14,6 +14,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgPlaceBid{}, "auction/MsgPlaceBid")
 	legacy.RegisterAminoMsg(cdc, &MsgCreateAuction{}, "auction/MsgCreateAuction")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateAuction{}, "auction/MsgUpdateAuction")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "auction/MsgUpdateParams")
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -21,6 +22,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgPlaceBid{},
 		&MsgCreateAuction{},
 		&MsgUpdateAuction{},
+		&MsgUpdateParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
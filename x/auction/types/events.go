@@ -4,6 +4,10 @@ package types
 const (
 	EventTypeBidPlaced   = "bid_placed"
 	EventTypeBidAccepted = "bid_accepted"
+	EventTypeMintStream  = "mint_stream"
+
+	EventTypeAuctionProvisioned = "auction_provisioned"
+	EventTypeAuctionEnabled     = "auction_enabled"
 
 	AttributeKeyAuctionName   = "auction_name"
 	AttributeKeyBidder        = "bidder"
@@ -12,4 +16,6 @@ const (
 	AttributeKeySellingAmount = "selling_amount"
 	AttributeKeySellingDenom  = "selling_denom"
 	AttributeKeyPrice         = "discounted_price"
+	AttributeKeyStreamAmount  = "stream_amount"
+	AttributeKeyDestination   = "destination"
 )
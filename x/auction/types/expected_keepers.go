@@ -4,6 +4,10 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	icqoracletypes "github.com/Stride-Labs/stride/v27/x/icqoracle/types"
+	minttypes "github.com/Stride-Labs/stride/v27/x/mint/types"
+	strdburnertypes "github.com/Stride-Labs/stride/v27/x/strdburner/types"
 )
 
 // Required AccountKeeper functions
@@ -16,11 +20,26 @@ type AccountKeeper interface {
 type BankKeeper interface {
 	BlockedAddr(addr sdk.AccAddress) bool
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoins(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+}
+
+// Required MintKeeper functions
+type MintKeeper interface {
+	GetParams(ctx sdk.Context) (params minttypes.Params)
+	GetSubmoduleAddress(submoduleName string, submoduleNamespace string) sdk.AccAddress
 }
 
 // Required IcqOracleKeeper functions
 type IcqOracleKeeper interface {
+	GetTokenPricesByDenom(ctx sdk.Context, baseDenom string) (map[string]*icqoracletypes.TokenPrice, error)
 	GetTokenPriceForQuoteDenom(ctx sdk.Context, baseDenom string, quoteDenom string) (price math.LegacyDec, err error)
 }
+
+// Required StrdBurnerKeeper functions
+type StrdBurnerKeeper interface {
+	GetStrdBurnerAddress() sdk.AccAddress
+	Burn(ctx sdk.Context, burner sdk.AccAddress, amount math.Int, source strdburnertypes.BurnSource) error
+}
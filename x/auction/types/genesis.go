@@ -6,12 +6,18 @@ import (
 
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
 }
 
-// Performs basic genesis state validation by iterating through all auctions and validating
-// using ValidateCreateAuctionParams()
+// Performs basic genesis state validation by validating the params and iterating through
+// all auctions and validating using ValidateCreateAuctionParams()
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid genesis params: %w", err)
+	}
+
 	for i, auction := range gs.Auctions {
 		err := ValidateCreateAuctionParams(
 			auction.Name,
@@ -10,7 +10,17 @@ const (
 	RouterKey = ModuleName
 )
 
+const (
+	// Prefix of the name of auctions that are automatically created for new denoms
+	AutoProvisionedAuctionPrefix = "auto-"
+)
+
 var (
 	ParamsKey     = []byte("params")
 	AuctionPrefix = []byte("auction")
 )
+
+// Returns the name of the auction that is automatically created for a selling denom
+func AutoProvisionedAuctionName(sellingDenom string) string {
+	return AutoProvisionedAuctionPrefix + sellingDenom
+}
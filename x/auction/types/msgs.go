@@ -17,17 +17,20 @@ const (
 	TypeMsgPlaceBid      = "place_bid"
 	TypeMsgCreateAuction = "create_auction"
 	TypeMsgUpdateAuction = "update_auction"
+	TypeMsgUpdateParams  = "update_params"
 )
 
 var (
 	_ sdk.Msg = &MsgPlaceBid{}
 	_ sdk.Msg = &MsgCreateAuction{}
 	_ sdk.Msg = &MsgUpdateAuction{}
+	_ sdk.Msg = &MsgUpdateParams{}
 
 	// Implement legacy interface for ledger support
 	_ legacytx.LegacyMsg = &MsgPlaceBid{}
 	_ legacytx.LegacyMsg = &MsgCreateAuction{}
 	_ legacytx.LegacyMsg = &MsgUpdateAuction{}
+	_ legacytx.LegacyMsg = &MsgUpdateParams{}
 )
 
 // ----------------------------------------------
@@ -228,3 +231,39 @@ func (msg *MsgUpdateAuction) ValidateBasic() error {
 
 	return nil
 }
+
+// ----------------------------------------------
+//               MsgUpdateParams
+// ----------------------------------------------
+
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+func (msg MsgUpdateParams) Type() string {
+	return TypeMsgUpdateParams
+}
+
+func (msg MsgUpdateParams) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgUpdateParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	return msg.Params.Validate()
+}
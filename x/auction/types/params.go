@@ -1,16 +1,99 @@
 package types
 
+import (
+	"errors"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultAutoProvisionPaymentDenom = "ustrd"
+)
+
+var (
+	DefaultAutoProvisionMinPriceMultiplier = math.LegacyMustNewDecFromStr("0.95")
+	DefaultAutoProvisionMinBalance         = math.NewInt(1_000_000)
+)
+
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	mintStreamRate math.LegacyDec,
+	mintStreamDestination MintStreamDestination,
+	autoProvisionEnabled bool,
+	defaultAuctionType AuctionType,
+	defaultPaymentDenom string,
+	defaultBeneficiary string,
+	defaultMinPriceMultiplier math.LegacyDec,
+	autoProvisionMinBalance math.Int,
+) Params {
+	return Params{
+		MintStreamRate:            mintStreamRate,
+		MintStreamDestination:     mintStreamDestination,
+		AutoProvisionEnabled:      autoProvisionEnabled,
+		DefaultAuctionType:        defaultAuctionType,
+		DefaultPaymentDenom:       defaultPaymentDenom,
+		DefaultBeneficiary:        defaultBeneficiary,
+		DefaultMinPriceMultiplier: defaultMinPriceMultiplier,
+		AutoProvisionMinBalance:   autoProvisionMinBalance,
+	}
 }
 
 // DefaultParams returns a default set of parameters
+// The mint stream and auction auto-provisioning are disabled by default
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		math.LegacyZeroDec(),
+		MintStreamDestination_MINT_STREAM_DESTINATION_STRDBURNER,
+		false,
+		AuctionType_AUCTION_TYPE_FCFS,
+		DefaultAutoProvisionPaymentDenom,
+		"",
+		DefaultAutoProvisionMinPriceMultiplier,
+		DefaultAutoProvisionMinBalance,
+	)
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if p.MintStreamRate.IsNil() {
+		return errors.New("mint-stream-rate must be specified")
+	}
+	if p.MintStreamRate.IsNegative() || p.MintStreamRate.GT(math.LegacyOneDec()) {
+		return errors.New("mint-stream-rate must be between 0 and 1")
+	}
+	if _, ok := MintStreamDestination_name[int32(p.MintStreamDestination)]; !ok {
+		return errors.New("mint-stream-destination is invalid")
+	}
+
+	if p.DefaultBeneficiary != "" {
+		if _, err := sdk.AccAddressFromBech32(p.DefaultBeneficiary); err != nil {
+			return errors.New("default-beneficiary is invalid")
+		}
+	}
+
+	// The remaining defaults are only required if auto-provisioning is enabled
+	if !p.AutoProvisionEnabled {
+		return nil
+	}
+	if _, ok := AuctionType_name[int32(p.DefaultAuctionType)]; !ok || p.DefaultAuctionType == AuctionType_AUCTION_TYPE_UNSPECIFIED {
+		return errors.New("default-auction-type is invalid")
+	}
+	if p.DefaultPaymentDenom == "" {
+		return errors.New("default-payment-denom must be specified")
+	}
+	if p.DefaultMinPriceMultiplier.IsNil() ||
+		!(p.DefaultMinPriceMultiplier.IsPositive() && p.DefaultMinPriceMultiplier.LTE(math.LegacyOneDec())) {
+		return errors.New("default-min-price-multiplier must be > 0 and <= 1")
+	}
+	if p.AutoProvisionMinBalance.IsNil() || !p.AutoProvisionMinBalance.IsPositive() {
+		return errors.New("auto-provision-min-balance must be positive")
+	}
+
 	return nil
 }
+
+// Returns true if a portion of each mint should be streamed
+func (p Params) IsMintStreamEnabled() bool {
+	return !p.MintStreamRate.IsNil() && p.MintStreamRate.IsPositive()
+}
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -222,44 +224,137 @@ func (m *QueryAuctionsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryUnauctionedBalancesRequest is the request type for the
+// Query/UnauctionedBalances RPC method
+type QueryUnauctionedBalancesRequest struct {
+}
+
+func (m *QueryUnauctionedBalancesRequest) Reset()         { *m = QueryUnauctionedBalancesRequest{} }
+func (m *QueryUnauctionedBalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnauctionedBalancesRequest) ProtoMessage()    {}
+func (*QueryUnauctionedBalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8113674a9412675c, []int{4}
+}
+func (m *QueryUnauctionedBalancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnauctionedBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnauctionedBalancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnauctionedBalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnauctionedBalancesRequest.Merge(m, src)
+}
+func (m *QueryUnauctionedBalancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnauctionedBalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnauctionedBalancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnauctionedBalancesRequest proto.InternalMessageInfo
+
+// QueryUnauctionedBalancesResponse is the response type for the
+// Query/UnauctionedBalances RPC method
+type QueryUnauctionedBalancesResponse struct {
+	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
+}
+
+func (m *QueryUnauctionedBalancesResponse) Reset()         { *m = QueryUnauctionedBalancesResponse{} }
+func (m *QueryUnauctionedBalancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnauctionedBalancesResponse) ProtoMessage()    {}
+func (*QueryUnauctionedBalancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8113674a9412675c, []int{5}
+}
+func (m *QueryUnauctionedBalancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnauctionedBalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnauctionedBalancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnauctionedBalancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnauctionedBalancesResponse.Merge(m, src)
+}
+func (m *QueryUnauctionedBalancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnauctionedBalancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnauctionedBalancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnauctionedBalancesResponse proto.InternalMessageInfo
+
+func (m *QueryUnauctionedBalancesResponse) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAuctionRequest)(nil), "stride.auction.QueryAuctionRequest")
 	proto.RegisterType((*QueryAuctionResponse)(nil), "stride.auction.QueryAuctionResponse")
 	proto.RegisterType((*QueryAuctionsRequest)(nil), "stride.auction.QueryAuctionsRequest")
 	proto.RegisterType((*QueryAuctionsResponse)(nil), "stride.auction.QueryAuctionsResponse")
+	proto.RegisterType((*QueryUnauctionedBalancesRequest)(nil), "stride.auction.QueryUnauctionedBalancesRequest")
+	proto.RegisterType((*QueryUnauctionedBalancesResponse)(nil), "stride.auction.QueryUnauctionedBalancesResponse")
 }
 
 func init() { proto.RegisterFile("stride/auction/query.proto", fileDescriptor_8113674a9412675c) }
 
 var fileDescriptor_8113674a9412675c = []byte{
-	// 425 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4f, 0x4b, 0xe3, 0x40,
-	0x18, 0xc6, 0x93, 0x6e, 0x77, 0xdb, 0x9d, 0x85, 0x3d, 0xcc, 0x76, 0xd9, 0x12, 0x96, 0x6c, 0xc8,
-	0xee, 0x76, 0xff, 0xc0, 0xce, 0xd0, 0xee, 0xa1, 0x78, 0xb4, 0x07, 0x3d, 0x28, 0xa8, 0xf1, 0xe6,
-	0x41, 0x98, 0xd4, 0x21, 0x06, 0x6c, 0x26, 0xed, 0x4c, 0x8a, 0x45, 0x05, 0xf1, 0x13, 0x08, 0x1e,
-	0xfd, 0x42, 0x3d, 0x16, 0xbc, 0x78, 0x12, 0x69, 0xfd, 0x20, 0x92, 0x99, 0x49, 0x6d, 0x4a, 0x6d,
-	0x4f, 0x19, 0xf2, 0x3e, 0xef, 0xf3, 0xfc, 0xe6, 0x7d, 0x07, 0x58, 0x5c, 0xf4, 0xc2, 0x23, 0x8a,
-	0x49, 0xd2, 0x16, 0x21, 0x8b, 0x70, 0x37, 0xa1, 0xbd, 0x01, 0x8a, 0x7b, 0x4c, 0x30, 0xf8, 0x51,
-	0xd5, 0x90, 0xae, 0x59, 0x7f, 0xdb, 0x8c, 0x77, 0x18, 0xc7, 0x3e, 0xe1, 0x54, 0x09, 0x71, 0xbf,
-	0xee, 0x53, 0x41, 0xea, 0x38, 0x26, 0x41, 0x18, 0x91, 0x54, 0xa5, 0x7a, 0xad, 0x4a, 0xc0, 0x02,
-	0x26, 0x8f, 0x38, 0x3d, 0xe9, 0xbf, 0x5f, 0x03, 0xc6, 0x82, 0x13, 0x8a, 0x49, 0x1c, 0x62, 0x12,
-	0x45, 0x4c, 0xc8, 0x16, 0x9e, 0x55, 0xe7, 0x58, 0xf4, 0x57, 0x55, 0xdd, 0x3f, 0xe0, 0xd3, 0x5e,
-	0x9a, 0xb9, 0xae, 0xfe, 0x7a, 0xb4, 0x9b, 0x50, 0x2e, 0x20, 0x04, 0xc5, 0x88, 0x74, 0x68, 0xd5,
-	0x74, 0xcc, 0xdf, 0xef, 0x3d, 0x79, 0x76, 0x77, 0x40, 0x25, 0x2f, 0xe5, 0x31, 0x8b, 0x38, 0x85,
-	0x4d, 0x50, 0xd2, 0x9e, 0x52, 0xfe, 0xa1, 0xf1, 0x05, 0xe5, 0xaf, 0x88, 0x74, 0x47, 0xab, 0x38,
-	0x7c, 0xf8, 0x66, 0x78, 0x99, 0xda, 0x3d, 0xcc, 0x1b, 0xf2, 0x2c, 0x7c, 0x03, 0x80, 0x97, 0x9b,
-	0x6b, 0xcf, 0x1a, 0x52, 0x63, 0x42, 0xe9, 0x98, 0x90, 0x9a, 0xa7, 0x1e, 0x13, 0xda, 0x25, 0x01,
-	0xd5, 0xbd, 0xde, 0x4c, 0xa7, 0x7b, 0x6b, 0x82, 0xcf, 0x73, 0x01, 0x1a, 0x79, 0x0d, 0x94, 0x35,
-	0x04, 0xaf, 0x9a, 0xce, 0x9b, 0xd5, 0xcc, 0x53, 0x39, 0xdc, 0xcc, 0xc1, 0x15, 0x24, 0xdc, 0xaf,
-	0x95, 0x70, 0x2a, 0x77, 0x96, 0xae, 0x71, 0x59, 0x00, 0x6f, 0x25, 0x1d, 0x3c, 0x07, 0x25, 0x9d,
-	0x06, 0xbf, 0xcf, 0x63, 0x2c, 0x58, 0x8e, 0xf5, 0x63, 0xb9, 0x48, 0x65, 0xb9, 0xb5, 0xab, 0xbb,
-	0xa7, 0x9b, 0x82, 0x03, 0x6d, 0xbc, 0xf8, 0x01, 0xe0, 0xb3, 0x74, 0xab, 0x17, 0x70, 0x00, 0xca,
-	0xd9, 0x7c, 0xe0, 0x52, 0xe7, 0x6c, 0x3f, 0xd6, 0xcf, 0x15, 0x2a, 0x0d, 0xe0, 0x48, 0x00, 0x0b,
-	0x56, 0x5f, 0x01, 0xe0, 0xad, 0xad, 0xe1, 0xd8, 0x36, 0x47, 0x63, 0xdb, 0x7c, 0x1c, 0xdb, 0xe6,
-	0xf5, 0xc4, 0x36, 0x46, 0x13, 0xdb, 0xb8, 0x9f, 0xd8, 0xc6, 0x41, 0x3d, 0x08, 0xc5, 0x71, 0xe2,
-	0xa3, 0x36, 0xeb, 0xe0, 0x7d, 0xd9, 0xfd, 0x6f, 0x9b, 0xf8, 0x3c, 0x73, 0xea, 0x37, 0x9a, 0xf8,
-	0x74, 0xea, 0x27, 0x06, 0x31, 0xe5, 0xfe, 0x3b, 0xf9, 0xa0, 0xff, 0x3f, 0x07, 0x00, 0x00, 0xff,
-	0xff, 0x67, 0x0c, 0x89, 0x15, 0x7c, 0x03, 0x00, 0x00,
+	// 531 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xc1, 0x6f, 0xd3, 0x30,
+	0x14, 0xc6, 0x9b, 0x6d, 0xb0, 0x61, 0x24, 0x0e, 0xde, 0x10, 0x25, 0x42, 0x69, 0x08, 0xa3, 0x0c,
+	0xc4, 0xec, 0xb5, 0x1c, 0x26, 0x8e, 0x14, 0x09, 0x0e, 0x20, 0x01, 0x41, 0x5c, 0x38, 0x80, 0x9c,
+	0xd4, 0x0a, 0x11, 0xab, 0x9d, 0xd5, 0xc9, 0x44, 0x05, 0x5c, 0xb8, 0x72, 0x41, 0xe2, 0xc8, 0x1d,
+	0x09, 0xfe, 0x92, 0x1d, 0x27, 0x71, 0xe1, 0x04, 0xa8, 0xe5, 0x0f, 0x41, 0xb1, 0x9f, 0xbb, 0xa6,
+	0x6a, 0x57, 0x4e, 0xb1, 0xec, 0xef, 0x7d, 0xfe, 0xf9, 0x7b, 0x4f, 0x41, 0xae, 0xca, 0xfb, 0x69,
+	0x97, 0x53, 0x56, 0xc4, 0x79, 0x2a, 0x05, 0xdd, 0x2f, 0x78, 0x7f, 0x40, 0xb2, 0xbe, 0xcc, 0x25,
+	0x3e, 0x67, 0xce, 0x08, 0x9c, 0xb9, 0x37, 0x62, 0xa9, 0x7a, 0x52, 0xd1, 0x88, 0x29, 0x6e, 0x84,
+	0xf4, 0xa0, 0x15, 0xf1, 0x9c, 0xb5, 0x68, 0xc6, 0x92, 0x54, 0xb0, 0x52, 0x65, 0x6a, 0x5d, 0x6f,
+	0x52, 0x6b, 0x55, 0xb1, 0x4c, 0xed, 0xf9, 0x46, 0x22, 0x13, 0xa9, 0x97, 0xb4, 0x5c, 0xc1, 0xee,
+	0xa5, 0x44, 0xca, 0x64, 0x8f, 0x53, 0x96, 0xa5, 0x94, 0x09, 0x21, 0x73, 0x6d, 0xa9, 0xec, 0xe9,
+	0x14, 0x2b, 0x7c, 0xcd, 0x69, 0x70, 0x1d, 0xad, 0x3f, 0x29, 0x99, 0xee, 0x98, 0xdd, 0x90, 0xef,
+	0x17, 0x5c, 0xe5, 0x18, 0xa3, 0x15, 0xc1, 0x7a, 0xbc, 0xee, 0xf8, 0xce, 0xd6, 0x99, 0x50, 0xaf,
+	0x83, 0x47, 0x68, 0xa3, 0x2a, 0x55, 0x99, 0x14, 0x8a, 0xe3, 0x5d, 0xb4, 0x0a, 0x9e, 0x5a, 0x7e,
+	0xb6, 0x7d, 0x81, 0x54, 0x23, 0x20, 0x50, 0xd1, 0x59, 0x39, 0xfc, 0xd5, 0xa8, 0x85, 0x56, 0x1d,
+	0xbc, 0xa8, 0x1a, 0x2a, 0x7b, 0xf9, 0x3d, 0x84, 0x8e, 0x93, 0x01, 0xcf, 0x26, 0x31, 0xd1, 0x90,
+	0x32, 0x1a, 0x62, 0xf2, 0x86, 0x80, 0xc8, 0x63, 0x96, 0x70, 0xa8, 0x0d, 0x27, 0x2a, 0x83, 0x2f,
+	0x0e, 0x3a, 0x3f, 0x75, 0x01, 0x20, 0xdf, 0x46, 0x6b, 0x00, 0xa1, 0xea, 0x8e, 0xbf, 0xbc, 0x98,
+	0x79, 0x2c, 0xc7, 0xf7, 0x2b, 0x70, 0x4b, 0x1a, 0xee, 0xda, 0x42, 0x38, 0x73, 0x6f, 0x85, 0xee,
+	0x32, 0x6a, 0x68, 0xb8, 0x67, 0x02, 0xbc, 0x79, 0xb7, 0xc3, 0xf6, 0x98, 0x88, 0xb9, 0x0d, 0x22,
+	0xf8, 0xe8, 0x20, 0x7f, 0xbe, 0x06, 0xde, 0x92, 0xa0, 0xb5, 0x08, 0xf6, 0xe0, 0x2d, 0x17, 0x2b,
+	0x38, 0x16, 0xe4, 0xae, 0x4c, 0x45, 0x67, 0xa7, 0x7c, 0xcd, 0xf7, 0xdf, 0x8d, 0xad, 0x24, 0xcd,
+	0x5f, 0x15, 0x11, 0x89, 0x65, 0x8f, 0xc2, 0xcc, 0x99, 0xcf, 0xb6, 0xea, 0xbe, 0xa6, 0xf9, 0x20,
+	0xe3, 0x4a, 0x17, 0xa8, 0x70, 0x6c, 0xde, 0xfe, 0xb6, 0x8c, 0x4e, 0x69, 0x1a, 0xfc, 0x0e, 0xad,
+	0x42, 0x3c, 0xf8, 0xca, 0x74, 0x6e, 0x33, 0xa6, 0xc9, 0xdd, 0x3c, 0x59, 0x64, 0x1e, 0x12, 0x34,
+	0x3f, 0xfc, 0xf8, 0xfb, 0x79, 0xc9, 0xc7, 0x1e, 0x9d, 0x3d, 0xb1, 0xf4, 0x6d, 0x39, 0x86, 0xef,
+	0xf1, 0x00, 0xad, 0xd9, 0x86, 0xe2, 0x13, 0x9d, 0x6d, 0x8e, 0xee, 0xd5, 0x05, 0x2a, 0x00, 0xf0,
+	0x35, 0x80, 0x8b, 0xeb, 0x73, 0x00, 0x14, 0xfe, 0xea, 0xa0, 0xf5, 0x19, 0xbd, 0xc0, 0x74, 0xe6,
+	0x05, 0xf3, 0x3b, 0xeb, 0xee, 0xfc, 0x7f, 0x01, 0xc0, 0xdd, 0xd4, 0x70, 0x4d, 0xbc, 0x39, 0x0d,
+	0x57, 0x1c, 0x17, 0xbd, 0xb4, 0xbd, 0xea, 0x3c, 0x38, 0x1c, 0x7a, 0xce, 0xd1, 0xd0, 0x73, 0xfe,
+	0x0c, 0x3d, 0xe7, 0xd3, 0xc8, 0xab, 0x1d, 0x8d, 0xbc, 0xda, 0xcf, 0x91, 0x57, 0x7b, 0xde, 0x9a,
+	0xe8, 0xfc, 0x53, 0xed, 0xb4, 0xfd, 0x90, 0x45, 0xca, 0xba, 0x1e, 0xb4, 0x77, 0xe9, 0x9b, 0xb1,
+	0xb7, 0x1e, 0x84, 0xe8, 0xb4, 0xfe, 0x55, 0xdc, 0xfa, 0x37, 0x00, 0x6f, 0xf4, 0x85, 0x92, 0xf6,
+	0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -278,6 +373,9 @@ type QueryClient interface {
 	Auction(ctx context.Context, in *QueryAuctionRequest, opts ...grpc.CallOption) (*QueryAuctionResponse, error)
 	// Auctions queries the auction info for a specific token
 	Auctions(ctx context.Context, in *QueryAuctionsRequest, opts ...grpc.CallOption) (*QueryAuctionsResponse, error)
+	// UnauctionedBalances queries the balances in the auction module account
+	// that do not have an active auction
+	UnauctionedBalances(ctx context.Context, in *QueryUnauctionedBalancesRequest, opts ...grpc.CallOption) (*QueryUnauctionedBalancesResponse, error)
 }
 
 type queryClient struct {
@@ -306,12 +404,24 @@ func (c *queryClient) Auctions(ctx context.Context, in *QueryAuctionsRequest, op
 	return out, nil
 }
 
+func (c *queryClient) UnauctionedBalances(ctx context.Context, in *QueryUnauctionedBalancesRequest, opts ...grpc.CallOption) (*QueryUnauctionedBalancesResponse, error) {
+	out := new(QueryUnauctionedBalancesResponse)
+	err := c.cc.Invoke(ctx, "/stride.auction.Query/UnauctionedBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Auction queries the auction info for a specific token
 	Auction(context.Context, *QueryAuctionRequest) (*QueryAuctionResponse, error)
 	// Auctions queries the auction info for a specific token
 	Auctions(context.Context, *QueryAuctionsRequest) (*QueryAuctionsResponse, error)
+	// UnauctionedBalances queries the balances in the auction module account
+	// that do not have an active auction
+	UnauctionedBalances(context.Context, *QueryUnauctionedBalancesRequest) (*QueryUnauctionedBalancesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -324,6 +434,9 @@ func (*UnimplementedQueryServer) Auction(ctx context.Context, req *QueryAuctionR
 func (*UnimplementedQueryServer) Auctions(ctx context.Context, req *QueryAuctionsRequest) (*QueryAuctionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Auctions not implemented")
 }
+func (*UnimplementedQueryServer) UnauctionedBalances(ctx context.Context, req *QueryUnauctionedBalancesRequest) (*QueryUnauctionedBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnauctionedBalances not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -365,6 +478,24 @@ func _Query_Auctions_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UnauctionedBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnauctionedBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnauctionedBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.auction.Query/UnauctionedBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnauctionedBalances(ctx, req.(*QueryUnauctionedBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.auction.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -377,6 +508,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Auctions",
 			Handler:    _Query_Auctions_Handler,
 		},
+		{
+			MethodName: "UnauctionedBalances",
+			Handler:    _Query_UnauctionedBalances_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stride/auction/query.proto",
@@ -529,6 +664,66 @@ func (m *QueryAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryUnauctionedBalancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnauctionedBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnauctionedBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnauctionedBalancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnauctionedBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnauctionedBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -596,6 +791,30 @@ func (m *QueryAuctionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryUnauctionedBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUnauctionedBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -973,6 +1192,140 @@ func (m *QueryAuctionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryUnauctionedBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnauctionedBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnauctionedBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnauctionedBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnauctionedBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnauctionedBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -389,6 +389,102 @@ func (m *MsgUpdateAuctionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateAuctionResponse proto.InternalMessageInfo
 
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/auction parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07b888fb549a7ca8, []int{6}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07b888fb549a7ca8, []int{7}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgPlaceBid)(nil), "stride.auction.MsgPlaceBid")
 	proto.RegisterType((*MsgPlaceBidResponse)(nil), "stride.auction.MsgPlaceBidResponse")
@@ -396,55 +492,63 @@ func init() {
 	proto.RegisterType((*MsgCreateAuctionResponse)(nil), "stride.auction.MsgCreateAuctionResponse")
 	proto.RegisterType((*MsgUpdateAuction)(nil), "stride.auction.MsgUpdateAuction")
 	proto.RegisterType((*MsgUpdateAuctionResponse)(nil), "stride.auction.MsgUpdateAuctionResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "stride.auction.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "stride.auction.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("stride/auction/tx.proto", fileDescriptor_07b888fb549a7ca8) }
 
 var fileDescriptor_07b888fb549a7ca8 = []byte{
-	// 688 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x3d, 0x4f, 0xdc, 0x4a,
-	0x14, 0x5d, 0x03, 0xbb, 0xc0, 0xf0, 0xa1, 0xf7, 0xcc, 0x22, 0xfc, 0x96, 0xf7, 0x16, 0xde, 0x6e,
-	0x11, 0x84, 0x84, 0x1d, 0x48, 0x11, 0x89, 0x22, 0x12, 0x0b, 0x4d, 0x14, 0x36, 0x41, 0x06, 0x9a,
-	0xa4, 0x58, 0x8d, 0x3d, 0x13, 0x33, 0x62, 0x67, 0xc6, 0xf2, 0xcc, 0x22, 0xb6, 0x8b, 0x52, 0x52,
-	0xa5, 0xc8, 0x9f, 0x48, 0x47, 0x91, 0x1f, 0x90, 0x92, 0x12, 0xa5, 0x8a, 0x52, 0xa0, 0x08, 0x0a,
-	0xfe, 0x46, 0x64, 0xcf, 0x78, 0xb1, 0x61, 0x15, 0x56, 0x0a, 0x45, 0x1a, 0xcc, 0xbd, 0xf7, 0x9c,
-	0x63, 0xef, 0x39, 0x57, 0x33, 0x60, 0x4e, 0xc8, 0x88, 0x20, 0xec, 0xc0, 0x8e, 0x2f, 0x09, 0x67,
-	0x8e, 0x3c, 0xb6, 0xc3, 0x88, 0x4b, 0x6e, 0x4e, 0xab, 0x81, 0xad, 0x07, 0x95, 0xbf, 0x21, 0x25,
-	0x8c, 0x3b, 0xc9, 0x5f, 0x05, 0xa9, 0xfc, 0xe3, 0x73, 0x41, 0xb9, 0x68, 0x25, 0x95, 0xa3, 0x0a,
-	0x3d, 0x9a, 0x53, 0x95, 0x43, 0x45, 0xe0, 0x1c, 0xad, 0xc6, 0x0f, 0x3d, 0x28, 0x07, 0x3c, 0xe0,
-	0x8a, 0x10, 0xff, 0xa7, 0xbb, 0xff, 0xde, 0xfa, 0x0a, 0xfd, 0x54, 0xd3, 0xda, 0xa7, 0x21, 0x30,
-	0xd1, 0x14, 0xc1, 0x4e, 0x1b, 0xfa, 0xb8, 0x41, 0x90, 0xf9, 0x18, 0x94, 0x3c, 0x82, 0x10, 0x8e,
-	0x2c, 0x63, 0xd1, 0x58, 0x1a, 0x6f, 0x58, 0x5f, 0x3f, 0xaf, 0x94, 0xf5, 0xeb, 0x37, 0x10, 0x8a,
-	0xb0, 0x10, 0xbb, 0x32, 0x22, 0x2c, 0x70, 0x35, 0xce, 0xfc, 0x1f, 0x4c, 0x6a, 0xc9, 0x16, 0x83,
-	0x14, 0x5b, 0x43, 0x31, 0xcf, 0x9d, 0xd0, 0xbd, 0x97, 0x90, 0x62, 0xf3, 0x15, 0x28, 0x0b, 0xdc,
-	0x6e, 0x13, 0x16, 0xb4, 0x24, 0x3f, 0xc4, 0xac, 0x05, 0x29, 0xef, 0x30, 0x69, 0x0d, 0x27, 0xaf,
-	0xf8, 0xef, 0xec, 0x62, 0xa1, 0xf0, 0xfd, 0x62, 0x61, 0x56, 0xbd, 0x46, 0xa0, 0x43, 0x9b, 0x70,
-	0x87, 0x42, 0x79, 0x60, 0x3f, 0x67, 0xd2, 0x35, 0x35, 0x75, 0x2f, 0x66, 0x6e, 0x24, 0xc4, 0x58,
-	0x30, 0x84, 0x5d, 0x8a, 0x99, 0xcc, 0x0b, 0x8e, 0x0c, 0x24, 0xa8, 0xa9, 0x19, 0xc1, 0xf5, 0xfa,
-	0xfb, 0xeb, 0xd3, 0x65, 0xfd, 0x8b, 0x4e, 0xae, 0x4f, 0x97, 0x67, 0x52, 0xb7, 0x32, 0xde, 0xd4,
-	0x66, 0xc1, 0x4c, 0xa6, 0x74, 0xb1, 0x08, 0x39, 0x13, 0xb8, 0x76, 0x32, 0x02, 0xfe, 0x6a, 0x8a,
-	0x60, 0x33, 0xc2, 0x50, 0xe2, 0x0d, 0xc5, 0x33, 0x6d, 0x50, 0x84, 0x88, 0x12, 0x76, 0xaf, 0x8d,
-	0x0a, 0x36, 0x88, 0x8b, 0xcf, 0x6e, 0x20, 0xb2, 0x1b, 0xe2, 0xc4, 0xbd, 0xe9, 0xb5, 0x79, 0x3b,
-	0xbf, 0x4c, 0xb6, 0xfe, 0x82, 0xbd, 0x6e, 0x88, 0x7b, 0xfc, 0xb8, 0x30, 0xeb, 0x60, 0x2a, 0x4d,
-	0x01, 0x61, 0xc6, 0xa9, 0x72, 0xcb, 0x9d, 0xd4, 0xcd, 0xad, 0xb8, 0x17, 0x83, 0x52, 0x67, 0x15,
-	0xa8, 0xa8, 0x40, 0xba, 0xa9, 0x40, 0x16, 0x18, 0xc5, 0x0c, 0x7a, 0x6d, 0x8c, 0xac, 0xd2, 0xa2,
-	0xb1, 0x34, 0xe6, 0xa6, 0xa5, 0xb9, 0x0f, 0xca, 0x94, 0xb0, 0x56, 0x18, 0x11, 0x1f, 0xb7, 0x68,
-	0xa7, 0x2d, 0x49, 0xd8, 0x26, 0x38, 0xb2, 0x46, 0x13, 0x17, 0xea, 0x3a, 0x98, 0xf9, 0xbb, 0xc1,
-	0x6c, 0xe3, 0x00, 0xfa, 0xdd, 0x2d, 0xec, 0xbb, 0x26, 0x25, 0x6c, 0x27, 0xe6, 0x37, 0x7b, 0x74,
-	0x73, 0x13, 0x4c, 0xc7, 0xb2, 0x1e, 0x41, 0x69, 0xd2, 0x63, 0x83, 0x24, 0x3d, 0x49, 0x09, 0x6b,
-	0x10, 0xa4, 0x97, 0x66, 0x1d, 0x4c, 0x78, 0x98, 0xe1, 0xb7, 0xc4, 0x27, 0x30, 0xea, 0x5a, 0xe3,
-	0xf7, 0x04, 0x93, 0x05, 0xaf, 0x3f, 0x8a, 0xf7, 0x43, 0x45, 0x15, 0xaf, 0x87, 0x95, 0x59, 0x8f,
-	0x5c, 0xee, 0xb5, 0x0a, 0xb0, 0x6e, 0xf7, 0x7a, 0x8b, 0xf2, 0x65, 0x38, 0x59, 0x94, 0xfd, 0x10,
-	0xfd, 0xd9, 0x8b, 0x92, 0x89, 0x77, 0x64, 0xb0, 0x78, 0x8b, 0x0f, 0x1d, 0x6f, 0xe9, 0xb7, 0xe3,
-	0x1d, 0x7d, 0xa0, 0x78, 0x73, 0x69, 0xe9, 0x78, 0x73, 0xbd, 0x34, 0xde, 0xb5, 0x8f, 0x43, 0x60,
-	0xb8, 0x29, 0x02, 0x73, 0x1b, 0x8c, 0xf5, 0x8e, 0xd3, 0x3b, 0xa6, 0x67, 0x0e, 0x90, 0x4a, 0xfd,
-	0x17, 0xc3, 0x54, 0xd5, 0x7c, 0x03, 0xa6, 0xf2, 0x27, 0xcb, 0x62, 0x1f, 0x56, 0x0e, 0x51, 0x59,
-	0xba, 0x0f, 0x91, 0x15, 0xcf, 0x6f, 0x63, 0x3f, 0xf1, 0x1c, 0xa2, 0xaf, 0x78, 0x5f, 0x3f, 0x2a,
-	0xc5, 0x77, 0xd7, 0xa7, 0xcb, 0x46, 0xe3, 0xc5, 0xd9, 0x65, 0xd5, 0x38, 0xbf, 0xac, 0x1a, 0x3f,
-	0x2e, 0xab, 0xc6, 0x87, 0xab, 0x6a, 0xe1, 0xfc, 0xaa, 0x5a, 0xf8, 0x76, 0x55, 0x2d, 0xbc, 0x5e,
-	0x0d, 0x88, 0x3c, 0xe8, 0x78, 0xb6, 0xcf, 0xa9, 0xb3, 0x9b, 0x88, 0xae, 0x6c, 0x43, 0x4f, 0x38,
-	0xfa, 0xc2, 0x3a, 0x5a, 0x7b, 0xea, 0x1c, 0xdf, 0x5c, 0x9e, 0xdd, 0x10, 0x0b, 0xaf, 0x94, 0xdc,
-	0x5a, 0x4f, 0x7e, 0x06, 0x00, 0x00, 0xff, 0xff, 0x11, 0x6d, 0x07, 0xae, 0x5b, 0x07, 0x00, 0x00,
+	// 776 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0xdb, 0x26, 0x6d, 0x26, 0xd9, 0x02, 0xde, 0x2c, 0xf1, 0x7a, 0x21, 0x2d, 0xc9, 0x61,
+	0xa3, 0x48, 0x6b, 0xd3, 0x20, 0x81, 0xc8, 0x01, 0xa9, 0x69, 0x2f, 0x88, 0x06, 0x2a, 0xb7, 0x95,
+	0x10, 0x1c, 0xa2, 0x89, 0x3d, 0xb8, 0xa3, 0x66, 0xc6, 0x96, 0x67, 0x52, 0x35, 0x37, 0xc4, 0xb1,
+	0x27, 0x7e, 0x06, 0xdc, 0x72, 0xe0, 0x07, 0x70, 0xec, 0xb1, 0xe2, 0x84, 0x38, 0x54, 0xa8, 0x3d,
+	0xf4, 0xc6, 0x6f, 0x40, 0xe3, 0x19, 0x27, 0x76, 0x5a, 0x48, 0x24, 0x7a, 0xe0, 0x12, 0xe7, 0xbd,
+	0xf7, 0x7d, 0x9f, 0xfd, 0xde, 0xf7, 0xe2, 0x09, 0xa8, 0x32, 0x1e, 0x61, 0x0f, 0xd9, 0x70, 0xe4,
+	0x72, 0x1c, 0x50, 0x9b, 0x5f, 0x58, 0x61, 0x14, 0xf0, 0x40, 0xdf, 0x94, 0x05, 0x4b, 0x15, 0xcc,
+	0x77, 0x20, 0xc1, 0x34, 0xb0, 0xe3, 0x4f, 0x09, 0x31, 0x5f, 0xba, 0x01, 0x23, 0x01, 0xeb, 0xc7,
+	0x91, 0x2d, 0x03, 0x55, 0xaa, 0xca, 0xc8, 0x26, 0xcc, 0xb7, 0xcf, 0x77, 0xc4, 0x45, 0x15, 0x2a,
+	0x7e, 0xe0, 0x07, 0x92, 0x20, 0xbe, 0xa9, 0xec, 0x7b, 0x73, 0x4f, 0xa1, 0xae, 0xb2, 0x5a, 0xff,
+	0x79, 0x05, 0x94, 0x7a, 0xcc, 0x3f, 0x1c, 0x42, 0x17, 0x75, 0xb1, 0xa7, 0x7f, 0x08, 0x0a, 0x03,
+	0xec, 0x79, 0x28, 0x32, 0xb4, 0x6d, 0xad, 0x59, 0xec, 0x1a, 0xbf, 0xfd, 0xf2, 0xa6, 0xa2, 0x6e,
+	0xbf, 0xeb, 0x79, 0x11, 0x62, 0xec, 0x88, 0x47, 0x98, 0xfa, 0x8e, 0xc2, 0xe9, 0x1f, 0x80, 0xb2,
+	0x92, 0xec, 0x53, 0x48, 0x90, 0xb1, 0x22, 0x78, 0x4e, 0x49, 0xe5, 0xbe, 0x84, 0x04, 0xe9, 0x5f,
+	0x81, 0x0a, 0x43, 0xc3, 0x21, 0xa6, 0x7e, 0x9f, 0x07, 0x67, 0x88, 0xf6, 0x21, 0x09, 0x46, 0x94,
+	0x1b, 0xab, 0xf1, 0x2d, 0xde, 0xbf, 0xba, 0xd9, 0xca, 0xfd, 0x71, 0xb3, 0xf5, 0x42, 0xde, 0x86,
+	0x79, 0x67, 0x16, 0x0e, 0x6c, 0x02, 0xf9, 0xa9, 0xf5, 0x39, 0xe5, 0x8e, 0xae, 0xa8, 0xc7, 0x82,
+	0xb9, 0x1b, 0x13, 0x85, 0x60, 0x08, 0xc7, 0x04, 0x51, 0x9e, 0x15, 0x5c, 0x5b, 0x4a, 0x50, 0x51,
+	0x53, 0x82, 0x9d, 0xc6, 0x0f, 0xf7, 0x93, 0x96, 0xea, 0xe8, 0xf2, 0x7e, 0xd2, 0x7a, 0x9e, 0x4c,
+	0x2b, 0x35, 0x9b, 0xfa, 0x0b, 0xf0, 0x3c, 0x15, 0x3a, 0x88, 0x85, 0x01, 0x65, 0xa8, 0x7e, 0xb9,
+	0x06, 0xde, 0xee, 0x31, 0x7f, 0x2f, 0x42, 0x90, 0xa3, 0x5d, 0xc9, 0xd3, 0x2d, 0x90, 0x87, 0x1e,
+	0xc1, 0x74, 0xe1, 0x18, 0x25, 0x6c, 0x99, 0x29, 0x7e, 0x36, 0x83, 0xf0, 0x71, 0x88, 0xe2, 0xe9,
+	0x6d, 0xb6, 0x5f, 0x59, 0xd9, 0x65, 0xb2, 0xd4, 0x13, 0x1c, 0x8f, 0x43, 0x34, 0xe5, 0x8b, 0x40,
+	0x6f, 0x80, 0x67, 0x89, 0x0b, 0x1e, 0xa2, 0x01, 0x91, 0xd3, 0x72, 0xca, 0x2a, 0xb9, 0x2f, 0x72,
+	0x02, 0x94, 0x4c, 0x56, 0x82, 0xf2, 0x12, 0xa4, 0x92, 0x12, 0x64, 0x80, 0x75, 0x44, 0xe1, 0x60,
+	0x88, 0x3c, 0xa3, 0xb0, 0xad, 0x35, 0x37, 0x9c, 0x24, 0xd4, 0x4f, 0x40, 0x85, 0x60, 0xda, 0x0f,
+	0x23, 0xec, 0xa2, 0x3e, 0x19, 0x0d, 0x39, 0x0e, 0x87, 0x18, 0x45, 0xc6, 0x7a, 0x3c, 0x85, 0x86,
+	0x32, 0xe6, 0xd5, 0x43, 0x63, 0x0e, 0x90, 0x0f, 0xdd, 0xf1, 0x3e, 0x72, 0x1d, 0x9d, 0x60, 0x7a,
+	0x28, 0xf8, 0xbd, 0x29, 0x5d, 0xdf, 0x03, 0x9b, 0x42, 0x76, 0x80, 0xbd, 0xc4, 0xe9, 0x8d, 0x65,
+	0x9c, 0x2e, 0x13, 0x4c, 0xbb, 0xd8, 0x53, 0x4b, 0xd3, 0x01, 0xa5, 0x01, 0xa2, 0xe8, 0x3b, 0xec,
+	0x62, 0x18, 0x8d, 0x8d, 0xe2, 0x02, 0x63, 0xd2, 0xe0, 0xce, 0x6b, 0xb1, 0x1f, 0xd2, 0x2a, 0xb1,
+	0x1e, 0x46, 0x6a, 0x3d, 0x32, 0xbe, 0xd7, 0x4d, 0x60, 0xcc, 0xe7, 0xa6, 0x8b, 0xf2, 0xeb, 0x6a,
+	0xbc, 0x28, 0x27, 0xa1, 0xf7, 0xff, 0x5e, 0x94, 0x94, 0xbd, 0x6b, 0xcb, 0xd9, 0x9b, 0x7f, 0x6a,
+	0x7b, 0x0b, 0xff, 0xd9, 0xde, 0xf5, 0x27, 0xb2, 0x37, 0xe3, 0x96, 0xb2, 0x37, 0x93, 0x9b, 0xda,
+	0x3b, 0xd1, 0xc0, 0x5b, 0xd3, 0xe2, 0x21, 0x8c, 0x20, 0x61, 0xfa, 0xc7, 0xa0, 0x08, 0x47, 0xfc,
+	0x34, 0x88, 0x30, 0x1f, 0x2f, 0x74, 0x78, 0x06, 0xd5, 0x3f, 0x05, 0x85, 0x30, 0x56, 0x88, 0xfd,
+	0x2d, 0xb5, 0xdf, 0x9d, 0x37, 0x4f, 0xea, 0x77, 0x8b, 0x62, 0x42, 0x3f, 0xdd, 0x4f, 0x5a, 0x9a,
+	0xa3, 0x08, 0x9d, 0x96, 0xe8, 0x65, 0x26, 0x25, 0xfa, 0xa9, 0x3e, 0xe8, 0x47, 0xd2, 0xeb, 0x2f,
+	0x41, 0x75, 0x2e, 0x95, 0x74, 0xd3, 0xfe, 0x6b, 0x05, 0xac, 0xf6, 0x98, 0xaf, 0x1f, 0x80, 0x8d,
+	0xe9, 0xe1, 0xf0, 0x60, 0x85, 0x52, 0xaf, 0x43, 0xb3, 0xf1, 0x2f, 0xc5, 0x44, 0x55, 0xff, 0x16,
+	0x3c, 0xcb, 0xbe, 0x27, 0xb7, 0x1f, 0x61, 0x65, 0x10, 0x66, 0x73, 0x11, 0x22, 0x2d, 0x9e, 0xfd,
+	0x6d, 0x3d, 0x26, 0x9e, 0x41, 0x98, 0xcd, 0x45, 0x88, 0xa9, 0xf8, 0xd7, 0xa0, 0x9c, 0x71, 0x76,
+	0xeb, 0x1f, 0x99, 0x12, 0x60, 0xbe, 0x5e, 0x00, 0x48, 0x94, 0xcd, 0xfc, 0xf7, 0xc2, 0xbf, 0xee,
+	0x17, 0x57, 0xb7, 0x35, 0xed, 0xfa, 0xb6, 0xa6, 0xfd, 0x79, 0x5b, 0xd3, 0x7e, 0xbc, 0xab, 0xe5,
+	0xae, 0xef, 0x6a, 0xb9, 0xdf, 0xef, 0x6a, 0xb9, 0x6f, 0x76, 0x7c, 0xcc, 0x4f, 0x47, 0x03, 0xcb,
+	0x0d, 0x88, 0x7d, 0x14, 0x6b, 0xbe, 0x39, 0x80, 0x03, 0x66, 0xab, 0x83, 0xfd, 0xbc, 0xfd, 0x89,
+	0x7d, 0x31, 0xfb, 0x93, 0x31, 0x0e, 0x11, 0x1b, 0x14, 0xe2, 0xd3, 0xfd, 0xa3, 0xbf, 0x07, 0x00,
+	0xba, 0x3e, 0x4e, 0x32, 0x83, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -465,6 +569,8 @@ type MsgClient interface {
 	CreateAuction(ctx context.Context, in *MsgCreateAuction, opts ...grpc.CallOption) (*MsgCreateAuctionResponse, error)
 	// CreateAuction updates an existing auction
 	UpdateAuction(ctx context.Context, in *MsgUpdateAuction, opts ...grpc.CallOption) (*MsgUpdateAuctionResponse, error)
+	// UpdateParams updates the module parameters
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -502,6 +608,15 @@ func (c *msgClient) UpdateAuction(ctx context.Context, in *MsgUpdateAuction, opt
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/stride.auction.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// PlaceBid places a bid to buy a token off an auction
@@ -510,6 +625,8 @@ type MsgServer interface {
 	CreateAuction(context.Context, *MsgCreateAuction) (*MsgCreateAuctionResponse, error)
 	// CreateAuction updates an existing auction
 	UpdateAuction(context.Context, *MsgUpdateAuction) (*MsgUpdateAuctionResponse, error)
+	// UpdateParams updates the module parameters
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -525,6 +642,9 @@ func (*UnimplementedMsgServer) CreateAuction(ctx context.Context, req *MsgCreate
 func (*UnimplementedMsgServer) UpdateAuction(ctx context.Context, req *MsgUpdateAuction) (*MsgUpdateAuctionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateAuction not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -584,6 +704,24 @@ func _Msg_UpdateAuction_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.auction.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.auction.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -600,6 +738,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateAuction",
 			Handler:    _Msg_UpdateAuction_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stride/auction/tx.proto",
@@ -903,6 +1045,69 @@ func (m *MsgUpdateAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1032,6 +1237,30 @@ func (m *MsgUpdateAuctionResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1940,6 +2169,171 @@ func (m *MsgUpdateAuctionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
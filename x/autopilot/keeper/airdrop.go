@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	"errors"
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
@@ -9,53 +8,38 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
-	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 
 	"github.com/Stride-Labs/stride/v27/utils"
-	claimtypes "github.com/Stride-Labs/stride/v27/x/claim/types"
-	stakeibctypes "github.com/Stride-Labs/stride/v27/x/stakeibc/types"
+	"github.com/Stride-Labs/stride/v27/x/autopilot/types"
 )
 
-// Attempt to link a host address with a stride address to enable airdrop claims
-func (k Keeper) TryUpdateAirdropClaim(
+// Attempt to claim and liquid stake the receiver's x/airdrop rewards
+// Since claiming and staking is a decision for the owner of the allocation, the packet sender must
+// correspond to the same account as the receiver (i.e. the sender's address converted to a stride
+// address must match the receiver)
+func (k Keeper) TryClaimAndStakeAirdrop(
 	ctx sdk.Context,
-	packet channeltypes.Packet,
 	transferMetadata transfertypes.FungibleTokenPacketData,
+	autopilotMetadata types.AirdropPacketMetadata,
 ) error {
 	params := k.GetParams(ctx)
-	if !params.ClaimActive {
-		return errors.New("packet forwarding param is not active")
+	if !params.StakeibcActive {
+		return errorsmod.Wrapf(types.ErrPacketForwardingInactive, "autopilot stakeibc routing is inactive")
 	}
 
-	// verify packet originated on a registered host zone
-	if packet.GetDestPort() != transfertypes.PortID {
-		return errors.New("airdrop claim packet should be sent along a transfer channel")
-	}
-	hostZone, found := k.stakeibcKeeper.GetHostZoneFromTransferChannelID(ctx, packet.GetDestChannel())
-	if !found {
-		return errorsmod.Wrapf(stakeibctypes.ErrHostZoneNotFound,
-			"host zone not found for transfer channel %s", packet.GetDestChannel())
-	}
-
-	// grab relevant addresses
 	senderStrideAddress := utils.ConvertAddressToStrideAddress(transferMetadata.Sender)
 	if senderStrideAddress == "" {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", transferMetadata.Sender)
 	}
-	newStrideAddress := transferMetadata.Receiver
-
-	// find the airdrop for this host chain ID
-	airdrop, found := k.claimKeeper.GetAirdropByChainId(ctx, hostZone.ChainId)
-	if !found {
-		return errorsmod.Wrapf(claimtypes.ErrAirdropNotFound, "airdrop not found for chain-id %s", hostZone.ChainId)
-	}
-	if !airdrop.AutopilotEnabled {
-		return fmt.Errorf("autopilot claiming is not enabled for host zone %s", hostZone.ChainId)
+	if senderStrideAddress != autopilotMetadata.StrideAddress {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress,
+			"sender %s (%s) does not match the airdrop claimer %s",
+			transferMetadata.Sender, senderStrideAddress, autopilotMetadata.StrideAddress)
 	}
 
-	airdropId := airdrop.AirdropIdentifier
-	k.Logger(ctx).Info(fmt.Sprintf("updating airdrop address %s (orig %s) to %s for airdrop %s",
-		senderStrideAddress, transferMetadata.Sender, newStrideAddress, airdropId))
+	k.Logger(ctx).Info(fmt.Sprintf("claiming and staking airdrop %s for %s",
+		autopilotMetadata.AirdropId, autopilotMetadata.StrideAddress))
 
-	return k.claimKeeper.UpdateAirdropAddress(ctx, senderStrideAddress, newStrideAddress, airdropId)
+	_, err := k.airdropKeeper.ClaimAndStake(ctx, autopilotMetadata.AirdropId, autopilotMetadata.StrideAddress)
+	return err
 }
@@ -2,20 +2,20 @@ package keeper_test
 
 import (
 	"fmt"
-	"strings"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/ibc-go/v7/modules/apps/transfer"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
-	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/Stride-Labs/stride/v27/utils"
+	airdroptypes "github.com/Stride-Labs/stride/v27/x/airdrop/types"
 	"github.com/Stride-Labs/stride/v27/x/autopilot"
 	"github.com/Stride-Labs/stride/v27/x/autopilot/types"
-	claimtypes "github.com/Stride-Labs/stride/v27/x/claim/types"
 	stakeibctypes "github.com/Stride-Labs/stride/v27/x/stakeibc/types"
 )
 
@@ -36,358 +36,153 @@ func getClaimPacketMetadata(address string) string {
 }
 
 func (s *KeeperTestSuite) TestAirdropOnRecvPacket() {
-	evmosAirdropId := "evmos"
 	evmosDenom := "aevmos"
-
-	// The evmos addresses represent the airdrop recipient
 	evmosAddress := "evmos1wg6vh689gw93umxqquhe3yaqf0h9wt9d4q7550"
+	strideAddress := s.TestAccs[0].String()
+
+	// Store the host zone so that the channel is valid
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{
+		ChainId:           EvmosChainId,
+		TransferChannelId: ibctesting.FirstChannelID,
+	})
+
+	packetData := transfertypes.FungibleTokenPacketData{
+		Denom:    evmosDenom,
+		Amount:   "1000000",
+		Sender:   evmosAddress,
+		Receiver: strideAddress,
+		Memo:     getClaimPacketMetadata(strideAddress),
+	}
+	packet := channeltypes.Packet{
+		Sequence:           1,
+		SourcePort:         transfertypes.PortID,
+		SourceChannel:      ibctesting.FirstChannelID,
+		DestinationPort:    transfertypes.PortID,
+		DestinationChannel: ibctesting.FirstChannelID,
+		Data:               transfertypes.ModuleCdc.MustMarshalJSON(&packetData),
+	}
 
-	// Each evmos address has a serialized mapping that was used to store the claim record
-	// This is in the form of an "incorrect" stride address and was stored during the upgrade
-	evmosAddressKeyString := utils.ConvertAddressToStrideAddress(evmosAddress)
-	evmosAddressKey := sdk.MustAccAddressFromBech32(evmosAddressKeyString)
+	// Replicate middleware stack
+	transferIBCModule := transfer.NewIBCModule(s.App.TransferKeeper)
+	autopilotStack := autopilot.NewIBCModule(s.App.AutopilotKeeper, transferIBCModule)
 
-	// For each evmos address, there is a corresponding stride address that will specified
-	// in the transfer packet - so for the sake of this test, we'll use arbitrary stride addresses
-	strideAccAddress := s.TestAccs[0]
-	strideAddress := strideAccAddress.String()
-	differentAddress := s.TestAccs[1].String()
+	// The claim route should be rejected regardless of whether claim routing is active,
+	// since the x/claim airdrops were migrated to x/airdrop
+	for _, claimActive := range []bool{true, false} {
+		s.App.AutopilotKeeper.SetParams(s.Ctx, types.Params{ClaimActive: claimActive})
 
-	// Build the template for the transfer packet (the data and channel fields will get updated from each unit test)
-	packetTemplate := channeltypes.Packet{
-		Sequence:         1,
-		SourcePort:       transfertypes.PortID,
-		SourceChannel:    ibctesting.FirstChannelID,
-		DestinationPort:  transfertypes.PortID,
-		Data:             []byte{},
-		TimeoutHeight:    clienttypes.Height{},
-		TimeoutTimestamp: 0,
-	}
-	packetDataTemplate := transfertypes.FungibleTokenPacketData{
-		Denom:  evmosDenom,
-		Amount: "1000000",
-		Sender: evmosAddress,
+		ack := autopilotStack.OnRecvPacket(s.Ctx, packet, sdk.AccAddress{})
+		s.Require().False(ack.Success(), "ack should have failed when claim active is %v", claimActive)
+		s.Require().Contains(string(ack.Acknowledgement()), "ABCI code: 1505", "ack error")
 	}
+}
+
+func (s *KeeperTestSuite) TestTryClaimAndStakeAirdrop() {
+	airdropId := "airdrop-1"
+	distributionStartDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	distributionEndDate := distributionStartDate.Add(time.Hour * 24 * 2)
+	clawbackDate := distributionEndDate.Add(time.Hour * 24)
 
-	// To test the case where the packet has a valid channel but for a host zone without an airdrop
-	channelIdForDifferentHostZone := "channel-1"
+	claimer := s.TestAccs[0]
+	distributor := s.TestAccs[1]
+	depositAddress := s.TestAccs[2]
+
+	// The sender on the host zone corresponds to the same account as the claimer
+	claimerHostAddress, err := bech32.ConvertAndEncode(HostBechPrefix, claimer)
+	s.Require().NoError(err, "no error expected when converting claimer address")
+	differentHostAddress, err := bech32.ConvertAndEncode(HostBechPrefix, s.TestAccs[3])
+	s.Require().NoError(err, "no error expected when converting different address")
 
 	testCases := []struct {
-		name                         string
-		autopilotClaimActive         bool
-		autopilotClaimEnabledForHost bool
-		packetData                   transfertypes.FungibleTokenPacketData
-		destinationChannelID         string
-		destinationPortID            string
-		transferShouldSucceed        bool
-		airdropShouldUpdate          bool
+		name           string
+		featureEnabled bool
+		sender         string
+		airdropId      string
+		expectedError  string
 	}{
 		{
-			name:                         "successful airdrop update",
-			autopilotClaimActive:         true,
-			autopilotClaimEnabledForHost: true,
-			destinationChannelID:         ibctesting.FirstChannelID,
-			destinationPortID:            transfertypes.PortID,
-			packetData: transfertypes.FungibleTokenPacketData{
-				Receiver: strideAddress,
-				Memo:     getClaimPacketMetadata(strideAddress),
-			},
-			transferShouldSucceed: true,
-			airdropShouldUpdate:   true,
-		},
-		{
-			name:                         "valid routing schema, but routing inactive",
-			autopilotClaimActive:         false,
-			autopilotClaimEnabledForHost: true,
-			destinationChannelID:         ibctesting.FirstChannelID,
-			destinationPortID:            transfertypes.PortID,
-			packetData: transfertypes.FungibleTokenPacketData{
-				Receiver: strideAddress,
-				Memo:     getClaimPacketMetadata(strideAddress),
-			},
-			transferShouldSucceed: false,
-			airdropShouldUpdate:   false,
-		},
-		{
-			name:                         "valid routing schema, but routing inactive for airdrop",
-			autopilotClaimActive:         true,
-			autopilotClaimEnabledForHost: false,
-			destinationChannelID:         ibctesting.FirstChannelID,
-			destinationPortID:            transfertypes.PortID,
-			packetData: transfertypes.FungibleTokenPacketData{
-				Receiver: strideAddress,
-				Memo:     getClaimPacketMetadata(strideAddress),
-			},
-			transferShouldSucceed: false,
-			airdropShouldUpdate:   false,
-		},
-		{
-			name:                         "invalid stride address in memo",
-			autopilotClaimActive:         true,
-			autopilotClaimEnabledForHost: true,
-			destinationChannelID:         ibctesting.FirstChannelID,
-			destinationPortID:            transfertypes.PortID,
-			packetData: transfertypes.FungibleTokenPacketData{
-				Receiver: strideAddress,
-				Memo:     getClaimPacketMetadata("invalid_address"),
-			},
-			transferShouldSucceed: false,
-			airdropShouldUpdate:   false,
-		},
-		{
-			name:                         "invalid stride address in receiver",
-			autopilotClaimActive:         true,
-			autopilotClaimEnabledForHost: true,
-			destinationChannelID:         ibctesting.FirstChannelID,
-			destinationPortID:            transfertypes.PortID,
-			packetData: transfertypes.FungibleTokenPacketData{
-				Receiver: "invalid-address",
-				Memo:     getClaimPacketMetadata(strideAddress),
-			},
-			transferShouldSucceed: false,
-			airdropShouldUpdate:   false,
-		},
-		{
-			name:                         "memo receiver doesn't match transfer receiver",
-			autopilotClaimActive:         true,
-			autopilotClaimEnabledForHost: true,
-			destinationChannelID:         ibctesting.FirstChannelID,
-			destinationPortID:            transfertypes.PortID,
-			packetData: transfertypes.FungibleTokenPacketData{
-				Receiver: differentAddress,
-				Memo:     getClaimPacketMetadata(strideAddress),
-			},
-			transferShouldSucceed: false,
-			airdropShouldUpdate:   false,
-		},
-		{
-			name:                         "normal transfer packet - no memo",
-			autopilotClaimActive:         true,
-			autopilotClaimEnabledForHost: true,
-			destinationChannelID:         ibctesting.FirstChannelID,
-			destinationPortID:            transfertypes.PortID,
-			packetData: transfertypes.FungibleTokenPacketData{
-				Receiver: strideAddress,
-				Memo:     "",
-			},
-			transferShouldSucceed: true,
-			airdropShouldUpdate:   false,
-		},
-		{
-			name:                         "normal transfer packet - empty JSON memo",
-			autopilotClaimActive:         true,
-			autopilotClaimEnabledForHost: true,
-			destinationChannelID:         ibctesting.FirstChannelID,
-			destinationPortID:            transfertypes.PortID,
-			packetData: transfertypes.FungibleTokenPacketData{
-				Receiver: strideAddress,
-				Memo:     "{}",
-			},
-			transferShouldSucceed: true,
-			airdropShouldUpdate:   false,
-		},
-		{
-			name:                         "normal transfer packet - different middleware",
-			autopilotClaimActive:         true,
-			autopilotClaimEnabledForHost: true,
-			destinationChannelID:         ibctesting.FirstChannelID,
-			destinationPortID:            transfertypes.PortID,
-			packetData: transfertypes.FungibleTokenPacketData{
-				Receiver: strideAddress,
-				Memo:     `{ "other_module": { } }`,
-			},
-			transferShouldSucceed: true,
-			airdropShouldUpdate:   false,
-		},
-		{
-			name:                         "invalid autopilot JSON - no receiver",
-			autopilotClaimActive:         true,
-			autopilotClaimEnabledForHost: true,
-			destinationChannelID:         ibctesting.FirstChannelID,
-			destinationPortID:            transfertypes.PortID,
-			packetData: transfertypes.FungibleTokenPacketData{
-				Receiver: strideAddress,
-				Memo:     `{ "autopilot": {} }`,
-			},
-			transferShouldSucceed: false,
-			airdropShouldUpdate:   false,
+			name:           "successful claim and stake",
+			featureEnabled: true,
+			sender:         claimerHostAddress,
+			airdropId:      airdropId,
 		},
 		{
-			name:                         "invalid autopilot JSON - no routing module",
-			autopilotClaimActive:         true,
-			autopilotClaimEnabledForHost: true,
-			destinationChannelID:         ibctesting.FirstChannelID,
-			destinationPortID:            transfertypes.PortID,
-			packetData: transfertypes.FungibleTokenPacketData{
-				Receiver: strideAddress,
-				Memo:     fmt.Sprintf(`{ "autopilot": { "receiver": "%s" } }`, strideAddress),
-			},
-			transferShouldSucceed: false,
-			airdropShouldUpdate:   false,
+			name:           "autopilot disabled",
+			featureEnabled: false,
+			sender:         claimerHostAddress,
+			airdropId:      airdropId,
+			expectedError:  "autopilot stakeibc routing is inactive",
 		},
 		{
-			name:                         "memo too long",
-			autopilotClaimActive:         true,
-			autopilotClaimEnabledForHost: true,
-			destinationChannelID:         ibctesting.FirstChannelID,
-			destinationPortID:            transfertypes.PortID,
-			packetData: transfertypes.FungibleTokenPacketData{
-				Receiver: strideAddress,
-				Memo:     strings.Repeat("X", 4001),
-			},
-			transferShouldSucceed: false,
-			airdropShouldUpdate:   false,
+			name:           "sender does not match claimer",
+			featureEnabled: true,
+			sender:         differentHostAddress,
+			airdropId:      airdropId,
+			expectedError:  "does not match the airdrop claimer",
 		},
 		{
-			name:                         "receiver too long",
-			autopilotClaimActive:         true,
-			autopilotClaimEnabledForHost: true,
-			destinationChannelID:         ibctesting.FirstChannelID,
-			destinationPortID:            transfertypes.PortID,
-			packetData: transfertypes.FungibleTokenPacketData{
-				Receiver: strings.Repeat("X", 101),
-				Memo:     "",
-			},
-			transferShouldSucceed: false,
-			airdropShouldUpdate:   false,
+			name:           "invalid sender",
+			featureEnabled: true,
+			sender:         "invalid_sender",
+			airdropId:      airdropId,
+			expectedError:  "invalid sender address",
 		},
 		{
-			name:                         "not transfer channel",
-			autopilotClaimActive:         true,
-			autopilotClaimEnabledForHost: true,
-			destinationChannelID:         ibctesting.FirstChannelID,
-			destinationPortID:            "invalid_port",
-			packetData: transfertypes.FungibleTokenPacketData{
-				Receiver: strideAddress,
-				Memo:     getClaimPacketMetadata(strideAddress),
-			},
-			transferShouldSucceed: false,
-			airdropShouldUpdate:   false,
-		},
-		{
-			name:                         "transfer channel from a different host zone",
-			autopilotClaimActive:         true,
-			autopilotClaimEnabledForHost: true,
-			destinationChannelID:         channelIdForDifferentHostZone,
-			destinationPortID:            transfertypes.PortID,
-			packetData: transfertypes.FungibleTokenPacketData{
-				Receiver: strideAddress,
-				Memo:     getClaimPacketMetadata(strideAddress),
-			},
-			transferShouldSucceed: false,
-			airdropShouldUpdate:   false,
-		},
-		{
-			name:                         "transfer channel does not exist",
-			autopilotClaimActive:         true,
-			autopilotClaimEnabledForHost: true,
-			destinationChannelID:         "channel-XXX",
-			destinationPortID:            transfertypes.PortID,
-			packetData: transfertypes.FungibleTokenPacketData{
-				Receiver: strideAddress,
-				Memo:     getClaimPacketMetadata(strideAddress),
-			},
-			transferShouldSucceed: false,
-			airdropShouldUpdate:   false,
+			name:           "airdrop not found",
+			featureEnabled: true,
+			sender:         claimerHostAddress,
+			airdropId:      "different-airdrop",
+			expectedError:  "airdrop not found",
 		},
 	}
 
-	for i, tc := range testCases {
-		s.Run(fmt.Sprintf("Case %d_%s", i, tc.name), func() {
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
 			s.SetupTest()
 
-			// Update the autopilot active flag
-			s.App.AutopilotKeeper.SetParams(s.Ctx, types.Params{
-				ClaimActive: tc.autopilotClaimActive,
+			// Create the host zone and records needed to liquid stake
+			rewardDenom := s.SetupAutopilotLiquidStake(tc.featureEnabled, ibctesting.FirstChannelID, depositAddress, claimer)
+
+			// Create the airdrop with 10 rewards for the claimer on each day
+			s.FundAccount(distributor, sdk.NewCoin(rewardDenom, sdkmath.NewInt(1000)))
+			s.App.AirdropKeeper.SetAirdrop(s.Ctx, airdroptypes.Airdrop{
+				Id:                    airdropId,
+				RewardDenom:           rewardDenom,
+				DistributorAddress:    distributor.String(),
+				DistributionStartDate: &distributionStartDate,
+				DistributionEndDate:   &distributionEndDate,
+				ClawbackDate:          &clawbackDate,
 			})
-
-			// Set evmos airdrop
-			airdrops := claimtypes.Params{
-				Airdrops: []*claimtypes.Airdrop{{
-					AirdropIdentifier: evmosAirdropId,
-					ChainId:           EvmosChainId,
-					AutopilotEnabled:  tc.autopilotClaimEnabledForHost,
-				}},
-			}
-			err := s.App.ClaimKeeper.SetParams(s.Ctx, airdrops)
-			s.Require().NoError(err, "no error expected when setting airdrop params")
-
-			// Store the host zone so that we can verify the channel
-			s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{
-				ChainId:           EvmosChainId,
-				TransferChannelId: ibctesting.FirstChannelID,
+			s.App.AirdropKeeper.SetUserAllocation(s.Ctx, airdroptypes.UserAllocation{
+				AirdropId:   airdropId,
+				Address:     claimer.String(),
+				Claimed:     sdkmath.ZeroInt(),
+				Forfeited:   sdkmath.ZeroInt(),
+				Allocations: []sdkmath.Int{sdkmath.NewInt(10), sdkmath.NewInt(10), sdkmath.NewInt(10)},
 			})
 
-			// Store a second host zone that does not have an airdrop
-			s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{
-				ChainId:           "differnet_host_zone",
-				TransferChannelId: channelIdForDifferentHostZone,
-			})
+			// Claim on the second day
+			s.Ctx = s.Ctx.WithBlockTime(distributionStartDate.Add(time.Hour * 25))
 
-			// Set claim records using key'd address
-			oldClaimRecord := claimtypes.ClaimRecord{
-				AirdropIdentifier: evmosAirdropId,
-				Address:           evmosAddressKeyString,
-				Weight:            sdk.NewDec(10),
-				ActionCompleted:   []bool{false, false, false},
+			transferMetadata := transfertypes.FungibleTokenPacketData{
+				Sender:   tc.sender,
+				Receiver: claimer.String(),
+			}
+			routingInfo := types.AirdropPacketMetadata{
+				AirdropId:     tc.airdropId,
+				StrideAddress: claimer.String(),
 			}
-			err = s.App.ClaimKeeper.SetClaimRecord(s.Ctx, oldClaimRecord)
-			s.Require().NoError(err, "no error expected when setting claim record")
-
-			// Store the expected new claim record which should have the address changed
-			expectedNewClaimRecord := oldClaimRecord
-			expectedNewClaimRecord.Address = strideAddress
-
-			// Replicate middleware stack
-			transferIBCModule := transfer.NewIBCModule(s.App.TransferKeeper)
-			autopilotStack := autopilot.NewIBCModule(s.App.AutopilotKeeper, transferIBCModule)
-
-			// Update packet and packet data
-			packetData := packetDataTemplate
-			packetData.Memo = tc.packetData.Memo
-			packetData.Receiver = tc.packetData.Receiver
-
-			packet := packetTemplate
-			packet.DestinationChannel = tc.destinationChannelID
-			packet.DestinationPort = tc.destinationPortID
-			packet.Data = transfertypes.ModuleCdc.MustMarshalJSON(&packetData)
-
-			// Build the evmos denom from the packet
-			prefixedDenom := transfertypes.GetPrefixedDenom(packet.DestinationPort, packet.DestinationChannel, evmosDenom)
-			evmosIbcDenom := transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
-
-			// Call OnRecvPacket for autopilot
-			ack := autopilotStack.OnRecvPacket(
-				s.Ctx,
-				packet,
-				sdk.AccAddress{},
-			)
-
-			if tc.transferShouldSucceed {
-				s.Require().True(ack.Success(), "ack should be successful - ack: %+v", string(ack.Acknowledgement()))
-
-				// Check funds were transferred
-				coin := s.App.BankKeeper.GetBalance(s.Ctx, sdk.MustAccAddressFromBech32(strideAddress), evmosIbcDenom)
-				s.Require().Equal(packetDataTemplate.Amount, coin.Amount.String(), "balance should have updated after successful transfer")
-
-				if tc.airdropShouldUpdate {
-					// Check that we have a new record for the user
-					actualNewClaimRecord, err := s.App.ClaimKeeper.GetClaimRecord(s.Ctx, strideAccAddress, evmosAirdropId)
-					s.Require().NoError(err, "no error expected when getting new claim record")
-					s.Require().Equal(expectedNewClaimRecord, actualNewClaimRecord)
 
-					// Check that the old record was removed (GetClaimRecord returns a zero-struct if not found)
-					oldClaimRecord, _ := s.App.ClaimKeeper.GetClaimRecord(s.Ctx, evmosAddressKey, evmosAirdropId)
-					s.Require().Equal("", oldClaimRecord.Address)
-				} else {
-					// If the airdrop code was never called, check that the old record claim record is still there
-					oldClaimRecordAfterTransfer, err := s.App.ClaimKeeper.GetClaimRecord(s.Ctx, evmosAddressKey, evmosAirdropId)
-					s.Require().NoError(err, "no error expected when getting old claim record")
-					s.Require().Equal(oldClaimRecord, oldClaimRecordAfterTransfer)
-				}
-			} else {
-				s.Require().False(ack.Success(), "ack should have failed - ack: %+v", string(ack.Acknowledgement()))
+			err := s.App.AutopilotKeeper.TryClaimAndStakeAirdrop(s.Ctx, transferMetadata, routingInfo)
+			if tc.expectedError != "" {
+				s.Require().ErrorContains(err, tc.expectedError)
+				return
 			}
+			s.Require().NoError(err, "no error expected when claiming and staking")
+
+			// Confirm the first two days were claimed and liquid staked
+			stBalance := s.App.BankKeeper.GetBalance(s.Ctx, claimer, "st"+HostDenom)
+			s.Require().Equal(int64(20), stBalance.Amount.Int64(), "claimer st balance")
 		})
 	}
 }
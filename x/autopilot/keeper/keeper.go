@@ -10,8 +10,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
+	airdropkeeper "github.com/Stride-Labs/stride/v27/x/airdrop/keeper"
 	"github.com/Stride-Labs/stride/v27/x/autopilot/types"
-	claimkeeper "github.com/Stride-Labs/stride/v27/x/claim/keeper"
 	stakeibckeeper "github.com/Stride-Labs/stride/v27/x/stakeibc/keeper"
 )
 
@@ -22,7 +22,7 @@ type (
 		paramstore     paramtypes.Subspace
 		bankKeeper     types.BankKeeper
 		stakeibcKeeper stakeibckeeper.Keeper
-		claimKeeper    claimkeeper.Keeper
+		airdropKeeper  airdropkeeper.Keeper
 		transferKeeper types.IbcTransferKeeper
 	}
 )
@@ -33,7 +33,7 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 	bankKeeper types.BankKeeper,
 	stakeibcKeeper stakeibckeeper.Keeper,
-	claimKeeper claimkeeper.Keeper,
+	airdropKeeper airdropkeeper.Keeper,
 	transferKeeper types.IbcTransferKeeper,
 ) *Keeper {
 	// set KeyTable if it has not already been set
@@ -47,7 +47,7 @@ func NewKeeper(
 		paramstore:     ps,
 		bankKeeper:     bankKeeper,
 		stakeibcKeeper: stakeibcKeeper,
-		claimKeeper:    claimKeeper,
+		airdropKeeper:  airdropKeeper,
 		transferKeeper: transferKeeper,
 	}
 }
@@ -238,15 +238,23 @@ func (im IBCModule) OnRecvPacket(
 		return ack
 
 	case types.ClaimPacketMetadata:
-		// If claim routing is inactive (but the packet had routing info in the memo) return an ack error
-		if !autopilotParams.ClaimActive {
-			im.keeper.Logger(ctx).Error(fmt.Sprintf("Packet from %s had claim routing info but autopilot claim routing is disabled", sender))
+		// The x/claim airdrops have been migrated to x/airdrop, so there are no longer any claim
+		// records to update
+		im.keeper.Logger(ctx).Error(fmt.Sprintf("Packet from %s had claim routing info but x/claim has been removed", sender))
+		return channeltypes.NewErrorAcknowledgement(
+			types.ErrUnsupportedAutopilotRoute.Wrap("x/claim airdrops have been migrated to x/airdrop"))
+
+	case types.AirdropPacketMetadata:
+		// If stakeibc routing is inactive (but the packet had routing info in the memo) return an ack error
+		// The stakeibc param is used since the claimed rewards are liquid staked
+		if !autopilotParams.StakeibcActive {
+			im.keeper.Logger(ctx).Error(fmt.Sprintf("Packet from %s had airdrop routing info but autopilot stakeibc routing is disabled", sender))
 			return channeltypes.NewErrorAcknowledgement(types.ErrPacketForwardingInactive)
 		}
-		im.keeper.Logger(ctx).Info(fmt.Sprintf("Forwaring packet from %s to claim", sender))
+		im.keeper.Logger(ctx).Info(fmt.Sprintf("Forwaring packet from %s to airdrop", sender))
 
-		if err := im.keeper.TryUpdateAirdropClaim(ctx, packet, tokenPacketData); err != nil {
-			im.keeper.Logger(ctx).Error(fmt.Sprintf("Error updating airdrop claim from autopilot for %s: %s", sender, err.Error()))
+		if err := im.keeper.TryClaimAndStakeAirdrop(ctx, tokenPacketData, routingInfo); err != nil {
+			im.keeper.Logger(ctx).Error(fmt.Sprintf("Error claiming and staking airdrop from autopilot for %s: %s", sender, err.Error()))
 			return channeltypes.NewErrorAcknowledgement(err)
 		}
 
@@ -17,6 +17,7 @@ type RawPacketMetadata struct {
 		Receiver string                  `json:"receiver"`
 		Stakeibc *StakeibcPacketMetadata `json:"stakeibc,omitempty"`
 		Claim    *ClaimPacketMetadata    `json:"claim,omitempty"`
+		Airdrop  *AirdropPacketMetadata  `json:"airdrop,omitempty"`
 	} `json:"autopilot"`
 	Forward *interface{} `json:"forward"`
 	Wasm    *interface{} `json:"wasm"`
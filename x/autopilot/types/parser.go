@@ -25,6 +25,12 @@ type ClaimPacketMetadata struct {
 	StrideAddress string
 }
 
+// Packet metadata info specific to Airdrop (e.g. claiming and liquid staking x/airdrop rewards)
+type AirdropPacketMetadata struct {
+	AirdropId     string `json:"airdrop_id"`
+	StrideAddress string
+}
+
 // Validate stakeibc packet metadata fields
 // including the stride address and action type
 func (m StakeibcPacketMetadata) Validate() error {
@@ -53,6 +59,19 @@ func (m ClaimPacketMetadata) Validate() error {
 	return nil
 }
 
+// Validate airdrop packet metadata includes the stride address and airdrop ID
+func (m AirdropPacketMetadata) Validate() error {
+	_, err := sdk.AccAddressFromBech32(m.StrideAddress)
+	if err != nil {
+		return err
+	}
+	if m.AirdropId == "" {
+		return ErrInvalidClaimAirdropId
+	}
+
+	return nil
+}
+
 // Parse packet metadata intended for autopilot
 // In the ICS-20 packet, the metadata can optionally indicate a module to route to (e.g. stakeibc)
 // The AutopilotMetadata returned from this function contains attributes for each autopilot supported module
@@ -110,6 +129,12 @@ func ParseAutopilotMetadata(metadata string) (*AutopilotMetadata, error) {
 		moduleCount++
 		routingInfo = *raw.Autopilot.Claim
 	}
+	if raw.Autopilot.Airdrop != nil {
+		// override the stride address with the receiver address
+		raw.Autopilot.Airdrop.StrideAddress = raw.Autopilot.Receiver
+		moduleCount++
+		routingInfo = *raw.Autopilot.Airdrop
+	}
 	if moduleCount != 1 {
 		return nil, errorsmod.Wrap(ErrInvalidPacketMetadata, ErrInvalidModuleRoutes.Error())
 	}
@@ -54,6 +54,16 @@ func getClaimMemoWithStrideAddress(receiverAddress, strideAddress string) string
 		}`, receiverAddress, strideAddress)
 }
 
+func getAirdropMemo(address, airdropId string) string {
+	return fmt.Sprintf(`
+		{
+			"autopilot": {
+				"receiver": "%[1]s",
+				"airdrop": { "airdrop_id": "%[2]s" } 
+			}
+		}`, address, airdropId)
+}
+
 func getClaimAndStakeibcMemo(address, action string) string {
 	return fmt.Sprintf(`
 	    {
@@ -74,6 +84,8 @@ func checkModuleRoutingInfoType(routingInfo types.ModuleRoutingInfo, expectedTyp
 		return expectedType == "stakeibc"
 	case types.ClaimPacketMetadata:
 		return expectedType == "claim"
+	case types.AirdropPacketMetadata:
+		return expectedType == "airdrop"
 	default:
 		return false
 	}
@@ -92,11 +104,17 @@ func TestParsePacketMetadata(t *testing.T) {
 		StrideAddress: validAddress,
 	}
 
+	validParsedAirdropPacketMetadata := types.AirdropPacketMetadata{
+		StrideAddress: validAddress,
+		AirdropId:     "airdrop-1",
+	}
+
 	testCases := []struct {
 		name                string
 		metadata            string
 		parsedStakeibc      *types.StakeibcPacketMetadata
 		parsedClaim         *types.ClaimPacketMetadata
+		parsedAirdrop       *types.AirdropPacketMetadata
 		expectedNilMetadata bool
 		expectedErr         string
 	}{
@@ -110,6 +128,11 @@ func TestParsePacketMetadata(t *testing.T) {
 			metadata:    getClaimMemo(validAddress),
 			parsedClaim: &validParsedClaimPacketMetadata,
 		},
+		{
+			name:          "valid airdrop memo",
+			metadata:      getAirdropMemo(validAddress, "airdrop-1"),
+			parsedAirdrop: &validParsedAirdropPacketMetadata,
+		},
 		{
 			name:           "valid stakeibc memo with stride address override",
 			metadata:       getStakeibcMemoWithStrideAddress(validAddress, validStakeibcAction, "different_address"),
@@ -190,6 +213,16 @@ func TestParsePacketMetadata(t *testing.T) {
 			metadata:    getClaimMemo(invalidAddress),
 			expectedErr: "receiver address must be specified when using autopilot",
 		},
+		{
+			name:        "invalid airdrop address",
+			metadata:    getAirdropMemo(invalidAddress, "airdrop-1"),
+			expectedErr: "receiver address must be specified when using autopilot",
+		},
+		{
+			name:        "missing airdrop id",
+			metadata:    getAirdropMemo(validAddress, ""),
+			expectedErr: "invalid claim airdrop ID",
+		},
 		{
 			name:        "both claim and stakeibc memo set",
 			metadata:    getClaimAndStakeibcMemo(validAddress, validStakeibcAction),
@@ -216,6 +249,11 @@ func TestParsePacketMetadata(t *testing.T) {
 						routingInfo, ok := parsedData.RoutingInfo.(types.ClaimPacketMetadata)
 						require.True(t, ok, "routing info should be claim")
 						require.Equal(t, *tc.parsedClaim, routingInfo, "parsed claim value")
+					} else if tc.parsedAirdrop != nil {
+						checkModuleRoutingInfoType(parsedData.RoutingInfo, "airdrop")
+						routingInfo, ok := parsedData.RoutingInfo.(types.AirdropPacketMetadata)
+						require.True(t, ok, "routing info should be airdrop")
+						require.Equal(t, *tc.parsedAirdrop, routingInfo, "parsed airdrop value")
 					}
 				}
 			} else {
@@ -306,3 +344,48 @@ func TestValidateClaimPacketMetadata(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateAirdropPacketMetadata(t *testing.T) {
+	validAddress, _ := apptesting.GenerateTestAddrs()
+
+	testCases := []struct {
+		name        string
+		metadata    *types.AirdropPacketMetadata
+		expectedErr string
+	}{
+		{
+			name: "valid metadata",
+			metadata: &types.AirdropPacketMetadata{
+				StrideAddress: validAddress,
+				AirdropId:     "airdrop-1",
+			},
+		},
+		{
+			name: "invalid address",
+			metadata: &types.AirdropPacketMetadata{
+				StrideAddress: "bad_address",
+				AirdropId:     "airdrop-1",
+			},
+			expectedErr: "decoding bech32 failed",
+		},
+		{
+			name: "missing airdrop id",
+			metadata: &types.AirdropPacketMetadata{
+				StrideAddress: validAddress,
+				AirdropId:     "",
+			},
+			expectedErr: "invalid claim airdrop ID",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actualErr := tc.metadata.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, actualErr, "no error expected for %s", tc.name)
+			} else {
+				require.ErrorContains(t, actualErr, tc.expectedErr, "error expected for %s", tc.name)
+			}
+		})
+	}
+}
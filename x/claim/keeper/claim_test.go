@@ -29,25 +29,25 @@ stride,stride1av5lwh0msnafn04xkhdyk6mrykxthrawy7uf3d,0.7
 stride,stride1g7yxhuppp5x3yqkah5mw29eqq5s4sv2f222xmk,0.3
 stride,stride1g7yxhuppp5x3yqkah5mw29eqq5s4sv2f222xmk,0.5`
 
-	ok := suite.app.ClaimKeeper.LoadAllocationData(suite.ctx, allocations)
+	ok := suite.app.LegacyClaimKeeper().LoadAllocationData(suite.ctx, allocations)
 	suite.Require().True(ok)
 
-	totalWeight, err := suite.app.ClaimKeeper.GetTotalWeight(suite.ctx, "osmosis")
+	totalWeight, err := suite.app.LegacyClaimKeeper().GetTotalWeight(suite.ctx, "osmosis")
 	suite.Require().NoError(err)
 	suite.Require().True(totalWeight.Equal(sdk.MustNewDecFromStr("0.8")))
 
-	totalWeight, err = suite.app.ClaimKeeper.GetTotalWeight(suite.ctx, "stride")
+	totalWeight, err = suite.app.LegacyClaimKeeper().GetTotalWeight(suite.ctx, "stride")
 	suite.Require().NoError(err)
 	suite.Require().True(totalWeight.Equal(sdk.MustNewDecFromStr("1")))
 
 	addr, _ := sdk.AccAddressFromBech32("stride1g7yxhuppp5x3yqkah5mw29eqq5s4sv2f222xmk") // hex(stride1g7yxhuppp5x3yqkah5mw29eqq5s4sv2f222xmk) = hex(osmo1g7yxhuppp5x3yqkah5mw29eqq5s4sv2fp6e2eg)
-	claimRecord, err := suite.app.ClaimKeeper.GetClaimRecord(suite.ctx, addr, "osmosis")
+	claimRecord, err := suite.app.LegacyClaimKeeper().GetClaimRecord(suite.ctx, addr, "osmosis")
 	suite.Require().NoError(err)
 	suite.Require().Equal(claimRecord.Address, "stride1g7yxhuppp5x3yqkah5mw29eqq5s4sv2f222xmk")
 	suite.Require().True(claimRecord.Weight.Equal(sdk.MustNewDecFromStr("0.5")))
 	suite.Require().Equal(claimRecord.ActionCompleted, []bool{false, false, false})
 
-	claimRecord, err = suite.app.ClaimKeeper.GetClaimRecord(suite.ctx, addr, "stride")
+	claimRecord, err = suite.app.LegacyClaimKeeper().GetClaimRecord(suite.ctx, addr, "stride")
 	suite.Require().NoError(err)
 	suite.Require().True(claimRecord.Weight.Equal(sdk.MustNewDecFromStr("0.3")))
 	suite.Require().Equal(claimRecord.ActionCompleted, []bool{false, false, false})
@@ -62,11 +62,11 @@ func (suite *KeeperTestSuite) TestHookOfUnclaimableAccount() {
 	addr1 := sdk.AccAddress(pub1.Address())
 	suite.app.AccountKeeper.SetAccount(suite.ctx, authtypes.NewBaseAccount(addr1, nil, 0, 0))
 
-	claim, err := suite.app.ClaimKeeper.GetClaimRecord(suite.ctx, addr1, "stride")
+	claim, err := suite.app.LegacyClaimKeeper().GetClaimRecord(suite.ctx, addr1, "stride")
 	suite.NoError(err)
 	suite.Equal(types.ClaimRecord{}, claim)
 
-	suite.app.ClaimKeeper.AfterLiquidStake(suite.ctx, addr1)
+	suite.app.LegacyClaimKeeper().AfterLiquidStake(suite.ctx, addr1)
 
 	// Get balances for the account
 	balances := suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1)
@@ -79,7 +79,7 @@ func (suite *KeeperTestSuite) TestHookBeforeAirdropStart() {
 
 	airdropStartTime := time.Now().Add(time.Hour)
 
-	err := suite.app.ClaimKeeper.SetParams(suite.ctx, types.Params{
+	err := suite.app.LegacyClaimKeeper().SetParams(suite.ctx, types.Params{
 		Airdrops: []*types.Airdrop{
 			{
 				AirdropIdentifier:  types.DefaultAirdropIdentifier,
@@ -105,26 +105,26 @@ func (suite *KeeperTestSuite) TestHookBeforeAirdropStart() {
 		},
 	}
 	suite.app.AccountKeeper.SetAccount(suite.ctx, authtypes.NewBaseAccount(addr1, nil, 0, 0))
-	err = suite.app.ClaimKeeper.SetClaimRecordsWithWeights(suite.ctx, claimRecords)
+	err = suite.app.LegacyClaimKeeper().SetClaimRecordsWithWeights(suite.ctx, claimRecords)
 	suite.Require().NoError(err)
 
-	coins, err := suite.app.ClaimKeeper.GetUserTotalClaimable(suite.ctx, addr1, "stride", false)
+	coins, err := suite.app.LegacyClaimKeeper().GetUserTotalClaimable(suite.ctx, addr1, "stride", false)
 	suite.NoError(err)
 	// Now, it is before starting air drop, so this value should return the empty coins
 	suite.True(coins.Empty())
 
-	coins, err = suite.app.ClaimKeeper.GetClaimableAmountForAction(suite.ctx, addr1, types.ACTION_FREE, "stride", false)
+	coins, err = suite.app.LegacyClaimKeeper().GetClaimableAmountForAction(suite.ctx, addr1, types.ACTION_FREE, "stride", false)
 	suite.NoError(err)
 	// Now, it is before starting air drop, so this value should return the empty coins
 	suite.True(coins.Empty())
 
-	err = suite.app.ClaimKeeper.AfterDelegationModified(suite.ctx, addr1, val1)
+	err = suite.app.LegacyClaimKeeper().AfterDelegationModified(suite.ctx, addr1, val1)
 	suite.NoError(err)
 	balances := suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1)
 	// Now, it is before starting air drop, so claim module should not send the balances to the user after swap.
 	suite.True(balances.Empty())
 
-	suite.app.ClaimKeeper.AfterLiquidStake(suite.ctx.WithBlockTime(airdropStartTime), addr1)
+	suite.app.LegacyClaimKeeper().AfterLiquidStake(suite.ctx.WithBlockTime(airdropStartTime), addr1)
 	balances = suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1)
 	// Now, it is the time for air drop, so claim module should send the balances to the user after liquid stake.
 	claimableAmountForLiquidStake := sdk.NewDecWithPrec(60, 2).
@@ -154,11 +154,11 @@ func (suite *KeeperTestSuite) TestBalancesAfterAccountConversion() {
 		},
 	}
 
-	err = suite.app.ClaimKeeper.SetClaimRecordsWithWeights(suite.ctx, claimRecords)
+	err = suite.app.LegacyClaimKeeper().SetClaimRecordsWithWeights(suite.ctx, claimRecords)
 	suite.Require().NoError(err)
 
 	// check if original account tokens are not affected after stride vesting
-	_, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx, addr, types.ACTION_DELEGATE_STAKE, "stride")
+	_, err = suite.app.LegacyClaimKeeper().ClaimCoinsForAction(suite.ctx, addr, types.ACTION_DELEGATE_STAKE, "stride")
 	suite.Require().NoError(err)
 	claimableAmountForStake := sdk.NewDecWithPrec(20, 2).
 		Mul(sdk.NewDec(100_000_000 - initialBal)).
@@ -193,11 +193,11 @@ func (suite *KeeperTestSuite) TestClaimAccountTypes() {
 		},
 	}
 
-	err = suite.app.ClaimKeeper.SetClaimRecordsWithWeights(suite.ctx, claimRecords)
+	err = suite.app.LegacyClaimKeeper().SetClaimRecordsWithWeights(suite.ctx, claimRecords)
 	suite.Require().NoError(err)
 
 	// check if original account tokens are not affected after stride vesting
-	_, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx, addr, types.ACTION_DELEGATE_STAKE, "stride")
+	_, err = suite.app.LegacyClaimKeeper().ClaimCoinsForAction(suite.ctx, addr, types.ACTION_DELEGATE_STAKE, "stride")
 	suite.Require().NoError(err)
 	claimableAmountForStake := sdk.NewDecWithPrec(20, 2).
 		Mul(sdk.NewDec(100_000_000 - initialBal)).
@@ -243,13 +243,13 @@ func (suite *KeeperTestSuite) TestClaimAccountTypes() {
 				AirdropIdentifier: types.DefaultAirdropIdentifier,
 			},
 		}
-		err = suite.app.ClaimKeeper.SetClaimRecordsWithWeights(suite.ctx, claimRecords)
+		err = suite.app.LegacyClaimKeeper().SetClaimRecordsWithWeights(suite.ctx, claimRecords)
 		suite.Require().NoError(err)
 	}
 
 	// Try to claim tokens with each account type
 	for _, addr := range []sdk.AccAddress{addr2, addr3, addr4} {
-		_, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx, addr, types.ACTION_DELEGATE_STAKE, "stride")
+		_, err = suite.app.LegacyClaimKeeper().ClaimCoinsForAction(suite.ctx, addr, types.ACTION_DELEGATE_STAKE, "stride")
 		suite.Require().ErrorContains(err, "only BaseAccount and StridePeriodicVestingAccount can claim")
 	}
 }
@@ -286,23 +286,23 @@ func (suite *KeeperTestSuite) TestAirdropFlow() {
 		},
 	}
 
-	err := suite.app.ClaimKeeper.SetClaimRecordsWithWeights(suite.ctx, claimRecords)
+	err := suite.app.LegacyClaimKeeper().SetClaimRecordsWithWeights(suite.ctx, claimRecords)
 	suite.Require().NoError(err)
 
-	coins, err := suite.app.ClaimKeeper.GetUserTotalClaimable(suite.ctx, addr1, "stride", false)
+	coins, err := suite.app.LegacyClaimKeeper().GetUserTotalClaimable(suite.ctx, addr1, "stride", false)
 	suite.Require().NoError(err)
 	suite.Require().Equal(coins.String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50_000_000)).String())
 
-	coins, err = suite.app.ClaimKeeper.GetUserTotalClaimable(suite.ctx, addr2, "stride", false)
+	coins, err = suite.app.LegacyClaimKeeper().GetUserTotalClaimable(suite.ctx, addr2, "stride", false)
 	suite.Require().NoError(err)
 	suite.Require().Equal(coins.String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50_000_000)).String())
 
-	coins, err = suite.app.ClaimKeeper.GetUserTotalClaimable(suite.ctx, addr3, "stride", false)
+	coins, err = suite.app.LegacyClaimKeeper().GetUserTotalClaimable(suite.ctx, addr3, "stride", false)
 	suite.Require().NoError(err)
 	suite.Require().Equal(coins, sdk.Coins{})
 
 	// get rewards amount for free
-	coins, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx, addr1, types.ACTION_FREE, "stride")
+	coins, err = suite.app.LegacyClaimKeeper().ClaimCoinsForAction(suite.ctx, addr1, types.ACTION_FREE, "stride")
 	suite.Require().NoError(err)
 	claimableAmountForFree := sdk.NewDecWithPrec(20, 2).
 		Mul(sdk.NewDec(100_000_000)).
@@ -311,7 +311,7 @@ func (suite *KeeperTestSuite) TestAirdropFlow() {
 	suite.Require().Equal(coins.String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, claimableAmountForFree)).String())
 
 	// get rewards amount for stake
-	coins, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx, addr1, types.ACTION_DELEGATE_STAKE, "stride")
+	coins, err = suite.app.LegacyClaimKeeper().ClaimCoinsForAction(suite.ctx, addr1, types.ACTION_DELEGATE_STAKE, "stride")
 	suite.Require().NoError(err)
 	claimableAmountForStake := sdk.NewDecWithPrec(20, 2).
 		Mul(sdk.NewDec(100_000_000)).
@@ -320,7 +320,7 @@ func (suite *KeeperTestSuite) TestAirdropFlow() {
 	suite.Require().Equal(coins.String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, claimableAmountForStake)).String())
 
 	// get rewards amount for liquid stake
-	coins, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx, addr1, types.ACTION_LIQUID_STAKE, "stride")
+	coins, err = suite.app.LegacyClaimKeeper().ClaimCoinsForAction(suite.ctx, addr1, types.ACTION_LIQUID_STAKE, "stride")
 	suite.Require().NoError(err)
 	claimableAmountForLiquidStake := sdk.NewDecWithPrec(60, 2).
 		Mul(sdk.NewDec(100_000_000)).
@@ -339,9 +339,9 @@ func (suite *KeeperTestSuite) TestAirdropFlow() {
 
 	// check if claims don't vest after initial period of 3 months
 	suite.ctx = suite.ctx.WithBlockTime(time.Now().Add(types.DefaultVestingInitialPeriod))
-	err = suite.app.ClaimKeeper.ResetClaimStatus(suite.ctx, "stride")
+	err = suite.app.LegacyClaimKeeper().ResetClaimStatus(suite.ctx, "stride")
 	suite.Require().NoError(err)
-	_, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx, addr1, types.ACTION_LIQUID_STAKE, "stride")
+	_, err = suite.app.LegacyClaimKeeper().ClaimCoinsForAction(suite.ctx, addr1, types.ACTION_LIQUID_STAKE, "stride")
 	suite.Require().NoError(err)
 	claimableAmountForLiquidStake2 := sdk.NewDecWithPrec(60, 2).
 		Mul(sdk.NewDec(50_000_000)).
@@ -354,7 +354,7 @@ func (suite *KeeperTestSuite) TestAirdropFlow() {
 	suite.Require().Equal(coins.String(), coinsSpendable.String())
 
 	// end airdrop
-	err = suite.app.ClaimKeeper.EndAirdrop(suite.ctx, "stride")
+	err = suite.app.LegacyClaimKeeper().EndAirdrop(suite.ctx, "stride")
 	suite.Require().NoError(err)
 }
 
@@ -394,26 +394,26 @@ func (suite *KeeperTestSuite) TestMultiChainAirdropFlow() {
 		},
 	}
 
-	err := suite.app.ClaimKeeper.SetClaimRecordsWithWeights(suite.ctx, claimRecords)
+	err := suite.app.LegacyClaimKeeper().SetClaimRecordsWithWeights(suite.ctx, claimRecords)
 	suite.Require().NoError(err)
 
-	coins, err := suite.app.ClaimKeeper.GetUserTotalClaimable(suite.ctx, addr1, "stride", false)
+	coins, err := suite.app.LegacyClaimKeeper().GetUserTotalClaimable(suite.ctx, addr1, "stride", false)
 	suite.Require().NoError(err)
 	suite.Require().Equal(coins.String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000_000)).String())
 
-	coins, err = suite.app.ClaimKeeper.GetUserTotalClaimable(suite.ctx, addr2, "juno", false)
+	coins, err = suite.app.LegacyClaimKeeper().GetUserTotalClaimable(suite.ctx, addr2, "juno", false)
 	suite.Require().NoError(err)
 	suite.Require().Equal(coins.String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)).String())
 
-	identifiers := suite.app.ClaimKeeper.GetAirdropIdentifiersForUser(suite.ctx, addr1)
+	identifiers := suite.app.LegacyClaimKeeper().GetAirdropIdentifiersForUser(suite.ctx, addr1)
 	suite.Require().Equal(identifiers[0], types.DefaultAirdropIdentifier)
 	suite.Require().Equal(identifiers[1], "osmosis")
 
 	// get rewards amount for free (stride, osmosis addresses)
-	coins, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx, addr1, types.ACTION_FREE, "stride")
+	coins, err = suite.app.LegacyClaimKeeper().ClaimCoinsForAction(suite.ctx, addr1, types.ACTION_FREE, "stride")
 	suite.Require().NoError(err)
 
-	coins1, err := suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx, addr1, types.ACTION_FREE, "osmosis")
+	coins1, err := suite.app.LegacyClaimKeeper().ClaimCoinsForAction(suite.ctx, addr1, types.ACTION_FREE, "osmosis")
 	suite.Require().NoError(err)
 
 	claimableAmountForFree := sdk.NewDecWithPrec(20, 2).
@@ -423,10 +423,10 @@ func (suite *KeeperTestSuite) TestMultiChainAirdropFlow() {
 	suite.Require().Equal(coins1.String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, claimableAmountForFree)).String())
 
 	// get rewards amount for stake (stride, osmosis addresses)
-	coins, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx, addr1, types.ACTION_DELEGATE_STAKE, "stride")
+	coins, err = suite.app.LegacyClaimKeeper().ClaimCoinsForAction(suite.ctx, addr1, types.ACTION_DELEGATE_STAKE, "stride")
 	suite.Require().NoError(err)
 
-	coins1, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx, addr1, types.ACTION_DELEGATE_STAKE, "osmosis")
+	coins1, err = suite.app.LegacyClaimKeeper().ClaimCoinsForAction(suite.ctx, addr1, types.ACTION_DELEGATE_STAKE, "osmosis")
 	suite.Require().NoError(err)
 
 	claimableAmountForStake := sdk.NewDecWithPrec(20, 2).
@@ -436,10 +436,10 @@ func (suite *KeeperTestSuite) TestMultiChainAirdropFlow() {
 	suite.Require().Equal(coins1.String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, claimableAmountForStake)).String())
 
 	// get rewards amount for liquid stake (stride, osmosis addresses)
-	coins, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx, addr1, types.ACTION_LIQUID_STAKE, "stride")
+	coins, err = suite.app.LegacyClaimKeeper().ClaimCoinsForAction(suite.ctx, addr1, types.ACTION_LIQUID_STAKE, "stride")
 	suite.Require().NoError(err)
 
-	coins1, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx, addr1, types.ACTION_LIQUID_STAKE, "osmosis")
+	coins1, err = suite.app.LegacyClaimKeeper().ClaimCoinsForAction(suite.ctx, addr1, types.ACTION_LIQUID_STAKE, "osmosis")
 	suite.Require().NoError(err)
 
 	claimableAmountForLiquidStake := sdk.NewDecWithPrec(60, 2).
@@ -453,37 +453,37 @@ func (suite *KeeperTestSuite) TestMultiChainAirdropFlow() {
 	suite.Require().Equal(coins.String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, (claimableAmountForFree+claimableAmountForStake+claimableAmountForLiquidStake)*2)).String())
 
 	// Verify that the max claimable amount is unchanged, even after claims
-	maxCoins, err := suite.app.ClaimKeeper.GetUserTotalClaimable(suite.ctx, addr1, "stride", true)
+	maxCoins, err := suite.app.LegacyClaimKeeper().GetUserTotalClaimable(suite.ctx, addr1, "stride", true)
 	suite.Require().NoError(err)
 	suite.Require().Equal(maxCoins.String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000_000)).String())
-	claimableCoins, err := suite.app.ClaimKeeper.GetUserTotalClaimable(suite.ctx, addr1, "stride", false)
+	claimableCoins, err := suite.app.LegacyClaimKeeper().GetUserTotalClaimable(suite.ctx, addr1, "stride", false)
 	suite.Require().NoError(err)
 	suite.Require().Equal(claimableCoins.String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)).String())
 
 	// check if stride and osmosis airdrops ended properly
 	suite.ctx = suite.ctx.WithBlockHeight(1000)
-	suite.app.ClaimKeeper.EndBlocker(suite.ctx.WithBlockTime(time.Now().Add(types.DefaultAirdropDuration)))
+	suite.app.LegacyClaimKeeper().EndBlocker(suite.ctx.WithBlockTime(time.Now().Add(types.DefaultAirdropDuration)))
 	// for stride
-	weight, err := suite.app.ClaimKeeper.GetTotalWeight(suite.ctx, types.DefaultAirdropIdentifier)
+	weight, err := suite.app.LegacyClaimKeeper().GetTotalWeight(suite.ctx, types.DefaultAirdropIdentifier)
 	suite.Require().NoError(err)
 	suite.Require().Equal(weight, sdk.ZeroDec())
 
-	records := suite.app.ClaimKeeper.GetClaimRecords(suite.ctx, types.DefaultAirdropIdentifier)
+	records := suite.app.LegacyClaimKeeper().GetClaimRecords(suite.ctx, types.DefaultAirdropIdentifier)
 	suite.Require().Equal(0, len(records))
 
 	// for osmosis
-	weight, err = suite.app.ClaimKeeper.GetTotalWeight(suite.ctx, "osmosis")
+	weight, err = suite.app.LegacyClaimKeeper().GetTotalWeight(suite.ctx, "osmosis")
 	suite.Require().NoError(err)
 	suite.Require().Equal(weight, sdk.ZeroDec())
 
-	records = suite.app.ClaimKeeper.GetClaimRecords(suite.ctx, "osmosis")
+	records = suite.app.LegacyClaimKeeper().GetClaimRecords(suite.ctx, "osmosis")
 	suite.Require().Equal(0, len(records))
 
 	//*********************** End of Stride, Osmosis airdrop *************************
 
 	// claim airdrops for juno users after ending stride airdrop
 	// get rewards amount for stake (juno user)
-	coins, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx.WithBlockTime(time.Now().Add(time.Hour)), addr2, types.ACTION_DELEGATE_STAKE, "juno")
+	coins, err = suite.app.LegacyClaimKeeper().ClaimCoinsForAction(suite.ctx.WithBlockTime(time.Now().Add(time.Hour)), addr2, types.ACTION_DELEGATE_STAKE, "juno")
 	suite.Require().NoError(err)
 	claimableAmountForStake = sdk.NewDecWithPrec(20, 2).
 		Mul(sdk.NewDec(100_000_000)).
@@ -491,7 +491,7 @@ func (suite *KeeperTestSuite) TestMultiChainAirdropFlow() {
 	suite.Require().Equal(coins.String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, claimableAmountForStake)).String())
 
 	// get rewards amount for liquid stake (juno user)
-	coins, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx.WithBlockTime(time.Now().Add(time.Hour)), addr2, types.ACTION_LIQUID_STAKE, "juno")
+	coins, err = suite.app.LegacyClaimKeeper().ClaimCoinsForAction(suite.ctx.WithBlockTime(time.Now().Add(time.Hour)), addr2, types.ACTION_LIQUID_STAKE, "juno")
 	suite.Require().NoError(err)
 	claimableAmountForLiquidStake = sdk.NewDecWithPrec(60, 2).
 		Mul(sdk.NewDec(100_000_000)).
@@ -504,9 +504,9 @@ func (suite *KeeperTestSuite) TestMultiChainAirdropFlow() {
 
 	// after 3 years, juno users should be still able to claim
 	suite.ctx = suite.ctx.WithBlockTime(time.Now().Add(types.DefaultAirdropDuration))
-	err = suite.app.ClaimKeeper.ResetClaimStatus(suite.ctx, "juno")
+	err = suite.app.LegacyClaimKeeper().ResetClaimStatus(suite.ctx, "juno")
 	suite.Require().NoError(err)
-	coins, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx, addr2, types.ACTION_FREE, "juno")
+	coins, err = suite.app.LegacyClaimKeeper().ClaimCoinsForAction(suite.ctx, addr2, types.ACTION_FREE, "juno")
 	suite.Require().NoError(err)
 
 	claimableAmountForFree = sdk.NewDecWithPrec(20, 2).
@@ -516,18 +516,18 @@ func (suite *KeeperTestSuite) TestMultiChainAirdropFlow() {
 
 	// after 3 years + 1 hour, juno users shouldn't be able to claim anymore
 	suite.ctx = suite.ctx.WithBlockTime(time.Now().Add(time.Hour).Add(types.DefaultAirdropDuration))
-	suite.app.ClaimKeeper.EndBlocker(suite.ctx)
-	err = suite.app.ClaimKeeper.ResetClaimStatus(suite.ctx, "juno")
+	suite.app.LegacyClaimKeeper().EndBlocker(suite.ctx)
+	err = suite.app.LegacyClaimKeeper().ResetClaimStatus(suite.ctx, "juno")
 	suite.Require().NoError(err)
-	coins, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx.WithBlockTime(time.Now().Add(time.Hour).Add(types.DefaultAirdropDuration)), addr2, types.ACTION_FREE, "juno")
+	coins, err = suite.app.LegacyClaimKeeper().ClaimCoinsForAction(suite.ctx.WithBlockTime(time.Now().Add(time.Hour).Add(types.DefaultAirdropDuration)), addr2, types.ACTION_FREE, "juno")
 	suite.Require().NoError(err)
 	suite.Require().Equal(coins.String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)).String())
 
-	weight, err = suite.app.ClaimKeeper.GetTotalWeight(suite.ctx, types.DefaultAirdropIdentifier)
+	weight, err = suite.app.LegacyClaimKeeper().GetTotalWeight(suite.ctx, types.DefaultAirdropIdentifier)
 	suite.Require().NoError(err)
 	suite.Require().Equal(weight, sdk.ZeroDec())
 
-	records = suite.app.ClaimKeeper.GetClaimRecords(suite.ctx, types.DefaultAirdropIdentifier)
+	records = suite.app.LegacyClaimKeeper().GetClaimRecords(suite.ctx, types.DefaultAirdropIdentifier)
 	suite.Require().Equal(0, len(records))
 	//*********************** End of Juno airdrop *************************
 }
@@ -567,7 +567,7 @@ func (suite *KeeperTestSuite) TestGetClaimStatus() {
 			{AirdropIdentifier: "stargaze"},
 		},
 	}
-	err := suite.app.ClaimKeeper.SetParams(suite.ctx, airdrops)
+	err := suite.app.LegacyClaimKeeper().SetParams(suite.ctx, airdrops)
 	suite.Require().NoError(err)
 
 	// For the given user, add 4 claim records
@@ -597,7 +597,7 @@ func (suite *KeeperTestSuite) TestGetClaimStatus() {
 		},
 	}
 	for _, claimRecord := range claimRecords {
-		err := suite.app.ClaimKeeper.SetClaimRecord(suite.ctx, claimRecord)
+		err := suite.app.LegacyClaimKeeper().SetClaimRecord(suite.ctx, claimRecord)
 		suite.Require().NoError(err)
 	}
 
@@ -608,7 +608,7 @@ func (suite *KeeperTestSuite) TestGetClaimStatus() {
 	}
 
 	// Confirm status lines up with expectations
-	status, err := suite.app.ClaimKeeper.GetClaimStatus(suite.ctx, sdk.MustAccAddressFromBech32(address))
+	status, err := suite.app.LegacyClaimKeeper().GetClaimStatus(suite.ctx, sdk.MustAccAddressFromBech32(address))
 	suite.Require().NoError(err, "no error expected when getting claim status")
 
 	suite.Require().Equal(len(expectedClaimStatus), len(status), "number of airdrops")
@@ -647,7 +647,7 @@ func (suite *KeeperTestSuite) TestGetClaimMetadata() {
 	}
 
 	// Get claim metadata
-	claimMetadataList := suite.app.ClaimKeeper.GetClaimMetadata(suite.ctx)
+	claimMetadataList := suite.app.LegacyClaimKeeper().GetClaimMetadata(suite.ctx)
 	suite.Require().NotNil(claimMetadataList)
 	suite.Require().Len(claimMetadataList, 3)
 
@@ -695,7 +695,7 @@ func (suite *KeeperTestSuite) SetupUpdateAirdropAddressChangeTests() UpdateAirdr
 		AirdropIdentifier: airdropId,
 	}
 
-	err := suite.app.ClaimKeeper.SetClaimRecordsWithWeights(suite.ctx, []types.ClaimRecord{claimRecord})
+	err := suite.app.LegacyClaimKeeper().SetClaimRecordsWithWeights(suite.ctx, []types.ClaimRecord{claimRecord})
 	suite.Require().NoError(err)
 
 	// Create stride account so that it can claim
@@ -721,59 +721,59 @@ func (suite *KeeperTestSuite) TestUpdateAirdropAddress() {
 	suite.Require().True(strings.HasPrefix(tc.recordKey.String(), "stride"), "evmos address should start with stride")
 
 	// Confirm that the user (using the old key'd address) has claimable tokens
-	coins, err := suite.app.ClaimKeeper.GetUserTotalClaimable(suite.ctx, tc.recordKey, tc.airdropId, false)
+	coins, err := suite.app.LegacyClaimKeeper().GetUserTotalClaimable(suite.ctx, tc.recordKey, tc.airdropId, false)
 	suite.Require().NoError(err)
 	suite.Require().Equal(coins.String(), airdropClaimCoins.String())
 
 	// verify that we can't yet claim with the stride address (because it hasn't been remapped yet)
-	coins, err = suite.app.ClaimKeeper.GetUserTotalClaimable(suite.ctx, strideAccAddress, tc.airdropId, false)
+	coins, err = suite.app.LegacyClaimKeeper().GetUserTotalClaimable(suite.ctx, strideAccAddress, tc.airdropId, false)
 	suite.Require().NoError(err)
 	suite.Require().Equal(coins, sdk.NewCoins(), "stride address should claim 0 coins before update", strideAccAddress)
 
-	claims, err := suite.app.ClaimKeeper.GetClaimStatus(suite.ctx, strideAccAddress)
+	claims, err := suite.app.LegacyClaimKeeper().GetClaimStatus(suite.ctx, strideAccAddress)
 	suite.Require().NoError(err)
 	suite.Require().Empty(claims, "stride address should have 0 claim records before update")
 
 	// verify that we can claim the airdrop with the current airdrop key (which represents the incorrect stride address)
-	coins, err = suite.app.ClaimKeeper.GetUserTotalClaimable(suite.ctx, tc.recordKey, tc.airdropId, false)
+	coins, err = suite.app.LegacyClaimKeeper().GetUserTotalClaimable(suite.ctx, tc.recordKey, tc.airdropId, false)
 	suite.Require().NoError(err)
 	suite.Require().Equal(coins, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewIntFromUint64(100_000_000))), "parsed evmos address should be allowed to claim")
 
-	claims, err = suite.app.ClaimKeeper.GetClaimStatus(suite.ctx, tc.recordKey)
+	claims, err = suite.app.LegacyClaimKeeper().GetClaimStatus(suite.ctx, tc.recordKey)
 	suite.Require().NoError(err)
 
 	properClaims := []types.ClaimStatus{{AirdropIdentifier: tc.airdropId, Claimed: false}}
 	suite.Require().Equal(claims, properClaims, "evmos address should have 1 claim record before update")
 
 	// update the stride address so that there's now a correct mapping from evmos -> stride address
-	err = suite.app.ClaimKeeper.UpdateAirdropAddress(suite.ctx, tc.recordKey.String(), tc.strideAddress, tc.airdropId)
+	err = suite.app.LegacyClaimKeeper().UpdateAirdropAddress(suite.ctx, tc.recordKey.String(), tc.strideAddress, tc.airdropId)
 	suite.Require().NoError(err, "airdrop update address should succeed")
 
 	// verify that the old key CAN NOT claim after the update
-	coins, err = suite.app.ClaimKeeper.GetUserTotalClaimable(suite.ctx, tc.recordKey, tc.airdropId, false)
+	coins, err = suite.app.LegacyClaimKeeper().GetUserTotalClaimable(suite.ctx, tc.recordKey, tc.airdropId, false)
 	suite.Require().NoError(err)
 	suite.Require().Equal(coins, sdk.NewCoins(), "evmos address should claim 0 coins after update", tc.recordKey)
 
-	claims, err = suite.app.ClaimKeeper.GetClaimStatus(suite.ctx, tc.recordKey)
+	claims, err = suite.app.LegacyClaimKeeper().GetClaimStatus(suite.ctx, tc.recordKey)
 	suite.Require().NoError(err)
 	suite.Require().Empty(claims, "evmos address should have 0 claim records after update")
 
 	// verify that the stride address CAN claim after the update
-	coins, err = suite.app.ClaimKeeper.GetUserTotalClaimable(suite.ctx, strideAccAddress, tc.airdropId, false)
+	coins, err = suite.app.LegacyClaimKeeper().GetUserTotalClaimable(suite.ctx, strideAccAddress, tc.airdropId, false)
 	suite.Require().NoError(err)
 	suite.Require().Equal(coins, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewIntFromUint64(100_000_000))), "stride address should be allowed to claim after update")
 
-	claims, err = suite.app.ClaimKeeper.GetClaimStatus(suite.ctx, strideAccAddress)
+	claims, err = suite.app.LegacyClaimKeeper().GetClaimStatus(suite.ctx, strideAccAddress)
 	suite.Require().NoError(err)
 	suite.Require().Equal(claims, properClaims, "stride address should have 1 claim record after update")
 
 	// claim with the Stride address
-	coins, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx, strideAccAddress, types.ACTION_FREE, tc.airdropId)
+	coins, err = suite.app.LegacyClaimKeeper().ClaimCoinsForAction(suite.ctx, strideAccAddress, types.ACTION_FREE, tc.airdropId)
 	suite.Require().NoError(err)
 	suite.Require().Equal(coins, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewIntFromUint64(20_000_000))), "stride address should be allowed to claim after update")
 
 	// verify Stride address can't claim again
-	coins, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx, strideAccAddress, types.ACTION_FREE, tc.airdropId)
+	coins, err = suite.app.LegacyClaimKeeper().ClaimCoinsForAction(suite.ctx, strideAccAddress, types.ACTION_FREE, tc.airdropId)
 	suite.Require().NoError(err)
 	suite.Require().Equal(coins, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewIntFromUint64(0))), "can't claim twice after update")
 
@@ -786,7 +786,7 @@ func (suite *KeeperTestSuite) TestUpdateAirdropAddress_AirdropNotFound() {
 	tc := suite.SetupUpdateAirdropAddressChangeTests()
 
 	// update the address
-	err := suite.app.ClaimKeeper.UpdateAirdropAddress(suite.ctx, tc.evmosAddress, tc.strideAddress, "stride")
+	err := suite.app.LegacyClaimKeeper().UpdateAirdropAddress(suite.ctx, tc.evmosAddress, tc.strideAddress, "stride")
 	suite.Require().Error(err, "airdrop address update should fail with incorrect airdrop id")
 }
 
@@ -795,7 +795,7 @@ func (suite *KeeperTestSuite) TestUpdateAirdropAddress_StrideAddressIncorrect()
 
 	// update the address
 	incorrectStrideAddress := tc.strideAddress + "a"
-	err := suite.app.ClaimKeeper.UpdateAirdropAddress(suite.ctx, tc.evmosAddress, incorrectStrideAddress, tc.airdropId)
+	err := suite.app.LegacyClaimKeeper().UpdateAirdropAddress(suite.ctx, tc.evmosAddress, incorrectStrideAddress, tc.airdropId)
 	suite.Require().Error(err, "airdrop address update should fail with incorrect stride address")
 }
 
@@ -803,22 +803,22 @@ func (suite *KeeperTestSuite) TestUpdateAirdropAddress_HostAddressIncorrect() {
 	tc := suite.SetupUpdateAirdropAddressChangeTests()
 
 	// should fail with a clearly wrong host address
-	err := suite.app.ClaimKeeper.UpdateAirdropAddress(suite.ctx, "evmostest", tc.strideAddress, tc.airdropId)
+	err := suite.app.LegacyClaimKeeper().UpdateAirdropAddress(suite.ctx, "evmostest", tc.strideAddress, tc.airdropId)
 	suite.Require().Error(err, "airdrop address update should fail with clearly incorrect host address")
 
 	// should fail if host address is not a stride address
-	err = suite.app.ClaimKeeper.UpdateAirdropAddress(suite.ctx, tc.evmosAddress, tc.strideAddress, tc.airdropId)
+	err = suite.app.LegacyClaimKeeper().UpdateAirdropAddress(suite.ctx, tc.evmosAddress, tc.strideAddress, tc.airdropId)
 	suite.Require().Error(err, "airdrop address update should fail with host address in wrong zone")
 
 	// should fail is host address (record key) is slightly incorrect
 	recordKeyString := tc.recordKey.String()
 	modifiedAddress := recordKeyString[:len(recordKeyString)-1] + "a"
-	err = suite.app.ClaimKeeper.UpdateAirdropAddress(suite.ctx, modifiedAddress, tc.strideAddress, tc.airdropId)
+	err = suite.app.LegacyClaimKeeper().UpdateAirdropAddress(suite.ctx, modifiedAddress, tc.strideAddress, tc.airdropId)
 	suite.Require().Error(err, "airdrop address update should fail with incorrect host address")
 
 	// should fail is host address is correct but doesn't have a claimrecord
 	randomStrideAddress := "stride16qv5wnkwwvd2qj5ttwznmngc09cet8l9zhm2ru"
-	err = suite.app.ClaimKeeper.UpdateAirdropAddress(suite.ctx, randomStrideAddress, tc.strideAddress, tc.airdropId)
+	err = suite.app.LegacyClaimKeeper().UpdateAirdropAddress(suite.ctx, randomStrideAddress, tc.strideAddress, tc.airdropId)
 	suite.Require().Error(err, "airdrop address update should fail with not present host address")
 }
 
@@ -834,17 +834,17 @@ func (suite *KeeperTestSuite) TestGetAirdropByChainId() {
 			ChainId:           chainId,
 		})
 	}
-	err := suite.app.ClaimKeeper.SetParams(suite.ctx, types.Params{Airdrops: airdrops})
+	err := suite.app.LegacyClaimKeeper().SetParams(suite.ctx, types.Params{Airdrops: airdrops})
 	suite.Require().NoError(err, "no error expected when setting airdrops")
 
 	// Lookup each airdrop by chain-id
 	for i, expected := range airdrops {
-		actual, found := suite.app.ClaimKeeper.GetAirdropByChainId(suite.ctx, expected.ChainId)
+		actual, found := suite.app.LegacyClaimKeeper().GetAirdropByChainId(suite.ctx, expected.ChainId)
 		suite.Require().True(found, "should have found airdrop %d", i)
 		suite.Require().Equal(expected.AirdropIdentifier, actual.AirdropIdentifier, "airdrop identifier for %d", i)
 	}
 
 	// Lookup a non-existent airdrop - it should not be found
-	_, found := suite.app.ClaimKeeper.GetAirdropByChainId(suite.ctx, "fake_chain_id")
+	_, found := suite.app.LegacyClaimKeeper().GetAirdropByChainId(suite.ctx, "fake_chain_id")
 	suite.Require().False(found, "fake_chain_id should not have been found")
 }
@@ -89,6 +89,12 @@ func (k Keeper) Hooks() Hooks {
 func (h Hooks) AfterLiquidStake(ctx sdk.Context, addr sdk.AccAddress) {
 	h.k.AfterLiquidStake(ctx, addr)
 }
+func (h Hooks) AfterRewardCollectorAuctioned(ctx sdk.Context) {}
+
+// GetModuleName returns the module name, used by the epochs module for events and telemetry
+func (h Hooks) GetModuleName() string {
+	return types.ModuleName
+}
 
 // epochs hooks
 func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInfo) {
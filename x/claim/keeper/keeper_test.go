@@ -76,7 +76,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 
 	// Stride airdrop
 	airdropStartTime := time.Now()
-	err = suite.app.ClaimKeeper.CreateAirdropAndEpoch(suite.ctx, types.MsgCreateAirdrop{
+	err = suite.app.LegacyClaimKeeper().CreateAirdropAndEpoch(suite.ctx, types.MsgCreateAirdrop{
 		Distributor:      addr1.String(),
 		Identifier:       types.DefaultAirdropIdentifier,
 		ChainId:          "stride-1",
@@ -90,7 +90,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 	}
 
 	// Juno airdrop
-	err = suite.app.ClaimKeeper.CreateAirdropAndEpoch(suite.ctx, types.MsgCreateAirdrop{
+	err = suite.app.LegacyClaimKeeper().CreateAirdropAndEpoch(suite.ctx, types.MsgCreateAirdrop{
 		Distributor: addr2.String(),
 		Identifier:  "juno",
 		ChainId:     "juno-1",
@@ -103,7 +103,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 	}
 
 	// Osmosis airdrop
-	err = suite.app.ClaimKeeper.CreateAirdropAndEpoch(suite.ctx, types.MsgCreateAirdrop{
+	err = suite.app.LegacyClaimKeeper().CreateAirdropAndEpoch(suite.ctx, types.MsgCreateAirdrop{
 		Distributor: addr3.String(),
 		Identifier:  "osmosis",
 		ChainId:     "osmosis-1",
@@ -10,7 +10,7 @@ import (
 
 func (suite *KeeperTestSuite) TestSetAirdropAllocationsForMultiAirdrops() {
 	suite.SetupTest()
-	msgServer := keeper.NewMsgServerImpl(suite.app.ClaimKeeper)
+	msgServer := keeper.NewMsgServerImpl(suite.app.LegacyClaimKeeper())
 
 	// Set initial allocations for each airdrop
 	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
@@ -70,18 +70,18 @@ func (suite *KeeperTestSuite) TestSetAirdropAllocationsForMultiAirdrops() {
 		suite.Require().NoError(err)
 	}
 
-	totalWeightStride, err := suite.app.ClaimKeeper.GetTotalWeight(suite.ctx, types.DefaultAirdropIdentifier)
+	totalWeightStride, err := suite.app.LegacyClaimKeeper().GetTotalWeight(suite.ctx, types.DefaultAirdropIdentifier)
 	suite.Require().NoError(err)
 	suite.Require().Equal(totalWeightStride, sdk.NewDecWithPrec(90, 2))
 
-	totalWeightJuno, err := suite.app.ClaimKeeper.GetTotalWeight(suite.ctx, "juno")
+	totalWeightJuno, err := suite.app.LegacyClaimKeeper().GetTotalWeight(suite.ctx, "juno")
 	suite.Require().NoError(err)
 	suite.Require().Equal(totalWeightJuno, sdk.NewDecWithPrec(50, 2))
 
-	claimRecords := suite.app.ClaimKeeper.GetClaimRecords(suite.ctx, types.DefaultAirdropIdentifier)
+	claimRecords := suite.app.LegacyClaimKeeper().GetClaimRecords(suite.ctx, types.DefaultAirdropIdentifier)
 	suite.Require().Equal(2, len(claimRecords))
 
-	claimRecords = suite.app.ClaimKeeper.GetClaimRecords(suite.ctx, "juno")
+	claimRecords = suite.app.LegacyClaimKeeper().GetClaimRecords(suite.ctx, "juno")
 	suite.Require().Equal(2, len(claimRecords))
 
 	// Multiple airdrop allocations for same user should be ignored
@@ -110,10 +110,10 @@ func (suite *KeeperTestSuite) TestSetAirdropAllocationsForMultiAirdrops() {
 		suite.Require().NoError(err)
 	}
 
-	claimRecords = suite.app.ClaimKeeper.GetClaimRecords(suite.ctx, types.DefaultAirdropIdentifier)
+	claimRecords = suite.app.LegacyClaimKeeper().GetClaimRecords(suite.ctx, types.DefaultAirdropIdentifier)
 	suite.Require().Equal(2, len(claimRecords))
 
-	claimRecords = suite.app.ClaimKeeper.GetClaimRecords(suite.ctx, "juno")
+	claimRecords = suite.app.LegacyClaimKeeper().GetClaimRecords(suite.ctx, "juno")
 	suite.Require().Equal(3, len(claimRecords))
 }
 
@@ -131,7 +131,7 @@ func getValidCreateEvmosAirdropMsg(ctx sdk.Context) types.MsgCreateAirdrop {
 
 func (suite *KeeperTestSuite) TestCreateAirdrop_Successful() {
 	suite.SetupTest()
-	msgServer := keeper.NewMsgServerImpl(suite.app.ClaimKeeper)
+	msgServer := keeper.NewMsgServerImpl(suite.app.LegacyClaimKeeper())
 
 	// Successfully create a new airdrop
 	validMsg := getValidCreateEvmosAirdropMsg(suite.ctx)
@@ -139,7 +139,7 @@ func (suite *KeeperTestSuite) TestCreateAirdrop_Successful() {
 	suite.Require().NoError(err, "no error expected when adding evmos airdrop")
 
 	// Check that it matches the evmos airdrop
-	airdrop := suite.app.ClaimKeeper.GetAirdropByIdentifier(suite.ctx, "evmos")
+	airdrop := suite.app.LegacyClaimKeeper().GetAirdropByIdentifier(suite.ctx, "evmos")
 	suite.Require().Equal("evmos", airdrop.AirdropIdentifier, "airdrop identifier")
 	suite.Require().Equal("evmos-1", airdrop.ChainId, "airdrop chain-id")
 	suite.Require().Equal(true, airdrop.AutopilotEnabled, "airdrop autopilot enabled")
@@ -147,7 +147,7 @@ func (suite *KeeperTestSuite) TestCreateAirdrop_Successful() {
 
 func (suite *KeeperTestSuite) TestCreateAirdrop_IdentifierAlreadyExists() {
 	suite.SetupTest()
-	msgServer := keeper.NewMsgServerImpl(suite.app.ClaimKeeper)
+	msgServer := keeper.NewMsgServerImpl(suite.app.LegacyClaimKeeper())
 
 	// Attempt to create an airdrop with an identifier that already exists, it should fail
 	validMsg := getValidCreateEvmosAirdropMsg(suite.ctx)
@@ -160,7 +160,7 @@ func (suite *KeeperTestSuite) TestCreateAirdrop_IdentifierAlreadyExists() {
 
 func (suite *KeeperTestSuite) TestCreateAirdrop_ChainIdAlreadyExists() {
 	suite.SetupTest()
-	msgServer := keeper.NewMsgServerImpl(suite.app.ClaimKeeper)
+	msgServer := keeper.NewMsgServerImpl(suite.app.LegacyClaimKeeper())
 
 	// Attempt to create an airdrop with a chain-id that already exists, it should fail
 	validMsg := getValidCreateEvmosAirdropMsg(suite.ctx)
@@ -30,6 +30,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdEpochsInfos(),
 		GetCmdCurrentEpoch(),
 		GetCmdSecondsRemaining(),
+		GetCmdNextEpochTime(),
 	)
 
 	return cmd
@@ -157,3 +158,38 @@ func GetCmdSecondsRemaining() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdNextEpochTime provides the start time of the next epoch by specified identifier
+func GetCmdNextEpochTime() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-epoch-time",
+		Short: "Query the start time of the next epoch by specified identifier",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query epochs next-epoch-time week`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.NextEpochTime(cmd.Context(), &types.QueryNextEpochTimeRequest{
+				Identifier: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
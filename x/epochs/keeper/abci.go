@@ -76,5 +76,12 @@ func startInitialEpoch(epochInfo types.EpochInfo) types.EpochInfo {
 func endEpoch(epochInfo types.EpochInfo) types.EpochInfo {
 	epochInfo.CurrentEpoch++
 	epochInfo.CurrentEpochStartTime = epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
+
+	// If the duration was updated during the last epoch, it takes effect from the new epoch
+	if epochInfo.PendingDuration != 0 {
+		epochInfo.Duration = epochInfo.PendingDuration
+		epochInfo.PendingDuration = 0
+	}
+
 	return epochInfo
 }
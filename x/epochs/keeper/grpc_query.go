@@ -75,3 +75,27 @@ func (k Keeper) EpochInfo(c context.Context, req *types.QueryEpochInfoRequest) (
 		Epoch: info,
 	}, nil
 }
+
+// NextEpochTime provides the time at which the next epoch of the specified identifier will start
+// The epoch will tick in the first block after this time
+func (k Keeper) NextEpochTime(c context.Context, req *types.QueryNextEpochTimeRequest) (*types.QueryNextEpochTimeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	info, found := k.GetEpochInfo(ctx, req.Identifier)
+	if !found {
+		return nil, status.Error(codes.NotFound, "epoch info not found")
+	}
+
+	nextEpochTime := info.StartTime
+	if info.EpochCountingStarted {
+		nextEpochTime = info.CurrentEpochStartTime.Add(info.Duration)
+	}
+
+	return &types.QueryNextEpochTimeResponse{
+		NextEpochTime: nextEpochTime,
+	}, nil
+}
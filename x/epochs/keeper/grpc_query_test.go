@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	gocontext "context"
+	"time"
 
 	"github.com/Stride-Labs/stride/v27/x/epochs/types"
 )
@@ -26,3 +27,37 @@ func (s *KeeperTestSuite) TestQueryEpochInfos() {
 	s.Require().Equal(epochInfosResponse.Epochs[3], expectedEpochs["stride_epoch"])
 	s.Require().Equal(epochInfosResponse.Epochs[4], expectedEpochs["week"])
 }
+
+func (s *KeeperTestSuite) TestQueryNextEpochTime() {
+	s.SetupTest()
+	startTime := s.Ctx.BlockTime()
+
+	// Epoch that has not started yet - the next epoch time is the start time
+	s.App.EpochsKeeper.SetEpochInfo(s.Ctx, types.EpochInfo{
+		Identifier:           "not_started",
+		StartTime:            startTime.Add(time.Hour),
+		Duration:             time.Minute,
+		EpochCountingStarted: false,
+	})
+
+	// Epoch that is running - the next epoch time is the end of the current epoch
+	s.App.EpochsKeeper.SetEpochInfo(s.Ctx, types.EpochInfo{
+		Identifier:            "running",
+		StartTime:             startTime,
+		Duration:              time.Hour,
+		CurrentEpoch:          3,
+		CurrentEpochStartTime: startTime.Add(time.Hour * 2),
+		EpochCountingStarted:  true,
+	})
+
+	resp, err := s.App.EpochsKeeper.NextEpochTime(s.Ctx, &types.QueryNextEpochTimeRequest{Identifier: "not_started"})
+	s.Require().NoError(err, "no error expected when querying epoch that hasn't started")
+	s.Require().Equal(startTime.Add(time.Hour), resp.NextEpochTime, "next epoch time for epoch that hasn't started")
+
+	resp, err = s.App.EpochsKeeper.NextEpochTime(s.Ctx, &types.QueryNextEpochTimeRequest{Identifier: "running"})
+	s.Require().NoError(err, "no error expected when querying running epoch")
+	s.Require().Equal(startTime.Add(time.Hour*3), resp.NextEpochTime, "next epoch time for running epoch")
+
+	_, err = s.App.EpochsKeeper.NextEpochTime(s.Ctx, &types.QueryNextEpochTimeRequest{Identifier: "fake_epoch"})
+	s.Require().ErrorContains(err, "epoch info not found")
+}
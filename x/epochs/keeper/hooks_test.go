@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v27/x/epochs/types"
+)
+
+// Mock epoch hooks that record an epoch info under the module name
+// before optionally panicking
+type mockEpochHooks struct {
+	s          *KeeperTestSuite
+	moduleName string
+	panicFn    func(ctx sdk.Context)
+}
+
+func (h mockEpochHooks) run(ctx sdk.Context) {
+	h.s.App.EpochsKeeper.SetEpochInfo(ctx, types.EpochInfo{Identifier: h.moduleName})
+	if h.panicFn != nil {
+		h.panicFn(ctx)
+	}
+}
+
+func (h mockEpochHooks) AfterEpochEnd(ctx sdk.Context, epochInfo types.EpochInfo) {
+	h.run(ctx)
+}
+
+func (h mockEpochHooks) BeforeEpochStart(ctx sdk.Context, epochInfo types.EpochInfo) {
+	h.run(ctx)
+}
+
+func (h mockEpochHooks) GetModuleName() string {
+	return h.moduleName
+}
+
+func (s *KeeperTestSuite) TestMultiEpochHooks_FailureIsolated() {
+	hooks := types.NewMultiEpochHooks(
+		mockEpochHooks{s: s, moduleName: "module-a"},
+		mockEpochHooks{s: s, moduleName: "module-panic", panicFn: func(ctx sdk.Context) {
+			panic("hook panicked")
+		}},
+		mockEpochHooks{s: s, moduleName: "module-out-of-gas", panicFn: func(ctx sdk.Context) {
+			panic(storetypes.ErrorOutOfGas{Descriptor: "hook"})
+		}},
+		mockEpochHooks{s: s, moduleName: "module-b"},
+	)
+	epochInfo := types.EpochInfo{Identifier: "day", CurrentEpoch: 2}
+
+	for _, hookName := range []string{types.HookNameBeforeEpochStart, types.HookNameAfterEpochEnd} {
+		s.Run(hookName, func() {
+			s.SetupTest()
+			ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			if hookName == types.HookNameBeforeEpochStart {
+				hooks.BeforeEpochStart(ctx, epochInfo)
+			} else {
+				hooks.AfterEpochEnd(ctx, epochInfo)
+			}
+
+			// The successful hooks should have committed their state
+			_, found := s.App.EpochsKeeper.GetEpochInfo(ctx, "module-a")
+			s.Require().True(found, "module-a state should be written")
+			_, found = s.App.EpochsKeeper.GetEpochInfo(ctx, "module-b")
+			s.Require().True(found, "module-b state should be written")
+
+			// The failed hooks should have their state discarded
+			_, found = s.App.EpochsKeeper.GetEpochInfo(ctx, "module-panic")
+			s.Require().False(found, "module-panic state should be discarded")
+			_, found = s.App.EpochsKeeper.GetEpochInfo(ctx, "module-out-of-gas")
+			s.Require().False(found, "module-out-of-gas state should be discarded")
+
+			// A failure event should be emitted for each failed hook only
+			failedModules := []string{}
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != types.EventTypeHookFailed {
+					continue
+				}
+				for _, attribute := range event.Attributes {
+					switch attribute.Key {
+					case types.AttributeModuleName:
+						failedModules = append(failedModules, attribute.Value)
+					case types.AttributeHookName:
+						s.Require().Equal(hookName, attribute.Value, "hook name")
+					case types.AttributeEpochIdentifier:
+						s.Require().Equal("day", attribute.Value, "epoch identifier")
+					}
+				}
+			}
+			s.Require().Equal([]string{"module-panic", "module-out-of-gas"}, failedModules, "failed modules")
+		})
+	}
+}
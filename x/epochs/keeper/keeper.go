@@ -13,16 +13,18 @@ import (
 
 // Keeper of this module maintains collections of epochs and hooks.
 type Keeper struct {
-	cdc      codec.Codec
-	storeKey storetypes.StoreKey
-	hooks    types.EpochHooks
+	cdc       codec.Codec
+	storeKey  storetypes.StoreKey
+	hooks     types.EpochHooks
+	authority string
 }
 
 // NewKeeper returns a new instance of epochs Keeper
-func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey) *Keeper {
+func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, authority string) *Keeper {
 	return &Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
+		cdc:       cdc,
+		storeKey:  storeKey,
+		authority: authority,
 	}
 }
 
@@ -41,3 +43,8 @@ func (k *Keeper) SetHooks(eh types.EpochHooks) *Keeper {
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
+
+// GetAuthority returns the x/epochs module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Stride-Labs/stride/v27/x/epochs/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// Registers a new epoch that will start counting at the specified start time
+// If the start time is not specified, the epoch will start in the next block
+func (k msgServer) CreateEpoch(goCtx context.Context, msg *types.MsgCreateEpoch) (*types.MsgCreateEpochResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetEpochInfo(ctx, msg.Identifier); found {
+		return nil, errorsmod.Wrapf(types.ErrEpochAlreadyExists, "epoch %s", msg.Identifier)
+	}
+
+	// The start time cannot be in the past, otherwise the epoch would tick once per block
+	// until it caught up to the current time
+	startTime := msg.StartTime
+	if startTime.IsZero() {
+		startTime = ctx.BlockTime()
+	}
+	if startTime.Before(ctx.BlockTime()) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidEpochStart,
+			"start time %s is before the current block time %s", startTime, ctx.BlockTime())
+	}
+
+	k.SetEpochInfo(ctx, types.EpochInfo{
+		Identifier:              msg.Identifier,
+		StartTime:               startTime,
+		Duration:                msg.Duration,
+		CurrentEpoch:            0,
+		CurrentEpochStartHeight: ctx.BlockHeight(),
+		EpochCountingStarted:    false,
+	})
+
+	return &types.MsgCreateEpochResponse{}, nil
+}
+
+// Updates the duration of an epoch
+// If the epoch has already started counting, the current epoch keeps its original length
+// and the new duration takes effect at the next epoch boundary
+// The mint epoch cannot be updated since the epoch provisions and reduction schedule are
+// defined in terms of mint epochs, so changing its duration would change the inflation rate
+// The day epoch must also remain a multiple of the stride epoch, since stakeibc calculates
+// the number of stride epochs per day with integer division
+func (k msgServer) UpdateEpochDuration(goCtx context.Context, msg *types.MsgUpdateEpochDuration) (*types.MsgUpdateEpochDurationResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	epochInfo, found := k.GetEpochInfo(ctx, msg.Identifier)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "epoch %s", msg.Identifier)
+	}
+
+	if msg.Identifier == types.MINT_EPOCH {
+		return nil, errorsmod.Wrapf(types.ErrInvalidEpochRequest, "the %s epoch duration cannot be updated", types.MINT_EPOCH)
+	}
+	if msg.Identifier == types.DAY_EPOCH || msg.Identifier == types.STRIDE_EPOCH {
+		if err := k.validateDayEpochDivisibleByStrideEpoch(ctx, msg.Identifier, msg.Duration); err != nil {
+			return nil, err
+		}
+	}
+
+	if !epochInfo.EpochCountingStarted || epochInfo.Duration == msg.Duration {
+		epochInfo.Duration = msg.Duration
+		epochInfo.PendingDuration = 0
+	} else {
+		epochInfo.PendingDuration = msg.Duration
+	}
+	k.SetEpochInfo(ctx, epochInfo)
+
+	return &types.MsgUpdateEpochDurationResponse{}, nil
+}
+
+// Confirms the day epoch duration will still be a multiple of the stride epoch duration
+// after the given epoch is updated, taking into account any pending duration updates
+func (k msgServer) validateDayEpochDivisibleByStrideEpoch(ctx sdk.Context, identifier string, duration time.Duration) error {
+	nextDurations := map[string]time.Duration{}
+	for _, epochIdentifier := range []string{types.DAY_EPOCH, types.STRIDE_EPOCH} {
+		epochInfo, found := k.GetEpochInfo(ctx, epochIdentifier)
+		if !found {
+			return nil
+		}
+		nextDurations[epochIdentifier] = epochInfo.Duration
+		if epochInfo.PendingDuration != 0 {
+			nextDurations[epochIdentifier] = epochInfo.PendingDuration
+		}
+	}
+	nextDurations[identifier] = duration
+
+	dayDuration, strideDuration := nextDurations[types.DAY_EPOCH], nextDurations[types.STRIDE_EPOCH]
+	if strideDuration <= 0 || dayDuration%strideDuration != 0 {
+		return errorsmod.Wrapf(types.ErrInvalidEpochRequest,
+			"%s epoch duration (%s) must be a multiple of the %s epoch duration (%s)",
+			types.DAY_EPOCH, dayDuration, types.STRIDE_EPOCH, strideDuration)
+	}
+
+	return nil
+}
+
+// Removes an epoch, as long as it's not required by any other modules
+func (k msgServer) DeleteEpoch(goCtx context.Context, msg *types.MsgDeleteEpoch) (*types.MsgDeleteEpochResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if types.IsProtectedEpoch(msg.Identifier) {
+		return nil, errorsmod.Wrapf(types.ErrEpochNotRemovable, "epoch %s is required by other modules", msg.Identifier)
+	}
+	if _, found := k.GetEpochInfo(ctx, msg.Identifier); !found {
+		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "epoch %s", msg.Identifier)
+	}
+
+	k.DeleteEpochInfo(ctx, msg.Identifier)
+
+	return &types.MsgDeleteEpochResponse{}, nil
+}
@@ -0,0 +1,241 @@
+package keeper_test
+
+import (
+	"time"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Stride-Labs/stride/v27/x/epochs/keeper"
+	"github.com/Stride-Labs/stride/v27/x/epochs/types"
+)
+
+var authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+func (s *KeeperTestSuite) TestCreateEpoch() {
+	s.SetupTest()
+	msgServer := keeper.NewMsgServerImpl(s.App.EpochsKeeper)
+	blockTime := s.Ctx.BlockTime()
+
+	// Create an epoch with a future start time
+	startTime := blockTime.Add(time.Hour)
+	_, err := msgServer.CreateEpoch(s.Ctx, types.NewMsgCreateEpoch(authority, "two_day", time.Hour*48, startTime))
+	s.Require().NoError(err, "no error expected when creating epoch")
+
+	epochInfo, found := s.App.EpochsKeeper.GetEpochInfo(s.Ctx, "two_day")
+	s.Require().True(found, "epoch should have been created")
+	s.Require().Equal(time.Hour*48, epochInfo.Duration, "duration")
+	s.Require().Equal(startTime, epochInfo.StartTime, "start time")
+	s.Require().False(epochInfo.EpochCountingStarted, "epoch counting started")
+
+	// Create an epoch without a start time - it should default to the block time
+	_, err = msgServer.CreateEpoch(s.Ctx, types.NewMsgCreateEpoch(authority, "minute", time.Minute, time.Time{}))
+	s.Require().NoError(err, "no error expected when creating epoch without start time")
+
+	epochInfo, found = s.App.EpochsKeeper.GetEpochInfo(s.Ctx, "minute")
+	s.Require().True(found, "epoch should have been created")
+	s.Require().Equal(blockTime, epochInfo.StartTime, "start time")
+
+	// Attempt to create an epoch that already exists
+	_, err = msgServer.CreateEpoch(s.Ctx, types.NewMsgCreateEpoch(authority, "two_day", time.Hour, startTime))
+	s.Require().ErrorIs(err, types.ErrEpochAlreadyExists)
+
+	// Attempt to create an epoch that starts in the past
+	_, err = msgServer.CreateEpoch(s.Ctx, types.NewMsgCreateEpoch(authority, "past", time.Hour, blockTime.Add(-time.Hour)))
+	s.Require().ErrorIs(err, types.ErrInvalidEpochStart)
+
+	// Attempt to create an epoch from a non-authority account
+	_, err = msgServer.CreateEpoch(s.Ctx, types.NewMsgCreateEpoch("invalid_authority", "other", time.Hour, startTime))
+	s.Require().ErrorContains(err, "invalid authority")
+}
+
+func (s *KeeperTestSuite) TestUpdateEpochDuration() {
+	s.SetupTest()
+	msgServer := keeper.NewMsgServerImpl(s.App.EpochsKeeper)
+	blockTime := s.Ctx.BlockTime()
+
+	// Store an epoch that has not started yet and one that is currently running
+	s.App.EpochsKeeper.SetEpochInfo(s.Ctx, types.EpochInfo{
+		Identifier:           "not_started",
+		StartTime:            blockTime.Add(time.Hour),
+		Duration:             time.Hour,
+		EpochCountingStarted: false,
+	})
+	s.App.EpochsKeeper.SetEpochInfo(s.Ctx, types.EpochInfo{
+		Identifier:            "running",
+		StartTime:             blockTime,
+		Duration:              time.Hour,
+		CurrentEpoch:          1,
+		CurrentEpochStartTime: blockTime,
+		EpochCountingStarted:  true,
+	})
+
+	// If the epoch hasn't started, the duration should be updated immediately
+	_, err := msgServer.UpdateEpochDuration(s.Ctx, types.NewMsgUpdateEpochDuration(authority, "not_started", time.Hour*2))
+	s.Require().NoError(err, "no error expected when updating epoch that hasn't started")
+
+	epochInfo, _ := s.App.EpochsKeeper.GetEpochInfo(s.Ctx, "not_started")
+	s.Require().Equal(time.Hour*2, epochInfo.Duration, "duration of epoch that hasn't started")
+	s.Require().Zero(epochInfo.PendingDuration, "pending duration of epoch that hasn't started")
+
+	// If the epoch is running, the update should be pending until the next boundary
+	_, err = msgServer.UpdateEpochDuration(s.Ctx, types.NewMsgUpdateEpochDuration(authority, "running", time.Hour*2))
+	s.Require().NoError(err, "no error expected when updating running epoch")
+
+	epochInfo, _ = s.App.EpochsKeeper.GetEpochInfo(s.Ctx, "running")
+	s.Require().Equal(time.Hour, epochInfo.Duration, "duration of running epoch")
+	s.Require().Equal(time.Hour*2, epochInfo.PendingDuration, "pending duration of running epoch")
+
+	// Setting the duration back to the current value should clear the pending update
+	_, err = msgServer.UpdateEpochDuration(s.Ctx, types.NewMsgUpdateEpochDuration(authority, "running", time.Hour))
+	s.Require().NoError(err, "no error expected when reverting running epoch duration")
+
+	epochInfo, _ = s.App.EpochsKeeper.GetEpochInfo(s.Ctx, "running")
+	s.Require().Equal(time.Hour, epochInfo.Duration, "duration of running epoch after revert")
+	s.Require().Zero(epochInfo.PendingDuration, "pending duration of running epoch after revert")
+
+	// Attempt to update an epoch that doesn't exist
+	_, err = msgServer.UpdateEpochDuration(s.Ctx, types.NewMsgUpdateEpochDuration(authority, "fake_epoch", time.Hour))
+	s.Require().ErrorIs(err, types.ErrEpochNotFound)
+
+	// Attempt to update an epoch from a non-authority account
+	_, err = msgServer.UpdateEpochDuration(s.Ctx, types.NewMsgUpdateEpochDuration("invalid_authority", "running", time.Hour))
+	s.Require().ErrorContains(err, "invalid authority")
+}
+
+func (s *KeeperTestSuite) TestUpdateEpochDuration_MintEpoch() {
+	s.SetupTest()
+	msgServer := keeper.NewMsgServerImpl(s.App.EpochsKeeper)
+
+	s.App.EpochsKeeper.SetEpochInfo(s.Ctx, types.EpochInfo{
+		Identifier: types.MINT_EPOCH,
+		StartTime:  s.Ctx.BlockTime(),
+		Duration:   time.Hour,
+	})
+
+	// The mint epoch can't be updated since it would change the inflation rate
+	_, err := msgServer.UpdateEpochDuration(s.Ctx, types.NewMsgUpdateEpochDuration(authority, types.MINT_EPOCH, time.Hour*2))
+	s.Require().ErrorIs(err, types.ErrInvalidEpochRequest)
+
+	epochInfo, _ := s.App.EpochsKeeper.GetEpochInfo(s.Ctx, types.MINT_EPOCH)
+	s.Require().Equal(time.Hour, epochInfo.Duration, "mint epoch duration")
+	s.Require().Zero(epochInfo.PendingDuration, "mint epoch pending duration")
+}
+
+func (s *KeeperTestSuite) TestUpdateEpochDuration_DayMultipleOfStrideEpoch() {
+	s.SetupTest()
+	msgServer := keeper.NewMsgServerImpl(s.App.EpochsKeeper)
+	blockTime := s.Ctx.BlockTime()
+
+	// Store running day and stride epochs (24 hours and 6 hours)
+	for identifier, duration := range map[string]time.Duration{
+		types.DAY_EPOCH:    time.Hour * 24,
+		types.STRIDE_EPOCH: time.Hour * 6,
+	} {
+		s.App.EpochsKeeper.SetEpochInfo(s.Ctx, types.EpochInfo{
+			Identifier:            identifier,
+			StartTime:             blockTime,
+			Duration:              duration,
+			CurrentEpoch:          1,
+			CurrentEpochStartTime: blockTime,
+			EpochCountingStarted:  true,
+		})
+	}
+
+	// Updating the stride epoch to a duration that doesn't divide the day should fail
+	_, err := msgServer.UpdateEpochDuration(s.Ctx, types.NewMsgUpdateEpochDuration(authority, types.STRIDE_EPOCH, time.Hour*5))
+	s.Require().ErrorIs(err, types.ErrInvalidEpochRequest, "stride epoch of 5 hours")
+
+	// Updating the day epoch to a duration that isn't a multiple of the stride epoch should fail
+	_, err = msgServer.UpdateEpochDuration(s.Ctx, types.NewMsgUpdateEpochDuration(authority, types.DAY_EPOCH, time.Hour*20))
+	s.Require().ErrorIs(err, types.ErrInvalidEpochRequest, "day epoch of 20 hours")
+
+	// Updating the stride epoch to 8 hours should succeed (24 / 8 = 3)
+	_, err = msgServer.UpdateEpochDuration(s.Ctx, types.NewMsgUpdateEpochDuration(authority, types.STRIDE_EPOCH, time.Hour*8))
+	s.Require().NoError(err, "no error expected when updating stride epoch to 8 hours")
+
+	// The pending stride epoch duration should be used when updating the day epoch
+	// 18 hours is a multiple of the current 6 hour stride epoch, but not the pending 8 hour epoch
+	_, err = msgServer.UpdateEpochDuration(s.Ctx, types.NewMsgUpdateEpochDuration(authority, types.DAY_EPOCH, time.Hour*18))
+	s.Require().ErrorIs(err, types.ErrInvalidEpochRequest, "day epoch of 18 hours with pending stride epoch")
+
+	_, err = msgServer.UpdateEpochDuration(s.Ctx, types.NewMsgUpdateEpochDuration(authority, types.DAY_EPOCH, time.Hour*16))
+	s.Require().NoError(err, "no error expected when updating day epoch to 16 hours")
+
+	dayEpoch, _ := s.App.EpochsKeeper.GetEpochInfo(s.Ctx, types.DAY_EPOCH)
+	s.Require().Equal(time.Hour*16, dayEpoch.PendingDuration, "day epoch pending duration")
+	strideEpoch, _ := s.App.EpochsKeeper.GetEpochInfo(s.Ctx, types.STRIDE_EPOCH)
+	s.Require().Equal(time.Hour*8, strideEpoch.PendingDuration, "stride epoch pending duration")
+}
+
+func (s *KeeperTestSuite) TestUpdateEpochDuration_TakesEffectAtNextBoundary() {
+	s.SetupTest()
+	msgServer := keeper.NewMsgServerImpl(s.App.EpochsKeeper)
+	startTime := s.Ctx.BlockTime()
+
+	s.App.EpochsKeeper.SetEpochInfo(s.Ctx, types.EpochInfo{
+		Identifier:            "running",
+		StartTime:             startTime,
+		Duration:              time.Hour,
+		CurrentEpoch:          1,
+		CurrentEpochStartTime: startTime,
+		EpochCountingStarted:  true,
+	})
+
+	_, err := msgServer.UpdateEpochDuration(s.Ctx, types.NewMsgUpdateEpochDuration(authority, "running", time.Hour*3))
+	s.Require().NoError(err, "no error expected when updating epoch duration")
+
+	// Before the current epoch ends, nothing should change
+	ctx := s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1).WithBlockTime(startTime.Add(time.Minute * 30))
+	s.App.EpochsKeeper.BeginBlocker(ctx)
+
+	epochInfo, _ := s.App.EpochsKeeper.GetEpochInfo(ctx, "running")
+	s.Require().Equal(int64(1), epochInfo.CurrentEpoch, "epoch number before boundary")
+	s.Require().Equal(time.Hour, epochInfo.Duration, "duration before boundary")
+
+	// Once the current epoch ends (with the old duration), the new duration should be applied
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(startTime.Add(time.Hour + time.Second))
+	s.App.EpochsKeeper.BeginBlocker(ctx)
+
+	epochInfo, _ = s.App.EpochsKeeper.GetEpochInfo(ctx, "running")
+	s.Require().Equal(int64(2), epochInfo.CurrentEpoch, "epoch number after boundary")
+	s.Require().Equal(startTime.Add(time.Hour), epochInfo.CurrentEpochStartTime, "epoch start time after boundary")
+	s.Require().Equal(time.Hour*3, epochInfo.Duration, "duration after boundary")
+	s.Require().Zero(epochInfo.PendingDuration, "pending duration after boundary")
+
+	// The following epoch should use the new duration
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(startTime.Add(time.Hour * 2))
+	s.App.EpochsKeeper.BeginBlocker(ctx)
+
+	epochInfo, _ = s.App.EpochsKeeper.GetEpochInfo(ctx, "running")
+	s.Require().Equal(int64(2), epochInfo.CurrentEpoch, "epoch number before next boundary")
+}
+
+func (s *KeeperTestSuite) TestDeleteEpoch() {
+	s.SetupTest()
+	msgServer := keeper.NewMsgServerImpl(s.App.EpochsKeeper)
+
+	// Delete an epoch that is not used by other modules
+	_, err := msgServer.DeleteEpoch(s.Ctx, types.NewMsgDeleteEpoch(authority, types.WEEK_EPOCH))
+	s.Require().NoError(err, "no error expected when deleting epoch")
+
+	_, found := s.App.EpochsKeeper.GetEpochInfo(s.Ctx, types.WEEK_EPOCH)
+	s.Require().False(found, "epoch should have been deleted")
+
+	// Attempt to delete an epoch that doesn't exist
+	_, err = msgServer.DeleteEpoch(s.Ctx, types.NewMsgDeleteEpoch(authority, types.WEEK_EPOCH))
+	s.Require().ErrorIs(err, types.ErrEpochNotFound)
+
+	// Attempt to delete each epoch that is used by other modules
+	for _, identifier := range types.ProtectedEpochIdentifiers {
+		_, err = msgServer.DeleteEpoch(s.Ctx, types.NewMsgDeleteEpoch(authority, identifier))
+		s.Require().ErrorIs(err, types.ErrEpochNotRemovable, "deleting %s", identifier)
+
+		_, found := s.App.EpochsKeeper.GetEpochInfo(s.Ctx, identifier)
+		s.Require().True(found, "%s epoch should not have been deleted", identifier)
+	}
+
+	// Attempt to delete an epoch from a non-authority account
+	_, err = msgServer.DeleteEpoch(s.Ctx, types.NewMsgDeleteEpoch("invalid_authority", types.DAY_EPOCH))
+	s.Require().ErrorContains(err, "invalid authority")
+}
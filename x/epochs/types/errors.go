@@ -0,0 +1,16 @@
+package types
+
+// DONTCOVER
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/epochs module sentinel errors
+var (
+	ErrEpochAlreadyExists  = errorsmod.Register(ModuleName, 2, "epoch already exists")
+	ErrEpochNotFound       = errorsmod.Register(ModuleName, 3, "epoch not found")
+	ErrInvalidEpochStart   = errorsmod.Register(ModuleName, 4, "invalid epoch start time")
+	ErrEpochNotRemovable   = errorsmod.Register(ModuleName, 5, "epoch cannot be removed")
+	ErrInvalidEpochRequest = errorsmod.Register(ModuleName, 6, "invalid epoch request")
+)
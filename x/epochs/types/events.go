@@ -3,7 +3,12 @@ package types
 const (
 	EventTypeEpochEnd   = "epoch_end"
 	EventTypeEpochStart = "epoch_start"
+	EventTypeHookFailed = "hook_failed"
 
-	AttributeEpochNumber    = "epoch_number"
-	AttributeEpochStartTime = "start_time"
+	AttributeEpochNumber     = "epoch_number"
+	AttributeEpochStartTime  = "start_time"
+	AttributeEpochIdentifier = "epoch_identifier"
+	AttributeModuleName      = "module"
+	AttributeHookName        = "hook"
+	AttributeError           = "error"
 )
@@ -17,6 +17,19 @@ var (
 	MINT_EPOCH   = "mint"
 )
 
+// Epochs that other modules rely on in their hooks, and therefore cannot be deleted
+var ProtectedEpochIdentifiers = []string{HOUR_EPOCH, DAY_EPOCH, STRIDE_EPOCH, MINT_EPOCH}
+
+// Checks whether the epoch is required by another module
+func IsProtectedEpoch(identifier string) bool {
+	for _, protectedIdentifier := range ProtectedEpochIdentifiers {
+		if identifier == protectedIdentifier {
+			return true
+		}
+	}
+	return false
+}
+
 // DefaultGenesis returns the default Capability genesis state
 // The hour epoch was not included in the mainnet genesis config,
 //  but has been included here for local testing
@@ -85,6 +98,9 @@ func (gs GenesisState) Validate() error {
 		if epoch.Duration == 0 {
 			return errors.New("epoch duration should NOT be 0")
 		}
+		if epoch.PendingDuration < 0 {
+			return errors.New("epoch pending duration should NOT be negative")
+		}
 		// enforce EpochCountingStarted is false for all epochs
 		if epoch.EpochCountingStarted {
 			return errors.New("epoch counting should NOT be started at genesis")
@@ -35,6 +35,9 @@ type EpochInfo struct {
 	CurrentEpochStartTime   time.Time     `protobuf:"bytes,5,opt,name=current_epoch_start_time,json=currentEpochStartTime,proto3,stdtime" json:"current_epoch_start_time" yaml:"current_epoch_start_time"`
 	EpochCountingStarted    bool          `protobuf:"varint,6,opt,name=epoch_counting_started,json=epochCountingStarted,proto3" json:"epoch_counting_started,omitempty"`
 	CurrentEpochStartHeight int64         `protobuf:"varint,7,opt,name=current_epoch_start_height,json=currentEpochStartHeight,proto3" json:"current_epoch_start_height,omitempty"`
+	// Duration that will take effect at the next epoch boundary, after being
+	// updated through governance (zero if there is no pending update)
+	PendingDuration time.Duration `protobuf:"bytes,8,opt,name=pending_duration,json=pendingDuration,proto3,stdduration" json:"pending_duration,omitempty" yaml:"pending_duration"`
 }
 
 func (m *EpochInfo) Reset()         { *m = EpochInfo{} }
@@ -119,6 +122,13 @@ func (m *EpochInfo) GetCurrentEpochStartHeight() int64 {
 	return 0
 }
 
+func (m *EpochInfo) GetPendingDuration() time.Duration {
+	if m != nil {
+		return m.PendingDuration
+	}
+	return 0
+}
+
 // GenesisState defines the epochs module's genesis state.
 type GenesisState struct {
 	Epochs []EpochInfo `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
@@ -172,37 +182,39 @@ func init() {
 func init() { proto.RegisterFile("stride/epochs/genesis.proto", fileDescriptor_92af8154b2eb736d) }
 
 var fileDescriptor_92af8154b2eb736d = []byte{
-	// 468 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xbf, 0x8f, 0xd3, 0x30,
-	0x14, 0xc7, 0x6b, 0x5a, 0xca, 0xd5, 0x77, 0x27, 0x84, 0x75, 0x80, 0x29, 0x22, 0x89, 0xc2, 0x12,
-	0x09, 0x48, 0xa0, 0x20, 0x90, 0x60, 0x2b, 0xbf, 0x11, 0x53, 0xca, 0x80, 0x58, 0xaa, 0xb4, 0x75,
-	0x1d, 0x4b, 0x97, 0x38, 0x8a, 0x5f, 0x10, 0xdd, 0x98, 0x99, 0x6e, 0xe4, 0x4f, 0xba, 0xf1, 0x46,
-	0xa6, 0x80, 0xda, 0x8d, 0xf1, 0xfe, 0x02, 0x14, 0x3b, 0x29, 0x3d, 0x0a, 0x62, 0x4b, 0xfc, 0xf9,
-	0xbe, 0xef, 0xd7, 0xef, 0xe9, 0x19, 0x5f, 0x57, 0x90, 0x8b, 0x19, 0x0b, 0x58, 0x26, 0xa7, 0xb1,
-	0x0a, 0x38, 0x4b, 0x99, 0x12, 0xca, 0xcf, 0x72, 0x09, 0x92, 0xec, 0x1b, 0xe8, 0x1b, 0xd8, 0x3f,
-	0xe0, 0x92, 0x4b, 0x4d, 0x82, 0xea, 0xcb, 0x88, 0xfa, 0x16, 0x97, 0x92, 0x1f, 0xb2, 0x40, 0xff,
-	0x4d, 0x8a, 0x79, 0x30, 0x2b, 0xf2, 0x08, 0x84, 0x4c, 0x6b, 0x6e, 0xff, 0xc9, 0x41, 0x24, 0x4c,
-	0x41, 0x94, 0x64, 0x46, 0xe0, 0x7e, 0xe9, 0xe0, 0xde, 0xf3, 0x2a, 0xe1, 0x75, 0x3a, 0x97, 0xc4,
-	0xc2, 0x58, 0xcc, 0x58, 0x0a, 0x62, 0x2e, 0x58, 0x4e, 0x91, 0x83, 0xbc, 0x5e, 0xb8, 0x71, 0x42,
-	0xde, 0x63, 0xac, 0x20, 0xca, 0x61, 0x5c, 0xd9, 0xd0, 0x73, 0x0e, 0xf2, 0x76, 0x07, 0x7d, 0xdf,
-	0x64, 0xf8, 0x4d, 0x86, 0xff, 0xae, 0xc9, 0x18, 0xde, 0x38, 0x2e, 0xed, 0xd6, 0x69, 0x69, 0x5f,
-	0x5a, 0x44, 0xc9, 0xe1, 0x63, 0xf7, 0x77, 0xad, 0x7b, 0xf4, 0xdd, 0x46, 0x61, 0x4f, 0x1f, 0x54,
-	0x72, 0x12, 0xe3, 0x9d, 0xe6, 0xea, 0xb4, 0xad, 0x7d, 0xaf, 0x6d, 0xf9, 0x3e, 0xab, 0x05, 0xc3,
-	0x7b, 0x95, 0xed, 0xcf, 0xd2, 0x26, 0x4d, 0xc9, 0x6d, 0x99, 0x08, 0x60, 0x49, 0x06, 0x8b, 0xd3,
-	0xd2, 0xbe, 0x68, 0xc2, 0x1a, 0xe6, 0x7e, 0xad, 0xa2, 0xd6, 0xee, 0xe4, 0x26, 0xde, 0x9f, 0x16,
-	0x79, 0xce, 0x52, 0x18, 0xeb, 0xd1, 0xd2, 0x8e, 0x83, 0xbc, 0x76, 0xb8, 0x57, 0x1f, 0xea, 0x61,
-	0x90, 0xcf, 0x08, 0xd3, 0x33, 0xaa, 0xf1, 0x46, 0xdf, 0xe7, 0xff, 0xdb, 0xf7, 0xad, 0xba, 0x6f,
-	0xdb, 0x5c, 0xe5, 0x5f, 0x4e, 0x66, 0x0a, 0x97, 0x37, 0x93, 0x47, 0xeb, 0x89, 0x3c, 0xc0, 0x57,
-	0x8c, 0x7e, 0x2a, 0x8b, 0x14, 0x44, 0xca, 0x4d, 0x21, 0x9b, 0xd1, 0xae, 0x83, 0xbc, 0x9d, 0xf0,
-	0x40, 0xd3, 0xa7, 0x35, 0x1c, 0x19, 0x46, 0x9e, 0xe0, 0xfe, 0xdf, 0xd2, 0x62, 0x26, 0x78, 0x0c,
-	0xf4, 0x82, 0x6e, 0xf5, 0xea, 0x56, 0xe0, 0x2b, 0x8d, 0xdd, 0x17, 0x78, 0xef, 0xa5, 0xd9, 0xc1,
-	0x11, 0x44, 0xc0, 0xc8, 0x43, 0xdc, 0x35, 0xdb, 0x47, 0x91, 0xd3, 0xf6, 0x76, 0x07, 0xd4, 0x3f,
-	0xb3, 0x93, 0xfe, 0x7a, 0x71, 0x86, 0x9d, 0xaa, 0xe1, 0xb0, 0x56, 0x0f, 0xdf, 0x1c, 0x2f, 0x2d,
-	0x74, 0xb2, 0xb4, 0xd0, 0x8f, 0xa5, 0x85, 0x8e, 0x56, 0x56, 0xeb, 0x64, 0x65, 0xb5, 0xbe, 0xad,
-	0xac, 0xd6, 0x87, 0xbb, 0x5c, 0x40, 0x5c, 0x4c, 0xfc, 0xa9, 0x4c, 0x82, 0x91, 0xf6, 0xba, 0xf3,
-	0x36, 0x9a, 0xa8, 0xa0, 0x7e, 0x08, 0x1f, 0x07, 0x8f, 0x82, 0x4f, 0xcd, 0x73, 0x80, 0x45, 0xc6,
-	0xd4, 0xa4, 0xab, 0xc7, 0x7b, 0xff, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc8, 0xfc, 0x5e, 0xf0,
-	0x2c, 0x03, 0x00, 0x00,
+	// 500 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0x91, 0x36, 0x24, 0xd7, 0x56, 0x85, 0x53, 0xa1, 0xc6, 0x08, 0xdb, 0x32, 0x8b, 0x25,
+	0xc0, 0x86, 0x80, 0x40, 0x82, 0x89, 0xf0, 0x1b, 0x31, 0x39, 0x0c, 0x88, 0x25, 0x72, 0xe2, 0x8b,
+	0x7d, 0x52, 0xed, 0xb3, 0xec, 0x67, 0x44, 0x36, 0x36, 0xd6, 0x0e, 0x0c, 0xfc, 0x49, 0x1d, 0x3b,
+	0x32, 0x05, 0x94, 0x6c, 0x8c, 0xfd, 0x0b, 0x90, 0xef, 0xce, 0x21, 0x49, 0x41, 0xd9, 0xec, 0xf7,
+	0x7d, 0xef, 0xfb, 0xde, 0x8f, 0x7b, 0xf8, 0x7a, 0x01, 0x39, 0x0b, 0xa9, 0x47, 0x33, 0x3e, 0x8a,
+	0x0b, 0x2f, 0xa2, 0x29, 0x2d, 0x58, 0xe1, 0x66, 0x39, 0x07, 0x4e, 0xf6, 0x24, 0xe8, 0x4a, 0x50,
+	0x3f, 0x88, 0x78, 0xc4, 0x05, 0xe2, 0x55, 0x5f, 0x92, 0xa4, 0x1b, 0x11, 0xe7, 0xd1, 0x11, 0xf5,
+	0xc4, 0xdf, 0xb0, 0x1c, 0x7b, 0x61, 0x99, 0x07, 0xc0, 0x78, 0xaa, 0x70, 0x73, 0x1d, 0x07, 0x96,
+	0xd0, 0x02, 0x82, 0x24, 0x93, 0x04, 0xfb, 0xdb, 0x36, 0xee, 0xbc, 0xa8, 0x1c, 0xde, 0xa4, 0x63,
+	0x4e, 0x0c, 0x8c, 0x59, 0x48, 0x53, 0x60, 0x63, 0x46, 0x73, 0x0d, 0x59, 0xc8, 0xe9, 0xf8, 0x4b,
+	0x11, 0xf2, 0x01, 0xe3, 0x02, 0x82, 0x1c, 0x06, 0x95, 0x8c, 0x76, 0xc1, 0x42, 0xce, 0x4e, 0x57,
+	0x77, 0xa5, 0x87, 0x5b, 0x7b, 0xb8, 0xef, 0x6b, 0x8f, 0xde, 0x8d, 0x93, 0xa9, 0xd9, 0x38, 0x9b,
+	0x9a, 0x97, 0x27, 0x41, 0x72, 0xf4, 0xd8, 0xfe, 0x9b, 0x6b, 0x1f, 0xff, 0x34, 0x91, 0xdf, 0x11,
+	0x81, 0x8a, 0x4e, 0x62, 0xdc, 0xae, 0x4b, 0xd7, 0x9a, 0x42, 0xf7, 0xda, 0x39, 0xdd, 0xe7, 0x8a,
+	0xd0, 0xbb, 0x57, 0xc9, 0xfe, 0x9e, 0x9a, 0xa4, 0x4e, 0xb9, 0xcd, 0x13, 0x06, 0x34, 0xc9, 0x60,
+	0x72, 0x36, 0x35, 0xf7, 0xa5, 0x59, 0x8d, 0xd9, 0xdf, 0x2b, 0xab, 0x85, 0x3a, 0xb9, 0x89, 0xf7,
+	0x46, 0x65, 0x9e, 0xd3, 0x14, 0x06, 0x62, 0xb4, 0xda, 0x96, 0x85, 0x9c, 0xa6, 0xbf, 0xab, 0x82,
+	0x62, 0x18, 0xe4, 0x0b, 0xc2, 0xda, 0x0a, 0x6b, 0xb0, 0xd4, 0xf7, 0xf6, 0xc6, 0xbe, 0x6f, 0xa9,
+	0xbe, 0x4d, 0x59, 0xca, 0xff, 0x94, 0xe4, 0x14, 0xae, 0x2c, 0x3b, 0xf7, 0x17, 0x13, 0x79, 0x80,
+	0xaf, 0x4a, 0xfe, 0x88, 0x97, 0x29, 0xb0, 0x34, 0x92, 0x89, 0x34, 0xd4, 0x5a, 0x16, 0x72, 0xda,
+	0xfe, 0x81, 0x40, 0x9f, 0x29, 0xb0, 0x2f, 0x31, 0xf2, 0x04, 0xeb, 0xff, 0x72, 0x8b, 0x29, 0x8b,
+	0x62, 0xd0, 0x2e, 0x8a, 0x56, 0x0f, 0xcf, 0x19, 0xbe, 0x16, 0x30, 0xf9, 0x8a, 0xf0, 0xa5, 0x8c,
+	0xa6, 0x61, 0x65, 0xb6, 0xd8, 0x46, 0x7b, 0xd3, 0x36, 0x9e, 0xaa, 0x6d, 0xe8, 0xeb, 0xa9, 0x2b,
+	0x5b, 0x39, 0x94, 0xa3, 0x58, 0xe7, 0xc8, 0xed, 0xec, 0xab, 0x70, 0xad, 0x69, 0xbf, 0xc4, 0xbb,
+	0xaf, 0xe4, 0x35, 0xf4, 0x21, 0x00, 0x4a, 0x1e, 0xe2, 0x96, 0xbc, 0x03, 0x0d, 0x59, 0x4d, 0x67,
+	0xa7, 0xab, 0xb9, 0x2b, 0xd7, 0xe1, 0x2e, 0x9e, 0x70, 0x6f, 0xab, 0xaa, 0xc6, 0x57, 0xec, 0xde,
+	0xdb, 0x93, 0x99, 0x81, 0x4e, 0x67, 0x06, 0xfa, 0x35, 0x33, 0xd0, 0xf1, 0xdc, 0x68, 0x9c, 0xce,
+	0x8d, 0xc6, 0x8f, 0xb9, 0xd1, 0xf8, 0x78, 0x37, 0x62, 0x10, 0x97, 0x43, 0x77, 0xc4, 0x13, 0xaf,
+	0x2f, 0xb4, 0xee, 0xbc, 0x0b, 0x86, 0x85, 0xa7, 0x4e, 0xf2, 0x53, 0xf7, 0x91, 0xf7, 0xb9, 0x3e,
+	0x4c, 0x98, 0x64, 0xb4, 0x18, 0xb6, 0x44, 0xeb, 0xf7, 0xff, 0x0c, 0x00, 0x69, 0x94, 0x46, 0x11,
+	0xb6, 0x03, 0x00, 0x00,
 }
 
 func (m *EpochInfo) Marshal() (dAtA []byte, err error) {
@@ -225,6 +237,14 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.PendingDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PendingDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
 	if m.CurrentEpochStartHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.CurrentEpochStartHeight))
 		i--
@@ -240,12 +260,12 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CurrentEpochStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CurrentEpochStartTime):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CurrentEpochStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CurrentEpochStartTime):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x2a
 	if m.CurrentEpoch != 0 {
@@ -253,21 +273,21 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x20
 	}
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintGenesis(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x1a
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
 	if err3 != nil {
 		return 0, err3
 	}
 	i -= n3
 	i = encodeVarintGenesis(dAtA, i, uint64(n3))
 	i--
+	dAtA[i] = 0x1a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
 	dAtA[i] = 0x12
 	if len(m.Identifier) > 0 {
 		i -= len(m.Identifier)
@@ -352,6 +372,8 @@ func (m *EpochInfo) Size() (n int) {
 	if m.CurrentEpochStartHeight != 0 {
 		n += 1 + sovGenesis(uint64(m.CurrentEpochStartHeight))
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PendingDuration)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -594,6 +616,39 @@ func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.PendingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
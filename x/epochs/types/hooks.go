@@ -1,7 +1,19 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v27/utils"
+)
+
+const (
+	HookNameAfterEpochEnd    = "after_epoch_end"
+	HookNameBeforeEpochStart = "before_epoch_start"
 )
 
 type EpochHooks interface {
@@ -9,11 +21,15 @@ type EpochHooks interface {
 	AfterEpochEnd(ctx sdk.Context, epochInfo EpochInfo)
 	// new epoch is next block of epoch end block
 	BeforeEpochStart(ctx sdk.Context, epochInfo EpochInfo)
+	// name of the module implementing the hooks, used for events and telemetry
+	GetModuleName() string
 }
 
 var _ EpochHooks = MultiEpochHooks{}
 
 // combine multiple gamm hooks, all hook functions are run in array sequence
+// each hook is run in its own cached context, so that a failure in one module's
+// hook does not prevent the remaining hooks from running
 type MultiEpochHooks []EpochHooks
 
 func NewMultiEpochHooks(hooks ...EpochHooks) MultiEpochHooks {
@@ -23,13 +39,89 @@ func NewMultiEpochHooks(hooks ...EpochHooks) MultiEpochHooks {
 // AfterEpochEnd is called when epoch is going to be ended, epochNumber is the number of epoch that is ending
 func (h MultiEpochHooks) AfterEpochEnd(ctx sdk.Context, epochInfo EpochInfo) {
 	for i := range h {
-		h[i].AfterEpochEnd(ctx, epochInfo)
+		hook := h[i]
+		RunHookIsolated(ctx, hook.GetModuleName(), HookNameAfterEpochEnd, epochInfo, func(ctx sdk.Context) {
+			hook.AfterEpochEnd(ctx, epochInfo)
+		})
 	}
 }
 
 // BeforeEpochStart is called when epoch is going to be started, epochNumber is the number of epoch that is starting
 func (h MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epochInfo EpochInfo) {
 	for i := range h {
-		h[i].BeforeEpochStart(ctx, epochInfo)
+		hook := h[i]
+		RunHookIsolated(ctx, hook.GetModuleName(), HookNameBeforeEpochStart, epochInfo, func(ctx sdk.Context) {
+			hook.BeforeEpochStart(ctx, epochInfo)
+		})
 	}
 }
+
+// GetModuleName returns the epochs module name, since the hooks are dispatched from this module
+func (h MultiEpochHooks) GetModuleName() string {
+	return ModuleName
+}
+
+// Runs a single module's epoch hook in a cached context
+// If the hook panics (including from running out of gas), the state changes are discarded,
+// a hook_failed event is emitted, and execution continues
+// The gas consumed and time taken by each hook are reported to telemetry
+func RunHookIsolated(
+	ctx sdk.Context,
+	moduleName string,
+	hookName string,
+	epochInfo EpochInfo,
+	hookFn func(ctx sdk.Context),
+) {
+	start := time.Now()
+	gasBefore := ctx.GasMeter().GasConsumed()
+
+	labels := []metrics.Label{
+		telemetry.NewLabel("module", moduleName),
+		telemetry.NewLabel("hook", hookName),
+		telemetry.NewLabel("epoch_identifier", epochInfo.Identifier),
+	}
+	defer func() {
+		gasUsed := ctx.GasMeter().GasConsumed() - gasBefore
+		telemetry.SetGaugeWithLabels([]string{ModuleName, "hook", "gas_used"}, float32(gasUsed), labels)
+		durationMs := float32(time.Since(start).Microseconds()) / 1000
+		telemetry.SetGaugeWithLabels([]string{ModuleName, "hook", "duration_ms"}, durationMs, labels)
+	}()
+
+	if err := runHookInCacheCtx(ctx, hookFn); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("epoch hook %s failed for module %s: %s", hookName, moduleName, err.Error()))
+		telemetry.IncrCounterWithLabels([]string{ModuleName, "hook", "failed"}, 1, labels)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeHookFailed,
+				sdk.NewAttribute(AttributeModuleName, moduleName),
+				sdk.NewAttribute(AttributeHookName, hookName),
+				sdk.NewAttribute(AttributeEpochIdentifier, epochInfo.Identifier),
+				sdk.NewAttribute(AttributeEpochNumber, fmt.Sprintf("%d", epochInfo.CurrentEpoch)),
+				sdk.NewAttribute(AttributeError, err.Error()),
+			),
+		)
+	}
+}
+
+// Runs the hook in a cached context and only writes the state changes if it completes
+// Unlike utils.ApplyFuncIfNoError, out of gas panics are also recovered, since an
+// epoch hook is not tied to a tx and re-panicking would halt the chain
+func runHookInCacheCtx(ctx sdk.Context, hookFn func(ctx sdk.Context)) (err error) {
+	defer func() {
+		if recoveryError := recover(); recoveryError != nil {
+			utils.PrintPanicRecoveryError(ctx, recoveryError)
+			if isOutOfGas, descriptor := utils.IsOutOfGasError(recoveryError); isOutOfGas {
+				err = fmt.Errorf("out of gas: %s", descriptor)
+			} else {
+				err = fmt.Errorf("panic: %v", recoveryError)
+			}
+		}
+	}()
+
+	cacheCtx, write := ctx.CacheContext()
+	hookFn(cacheCtx)
+	write()
+
+	return nil
+}
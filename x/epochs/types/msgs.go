@@ -0,0 +1,155 @@
+package types
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+const (
+	TypeMsgCreateEpoch         = "create_epoch"
+	TypeMsgUpdateEpochDuration = "update_epoch_duration"
+	TypeMsgDeleteEpoch         = "delete_epoch"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateEpoch{}
+	_ sdk.Msg = &MsgUpdateEpochDuration{}
+	_ sdk.Msg = &MsgDeleteEpoch{}
+
+	// Implement legacy interface for ledger support
+	_ legacytx.LegacyMsg = &MsgCreateEpoch{}
+	_ legacytx.LegacyMsg = &MsgUpdateEpochDuration{}
+	_ legacytx.LegacyMsg = &MsgDeleteEpoch{}
+)
+
+// ----------------------------------------------
+//               MsgCreateEpoch
+// ----------------------------------------------
+
+func NewMsgCreateEpoch(authority, identifier string, duration time.Duration, startTime time.Time) *MsgCreateEpoch {
+	return &MsgCreateEpoch{
+		Authority:  authority,
+		Identifier: identifier,
+		Duration:   duration,
+		StartTime:  startTime,
+	}
+}
+
+func (msg MsgCreateEpoch) Type() string {
+	return TypeMsgCreateEpoch
+}
+
+func (msg MsgCreateEpoch) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateEpoch) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgCreateEpoch) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+	if msg.Identifier == "" {
+		return errors.New("epoch identifier must be specified")
+	}
+	if msg.Duration <= 0 {
+		return errors.New("epoch duration must be positive")
+	}
+
+	return nil
+}
+
+// ----------------------------------------------
+//               MsgUpdateEpochDuration
+// ----------------------------------------------
+
+func NewMsgUpdateEpochDuration(authority, identifier string, duration time.Duration) *MsgUpdateEpochDuration {
+	return &MsgUpdateEpochDuration{
+		Authority:  authority,
+		Identifier: identifier,
+		Duration:   duration,
+	}
+}
+
+func (msg MsgUpdateEpochDuration) Type() string {
+	return TypeMsgUpdateEpochDuration
+}
+
+func (msg MsgUpdateEpochDuration) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateEpochDuration) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgUpdateEpochDuration) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateEpochDuration) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+	if msg.Identifier == "" {
+		return errors.New("epoch identifier must be specified")
+	}
+	if msg.Duration <= 0 {
+		return errors.New("epoch duration must be positive")
+	}
+
+	return nil
+}
+
+// ----------------------------------------------
+//               MsgDeleteEpoch
+// ----------------------------------------------
+
+func NewMsgDeleteEpoch(authority, identifier string) *MsgDeleteEpoch {
+	return &MsgDeleteEpoch{
+		Authority:  authority,
+		Identifier: identifier,
+	}
+}
+
+func (msg MsgDeleteEpoch) Type() string {
+	return TypeMsgDeleteEpoch
+}
+
+func (msg MsgDeleteEpoch) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDeleteEpoch) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgDeleteEpoch) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDeleteEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+	if msg.Identifier == "" {
+		return errors.New("epoch identifier must be specified")
+	}
+
+	return nil
+}
@@ -10,6 +10,8 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -17,12 +19,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -302,6 +306,94 @@ func (m *QueryEpochInfoResponse) GetEpoch() EpochInfo {
 	return EpochInfo{}
 }
 
+type QueryNextEpochTimeRequest struct {
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+}
+
+func (m *QueryNextEpochTimeRequest) Reset()         { *m = QueryNextEpochTimeRequest{} }
+func (m *QueryNextEpochTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextEpochTimeRequest) ProtoMessage()    {}
+func (*QueryNextEpochTimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de81e87fff8f1327, []int{6}
+}
+func (m *QueryNextEpochTimeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextEpochTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextEpochTimeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextEpochTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextEpochTimeRequest.Merge(m, src)
+}
+func (m *QueryNextEpochTimeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextEpochTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextEpochTimeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextEpochTimeRequest proto.InternalMessageInfo
+
+func (m *QueryNextEpochTimeRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+type QueryNextEpochTimeResponse struct {
+	NextEpochTime time.Time `protobuf:"bytes,1,opt,name=next_epoch_time,json=nextEpochTime,proto3,stdtime" json:"next_epoch_time"`
+}
+
+func (m *QueryNextEpochTimeResponse) Reset()         { *m = QueryNextEpochTimeResponse{} }
+func (m *QueryNextEpochTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextEpochTimeResponse) ProtoMessage()    {}
+func (*QueryNextEpochTimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de81e87fff8f1327, []int{7}
+}
+func (m *QueryNextEpochTimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextEpochTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextEpochTimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextEpochTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextEpochTimeResponse.Merge(m, src)
+}
+func (m *QueryNextEpochTimeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextEpochTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextEpochTimeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextEpochTimeResponse proto.InternalMessageInfo
+
+func (m *QueryNextEpochTimeResponse) GetNextEpochTime() time.Time {
+	if m != nil {
+		return m.NextEpochTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*QueryEpochsInfoRequest)(nil), "stride.epochs.QueryEpochsInfoRequest")
 	proto.RegisterType((*QueryEpochsInfoResponse)(nil), "stride.epochs.QueryEpochsInfoResponse")
@@ -309,44 +401,53 @@ func init() {
 	proto.RegisterType((*QueryCurrentEpochResponse)(nil), "stride.epochs.QueryCurrentEpochResponse")
 	proto.RegisterType((*QueryEpochInfoRequest)(nil), "stride.epochs.QueryEpochInfoRequest")
 	proto.RegisterType((*QueryEpochInfoResponse)(nil), "stride.epochs.QueryEpochInfoResponse")
+	proto.RegisterType((*QueryNextEpochTimeRequest)(nil), "stride.epochs.QueryNextEpochTimeRequest")
+	proto.RegisterType((*QueryNextEpochTimeResponse)(nil), "stride.epochs.QueryNextEpochTimeResponse")
 }
 
 func init() { proto.RegisterFile("stride/epochs/query.proto", fileDescriptor_de81e87fff8f1327) }
 
 var fileDescriptor_de81e87fff8f1327 = []byte{
-	// 511 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xeb, 0x95, 0x4d, 0xda, 0xdb, 0x76, 0xb1, 0xf8, 0xd1, 0x76, 0x28, 0x8c, 0xb0, 0xb5,
-	0x05, 0x81, 0xcd, 0x0a, 0x62, 0x12, 0x27, 0x04, 0x02, 0x04, 0x42, 0x08, 0xc2, 0x8d, 0xcb, 0x48,
-	0x32, 0x2f, 0xb3, 0xb4, 0xd9, 0x59, 0xec, 0x4e, 0xec, 0xc2, 0x81, 0x03, 0x67, 0x04, 0x37, 0xee,
-	0xfc, 0x2f, 0x3b, 0x4e, 0xe2, 0xc2, 0x09, 0xa1, 0x96, 0x3f, 0x04, 0xc5, 0xf6, 0xda, 0x04, 0x52,
-	0xe5, 0xd4, 0xc8, 0xef, 0xfb, 0x7d, 0xef, 0xe3, 0xef, 0xb3, 0x0a, 0x6d, 0xa5, 0x33, 0xbe, 0xc3,
-	0x28, 0x4b, 0x65, 0xbc, 0xa7, 0xe8, 0xe1, 0x90, 0x65, 0xc7, 0x24, 0xcd, 0xa4, 0x96, 0x78, 0xc5,
-	0x96, 0x88, 0x2d, 0x75, 0x6e, 0xc4, 0x52, 0x1d, 0x48, 0x45, 0xa3, 0x50, 0x31, 0xab, 0xa3, 0x47,
-	0x9b, 0x11, 0xd3, 0xe1, 0x26, 0x4d, 0xc3, 0x84, 0x8b, 0x50, 0x73, 0x29, 0xac, 0xb5, 0x73, 0x3e,
-	0x91, 0x89, 0x34, 0x9f, 0x34, 0xff, 0x72, 0xa7, 0x97, 0x13, 0x29, 0x93, 0x7d, 0x46, 0xc3, 0x94,
-	0xd3, 0x50, 0x08, 0xa9, 0x8d, 0x45, 0xb9, 0xea, 0x6a, 0x99, 0x24, 0x61, 0x82, 0x29, 0xee, 0x8a,
-	0xfe, 0x3b, 0xb8, 0xf8, 0x3a, 0x1f, 0xf9, 0xd8, 0x14, 0x9f, 0x89, 0x5d, 0x19, 0xb0, 0xc3, 0x21,
-	0x53, 0x1a, 0x3f, 0x01, 0x98, 0x8e, 0x6f, 0xa1, 0x35, 0xd4, 0x5f, 0x1a, 0x74, 0x89, 0x65, 0x25,
-	0x39, 0x2b, 0xb1, 0x77, 0x72, 0xac, 0xe4, 0x55, 0x98, 0x30, 0xe7, 0x0d, 0x0a, 0x4e, 0xff, 0x1b,
-	0x82, 0x4b, 0xff, 0x8d, 0x50, 0xa9, 0x14, 0x8a, 0xe1, 0x7b, 0xb0, 0x60, 0xa9, 0x5a, 0x68, 0xad,
-	0xd9, 0x5f, 0x1a, 0xb4, 0x48, 0x29, 0x1a, 0x62, 0x2c, 0xb9, 0xe3, 0xe1, 0xb9, 0x93, 0x5f, 0x57,
-	0x1a, 0x81, 0x53, 0xe3, 0xa7, 0x25, 0xb6, 0x39, 0xc3, 0xd6, 0xab, 0x65, 0xb3, 0x43, 0x4b, 0x70,
-	0xf7, 0xa1, 0x65, 0xd8, 0x1e, 0x0d, 0xb3, 0x8c, 0x09, 0x6d, 0xe6, 0x9d, 0x05, 0xe0, 0x01, 0xf0,
-	0x1d, 0x26, 0x34, 0xdf, 0xe5, 0x2c, 0x33, 0x01, 0x2c, 0x06, 0x85, 0x13, 0xff, 0x01, 0xb4, 0x2b,
-	0xbc, 0xee, 0x66, 0xd7, 0x60, 0x25, 0xb6, 0xe7, 0xdb, 0x86, 0xd9, 0xf8, 0x9b, 0xc1, 0x72, 0x5c,
-	0x10, 0xfb, 0x5b, 0x70, 0x61, 0x9a, 0x4c, 0x31, 0xfb, 0xba, 0xd1, 0x2f, 0x8b, 0x5b, 0x2b, 0x25,
-	0x7a, 0x17, 0xe6, 0xa7, 0xf3, 0xea, 0x03, 0xb5, 0xe2, 0xc1, 0xf7, 0x26, 0xcc, 0x9b, 0x86, 0xf8,
-	0x03, 0xc0, 0x44, 0xa3, 0xf0, 0xc6, 0x3f, 0xf6, 0xea, 0xa7, 0xd2, 0xe9, 0xd6, 0xc9, 0x2c, 0x9c,
-	0x7f, 0xf5, 0xe3, 0x8f, 0x3f, 0x5f, 0xe7, 0x56, 0x71, 0x9b, 0xbe, 0x31, 0xfa, 0xfd, 0x30, 0x52,
-	0xb4, 0xf4, 0x3a, 0xf1, 0x17, 0x04, 0xcb, 0xc5, 0x40, 0x71, 0xaf, 0xaa, 0x77, 0xc5, 0xba, 0x3a,
-	0xfd, 0x7a, 0xa1, 0xc3, 0xa0, 0x06, 0xe3, 0x3a, 0xee, 0xcd, 0xc4, 0xa0, 0xa5, 0xdd, 0xe1, 0x4f,
-	0x08, 0x16, 0x27, 0xa9, 0xe0, 0xf5, 0x99, 0xb7, 0x2d, 0x66, 0xb2, 0x51, 0xa3, 0x72, 0x2c, 0x37,
-	0x0d, 0x4b, 0x17, 0xaf, 0xcf, 0x66, 0x31, 0x3f, 0xdb, 0x3c, 0x5f, 0xda, 0xf3, 0x93, 0x91, 0x87,
-	0x4e, 0x47, 0x1e, 0xfa, 0x3d, 0xf2, 0xd0, 0xe7, 0xb1, 0xd7, 0x38, 0x1d, 0x7b, 0x8d, 0x9f, 0x63,
-	0xaf, 0xf1, 0xf6, 0x76, 0xc2, 0xf5, 0xde, 0x30, 0x22, 0xb1, 0x3c, 0x70, 0x9d, 0x6e, 0xbd, 0x28,
-	0xb4, 0x3a, 0x1a, 0x6c, 0xd1, 0xf7, 0x67, 0x0d, 0xf5, 0x71, 0xca, 0x54, 0xb4, 0x60, 0xfe, 0x00,
-	0xee, 0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x76, 0x78, 0x08, 0xa9, 0x04, 0x00, 0x00,
+	// 618 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x1c, 0xc6, 0x9b, 0xfd, 0xd3, 0x6f, 0xde, 0xaa, 0x9f, 0x64, 0xc1, 0xe8, 0x32, 0x94, 0x8d, 0xb0,
+	0xb5, 0x1d, 0x02, 0x9b, 0x15, 0xb4, 0x21, 0x90, 0x10, 0x2a, 0x02, 0x04, 0x9a, 0x26, 0x08, 0x3b,
+	0x71, 0x19, 0x49, 0xe7, 0x66, 0x46, 0x6b, 0x9c, 0xc5, 0xee, 0xd4, 0x09, 0xc1, 0x81, 0x03, 0xe7,
+	0x09, 0x6e, 0x9c, 0x79, 0x31, 0x3b, 0x70, 0x98, 0xc4, 0x85, 0x13, 0xa0, 0x96, 0x17, 0x82, 0x62,
+	0xbb, 0x6b, 0x52, 0x52, 0x65, 0xb7, 0x34, 0x7e, 0x1e, 0x7f, 0x3f, 0xcf, 0x37, 0x4f, 0xc1, 0x3c,
+	0x17, 0x11, 0xdd, 0x25, 0x98, 0x84, 0xac, 0xb1, 0xc7, 0xf1, 0x41, 0x9b, 0x44, 0x47, 0x28, 0x8c,
+	0x98, 0x60, 0xb0, 0xa8, 0x8e, 0x90, 0x3a, 0x32, 0xaf, 0x35, 0x18, 0x6f, 0x31, 0x8e, 0x3d, 0x97,
+	0x13, 0xa5, 0xc3, 0x87, 0x6b, 0x1e, 0x11, 0xee, 0x1a, 0x0e, 0x5d, 0x9f, 0x06, 0xae, 0xa0, 0x2c,
+	0x50, 0x56, 0xf3, 0x82, 0xcf, 0x7c, 0x26, 0x1f, 0x71, 0xfc, 0xa4, 0xdf, 0x5e, 0xf6, 0x19, 0xf3,
+	0xf7, 0x09, 0x76, 0x43, 0x8a, 0xdd, 0x20, 0x60, 0x42, 0x5a, 0xb8, 0x3e, 0x5d, 0xd4, 0xa7, 0xf2,
+	0x97, 0xd7, 0x6e, 0x62, 0x41, 0x5b, 0x84, 0x0b, 0xb7, 0x15, 0x6a, 0xc1, 0x42, 0x1a, 0xd5, 0x27,
+	0x01, 0xe1, 0x54, 0xbb, 0xed, 0xd7, 0x60, 0xee, 0x45, 0xcc, 0xf4, 0x48, 0x1e, 0x3e, 0x0d, 0x9a,
+	0xcc, 0x21, 0x07, 0x6d, 0xc2, 0x05, 0x7c, 0x0c, 0xc0, 0x80, 0xaf, 0x64, 0x2c, 0x19, 0xd5, 0x99,
+	0x5a, 0x19, 0xa9, 0x30, 0x28, 0x0e, 0x83, 0x54, 0x68, 0x1d, 0x06, 0x3d, 0x77, 0x7d, 0xa2, 0xbd,
+	0x4e, 0xc2, 0x69, 0x7f, 0x31, 0xc0, 0xa5, 0x7f, 0x46, 0xf0, 0x90, 0x05, 0x9c, 0xc0, 0x75, 0x30,
+	0xa5, 0xa8, 0x4a, 0xc6, 0xd2, 0x78, 0x75, 0xa6, 0x56, 0x42, 0xa9, 0xdd, 0x21, 0x69, 0x89, 0x1d,
+	0xf5, 0x89, 0x93, 0x9f, 0x8b, 0x05, 0x47, 0xab, 0xe1, 0x93, 0x14, 0xdb, 0x98, 0x64, 0xab, 0xe4,
+	0xb2, 0xa9, 0xa1, 0x29, 0xb8, 0xbb, 0xa0, 0x24, 0xd9, 0x1e, 0xb6, 0xa3, 0x88, 0x04, 0x42, 0xce,
+	0xeb, 0x2f, 0xc0, 0x02, 0x80, 0xee, 0x92, 0x40, 0xd0, 0x26, 0x25, 0x91, 0x5c, 0xc0, 0xb4, 0x93,
+	0x78, 0x63, 0x3f, 0x00, 0xf3, 0x19, 0x5e, 0x9d, 0xec, 0x2a, 0x28, 0x36, 0xd4, 0xfb, 0x1d, 0xc9,
+	0x2c, 0xfd, 0xe3, 0xce, 0x6c, 0x23, 0x21, 0xb6, 0x37, 0xc0, 0xc5, 0xc1, 0x66, 0x92, 0xbb, 0xcf,
+	0x1b, 0xbd, 0x05, 0xe6, 0x86, 0x8d, 0x7a, 0xee, 0x6d, 0x30, 0x39, 0x98, 0x97, 0xbf, 0x50, 0x25,
+	0xb6, 0xef, 0xe9, 0x28, 0x5b, 0xa4, 0xa3, 0xd0, 0xb6, 0x69, 0x8b, 0x9c, 0x17, 0xe6, 0x0d, 0x30,
+	0xb3, 0xcc, 0x1a, 0x68, 0x13, 0xfc, 0x1f, 0x90, 0x8e, 0xde, 0xc2, 0x4e, 0xdc, 0x4d, 0x8d, 0x66,
+	0x22, 0x55, 0x5c, 0xd4, 0x2f, 0x2e, 0xda, 0xee, 0x17, 0xb7, 0xfe, 0x5f, 0x0c, 0x77, 0xfc, 0x6b,
+	0xd1, 0x70, 0x8a, 0x41, 0xf2, 0xd6, 0xda, 0xb7, 0x09, 0x30, 0x29, 0x87, 0xc1, 0xf7, 0x00, 0x9c,
+	0x85, 0xe1, 0x70, 0x65, 0x28, 0x67, 0x76, 0xa7, 0xcd, 0x72, 0x9e, 0x4c, 0x41, 0xdb, 0x57, 0x3e,
+	0x7c, 0xff, 0xf3, 0x79, 0x6c, 0x01, 0xce, 0xe3, 0x97, 0x52, 0xbf, 0xef, 0x7a, 0x1c, 0xa7, 0xfe,
+	0x46, 0xf0, 0x93, 0x01, 0x66, 0x93, 0x5f, 0x1e, 0x56, 0xb2, 0xee, 0xce, 0xe8, 0x95, 0x59, 0xcd,
+	0x17, 0x6a, 0x0c, 0x2c, 0x31, 0x56, 0x61, 0x65, 0x24, 0x06, 0x4e, 0x95, 0x0c, 0x7e, 0x34, 0xc0,
+	0xf4, 0xd9, 0x56, 0xe0, 0xf2, 0xc8, 0xb4, 0xc9, 0x9d, 0xac, 0xe4, 0xa8, 0x34, 0xcb, 0x75, 0xc9,
+	0x52, 0x86, 0xcb, 0xa3, 0x59, 0xd4, 0x27, 0xa6, 0xf1, 0xe8, 0xaf, 0x06, 0x28, 0xa6, 0xfa, 0x00,
+	0x33, 0x53, 0x67, 0xf5, 0xcd, 0x5c, 0x3d, 0x87, 0x52, 0x43, 0xdd, 0x97, 0x50, 0x77, 0xe0, 0xfa,
+	0x68, 0xa8, 0xa1, 0xf2, 0xe1, 0xb7, 0x83, 0xe6, 0xbe, 0xab, 0x3f, 0x3b, 0xe9, 0x5a, 0xc6, 0x69,
+	0xd7, 0x32, 0x7e, 0x77, 0x2d, 0xe3, 0xb8, 0x67, 0x15, 0x4e, 0x7b, 0x56, 0xe1, 0x47, 0xcf, 0x2a,
+	0xbc, 0xba, 0xe9, 0x53, 0xb1, 0xd7, 0xf6, 0x50, 0x83, 0xb5, 0xf4, 0xdd, 0x37, 0x36, 0x13, 0x97,
+	0x1f, 0xd6, 0x36, 0x70, 0xa7, 0x3f, 0x42, 0x1c, 0x85, 0x84, 0x7b, 0x53, 0xb2, 0xc7, 0xb7, 0xfe,
+	0x0e, 0x00, 0x6a, 0xe7, 0x90, 0x9d, 0x1a, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -367,6 +468,9 @@ type QueryClient interface {
 	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	EpochInfo(ctx context.Context, in *QueryEpochInfoRequest, opts ...grpc.CallOption) (*QueryEpochInfoResponse, error)
+	// NextEpochTime provides the time at which the next epoch of the specified
+	// identifier will start
+	NextEpochTime(ctx context.Context, in *QueryNextEpochTimeRequest, opts ...grpc.CallOption) (*QueryNextEpochTimeResponse, error)
 }
 
 type queryClient struct {
@@ -404,6 +508,15 @@ func (c *queryClient) EpochInfo(ctx context.Context, in *QueryEpochInfoRequest,
 	return out, nil
 }
 
+func (c *queryClient) NextEpochTime(ctx context.Context, in *QueryNextEpochTimeRequest, opts ...grpc.CallOption) (*QueryNextEpochTimeResponse, error) {
+	out := new(QueryNextEpochTimeResponse)
+	err := c.cc.Invoke(ctx, "/stride.epochs.Query/NextEpochTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// EpochInfos provide running epochInfos
@@ -412,6 +525,9 @@ type QueryServer interface {
 	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	EpochInfo(context.Context, *QueryEpochInfoRequest) (*QueryEpochInfoResponse, error)
+	// NextEpochTime provides the time at which the next epoch of the specified
+	// identifier will start
+	NextEpochTime(context.Context, *QueryNextEpochTimeRequest) (*QueryNextEpochTimeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -427,6 +543,9 @@ func (*UnimplementedQueryServer) CurrentEpoch(ctx context.Context, req *QueryCur
 func (*UnimplementedQueryServer) EpochInfo(ctx context.Context, req *QueryEpochInfoRequest) (*QueryEpochInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EpochInfo not implemented")
 }
+func (*UnimplementedQueryServer) NextEpochTime(ctx context.Context, req *QueryNextEpochTimeRequest) (*QueryNextEpochTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextEpochTime not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -486,6 +605,24 @@ func _Query_EpochInfo_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_NextEpochTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextEpochTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextEpochTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.epochs.Query/NextEpochTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextEpochTime(ctx, req.(*QueryNextEpochTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.epochs.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -502,6 +639,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EpochInfo",
 			Handler:    _Query_EpochInfo_Handler,
 		},
+		{
+			MethodName: "NextEpochTime",
+			Handler:    _Query_NextEpochTime_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stride/epochs/query.proto",
@@ -712,6 +853,67 @@ func (m *QueryEpochInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryNextEpochTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextEpochTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextEpochTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextEpochTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextEpochTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextEpochTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextEpochTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextEpochTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -804,6 +1006,30 @@ func (m *QueryEpochInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryNextEpochTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextEpochTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextEpochTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1332,6 +1558,171 @@ func (m *QueryEpochInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryNextEpochTimeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextEpochTimeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextEpochTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextEpochTimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextEpochTimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextEpochTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextEpochTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -27,6 +27,9 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdListCallbackData())
 	cmd.AddCommand(CmdShowCallbackData())
+	cmd.AddCommand(CmdListDecodedCallbackData())
+	cmd.AddCommand(CmdShowDecodedCallbackData())
+	cmd.AddCommand(CmdListCallbackRetries())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
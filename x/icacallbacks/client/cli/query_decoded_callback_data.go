@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/v27/x/icacallbacks/types"
+)
+
+const (
+	FlagCallbackId    = "callback-id"
+	FlagHostZone      = "host-zone"
+	FlagMinAgeSeconds = "min-age-seconds"
+)
+
+func CmdListDecodedCallbackData() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-decoded-callback-data",
+		Short: "list all callback-data with the callback args decoded",
+		Long: `List all pending callback-data with the callback args decoded.
+Optionally filter by callback ID, host zone, or the minimum age of the callback.
+
+Example:
+  $ strided q icacallbacks list-decoded-callback-data --callback-id delegate --host-zone cosmoshub-4 --min-age-seconds 3600`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			callbackId, err := cmd.Flags().GetString(FlagCallbackId)
+			if err != nil {
+				return err
+			}
+			hostZoneId, err := cmd.Flags().GetString(FlagHostZone)
+			if err != nil {
+				return err
+			}
+			minAgeSeconds, err := cmd.Flags().GetUint64(FlagMinAgeSeconds)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllDecodedCallbackDataRequest{
+				CallbackId:    callbackId,
+				HostZoneId:    hostZoneId,
+				MinAgeSeconds: minAgeSeconds,
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.DecodedCallbackDataAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagCallbackId, "", "Only return callbacks with this callback ID")
+	cmd.Flags().String(FlagHostZone, "", "Only return callbacks for ICAs sent to this host zone")
+	cmd.Flags().Uint64(FlagMinAgeSeconds, 0, "Only return callbacks that were stored at least this many seconds ago")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowDecodedCallbackData() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-decoded-callback-data [callback-key]",
+		Short: "shows a callback-data with the callback args decoded",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argCallbackKey := args[0]
+
+			params := &types.QueryDecodedCallbackDataRequest{
+				CallbackKey: argCallbackKey,
+			}
+
+			res, err := queryClient.DecodedCallbackData(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -14,6 +14,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.CallbackDataList {
 		k.SetCallbackData(ctx, elem)
 	}
+	// Set all the callbackRetry
+	for _, elem := range genState.CallbackRetryList {
+		k.SetCallbackRetry(ctx, elem)
+	}
 	k.SetParams(ctx, genState.Params)
 }
 
@@ -23,6 +27,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.Params = k.GetParams(ctx)
 
 	genesis.CallbackDataList = k.GetAllCallbackData(ctx)
+	genesis.CallbackRetryList = k.GetAllCallbackRetry(ctx)
 
 	return genesis
 }
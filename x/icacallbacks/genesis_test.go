@@ -23,6 +23,14 @@ func TestGenesis(t *testing.T) {
 				CallbackKey: "1",
 			},
 		},
+		CallbackRetryList: []types.CallbackRetry{
+			{
+				CallbackKey: "0",
+			},
+			{
+				CallbackKey: "1",
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -37,5 +45,6 @@ func TestGenesis(t *testing.T) {
 	require.Equal(t, genesisState.PortId, got.PortId)
 
 	require.ElementsMatch(t, genesisState.CallbackDataList, got.CallbackDataList)
+	require.ElementsMatch(t, genesisState.CallbackRetryList, got.CallbackRetryList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -0,0 +1,10 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker of icacallbacks module
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	k.ProcessCallbackRetries(ctx)
+}
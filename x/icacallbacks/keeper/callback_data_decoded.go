@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"reflect"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+
+	"github.com/Stride-Labs/stride/v27/x/icacallbacks/types"
+)
+
+// Returns the chain ID of the counterparty of the channel that the ICA was sent on
+func (k Keeper) GetChainIdFromChannel(ctx sdk.Context, portId, channelId string) (string, error) {
+	_, clientState, err := k.IBCKeeper.ChannelKeeper.GetChannelClientState(ctx, portId, channelId)
+	if err != nil {
+		return "", err
+	}
+	client, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return "", errorsmod.Wrapf(clienttypes.ErrInvalidClientType, "client state for channel %s is not tendermint", channelId)
+	}
+	return client.ChainId, nil
+}
+
+// Decodes the callback args using the type registered with the callback
+// If no type was registered for the callback, the args are left undecoded
+func (k Keeper) DecodeCallbackArgs(callbackData types.CallbackData) (argsType string, argsJson string, err error) {
+	callback, found := k.icacallbacks[callbackData.CallbackId]
+	if !found || callback.CallbackArgsType == nil {
+		return "", "", nil
+	}
+
+	// Create a new instance of the registered type to unmarshal into
+	args := reflect.New(reflect.TypeOf(callback.CallbackArgsType).Elem()).Interface().(proto.Message)
+	if err := proto.Unmarshal(callbackData.CallbackArgs, args); err != nil {
+		return "", "", errorsmod.Wrapf(types.ErrInvalidCallbackArgs, "unable to unmarshal %s args: %s",
+			callbackData.CallbackId, err.Error())
+	}
+
+	argsBz, err := codec.ProtoMarshalJSON(args, nil)
+	if err != nil {
+		return "", "", errorsmod.Wrapf(types.ErrInvalidCallbackArgs, "unable to marshal %s args to JSON: %s",
+			callbackData.CallbackId, err.Error())
+	}
+
+	return proto.MessageName(args), string(argsBz), nil
+}
+
+// Builds the query representation of callback data, with the args decoded
+// and the host zone resolved from the channel
+// If the args can't be decoded, the error is returned on the entry instead
+// so that a single bad entry doesn't fail the whole query
+func (k Keeper) GetDecodedCallbackData(ctx sdk.Context, callbackData types.CallbackData) types.DecodedCallbackData {
+	decodeError := ""
+	argsType, argsJson, err := k.DecodeCallbackArgs(callbackData)
+	if err != nil {
+		decodeError = err.Error()
+	}
+
+	// The channel may have since been closed, in which case the host zone is left empty
+	hostZoneId, err := k.GetChainIdFromChannel(ctx, callbackData.PortId, callbackData.ChannelId)
+	if err != nil {
+		hostZoneId = ""
+	}
+
+	return types.DecodedCallbackData{
+		CallbackData:     callbackData,
+		HostZoneId:       hostZoneId,
+		CallbackArgsType: argsType,
+		CallbackArgsJson: argsJson,
+		DecodeError:      decodeError,
+	}
+}
+
+// Checks whether decoded callback data matches each of the specified query filters
+// Empty filters are ignored
+func (k Keeper) CallbackDataMatchesFilters(
+	ctx sdk.Context,
+	decodedData types.DecodedCallbackData,
+	callbackId string,
+	hostZoneId string,
+	minAgeSeconds uint64,
+) bool {
+	if callbackId != "" && decodedData.CallbackData.CallbackId != callbackId {
+		return false
+	}
+	if hostZoneId != "" && decodedData.HostZoneId != hostZoneId {
+		return false
+	}
+	if minAgeSeconds > 0 {
+		minAge := time.Duration(minAgeSeconds) * time.Second
+		if decodedData.CallbackData.CreatedAt.Add(minAge).After(ctx.BlockTime()) {
+			return false
+		}
+	}
+	return true
+}
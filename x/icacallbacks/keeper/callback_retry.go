@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v27/x/icacallbacks/types"
+)
+
+// SetCallbackRetry set a specific callbackRetry in the store from its index
+func (k Keeper) SetCallbackRetry(ctx sdk.Context, callbackRetry types.CallbackRetry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CallbackRetryKeyPrefix))
+	b := k.cdc.MustMarshal(&callbackRetry)
+	store.Set(types.CallbackDataKey(callbackRetry.CallbackKey), b)
+}
+
+// GetCallbackRetry returns a callbackRetry from its index
+func (k Keeper) GetCallbackRetry(ctx sdk.Context, callbackKey string) (val types.CallbackRetry, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CallbackRetryKeyPrefix))
+
+	b := store.Get(types.CallbackDataKey(callbackKey))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveCallbackRetry removes a callbackRetry from the store
+func (k Keeper) RemoveCallbackRetry(ctx sdk.Context, callbackKey string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CallbackRetryKeyPrefix))
+	store.Delete(types.CallbackDataKey(callbackKey))
+}
+
+// GetAllCallbackRetry returns all callbackRetry
+func (k Keeper) GetAllCallbackRetry(ctx sdk.Context) (list []types.CallbackRetry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CallbackRetryKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.CallbackRetry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
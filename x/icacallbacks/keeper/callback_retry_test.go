@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Stride-Labs/stride/v27/testutil/keeper"
+	"github.com/Stride-Labs/stride/v27/testutil/nullify"
+	"github.com/Stride-Labs/stride/v27/x/icacallbacks/keeper"
+	"github.com/Stride-Labs/stride/v27/x/icacallbacks/types"
+)
+
+func createNCallbackRetry(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.CallbackRetry {
+	items := make([]types.CallbackRetry, n)
+	for i := range items {
+		items[i].CallbackKey = strconv.Itoa(i)
+
+		keeper.SetCallbackRetry(ctx, items[i])
+	}
+	return items
+}
+
+func TestCallbackRetryGet(t *testing.T) {
+	keeper, ctx := keepertest.IcacallbacksKeeper(t)
+	items := createNCallbackRetry(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetCallbackRetry(ctx, item.CallbackKey)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestCallbackRetryRemove(t *testing.T) {
+	keeper, ctx := keepertest.IcacallbacksKeeper(t)
+	items := createNCallbackRetry(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveCallbackRetry(ctx, item.CallbackKey)
+		_, found := keeper.GetCallbackRetry(ctx, item.CallbackKey)
+		require.False(t, found)
+	}
+}
+
+func TestCallbackRetryGetAll(t *testing.T) {
+	keeper, ctx := keepertest.IcacallbacksKeeper(t)
+	items := createNCallbackRetry(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllCallbackRetry(ctx)),
+	)
+}
@@ -55,3 +55,58 @@ func (k Keeper) CallbackData(c context.Context, req *types.QueryGetCallbackDataR
 
 	return &types.QueryGetCallbackDataResponse{CallbackData: val}, nil
 }
+
+func (k Keeper) DecodedCallbackDataAll(
+	c context.Context,
+	req *types.QueryAllDecodedCallbackDataRequest,
+) (*types.QueryAllDecodedCallbackDataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var decodedCallbackDatas []types.DecodedCallbackData
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	callbackDataStore := prefix.NewStore(store, types.KeyPrefix(types.CallbackDataKeyPrefix))
+
+	pageRes, err := query.FilteredPaginate(callbackDataStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var callbackData types.CallbackData
+		if err := k.cdc.Unmarshal(value, &callbackData); err != nil {
+			return false, err
+		}
+
+		decodedData := k.GetDecodedCallbackData(ctx, callbackData)
+		if !k.CallbackDataMatchesFilters(ctx, decodedData, req.CallbackId, req.HostZoneId, req.MinAgeSeconds) {
+			return false, nil
+		}
+
+		if accumulate {
+			decodedCallbackDatas = append(decodedCallbackDatas, decodedData)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllDecodedCallbackDataResponse{CallbackData: decodedCallbackDatas, Pagination: pageRes}, nil
+}
+
+func (k Keeper) DecodedCallbackData(
+	c context.Context,
+	req *types.QueryDecodedCallbackDataRequest,
+) (*types.QueryDecodedCallbackDataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	callbackData, found := k.GetCallbackData(ctx, req.CallbackKey)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	decodedData := k.GetDecodedCallbackData(ctx, callbackData)
+	return &types.QueryDecodedCallbackDataResponse{CallbackData: decodedData}, nil
+}
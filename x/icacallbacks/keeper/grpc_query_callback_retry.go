@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Stride-Labs/stride/v27/x/icacallbacks/types"
+)
+
+func (k Keeper) CallbackRetries(c context.Context, req *types.QueryCallbackRetriesRequest) (*types.QueryCallbackRetriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var callbackRetries []types.CallbackRetry
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	callbackRetryStore := prefix.NewStore(store, types.KeyPrefix(types.CallbackRetryKeyPrefix))
+
+	pageRes, err := query.Paginate(callbackRetryStore, req.Pagination, func(key []byte, value []byte) error {
+		var callbackRetry types.CallbackRetry
+		if err := k.cdc.Unmarshal(value, &callbackRetry); err != nil {
+			return err
+		}
+
+		callbackRetries = append(callbackRetries, callbackRetry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCallbackRetriesResponse{CallbackRetries: callbackRetries, Pagination: pageRes}, nil
+}
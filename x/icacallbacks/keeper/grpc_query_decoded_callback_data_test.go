@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/Stride-Labs/stride/v27/x/icacallbacks/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/v27/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/v27/x/stakeibc/types"
+)
+
+// Stores callback data for a delegation ICA, returning the callback key
+func (s *KeeperTestSuite) setDelegateCallbackData(channelId, portId string, sequence uint64, createdAt time.Time) string {
+	callbackArgs := stakeibctypes.DelegateCallback{
+		HostZoneId:      HostChainId,
+		DepositRecordId: sequence,
+	}
+	callbackArgsBz, err := proto.Marshal(&callbackArgs)
+	s.Require().NoError(err, "no error expected when marshalling callback args")
+
+	callbackKey := types.PacketID(portId, channelId, sequence)
+	s.App.IcacallbacksKeeper.SetCallbackData(s.Ctx, types.CallbackData{
+		CallbackKey:  callbackKey,
+		PortId:       portId,
+		ChannelId:    channelId,
+		Sequence:     sequence,
+		CallbackId:   stakeibckeeper.ICACallbackID_Delegate,
+		CallbackArgs: callbackArgsBz,
+		CreatedAt:    createdAt,
+	})
+	return callbackKey
+}
+
+func (s *KeeperTestSuite) TestQueryDecodedCallbackData() {
+	channelId, portId := s.CreateICAChannel(HostChainId + ".DELEGATION")
+	callbackKey := s.setDelegateCallbackData(channelId, portId, 1, s.Ctx.BlockTime())
+
+	resp, err := s.App.IcacallbacksKeeper.DecodedCallbackData(sdk.WrapSDKContext(s.Ctx), &types.QueryDecodedCallbackDataRequest{
+		CallbackKey: callbackKey,
+	})
+	s.Require().NoError(err, "no error expected when querying decoded callback data")
+
+	decodedData := resp.CallbackData
+	s.Require().Equal(callbackKey, decodedData.CallbackData.CallbackKey, "callback key")
+	s.Require().Equal(HostChainId, decodedData.HostZoneId, "host zone")
+	s.Require().Equal("stride.stakeibc.DelegateCallback", decodedData.CallbackArgsType, "callback args type")
+	s.Require().Contains(decodedData.CallbackArgsJson, `"host_zone_id":"GAIA"`, "callback args host zone")
+	s.Require().Contains(decodedData.CallbackArgsJson, `"deposit_record_id":"1"`, "callback args deposit record")
+
+	// Callback data without a registered args type should be returned undecoded
+	unregisteredKey := types.PacketID(portId, channelId, 2)
+	s.App.IcacallbacksKeeper.SetCallbackData(s.Ctx, types.CallbackData{
+		CallbackKey:  unregisteredKey,
+		PortId:       portId,
+		ChannelId:    channelId,
+		CallbackId:   "unregistered",
+		CallbackArgs: []byte{1, 2, 3},
+	})
+	resp, err = s.App.IcacallbacksKeeper.DecodedCallbackData(sdk.WrapSDKContext(s.Ctx), &types.QueryDecodedCallbackDataRequest{
+		CallbackKey: unregisteredKey,
+	})
+	s.Require().NoError(err, "no error expected when querying unregistered callback data")
+	s.Require().Equal(HostChainId, resp.CallbackData.HostZoneId, "host zone for unregistered callback")
+	s.Require().Empty(resp.CallbackData.CallbackArgsType, "args type for unregistered callback")
+	s.Require().Empty(resp.CallbackData.CallbackArgsJson, "args JSON for unregistered callback")
+
+	// Callback args that can't be decoded should be returned undecoded with the decode error
+	invalidKey := types.PacketID(portId, channelId, 3)
+	s.App.IcacallbacksKeeper.SetCallbackData(s.Ctx, types.CallbackData{
+		CallbackKey:  invalidKey,
+		CallbackId:   stakeibckeeper.ICACallbackID_Delegate,
+		CallbackArgs: []byte{1, 2, 3},
+	})
+	resp, err = s.App.IcacallbacksKeeper.DecodedCallbackData(sdk.WrapSDKContext(s.Ctx), &types.QueryDecodedCallbackDataRequest{
+		CallbackKey: invalidKey,
+	})
+	s.Require().NoError(err, "no error expected when querying callback data with invalid args")
+	s.Require().Equal([]byte{1, 2, 3}, resp.CallbackData.CallbackData.CallbackArgs, "raw args for invalid callback")
+	s.Require().Empty(resp.CallbackData.CallbackArgsJson, "args JSON for invalid callback")
+	s.Require().Contains(resp.CallbackData.DecodeError, "unable to unmarshal delegate args", "decode error")
+
+	// Missing callback data should return not found
+	_, err = s.App.IcacallbacksKeeper.DecodedCallbackData(sdk.WrapSDKContext(s.Ctx), &types.QueryDecodedCallbackDataRequest{
+		CallbackKey: "missing",
+	})
+	s.Require().ErrorContains(err, "not found")
+}
+
+func (s *KeeperTestSuite) TestQueryDecodedCallbackDataAll() {
+	channelId, portId := s.CreateICAChannel(HostChainId + ".DELEGATION")
+
+	oldTime := s.Ctx.BlockTime()
+	newTime := oldTime.Add(time.Hour)
+
+	// Old delegation on the host
+	oldDelegationKey := s.setDelegateCallbackData(channelId, portId, 1, oldTime)
+	// New delegation on the host
+	newDelegationKey := s.setDelegateCallbackData(channelId, portId, 2, newTime)
+	// Old delegation on a channel that no longer exists
+	otherHostKey := s.setDelegateCallbackData("channel-100", portId, 3, oldTime)
+	// Old delegation on the host with args that can't be decoded
+	invalidArgsKey := types.PacketID(portId, channelId, 5)
+	s.App.IcacallbacksKeeper.SetCallbackData(s.Ctx, types.CallbackData{
+		CallbackKey:  invalidArgsKey,
+		PortId:       portId,
+		ChannelId:    channelId,
+		CallbackId:   stakeibckeeper.ICACallbackID_Delegate,
+		CallbackArgs: []byte{1, 2, 3},
+		CreatedAt:    oldTime,
+	})
+	// Old transfer on the host (records callback)
+	transferKey := types.PacketID(portId, channelId, 4)
+	s.App.IcacallbacksKeeper.SetCallbackData(s.Ctx, types.CallbackData{
+		CallbackKey: transferKey,
+		PortId:      portId,
+		ChannelId:   channelId,
+		CallbackId:  "transfer",
+		CreatedAt:   oldTime,
+	})
+
+	s.Ctx = s.Ctx.WithBlockTime(newTime)
+
+	testCases := []struct {
+		name          string
+		callbackId    string
+		hostZoneId    string
+		minAgeSeconds uint64
+		expectedKeys  []string
+	}{
+		{
+			name:         "no filters",
+			expectedKeys: []string{oldDelegationKey, newDelegationKey, otherHostKey, invalidArgsKey, transferKey},
+		},
+		{
+			name:         "filter by callback id",
+			callbackId:   stakeibckeeper.ICACallbackID_Delegate,
+			expectedKeys: []string{oldDelegationKey, newDelegationKey, otherHostKey, invalidArgsKey},
+		},
+		{
+			name:         "filter by host zone",
+			hostZoneId:   HostChainId,
+			expectedKeys: []string{oldDelegationKey, newDelegationKey, invalidArgsKey, transferKey},
+		},
+		{
+			name:          "filter by age",
+			minAgeSeconds: 1800,
+			expectedKeys:  []string{oldDelegationKey, otherHostKey, invalidArgsKey, transferKey},
+		},
+		{
+			name:          "all filters",
+			callbackId:    stakeibckeeper.ICACallbackID_Delegate,
+			hostZoneId:    HostChainId,
+			minAgeSeconds: 1800,
+			expectedKeys:  []string{oldDelegationKey, invalidArgsKey},
+		},
+		{
+			name:         "no matches",
+			hostZoneId:   "OSMO",
+			expectedKeys: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			resp, err := s.App.IcacallbacksKeeper.DecodedCallbackDataAll(sdk.WrapSDKContext(s.Ctx), &types.QueryAllDecodedCallbackDataRequest{
+				CallbackId:    tc.callbackId,
+				HostZoneId:    tc.hostZoneId,
+				MinAgeSeconds: tc.minAgeSeconds,
+			})
+			s.Require().NoError(err, "no error expected when querying decoded callback data")
+
+			actualKeys := []string{}
+			for _, decodedData := range resp.CallbackData {
+				actualKeys = append(actualKeys, decodedData.CallbackData.CallbackKey)
+
+				// Only the entry with invalid args should have a decode error
+				if decodedData.CallbackData.CallbackKey == invalidArgsKey {
+					s.Require().NotEmpty(decodedData.DecodeError, "decode error for invalid args")
+				} else {
+					s.Require().Empty(decodedData.DecodeError, "decode error for %s", decodedData.CallbackData.CallbackKey)
+				}
+			}
+			s.Require().ElementsMatch(tc.expectedKeys, actualKeys, "callback keys")
+			s.Require().Equal(uint64(len(tc.expectedKeys)), resp.Pagination.Total, "pagination total")
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/cometbft/cometbft/libs/log"
@@ -11,6 +12,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/keeper"
+	ibctransferkeeper "github.com/cosmos/ibc-go/v7/modules/apps/transfer/keeper"
 	ibckeeper "github.com/cosmos/ibc-go/v7/modules/core/keeper"
 
 	"github.com/Stride-Labs/stride/v27/x/icacallbacks/types"
@@ -22,12 +25,14 @@ import (
 
 type (
 	Keeper struct {
-		cdc          codec.BinaryCodec
-		storeKey     storetypes.StoreKey
-		memKey       storetypes.StoreKey
-		paramstore   paramtypes.Subspace
-		icacallbacks map[string]types.ICACallback
-		IBCKeeper    ibckeeper.Keeper
+		cdc                 codec.BinaryCodec
+		storeKey            storetypes.StoreKey
+		memKey              storetypes.StoreKey
+		paramstore          paramtypes.Subspace
+		icacallbacks        map[string]types.ICACallback
+		IBCKeeper           ibckeeper.Keeper
+		ICAControllerKeeper icacontrollerkeeper.Keeper
+		TransferKeeper      ibctransferkeeper.Keeper
 	}
 )
 
@@ -37,6 +42,8 @@ func NewKeeper(
 	memKey storetypes.StoreKey,
 	ps paramtypes.Subspace,
 	ibcKeeper ibckeeper.Keeper,
+	icaControllerKeeper icacontrollerkeeper.Keeper,
+	transferKeeper ibctransferkeeper.Keeper,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -44,12 +51,14 @@ func NewKeeper(
 	}
 
 	return &Keeper{
-		cdc:          cdc,
-		storeKey:     storeKey,
-		memKey:       memKey,
-		paramstore:   ps,
-		icacallbacks: make(map[string]types.ICACallback),
-		IBCKeeper:    ibcKeeper,
+		cdc:                 cdc,
+		storeKey:            storeKey,
+		memKey:              memKey,
+		paramstore:          ps,
+		icacallbacks:        make(map[string]types.ICACallback),
+		IBCKeeper:           ibcKeeper,
+		ICAControllerKeeper: icaControllerKeeper,
+		TransferKeeper:      transferKeeper,
 	}
 }
 
@@ -85,8 +94,20 @@ func (k Keeper) CallRegisteredICACallback(ctx sdk.Context, packet channeltypes.P
 		k.Logger(ctx).Info(fmt.Sprintf("No associated callback with callback data %v", callbackData))
 		return nil
 	}
+
+	// Let the callback know whether it's allowed to request a retry
+	ackResponse.CanRetry = IsRetryablePort(packet.SourcePort) &&
+		callbackData.RetryAttempts < k.GetParams(ctx).MaxRetryAttempts
+
 	if err := callback.CallbackFunc(ctx, packet, ackResponse, callbackData.CallbackArgs); err != nil {
-		return errorsmod.Wrapf(err, "failed to invoke icacallback %s", callbackData.CallbackId)
+		// If the callback indicated that the ICA can be retried, queue it to be re-submitted
+		// instead of failing the callback
+		if !errors.Is(err, types.ErrCallbackRetryable) {
+			return errorsmod.Wrapf(err, "failed to invoke icacallback %s", callbackData.CallbackId)
+		}
+		if err := k.QueueCallbackRetry(ctx, packet, callbackData, err); err != nil {
+			return errorsmod.Wrapf(err, "failed to queue retry for icacallback %s", callbackData.CallbackId)
+		}
 	}
 
 	// remove the callback data
@@ -0,0 +1,26 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Stride-Labs/stride/v27/app/apptesting"
+	"github.com/Stride-Labs/stride/v27/x/icacallbacks/types"
+)
+
+const HostChainId = "GAIA"
+
+type KeeperTestSuite struct {
+	apptesting.AppTestHelper
+	QueryClient types.QueryClient
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.Setup()
+	s.QueryClient = types.NewQueryClient(s.QueryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,286 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/Stride-Labs/stride/v27/utils"
+	"github.com/Stride-Labs/stride/v27/x/icacallbacks/types"
+)
+
+// The backoff stops doubling after this many attempts to prevent an overflow
+const maxRetryBackoffDoublings = 16
+
+// Returns the delay before the next retry, which doubles with each attempt
+func GetRetryBackoff(backoffSeconds uint64, attempts uint64) time.Duration {
+	if attempts > maxRetryBackoffDoublings {
+		attempts = maxRetryBackoffDoublings
+	}
+	return time.Duration(backoffSeconds<<attempts) * time.Second
+}
+
+// Emits an event for a change in the status of a callback retry
+func EmitCallbackRetryEvent(ctx sdk.Context, eventType string, retry types.CallbackRetry) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyCallbackKey, retry.CallbackKey),
+			sdk.NewAttribute(types.AttributeKeyCallbackId, retry.CallbackId),
+			sdk.NewAttribute(types.AttributeKeyRetryAttempt, fmt.Sprintf("%d", retry.Attempts)),
+			sdk.NewAttribute(types.AttributeKeyAckError, retry.Error),
+		),
+	)
+}
+
+// Retries are supported for ICAs (which are re-sent from the same ICA) and for
+// ICS-20 transfers (which are re-sent from the same sender)
+func IsRetryablePort(portId string) bool {
+	return strings.HasPrefix(portId, icatypes.ControllerPortPrefix) || portId == transfertypes.PortID
+}
+
+// Queues a packet to be re-submitted after its callback returned a retryable error
+// The original packet data is re-sent as is, and the same callback is re-registered
+// with the new packet
+// If the packet has already been retried the max number of times, an error is returned
+func (k Keeper) QueueCallbackRetry(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	callbackData types.CallbackData,
+	callbackErr error,
+) error {
+	if !IsRetryablePort(packet.SourcePort) {
+		return errorsmod.Wrapf(types.ErrRetryNotSupported, "port %s", packet.SourcePort)
+	}
+
+	params := k.GetParams(ctx)
+	if callbackData.RetryAttempts >= params.MaxRetryAttempts {
+		return errorsmod.Wrapf(types.ErrMaxRetryAttempts, "packet retried %d times, latest error: %s",
+			callbackData.RetryAttempts, callbackErr.Error())
+	}
+
+	channel, found := k.IBCKeeper.ChannelKeeper.GetChannel(ctx, packet.SourcePort, packet.SourceChannel)
+	if !found || len(channel.ConnectionHops) == 0 {
+		return errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port %s, channel %s", packet.SourcePort, packet.SourceChannel)
+	}
+
+	backoff := GetRetryBackoff(params.RetryBackoffSeconds, callbackData.RetryAttempts)
+	retry := types.CallbackRetry{
+		CallbackKey:   callbackData.CallbackKey,
+		PortId:        packet.SourcePort,
+		ChannelId:     packet.SourceChannel,
+		ConnectionId:  channel.ConnectionHops[0],
+		PacketData:    packet.GetData(),
+		CallbackId:    callbackData.CallbackId,
+		CallbackArgs:  callbackData.CallbackArgs,
+		Attempts:      callbackData.RetryAttempts,
+		NextRetryTime: utils.IntToUint(ctx.BlockTime().Add(backoff).UnixNano()),
+		Error:         callbackErr.Error(),
+	}
+	k.SetCallbackRetry(ctx, retry)
+
+	k.Logger(ctx).Info(fmt.Sprintf("Queued retry for packet %s (callback %s) in %s, error: %s",
+		retry.CallbackKey, retry.CallbackId, backoff, retry.Error))
+	EmitCallbackRetryEvent(ctx, types.EventTypeCallbackRetryQueued, retry)
+
+	return nil
+}
+
+// Checks the state of the channel that a retry was originally sent on
+// Returns whether the channel is open, and whether the channel has been
+// replaced, in which case the retry should be dropped
+// ICA channels are ORDERED, so a timeout closes the channel and the owning module
+// re-queues its in-progress work when the channel is restored under a new ID
+// Re-submitting the retry on top of that would duplicate the ICA
+func (k Keeper) CheckRetryChannel(ctx sdk.Context, retry types.CallbackRetry) (open bool, replaced bool) {
+	if strings.HasPrefix(retry.PortId, icatypes.ControllerPortPrefix) {
+		activeChannelId, found := k.ICAControllerKeeper.GetActiveChannelID(ctx, retry.ConnectionId, retry.PortId)
+		if !found || activeChannelId != retry.ChannelId {
+			return false, true
+		}
+	}
+
+	channel, found := k.IBCKeeper.ChannelKeeper.GetChannel(ctx, retry.PortId, retry.ChannelId)
+	if !found {
+		return false, true
+	}
+	return channel.State == channeltypes.OPEN, false
+}
+
+// Re-sends the original ICA tx from the same ICA account and returns the new sequence number
+func (k Keeper) submitICARetry(ctx sdk.Context, retry types.CallbackRetry) (sequence uint64, err error) {
+	var packetData icatypes.InterchainAccountPacketData
+	if err := icatypes.ModuleCdc.UnmarshalJSON(retry.PacketData, &packetData); err != nil {
+		return 0, errorsmod.Wrapf(types.ErrInvalidPacketData, "unable to unmarshal ICA packet data: %s", err.Error())
+	}
+
+	owner := strings.TrimPrefix(retry.PortId, icatypes.ControllerPortPrefix)
+	timeoutNanos := utils.IntToUint((time.Duration(k.GetParams(ctx).RetryTimeoutSeconds) * time.Second).Nanoseconds())
+
+	msgServer := icacontrollerkeeper.NewMsgServerImpl(&k.ICAControllerKeeper)
+	msgSendTx := icacontrollertypes.NewMsgSendTx(owner, retry.ConnectionId, timeoutNanos, packetData)
+	res, err := msgServer.SendTx(ctx, msgSendTx)
+	if err != nil {
+		return 0, err
+	}
+	return res.Sequence, nil
+}
+
+// Re-sends the original ICS-20 transfer from the same sender and returns the new sequence number
+// The tokens are refunded to the sender when the original transfer fails, so they're
+// available to be sent again
+func (k Keeper) submitTransferRetry(ctx sdk.Context, retry types.CallbackRetry) (sequence uint64, err error) {
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(retry.PacketData, &packetData); err != nil {
+		return 0, errorsmod.Wrapf(types.ErrInvalidPacketData, "unable to unmarshal transfer packet data: %s", err.Error())
+	}
+	amount, ok := sdkmath.NewIntFromString(packetData.Amount)
+	if !ok {
+		return 0, errorsmod.Wrapf(types.ErrInvalidPacketData, "invalid transfer amount %s", packetData.Amount)
+	}
+
+	// The packet denom is the full trace, which needs to be converted back to the local denom
+	denom := transfertypes.ParseDenomTrace(packetData.Denom).IBCDenom()
+	timeout := ctx.BlockTime().Add(time.Duration(k.GetParams(ctx).RetryTimeoutSeconds) * time.Second)
+
+	msgTransfer := transfertypes.NewMsgTransfer(
+		retry.PortId,
+		retry.ChannelId,
+		sdk.NewCoin(denom, amount),
+		packetData.Sender,
+		packetData.Receiver,
+		clienttypes.Height{},
+		utils.IntToUint(timeout.UnixNano()),
+		packetData.Memo,
+	)
+	res, err := k.TransferKeeper.Transfer(sdk.WrapSDKContext(ctx), msgTransfer)
+	if err != nil {
+		return 0, err
+	}
+	return res.Sequence, nil
+}
+
+// Re-submits the packet from a queued retry and registers the callback for the new packet
+func (k Keeper) SubmitCallbackRetry(ctx sdk.Context, retry types.CallbackRetry) (err error) {
+	var sequence uint64
+	if retry.PortId == transfertypes.PortID {
+		sequence, err = k.submitTransferRetry(ctx, retry)
+	} else {
+		sequence, err = k.submitICARetry(ctx, retry)
+	}
+	if err != nil {
+		return err
+	}
+
+	k.SetCallbackData(ctx, types.CallbackData{
+		CallbackKey:   types.PacketID(retry.PortId, retry.ChannelId, sequence),
+		PortId:        retry.PortId,
+		ChannelId:     retry.ChannelId,
+		Sequence:      sequence,
+		CallbackId:    retry.CallbackId,
+		CallbackArgs:  retry.CallbackArgs,
+		RetryAttempts: retry.Attempts + 1,
+		CreatedAt:     ctx.BlockTime(),
+	})
+
+	return nil
+}
+
+// Invokes the callback one last time with a failed ack once a retry is abandoned,
+// so that the owning module can apply its terminal failure handling
+func (k Keeper) FinalizeCallbackRetry(ctx sdk.Context, retry types.CallbackRetry) error {
+	callback, found := k.icacallbacks[retry.CallbackId]
+	if !found {
+		return errorsmod.Wrapf(types.ErrCallbackIdNotFound, "callback %s", retry.CallbackId)
+	}
+
+	packet := channeltypes.Packet{
+		SourcePort:    retry.PortId,
+		SourceChannel: retry.ChannelId,
+		Data:          retry.PacketData,
+	}
+	ackResponse := types.AcknowledgementResponse{
+		Status:   types.AckResponseStatus_FAILURE,
+		Error:    retry.Error,
+		CanRetry: false,
+	}
+	return callback.CallbackFunc(ctx, packet, &ackResponse, retry.CallbackArgs)
+}
+
+// Submits each queued retry whose backoff has elapsed
+//   - If the channel is closed, the retry waits (without counting an attempt)
+//   - If the ICA channel was restored under a new ID, the retry is dropped since
+//     the restore re-queues the work
+//   - If the re-submission itself fails, it counts as an attempt and the retry is
+//     rescheduled, unless the max number of attempts has been reached, in which
+//     case the callback is invoked with a final failed ack
+func (k Keeper) ProcessCallbackRetries(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	for _, retry := range k.GetAllCallbackRetry(ctx) {
+		nextRetryTime := time.Unix(0, utils.UintToInt(retry.NextRetryTime))
+		if nextRetryTime.After(ctx.BlockTime()) {
+			continue
+		}
+
+		open, replaced := k.CheckRetryChannel(ctx, retry)
+		if replaced {
+			k.RemoveCallbackRetry(ctx, retry.CallbackKey)
+			k.Logger(ctx).Info(fmt.Sprintf("Dropping retry for packet %s (callback %s) since channel %s was replaced",
+				retry.CallbackKey, retry.CallbackId, retry.ChannelId))
+			EmitCallbackRetryEvent(ctx, types.EventTypeCallbackRetryDropped, retry)
+			continue
+		}
+		if !open {
+			backoff := GetRetryBackoff(params.RetryBackoffSeconds, 0)
+			retry.NextRetryTime = utils.IntToUint(ctx.BlockTime().Add(backoff).UnixNano())
+			k.SetCallbackRetry(ctx, retry)
+			continue
+		}
+
+		k.RemoveCallbackRetry(ctx, retry.CallbackKey)
+
+		err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.SubmitCallbackRetry(ctx, retry)
+		})
+		if err == nil {
+			k.Logger(ctx).Info(fmt.Sprintf("Submitted retry #%d for packet %s (callback %s)",
+				retry.Attempts+1, retry.CallbackKey, retry.CallbackId))
+			EmitCallbackRetryEvent(ctx, types.EventTypeCallbackRetrySubmitted, retry)
+			continue
+		}
+
+		retry.Attempts++
+		retry.Error = err.Error()
+		if retry.Attempts >= params.MaxRetryAttempts {
+			k.Logger(ctx).Error(fmt.Sprintf("Abandoning retry for packet %s (callback %s) after %d attempts, error: %s",
+				retry.CallbackKey, retry.CallbackId, retry.Attempts, retry.Error))
+			EmitCallbackRetryEvent(ctx, types.EventTypeCallbackRetryAbandoned, retry)
+
+			if err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+				return k.FinalizeCallbackRetry(ctx, retry)
+			}); err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("Failed to finalize abandoned retry for packet %s (callback %s): %s",
+					retry.CallbackKey, retry.CallbackId, err.Error()))
+			}
+			continue
+		}
+
+		backoff := GetRetryBackoff(params.RetryBackoffSeconds, retry.Attempts)
+		retry.NextRetryTime = utils.IntToUint(ctx.BlockTime().Add(backoff).UnixNano())
+		k.SetCallbackRetry(ctx, retry)
+
+		k.Logger(ctx).Error(fmt.Sprintf("Failed to submit retry for packet %s (callback %s), retrying in %s, error: %s",
+			retry.CallbackKey, retry.CallbackId, backoff, retry.Error))
+	}
+}
@@ -0,0 +1,270 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v27/x/icacallbacks/keeper"
+	"github.com/Stride-Labs/stride/v27/x/icacallbacks/types"
+)
+
+const (
+	RetryableCallbackId = "retryable"
+	FailingCallbackId   = "failing"
+)
+
+type RetryTestCase struct {
+	packet       channeltypes.Packet
+	callbackData types.CallbackData
+	portId       string
+	channelId    string
+	acks         *[]types.AcknowledgementResponse
+}
+
+// Creates an ICA channel, registers a retryable and non-retryable callback,
+// and stores callback data for a packet sent on the channel
+func (s *KeeperTestSuite) SetupCallbackRetry(callbackId string) RetryTestCase {
+	owner := HostChainId + ".DELEGATION"
+	channelId, portId := s.CreateICAChannel(owner)
+
+	// The retryable callback records each ack it's invoked with
+	acks := []types.AcknowledgementResponse{}
+	err := s.App.IcacallbacksKeeper.SetICACallbacks(types.ModuleCallbacks{
+		{
+			CallbackId: RetryableCallbackId,
+			CallbackFunc: func(_ sdk.Context, _ channeltypes.Packet, ackResponse *types.AcknowledgementResponse, _ []byte) error {
+				acks = append(acks, *ackResponse)
+				return errorsmod.Wrap(types.ErrCallbackRetryable, "host busy")
+			},
+		},
+		{
+			CallbackId: FailingCallbackId,
+			CallbackFunc: func(sdk.Context, channeltypes.Packet, *types.AcknowledgementResponse, []byte) error {
+				return errors.New("callback failed")
+			},
+		},
+	})
+	s.Require().NoError(err, "no error expected when registering callbacks")
+
+	// Build the packet data from a bank send
+	data, err := icatypes.SerializeCosmosTx(s.App.AppCodec(), []proto.Message{&banktypes.MsgSend{
+		FromAddress: "from",
+		ToAddress:   "to",
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)),
+	}})
+	s.Require().NoError(err, "no error expected when serializing ICA tx")
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	sequence := uint64(1)
+	packet := channeltypes.Packet{
+		SourcePort:    portId,
+		SourceChannel: channelId,
+		Sequence:      sequence,
+		Data:          packetData.GetBytes(),
+	}
+	callbackData := types.CallbackData{
+		CallbackKey:  types.PacketID(portId, channelId, sequence),
+		PortId:       portId,
+		ChannelId:    channelId,
+		Sequence:     sequence,
+		CallbackId:   callbackId,
+		CallbackArgs: []byte{1, 2, 3},
+	}
+	s.App.IcacallbacksKeeper.SetCallbackData(s.Ctx, callbackData)
+
+	return RetryTestCase{
+		packet:       packet,
+		callbackData: callbackData,
+		portId:       portId,
+		channelId:    channelId,
+		acks:         &acks,
+	}
+}
+
+func TestGetRetryBackoff(t *testing.T) {
+	require.Equal(t, time.Minute, keeper.GetRetryBackoff(60, 0), "first attempt")
+	require.Equal(t, 2*time.Minute, keeper.GetRetryBackoff(60, 1), "second attempt")
+	require.Equal(t, 8*time.Minute, keeper.GetRetryBackoff(60, 3), "fourth attempt")
+	require.Equal(t, keeper.GetRetryBackoff(60, 16), keeper.GetRetryBackoff(60, 100), "capped")
+}
+
+func (s *KeeperTestSuite) TestCallRegisteredICACallback_Retryable() {
+	tc := s.SetupCallbackRetry(RetryableCallbackId)
+
+	ackResponse := types.AcknowledgementResponse{Status: types.AckResponseStatus_TIMEOUT}
+	err := s.App.IcacallbacksKeeper.CallRegisteredICACallback(s.Ctx, tc.packet, &ackResponse)
+	s.Require().NoError(err, "no error expected when calling a retryable callback")
+
+	// The callback data should be removed and the retry should be queued
+	_, found := s.App.IcacallbacksKeeper.GetCallbackData(s.Ctx, tc.callbackData.CallbackKey)
+	s.Require().False(found, "callback data should have been removed")
+
+	retry, found := s.App.IcacallbacksKeeper.GetCallbackRetry(s.Ctx, tc.callbackData.CallbackKey)
+	s.Require().True(found, "retry should have been queued")
+
+	expectedRetryTime := s.Ctx.BlockTime().Add(time.Duration(types.DefaultRetryBackoffSeconds) * time.Second)
+	s.Require().True((*tc.acks)[0].CanRetry, "callback should be allowed to retry")
+	s.Require().Equal(tc.portId, retry.PortId, "retry port")
+	s.Require().Equal(tc.channelId, retry.ChannelId, "retry channel")
+	s.Require().Equal(ibctesting.FirstConnectionID, retry.ConnectionId, "retry connection")
+	s.Require().Equal(tc.packet.Data, retry.PacketData, "retry packet data")
+	s.Require().Equal(RetryableCallbackId, retry.CallbackId, "retry callback ID")
+	s.Require().Equal(tc.callbackData.CallbackArgs, retry.CallbackArgs, "retry callback args")
+	s.Require().Equal(uint64(0), retry.Attempts, "retry attempts")
+	s.Require().Equal(uint64(expectedRetryTime.UnixNano()), retry.NextRetryTime, "next retry time")
+	s.Require().Contains(retry.Error, "host busy", "retry error")
+}
+
+func (s *KeeperTestSuite) TestCallRegisteredICACallback_MaxRetryAttempts() {
+	tc := s.SetupCallbackRetry(RetryableCallbackId)
+
+	// Update the callback data so that it's already been retried the max number of times
+	tc.callbackData.RetryAttempts = types.DefaultMaxRetryAttempts
+	s.App.IcacallbacksKeeper.SetCallbackData(s.Ctx, tc.callbackData)
+
+	ackResponse := types.AcknowledgementResponse{Status: types.AckResponseStatus_FAILURE}
+	err := s.App.IcacallbacksKeeper.CallRegisteredICACallback(s.Ctx, tc.packet, &ackResponse)
+	s.Require().ErrorIs(err, types.ErrMaxRetryAttempts)
+	s.Require().False((*tc.acks)[0].CanRetry, "callback should not be allowed to retry")
+
+	_, found := s.App.IcacallbacksKeeper.GetCallbackRetry(s.Ctx, tc.callbackData.CallbackKey)
+	s.Require().False(found, "retry should not have been queued")
+}
+
+func (s *KeeperTestSuite) TestCallRegisteredICACallback_NotRetryable() {
+	tc := s.SetupCallbackRetry(FailingCallbackId)
+
+	ackResponse := types.AcknowledgementResponse{Status: types.AckResponseStatus_FAILURE}
+	err := s.App.IcacallbacksKeeper.CallRegisteredICACallback(s.Ctx, tc.packet, &ackResponse)
+	s.Require().ErrorContains(err, "callback failed")
+
+	_, found := s.App.IcacallbacksKeeper.GetCallbackRetry(s.Ctx, tc.callbackData.CallbackKey)
+	s.Require().False(found, "retry should not have been queued")
+}
+
+func (s *KeeperTestSuite) TestProcessCallbackRetries() {
+	tc := s.SetupCallbackRetry(RetryableCallbackId)
+
+	ackResponse := types.AcknowledgementResponse{Status: types.AckResponseStatus_TIMEOUT}
+	err := s.App.IcacallbacksKeeper.CallRegisteredICACallback(s.Ctx, tc.packet, &ackResponse)
+	s.Require().NoError(err, "no error expected when calling a retryable callback")
+
+	// Process the retries before the backoff has elapsed - nothing should be submitted
+	startSequence := s.MustGetNextSequenceNumber(tc.portId, tc.channelId)
+	s.App.IcacallbacksKeeper.ProcessCallbackRetries(s.Ctx)
+
+	s.Require().Equal(startSequence, s.MustGetNextSequenceNumber(tc.portId, tc.channelId), "no packet sent before backoff")
+	s.Require().Len(s.App.IcacallbacksKeeper.GetAllCallbackRetry(s.Ctx), 1, "retry still queued before backoff")
+
+	// Process again after the backoff - the ICA should be re-submitted
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Duration(types.DefaultRetryBackoffSeconds) * time.Second))
+	s.App.IcacallbacksKeeper.ProcessCallbackRetries(s.Ctx)
+
+	s.Require().Equal(startSequence+1, s.MustGetNextSequenceNumber(tc.portId, tc.channelId), "packet sent after backoff")
+	s.Require().Empty(s.App.IcacallbacksKeeper.GetAllCallbackRetry(s.Ctx), "retry removed after submission")
+
+	// The callback should be re-registered for the new packet
+	newCallbackKey := types.PacketID(tc.portId, tc.channelId, startSequence)
+	callbackData, found := s.App.IcacallbacksKeeper.GetCallbackData(s.Ctx, newCallbackKey)
+	s.Require().True(found, "callback data should have been stored for the retry")
+	s.Require().Equal(RetryableCallbackId, callbackData.CallbackId, "retry callback ID")
+	s.Require().Equal(tc.callbackData.CallbackArgs, callbackData.CallbackArgs, "retry callback args")
+	s.Require().Equal(uint64(1), callbackData.RetryAttempts, "retry attempts")
+}
+
+func (s *KeeperTestSuite) TestQueueCallbackRetry_UnsupportedPort() {
+	packet := channeltypes.Packet{SourcePort: "icqoracle", SourceChannel: "channel-0"}
+	err := s.App.IcacallbacksKeeper.QueueCallbackRetry(s.Ctx, packet, types.CallbackData{}, errors.New("failed"))
+	s.Require().ErrorIs(err, types.ErrRetryNotSupported)
+}
+
+func (s *KeeperTestSuite) TestProcessCallbackRetries_SubmissionFailed() {
+	tc := s.SetupCallbackRetry(RetryableCallbackId)
+
+	// Queue a retry with packet data that can't be re-submitted
+	retry := types.CallbackRetry{
+		CallbackKey:   tc.callbackData.CallbackKey,
+		PortId:        tc.portId,
+		ChannelId:     tc.channelId,
+		ConnectionId:  ibctesting.FirstConnectionID,
+		PacketData:    []byte("invalid"),
+		CallbackId:    RetryableCallbackId,
+		CallbackArgs:  tc.callbackData.CallbackArgs,
+		NextRetryTime: uint64(s.Ctx.BlockTime().UnixNano()),
+	}
+	s.App.IcacallbacksKeeper.SetCallbackRetry(s.Ctx, retry)
+
+	// Each failed submission should count as an attempt and be rescheduled with a longer backoff
+	for attempt := uint64(1); attempt < types.DefaultMaxRetryAttempts; attempt++ {
+		s.App.IcacallbacksKeeper.ProcessCallbackRetries(s.Ctx)
+
+		actualRetry, found := s.App.IcacallbacksKeeper.GetCallbackRetry(s.Ctx, retry.CallbackKey)
+		s.Require().True(found, "retry should still be queued after attempt %d", attempt)
+		s.Require().Equal(attempt, actualRetry.Attempts, "attempts after attempt %d", attempt)
+		s.Require().Contains(actualRetry.Error, "unable to unmarshal ICA packet data", "error after attempt %d", attempt)
+
+		backoff := keeper.GetRetryBackoff(types.DefaultRetryBackoffSeconds, attempt)
+		expectedRetryTime := s.Ctx.BlockTime().Add(backoff)
+		s.Require().Equal(uint64(expectedRetryTime.UnixNano()), actualRetry.NextRetryTime, "retry time after attempt %d", attempt)
+
+		s.Ctx = s.Ctx.WithBlockTime(expectedRetryTime)
+	}
+	s.Require().Empty(*tc.acks, "callback should not be invoked before the retry is abandoned")
+
+	// Once the max attempts is reached, the retry should be abandoned and the callback
+	// should be invoked with a final failed ack
+	s.App.IcacallbacksKeeper.ProcessCallbackRetries(s.Ctx)
+	_, found := s.App.IcacallbacksKeeper.GetCallbackRetry(s.Ctx, retry.CallbackKey)
+	s.Require().False(found, "retry should have been abandoned")
+	s.CheckEventValueEmitted(types.EventTypeCallbackRetryAbandoned, types.AttributeKeyCallbackKey, retry.CallbackKey)
+
+	s.Require().Len(*tc.acks, 1, "callback should be invoked once the retry is abandoned")
+	s.Require().Equal(types.AckResponseStatus_FAILURE, (*tc.acks)[0].Status, "final ack status")
+	s.Require().False((*tc.acks)[0].CanRetry, "final ack should not allow a retry")
+}
+
+func (s *KeeperTestSuite) TestProcessCallbackRetries_ChannelReplaced() {
+	tc := s.SetupCallbackRetry(RetryableCallbackId)
+
+	ackResponse := types.AcknowledgementResponse{Status: types.AckResponseStatus_FAILURE}
+	err := s.App.IcacallbacksKeeper.CallRegisteredICACallback(s.Ctx, tc.packet, &ackResponse)
+	s.Require().NoError(err, "no error expected when calling a retryable callback")
+
+	// Mimic the ICA being restored on a new channel
+	s.App.ICAControllerKeeper.SetActiveChannelID(s.Ctx, ibctesting.FirstConnectionID, tc.portId, "channel-100")
+
+	// The retry should be dropped instead of submitted, since the restore re-queues the work
+	startSequence := s.MustGetNextSequenceNumber(tc.portId, tc.channelId)
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Duration(types.DefaultRetryBackoffSeconds) * time.Second))
+	s.App.IcacallbacksKeeper.ProcessCallbackRetries(s.Ctx)
+
+	s.Require().Equal(startSequence, s.MustGetNextSequenceNumber(tc.portId, tc.channelId), "no packet sent")
+	s.Require().Empty(s.App.IcacallbacksKeeper.GetAllCallbackRetry(s.Ctx), "retry should have been dropped")
+	s.CheckEventValueEmitted(types.EventTypeCallbackRetryDropped, types.AttributeKeyCallbackKey, tc.callbackData.CallbackKey)
+	s.Require().Len(*tc.acks, 1, "callback should not be invoked when the retry is dropped")
+}
+
+func (s *KeeperTestSuite) TestQueryCallbackRetries() {
+	for _, key := range []string{"key-1", "key-2"} {
+		s.App.IcacallbacksKeeper.SetCallbackRetry(s.Ctx, types.CallbackRetry{CallbackKey: key})
+	}
+
+	resp, err := s.QueryClient.CallbackRetries(sdk.WrapSDKContext(s.Ctx), &types.QueryCallbackRetriesRequest{})
+	s.Require().NoError(err, "no error expected when querying retries")
+	s.Require().Len(resp.CallbackRetries, 2, "number of retries")
+	s.Require().Equal("key-1", resp.CallbackRetries[0].CallbackKey, "first retry")
+	s.Require().Equal("key-2", resp.CallbackRetries[1].CallbackKey, "second retry")
+}
@@ -12,4 +12,9 @@ type AcknowledgementResponse struct {
 	Status       AckResponseStatus
 	MsgResponses [][]byte
 	Error        string
+	// Set when the callback is allowed to request a retry by returning an error
+	// that wraps ErrCallbackRetryable (i.e. the packet is from a port that
+	// supports retries and the max number of attempts has not been reached)
+	// Once this is false, the callback should apply its terminal failure handling
+	CanRetry bool
 }
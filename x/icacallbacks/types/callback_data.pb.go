@@ -5,16 +5,21 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -29,6 +34,10 @@ type CallbackData struct {
 	Sequence     uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	CallbackId   string `protobuf:"bytes,5,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
 	CallbackArgs []byte `protobuf:"bytes,6,opt,name=callback_args,json=callbackArgs,proto3" json:"callback_args,omitempty"`
+	// Number of times the ICA has been re-submitted after a retryable failure
+	RetryAttempts uint64 `protobuf:"varint,7,opt,name=retry_attempts,json=retryAttempts,proto3" json:"retry_attempts,omitempty"`
+	// Block time at which the callback data was stored
+	CreatedAt time.Time `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
 }
 
 func (m *CallbackData) Reset()         { *m = CallbackData{} }
@@ -106,8 +115,107 @@ func (m *CallbackData) GetCallbackArgs() []byte {
 	return nil
 }
 
+func (m *CallbackData) GetRetryAttempts() uint64 {
+	if m != nil {
+		return m.RetryAttempts
+	}
+	return 0
+}
+
+func (m *CallbackData) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+// CallbackData with the callback args decoded using the type registered
+// by the callback's module
+type DecodedCallbackData struct {
+	CallbackData CallbackData `protobuf:"bytes,1,opt,name=callback_data,json=callbackData,proto3" json:"callback_data"`
+	// Chain ID of the host that the ICA was sent to
+	HostZoneId string `protobuf:"bytes,2,opt,name=host_zone_id,json=hostZoneId,proto3" json:"host_zone_id,omitempty"`
+	// Fully qualified proto name of the callback args (e.g.
+	// stride.stakeibc.DelegateCallback), empty if no type was registered
+	CallbackArgsType string `protobuf:"bytes,3,opt,name=callback_args_type,json=callbackArgsType,proto3" json:"callback_args_type,omitempty"`
+	// JSON representation of the callback args
+	CallbackArgsJson string `protobuf:"bytes,4,opt,name=callback_args_json,json=callbackArgsJson,proto3" json:"callback_args_json,omitempty"`
+	// Set if the callback args could not be decoded with the registered type, in
+	// which case the args are left undecoded
+	DecodeError string `protobuf:"bytes,5,opt,name=decode_error,json=decodeError,proto3" json:"decode_error,omitempty"`
+}
+
+func (m *DecodedCallbackData) Reset()         { *m = DecodedCallbackData{} }
+func (m *DecodedCallbackData) String() string { return proto.CompactTextString(m) }
+func (*DecodedCallbackData) ProtoMessage()    {}
+func (*DecodedCallbackData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b6f19ce856679b, []int{1}
+}
+func (m *DecodedCallbackData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecodedCallbackData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecodedCallbackData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecodedCallbackData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodedCallbackData.Merge(m, src)
+}
+func (m *DecodedCallbackData) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecodedCallbackData) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodedCallbackData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodedCallbackData proto.InternalMessageInfo
+
+func (m *DecodedCallbackData) GetCallbackData() CallbackData {
+	if m != nil {
+		return m.CallbackData
+	}
+	return CallbackData{}
+}
+
+func (m *DecodedCallbackData) GetHostZoneId() string {
+	if m != nil {
+		return m.HostZoneId
+	}
+	return ""
+}
+
+func (m *DecodedCallbackData) GetCallbackArgsType() string {
+	if m != nil {
+		return m.CallbackArgsType
+	}
+	return ""
+}
+
+func (m *DecodedCallbackData) GetCallbackArgsJson() string {
+	if m != nil {
+		return m.CallbackArgsJson
+	}
+	return ""
+}
+
+func (m *DecodedCallbackData) GetDecodeError() string {
+	if m != nil {
+		return m.DecodeError
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*CallbackData)(nil), "stride.icacallbacks.CallbackData")
+	proto.RegisterType((*DecodedCallbackData)(nil), "stride.icacallbacks.DecodedCallbackData")
 }
 
 func init() {
@@ -115,24 +223,37 @@ func init() {
 }
 
 var fileDescriptor_19b6f19ce856679b = []byte{
-	// 269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2f, 0x2e, 0x29, 0xca,
-	0x4c, 0x49, 0xd5, 0xcf, 0x4c, 0x4e, 0x4c, 0x4e, 0xcc, 0xc9, 0x49, 0x4a, 0x4c, 0xce, 0x2e, 0xd6,
-	0x87, 0xb1, 0xe2, 0x53, 0x12, 0x4b, 0x12, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x84, 0x21,
-	0x0a, 0xf5, 0x90, 0x15, 0x2a, 0x9d, 0x66, 0xe4, 0xe2, 0x71, 0x86, 0xf2, 0x5c, 0x12, 0x4b, 0x12,
-	0x85, 0x14, 0xb9, 0x78, 0xe0, 0x9a, 0xb3, 0x53, 0x2b, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83,
-	0xb8, 0x61, 0x62, 0xde, 0xa9, 0x95, 0x42, 0xe2, 0x5c, 0xec, 0x05, 0xf9, 0x45, 0x25, 0xf1, 0x99,
-	0x29, 0x12, 0x4c, 0x60, 0x59, 0x36, 0x10, 0xd7, 0x33, 0x45, 0x48, 0x96, 0x8b, 0x2b, 0x39, 0x23,
-	0x31, 0x2f, 0x2f, 0x35, 0x07, 0x24, 0xc7, 0x0c, 0x96, 0xe3, 0x84, 0x8a, 0x78, 0xa6, 0x08, 0x49,
-	0x71, 0x71, 0x14, 0xa7, 0x16, 0x96, 0xa6, 0xe6, 0x25, 0xa7, 0x4a, 0xb0, 0x28, 0x30, 0x6a, 0xb0,
-	0x04, 0xc1, 0xf9, 0x42, 0xf2, 0x5c, 0x70, 0x2b, 0x40, 0x7a, 0x59, 0xc1, 0x7a, 0xb9, 0x60, 0x42,
-	0x9e, 0x29, 0x42, 0xca, 0x5c, 0xbc, 0x70, 0x05, 0x89, 0x45, 0xe9, 0xc5, 0x12, 0x6c, 0x0a, 0x8c,
-	0x1a, 0x3c, 0x41, 0x70, 0xc7, 0x3a, 0x16, 0xa5, 0x17, 0x3b, 0x05, 0x9c, 0x78, 0x24, 0xc7, 0x78,
-	0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7,
-	0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x59, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e,
-	0xae, 0x7e, 0x30, 0x38, 0x1c, 0x74, 0x7d, 0x12, 0x93, 0x8a, 0xf5, 0xa1, 0x81, 0x57, 0x66, 0x64,
-	0xae, 0x5f, 0x81, 0x1a, 0x84, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xb0, 0x33, 0x06,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x70, 0x67, 0xc4, 0x66, 0x01, 0x00, 0x00,
+	// 473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xe3, 0x10, 0xd2, 0x64, 0x92, 0x20, 0xb4, 0x45, 0xc2, 0x8a, 0x84, 0x93, 0x16, 0x21,
+	0x72, 0x00, 0x5b, 0x0a, 0x12, 0x9c, 0x93, 0x96, 0x43, 0xa0, 0x07, 0x14, 0x7a, 0xea, 0xc5, 0x5a,
+	0x7b, 0x07, 0xc7, 0x34, 0xf1, 0x9a, 0xdd, 0x0d, 0xc2, 0x9c, 0x79, 0x80, 0xbe, 0x0d, 0xaf, 0xd0,
+	0x63, 0x8f, 0x9c, 0x00, 0x25, 0x2f, 0x82, 0x76, 0xfd, 0xa7, 0x89, 0xca, 0x6d, 0xf7, 0x9b, 0x6f,
+	0x76, 0x76, 0x7e, 0x33, 0xf0, 0x5c, 0x2a, 0x11, 0x33, 0xf4, 0xe2, 0x90, 0x86, 0x74, 0xb9, 0x0c,
+	0x68, 0x78, 0x29, 0xbd, 0xf2, 0xe4, 0x33, 0xaa, 0xa8, 0x9b, 0x0a, 0xae, 0x38, 0x39, 0xcc, 0x8d,
+	0xee, 0xae, 0xb1, 0xff, 0x28, 0xe2, 0x11, 0x37, 0x71, 0x4f, 0x9f, 0x72, 0x6b, 0x7f, 0x10, 0x71,
+	0x1e, 0x2d, 0xd1, 0x33, 0xb7, 0x60, 0xfd, 0xc9, 0x53, 0xf1, 0x0a, 0xa5, 0xa2, 0xab, 0x34, 0x37,
+	0x1c, 0xff, 0xac, 0x43, 0xf7, 0xa4, 0x78, 0xe4, 0x94, 0x2a, 0x4a, 0x8e, 0xa0, 0x5b, 0xd5, 0xbc,
+	0xc4, 0xcc, 0xb6, 0x86, 0xd6, 0xa8, 0x3d, 0xef, 0x94, 0xda, 0x7b, 0xcc, 0xc8, 0x63, 0x38, 0x48,
+	0xb9, 0x50, 0x7e, 0xcc, 0xec, 0xba, 0x89, 0x36, 0xf5, 0x75, 0xc6, 0xc8, 0x13, 0x80, 0x70, 0x41,
+	0x93, 0x04, 0x97, 0x3a, 0x76, 0xcf, 0xc4, 0xda, 0x85, 0x32, 0x63, 0xa4, 0x0f, 0x2d, 0x89, 0x5f,
+	0xd6, 0x98, 0x84, 0x68, 0x37, 0x86, 0xd6, 0xa8, 0x31, 0xaf, 0xee, 0x64, 0x00, 0x55, 0x09, 0x9d,
+	0x7b, 0xdf, 0xe4, 0x42, 0x29, 0xcd, 0x18, 0x79, 0x0a, 0xbd, 0xca, 0x40, 0x45, 0x24, 0xed, 0xe6,
+	0xd0, 0x1a, 0x75, 0xe7, 0xd5, 0x67, 0x27, 0x22, 0x92, 0xe4, 0x19, 0x3c, 0x10, 0xa8, 0x44, 0xe6,
+	0x53, 0xa5, 0x70, 0x95, 0x2a, 0x69, 0x1f, 0x98, 0x3a, 0x3d, 0xa3, 0x4e, 0x0a, 0x91, 0x9c, 0x00,
+	0x84, 0x02, 0xa9, 0x42, 0xe6, 0x53, 0x65, 0xb7, 0x86, 0xd6, 0xa8, 0x33, 0xee, 0xbb, 0x39, 0x2a,
+	0xb7, 0x44, 0xe5, 0x9e, 0x97, 0xa8, 0xa6, 0xad, 0xeb, 0xdf, 0x83, 0xda, 0xd5, 0x9f, 0x81, 0x35,
+	0x6f, 0x17, 0x79, 0x13, 0x75, 0xfc, 0xa3, 0x0e, 0x87, 0xa7, 0x18, 0x72, 0x86, 0x6c, 0x0f, 0xe0,
+	0x19, 0xf4, 0xf6, 0x86, 0x66, 0x08, 0x76, 0xc6, 0x47, 0xee, 0x7f, 0xa6, 0xe6, 0xee, 0x66, 0x4e,
+	0x1b, 0xba, 0xcc, 0x6d, 0x47, 0xe6, 0xb5, 0x21, 0x74, 0x17, 0x5c, 0x2a, 0xff, 0x3b, 0x4f, 0xf0,
+	0x16, 0x38, 0x68, 0xed, 0x82, 0x27, 0x38, 0x63, 0xe4, 0x05, 0x90, 0x3d, 0x30, 0xbe, 0xca, 0x52,
+	0x2c, 0xe0, 0x3f, 0xdc, 0xa5, 0x73, 0x9e, 0xa5, 0x78, 0xd7, 0xfd, 0x59, 0xf2, 0xc4, 0x6e, 0xdc,
+	0x75, 0xbf, 0x93, 0x3c, 0xd1, 0xcb, 0xc0, 0x4c, 0x8b, 0x3e, 0x0a, 0xc1, 0x45, 0x31, 0x96, 0x4e,
+	0xae, 0xbd, 0xd5, 0xd2, 0xf4, 0xc3, 0xf5, 0xc6, 0xb1, 0x6e, 0x36, 0x8e, 0xf5, 0x77, 0xe3, 0x58,
+	0x57, 0x5b, 0xa7, 0x76, 0xb3, 0x75, 0x6a, 0xbf, 0xb6, 0x4e, 0xed, 0xe2, 0x75, 0x14, 0xab, 0xc5,
+	0x3a, 0x70, 0x43, 0xbe, 0xf2, 0x3e, 0x9a, 0xde, 0x5f, 0x9e, 0xd1, 0x40, 0x7a, 0xc5, 0x9a, 0x7f,
+	0x1d, 0xbf, 0xf1, 0xbe, 0xed, 0x2f, 0xbb, 0xfe, 0xb9, 0x0c, 0x9a, 0x66, 0x02, 0xaf, 0xfe, 0x0d,
+	0x00, 0x2d, 0x2f, 0xe5, 0xb6, 0x10, 0x03, 0x00, 0x00,
 }
 
 func (m *CallbackData) Marshal() (dAtA []byte, err error) {
@@ -155,6 +276,19 @@ func (m *CallbackData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintCallbackData(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if m.RetryAttempts != 0 {
+		i = encodeVarintCallbackData(dAtA, i, uint64(m.RetryAttempts))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.CallbackArgs) > 0 {
 		i -= len(m.CallbackArgs)
 		copy(dAtA[i:], m.CallbackArgs)
@@ -198,6 +332,67 @@ func (m *CallbackData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DecodedCallbackData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecodedCallbackData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecodedCallbackData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DecodeError) > 0 {
+		i -= len(m.DecodeError)
+		copy(dAtA[i:], m.DecodeError)
+		i = encodeVarintCallbackData(dAtA, i, uint64(len(m.DecodeError)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CallbackArgsJson) > 0 {
+		i -= len(m.CallbackArgsJson)
+		copy(dAtA[i:], m.CallbackArgsJson)
+		i = encodeVarintCallbackData(dAtA, i, uint64(len(m.CallbackArgsJson)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CallbackArgsType) > 0 {
+		i -= len(m.CallbackArgsType)
+		copy(dAtA[i:], m.CallbackArgsType)
+		i = encodeVarintCallbackData(dAtA, i, uint64(len(m.CallbackArgsType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.HostZoneId) > 0 {
+		i -= len(m.HostZoneId)
+		copy(dAtA[i:], m.HostZoneId)
+		i = encodeVarintCallbackData(dAtA, i, uint64(len(m.HostZoneId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.CallbackData.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCallbackData(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCallbackData(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCallbackData(v)
 	base := offset
@@ -238,6 +433,38 @@ func (m *CallbackData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCallbackData(uint64(l))
 	}
+	if m.RetryAttempts != 0 {
+		n += 1 + sovCallbackData(uint64(m.RetryAttempts))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovCallbackData(uint64(l))
+	return n
+}
+
+func (m *DecodedCallbackData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CallbackData.Size()
+	n += 1 + l + sovCallbackData(uint64(l))
+	l = len(m.HostZoneId)
+	if l > 0 {
+		n += 1 + l + sovCallbackData(uint64(l))
+	}
+	l = len(m.CallbackArgsType)
+	if l > 0 {
+		n += 1 + l + sovCallbackData(uint64(l))
+	}
+	l = len(m.CallbackArgsJson)
+	if l > 0 {
+		n += 1 + l + sovCallbackData(uint64(l))
+	}
+	l = len(m.DecodeError)
+	if l > 0 {
+		n += 1 + l + sovCallbackData(uint64(l))
+	}
 	return n
 }
 
@@ -457,6 +684,269 @@ func (m *CallbackData) Unmarshal(dAtA []byte) error {
 				m.CallbackArgs = []byte{}
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryAttempts", wireType)
+			}
+			m.RetryAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbackData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryAttempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbackData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCallbackData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbackData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCallbackData(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCallbackData
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecodedCallbackData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCallbackData
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecodedCallbackData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecodedCallbackData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbackData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCallbackData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbackData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CallbackData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbackData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbackData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbackData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackArgsType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbackData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbackData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbackData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackArgsType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackArgsJson", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbackData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbackData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbackData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackArgsJson = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodeError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbackData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbackData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbackData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecodeError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCallbackData(dAtA[iNdEx:])
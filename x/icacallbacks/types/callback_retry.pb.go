@@ -0,0 +1,773 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stride/icacallbacks/callback_retry.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// An ICA or ICS-20 transfer whose callback returned a retryable error, and is
+// queued to be re-submitted
+type CallbackRetry struct {
+	// Callback key of the most recent attempt
+	CallbackKey string `protobuf:"bytes,1,opt,name=callback_key,json=callbackKey,proto3" json:"callback_key,omitempty"`
+	// Port that submitted the original packet (either an ICA controller port or
+	// the transfer port)
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// Connection of the channel
+	ConnectionId string `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// Packet data from the original submission, which is re-sent as is
+	PacketData []byte `protobuf:"bytes,4,opt,name=packet_data,json=packetData,proto3" json:"packet_data,omitempty"`
+	// Callback ID and args that are re-registered with each retry
+	CallbackId   string `protobuf:"bytes,5,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	CallbackArgs []byte `protobuf:"bytes,6,opt,name=callback_args,json=callbackArgs,proto3" json:"callback_args,omitempty"`
+	// Number of retries that have already been submitted
+	Attempts uint64 `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Unix time (in nanoseconds) at which the next retry should be submitted
+	NextRetryTime uint64 `protobuf:"varint,8,opt,name=next_retry_time,json=nextRetryTime,proto3" json:"next_retry_time,omitempty"`
+	// Error from the most recent attempt
+	Error string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	// Channel that the original packet was sent on
+	// If an ICA channel is restored under a new channel ID, the retry is dropped
+	// since the restore re-queues any in-progress work
+	ChannelId string `protobuf:"bytes,10,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *CallbackRetry) Reset()         { *m = CallbackRetry{} }
+func (m *CallbackRetry) String() string { return proto.CompactTextString(m) }
+func (*CallbackRetry) ProtoMessage()    {}
+func (*CallbackRetry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e29a6ee1895af1e, []int{0}
+}
+func (m *CallbackRetry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CallbackRetry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CallbackRetry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CallbackRetry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallbackRetry.Merge(m, src)
+}
+func (m *CallbackRetry) XXX_Size() int {
+	return m.Size()
+}
+func (m *CallbackRetry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallbackRetry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallbackRetry proto.InternalMessageInfo
+
+func (m *CallbackRetry) GetCallbackKey() string {
+	if m != nil {
+		return m.CallbackKey
+	}
+	return ""
+}
+
+func (m *CallbackRetry) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *CallbackRetry) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *CallbackRetry) GetPacketData() []byte {
+	if m != nil {
+		return m.PacketData
+	}
+	return nil
+}
+
+func (m *CallbackRetry) GetCallbackId() string {
+	if m != nil {
+		return m.CallbackId
+	}
+	return ""
+}
+
+func (m *CallbackRetry) GetCallbackArgs() []byte {
+	if m != nil {
+		return m.CallbackArgs
+	}
+	return nil
+}
+
+func (m *CallbackRetry) GetAttempts() uint64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *CallbackRetry) GetNextRetryTime() uint64 {
+	if m != nil {
+		return m.NextRetryTime
+	}
+	return 0
+}
+
+func (m *CallbackRetry) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *CallbackRetry) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*CallbackRetry)(nil), "stride.icacallbacks.CallbackRetry")
+}
+
+func init() {
+	proto.RegisterFile("stride/icacallbacks/callback_retry.proto", fileDescriptor_9e29a6ee1895af1e)
+}
+
+var fileDescriptor_9e29a6ee1895af1e = []byte{
+	// 338 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x51, 0xcb, 0x4a, 0xf3, 0x40,
+	0x14, 0x6e, 0xfa, 0xf7, 0x7a, 0xda, 0xf2, 0xc3, 0x28, 0x38, 0x08, 0xc6, 0xaa, 0x20, 0xd9, 0xd8,
+	0x80, 0x82, 0xae, 0xbd, 0x6c, 0x8a, 0x2e, 0x24, 0xba, 0x72, 0x13, 0x26, 0x93, 0xa1, 0x1d, 0xda,
+	0x5c, 0x98, 0x39, 0x4a, 0xf3, 0x16, 0x3e, 0x96, 0xcb, 0x2e, 0x5d, 0x4a, 0xfb, 0x22, 0x92, 0xc9,
+	0x45, 0xdd, 0xcd, 0x77, 0x99, 0x73, 0x3e, 0xce, 0x07, 0x8e, 0x46, 0x25, 0x43, 0xe1, 0x4a, 0xce,
+	0x38, 0x5b, 0x2e, 0x03, 0xc6, 0x17, 0xda, 0xad, 0x5e, 0xbe, 0x12, 0xa8, 0xb2, 0x49, 0xaa, 0x12,
+	0x4c, 0xc8, 0x4e, 0xe1, 0x9c, 0xfc, 0x76, 0x1e, 0xaf, 0x9b, 0x30, 0xba, 0x2d, 0x91, 0x97, 0x9b,
+	0xc9, 0x11, 0x0c, 0xeb, 0xef, 0x0b, 0x91, 0x51, 0x6b, 0x6c, 0x39, 0x7d, 0x6f, 0x50, 0x71, 0xf7,
+	0x22, 0x23, 0x7b, 0xd0, 0x4d, 0x13, 0x85, 0xbe, 0x0c, 0x69, 0xd3, 0xa8, 0x9d, 0x1c, 0x4e, 0x43,
+	0x72, 0x02, 0x23, 0x9e, 0xc4, 0xb1, 0xe0, 0x28, 0x93, 0x38, 0x97, 0xff, 0x19, 0x79, 0xf8, 0x43,
+	0x4e, 0x43, 0x72, 0x08, 0x83, 0x94, 0xf1, 0x85, 0x40, 0x3f, 0x64, 0xc8, 0x68, 0x6b, 0x6c, 0x39,
+	0x43, 0x0f, 0x0a, 0xea, 0x8e, 0x21, 0xcb, 0x0d, 0x75, 0x02, 0x19, 0xd2, 0xb6, 0x99, 0x01, 0x15,
+	0x55, 0xae, 0xa9, 0x0c, 0x4c, 0xcd, 0x34, 0xed, 0x98, 0x19, 0x75, 0xee, 0x6b, 0x35, 0xd3, 0x64,
+	0x1f, 0x7a, 0x0c, 0x51, 0x44, 0x29, 0x6a, 0xda, 0x1d, 0x5b, 0x4e, 0xcb, 0xab, 0x31, 0x39, 0x85,
+	0xff, 0xb1, 0x58, 0x61, 0x71, 0x1e, 0x1f, 0x65, 0x24, 0x68, 0xcf, 0x58, 0x46, 0x39, 0x6d, 0xee,
+	0xf0, 0x2c, 0x23, 0x41, 0x76, 0xa1, 0x2d, 0x94, 0x4a, 0x14, 0xed, 0x9b, 0x0c, 0x05, 0x20, 0x07,
+	0x00, 0x7c, 0xce, 0xe2, 0x58, 0x2c, 0xf3, 0x78, 0x60, 0xa4, 0x7e, 0xc9, 0x4c, 0xc3, 0x9b, 0xc7,
+	0x8f, 0x8d, 0x6d, 0xad, 0x37, 0xb6, 0xf5, 0xb5, 0xb1, 0xad, 0xf7, 0xad, 0xdd, 0x58, 0x6f, 0xed,
+	0xc6, 0xe7, 0xd6, 0x6e, 0xbc, 0x5c, 0xce, 0x24, 0xce, 0x5f, 0x83, 0x09, 0x4f, 0x22, 0xf7, 0xc9,
+	0x94, 0x71, 0xf6, 0xc0, 0x02, 0xed, 0x96, 0x15, 0xbe, 0x9d, 0x5f, 0xb9, 0xab, 0xbf, 0x45, 0x62,
+	0x96, 0x0a, 0x1d, 0x74, 0x4c, 0x81, 0x17, 0xdf, 0x03, 0x00, 0xa0, 0x71, 0xf1, 0x70, 0xec, 0x01,
+	0x00, 0x00,
+}
+
+func (m *CallbackRetry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallbackRetry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallbackRetry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintCallbackRetry(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintCallbackRetry(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.NextRetryTime != 0 {
+		i = encodeVarintCallbackRetry(dAtA, i, uint64(m.NextRetryTime))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintCallbackRetry(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.CallbackArgs) > 0 {
+		i -= len(m.CallbackArgs)
+		copy(dAtA[i:], m.CallbackArgs)
+		i = encodeVarintCallbackRetry(dAtA, i, uint64(len(m.CallbackArgs)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CallbackId) > 0 {
+		i -= len(m.CallbackId)
+		copy(dAtA[i:], m.CallbackId)
+		i = encodeVarintCallbackRetry(dAtA, i, uint64(len(m.CallbackId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PacketData) > 0 {
+		i -= len(m.PacketData)
+		copy(dAtA[i:], m.PacketData)
+		i = encodeVarintCallbackRetry(dAtA, i, uint64(len(m.PacketData)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintCallbackRetry(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintCallbackRetry(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CallbackKey) > 0 {
+		i -= len(m.CallbackKey)
+		copy(dAtA[i:], m.CallbackKey)
+		i = encodeVarintCallbackRetry(dAtA, i, uint64(len(m.CallbackKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCallbackRetry(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCallbackRetry(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CallbackRetry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CallbackKey)
+	if l > 0 {
+		n += 1 + l + sovCallbackRetry(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovCallbackRetry(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovCallbackRetry(uint64(l))
+	}
+	l = len(m.PacketData)
+	if l > 0 {
+		n += 1 + l + sovCallbackRetry(uint64(l))
+	}
+	l = len(m.CallbackId)
+	if l > 0 {
+		n += 1 + l + sovCallbackRetry(uint64(l))
+	}
+	l = len(m.CallbackArgs)
+	if l > 0 {
+		n += 1 + l + sovCallbackRetry(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovCallbackRetry(uint64(m.Attempts))
+	}
+	if m.NextRetryTime != 0 {
+		n += 1 + sovCallbackRetry(uint64(m.NextRetryTime))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCallbackRetry(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovCallbackRetry(uint64(l))
+	}
+	return n
+}
+
+func sovCallbackRetry(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCallbackRetry(x uint64) (n int) {
+	return sovCallbackRetry(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CallbackRetry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCallbackRetry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallbackRetry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallbackRetry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbackRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbackRetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbackRetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbackRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbackRetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbackRetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbackRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbackRetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbackRetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbackRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCallbackRetry
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbackRetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketData = append(m.PacketData[:0], dAtA[iNdEx:postIndex]...)
+			if m.PacketData == nil {
+				m.PacketData = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbackRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbackRetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbackRetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackArgs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbackRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCallbackRetry
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbackRetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackArgs = append(m.CallbackArgs[:0], dAtA[iNdEx:postIndex]...)
+			if m.CallbackArgs == nil {
+				m.CallbackArgs = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbackRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRetryTime", wireType)
+			}
+			m.NextRetryTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbackRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRetryTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbackRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbackRetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbackRetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbackRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbackRetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbackRetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCallbackRetry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCallbackRetry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCallbackRetry(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCallbackRetry
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCallbackRetry
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCallbackRetry
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCallbackRetry
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCallbackRetry
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCallbackRetry
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCallbackRetry        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCallbackRetry          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCallbackRetry = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"github.com/cosmos/gogoproto/proto"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,6 +12,9 @@ type ICACallbackFunction func(sdk.Context, channeltypes.Packet, *Acknowledgement
 type ICACallback struct {
 	CallbackId   string
 	CallbackFunc ICACallbackFunction
+	// Optional type of the callback args (e.g. &DelegateCallback{}), used to
+	// decode the args in queries
+	CallbackArgsType proto.Message
 }
 
 type ModuleCallbacks []ICACallback
@@ -15,4 +15,9 @@ var (
 	ErrCallbackDataNotFound    = errorsmod.Register(ModuleName, 1505, "icacallback data not found")
 	ErrTxMsgData               = errorsmod.Register(ModuleName, 1506, "txMsgData fetch failed")
 	ErrInvalidAcknowledgement  = errorsmod.Register(ModuleName, 1507, "invalid acknowledgement")
+	ErrCallbackRetryable       = errorsmod.Register(ModuleName, 1508, "icacallback failed with a retryable error")
+	ErrMaxRetryAttempts        = errorsmod.Register(ModuleName, 1509, "max icacallback retry attempts exceeded")
+	ErrInvalidPacketData       = errorsmod.Register(ModuleName, 1510, "invalid retry packet data")
+	ErrInvalidCallbackArgs     = errorsmod.Register(ModuleName, 1511, "invalid icacallback args")
+	ErrRetryNotSupported       = errorsmod.Register(ModuleName, 1512, "icacallback retries not supported for port")
 )
@@ -6,7 +6,16 @@ const (
 	EventTypeTimeout = "timeout"
 	// this line is used by starport scaffolding # ibc/packet/event
 
+	EventTypeCallbackRetryQueued    = "callback_retry_queued"
+	EventTypeCallbackRetrySubmitted = "callback_retry_submitted"
+	EventTypeCallbackRetryAbandoned = "callback_retry_abandoned"
+	EventTypeCallbackRetryDropped   = "callback_retry_dropped"
+
 	AttributeKeyAckSuccess = "success"
 	AttributeKeyAck        = "acknowledgement"
 	AttributeKeyAckError   = "error"
+
+	AttributeKeyCallbackKey  = "callback_key"
+	AttributeKeyCallbackId   = "callback_id"
+	AttributeKeyRetryAttempt = "retry_attempt"
 )
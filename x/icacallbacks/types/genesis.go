@@ -12,8 +12,9 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		PortId:           PortID,
-		CallbackDataList: []CallbackData{},
+		PortId:            PortID,
+		CallbackDataList:  []CallbackData{},
+		CallbackRetryList: []CallbackRetry{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -35,6 +36,16 @@ func (gs GenesisState) Validate() error {
 		}
 		callbackDataIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated index in callbackRetry
+	callbackRetryIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.CallbackRetryList {
+		index := string(CallbackDataKey(elem.CallbackKey))
+		if _, ok := callbackRetryIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for callbackRetry")
+		}
+		callbackRetryIndexMap[index] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
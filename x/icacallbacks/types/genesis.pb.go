@@ -25,9 +25,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the icacallbacks module's genesis state.
 type GenesisState struct {
-	Params           Params         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	PortId           string         `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
-	CallbackDataList []CallbackData `protobuf:"bytes,3,rep,name=callback_data_list,json=callbackDataList,proto3" json:"callback_data_list"`
+	Params            Params          `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PortId            string          `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	CallbackDataList  []CallbackData  `protobuf:"bytes,3,rep,name=callback_data_list,json=callbackDataList,proto3" json:"callback_data_list"`
+	CallbackRetryList []CallbackRetry `protobuf:"bytes,4,rep,name=callback_retry_list,json=callbackRetryList,proto3" json:"callback_retry_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +85,13 @@ func (m *GenesisState) GetCallbackDataList() []CallbackData {
 	return nil
 }
 
+func (m *GenesisState) GetCallbackRetryList() []CallbackRetry {
+	if m != nil {
+		return m.CallbackRetryList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "stride.icacallbacks.GenesisState")
 }
@@ -91,25 +99,27 @@ func init() {
 func init() { proto.RegisterFile("stride/icacallbacks/genesis.proto", fileDescriptor_8c333baddfa20681) }
 
 var fileDescriptor_8c333baddfa20681 = []byte{
-	// 278 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2c, 0x2e, 0x29, 0xca,
-	0x4c, 0x49, 0xd5, 0xcf, 0x4c, 0x4e, 0x4c, 0x4e, 0xcc, 0xc9, 0x49, 0x4a, 0x4c, 0xce, 0x2e, 0xd6,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x43, 0x56, 0x22, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x96, 0xd7, 0x07, 0xb1, 0x20,
-	0x4a, 0xa5, 0xd4, 0xb1, 0x99, 0x06, 0x63, 0xc5, 0xa7, 0x24, 0x96, 0x24, 0x42, 0x15, 0x2a, 0x60,
-	0x53, 0x58, 0x90, 0x58, 0x94, 0x98, 0x0b, 0xb5, 0x55, 0x69, 0x27, 0x23, 0x17, 0x8f, 0x3b, 0xc4,
-	0x1d, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x96, 0x5c, 0x6c, 0x10, 0x05, 0x12, 0x8c, 0x0a, 0x8c,
-	0x1a, 0xdc, 0x46, 0xd2, 0x7a, 0x58, 0xdc, 0xa5, 0x17, 0x00, 0x56, 0xe2, 0xc4, 0x72, 0xe2, 0x9e,
-	0x3c, 0x43, 0x10, 0x54, 0x83, 0x90, 0x38, 0x17, 0x7b, 0x41, 0x7e, 0x51, 0x49, 0x7c, 0x66, 0x8a,
-	0x04, 0x93, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x1b, 0x88, 0xeb, 0x99, 0x22, 0x14, 0xca, 0x25, 0x84,
-	0xe2, 0xba, 0xf8, 0x9c, 0xcc, 0xe2, 0x12, 0x09, 0x66, 0x05, 0x66, 0x0d, 0x6e, 0x23, 0x45, 0xac,
-	0xe6, 0x3b, 0x43, 0x59, 0x2e, 0x89, 0x25, 0x89, 0x50, 0x5b, 0x04, 0x92, 0x91, 0xc4, 0x7c, 0x32,
-	0x8b, 0x4b, 0x9c, 0x02, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
-	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x2c,
-	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0x18, 0x6c, 0xbc, 0xae, 0x4f,
-	0x62, 0x52, 0xb1, 0x3e, 0x34, 0x38, 0xca, 0x8c, 0xcc, 0xf5, 0x2b, 0x50, 0x03, 0xa5, 0xa4, 0xb2,
-	0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x28, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5c, 0x88,
-	0x9b, 0x01, 0xaf, 0x01, 0x00, 0x00,
+	// 314 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xc1, 0x4b, 0xfb, 0x30,
+	0x14, 0xc7, 0x9b, 0x6d, 0xec, 0xc7, 0x2f, 0xf3, 0xa0, 0x99, 0xe0, 0x98, 0x10, 0xbb, 0x5d, 0xec,
+	0xc5, 0x06, 0x26, 0x28, 0x5e, 0xa7, 0x20, 0xc2, 0x0e, 0x63, 0x43, 0x10, 0x2f, 0x23, 0x4d, 0x43,
+	0x0d, 0x6e, 0xa6, 0x24, 0x4f, 0x71, 0xff, 0x85, 0xe0, 0x3f, 0xb5, 0xe3, 0x8e, 0x9e, 0x44, 0xb6,
+	0x7f, 0x44, 0xda, 0x46, 0xd8, 0xa0, 0xec, 0xf6, 0xfa, 0xfa, 0xc9, 0xe7, 0xbd, 0xf7, 0xc5, 0x1d,
+	0x0b, 0x46, 0xc5, 0x92, 0x29, 0xc1, 0x05, 0x9f, 0x4e, 0x23, 0x2e, 0x9e, 0x2d, 0x4b, 0xe4, 0x8b,
+	0xb4, 0xca, 0x86, 0xa9, 0xd1, 0xa0, 0x49, 0xb3, 0x40, 0xc2, 0x4d, 0xa4, 0x7d, 0x98, 0xe8, 0x44,
+	0xe7, 0xff, 0x59, 0x56, 0x15, 0x68, 0xfb, 0xb4, 0xcc, 0xf6, 0x57, 0x4d, 0x62, 0x0e, 0xdc, 0x81,
+	0xc1, 0x4e, 0xd0, 0x48, 0x30, 0x73, 0x47, 0xfa, 0x65, 0x64, 0xca, 0x0d, 0x9f, 0xb9, 0xfd, 0xba,
+	0x9f, 0x15, 0xbc, 0x77, 0x5b, 0x6c, 0x3c, 0x06, 0x0e, 0x92, 0x5c, 0xe1, 0x7a, 0x01, 0xb4, 0x90,
+	0x8f, 0x82, 0x46, 0xef, 0x38, 0x2c, 0xb9, 0x20, 0x1c, 0xe6, 0x48, 0xbf, 0xb6, 0xf8, 0x3e, 0xf1,
+	0x46, 0xee, 0x01, 0x39, 0xc2, 0xff, 0x52, 0x6d, 0x60, 0xa2, 0xe2, 0x56, 0xc5, 0x47, 0xc1, 0xff,
+	0x51, 0x3d, 0xfb, 0xbc, 0x8b, 0xc9, 0x3d, 0x26, 0x5b, 0x77, 0x4c, 0xa6, 0xca, 0x42, 0xab, 0xea,
+	0x57, 0x83, 0x46, 0xaf, 0x53, 0xea, 0xbf, 0x76, 0xd5, 0x0d, 0x07, 0xee, 0xa6, 0xec, 0x8b, 0x8d,
+	0xde, 0x40, 0x59, 0x20, 0x0f, 0xb8, 0xb9, 0x7d, 0x75, 0xe1, 0xad, 0xe5, 0xde, 0xee, 0x4e, 0xef,
+	0x28, 0xc3, 0x9d, 0xf8, 0x40, 0x6c, 0x36, 0x33, 0x73, 0x7f, 0xb8, 0x58, 0x51, 0xb4, 0x5c, 0x51,
+	0xf4, 0xb3, 0xa2, 0xe8, 0x63, 0x4d, 0xbd, 0xe5, 0x9a, 0x7a, 0x5f, 0x6b, 0xea, 0x3d, 0x5e, 0x24,
+	0x0a, 0x9e, 0x5e, 0xa3, 0x50, 0xe8, 0x19, 0x1b, 0xe7, 0x03, 0xce, 0x06, 0x3c, 0xb2, 0xcc, 0x05,
+	0xfd, 0xd6, 0xbb, 0x64, 0xef, 0xdb, 0x71, 0xc3, 0x3c, 0x95, 0x36, 0xaa, 0xe7, 0x71, 0x9f, 0xff,
+	0x0e, 0x00, 0xbc, 0x61, 0x0e, 0x5e, 0x33, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -132,6 +142,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CallbackRetryList) > 0 {
+		for iNdEx := len(m.CallbackRetryList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CallbackRetryList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.CallbackDataList) > 0 {
 		for iNdEx := len(m.CallbackDataList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -195,6 +219,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.CallbackRetryList) > 0 {
+		for _, e := range m.CallbackRetryList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -332,6 +362,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackRetryList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackRetryList = append(m.CallbackRetryList, CallbackRetry{})
+			if err := m.CallbackRetryList[len(m.CallbackRetryList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
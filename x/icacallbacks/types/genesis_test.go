@@ -22,6 +22,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				PortId: types.PortID,
 				CallbackDataList: []types.CallbackData{
 					{
@@ -31,6 +32,14 @@ func TestGenesisState_Validate(t *testing.T) {
 						CallbackKey: "1",
 					},
 				},
+				CallbackRetryList: []types.CallbackRetry{
+					{
+						CallbackKey: "0",
+					},
+					{
+						CallbackKey: "1",
+					},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -49,6 +58,30 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated callbackRetry",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PortId: types.PortID,
+				CallbackRetryList: []types.CallbackRetry{
+					{
+						CallbackKey: "0",
+					},
+					{
+						CallbackKey: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid retry timeout",
+			genState: &types.GenesisState{
+				Params: types.NewParams(3, 60, 0),
+				PortId: types.PortID,
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -9,6 +9,8 @@ var _ binary.ByteOrder
 const (
 	// CallbackDataKeyPrefix is the prefix to retrieve all CallbackData
 	CallbackDataKeyPrefix = "CallbackData/value/"
+	// CallbackRetryKeyPrefix is the prefix to retrieve all CallbackRetry
+	CallbackRetryKeyPrefix = "CallbackRetry/value/"
 )
 
 // CallbackDataKey returns the store key to retrieve a CallbackData from the index fields
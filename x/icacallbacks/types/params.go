@@ -1,35 +1,83 @@
 package types
 
 import (
+	"fmt"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+// Default retry params
+const (
+	DefaultMaxRetryAttempts    uint64 = 3
+	DefaultRetryBackoffSeconds uint64 = 60      // 1 minute
+	DefaultRetryTimeoutSeconds uint64 = 60 * 60 // 1 hour
+)
+
+// Parameter store keys
+var (
+	KeyMaxRetryAttempts    = []byte("MaxRetryAttempts")
+	KeyRetryBackoffSeconds = []byte("RetryBackoffSeconds")
+	KeyRetryTimeoutSeconds = []byte("RetryTimeoutSeconds")
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(maxRetryAttempts, retryBackoffSeconds, retryTimeoutSeconds uint64) Params {
+	return Params{
+		MaxRetryAttempts:    maxRetryAttempts,
+		RetryBackoffSeconds: retryBackoffSeconds,
+		RetryTimeoutSeconds: retryTimeoutSeconds,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultMaxRetryAttempts, DefaultRetryBackoffSeconds, DefaultRetryTimeoutSeconds)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxRetryAttempts, &p.MaxRetryAttempts, validateUint64),
+		paramtypes.NewParamSetPair(KeyRetryBackoffSeconds, &p.RetryBackoffSeconds, validatePositive),
+		paramtypes.NewParamSetPair(KeyRetryTimeoutSeconds, &p.RetryTimeoutSeconds, validatePositive),
+	}
+}
+
+func validateUint64(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validatePositive(i interface{}) error {
+	ival, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if ival == 0 {
+		return fmt.Errorf("parameter must be positive: %d", ival)
+	}
+	return nil
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return nil
+	if err := validateUint64(p.MaxRetryAttempts); err != nil {
+		return err
+	}
+	if err := validatePositive(p.RetryBackoffSeconds); err != nil {
+		return err
+	}
+	return validatePositive(p.RetryTimeoutSeconds)
 }
 
 // String implements the Stringer interface.
@@ -25,6 +25,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// Max number of times an ICA with a retryable callback failure is re-submitted
+	MaxRetryAttempts uint64 `protobuf:"varint,1,opt,name=max_retry_attempts,json=maxRetryAttempts,proto3" json:"max_retry_attempts,omitempty"`
+	// Delay before the first retry, doubled with each subsequent attempt
+	RetryBackoffSeconds uint64 `protobuf:"varint,2,opt,name=retry_backoff_seconds,json=retryBackoffSeconds,proto3" json:"retry_backoff_seconds,omitempty"`
+	// Timeout for each retried ICA
+	RetryTimeoutSeconds uint64 `protobuf:"varint,3,opt,name=retry_timeout_seconds,json=retryTimeoutSeconds,proto3" json:"retry_timeout_seconds,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +65,27 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMaxRetryAttempts() uint64 {
+	if m != nil {
+		return m.MaxRetryAttempts
+	}
+	return 0
+}
+
+func (m *Params) GetRetryBackoffSeconds() uint64 {
+	if m != nil {
+		return m.RetryBackoffSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetRetryTimeoutSeconds() uint64 {
+	if m != nil {
+		return m.RetryTimeoutSeconds
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "stride.icacallbacks.Params")
 }
@@ -66,17 +93,23 @@ func init() {
 func init() { proto.RegisterFile("stride/icacallbacks/params.proto", fileDescriptor_4c402599e6cfed62) }
 
 var fileDescriptor_4c402599e6cfed62 = []byte{
-	// 160 bytes of a gzipped FileDescriptorProto
+	// 248 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x28, 0x2e, 0x29, 0xca,
 	0x4c, 0x49, 0xd5, 0xcf, 0x4c, 0x4e, 0x4c, 0x4e, 0xcc, 0xc9, 0x49, 0x4a, 0x4c, 0xce, 0x2e, 0xd6,
 	0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xa8,
 	0xd0, 0x43, 0x56, 0x21, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x96, 0xd7, 0x07, 0xb1, 0x20, 0x4a,
-	0x95, 0xf8, 0xb8, 0xd8, 0x02, 0xc0, 0x5a, 0xad, 0x58, 0x66, 0x2c, 0x90, 0x67, 0x70, 0x0a, 0x38,
-	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63,
-	0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xb3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2,
-	0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0x60, 0xb0, 0xf9, 0xba, 0x3e, 0x89, 0x49, 0xc5, 0xfa, 0x50,
-	0xd7, 0x94, 0x19, 0x99, 0xeb, 0x57, 0xa0, 0xba, 0xa9, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d,
-	0x6c, 0x91, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x1b, 0xad, 0x18, 0xb7, 0x00, 0x00, 0x00,
+	0x95, 0x96, 0x30, 0x72, 0xb1, 0x05, 0x80, 0xf5, 0x0a, 0xe9, 0x70, 0x09, 0xe5, 0x26, 0x56, 0xc4,
+	0x17, 0xa5, 0x96, 0x14, 0x55, 0xc6, 0x27, 0x96, 0x94, 0xa4, 0xe6, 0x16, 0x94, 0x14, 0x4b, 0x30,
+	0x2a, 0x30, 0x6a, 0xb0, 0x04, 0x09, 0xe4, 0x26, 0x56, 0x04, 0x81, 0x24, 0x1c, 0xa1, 0xe2, 0x42,
+	0x46, 0x5c, 0xa2, 0x10, 0x95, 0x20, 0xd3, 0xf3, 0xd3, 0xd2, 0xe2, 0x8b, 0x53, 0x93, 0xf3, 0xf3,
+	0x52, 0x8a, 0x25, 0x98, 0xc0, 0x1a, 0x84, 0xc1, 0x92, 0x4e, 0x10, 0xb9, 0x60, 0x88, 0x14, 0x42,
+	0x4f, 0x49, 0x66, 0x6e, 0x6a, 0x7e, 0x69, 0x09, 0x5c, 0x0f, 0x33, 0x92, 0x9e, 0x10, 0x88, 0x1c,
+	0x54, 0x8f, 0x15, 0xcb, 0x8c, 0x05, 0xf2, 0x0c, 0x4e, 0x01, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78,
+	0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc,
+	0x78, 0x2c, 0xc7, 0x10, 0x65, 0x96, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab,
+	0x1f, 0x0c, 0xf6, 0xb6, 0xae, 0x4f, 0x62, 0x52, 0xb1, 0x3e, 0x34, 0x90, 0xca, 0x8c, 0xcc, 0xf5,
+	0x2b, 0x50, 0x83, 0xaa, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x7f, 0x63, 0xc0, 0x00,
+	0xe1, 0xd1, 0xb2, 0x20, 0x4e, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -99,6 +132,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RetryTimeoutSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RetryTimeoutSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RetryBackoffSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RetryBackoffSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxRetryAttempts != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRetryAttempts))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -119,6 +167,15 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.MaxRetryAttempts != 0 {
+		n += 1 + sovParams(uint64(m.MaxRetryAttempts))
+	}
+	if m.RetryBackoffSeconds != 0 {
+		n += 1 + sovParams(uint64(m.RetryBackoffSeconds))
+	}
+	if m.RetryTimeoutSeconds != 0 {
+		n += 1 + sovParams(uint64(m.RetryTimeoutSeconds))
+	}
 	return n
 }
 
@@ -157,6 +214,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetryAttempts", wireType)
+			}
+			m.MaxRetryAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetryAttempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryBackoffSeconds", wireType)
+			}
+			m.RetryBackoffSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryBackoffSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryTimeoutSeconds", wireType)
+			}
+			m.RetryTimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryTimeoutSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -297,6 +297,314 @@ func (m *QueryAllCallbackDataResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type QueryDecodedCallbackDataRequest struct {
+	CallbackKey string `protobuf:"bytes,1,opt,name=callback_key,json=callbackKey,proto3" json:"callback_key,omitempty"`
+}
+
+func (m *QueryDecodedCallbackDataRequest) Reset()         { *m = QueryDecodedCallbackDataRequest{} }
+func (m *QueryDecodedCallbackDataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDecodedCallbackDataRequest) ProtoMessage()    {}
+func (*QueryDecodedCallbackDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e73b99abb7e91c2, []int{6}
+}
+func (m *QueryDecodedCallbackDataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDecodedCallbackDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDecodedCallbackDataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDecodedCallbackDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDecodedCallbackDataRequest.Merge(m, src)
+}
+func (m *QueryDecodedCallbackDataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDecodedCallbackDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDecodedCallbackDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDecodedCallbackDataRequest proto.InternalMessageInfo
+
+func (m *QueryDecodedCallbackDataRequest) GetCallbackKey() string {
+	if m != nil {
+		return m.CallbackKey
+	}
+	return ""
+}
+
+type QueryDecodedCallbackDataResponse struct {
+	CallbackData DecodedCallbackData `protobuf:"bytes,1,opt,name=callback_data,json=callbackData,proto3" json:"callback_data"`
+}
+
+func (m *QueryDecodedCallbackDataResponse) Reset()         { *m = QueryDecodedCallbackDataResponse{} }
+func (m *QueryDecodedCallbackDataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDecodedCallbackDataResponse) ProtoMessage()    {}
+func (*QueryDecodedCallbackDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e73b99abb7e91c2, []int{7}
+}
+func (m *QueryDecodedCallbackDataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDecodedCallbackDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDecodedCallbackDataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDecodedCallbackDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDecodedCallbackDataResponse.Merge(m, src)
+}
+func (m *QueryDecodedCallbackDataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDecodedCallbackDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDecodedCallbackDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDecodedCallbackDataResponse proto.InternalMessageInfo
+
+func (m *QueryDecodedCallbackDataResponse) GetCallbackData() DecodedCallbackData {
+	if m != nil {
+		return m.CallbackData
+	}
+	return DecodedCallbackData{}
+}
+
+type QueryAllDecodedCallbackDataRequest struct {
+	// Optional filter for the callback ID (e.g. "delegate")
+	CallbackId string `protobuf:"bytes,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	// Optional filter for the chain ID of the host that the ICA was sent to
+	HostZoneId string `protobuf:"bytes,2,opt,name=host_zone_id,json=hostZoneId,proto3" json:"host_zone_id,omitempty"`
+	// Optional filter to only return callbacks that were stored at least this
+	// many seconds ago
+	MinAgeSeconds uint64             `protobuf:"varint,3,opt,name=min_age_seconds,json=minAgeSeconds,proto3" json:"min_age_seconds,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllDecodedCallbackDataRequest) Reset()         { *m = QueryAllDecodedCallbackDataRequest{} }
+func (m *QueryAllDecodedCallbackDataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllDecodedCallbackDataRequest) ProtoMessage()    {}
+func (*QueryAllDecodedCallbackDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e73b99abb7e91c2, []int{8}
+}
+func (m *QueryAllDecodedCallbackDataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllDecodedCallbackDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllDecodedCallbackDataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllDecodedCallbackDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllDecodedCallbackDataRequest.Merge(m, src)
+}
+func (m *QueryAllDecodedCallbackDataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllDecodedCallbackDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllDecodedCallbackDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllDecodedCallbackDataRequest proto.InternalMessageInfo
+
+func (m *QueryAllDecodedCallbackDataRequest) GetCallbackId() string {
+	if m != nil {
+		return m.CallbackId
+	}
+	return ""
+}
+
+func (m *QueryAllDecodedCallbackDataRequest) GetHostZoneId() string {
+	if m != nil {
+		return m.HostZoneId
+	}
+	return ""
+}
+
+func (m *QueryAllDecodedCallbackDataRequest) GetMinAgeSeconds() uint64 {
+	if m != nil {
+		return m.MinAgeSeconds
+	}
+	return 0
+}
+
+func (m *QueryAllDecodedCallbackDataRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllDecodedCallbackDataResponse struct {
+	CallbackData []DecodedCallbackData `protobuf:"bytes,1,rep,name=callback_data,json=callbackData,proto3" json:"callback_data"`
+	Pagination   *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllDecodedCallbackDataResponse) Reset()         { *m = QueryAllDecodedCallbackDataResponse{} }
+func (m *QueryAllDecodedCallbackDataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllDecodedCallbackDataResponse) ProtoMessage()    {}
+func (*QueryAllDecodedCallbackDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e73b99abb7e91c2, []int{9}
+}
+func (m *QueryAllDecodedCallbackDataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllDecodedCallbackDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllDecodedCallbackDataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllDecodedCallbackDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllDecodedCallbackDataResponse.Merge(m, src)
+}
+func (m *QueryAllDecodedCallbackDataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllDecodedCallbackDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllDecodedCallbackDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllDecodedCallbackDataResponse proto.InternalMessageInfo
+
+func (m *QueryAllDecodedCallbackDataResponse) GetCallbackData() []DecodedCallbackData {
+	if m != nil {
+		return m.CallbackData
+	}
+	return nil
+}
+
+func (m *QueryAllDecodedCallbackDataResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryCallbackRetriesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCallbackRetriesRequest) Reset()         { *m = QueryCallbackRetriesRequest{} }
+func (m *QueryCallbackRetriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCallbackRetriesRequest) ProtoMessage()    {}
+func (*QueryCallbackRetriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e73b99abb7e91c2, []int{10}
+}
+func (m *QueryCallbackRetriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCallbackRetriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCallbackRetriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCallbackRetriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCallbackRetriesRequest.Merge(m, src)
+}
+func (m *QueryCallbackRetriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCallbackRetriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCallbackRetriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCallbackRetriesRequest proto.InternalMessageInfo
+
+func (m *QueryCallbackRetriesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryCallbackRetriesResponse struct {
+	CallbackRetries []CallbackRetry     `protobuf:"bytes,1,rep,name=callback_retries,json=callbackRetries,proto3" json:"callback_retries"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCallbackRetriesResponse) Reset()         { *m = QueryCallbackRetriesResponse{} }
+func (m *QueryCallbackRetriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCallbackRetriesResponse) ProtoMessage()    {}
+func (*QueryCallbackRetriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e73b99abb7e91c2, []int{11}
+}
+func (m *QueryCallbackRetriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCallbackRetriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCallbackRetriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCallbackRetriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCallbackRetriesResponse.Merge(m, src)
+}
+func (m *QueryCallbackRetriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCallbackRetriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCallbackRetriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCallbackRetriesResponse proto.InternalMessageInfo
+
+func (m *QueryCallbackRetriesResponse) GetCallbackRetries() []CallbackRetry {
+	if m != nil {
+		return m.CallbackRetries
+	}
+	return nil
+}
+
+func (m *QueryCallbackRetriesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "stride.icacallbacks.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "stride.icacallbacks.QueryParamsResponse")
@@ -304,45 +612,68 @@ func init() {
 	proto.RegisterType((*QueryGetCallbackDataResponse)(nil), "stride.icacallbacks.QueryGetCallbackDataResponse")
 	proto.RegisterType((*QueryAllCallbackDataRequest)(nil), "stride.icacallbacks.QueryAllCallbackDataRequest")
 	proto.RegisterType((*QueryAllCallbackDataResponse)(nil), "stride.icacallbacks.QueryAllCallbackDataResponse")
+	proto.RegisterType((*QueryDecodedCallbackDataRequest)(nil), "stride.icacallbacks.QueryDecodedCallbackDataRequest")
+	proto.RegisterType((*QueryDecodedCallbackDataResponse)(nil), "stride.icacallbacks.QueryDecodedCallbackDataResponse")
+	proto.RegisterType((*QueryAllDecodedCallbackDataRequest)(nil), "stride.icacallbacks.QueryAllDecodedCallbackDataRequest")
+	proto.RegisterType((*QueryAllDecodedCallbackDataResponse)(nil), "stride.icacallbacks.QueryAllDecodedCallbackDataResponse")
+	proto.RegisterType((*QueryCallbackRetriesRequest)(nil), "stride.icacallbacks.QueryCallbackRetriesRequest")
+	proto.RegisterType((*QueryCallbackRetriesResponse)(nil), "stride.icacallbacks.QueryCallbackRetriesResponse")
 }
 
 func init() { proto.RegisterFile("stride/icacallbacks/query.proto", fileDescriptor_5e73b99abb7e91c2) }
 
 var fileDescriptor_5e73b99abb7e91c2 = []byte{
-	// 521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0xb5, 0x06, 0x9c, 0x56, 0x84, 0x69, 0x0f, 0x92, 0x96, 0x6d, 0xbb, 0x07, 0x53,
-	0x05, 0x67, 0x9a, 0x08, 0x15, 0x0f, 0xa2, 0xad, 0x3f, 0x7a, 0xb0, 0x87, 0x18, 0x6f, 0x5e, 0xe4,
-	0xed, 0x76, 0x58, 0x97, 0x4e, 0x76, 0xb6, 0x99, 0x49, 0x31, 0x88, 0x17, 0xf1, 0xe8, 0x41, 0xf0,
-	0xff, 0xf0, 0xe6, 0xc5, 0xbf, 0xa0, 0xc7, 0x82, 0x17, 0x4f, 0x22, 0x89, 0x7f, 0x88, 0x64, 0x66,
-	0x5a, 0x77, 0xe9, 0x6c, 0x43, 0x7b, 0x1b, 0x5e, 0xde, 0xf7, 0xfb, 0x3e, 0x6f, 0xdf, 0x97, 0xe0,
-	0x15, 0xa5, 0xfb, 0xe9, 0x1e, 0x67, 0x69, 0x0c, 0x31, 0x08, 0x11, 0x41, 0xbc, 0xaf, 0xd8, 0xc1,
-	0x80, 0xf7, 0x87, 0x34, 0xef, 0x4b, 0x2d, 0xc9, 0x82, 0x6d, 0xa0, 0xc5, 0x86, 0xc6, 0x9d, 0x58,
-	0xaa, 0x9e, 0x54, 0x2c, 0x02, 0xc5, 0x6d, 0x37, 0x3b, 0x6c, 0x45, 0x5c, 0x43, 0x8b, 0xe5, 0x90,
-	0xa4, 0x19, 0xe8, 0x54, 0x66, 0xd6, 0xa0, 0xb1, 0x98, 0xc8, 0x44, 0x9a, 0x27, 0x9b, 0xbc, 0x5c,
-	0x75, 0x39, 0x91, 0x32, 0x11, 0x9c, 0x41, 0x9e, 0x32, 0xc8, 0x32, 0xa9, 0x8d, 0x44, 0xb9, 0x5f,
-	0x9b, 0x3e, 0xaa, 0x93, 0xd7, 0x9b, 0x3d, 0xd0, 0xe0, 0x1a, 0x57, 0x7d, 0x8d, 0x39, 0xf4, 0xa1,
-	0xe7, 0xac, 0xc2, 0x45, 0x4c, 0x5e, 0x4e, 0x00, 0x3b, 0xa6, 0xd8, 0xe5, 0x07, 0x03, 0xae, 0x74,
-	0xd8, 0xc1, 0x0b, 0xa5, 0xaa, 0xca, 0x65, 0xa6, 0x38, 0x79, 0x80, 0xeb, 0x56, 0x7c, 0x13, 0xad,
-	0xa2, 0xf5, 0xb9, 0xf6, 0x12, 0xf5, 0x6c, 0x4f, 0xad, 0x68, 0x7b, 0xf6, 0xe8, 0xf7, 0x4a, 0xad,
-	0xeb, 0x04, 0xe1, 0x63, 0xbc, 0x64, 0x1c, 0x77, 0xb8, 0x7e, 0xe2, 0x3a, 0x9f, 0x82, 0x06, 0x37,
-	0x90, 0xac, 0xe1, 0xf9, 0x53, 0xfe, 0x7d, 0x3e, 0x34, 0xfe, 0xd7, 0xba, 0x73, 0x27, 0xb5, 0x17,
-	0x7c, 0x18, 0x0a, 0xbc, 0xec, 0x77, 0x70, 0x70, 0xbb, 0xf8, 0x7a, 0xe9, 0x13, 0x38, 0xc6, 0x35,
-	0x2f, 0x63, 0xd1, 0xc1, 0x91, 0x9e, 0x02, 0x4c, 0x6a, 0x21, 0x77, 0xbc, 0x5b, 0x42, 0xf8, 0x78,
-	0x9f, 0x63, 0xfc, 0xff, 0x92, 0x6e, 0xd2, 0x2d, 0x6a, 0xcf, 0x4e, 0x27, 0x67, 0xa7, 0x36, 0x24,
-	0xee, 0xec, 0xb4, 0x03, 0x09, 0x77, 0xda, 0x6e, 0x41, 0x19, 0x7e, 0x47, 0x6e, 0xab, 0x33, 0x73,
-	0xaa, 0xb7, 0xba, 0x72, 0xe9, 0xad, 0xc8, 0x4e, 0x09, 0x7b, 0xc6, 0x60, 0x37, 0xa7, 0x62, 0x5b,
-	0x94, 0x22, 0x77, 0xfb, 0xd3, 0x2c, 0xbe, 0x6a, 0xb8, 0xc9, 0x67, 0x84, 0xeb, 0xf6, 0xe2, 0xa4,
-	0xe9, 0x85, 0x3a, 0x1b, 0xaf, 0xc6, 0xfa, 0xf4, 0x46, 0x3b, 0x33, 0x64, 0x1f, 0x7f, 0xfe, 0xfd,
-	0x3a, 0x73, 0x9b, 0x34, 0xd9, 0x2b, 0xa3, 0xb8, 0xbb, 0x0b, 0x91, 0x62, 0xd5, 0xa9, 0x26, 0x3f,
-	0x10, 0x9e, 0x2f, 0x7e, 0x06, 0xb2, 0x51, 0x3d, 0xcb, 0x9f, 0xc5, 0x46, 0xeb, 0x02, 0x0a, 0x87,
-	0xf9, 0xcc, 0x60, 0x3e, 0x22, 0x0f, 0xa7, 0x62, 0x96, 0x8e, 0xc9, 0xde, 0x17, 0x43, 0xff, 0x81,
-	0x7c, 0x43, 0xf8, 0x46, 0xd1, 0x7f, 0x4b, 0x88, 0xf3, 0xf8, 0xfd, 0xd9, 0x3c, 0x8f, 0xbf, 0x22,
-	0x65, 0xe1, 0xa6, 0xe1, 0xdf, 0x20, 0xf4, 0x62, 0xfc, 0xdb, 0x9d, 0xa3, 0x51, 0x80, 0x8e, 0x47,
-	0x01, 0xfa, 0x33, 0x0a, 0xd0, 0x97, 0x71, 0x50, 0x3b, 0x1e, 0x07, 0xb5, 0x5f, 0xe3, 0xa0, 0xf6,
-	0x7a, 0x33, 0x49, 0xf5, 0xdb, 0x41, 0x44, 0x63, 0xd9, 0xf3, 0x79, 0x1e, 0xb6, 0xef, 0xb3, 0x77,
-	0x65, 0x67, 0x3d, 0xcc, 0xb9, 0x8a, 0xea, 0xe6, 0x6f, 0xe9, 0xde, 0xbf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x77, 0x56, 0x53, 0x32, 0x79, 0x05, 0x00, 0x00,
+	// 792 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xc7, 0xbb, 0x80, 0x44, 0x87, 0x12, 0xcc, 0x40, 0x0c, 0x59, 0x48, 0x5b, 0xd6, 0x84, 0x56,
+	0x13, 0x77, 0x28, 0x28, 0x3f, 0x0e, 0xfe, 0x00, 0x41, 0x42, 0xc4, 0xa4, 0x96, 0x1b, 0x97, 0x66,
+	0xba, 0x3b, 0x59, 0x36, 0x6c, 0x77, 0x4a, 0x67, 0x40, 0xab, 0xf1, 0xe2, 0xd9, 0x83, 0x89, 0xff,
+	0x87, 0x89, 0x07, 0x2f, 0xc6, 0x9b, 0x17, 0x12, 0x2f, 0x18, 0x0f, 0x1a, 0x0f, 0xc6, 0x80, 0x7f,
+	0x88, 0xe9, 0xec, 0x2c, 0xee, 0xca, 0x74, 0x6b, 0x81, 0xdb, 0xe6, 0xf5, 0xfd, 0xf8, 0xbc, 0xef,
+	0xbc, 0x79, 0x53, 0x90, 0x65, 0xbc, 0xe1, 0xda, 0x04, 0xb9, 0x16, 0xb6, 0xb0, 0xe7, 0x55, 0xb1,
+	0xb5, 0xcd, 0xd0, 0xce, 0x2e, 0x69, 0x34, 0xcd, 0x7a, 0x83, 0x72, 0x0a, 0x87, 0x03, 0x07, 0x33,
+	0xea, 0xa0, 0x5f, 0xb7, 0x28, 0xab, 0x51, 0x86, 0xaa, 0x98, 0x91, 0xc0, 0x1b, 0xed, 0x15, 0xab,
+	0x84, 0xe3, 0x22, 0xaa, 0x63, 0xc7, 0xf5, 0x31, 0x77, 0xa9, 0x1f, 0x24, 0xd0, 0x47, 0x1c, 0xea,
+	0x50, 0xf1, 0x89, 0x5a, 0x5f, 0xd2, 0x3a, 0xee, 0x50, 0xea, 0x78, 0x04, 0xe1, 0xba, 0x8b, 0xb0,
+	0xef, 0x53, 0x2e, 0x42, 0x98, 0xfc, 0x35, 0xaf, 0xa2, 0x0a, 0xbf, 0x2a, 0x36, 0xe6, 0x58, 0x3a,
+	0x16, 0x12, 0x1d, 0x1b, 0x84, 0x87, 0x7d, 0xe8, 0x39, 0x95, 0x67, 0x1d, 0x37, 0x70, 0x4d, 0x16,
+	0x35, 0x46, 0x00, 0x7c, 0xdc, 0x6a, 0xa5, 0x24, 0x8c, 0x65, 0xb2, 0xb3, 0x4b, 0x18, 0x37, 0x4a,
+	0x60, 0x38, 0x66, 0x65, 0x75, 0xea, 0x33, 0x02, 0x17, 0x40, 0x7f, 0x10, 0x3c, 0xaa, 0xe5, 0xb4,
+	0xc2, 0xc0, 0xf4, 0x98, 0xa9, 0xd0, 0xc9, 0x0c, 0x82, 0x96, 0xfa, 0xf6, 0x7f, 0x66, 0x53, 0x65,
+	0x19, 0x60, 0xdc, 0x03, 0x63, 0x22, 0xe3, 0x2a, 0xe1, 0xf7, 0xa5, 0xe7, 0x32, 0xe6, 0x58, 0x16,
+	0x84, 0x13, 0x20, 0x7d, 0xdc, 0xc0, 0x36, 0x69, 0x8a, 0xfc, 0x97, 0xca, 0x03, 0xa1, 0xed, 0x21,
+	0x69, 0x1a, 0x1e, 0x18, 0x57, 0x67, 0x90, 0x70, 0xeb, 0x60, 0x30, 0x26, 0x96, 0x64, 0x9c, 0x50,
+	0x32, 0x46, 0x33, 0x48, 0xd2, 0xb4, 0x15, 0xb1, 0x19, 0x44, 0xf2, 0x2e, 0x7a, 0x9e, 0x8a, 0xf7,
+	0x01, 0x00, 0x7f, 0xcf, 0x5c, 0x56, 0x9a, 0x34, 0x83, 0x01, 0x31, 0x5b, 0x03, 0x62, 0x06, 0xe3,
+	0x24, 0x07, 0xc4, 0x2c, 0x61, 0x87, 0xc8, 0xd8, 0x72, 0x24, 0xd2, 0x78, 0xaf, 0x81, 0x71, 0x75,
+	0x9d, 0xf6, 0x5d, 0xf5, 0x9e, 0xba, 0x2b, 0xb8, 0x1a, 0xc3, 0xee, 0x11, 0xd8, 0xf9, 0x8e, 0xd8,
+	0x01, 0x4a, 0x8c, 0x7b, 0x19, 0x64, 0x05, 0xf6, 0x32, 0xb1, 0xa8, 0x4d, 0xec, 0x53, 0x1e, 0xe9,
+	0x13, 0x90, 0x6b, 0x9f, 0x45, 0x0a, 0xb0, 0xa1, 0x3e, 0xd6, 0x82, 0x52, 0x00, 0x45, 0x22, 0xe5,
+	0xe9, 0x7e, 0xd3, 0x80, 0x11, 0xca, 0x9e, 0xd0, 0x42, 0x16, 0x1c, 0xe3, 0x56, 0x5c, 0x5b, 0x76,
+	0x00, 0x42, 0xd3, 0x9a, 0x0d, 0x73, 0x20, 0xbd, 0x45, 0x19, 0xaf, 0x3c, 0xa3, 0x3e, 0x69, 0x79,
+	0xf4, 0x04, 0x1e, 0x2d, 0xdb, 0x26, 0xf5, 0xc9, 0x9a, 0x0d, 0x27, 0xc1, 0x50, 0xcd, 0xf5, 0x2b,
+	0xd8, 0x21, 0x15, 0x46, 0x2c, 0xea, 0xdb, 0x6c, 0xb4, 0x37, 0xa7, 0x15, 0xfa, 0xca, 0x83, 0x35,
+	0xd7, 0x5f, 0x74, 0xc8, 0x46, 0x60, 0xfc, 0x67, 0xa0, 0xfa, 0x4e, 0x3d, 0x50, 0x9f, 0x34, 0x70,
+	0x35, 0xb1, 0xb3, 0xf6, 0xb2, 0xf6, 0x9e, 0x55, 0xd6, 0xf3, 0x1b, 0xaf, 0xf0, 0xf6, 0x85, 0x15,
+	0xcb, 0x84, 0x37, 0x5c, 0xc2, 0xce, 0xfb, 0xf6, 0x7d, 0x0c, 0x6f, 0xdf, 0x89, 0x3a, 0xc7, 0x2a,
+	0x5d, 0x8e, 0xed, 0x55, 0x97, 0x30, 0x29, 0x94, 0x91, 0x78, 0x01, 0x5b, 0x79, 0x9a, 0x52, 0xa2,
+	0x21, 0x2b, 0x9e, 0xfc, 0xdc, 0x54, 0x9a, 0xfe, 0x71, 0x11, 0x5c, 0x10, 0xf8, 0xf0, 0x95, 0x06,
+	0xfa, 0x83, 0xb5, 0x0b, 0xf3, 0x4a, 0xb0, 0x93, 0x3b, 0x5e, 0x2f, 0x74, 0x76, 0x0c, 0x6a, 0x1a,
+	0xe8, 0xe5, 0xd7, 0xdf, 0x6f, 0x7a, 0xae, 0xc1, 0x3c, 0xda, 0x10, 0x11, 0x37, 0xd6, 0x71, 0x95,
+	0xa1, 0xf6, 0x4f, 0x0b, 0xfc, 0xa0, 0x81, 0x74, 0x74, 0x58, 0xe0, 0x54, 0xfb, 0x5a, 0xea, 0x07,
+	0x41, 0x2f, 0x76, 0x11, 0x21, 0x31, 0x57, 0x04, 0xe6, 0x5d, 0x78, 0xbb, 0x23, 0x66, 0x6c, 0xf2,
+	0xd1, 0xf3, 0xe8, 0x9a, 0x7a, 0x01, 0xdf, 0x6a, 0x60, 0x28, 0x9a, 0x7f, 0xd1, 0xf3, 0x92, 0xf8,
+	0xd5, 0x0f, 0x84, 0x5e, 0xec, 0x22, 0x42, 0xf2, 0xcf, 0x0a, 0xfe, 0x29, 0x68, 0x76, 0xc7, 0x0f,
+	0xbf, 0x68, 0x60, 0x58, 0x71, 0x43, 0xe1, 0xcd, 0xf6, 0x08, 0xed, 0x77, 0x9e, 0x7e, 0xab, 0xcb,
+	0x28, 0x09, 0xff, 0x48, 0xc0, 0xaf, 0xc2, 0x95, 0x33, 0x89, 0x8f, 0xec, 0xa0, 0x04, 0xfc, 0xac,
+	0x81, 0x2b, 0x8a, 0x72, 0xad, 0xb3, 0x98, 0x4b, 0x54, 0x36, 0xa1, 0xb3, 0xf9, 0xee, 0x03, 0x65,
+	0x73, 0x77, 0x44, 0x73, 0xf3, 0x70, 0xb6, 0x63, 0x73, 0x92, 0xbf, 0x12, 0x3f, 0xa1, 0x77, 0x91,
+	0x91, 0x0a, 0xb7, 0x40, 0xc2, 0x48, 0xa9, 0xb7, 0x9e, 0x5e, 0xec, 0x22, 0x42, 0x82, 0x2f, 0x08,
+	0xf0, 0x19, 0x58, 0xfc, 0xff, 0x53, 0x91, 0x6b, 0x6e, 0xa9, 0xb4, 0x7f, 0x98, 0xd1, 0x0e, 0x0e,
+	0x33, 0xda, 0xaf, 0xc3, 0x8c, 0xf6, 0xfa, 0x28, 0x93, 0x3a, 0x38, 0xca, 0xa4, 0xbe, 0x1f, 0x65,
+	0x52, 0x9b, 0xb3, 0x8e, 0xcb, 0xb7, 0x76, 0xab, 0xa6, 0x45, 0x6b, 0xaa, 0xb4, 0x7b, 0xd3, 0x73,
+	0xe8, 0x69, 0x3c, 0x39, 0x6f, 0xd6, 0x09, 0xab, 0xf6, 0x8b, 0x7f, 0x9c, 0x33, 0x7f, 0x06, 0x00,
+	0xc0, 0xb3, 0x7e, 0xb3, 0x7e, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -363,6 +694,13 @@ type QueryClient interface {
 	CallbackData(ctx context.Context, in *QueryGetCallbackDataRequest, opts ...grpc.CallOption) (*QueryGetCallbackDataResponse, error)
 	// Queries a list of CallbackData items.
 	CallbackDataAll(ctx context.Context, in *QueryAllCallbackDataRequest, opts ...grpc.CallOption) (*QueryAllCallbackDataResponse, error)
+	// Queries a CallbackData by index, with the callback args decoded
+	DecodedCallbackData(ctx context.Context, in *QueryDecodedCallbackDataRequest, opts ...grpc.CallOption) (*QueryDecodedCallbackDataResponse, error)
+	// Queries a list of CallbackData items, with the callback args decoded
+	// Optionally filtered by callback ID, host zone, and minimum age
+	DecodedCallbackDataAll(ctx context.Context, in *QueryAllDecodedCallbackDataRequest, opts ...grpc.CallOption) (*QueryAllDecodedCallbackDataResponse, error)
+	// Queries the ICAs that are queued to be retried
+	CallbackRetries(ctx context.Context, in *QueryCallbackRetriesRequest, opts ...grpc.CallOption) (*QueryCallbackRetriesResponse, error)
 }
 
 type queryClient struct {
@@ -400,6 +738,33 @@ func (c *queryClient) CallbackDataAll(ctx context.Context, in *QueryAllCallbackD
 	return out, nil
 }
 
+func (c *queryClient) DecodedCallbackData(ctx context.Context, in *QueryDecodedCallbackDataRequest, opts ...grpc.CallOption) (*QueryDecodedCallbackDataResponse, error) {
+	out := new(QueryDecodedCallbackDataResponse)
+	err := c.cc.Invoke(ctx, "/stride.icacallbacks.Query/DecodedCallbackData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DecodedCallbackDataAll(ctx context.Context, in *QueryAllDecodedCallbackDataRequest, opts ...grpc.CallOption) (*QueryAllDecodedCallbackDataResponse, error) {
+	out := new(QueryAllDecodedCallbackDataResponse)
+	err := c.cc.Invoke(ctx, "/stride.icacallbacks.Query/DecodedCallbackDataAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CallbackRetries(ctx context.Context, in *QueryCallbackRetriesRequest, opts ...grpc.CallOption) (*QueryCallbackRetriesResponse, error) {
+	out := new(QueryCallbackRetriesResponse)
+	err := c.cc.Invoke(ctx, "/stride.icacallbacks.Query/CallbackRetries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -408,6 +773,13 @@ type QueryServer interface {
 	CallbackData(context.Context, *QueryGetCallbackDataRequest) (*QueryGetCallbackDataResponse, error)
 	// Queries a list of CallbackData items.
 	CallbackDataAll(context.Context, *QueryAllCallbackDataRequest) (*QueryAllCallbackDataResponse, error)
+	// Queries a CallbackData by index, with the callback args decoded
+	DecodedCallbackData(context.Context, *QueryDecodedCallbackDataRequest) (*QueryDecodedCallbackDataResponse, error)
+	// Queries a list of CallbackData items, with the callback args decoded
+	// Optionally filtered by callback ID, host zone, and minimum age
+	DecodedCallbackDataAll(context.Context, *QueryAllDecodedCallbackDataRequest) (*QueryAllDecodedCallbackDataResponse, error)
+	// Queries the ICAs that are queued to be retried
+	CallbackRetries(context.Context, *QueryCallbackRetriesRequest) (*QueryCallbackRetriesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -423,6 +795,15 @@ func (*UnimplementedQueryServer) CallbackData(ctx context.Context, req *QueryGet
 func (*UnimplementedQueryServer) CallbackDataAll(ctx context.Context, req *QueryAllCallbackDataRequest) (*QueryAllCallbackDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CallbackDataAll not implemented")
 }
+func (*UnimplementedQueryServer) DecodedCallbackData(ctx context.Context, req *QueryDecodedCallbackDataRequest) (*QueryDecodedCallbackDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodedCallbackData not implemented")
+}
+func (*UnimplementedQueryServer) DecodedCallbackDataAll(ctx context.Context, req *QueryAllDecodedCallbackDataRequest) (*QueryAllDecodedCallbackDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodedCallbackDataAll not implemented")
+}
+func (*UnimplementedQueryServer) CallbackRetries(ctx context.Context, req *QueryCallbackRetriesRequest) (*QueryCallbackRetriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CallbackRetries not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -482,6 +863,60 @@ func _Query_CallbackDataAll_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DecodedCallbackData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDecodedCallbackDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DecodedCallbackData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.icacallbacks.Query/DecodedCallbackData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DecodedCallbackData(ctx, req.(*QueryDecodedCallbackDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DecodedCallbackDataAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDecodedCallbackDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DecodedCallbackDataAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.icacallbacks.Query/DecodedCallbackDataAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DecodedCallbackDataAll(ctx, req.(*QueryAllDecodedCallbackDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CallbackRetries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCallbackRetriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CallbackRetries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.icacallbacks.Query/CallbackRetries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CallbackRetries(ctx, req.(*QueryCallbackRetriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.icacallbacks.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -498,6 +933,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CallbackDataAll",
 			Handler:    _Query_CallbackDataAll_Handler,
 		},
+		{
+			MethodName: "DecodedCallbackData",
+			Handler:    _Query_DecodedCallbackData_Handler,
+		},
+		{
+			MethodName: "DecodedCallbackDataAll",
+			Handler:    _Query_DecodedCallbackDataAll_Handler,
+		},
+		{
+			MethodName: "CallbackRetries",
+			Handler:    _Query_CallbackRetries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stride/icacallbacks/query.proto",
@@ -706,8 +1153,258 @@ func (m *QueryAllCallbackDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
+func (m *QueryDecodedCallbackDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDecodedCallbackDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDecodedCallbackDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CallbackKey) > 0 {
+		i -= len(m.CallbackKey)
+		copy(dAtA[i:], m.CallbackKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CallbackKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDecodedCallbackDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDecodedCallbackDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDecodedCallbackDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CallbackData.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDecodedCallbackDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDecodedCallbackDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDecodedCallbackDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MinAgeSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinAgeSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.HostZoneId) > 0 {
+		i -= len(m.HostZoneId)
+		copy(dAtA[i:], m.HostZoneId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.HostZoneId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CallbackId) > 0 {
+		i -= len(m.CallbackId)
+		copy(dAtA[i:], m.CallbackId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CallbackId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDecodedCallbackDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDecodedCallbackDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDecodedCallbackDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CallbackData) > 0 {
+		for iNdEx := len(m.CallbackData) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CallbackData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCallbackRetriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCallbackRetriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCallbackRetriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCallbackRetriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCallbackRetriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCallbackRetriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CallbackRetries) > 0 {
+		for iNdEx := len(m.CallbackRetries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CallbackRetries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
 	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -786,20 +1483,623 @@ func (m *QueryAllCallbackDataResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDecodedCallbackDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CallbackKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDecodedCallbackDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CallbackData.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllDecodedCallbackDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CallbackId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.HostZoneId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MinAgeSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.MinAgeSeconds))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllDecodedCallbackDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CallbackData) > 0 {
+		for _, e := range m.CallbackData {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCallbackRetriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCallbackRetriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CallbackRetries) > 0 {
+		for _, e := range m.CallbackRetries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCallbackDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCallbackDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCallbackDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCallbackDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCallbackDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCallbackDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CallbackData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllCallbackDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllCallbackDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllCallbackDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllCallbackDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllCallbackDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllCallbackDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackData = append(m.CallbackData, CallbackData{})
+			if err := m.CallbackData[len(m.CallbackData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDecodedCallbackDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -822,12 +2122,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDecodedCallbackDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDecodedCallbackDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -849,7 +2181,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDecodedCallbackDataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -872,15 +2204,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDecodedCallbackDataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDecodedCallbackDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -907,7 +2239,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CallbackData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -932,7 +2264,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCallbackDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllDecodedCallbackDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -955,15 +2287,15 @@ func (m *QueryGetCallbackDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCallbackDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllDecodedCallbackDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCallbackDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllDecodedCallbackDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallbackKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -991,7 +2323,94 @@ func (m *QueryGetCallbackDataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CallbackKey = string(dAtA[iNdEx:postIndex])
+			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAgeSeconds", wireType)
+			}
+			m.MinAgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinAgeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1014,7 +2433,7 @@ func (m *QueryGetCallbackDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCallbackDataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllDecodedCallbackDataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1037,10 +2456,10 @@ func (m *QueryGetCallbackDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCallbackDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllDecodedCallbackDataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCallbackDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllDecodedCallbackDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1072,7 +2491,44 @@ func (m *QueryGetCallbackDataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CallbackData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CallbackData = append(m.CallbackData, DecodedCallbackData{})
+			if err := m.CallbackData[len(m.CallbackData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1097,7 +2553,7 @@ func (m *QueryGetCallbackDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCallbackDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCallbackRetriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1120,10 +2576,10 @@ func (m *QueryAllCallbackDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCallbackDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCallbackRetriesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCallbackDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCallbackRetriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1183,7 +2639,7 @@ func (m *QueryAllCallbackDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCallbackDataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCallbackRetriesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1206,15 +2662,15 @@ func (m *QueryAllCallbackDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCallbackDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCallbackRetriesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCallbackDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCallbackRetriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallbackData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackRetries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1241,8 +2697,8 @@ func (m *QueryAllCallbackDataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CallbackData = append(m.CallbackData, CallbackData{})
-			if err := m.CallbackData[len(m.CallbackData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CallbackRetries = append(m.CallbackRetries, CallbackRetry{})
+			if err := m.CallbackRetries[len(m.CallbackRetries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
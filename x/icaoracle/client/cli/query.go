@@ -36,6 +36,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryOracle(),
 		GetCmdQueryOracles(),
 		GetCmdQueryMetrics(),
+		GetCmdQueryMetricProducers(),
 	)
 
 	return cmd
@@ -187,3 +188,37 @@ Examples:
 
 	return cmd
 }
+
+// GetCmdQueryMetricProducers implements a command to query all metric producer configs
+func GetCmdQueryMetricProducers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "metric-producers",
+		Short: "Queries all metric producer configs",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries all metric producer configs, along with the IDs of the registered producers
+Example:
+  $ %[1]s query %[2]s metric-producers
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryMetricProducersRequest{}
+			res, err := queryClient.MetricProducers(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -28,3 +28,8 @@ func (s *ClientTestSuite) TestCmdQueryPendingMetricUpdates() {
 	s.ExecuteQueryAndCheckSuccessful(cmd, byOracle, "query pending metric updates by oracle")
 	s.ExecuteQueryAndCheckSuccessful(cmd, append(byMetric, byOracle...), "query pending metric updates by metric and oracle")
 }
+
+func (s *ClientTestSuite) TestCmdQueryMetricProducers() {
+	cmd := cli.GetCmdQueryMetricProducers()
+	s.ExecuteQueryAndCheckSuccessful(cmd, []string{}, "query metric producers")
+}
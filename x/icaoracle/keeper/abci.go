@@ -6,5 +6,6 @@ import (
 
 // EndBlocker of icaoracle module
 func (k Keeper) EndBlocker(ctx sdk.Context) {
+	k.RunMetricProducers(ctx)
 	k.PostAllQueuedMetrics(ctx)
 }
@@ -45,3 +45,19 @@ func EmitUpdateOracleAckEvent(ctx sdk.Context, metric *types.Metric, ackStatus s
 		),
 	)
 }
+
+// Emits an event after the contract's state was queried to verify a metric update
+func EmitVerifyMetricEvent(ctx sdk.Context, metric types.Metric, verified bool, contractValue string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVerifyMetric,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyOracleChainId, metric.DestinationOracle),
+			sdk.NewAttribute(types.AttributeKeyMetricID, metric.GetMetricID()),
+			sdk.NewAttribute(types.AttributeKeyMetricKey, metric.Key),
+			sdk.NewAttribute(types.AttributeKeyMetricValue, metric.Value),
+			sdk.NewAttribute(types.AttributeKeyMetricVerified, fmt.Sprintf("%t", verified)),
+			sdk.NewAttribute(types.AttributeKeyContractValue, contractValue),
+		),
+	)
+}
@@ -12,20 +12,26 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	if err := genState.Validate(); err != nil {
 		panic(err)
 	}
+	k.SetParams(ctx, genState.Params)
 	for _, oracle := range genState.Oracles {
 		k.SetOracle(ctx, oracle)
 	}
 	for _, metric := range genState.Metrics {
 		k.SetMetric(ctx, metric)
 	}
+	for _, config := range genState.MetricProducers {
+		k.SetMetricProducerConfig(ctx, config)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 
+	genesis.Params = k.GetParams(ctx)
 	genesis.Oracles = k.GetAllOracles(ctx)
 	genesis.Metrics = k.GetAllMetrics(ctx)
+	genesis.MetricProducers = k.GetAllMetricProducerConfigs(ctx)
 
 	return genesis
 }
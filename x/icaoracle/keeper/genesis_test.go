@@ -15,10 +15,19 @@ func (s *KeeperTestSuite) TestGenesis() {
 		Status:            types.MetricStatus_QUEUED,
 	}
 
+	metricProducer := types.MetricProducerConfig{
+		ProducerId:      "producer",
+		IntervalSeconds: 60,
+		OracleChainIds:  []string{"chain"},
+		Enabled:         true,
+		LastUpdateTime:  int64(1),
+	}
+
 	genesisState := types.GenesisState{
-		Params:  types.Params{},
-		Oracles: []types.Oracle{oracle},
-		Metrics: []types.Metric{metric},
+		Params:          types.Params{},
+		Oracles:         []types.Oracle{oracle},
+		Metrics:         []types.Metric{metric},
+		MetricProducers: []types.MetricProducerConfig{metricProducer},
 	}
 
 	s.App.ICAOracleKeeper.InitGenesis(s.Ctx, genesisState)
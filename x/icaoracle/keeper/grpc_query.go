@@ -58,3 +58,13 @@ func (k Keeper) Metrics(c context.Context, req *types.QueryMetricsRequest) (*typ
 
 	return &types.QueryMetricsResponse{Metrics: metrics}, nil
 }
+
+// Query all metric producer configs, along with the IDs of the producers registered in the app
+func (k Keeper) MetricProducers(c context.Context, req *types.QueryMetricProducersRequest) (*types.QueryMetricProducersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryMetricProducersResponse{
+		MetricProducers:       k.GetAllMetricProducerConfigs(ctx),
+		RegisteredProducerIds: k.GetRegisteredMetricProducerIds(),
+	}, nil
+}
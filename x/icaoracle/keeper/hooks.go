@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v27/x/icaoracle/types"
+	minttypes "github.com/Stride-Labs/stride/v27/x/mint/types"
+)
+
+// Queues the amount minted in the latest mint epoch as an inflation metric to the
+// oracles configured in the params
+// Metric keys are of format: {mintDenom}_inflation
+func (k Keeper) QueueInflationMetric(ctx sdk.Context, mintedCoin sdk.Coin) error {
+	params := k.GetParams(ctx)
+	if !params.StrdInflationMetricEnabled {
+		return nil
+	}
+
+	attributes, err := json.Marshal(types.InflationAttributes{
+		Denom: mintedCoin.Denom,
+	})
+	if err != nil {
+		return err
+	}
+
+	metricKey := fmt.Sprintf("%s_%s", mintedCoin.Denom, types.MetricType_Inflation)
+	k.QueueMetricUpdateToOracles(ctx, metricKey, mintedCoin.Amount.String(), types.MetricType_Inflation,
+		string(attributes), params.StrdInflationOracleChainIds)
+
+	return nil
+}
+
+// After each mint, push the minted amount to the oracles
+func (k Keeper) AfterDistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) {
+	if err := k.QueueInflationMetric(ctx, mintedCoin); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Unable to queue inflation metric: %s", err.Error()))
+	}
+}
+
+// ________________________________________________________________________________________
+
+// Hooks wrapper struct for icaoracle keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ minttypes.MintHooks = Hooks{}
+
+// Return the wrapper struct
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// mint hooks
+func (h Hooks) AfterDistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) {
+	h.k.AfterDistributeMintedCoin(ctx, mintedCoin)
+}
@@ -0,0 +1,42 @@
+package keeper_test
+
+import (
+	"encoding/json"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v27/x/icaoracle/types"
+)
+
+func (s *KeeperTestSuite) TestQueueInflationMetric() {
+	s.CreateTestOracles()
+	mintedCoin := sdk.NewCoin("ustrd", sdkmath.NewInt(1000))
+	metricKey := "ustrd_inflation"
+
+	// When the metric is disabled, nothing should be queued
+	s.App.ICAOracleKeeper.SetParams(s.Ctx, types.Params{StrdInflationMetricEnabled: false})
+	s.App.ICAOracleKeeper.Hooks().AfterDistributeMintedCoin(s.Ctx, mintedCoin)
+	s.Require().Empty(s.GetMetricDestinations(metricKey), "no metrics expected when disabled")
+
+	// Enable the metric for two of the oracles
+	s.App.ICAOracleKeeper.SetParams(s.Ctx, types.Params{
+		StrdInflationMetricEnabled:  true,
+		StrdInflationOracleChainIds: []string{"chain-1", "chain-2"},
+	})
+	s.App.ICAOracleKeeper.Hooks().AfterDistributeMintedCoin(s.Ctx, mintedCoin)
+	s.Require().ElementsMatch([]string{"chain-1", "chain-2"}, s.GetMetricDestinations(metricKey),
+		"metric destinations")
+
+	// Confirm the metric value and attributes
+	expectedMetric := types.Metric{Key: metricKey, Value: "1000", UpdateTime: s.Ctx.BlockTime().Unix(), DestinationOracle: "chain-1"}
+	metric, found := s.App.ICAOracleKeeper.GetMetric(s.Ctx, expectedMetric.GetMetricID())
+	s.Require().True(found, "metric should have been queued")
+	s.Require().Equal(types.MetricType_Inflation, metric.MetricType, "metric type")
+	s.Require().Equal(types.MetricStatus_QUEUED, metric.Status, "metric status")
+
+	var attributes types.InflationAttributes
+	err := json.Unmarshal([]byte(metric.Attributes), &attributes)
+	s.Require().NoError(err, "no error expected when unmarshaling attributes")
+	s.Require().Equal("ustrd", attributes.Denom, "attribute denom")
+}
@@ -89,6 +89,7 @@ func (k Keeper) SubmitICATx(ctx sdk.Context, tx types.ICATx) error {
 		Sequence:     sequence,
 		CallbackId:   tx.CallbackId,
 		CallbackArgs: callbackArgsBz,
+		CreatedAt:    ctx.BlockTime(),
 	}
 	k.ICACallbacksKeeper.SetCallbackData(ctx, callbackData)
 
@@ -182,6 +182,7 @@ func (s *KeeperTestSuite) TestSubmitICATx_Success() {
 		Sequence:     sequence,
 		CallbackId:   icaTx.CallbackId,
 		CallbackArgs: callbackBz,
+		CreatedAt:    s.Ctx.BlockTime(),
 	}
 	actualCallbackData, found := s.App.IcacallbacksKeeper.GetCallbackData(s.Ctx, callbackKey)
 	s.Require().True(found, "callback data should have been found")
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	icacallbackstypes "github.com/Stride-Labs/stride/v27/x/icacallbacks/types"
+	"github.com/Stride-Labs/stride/v27/x/icaoracle/types"
 )
 
 const (
@@ -12,12 +13,14 @@ const (
 func (k Keeper) Callbacks() icacallbackstypes.ModuleCallbacks {
 	return []icacallbackstypes.ICACallback{
 		{
-			CallbackId:   ICACallbackID_InstantiateOracle,
-			CallbackFunc: icacallbackstypes.ICACallbackFunction(k.InstantiateOracleCallback),
+			CallbackId:       ICACallbackID_InstantiateOracle,
+			CallbackFunc:     icacallbackstypes.ICACallbackFunction(k.InstantiateOracleCallback),
+			CallbackArgsType: &types.InstantiateOracleCallback{},
 		},
 		{
-			CallbackId:   ICACallbackID_UpdateOracle,
-			CallbackFunc: icacallbackstypes.ICACallbackFunction(k.UpdateOracleCallback),
+			CallbackId:       ICACallbackID_UpdateOracle,
+			CallbackFunc:     icacallbackstypes.ICACallbackFunction(k.UpdateOracleCallback),
+			CallbackArgsType: &types.UpdateOracleCallback{},
 		},
 	}
 }
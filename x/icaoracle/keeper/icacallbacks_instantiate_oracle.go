@@ -16,7 +16,8 @@ import (
 // Callback after an instantiating an oracle's CW contract
 //
 //	If successful: Stores the cosmwasm contract address on the oracle object
-//	If timeout/failure: Does nothing
+//	If failure: Requests a retry, and once retries are exhausted, does nothing
+//	If timeout: Does nothing
 func (k Keeper) InstantiateOracleCallback(ctx sdk.Context, packet channeltypes.Packet, ackResponse *icacallbackstypes.AcknowledgementResponse, args []byte) error {
 	// Fetch callback args
 	instantiateCallback := types.InstantiateOracleCallback{}
@@ -26,6 +27,12 @@ func (k Keeper) InstantiateOracleCallback(ctx sdk.Context, packet channeltypes.P
 	chainId := instantiateCallback.OracleChainId
 	k.Logger(ctx).Info(utils.LogICACallbackWithHostZone(chainId, ICACallbackID_InstantiateOracle, "Starting instantiate oracle callback"))
 
+	// If the instantiation failed and can still be retried, re-submit it
+	if ackResponse.Status == icacallbackstypes.AckResponseStatus_FAILURE && ackResponse.CanRetry {
+		k.Logger(ctx).Error(utils.LogICACallbackWithHostZone(chainId, ICACallbackID_InstantiateOracle, "Retrying oracle instantiation"))
+		return errorsmod.Wrapf(icacallbackstypes.ErrCallbackRetryable, "oracle instantiation failed: %s", ackResponse.Error)
+	}
+
 	// Check for timeout/failure
 	// No action is necessary on a timeout
 	if ackResponse.Status == icacallbackstypes.AckResponseStatus_TIMEOUT ||
@@ -1,11 +1,15 @@
 package keeper_test
 
 import (
+	"time"
+
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 
 	icacallbacktypes "github.com/Stride-Labs/stride/v27/x/icacallbacks/types"
+	"github.com/Stride-Labs/stride/v27/x/icaoracle/keeper"
 	"github.com/Stride-Labs/stride/v27/x/icaoracle/types"
 )
 
@@ -165,3 +169,74 @@ func (s *KeeperTestSuite) TestInstantiateOracleCallback_NoContractAddressInICARe
 	err = s.App.ICAOracleKeeper.InstantiateOracleCallback(s.Ctx, channeltypes.Packet{}, &invalidAckResponse, tc.ValidCallbackArgs)
 	s.Require().ErrorContains(err, "response from CW contract instantiation ICA does not contain a contract address")
 }
+
+func (s *KeeperTestSuite) TestInstantiateOracleCallback_AckFailureRetried() {
+	owner := types.FormatICAAccountOwner(HostChainId, types.ICAAccountType_Oracle)
+	channelId, portId := s.CreateICAChannel(owner)
+	tc := s.SetupTestInstantiateOracleCallback()
+
+	// Register the callback for an instantiation sent on the oracle ICA
+	data, err := icatypes.SerializeCosmosTx(s.App.AppCodec(), []proto.Message{&wasmtypes.MsgInstantiateContract{
+		Sender: "sender",
+		CodeID: 1,
+		Label:  "oracle",
+		Msg:    []byte("{}"),
+	}})
+	s.Require().NoError(err, "no error expected when serializing ICA tx")
+	packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data}
+
+	sequence := s.MustGetNextSequenceNumber(portId, channelId)
+	packet := channeltypes.Packet{
+		SourcePort:    portId,
+		SourceChannel: channelId,
+		Sequence:      sequence,
+		Data:          packetData.GetBytes(),
+	}
+	callbackKey := icacallbacktypes.PacketID(portId, channelId, sequence)
+	s.App.IcacallbacksKeeper.SetCallbackData(s.Ctx, icacallbacktypes.CallbackData{
+		CallbackKey:  callbackKey,
+		PortId:       portId,
+		ChannelId:    channelId,
+		Sequence:     sequence,
+		CallbackId:   keeper.ICACallbackID_InstantiateOracle,
+		CallbackArgs: tc.ValidCallbackArgs,
+	})
+
+	// Fail the ICA through the callbacks keeper - it should be queued for retry
+	ackFailure := icacallbacktypes.AcknowledgementResponse{Status: icacallbacktypes.AckResponseStatus_FAILURE}
+	err = s.App.IcacallbacksKeeper.CallRegisteredICACallback(s.Ctx, packet, &ackFailure)
+	s.Require().NoError(err, "no error expected during callback")
+	s.checkStateAfterInstantiateCallback(false, "")
+
+	_, found := s.App.IcacallbacksKeeper.GetCallbackRetry(s.Ctx, callbackKey)
+	s.Require().True(found, "retry should have been queued")
+
+	// Close the channel - the retry should wait without counting an attempt
+	channel, found := s.App.IBCKeeper.ChannelKeeper.GetChannel(s.Ctx, portId, channelId)
+	s.Require().True(found, "channel should have been found")
+	channel.State = channeltypes.CLOSED
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, portId, channelId, channel)
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Duration(icacallbacktypes.DefaultRetryBackoffSeconds) * time.Second))
+	s.App.IcacallbacksKeeper.ProcessCallbackRetries(s.Ctx)
+
+	retry, found := s.App.IcacallbacksKeeper.GetCallbackRetry(s.Ctx, callbackKey)
+	s.Require().True(found, "retry should still be queued while the channel is closed")
+	s.Require().Zero(retry.Attempts, "no attempt should be counted while the channel is closed")
+	s.Require().Equal(sequence, s.MustGetNextSequenceNumber(portId, channelId), "no ICA sent while the channel is closed")
+
+	// Re-open the channel - the retry should be submitted once the backoff elapses again
+	channel.State = channeltypes.OPEN
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, portId, channelId, channel)
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Duration(icacallbacktypes.DefaultRetryBackoffSeconds) * time.Second))
+	s.App.IcacallbacksKeeper.ProcessCallbackRetries(s.Ctx)
+
+	s.Require().Equal(sequence+1, s.MustGetNextSequenceNumber(portId, channelId), "ICA re-submitted")
+	s.Require().Empty(s.App.IcacallbacksKeeper.GetAllCallbackRetry(s.Ctx), "retry removed after submission")
+
+	// Acknowledge the retry successfully - the oracle should now be active
+	err = s.App.IcacallbacksKeeper.CallRegisteredICACallback(s.Ctx, packet, &tc.ValidAckResponse)
+	s.Require().NoError(err, "no error expected during callback")
+	s.checkStateAfterInstantiateCallback(true, tc.ContractAddress)
+}
@@ -15,8 +15,11 @@ import (
 
 // Callback after an update oracle ICA
 //
-//	If successful/failure: the metric is removed from the pending store
-//	If timeout: metric is left in pending store so it can be re-submitted
+//	If successful: an ICQ is submitted for each metric in the batch to verify the contract's state
+//	If failure: if the batch had multiple metrics, each metric is resubmitted in its own ICA so that
+//	  a single invalid metric does not discard the others; otherwise, a retry of the ICA is requested
+//	  from the icacallbacks module, and once retries are exhausted, the metric is removed from the pending store
+//	If timeout: the metrics are left in pending store so they can be re-submitted
 func (k Keeper) UpdateOracleCallback(ctx sdk.Context, packet channeltypes.Packet, ackResponse *icacallbackstypes.AcknowledgementResponse, args []byte) error {
 	// Fetch callback args
 	updateOracleCallback := types.UpdateOracleCallback{}
@@ -26,30 +29,86 @@ func (k Keeper) UpdateOracleCallback(ctx sdk.Context, packet channeltypes.Packet
 	chainId := updateOracleCallback.OracleChainId
 	k.Logger(ctx).Info(utils.LogICACallbackWithHostZone(chainId, ICACallbackID_UpdateOracle, "Starting update oracle callback"))
 
+	// ICAs submitted before metric updates were batched only have a single metric
+	metrics := updateOracleCallback.Metrics
+	if updateOracleCallback.Metric != nil {
+		metrics = append(metrics, *updateOracleCallback.Metric)
+	}
+
 	// If the ack timed-out, log the error and exit successfully
-	// The metric should remain in the pending store so that the ICA can be resubmitted when the channel is restored
+	// The metrics should remain in the pending store so that the ICA can be resubmitted when the channel is restored
 	if ackResponse.Status == icacallbackstypes.AckResponseStatus_TIMEOUT {
-		EmitUpdateOracleAckEvent(ctx, updateOracleCallback.Metric, "timeout")
+		for i := range metrics {
+			EmitUpdateOracleAckEvent(ctx, &metrics[i], "timeout")
+		}
 		k.Logger(ctx).Error(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_UpdateOracle, ackResponse.Status, packet))
 		return nil
 	}
 
 	// if the ack fails, log the response as an error, otherwise log the success as an info log
+	ackStatus := "success"
 	if ackResponse.Status == icacallbackstypes.AckResponseStatus_FAILURE {
-		EmitUpdateOracleAckEvent(ctx, updateOracleCallback.Metric, "failure")
+		ackStatus = "failure"
 		k.Logger(ctx).Error(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_UpdateOracle, ackResponse.Status, packet))
 	} else {
-		EmitUpdateOracleAckEvent(ctx, updateOracleCallback.Metric, "success")
 		k.Logger(ctx).Info(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_UpdateOracle, ackResponse.Status, packet))
 	}
+	for i := range metrics {
+		EmitUpdateOracleAckEvent(ctx, &metrics[i], ackStatus)
+	}
 
-	// Confirm the callback has a valid metric
-	if updateOracleCallback.Metric == nil || updateOracleCallback.Metric.Key == "" {
+	// Confirm the callback has valid metrics
+	if len(metrics) == 0 {
 		return errorsmod.Wrapf(types.ErrInvalidCallback, "metric is missing from callback: %+v", updateOracleCallback)
 	}
+	for _, metric := range metrics {
+		if metric.Key == "" {
+			return errorsmod.Wrapf(types.ErrInvalidCallback, "metric is missing from callback: %+v", updateOracleCallback)
+		}
+	}
+
+	oracle, oracleFound := k.GetOracle(ctx, chainId)
+
+	// If a single metric update failed and can still be retried, leave the metric in progress,
+	// since the retry re-submits the same ICA
+	if ackResponse.Status == icacallbackstypes.AckResponseStatus_FAILURE && ackResponse.CanRetry &&
+		len(metrics) == 1 && oracleFound && oracle.Active {
+		k.Logger(ctx).Error(utils.LogICACallbackWithHostZone(chainId, ICACallbackID_UpdateOracle,
+			"Retrying update of metric %s", metrics[0].GetMetricID()))
+		return errorsmod.Wrapf(icacallbackstypes.ErrCallbackRetryable, "update of metric %s failed: %s",
+			metrics[0].GetMetricID(), ackResponse.Error)
+	}
+
+	// If the ICA failed, the tx is atomic so a single invalid metric would have failed the whole batch
+	// In that case, fall back to submitting each metric in its own ICA
+	// Metrics that were already submitted alone (or cannot be resubmitted) are removed from the store
+	// (aka mark update as complete)
+	if ackResponse.Status == icacallbackstypes.AckResponseStatus_FAILURE {
+		failedMetrics := metrics
+		if len(metrics) > 1 && oracleFound && oracle.Active {
+			k.Logger(ctx).Info(utils.LogICACallbackWithHostZone(chainId, ICACallbackID_UpdateOracle,
+				"Resubmitting %d metrics from the failed batch individually", len(metrics)))
+			failedMetrics = k.SubmitMetricUpdatesIndividually(ctx, oracle, metrics)
+		}
+		for _, metric := range failedMetrics {
+			k.RemoveMetric(ctx, metric.GetMetricID())
+		}
+		return nil
+	}
 
-	// Remove the metric from the store (aka mark update as complete)
-	k.RemoveMetric(ctx, updateOracleCallback.Metric.GetMetricID())
+	// Otherwise, confirm the contract's state was updated with an ICQ for each metric
+	// If the query can't be submitted, the metric is removed since the update was still acknowledged
+	for _, metric := range metrics {
+		if !oracleFound {
+			k.RemoveMetric(ctx, metric.GetMetricID())
+			continue
+		}
+		if err := k.SubmitMetricVerificationICQ(ctx, oracle, metric); err != nil {
+			k.Logger(ctx).Error(utils.LogICACallbackWithHostZone(chainId, ICACallbackID_UpdateOracle,
+				"Unable to verify metric %s: %s", metric.GetMetricID(), err.Error()))
+			k.RemoveMetric(ctx, metric.GetMetricID())
+		}
+	}
 
 	return nil
 }
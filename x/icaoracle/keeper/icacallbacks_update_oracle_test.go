@@ -88,3 +88,183 @@ func (s *KeeperTestSuite) TestUpdateOracleCallback_InvalidCallbackData() {
 	err = s.App.ICAOracleKeeper.UpdateOracleCallback(s.Ctx, dummyPacket, &dummyAckResponse, invalidArgs)
 	s.Require().ErrorContains(err, "metric is missing from callback")
 }
+
+func (s *KeeperTestSuite) TestUpdateOracleCallback_BatchedMetrics() {
+	// Store multiple IN_PROGRESS metrics
+	metrics := []types.Metric{}
+	for _, key := range []string{"key1", "key2", "key3"} {
+		metric := types.Metric{
+			Key:               key,
+			UpdateTime:        1,
+			DestinationOracle: HostChainId,
+			Status:            types.MetricStatus_IN_PROGRESS,
+		}
+		s.App.ICAOracleKeeper.SetMetric(s.Ctx, metric)
+		metrics = append(metrics, metric)
+	}
+
+	// Only the first two metrics are included in the batch
+	callback := types.UpdateOracleCallback{
+		OracleChainId: HostChainId,
+		Metrics:       metrics[:2],
+	}
+	callbackBz, err := proto.Marshal(&callback)
+	s.Require().NoError(err, "no error expected when marshalling callback data")
+
+	ackResponse := icacallbacktypes.AcknowledgementResponse{Status: icacallbacktypes.AckResponseStatus_SUCCESS}
+	err = s.App.ICAOracleKeeper.UpdateOracleCallback(s.Ctx, channeltypes.Packet{}, &ackResponse, callbackBz)
+	s.Require().NoError(err, "no error expected during callback")
+
+	// Confirm only the metrics in the batch were removed
+	for i, metric := range metrics {
+		_, found := s.App.ICAOracleKeeper.GetMetric(s.Ctx, metric.GetMetricID())
+		s.Require().Equal(i == 2, found, "metric %s found", metric.Key)
+	}
+
+	// A batch with an invalid metric should fail
+	callback = types.UpdateOracleCallback{
+		OracleChainId: HostChainId,
+		Metrics:       []types.Metric{metrics[2], {Value: "value"}},
+	}
+	callbackBz, err = proto.Marshal(&callback)
+	s.Require().NoError(err, "no error expected when marshalling callback data")
+
+	err = s.App.ICAOracleKeeper.UpdateOracleCallback(s.Ctx, channeltypes.Packet{}, &ackResponse, callbackBz)
+	s.Require().ErrorContains(err, "metric is missing from callback")
+}
+
+func (s *KeeperTestSuite) TestUpdateOracleCallback_AckSuccessSubmitsVerification() {
+	submittedQueries := s.MockSubmittedQueries()
+	s.SetupVerificationOracle()
+	metric := s.SetupTestUpdateOracleCallback()
+
+	callback := types.UpdateOracleCallback{
+		OracleChainId: HostChainId,
+		Metrics:       []types.Metric{metric},
+	}
+	callbackBz, err := proto.Marshal(&callback)
+	s.Require().NoError(err, "no error expected when marshalling callback data")
+
+	ackResponse := icacallbacktypes.AcknowledgementResponse{Status: icacallbacktypes.AckResponseStatus_SUCCESS}
+	err = s.App.ICAOracleKeeper.UpdateOracleCallback(s.Ctx, channeltypes.Packet{}, &ackResponse, callbackBz)
+	s.Require().NoError(err, "no error expected during callback")
+
+	// The metric should be kept while the contract's state is verified
+	storedMetric, found := s.App.ICAOracleKeeper.GetMetric(s.Ctx, metric.GetMetricID())
+	s.Require().True(found, "metric should still be stored")
+	s.Require().Equal(types.MetricStatus_VERIFYING, storedMetric.Status, "metric status")
+	s.Require().Len(*submittedQueries, 1, "verification query submitted")
+}
+
+func (s *KeeperTestSuite) TestUpdateOracleCallback_AckFailureBatchResubmittedIndividually() {
+	s.SetupTestSubmitMetricUpdate()
+
+	// Store multiple IN_PROGRESS metrics that were posted in the same batch
+	metrics := []types.Metric{}
+	for _, key := range []string{"key1", "key2"} {
+		metric := types.Metric{
+			Key:               key,
+			Value:             "value",
+			UpdateTime:        1,
+			DestinationOracle: HostChainId,
+			Status:            types.MetricStatus_IN_PROGRESS,
+		}
+		s.App.ICAOracleKeeper.SetMetric(s.Ctx, metric)
+		metrics = append(metrics, metric)
+	}
+
+	callback := types.UpdateOracleCallback{
+		OracleChainId: HostChainId,
+		Metrics:       metrics,
+	}
+	callbackBz, err := proto.Marshal(&callback)
+	s.Require().NoError(err, "no error expected when marshalling callback data")
+
+	// Fail the batch
+	ackResponse := icacallbacktypes.AcknowledgementResponse{Status: icacallbacktypes.AckResponseStatus_FAILURE}
+	err = s.App.ICAOracleKeeper.UpdateOracleCallback(s.Ctx, channeltypes.Packet{}, &ackResponse, callbackBz)
+	s.Require().NoError(err, "no error expected during callback")
+
+	// Each metric should have been resubmitted in its own ICA
+	callbacks := s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx)
+	s.Require().Len(callbacks, 2, "one ICA per metric")
+	for i, callbackData := range callbacks {
+		var callbackArgs types.UpdateOracleCallback
+		err := proto.Unmarshal(callbackData.CallbackArgs, &callbackArgs)
+		s.Require().NoError(err, "no error expected when unmarshalling callback args")
+		s.Require().Len(callbackArgs.Metrics, 1, "metrics in resubmitted ICA %d", i)
+	}
+
+	// The metrics should still be stored and IN_PROGRESS
+	for _, metric := range metrics {
+		storedMetric, found := s.App.ICAOracleKeeper.GetMetric(s.Ctx, metric.GetMetricID())
+		s.Require().True(found, "metric %s should still be stored", metric.Key)
+		s.Require().Equal(types.MetricStatus_IN_PROGRESS, storedMetric.Status, "metric %s status", metric.Key)
+	}
+
+	// If one of the individual metrics then fails, only that metric should be removed
+	callback = types.UpdateOracleCallback{
+		OracleChainId: HostChainId,
+		Metrics:       metrics[:1],
+	}
+	callbackBz, err = proto.Marshal(&callback)
+	s.Require().NoError(err, "no error expected when marshalling callback data")
+
+	err = s.App.ICAOracleKeeper.UpdateOracleCallback(s.Ctx, channeltypes.Packet{}, &ackResponse, callbackBz)
+	s.Require().NoError(err, "no error expected during callback")
+
+	_, found := s.App.ICAOracleKeeper.GetMetric(s.Ctx, metrics[0].GetMetricID())
+	s.Require().False(found, "failed individual metric should have been removed")
+	_, found = s.App.ICAOracleKeeper.GetMetric(s.Ctx, metrics[1].GetMetricID())
+	s.Require().True(found, "other metric should still be stored")
+	s.Require().Len(s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx), 2, "no additional ICA submitted")
+}
+
+func (s *KeeperTestSuite) TestUpdateOracleCallback_AckFailureBatchOracleNotFound() {
+	// Without an oracle, the failed batch cannot be resubmitted, so the metrics are removed
+	metrics := []types.Metric{}
+	for _, key := range []string{"key1", "key2"} {
+		metric := types.Metric{Key: key, UpdateTime: 1, DestinationOracle: HostChainId, Status: types.MetricStatus_IN_PROGRESS}
+		s.App.ICAOracleKeeper.SetMetric(s.Ctx, metric)
+		metrics = append(metrics, metric)
+	}
+
+	callback := types.UpdateOracleCallback{OracleChainId: HostChainId, Metrics: metrics}
+	callbackBz, err := proto.Marshal(&callback)
+	s.Require().NoError(err, "no error expected when marshalling callback data")
+
+	ackResponse := icacallbacktypes.AcknowledgementResponse{Status: icacallbacktypes.AckResponseStatus_FAILURE}
+	err = s.App.ICAOracleKeeper.UpdateOracleCallback(s.Ctx, channeltypes.Packet{}, &ackResponse, callbackBz)
+	s.Require().NoError(err, "no error expected during callback")
+
+	s.Require().Empty(s.App.ICAOracleKeeper.GetAllMetrics(s.Ctx), "metrics should have been removed")
+	s.Require().Empty(s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx), "no ICA submitted")
+}
+
+func (s *KeeperTestSuite) TestUpdateOracleCallback_AckFailureRetried() {
+	metric := s.SetupTestUpdateOracleCallback()
+	s.App.ICAOracleKeeper.SetOracle(s.Ctx, types.Oracle{ChainId: HostChainId, Active: true})
+
+	callbackBz, err := proto.Marshal(&types.UpdateOracleCallback{OracleChainId: HostChainId, Metric: &metric})
+	s.Require().NoError(err, "no error expected when marshalling callback data")
+
+	// If the update can still be retried, a retry should be requested and the metric should be left in progress
+	ackResponse := icacallbacktypes.AcknowledgementResponse{
+		Status:   icacallbacktypes.AckResponseStatus_FAILURE,
+		CanRetry: true,
+	}
+	err = s.App.ICAOracleKeeper.UpdateOracleCallback(s.Ctx, channeltypes.Packet{}, &ackResponse, callbackBz)
+	s.Require().ErrorIs(err, icacallbacktypes.ErrCallbackRetryable, "retry should be requested")
+
+	storedMetric, found := s.App.ICAOracleKeeper.GetMetric(s.Ctx, metric.GetMetricID())
+	s.Require().True(found, "metric should remain in the store while it's retried")
+	s.Require().Equal(types.MetricStatus_IN_PROGRESS, storedMetric.Status, "metric status while retried")
+
+	// Once retries are exhausted, the metric should be removed
+	ackResponse.CanRetry = false
+	err = s.App.ICAOracleKeeper.UpdateOracleCallback(s.Ctx, channeltypes.Packet{}, &ackResponse, callbackBz)
+	s.Require().NoError(err, "no error expected once retries are exhausted")
+
+	_, found = s.App.ICAOracleKeeper.GetMetric(s.Ctx, metric.GetMetricID())
+	s.Require().False(found, "metric should be removed once retries are exhausted")
+}
@@ -10,17 +10,28 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	proto "github.com/cosmos/gogoproto/proto"
 
+	"github.com/Stride-Labs/stride/v27/utils"
 	"github.com/Stride-Labs/stride/v27/x/icaoracle/types"
 )
 
 var (
 	InstantiateOracleTimeout = time.Hour * 24 // 1 day
 	MetricUpdateTimeout      = time.Hour * 24 // 1 day
+	MetricVerifyICQTimeout   = time.Hour      // 1 hour
+
+	// Max number of metrics that are posted to an oracle in a single ICA tx
+	MaxMetricUpdatesPerICATx = 50
 )
 
 // Queues an metric update across each active oracle
 // One metric record is created for each oracle, in status QUEUED
 func (k Keeper) QueueMetricUpdate(ctx sdk.Context, key, value, metricType, attributes string) {
+	k.QueueMetricUpdateToOracles(ctx, key, value, metricType, attributes, []string{})
+}
+
+// Queues an metric update across each of the specified oracles
+// If no oracles are specified, the metric is queued to every active oracle
+func (k Keeper) QueueMetricUpdateToOracles(ctx sdk.Context, key, value, metricType, attributes string, oracleChainIds []string) {
 	metric := types.NewMetric(ctx, key, value, metricType, attributes)
 	metric.Status = types.MetricStatus_QUEUED
 
@@ -30,6 +41,11 @@ func (k Keeper) QueueMetricUpdate(ctx sdk.Context, key, value, metricType, attri
 			continue
 		}
 
+		// Ignore any oracles that were not targeted
+		if len(oracleChainIds) > 0 && !utils.ContainsString(oracleChainIds, oracle.ChainId) {
+			continue
+		}
+
 		metric.DestinationOracle = oracle.ChainId
 		k.SetMetric(ctx, metric)
 
@@ -39,6 +55,12 @@ func (k Keeper) QueueMetricUpdate(ctx sdk.Context, key, value, metricType, attri
 
 // Submits an ICA to update the metric in the CW contract
 func (k Keeper) SubmitMetricUpdate(ctx sdk.Context, oracle types.Oracle, metric types.Metric) error {
+	return k.SubmitMetricUpdates(ctx, oracle, []types.Metric{metric})
+}
+
+// Submits a single ICA to update each of the metrics in the CW contract
+// Each metric is posted with a separate MsgExecuteContract in the same ICA tx
+func (k Keeper) SubmitMetricUpdates(ctx sdk.Context, oracle types.Oracle, metrics []types.Metric) error {
 	// Validate ICA is setup properly, contract has been instantiated, and oracle is active
 	if err := oracle.ValidateICASetup(); err != nil {
 		return err
@@ -49,25 +71,31 @@ func (k Keeper) SubmitMetricUpdate(ctx sdk.Context, oracle types.Oracle, metric
 	if !oracle.Active {
 		return errorsmod.Wrapf(types.ErrOracleInactive, "oracle (%s) is inactive", oracle.ChainId)
 	}
-
-	// Build contract message with metric update
-	contractMsg := types.NewMsgExecuteContractPostMetric(metric)
-	contractMsgBz, err := json.Marshal(contractMsg)
-	if err != nil {
-		return errorsmod.Wrapf(err, "unable to marshal execute contract post metric")
+	if len(metrics) == 0 {
+		return errorsmod.Wrapf(types.ErrInvalidICARequest, "no metrics provided for oracle (%s)", oracle.ChainId)
 	}
 
-	// Build ICA message to execute the CW contract
-	msgs := []proto.Message{&wasmtypes.MsgExecuteContract{
-		Sender:   oracle.IcaAddress,
-		Contract: oracle.ContractAddress,
-		Msg:      contractMsgBz,
-	}}
+	// Build a contract message with each metric update
+	msgs := []proto.Message{}
+	for _, metric := range metrics {
+		contractMsg := types.NewMsgExecuteContractPostMetric(metric)
+		contractMsgBz, err := json.Marshal(contractMsg)
+		if err != nil {
+			return errorsmod.Wrapf(err, "unable to marshal execute contract post metric")
+		}
+
+		// Build ICA message to execute the CW contract
+		msgs = append(msgs, &wasmtypes.MsgExecuteContract{
+			Sender:   oracle.IcaAddress,
+			Contract: oracle.ContractAddress,
+			Msg:      contractMsgBz,
+		})
+	}
 
 	// Submit the ICA to execute the contract
 	callbackArgs := types.UpdateOracleCallback{
 		OracleChainId: oracle.ChainId,
-		Metric:        &metric,
+		Metrics:       metrics,
 	}
 	icaTx := types.ICATx{
 		ConnectionId:    oracle.ConnectionId,
@@ -86,35 +114,80 @@ func (k Keeper) SubmitMetricUpdate(ctx sdk.Context, oracle types.Oracle, metric
 	return nil
 }
 
-// For each queued metric, submit an ICA to each oracle, and then flag the metric as IN_PROGRESS
+// Submits a separate ICA for each metric, so that an invalid metric cannot fail the updates
+// of the other metrics
+// Returns the metrics that could not be submitted
+func (k Keeper) SubmitMetricUpdatesIndividually(ctx sdk.Context, oracle types.Oracle, metrics []types.Metric) (failedMetrics []types.Metric) {
+	for _, metric := range metrics {
+		if err := k.SubmitMetricUpdate(ctx, oracle, metric); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Failed to submit a metric update ICA - Oracle: %s, Metric: %s, %s",
+				oracle.ChainId, metric.GetMetricID(), err.Error()))
+			failedMetrics = append(failedMetrics, metric)
+			continue
+		}
+		EmitUpdateOracleEvent(ctx, metric)
+	}
+	return failedMetrics
+}
+
+// Groups the queued metrics by their destination oracle, preserving the queue order
+func groupMetricsByOracle(metrics []types.Metric) (oracleChainIds []string, metricsByOracle map[string][]types.Metric) {
+	metricsByOracle = map[string][]types.Metric{}
+	for _, metric := range metrics {
+		if _, ok := metricsByOracle[metric.DestinationOracle]; !ok {
+			oracleChainIds = append(oracleChainIds, metric.DestinationOracle)
+		}
+		metricsByOracle[metric.DestinationOracle] = append(metricsByOracle[metric.DestinationOracle], metric)
+	}
+	return oracleChainIds, metricsByOracle
+}
+
+// For each oracle, submit an ICA with each of the oracle's queued metrics (in batches of at most
+// MaxMetricUpdatesPerICATx), and then flag the metrics as IN_PROGRESS
 func (k Keeper) PostAllQueuedMetrics(ctx sdk.Context) {
-	for _, metric := range k.GetAllQueuedMetrics(ctx) {
-		k.Logger(ctx).Info(fmt.Sprintf("Submitting oracle metric update - Metric: %s, Oracle: %s", metric.Key, metric.DestinationOracle))
+	oracleChainIds, metricsByOracle := groupMetricsByOracle(k.GetAllQueuedMetrics(ctx))
+
+	for _, oracleChainId := range oracleChainIds {
+		metrics := metricsByOracle[oracleChainId]
+		k.Logger(ctx).Info(fmt.Sprintf("Submitting oracle metric updates - Oracle: %s, Number of Metrics: %d", oracleChainId, len(metrics)))
 
 		// Ignore any inactive oracles
-		oracle, found := k.GetOracle(ctx, metric.DestinationOracle)
+		oracle, found := k.GetOracle(ctx, oracleChainId)
 		if !found || !oracle.Active {
-			k.Logger(ctx).Info(fmt.Sprintf("Oracle %s is inactive", oracle.ChainId))
+			k.Logger(ctx).Info(fmt.Sprintf("Oracle %s is inactive", oracleChainId))
 			continue
 		}
 
-		// Flag the metric as IN_PROGRESS to prevent resubmissions next block
+		// Flag the metrics as IN_PROGRESS to prevent resubmissions next block
 		// We do this even in the case where the ICA submission fails (from something like a channel closure)
-		// If the channel closes, once it is restored, the metric will get re-queued
-		k.UpdateMetricStatus(ctx, metric, types.MetricStatus_IN_PROGRESS)
+		// If the channel closes, once it is restored, the metrics will get re-queued
+		for _, metric := range metrics {
+			k.UpdateMetricStatus(ctx, metric, types.MetricStatus_IN_PROGRESS)
+		}
 
 		if !k.IsOracleICAChannelOpen(ctx, oracle) {
 			k.Logger(ctx).Error(fmt.Sprintf("Oracle %s has a closed ICA channel (%s)", oracle.ChainId, oracle.ChannelId))
 			continue
 		}
 
-		// Submit the ICA for each metric
-		if err := k.SubmitMetricUpdate(ctx, oracle, metric); err != nil {
-			k.Logger(ctx).Error(fmt.Sprintf("Failed to submit a metric update ICA - Metric: %+v, Oracle: %+v, %s", metric, oracle, err.Error()))
-			continue
+		// Submit one ICA for each batch of metrics
+		for start := 0; start < len(metrics); start += MaxMetricUpdatesPerICATx {
+			end := start + MaxMetricUpdatesPerICATx
+			if end > len(metrics) {
+				end = len(metrics)
+			}
+			batch := metrics[start:end]
+
+			if err := k.SubmitMetricUpdates(ctx, oracle, batch); err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("Failed to submit a metric update ICA - Oracle: %+v, Number of Metrics: %d, %s",
+					oracle, len(batch), err.Error()))
+				continue
+			}
+
+			k.Logger(ctx).Info(fmt.Sprintf("Submitted metric update ICA - Oracle: %s, Number of Metrics: %d", oracle.ChainId, len(batch)))
+			for _, metric := range batch {
+				EmitUpdateOracleEvent(ctx, metric)
+			}
 		}
-
-		k.Logger(ctx).Info(fmt.Sprintf("Submitted metric update ICA - Metric: %s, Oracle: %s, Time: %d", metric.Key, oracle.ChainId, metric.UpdateTime))
-		EmitUpdateOracleEvent(ctx, metric)
 	}
 }
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"fmt"
+
 	"github.com/cosmos/gogoproto/proto"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
@@ -44,7 +46,7 @@ func (s *KeeperTestSuite) SetupTestSubmitMetricUpdate() SubmitMetricUpdateTestCa
 	callbackId := keeper.ICACallbackID_UpdateOracle
 	callback := types.UpdateOracleCallback{
 		OracleChainId: HostChainId,
-		Metric:        &metric,
+		Metrics:       []types.Metric{metric},
 	}
 	callbackBz, err := proto.Marshal(&callback)
 	s.Require().NoError(err, "no error expected when serializing callback args")
@@ -75,6 +77,7 @@ func (s *KeeperTestSuite) TestSubmitMetricUpdate_Success() {
 		Sequence:     sequence,
 		CallbackId:   tc.CallbackId,
 		CallbackArgs: tc.CallbackArgs,
+		CreatedAt:    s.Ctx.BlockTime(),
 	}
 	actualCallbackData, found := s.App.IcacallbacksKeeper.GetCallbackData(s.Ctx, callbackKey)
 	s.Require().True(found, "callback data should have been found")
@@ -157,7 +160,54 @@ func (s *KeeperTestSuite) TestPostAllQueuedMetrics() {
 	// Post all metrics
 	s.App.ICAOracleKeeper.PostAllQueuedMetrics(s.Ctx)
 
-	// Check 3 ICAs were submitted
+	// Check a single ICA was submitted with all 3 metrics
+	callbacks := s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx)
+	s.Require().Len(callbacks, 1, "one callback submitted")
+
+	var callbackArgs types.UpdateOracleCallback
+	err := proto.Unmarshal(callbacks[0].CallbackArgs, &callbackArgs)
+	s.Require().NoError(err, "no error expected when unmarshalling callback args")
+	s.Require().Equal(HostChainId, callbackArgs.OracleChainId, "callback oracle chain ID")
+
+	actualKeys := []string{}
+	for _, metric := range callbackArgs.Metrics {
+		actualKeys = append(actualKeys, metric.Key)
+	}
+	s.Require().Equal([]string{"key-1", "key-2", "key-3"}, actualKeys, "metrics in callback")
+
+	// Each of the metrics should now be IN_PROGRESS
+	s.Require().Len(s.App.ICAOracleKeeper.GetAllQueuedMetrics(s.Ctx), 2, "only the inactive oracle's metrics should remain queued")
+}
+
+func (s *KeeperTestSuite) TestPostAllQueuedMetrics_MaxBatchSize() {
+	s.SetupTestSubmitMetricUpdate()
+
+	// Queue more metrics than can fit in a single ICA tx
+	numMetrics := keeper.MaxMetricUpdatesPerICATx + 1
+	for i := 0; i < numMetrics; i++ {
+		s.App.ICAOracleKeeper.SetMetric(s.Ctx, types.Metric{
+			Key:               fmt.Sprintf("key-%d", i),
+			Value:             "value",
+			DestinationOracle: HostChainId,
+			Status:            types.MetricStatus_QUEUED,
+		})
+	}
+
+	// Post all metrics
+	s.App.ICAOracleKeeper.PostAllQueuedMetrics(s.Ctx)
+
+	// The metrics should have been split across two ICAs
 	callbacks := s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx)
-	s.Require().Len(callbacks, 3, "three callbacks submitted")
+	s.Require().Len(callbacks, 2, "two callbacks submitted")
+
+	totalMetrics := 0
+	for _, callback := range callbacks {
+		var callbackArgs types.UpdateOracleCallback
+		err := proto.Unmarshal(callback.CallbackArgs, &callbackArgs)
+		s.Require().NoError(err, "no error expected when unmarshalling callback args")
+		s.Require().LessOrEqual(len(callbackArgs.Metrics), keeper.MaxMetricUpdatesPerICATx, "metrics per ICA")
+		totalMetrics += len(callbackArgs.Metrics)
+	}
+	s.Require().Equal(numMetrics, totalMetrics, "total metrics across ICAs")
+	s.Require().Empty(s.App.ICAOracleKeeper.GetAllQueuedMetrics(s.Ctx), "no metrics should remain queued")
 }
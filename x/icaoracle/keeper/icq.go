@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v27/utils"
+	"github.com/Stride-Labs/stride/v27/x/icaoracle/types"
+	icqtypes "github.com/Stride-Labs/stride/v27/x/interchainquery/types"
+)
+
+const (
+	ICQCallbackID_VerifyMetric = "verifymetric"
+)
+
+// ICQCallbacks wrapper struct for icaoracle keeper
+type ICQCallback func(Keeper, sdk.Context, []byte, icqtypes.Query) error
+
+type ICQCallbacks struct {
+	k         Keeper
+	callbacks map[string]ICQCallback
+}
+
+var _ icqtypes.QueryCallbacks = ICQCallbacks{}
+
+func (k Keeper) ICQCallbackHandler() ICQCallbacks {
+	return ICQCallbacks{k, make(map[string]ICQCallback)}
+}
+
+func (c ICQCallbacks) CallICQCallback(ctx sdk.Context, id string, args []byte, query icqtypes.Query) error {
+	return c.callbacks[id](c.k, ctx, args, query)
+}
+
+func (c ICQCallbacks) HasICQCallback(id string) bool {
+	_, found := c.callbacks[id]
+	return found
+}
+
+func (c ICQCallbacks) AddICQCallback(id string, fn interface{}) icqtypes.QueryCallbacks {
+	c.callbacks[id] = fn.(ICQCallback)
+	return c
+}
+
+func (c ICQCallbacks) RegisterICQCallbacks() icqtypes.QueryCallbacks {
+	return c.
+		AddICQCallback(ICQCallbackID_VerifyMetric, ICQCallback(VerifyMetricCallback))
+}
+
+// Submits an ICQ to read a metric from the oracle contract's store, to confirm
+// that the metric update ICA was applied to the contract
+// The metric is flagged as VERIFYING until the query returns
+func (k Keeper) SubmitMetricVerificationICQ(ctx sdk.Context, oracle types.Oracle, metric types.Metric) error {
+	k.Logger(ctx).Info(utils.LogWithHostZone(oracle.ChainId, "Submitting VerifyMetric ICQ for metric %s", metric.GetMetricID()))
+
+	queryData, err := types.GetContractMetricStoreKey(oracle.ContractAddress, metric.Key)
+	if err != nil {
+		return err
+	}
+
+	metric.Status = types.MetricStatus_VERIFYING
+	metricBz, err := k.cdc.Marshal(&metric)
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to marshal metric %s", metric.GetMetricID())
+	}
+
+	query := icqtypes.Query{
+		ChainId:         oracle.ChainId,
+		ConnectionId:    oracle.ConnectionId,
+		QueryType:       icqtypes.WASM_STORE_QUERY_WITH_PROOF,
+		RequestData:     queryData,
+		CallbackModule:  types.ModuleName,
+		CallbackId:      ICQCallbackID_VerifyMetric,
+		CallbackData:    metricBz,
+		TimeoutDuration: MetricVerifyICQTimeout,
+		TimeoutPolicy:   icqtypes.TimeoutPolicy_RETRY_QUERY_REQUEST,
+	}
+	// Updates to the same metric key read the same contract store key, so the query must be forced
+	// unique, otherwise a newer update's query would replace the pending query for an older update
+	if err := k.IcqKeeper.SubmitICQRequest(ctx, query, true); err != nil {
+		return errorsmod.Wrapf(err, "unable to submit VerifyMetric ICQ for metric %s", metric.GetMetricID())
+	}
+
+	k.SetMetric(ctx, metric)
+
+	return nil
+}
+
+// Callback after querying a metric from the oracle contract's store
+//
+//	If the contract has the posted value: the metric is removed and the oracle is flagged as VERIFIED
+//	If the contract has since been updated with a newer metric: the metric is removed (it was superseded)
+//	Otherwise: the metric is flagged as MISMATCH and kept in the store, and the oracle is flagged as MISMATCH
+//
+// Only the oracle's most recent mismatched metric is kept, so any previously mismatched metric is removed
+// when a new mismatch is found, or when a newer update of the same key is verified
+func VerifyMetricCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+	var metric types.Metric
+	if err := k.cdc.Unmarshal(query.CallbackData, &metric); err != nil {
+		return fmt.Errorf("Error deserializing query.CallbackData '%s' as Metric", hex.EncodeToString(query.CallbackData))
+	}
+	chainId := metric.DestinationOracle
+
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_VerifyMetric,
+		"Starting VerifyMetric ICQ callback, QueryId: %v, QueryType: %s, Connection: %s, Metric: %s",
+		query.Id, query.QueryType, query.ConnectionId, metric.GetMetricID()))
+
+	// If the metric was removed in the meantime (e.g. the oracle was removed), there's nothing to verify
+	if _, found := k.GetMetric(ctx, metric.GetMetricID()); !found {
+		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_VerifyMetric,
+			"Metric %s no longer exists, skipping verification", metric.GetMetricID()))
+		return nil
+	}
+
+	oracle, found := k.GetOracle(ctx, chainId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrOracleNotFound, "oracle %s not found", chainId)
+	}
+
+	// An empty response means the key was not found in the contract
+	// If the response can't be parsed, we treat it as a mismatch
+	contractMetric := types.ContractMetric{}
+	if len(args) > 0 {
+		if err := json.Unmarshal(args, &contractMetric); err != nil {
+			k.Logger(ctx).Error(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_VerifyMetric,
+				"Unable to unmarshal contract metric %s: %s", metric.GetMetricID(), err.Error()))
+		}
+	}
+
+	// If a newer metric with the same key was posted after this one, we can't confirm this metric,
+	// but there is nothing to flag either since the newer update will be verified separately
+	if contractMetric.UpdateTime > metric.UpdateTime {
+		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_VerifyMetric,
+			"Metric %s was superseded by a newer update at %d", metric.GetMetricID(), contractMetric.UpdateTime))
+		k.RemoveMetric(ctx, metric.GetMetricID())
+		return nil
+	}
+
+	verified := contractMetric.Value == metric.Value && contractMetric.UpdateTime == metric.UpdateTime
+	EmitVerifyMetricEvent(ctx, metric, verified, contractMetric.Value)
+
+	oracle.LastVerificationTime = ctx.BlockTime().Unix()
+	if verified {
+		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_VerifyMetric,
+			"Metric %s verified", metric.GetMetricID()))
+
+		k.RemoveMetric(ctx, metric.GetMetricID())
+
+		// If the previous mismatch was for the same key, it's been resolved by this update
+		// Otherwise, the oracle stays flagged as mismatched until that key is verified
+		if oracle.LastMismatchedMetricId != "" {
+			lastMismatch, found := k.GetMetric(ctx, oracle.LastMismatchedMetricId)
+			if found && lastMismatch.Key == metric.Key {
+				k.RemoveMetric(ctx, lastMismatch.GetMetricID())
+				oracle.LastMismatchedMetricId = ""
+			} else if !found {
+				oracle.LastMismatchedMetricId = ""
+			}
+		}
+		if oracle.LastMismatchedMetricId == "" {
+			oracle.VerificationStatus = types.VerificationStatus_VERIFIED
+		}
+	} else {
+		k.Logger(ctx).Error(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_VerifyMetric,
+			"Metric %s does not match the contract - expected value: %s (time: %d), contract value: %s (time: %d)",
+			metric.GetMetricID(), metric.Value, metric.UpdateTime, contractMetric.Value, contractMetric.UpdateTime))
+
+		// Remove the previously mismatched metric so that mismatches do not accumulate in the store
+		if oracle.LastMismatchedMetricId != "" && oracle.LastMismatchedMetricId != metric.GetMetricID() {
+			k.RemoveMetric(ctx, oracle.LastMismatchedMetricId)
+		}
+
+		oracle.VerificationStatus = types.VerificationStatus_MISMATCH
+		oracle.LastMismatchedMetricId = metric.GetMetricID()
+		k.UpdateMetricStatus(ctx, metric, types.MetricStatus_MISMATCH)
+	}
+	k.SetOracle(ctx, oracle)
+
+	return nil
+}
@@ -0,0 +1,358 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v27/x/icaoracle/keeper"
+	"github.com/Stride-Labs/stride/v27/x/icaoracle/types"
+	icqtypes "github.com/Stride-Labs/stride/v27/x/interchainquery/types"
+)
+
+// Mock ICQ Keeper struct
+type MockICQKeeper struct {
+	SubmitICQRequestFn func(ctx sdk.Context, query icqtypes.Query, forceUnique bool) error
+}
+
+func (m MockICQKeeper) SubmitICQRequest(ctx sdk.Context, query icqtypes.Query, forceUnique bool) error {
+	if m.SubmitICQRequestFn != nil {
+		return m.SubmitICQRequestFn(ctx, query, forceUnique)
+	}
+	return nil
+}
+
+// Replaces the ICQ keeper with a mock that records each submitted query
+func (s *KeeperTestSuite) MockSubmittedQueries() *[]icqtypes.Query {
+	submittedQueries := []icqtypes.Query{}
+	s.App.ICAOracleKeeper.IcqKeeper = MockICQKeeper{
+		SubmitICQRequestFn: func(ctx sdk.Context, query icqtypes.Query, forceUnique bool) error {
+			submittedQueries = append(submittedQueries, query)
+			return nil
+		},
+	}
+	return &submittedQueries
+}
+
+// Stores an oracle with a valid contract address
+func (s *KeeperTestSuite) SetupVerificationOracle() types.Oracle {
+	oracle := types.Oracle{
+		ChainId:         HostChainId,
+		ConnectionId:    ConnectionId,
+		ContractAddress: s.TestAccs[0].String(),
+		Active:          true,
+	}
+	s.App.ICAOracleKeeper.SetOracle(s.Ctx, oracle)
+	return oracle
+}
+
+func (s *KeeperTestSuite) TestSubmitMetricVerificationICQ() {
+	submittedQueries := []icqtypes.Query{}
+	forceUniqueFlags := &[]bool{}
+	s.App.ICAOracleKeeper.IcqKeeper = MockICQKeeper{
+		SubmitICQRequestFn: func(ctx sdk.Context, query icqtypes.Query, forceUnique bool) error {
+			submittedQueries = append(submittedQueries, query)
+			*forceUniqueFlags = append(*forceUniqueFlags, forceUnique)
+			return nil
+		},
+	}
+	oracle := s.SetupVerificationOracle()
+
+	metric := types.Metric{
+		Key:               "key1",
+		Value:             "value1",
+		UpdateTime:        1,
+		DestinationOracle: HostChainId,
+		Status:            types.MetricStatus_IN_PROGRESS,
+	}
+	s.App.ICAOracleKeeper.SetMetric(s.Ctx, metric)
+
+	err := s.App.ICAOracleKeeper.SubmitMetricVerificationICQ(s.Ctx, oracle, metric)
+	s.Require().NoError(err, "no error expected when submitting ICQ")
+
+	// Confirm the query targets the metric in the contract's store
+	expectedKey, err := types.GetContractMetricStoreKey(oracle.ContractAddress, metric.Key)
+	s.Require().NoError(err, "no error expected when building contract key")
+
+	s.Require().Len(submittedQueries, 1, "one query submitted")
+	query := submittedQueries[0]
+	s.Require().Equal(HostChainId, query.ChainId, "query chain ID")
+	s.Require().Equal(ConnectionId, query.ConnectionId, "query connection ID")
+	s.Require().Equal(icqtypes.WASM_STORE_QUERY_WITH_PROOF, query.QueryType, "query type")
+	s.Require().Equal(expectedKey, query.RequestData, "query request data")
+	s.Require().Equal(types.ModuleName, query.CallbackModule, "query callback module")
+	s.Require().Equal(keeper.ICQCallbackID_VerifyMetric, query.CallbackId, "query callback ID")
+	s.Require().Equal(icqtypes.TimeoutPolicy_RETRY_QUERY_REQUEST, query.TimeoutPolicy, "query timeout policy")
+	s.Require().True((*forceUniqueFlags)[0], "query should be forced unique")
+
+	// Confirm the metric was flagged as VERIFYING
+	storedMetric, found := s.App.ICAOracleKeeper.GetMetric(s.Ctx, metric.GetMetricID())
+	s.Require().True(found, "metric should still be stored")
+	s.Require().Equal(types.MetricStatus_VERIFYING, storedMetric.Status, "metric status")
+
+	// An invalid contract address should fail
+	oracle.ContractAddress = "invalid"
+	err = s.App.ICAOracleKeeper.SubmitMetricVerificationICQ(s.Ctx, oracle, metric)
+	s.Require().ErrorContains(err, "invalid contract address")
+
+	// An error from the ICQ keeper should be returned
+	s.App.ICAOracleKeeper.IcqKeeper = MockICQKeeper{
+		SubmitICQRequestFn: func(ctx sdk.Context, query icqtypes.Query, forceUnique bool) error {
+			return errors.New("icq error")
+		},
+	}
+	oracle.ContractAddress = s.TestAccs[0].String()
+	err = s.App.ICAOracleKeeper.SubmitMetricVerificationICQ(s.Ctx, oracle, metric)
+	s.Require().ErrorContains(err, "icq error")
+}
+
+func (s *KeeperTestSuite) TestVerifyMetricCallback() {
+	expectedMetric := types.Metric{
+		Key:               "key1",
+		Value:             "value1",
+		UpdateTime:        10,
+		DestinationOracle: HostChainId,
+		Status:            types.MetricStatus_VERIFYING,
+	}
+
+	testCases := []struct {
+		name                  string
+		contractResponse      []byte
+		expectedMetricStatus  *types.MetricStatus // nil if the metric should be removed
+		expectedOracleStatus  types.VerificationStatus
+		expectedMismatchedId  string
+		expectedVerifyTimeSet bool
+	}{
+		{
+			name:                  "value matches",
+			contractResponse:      []byte(`{"key":"key1","value":"value1","update_time":10}`),
+			expectedOracleStatus:  types.VerificationStatus_VERIFIED,
+			expectedVerifyTimeSet: true,
+		},
+		{
+			name:                  "value mismatch",
+			contractResponse:      []byte(`{"key":"key1","value":"value2","update_time":10}`),
+			expectedMetricStatus:  &[]types.MetricStatus{types.MetricStatus_MISMATCH}[0],
+			expectedOracleStatus:  types.VerificationStatus_MISMATCH,
+			expectedMismatchedId:  expectedMetric.GetMetricID(),
+			expectedVerifyTimeSet: true,
+		},
+		{
+			name:                  "stale update in contract",
+			contractResponse:      []byte(`{"key":"key1","value":"value0","update_time":5}`),
+			expectedMetricStatus:  &[]types.MetricStatus{types.MetricStatus_MISMATCH}[0],
+			expectedOracleStatus:  types.VerificationStatus_MISMATCH,
+			expectedMismatchedId:  expectedMetric.GetMetricID(),
+			expectedVerifyTimeSet: true,
+		},
+		{
+			name:                  "metric not in contract",
+			contractResponse:      []byte{},
+			expectedMetricStatus:  &[]types.MetricStatus{types.MetricStatus_MISMATCH}[0],
+			expectedOracleStatus:  types.VerificationStatus_MISMATCH,
+			expectedMismatchedId:  expectedMetric.GetMetricID(),
+			expectedVerifyTimeSet: true,
+		},
+		{
+			name:                  "unparsable contract response",
+			contractResponse:      []byte{1, 2, 3},
+			expectedMetricStatus:  &[]types.MetricStatus{types.MetricStatus_MISMATCH}[0],
+			expectedOracleStatus:  types.VerificationStatus_MISMATCH,
+			expectedMismatchedId:  expectedMetric.GetMetricID(),
+			expectedVerifyTimeSet: true,
+		},
+		{
+			name:                 "superseded by newer update",
+			contractResponse:     []byte(`{"key":"key1","value":"value3","update_time":20}`),
+			expectedOracleStatus: types.VerificationStatus_UNVERIFIED,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.SetupVerificationOracle()
+			s.App.ICAOracleKeeper.SetMetric(s.Ctx, expectedMetric)
+
+			metricBz, err := s.App.AppCodec().Marshal(&expectedMetric)
+			s.Require().NoError(err, "no error expected when marshalling metric")
+			query := icqtypes.Query{CallbackData: metricBz}
+
+			err = keeper.VerifyMetricCallback(s.App.ICAOracleKeeper, s.Ctx, tc.contractResponse, query)
+			s.Require().NoError(err, "no error expected during callback")
+
+			// Check the metric status
+			metric, found := s.App.ICAOracleKeeper.GetMetric(s.Ctx, expectedMetric.GetMetricID())
+			if tc.expectedMetricStatus == nil {
+				s.Require().False(found, "metric should have been removed")
+			} else {
+				s.Require().True(found, "metric should still be stored")
+				s.Require().Equal(*tc.expectedMetricStatus, metric.Status, "metric status")
+			}
+
+			// Check the oracle status
+			oracle, found := s.App.ICAOracleKeeper.GetOracle(s.Ctx, HostChainId)
+			s.Require().True(found, "oracle should exist")
+			s.Require().Equal(tc.expectedOracleStatus, oracle.VerificationStatus, "oracle verification status")
+			s.Require().Equal(tc.expectedMismatchedId, oracle.LastMismatchedMetricId, "oracle mismatched metric")
+			if tc.expectedVerifyTimeSet {
+				s.Require().Equal(s.Ctx.BlockTime().Unix(), oracle.LastVerificationTime, "oracle verification time")
+			} else {
+				s.Require().Zero(oracle.LastVerificationTime, "oracle verification time")
+			}
+
+			// The mismatched metric should not be re-queued
+			s.Require().Empty(s.App.ICAOracleKeeper.GetAllQueuedMetrics(s.Ctx), "no queued metrics")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestVerifyMetricCallback_MetricRemoved() {
+	s.SetupVerificationOracle()
+
+	// If the metric is no longer in the store, the callback should be a no-op
+	metric := types.Metric{Key: "key1", Value: "value1", UpdateTime: 1, DestinationOracle: HostChainId}
+	metricBz, err := s.App.AppCodec().Marshal(&metric)
+	s.Require().NoError(err, "no error expected when marshalling metric")
+
+	contractResponse, err := json.Marshal(types.ContractMetric{Key: "key1", Value: "value2", UpdateTime: 1})
+	s.Require().NoError(err, "no error expected when marshalling contract metric")
+
+	err = keeper.VerifyMetricCallback(s.App.ICAOracleKeeper, s.Ctx, contractResponse, icqtypes.Query{CallbackData: metricBz})
+	s.Require().NoError(err, "no error expected during callback")
+
+	oracle, found := s.App.ICAOracleKeeper.GetOracle(s.Ctx, HostChainId)
+	s.Require().True(found, "oracle should exist")
+	s.Require().Equal(types.VerificationStatus_UNVERIFIED, oracle.VerificationStatus, "oracle verification status")
+
+	// Invalid callback data should fail
+	err = keeper.VerifyMetricCallback(s.App.ICAOracleKeeper, s.Ctx, contractResponse, icqtypes.Query{CallbackData: []byte{1, 2, 3}})
+	s.Require().ErrorContains(err, "Error deserializing query.CallbackData")
+}
+
+func (s *KeeperTestSuite) TestSubmitMetricVerificationICQ_SameKeyDifferentUpdates() {
+	// Use the real ICQ keeper to confirm two updates of the same key get separate queries
+	s.CreateTransferChannel(HostChainId)
+	s.App.ICAOracleKeeper.IcqKeeper = &s.App.InterchainqueryKeeper
+	oracle := s.SetupVerificationOracle()
+	oracle.ConnectionId = s.TransferPath.EndpointA.ConnectionID
+	s.App.ICAOracleKeeper.SetOracle(s.Ctx, oracle)
+
+	for _, updateTime := range []int64{1, 2} {
+		metric := types.Metric{
+			Key:               "key1",
+			Value:             "value1",
+			UpdateTime:        updateTime,
+			DestinationOracle: HostChainId,
+			Status:            types.MetricStatus_IN_PROGRESS,
+		}
+		s.App.ICAOracleKeeper.SetMetric(s.Ctx, metric)
+
+		err := s.App.ICAOracleKeeper.SubmitMetricVerificationICQ(s.Ctx, oracle, metric)
+		s.Require().NoError(err, "no error expected when submitting ICQ for update %d", updateTime)
+	}
+
+	s.Require().Len(s.App.InterchainqueryKeeper.AllQueries(s.Ctx), 2, "one query per update")
+}
+
+func (s *KeeperTestSuite) TestVerifyMetricCallback_OnlyLatestMismatchKept() {
+	s.SetupVerificationOracle()
+
+	verifyMetric := func(metric types.Metric, contractMetric types.ContractMetric) {
+		s.App.ICAOracleKeeper.SetMetric(s.Ctx, metric)
+
+		metricBz, err := s.App.AppCodec().Marshal(&metric)
+		s.Require().NoError(err, "no error expected when marshalling metric")
+		contractResponse, err := json.Marshal(contractMetric)
+		s.Require().NoError(err, "no error expected when marshalling contract metric")
+
+		err = keeper.VerifyMetricCallback(s.App.ICAOracleKeeper, s.Ctx, contractResponse, icqtypes.Query{CallbackData: metricBz})
+		s.Require().NoError(err, "no error expected during callback")
+	}
+
+	// Mismatch the first metric
+	metric1 := types.Metric{Key: "key1", Value: "value1", UpdateTime: 1, DestinationOracle: HostChainId, Status: types.MetricStatus_VERIFYING}
+	verifyMetric(metric1, types.ContractMetric{Key: "key1", Value: "wrong", UpdateTime: 1})
+
+	_, found := s.App.ICAOracleKeeper.GetMetric(s.Ctx, metric1.GetMetricID())
+	s.Require().True(found, "first mismatched metric should be stored")
+
+	// Mismatch a second metric - the first mismatch should be removed
+	metric2 := types.Metric{Key: "key2", Value: "value2", UpdateTime: 2, DestinationOracle: HostChainId, Status: types.MetricStatus_VERIFYING}
+	verifyMetric(metric2, types.ContractMetric{Key: "key2", Value: "wrong", UpdateTime: 2})
+
+	_, found = s.App.ICAOracleKeeper.GetMetric(s.Ctx, metric1.GetMetricID())
+	s.Require().False(found, "first mismatched metric should have been removed")
+	_, found = s.App.ICAOracleKeeper.GetMetric(s.Ctx, metric2.GetMetricID())
+	s.Require().True(found, "second mismatched metric should be stored")
+
+	oracle, found := s.App.ICAOracleKeeper.GetOracle(s.Ctx, HostChainId)
+	s.Require().True(found, "oracle should exist")
+	s.Require().Equal(metric2.GetMetricID(), oracle.LastMismatchedMetricId, "oracle mismatched metric")
+
+	// Verify an update of a different key - the mismatch should be kept
+	metric3 := types.Metric{Key: "key3", Value: "value3", UpdateTime: 3, DestinationOracle: HostChainId, Status: types.MetricStatus_VERIFYING}
+	verifyMetric(metric3, types.ContractMetric{Key: "key3", Value: "value3", UpdateTime: 3})
+
+	_, found = s.App.ICAOracleKeeper.GetMetric(s.Ctx, metric2.GetMetricID())
+	s.Require().True(found, "mismatched metric should still be stored")
+
+	oracle, found = s.App.ICAOracleKeeper.GetOracle(s.Ctx, HostChainId)
+	s.Require().True(found, "oracle should exist")
+	s.Require().Equal(types.VerificationStatus_MISMATCH, oracle.VerificationStatus, "oracle should remain mismatched")
+
+	// Verify a newer update of the mismatched key - the mismatch should be resolved
+	metric4 := types.Metric{Key: "key2", Value: "value4", UpdateTime: 4, DestinationOracle: HostChainId, Status: types.MetricStatus_VERIFYING}
+	verifyMetric(metric4, types.ContractMetric{Key: "key2", Value: "value4", UpdateTime: 4})
+
+	s.Require().Empty(s.App.ICAOracleKeeper.GetAllMetrics(s.Ctx), "no metrics should remain")
+
+	oracle, found = s.App.ICAOracleKeeper.GetOracle(s.Ctx, HostChainId)
+	s.Require().True(found, "oracle should exist")
+	s.Require().Equal(types.VerificationStatus_VERIFIED, oracle.VerificationStatus, "oracle verification status")
+	s.Require().Empty(oracle.LastMismatchedMetricId, "oracle mismatched metric")
+}
+
+func (s *KeeperTestSuite) TestVerifyMetricCallback_MismatchKeptUntilKeyVerified() {
+	s.SetupVerificationOracle()
+
+	verifyMetric := func(metric types.Metric, contractValue string) types.Oracle {
+		s.App.ICAOracleKeeper.SetMetric(s.Ctx, metric)
+
+		metricBz, err := s.App.AppCodec().Marshal(&metric)
+		s.Require().NoError(err, "no error expected when marshalling metric")
+		contractResponse, err := json.Marshal(types.ContractMetric{Key: metric.Key, Value: contractValue, UpdateTime: metric.UpdateTime})
+		s.Require().NoError(err, "no error expected when marshalling contract metric")
+
+		err = keeper.VerifyMetricCallback(s.App.ICAOracleKeeper, s.Ctx, contractResponse, icqtypes.Query{CallbackData: metricBz})
+		s.Require().NoError(err, "no error expected during callback")
+
+		oracle, found := s.App.ICAOracleKeeper.GetOracle(s.Ctx, HostChainId)
+		s.Require().True(found, "oracle should exist")
+		return oracle
+	}
+
+	// Key A mismatches
+	metricA := types.Metric{Key: "keyA", Value: "valueA", UpdateTime: 1, DestinationOracle: HostChainId, Status: types.MetricStatus_VERIFYING}
+	oracle := verifyMetric(metricA, "wrong")
+	s.Require().Equal(types.VerificationStatus_MISMATCH, oracle.VerificationStatus, "oracle status after key A mismatch")
+
+	// Key B is then verified - the oracle should remain mismatched since key A is unresolved
+	metricB := types.Metric{Key: "keyB", Value: "valueB", UpdateTime: 2, DestinationOracle: HostChainId, Status: types.MetricStatus_VERIFYING}
+	oracle = verifyMetric(metricB, metricB.Value)
+	s.Require().Equal(types.VerificationStatus_MISMATCH, oracle.VerificationStatus, "oracle status after key B verified")
+	s.Require().Equal(metricA.GetMetricID(), oracle.LastMismatchedMetricId, "oracle mismatched metric after key B verified")
+
+	_, found := s.App.ICAOracleKeeper.GetMetric(s.Ctx, metricA.GetMetricID())
+	s.Require().True(found, "key A mismatch should still be stored")
+	_, found = s.App.ICAOracleKeeper.GetMetric(s.Ctx, metricB.GetMetricID())
+	s.Require().False(found, "key B metric should have been removed")
+
+	// Once a newer update of key A is verified, the oracle should be verified
+	metricA2 := types.Metric{Key: "keyA", Value: "valueA2", UpdateTime: 3, DestinationOracle: HostChainId, Status: types.MetricStatus_VERIFYING}
+	oracle = verifyMetric(metricA2, metricA2.Value)
+	s.Require().Equal(types.VerificationStatus_VERIFIED, oracle.VerificationStatus, "oracle status after key A verified")
+	s.Require().Empty(oracle.LastMismatchedMetricId, "oracle mismatched metric after key A verified")
+	s.Require().Empty(s.App.ICAOracleKeeper.GetAllMetrics(s.Ctx), "no metrics should remain")
+}
@@ -25,6 +25,10 @@ type Keeper struct {
 	ChannelKeeper       types.ChannelKeeper
 	ICAControllerKeeper icacontrollerkeeper.Keeper
 	ICACallbacksKeeper  types.ICACallbacksKeeper
+	IcqKeeper           types.IcqKeeper
+
+	// Metric producers registered by other modules, keyed by producer ID
+	metricProducers map[string]types.MetricProducer
 }
 
 func NewKeeper(
@@ -39,7 +43,13 @@ func NewKeeper(
 	channelKeeper types.ChannelKeeper,
 	icaControllerKeeper icacontrollerkeeper.Keeper,
 	icaCallbacksKeeper types.ICACallbacksKeeper,
+	icqKeeper types.IcqKeeper,
 ) *Keeper {
+	// set KeyTable if it has not already been set
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return &Keeper{
 		cdc:        cdc,
 		storeKey:   key,
@@ -52,6 +62,9 @@ func NewKeeper(
 		ChannelKeeper:       channelKeeper,
 		ICAControllerKeeper: icaControllerKeeper,
 		ICACallbacksKeeper:  icaCallbacksKeeper,
+		IcqKeeper:           icqKeeper,
+
+		metricProducers: map[string]types.MetricProducer{},
 	}
 }
 
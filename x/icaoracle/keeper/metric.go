@@ -21,10 +21,10 @@ func (k Keeper) SetMetric(ctx sdk.Context, metric types.Metric) {
 	switch metric.Status {
 	case types.MetricStatus_QUEUED:
 		k.addMetricToQueue(ctx, metricKey)
-	case types.MetricStatus_IN_PROGRESS:
+	case types.MetricStatus_IN_PROGRESS, types.MetricStatus_VERIFYING, types.MetricStatus_MISMATCH:
 		k.removeMetricFromQueue(ctx, metricKey)
 	default:
-		panic("metric status must be specified as QUEUED or IN_PROGRESS before storing")
+		panic("metric status must be specified as QUEUED, IN_PROGRESS, VERIFYING, or MISMATCH before storing")
 	}
 }
 
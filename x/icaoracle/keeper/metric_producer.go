@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v27/utils"
+	"github.com/Stride-Labs/stride/v27/x/icaoracle/types"
+)
+
+// Registers a function that computes metrics for the oracles
+// This should be called from app.go after all the keepers have been built
+// The producer will not run until a config is set for it through governance
+func (k Keeper) RegisterMetricProducer(producerId string, producer types.MetricProducer) error {
+	if _, ok := k.metricProducers[producerId]; ok {
+		return errorsmod.Wrapf(types.ErrMetricProducerRegistered, "producer %s", producerId)
+	}
+	k.metricProducers[producerId] = producer
+	return nil
+}
+
+// Registers each of the metric producers exposed by the other modules
+func (k Keeper) SetMetricProducers(moduleProducers ...types.ModuleMetricProducers) error {
+	for _, producers := range moduleProducers {
+		for producerId, producer := range producers {
+			if err := k.RegisterMetricProducer(producerId, producer); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Returns the IDs of all registered metric producers, in sorted order
+func (k Keeper) GetRegisteredMetricProducerIds() []string {
+	producerIds := []string{}
+	for producerId := range k.metricProducers {
+		producerIds = append(producerIds, producerId)
+	}
+	sort.Strings(producerIds)
+	return producerIds
+}
+
+// Stores/updates a metric producer config
+func (k Keeper) SetMetricProducerConfig(ctx sdk.Context, config types.MetricProducerConfig) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MetricProducerKeyPrefix)
+
+	configKey := types.KeyPrefix(config.ProducerId)
+	configValue := k.cdc.MustMarshal(&config)
+
+	store.Set(configKey, configValue)
+}
+
+// Grabs and returns a metric producer config from the store
+func (k Keeper) GetMetricProducerConfig(ctx sdk.Context, producerId string) (config types.MetricProducerConfig, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MetricProducerKeyPrefix)
+
+	configBz := store.Get(types.KeyPrefix(producerId))
+	if len(configBz) == 0 {
+		return config, false
+	}
+
+	k.cdc.MustUnmarshal(configBz, &config)
+	return config, true
+}
+
+// Returns all metric producer configs
+func (k Keeper) GetAllMetricProducerConfigs(ctx sdk.Context) []types.MetricProducerConfig {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MetricProducerKeyPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	allConfigs := []types.MetricProducerConfig{}
+	for ; iterator.Valid(); iterator.Next() {
+		config := types.MetricProducerConfig{}
+		k.cdc.MustUnmarshal(iterator.Value(), &config)
+		allConfigs = append(allConfigs, config)
+	}
+
+	return allConfigs
+}
+
+// Removes a metric producer config from the store
+func (k Keeper) RemoveMetricProducerConfig(ctx sdk.Context, producerId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MetricProducerKeyPrefix)
+	store.Delete(types.KeyPrefix(producerId))
+}
+
+// Removes an oracle from each metric producer config that targets it
+// If the oracle was the only target of a config, the config is removed entirely, since an
+// empty oracle list would otherwise broaden the producer to every active oracle
+func (k Keeper) RemoveOracleFromMetricProducers(ctx sdk.Context, oracleChainId string) {
+	for _, config := range k.GetAllMetricProducerConfigs(ctx) {
+		remainingOracleChainIds := []string{}
+		for _, chainId := range config.OracleChainIds {
+			if chainId != oracleChainId {
+				remainingOracleChainIds = append(remainingOracleChainIds, chainId)
+			}
+		}
+
+		if len(remainingOracleChainIds) == len(config.OracleChainIds) {
+			continue
+		}
+
+		if len(remainingOracleChainIds) == 0 {
+			k.RemoveMetricProducerConfig(ctx, config.ProducerId)
+			continue
+		}
+
+		config.OracleChainIds = remainingOracleChainIds
+		k.SetMetricProducerConfig(ctx, config)
+	}
+}
+
+// Runs a single metric producer and queues each of its metrics to the configured oracles
+func (k Keeper) RunMetricProducer(ctx sdk.Context, config types.MetricProducerConfig) error {
+	producer, ok := k.metricProducers[config.ProducerId]
+	if !ok {
+		return errorsmod.Wrapf(types.ErrMetricProducerNotFound, "producer %s is not registered", config.ProducerId)
+	}
+
+	metricUpdates, err := producer(ctx)
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to produce metrics for %s", config.ProducerId)
+	}
+
+	for _, update := range metricUpdates {
+		k.QueueMetricUpdateToOracles(ctx, update.Key, update.Value, update.MetricType, update.Attributes, config.OracleChainIds)
+	}
+
+	return nil
+}
+
+// For each enabled metric producer whose interval has elapsed, computes the latest metrics
+// and queues them to the producer's oracles
+// Each producer runs in an isolated context so that a failure in one does not impact the others
+// The last update time is recorded even if the producer fails so that it's not retried every block
+func (k Keeper) RunMetricProducers(ctx sdk.Context) {
+	blockTime := ctx.BlockTime().Unix()
+
+	for _, config := range k.GetAllMetricProducerConfigs(ctx) {
+		if !config.IsDue(blockTime) {
+			continue
+		}
+
+		err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.RunMetricProducer(ctx, config)
+		})
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Failed to run metric producer %s: %s", config.ProducerId, err.Error()))
+		}
+
+		config.LastUpdateTime = blockTime
+		k.SetMetricProducerConfig(ctx, config)
+	}
+}
@@ -0,0 +1,216 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v27/x/icaoracle/types"
+)
+
+const (
+	TestProducerId    = "test-producer"
+	FailingProducerId = "failing-producer"
+)
+
+// Registers a producer that emits a single metric, along with a producer that always fails
+func (s *KeeperTestSuite) RegisterTestMetricProducers() {
+	err := s.App.ICAOracleKeeper.RegisterMetricProducer(TestProducerId, func(ctx sdk.Context) ([]types.MetricUpdate, error) {
+		return []types.MetricUpdate{{Key: "test_key", Value: "1", MetricType: "test"}}, nil
+	})
+	s.Require().NoError(err, "no error expected when registering test producer")
+
+	err = s.App.ICAOracleKeeper.RegisterMetricProducer(FailingProducerId, func(ctx sdk.Context) ([]types.MetricUpdate, error) {
+		return nil, errors.New("producer failed")
+	})
+	s.Require().NoError(err, "no error expected when registering failing producer")
+}
+
+// Returns the destination oracle of each metric with the given key
+func (s *KeeperTestSuite) GetMetricDestinations(metricKey string) []string {
+	destinations := []string{}
+	for _, metric := range s.App.ICAOracleKeeper.GetAllMetrics(s.Ctx) {
+		if metric.Key == metricKey {
+			destinations = append(destinations, metric.DestinationOracle)
+		}
+	}
+	return destinations
+}
+
+func (s *KeeperTestSuite) TestRegisterMetricProducer() {
+	s.RegisterTestMetricProducers()
+
+	registeredIds := s.App.ICAOracleKeeper.GetRegisteredMetricProducerIds()
+	s.Require().Contains(registeredIds, TestProducerId, "test producer registered")
+	s.Require().Contains(registeredIds, FailingProducerId, "failing producer registered")
+
+	// Registering the same ID twice should fail
+	err := s.App.ICAOracleKeeper.RegisterMetricProducer(TestProducerId, func(ctx sdk.Context) ([]types.MetricUpdate, error) {
+		return nil, nil
+	})
+	s.Require().ErrorIs(err, types.ErrMetricProducerRegistered)
+}
+
+func (s *KeeperTestSuite) TestQueueMetricUpdateToOracles() {
+	oracles := s.CreateTestOracles()
+
+	// Deactivate one of the oracles
+	inactiveOracle := oracles[4]
+	inactiveOracle.Active = false
+	s.App.ICAOracleKeeper.SetOracle(s.Ctx, inactiveOracle)
+
+	// If no oracles are specified, the metric should be sent to each active oracle
+	s.App.ICAOracleKeeper.QueueMetricUpdateToOracles(s.Ctx, "all", "1", "type", "", []string{})
+	s.Require().ElementsMatch([]string{"chain-1", "chain-2", "chain-3", "chain-4"}, s.GetMetricDestinations("all"),
+		"metric destinations without target oracles")
+
+	// Otherwise, the metric should only be sent to the target oracles that are active
+	targetOracles := []string{"chain-2", "chain-3", inactiveOracle.ChainId}
+	s.App.ICAOracleKeeper.QueueMetricUpdateToOracles(s.Ctx, "targeted", "1", "type", "", targetOracles)
+	s.Require().ElementsMatch([]string{"chain-2", "chain-3"}, s.GetMetricDestinations("targeted"),
+		"metric destinations with target oracles")
+}
+
+func (s *KeeperTestSuite) TestRunMetricProducers() {
+	s.CreateTestOracles()
+	s.RegisterTestMetricProducers()
+
+	blockTime := s.Ctx.BlockTime().Unix()
+	interval := uint64(60)
+
+	configs := []types.MetricProducerConfig{
+		// Due - should queue a metric to chain-1
+		{ProducerId: TestProducerId, IntervalSeconds: interval, OracleChainIds: []string{"chain-1"}, Enabled: true},
+		// Fails - should not impact the other producers, but should still update the last update time
+		{ProducerId: FailingProducerId, IntervalSeconds: interval, Enabled: true},
+		// Not registered - should be skipped
+		{ProducerId: "not-registered", IntervalSeconds: interval, Enabled: true},
+		// Disabled - should not run
+		{ProducerId: "disabled", IntervalSeconds: interval, Enabled: false},
+	}
+	for _, config := range configs {
+		s.App.ICAOracleKeeper.SetMetricProducerConfig(s.Ctx, config)
+	}
+
+	s.App.ICAOracleKeeper.RunMetricProducers(s.Ctx)
+
+	// Only the test producer's metric should have been queued
+	s.Require().Equal([]string{"chain-1"}, s.GetMetricDestinations("test_key"), "test producer metric destinations")
+	s.Require().Len(s.App.ICAOracleKeeper.GetAllQueuedMetrics(s.Ctx), 1, "number of queued metrics")
+
+	// Each enabled producer should have its update time set
+	for _, producerId := range []string{TestProducerId, FailingProducerId, "not-registered"} {
+		config, found := s.App.ICAOracleKeeper.GetMetricProducerConfig(s.Ctx, producerId)
+		s.Require().True(found, "config for %s should exist", producerId)
+		s.Require().Equal(blockTime, config.LastUpdateTime, "last update time for %s", producerId)
+	}
+	disabledConfig, _ := s.App.ICAOracleKeeper.GetMetricProducerConfig(s.Ctx, "disabled")
+	s.Require().Zero(disabledConfig.LastUpdateTime, "disabled producer last update time")
+
+	// Running again before the interval has passed should not queue another metric
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Second * time.Duration(interval-1)))
+	s.App.ICAOracleKeeper.RunMetricProducers(s.Ctx)
+	s.Require().Len(s.GetMetricDestinations("test_key"), 1, "no new metric before interval")
+
+	// Once the interval has passed, the producer should run again
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Second))
+	s.App.ICAOracleKeeper.RunMetricProducers(s.Ctx)
+	s.Require().Len(s.GetMetricDestinations("test_key"), 2, "new metric after interval")
+}
+
+func (s *KeeperTestSuite) TestGovSetMetricProducer() {
+	s.CreateTestOracles()
+	s.RegisterTestMetricProducers()
+
+	validMsg := types.MsgSetMetricProducer{
+		Authority:       s.App.ICAOracleKeeper.GetAuthority(),
+		ProducerId:      TestProducerId,
+		IntervalSeconds: 60,
+		OracleChainIds:  []string{"chain-1"},
+		Enabled:         true,
+	}
+
+	// Set the config for the first time
+	_, err := s.GetMsgServer().SetMetricProducer(s.Ctx, &validMsg)
+	s.Require().NoError(err, "no error expected when setting metric producer")
+
+	config, found := s.App.ICAOracleKeeper.GetMetricProducerConfig(s.Ctx, TestProducerId)
+	s.Require().True(found, "config should have been set")
+	s.Require().Equal(validMsg.IntervalSeconds, config.IntervalSeconds, "interval")
+	s.Require().Equal(validMsg.OracleChainIds, config.OracleChainIds, "oracle chain IDs")
+	s.Require().True(config.Enabled, "enabled")
+
+	// Updating the config should preserve the last update time
+	config.LastUpdateTime = 100
+	s.App.ICAOracleKeeper.SetMetricProducerConfig(s.Ctx, config)
+
+	updateMsg := validMsg
+	updateMsg.IntervalSeconds = 120
+	updateMsg.Enabled = false
+	_, err = s.GetMsgServer().SetMetricProducer(s.Ctx, &updateMsg)
+	s.Require().NoError(err, "no error expected when updating metric producer")
+
+	config, _ = s.App.ICAOracleKeeper.GetMetricProducerConfig(s.Ctx, TestProducerId)
+	s.Require().Equal(uint64(120), config.IntervalSeconds, "updated interval")
+	s.Require().False(config.Enabled, "updated enabled")
+	s.Require().Equal(int64(100), config.LastUpdateTime, "last update time")
+
+	// Invalid authority
+	invalidMsg := validMsg
+	invalidMsg.Authority = "invalid"
+	_, err = s.GetMsgServer().SetMetricProducer(s.Ctx, &invalidMsg)
+	s.Require().ErrorContains(err, "invalid authority")
+
+	// Producer not registered
+	invalidMsg = validMsg
+	invalidMsg.ProducerId = "not-registered"
+	_, err = s.GetMsgServer().SetMetricProducer(s.Ctx, &invalidMsg)
+	s.Require().ErrorIs(err, types.ErrMetricProducerNotFound)
+
+	// Oracle does not exist
+	invalidMsg = validMsg
+	invalidMsg.OracleChainIds = []string{"chain-1", "not-found"}
+	_, err = s.GetMsgServer().SetMetricProducer(s.Ctx, &invalidMsg)
+	s.Require().ErrorIs(err, types.ErrOracleNotFound)
+}
+
+func (s *KeeperTestSuite) TestGovRemoveMetricProducer() {
+	s.App.ICAOracleKeeper.SetMetricProducerConfig(s.Ctx, types.MetricProducerConfig{ProducerId: TestProducerId})
+
+	// Invalid authority
+	_, err := s.GetMsgServer().RemoveMetricProducer(s.Ctx, &types.MsgRemoveMetricProducer{
+		Authority:  "invalid",
+		ProducerId: TestProducerId,
+	})
+	s.Require().ErrorContains(err, "invalid authority")
+
+	// Remove the config
+	_, err = s.GetMsgServer().RemoveMetricProducer(s.Ctx, &types.MsgRemoveMetricProducer{
+		Authority:  s.App.ICAOracleKeeper.GetAuthority(),
+		ProducerId: TestProducerId,
+	})
+	s.Require().NoError(err, "no error expected when removing metric producer")
+
+	_, found := s.App.ICAOracleKeeper.GetMetricProducerConfig(s.Ctx, TestProducerId)
+	s.Require().False(found, "config should have been removed")
+
+	// Removing again should fail
+	_, err = s.GetMsgServer().RemoveMetricProducer(s.Ctx, &types.MsgRemoveMetricProducer{
+		Authority:  s.App.ICAOracleKeeper.GetAuthority(),
+		ProducerId: TestProducerId,
+	})
+	s.Require().ErrorIs(err, types.ErrMetricProducerNotFound)
+}
+
+func (s *KeeperTestSuite) TestQueryMetricProducers() {
+	s.RegisterTestMetricProducers()
+
+	config := types.MetricProducerConfig{ProducerId: TestProducerId, IntervalSeconds: 60, Enabled: true}
+	s.App.ICAOracleKeeper.SetMetricProducerConfig(s.Ctx, config)
+
+	resp, err := s.QueryClient.MetricProducers(s.Ctx, &types.QueryMetricProducersRequest{})
+	s.Require().NoError(err, "no error expected when querying metric producers")
+	s.Require().Equal([]types.MetricProducerConfig{config}, resp.MetricProducers, "metric producer configs")
+	s.Require().Contains(resp.RegisteredProducerIds, TestProducerId, "registered producer IDs")
+}
@@ -265,5 +265,54 @@ func (ms msgServer) RemoveOracle(goCtx context.Context, msg *types.MsgRemoveOrac
 		}
 	}
 
+	// Stop any metric producers from targeting this oracle
+	ms.Keeper.RemoveOracleFromMetricProducers(ctx, msg.OracleChainId)
+
 	return &types.MsgRemoveOracleResponse{}, nil
 }
+
+// Proposal handler for adding or updating the config of a metric producer
+// The producer must already be registered by a module in the app
+func (ms msgServer) SetMetricProducer(goCtx context.Context, msg *types.MsgSetMetricProducer) (*types.MsgSetMetricProducerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if _, ok := ms.Keeper.metricProducers[msg.ProducerId]; !ok {
+		return nil, errorsmod.Wrapf(types.ErrMetricProducerNotFound, "producer %s is not registered", msg.ProducerId)
+	}
+
+	// Each target oracle must exist
+	for _, oracleChainId := range msg.OracleChainIds {
+		if _, found := ms.Keeper.GetOracle(ctx, oracleChainId); !found {
+			return nil, errorsmod.Wrapf(types.ErrOracleNotFound, "oracle %s", oracleChainId)
+		}
+	}
+
+	// Preserve the last update time so that changing the config does not reset the cadence
+	config, _ := ms.Keeper.GetMetricProducerConfig(ctx, msg.ProducerId)
+	config.ProducerId = msg.ProducerId
+	config.IntervalSeconds = msg.IntervalSeconds
+	config.OracleChainIds = msg.OracleChainIds
+	config.Enabled = msg.Enabled
+	ms.Keeper.SetMetricProducerConfig(ctx, config)
+
+	return &types.MsgSetMetricProducerResponse{}, nil
+}
+
+// Proposal handler for removing the config of a metric producer, which stops the producer
+func (ms msgServer) RemoveMetricProducer(goCtx context.Context, msg *types.MsgRemoveMetricProducer) (*types.MsgRemoveMetricProducerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if _, found := ms.Keeper.GetMetricProducerConfig(ctx, msg.ProducerId); !found {
+		return nil, errorsmod.Wrapf(types.ErrMetricProducerNotFound, "no config for producer %s", msg.ProducerId)
+	}
+
+	ms.Keeper.RemoveMetricProducerConfig(ctx, msg.ProducerId)
+
+	return &types.MsgRemoveMetricProducerResponse{}, nil
+}
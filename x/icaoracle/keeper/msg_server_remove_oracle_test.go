@@ -25,6 +25,19 @@ func (s *KeeperTestSuite) TestGovRemoveOracle() {
 		s.App.ICAOracleKeeper.SetMetric(s.Ctx, metric)
 	}
 
+	// Add metric producer configs that target only the removed oracle, the removed oracle
+	// and another oracle, only another oracle, and every active oracle
+	otherOracle := oracles[0]
+	producerConfigs := []types.MetricProducerConfig{
+		{ProducerId: "removed-only", OracleChainIds: []string{oracleToRemove.ChainId}},
+		{ProducerId: "removed-and-other", OracleChainIds: []string{oracleToRemove.ChainId, otherOracle.ChainId}},
+		{ProducerId: "other-only", OracleChainIds: []string{otherOracle.ChainId}},
+		{ProducerId: "all-oracles", OracleChainIds: []string{}},
+	}
+	for _, config := range producerConfigs {
+		s.App.ICAOracleKeeper.SetMetricProducerConfig(s.Ctx, config)
+	}
+
 	// Remove the oracle thorugh goverance
 	_, err := s.GetMsgServer().RemoveOracle(s.Ctx, &types.MsgRemoveOracle{
 		Authority:     s.App.ICAOracleKeeper.GetAuthority(),
@@ -48,4 +61,20 @@ func (s *KeeperTestSuite) TestGovRemoveOracle() {
 
 	// Confirm the metrics were removed
 	s.Require().Empty(s.App.ICAOracleKeeper.GetAllMetrics(s.Ctx), "all metrics removed")
+
+	// Confirm the producer that only targeted the removed oracle was removed,
+	// and the removed oracle was dropped from the other producers
+	_, found := s.App.ICAOracleKeeper.GetMetricProducerConfig(s.Ctx, "removed-only")
+	s.Require().False(found, "producer targeting only the removed oracle should have been removed")
+
+	expectedOracleChainIds := map[string][]string{
+		"removed-and-other": {otherOracle.ChainId},
+		"other-only":        {otherOracle.ChainId},
+		"all-oracles":       nil,
+	}
+	for producerId, expectedChainIds := range expectedOracleChainIds {
+		config, found := s.App.ICAOracleKeeper.GetMetricProducerConfig(s.Ctx, producerId)
+		s.Require().True(found, "producer %s should not have been removed", producerId)
+		s.Require().Equal(expectedChainIds, config.OracleChainIds, "oracle chain IDs for producer %s", producerId)
+	}
 }
@@ -16,6 +16,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgRestoreOracleICA{}, "icaoracle/MsgRestoreOracleICA")
 	legacy.RegisterAminoMsg(cdc, &MsgToggleOracle{}, "icaoracle/MsgToggleOracle")
 	legacy.RegisterAminoMsg(cdc, &MsgRemoveOracle{}, "icaoracle/MsgRemoveOracle")
+	legacy.RegisterAminoMsg(cdc, &MsgSetMetricProducer{}, "icaoracle/MsgSetMetricProducer")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveMetricProducer{}, "icaoracle/MsgRemoveMetricProducer")
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -25,6 +27,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgRestoreOracleICA{},
 		&MsgToggleOracle{},
 		&MsgRemoveOracle{},
+		&MsgSetMetricProducer{},
+		&MsgRemoveMetricProducer{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
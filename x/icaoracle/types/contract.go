@@ -2,8 +2,25 @@ package types
 
 import (
 	"encoding/base64"
+	"encoding/binary"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 )
 
+// Namespace of the cw-storage-plus Map that the oracle contract uses to store metrics (key'd by the metric key)
+// Note: this must be kept in sync with the oracle contract
+const ContractMetricsNamespace = "metrics"
+
+// Metric as stored in the oracle contract's state
+// Only the fields that are needed to confirm the metric update landed are deserialized
+type ContractMetric struct {
+	Key        string `json:"key"`
+	Value      string `json:"value"`
+	UpdateTime int64  `json:"update_time"`
+}
+
 // Creates a new PostMetric contract message from a Metric
 func NewMsgExecuteContractPostMetric(metric Metric) MsgExecuteContractPostMetric {
 	return MsgExecuteContractPostMetric{
@@ -17,3 +34,23 @@ func NewMsgExecuteContractPostMetric(metric Metric) MsgExecuteContractPostMetric
 		},
 	}
 }
+
+// Builds the key of a metric in the oracle contract's store, on the oracle chain's wasm module
+// The key is of the form: {contractStorePrefix}{len(namespace) as 2 byte big endian}{namespace}{metricKey}
+// where the contract store prefix is 0x03 | {contractAddress}
+func GetContractMetricStoreKey(contractAddress string, metricKey string) ([]byte, error) {
+	_, contractAddressBz, err := bech32.DecodeAndConvert(contractAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid contract address %s", contractAddress)
+	}
+
+	namespaceLength := make([]byte, 2)
+	binary.BigEndian.PutUint16(namespaceLength, uint16(len(ContractMetricsNamespace)))
+
+	key := wasmtypes.GetContractStorePrefix(contractAddressBz)
+	key = append(key, namespaceLength...)
+	key = append(key, []byte(ContractMetricsNamespace)...)
+	key = append(key, []byte(metricKey)...)
+
+	return key, nil
+}
@@ -0,0 +1,29 @@
+package types_test
+
+import (
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v27/x/icaoracle/types"
+)
+
+func TestGetContractMetricStoreKey(t *testing.T) {
+	contractAddress := sdk.AccAddress([]byte("contract-address"))
+
+	// The key should be the contract prefix, followed by the length-prefixed namespace, and then the metric key
+	expectedKey := wasmtypes.GetContractStorePrefix(contractAddress)
+	expectedKey = append(expectedKey, []byte{0, 7}...)
+	expectedKey = append(expectedKey, []byte("metrics")...)
+	expectedKey = append(expectedKey, []byte("key1")...)
+
+	actualKey, err := types.GetContractMetricStoreKey(contractAddress.String(), "key1")
+	require.NoError(t, err, "no error expected when building key")
+	require.Equal(t, expectedKey, actualKey, "contract metric key")
+
+	// Invalid contract address
+	_, err = types.GetContractMetricStoreKey("invalid", "key1")
+	require.ErrorContains(t, err, "invalid contract address")
+}
@@ -20,4 +20,6 @@ var (
 	ErrICAAccountDoesNotExist    = errorsmod.Register(ModuleName, 13, "ICA account does not exist")
 	ErrInvalidGenesisState       = errorsmod.Register(ModuleName, 14, "Invalid genesis state")
 	ErrUnableToRestoreICAChannel = errorsmod.Register(ModuleName, 15, "unable to restore oracle ICA channel")
+	ErrMetricProducerNotFound    = errorsmod.Register(ModuleName, 16, "metric producer not found")
+	ErrMetricProducerRegistered  = errorsmod.Register(ModuleName, 17, "metric producer already registered")
 )
@@ -3,6 +3,7 @@ package types
 const (
 	EventTypeUpdateOracle    = "update_oracle"
 	EventTypeUpdateOracleAck = "update_oracle_ack"
+	EventTypeVerifyMetric    = "verify_metric"
 
 	AttributeKeyOracleChainId     = "oracle_chain_id"
 	AttributeKeyMetricID          = "metric_id"
@@ -12,4 +13,6 @@ const (
 	AttributeKeyMetricUpdateTime  = "metric_update_time"
 	AttributeKeyMetricBlockHeight = "metric_block_height"
 	AttributeKeyMetricAckStatus   = "metric_ack_status"
+	AttributeKeyMetricVerified    = "metric_verified"
+	AttributeKeyContractValue     = "contract_value"
 )
@@ -10,6 +10,7 @@ import (
 	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
 
 	icacallbackstypes "github.com/Stride-Labs/stride/v27/x/icacallbacks/types"
+	icqtypes "github.com/Stride-Labs/stride/v27/x/interchainquery/types"
 )
 
 // ClientKeeper defines the expected IBC client keeper
@@ -40,6 +41,11 @@ type ICACallbacksKeeper interface {
 	SetCallbackData(ctx sdk.Context, callbackData icacallbackstypes.CallbackData)
 }
 
+// IcqKeeper defines the expected interface needed to send ICQ requests
+type IcqKeeper interface {
+	SubmitICQRequest(ctx sdk.Context, query icqtypes.Query, forceUnique bool) error
+}
+
 // ICS4Wrapper defines the expected ICS4Wrapper for middleware
 type ICS4Wrapper interface {
 	WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, acknowledgement ibcexported.Acknowledgement) error
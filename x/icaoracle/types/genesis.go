@@ -7,9 +7,10 @@ import (
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:  DefaultParams(),
-		Oracles: []Oracle{},
-		Metrics: []Metric{},
+		Params:          DefaultParams(),
+		Oracles:         []Oracle{},
+		Metrics:         []Metric{},
+		MetricProducers: []MetricProducerConfig{},
 	}
 }
 
@@ -17,7 +18,7 @@ func DefaultGenesis() *GenesisState {
 // failure.
 func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
-		return errorsmod.Wrap(err, "invalid genesis state")
+		return errorsmod.Wrapf(ErrInvalidGenesisState, "invalid params: %s", err.Error())
 	}
 	for _, oracle := range gs.Oracles {
 		if oracle.ChainId == "" {
@@ -35,6 +36,16 @@ func (gs GenesisState) Validate() error {
 			return errorsmod.Wrap(ErrInvalidGenesisState, "metric has missing destination oracle chain ID")
 		}
 	}
+	producerIds := map[string]bool{}
+	for _, producer := range gs.MetricProducers {
+		if err := producer.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesisState, "invalid metric producer: %s", err.Error())
+		}
+		if producerIds[producer.ProducerId] {
+			return errorsmod.Wrapf(ErrInvalidGenesisState, "duplicate metric producer %s", producer.ProducerId)
+		}
+		producerIds[producer.ProducerId] = true
+	}
 
 	return nil
 }
@@ -25,6 +25,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the icaoracle module parameters.
 type Params struct {
+	// Whether the amount of STRD minted each mint epoch should be pushed to the
+	// oracles as an inflation metric
+	StrdInflationMetricEnabled bool `protobuf:"varint,1,opt,name=strd_inflation_metric_enabled,json=strdInflationMetricEnabled,proto3" json:"strd_inflation_metric_enabled,omitempty" yaml:"strd_inflation_metric_enabled"`
+	// Chain IDs of the oracles that should receive the inflation metric
+	// If empty, the metric is sent to every active oracle
+	StrdInflationOracleChainIds []string `protobuf:"bytes,2,rep,name=strd_inflation_oracle_chain_ids,json=strdInflationOracleChainIds,proto3" json:"strd_inflation_oracle_chain_ids,omitempty" yaml:"strd_inflation_oracle_chain_ids"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -60,11 +66,26 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetStrdInflationMetricEnabled() bool {
+	if m != nil {
+		return m.StrdInflationMetricEnabled
+	}
+	return false
+}
+
+func (m *Params) GetStrdInflationOracleChainIds() []string {
+	if m != nil {
+		return m.StrdInflationOracleChainIds
+	}
+	return nil
+}
+
 // GenesisState defines the icaoracle module's genesis state.
 type GenesisState struct {
-	Params  Params   `protobuf:"bytes,1,opt,name=params,proto3" json:"params" yaml:"params"`
-	Oracles []Oracle `protobuf:"bytes,2,rep,name=oracles,proto3" json:"oracles" yaml:"oracles"`
-	Metrics []Metric `protobuf:"bytes,3,rep,name=metrics,proto3" json:"metrics" yaml:"metrics"`
+	Params          Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params" yaml:"params"`
+	Oracles         []Oracle               `protobuf:"bytes,2,rep,name=oracles,proto3" json:"oracles" yaml:"oracles"`
+	Metrics         []Metric               `protobuf:"bytes,3,rep,name=metrics,proto3" json:"metrics" yaml:"metrics"`
+	MetricProducers []MetricProducerConfig `protobuf:"bytes,4,rep,name=metric_producers,json=metricProducers,proto3" json:"metric_producers" yaml:"metric_producers"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -121,6 +142,13 @@ func (m *GenesisState) GetMetrics() []Metric {
 	return nil
 }
 
+func (m *GenesisState) GetMetricProducers() []MetricProducerConfig {
+	if m != nil {
+		return m.MetricProducers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "stride.icaoracle.Params")
 	proto.RegisterType((*GenesisState)(nil), "stride.icaoracle.GenesisState")
@@ -129,25 +157,33 @@ func init() {
 func init() { proto.RegisterFile("stride/icaoracle/genesis.proto", fileDescriptor_89fd81957c6adfb8) }
 
 var fileDescriptor_89fd81957c6adfb8 = []byte{
-	// 274 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2b, 0x2e, 0x29, 0xca,
-	0x4c, 0x49, 0xd5, 0xcf, 0x4c, 0x4e, 0xcc, 0x2f, 0x4a, 0x4c, 0xce, 0x49, 0xd5, 0x4f, 0x4f, 0xcd,
-	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc8, 0xeb, 0xc1,
-	0xe5, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0x94, 0x02,
-	0x86, 0x39, 0x70, 0x16, 0x44, 0x85, 0x12, 0x07, 0x17, 0x5b, 0x40, 0x62, 0x51, 0x62, 0x6e, 0xb1,
-	0xd2, 0x7b, 0x46, 0x2e, 0x1e, 0x77, 0x88, 0x2d, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0xee, 0x5c,
-	0x6c, 0x05, 0x60, 0x29, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x09, 0x3d, 0x74, 0x5b, 0xf5,
-	0x20, 0x5a, 0x9d, 0x44, 0x4f, 0xdc, 0x93, 0x67, 0xf8, 0x74, 0x4f, 0x9e, 0xb7, 0x32, 0x31, 0x37,
-	0xc7, 0x4a, 0x09, 0xa2, 0x4b, 0x29, 0x08, 0xaa, 0x5d, 0xc8, 0x8b, 0x8b, 0x1d, 0xa2, 0xbe, 0x58,
-	0x82, 0x49, 0x81, 0x19, 0xbb, 0x49, 0xfe, 0x60, 0xca, 0x49, 0x0c, 0x6a, 0x12, 0x1f, 0xc4, 0x24,
-	0xa8, 0x36, 0xa5, 0x20, 0x98, 0x01, 0x20, 0xb3, 0x72, 0x53, 0x4b, 0x8a, 0x32, 0x93, 0x8b, 0x25,
-	0x98, 0x71, 0x99, 0xe5, 0x0b, 0x56, 0x80, 0x6e, 0x16, 0x54, 0x9b, 0x52, 0x10, 0xcc, 0x00, 0x27,
-	0xdf, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63,
-	0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x4e, 0xcf, 0x2c, 0xc9,
-	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x0f, 0x06, 0x1b, 0xaf, 0xeb, 0x93, 0x98, 0x54, 0xac,
-	0x0f, 0x0d, 0xce, 0x32, 0x23, 0x73, 0xfd, 0x0a, 0xa4, 0x40, 0x2d, 0xa9, 0x2c, 0x48, 0x2d, 0x4e,
-	0x62, 0x03, 0x87, 0xa8, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x1c, 0xe9, 0x9b, 0x04, 0xbd, 0x01,
-	0x00, 0x00,
+	// 415 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x93, 0x5d, 0xa9, 0x3a, 0xfe, 0x5b, 0x82, 0x7f, 0x42, 0xc5, 0xa4, 0x0c, 0xb2, 0x14,
+	0xc1, 0x04, 0x76, 0x0f, 0x82, 0xc7, 0x2c, 0xb2, 0xac, 0xb8, 0x58, 0xd2, 0x9b, 0x97, 0x30, 0x49,
+	0xa6, 0xe9, 0x60, 0x92, 0x09, 0x33, 0x53, 0xb1, 0xdf, 0xc2, 0x8f, 0xd5, 0x63, 0x8f, 0x9e, 0x82,
+	0x34, 0x07, 0xef, 0xfd, 0x04, 0x92, 0x99, 0x69, 0x6d, 0x63, 0xeb, 0x2d, 0xf0, 0xbc, 0xcf, 0xef,
+	0x79, 0xe7, 0xc9, 0x0b, 0x1c, 0x2e, 0x18, 0x49, 0xb1, 0x4f, 0x12, 0x44, 0x19, 0x4a, 0x72, 0xec,
+	0x67, 0xb8, 0xc4, 0x9c, 0x70, 0xaf, 0x62, 0x54, 0x50, 0xeb, 0x4c, 0xe9, 0xde, 0x56, 0xef, 0x3f,
+	0xcd, 0x68, 0x46, 0xa5, 0xe8, 0xb7, 0x5f, 0x6a, 0xae, 0x3f, 0xf8, 0x87, 0xb3, 0xfd, 0x52, 0x13,
+	0xf0, 0xb7, 0x09, 0x7a, 0x23, 0xc4, 0x50, 0xc1, 0xad, 0xaf, 0xe0, 0x15, 0x17, 0x2c, 0x8d, 0x48,
+	0x39, 0xc9, 0x91, 0x20, 0xb4, 0x8c, 0x0a, 0x2c, 0x18, 0x49, 0x22, 0x5c, 0xa2, 0x38, 0xc7, 0xa9,
+	0x6d, 0x0e, 0xcc, 0xe1, 0xbd, 0x60, 0xb8, 0xae, 0xdd, 0xd7, 0x73, 0x54, 0xe4, 0xef, 0xe1, 0x7f,
+	0xc7, 0x61, 0xd8, 0x6f, 0xf5, 0x9b, 0x8d, 0x7c, 0x2b, 0xd5, 0x0f, 0x4a, 0xb4, 0x2a, 0xe0, 0x76,
+	0xdc, 0x6a, 0xad, 0x28, 0x99, 0x22, 0x52, 0x46, 0x24, 0xe5, 0xf6, 0xc9, 0xe0, 0x74, 0x78, 0x3f,
+	0x78, 0xb3, 0xae, 0xdd, 0xf3, 0x83, 0x71, 0x5d, 0x03, 0x0c, 0x5f, 0xee, 0x05, 0x7e, 0x96, 0xfa,
+	0x55, 0x2b, 0xdf, 0xa4, 0x1c, 0x36, 0x27, 0xe0, 0xe1, 0xb5, 0x6a, 0x71, 0x2c, 0x90, 0xc0, 0xd6,
+	0x35, 0xe8, 0x55, 0xf2, 0xe5, 0xf2, 0x61, 0x0f, 0x2e, 0x6c, 0xaf, 0xdb, 0xaa, 0xa7, 0x9a, 0x09,
+	0x9e, 0x2d, 0x6a, 0xd7, 0x58, 0xd7, 0xee, 0x23, 0xb5, 0x87, 0x72, 0xc1, 0x50, 0xdb, 0xad, 0x8f,
+	0xe0, 0xae, 0x9a, 0x57, 0x3b, 0x1f, 0x24, 0xa9, 0x65, 0x82, 0xe7, 0x9a, 0xf4, 0x58, 0x91, 0xb4,
+	0x0d, 0x86, 0x1b, 0x40, 0xcb, 0x52, 0x35, 0x72, 0xfb, 0xf4, 0x18, 0x4b, 0x35, 0xd9, 0x65, 0x69,
+	0x1b, 0x0c, 0x37, 0x00, 0x8b, 0x81, 0x33, 0xfd, 0x4b, 0x2a, 0x46, 0xd3, 0x59, 0x82, 0x19, 0xb7,
+	0xef, 0x48, 0xe8, 0xf9, 0x31, 0xe8, 0x48, 0x0f, 0x5e, 0xd1, 0x72, 0x42, 0xb2, 0xc0, 0xd5, 0x11,
+	0x2f, 0x76, 0x23, 0xfe, 0xd2, 0x60, 0xf8, 0xa4, 0xd8, 0xb3, 0xf1, 0xe0, 0x76, 0xb1, 0x72, 0xcc,
+	0xe5, 0xca, 0x31, 0x7f, 0xad, 0x1c, 0xf3, 0x47, 0xe3, 0x18, 0xcb, 0xc6, 0x31, 0x7e, 0x36, 0x8e,
+	0xf1, 0xe5, 0x32, 0x23, 0x62, 0x3a, 0x8b, 0xbd, 0x84, 0x16, 0xfe, 0x58, 0xa6, 0xbf, 0xfd, 0x84,
+	0x62, 0xee, 0xeb, 0x13, 0xfd, 0x76, 0xf1, 0xce, 0xff, 0xbe, 0x73, 0xa8, 0x62, 0x5e, 0x61, 0x1e,
+	0xf7, 0xe4, 0x95, 0x5e, 0xfe, 0x19, 0x00, 0x89, 0x7f, 0x71, 0x37, 0x11, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -170,6 +206,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StrdInflationOracleChainIds) > 0 {
+		for iNdEx := len(m.StrdInflationOracleChainIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StrdInflationOracleChainIds[iNdEx])
+			copy(dAtA[i:], m.StrdInflationOracleChainIds[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.StrdInflationOracleChainIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.StrdInflationMetricEnabled {
+		i--
+		if m.StrdInflationMetricEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -193,6 +248,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MetricProducers) > 0 {
+		for iNdEx := len(m.MetricProducers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MetricProducers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Metrics) > 0 {
 		for iNdEx := len(m.Metrics) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -251,6 +320,15 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.StrdInflationMetricEnabled {
+		n += 2
+	}
+	if len(m.StrdInflationOracleChainIds) > 0 {
+		for _, s := range m.StrdInflationOracleChainIds {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -274,6 +352,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.MetricProducers) > 0 {
+		for _, e := range m.MetricProducers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -312,6 +396,58 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrdInflationMetricEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StrdInflationMetricEnabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrdInflationOracleChainIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StrdInflationOracleChainIds = append(m.StrdInflationOracleChainIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -463,6 +599,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricProducers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetricProducers = append(m.MetricProducers, MetricProducerConfig{})
+			if err := m.MetricProducers[len(m.MetricProducers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -38,6 +38,36 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			name: "valid params",
+			genesisState: types.GenesisState{
+				Params: types.Params{
+					StrdInflationMetricEnabled:  true,
+					StrdInflationOracleChainIds: []string{"chain-1", "chain-2"},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "invalid params - empty oracle chain-id",
+			genesisState: types.GenesisState{
+				Params: types.Params{
+					StrdInflationMetricEnabled:  true,
+					StrdInflationOracleChainIds: []string{"chain-1", ""},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid params - duplicate oracle chain-id",
+			genesisState: types.GenesisState{
+				Params: types.Params{
+					StrdInflationMetricEnabled:  true,
+					StrdInflationOracleChainIds: []string{"chain-1", "chain-1"},
+				},
+			},
+			valid: false,
+		},
 		{
 			name: "invalid oracle",
 			genesisState: types.GenesisState{
@@ -62,6 +92,35 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			name: "valid metric producers",
+			genesisState: types.GenesisState{
+				MetricProducers: []types.MetricProducerConfig{
+					{ProducerId: "producer-1", IntervalSeconds: 60},
+					{ProducerId: "producer-2", IntervalSeconds: 60, OracleChainIds: []string{validChainId}},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "invalid metric producer",
+			genesisState: types.GenesisState{
+				MetricProducers: []types.MetricProducerConfig{
+					{ProducerId: "producer-1", IntervalSeconds: 0},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "duplicate metric producer",
+			genesisState: types.GenesisState{
+				MetricProducers: []types.MetricProducerConfig{
+					{ProducerId: "producer-1", IntervalSeconds: 60},
+					{ProducerId: "producer-1", IntervalSeconds: 120},
+				},
+			},
+			valid: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -23,6 +23,36 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// VerificationStatus indicates whether the metrics stored in the oracle
+// contract were confirmed to match the metrics posted from Stride
+type VerificationStatus int32
+
+const (
+	VerificationStatus_UNVERIFIED VerificationStatus = 0
+	VerificationStatus_VERIFIED   VerificationStatus = 1
+	VerificationStatus_MISMATCH   VerificationStatus = 2
+)
+
+var VerificationStatus_name = map[int32]string{
+	0: "VERIFICATION_STATUS_UNSPECIFIED",
+	1: "VERIFICATION_STATUS_VERIFIED",
+	2: "VERIFICATION_STATUS_MISMATCH",
+}
+
+var VerificationStatus_value = map[string]int32{
+	"VERIFICATION_STATUS_UNSPECIFIED": 0,
+	"VERIFICATION_STATUS_VERIFIED":    1,
+	"VERIFICATION_STATUS_MISMATCH":    2,
+}
+
+func (x VerificationStatus) String() string {
+	return proto.EnumName(VerificationStatus_name, int32(x))
+}
+
+func (VerificationStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_842e38c1f0da9e66, []int{0}
+}
+
 // MetricStatus indicates whether the Metric update ICA has been sent
 type MetricStatus int32
 
@@ -30,18 +60,27 @@ const (
 	MetricStatus_UNSPECIFIED MetricStatus = 0
 	MetricStatus_QUEUED      MetricStatus = 1
 	MetricStatus_IN_PROGRESS MetricStatus = 2
+	// The ICA was acknowledged, and the contract state is being verified with
+	// an ICQ
+	MetricStatus_VERIFYING MetricStatus = 3
+	// The metric stored in the contract did not match the posted metric
+	MetricStatus_MISMATCH MetricStatus = 4
 )
 
 var MetricStatus_name = map[int32]string{
 	0: "METRIC_STATUS_UNSPECIFIED",
 	1: "METRIC_STATUS_QUEUED",
 	2: "METRIC_STATUS_IN_PROGRESS",
+	3: "METRIC_STATUS_VERIFYING",
+	4: "METRIC_STATUS_MISMATCH",
 }
 
 var MetricStatus_value = map[string]int32{
 	"METRIC_STATUS_UNSPECIFIED": 0,
 	"METRIC_STATUS_QUEUED":      1,
 	"METRIC_STATUS_IN_PROGRESS": 2,
+	"METRIC_STATUS_VERIFYING":   3,
+	"METRIC_STATUS_MISMATCH":    4,
 }
 
 func (x MetricStatus) String() string {
@@ -49,7 +88,7 @@ func (x MetricStatus) String() string {
 }
 
 func (MetricStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_842e38c1f0da9e66, []int{0}
+	return fileDescriptor_842e38c1f0da9e66, []int{1}
 }
 
 // Oracle structure stores context about the CW oracle sitting a different chain
@@ -61,6 +100,12 @@ type Oracle struct {
 	IcaAddress      string `protobuf:"bytes,5,opt,name=ica_address,json=icaAddress,proto3" json:"ica_address,omitempty"`
 	ContractAddress string `protobuf:"bytes,6,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	Active          bool   `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	// Result of the most recent ICQ verification of a metric in the contract
+	VerificationStatus VerificationStatus `protobuf:"varint,8,opt,name=verification_status,json=verificationStatus,proto3,enum=stride.icaoracle.VerificationStatus" json:"verification_status,omitempty"`
+	// Unix time (in seconds) of the most recent verification
+	LastVerificationTime int64 `protobuf:"varint,9,opt,name=last_verification_time,json=lastVerificationTime,proto3" json:"last_verification_time,omitempty"`
+	// ID of the most recent metric that did not match the contract's state
+	LastMismatchedMetricId string `protobuf:"bytes,10,opt,name=last_mismatched_metric_id,json=lastMismatchedMetricId,proto3" json:"last_mismatched_metric_id,omitempty"`
 }
 
 func (m *Oracle) Reset()         { *m = Oracle{} }
@@ -145,6 +190,27 @@ func (m *Oracle) GetActive() bool {
 	return false
 }
 
+func (m *Oracle) GetVerificationStatus() VerificationStatus {
+	if m != nil {
+		return m.VerificationStatus
+	}
+	return VerificationStatus_UNVERIFIED
+}
+
+func (m *Oracle) GetLastVerificationTime() int64 {
+	if m != nil {
+		return m.LastVerificationTime
+	}
+	return 0
+}
+
+func (m *Oracle) GetLastMismatchedMetricId() string {
+	if m != nil {
+		return m.LastMismatchedMetricId
+	}
+	return ""
+}
+
 // Metric structure stores a generic metric using a key value structure
 // along with additional context
 type Metric struct {
@@ -247,6 +313,90 @@ func (m *Metric) GetStatus() MetricStatus {
 	return MetricStatus_UNSPECIFIED
 }
 
+// Configuration for a registered metric producer, which computes metrics that
+// are pushed to the oracles on a fixed cadence
+type MetricProducerConfig struct {
+	// Name of the registered producer (e.g. stakeibc_tvl)
+	ProducerId string `protobuf:"bytes,1,opt,name=producer_id,json=producerId,proto3" json:"producer_id,omitempty"`
+	// Minimum number of seconds between metric updates
+	IntervalSeconds uint64 `protobuf:"varint,2,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	// Chain IDs of the oracles that should receive the metrics
+	// If empty, the metrics are sent to every active oracle
+	OracleChainIds []string `protobuf:"bytes,3,rep,name=oracle_chain_ids,json=oracleChainIds,proto3" json:"oracle_chain_ids,omitempty"`
+	// Whether the producer should run
+	Enabled bool `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Unix time (in seconds) of the last time the producer ran
+	LastUpdateTime int64 `protobuf:"varint,5,opt,name=last_update_time,json=lastUpdateTime,proto3" json:"last_update_time,omitempty"`
+}
+
+func (m *MetricProducerConfig) Reset()         { *m = MetricProducerConfig{} }
+func (m *MetricProducerConfig) String() string { return proto.CompactTextString(m) }
+func (*MetricProducerConfig) ProtoMessage()    {}
+func (*MetricProducerConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_842e38c1f0da9e66, []int{2}
+}
+func (m *MetricProducerConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetricProducerConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MetricProducerConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MetricProducerConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetricProducerConfig.Merge(m, src)
+}
+func (m *MetricProducerConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetricProducerConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetricProducerConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetricProducerConfig proto.InternalMessageInfo
+
+func (m *MetricProducerConfig) GetProducerId() string {
+	if m != nil {
+		return m.ProducerId
+	}
+	return ""
+}
+
+func (m *MetricProducerConfig) GetIntervalSeconds() uint64 {
+	if m != nil {
+		return m.IntervalSeconds
+	}
+	return 0
+}
+
+func (m *MetricProducerConfig) GetOracleChainIds() []string {
+	if m != nil {
+		return m.OracleChainIds
+	}
+	return nil
+}
+
+func (m *MetricProducerConfig) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *MetricProducerConfig) GetLastUpdateTime() int64 {
+	if m != nil {
+		return m.LastUpdateTime
+	}
+	return 0
+}
+
 // Attributes associated with a RedemptionRate metric update
 type RedemptionRateAttributes struct {
 	SttokenDenom string `protobuf:"bytes,1,opt,name=sttoken_denom,json=sttokenDenom,proto3" json:"sttoken_denom,omitempty"`
@@ -256,7 +406,7 @@ func (m *RedemptionRateAttributes) Reset()         { *m = RedemptionRateAttribut
 func (m *RedemptionRateAttributes) String() string { return proto.CompactTextString(m) }
 func (*RedemptionRateAttributes) ProtoMessage()    {}
 func (*RedemptionRateAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_842e38c1f0da9e66, []int{2}
+	return fileDescriptor_842e38c1f0da9e66, []int{3}
 }
 func (m *RedemptionRateAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -292,52 +442,241 @@ func (m *RedemptionRateAttributes) GetSttokenDenom() string {
 	return ""
 }
 
+// Attributes associated with the host zone metrics (TVL, stToken supply,
+// and unbonding queue)
+type StTokenMetricAttributes struct {
+	SttokenDenom string `protobuf:"bytes,1,opt,name=sttoken_denom,json=sttokenDenom,proto3" json:"sttoken_denom,omitempty"`
+	HostZoneId   string `protobuf:"bytes,2,opt,name=host_zone_id,json=hostZoneId,proto3" json:"host_zone_id,omitempty"`
+}
+
+func (m *StTokenMetricAttributes) Reset()         { *m = StTokenMetricAttributes{} }
+func (m *StTokenMetricAttributes) String() string { return proto.CompactTextString(m) }
+func (*StTokenMetricAttributes) ProtoMessage()    {}
+func (*StTokenMetricAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_842e38c1f0da9e66, []int{4}
+}
+func (m *StTokenMetricAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StTokenMetricAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StTokenMetricAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StTokenMetricAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StTokenMetricAttributes.Merge(m, src)
+}
+func (m *StTokenMetricAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *StTokenMetricAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_StTokenMetricAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StTokenMetricAttributes proto.InternalMessageInfo
+
+func (m *StTokenMetricAttributes) GetSttokenDenom() string {
+	if m != nil {
+		return m.SttokenDenom
+	}
+	return ""
+}
+
+func (m *StTokenMetricAttributes) GetHostZoneId() string {
+	if m != nil {
+		return m.HostZoneId
+	}
+	return ""
+}
+
+// Attributes associated with a TokenPrice metric update
+type TokenPriceAttributes struct {
+	BaseDenom     string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	QuoteDenom    string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	OsmosisPoolId uint64 `protobuf:"varint,3,opt,name=osmosis_pool_id,json=osmosisPoolId,proto3" json:"osmosis_pool_id,omitempty"`
+}
+
+func (m *TokenPriceAttributes) Reset()         { *m = TokenPriceAttributes{} }
+func (m *TokenPriceAttributes) String() string { return proto.CompactTextString(m) }
+func (*TokenPriceAttributes) ProtoMessage()    {}
+func (*TokenPriceAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_842e38c1f0da9e66, []int{5}
+}
+func (m *TokenPriceAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenPriceAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenPriceAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenPriceAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenPriceAttributes.Merge(m, src)
+}
+func (m *TokenPriceAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenPriceAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenPriceAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenPriceAttributes proto.InternalMessageInfo
+
+func (m *TokenPriceAttributes) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *TokenPriceAttributes) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *TokenPriceAttributes) GetOsmosisPoolId() uint64 {
+	if m != nil {
+		return m.OsmosisPoolId
+	}
+	return 0
+}
+
+// Attributes associated with an Inflation metric update
+type InflationAttributes struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *InflationAttributes) Reset()         { *m = InflationAttributes{} }
+func (m *InflationAttributes) String() string { return proto.CompactTextString(m) }
+func (*InflationAttributes) ProtoMessage()    {}
+func (*InflationAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_842e38c1f0da9e66, []int{6}
+}
+func (m *InflationAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationAttributes.Merge(m, src)
+}
+func (m *InflationAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationAttributes proto.InternalMessageInfo
+
+func (m *InflationAttributes) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("stride.icaoracle.VerificationStatus", VerificationStatus_name, VerificationStatus_value)
 	proto.RegisterEnum("stride.icaoracle.MetricStatus", MetricStatus_name, MetricStatus_value)
 	proto.RegisterType((*Oracle)(nil), "stride.icaoracle.Oracle")
 	proto.RegisterType((*Metric)(nil), "stride.icaoracle.Metric")
+	proto.RegisterType((*MetricProducerConfig)(nil), "stride.icaoracle.MetricProducerConfig")
 	proto.RegisterType((*RedemptionRateAttributes)(nil), "stride.icaoracle.RedemptionRateAttributes")
+	proto.RegisterType((*StTokenMetricAttributes)(nil), "stride.icaoracle.StTokenMetricAttributes")
+	proto.RegisterType((*TokenPriceAttributes)(nil), "stride.icaoracle.TokenPriceAttributes")
+	proto.RegisterType((*InflationAttributes)(nil), "stride.icaoracle.InflationAttributes")
 }
 
 func init() { proto.RegisterFile("stride/icaoracle/icaoracle.proto", fileDescriptor_842e38c1f0da9e66) }
 
 var fileDescriptor_842e38c1f0da9e66 = []byte{
-	// 557 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x93, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x14, 0x85, 0xe3, 0xe6, 0xaf, 0xdb, 0xde, 0xa6, 0x7f, 0xcd, 0x28, 0x82, 0x34, 0x12, 0xc6, 0xa4,
-	0x2c, 0x02, 0x52, 0x1d, 0xa9, 0x95, 0x60, 0x89, 0x42, 0x63, 0xc0, 0x12, 0x69, 0x8b, 0x9d, 0x6c,
-	0xd8, 0x58, 0x93, 0x99, 0x51, 0x32, 0x4a, 0xec, 0xb1, 0xec, 0x49, 0x44, 0x5e, 0xa1, 0x2b, 0x16,
-	0x2c, 0xe9, 0xfb, 0xb0, 0xec, 0x92, 0x25, 0x4a, 0x78, 0x10, 0xe4, 0xb1, 0xd3, 0x1a, 0xd8, 0xcd,
-	0xfd, 0xce, 0xf1, 0x95, 0xcf, 0x9d, 0xb9, 0x60, 0xa5, 0x32, 0xe1, 0x94, 0x75, 0x38, 0xc1, 0x22,
-	0xc1, 0x64, 0x56, 0x3a, 0xd9, 0x71, 0x22, 0xa4, 0x40, 0x46, 0xee, 0xb0, 0xef, 0x78, 0xb3, 0x3e,
-	0x16, 0x63, 0xa1, 0xc4, 0x4e, 0x76, 0xca, 0x7d, 0xad, 0x5f, 0x1a, 0xe8, 0x97, 0xca, 0x80, 0x8e,
-	0x60, 0x97, 0x4c, 0x30, 0x8f, 0x02, 0x4e, 0x1b, 0x9a, 0xa5, 0xb5, 0xf7, 0xbc, 0x1d, 0x55, 0xbb,
-	0x14, 0x1d, 0xc3, 0x01, 0x11, 0x51, 0xc4, 0x88, 0xe4, 0x42, 0xe9, 0x5b, 0x4a, 0xaf, 0xdd, 0x43,
-	0x97, 0xa2, 0xc7, 0x00, 0x64, 0x82, 0xa3, 0x88, 0xcd, 0x32, 0x47, 0x55, 0x39, 0xf6, 0x0a, 0xe2,
-	0x52, 0xf4, 0x08, 0x76, 0x62, 0x91, 0xc8, 0x4c, 0xfb, 0x4f, 0x69, 0x7a, 0x56, 0xba, 0x14, 0x3d,
-	0x81, 0x7d, 0x4e, 0x70, 0x80, 0x29, 0x4d, 0x58, 0x9a, 0x36, 0xb6, 0x95, 0x08, 0x9c, 0xe0, 0x6e,
-	0x4e, 0xd0, 0x73, 0x30, 0x88, 0x88, 0x64, 0x82, 0x89, 0xbc, 0x73, 0xe9, 0xca, 0x75, 0xb8, 0xe1,
-	0x1b, 0xeb, 0x43, 0xd0, 0x31, 0x91, 0x7c, 0xc1, 0x1a, 0x3b, 0x96, 0xd6, 0xde, 0xf5, 0x8a, 0xaa,
-	0xf5, 0x6d, 0x0b, 0xf4, 0x3e, 0x93, 0x09, 0x27, 0xc8, 0x80, 0xea, 0x94, 0x2d, 0x8b, 0x84, 0xd9,
-	0x11, 0xd5, 0x61, 0x7b, 0x81, 0x67, 0x73, 0x56, 0xa4, 0xca, 0x8b, 0xec, 0xb7, 0x42, 0xf5, 0x45,
-	0x20, 0x97, 0x31, 0x2b, 0xf2, 0x40, 0x8e, 0x06, 0xcb, 0x58, 0x19, 0xe6, 0x31, 0xc5, 0x92, 0x05,
-	0x92, 0x87, 0x4c, 0x85, 0xaa, 0x7a, 0x90, 0xa3, 0x01, 0x0f, 0x19, 0x7a, 0x0a, 0xb5, 0xd1, 0x4c,
-	0x90, 0x69, 0x30, 0x61, 0x7c, 0x3c, 0x91, 0x2a, 0x59, 0xd5, 0xdb, 0x57, 0xec, 0xbd, 0x42, 0xc8,
-	0x04, 0xc0, 0x52, 0x26, 0x7c, 0x34, 0x97, 0x6c, 0x13, 0xaa, 0x44, 0xd0, 0x09, 0x20, 0xca, 0x52,
-	0xc9, 0x23, 0xac, 0x26, 0x9f, 0x5f, 0xa5, 0xca, 0xb6, 0xe7, 0x3d, 0x28, 0x29, 0xc5, 0x15, 0xbe,
-	0x04, 0x3d, 0x95, 0x58, 0xce, 0xd3, 0xc6, 0xae, 0xa5, 0xb5, 0xff, 0x3f, 0x35, 0xed, 0xbf, 0x9f,
-	0x81, 0x9d, 0x4f, 0xc1, 0x57, 0x2e, 0xaf, 0x70, 0xb7, 0x5e, 0x43, 0xc3, 0x63, 0x94, 0x85, 0x71,
-	0xd6, 0xcb, 0xc3, 0x92, 0x75, 0xef, 0x7f, 0xe1, 0x18, 0x0e, 0x52, 0x29, 0xc5, 0x94, 0x45, 0x01,
-	0x65, 0x91, 0x08, 0x8b, 0xc9, 0xd5, 0x0a, 0xd8, 0xcb, 0xd8, 0x8b, 0xaf, 0x1a, 0xd4, 0xca, 0x9d,
-	0x91, 0x0d, 0x47, 0x7d, 0x67, 0xe0, 0xb9, 0xe7, 0x81, 0x3f, 0xe8, 0x0e, 0x86, 0x7e, 0x30, 0xbc,
-	0xf0, 0xaf, 0x9c, 0x73, 0xf7, 0xad, 0xeb, 0xf4, 0x8c, 0x4a, 0xf3, 0xf0, 0xfa, 0xc6, 0xda, 0x2f,
-	0x21, 0xf4, 0x0c, 0xea, 0x7f, 0xfa, 0x3f, 0x0e, 0x9d, 0xa1, 0xd3, 0x33, 0xb4, 0x26, 0x5c, 0xdf,
-	0x58, 0x7a, 0x5e, 0xfd, 0xdb, 0xd5, 0xbd, 0x08, 0xae, 0xbc, 0xcb, 0x77, 0x9e, 0xe3, 0xfb, 0xc6,
-	0x56, 0xde, 0xb5, 0x84, 0xde, 0xf4, 0xbf, 0xaf, 0x4c, 0xed, 0x76, 0x65, 0x6a, 0x3f, 0x57, 0xa6,
-	0xf6, 0x65, 0x6d, 0x56, 0x6e, 0xd7, 0x66, 0xe5, 0xc7, 0xda, 0xac, 0x7c, 0x3a, 0x1b, 0x73, 0x39,
-	0x99, 0x8f, 0x6c, 0x22, 0xc2, 0x8e, 0xaf, 0x66, 0x74, 0xf2, 0x01, 0x8f, 0xd2, 0x4e, 0xb1, 0x58,
-	0x8b, 0xd3, 0x57, 0x9d, 0xcf, 0xa5, 0xf5, 0xca, 0x9e, 0x40, 0x3a, 0xd2, 0xd5, 0xce, 0x9c, 0xfd,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0x42, 0xb8, 0x01, 0xa5, 0x7f, 0x03, 0x00, 0x00,
+	// 932 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0xc7, 0xeb, 0xa6, 0x4d, 0x9b, 0xd3, 0xb4, 0x0d, 0xb3, 0x51, 0x9b, 0x56, 0x6c, 0x6a, 0xb2,
+	0x2b, 0x14, 0x8a, 0x36, 0x95, 0xb6, 0x08, 0xc4, 0x15, 0x0a, 0x69, 0x76, 0xd7, 0x12, 0x49, 0x83,
+	0x9d, 0xac, 0xc4, 0xde, 0x98, 0xc9, 0xcc, 0x34, 0x19, 0x35, 0xf1, 0x04, 0xcf, 0x24, 0xa2, 0x5c,
+	0xf0, 0x00, 0xb9, 0xe2, 0x01, 0xc8, 0x03, 0x20, 0xf1, 0x20, 0x5c, 0xf6, 0x92, 0x4b, 0xd4, 0xde,
+	0xf1, 0x14, 0xc8, 0x33, 0x76, 0xea, 0xd0, 0x95, 0x10, 0x77, 0x3e, 0xbf, 0xf3, 0xf7, 0xf1, 0xf9,
+	0x1a, 0x0f, 0xd8, 0x52, 0x85, 0x9c, 0xb2, 0x33, 0x4e, 0xb0, 0x08, 0x31, 0x19, 0xa5, 0x9e, 0x6a,
+	0x93, 0x50, 0x28, 0x81, 0x0a, 0x46, 0x51, 0x5b, 0xf2, 0xe3, 0xe2, 0x40, 0x0c, 0x84, 0x76, 0x9e,
+	0x45, 0x4f, 0x46, 0x57, 0xf9, 0x3d, 0x03, 0xd9, 0x4b, 0x2d, 0x40, 0x47, 0xb0, 0x4d, 0x86, 0x98,
+	0x07, 0x3e, 0xa7, 0x25, 0xcb, 0xb6, 0xaa, 0x39, 0x77, 0x4b, 0xdb, 0x0e, 0x45, 0xcf, 0x60, 0x97,
+	0x88, 0x20, 0x60, 0x44, 0x71, 0xa1, 0xfd, 0xeb, 0xda, 0x9f, 0x7f, 0x80, 0x0e, 0x45, 0x4f, 0x01,
+	0xc8, 0x10, 0x07, 0x01, 0x1b, 0x45, 0x8a, 0x8c, 0x56, 0xe4, 0x62, 0xe2, 0x50, 0x74, 0x08, 0x5b,
+	0x13, 0x11, 0xaa, 0xc8, 0xb7, 0xa1, 0x7d, 0xd9, 0xc8, 0x74, 0x28, 0x3a, 0x81, 0x1d, 0x4e, 0xb0,
+	0x8f, 0x29, 0x0d, 0x99, 0x94, 0xa5, 0x4d, 0xed, 0x04, 0x4e, 0x70, 0xdd, 0x10, 0xf4, 0x09, 0x14,
+	0x88, 0x08, 0x54, 0x88, 0x89, 0x5a, 0xaa, 0xb2, 0x5a, 0xb5, 0x9f, 0xf0, 0x44, 0x7a, 0x00, 0x59,
+	0x4c, 0x14, 0x9f, 0xb1, 0xd2, 0x96, 0x6d, 0x55, 0xb7, 0xdd, 0xd8, 0x42, 0x3d, 0x78, 0x32, 0x63,
+	0x21, 0xbf, 0xe2, 0x04, 0xeb, 0x12, 0xa4, 0xc2, 0x6a, 0x2a, 0x4b, 0xdb, 0xb6, 0x55, 0xdd, 0x7b,
+	0xf9, 0xbc, 0xf6, 0xef, 0x66, 0xd5, 0xde, 0xa6, 0xc4, 0x9e, 0xd6, 0xba, 0x68, 0xf6, 0x88, 0xa1,
+	0xcf, 0xe0, 0x60, 0x84, 0xa5, 0xf2, 0x57, 0x62, 0x2b, 0x3e, 0x66, 0xa5, 0x9c, 0x6d, 0x55, 0x33,
+	0x6e, 0x31, 0xf2, 0xa6, 0x63, 0x75, 0xf9, 0x98, 0xa1, 0x2f, 0xe1, 0x48, 0xbf, 0x35, 0xe6, 0x72,
+	0x8c, 0x15, 0x19, 0x32, 0xea, 0x8f, 0x99, 0x0a, 0x39, 0x89, 0x7a, 0x03, 0xba, 0x30, 0x1d, 0xb6,
+	0xb5, 0xf4, 0xb7, 0xb4, 0xdb, 0xa1, 0x95, 0x5f, 0xd7, 0x21, 0x6b, 0x0c, 0x54, 0x80, 0xcc, 0x35,
+	0xbb, 0x89, 0x27, 0x15, 0x3d, 0xa2, 0x22, 0x6c, 0xce, 0xf0, 0x68, 0xca, 0xe2, 0xe9, 0x18, 0x23,
+	0x6a, 0x6f, 0x1c, 0x5d, 0xdd, 0x4c, 0x58, 0x3c, 0x17, 0x30, 0xa8, 0x7b, 0x33, 0xd1, 0x82, 0xe9,
+	0x84, 0x62, 0xc5, 0x4c, 0xe6, 0x1b, 0x3a, 0x73, 0x30, 0x48, 0xe7, 0xfb, 0x11, 0xe4, 0xfb, 0x23,
+	0x41, 0xae, 0xfd, 0x21, 0xe3, 0x83, 0xa1, 0xd2, 0x13, 0xca, 0xb8, 0x3b, 0x9a, 0xbd, 0xd1, 0x08,
+	0x95, 0x01, 0xb0, 0x52, 0x21, 0xef, 0x4f, 0x15, 0x4b, 0x86, 0x93, 0x22, 0xe8, 0x05, 0x20, 0xca,
+	0xa4, 0xe2, 0x81, 0x69, 0x91, 0xe9, 0xb2, 0x9e, 0x51, 0xce, 0xfd, 0x20, 0xe5, 0x89, 0x57, 0xf1,
+	0x73, 0xc8, 0xae, 0x4c, 0xa8, 0xfc, 0x78, 0x42, 0xa6, 0x0b, 0xf1, 0x6c, 0x62, 0x75, 0xe5, 0xd6,
+	0x82, 0xa2, 0x71, 0x74, 0x42, 0x41, 0xa7, 0x84, 0x85, 0x0d, 0x11, 0x5c, 0xf1, 0x41, 0x54, 0xe3,
+	0x24, 0x26, 0x0f, 0xeb, 0x0d, 0x09, 0x72, 0x68, 0xb4, 0x63, 0x3c, 0x50, 0x2c, 0x9c, 0xe1, 0x91,
+	0x2f, 0x19, 0x11, 0x01, 0x95, 0xba, 0x8d, 0x1b, 0xee, 0x7e, 0xc2, 0x3d, 0x83, 0x51, 0x15, 0x0a,
+	0x26, 0x07, 0x3f, 0x39, 0x2e, 0xb2, 0x94, 0xb1, 0x33, 0xd5, 0x9c, 0xbb, 0x67, 0x78, 0xc3, 0x9c,
+	0x1a, 0x89, 0x4a, 0xb0, 0xc5, 0x02, 0xdc, 0x1f, 0x31, 0xb3, 0xf2, 0xdb, 0x6e, 0x62, 0x46, 0x31,
+	0xf4, 0x0a, 0xa4, 0x1b, 0x6f, 0xda, 0xba, 0x17, 0xf1, 0xde, 0xb2, 0xf9, 0x95, 0xaf, 0xa0, 0xe4,
+	0x32, 0xca, 0xc6, 0x93, 0xa8, 0x3d, 0x2e, 0x56, 0xac, 0xfe, 0xd0, 0xd5, 0x67, 0xb0, 0x2b, 0x95,
+	0x12, 0xd7, 0x2c, 0xf0, 0x29, 0x0b, 0xc4, 0x38, 0xae, 0x2b, 0x1f, 0xc3, 0x8b, 0x88, 0x55, 0xbe,
+	0x87, 0x43, 0x4f, 0x75, 0x23, 0xdb, 0x74, 0xe6, 0x7f, 0xbe, 0x8f, 0x6c, 0xc8, 0x0f, 0x85, 0x54,
+	0xfe, 0x4f, 0x22, 0x60, 0x0f, 0x47, 0x1f, 0x22, 0xf6, 0x4e, 0x04, 0xcc, 0xa1, 0x95, 0x9f, 0xa1,
+	0xa8, 0xe3, 0x77, 0x42, 0x4e, 0xd2, 0xe9, 0x3d, 0x05, 0xe8, 0x63, 0xc9, 0x56, 0x62, 0xe7, 0x22,
+	0x62, 0x02, 0x9f, 0xc0, 0xce, 0x0f, 0x53, 0xa1, 0x12, 0x7f, 0x1c, 0x57, 0x23, 0x23, 0xf8, 0x18,
+	0xf6, 0x85, 0x1c, 0x0b, 0xc9, 0xa5, 0x3f, 0x11, 0x62, 0xf9, 0x57, 0xd9, 0x70, 0x77, 0x63, 0xdc,
+	0x11, 0x62, 0xe4, 0xd0, 0xca, 0xa7, 0xf0, 0xc4, 0x09, 0xae, 0x46, 0x7a, 0x81, 0x52, 0x9f, 0x2f,
+	0xc2, 0x66, 0xfa, 0xcb, 0xc6, 0x38, 0xfd, 0xcd, 0x02, 0xf4, 0xf8, 0x74, 0xa3, 0x73, 0x38, 0x79,
+	0xdb, 0x74, 0x9d, 0x57, 0x4e, 0xa3, 0xde, 0x75, 0x2e, 0xdb, 0xbe, 0xd7, 0xad, 0x77, 0x7b, 0x9e,
+	0xdf, 0x6b, 0x7b, 0x9d, 0x66, 0xc3, 0x79, 0xe5, 0x34, 0x2f, 0x0a, 0x6b, 0xc7, 0x7b, 0xf3, 0x85,
+	0x0d, 0xbd, 0xb6, 0x11, 0x36, 0x2f, 0x50, 0x0d, 0x3e, 0x7c, 0xdf, 0x4b, 0x89, 0xbf, 0x60, 0x1d,
+	0xe7, 0xe7, 0x0b, 0x7b, 0xfb, 0xbf, 0xf4, 0x2d, 0xc7, 0x6b, 0xd5, 0xbb, 0x8d, 0x37, 0x85, 0x75,
+	0xa3, 0x4f, 0xec, 0xd3, 0xbf, 0x2d, 0xc8, 0xa7, 0xf7, 0x1c, 0xd5, 0xe0, 0xa8, 0xd5, 0xec, 0xba,
+	0x4e, 0xe3, 0xfd, 0xf9, 0xed, 0xcf, 0x17, 0xf6, 0x4e, 0x0a, 0xa1, 0xe7, 0x50, 0x5c, 0xd5, 0x7f,
+	0xdb, 0x6b, 0xf6, 0x74, 0x62, 0x30, 0x5f, 0xd8, 0x59, 0x63, 0x3d, 0x8e, 0xea, 0xb4, 0xfd, 0x8e,
+	0x7b, 0xf9, 0xda, 0x6d, 0x7a, 0x5e, 0x61, 0xdd, 0x44, 0x4d, 0x21, 0x74, 0x0a, 0x87, 0xab, 0x7a,
+	0x5d, 0xd4, 0x77, 0x4e, 0xfb, 0x75, 0x21, 0x73, 0xbc, 0x3b, 0x5f, 0xd8, 0xb9, 0x25, 0x40, 0x55,
+	0x38, 0x58, 0xd5, 0x2e, 0x8b, 0xdd, 0x58, 0x2d, 0xf6, 0xeb, 0xd6, 0x1f, 0x77, 0x65, 0xeb, 0xf6,
+	0xae, 0x6c, 0xfd, 0x75, 0x57, 0xb6, 0x7e, 0xb9, 0x2f, 0xaf, 0xdd, 0xde, 0x97, 0xd7, 0xfe, 0xbc,
+	0x2f, 0xaf, 0xbd, 0x3b, 0x1f, 0x70, 0x35, 0x9c, 0xf6, 0x6b, 0x44, 0x8c, 0xcf, 0x3c, 0xfd, 0x1f,
+	0x78, 0xf1, 0x0d, 0xee, 0xcb, 0xb3, 0xf8, 0x12, 0x9c, 0xbd, 0xfc, 0xe2, 0xec, 0xc7, 0xd4, 0x55,
+	0x18, 0xfd, 0xe6, 0x64, 0x3f, 0xab, 0xef, 0xb7, 0xf3, 0x7f, 0x06, 0x00, 0x64, 0x78, 0xdc, 0x54,
+	0x2b, 0x07, 0x00, 0x00,
 }
 
 func (m *Oracle) Marshal() (dAtA []byte, err error) {
@@ -360,6 +699,23 @@ func (m *Oracle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LastMismatchedMetricId) > 0 {
+		i -= len(m.LastMismatchedMetricId)
+		copy(dAtA[i:], m.LastMismatchedMetricId)
+		i = encodeVarintIcaoracle(dAtA, i, uint64(len(m.LastMismatchedMetricId)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.LastVerificationTime != 0 {
+		i = encodeVarintIcaoracle(dAtA, i, uint64(m.LastVerificationTime))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.VerificationStatus != 0 {
+		i = encodeVarintIcaoracle(dAtA, i, uint64(m.VerificationStatus))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.Active {
 		i--
 		if m.Active {
@@ -488,6 +844,65 @@ func (m *Metric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MetricProducerConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetricProducerConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetricProducerConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUpdateTime != 0 {
+		i = encodeVarintIcaoracle(dAtA, i, uint64(m.LastUpdateTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.OracleChainIds) > 0 {
+		for iNdEx := len(m.OracleChainIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OracleChainIds[iNdEx])
+			copy(dAtA[i:], m.OracleChainIds[iNdEx])
+			i = encodeVarintIcaoracle(dAtA, i, uint64(len(m.OracleChainIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.IntervalSeconds != 0 {
+		i = encodeVarintIcaoracle(dAtA, i, uint64(m.IntervalSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProducerId) > 0 {
+		i -= len(m.ProducerId)
+		copy(dAtA[i:], m.ProducerId)
+		i = encodeVarintIcaoracle(dAtA, i, uint64(len(m.ProducerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RedemptionRateAttributes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -518,34 +933,143 @@ func (m *RedemptionRateAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintIcaoracle(dAtA []byte, offset int, v uint64) int {
-	offset -= sovIcaoracle(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *StTokenMetricAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Oracle) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *StTokenMetricAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StTokenMetricAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovIcaoracle(uint64(l))
-	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovIcaoracle(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovIcaoracle(uint64(l))
+	if len(m.HostZoneId) > 0 {
+		i -= len(m.HostZoneId)
+		copy(dAtA[i:], m.HostZoneId)
+		i = encodeVarintIcaoracle(dAtA, i, uint64(len(m.HostZoneId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SttokenDenom) > 0 {
+		i -= len(m.SttokenDenom)
+		copy(dAtA[i:], m.SttokenDenom)
+		i = encodeVarintIcaoracle(dAtA, i, uint64(len(m.SttokenDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenPriceAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenPriceAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenPriceAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OsmosisPoolId != 0 {
+		i = encodeVarintIcaoracle(dAtA, i, uint64(m.OsmosisPoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintIcaoracle(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintIcaoracle(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InflationAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintIcaoracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIcaoracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIcaoracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Oracle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovIcaoracle(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovIcaoracle(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovIcaoracle(uint64(l))
 	}
 	l = len(m.PortId)
 	if l > 0 {
@@ -562,6 +1086,16 @@ func (m *Oracle) Size() (n int) {
 	if m.Active {
 		n += 2
 	}
+	if m.VerificationStatus != 0 {
+		n += 1 + sovIcaoracle(uint64(m.VerificationStatus))
+	}
+	if m.LastVerificationTime != 0 {
+		n += 1 + sovIcaoracle(uint64(m.LastVerificationTime))
+	}
+	l = len(m.LastMismatchedMetricId)
+	if l > 0 {
+		n += 1 + l + sovIcaoracle(uint64(l))
+	}
 	return n
 }
 
@@ -603,6 +1137,34 @@ func (m *Metric) Size() (n int) {
 	return n
 }
 
+func (m *MetricProducerConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProducerId)
+	if l > 0 {
+		n += 1 + l + sovIcaoracle(uint64(l))
+	}
+	if m.IntervalSeconds != 0 {
+		n += 1 + sovIcaoracle(uint64(m.IntervalSeconds))
+	}
+	if len(m.OracleChainIds) > 0 {
+		for _, s := range m.OracleChainIds {
+			l = len(s)
+			n += 1 + l + sovIcaoracle(uint64(l))
+		}
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.LastUpdateTime != 0 {
+		n += 1 + sovIcaoracle(uint64(m.LastUpdateTime))
+	}
+	return n
+}
+
 func (m *RedemptionRateAttributes) Size() (n int) {
 	if m == nil {
 		return 0
@@ -616,6 +1178,56 @@ func (m *RedemptionRateAttributes) Size() (n int) {
 	return n
 }
 
+func (m *StTokenMetricAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SttokenDenom)
+	if l > 0 {
+		n += 1 + l + sovIcaoracle(uint64(l))
+	}
+	l = len(m.HostZoneId)
+	if l > 0 {
+		n += 1 + l + sovIcaoracle(uint64(l))
+	}
+	return n
+}
+
+func (m *TokenPriceAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovIcaoracle(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovIcaoracle(uint64(l))
+	}
+	if m.OsmosisPoolId != 0 {
+		n += 1 + sovIcaoracle(uint64(m.OsmosisPoolId))
+	}
+	return n
+}
+
+func (m *InflationAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovIcaoracle(uint64(l))
+	}
+	return n
+}
+
 func sovIcaoracle(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -863,6 +1475,76 @@ func (m *Oracle) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Active = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationStatus", wireType)
+			}
+			m.VerificationStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaoracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerificationStatus |= VerificationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastVerificationTime", wireType)
+			}
+			m.LastVerificationTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaoracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastVerificationTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastMismatchedMetricId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaoracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaoracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaoracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastMismatchedMetricId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIcaoracle(dAtA[iNdEx:])
@@ -1151,7 +1833,7 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedemptionRateAttributes) Unmarshal(dAtA []byte) error {
+func (m *MetricProducerConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1174,15 +1856,15 @@ func (m *RedemptionRateAttributes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedemptionRateAttributes: wiretype end group for non-group")
+			return fmt.Errorf("proto: MetricProducerConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedemptionRateAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MetricProducerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SttokenDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1210,7 +1892,508 @@ func (m *RedemptionRateAttributes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SttokenDenom = string(dAtA[iNdEx:postIndex])
+			m.ProducerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalSeconds", wireType)
+			}
+			m.IntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaoracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleChainIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaoracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaoracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaoracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleChainIds = append(m.OracleChainIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaoracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			}
+			m.LastUpdateTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaoracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdateTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcaoracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcaoracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedemptionRateAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcaoracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedemptionRateAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedemptionRateAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SttokenDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaoracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaoracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaoracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SttokenDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcaoracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcaoracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StTokenMetricAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcaoracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StTokenMetricAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StTokenMetricAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SttokenDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaoracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaoracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaoracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SttokenDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaoracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaoracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaoracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcaoracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcaoracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenPriceAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcaoracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenPriceAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenPriceAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaoracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaoracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaoracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaoracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaoracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaoracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmosisPoolId", wireType)
+			}
+			m.OsmosisPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaoracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OsmosisPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcaoracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcaoracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcaoracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaoracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaoracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaoracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
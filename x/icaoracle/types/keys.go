@@ -22,4 +22,6 @@ var (
 	OracleKeyPrefix      = KeyPrefix("oracle")
 	MetricKeyPrefix      = KeyPrefix("metric")
 	MetricQueueKeyPrefix = KeyPrefix("queue")
+
+	MetricProducerKeyPrefix = KeyPrefix("producer")
 )
@@ -0,0 +1,45 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+const TypeMsgRemoveMetricProducer = "remove_metric_producer"
+
+var (
+	_ sdk.Msg            = &MsgRemoveMetricProducer{}
+	_ legacytx.LegacyMsg = &MsgRemoveMetricProducer{}
+)
+
+func (msg MsgRemoveMetricProducer) Type() string {
+	return TypeMsgRemoveMetricProducer
+}
+
+func (msg MsgRemoveMetricProducer) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveMetricProducer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemoveMetricProducer) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgRemoveMetricProducer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if msg.ProducerId == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "producer-id is required")
+	}
+
+	return nil
+}
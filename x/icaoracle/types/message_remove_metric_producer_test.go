@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v27/app/apptesting"
+	"github.com/Stride-Labs/stride/v27/x/icaoracle/types"
+)
+
+func TestMsgRemoveMetricProducer(t *testing.T) {
+	apptesting.SetupConfig()
+
+	validProducerId := "producer"
+
+	tests := []struct {
+		name string
+		msg  types.MsgRemoveMetricProducer
+		err  string
+	}{
+		{
+			name: "successful message",
+			msg: types.MsgRemoveMetricProducer{
+				Authority:  authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				ProducerId: validProducerId,
+			},
+		},
+		{
+			name: "empty producer id",
+			msg: types.MsgRemoveMetricProducer{
+				Authority:  authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				ProducerId: "",
+			},
+			err: "producer-id is required",
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgRemoveMetricProducer{
+				Authority:  "invalid",
+				ProducerId: validProducerId,
+			},
+			err: "invalid authority address",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.ProducerId, validProducerId, "producer-id")
+				require.Equal(t, test.msg.Type(), "remove_metric_producer", "type")
+			} else {
+				require.ErrorContains(t, test.msg.ValidateBasic(), test.err, "test: %v", test.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+const TypeMsgSetMetricProducer = "set_metric_producer"
+
+var (
+	_ sdk.Msg            = &MsgSetMetricProducer{}
+	_ legacytx.LegacyMsg = &MsgSetMetricProducer{}
+)
+
+func (msg MsgSetMetricProducer) Type() string {
+	return TypeMsgSetMetricProducer
+}
+
+func (msg MsgSetMetricProducer) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetMetricProducer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetMetricProducer) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgSetMetricProducer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	config := MetricProducerConfig{
+		ProducerId:      msg.ProducerId,
+		IntervalSeconds: msg.IntervalSeconds,
+		OracleChainIds:  msg.OracleChainIds,
+		Enabled:         msg.Enabled,
+	}
+	return config.ValidateBasic()
+}
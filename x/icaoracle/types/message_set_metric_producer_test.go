@@ -0,0 +1,74 @@
+package types_test
+
+import (
+	"testing"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v27/app/apptesting"
+	"github.com/Stride-Labs/stride/v27/x/icaoracle/types"
+)
+
+func TestMsgSetMetricProducer(t *testing.T) {
+	apptesting.SetupConfig()
+
+	validAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	validProducerId := "producer"
+
+	tests := []struct {
+		name string
+		msg  types.MsgSetMetricProducer
+		err  string
+	}{
+		{
+			name: "successful message",
+			msg: types.MsgSetMetricProducer{
+				Authority:       validAuthority,
+				ProducerId:      validProducerId,
+				IntervalSeconds: 60,
+				OracleChainIds:  []string{"chain-1"},
+				Enabled:         true,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgSetMetricProducer{
+				Authority:       "invalid",
+				ProducerId:      validProducerId,
+				IntervalSeconds: 60,
+			},
+			err: "invalid authority address",
+		},
+		{
+			name: "empty producer id",
+			msg: types.MsgSetMetricProducer{
+				Authority:       validAuthority,
+				ProducerId:      "",
+				IntervalSeconds: 60,
+			},
+			err: "producer-id is required",
+		},
+		{
+			name: "zero interval",
+			msg: types.MsgSetMetricProducer{
+				Authority:       validAuthority,
+				ProducerId:      validProducerId,
+				IntervalSeconds: 0,
+			},
+			err: "interval-seconds must be greater than 0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Type(), "set_metric_producer", "type")
+			} else {
+				require.ErrorContains(t, test.msg.ValidateBasic(), test.err, "test: %v", test.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// A metric computed by a metric producer, that will be queued to each of the
+// producer's target oracles
+type MetricUpdate struct {
+	Key        string
+	Value      string
+	MetricType string
+	Attributes string
+}
+
+// Function registered by other modules that computes the latest value of each
+// of the metrics they'd like to push to the oracles
+type MetricProducer func(ctx sdk.Context) ([]MetricUpdate, error)
+
+// Metric producers exposed by a module, keyed by producer ID
+type ModuleMetricProducers map[string]MetricProducer
+
+// Validates the fields of a metric producer config that are set by governance
+func (c MetricProducerConfig) ValidateBasic() error {
+	if c.ProducerId == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "producer-id is required")
+	}
+	if c.IntervalSeconds == 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "interval-seconds must be greater than 0")
+	}
+
+	oracleChainIds := map[string]bool{}
+	for _, chainId := range c.OracleChainIds {
+		if chainId == "" {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "oracle chain-id cannot be empty")
+		}
+		if oracleChainIds[chainId] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate oracle chain-id %s", chainId)
+		}
+		oracleChainIds[chainId] = true
+	}
+
+	return nil
+}
+
+// Returns true if the producer is enabled and at least one interval has
+// passed since the last time it ran
+func (c MetricProducerConfig) IsDue(blockTime int64) bool {
+	if !c.Enabled {
+		return false
+	}
+	if c.LastUpdateTime == 0 {
+		return true
+	}
+	return blockTime >= c.LastUpdateTime+int64(c.IntervalSeconds)
+}
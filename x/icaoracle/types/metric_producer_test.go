@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v27/x/icaoracle/types"
+)
+
+func TestMetricProducerConfigValidateBasic(t *testing.T) {
+	tests := []struct {
+		name   string
+		config types.MetricProducerConfig
+		err    string
+	}{
+		{
+			name:   "valid config without oracles",
+			config: types.MetricProducerConfig{ProducerId: "producer", IntervalSeconds: 60},
+		},
+		{
+			name:   "valid config with oracles",
+			config: types.MetricProducerConfig{ProducerId: "producer", IntervalSeconds: 60, OracleChainIds: []string{"chain-1", "chain-2"}},
+		},
+		{
+			name:   "missing producer id",
+			config: types.MetricProducerConfig{ProducerId: "", IntervalSeconds: 60},
+			err:    "producer-id is required",
+		},
+		{
+			name:   "zero interval",
+			config: types.MetricProducerConfig{ProducerId: "producer", IntervalSeconds: 0},
+			err:    "interval-seconds must be greater than 0",
+		},
+		{
+			name:   "empty oracle chain id",
+			config: types.MetricProducerConfig{ProducerId: "producer", IntervalSeconds: 60, OracleChainIds: []string{""}},
+			err:    "oracle chain-id cannot be empty",
+		},
+		{
+			name:   "duplicate oracle chain id",
+			config: types.MetricProducerConfig{ProducerId: "producer", IntervalSeconds: 60, OracleChainIds: []string{"chain-1", "chain-1"}},
+			err:    "duplicate oracle chain-id chain-1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.err == "" {
+				require.NoError(t, tc.config.ValidateBasic(), "test: %v", tc.name)
+			} else {
+				require.ErrorContains(t, tc.config.ValidateBasic(), tc.err, "test: %v", tc.name)
+			}
+		})
+	}
+}
+
+func TestMetricProducerConfigIsDue(t *testing.T) {
+	config := types.MetricProducerConfig{IntervalSeconds: 60, Enabled: true}
+
+	// Never ran
+	require.True(t, config.IsDue(100), "due when never ran")
+
+	// Before and after the interval
+	config.LastUpdateTime = 100
+	require.False(t, config.IsDue(159), "not due before interval")
+	require.True(t, config.IsDue(160), "due at interval")
+
+	// Disabled
+	config.Enabled = false
+	require.False(t, config.IsDue(200), "not due when disabled")
+}
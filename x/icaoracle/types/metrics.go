@@ -2,4 +2,10 @@ package types
 
 var (
 	MetricType_RedemptionRate = "redemption_rate"
+	MetricType_TVL            = "tvl"
+	MetricType_StTokenSupply  = "sttoken_supply"
+	MetricType_UnbondingQueue = "unbonding_queue"
+	MetricType_StakingAPR     = "staking_apr"
+	MetricType_TokenPrice     = "token_price"
+	MetricType_Inflation      = "inflation"
 )
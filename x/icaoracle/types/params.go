@@ -1,32 +1,76 @@
 package types
 
 import (
+	"errors"
+	"fmt"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+// Parameter store keys
+var (
+	KeyStrdInflationMetricEnabled  = []byte("StrdInflationMetricEnabled")
+	KeyStrdInflationOracleChainIds = []byte("StrdInflationOracleChainIds")
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(strdInflationMetricEnabled bool, strdInflationOracleChainIds []string) Params {
+	return Params{
+		StrdInflationMetricEnabled:  strdInflationMetricEnabled,
+		StrdInflationOracleChainIds: strdInflationOracleChainIds,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(false, []string{})
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyStrdInflationMetricEnabled, &p.StrdInflationMetricEnabled, validateBool),
+		paramtypes.NewParamSetPair(KeyStrdInflationOracleChainIds, &p.StrdInflationOracleChainIds, validateOracleChainIds),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateBool(p.StrdInflationMetricEnabled); err != nil {
+		return err
+	}
+	return validateOracleChainIds(p.StrdInflationOracleChainIds)
+}
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateOracleChainIds(i interface{}) error {
+	chainIds, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	uniqueChainIds := map[string]bool{}
+	for _, chainId := range chainIds {
+		if chainId == "" {
+			return errors.New("oracle chain-id cannot be empty")
+		}
+		if uniqueChainIds[chainId] {
+			return fmt.Errorf("duplicate oracle chain-id %s", chainId)
+		}
+		uniqueChainIds[chainId] = true
+	}
 	return nil
 }
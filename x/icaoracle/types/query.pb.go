@@ -385,6 +385,95 @@ func (m *QueryMetricsResponse) GetMetrics() []Metric {
 	return nil
 }
 
+// Query's all metric producer configurations
+type QueryMetricProducersRequest struct {
+}
+
+func (m *QueryMetricProducersRequest) Reset()         { *m = QueryMetricProducersRequest{} }
+func (m *QueryMetricProducersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMetricProducersRequest) ProtoMessage()    {}
+func (*QueryMetricProducersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4d4563f64cd9510, []int{8}
+}
+func (m *QueryMetricProducersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMetricProducersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMetricProducersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMetricProducersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMetricProducersRequest.Merge(m, src)
+}
+func (m *QueryMetricProducersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMetricProducersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMetricProducersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMetricProducersRequest proto.InternalMessageInfo
+
+type QueryMetricProducersResponse struct {
+	MetricProducers       []MetricProducerConfig `protobuf:"bytes,1,rep,name=metric_producers,json=metricProducers,proto3" json:"metric_producers"`
+	RegisteredProducerIds []string               `protobuf:"bytes,2,rep,name=registered_producer_ids,json=registeredProducerIds,proto3" json:"registered_producer_ids,omitempty"`
+}
+
+func (m *QueryMetricProducersResponse) Reset()         { *m = QueryMetricProducersResponse{} }
+func (m *QueryMetricProducersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMetricProducersResponse) ProtoMessage()    {}
+func (*QueryMetricProducersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4d4563f64cd9510, []int{9}
+}
+func (m *QueryMetricProducersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMetricProducersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMetricProducersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMetricProducersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMetricProducersResponse.Merge(m, src)
+}
+func (m *QueryMetricProducersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMetricProducersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMetricProducersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMetricProducersResponse proto.InternalMessageInfo
+
+func (m *QueryMetricProducersResponse) GetMetricProducers() []MetricProducerConfig {
+	if m != nil {
+		return m.MetricProducers
+	}
+	return nil
+}
+
+func (m *QueryMetricProducersResponse) GetRegisteredProducerIds() []string {
+	if m != nil {
+		return m.RegisteredProducerIds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryOracleRequest)(nil), "stride.icaoracle.QueryOracleRequest")
 	proto.RegisterType((*QueryOracleResponse)(nil), "stride.icaoracle.QueryOracleResponse")
@@ -394,45 +483,53 @@ func init() {
 	proto.RegisterType((*QueryActiveOraclesResponse)(nil), "stride.icaoracle.QueryActiveOraclesResponse")
 	proto.RegisterType((*QueryMetricsRequest)(nil), "stride.icaoracle.QueryMetricsRequest")
 	proto.RegisterType((*QueryMetricsResponse)(nil), "stride.icaoracle.QueryMetricsResponse")
+	proto.RegisterType((*QueryMetricProducersRequest)(nil), "stride.icaoracle.QueryMetricProducersRequest")
+	proto.RegisterType((*QueryMetricProducersResponse)(nil), "stride.icaoracle.QueryMetricProducersResponse")
 }
 
 func init() { proto.RegisterFile("stride/icaoracle/query.proto", fileDescriptor_d4d4563f64cd9510) }
 
 var fileDescriptor_d4d4563f64cd9510 = []byte{
-	// 518 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0xd4, 0x9a, 0xb4, 0x4f, 0x8a, 0xf2, 0x2c, 0x35, 0x5d, 0xea, 0x1a, 0x16, 0x5b, 0x2b,
-	0xda, 0x1d, 0x4d, 0x0e, 0xf5, 0x6a, 0x3d, 0x88, 0x68, 0x51, 0x53, 0xf0, 0x20, 0x42, 0xd8, 0x6c,
-	0x86, 0xed, 0x60, 0xba, 0x93, 0xee, 0x4c, 0x8a, 0x8b, 0x78, 0xf1, 0xe2, 0x4d, 0x0a, 0xfe, 0x04,
-	0xff, 0x4c, 0x8f, 0x05, 0x2f, 0x82, 0x20, 0x92, 0xf8, 0x43, 0xa4, 0x33, 0x93, 0xd4, 0x75, 0x93,
-	0x26, 0xea, 0x29, 0x3b, 0xef, 0x7d, 0xef, 0xfb, 0xbe, 0x79, 0xf3, 0x11, 0x58, 0x91, 0x2a, 0xe1,
-	0x2d, 0x46, 0x79, 0x18, 0x88, 0x24, 0x08, 0xdb, 0x8c, 0xee, 0x77, 0x59, 0x92, 0xfa, 0x9d, 0x44,
-	0x28, 0x81, 0x97, 0x4c, 0xd7, 0x1f, 0x76, 0x9d, 0xc5, 0x48, 0x44, 0x42, 0x37, 0xe9, 0xc9, 0x97,
-	0xc1, 0x39, 0x2b, 0x91, 0x10, 0x51, 0x9b, 0xd1, 0xa0, 0xc3, 0x69, 0x10, 0xc7, 0x42, 0x05, 0x8a,
-	0x8b, 0x58, 0xda, 0x6e, 0x25, 0xa7, 0x31, 0xfc, 0x32, 0x08, 0x8f, 0x02, 0x3e, 0x3f, 0x91, 0x7d,
-	0xaa, 0x8b, 0x75, 0xb6, 0xdf, 0x65, 0x52, 0xe1, 0x32, 0xcc, 0x85, 0xbb, 0x01, 0x8f, 0x1b, 0xbc,
-	0x55, 0x26, 0x15, 0xb2, 0x3e, 0x5f, 0x2f, 0xe9, 0xf3, 0xa3, 0x96, 0xf7, 0x10, 0x2e, 0x67, 0x06,
-	0x64, 0x47, 0xc4, 0x92, 0xe1, 0x1d, 0x28, 0x1a, 0x5e, 0x8d, 0xbf, 0x50, 0x2d, 0xfb, 0x7f, 0x5e,
-	0xc0, 0xb7, 0x13, 0x16, 0xe7, 0x95, 0x61, 0x49, 0x13, 0xdd, 0x6f, 0xb7, 0x4d, 0x47, 0x5a, 0x75,
-	0x6f, 0x07, 0xae, 0xe4, 0x3a, 0x56, 0xe6, 0x1e, 0x94, 0xcc, 0xb8, 0x2c, 0x93, 0xca, 0xb9, 0xb3,
-	0x74, 0xb6, 0x66, 0x8f, 0xbe, 0x5f, 0x2b, 0xd4, 0x07, 0x70, 0xaf, 0x06, 0xcb, 0x86, 0x34, 0x54,
-	0xfc, 0x80, 0x65, 0x15, 0x71, 0x09, 0x8a, 0x81, 0xae, 0x6b, 0xf7, 0x73, 0x75, 0x7b, 0xf2, 0x5e,
-	0x80, 0x33, 0x6a, 0xe8, 0xbf, 0xcd, 0xbc, 0xb2, 0x4b, 0xdc, 0x66, 0x2a, 0xe1, 0xe1, 0xd0, 0xc6,
-	0x55, 0x80, 0x3d, 0x5d, 0x69, 0xbc, 0x66, 0xa9, 0x5d, 0xfc, 0xbc, 0xa9, 0x3c, 0x66, 0x29, 0xae,
-	0xc1, 0x45, 0x43, 0xd0, 0x18, 0x3e, 0xce, 0x8c, 0xc6, 0x2c, 0x98, 0xf2, 0x03, 0xfb, 0x44, 0xcf,
-	0x60, 0x31, 0xcb, 0x7e, 0xea, 0xd7, 0x90, 0x9d, 0xe1, 0xd7, 0xcc, 0x0c, 0xfc, 0x5a, 0x78, 0xf5,
-	0xdb, 0x2c, 0x9c, 0xd7, 0x94, 0xf8, 0x91, 0x40, 0xd1, 0xdc, 0x09, 0xaf, 0xe7, 0xa7, 0xf3, 0x51,
-	0x72, 0x56, 0x27, 0xa0, 0x8c, 0x37, 0x6f, 0xf3, 0xfd, 0x97, 0x9f, 0x9f, 0x66, 0xee, 0x22, 0xa5,
-	0x3b, 0x1a, 0xbe, 0xf1, 0x24, 0x68, 0x4a, 0x9a, 0x8b, 0xaf, 0xfd, 0x79, 0x3b, 0x58, 0xc0, 0x3b,
-	0x3c, 0x24, 0x00, 0xa7, 0x41, 0xc1, 0xf5, 0x31, 0x72, 0xb9, 0x94, 0x39, 0x37, 0xa7, 0x40, 0x5a,
-	0x73, 0x1b, 0xda, 0xdc, 0x0d, 0x5c, 0x9d, 0xc6, 0x9c, 0xc4, 0xcf, 0x04, 0x16, 0x32, 0x89, 0xc1,
-	0x5b, 0xe3, 0xb4, 0x46, 0x84, 0xd1, 0xb9, 0x3d, 0x1d, 0xf8, 0x5f, 0x16, 0x27, 0x69, 0x33, 0x6d,
-	0x98, 0x6c, 0xe3, 0x07, 0x02, 0x25, 0x9b, 0x10, 0x1c, 0xf7, 0x48, 0xd9, 0x7c, 0x3a, 0x6b, 0x93,
-	0x60, 0x7f, 0xb7, 0x2f, 0x9b, 0xae, 0xad, 0xed, 0xa3, 0x9e, 0x4b, 0x8e, 0x7b, 0x2e, 0xf9, 0xd1,
-	0x73, 0xc9, 0x61, 0xdf, 0x2d, 0x1c, 0xf7, 0xdd, 0xc2, 0xd7, 0xbe, 0x5b, 0x78, 0x59, 0x8b, 0xb8,
-	0xda, 0xed, 0x36, 0xfd, 0x50, 0xec, 0x8d, 0xa2, 0x3a, 0xa8, 0x6e, 0xd2, 0x37, 0xbf, 0x11, 0xaa,
-	0xb4, 0xc3, 0x64, 0xb3, 0xa8, 0xff, 0xd9, 0x6a, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xd7, 0xe2,
-	0x0b, 0x3a, 0x61, 0x05, 0x00, 0x00,
+	// 622 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x41, 0x4f, 0x13, 0x41,
+	0x14, 0xee, 0x80, 0x16, 0x78, 0x86, 0x40, 0x46, 0x84, 0xb2, 0xc2, 0xda, 0x6c, 0x04, 0x31, 0xda,
+	0x5d, 0x6c, 0x13, 0xf0, 0x2a, 0x1c, 0x0c, 0x51, 0x22, 0x96, 0x44, 0x13, 0x63, 0xd2, 0x6c, 0x77,
+	0xc7, 0x65, 0x62, 0xbb, 0x53, 0x76, 0xb6, 0xc4, 0xc6, 0x78, 0xf1, 0xa0, 0x37, 0x43, 0xe2, 0x4f,
+	0xf0, 0xe2, 0xcd, 0xbf, 0xc1, 0x91, 0xc4, 0x8b, 0x27, 0x63, 0x5a, 0x7f, 0x88, 0x61, 0x66, 0x76,
+	0xcb, 0x76, 0xdb, 0x52, 0xf5, 0xd4, 0xdd, 0xf7, 0xbe, 0xf7, 0x7d, 0xdf, 0xcc, 0xdb, 0x2f, 0x85,
+	0x25, 0x1e, 0x06, 0xd4, 0x25, 0x16, 0x75, 0x6c, 0x16, 0xd8, 0x4e, 0x8d, 0x58, 0x87, 0x4d, 0x12,
+	0xb4, 0xcc, 0x46, 0xc0, 0x42, 0x86, 0x67, 0x65, 0xd7, 0x8c, 0xbb, 0xda, 0x9c, 0xc7, 0x3c, 0x26,
+	0x9a, 0xd6, 0xd9, 0x93, 0xc4, 0x69, 0x4b, 0x1e, 0x63, 0x5e, 0x8d, 0x58, 0x76, 0x83, 0x5a, 0xb6,
+	0xef, 0xb3, 0xd0, 0x0e, 0x29, 0xf3, 0xb9, 0xea, 0xe6, 0x53, 0x1a, 0xf1, 0x93, 0x44, 0x18, 0x16,
+	0xe0, 0xa7, 0x67, 0xb2, 0x4f, 0x44, 0xb1, 0x4c, 0x0e, 0x9b, 0x84, 0x87, 0x78, 0x11, 0x26, 0x9d,
+	0x03, 0x9b, 0xfa, 0x15, 0xea, 0xe6, 0x50, 0x1e, 0xad, 0x4d, 0x95, 0x27, 0xc4, 0xfb, 0x8e, 0x6b,
+	0x3c, 0x84, 0xab, 0x89, 0x01, 0xde, 0x60, 0x3e, 0x27, 0x78, 0x1d, 0xb2, 0x92, 0x57, 0xe0, 0xaf,
+	0x14, 0x73, 0x66, 0xef, 0x01, 0x4c, 0x35, 0xa1, 0x70, 0x46, 0x0e, 0xe6, 0x05, 0xd1, 0x83, 0x5a,
+	0x4d, 0x76, 0xb8, 0x52, 0x37, 0xf6, 0x61, 0x21, 0xd5, 0x51, 0x32, 0xf7, 0x61, 0x42, 0x8e, 0xf3,
+	0x1c, 0xca, 0x8f, 0x0f, 0xd3, 0xd9, 0xba, 0x74, 0xf2, 0xf3, 0x46, 0xa6, 0x1c, 0xc1, 0x8d, 0x12,
+	0x2c, 0x4a, 0x52, 0x27, 0xa4, 0x47, 0x24, 0xa9, 0x88, 0xe7, 0x21, 0x6b, 0x8b, 0xba, 0x70, 0x3f,
+	0x59, 0x56, 0x6f, 0xc6, 0x33, 0xd0, 0xfa, 0x0d, 0xfd, 0xb7, 0x99, 0x97, 0xea, 0x12, 0x77, 0x49,
+	0x18, 0x50, 0x27, 0xb6, 0xb1, 0x0c, 0x50, 0x17, 0x95, 0xca, 0x6b, 0xd2, 0x52, 0x17, 0x3f, 0x25,
+	0x2b, 0x8f, 0x48, 0x0b, 0xaf, 0xc2, 0x8c, 0x24, 0xa8, 0xc4, 0xcb, 0x19, 0x13, 0x98, 0x69, 0x59,
+	0xde, 0x56, 0x2b, 0xda, 0x83, 0xb9, 0x24, 0x7b, 0xd7, 0xaf, 0x24, 0x1b, 0xe2, 0x57, 0xce, 0x44,
+	0x7e, 0x15, 0xdc, 0x58, 0x86, 0xeb, 0xe7, 0x18, 0xf7, 0x02, 0xe6, 0x36, 0x1d, 0x12, 0xc4, 0x0b,
+	0xfb, 0x86, 0x60, 0xa9, 0x7f, 0x5f, 0x29, 0x3f, 0x87, 0x59, 0x75, 0xb0, 0x46, 0xd4, 0x53, 0x16,
+	0x56, 0x07, 0x59, 0x88, 0x48, 0xb6, 0x99, 0xff, 0x8a, 0x7a, 0xca, 0xd0, 0x4c, 0x3d, 0x29, 0x80,
+	0x37, 0x60, 0x21, 0x20, 0x1e, 0xe5, 0x21, 0x09, 0x88, 0x1b, 0x93, 0x57, 0xa8, 0xcb, 0x73, 0x63,
+	0xf9, 0xf1, 0xb5, 0xa9, 0xf2, 0xb5, 0x6e, 0x3b, 0x9a, 0xda, 0x71, 0x79, 0xf1, 0x43, 0x16, 0x2e,
+	0x0b, 0xc7, 0xf8, 0x13, 0x82, 0xac, 0x5c, 0x12, 0xbe, 0x99, 0xf6, 0x92, 0xce, 0x86, 0xb6, 0x72,
+	0x01, 0x4a, 0x1e, 0xd9, 0xd8, 0x7c, 0xff, 0xfd, 0xf7, 0xe7, 0xb1, 0x7b, 0xd8, 0xb2, 0xf6, 0x05,
+	0xbc, 0xf0, 0xd8, 0xae, 0x72, 0x2b, 0x95, 0x47, 0xf5, 0xf3, 0x36, 0xda, 0xe8, 0x3b, 0x7c, 0x8c,
+	0x00, 0xba, 0x5f, 0x3e, 0x5e, 0x1b, 0x20, 0x97, 0x8a, 0x8d, 0x76, 0x7b, 0x04, 0xa4, 0x32, 0x57,
+	0x10, 0xe6, 0x6e, 0xe1, 0x95, 0x51, 0xcc, 0x71, 0xfc, 0x05, 0xc1, 0x74, 0x22, 0x02, 0xf8, 0xce,
+	0x20, 0xad, 0x3e, 0xe9, 0xd2, 0xee, 0x8e, 0x06, 0xfe, 0x97, 0x8b, 0xe3, 0x56, 0xb5, 0x55, 0x91,
+	0x61, 0xc5, 0x1f, 0x11, 0x4c, 0xa8, 0x4f, 0x1e, 0x0f, 0x5a, 0x52, 0x32, 0x70, 0xda, 0xea, 0x45,
+	0xb0, 0xbf, 0xbb, 0x2f, 0x15, 0x17, 0xfc, 0x15, 0xc1, 0x4c, 0x4f, 0x14, 0x70, 0x61, 0xa8, 0x54,
+	0x6f, 0xa4, 0x34, 0x73, 0x54, 0xb8, 0x72, 0xb8, 0x21, 0x1c, 0xae, 0x63, 0x73, 0x14, 0x87, 0xdd,
+	0x14, 0x6e, 0xed, 0x9e, 0xb4, 0x75, 0x74, 0xda, 0xd6, 0xd1, 0xaf, 0xb6, 0x8e, 0x8e, 0x3b, 0x7a,
+	0xe6, 0xb4, 0xa3, 0x67, 0x7e, 0x74, 0xf4, 0xcc, 0x8b, 0x92, 0x47, 0xc3, 0x83, 0x66, 0xd5, 0x74,
+	0x58, 0xbd, 0x1f, 0xe7, 0x51, 0x71, 0xd3, 0x7a, 0x73, 0x8e, 0x39, 0x6c, 0x35, 0x08, 0xaf, 0x66,
+	0xc5, 0xbf, 0x4a, 0xe9, 0xcf, 0x00, 0xb0, 0x78, 0xe9, 0xf8, 0xdd, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -462,6 +559,9 @@ type QueryClient interface {
 	// - /metrics?metric_key=X
 	// - /metrics?oracle_chain_id=Y
 	Metrics(ctx context.Context, in *QueryMetricsRequest, opts ...grpc.CallOption) (*QueryMetricsResponse, error)
+	// Query the configured metric producers, along with the IDs of the
+	// producers registered by each module
+	MetricProducers(ctx context.Context, in *QueryMetricProducersRequest, opts ...grpc.CallOption) (*QueryMetricProducersResponse, error)
 }
 
 type queryClient struct {
@@ -508,6 +608,15 @@ func (c *queryClient) Metrics(ctx context.Context, in *QueryMetricsRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) MetricProducers(ctx context.Context, in *QueryMetricProducersRequest, opts ...grpc.CallOption) (*QueryMetricProducersResponse, error) {
+	out := new(QueryMetricProducersResponse)
+	err := c.cc.Invoke(ctx, "/stride.icaoracle.Query/MetricProducers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Query a specific oracle
@@ -525,6 +634,9 @@ type QueryServer interface {
 	// - /metrics?metric_key=X
 	// - /metrics?oracle_chain_id=Y
 	Metrics(context.Context, *QueryMetricsRequest) (*QueryMetricsResponse, error)
+	// Query the configured metric producers, along with the IDs of the
+	// producers registered by each module
+	MetricProducers(context.Context, *QueryMetricProducersRequest) (*QueryMetricProducersResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -543,6 +655,9 @@ func (*UnimplementedQueryServer) ActiveOracles(ctx context.Context, req *QueryAc
 func (*UnimplementedQueryServer) Metrics(ctx context.Context, req *QueryMetricsRequest) (*QueryMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Metrics not implemented")
 }
+func (*UnimplementedQueryServer) MetricProducers(ctx context.Context, req *QueryMetricProducersRequest) (*QueryMetricProducersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MetricProducers not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -620,6 +735,24 @@ func _Query_Metrics_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MetricProducers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMetricProducersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MetricProducers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.icaoracle.Query/MetricProducers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MetricProducers(ctx, req.(*QueryMetricProducersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.icaoracle.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -640,6 +773,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Metrics",
 			Handler:    _Query_Metrics_Handler,
 		},
+		{
+			MethodName: "MetricProducers",
+			Handler:    _Query_MetricProducers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stride/icaoracle/query.proto",
@@ -914,6 +1051,75 @@ func (m *QueryMetricsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryMetricProducersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMetricProducersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMetricProducersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMetricProducersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMetricProducersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMetricProducersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RegisteredProducerIds) > 0 {
+		for iNdEx := len(m.RegisteredProducerIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RegisteredProducerIds[iNdEx])
+			copy(dAtA[i:], m.RegisteredProducerIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.RegisteredProducerIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MetricProducers) > 0 {
+		for iNdEx := len(m.MetricProducers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MetricProducers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1034,6 +1240,36 @@ func (m *QueryMetricsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryMetricProducersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMetricProducersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MetricProducers) > 0 {
+		for _, e := range m.MetricProducers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RegisteredProducerIds) > 0 {
+		for _, s := range m.RegisteredProducerIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1694,6 +1930,172 @@ func (m *QueryMetricsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryMetricProducersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMetricProducersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMetricProducersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMetricProducersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMetricProducersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMetricProducersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricProducers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetricProducers = append(m.MetricProducers, MetricProducerConfig{})
+			if err := m.MetricProducers[len(m.MetricProducers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredProducerIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredProducerIds = append(m.RegisteredProducerIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -503,6 +503,212 @@ func (m *MsgRemoveOracleResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveOracleResponse proto.InternalMessageInfo
 
+// Adds or updates the configuration of a metric producer
+type MsgSetMetricProducer struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority       string   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ProducerId      string   `protobuf:"bytes,2,opt,name=producer_id,json=producerId,proto3" json:"producer_id,omitempty"`
+	IntervalSeconds uint64   `protobuf:"varint,3,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	OracleChainIds  []string `protobuf:"bytes,4,rep,name=oracle_chain_ids,json=oracleChainIds,proto3" json:"oracle_chain_ids,omitempty"`
+	Enabled         bool     `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetMetricProducer) Reset()         { *m = MsgSetMetricProducer{} }
+func (m *MsgSetMetricProducer) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMetricProducer) ProtoMessage()    {}
+func (*MsgSetMetricProducer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e58a377bb8520d3, []int{10}
+}
+func (m *MsgSetMetricProducer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMetricProducer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMetricProducer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMetricProducer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMetricProducer.Merge(m, src)
+}
+func (m *MsgSetMetricProducer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMetricProducer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMetricProducer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMetricProducer proto.InternalMessageInfo
+
+func (m *MsgSetMetricProducer) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetMetricProducer) GetProducerId() string {
+	if m != nil {
+		return m.ProducerId
+	}
+	return ""
+}
+
+func (m *MsgSetMetricProducer) GetIntervalSeconds() uint64 {
+	if m != nil {
+		return m.IntervalSeconds
+	}
+	return 0
+}
+
+func (m *MsgSetMetricProducer) GetOracleChainIds() []string {
+	if m != nil {
+		return m.OracleChainIds
+	}
+	return nil
+}
+
+func (m *MsgSetMetricProducer) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type MsgSetMetricProducerResponse struct {
+}
+
+func (m *MsgSetMetricProducerResponse) Reset()         { *m = MsgSetMetricProducerResponse{} }
+func (m *MsgSetMetricProducerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMetricProducerResponse) ProtoMessage()    {}
+func (*MsgSetMetricProducerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e58a377bb8520d3, []int{11}
+}
+func (m *MsgSetMetricProducerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMetricProducerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMetricProducerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMetricProducerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMetricProducerResponse.Merge(m, src)
+}
+func (m *MsgSetMetricProducerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMetricProducerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMetricProducerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMetricProducerResponse proto.InternalMessageInfo
+
+// Removes the configuration of a metric producer
+type MsgRemoveMetricProducer struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority  string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ProducerId string `protobuf:"bytes,2,opt,name=producer_id,json=producerId,proto3" json:"producer_id,omitempty"`
+}
+
+func (m *MsgRemoveMetricProducer) Reset()         { *m = MsgRemoveMetricProducer{} }
+func (m *MsgRemoveMetricProducer) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveMetricProducer) ProtoMessage()    {}
+func (*MsgRemoveMetricProducer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e58a377bb8520d3, []int{12}
+}
+func (m *MsgRemoveMetricProducer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveMetricProducer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveMetricProducer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveMetricProducer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveMetricProducer.Merge(m, src)
+}
+func (m *MsgRemoveMetricProducer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveMetricProducer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveMetricProducer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveMetricProducer proto.InternalMessageInfo
+
+func (m *MsgRemoveMetricProducer) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveMetricProducer) GetProducerId() string {
+	if m != nil {
+		return m.ProducerId
+	}
+	return ""
+}
+
+type MsgRemoveMetricProducerResponse struct {
+}
+
+func (m *MsgRemoveMetricProducerResponse) Reset()         { *m = MsgRemoveMetricProducerResponse{} }
+func (m *MsgRemoveMetricProducerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveMetricProducerResponse) ProtoMessage()    {}
+func (*MsgRemoveMetricProducerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e58a377bb8520d3, []int{13}
+}
+func (m *MsgRemoveMetricProducerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveMetricProducerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveMetricProducerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveMetricProducerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveMetricProducerResponse.Merge(m, src)
+}
+func (m *MsgRemoveMetricProducerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveMetricProducerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveMetricProducerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveMetricProducerResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAddOracle)(nil), "stride.icaoracle.MsgAddOracle")
 	proto.RegisterType((*MsgAddOracleResponse)(nil), "stride.icaoracle.MsgAddOracleResponse")
@@ -514,51 +720,64 @@ func init() {
 	proto.RegisterType((*MsgToggleOracleResponse)(nil), "stride.icaoracle.MsgToggleOracleResponse")
 	proto.RegisterType((*MsgRemoveOracle)(nil), "stride.icaoracle.MsgRemoveOracle")
 	proto.RegisterType((*MsgRemoveOracleResponse)(nil), "stride.icaoracle.MsgRemoveOracleResponse")
+	proto.RegisterType((*MsgSetMetricProducer)(nil), "stride.icaoracle.MsgSetMetricProducer")
+	proto.RegisterType((*MsgSetMetricProducerResponse)(nil), "stride.icaoracle.MsgSetMetricProducerResponse")
+	proto.RegisterType((*MsgRemoveMetricProducer)(nil), "stride.icaoracle.MsgRemoveMetricProducer")
+	proto.RegisterType((*MsgRemoveMetricProducerResponse)(nil), "stride.icaoracle.MsgRemoveMetricProducerResponse")
 }
 
 func init() { proto.RegisterFile("stride/icaoracle/tx.proto", fileDescriptor_6e58a377bb8520d3) }
 
 var fileDescriptor_6e58a377bb8520d3 = []byte{
-	// 619 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x6b, 0x13, 0x41,
-	0x1c, 0xed, 0xb6, 0xa5, 0xda, 0xa1, 0xb5, 0xed, 0x5a, 0x9a, 0x64, 0xb5, 0x4b, 0xbb, 0x62, 0x8d,
-	0xc5, 0xec, 0x62, 0x0a, 0x0a, 0xf1, 0x94, 0xe6, 0x14, 0x30, 0x14, 0x36, 0x9e, 0x44, 0x58, 0x26,
-	0xb3, 0xe3, 0x66, 0x31, 0x99, 0x09, 0x33, 0xd3, 0xd0, 0x5e, 0x3d, 0xf6, 0xd4, 0x6f, 0x20, 0x7e,
-	0x83, 0x1e, 0x04, 0xbf, 0x82, 0xc7, 0xe2, 0xc9, 0xa3, 0x24, 0x87, 0x9e, 0xfc, 0x0e, 0xb2, 0xff,
-	0x37, 0x9b, 0xad, 0x09, 0x88, 0x5e, 0x12, 0x7e, 0xef, 0xf7, 0xe6, 0xcd, 0x7b, 0x33, 0x3b, 0x33,
-	0xa0, 0xc4, 0x05, 0x73, 0x6d, 0x6c, 0xb8, 0x08, 0x52, 0x06, 0x51, 0x0f, 0x1b, 0xe2, 0x4c, 0x1f,
-	0x30, 0x2a, 0xa8, 0xbc, 0x19, 0xb4, 0xf4, 0xb8, 0xa5, 0x6c, 0xc1, 0xbe, 0x4b, 0xa8, 0xe1, 0xff,
-	0x06, 0x24, 0xa5, 0x84, 0x28, 0xef, 0x53, 0x6e, 0xf9, 0x95, 0x11, 0x14, 0x61, 0xab, 0x10, 0x54,
-	0x46, 0x9f, 0x3b, 0xc6, 0xf0, 0xb9, 0xf7, 0x17, 0x34, 0xb4, 0x4b, 0x09, 0xac, 0xb5, 0xb8, 0x53,
-	0xb7, 0xed, 0x13, 0x5f, 0x57, 0xae, 0x82, 0x3b, 0x88, 0x61, 0x28, 0x28, 0x2b, 0x4a, 0x7b, 0x52,
-	0x79, 0xf5, 0xb8, 0xf8, 0xfd, 0x4b, 0x65, 0x3b, 0x14, 0xab, 0xdb, 0x36, 0xc3, 0x9c, 0xb7, 0x05,
-	0x73, 0x89, 0x63, 0x46, 0x44, 0xf9, 0x11, 0x58, 0x47, 0x94, 0x10, 0x8c, 0x84, 0x4b, 0x89, 0xe5,
-	0xda, 0xc5, 0x45, 0x6f, 0xa4, 0xb9, 0x96, 0x80, 0x4d, 0xbb, 0xf6, 0xe4, 0xe3, 0xcd, 0xd5, 0x61,
-	0x34, 0xe4, 0xe2, 0xe6, 0xea, 0x70, 0x27, 0xc9, 0x99, 0x76, 0xa0, 0xed, 0x80, 0xed, 0x74, 0x6d,
-	0x62, 0x3e, 0xa0, 0x84, 0x63, 0xed, 0x62, 0xd1, 0x6f, 0x34, 0x09, 0x17, 0x90, 0x08, 0x17, 0x0a,
-	0xfc, 0x17, 0x96, 0x0f, 0xc0, 0x46, 0x30, 0xb7, 0x85, 0xba, 0xd0, 0x4d, 0x99, 0x5e, 0x0f, 0xe0,
-	0x86, 0x87, 0x36, 0x6d, 0xb9, 0x0c, 0x36, 0x11, 0x25, 0x82, 0x41, 0x24, 0x2c, 0x44, 0x6d, 0xec,
-	0x11, 0x97, 0xf6, 0xa4, 0xf2, 0xb2, 0x79, 0x2f, 0xc2, 0x1b, 0xd4, 0xc6, 0x4d, 0x5b, 0x7e, 0x05,
-	0x14, 0xc1, 0x20, 0xe1, 0xef, 0x31, 0xf3, 0x34, 0x09, 0xc1, 0x3d, 0x8b, 0x12, 0x2b, 0x90, 0x2b,
-	0x2e, 0xfb, 0xe2, 0x85, 0x88, 0xd1, 0x08, 0x08, 0x27, 0x24, 0x88, 0x50, 0x33, 0xb2, 0x8b, 0xa3,
-	0x4e, 0x2c, 0xce, 0x54, 0x66, 0x4d, 0x05, 0x0f, 0xf3, 0xf0, 0x78, 0xb1, 0x3e, 0x4b, 0xe0, 0x7e,
-	0x8b, 0x3b, 0x26, 0xe6, 0x82, 0xb2, 0xb0, 0xd9, 0x6c, 0xd4, 0xff, 0xe5, 0x5a, 0xd5, 0xf4, 0x6c,
-	0x88, 0xdd, 0x89, 0x10, 0x59, 0x2f, 0xda, 0x2e, 0x78, 0x90, 0x03, 0xc7, 0x11, 0xbe, 0x4a, 0x60,
-	0xa3, 0xc5, 0x9d, 0x37, 0xd4, 0x71, 0x7a, 0xd1, 0x56, 0xbf, 0x00, 0xab, 0xf0, 0x54, 0x74, 0x29,
-	0x73, 0xc5, 0xf9, 0xcc, 0x00, 0x09, 0x75, 0xee, 0xed, 0xde, 0x01, 0x2b, 0x10, 0x09, 0x77, 0x88,
-	0xfd, 0x4d, 0xbe, 0x6b, 0x86, 0x55, 0xed, 0x99, 0x17, 0x2d, 0xd1, 0xf3, 0xc2, 0x95, 0x26, 0xc2,
-	0xa5, 0x5d, 0x6a, 0x25, 0x50, 0xc8, 0x40, 0x71, 0xa8, 0x4f, 0x41, 0x28, 0x13, 0xf7, 0xe9, 0xf0,
-	0x3f, 0x85, 0x9a, 0x6d, 0x3e, 0xed, 0x26, 0x34, 0x9f, 0x86, 0x22, 0xf3, 0xd5, 0x5f, 0x4b, 0x60,
-	0xa9, 0xc5, 0x1d, 0xb9, 0x0d, 0x56, 0x93, 0x0b, 0x43, 0xd5, 0xb3, 0x77, 0x93, 0x9e, 0x3e, 0xbe,
-	0xca, 0xc1, 0x9f, 0xfb, 0x91, 0xb8, 0xfc, 0x01, 0x6c, 0x4d, 0x1f, 0xed, 0xfc, 0xc1, 0x53, 0x3c,
-	0x45, 0x9f, 0x8f, 0x17, 0x4f, 0xd6, 0x05, 0x9b, 0x53, 0x47, 0xe3, 0x71, 0xae, 0x46, 0x96, 0xa6,
-	0x54, 0xe6, 0xa2, 0xc5, 0x33, 0xbd, 0x03, 0x6b, 0x13, 0x5f, 0xf0, 0x7e, 0xee, 0xf0, 0x34, 0x45,
-	0x79, 0x3a, 0x93, 0x92, 0x56, 0x9f, 0xf8, 0x94, 0xf6, 0x6f, 0x31, 0x97, 0x50, 0x6e, 0x51, 0xcf,
-	0xdb, 0xef, 0xe3, 0xd6, 0xb7, 0x91, 0x2a, 0x5d, 0x8f, 0x54, 0xe9, 0xe7, 0x48, 0x95, 0x2e, 0xc7,
-	0xea, 0xc2, 0xf5, 0x58, 0x5d, 0xf8, 0x31, 0x56, 0x17, 0xde, 0x1e, 0x39, 0xae, 0xe8, 0x9e, 0x76,
-	0x74, 0x44, 0xfb, 0x46, 0xdb, 0x97, 0xab, 0xbc, 0x86, 0x1d, 0x6e, 0x84, 0x2f, 0xd8, 0xb0, 0xfa,
-	0xd2, 0x38, 0x4b, 0xbf, 0x63, 0xe7, 0x03, 0xcc, 0x3b, 0x2b, 0xfe, 0x93, 0x73, 0xf4, 0x3b, 0x00,
-	0x00, 0xff, 0xff, 0x98, 0x49, 0xca, 0xc4, 0xe8, 0x06, 0x00, 0x00,
+	// 768 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0x66, 0xf9, 0xfb, 0xeb, 0xfc, 0xf8, 0x53, 0x56, 0x02, 0xed, 0x2a, 0x0b, 0xad, 0x11, 0x0b,
+	0x91, 0xdd, 0x00, 0x46, 0x93, 0x7a, 0x82, 0x9e, 0x9a, 0xd8, 0x60, 0xb6, 0x9e, 0x8c, 0x49, 0xb3,
+	0x9d, 0x19, 0xb7, 0x1b, 0xdb, 0x99, 0x66, 0x66, 0x68, 0xe0, 0xea, 0x91, 0x13, 0x7e, 0x02, 0xe3,
+	0xcd, 0x23, 0x07, 0x13, 0xbf, 0x82, 0x47, 0xe2, 0xc9, 0xa3, 0x81, 0x03, 0x5f, 0xc3, 0xec, 0xdf,
+	0x6e, 0x77, 0x17, 0xda, 0x68, 0xe4, 0xd2, 0x66, 0x9e, 0xf7, 0x99, 0x77, 0x9e, 0xe7, 0x7d, 0xf3,
+	0xce, 0x0e, 0xc8, 0x73, 0xc1, 0x6c, 0x84, 0x75, 0x1b, 0x9a, 0x94, 0x99, 0xb0, 0x8d, 0x75, 0x71,
+	0xac, 0x75, 0x19, 0x15, 0x54, 0xce, 0x7a, 0x21, 0x2d, 0x0c, 0x29, 0x8b, 0x66, 0xc7, 0x26, 0x54,
+	0x77, 0x7f, 0x3d, 0x92, 0x92, 0x87, 0x94, 0x77, 0x28, 0x6f, 0xb8, 0x2b, 0xdd, 0x5b, 0xf8, 0xa1,
+	0x15, 0x6f, 0xa5, 0x77, 0xb8, 0xa5, 0xf7, 0x76, 0x9c, 0x3f, 0x2f, 0x50, 0x3c, 0x93, 0xc0, 0x6c,
+	0x8d, 0x5b, 0xfb, 0x08, 0x1d, 0xba, 0x79, 0xe5, 0x5d, 0x30, 0x03, 0x19, 0x36, 0x05, 0x65, 0x39,
+	0x69, 0x5d, 0x2a, 0x65, 0x0e, 0x72, 0x3f, 0xbe, 0x6e, 0x2f, 0xf9, 0xc9, 0xf6, 0x11, 0x62, 0x98,
+	0xf3, 0xba, 0x60, 0x36, 0xb1, 0x8c, 0x80, 0x28, 0x3f, 0x04, 0x73, 0x90, 0x12, 0x82, 0xa1, 0xb0,
+	0x29, 0x69, 0xd8, 0x28, 0x37, 0xee, 0xec, 0x34, 0x66, 0xfb, 0x60, 0x15, 0x95, 0x1f, 0x7f, 0xb8,
+	0x3e, 0xdf, 0x0a, 0xb6, 0x9c, 0x5e, 0x9f, 0x6f, 0x2d, 0xf7, 0x7d, 0x46, 0x15, 0x14, 0x97, 0xc1,
+	0x52, 0x74, 0x6d, 0x60, 0xde, 0xa5, 0x84, 0xe3, 0xe2, 0xe9, 0xb8, 0x1b, 0xa8, 0x12, 0x2e, 0x4c,
+	0x22, 0x6c, 0x53, 0xe0, 0xbf, 0x90, 0xbc, 0x01, 0x16, 0xbc, 0xb3, 0x1b, 0xb0, 0x65, 0xda, 0x11,
+	0xd1, 0x73, 0x1e, 0x5c, 0x71, 0xd0, 0x2a, 0x92, 0x4b, 0x20, 0x0b, 0x29, 0x11, 0xcc, 0x84, 0xa2,
+	0x01, 0x29, 0xc2, 0x0e, 0x71, 0x62, 0x5d, 0x2a, 0x4d, 0x1a, 0xf3, 0x01, 0x5e, 0xa1, 0x08, 0x57,
+	0x91, 0xfc, 0x02, 0x28, 0x82, 0x99, 0x84, 0xbf, 0xc3, 0xcc, 0xc9, 0x49, 0x08, 0x6e, 0x37, 0x28,
+	0x69, 0x78, 0xe9, 0x72, 0x93, 0x6e, 0xf2, 0x95, 0x80, 0x51, 0xf1, 0x08, 0x87, 0xc4, 0xb3, 0x50,
+	0xd6, 0xe3, 0xc5, 0x51, 0x07, 0x8a, 0x93, 0xf0, 0x5c, 0x54, 0xc1, 0x83, 0x34, 0x3c, 0x2c, 0xd6,
+	0x67, 0x09, 0xdc, 0xab, 0x71, 0xcb, 0xc0, 0x5c, 0x50, 0xe6, 0x07, 0xab, 0x95, 0xfd, 0x7f, 0x59,
+	0xab, 0xb2, 0x16, 0x37, 0xb1, 0x3a, 0x60, 0x22, 0xae, 0xa5, 0xb8, 0x0a, 0xee, 0xa7, 0xc0, 0xa1,
+	0x85, 0x6f, 0x12, 0x58, 0xa8, 0x71, 0xeb, 0x35, 0xb5, 0xac, 0x76, 0xd0, 0xea, 0x67, 0x20, 0x63,
+	0x1e, 0x89, 0x16, 0x65, 0xb6, 0x38, 0x19, 0x6a, 0xa0, 0x4f, 0x1d, 0xb9, 0xdd, 0xcb, 0x60, 0xda,
+	0x84, 0xc2, 0xee, 0x61, 0xb7, 0xc9, 0xff, 0x19, 0xfe, 0xaa, 0xfc, 0xc4, 0xb1, 0xd6, 0xcf, 0xe7,
+	0x98, 0xcb, 0x0f, 0x98, 0x8b, 0xaa, 0x2c, 0xe6, 0xc1, 0x4a, 0x0c, 0x0a, 0x4d, 0x7d, 0xf2, 0x4c,
+	0x19, 0xb8, 0x43, 0x7b, 0x77, 0x64, 0x6a, 0xb8, 0xf8, 0xa8, 0x1a, 0x5f, 0x7c, 0x14, 0x0a, 0xc5,
+	0x7f, 0xf4, 0x26, 0xb0, 0x8e, 0x45, 0x0d, 0x0b, 0x66, 0xc3, 0x57, 0x8c, 0xa2, 0x23, 0x88, 0xd9,
+	0x1f, 0x3b, 0x58, 0x03, 0xff, 0x77, 0xfd, 0x1c, 0x7d, 0xf5, 0x20, 0x80, 0xaa, 0x48, 0xde, 0x04,
+	0x59, 0x9b, 0x08, 0xcc, 0x7a, 0x66, 0xbb, 0xc1, 0x31, 0xa4, 0x04, 0x71, 0x7f, 0xfc, 0x16, 0x02,
+	0xbc, 0xee, 0xc1, 0xce, 0xa4, 0xc6, 0xaa, 0xc1, 0x73, 0x93, 0xeb, 0x13, 0xa5, 0x8c, 0x31, 0x3f,
+	0x50, 0x0e, 0x2e, 0xe7, 0xc0, 0x0c, 0x26, 0x66, 0xb3, 0x8d, 0x51, 0x6e, 0xca, 0xed, 0x72, 0xb0,
+	0x2c, 0xef, 0x24, 0x2b, 0x35, 0x38, 0x88, 0x09, 0xeb, 0xfe, 0x20, 0x26, 0xf0, 0xb0, 0x66, 0x5f,
+	0xa4, 0x48, 0x3d, 0xef, 0xa8, 0x6c, 0xe5, 0xa7, 0x49, 0x1f, 0x85, 0x94, 0x8e, 0xc7, 0xac, 0x14,
+	0xc0, 0xda, 0x0d, 0xa1, 0xc0, 0xcd, 0xee, 0xc5, 0x14, 0x98, 0xa8, 0x71, 0x4b, 0xae, 0x83, 0x4c,
+	0xff, 0x93, 0xa1, 0x6a, 0xf1, 0xaf, 0x93, 0x16, 0xbd, 0xc0, 0x95, 0x8d, 0xdb, 0xe3, 0x41, 0x72,
+	0xf9, 0x3d, 0x58, 0x4c, 0x5e, 0xee, 0xe9, 0x9b, 0x13, 0x3c, 0x45, 0x1b, 0x8d, 0x17, 0x1e, 0xd6,
+	0x02, 0xd9, 0xc4, 0xe5, 0xf8, 0x28, 0x35, 0x47, 0x9c, 0xa6, 0x6c, 0x8f, 0x44, 0x0b, 0x4f, 0x7a,
+	0x0b, 0x66, 0x07, 0xee, 0xb0, 0x42, 0xea, 0xf6, 0x28, 0x45, 0xd9, 0x1c, 0x4a, 0x89, 0x66, 0x1f,
+	0xb8, 0x4c, 0x0a, 0x37, 0x88, 0xeb, 0x53, 0x94, 0xcd, 0xa1, 0x94, 0x68, 0x4b, 0x92, 0xd3, 0x9e,
+	0xde, 0x92, 0x04, 0x4f, 0xd1, 0x46, 0xe3, 0x85, 0x87, 0x09, 0xb0, 0x94, 0x3a, 0x26, 0xb7, 0xe9,
+	0x8d, 0x1d, 0xb9, 0x33, 0x32, 0x35, 0x38, 0xf5, 0xa0, 0xf6, 0xfd, 0x52, 0x95, 0x2e, 0x2e, 0x55,
+	0xe9, 0xd7, 0xa5, 0x2a, 0x9d, 0x5d, 0xa9, 0x63, 0x17, 0x57, 0xea, 0xd8, 0xcf, 0x2b, 0x75, 0xec,
+	0xcd, 0x9e, 0x65, 0x8b, 0xd6, 0x51, 0x53, 0x83, 0xb4, 0xa3, 0xd7, 0xdd, 0xb4, 0xdb, 0x2f, 0xcd,
+	0x26, 0xd7, 0xfd, 0x67, 0x5a, 0x6f, 0xf7, 0xb9, 0x7e, 0x1c, 0x7d, 0xac, 0x9d, 0x74, 0x31, 0x6f,
+	0x4e, 0xbb, 0xef, 0xaa, 0xbd, 0xdf, 0x03, 0x00, 0x3d, 0xd2, 0xb0, 0x05, 0xcd, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -583,6 +802,10 @@ type MsgClient interface {
 	ToggleOracle(ctx context.Context, in *MsgToggleOracle, opts ...grpc.CallOption) (*MsgToggleOracleResponse, error)
 	// Removes an oracle completely
 	RemoveOracle(ctx context.Context, in *MsgRemoveOracle, opts ...grpc.CallOption) (*MsgRemoveOracleResponse, error)
+	// Adds or updates the configuration of a metric producer
+	SetMetricProducer(ctx context.Context, in *MsgSetMetricProducer, opts ...grpc.CallOption) (*MsgSetMetricProducerResponse, error)
+	// Removes the configuration of a metric producer
+	RemoveMetricProducer(ctx context.Context, in *MsgRemoveMetricProducer, opts ...grpc.CallOption) (*MsgRemoveMetricProducerResponse, error)
 }
 
 type msgClient struct {
@@ -638,6 +861,24 @@ func (c *msgClient) RemoveOracle(ctx context.Context, in *MsgRemoveOracle, opts
 	return out, nil
 }
 
+func (c *msgClient) SetMetricProducer(ctx context.Context, in *MsgSetMetricProducer, opts ...grpc.CallOption) (*MsgSetMetricProducerResponse, error) {
+	out := new(MsgSetMetricProducerResponse)
+	err := c.cc.Invoke(ctx, "/stride.icaoracle.Msg/SetMetricProducer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveMetricProducer(ctx context.Context, in *MsgRemoveMetricProducer, opts ...grpc.CallOption) (*MsgRemoveMetricProducerResponse, error) {
+	out := new(MsgRemoveMetricProducerResponse)
+	err := c.cc.Invoke(ctx, "/stride.icaoracle.Msg/RemoveMetricProducer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Adds a new oracle given a provided connection
@@ -650,6 +891,10 @@ type MsgServer interface {
 	ToggleOracle(context.Context, *MsgToggleOracle) (*MsgToggleOracleResponse, error)
 	// Removes an oracle completely
 	RemoveOracle(context.Context, *MsgRemoveOracle) (*MsgRemoveOracleResponse, error)
+	// Adds or updates the configuration of a metric producer
+	SetMetricProducer(context.Context, *MsgSetMetricProducer) (*MsgSetMetricProducerResponse, error)
+	// Removes the configuration of a metric producer
+	RemoveMetricProducer(context.Context, *MsgRemoveMetricProducer) (*MsgRemoveMetricProducerResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -671,6 +916,12 @@ func (*UnimplementedMsgServer) ToggleOracle(ctx context.Context, req *MsgToggleO
 func (*UnimplementedMsgServer) RemoveOracle(ctx context.Context, req *MsgRemoveOracle) (*MsgRemoveOracleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveOracle not implemented")
 }
+func (*UnimplementedMsgServer) SetMetricProducer(ctx context.Context, req *MsgSetMetricProducer) (*MsgSetMetricProducerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMetricProducer not implemented")
+}
+func (*UnimplementedMsgServer) RemoveMetricProducer(ctx context.Context, req *MsgRemoveMetricProducer) (*MsgRemoveMetricProducerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMetricProducer not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -766,6 +1017,42 @@ func _Msg_RemoveOracle_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetMetricProducer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMetricProducer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMetricProducer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.icaoracle.Msg/SetMetricProducer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMetricProducer(ctx, req.(*MsgSetMetricProducer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveMetricProducer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMetricProducer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMetricProducer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.icaoracle.Msg/RemoveMetricProducer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMetricProducer(ctx, req.(*MsgRemoveMetricProducer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.icaoracle.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -790,6 +1077,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveOracle",
 			Handler:    _Msg_RemoveOracle_Handler,
 		},
+		{
+			MethodName: "SetMetricProducer",
+			Handler:    _Msg_SetMetricProducer_Handler,
+		},
+		{
+			MethodName: "RemoveMetricProducer",
+			Handler:    _Msg_RemoveMetricProducer_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stride/icaoracle/tx.proto",
@@ -1117,74 +1412,218 @@ func (m *MsgRemoveOracleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgAddOracle) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetMetricProducer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddOracleResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgSetMetricProducer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgInstantiateOracle) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetMetricProducer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.OracleChainIds) > 0 {
+		for iNdEx := len(m.OracleChainIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OracleChainIds[iNdEx])
+			copy(dAtA[i:], m.OracleChainIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.OracleChainIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.OracleChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.IntervalSeconds != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IntervalSeconds))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.ContractCodeId != 0 {
-		n += 1 + sovTx(uint64(m.ContractCodeId))
+	if len(m.ProducerId) > 0 {
+		i -= len(m.ProducerId)
+		copy(dAtA[i:], m.ProducerId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProducerId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.TransferChannelOnOracle)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgInstantiateOracleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetMetricProducerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMetricProducerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMetricProducerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMetricProducer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMetricProducer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMetricProducer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProducerId) > 0 {
+		i -= len(m.ProducerId)
+		copy(dAtA[i:], m.ProducerId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProducerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMetricProducerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMetricProducerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMetricProducerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddOracle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddOracleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgInstantiateOracle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OracleChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractCodeId != 0 {
+		n += 1 + sovTx(uint64(m.ContractCodeId))
+	}
+	l = len(m.TransferChannelOnOracle)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInstantiateOracleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func (m *MsgRestoreOracleICA) Size() (n int) {
@@ -1230,51 +1669,494 @@ func (m *MsgToggleOracle) Size() (n int) {
 	if m.Active {
 		n += 2
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgToggleOracleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveOracle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OracleChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveOracleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetMetricProducer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProducerId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IntervalSeconds != 0 {
+		n += 1 + sovTx(uint64(m.IntervalSeconds))
+	}
+	if len(m.OracleChainIds) > 0 {
+		for _, s := range m.OracleChainIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetMetricProducerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveMetricProducer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProducerId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveMetricProducerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddOracle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddOracle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddOracle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddOracleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddOracleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddOracleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantiateOracle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantiateOracle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantiateOracle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCodeId", wireType)
+			}
+			m.ContractCodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractCodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferChannelOnOracle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferChannelOnOracle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgToggleOracleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgRemoveOracle) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OracleChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgInstantiateOracleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantiateOracleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantiateOracleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgRemoveOracleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgAddOracle) Unmarshal(dAtA []byte) error {
+func (m *MsgRestoreOracleICA) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1297,10 +2179,10 @@ func (m *MsgAddOracle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddOracle: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRestoreOracleICA: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddOracle: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRestoreOracleICA: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1337,7 +2219,7 @@ func (m *MsgAddOracle) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1365,7 +2247,7 @@ func (m *MsgAddOracle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			m.OracleChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1388,7 +2270,7 @@ func (m *MsgAddOracle) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddOracleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRestoreOracleICAResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1411,10 +2293,10 @@ func (m *MsgAddOracleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddOracleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRestoreOracleICAResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddOracleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRestoreOracleICAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1438,7 +2320,7 @@ func (m *MsgAddOracleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInstantiateOracle) Unmarshal(dAtA []byte) error {
+func (m *MsgToggleOracle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1461,15 +2343,15 @@ func (m *MsgInstantiateOracle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInstantiateOracle: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgToggleOracle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInstantiateOracle: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgToggleOracle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1497,7 +2379,7 @@ func (m *MsgInstantiateOracle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1533,28 +2415,9 @@ func (m *MsgInstantiateOracle) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractCodeId", wireType)
-			}
-			m.ContractCodeId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ContractCodeId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferChannelOnOracle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1564,24 +2427,12 @@ func (m *MsgInstantiateOracle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TransferChannelOnOracle = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Active = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1603,7 +2454,7 @@ func (m *MsgInstantiateOracle) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInstantiateOracleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgToggleOracleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1626,10 +2477,10 @@ func (m *MsgInstantiateOracleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInstantiateOracleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgToggleOracleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInstantiateOracleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgToggleOracleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1653,7 +2504,7 @@ func (m *MsgInstantiateOracleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRestoreOracleICA) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveOracle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1676,15 +2527,15 @@ func (m *MsgRestoreOracleICA) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRestoreOracleICA: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveOracle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRestoreOracleICA: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveOracle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1712,7 +2563,7 @@ func (m *MsgRestoreOracleICA) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1767,7 +2618,7 @@ func (m *MsgRestoreOracleICA) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRestoreOracleICAResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveOracleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1790,10 +2641,10 @@ func (m *MsgRestoreOracleICAResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRestoreOracleICAResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveOracleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRestoreOracleICAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveOracleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1817,7 +2668,7 @@ func (m *MsgRestoreOracleICAResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgToggleOracle) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMetricProducer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1840,10 +2691,10 @@ func (m *MsgToggleOracle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgToggleOracle: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMetricProducer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgToggleOracle: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMetricProducer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1880,7 +2731,7 @@ func (m *MsgToggleOracle) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1908,11 +2759,62 @@ func (m *MsgToggleOracle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleChainId = string(dAtA[iNdEx:postIndex])
+			m.ProducerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalSeconds", wireType)
+			}
+			m.IntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleChainIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleChainIds = append(m.OracleChainIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1929,7 +2831,7 @@ func (m *MsgToggleOracle) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Active = bool(v != 0)
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1951,7 +2853,7 @@ func (m *MsgToggleOracle) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgToggleOracleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMetricProducerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1974,10 +2876,10 @@ func (m *MsgToggleOracleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgToggleOracleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMetricProducerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgToggleOracleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMetricProducerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2001,7 +2903,7 @@ func (m *MsgToggleOracleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveOracle) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveMetricProducer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2024,10 +2926,10 @@ func (m *MsgRemoveOracle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveOracle: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveMetricProducer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveOracle: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveMetricProducer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2064,7 +2966,7 @@ func (m *MsgRemoveOracle) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2092,7 +2994,7 @@ func (m *MsgRemoveOracle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleChainId = string(dAtA[iNdEx:postIndex])
+			m.ProducerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2115,7 +3017,7 @@ func (m *MsgRemoveOracle) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveOracleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveMetricProducerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2138,10 +3040,10 @@ func (m *MsgRemoveOracleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveOracleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveMetricProducerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveOracleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveMetricProducerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
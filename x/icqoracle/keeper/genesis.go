@@ -10,6 +10,7 @@ import (
 func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	k.SetParams(ctx, genState.Params)
 
+	// The price queries are exported with the interchainquery module's recurring queries
 	for _, tokenPrice := range genState.TokenPrices {
 		k.SetTokenPrice(ctx, tokenPrice)
 	}
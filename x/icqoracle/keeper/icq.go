@@ -51,12 +51,12 @@ func (c ICQCallbacks) RegisterICQCallbacks() icqtypes.QueryCallbacks {
 		AddICQCallback(ICQCallbackID_OsmosisPrice, ICQCallback(OsmosisPriceCallback))
 }
 
-// Submits an ICQ to get a concentrated liquidity pool from Osmosis' store
-func (k Keeper) SubmitOsmosisPriceICQ(
-	ctx sdk.Context,
-	tokenPrice types.TokenPrice,
-) error {
-	k.Logger(ctx).Info(fmt.Sprintf("Submitting OsmosisPrice ICQ - Base: %s / Quote: %s / Pool: %d",
+// Registers the recurring query that refreshes a token's price from its Osmosis pool
+// The query is re-issued by the interchainquery module every update interval, and
+// each query times out after the same interval
+// Registering is idempotent, so this is also used to apply updated params
+func (k Keeper) RegisterOsmosisPriceQuery(ctx sdk.Context, tokenPrice types.TokenPrice) error {
+	k.Logger(ctx).Info(fmt.Sprintf("Registering OsmosisPrice ICQ - Base: %s / Quote: %s / Pool: %d",
 		tokenPrice.BaseDenom, tokenPrice.QuoteDenom, tokenPrice.OsmosisPoolId))
 
 	params := k.GetParams(ctx)
@@ -72,26 +72,39 @@ func (k Keeper) SubmitOsmosisPriceICQ(
 		tokenPrice.OsmosisQuoteDenom,
 	)
 
-	query := icqtypes.Query{
-		ChainId:         params.OsmosisChainId,
-		ConnectionId:    params.OsmosisConnectionId,
-		QueryType:       icqtypes.OSMOSIS_TWAP_STORE_QUERY_WITH_PROOF,
-		RequestData:     queryData,
-		CallbackModule:  types.ModuleName,
-		CallbackId:      ICQCallbackID_OsmosisPrice,
-		CallbackData:    tokenPriceBz,
-		TimeoutDuration: time.Duration(utils.UintToInt(params.UpdateIntervalSec)) * time.Second,
-		TimeoutPolicy:   icqtypes.TimeoutPolicy_REJECT_QUERY_RESPONSE,
+	updateInterval := time.Duration(utils.UintToInt(params.UpdateIntervalSec)) * time.Second
+	recurringQuery := icqtypes.RecurringQuery{
+		Id:            types.TokenPriceQueryId(tokenPrice.BaseDenom, tokenPrice.QuoteDenom, tokenPrice.OsmosisPoolId),
+		OwnerModule:   types.ModuleName,
+		CallbackId:    ICQCallbackID_OsmosisPrice,
+		ChainId:       params.OsmosisChainId,
+		ConnectionId:  params.OsmosisConnectionId,
+		QueryType:     icqtypes.OSMOSIS_TWAP_STORE_QUERY_WITH_PROOF,
+		RequestData:   queryData,
+		CallbackData:  tokenPriceBz,
+		Interval:      updateInterval,
+		MaxStaleness:  updateInterval,
+		TimeoutPolicy: icqtypes.TimeoutPolicy_REJECT_QUERY_RESPONSE,
 	}
 
-	if err := k.IcqKeeper.SubmitICQRequest(ctx, query, false); err != nil {
-		return errorsmod.Wrap(err, "Error submitting OsmosisPrice ICQ")
+	if err := k.IcqKeeper.RegisterRecurringQuery(ctx, recurringQuery); err != nil {
+		return errorsmod.Wrap(err, "Error registering OsmosisPrice ICQ")
 	}
 
-	if err := k.SetQueryInProgress(ctx, tokenPrice.BaseDenom, tokenPrice.QuoteDenom, tokenPrice.OsmosisPoolId); err != nil {
-		return errorsmod.Wrap(err, "Error updating token price query to in progress")
-	}
+	return nil
+}
 
+// Registers the price query for each token price
+func (k Keeper) RegisterAllOsmosisPriceQueries(ctx sdk.Context) error {
+	for _, tokenPrice := range k.GetAllTokenPrices(ctx) {
+		if err := k.RegisterOsmosisPriceQuery(ctx, tokenPrice); err != nil {
+			return errorsmod.Wrapf(err,
+				"failed to register Osmosis CL pool ICQ baseToken='%s' quoteToken='%s' poolId='%d'",
+				tokenPrice.BaseDenom,
+				tokenPrice.QuoteDenom,
+				tokenPrice.OsmosisPoolId)
+		}
+	}
 	return nil
 }
 
@@ -111,8 +124,11 @@ func OsmosisPriceCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes
 		return errorsmod.Wrap(err, "Error getting current spot price")
 	}
 
-	if !tokenPrice.QueryInProgress {
-		return nil
+	// The query is issued by the interchainquery module, so the request time is
+	// read from the recurring query
+	queryId := types.TokenPriceQueryId(tokenPrice.BaseDenom, tokenPrice.QuoteDenom, tokenPrice.OsmosisPoolId)
+	if recurringQuery, found := k.IcqKeeper.GetRecurringQuery(ctx, types.ModuleName, queryId); found {
+		tokenPrice.LastRequestTime = recurringQuery.LastSubmissionTime
 	}
 
 	newSpotPrice, err := UnmarshalSpotPriceFromOsmosis(k, tokenPrice, args)
@@ -15,23 +15,38 @@ import (
 
 // Mock ICQ Keeper struct
 type MockICQKeeper struct {
-	SubmitICQRequestFn func(ctx sdk.Context, query icqtypes.Query, forceUnique bool) error
+	RegisterRecurringQueryFn func(ctx sdk.Context, recurringQuery icqtypes.RecurringQuery) error
+	RemoveRecurringQueryFn   func(ctx sdk.Context, ownerModule, id string)
+	GetRecurringQueryFn      func(ctx sdk.Context, ownerModule, id string) (icqtypes.RecurringQuery, bool)
 }
 
-func (m MockICQKeeper) SubmitICQRequest(ctx sdk.Context, query icqtypes.Query, forceUnique bool) error {
-	if m.SubmitICQRequestFn != nil {
-		return m.SubmitICQRequestFn(ctx, query, forceUnique)
+func (m MockICQKeeper) RegisterRecurringQuery(ctx sdk.Context, recurringQuery icqtypes.RecurringQuery) error {
+	if m.RegisterRecurringQueryFn != nil {
+		return m.RegisterRecurringQueryFn(ctx, recurringQuery)
 	}
 	return nil
 }
 
-func (s *KeeperTestSuite) TestSubmitOsmosisPriceICQ_Success() {
-	var submittedQuery icqtypes.Query
+func (m MockICQKeeper) RemoveRecurringQuery(ctx sdk.Context, ownerModule, id string) {
+	if m.RemoveRecurringQueryFn != nil {
+		m.RemoveRecurringQueryFn(ctx, ownerModule, id)
+	}
+}
 
-	// Setup mock ICQ keeper with custom behavior
+func (m MockICQKeeper) GetRecurringQuery(ctx sdk.Context, ownerModule, id string) (icqtypes.RecurringQuery, bool) {
+	if m.GetRecurringQueryFn != nil {
+		return m.GetRecurringQueryFn(ctx, ownerModule, id)
+	}
+	return icqtypes.RecurringQuery{}, false
+}
+
+func (s *KeeperTestSuite) TestRegisterOsmosisPriceQuery_Success() {
+	var registeredQuery icqtypes.RecurringQuery
+
+	// Setup mock ICQ keeper to capture the registered query
 	s.mockICQKeeper = MockICQKeeper{
-		SubmitICQRequestFn: func(ctx sdk.Context, query icqtypes.Query, forceUnique bool) error {
-			submittedQuery = query
+		RegisterRecurringQueryFn: func(ctx sdk.Context, recurringQuery icqtypes.RecurringQuery) error {
+			registeredQuery = recurringQuery
 			return nil
 		},
 	}
@@ -39,13 +54,13 @@ func (s *KeeperTestSuite) TestSubmitOsmosisPriceICQ_Success() {
 
 	// Set up test parameters
 	tokenPrice := types.TokenPrice{
-		BaseDenom:     "uatom",
-		QuoteDenom:    "uusdc",
-		OsmosisPoolId: 1,
+		BaseDenom:         "uatom",
+		QuoteDenom:        "uusdc",
+		OsmosisPoolId:     1,
+		OsmosisBaseDenom:  "ibc/uatom",
+		OsmosisQuoteDenom: "ibc/uusdc",
 	}
 
-	s.App.ICQOracleKeeper.SetTokenPrice(s.Ctx, tokenPrice)
-
 	params := types.Params{
 		OsmosisChainId:      "osmosis-1",
 		OsmosisConnectionId: "connection-0",
@@ -53,163 +68,121 @@ func (s *KeeperTestSuite) TestSubmitOsmosisPriceICQ_Success() {
 	}
 	s.App.ICQOracleKeeper.SetParams(s.Ctx, params)
 
-	// Verify tokenPrice.QueryInProgress before
-	s.Require().False(tokenPrice.QueryInProgress)
-
-	// Submit ICQ request
-	err := s.App.ICQOracleKeeper.SubmitOsmosisPriceICQ(s.Ctx, tokenPrice)
+	// Register the query
+	err := s.App.ICQOracleKeeper.RegisterOsmosisPriceQuery(s.Ctx, tokenPrice)
 	s.Require().NoError(err)
 
 	// Verify the captured query data
-	s.Require().Equal(params.OsmosisChainId, submittedQuery.ChainId)
-	s.Require().Equal(params.OsmosisConnectionId, submittedQuery.ConnectionId)
-	s.Require().Equal(icqtypes.OSMOSIS_TWAP_STORE_QUERY_WITH_PROOF, submittedQuery.QueryType)
-	s.Require().Equal(types.ModuleName, submittedQuery.CallbackModule)
-	s.Require().Equal(keeper.ICQCallbackID_OsmosisPrice, submittedQuery.CallbackId)
-
-	// Verify tokenPrice.QueryInProgress after
-	tokenPriceAfter, err := s.App.ICQOracleKeeper.GetTokenPrice(
-		s.Ctx,
-		tokenPrice.BaseDenom,
-		tokenPrice.QuoteDenom,
+	expectedQueryId := types.TokenPriceQueryId(tokenPrice.BaseDenom, tokenPrice.QuoteDenom, tokenPrice.OsmosisPoolId)
+	s.Require().Equal(expectedQueryId, registeredQuery.Id, "query id")
+	s.Require().Equal(types.ModuleName, registeredQuery.OwnerModule, "owner module")
+	s.Require().Equal(keeper.ICQCallbackID_OsmosisPrice, registeredQuery.CallbackId, "callback id")
+	s.Require().Equal(params.OsmosisChainId, registeredQuery.ChainId, "chain id")
+	s.Require().Equal(params.OsmosisConnectionId, registeredQuery.ConnectionId, "connection id")
+	s.Require().Equal(icqtypes.OSMOSIS_TWAP_STORE_QUERY_WITH_PROOF, registeredQuery.QueryType, "query type")
+
+	expectedRequestData := icqtypes.FormatOsmosisMostRecentTWAPKey(
 		tokenPrice.OsmosisPoolId,
+		tokenPrice.OsmosisBaseDenom,
+		tokenPrice.OsmosisQuoteDenom,
 	)
-	s.Require().NoError(err)
-
-	s.Require().True(tokenPriceAfter.QueryInProgress, "query in progress")
-	s.Require().Equal(tokenPriceAfter.LastRequestTime, s.Ctx.BlockTime(), "query request time")
+	s.Require().Equal(expectedRequestData, registeredQuery.RequestData, "request data")
 
 	// Verify callback data contains the token price
 	var decodedTokenPrice types.TokenPrice
-	err = s.App.AppCodec().Unmarshal(submittedQuery.CallbackData, &decodedTokenPrice)
+	err = s.App.AppCodec().Unmarshal(registeredQuery.CallbackData, &decodedTokenPrice)
 	s.Require().NoError(err)
 	s.Require().Equal(tokenPrice.BaseDenom, decodedTokenPrice.BaseDenom)
 	s.Require().Equal(tokenPrice.QuoteDenom, decodedTokenPrice.QuoteDenom)
 	s.Require().Equal(tokenPrice.OsmosisPoolId, decodedTokenPrice.OsmosisPoolId)
 
-	// Verify timeout settings
-	expectedTimeout := time.Duration(params.UpdateIntervalSec) * time.Second
-	s.Require().Equal(expectedTimeout, submittedQuery.TimeoutDuration)
-	s.Require().Equal(icqtypes.TimeoutPolicy_REJECT_QUERY_RESPONSE, submittedQuery.TimeoutPolicy)
+	// Verify the interval and timeout settings
+	expectedInterval := time.Duration(params.UpdateIntervalSec) * time.Second
+	s.Require().Equal(expectedInterval, registeredQuery.Interval, "interval")
+	s.Require().Equal(expectedInterval, registeredQuery.MaxStaleness, "max staleness")
+	s.Require().Equal(icqtypes.TimeoutPolicy_REJECT_QUERY_RESPONSE, registeredQuery.TimeoutPolicy, "timeout policy")
 }
 
-func (s *KeeperTestSuite) TestSubmitOsmosisPriceICQ_Errors() {
-	testCases := []struct {
-		name          string
-		setup         func()
-		tokenPrice    types.TokenPrice
-		expectedError string
-	}{
-		{
-			name: "token price not found",
-			setup: func() {
-				params := types.Params{
-					OsmosisChainId:      "osmosis-1",
-					OsmosisConnectionId: "connection-0",
-					UpdateIntervalSec:   60,
-				}
-				s.App.ICQOracleKeeper.SetParams(s.Ctx, params)
-
-				// Token price will not be added to the store in this test
-			},
-			tokenPrice: types.TokenPrice{
-				BaseDenom:     "uatom",
-				QuoteDenom:    "uusdc",
-				OsmosisPoolId: 1,
-			},
-			expectedError: "token price not found",
+func (s *KeeperTestSuite) TestRegisterOsmosisPriceQuery_Failure() {
+	s.mockICQKeeper = MockICQKeeper{
+		RegisterRecurringQueryFn: func(ctx sdk.Context, recurringQuery icqtypes.RecurringQuery) error {
+			return fmt.Errorf("mock ICQ register error")
 		},
-		{
-			name: "error submitting ICQ request",
-			setup: func() {
-				params := types.Params{
-					OsmosisChainId:      "osmosis-1",
-					OsmosisConnectionId: "connection-0",
-					UpdateIntervalSec:   60,
-				}
-				s.App.ICQOracleKeeper.SetParams(s.Ctx, params)
+	}
+	s.App.ICQOracleKeeper.IcqKeeper = s.mockICQKeeper
 
-				// Mock ICQ keeper to return error
-				s.mockICQKeeper = MockICQKeeper{
-					SubmitICQRequestFn: func(ctx sdk.Context, query icqtypes.Query, forceUnique bool) error {
-						return fmt.Errorf("mock ICQ submit error")
-					},
-				}
-				s.App.ICQOracleKeeper.IcqKeeper = s.mockICQKeeper
-			},
-			tokenPrice: types.TokenPrice{
-				BaseDenom:     "uatom",
-				QuoteDenom:    "uusdc",
-				OsmosisPoolId: 1,
-			},
-			expectedError: "Error submitting OsmosisPrice ICQ",
-		},
-		{
-			name: "error setting query in progress",
-			setup: func() {
-				params := types.Params{
-					OsmosisChainId:      "osmosis-1",
-					OsmosisConnectionId: "connection-0",
-					UpdateIntervalSec:   60,
-				}
-				s.App.ICQOracleKeeper.SetParams(s.Ctx, params)
-
-				// Setup mock ICQ keeper with success response
-				s.mockICQKeeper = MockICQKeeper{
-					SubmitICQRequestFn: func(ctx sdk.Context, query icqtypes.Query, forceUnique bool) error {
-						// Remove token price so set query in progress will fail to get it after SubmitICQRequest returns
-						s.App.ICQOracleKeeper.RemoveTokenPrice(ctx, "uatom", "uusdc", 1)
-						return nil
-					},
-				}
-				s.App.ICQOracleKeeper.IcqKeeper = s.mockICQKeeper
+	tokenPrice := types.TokenPrice{BaseDenom: "uatom", QuoteDenom: "uusdc", OsmosisPoolId: 1}
+	err := s.App.ICQOracleKeeper.RegisterOsmosisPriceQuery(s.Ctx, tokenPrice)
+	s.Require().ErrorContains(err, "Error registering OsmosisPrice ICQ")
+}
 
-				// Don't set the token price first, which will cause SetTokenPriceQueryInProgress to fail
-			},
-			tokenPrice: types.TokenPrice{
-				BaseDenom:     "uatom",
-				QuoteDenom:    "uusdc",
-				OsmosisPoolId: 1,
-			},
-			expectedError: "Error updating token price query to in progress",
-		},
+func (s *KeeperTestSuite) TestRegisterOsmosisPriceQuery_InterchainqueryKeeper() {
+	// Use the real interchainquery keeper to confirm the query passes validation
+	s.App.ICQOracleKeeper.IcqKeeper = s.App.InterchainqueryKeeper
+
+	params := types.Params{
+		OsmosisChainId:      "osmosis-1",
+		OsmosisConnectionId: "connection-0",
+		UpdateIntervalSec:   60,
 	}
+	s.App.ICQOracleKeeper.SetParams(s.Ctx, params)
 
-	for _, tc := range testCases {
-		s.Run(tc.name, func() {
-			// Reset context for each test case
-			s.SetupTest()
+	tokenPrice := types.TokenPrice{BaseDenom: "uatom", QuoteDenom: "uusdc", OsmosisPoolId: 1}
+	err := s.App.ICQOracleKeeper.RegisterOsmosisPriceQuery(s.Ctx, tokenPrice)
+	s.Require().NoError(err)
 
-			// Run test case setup
-			tc.setup()
+	queryId := types.TokenPriceQueryId(tokenPrice.BaseDenom, tokenPrice.QuoteDenom, tokenPrice.OsmosisPoolId)
+	recurringQuery, found := s.App.InterchainqueryKeeper.GetRecurringQuery(s.Ctx, types.ModuleName, queryId)
+	s.Require().True(found, "recurring query should have been registered")
+	s.Require().Equal(time.Minute, recurringQuery.Interval, "interval")
 
-			// If this is not an error case testing query in progress,
-			// set the token price first
-			if tc.expectedError != "token price not found" {
-				s.App.ICQOracleKeeper.SetTokenPrice(s.Ctx, tc.tokenPrice)
-			}
+	// Re-registering after a param change should update the interval in place
+	params.UpdateIntervalSec = 120
+	s.App.ICQOracleKeeper.SetParams(s.Ctx, params)
 
-			// Execute
-			err := s.App.ICQOracleKeeper.SubmitOsmosisPriceICQ(s.Ctx, tc.tokenPrice)
+	err = s.App.ICQOracleKeeper.RegisterOsmosisPriceQuery(s.Ctx, tokenPrice)
+	s.Require().NoError(err)
 
-			// Verify results
-			if tc.expectedError != "" {
-				s.Require().ErrorContains(err, tc.expectedError)
-			} else {
-				s.Require().NoError(err)
+	recurringQuery, found = s.App.InterchainqueryKeeper.GetRecurringQuery(s.Ctx, types.ModuleName, queryId)
+	s.Require().True(found, "recurring query should still be registered")
+	s.Require().Equal(2*time.Minute, recurringQuery.Interval, "updated interval")
+}
 
-				// For successful case, verify query in progress was set
-				tokenPriceAfter, err := s.App.ICQOracleKeeper.GetTokenPrice(
-					s.Ctx,
-					tc.tokenPrice.BaseDenom,
-					tc.tokenPrice.QuoteDenom,
-					tc.tokenPrice.OsmosisPoolId,
-				)
-				s.Require().NoError(err)
-				s.Require().True(tokenPriceAfter.QueryInProgress)
-			}
-		})
+func (s *KeeperTestSuite) TestRegisterAllOsmosisPriceQueries() {
+	registeredQueryIds := []string{}
+	s.mockICQKeeper = MockICQKeeper{
+		RegisterRecurringQueryFn: func(ctx sdk.Context, recurringQuery icqtypes.RecurringQuery) error {
+			registeredQueryIds = append(registeredQueryIds, recurringQuery.Id)
+			return nil
+		},
+	}
+	s.App.ICQOracleKeeper.IcqKeeper = s.mockICQKeeper
+
+	tokenPrices := []types.TokenPrice{
+		{BaseDenom: "uatom", QuoteDenom: "uusdc", OsmosisPoolId: 1},
+		{BaseDenom: "uosmo", QuoteDenom: "uusdc", OsmosisPoolId: 2},
+	}
+	expectedQueryIds := []string{}
+	for _, tokenPrice := range tokenPrices {
+		s.App.ICQOracleKeeper.SetTokenPrice(s.Ctx, tokenPrice)
+		expectedQueryIds = append(expectedQueryIds,
+			types.TokenPriceQueryId(tokenPrice.BaseDenom, tokenPrice.QuoteDenom, tokenPrice.OsmosisPoolId))
+	}
+
+	err := s.App.ICQOracleKeeper.RegisterAllOsmosisPriceQueries(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().ElementsMatch(expectedQueryIds, registeredQueryIds, "registered query ids")
+
+	// If any registration fails, the error should be returned
+	s.mockICQKeeper = MockICQKeeper{
+		RegisterRecurringQueryFn: func(ctx sdk.Context, recurringQuery icqtypes.RecurringQuery) error {
+			return fmt.Errorf("mock ICQ register error")
+		},
 	}
+	s.App.ICQOracleKeeper.IcqKeeper = s.mockICQKeeper
+
+	err = s.App.ICQOracleKeeper.RegisterAllOsmosisPriceQueries(s.Ctx)
+	s.Require().ErrorContains(err, "failed to register Osmosis CL pool ICQ")
 }
 
 // Helper function to create mock twap data
@@ -261,7 +234,6 @@ func (s *KeeperTestSuite) TestOsmosisPriceCallback() {
 		OsmosisBaseDenom:  "ibc/uatom",
 		OsmosisQuoteDenom: "ibc/uusdc",
 		SpotPrice:         math.LegacyNewDec(2),
-		QueryInProgress:   true,
 	}
 
 	testCases := []struct {
@@ -297,7 +269,6 @@ func (s *KeeperTestSuite) TestOsmosisPriceCallback() {
 				)
 
 				// Verify updated fields
-				s.Require().False(tokenPrice.QueryInProgress)
 				s.Require().Equal(s.Ctx.BlockTime().UnixNano(), tokenPrice.LastResponseTime.UnixNano())
 				s.Require().InDelta(1.5, tokenPrice.SpotPrice.MustFloat64(), 0.00001)
 			},
@@ -329,7 +300,6 @@ func (s *KeeperTestSuite) TestOsmosisPriceCallback() {
 				)
 
 				// Verify updated fields
-				s.Require().False(tokenPrice.QueryInProgress)
 				s.Require().Equal(s.Ctx.BlockTime().UnixNano(), tokenPrice.LastResponseTime.UnixNano())
 				s.Require().InDelta(1.5, tokenPrice.SpotPrice.MustFloat64(), 0.00001) // inversed price
 			},
@@ -350,17 +320,38 @@ func (s *KeeperTestSuite) TestOsmosisPriceCallback() {
 			expectedError: "token price not found",
 		},
 		{
-			name: "query not in progress",
+			name: "request time read from recurring query",
 			setup: func() (responseBz []byte, callbackDataBz []byte) {
-				tokenPrice := baseTokenPrice
-				tokenPrice.QueryInProgress = false
-				s.App.ICQOracleKeeper.SetTokenPrice(s.Ctx, tokenPrice)
+				s.App.ICQOracleKeeper.SetTokenPrice(s.Ctx, baseTokenPrice)
+
+				// Mock the recurring query that issued the request
+				s.App.ICQOracleKeeper.IcqKeeper = MockICQKeeper{
+					GetRecurringQueryFn: func(ctx sdk.Context, ownerModule, id string) (icqtypes.RecurringQuery, bool) {
+						return icqtypes.RecurringQuery{LastSubmissionTime: s.Ctx.BlockTime().Add(-time.Minute)}, true
+					},
+				}
 
-				return []byte{}, s.App.AppCodec().MustMarshal(&tokenPrice)
+				poolData := s.createMockTwapData(
+					baseTokenPrice.OsmosisBaseDenom,  // base
+					baseTokenPrice.OsmosisQuoteDenom, // quote
+					baseTokenPrice.OsmosisBaseDenom,  // asset0
+					baseTokenPrice.OsmosisQuoteDenom, // asset1
+				)
+
+				return poolData, s.App.AppCodec().MustMarshal(&baseTokenPrice)
 			},
 			expectedError: "",
 			verify: func(err error) {
-				s.Require().NoError(err, "no error expected when query not in progress")
+				s.Require().NoError(err)
+
+				tokenPrice := s.MustGetTokenPrice(
+					baseTokenPrice.BaseDenom,
+					baseTokenPrice.QuoteDenom,
+					baseTokenPrice.OsmosisPoolId,
+				)
+
+				s.Require().Equal(s.Ctx.BlockTime().Add(-time.Minute).UnixNano(), tokenPrice.LastRequestTime.UnixNano(), "request time")
+				s.Require().Equal(s.Ctx.BlockTime().UnixNano(), tokenPrice.LastResponseTime.UnixNano(), "response time")
 			},
 		},
 		{
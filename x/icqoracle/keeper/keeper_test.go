@@ -21,7 +21,7 @@ type KeeperTestSuite struct {
 // Helper function to setup keeper with mock ICQ keeper
 func (s *KeeperTestSuite) SetupMockICQKeeper() {
 	mockICQKeeper := MockICQKeeper{
-		SubmitICQRequestFn: func(ctx sdk.Context, query icqtypes.Query, forceUnique bool) error {
+		RegisterRecurringQueryFn: func(ctx sdk.Context, recurringQuery icqtypes.RecurringQuery) error {
 			return nil
 		},
 	}
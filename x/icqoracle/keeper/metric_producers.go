@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v27/utils"
+	icaoracletypes "github.com/Stride-Labs/stride/v27/x/icaoracle/types"
+)
+
+const MetricProducerID_TokenPrices = "icqoracle_token_prices"
+
+// Metric producers that push token prices to the oracles
+// The cadence and target oracles are configured through governance in icaoracle
+func (k Keeper) MetricProducers() icaoracletypes.ModuleMetricProducers {
+	return icaoracletypes.ModuleMetricProducers{
+		MetricProducerID_TokenPrices: k.ProduceTokenPriceMetrics,
+	}
+}
+
+// Produces the spot price of each token price that has a recent response from Osmosis
+// Prices that have not been initialized, or that are stale, are skipped
+// Metric keys are of format: {baseDenom}_{quoteDenom}_{poolId}_token_price
+func (k Keeper) ProduceTokenPriceMetrics(ctx sdk.Context) ([]icaoracletypes.MetricUpdate, error) {
+	priceExpirationTimeoutSec := utils.UintToInt(k.GetParams(ctx).PriceExpirationTimeoutSec)
+
+	metrics := []icaoracletypes.MetricUpdate{}
+	for _, tokenPrice := range k.GetAllTokenPrices(ctx) {
+		if tokenPrice.SpotPrice.IsNil() || tokenPrice.SpotPrice.IsZero() {
+			continue
+		}
+		if ctx.BlockTime().Unix()-tokenPrice.LastResponseTime.Unix() > priceExpirationTimeoutSec {
+			continue
+		}
+
+		attributes, err := json.Marshal(icaoracletypes.TokenPriceAttributes{
+			BaseDenom:     tokenPrice.BaseDenom,
+			QuoteDenom:    tokenPrice.QuoteDenom,
+			OsmosisPoolId: tokenPrice.OsmosisPoolId,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		metricKey := fmt.Sprintf("%s_%s_%d_%s", tokenPrice.BaseDenom, tokenPrice.QuoteDenom,
+			tokenPrice.OsmosisPoolId, icaoracletypes.MetricType_TokenPrice)
+		metrics = append(metrics, icaoracletypes.MetricUpdate{
+			Key:        metricKey,
+			Value:      tokenPrice.SpotPrice.String(),
+			MetricType: icaoracletypes.MetricType_TokenPrice,
+			Attributes: string(attributes),
+		})
+	}
+
+	return metrics, nil
+}
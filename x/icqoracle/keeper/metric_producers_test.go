@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icaoracletypes "github.com/Stride-Labs/stride/v27/x/icaoracle/types"
+	"github.com/Stride-Labs/stride/v27/x/icqoracle/types"
+)
+
+func (s *KeeperTestSuite) TestProduceTokenPriceMetrics() {
+	params := types.Params{PriceExpirationTimeoutSec: 60}
+	s.App.ICQOracleKeeper.SetParams(s.Ctx, params)
+
+	freshTime := s.Ctx.BlockTime().Add(-1 * time.Second)
+	staleTime := s.Ctx.BlockTime().Add(-1 * time.Hour)
+
+	tokenPrices := []types.TokenPrice{
+		// Fresh price - should be included
+		{BaseDenom: "base-1", QuoteDenom: "quote", OsmosisPoolId: 1, SpotPrice: sdk.NewDec(2), LastResponseTime: freshTime},
+		// Stale price - should be skipped
+		{BaseDenom: "base-2", QuoteDenom: "quote", OsmosisPoolId: 2, SpotPrice: sdk.NewDec(3), LastResponseTime: staleTime},
+		// Uninitialized price - should be skipped
+		{BaseDenom: "base-3", QuoteDenom: "quote", OsmosisPoolId: 3, SpotPrice: sdk.ZeroDec(), LastResponseTime: freshTime},
+	}
+	for _, tokenPrice := range tokenPrices {
+		s.App.ICQOracleKeeper.SetTokenPrice(s.Ctx, tokenPrice)
+	}
+
+	metrics, err := s.App.ICQOracleKeeper.ProduceTokenPriceMetrics(s.Ctx)
+	s.Require().NoError(err, "no error expected when producing token price metrics")
+	s.Require().Len(metrics, 1, "number of metrics")
+
+	expectedAttributes, err := json.Marshal(icaoracletypes.TokenPriceAttributes{
+		BaseDenom:     "base-1",
+		QuoteDenom:    "quote",
+		OsmosisPoolId: 1,
+	})
+	s.Require().NoError(err)
+
+	s.Require().Equal(icaoracletypes.MetricUpdate{
+		Key:        "base-1_quote_1_token_price",
+		Value:      sdk.NewDec(2).String(),
+		MetricType: icaoracletypes.MetricType_TokenPrice,
+		Attributes: string(expectedAttributes),
+	}, metrics[0], "token price metric")
+}
@@ -42,10 +42,13 @@ func (ms msgServer) RegisterTokenPriceQuery(goCtx context.Context, msg *types.Ms
 		OsmosisQuoteDenom: msg.OsmosisQuoteDenom,
 		LastRequestTime:   time.Time{},
 		SpotPrice:         sdkmath.LegacyZeroDec(),
-		QueryInProgress:   false,
 	}
 	ms.Keeper.SetTokenPrice(ctx, tokenPrice)
 
+	if err := ms.Keeper.RegisterOsmosisPriceQuery(ctx, tokenPrice); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgRegisterTokenPriceQueryResponse{}, nil
 }
 
@@ -55,6 +58,9 @@ func (ms msgServer) RemoveTokenPriceQuery(goCtx context.Context, msg *types.MsgR
 
 	ms.Keeper.RemoveTokenPrice(ctx, msg.BaseDenom, msg.QuoteDenom, msg.OsmosisPoolId)
 
+	queryId := types.TokenPriceQueryId(msg.BaseDenom, msg.QuoteDenom, msg.OsmosisPoolId)
+	ms.Keeper.IcqKeeper.RemoveRecurringQuery(ctx, types.ModuleName, queryId)
+
 	return &types.MsgRemoveTokenPriceQueryResponse{}, nil
 }
 
@@ -66,5 +72,10 @@ func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	k.SetParams(ctx, req.Params)
 
+	// Re-register the price queries so that they pick up the new connection and interval
+	if err := k.RegisterAllOsmosisPriceQueries(ctx); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
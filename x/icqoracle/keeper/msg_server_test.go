@@ -7,9 +7,19 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/Stride-Labs/stride/v27/x/icqoracle/types"
+	icqtypes "github.com/Stride-Labs/stride/v27/x/interchainquery/types"
 )
 
 func (s *KeeperTestSuite) TestRegisterTokenPriceQuery() {
+	// Capture the recurring query registered with the interchainquery module
+	registeredQueryIds := []string{}
+	s.App.ICQOracleKeeper.IcqKeeper = MockICQKeeper{
+		RegisterRecurringQueryFn: func(ctx sdk.Context, recurringQuery icqtypes.RecurringQuery) error {
+			registeredQueryIds = append(registeredQueryIds, recurringQuery.Id)
+			return nil
+		},
+	}
+
 	// Create a new token price query
 	msg := types.MsgRegisterTokenPriceQuery{
 		BaseDenom:         "uatom",
@@ -30,15 +40,27 @@ func (s *KeeperTestSuite) TestRegisterTokenPriceQuery() {
 	s.Require().Equal(msg.OsmosisBaseDenom, tokenPrice.OsmosisBaseDenom, "osmosis base denom")
 	s.Require().Equal(msg.OsmosisQuoteDenom, tokenPrice.OsmosisQuoteDenom, "osmosis quote denom")
 	s.Require().Equal(sdkmath.LegacyZeroDec(), tokenPrice.SpotPrice, "spot price")
-	s.Require().Equal(false, tokenPrice.QueryInProgress, "query in progress")
 	s.Require().Equal(time.Time{}, tokenPrice.LastRequestTime, "updated at")
 
+	// Confirm the recurring price query was registered
+	expectedQueryId := types.TokenPriceQueryId(msg.BaseDenom, msg.QuoteDenom, msg.OsmosisPoolId)
+	s.Require().Equal([]string{expectedQueryId}, registeredQueryIds, "registered query ids")
+
 	// Attempt to register it again, it should fail
 	_, err = s.GetMsgServer().RegisterTokenPriceQuery(sdk.UnwrapSDKContext(s.Ctx), &msg)
 	s.Require().ErrorIs(err, types.ErrTokenPriceAlreadyExists)
 }
 
 func (s *KeeperTestSuite) TestRemoveTokenPriceQuery() {
+	// Capture the recurring query removed from the interchainquery module
+	removedQueryIds := []string{}
+	s.App.ICQOracleKeeper.IcqKeeper = MockICQKeeper{
+		RemoveRecurringQueryFn: func(ctx sdk.Context, ownerModule, id string) {
+			s.Require().Equal(types.ModuleName, ownerModule, "owner module")
+			removedQueryIds = append(removedQueryIds, id)
+		},
+	}
+
 	// Create a token price
 	tokenPrice := types.TokenPrice{
 		BaseDenom:         "uatom",
@@ -48,7 +70,6 @@ func (s *KeeperTestSuite) TestRemoveTokenPriceQuery() {
 		OsmosisQuoteDenom: "uusdc",
 		SpotPrice:         sdkmath.LegacyNewDec(1),
 		LastRequestTime:   time.Now().UTC(),
-		QueryInProgress:   false,
 	}
 	s.App.ICQOracleKeeper.SetTokenPrice(s.Ctx, tokenPrice)
 
@@ -65,7 +86,38 @@ func (s *KeeperTestSuite) TestRemoveTokenPriceQuery() {
 	tp, err := s.App.ICQOracleKeeper.GetTokenPrice(s.Ctx, msg.BaseDenom, msg.QuoteDenom, msg.OsmosisPoolId)
 	s.Require().Error(err, "token price %+v should have been removed", tp)
 
+	// Confirm the recurring price query was removed
+	expectedQueryId := types.TokenPriceQueryId(tokenPrice.BaseDenom, tokenPrice.QuoteDenom, tokenPrice.OsmosisPoolId)
+	s.Require().Equal([]string{expectedQueryId}, removedQueryIds, "removed query ids")
+
 	// Try to remove it again, it should still succeed
 	_, err = s.GetMsgServer().RemoveTokenPriceQuery(sdk.UnwrapSDKContext(s.Ctx), &msg)
 	s.Require().NoError(err, "no error expected when removing non-existent token price query")
 }
+
+func (s *KeeperTestSuite) TestUpdateParams_ReregistersPriceQueries() {
+	// Capture the intervals of the re-registered queries
+	registeredIntervals := []time.Duration{}
+	s.App.ICQOracleKeeper.IcqKeeper = MockICQKeeper{
+		RegisterRecurringQueryFn: func(ctx sdk.Context, recurringQuery icqtypes.RecurringQuery) error {
+			registeredIntervals = append(registeredIntervals, recurringQuery.Interval)
+			return nil
+		},
+	}
+
+	s.App.ICQOracleKeeper.SetTokenPrice(s.Ctx, types.TokenPrice{BaseDenom: "uatom", QuoteDenom: "uusdc", OsmosisPoolId: 1})
+	s.App.ICQOracleKeeper.SetTokenPrice(s.Ctx, types.TokenPrice{BaseDenom: "uosmo", QuoteDenom: "uusdc", OsmosisPoolId: 2})
+
+	params := s.App.ICQOracleKeeper.GetParams(s.Ctx)
+	params.UpdateIntervalSec = 300
+
+	msg := types.MsgUpdateParams{
+		Authority: s.App.ICQOracleKeeper.GetAuthority(),
+		Params:    params,
+	}
+	_, err := s.GetMsgServer().UpdateParams(sdk.UnwrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when updating params")
+
+	// Both queries should have been re-registered with the new interval
+	s.Require().Equal([]time.Duration{5 * time.Minute, 5 * time.Minute}, registeredIntervals, "registered intervals")
+}
@@ -27,25 +27,9 @@ func (k Keeper) RemoveTokenPrice(ctx sdk.Context, baseDenom string, quoteDenom s
 	store.Delete(key)
 }
 
-// Updates the token price when a query is requested
-func (k Keeper) SetQueryInProgress(ctx sdk.Context, baseDenom string, quoteDenom string, osmosisPoolId uint64) error {
-	tokenPrice, err := k.GetTokenPrice(ctx, baseDenom, quoteDenom, osmosisPoolId)
-	if err != nil {
-		return err
-	}
-
-	tokenPrice.QueryInProgress = true
-	tokenPrice.LastRequestTime = ctx.BlockTime()
-
-	k.SetTokenPrice(ctx, tokenPrice)
-
-	return nil
-}
-
 // Updates the token price when a query response is received
 func (k Keeper) SetQueryComplete(ctx sdk.Context, tokenPrice types.TokenPrice, newSpotPrice math.LegacyDec) {
 	tokenPrice.SpotPrice = newSpotPrice
-	tokenPrice.QueryInProgress = false
 	tokenPrice.LastResponseTime = ctx.BlockTime()
 	k.SetTokenPrice(ctx, tokenPrice)
 }
@@ -9,9 +9,11 @@ import (
 	"github.com/Stride-Labs/stride/v27/x/interchainquery/types"
 )
 
-// IcqKeeper defines the expected interface needed to send ICQ requests.
+// IcqKeeper defines the expected interface needed to schedule recurring ICQ requests.
 type IcqKeeper interface {
-	SubmitICQRequest(ctx sdk.Context, icqtypes types.Query, forceUnique bool) error
+	RegisterRecurringQuery(ctx sdk.Context, recurringQuery types.RecurringQuery) error
+	RemoveRecurringQuery(ctx sdk.Context, ownerModule, id string)
+	GetRecurringQuery(ctx sdk.Context, ownerModule, id string) (recurringQuery types.RecurringQuery, found bool)
 }
 
 // IbcTransferKeeper defines the expected interface needed to convert an ibc token hash to its denom on the source chain.
@@ -42,12 +42,14 @@ type TokenPrice struct {
 	OsmosisPoolId uint64 `protobuf:"varint,5,opt,name=osmosis_pool_id,json=osmosisPoolId,proto3" json:"osmosis_pool_id,omitempty"`
 	// Spot price of base_denom denominated in quote_denom
 	SpotPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=spot_price,json=spotPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"spot_price"`
-	// Last time a query request was submitted
+	// Submission time of the query that produced the latest response
+	// (queries are issued by the interchainquery module as a recurring query)
 	LastRequestTime time.Time `protobuf:"bytes,7,opt,name=last_request_time,json=lastRequestTime,proto3,stdtime" json:"last_request_time"`
 	// Last time a query response was received
 	LastResponseTime time.Time `protobuf:"bytes,8,opt,name=last_response_time,json=lastResponseTime,proto3,stdtime" json:"last_response_time"`
-	// Whether there is a spot price query currently in progress
-	QueryInProgress bool `protobuf:"varint,9,opt,name=query_in_progress,json=queryInProgress,proto3" json:"query_in_progress,omitempty"`
+	// Deprecated, queries are now issued by the interchainquery module as a
+	// recurring query
+	QueryInProgress bool `protobuf:"varint,9,opt,name=query_in_progress,json=queryInProgress,proto3" json:"query_in_progress,omitempty"` // Deprecated: Do not use.
 }
 
 func (m *TokenPrice) Reset()         { *m = TokenPrice{} }
@@ -132,6 +134,7 @@ func (m *TokenPrice) GetLastResponseTime() time.Time {
 	return time.Time{}
 }
 
+// Deprecated: Do not use.
 func (m *TokenPrice) GetQueryInProgress() bool {
 	if m != nil {
 		return m.QueryInProgress
@@ -221,46 +224,46 @@ func init() {
 func init() { proto.RegisterFile("stride/icqoracle/icqoracle.proto", fileDescriptor_08ead8ab9516d7fc) }
 
 var fileDescriptor_08ead8ab9516d7fc = []byte{
-	// 621 bytes of a gzipped FileDescriptorProto
+	// 620 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6f, 0xd3, 0x3e,
-	0x18, 0xc6, 0x9b, 0xff, 0xfa, 0x1f, 0xab, 0x27, 0xd8, 0x9a, 0x81, 0x28, 0x65, 0xc4, 0x55, 0x26,
-	0xa1, 0x0a, 0x41, 0x22, 0x6d, 0x42, 0x08, 0x8e, 0xd9, 0x10, 0xaa, 0x34, 0xa4, 0x92, 0xed, 0x02,
+	0x18, 0xc6, 0x9b, 0xad, 0xff, 0xfd, 0x57, 0x4f, 0xb0, 0x35, 0x03, 0x51, 0xca, 0x88, 0xab, 0x4c,
+	0x42, 0x3d, 0x40, 0x22, 0x6d, 0x42, 0x08, 0x8e, 0xd9, 0x10, 0xaa, 0x34, 0xa4, 0x92, 0xed, 0x02,
 	0x97, 0xc8, 0x75, 0x4c, 0x66, 0x2d, 0x89, 0xd3, 0xd8, 0x99, 0xd6, 0x6f, 0xc0, 0x71, 0x1f, 0x8a,
-	0xc3, 0x8e, 0x3b, 0x22, 0x0e, 0x01, 0x6d, 0x17, 0xd4, 0x63, 0x3f, 0x01, 0xb2, 0x9d, 0x94, 0x6a,
+	0xc3, 0x8e, 0x3b, 0x22, 0x0e, 0x01, 0x6d, 0xb7, 0x8a, 0x53, 0x3f, 0x01, 0xb2, 0x9d, 0x94, 0x6a,
 	0x4c, 0x93, 0xb8, 0xb9, 0xcf, 0xef, 0x79, 0x9f, 0xb7, 0x79, 0xfd, 0xca, 0xa0, 0xc7, 0x45, 0x4e,
-	0x43, 0xe2, 0x52, 0x3c, 0x66, 0x39, 0xc2, 0xf1, 0xc2, 0xc9, 0xc9, 0x72, 0x26, 0x98, 0xb9, 0xae,
-	0x1d, 0xce, 0x5c, 0xef, 0xde, 0x8f, 0x58, 0xc4, 0x14, 0x74, 0xe5, 0x49, 0xfb, 0xba, 0x30, 0x62,
+	0x43, 0xe2, 0x52, 0x3c, 0x66, 0x39, 0xc2, 0xf1, 0xc2, 0xc9, 0xc9, 0x72, 0x26, 0x98, 0xb9, 0xa1,
+	0x1d, 0xce, 0x5c, 0xef, 0x3e, 0x88, 0x58, 0xc4, 0x14, 0x74, 0xe5, 0x49, 0xfb, 0xba, 0x30, 0x62,
 	0x2c, 0x8a, 0x89, 0xab, 0x7e, 0x8d, 0x8a, 0xcf, 0xae, 0xa0, 0x09, 0xe1, 0x02, 0x25, 0x99, 0x36,
-	0xd8, 0xbf, 0x96, 0x00, 0x38, 0x64, 0xc7, 0x24, 0x1d, 0xe6, 0x14, 0x13, 0xf3, 0x09, 0x00, 0x23,
-	0xc4, 0x49, 0x10, 0x92, 0x94, 0x25, 0x1d, 0xa3, 0x67, 0xf4, 0x5b, 0x7e, 0x4b, 0x2a, 0x7b, 0x52,
-	0x30, 0x21, 0x58, 0x1d, 0x17, 0x4c, 0xd4, 0xfc, 0x3f, 0xc5, 0x81, 0x92, 0xb4, 0xe1, 0x39, 0x30,
-	0x19, 0x4f, 0x18, 0xa7, 0x3c, 0x58, 0xc8, 0x59, 0x52, 0xbe, 0xf5, 0x8a, 0x78, 0xf3, 0x38, 0x07,
-	0x6c, 0xd4, 0xee, 0xc5, 0xd8, 0xa6, 0xb2, 0xb7, 0x2b, 0xf4, 0xe1, 0x4f, 0xfa, 0x53, 0xb0, 0x56,
-	0xfb, 0x33, 0xc6, 0xe2, 0x80, 0x86, 0x9d, 0xff, 0x7b, 0x46, 0xbf, 0xe9, 0xdf, 0xad, 0xe4, 0x21,
-	0x63, 0xf1, 0x20, 0x34, 0x3d, 0x00, 0x78, 0xc6, 0x44, 0x90, 0xc9, 0x6f, 0xea, 0x2c, 0xcb, 0x38,
-	0x6f, 0xeb, 0xbc, 0x84, 0x8d, 0xef, 0x25, 0x7c, 0x8c, 0x95, 0x97, 0x87, 0xc7, 0x0e, 0x65, 0x6e,
-	0x82, 0xc4, 0x91, 0xb3, 0x4f, 0x22, 0x84, 0x27, 0x7b, 0x04, 0xfb, 0x2d, 0x59, 0xa6, 0x27, 0x31,
-	0x04, 0xed, 0x18, 0x71, 0x11, 0xe4, 0x64, 0x5c, 0x10, 0x2e, 0x02, 0x39, 0xb8, 0xce, 0x9d, 0x9e,
-	0xd1, 0x5f, 0xdd, 0xee, 0x3a, 0x7a, 0xaa, 0x4e, 0x3d, 0x55, 0xe7, 0xb0, 0x9e, 0xaa, 0xb7, 0x22,
-	0xdb, 0x9c, 0xfd, 0x80, 0x86, 0xbf, 0x26, 0xcb, 0x7d, 0x5d, 0x2d, 0xb9, 0xe9, 0x03, 0xb3, 0x4a,
-	0xe4, 0x19, 0x4b, 0x39, 0xd1, 0x91, 0x2b, 0xff, 0x10, 0xb9, 0xae, 0x23, 0x75, 0xb9, 0xca, 0x7c,
-	0x06, 0xda, 0xe3, 0x82, 0xe4, 0x93, 0x80, 0xa6, 0x41, 0x96, 0xb3, 0x28, 0x27, 0x9c, 0x77, 0x5a,
-	0x3d, 0xa3, 0xbf, 0xe2, 0xaf, 0x29, 0x30, 0x48, 0x87, 0x95, 0x6c, 0x7f, 0x5d, 0x02, 0xcb, 0x43,
-	0x94, 0xa3, 0x84, 0x9b, 0x1f, 0x41, 0x7d, 0x19, 0x01, 0x3e, 0x42, 0x34, 0x95, 0x93, 0x54, 0x97,
-	0xed, 0xb9, 0xd3, 0x12, 0xfe, 0xc5, 0x66, 0x25, 0x7c, 0x38, 0x41, 0x49, 0xfc, 0xc6, 0xbe, 0x4e,
-	0x6c, 0xff, 0x5e, 0x25, 0xed, 0x4a, 0x65, 0x10, 0x9a, 0x09, 0x78, 0x30, 0x37, 0xb1, 0x34, 0x25,
-	0x58, 0x50, 0xa6, 0xf2, 0xd5, 0xb2, 0x78, 0xaf, 0xa7, 0x25, 0xbc, 0xd9, 0x30, 0x2b, 0xe1, 0xe6,
-	0xb5, 0x26, 0x8b, 0xd8, 0xf6, 0xeb, 0x5d, 0xd9, 0x9d, 0xcb, 0x83, 0xd0, 0x24, 0x60, 0xa3, 0xc8,
-	0x42, 0x24, 0x48, 0x40, 0x53, 0x41, 0xf2, 0x13, 0x14, 0x07, 0x9c, 0x60, 0xb5, 0x71, 0x4d, 0xef,
-	0xe5, 0xb4, 0x84, 0x37, 0xe1, 0x59, 0x09, 0xbb, 0xba, 0xd5, 0x0d, 0xd0, 0xf6, 0xdb, 0x5a, 0x1d,
-	0x54, 0xe2, 0x01, 0xc1, 0xe6, 0x17, 0x03, 0x6c, 0xaa, 0x6d, 0x0a, 0xc8, 0x69, 0x46, 0x73, 0xa4,
-	0xfe, 0x94, 0xbc, 0x3f, 0x56, 0x08, 0xd5, 0xb0, 0xa9, 0x1a, 0xbe, 0x9b, 0x96, 0xf0, 0x56, 0xdf,
-	0xac, 0x84, 0x5b, 0xba, 0xf3, 0x6d, 0x2e, 0xdb, 0x7f, 0xa4, 0xf0, 0xdb, 0x39, 0x3d, 0xd4, 0xf0,
-	0x80, 0x60, 0xef, 0xfd, 0xf9, 0xa5, 0x65, 0x5c, 0x5c, 0x5a, 0xc6, 0xcf, 0x4b, 0xcb, 0x38, 0xbb,
-	0xb2, 0x1a, 0x17, 0x57, 0x56, 0xe3, 0xdb, 0x95, 0xd5, 0xf8, 0xb4, 0x13, 0x51, 0x71, 0x54, 0x8c,
-	0x1c, 0xcc, 0x12, 0xf7, 0x40, 0xbd, 0x0f, 0x2f, 0xf6, 0xd1, 0x88, 0xbb, 0xd5, 0x6b, 0x72, 0xb2,
-	0xfd, 0xca, 0x3d, 0x5d, 0x78, 0x53, 0xc4, 0x24, 0x23, 0x7c, 0xb4, 0xac, 0x36, 0x6e, 0xe7, 0x77,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xab, 0x72, 0x1b, 0x62, 0x74, 0x04, 0x00, 0x00,
+	0xd8, 0xbf, 0x96, 0x01, 0x38, 0x62, 0x27, 0x24, 0x1d, 0xe6, 0x14, 0x13, 0xf3, 0x29, 0x00, 0x23,
+	0xc4, 0x49, 0x10, 0x92, 0x94, 0x25, 0x1d, 0xa3, 0x67, 0xf4, 0x5b, 0x7e, 0x4b, 0x2a, 0xfb, 0x52,
+	0x30, 0x21, 0x58, 0x1b, 0x17, 0x4c, 0xd4, 0x7c, 0x49, 0x71, 0xa0, 0x24, 0x6d, 0x78, 0x0e, 0x4c,
+	0xc6, 0x13, 0xc6, 0x29, 0x0f, 0x16, 0x72, 0x96, 0x95, 0x6f, 0xa3, 0x22, 0xde, 0x3c, 0xce, 0x01,
+	0x9b, 0xb5, 0x7b, 0x31, 0xb6, 0xa9, 0xec, 0xed, 0x0a, 0x7d, 0xf8, 0x93, 0xfe, 0x0c, 0xac, 0xd7,
+	0xfe, 0x8c, 0xb1, 0x38, 0xa0, 0x61, 0xe7, 0xbf, 0x9e, 0xd1, 0x6f, 0xfa, 0xf7, 0x2a, 0x79, 0xc8,
+	0x58, 0x3c, 0x08, 0x4d, 0x0f, 0x00, 0x9e, 0x31, 0x11, 0x64, 0xf2, 0x9b, 0x3a, 0x2b, 0x32, 0xce,
+	0xdb, 0xbe, 0x28, 0x61, 0xe3, 0x7b, 0x09, 0x9f, 0x60, 0xe5, 0xe5, 0xe1, 0x89, 0x43, 0x99, 0x9b,
+	0x20, 0x71, 0xec, 0x1c, 0x90, 0x08, 0xe1, 0xc9, 0x3e, 0xc1, 0x7e, 0x4b, 0x96, 0xe9, 0x49, 0x0c,
+	0x41, 0x3b, 0x46, 0x5c, 0x04, 0x39, 0x19, 0x17, 0x84, 0x8b, 0x40, 0x0e, 0xae, 0xf3, 0x7f, 0xcf,
+	0xe8, 0xaf, 0xed, 0x74, 0x1d, 0x3d, 0x55, 0xa7, 0x9e, 0xaa, 0x73, 0x54, 0x4f, 0xd5, 0x5b, 0x95,
+	0x6d, 0xce, 0x7f, 0x40, 0xc3, 0x5f, 0x97, 0xe5, 0xbe, 0xae, 0x96, 0xdc, 0xf4, 0x81, 0x59, 0x25,
+	0xf2, 0x8c, 0xa5, 0x9c, 0xe8, 0xc8, 0xd5, 0x7f, 0x88, 0xdc, 0xd0, 0x91, 0xba, 0x5c, 0x65, 0x3a,
+	0xa0, 0x3d, 0x2e, 0x48, 0x3e, 0x09, 0x68, 0x1a, 0x64, 0x39, 0x8b, 0x72, 0xc2, 0x79, 0xa7, 0xd5,
+	0x33, 0xfa, 0xab, 0xde, 0x52, 0xc7, 0xf0, 0xd7, 0x15, 0x1c, 0xa4, 0xc3, 0x0a, 0xd9, 0x5f, 0x97,
+	0xc1, 0xca, 0x10, 0xe5, 0x28, 0xe1, 0xe6, 0x47, 0x50, 0x5f, 0x48, 0x80, 0x8f, 0x11, 0x4d, 0xe5,
+	0x34, 0xd5, 0x85, 0x7b, 0xee, 0xb4, 0x84, 0x7f, 0xb1, 0x59, 0x09, 0x1f, 0x4d, 0x50, 0x12, 0xbf,
+	0xb1, 0x6f, 0x12, 0xdb, 0xbf, 0x5f, 0x49, 0x7b, 0x52, 0x19, 0x84, 0x66, 0x02, 0x1e, 0xce, 0x4d,
+	0x2c, 0x4d, 0x09, 0x16, 0x94, 0xa9, 0x7c, 0xb5, 0x30, 0xde, 0xeb, 0x69, 0x09, 0x6f, 0x37, 0xcc,
+	0x4a, 0xb8, 0x75, 0xa3, 0xc9, 0x22, 0xb6, 0xfd, 0x7a, 0x5f, 0xf6, 0xe6, 0xf2, 0x20, 0x34, 0x09,
+	0xd8, 0x2c, 0xb2, 0x10, 0x09, 0x12, 0xd0, 0x54, 0x90, 0xfc, 0x14, 0xc5, 0x01, 0x27, 0x58, 0x6d,
+	0x5d, 0xd3, 0x7b, 0x39, 0x2d, 0xe1, 0x6d, 0x78, 0x56, 0xc2, 0xae, 0x6e, 0x75, 0x0b, 0xb4, 0xfd,
+	0xb6, 0x56, 0x07, 0x95, 0x78, 0x48, 0xb0, 0xf9, 0xc5, 0x00, 0x5b, 0x6a, 0xa3, 0x02, 0x72, 0x96,
+	0xd1, 0x1c, 0xa9, 0x3f, 0x25, 0xef, 0x90, 0x15, 0x42, 0x35, 0x6c, 0xaa, 0x86, 0xef, 0xa6, 0x25,
+	0xbc, 0xd3, 0x37, 0x2b, 0xe1, 0xb6, 0xee, 0x7c, 0x97, 0xcb, 0xf6, 0x1f, 0x2b, 0xfc, 0x76, 0x4e,
+	0x8f, 0x34, 0x3c, 0x24, 0xd8, 0x7b, 0x7f, 0x71, 0x65, 0x19, 0x97, 0x57, 0x96, 0xf1, 0xf3, 0xca,
+	0x32, 0xce, 0xaf, 0xad, 0xc6, 0xe5, 0xb5, 0xd5, 0xf8, 0x76, 0x6d, 0x35, 0x3e, 0xed, 0x46, 0x54,
+	0x1c, 0x17, 0x23, 0x07, 0xb3, 0xc4, 0x3d, 0x54, 0x6f, 0xc4, 0x8b, 0x03, 0x34, 0xe2, 0x6e, 0xf5,
+	0xa2, 0x9c, 0xee, 0xbc, 0x72, 0xcf, 0x16, 0xde, 0x15, 0x31, 0xc9, 0x08, 0x1f, 0xad, 0xa8, 0xad,
+	0xdb, 0xfd, 0x3d, 0x00, 0x64, 0xa9, 0x60, 0xf2, 0x78, 0x04, 0x00, 0x00,
 }
 
 func (m *TokenPrice) Marshal() (dAtA []byte, err error) {
@@ -26,3 +26,8 @@ func TokenPriceKey(baseDenom, quoteDenom string, poolId uint64) []byte {
 func TokenPriceByDenomKey(baseDenom string) []byte {
 	return []byte(baseDenom)
 }
+
+// ID of the recurring interchain query that refreshes a token price
+func TokenPriceQueryId(baseDenom, quoteDenom string, poolId uint64) string {
+	return string(TokenPriceKey(baseDenom, quoteDenom, poolId))
+}
@@ -28,6 +28,8 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetCmdListPendingQueries(),
+		GetCmdPendingQueryStats(),
+		GetCmdListRecurringQueries(),
 	)
 
 	return cmd
@@ -67,3 +69,71 @@ func GetCmdListPendingQueries() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdPendingQueryStats provides the number and age of pending queries for each chain and callback
+func GetCmdPendingQueryStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-query-stats",
+		Short: "Query the number and age of pending queries for each chain and callback",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query interchainquery pending-query-stats`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryServiceClient(clientCtx)
+
+			req := &types.QueryPendingQueryStatsRequest{}
+
+			res, err := queryClient.PendingQueryStats(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdListRecurringQueries provides a list of all registered recurring queries
+func GetCmdListRecurringQueries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-recurring-queries",
+		Short: "Query all registered recurring queries",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query interchainquery list-recurring-queries`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryServiceClient(clientCtx)
+
+			req := &types.QueryRecurringQueriesRequest{}
+
+			res, err := queryClient.RecurringQueries(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,6 +1,8 @@
 package interchainquery
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/Stride-Labs/stride/v27/x/interchainquery/keeper"
@@ -15,11 +17,20 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		// Initialize empty epoch values via Cosmos SDK
 		k.SetQuery(ctx, query)
 	}
+	for _, recurringQuery := range genState.RecurringQueries {
+		k.SetRecurringQuery(ctx, recurringQuery)
+	}
+
+	// Bind the port used for async-icq channels
+	if err := k.BindPort(ctx); err != nil {
+		panic(fmt.Sprintf("unable to bind async-icq port: %s", err.Error()))
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Queries: k.AllQueries(ctx),
+		Queries:          k.AllQueries(ctx),
+		RecurringQueries: k.AllRecurringQueries(ctx),
 	}
 }
@@ -0,0 +1,174 @@
+package interchainquery
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	"github.com/Stride-Labs/stride/v27/x/interchainquery/keeper"
+	"github.com/Stride-Labs/stride/v27/x/interchainquery/types"
+)
+
+var _ porttypes.IBCModule = &IBCModule{}
+
+// IBCModule implements the controller side of the ICS-31 (async-icq) protocol
+// Stride only sends queries over these channels, and does not respond to queries
+type IBCModule struct {
+	keeper keeper.Keeper
+}
+
+func NewIBCModule(k keeper.Keeper) IBCModule {
+	return IBCModule{
+		keeper: k,
+	}
+}
+
+// Validates the channel parameters and claims the channel capability
+func (im IBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	channelCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	if order != channeltypes.UNORDERED {
+		return "", errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.UNORDERED, order)
+	}
+	if portID != types.PortID {
+		return "", errorsmod.Wrapf(types.ErrInvalidICQChannel, "invalid port %s, expected %s", portID, types.PortID)
+	}
+	if version != "" && version != types.Version {
+		return "", errorsmod.Wrapf(types.ErrInvalidICQChannel, "invalid version %s, expected %s", version, types.Version)
+	}
+
+	if err := im.keeper.ClaimCapability(ctx, channelCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return "", err
+	}
+
+	return types.Version, nil
+}
+
+// Stride only acts as the controller, so the channel handshake cannot be initiated by the counterparty
+func (im IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return "", errorsmod.Wrapf(types.ErrInvalidICQChannel, "async-icq channels must be initiated from Stride")
+}
+
+// Validates the counterparty version and stores the channel as the async-icq channel for the connection
+func (im IBCModule) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	if counterpartyVersion != types.Version {
+		return errorsmod.Wrapf(types.ErrInvalidICQChannel, "invalid counterparty version %s, expected %s", counterpartyVersion, types.Version)
+	}
+
+	channel, found := im.keeper.IBCKeeper.ChannelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "channel %s not found", channelID)
+	}
+
+	connectionId := channel.ConnectionHops[0]
+	im.keeper.SetICQChannel(ctx, connectionId, channelID)
+
+	im.keeper.Logger(ctx).Info(fmt.Sprintf("Async-icq channel %s opened on connection %s", channelID, connectionId))
+
+	return nil
+}
+
+// Stride only acts as the controller, so the channel handshake cannot be confirmed on Stride
+func (im IBCModule) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return errorsmod.Wrapf(types.ErrInvalidICQChannel, "async-icq channels must be initiated from Stride")
+}
+
+// Async-icq channels cannot be closed by users
+func (im IBCModule) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return errorsmod.Wrapf(types.ErrInvalidICQChannel, "async-icq channels cannot be closed")
+}
+
+// Removes the channel from the connection if it was closed by the counterparty
+func (im IBCModule) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	channel, found := im.keeper.IBCKeeper.ChannelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return nil
+	}
+
+	connectionId := channel.ConnectionHops[0]
+	if icqChannelId, found := im.keeper.GetICQChannel(ctx, connectionId); found && icqChannelId == channelID {
+		im.keeper.RemoveICQChannel(ctx, connectionId)
+	}
+
+	return nil
+}
+
+// Invokes the query callback with the result from the acknowledgement
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	im.keeper.Logger(ctx).Info(fmt.Sprintf("OnAcknowledgementPacket (Interchainquery) - packet: %+v, relayer: %v", packet, relayer))
+
+	if err := im.keeper.OnAsyncICQAcknowledgement(ctx, packet, acknowledgement); err != nil {
+		return errorsmod.Wrapf(err, "unable to process async-icq acknowledgement | Sequence %d, from %s %s, to %s %s",
+			packet.Sequence, packet.SourceChannel, packet.SourcePort, packet.DestinationChannel, packet.DestinationPort)
+	}
+	return nil
+}
+
+// Applies the query's timeout policy
+func (im IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	im.keeper.Logger(ctx).Info(fmt.Sprintf("OnTimeoutPacket (Interchainquery) - packet: %+v, relayer: %v", packet, relayer))
+
+	if err := im.keeper.OnAsyncICQTimeout(ctx, packet); err != nil {
+		return errorsmod.Wrapf(err, "unable to process async-icq timeout | Sequence %d, from %s %s, to %s %s",
+			packet.Sequence, packet.SourceChannel, packet.SourcePort, packet.DestinationChannel, packet.DestinationPort)
+	}
+	return nil
+}
+
+// Stride does not respond to queries, so any received packet is rejected
+func (im IBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	return channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(types.ErrInvalidICQChannel, "async-icq packets cannot be received on Stride"))
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -15,35 +16,67 @@ import (
 func (k Keeper) EndBlocker(ctx sdk.Context) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
+	// Re-issue any recurring queries that are due, so that they're sent in the pass below
+	k.SubmitDueRecurringQueries(ctx)
+
+	// In a single pass over the queries:
+	//   - Remove any queries that timed out without a response (retried queries are sent this block)
+	//   - Emit the request event for each query that has not yet been sent to the relayer
+	//   - Tally the pending queries for telemetry
 	events := sdk.Events{}
+	pendingStats := pendingQueryStatsTracker{}
 	for _, query := range k.AllQueries(ctx) {
-		if query.RequestSent {
-			continue
+		if query.HasTimedOut(ctx.BlockTime()) {
+			retriedQuery, retried := k.SweepTimedOutQuery(ctx, query)
+			if !retried {
+				continue
+			}
+			query = retriedQuery
 		}
 
-		k.Logger(ctx).Info(fmt.Sprintf("Interchainquery event emitted %s", query.Id))
-
-		event := sdk.NewEvent(
-			sdk.EventTypeMessage,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueQuery),
-			sdk.NewAttribute(types.AttributeKeyQueryId, query.Id),
-			sdk.NewAttribute(types.AttributeKeyChainId, query.ChainId),
-			sdk.NewAttribute(types.AttributeKeyConnectionId, query.ConnectionId),
-			sdk.NewAttribute(types.AttributeKeyType, query.QueryType),
-			sdk.NewAttribute(types.AttributeKeyHeight, "0"),
-			sdk.NewAttribute(types.AttributeKeyRequest, hex.EncodeToString(query.RequestData)),
-		)
-		events = append(events, event)
-
-		event.Type = "query_request"
-		events = append(events, event)
-
-		query.RequestSent = true
-		k.SetQuery(ctx, query)
+		if !query.RequestSent {
+			events = append(events, k.SendQueryRequest(ctx, &query)...)
+		}
+		pendingStats.add(query, ctx.BlockTime())
 	}
 
 	if len(events) > 0 {
 		ctx.EventManager().EmitEvents(events)
 	}
+
+	k.EmitPendingQueryTelemetry(pendingStats.stats())
+}
+
+// Builds the events that notify the relayer of a query, and marks the query as sent
+func (k Keeper) SendQueryRequest(ctx sdk.Context, query *types.Query) sdk.Events {
+	k.Logger(ctx).Info(fmt.Sprintf("Interchainquery event emitted %s", query.Id))
+
+	event := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueQuery),
+		sdk.NewAttribute(types.AttributeKeyQueryId, query.Id),
+		sdk.NewAttribute(types.AttributeKeyChainId, query.ChainId),
+		sdk.NewAttribute(types.AttributeKeyConnectionId, query.ConnectionId),
+		sdk.NewAttribute(types.AttributeKeyType, query.QueryType),
+		sdk.NewAttribute(types.AttributeKeyHeight, "0"),
+		sdk.NewAttribute(types.AttributeKeyRequest, hex.EncodeToString(query.RequestData)),
+	)
+
+	// For batched queries, the hex encoded keys are comma separated
+	if query.IsBatch() {
+		batchRequest := []string{}
+		for _, key := range query.BatchRequestData {
+			batchRequest = append(batchRequest, hex.EncodeToString(key))
+		}
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyBatchRequest, strings.Join(batchRequest, ",")))
+	}
+
+	queryRequestEvent := event
+	queryRequestEvent.Type = "query_request"
+
+	query.RequestSent = true
+	k.SetQuery(ctx, *query)
+
+	return sdk.Events{event, queryRequestEvent}
 }
@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+
+	"github.com/Stride-Labs/stride/v27/utils"
+	"github.com/Stride-Labs/stride/v27/x/interchainquery/types"
+)
+
+// Binds the async-icq port, if it's not already bound
+func (k Keeper) BindPort(ctx sdk.Context) error {
+	if _, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(types.PortID)); ok {
+		return nil
+	}
+
+	portCap := k.IBCKeeper.PortKeeper.BindPort(ctx, types.PortID)
+	return k.ClaimCapability(ctx, portCap, host.PortPath(types.PortID))
+}
+
+// Claims a port or channel capability for the module
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
+}
+
+// Stores the async-icq channel used for queries on a given connection
+func (k Keeper) SetICQChannel(ctx sdk.Context, connectionId, channelId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixICQChannel)
+	store.Set([]byte(connectionId), []byte(channelId))
+}
+
+// Returns the async-icq channel used for queries on a given connection
+func (k Keeper) GetICQChannel(ctx sdk.Context, connectionId string) (channelId string, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixICQChannel)
+	bz := store.Get([]byte(connectionId))
+	if len(bz) == 0 {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// Removes the async-icq channel for a given connection
+func (k Keeper) RemoveICQChannel(ctx sdk.Context, connectionId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixICQChannel)
+	store.Delete([]byte(connectionId))
+}
+
+// Stores the ID of the query that was sent in an async-icq packet
+func (k Keeper) SetPacketQueryId(ctx sdk.Context, channelId string, sequence uint64, queryId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPacketQuery)
+	store.Set(types.PacketQueryKey(channelId, sequence), []byte(queryId))
+}
+
+// Returns the ID of the query that was sent in an async-icq packet
+func (k Keeper) GetPacketQueryId(ctx sdk.Context, channelId string, sequence uint64) (queryId string, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPacketQuery)
+	bz := store.Get(types.PacketQueryKey(channelId, sequence))
+	if len(bz) == 0 {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// Removes the query ID associated with an async-icq packet
+func (k Keeper) DeletePacketQueryId(ctx sdk.Context, channelId string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPacketQuery)
+	store.Delete(types.PacketQueryKey(channelId, sequence))
+}
+
+// Sends a query to the host over the async-icq channel on the query's connection
+// The packet times out at the query's timeout timestamp
+func (k Keeper) SendAsyncICQPacket(ctx sdk.Context, query types.Query) error {
+	channelId, found := k.GetICQChannel(ctx, query.ConnectionId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrICQChannelNotFound, "no async-icq channel on connection %s", query.ConnectionId)
+	}
+
+	channelCap, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(types.PortID, channelId))
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidICQChannel, "unable to find capability for channel %s", channelId)
+	}
+
+	packetData, err := types.NewAsyncICQPacketData(query)
+	if err != nil {
+		return err
+	}
+
+	sequence, err := k.IBCKeeper.ChannelKeeper.SendPacket(
+		ctx,
+		channelCap,
+		types.PortID,
+		channelId,
+		clienttypes.ZeroHeight(),
+		query.TimeoutTimestamp,
+		packetData,
+	)
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to send async-icq packet for query %s", query.Id)
+	}
+
+	k.SetPacketQueryId(ctx, channelId, sequence, query.Id)
+
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId,
+		"Sent async-icq packet - QueryId: %s, Channel: %s, Sequence: %d", query.Id, channelId, sequence))
+
+	return nil
+}
+
+// Looks up the pending query that was sent in an async-icq packet
+// Returns false if the query has already been processed (e.g. it was removed after timing out)
+func (k Keeper) getAsyncICQPacketQuery(ctx sdk.Context, packet channeltypes.Packet) (query types.Query, found bool) {
+	queryId, found := k.GetPacketQueryId(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return query, false
+	}
+	k.DeletePacketQueryId(ctx, packet.SourceChannel, packet.Sequence)
+
+	query, found = k.GetQuery(ctx, queryId)
+	if !found {
+		k.Logger(ctx).Info(fmt.Sprintf("Ignoring async-icq packet for query %s that no longer exists", queryId))
+		return query, false
+	}
+
+	return query, true
+}
+
+// Processes the acknowledgement of an async-icq packet by invoking the query's callback
+// with the result returned from the host
+// If the host returned an error, the query is removed without invoking the callback
+func (k Keeper) OnAsyncICQAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	query, found := k.getAsyncICQPacketQuery(ctx, packet)
+	if !found {
+		return nil
+	}
+
+	// Immediately delete the query so it cannot process again
+	k.DeleteQuery(ctx, query.Id)
+
+	responses, err := types.UnpackAsyncICQAcknowledgement(acknowledgement, len(query.GetRequestKeys()))
+	if err != nil {
+		k.Logger(ctx).Error(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId,
+			"Async-icq query failed - QueryId: %s, Error: %s", query.Id, err.Error()))
+		return nil
+	}
+
+	msg := types.MsgSubmitQueryResponse{
+		ChainId: query.ChainId,
+		QueryId: query.Id,
+		Height:  responses[0].Height,
+	}
+	if query.IsBatch() {
+		for _, response := range responses {
+			msg.BatchResults = append(msg.BatchResults, types.BatchQueryResult{Result: response.Value})
+		}
+	} else {
+		msg.Result = responses[0].Value
+	}
+
+	if err := k.InvokeCallback(ctx, &msg, query); err != nil {
+		return err
+	}
+
+	// If the query was issued from a recurring query, record the response time
+	k.RecordRecurringQueryResponse(ctx, query)
+
+	return nil
+}
+
+// Processes the timeout of an async-icq packet by applying the query's timeout policy
+func (k Keeper) OnAsyncICQTimeout(ctx sdk.Context, packet channeltypes.Packet) error {
+	query, found := k.getAsyncICQPacketQuery(ctx, packet)
+	if !found {
+		return nil
+	}
+
+	k.DeleteQuery(ctx, query.Id)
+
+	msg := types.MsgSubmitQueryResponse{ChainId: query.ChainId, QueryId: query.Id}
+	return k.HandleQueryTimeout(ctx, &msg, query)
+}
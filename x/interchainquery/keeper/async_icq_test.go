@@ -0,0 +1,263 @@
+package keeper_test
+
+import (
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+
+	"github.com/Stride-Labs/stride/v27/x/interchainquery/types"
+)
+
+const (
+	AsyncICQChannelId     = "channel-10"
+	AsyncICQHostChannelId = "channel-20"
+	AsyncICQHostPortId    = "icqhost"
+)
+
+// Opens an async-icq channel on the transfer connection and returns a query that uses it
+func (s *KeeperTestSuite) SetupAsyncICQChannel() types.Query {
+	tc := s.SetupMsgSubmitQueryResponse()
+	connectionId := s.TransferPath.EndpointA.ConnectionID
+
+	// Mock out the channel handshake by storing the channel and its capability directly
+	channel := channeltypes.NewChannel(
+		channeltypes.OPEN,
+		channeltypes.UNORDERED,
+		channeltypes.NewCounterparty(AsyncICQHostPortId, AsyncICQHostChannelId),
+		[]string{connectionId},
+		types.Version,
+	)
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, types.PortID, AsyncICQChannelId, channel)
+	s.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(s.Ctx, types.PortID, AsyncICQChannelId, 1)
+
+	capabilityPath := host.ChannelCapabilityPath(types.PortID, AsyncICQChannelId)
+	channelCap, err := s.App.ScopedInterchainqueryKeeper.NewCapability(s.Ctx, capabilityPath)
+	s.Require().NoError(err, "no error expected when creating channel capability")
+	err = s.App.ScopedIBCKeeper.ClaimCapability(s.Ctx, channelCap, capabilityPath)
+	s.Require().NoError(err, "no error expected when claiming channel capability")
+
+	s.App.InterchainqueryKeeper.SetICQChannel(s.Ctx, connectionId, AsyncICQChannelId)
+
+	requestData, err := types.NewBalanceGRPCQueryRequest(s.TestAccs[0].String(), HostChainId)
+	s.Require().NoError(err, "no error expected when building balance request")
+
+	query := tc.query
+	query.Id = ""
+	query.QueryType = types.BANK_BALANCE_GRPC_QUERY
+	query.RequestData = requestData
+	query.TimeoutDuration = time.Hour
+	query.Transport = types.QueryTransport_ASYNC_ICQ_PACKET
+	return query
+}
+
+// Submits an async-icq query and returns the stored query
+func (s *KeeperTestSuite) SubmitAsyncICQQuery(query types.Query) types.Query {
+	err := s.App.InterchainqueryKeeper.SubmitICQRequest(s.Ctx, query, true)
+	s.Require().NoError(err, "no error expected when submitting async-icq query")
+
+	queries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
+	s.Require().Len(queries, 1, "one query should have been submitted")
+	return queries[0]
+}
+
+func (s *KeeperTestSuite) TestBindPort() {
+	_, found := s.App.ScopedInterchainqueryKeeper.GetCapability(s.Ctx, host.PortPath(types.PortID))
+	s.Require().True(found, "async-icq port should be bound at genesis")
+
+	// Binding again should be a no-op
+	err := s.App.InterchainqueryKeeper.BindPort(s.Ctx)
+	s.Require().NoError(err, "no error expected when port is already bound")
+}
+
+func (s *KeeperTestSuite) TestAsyncICQPacketData() {
+	query := types.Query{
+		QueryType:   "/cosmos.bank.v1beta1.Query/Balance",
+		RequestData: []byte("request"),
+	}
+	packetBz, err := types.NewAsyncICQPacketData(query)
+	s.Require().NoError(err, "no error expected when building packet data")
+
+	var packetData types.InterchainQueryPacketData
+	err = types.AsyncICQCdc.UnmarshalJSON(packetBz, &packetData)
+	s.Require().NoError(err, "no error expected when unmarshaling packet data")
+
+	var cosmosQuery types.CosmosQuery
+	err = cosmosQuery.Unmarshal(packetData.Data)
+	s.Require().NoError(err, "no error expected when unmarshaling query")
+
+	s.Require().Len(cosmosQuery.Requests, 1, "number of requests")
+	s.Require().Equal(query.QueryType, cosmosQuery.Requests[0].Path, "query path")
+	s.Require().Equal(query.RequestData, cosmosQuery.Requests[0].Data, "query data")
+
+	// Store paths cannot be executed by the icq host module
+	query.QueryType = types.BANK_STORE_QUERY_WITH_PROOF
+	_, err = types.NewAsyncICQPacketData(query)
+	s.Require().ErrorContains(err, "async-icq queries must use a gRPC query path")
+}
+
+func (s *KeeperTestSuite) TestNewBalanceGRPCQueryRequest() {
+	requestBz, err := types.NewBalanceGRPCQueryRequest("address", "denom")
+	s.Require().NoError(err, "no error expected when building balance request")
+
+	var request banktypes.QueryBalanceRequest
+	err = request.Unmarshal(requestBz)
+	s.Require().NoError(err, "no error expected when unmarshaling balance request")
+	s.Require().Equal("address", request.Address, "request address")
+	s.Require().Equal("denom", request.Denom, "request denom")
+}
+
+func (s *KeeperTestSuite) TestIsGRPCQueryPath() {
+	s.Require().True(types.IsGRPCQueryPath(types.BANK_BALANCE_GRPC_QUERY), "bank balance path")
+	s.Require().True(types.IsGRPCQueryPath("/osmosis.twap.v1beta1.Query/ArithmeticTwapToNow"), "osmosis twap path")
+
+	s.Require().False(types.IsGRPCQueryPath(types.BANK_STORE_QUERY_WITH_PROOF), "store path")
+	s.Require().False(types.IsGRPCQueryPath(""), "empty path")
+	s.Require().False(types.IsGRPCQueryPath("/cosmos.bank.v1beta1.Query"), "missing method")
+	s.Require().False(types.IsGRPCQueryPath("/Query/Balance"), "missing package")
+	s.Require().False(types.IsGRPCQueryPath("cosmos.bank.v1beta1.Query/Balance"), "missing leading slash")
+}
+
+func (s *KeeperTestSuite) TestUnpackAsyncICQAcknowledgement() {
+	// Successful response
+	successAck, err := types.NewAsyncICQAcknowledgement(abci.ResponseQuery{Value: []byte("value"), Height: 10})
+	s.Require().NoError(err)
+
+	responses, err := types.UnpackAsyncICQAcknowledgement(successAck, 1)
+	s.Require().NoError(err, "no error expected for successful ack")
+	s.Require().Len(responses, 1, "number of responses")
+	s.Require().Equal([]byte("value"), responses[0].Value, "response value")
+	s.Require().Equal(int64(10), responses[0].Height, "response height")
+
+	// Query failed on the host
+	failedQueryAck, err := types.NewAsyncICQAcknowledgement(abci.ResponseQuery{Code: 1, Log: "query failed"})
+	s.Require().NoError(err)
+
+	_, err = types.UnpackAsyncICQAcknowledgement(failedQueryAck, 1)
+	s.Require().ErrorContains(err, "query failed on host with code 1")
+
+	// Multiple responses for a batched query
+	multiResponseAck, err := types.NewAsyncICQAcknowledgement(abci.ResponseQuery{}, abci.ResponseQuery{})
+	s.Require().NoError(err)
+
+	responses, err = types.UnpackAsyncICQAcknowledgement(multiResponseAck, 2)
+	s.Require().NoError(err, "no error expected for batched ack")
+	s.Require().Len(responses, 2, "number of batched responses")
+
+	// Number of responses does not match the number of requests
+	_, err = types.UnpackAsyncICQAcknowledgement(multiResponseAck, 1)
+	s.Require().ErrorContains(err, "expected 1 query responses, got 2")
+
+	// Error ack
+	errorAck := channeltypes.NewErrorAcknowledgement(types.ErrInvalidICQRequest).Acknowledgement()
+	_, err = types.UnpackAsyncICQAcknowledgement(errorAck, 1)
+	s.Require().ErrorContains(err, "error acknowledgement")
+}
+
+func (s *KeeperTestSuite) TestSubmitICQRequest_AsyncICQ() {
+	query := s.SubmitAsyncICQQuery(s.SetupAsyncICQChannel())
+
+	// The query should be marked as sent, so that it's not emitted to the relayer
+	s.Require().True(query.RequestSent, "request sent")
+
+	// The packet should have been sent and associated with the query
+	commitment := s.App.IBCKeeper.ChannelKeeper.GetPacketCommitment(s.Ctx, types.PortID, AsyncICQChannelId, 1)
+	s.Require().NotEmpty(commitment, "packet commitment should exist")
+
+	queryId, found := s.App.InterchainqueryKeeper.GetPacketQueryId(s.Ctx, AsyncICQChannelId, 1)
+	s.Require().True(found, "packet query ID should be stored")
+	s.Require().Equal(query.Id, queryId, "packet query ID")
+}
+
+func (s *KeeperTestSuite) TestSubmitICQRequest_AsyncICQ_NoChannel() {
+	query := s.SetupAsyncICQChannel()
+	s.App.InterchainqueryKeeper.RemoveICQChannel(s.Ctx, query.ConnectionId)
+
+	err := s.App.InterchainqueryKeeper.SubmitICQRequest(s.Ctx, query, true)
+	s.Require().ErrorIs(err, types.ErrICQChannelNotFound)
+	s.Require().Empty(s.App.InterchainqueryKeeper.AllQueries(s.Ctx), "no query should be stored")
+}
+
+func (s *KeeperTestSuite) TestOnAsyncICQAcknowledgement_InvokeCallback() {
+	query := s.SubmitAsyncICQQuery(s.SetupAsyncICQChannel())
+	packet := channeltypes.Packet{SourceChannel: AsyncICQChannelId, Sequence: 1}
+
+	// As with the relayer transport, we just want to check that the callback is invoked
+	// The withdrawal balance callback will fail at the start from the invalid response
+	ack, err := types.NewAsyncICQAcknowledgement(abci.ResponseQuery{Value: []byte("invalid")})
+	s.Require().NoError(err)
+
+	err = s.App.InterchainqueryKeeper.OnAsyncICQAcknowledgement(s.Ctx, packet, ack)
+	s.Require().ErrorContains(err, "unable to determine balance from query response")
+
+	_, found := s.App.InterchainqueryKeeper.GetPacketQueryId(s.Ctx, AsyncICQChannelId, 1)
+	s.Require().False(found, "packet query ID should have been removed")
+	_, found = s.App.InterchainqueryKeeper.GetQuery(s.Ctx, query.Id)
+	s.Require().False(found, "query should have been removed")
+}
+
+func (s *KeeperTestSuite) TestOnAsyncICQAcknowledgement_BalanceResponse() {
+	query := s.SubmitAsyncICQQuery(s.SetupAsyncICQChannel())
+	packet := channeltypes.Packet{SourceChannel: AsyncICQChannelId, Sequence: 1}
+
+	// The host returns a gRPC balance response, which the callback should decode
+	// With a zero balance, the callback exits without transferring anything
+	responseBz, err := (&banktypes.QueryBalanceResponse{}).Marshal()
+	s.Require().NoError(err)
+	ack, err := types.NewAsyncICQAcknowledgement(abci.ResponseQuery{Value: responseBz})
+	s.Require().NoError(err)
+
+	err = s.App.InterchainqueryKeeper.OnAsyncICQAcknowledgement(s.Ctx, packet, ack)
+	s.Require().NoError(err, "no error expected when processing balance response")
+
+	_, found := s.App.InterchainqueryKeeper.GetQuery(s.Ctx, query.Id)
+	s.Require().False(found, "query should have been removed")
+}
+
+func (s *KeeperTestSuite) TestOnAsyncICQAcknowledgement_ErrorAck() {
+	query := s.SubmitAsyncICQQuery(s.SetupAsyncICQChannel())
+	packet := channeltypes.Packet{SourceChannel: AsyncICQChannelId, Sequence: 1}
+
+	// An error ack should remove the query without invoking the callback
+	ack := channeltypes.NewErrorAcknowledgement(types.ErrInvalidICQRequest).Acknowledgement()
+	err := s.App.InterchainqueryKeeper.OnAsyncICQAcknowledgement(s.Ctx, packet, ack)
+	s.Require().NoError(err, "no error expected for error ack")
+
+	_, found := s.App.InterchainqueryKeeper.GetQuery(s.Ctx, query.Id)
+	s.Require().False(found, "query should have been removed")
+}
+
+func (s *KeeperTestSuite) TestOnAsyncICQAcknowledgement_QueryNotFound() {
+	query := s.SubmitAsyncICQQuery(s.SetupAsyncICQChannel())
+	packet := channeltypes.Packet{SourceChannel: AsyncICQChannelId, Sequence: 1}
+
+	// If the query was already removed (e.g. swept after a timeout), the ack should be ignored
+	s.App.InterchainqueryKeeper.DeleteQuery(s.Ctx, query.Id)
+
+	ack, err := types.NewAsyncICQAcknowledgement(abci.ResponseQuery{Value: []byte("invalid")})
+	s.Require().NoError(err)
+
+	err = s.App.InterchainqueryKeeper.OnAsyncICQAcknowledgement(s.Ctx, packet, ack)
+	s.Require().NoError(err, "no error expected when query was already removed")
+}
+
+func (s *KeeperTestSuite) TestOnAsyncICQTimeout_RetryQuery() {
+	query := s.SetupAsyncICQChannel()
+	query.TimeoutPolicy = types.TimeoutPolicy_RETRY_QUERY_REQUEST
+	originalQuery := s.SubmitAsyncICQQuery(query)
+
+	packet := channeltypes.Packet{SourceChannel: AsyncICQChannelId, Sequence: 1}
+	err := s.App.InterchainqueryKeeper.OnAsyncICQTimeout(s.Ctx, packet)
+	s.Require().NoError(err, "no error expected when handling timeout")
+
+	// The query should be re-sent in a new packet with a new ID
+	queries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
+	s.Require().Len(queries, 1, "there should be one new query")
+	s.Require().NotEqual(originalQuery.Id, queries[0].Id, "query ID")
+
+	queryId, found := s.App.InterchainqueryKeeper.GetPacketQueryId(s.Ctx, AsyncICQChannelId, 2)
+	s.Require().True(found, "retried packet query ID should be stored")
+	s.Require().Equal(queries[0].Id, queryId, "retried packet query ID")
+}
@@ -0,0 +1,210 @@
+package keeper_test
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/proto/tendermint/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/Stride-Labs/stride/v27/x/interchainquery/types"
+)
+
+const (
+	BatchCallbackModule = "batchtest"
+	BatchCallbackId     = "batchbalances"
+)
+
+// Callback handler that records the results passed to a batched query callback
+type BatchTestCallbacks struct {
+	results *[][]byte
+}
+
+func (c BatchTestCallbacks) AddICQCallback(id string, fn interface{}) types.QueryCallbacks {
+	return c
+}
+
+func (c BatchTestCallbacks) RegisterICQCallbacks() types.QueryCallbacks {
+	return c
+}
+
+func (c BatchTestCallbacks) HasICQCallback(id string) bool {
+	return id == BatchCallbackId
+}
+
+func (c BatchTestCallbacks) CallICQCallback(ctx sdk.Context, id string, args []byte, query types.Query) error {
+	results, err := types.UnmarshalBatchQueryCallbackArgs(args)
+	if err != nil {
+		return err
+	}
+	*c.results = results
+	return nil
+}
+
+// Registers the batch test callback and returns a batched query over two keys,
+// along with the slice that's populated when the callback is invoked
+func (s *KeeperTestSuite) SetupBatchQuery() (MsgSubmitQueryResponseTestCase, *[][]byte) {
+	tc := s.SetupMsgSubmitQueryResponse()
+
+	callbackResults := [][]byte{}
+	err := s.App.InterchainqueryKeeper.SetCallbackHandler(BatchCallbackModule, BatchTestCallbacks{results: &callbackResults})
+	s.Require().NoError(err, "no error expected when registering batch callback")
+
+	tc.query.CallbackModule = BatchCallbackModule
+	tc.query.CallbackId = BatchCallbackId
+	tc.query.RequestData = nil
+	tc.query.BatchRequestData = [][]byte{[]byte("key1"), []byte("key2")}
+
+	tc.validMsg.Result = nil
+	tc.validMsg.ProofOps = nil
+	tc.validMsg.BatchResults = []types.BatchQueryResult{
+		{Result: []byte("result1")},
+		{Result: []byte("result2")},
+	}
+
+	return tc, &callbackResults
+}
+
+func (s *KeeperTestSuite) TestGetQueryId_Batch() {
+	tc, _ := s.SetupBatchQuery()
+
+	// The ID should depend on each key, and the key boundaries
+	batchQueryId := s.App.InterchainqueryKeeper.GetQueryId(s.Ctx, tc.query, false)
+
+	reorderedQuery := tc.query
+	reorderedQuery.BatchRequestData = [][]byte{[]byte("key2"), []byte("key1")}
+	s.Require().NotEqual(batchQueryId, s.App.InterchainqueryKeeper.GetQueryId(s.Ctx, reorderedQuery, false), "reordered keys")
+
+	rebalancedQuery := tc.query
+	rebalancedQuery.BatchRequestData = [][]byte{[]byte("key"), []byte("1key2")}
+	s.Require().NotEqual(batchQueryId, s.App.InterchainqueryKeeper.GetQueryId(s.Ctx, rebalancedQuery, false), "shifted key boundary")
+}
+
+func (s *KeeperTestSuite) TestValidateQuery_Batch() {
+	tc, _ := s.SetupBatchQuery()
+	tc.query.TimeoutDuration = 1
+
+	err := s.App.InterchainqueryKeeper.ValidateQuery(s.Ctx, tc.query)
+	s.Require().NoError(err, "no error expected for valid batch query")
+
+	// Request data cannot be set alongside the batch keys
+	invalidQuery := tc.query
+	invalidQuery.RequestData = []byte("key0")
+	err = s.App.InterchainqueryKeeper.ValidateQuery(s.Ctx, invalidQuery)
+	s.Require().ErrorContains(err, "request data must be empty for batched queries")
+
+	// Each key must be non-empty
+	invalidQuery = tc.query
+	invalidQuery.BatchRequestData = [][]byte{[]byte("key1"), {}}
+	err = s.App.InterchainqueryKeeper.ValidateQuery(s.Ctx, invalidQuery)
+	s.Require().ErrorContains(err, "batched query keys cannot be empty")
+}
+
+func (s *KeeperTestSuite) TestMsgSubmitQueryResponse_Batch_InvokeCallback() {
+	tc, callbackResults := s.SetupBatchQuery()
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, tc.query)
+
+	_, err := s.GetMsgServer().SubmitQueryResponse(tc.goCtx, &tc.validMsg)
+	s.Require().NoError(err, "no error expected when submitting batch response")
+
+	// The callback should receive the results of both keys together
+	expectedResults := [][]byte{[]byte("result1"), []byte("result2")}
+	s.Require().Equal(expectedResults, *callbackResults, "callback results")
+
+	_, found := s.App.InterchainqueryKeeper.GetQuery(s.Ctx, tc.query.Id)
+	s.Require().False(found, "query should have been removed")
+}
+
+func (s *KeeperTestSuite) TestMsgSubmitQueryResponse_Batch_WrongNumberOfResults() {
+	tc, _ := s.SetupBatchQuery()
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, tc.query)
+
+	tc.validMsg.BatchResults = tc.validMsg.BatchResults[:1]
+	_, err := s.GetMsgServer().SubmitQueryResponse(tc.goCtx, &tc.validMsg)
+	s.Require().ErrorContains(err, "Expected 2 batched query results, 1 submitted")
+}
+
+func (s *KeeperTestSuite) TestMsgSubmitQueryResponse_Batch_SingleResult() {
+	tc, _ := s.SetupBatchQuery()
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, tc.query)
+
+	tc.validMsg.Result = []byte("result")
+	_, err := s.GetMsgServer().SubmitQueryResponse(tc.goCtx, &tc.validMsg)
+	s.Require().ErrorContains(err, "Single query result submitted for a batched query")
+}
+
+func (s *KeeperTestSuite) TestMsgSubmitQueryResponse_NonBatch_BatchResults() {
+	tc := s.SetupMsgSubmitQueryResponse()
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, tc.query)
+
+	// Batched results attached to a non-batched query with an empty result should be rejected,
+	// rather than bypassing the contentless check
+	tc.validMsg.Result = nil
+	tc.validMsg.BatchResults = []types.BatchQueryResult{{Result: []byte("result")}}
+	_, err := s.GetMsgServer().SubmitQueryResponse(tc.goCtx, &tc.validMsg)
+	s.Require().ErrorContains(err, "Batched query results submitted for a non-batched query")
+
+	_, found := s.App.InterchainqueryKeeper.GetQuery(s.Ctx, tc.query.Id)
+	s.Require().True(found, "query should not have been removed")
+}
+
+func (s *KeeperTestSuite) TestMsgSubmitQueryResponse_Batch_MissingProof() {
+	tc, _ := s.SetupBatchQuery()
+
+	// Only the first key has a proof
+	tc.query.QueryType = types.BANK_STORE_QUERY_WITH_PROOF
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, tc.query)
+
+	tc.validMsg.BatchResults[0].ProofOps = &crypto.ProofOps{}
+	_, err := s.GetMsgServer().SubmitQueryResponse(tc.goCtx, &tc.validMsg)
+	s.Require().ErrorContains(err, "No proof submitted")
+}
+
+func (s *KeeperTestSuite) TestMsgSubmitQueryResponse_Batch_WrongProof() {
+	tc, _ := s.SetupBatchQuery()
+
+	tc.query.QueryType = types.BANK_STORE_QUERY_WITH_PROOF
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, tc.query)
+
+	tc.validMsg.BatchResults[0].ProofOps = &crypto.ProofOps{}
+	tc.validMsg.BatchResults[1].ProofOps = &crypto.ProofOps{}
+	_, err := s.GetMsgServer().SubmitQueryResponse(tc.goCtx, &tc.validMsg)
+	s.Require().ErrorContains(err, "Unable to verify membership proof: proof cannot be empty")
+}
+
+func (s *KeeperTestSuite) TestEndBlocker_BatchQueryEvent() {
+	tc, _ := s.SetupBatchQuery()
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, tc.query)
+
+	s.App.InterchainqueryKeeper.EndBlocker(s.Ctx)
+
+	// 6b657931 and 6b657932 are the hex encoded keys
+	s.CheckEventValueEmitted("query_request", types.AttributeKeyBatchRequest, "6b657931,6b657932")
+}
+
+func (s *KeeperTestSuite) TestOnAsyncICQAcknowledgement_Batch() {
+	asyncQuery := s.SetupAsyncICQChannel()
+
+	callbackResults := [][]byte{}
+	err := s.App.InterchainqueryKeeper.SetCallbackHandler(BatchCallbackModule, BatchTestCallbacks{results: &callbackResults})
+	s.Require().NoError(err, "no error expected when registering batch callback")
+
+	asyncQuery.CallbackModule = BatchCallbackModule
+	asyncQuery.CallbackId = BatchCallbackId
+	asyncQuery.RequestData = nil
+	asyncQuery.BatchRequestData = [][]byte{[]byte("key1"), []byte("key2")}
+	s.SubmitAsyncICQQuery(asyncQuery)
+
+	// The host should return a response for each key, which are passed to the callback together
+	ack, err := types.NewAsyncICQAcknowledgement(
+		abci.ResponseQuery{Value: []byte("result1")},
+		abci.ResponseQuery{Value: []byte("result2")},
+	)
+	s.Require().NoError(err)
+
+	packet := channeltypes.Packet{SourceChannel: AsyncICQChannelId, Sequence: 1}
+	err = s.App.InterchainqueryKeeper.OnAsyncICQAcknowledgement(s.Ctx, packet, ack)
+	s.Require().NoError(err, "no error expected when processing batch ack")
+
+	expectedResults := [][]byte{[]byte("result1"), []byte("result2")}
+	s.Require().Equal(expectedResults, callbackResults, "callback results")
+}
@@ -22,3 +22,17 @@ func EmitEventQueryResponse(ctx sdk.Context, query types.Query) {
 		),
 	})
 }
+
+// Emits an event when a query is removed from the store after timing out
+func EmitEventQueryTimeout(ctx sdk.Context, query types.Query) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQueryTimeout,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyQueryId, query.Id),
+			sdk.NewAttribute(types.AttributeKeyChainId, query.ChainId),
+			sdk.NewAttribute(types.AttributeKeyCallbackId, query.CallbackId),
+			sdk.NewAttribute(types.AttributeKeyPolicy, query.TimeoutPolicy.String()),
+		),
+	)
+}
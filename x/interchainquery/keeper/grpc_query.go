@@ -23,3 +23,15 @@ func (k Keeper) PendingQueries(c context.Context, req *types.QueryPendingQueries
 
 	return &types.QueryPendingQueriesResponse{PendingQueries: pendingQueries}, nil
 }
+
+// Queries the number and age of pending queries, grouped by chain and callback
+func (k Keeper) PendingQueryStats(c context.Context, req *types.QueryPendingQueryStatsRequest) (*types.QueryPendingQueryStatsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryPendingQueryStatsResponse{Stats: k.GetPendingQueryStats(ctx)}, nil
+}
+
+// Queries all registered recurring queries
+func (k Keeper) RecurringQueries(c context.Context, req *types.QueryRecurringQueriesRequest) (*types.QueryRecurringQueriesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryRecurringQueriesResponse{RecurringQueries: k.AllRecurringQueries(ctx)}, nil
+}
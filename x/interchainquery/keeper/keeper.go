@@ -9,6 +9,7 @@ import (
 
 	"github.com/cometbft/cometbft/libs/log"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
 	ibckeeper "github.com/cosmos/ibc-go/v7/modules/core/keeper"
@@ -19,19 +20,32 @@ import (
 
 // Keeper of this module maintains collections of registered zones.
 type Keeper struct {
-	cdc       codec.Codec
-	storeKey  storetypes.StoreKey
-	callbacks map[string]types.QueryCallbacks
-	IBCKeeper *ibckeeper.Keeper
+	cdc          codec.Codec
+	storeKey     storetypes.StoreKey
+	callbacks    map[string]types.QueryCallbacks
+	IBCKeeper    *ibckeeper.Keeper
+	scopedKeeper capabilitykeeper.ScopedKeeper
+
+	// Chain and callback pairs with published pending query gauges
+	// Only used for telemetry and never read from consensus logic
+	pendingQueryGauges map[string]types.PendingQueryStats
 }
 
 // NewKeeper returns a new instance of zones Keeper
-func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, ibckeeper *ibckeeper.Keeper) Keeper {
+func NewKeeper(
+	cdc codec.Codec,
+	storeKey storetypes.StoreKey,
+	ibckeeper *ibckeeper.Keeper,
+	scopedKeeper capabilitykeeper.ScopedKeeper,
+) Keeper {
 	return Keeper{
-		cdc:       cdc,
-		storeKey:  storeKey,
-		callbacks: make(map[string]types.QueryCallbacks),
-		IBCKeeper: ibckeeper,
+		cdc:          cdc,
+		storeKey:     storeKey,
+		callbacks:    make(map[string]types.QueryCallbacks),
+		IBCKeeper:    ibckeeper,
+		scopedKeeper: scopedKeeper,
+
+		pendingQueryGauges: make(map[string]types.PendingQueryStats),
 	}
 }
 
@@ -50,12 +64,18 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 }
 
 func (k *Keeper) SubmitICQRequest(ctx sdk.Context, query types.Query, forceUnique bool) error {
+	_, err := k.submitICQRequest(ctx, query, forceUnique)
+	return err
+}
+
+// Validates and stores a new query, and returns the query as it was stored
+func (k *Keeper) submitICQRequest(ctx sdk.Context, query types.Query, forceUnique bool) (types.Query, error) {
 	k.Logger(ctx).Info(utils.LogWithHostZone(query.ChainId,
 		"Submitting ICQ Request - module=%s, callbackId=%s, connectionId=%s, queryType=%s, timeout_duration=%d",
 		query.CallbackModule, query.CallbackId, query.ConnectionId, query.QueryType, query.TimeoutDuration))
 
 	if err := k.ValidateQuery(ctx, query); err != nil {
-		return err
+		return query, err
 	}
 
 	// Set the timeout using the block time and timeout duration
@@ -70,24 +90,39 @@ func (k *Keeper) SubmitICQRequest(ctx sdk.Context, query types.Query, forceUniqu
 	// In the query response, this will be used to verify that the query wasn't historical
 	connection, found := k.IBCKeeper.ConnectionKeeper.GetConnection(ctx, query.ConnectionId)
 	if !found {
-		return errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, query.ConnectionId)
+		return query, errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, query.ConnectionId)
 	}
 	clientState, found := k.IBCKeeper.ClientKeeper.GetClientState(ctx, connection.ClientId)
 	if !found {
-		return errorsmod.Wrap(clienttypes.ErrClientNotFound, connection.ClientId)
+		return query, errorsmod.Wrap(clienttypes.ErrClientNotFound, connection.ClientId)
 	}
 	query.SubmissionHeight = clientState.GetLatestHeight().GetRevisionHeight()
 
+	// If the query uses the async-icq transport, send the packet now
+	// (rather than waiting for the EndBlocker to emit the query event)
+	if query.Transport == types.QueryTransport_ASYNC_ICQ_PACKET {
+		if err := k.SendAsyncICQPacket(ctx, query); err != nil {
+			return query, err
+		}
+		query.RequestSent = true
+	}
+
 	// Save the query to the store
 	// If the same query is re-requested, it will get replace in the store with an updated TTL
 	//  and the RequestSent bool reset to false
 	k.SetQuery(ctx, query)
 
-	return nil
+	return query, nil
 }
 
 // Re-submit an ICQ, generally used after a timeout
 func (k *Keeper) RetryICQRequest(ctx sdk.Context, query types.Query) error {
+	_, err := k.retryICQRequest(ctx, query)
+	return err
+}
+
+// Re-submits an ICQ and returns the new query
+func (k *Keeper) retryICQRequest(ctx sdk.Context, query types.Query) (types.Query, error) {
 	k.Logger(ctx).Info(utils.LogWithHostZone(query.ChainId,
 		"Queuing ICQ Retry - Query Type: %s, Query ID: %s", query.CallbackId, query.Id))
 
@@ -95,9 +130,10 @@ func (k *Keeper) RetryICQRequest(ctx sdk.Context, query types.Query) error {
 	k.DeleteQuery(ctx, query.Id)
 
 	// Submit a new query (with a new ID)
-	if err := k.SubmitICQRequest(ctx, query, true); err != nil {
-		return errorsmod.Wrap(err, types.ErrFailedToRetryQuery.Error())
+	retriedQuery, err := k.submitICQRequest(ctx, query, true)
+	if err != nil {
+		return retriedQuery, errorsmod.Wrap(err, types.ErrFailedToRetryQuery.Error())
 	}
 
-	return nil
+	return retriedQuery, nil
 }
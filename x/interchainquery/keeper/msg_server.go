@@ -32,7 +32,22 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 var _ types.MsgServer = msgServer{}
 
 // check if the query requires proving; if it does, verify it!
+// For batched queries, the proof of each key is verified against the same height
 func (k Keeper) VerifyKeyProof(ctx sdk.Context, msg *types.MsgSubmitQueryResponse, query types.Query) error {
+	// Batched results are only accepted for batched queries, and a single result only for non-batched queries
+	if !query.IsBatch() && len(msg.BatchResults) > 0 {
+		return errorsmod.Wrapf(types.ErrInvalidICQProof, "Batched query results submitted for a non-batched query")
+	}
+	if query.IsBatch() && len(msg.Result) > 0 {
+		return errorsmod.Wrapf(types.ErrInvalidICQProof, "Single query result submitted for a batched query")
+	}
+
+	// Batched queries must have a result for each key
+	if query.IsBatch() && len(msg.BatchResults) != len(query.BatchRequestData) {
+		return errorsmod.Wrapf(types.ErrInvalidICQProof,
+			"Expected %d batched query results, %d submitted", len(query.BatchRequestData), len(msg.BatchResults))
+	}
+
 	pathParts := strings.Split(query.QueryType, "/")
 
 	// the query does NOT have an associated proof, so no need to verify it.
@@ -40,9 +55,18 @@ func (k Keeper) VerifyKeyProof(ctx sdk.Context, msg *types.MsgSubmitQueryRespons
 		return nil
 	}
 
+	// Pair each queried key with its result and proof
+	keys := query.GetRequestKeys()
+	results := []types.BatchQueryResult{{Result: msg.Result, ProofOps: msg.ProofOps}}
+	if query.IsBatch() {
+		results = msg.BatchResults
+	}
+
 	// If the query is a "key" proof query, verify the results are valid by checking the poof
-	if msg.ProofOps == nil {
-		return errorsmod.Wrapf(types.ErrInvalidICQProof, "Unable to validate proof. No proof submitted")
+	for _, result := range results {
+		if result.ProofOps == nil {
+			return errorsmod.Wrapf(types.ErrInvalidICQProof, "Unable to validate proof. No proof submitted")
+		}
 	}
 
 	// Get the client consensus state at the height 1 block above the message height
@@ -86,26 +110,30 @@ func (k Keeper) VerifyKeyProof(ctx sdk.Context, msg *types.MsgSubmitQueryRespons
 	var stateRoot exported.Root = tendermintConsensusState.Root
 	var clientStateProof []*ics23.ProofSpec = tendermintClientState.ProofSpecs
 
-	// Get the merkle path and merkle proof
-	path := commitmenttypes.NewMerklePath([]string{pathParts[1], string(query.RequestData)}...)
-	merkleProof, err := commitmenttypes.ConvertProofs(msg.ProofOps)
-	if err != nil {
-		return errorsmod.Wrapf(types.ErrInvalidICQProof, "Error converting proofs: %s", err.Error())
-	}
+	for i, key := range keys {
+		result := results[i]
 
-	// If we got a non-nil response, verify inclusion proof
-	if len(msg.Result) != 0 {
-		if err := merkleProof.VerifyMembership(clientStateProof, stateRoot, path, msg.Result); err != nil {
-			return errorsmod.Wrapf(types.ErrInvalidICQProof, "Unable to verify membership proof: %s", err.Error())
+		// Get the merkle path and merkle proof
+		path := commitmenttypes.NewMerklePath([]string{pathParts[1], string(key)}...)
+		merkleProof, err := commitmenttypes.ConvertProofs(result.ProofOps)
+		if err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidICQProof, "Error converting proofs: %s", err.Error())
 		}
-		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId, "Inclusion proof validated - QueryId %s", query.Id))
 
-	} else {
-		// if we got a nil query response, verify non inclusion proof.
-		if err := merkleProof.VerifyNonMembership(clientStateProof, stateRoot, path); err != nil {
-			return errorsmod.Wrapf(types.ErrInvalidICQProof, "Unable to verify non-membership proof: %s", err.Error())
+		// If we got a non-nil response, verify inclusion proof
+		if len(result.Result) != 0 {
+			if err := merkleProof.VerifyMembership(clientStateProof, stateRoot, path, result.Result); err != nil {
+				return errorsmod.Wrapf(types.ErrInvalidICQProof, "Unable to verify membership proof: %s", err.Error())
+			}
+			k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId, "Inclusion proof validated - QueryId %s", query.Id))
+
+		} else {
+			// if we got a nil query response, verify non inclusion proof.
+			if err := merkleProof.VerifyNonMembership(clientStateProof, stateRoot, path); err != nil {
+				return errorsmod.Wrapf(types.ErrInvalidICQProof, "Unable to verify non-membership proof: %s", err.Error())
+			}
+			k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId, "Non-inclusion proof validated - QueryId %s", query.Id))
 		}
-		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId, "Non-inclusion proof validated - QueryId %s", query.Id))
 	}
 
 	return nil
@@ -144,16 +172,26 @@ func (k Keeper) InvokeCallback(ctx sdk.Context, msg *types.MsgSubmitQueryRespons
 	}
 	sort.Strings(moduleNames)
 
+	// Batched queries receive the results of all keys together
+	callbackArgs := msg.Result
+	if query.IsBatch() {
+		var err error
+		callbackArgs, err = types.NewBatchQueryCallbackArgs(msg.BatchResults)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Loop through each module until the callbackId is found in one of the module handlers
 	for _, moduleName := range moduleNames {
 		moduleCallbackHandler := k.callbacks[moduleName]
 
 		// Once the callback is found, invoke the function
 		if moduleCallbackHandler.HasICQCallback(query.CallbackId) {
-			if err := moduleCallbackHandler.CallICQCallback(ctx, query.CallbackId, msg.Result, query); err != nil {
+			if err := moduleCallbackHandler.CallICQCallback(ctx, query.CallbackId, callbackArgs, query); err != nil {
 				k.Logger(ctx).Error(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId,
 					"Error invoking ICQ callback, error: %s, %s, Query Response: %s",
-					err.Error(), query.Description(), msg.Result))
+					err.Error(), query.Description(), callbackArgs))
 
 				return err
 			}
@@ -191,7 +229,7 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 	k.DeleteQuery(ctx, query.Id)
 
 	// If the query is contentless, end
-	if len(msg.Result) == 0 {
+	if len(msg.Result) == 0 && len(msg.BatchResults) == 0 {
 		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId,
 			"Query response is contentless - QueryId: %s", query.Id))
 		return &types.MsgSubmitQueryResponseResponse{}, nil
@@ -210,5 +248,8 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 		return nil, err
 	}
 
+	// If the query was issued from a recurring query, record the response time
+	k.RecordRecurringQueryResponse(ctx, query)
+
 	return &types.MsgSubmitQueryResponseResponse{}, nil
 }
@@ -9,6 +9,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -30,6 +31,10 @@ func (k Keeper) GetQueryId(ctx sdk.Context, query types.Query, forceUnique bool)
 		queryKey = k.GetQueryUID(ctx)
 	} else {
 		queryKey = append([]byte(query.CallbackModule+query.ConnectionId+query.ChainId+query.QueryType+query.CallbackId), query.RequestData...)
+		for _, key := range query.BatchRequestData {
+			queryKey = append(queryKey, sdk.Uint64ToBigEndian(uint64(len(key)))...)
+			queryKey = append(queryKey, key...)
+		}
 	}
 	return fmt.Sprintf("%x", crypto.Sha256(queryKey))
 }
@@ -48,6 +53,17 @@ func (k Keeper) ValidateQuery(ctx sdk.Context, query types.Query) error {
 	if query.QueryType == "" {
 		return errorsmod.Wrapf(types.ErrInvalidICQRequest, "query type cannot be empty")
 	}
+	if query.Transport == types.QueryTransport_ASYNC_ICQ_PACKET && !types.IsGRPCQueryPath(query.QueryType) {
+		return errorsmod.Wrapf(types.ErrInvalidICQRequest, "async-icq queries must use a gRPC query path (%s)", query.QueryType)
+	}
+	if query.IsBatch() && len(query.RequestData) != 0 {
+		return errorsmod.Wrapf(types.ErrInvalidICQRequest, "request data must be empty for batched queries")
+	}
+	for _, key := range query.BatchRequestData {
+		if len(key) == 0 {
+			return errorsmod.Wrapf(types.ErrInvalidICQRequest, "batched query keys cannot be empty")
+		}
+	}
 	if query.CallbackModule == "" {
 		return errorsmod.Wrapf(types.ErrInvalidICQRequest, "callback module must be specified")
 	}
@@ -178,3 +194,18 @@ func UnmarshalAmountFromBalanceQuery(cdc codec.BinaryCodec, queryResponseBz []by
 	return sdkmath.Int{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
 		"unable to unmarshal balance query response %v as sdkmath.Int (err: %s) or sdk.Coin (err: %s)", queryResponseBz, intError.Error(), coinError.Error())
 }
+
+// Helper function to unmarshal a balance from an async-icq bank balance query (i.e. a gRPC QueryBalanceResponse)
+func UnmarshalAmountFromBalanceGRPCResponse(cdc codec.BinaryCodec, queryResponseBz []byte) (amount sdkmath.Int, err error) {
+	var response banktypes.QueryBalanceResponse
+	if err := cdc.Unmarshal(queryResponseBz, &response); err != nil {
+		return sdkmath.Int{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+			"unable to unmarshal balance query response %v as QueryBalanceResponse: %s", queryResponseBz, err.Error())
+	}
+
+	// A nil balance means the account holds none of the denom
+	if response.Balance == nil {
+		return sdkmath.ZeroInt(), nil
+	}
+	return response.Balance.Amount, nil
+}
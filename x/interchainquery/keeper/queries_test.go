@@ -8,6 +8,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/Stride-Labs/stride/v27/x/interchainquery/keeper"
@@ -133,6 +134,31 @@ func (s *KeeperTestSuite) TestValidateQuery() {
 			},
 			expectedError: "query type cannot be empty",
 		},
+		{
+			name: "valid async-icq query",
+			query: types.Query{
+				ChainId:         validChainId,
+				ConnectionId:    validConnectionId,
+				QueryType:       types.BANK_BALANCE_GRPC_QUERY,
+				CallbackModule:  validCallbackModule,
+				CallbackId:      validCallbackId,
+				TimeoutDuration: validTimeout,
+				Transport:       types.QueryTransport_ASYNC_ICQ_PACKET,
+			},
+		},
+		{
+			name: "async-icq query with store path",
+			query: types.Query{
+				ChainId:         validChainId,
+				ConnectionId:    validConnectionId,
+				QueryType:       types.BANK_STORE_QUERY_WITH_PROOF,
+				CallbackModule:  validCallbackModule,
+				CallbackId:      validCallbackId,
+				TimeoutDuration: validTimeout,
+				Transport:       types.QueryTransport_ASYNC_ICQ_PACKET,
+			},
+			expectedError: "async-icq queries must use a gRPC query path (store/bank/key)",
+		},
 		{
 			name: "missing callback module",
 			query: types.Query{
@@ -337,3 +363,25 @@ func TestUnmarshalAmountFromBalanceQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestUnmarshalAmountFromBalanceGRPCResponse(t *testing.T) {
+	// Response with a balance
+	responseBz, err := (&banktypes.QueryBalanceResponse{Balance: &sdk.Coin{Denom: "denom", Amount: sdkmath.NewInt(50)}}).Marshal()
+	require.NoError(t, err)
+
+	amount, err := keeper.UnmarshalAmountFromBalanceGRPCResponse(types.ModuleCdc, responseBz)
+	require.NoError(t, err)
+	require.Equal(t, int64(50), amount.Int64(), "balance amount")
+
+	// Response without a balance
+	responseBz, err = (&banktypes.QueryBalanceResponse{}).Marshal()
+	require.NoError(t, err)
+
+	amount, err = keeper.UnmarshalAmountFromBalanceGRPCResponse(types.ModuleCdc, responseBz)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), amount.Int64(), "empty balance amount")
+
+	// Invalid response
+	_, err = keeper.UnmarshalAmountFromBalanceGRPCResponse(types.ModuleCdc, []byte("invalid"))
+	require.ErrorContains(t, err, "unable to unmarshal balance query response")
+}
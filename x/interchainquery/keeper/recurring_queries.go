@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v27/utils"
+	"github.com/Stride-Labs/stride/v27/x/interchainquery/types"
+)
+
+// Stores a recurring query
+func (k Keeper) SetRecurringQuery(ctx sdk.Context, recurringQuery types.RecurringQuery) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRecurringQuery)
+	key := types.RecurringQueryKey(recurringQuery.OwnerModule, recurringQuery.Id)
+	store.Set(key, k.cdc.MustMarshal(&recurringQuery))
+}
+
+// Reads a recurring query from the store
+func (k Keeper) GetRecurringQuery(ctx sdk.Context, ownerModule, id string) (recurringQuery types.RecurringQuery, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRecurringQuery)
+	bz := store.Get(types.RecurringQueryKey(ownerModule, id))
+	if len(bz) == 0 {
+		return recurringQuery, false
+	}
+	k.cdc.MustUnmarshal(bz, &recurringQuery)
+	return recurringQuery, true
+}
+
+// Removes a recurring query from the store
+// Any query that was already issued will still be processed
+func (k Keeper) RemoveRecurringQuery(ctx sdk.Context, ownerModule, id string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRecurringQuery)
+	store.Delete(types.RecurringQueryKey(ownerModule, id))
+}
+
+// Returns all recurring queries
+func (k Keeper) AllRecurringQueries(ctx sdk.Context) []types.RecurringQuery {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRecurringQuery)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	recurringQueries := []types.RecurringQuery{}
+	for ; iterator.Valid(); iterator.Next() {
+		var recurringQuery types.RecurringQuery
+		k.cdc.MustUnmarshal(iterator.Value(), &recurringQuery)
+		recurringQueries = append(recurringQueries, recurringQuery)
+	}
+	return recurringQueries
+}
+
+// Registers a query to be issued on a fixed interval, with the response delivered to
+// the owner module's callback
+// If the query was already registered, it's updated in place and the last submission
+// and response times are preserved, so that modules can declare their queries idempotently
+func (k Keeper) RegisterRecurringQuery(ctx sdk.Context, recurringQuery types.RecurringQuery) error {
+	if err := recurringQuery.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := k.ValidateQuery(ctx, recurringQuery.ToQuery()); err != nil {
+		return errorsmod.Wrapf(err, "invalid recurring query %s", recurringQuery.Id)
+	}
+
+	if existingQuery, found := k.GetRecurringQuery(ctx, recurringQuery.OwnerModule, recurringQuery.Id); found {
+		recurringQuery.LastSubmissionTime = existingQuery.LastSubmissionTime
+		recurringQuery.LastResponseTime = existingQuery.LastResponseTime
+	}
+
+	k.SetRecurringQuery(ctx, recurringQuery)
+	return nil
+}
+
+// Issues each recurring query whose interval has elapsed
+// The query ID is deterministic, so if the previous query has not yet received a response,
+// it's replaced with the new query
+// If the submission fails, it's retried at the next interval
+func (k Keeper) SubmitDueRecurringQueries(ctx sdk.Context) {
+	for _, recurringQuery := range k.AllRecurringQueries(ctx) {
+		if !recurringQuery.IsDue(ctx.BlockTime()) {
+			continue
+		}
+
+		err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.SubmitICQRequest(ctx, recurringQuery.ToQuery(), false)
+		})
+		if err != nil {
+			k.Logger(ctx).Error(utils.LogICQCallbackWithHostZone(recurringQuery.ChainId, recurringQuery.CallbackId,
+				"Failed to submit recurring query %s/%s: %s", recurringQuery.OwnerModule, recurringQuery.Id, err.Error()))
+		}
+
+		recurringQuery.LastSubmissionTime = ctx.BlockTime()
+		k.SetRecurringQuery(ctx, recurringQuery)
+	}
+}
+
+// Records the time of the latest response for the recurring query that issued a query
+func (k Keeper) RecordRecurringQueryResponse(ctx sdk.Context, query types.Query) {
+	if query.RecurringQueryId == "" {
+		return
+	}
+
+	recurringQuery, found := k.GetRecurringQuery(ctx, query.CallbackModule, query.RecurringQueryId)
+	if !found {
+		k.Logger(ctx).Info(fmt.Sprintf("Recurring query %s/%s no longer registered", query.CallbackModule, query.RecurringQueryId))
+		return
+	}
+
+	recurringQuery.LastResponseTime = ctx.BlockTime()
+	k.SetRecurringQuery(ctx, recurringQuery)
+}
@@ -0,0 +1,182 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/Stride-Labs/stride/v27/x/interchainquery/types"
+)
+
+const (
+	RecurringQueryId  = "withdrawal-balance"
+	RecurringOwner    = "stakeibc"
+	RecurringCallback = "withdrawalbalance"
+)
+
+// Registers a recurring withdrawal balance query on the transfer connection
+func (s *KeeperTestSuite) SetupRecurringQuery() types.RecurringQuery {
+	s.CreateTransferChannel(HostChainId)
+
+	recurringQuery := types.RecurringQuery{
+		Id:            RecurringQueryId,
+		OwnerModule:   RecurringOwner,
+		CallbackId:    RecurringCallback,
+		ConnectionId:  s.TransferPath.EndpointA.ConnectionID,
+		ChainId:       HostChainId,
+		QueryType:     types.BANK_STORE_QUERY_WITH_PROOF,
+		RequestData:   []byte("request"),
+		Interval:      time.Hour,
+		MaxStaleness:  10 * time.Minute,
+		TimeoutPolicy: types.TimeoutPolicy_REJECT_QUERY_RESPONSE,
+	}
+	err := s.App.InterchainqueryKeeper.RegisterRecurringQuery(s.Ctx, recurringQuery)
+	s.Require().NoError(err, "no error expected when registering recurring query")
+
+	return recurringQuery
+}
+
+func (s *KeeperTestSuite) TestRegisterRecurringQuery() {
+	recurringQuery := s.SetupRecurringQuery()
+
+	actualQuery, found := s.App.InterchainqueryKeeper.GetRecurringQuery(s.Ctx, RecurringOwner, RecurringQueryId)
+	s.Require().True(found, "recurring query should have been registered")
+	s.Require().Equal(recurringQuery, actualQuery, "recurring query")
+
+	// Update the submission and response times, then re-register the query with a new interval
+	submissionTime := s.Ctx.BlockTime().Add(-time.Minute).UTC()
+	responseTime := s.Ctx.BlockTime().UTC()
+	actualQuery.LastSubmissionTime = submissionTime
+	actualQuery.LastResponseTime = responseTime
+	s.App.InterchainqueryKeeper.SetRecurringQuery(s.Ctx, actualQuery)
+
+	recurringQuery.Interval = 2 * time.Hour
+	err := s.App.InterchainqueryKeeper.RegisterRecurringQuery(s.Ctx, recurringQuery)
+	s.Require().NoError(err, "no error expected when re-registering recurring query")
+
+	// The interval should be updated, but the submission and response times should be preserved
+	actualQuery, found = s.App.InterchainqueryKeeper.GetRecurringQuery(s.Ctx, RecurringOwner, RecurringQueryId)
+	s.Require().True(found, "recurring query should still be registered")
+	s.Require().Equal(2*time.Hour, actualQuery.Interval, "updated interval")
+	s.Require().Equal(submissionTime, actualQuery.LastSubmissionTime, "last submission time")
+	s.Require().Equal(responseTime, actualQuery.LastResponseTime, "last response time")
+
+	// Remove the query
+	s.App.InterchainqueryKeeper.RemoveRecurringQuery(s.Ctx, RecurringOwner, RecurringQueryId)
+	s.Require().Empty(s.App.InterchainqueryKeeper.AllRecurringQueries(s.Ctx), "recurring query should have been removed")
+}
+
+func (s *KeeperTestSuite) TestRegisterRecurringQuery_Invalid() {
+	validQuery := s.SetupRecurringQuery()
+
+	testCases := []struct {
+		name          string
+		modify        func(*types.RecurringQuery)
+		expectedError string
+	}{
+		{
+			name:          "missing id",
+			modify:        func(q *types.RecurringQuery) { q.Id = "" },
+			expectedError: "recurring query id cannot be empty",
+		},
+		{
+			name:          "missing owner",
+			modify:        func(q *types.RecurringQuery) { q.OwnerModule = "" },
+			expectedError: "recurring query owner module cannot be empty",
+		},
+		{
+			name:          "zero interval",
+			modify:        func(q *types.RecurringQuery) { q.Interval = 0 },
+			expectedError: "recurring query interval must be positive",
+		},
+		{
+			name:          "negative max staleness",
+			modify:        func(q *types.RecurringQuery) { q.MaxStaleness = -time.Second },
+			expectedError: "recurring query max staleness cannot be negative",
+		},
+		{
+			name:          "unregistered callback",
+			modify:        func(q *types.RecurringQuery) { q.CallbackId = "fake" },
+			expectedError: "callback-id (fake) is not registered for module (stakeibc)",
+		},
+		{
+			name:          "invalid connection",
+			modify:        func(q *types.RecurringQuery) { q.ConnectionId = "fake" },
+			expectedError: "invalid connection-id (fake)",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			invalidQuery := validQuery
+			tc.modify(&invalidQuery)
+			err := s.App.InterchainqueryKeeper.RegisterRecurringQuery(s.Ctx, invalidQuery)
+			s.Require().ErrorContains(err, tc.expectedError)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSubmitDueRecurringQueries() {
+	recurringQuery := s.SetupRecurringQuery()
+	startTime := s.Ctx.BlockTime()
+
+	// The query has never been submitted, so it should be issued immediately
+	s.App.InterchainqueryKeeper.SubmitDueRecurringQueries(s.Ctx)
+
+	queries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
+	s.Require().Len(queries, 1, "one query should have been issued")
+	issuedQuery := queries[0]
+	s.Require().Equal(RecurringQueryId, issuedQuery.RecurringQueryId, "recurring query ID")
+	s.Require().Equal(RecurringOwner, issuedQuery.CallbackModule, "callback module")
+	s.Require().Equal(RecurringCallback, issuedQuery.CallbackId, "callback ID")
+	s.Require().Equal(recurringQuery.RequestData, issuedQuery.RequestData, "request data")
+	s.Require().Equal(recurringQuery.MaxStaleness, issuedQuery.TimeoutDuration, "timeout duration")
+
+	actualRecurringQuery, _ := s.App.InterchainqueryKeeper.GetRecurringQuery(s.Ctx, RecurringOwner, RecurringQueryId)
+	s.Require().Equal(startTime.UTC(), actualRecurringQuery.LastSubmissionTime.UTC(), "last submission time")
+
+	// Before the interval has elapsed, no new query should be issued
+	s.App.InterchainqueryKeeper.DeleteQuery(s.Ctx, issuedQuery.Id)
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(recurringQuery.Interval - time.Second))
+	s.App.InterchainqueryKeeper.SubmitDueRecurringQueries(s.Ctx)
+	s.Require().Empty(s.App.InterchainqueryKeeper.AllQueries(s.Ctx), "no query should be issued before the interval")
+
+	// Once the interval has elapsed, the query should be re-issued
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(recurringQuery.Interval))
+	s.App.InterchainqueryKeeper.SubmitDueRecurringQueries(s.Ctx)
+	s.Require().Len(s.App.InterchainqueryKeeper.AllQueries(s.Ctx), 1, "query should be re-issued after the interval")
+
+	actualRecurringQuery, _ = s.App.InterchainqueryKeeper.GetRecurringQuery(s.Ctx, RecurringOwner, RecurringQueryId)
+	s.Require().Equal(s.Ctx.BlockTime().UTC(), actualRecurringQuery.LastSubmissionTime.UTC(), "updated submission time")
+}
+
+func (s *KeeperTestSuite) TestSubmitDueRecurringQueries_DefaultTimeout() {
+	recurringQuery := s.SetupRecurringQuery()
+
+	// Without a max staleness, the timeout should default to the interval
+	recurringQuery.MaxStaleness = 0
+	s.App.InterchainqueryKeeper.SetRecurringQuery(s.Ctx, recurringQuery)
+
+	s.App.InterchainqueryKeeper.SubmitDueRecurringQueries(s.Ctx)
+
+	queries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
+	s.Require().Len(queries, 1, "one query should have been issued")
+	s.Require().Equal(recurringQuery.Interval, queries[0].TimeoutDuration, "timeout duration")
+}
+
+func (s *KeeperTestSuite) TestRecordRecurringQueryResponse() {
+	s.SetupRecurringQuery()
+
+	// A query that was not issued by a recurring query should be ignored
+	s.App.InterchainqueryKeeper.RecordRecurringQueryResponse(s.Ctx, types.Query{CallbackModule: RecurringOwner})
+
+	recurringQuery, _ := s.App.InterchainqueryKeeper.GetRecurringQuery(s.Ctx, RecurringOwner, RecurringQueryId)
+	s.Require().True(recurringQuery.LastResponseTime.IsZero(), "response time should not be set")
+
+	// A query issued by the recurring query should update the response time
+	s.App.InterchainqueryKeeper.RecordRecurringQueryResponse(s.Ctx, types.Query{
+		CallbackModule:   RecurringOwner,
+		RecurringQueryId: RecurringQueryId,
+	})
+
+	recurringQuery, _ = s.App.InterchainqueryKeeper.GetRecurringQuery(s.Ctx, RecurringOwner, RecurringQueryId)
+	s.Require().Equal(s.Ctx.BlockTime().UTC(), recurringQuery.LastResponseTime.UTC(), "response time")
+}
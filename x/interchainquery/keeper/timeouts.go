@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v27/utils"
+	"github.com/Stride-Labs/stride/v27/x/interchainquery/types"
+)
+
+// Removes each query that has passed its timeout without receiving a response,
+// and applies the query's timeout policy (reject, retry, or execute the callback)
+// When the callback is executed, it's invoked with an empty response, and
+// query.HasTimedOut will return true
+// If handling the timeout fails, the query is still removed so that it's not
+// re-processed every block
+func (k Keeper) SweepTimedOutQueries(ctx sdk.Context) {
+	for _, query := range k.AllQueries(ctx) {
+		if query.HasTimedOut(ctx.BlockTime()) {
+			k.SweepTimedOutQuery(ctx, query)
+		}
+	}
+}
+
+// Removes a single timed out query and applies its timeout policy
+// If the query was retried, the new query is returned so that it can be sent in the same block
+func (k Keeper) SweepTimedOutQuery(ctx sdk.Context, query types.Query) (retriedQuery types.Query, retried bool) {
+	EmitEventQueryTimeout(ctx, query)
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, "timed_out_queries"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel("chain_id", query.ChainId),
+			telemetry.NewLabel("callback_id", query.CallbackId),
+			telemetry.NewLabel("timeout_policy", query.TimeoutPolicy.String()),
+		},
+	)
+
+	err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		k.DeleteQuery(ctx, query.Id)
+
+		// Retries are handled here directly (rather than through HandleQueryTimeout)
+		// so that the new query can be returned
+		if query.TimeoutPolicy == types.TimeoutPolicy_RETRY_QUERY_REQUEST {
+			k.Logger(ctx).Error(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId,
+				"QUERY TIMEOUT - QueryId: %s, TTL: %d, BlockTime: %d", query.Id, query.TimeoutTimestamp, ctx.BlockHeader().Time.UnixNano()))
+
+			var err error
+			retriedQuery, err = k.retryICQRequest(ctx, query)
+			return err
+		}
+
+		msg := types.MsgSubmitQueryResponse{ChainId: query.ChainId, QueryId: query.Id}
+		return k.HandleQueryTimeout(ctx, &msg, query)
+	})
+	if err != nil {
+		k.Logger(ctx).Error(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId,
+			"Failed to handle query timeout, removing query - QueryId: %s, Error: %s", query.Id, err.Error()))
+		k.DeleteQuery(ctx, query.Id)
+		return types.Query{}, false
+	}
+
+	return retriedQuery, retriedQuery.Id != ""
+}
+
+// Tallies the number and age of queries that have been sent to the relayer but have
+// not yet received a response, grouped by chain and callback
+type pendingQueryStatsTracker map[string]*types.PendingQueryStats
+
+// Adds a query to the tally if it has been sent to the relayer
+func (t pendingQueryStatsTracker) add(query types.Query, blockTime time.Time) {
+	if !query.RequestSent {
+		return
+	}
+
+	key := pendingQueryStatsKey(query.ChainId, query.CallbackId)
+	stats, ok := t[key]
+	if !ok {
+		stats = &types.PendingQueryStats{ChainId: query.ChainId, CallbackId: query.CallbackId}
+		t[key] = stats
+	}
+
+	stats.NumPending++
+	if query.HasTimedOut(blockTime) {
+		stats.NumTimedOut++
+	}
+
+	ageSeconds := blockTime.Sub(query.SubmissionTime()).Seconds()
+	if ageSeconds > 0 && uint64(ageSeconds) > stats.OldestQueryAgeSeconds {
+		stats.OldestQueryAgeSeconds = uint64(ageSeconds)
+	}
+}
+
+// Returns the tallied stats, sorted by chain and callback for determinism
+func (t pendingQueryStatsTracker) stats() []types.PendingQueryStats {
+	keys := make([]string, 0, len(t))
+	for key := range t {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	allStats := []types.PendingQueryStats{}
+	for _, key := range keys {
+		allStats = append(allStats, *t[key])
+	}
+	return allStats
+}
+
+func pendingQueryStatsKey(chainId, callbackId string) string {
+	return fmt.Sprintf("%s/%s", chainId, callbackId)
+}
+
+// Returns the number and age of queries that have been sent to the relayer but have
+// not yet received a response, grouped by chain and callback
+func (k Keeper) GetPendingQueryStats(ctx sdk.Context) []types.PendingQueryStats {
+	tracker := pendingQueryStatsTracker{}
+	for _, query := range k.AllQueries(ctx) {
+		tracker.add(query, ctx.BlockTime())
+	}
+	return tracker.stats()
+}
+
+// Publishes the number and age of pending queries as telemetry gauges
+// The gauges of any chain and callback that no longer has pending queries are reset
+// to zero, so that stale values from removed queries do not linger
+// The set of published gauges is only held in memory and does not affect state
+func (k Keeper) EmitPendingQueryTelemetry(allStats []types.PendingQueryStats) {
+	publishedKeys := map[string]bool{}
+	for _, stats := range allStats {
+		k.setPendingQueryGauges(stats)
+		publishedKeys[pendingQueryStatsKey(stats.ChainId, stats.CallbackId)] = true
+	}
+
+	staleKeys := []string{}
+	for key := range k.pendingQueryGauges {
+		if !publishedKeys[key] {
+			staleKeys = append(staleKeys, key)
+		}
+	}
+	sort.Strings(staleKeys)
+
+	for _, key := range staleKeys {
+		staleStats := k.pendingQueryGauges[key]
+		k.setPendingQueryGauges(types.PendingQueryStats{ChainId: staleStats.ChainId, CallbackId: staleStats.CallbackId})
+		delete(k.pendingQueryGauges, key)
+	}
+
+	for _, stats := range allStats {
+		k.pendingQueryGauges[pendingQueryStatsKey(stats.ChainId, stats.CallbackId)] = stats
+	}
+}
+
+// Sets the pending query gauges for a single chain and callback
+func (k Keeper) setPendingQueryGauges(stats types.PendingQueryStats) {
+	labels := []metrics.Label{
+		telemetry.NewLabel("chain_id", stats.ChainId),
+		telemetry.NewLabel("callback_id", stats.CallbackId),
+	}
+	telemetry.SetGaugeWithLabels(
+		[]string{types.ModuleName, "pending_queries"},
+		float32(stats.NumPending),
+		labels,
+	)
+	telemetry.SetGaugeWithLabels(
+		[]string{types.ModuleName, "oldest_pending_query_age_seconds"},
+		float32(stats.OldestQueryAgeSeconds),
+		labels,
+	)
+}
@@ -0,0 +1,195 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/Stride-Labs/stride/v27/x/interchainquery/types"
+)
+
+func (s *KeeperTestSuite) TestSweepTimedOutQueries_NotTimedOut() {
+	tc := s.SetupMsgSubmitQueryResponse()
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, tc.query)
+
+	s.App.InterchainqueryKeeper.SweepTimedOutQueries(s.Ctx)
+
+	_, found := s.App.InterchainqueryKeeper.GetQuery(s.Ctx, tc.query.Id)
+	s.Require().True(found, "query should not have been removed")
+}
+
+func (s *KeeperTestSuite) TestSweepTimedOutQueries_RejectQuery() {
+	tc := s.SetupMsgSubmitQueryResponse()
+
+	tc.query.TimeoutTimestamp = uint64(1)
+	tc.query.TimeoutPolicy = types.TimeoutPolicy_REJECT_QUERY_RESPONSE
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, tc.query)
+
+	s.App.InterchainqueryKeeper.SweepTimedOutQueries(s.Ctx)
+
+	s.Require().Empty(s.App.InterchainqueryKeeper.AllQueries(s.Ctx), "query should have been removed")
+	s.CheckEventValueEmitted(types.EventTypeQueryTimeout, types.AttributeKeyQueryId, tc.query.Id)
+}
+
+func (s *KeeperTestSuite) TestSweepTimedOutQueries_RetryQuery() {
+	tc := s.SetupMsgSubmitQueryResponse()
+
+	tc.query.TimeoutTimestamp = uint64(1)
+	tc.query.TimeoutPolicy = types.TimeoutPolicy_RETRY_QUERY_REQUEST
+	tc.query.RequestSent = true
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, tc.query)
+
+	s.App.InterchainqueryKeeper.SweepTimedOutQueries(s.Ctx)
+
+	// The original query should be replaced with a new query with a reset timeout
+	_, found := s.App.InterchainqueryKeeper.GetQuery(s.Ctx, tc.query.Id)
+	s.Require().False(found, "original query should be removed")
+
+	queries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
+	s.Require().Len(queries, 1, "there should be one new query")
+
+	actualQuery := queries[0]
+	expectedTimeoutTimestamp := uint64(s.Ctx.BlockTime().Add(tc.query.TimeoutDuration).UnixNano())
+	s.Require().NotEqual(tc.query.Id, actualQuery.Id, "query ID")
+	s.Require().Equal(tc.query.CallbackId, actualQuery.CallbackId, "query callback ID")
+	s.Require().Equal(expectedTimeoutTimestamp, actualQuery.TimeoutTimestamp, "timeout timestamp")
+	s.Require().False(actualQuery.RequestSent, "request sent")
+}
+
+func (s *KeeperTestSuite) TestSweepTimedOutQueries_ExecuteCallback() {
+	tc := s.SetupMsgSubmitQueryResponse()
+
+	// The withdrawal balance callback will fail when invoked with an empty response
+	// The query should still be removed so that it's not processed again
+	tc.query.TimeoutTimestamp = uint64(1)
+	tc.query.TimeoutPolicy = types.TimeoutPolicy_EXECUTE_QUERY_CALLBACK
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, tc.query)
+
+	s.App.InterchainqueryKeeper.SweepTimedOutQueries(s.Ctx)
+
+	s.Require().Empty(s.App.InterchainqueryKeeper.AllQueries(s.Ctx), "query should have been removed")
+	s.CheckEventValueEmitted(types.EventTypeQueryTimeout, types.AttributeKeyQueryId, tc.query.Id)
+}
+
+func (s *KeeperTestSuite) TestEndBlocker_RetriedQuerySent() {
+	tc := s.SetupMsgSubmitQueryResponse()
+
+	tc.query.TimeoutTimestamp = uint64(1)
+	tc.query.TimeoutPolicy = types.TimeoutPolicy_RETRY_QUERY_REQUEST
+	tc.query.RequestSent = true
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, tc.query)
+
+	s.App.InterchainqueryKeeper.EndBlocker(s.Ctx)
+
+	// The retried query should be emitted to the relayer in the same block
+	queries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
+	s.Require().Len(queries, 1, "there should be one new query")
+	s.Require().True(queries[0].RequestSent, "retried query should have been sent")
+	s.CheckEventValueEmitted("query_request", types.AttributeKeyQueryId, queries[0].Id)
+}
+
+func (s *KeeperTestSuite) TestGetPendingQueryStats() {
+	blockTime := s.Ctx.BlockTime()
+	timeoutDuration := time.Hour
+
+	// Helper to store a query that was submitted a given duration before the current block
+	setQuery := func(id, chainId, callbackId string, age time.Duration, requestSent bool) {
+		s.App.InterchainqueryKeeper.SetQuery(s.Ctx, types.Query{
+			Id:               id,
+			ChainId:          chainId,
+			CallbackId:       callbackId,
+			TimeoutDuration:  timeoutDuration,
+			TimeoutTimestamp: uint64(blockTime.Add(-age).Add(timeoutDuration).UnixNano()),
+			RequestSent:      requestSent,
+		})
+	}
+
+	setQuery("1", "chain-A", "validator", time.Minute, true)
+	setQuery("2", "chain-A", "validator", 2*time.Hour, true) // timed out
+	setQuery("3", "chain-A", "withdrawalbalance", 10*time.Second, true)
+	setQuery("4", "chain-B", "validator", 30*time.Minute, true)
+	setQuery("5", "chain-B", "validator", 3*time.Hour, false) // not yet sent, should be ignored
+
+	expectedStats := []types.PendingQueryStats{
+		{ChainId: "chain-A", CallbackId: "validator", NumPending: 2, NumTimedOut: 1, OldestQueryAgeSeconds: 2 * 60 * 60},
+		{ChainId: "chain-A", CallbackId: "withdrawalbalance", NumPending: 1, NumTimedOut: 0, OldestQueryAgeSeconds: 10},
+		{ChainId: "chain-B", CallbackId: "validator", NumPending: 1, NumTimedOut: 0, OldestQueryAgeSeconds: 30 * 60},
+	}
+	s.Require().Equal(expectedStats, s.App.InterchainqueryKeeper.GetPendingQueryStats(s.Ctx), "pending query stats")
+
+	resp, err := s.App.InterchainqueryKeeper.PendingQueryStats(s.Ctx, &types.QueryPendingQueryStatsRequest{})
+	s.Require().NoError(err, "no error expected when querying pending query stats")
+	s.Require().Equal(expectedStats, resp.Stats, "pending query stats response")
+}
+
+func (s *KeeperTestSuite) TestEndBlocker_SinglePass() {
+	tc := s.SetupMsgSubmitQueryResponse()
+
+	// A query that timed out, a query that has not been sent, and a query awaiting a response
+	timedOutQuery := tc.query
+	timedOutQuery.Id = "timed-out"
+	timedOutQuery.TimeoutTimestamp = uint64(1)
+	timedOutQuery.TimeoutPolicy = types.TimeoutPolicy_REJECT_QUERY_RESPONSE
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, timedOutQuery)
+
+	unsentQuery := tc.query
+	unsentQuery.Id = "unsent"
+	unsentQuery.RequestSent = false
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, unsentQuery)
+
+	pendingQuery := tc.query
+	pendingQuery.Id = "pending"
+	pendingQuery.RequestSent = true
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, pendingQuery)
+
+	s.App.InterchainqueryKeeper.EndBlocker(s.Ctx)
+
+	_, found := s.App.InterchainqueryKeeper.GetQuery(s.Ctx, timedOutQuery.Id)
+	s.Require().False(found, "timed out query should have been removed")
+
+	actualUnsentQuery, found := s.App.InterchainqueryKeeper.GetQuery(s.Ctx, unsentQuery.Id)
+	s.Require().True(found, "unsent query should still be stored")
+	s.Require().True(actualUnsentQuery.RequestSent, "unsent query should have been sent")
+	s.CheckEventValueEmitted("query_request", types.AttributeKeyQueryId, unsentQuery.Id)
+
+	actualPendingQuery, found := s.App.InterchainqueryKeeper.GetQuery(s.Ctx, pendingQuery.Id)
+	s.Require().True(found, "pending query should still be stored")
+	s.Require().True(actualPendingQuery.RequestSent, "pending query should still be sent")
+}
+
+func (s *KeeperTestSuite) TestEmitPendingQueryTelemetry_ResetRemovedQueries() {
+	// Capture the published gauges in memory
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	metricsConfig := metrics.DefaultConfig("")
+	metricsConfig.EnableHostname = false
+	metricsConfig.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(metricsConfig, sink)
+	s.Require().NoError(err, "no error expected when configuring metrics")
+	defer metrics.NewGlobal(metricsConfig, &metrics.BlackholeSink{}) //nolint:errcheck
+
+	pendingGaugeKey := "interchainquery.pending_queries;chain_id=chain-A;callback_id=validator"
+	getPendingGauge := func() float32 {
+		intervals := sink.Data()
+		gauge, ok := intervals[len(intervals)-1].Gauges[pendingGaugeKey]
+		s.Require().True(ok, "pending queries gauge should have been published")
+		return gauge.Value
+	}
+
+	// Publish the gauge while a query is pending
+	query := types.Query{
+		Id:               "1",
+		ChainId:          "chain-A",
+		CallbackId:       "validator",
+		TimeoutDuration:  time.Hour,
+		TimeoutTimestamp: uint64(s.Ctx.BlockTime().Add(time.Hour).UnixNano()),
+		RequestSent:      true,
+	}
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, query)
+	s.App.InterchainqueryKeeper.EmitPendingQueryTelemetry(s.App.InterchainqueryKeeper.GetPendingQueryStats(s.Ctx))
+	s.Require().Equal(float32(1), getPendingGauge(), "pending queries gauge while query is pending")
+
+	// Once the query is removed, the gauge should be reset
+	s.App.InterchainqueryKeeper.DeleteQuery(s.Ctx, query.Id)
+	s.App.InterchainqueryKeeper.EmitPendingQueryTelemetry(s.App.InterchainqueryKeeper.GetPendingQueryStats(s.Ctx))
+	s.Require().Equal(float32(0), getPendingGauge(), "pending queries gauge after query is removed")
+}
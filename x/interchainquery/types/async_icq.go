@@ -0,0 +1,119 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+)
+
+// Codec used to JSON serialize the async-icq packet data and ack (consistent with the icq host module)
+var AsyncICQCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+// Checks whether a query path is a gRPC method path of the form "/{package}.{Service}/{Method}"
+// The icq host module only executes gRPC queries, so store paths (e.g. "store/bank/key")
+// cannot be used with the async-icq transport
+func IsGRPCQueryPath(path string) bool {
+	parts := strings.Split(path, "/")
+	return len(parts) == 3 && parts[0] == "" && strings.Contains(parts[1], ".") && parts[2] != ""
+}
+
+// Builds the proto-encoded request data for an async-icq bank balance query
+func NewBalanceGRPCQueryRequest(address, denom string) ([]byte, error) {
+	request := banktypes.QueryBalanceRequest{Address: address, Denom: denom}
+	requestBz, err := request.Marshal()
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "unable to marshal balance query request")
+	}
+	return requestBz, nil
+}
+
+// Builds the ICS-31 packet data for a query
+// The query type must be a gRPC method path, and the request data must be the
+// proto-encoded request message for that method
+// Batched queries include one request for each key
+func NewAsyncICQPacketData(query Query) ([]byte, error) {
+	if !IsGRPCQueryPath(query.QueryType) {
+		return nil, errorsmod.Wrapf(ErrInvalidICQRequest,
+			"async-icq queries must use a gRPC query path, got %s", query.QueryType)
+	}
+
+	cosmosQuery := CosmosQuery{}
+	for _, key := range query.GetRequestKeys() {
+		cosmosQuery.Requests = append(cosmosQuery.Requests, abci.RequestQuery{
+			Path: query.QueryType,
+			Data: key,
+		})
+	}
+	queryBz, err := cosmosQuery.Marshal()
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "unable to marshal async-icq query")
+	}
+
+	packetData := InterchainQueryPacketData{Data: queryBz}
+	packetBz, err := AsyncICQCdc.MarshalJSON(&packetData)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "unable to marshal async-icq packet data")
+	}
+
+	return sdk.MustSortJSON(packetBz), nil
+}
+
+// Builds the acknowledgement that's returned by the host for a successful query
+func NewAsyncICQAcknowledgement(responses ...abci.ResponseQuery) ([]byte, error) {
+	cosmosResponse := CosmosResponse{Responses: responses}
+	responseBz, err := cosmosResponse.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	packetAck := InterchainQueryPacketAck{Data: responseBz}
+	packetAckBz, err := AsyncICQCdc.MarshalJSON(&packetAck)
+	if err != nil {
+		return nil, err
+	}
+
+	return channeltypes.NewResultAcknowledgement(packetAckBz).Acknowledgement(), nil
+}
+
+// Unpacks the ABCI query responses from an async-icq acknowledgement
+// Errors if the host returned an error ack, if the number of responses does not match
+// the number of requests, or if any query failed on the host
+func UnpackAsyncICQAcknowledgement(acknowledgement []byte, numRequests int) ([]abci.ResponseQuery, error) {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidICQAck, "unable to unmarshal acknowledgement: %s", err.Error())
+	}
+
+	result, ok := ack.Response.(*channeltypes.Acknowledgement_Result)
+	if !ok {
+		return nil, errorsmod.Wrapf(ErrInvalidICQAck, "error acknowledgement: %s", ack.GetError())
+	}
+
+	var packetAck InterchainQueryPacketAck
+	if err := AsyncICQCdc.UnmarshalJSON(result.Result, &packetAck); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidICQAck, "unable to unmarshal packet ack: %s", err.Error())
+	}
+
+	var cosmosResponse CosmosResponse
+	if err := cosmosResponse.Unmarshal(packetAck.Data); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidICQAck, "unable to unmarshal query response: %s", err.Error())
+	}
+
+	if len(cosmosResponse.Responses) != numRequests {
+		return nil, errorsmod.Wrapf(ErrInvalidICQAck, "expected %d query responses, got %d", numRequests, len(cosmosResponse.Responses))
+	}
+
+	for _, response := range cosmosResponse.Responses {
+		if response.Code != 0 {
+			return nil, errorsmod.Wrapf(ErrInvalidICQAck, "query failed on host with code %d: %s", response.Code, response.Log)
+		}
+	}
+
+	return cosmosResponse.Responses, nil
+}
@@ -11,4 +11,7 @@ var (
 	ErrInvalidICQRequest     = errors.New("invalid interchain query request")
 	ErrFailedToRetryQuery    = errors.New("failed to retry query")
 	ErrQueryNotFound         = errors.New("Query not found")
+	ErrICQChannelNotFound    = errors.New("async-icq channel not found")
+	ErrInvalidICQChannel     = errors.New("invalid async-icq channel")
+	ErrInvalidICQAck         = errors.New("invalid async-icq acknowledgement")
 )
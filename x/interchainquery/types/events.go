@@ -7,10 +7,14 @@ const (
 	AttributeKeyType         = "type"
 	AttributeKeyParams       = "parameters"
 	AttributeKeyRequest      = "request"
+	AttributeKeyBatchRequest = "batch_request"
 	AttributeKeyHeight       = "height"
+	AttributeKeyCallbackId   = "callback_id"
+	AttributeKeyPolicy       = "timeout_policy"
 
 	AttributeValueCategory = ModuleName
 	AttributeValueQuery    = "query"
 
 	EventTypeQueryResponse = "query_response"
+	EventTypeQueryTimeout  = "query_timeout"
 )
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 func NewGenesisState(queries []Query) *GenesisState {
 	return &GenesisState{Queries: queries}
 }
@@ -13,6 +15,17 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	// TODO: validate genesis state.
+	recurringQueryKeys := map[string]bool{}
+	for _, recurringQuery := range gs.RecurringQueries {
+		if err := recurringQuery.ValidateBasic(); err != nil {
+			return err
+		}
+
+		key := string(RecurringQueryKey(recurringQuery.OwnerModule, recurringQuery.Id))
+		if recurringQueryKeys[key] {
+			return fmt.Errorf("duplicate recurring query %s", key)
+		}
+		recurringQueryKeys[key] = true
+	}
 	return nil
 }
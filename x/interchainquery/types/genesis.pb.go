@@ -57,6 +57,36 @@ func (TimeoutPolicy) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_74cd646eb05658fd, []int{0}
 }
 
+// Specifies how a query is delivered to the host chain
+type QueryTransport int32
+
+const (
+	// The query is emitted in an event and the response is submitted by a
+	// relayer with a proof
+	QueryTransport_RELAYER_EVENT QueryTransport = 0
+	// The query is sent in an ICS-31 (async-icq) packet and the response is
+	// returned in the acknowledgement
+	QueryTransport_ASYNC_ICQ_PACKET QueryTransport = 1
+)
+
+var QueryTransport_name = map[int32]string{
+	0: "RELAYER_EVENT",
+	1: "ASYNC_ICQ_PACKET",
+}
+
+var QueryTransport_value = map[string]int32{
+	"RELAYER_EVENT":    0,
+	"ASYNC_ICQ_PACKET": 1,
+}
+
+func (x QueryTransport) String() string {
+	return proto.EnumName(QueryTransport_name, int32(x))
+}
+
+func (QueryTransport) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_74cd646eb05658fd, []int{1}
+}
+
 type Query struct {
 	Id               string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	ConnectionId     string        `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
@@ -71,6 +101,12 @@ type Query struct {
 	TimeoutTimestamp uint64        `protobuf:"varint,9,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
 	RequestSent      bool          `protobuf:"varint,11,opt,name=request_sent,json=requestSent,proto3" json:"request_sent,omitempty"`
 	SubmissionHeight uint64        `protobuf:"varint,16,opt,name=submission_height,json=submissionHeight,proto3" json:"submission_height,omitempty"`
+	// ID of the recurring query that issued this query (if applicable)
+	RecurringQueryId string         `protobuf:"bytes,17,opt,name=recurring_query_id,json=recurringQueryId,proto3" json:"recurring_query_id,omitempty"`
+	Transport        QueryTransport `protobuf:"varint,18,opt,name=transport,proto3,enum=stride.interchainquery.v1.QueryTransport" json:"transport,omitempty"`
+	// Keys to query from the same store at the same height (used instead of
+	// request_data for batched queries)
+	BatchRequestData [][]byte `protobuf:"bytes,19,rep,name=batch_request_data,json=batchRequestData,proto3" json:"batch_request_data,omitempty"`
 }
 
 func (m *Query) Reset()         { *m = Query{} }
@@ -197,6 +233,192 @@ func (m *Query) GetSubmissionHeight() uint64 {
 	return 0
 }
 
+func (m *Query) GetRecurringQueryId() string {
+	if m != nil {
+		return m.RecurringQueryId
+	}
+	return ""
+}
+
+func (m *Query) GetTransport() QueryTransport {
+	if m != nil {
+		return m.Transport
+	}
+	return QueryTransport_RELAYER_EVENT
+}
+
+func (m *Query) GetBatchRequestData() [][]byte {
+	if m != nil {
+		return m.BatchRequestData
+	}
+	return nil
+}
+
+// A query that is re-issued by the interchainquery module on a fixed interval
+// The response is delivered to the registered callback of the owning module
+type RecurringQuery struct {
+	// Identifier for the recurring query, unique within the owning module
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Module that registered the query (and owns the callback)
+	OwnerModule  string `protobuf:"bytes,2,opt,name=owner_module,json=ownerModule,proto3" json:"owner_module,omitempty"`
+	CallbackId   string `protobuf:"bytes,3,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	ConnectionId string `protobuf:"bytes,4,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	ChainId      string `protobuf:"bytes,5,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	QueryType    string `protobuf:"bytes,6,opt,name=query_type,json=queryType,proto3" json:"query_type,omitempty"`
+	RequestData  []byte `protobuf:"bytes,7,opt,name=request_data,json=requestData,proto3" json:"request_data,omitempty"`
+	CallbackData []byte `protobuf:"bytes,8,opt,name=callback_data,json=callbackData,proto3" json:"callback_data,omitempty"`
+	// How often the query should be re-issued
+	Interval time.Duration `protobuf:"bytes,9,opt,name=interval,proto3,stdduration" json:"interval"`
+	// The max age of a response before it's considered stale, used as the
+	// timeout of each issued query (defaults to the interval if not specified)
+	MaxStaleness  time.Duration `protobuf:"bytes,10,opt,name=max_staleness,json=maxStaleness,proto3,stdduration" json:"max_staleness"`
+	TimeoutPolicy TimeoutPolicy `protobuf:"varint,11,opt,name=timeout_policy,json=timeoutPolicy,proto3,enum=stride.interchainquery.v1.TimeoutPolicy" json:"timeout_policy,omitempty"`
+	// Block time of the most recently issued query
+	LastSubmissionTime time.Time `protobuf:"bytes,12,opt,name=last_submission_time,json=lastSubmissionTime,proto3,stdtime" json:"last_submission_time"`
+	// Block time at which the most recent response was received
+	LastResponseTime time.Time      `protobuf:"bytes,13,opt,name=last_response_time,json=lastResponseTime,proto3,stdtime" json:"last_response_time"`
+	Transport        QueryTransport `protobuf:"varint,14,opt,name=transport,proto3,enum=stride.interchainquery.v1.QueryTransport" json:"transport,omitempty"`
+	BatchRequestData [][]byte       `protobuf:"bytes,15,rep,name=batch_request_data,json=batchRequestData,proto3" json:"batch_request_data,omitempty"`
+}
+
+func (m *RecurringQuery) Reset()         { *m = RecurringQuery{} }
+func (m *RecurringQuery) String() string { return proto.CompactTextString(m) }
+func (*RecurringQuery) ProtoMessage()    {}
+func (*RecurringQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74cd646eb05658fd, []int{1}
+}
+func (m *RecurringQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecurringQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecurringQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecurringQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecurringQuery.Merge(m, src)
+}
+func (m *RecurringQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecurringQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecurringQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecurringQuery proto.InternalMessageInfo
+
+func (m *RecurringQuery) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RecurringQuery) GetOwnerModule() string {
+	if m != nil {
+		return m.OwnerModule
+	}
+	return ""
+}
+
+func (m *RecurringQuery) GetCallbackId() string {
+	if m != nil {
+		return m.CallbackId
+	}
+	return ""
+}
+
+func (m *RecurringQuery) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *RecurringQuery) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *RecurringQuery) GetQueryType() string {
+	if m != nil {
+		return m.QueryType
+	}
+	return ""
+}
+
+func (m *RecurringQuery) GetRequestData() []byte {
+	if m != nil {
+		return m.RequestData
+	}
+	return nil
+}
+
+func (m *RecurringQuery) GetCallbackData() []byte {
+	if m != nil {
+		return m.CallbackData
+	}
+	return nil
+}
+
+func (m *RecurringQuery) GetInterval() time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *RecurringQuery) GetMaxStaleness() time.Duration {
+	if m != nil {
+		return m.MaxStaleness
+	}
+	return 0
+}
+
+func (m *RecurringQuery) GetTimeoutPolicy() TimeoutPolicy {
+	if m != nil {
+		return m.TimeoutPolicy
+	}
+	return TimeoutPolicy_REJECT_QUERY_RESPONSE
+}
+
+func (m *RecurringQuery) GetLastSubmissionTime() time.Time {
+	if m != nil {
+		return m.LastSubmissionTime
+	}
+	return time.Time{}
+}
+
+func (m *RecurringQuery) GetLastResponseTime() time.Time {
+	if m != nil {
+		return m.LastResponseTime
+	}
+	return time.Time{}
+}
+
+func (m *RecurringQuery) GetTransport() QueryTransport {
+	if m != nil {
+		return m.Transport
+	}
+	return QueryTransport_RELAYER_EVENT
+}
+
+func (m *RecurringQuery) GetBatchRequestData() [][]byte {
+	if m != nil {
+		return m.BatchRequestData
+	}
+	return nil
+}
+
 type DataPoint struct {
 	Id           string                                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	RemoteHeight github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=remote_height,json=remoteHeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remote_height"`
@@ -208,7 +430,7 @@ func (m *DataPoint) Reset()         { *m = DataPoint{} }
 func (m *DataPoint) String() string { return proto.CompactTextString(m) }
 func (*DataPoint) ProtoMessage()    {}
 func (*DataPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74cd646eb05658fd, []int{1}
+	return fileDescriptor_74cd646eb05658fd, []int{2}
 }
 func (m *DataPoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -253,14 +475,15 @@ func (m *DataPoint) GetValue() []byte {
 
 // GenesisState defines the epochs module's genesis state.
 type GenesisState struct {
-	Queries []Query `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries"`
+	Queries          []Query          `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries"`
+	RecurringQueries []RecurringQuery `protobuf:"bytes,2,rep,name=recurring_queries,json=recurringQueries,proto3" json:"recurring_queries"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74cd646eb05658fd, []int{2}
+	return fileDescriptor_74cd646eb05658fd, []int{3}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,9 +519,18 @@ func (m *GenesisState) GetQueries() []Query {
 	return nil
 }
 
+func (m *GenesisState) GetRecurringQueries() []RecurringQuery {
+	if m != nil {
+		return m.RecurringQueries
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("stride.interchainquery.v1.TimeoutPolicy", TimeoutPolicy_name, TimeoutPolicy_value)
+	proto.RegisterEnum("stride.interchainquery.v1.QueryTransport", QueryTransport_name, QueryTransport_value)
 	proto.RegisterType((*Query)(nil), "stride.interchainquery.v1.Query")
+	proto.RegisterType((*RecurringQuery)(nil), "stride.interchainquery.v1.RecurringQuery")
 	proto.RegisterType((*DataPoint)(nil), "stride.interchainquery.v1.DataPoint")
 	proto.RegisterType((*GenesisState)(nil), "stride.interchainquery.v1.GenesisState")
 }
@@ -308,52 +540,71 @@ func init() {
 }
 
 var fileDescriptor_74cd646eb05658fd = []byte{
-	// 717 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xc1, 0x6e, 0xd3, 0x4a,
-	0x14, 0x8d, 0xd3, 0xf4, 0x35, 0x99, 0x38, 0x69, 0xde, 0xbc, 0xbe, 0xf7, 0x9c, 0x4a, 0x24, 0xa6,
-	0x48, 0xd4, 0x2a, 0xd4, 0x56, 0xc3, 0x02, 0x21, 0xb1, 0xa0, 0x49, 0x2d, 0x08, 0x94, 0x36, 0x75,
-	0x52, 0x89, 0xb2, 0xb1, 0x1c, 0x7b, 0x48, 0x46, 0xb5, 0x3d, 0xa9, 0x67, 0x1c, 0x91, 0x6f, 0x60,
-	0xc3, 0x92, 0x2f, 0xe0, 0x0b, 0xf8, 0x88, 0x2e, 0x2b, 0x56, 0x88, 0x45, 0x41, 0xed, 0x8e, 0xaf,
-	0x40, 0x1e, 0x7b, 0xda, 0x42, 0x05, 0x2b, 0x56, 0xf6, 0x9c, 0x73, 0xe6, 0xdc, 0xeb, 0x3b, 0xc7,
-	0x03, 0x56, 0x29, 0x8b, 0xb0, 0x87, 0x0c, 0x1c, 0x32, 0x14, 0xb9, 0x63, 0x07, 0x87, 0x47, 0x31,
-	0x8a, 0x66, 0xc6, 0x74, 0xc3, 0x18, 0xa1, 0x10, 0x51, 0x4c, 0xf5, 0x49, 0x44, 0x18, 0x81, 0xf5,
-	0x54, 0xa8, 0xff, 0x24, 0xd4, 0xa7, 0x1b, 0xcb, 0x75, 0x97, 0xd0, 0x80, 0x50, 0x9b, 0x0b, 0x8d,
-	0x74, 0x91, 0xee, 0x5a, 0x5e, 0x1a, 0x91, 0x11, 0x49, 0xf1, 0xe4, 0x2d, 0x43, 0x1b, 0x23, 0x42,
-	0x46, 0x3e, 0x32, 0xf8, 0x6a, 0x18, 0xbf, 0x32, 0xbc, 0x38, 0x72, 0x18, 0x26, 0x61, 0xca, 0xaf,
-	0xbc, 0x2f, 0x80, 0xf9, 0xbd, 0xc4, 0x1d, 0x56, 0x41, 0x1e, 0x7b, 0x8a, 0xa4, 0x4a, 0x5a, 0xc9,
-	0xca, 0x63, 0x0f, 0xde, 0x02, 0x15, 0x97, 0x84, 0x21, 0x72, 0x13, 0xb5, 0x8d, 0x3d, 0x25, 0xcf,
-	0x29, 0xf9, 0x12, 0xec, 0x7a, 0xb0, 0x0e, 0x8a, 0xbc, 0xc1, 0x84, 0x9f, 0xe3, 0xfc, 0x02, 0x5f,
-	0x77, 0x3d, 0x78, 0x03, 0x00, 0xde, 0xb6, 0xcd, 0x66, 0x13, 0xa4, 0x14, 0x38, 0x59, 0xe2, 0xc8,
-	0x60, 0x36, 0x41, 0xf0, 0x26, 0x90, 0x23, 0x74, 0x14, 0x23, 0xca, 0x6c, 0xcf, 0x61, 0x8e, 0x32,
-	0xaf, 0x4a, 0x9a, 0x6c, 0x95, 0x33, 0x6c, 0xcb, 0x61, 0x0e, 0x5c, 0x05, 0x8b, 0xae, 0xe3, 0xfb,
-	0x43, 0xc7, 0x3d, 0xb4, 0x03, 0xe2, 0xc5, 0x3e, 0x52, 0x2a, 0xdc, 0xa6, 0x2a, 0xe0, 0xe7, 0x1c,
-	0x85, 0x4d, 0x50, 0xbe, 0x10, 0x62, 0x4f, 0x29, 0x72, 0x11, 0x10, 0x50, 0x37, 0xfd, 0x16, 0x21,
-	0xe0, 0xd5, 0x64, 0x5e, 0x4d, 0x16, 0x20, 0x2f, 0xb7, 0x0b, 0xaa, 0x0c, 0x07, 0x88, 0xc4, 0xcc,
-	0x9e, 0x10, 0x1f, 0xbb, 0x33, 0x65, 0x51, 0x95, 0xb4, 0x6a, 0x4b, 0xd3, 0x7f, 0x79, 0x1e, 0xfa,
-	0x20, 0xdd, 0xd0, 0xe3, 0x7a, 0xab, 0xc2, 0xae, 0x2e, 0xe1, 0x0e, 0xa8, 0x09, 0x43, 0x31, 0x75,
-	0xa5, 0xaa, 0x4a, 0x5a, 0xb9, 0x55, 0xd7, 0xd3, 0x63, 0xd1, 0xc5, 0xb1, 0xe8, 0x5b, 0x99, 0xa0,
-	0x5d, 0x3c, 0x3e, 0x6d, 0xe6, 0xde, 0x7d, 0x69, 0x4a, 0xd6, 0x62, 0xb6, 0x59, 0x50, 0xf0, 0x0e,
-	0xf8, 0x5b, 0xf8, 0x25, 0x4f, 0xca, 0x9c, 0x60, 0xa2, 0x94, 0x54, 0x49, 0x2b, 0x58, 0xa2, 0xd0,
-	0x40, 0xe0, 0x57, 0xe7, 0x4b, 0x51, 0xc8, 0x94, 0xb2, 0x2a, 0x69, 0xc5, 0x8b, 0xf9, 0xf6, 0x51,
-	0xc8, 0x12, 0x3f, 0x1a, 0x0f, 0x03, 0x4c, 0x69, 0x72, 0xc2, 0x63, 0x84, 0x47, 0x63, 0xa6, 0xd4,
-	0x52, 0xbf, 0x4b, 0xe2, 0x09, 0xc7, 0x57, 0xde, 0xe4, 0x41, 0x29, 0x19, 0x53, 0x8f, 0xe0, 0x90,
-	0x5d, 0x0b, 0x8b, 0x03, 0x2a, 0x11, 0x0a, 0x08, 0x43, 0xc2, 0x86, 0x87, 0xa5, 0xfd, 0x30, 0xf9,
-	0x98, 0xcf, 0xa7, 0xcd, 0xdb, 0x23, 0xcc, 0xc6, 0xf1, 0x50, 0x77, 0x49, 0x90, 0x85, 0x36, 0x7b,
-	0xac, 0x53, 0xef, 0xd0, 0x48, 0x02, 0x42, 0xf5, 0x6e, 0xc8, 0x3e, 0x7e, 0x58, 0x07, 0x59, 0xa6,
-	0xbb, 0x21, 0xb3, 0xe4, 0xd4, 0x32, 0x6d, 0x00, 0xda, 0x40, 0xf6, 0x89, 0xeb, 0xf8, 0xa2, 0xc2,
-	0xdc, 0x1f, 0xa8, 0x50, 0xe6, 0x8e, 0x59, 0x81, 0x35, 0x30, 0x3f, 0x75, 0xfc, 0x38, 0xcd, 0xaa,
-	0xdc, 0x5e, 0xfa, 0x76, 0xda, 0xac, 0x45, 0x88, 0xc6, 0x3e, 0xbb, 0x4b, 0x02, 0xcc, 0x50, 0x30,
-	0x61, 0x33, 0x2b, 0x95, 0xac, 0xf4, 0x80, 0xfc, 0x38, 0xfd, 0x67, 0xfb, 0xcc, 0x61, 0x08, 0x3e,
-	0x02, 0x0b, 0x49, 0x26, 0x30, 0xa2, 0x8a, 0xa4, 0xce, 0x69, 0xe5, 0x96, 0xfa, 0x9b, 0xd0, 0xf0,
-	0xff, 0xad, 0x5d, 0x48, 0x3a, 0xb7, 0xc4, 0xb6, 0x35, 0x1b, 0x54, 0x7e, 0x08, 0x13, 0xac, 0x83,
-	0x7f, 0x2d, 0xf3, 0xa9, 0xd9, 0x19, 0xd8, 0x7b, 0xfb, 0xa6, 0x75, 0x60, 0x5b, 0x66, 0xbf, 0xb7,
-	0xbb, 0xd3, 0x37, 0x6b, 0x39, 0xf8, 0x3f, 0xf8, 0xc7, 0x32, 0x07, 0xd6, 0xc1, 0x05, 0xb3, 0xb7,
-	0x6f, 0xf6, 0x07, 0x35, 0x09, 0x2e, 0x83, 0xff, 0xcc, 0x17, 0x66, 0x67, 0x7f, 0x60, 0x66, 0x54,
-	0x67, 0x73, 0x7b, 0xbb, 0xbd, 0xd9, 0x79, 0x56, 0xcb, 0xb7, 0xfb, 0xc7, 0x67, 0x0d, 0xe9, 0xe4,
-	0xac, 0x21, 0x7d, 0x3d, 0x6b, 0x48, 0x6f, 0xcf, 0x1b, 0xb9, 0x93, 0xf3, 0x46, 0xee, 0xd3, 0x79,
-	0x23, 0xf7, 0xf2, 0xc1, 0x95, 0xd9, 0xf5, 0x79, 0xd7, 0xeb, 0xdb, 0xce, 0x90, 0x1a, 0xd9, 0x7d,
-	0x35, 0x6d, 0xdd, 0x37, 0x5e, 0x5f, 0xbb, 0xb5, 0xf8, 0x48, 0x87, 0x7f, 0xf1, 0x00, 0xdf, 0xfb,
-	0x1e, 0x00, 0x00, 0xff, 0xff, 0xda, 0x60, 0xfd, 0x12, 0xdc, 0x04, 0x00, 0x00,
+	// 1021 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0xdb, 0xb6,
+	0x1b, 0xb6, 0x9c, 0x7f, 0x36, 0x2d, 0x3b, 0x0a, 0x9b, 0xdf, 0x6f, 0x4a, 0x80, 0xd9, 0x6e, 0x06,
+	0xac, 0x5e, 0xd6, 0xd8, 0x68, 0x76, 0x18, 0x0a, 0x0c, 0xd8, 0x62, 0x57, 0x68, 0xbd, 0x66, 0x69,
+	0x42, 0x29, 0xc5, 0x32, 0x0c, 0x10, 0x68, 0x89, 0x73, 0x88, 0x4a, 0xa2, 0x2b, 0x52, 0x59, 0xf2,
+	0x15, 0xb6, 0x4b, 0x8f, 0xfb, 0x14, 0x3b, 0xed, 0x43, 0x14, 0xd8, 0xa5, 0xd8, 0x69, 0xd8, 0x21,
+	0x1b, 0x92, 0xdb, 0x3e, 0xc5, 0x40, 0x4a, 0x72, 0xe2, 0x3a, 0x4d, 0x3a, 0xac, 0x27, 0x9b, 0xef,
+	0x9f, 0xe7, 0x25, 0xdf, 0xf7, 0x79, 0x48, 0x81, 0x3b, 0x5c, 0xc4, 0xd4, 0x27, 0x1d, 0x1a, 0x09,
+	0x12, 0x7b, 0x87, 0x98, 0x46, 0xcf, 0x13, 0x12, 0x9f, 0x74, 0x8e, 0xee, 0x75, 0x86, 0x24, 0x22,
+	0x9c, 0xf2, 0xf6, 0x28, 0x66, 0x82, 0xc1, 0x95, 0x34, 0xb0, 0xfd, 0x5a, 0x60, 0xfb, 0xe8, 0xde,
+	0xea, 0x8a, 0xc7, 0x78, 0xc8, 0xb8, 0xab, 0x02, 0x3b, 0xe9, 0x22, 0xcd, 0x5a, 0x5d, 0x1e, 0xb2,
+	0x21, 0x4b, 0xed, 0xf2, 0x5f, 0x66, 0xad, 0x0f, 0x19, 0x1b, 0x06, 0xa4, 0xa3, 0x56, 0x83, 0xe4,
+	0xbb, 0x8e, 0x9f, 0xc4, 0x58, 0x50, 0x16, 0x65, 0xfe, 0xc6, 0xeb, 0x7e, 0x41, 0x43, 0xc2, 0x05,
+	0x0e, 0x47, 0x69, 0xc0, 0xda, 0xaf, 0x73, 0x60, 0x6e, 0x4f, 0x96, 0x87, 0x35, 0x50, 0xa4, 0xbe,
+	0xa9, 0x35, 0xb5, 0x56, 0x19, 0x15, 0xa9, 0x0f, 0x3f, 0x00, 0x55, 0x8f, 0x45, 0x11, 0xf1, 0x24,
+	0x9c, 0x4b, 0x7d, 0xb3, 0xa8, 0x5c, 0xfa, 0x85, 0xb1, 0xef, 0xc3, 0x15, 0x50, 0x52, 0x27, 0x90,
+	0xfe, 0x19, 0xe5, 0x5f, 0x50, 0xeb, 0xbe, 0x0f, 0xdf, 0x07, 0x40, 0x9d, 0xcb, 0x15, 0x27, 0x23,
+	0x62, 0xce, 0x2a, 0x67, 0x59, 0x59, 0x9c, 0x93, 0x11, 0x81, 0xb7, 0x81, 0x1e, 0x93, 0xe7, 0x09,
+	0xe1, 0xc2, 0xf5, 0xb1, 0xc0, 0xe6, 0x5c, 0x53, 0x6b, 0xe9, 0xa8, 0x92, 0xd9, 0x1e, 0x60, 0x81,
+	0xe1, 0x1d, 0xb0, 0xe8, 0xe1, 0x20, 0x18, 0x60, 0xef, 0x99, 0x1b, 0x32, 0x3f, 0x09, 0x88, 0x59,
+	0x55, 0x30, 0xb5, 0xdc, 0xfc, 0x95, 0xb2, 0xc2, 0x06, 0xa8, 0x8c, 0x03, 0xa9, 0x6f, 0x96, 0x54,
+	0x10, 0xc8, 0x4d, 0xfd, 0xf4, 0x2c, 0x79, 0x80, 0xaa, 0xa6, 0xab, 0x6a, 0x7a, 0x6e, 0x54, 0xe5,
+	0x9e, 0x80, 0x9a, 0xec, 0x0e, 0x4b, 0x84, 0x3b, 0x62, 0x01, 0xf5, 0x4e, 0xcc, 0xc5, 0xa6, 0xd6,
+	0xaa, 0x6d, 0xb6, 0xda, 0x6f, 0x1c, 0x58, 0xdb, 0x49, 0x13, 0x76, 0x55, 0x3c, 0xaa, 0x8a, 0xcb,
+	0x4b, 0xb8, 0x03, 0x8c, 0x1c, 0x30, 0x1f, 0x8b, 0x59, 0x6b, 0x6a, 0xad, 0xca, 0xe6, 0x4a, 0x3b,
+	0x9d, 0x4b, 0x3b, 0x9f, 0x4b, 0xfb, 0x41, 0x16, 0xd0, 0x2d, 0xbd, 0x3c, 0x6d, 0x14, 0x7e, 0xfa,
+	0xb3, 0xa1, 0xa1, 0xc5, 0x2c, 0x39, 0x77, 0xc1, 0x8f, 0xc1, 0x52, 0x8e, 0x37, 0x1e, 0xa3, 0x59,
+	0x6e, 0x6a, 0xad, 0x59, 0x94, 0x17, 0x72, 0x72, 0xfb, 0xe5, 0xfe, 0x72, 0x12, 0x09, 0xb3, 0xd2,
+	0xd4, 0x5a, 0xa5, 0x71, 0x7f, 0x6d, 0x12, 0x09, 0x89, 0xc7, 0x93, 0x41, 0x48, 0x39, 0x97, 0x13,
+	0x3e, 0x24, 0x74, 0x78, 0x28, 0x4c, 0x23, 0xc5, 0xbb, 0x70, 0x3c, 0x52, 0x76, 0x78, 0x17, 0xc0,
+	0x98, 0x78, 0x49, 0x1c, 0xd3, 0x68, 0xe8, 0xa6, 0x83, 0xa5, 0xbe, 0xb9, 0xa4, 0x5a, 0x6d, 0x8c,
+	0x3d, 0x8a, 0x4a, 0x7d, 0x1f, 0x3e, 0x04, 0x65, 0x11, 0xe3, 0x88, 0x8f, 0x58, 0x2c, 0x4c, 0xa8,
+	0xda, 0xf8, 0xd1, 0x35, 0x6d, 0x54, 0x69, 0x4e, 0x9e, 0x80, 0x2e, 0x72, 0x65, 0xd9, 0x01, 0x16,
+	0xde, 0xa1, 0x3b, 0x41, 0x96, 0x5b, 0xcd, 0x99, 0x96, 0x8e, 0x0c, 0xe5, 0x41, 0x17, 0x8c, 0x59,
+	0xfb, 0x61, 0x1e, 0xd4, 0xd0, 0xc4, 0x5e, 0xa6, 0x68, 0x7d, 0x1b, 0xe8, 0xec, 0xfb, 0x88, 0xc4,
+	0x39, 0xa3, 0x52, 0x56, 0x57, 0x94, 0xed, 0x6a, 0x3a, 0xcd, 0x5c, 0x49, 0xa7, 0x09, 0x69, 0xcc,
+	0xde, 0x20, 0x8d, 0xb9, 0xeb, 0xa4, 0x31, 0x7f, 0x93, 0x34, 0x16, 0xa6, 0xa5, 0x31, 0x45, 0xe8,
+	0xd2, 0x15, 0x84, 0xfe, 0x1c, 0x94, 0x54, 0xaf, 0x8f, 0x70, 0x60, 0x96, 0xdf, 0x9e, 0x77, 0xe3,
+	0x24, 0xf8, 0x08, 0x54, 0x43, 0x7c, 0xec, 0x72, 0x81, 0x03, 0x79, 0x83, 0x71, 0x13, 0xbc, 0x3d,
+	0x8a, 0x1e, 0xe2, 0x63, 0x3b, 0x4f, 0xbc, 0x42, 0x5b, 0x95, 0xff, 0xa6, 0xad, 0xa7, 0x60, 0x39,
+	0xc0, 0x92, 0xdb, 0x17, 0x04, 0x96, 0x01, 0x4a, 0xd8, 0x95, 0xcd, 0xd5, 0xa9, 0x1d, 0x8e, 0x85,
+	0x91, 0x6e, 0xf1, 0x85, 0xdc, 0x22, 0x94, 0x08, 0xf6, 0x18, 0x40, 0x86, 0x40, 0x04, 0x94, 0xd5,
+	0x8d, 0x09, 0x1f, 0xb1, 0x88, 0x93, 0x14, 0xb5, 0xfa, 0x2f, 0x50, 0x0d, 0x99, 0x8f, 0xb2, 0x74,
+	0x85, 0x39, 0x21, 0x86, 0xda, 0x3b, 0x17, 0xc3, 0xe2, 0x1b, 0xc4, 0xf0, 0x63, 0x11, 0x94, 0xe5,
+	0x9f, 0x5d, 0x46, 0x23, 0x31, 0xa5, 0x03, 0x0c, 0xaa, 0x31, 0x09, 0x99, 0x20, 0xb9, 0xf0, 0x95,
+	0x10, 0xba, 0x9f, 0xc9, 0x73, 0xfc, 0x71, 0xda, 0xf8, 0x70, 0x48, 0xc5, 0x61, 0x32, 0x68, 0x7b,
+	0x2c, 0xcc, 0xde, 0xa1, 0xec, 0x67, 0x83, 0xfb, 0xcf, 0x3a, 0x92, 0xb7, 0xbc, 0xdd, 0x8f, 0xc4,
+	0x6f, 0xbf, 0x6c, 0x80, 0xd4, 0x2e, 0x57, 0x48, 0x4f, 0x21, 0xb3, 0x2b, 0xc3, 0x05, 0x7a, 0xc0,
+	0x3c, 0x1c, 0xe4, 0x15, 0x66, 0xde, 0x41, 0x85, 0x8a, 0x42, 0xcc, 0x0a, 0xac, 0x83, 0xb9, 0x23,
+	0x1c, 0x24, 0xe9, 0xeb, 0xa2, 0x77, 0x97, 0xff, 0x3e, 0x6d, 0x18, 0x31, 0xe1, 0x49, 0x20, 0xee,
+	0xb2, 0x90, 0x0a, 0x12, 0x8e, 0xc4, 0x09, 0x4a, 0x43, 0xd6, 0x7e, 0xd6, 0x80, 0xfe, 0x30, 0x7d,
+	0x87, 0x6d, 0x81, 0x05, 0x81, 0x5f, 0x80, 0x05, 0xd9, 0x72, 0x4a, 0xb8, 0xa9, 0x35, 0x67, 0x5a,
+	0x95, 0xcd, 0xe6, 0x4d, 0x33, 0xe9, 0xce, 0xca, 0xad, 0xa3, 0x3c, 0x0d, 0x7e, 0x0b, 0x96, 0x26,
+	0xaf, 0x44, 0x89, 0x55, 0x54, 0x58, 0xd7, 0xcd, 0x77, 0xf2, 0x82, 0xca, 0x40, 0x27, 0xaf, 0x50,
+	0x4a, 0xf8, 0xba, 0x0b, 0xaa, 0x13, 0x0a, 0x80, 0x2b, 0xe0, 0x7f, 0xc8, 0xfa, 0xd2, 0xea, 0x39,
+	0xee, 0xde, 0xbe, 0x85, 0x0e, 0x5c, 0x64, 0xd9, 0xbb, 0x4f, 0x76, 0x6c, 0xcb, 0x28, 0xc0, 0xf7,
+	0xc0, 0x2d, 0x64, 0x39, 0xe8, 0x60, 0xec, 0xd9, 0xdb, 0xb7, 0x6c, 0xc7, 0xd0, 0xe0, 0x2a, 0xf8,
+	0xbf, 0xf5, 0xb5, 0xd5, 0xdb, 0x77, 0xac, 0xcc, 0xd5, 0xdb, 0xda, 0xde, 0xee, 0x6e, 0xf5, 0x1e,
+	0x1b, 0xc5, 0xf5, 0xfb, 0xa0, 0x36, 0x49, 0x35, 0xb8, 0x04, 0xaa, 0xc8, 0xda, 0xde, 0x3a, 0xb0,
+	0x90, 0x6b, 0x3d, 0xb5, 0x76, 0x1c, 0xa3, 0x00, 0x97, 0x81, 0xb1, 0x65, 0x1f, 0xec, 0xf4, 0xdc,
+	0x7e, 0x6f, 0xcf, 0xdd, 0xdd, 0xea, 0x3d, 0xb6, 0x1c, 0x43, 0xeb, 0xda, 0x2f, 0xcf, 0xea, 0xda,
+	0xab, 0xb3, 0xba, 0xf6, 0xd7, 0x59, 0x5d, 0x7b, 0x71, 0x5e, 0x2f, 0xbc, 0x3a, 0xaf, 0x17, 0x7e,
+	0x3f, 0xaf, 0x17, 0xbe, 0xb9, 0x7f, 0x69, 0xaa, 0xb6, 0x6a, 0xc1, 0xc6, 0x36, 0x1e, 0xf0, 0x4e,
+	0xf6, 0x71, 0x74, 0xb4, 0xf9, 0x69, 0xe7, 0x78, 0xea, 0x13, 0x49, 0x0d, 0x7b, 0x30, 0xaf, 0x64,
+	0xf5, 0xc9, 0x3f, 0x03, 0x00, 0xaf, 0x4e, 0x77, 0x35, 0x49, 0x09, 0x00, 0x00,
 }
 
 func (m *Query) Marshal() (dAtA []byte, err error) {
@@ -376,6 +627,33 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BatchRequestData) > 0 {
+		for iNdEx := len(m.BatchRequestData) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BatchRequestData[iNdEx])
+			copy(dAtA[i:], m.BatchRequestData[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BatchRequestData[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.Transport != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Transport))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.RecurringQueryId) > 0 {
+		i -= len(m.RecurringQueryId)
+		copy(dAtA[i:], m.RecurringQueryId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RecurringQueryId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	if m.SubmissionHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.SubmissionHeight))
 		i--
@@ -470,6 +748,136 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RecurringQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecurringQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecurringQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BatchRequestData) > 0 {
+		for iNdEx := len(m.BatchRequestData) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BatchRequestData[iNdEx])
+			copy(dAtA[i:], m.BatchRequestData[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BatchRequestData[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.Transport != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Transport))
+		i--
+		dAtA[i] = 0x70
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastResponseTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastResponseTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x6a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastSubmissionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastSubmissionTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x62
+	if m.TimeoutPolicy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeoutPolicy))
+		i--
+		dAtA[i] = 0x58
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxStaleness, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxStaleness):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x52
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.CallbackData) > 0 {
+		i -= len(m.CallbackData)
+		copy(dAtA[i:], m.CallbackData)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CallbackData)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RequestData) > 0 {
+		i -= len(m.RequestData)
+		copy(dAtA[i:], m.RequestData)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RequestData)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.QueryType) > 0 {
+		i -= len(m.QueryType)
+		copy(dAtA[i:], m.QueryType)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.QueryType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CallbackId) > 0 {
+		i -= len(m.CallbackId)
+		copy(dAtA[i:], m.CallbackId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CallbackId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OwnerModule) > 0 {
+		i -= len(m.OwnerModule)
+		copy(dAtA[i:], m.OwnerModule)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.OwnerModule)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DataPoint) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -547,6 +955,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RecurringQueries) > 0 {
+		for iNdEx := len(m.RecurringQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecurringQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Queries) > 0 {
 		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -627,10 +1049,23 @@ func (m *Query) Size() (n int) {
 	if m.SubmissionHeight != 0 {
 		n += 2 + sovGenesis(uint64(m.SubmissionHeight))
 	}
+	l = len(m.RecurringQueryId)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.Transport != 0 {
+		n += 2 + sovGenesis(uint64(m.Transport))
+	}
+	if len(m.BatchRequestData) > 0 {
+		for _, b := range m.BatchRequestData {
+			l = len(b)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *DataPoint) Size() (n int) {
+func (m *RecurringQuery) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -640,9 +1075,70 @@ func (m *DataPoint) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	l = m.RemoteHeight.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.LocalHeight.Size()
+	l = len(m.OwnerModule)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.CallbackId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.QueryType)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.RequestData)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.CallbackData)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxStaleness)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.TimeoutPolicy != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeoutPolicy))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastSubmissionTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastResponseTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Transport != 0 {
+		n += 1 + sovGenesis(uint64(m.Transport))
+	}
+	if len(m.BatchRequestData) > 0 {
+		for _, b := range m.BatchRequestData {
+			l = len(b)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DataPoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.RemoteHeight.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.LocalHeight.Size()
 	n += 1 + l + sovGenesis(uint64(l))
 	l = len(m.Value)
 	if l > 0 {
@@ -663,16 +1159,525 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	return n
-}
+	if len(m.RecurringQueries) > 0 {
+		for _, e := range m.RecurringQueries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Query) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Query: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestData = append(m.RequestData[:0], dAtA[iNdEx:postIndex]...)
+			if m.RequestData == nil {
+				m.RequestData = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestSent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequestSent = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackData = append(m.CallbackData[:0], dAtA[iNdEx:postIndex]...)
+			if m.CallbackData == nil {
+				m.CallbackData = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackModule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackModule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeoutDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutPolicy", wireType)
+			}
+			m.TimeoutPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutPolicy |= TimeoutPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionHeight", wireType)
+			}
+			m.SubmissionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmissionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringQueryId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecurringQueryId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transport", wireType)
+			}
+			m.Transport = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Transport |= QueryTransport(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchRequestData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchRequestData = append(m.BatchRequestData, make([]byte, postIndex-iNdEx))
+			copy(m.BatchRequestData[len(m.BatchRequestData)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Query) Unmarshal(dAtA []byte) error {
+func (m *RecurringQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -695,10 +1700,10 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Query: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecurringQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecurringQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -735,7 +1740,7 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerModule", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -763,11 +1768,11 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			m.OwnerModule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -795,11 +1800,11 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.CallbackId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -827,13 +1832,13 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueryType = string(dAtA[iNdEx:postIndex])
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -843,29 +1848,27 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestData = append(m.RequestData[:0], dAtA[iNdEx:postIndex]...)
-			if m.RequestData == nil {
-				m.RequestData = []byte{}
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -893,13 +1896,13 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			m.QueryType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestData", wireType)
 			}
-			m.TimeoutTimestamp = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -909,32 +1912,27 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestSent", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.RequestSent = bool(v != 0)
-		case 12:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestData = append(m.RequestData[:0], dAtA[iNdEx:postIndex]...)
+			if m.RequestData == nil {
+				m.RequestData = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CallbackData", wireType)
 			}
@@ -968,11 +1966,11 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				m.CallbackData = []byte{}
 			}
 			iNdEx = postIndex
-		case 13:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallbackModule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -982,27 +1980,28 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CallbackModule = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 14:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStaleness", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1029,11 +2028,11 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeoutDuration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxStaleness, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 11:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutPolicy", wireType)
 			}
@@ -1052,11 +2051,77 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 16:
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSubmissionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastSubmissionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastResponseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastResponseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transport", wireType)
 			}
-			m.SubmissionHeight = 0
+			m.Transport = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1066,11 +2131,43 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubmissionHeight |= uint64(b&0x7F) << shift
+				m.Transport |= QueryTransport(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchRequestData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchRequestData = append(m.BatchRequestData, make([]byte, postIndex-iNdEx))
+			copy(m.BatchRequestData[len(m.BatchRequestData)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1339,6 +2436,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringQueries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecurringQueries = append(m.RecurringQueries, RecurringQuery{})
+			if err := m.RecurringQueries[len(m.RecurringQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
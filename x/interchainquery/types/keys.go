@@ -2,6 +2,8 @@ package types
 
 import (
 	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -16,13 +18,22 @@ const (
 
 	// QuerierRoute defines the module's query routing key
 	QuerierRoute = ModuleName
+
+	// PortID is the port bound by the module for ICS-31 (async-icq) channels
+	PortID = ModuleName
+
+	// Version defines the ICS-31 (async-icq) channel version
+	Version = "icq-1"
 )
 
 // prefix bytes for the interchainquery persistent store
 const (
-	prefixData         = iota + 1
-	prefixQuery        = iota + 1
-	prefixQueryCounter = iota + 1
+	prefixData           = iota + 1
+	prefixQuery          = iota + 1
+	prefixQueryCounter   = iota + 1
+	prefixRecurringQuery = iota + 1
+	prefixICQChannel     = iota + 1
+	prefixPacketQuery    = iota + 1
 )
 
 // keys for proof queries to various stores, note: there's an implicit assumption here that
@@ -37,6 +48,16 @@ const (
 	BANK_STORE_QUERY_WITH_PROOF = "store/bank/key"
 	// The Osmosis twap store - key'd by the pool ID and denom's
 	OSMOSIS_TWAP_STORE_QUERY_WITH_PROOF = "store/twap/key"
+	// The wasm store - key'd by the contract address and the contract's storage key
+	WASM_STORE_QUERY_WITH_PROOF = "store/wasm/key"
+)
+
+// gRPC method paths for queries sent over the async-icq transport
+// The icq host module routes these through the host's gRPC query router, so the request
+// data must be the proto-encoded request message (store paths are not supported)
+const (
+	// The bank balance query - takes a QueryBalanceRequest and returns a QueryBalanceResponse
+	BANK_BALANCE_GRPC_QUERY = "/cosmos.bank.v1beta1.Query/Balance"
 )
 
 var (
@@ -46,15 +67,28 @@ var (
 )
 
 var (
-	KeyPrefixData   = []byte{prefixData}
-	KeyPrefixQuery  = []byte{prefixQuery}
-	KeyQueryCounter = []byte{prefixQueryCounter}
+	KeyPrefixData           = []byte{prefixData}
+	KeyPrefixQuery          = []byte{prefixQuery}
+	KeyQueryCounter         = []byte{prefixQueryCounter}
+	KeyPrefixRecurringQuery = []byte{prefixRecurringQuery}
+	KeyPrefixICQChannel     = []byte{prefixICQChannel}
+	KeyPrefixPacketQuery    = []byte{prefixPacketQuery}
 )
 
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
 
+// Recurring queries are keyed by the owning module and the query ID
+func RecurringQueryKey(ownerModule, id string) []byte {
+	return []byte(ownerModule + "/" + id)
+}
+
+// Queries sent over an async-icq channel are keyed by the channel ID and packet sequence
+func PacketQueryKey(channelId string, sequence uint64) []byte {
+	return append([]byte(channelId+"/"), sdk.Uint64ToBigEndian(sequence)...)
+}
+
 func FormatOsmosisMostRecentTWAPKey(poolId uint64, denom1, denom2 string) []byte {
 	// Sort denoms
 	if denom1 > denom2 {
@@ -39,6 +39,9 @@ type MsgSubmitQueryResponse struct {
 	ProofOps    *crypto.ProofOps `protobuf:"bytes,4,opt,name=proof_ops,json=proofOps,proto3" json:"proof_ops,omitempty" yaml:"proof_ops"`
 	Height      int64            `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
 	FromAddress string           `protobuf:"bytes,6,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// Results for each key in a batched query, in the same order as the
+	// query's batch_request_data (all proven at the same height)
+	BatchResults []BatchQueryResult `protobuf:"bytes,7,rep,name=batch_results,json=batchResults,proto3" json:"batch_results"`
 }
 
 func (m *MsgSubmitQueryResponse) Reset()         { *m = MsgSubmitQueryResponse{} }
@@ -74,6 +77,105 @@ func (m *MsgSubmitQueryResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitQueryResponse proto.InternalMessageInfo
 
+// The result and proof for a single key in a batched query
+type BatchQueryResult struct {
+	Result   []byte           `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	ProofOps *crypto.ProofOps `protobuf:"bytes,2,opt,name=proof_ops,json=proofOps,proto3" json:"proof_ops,omitempty"`
+}
+
+func (m *BatchQueryResult) Reset()         { *m = BatchQueryResult{} }
+func (m *BatchQueryResult) String() string { return proto.CompactTextString(m) }
+func (*BatchQueryResult) ProtoMessage()    {}
+func (*BatchQueryResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25adad4f8ed32400, []int{1}
+}
+func (m *BatchQueryResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchQueryResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchQueryResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchQueryResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchQueryResult.Merge(m, src)
+}
+func (m *BatchQueryResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchQueryResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchQueryResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchQueryResult proto.InternalMessageInfo
+
+func (m *BatchQueryResult) GetResult() []byte {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *BatchQueryResult) GetProofOps() *crypto.ProofOps {
+	if m != nil {
+		return m.ProofOps
+	}
+	return nil
+}
+
+// The args passed to the callback of a batched query, with the result for each
+// key in the same order as the query's batch_request_data
+type BatchQueryCallbackArgs struct {
+	Results [][]byte `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *BatchQueryCallbackArgs) Reset()         { *m = BatchQueryCallbackArgs{} }
+func (m *BatchQueryCallbackArgs) String() string { return proto.CompactTextString(m) }
+func (*BatchQueryCallbackArgs) ProtoMessage()    {}
+func (*BatchQueryCallbackArgs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25adad4f8ed32400, []int{2}
+}
+func (m *BatchQueryCallbackArgs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchQueryCallbackArgs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchQueryCallbackArgs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchQueryCallbackArgs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchQueryCallbackArgs.Merge(m, src)
+}
+func (m *BatchQueryCallbackArgs) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchQueryCallbackArgs) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchQueryCallbackArgs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchQueryCallbackArgs proto.InternalMessageInfo
+
+func (m *BatchQueryCallbackArgs) GetResults() [][]byte {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 // MsgSubmitQueryResponseResponse defines the MsgSubmitQueryResponse response
 // type.
 type MsgSubmitQueryResponseResponse struct {
@@ -83,7 +185,7 @@ func (m *MsgSubmitQueryResponseResponse) Reset()         { *m = MsgSubmitQueryRe
 func (m *MsgSubmitQueryResponseResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitQueryResponseResponse) ProtoMessage()    {}
 func (*MsgSubmitQueryResponseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25adad4f8ed32400, []int{1}
+	return fileDescriptor_25adad4f8ed32400, []int{3}
 }
 func (m *MsgSubmitQueryResponseResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -114,6 +216,8 @@ var xxx_messageInfo_MsgSubmitQueryResponseResponse proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*MsgSubmitQueryResponse)(nil), "stride.interchainquery.v1.MsgSubmitQueryResponse")
+	proto.RegisterType((*BatchQueryResult)(nil), "stride.interchainquery.v1.BatchQueryResult")
+	proto.RegisterType((*BatchQueryCallbackArgs)(nil), "stride.interchainquery.v1.BatchQueryCallbackArgs")
 	proto.RegisterType((*MsgSubmitQueryResponseResponse)(nil), "stride.interchainquery.v1.MsgSubmitQueryResponseResponse")
 }
 
@@ -122,40 +226,45 @@ func init() {
 }
 
 var fileDescriptor_25adad4f8ed32400 = []byte{
-	// 513 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x3f, 0x8f, 0xd3, 0x3e,
-	0x18, 0xc7, 0xeb, 0xf6, 0xf7, 0xeb, 0xdd, 0xe5, 0x8a, 0x80, 0x5c, 0x85, 0x72, 0x05, 0x92, 0xca,
-	0x0b, 0x05, 0x71, 0xb6, 0x5a, 0x06, 0x74, 0x65, 0xa2, 0xdb, 0x49, 0x1c, 0x7f, 0xd2, 0x8d, 0xa5,
-	0x4a, 0x1a, 0x9f, 0x6b, 0xa9, 0x89, 0x83, 0xed, 0x56, 0xd7, 0x95, 0x89, 0x11, 0x89, 0x85, 0xb1,
-	0x2f, 0x02, 0x89, 0x91, 0x95, 0xf1, 0x04, 0x0b, 0x53, 0x85, 0x5a, 0x06, 0x58, 0xfb, 0x0a, 0x50,
-	0xec, 0x04, 0xd0, 0x5d, 0x19, 0x98, 0xfc, 0xe4, 0xfb, 0x7c, 0x9e, 0x7f, 0xf1, 0x63, 0xab, 0x25,
-	0x95, 0x60, 0x11, 0xc1, 0x2c, 0x51, 0x44, 0x0c, 0x47, 0x01, 0x4b, 0x5e, 0x4c, 0x88, 0x98, 0xe1,
-	0x69, 0x1b, 0xc7, 0x44, 0xca, 0x80, 0x12, 0x89, 0x52, 0xc1, 0x15, 0xb7, 0xf7, 0x0d, 0x89, 0xce,
-	0x91, 0x68, 0xda, 0x6e, 0xec, 0x0f, 0xb9, 0x8c, 0xb9, 0x1c, 0x68, 0x10, 0x9b, 0x0f, 0x13, 0xd5,
-	0xa8, 0x53, 0x4e, 0xb9, 0xd1, 0x33, 0x2b, 0x57, 0x6f, 0x50, 0xce, 0xe9, 0x98, 0xe0, 0x20, 0x65,
-	0x38, 0x48, 0x12, 0xae, 0x02, 0xc5, 0x78, 0x52, 0xc4, 0xdc, 0x54, 0x24, 0x89, 0x88, 0x88, 0x59,
-	0xa2, 0xf0, 0x50, 0xcc, 0x52, 0xc5, 0x71, 0x2a, 0x38, 0x3f, 0x31, 0x6e, 0xf8, 0xa3, 0x6c, 0x5d,
-	0x3b, 0x96, 0xb4, 0x3f, 0x09, 0x63, 0xa6, 0x9e, 0x65, 0x3d, 0xf8, 0x44, 0xa6, 0x3c, 0x91, 0xc4,
-	0x46, 0xd6, 0xb6, 0xee, 0x6c, 0xc0, 0x22, 0x07, 0x34, 0x41, 0x6b, 0xa7, 0xb7, 0xb7, 0x5e, 0x78,
-	0x97, 0x67, 0x41, 0x3c, 0xee, 0xc2, 0xc2, 0x03, 0xfd, 0x2d, 0x6d, 0x1e, 0x45, 0x19, 0xaf, 0x87,
-	0xc8, 0xf8, 0xf2, 0x79, 0xbe, 0xf0, 0x40, 0x7f, 0x4b, 0x9b, 0x47, 0x91, 0x7d, 0xdb, 0xaa, 0x0a,
-	0x22, 0x27, 0x63, 0xe5, 0x54, 0x9a, 0xa0, 0x55, 0xeb, 0x5d, 0x5d, 0x2f, 0xbc, 0x4b, 0x86, 0x36,
-	0x3a, 0xf4, 0x73, 0xc0, 0x7e, 0x6c, 0xed, 0xe8, 0xa6, 0x07, 0x3c, 0x95, 0xce, 0x7f, 0x4d, 0xd0,
-	0xda, 0xed, 0x5c, 0x47, 0xbf, 0x07, 0x43, 0x66, 0x30, 0xf4, 0x34, 0x63, 0x9e, 0xa4, 0xb2, 0x57,
-	0x5f, 0x2f, 0xbc, 0x2b, 0x26, 0xd5, 0xaf, 0x38, 0xe8, 0x6f, 0xa7, 0xb9, 0x3f, 0x2b, 0x3d, 0x22,
-	0x8c, 0x8e, 0x94, 0xf3, 0x7f, 0x13, 0xb4, 0x2a, 0x7f, 0x96, 0x36, 0x3a, 0xf4, 0x73, 0xc0, 0x7e,
-	0x60, 0xd5, 0x4e, 0x04, 0x8f, 0x07, 0x41, 0x14, 0x09, 0x22, 0xa5, 0x53, 0xd5, 0x93, 0x39, 0x9f,
-	0xde, 0x1d, 0xd4, 0xf3, 0xbb, 0x79, 0x68, 0x3c, 0x7d, 0x25, 0x58, 0x42, 0xfd, 0xdd, 0x8c, 0xce,
-	0xa5, 0x6e, 0xed, 0xd5, 0xdc, 0x2b, 0xbd, 0x9d, 0x7b, 0xe0, 0xfb, 0xdc, 0x2b, 0xc1, 0xa6, 0xe5,
-	0x6e, 0xfe, 0xd5, 0xc5, 0xd9, 0xf9, 0x00, 0xac, 0xca, 0xb1, 0xa4, 0xf6, 0x7b, 0x60, 0xed, 0x6d,
-	0xba, 0x92, 0x36, 0xfa, 0xeb, 0xde, 0xa0, 0xcd, 0xa9, 0x1b, 0x87, 0xff, 0x1c, 0x52, 0x9c, 0xb0,
-	0xf3, 0xf2, 0xf3, 0xb7, 0x37, 0xe5, 0xbb, 0x5d, 0x70, 0x07, 0xde, 0xba, 0xb0, 0xd3, 0xea, 0x14,
-	0x4f, 0xdb, 0x21, 0x51, 0x41, 0x1b, 0x4b, 0x9d, 0x43, 0xcb, 0xbd, 0xfe, 0xc7, 0xa5, 0x0b, 0xce,
-	0x96, 0x2e, 0xf8, 0xba, 0x74, 0xc1, 0xeb, 0x95, 0x5b, 0x3a, 0x5b, 0xb9, 0xa5, 0x2f, 0x2b, 0xb7,
-	0xf4, 0xfc, 0x90, 0x32, 0x35, 0x9a, 0x84, 0x68, 0xc8, 0x63, 0xdc, 0xd7, 0x2d, 0x1d, 0x3c, 0x0a,
-	0x42, 0x89, 0xf3, 0x37, 0x33, 0xed, 0xdc, 0xc7, 0xa7, 0x17, 0xab, 0xcc, 0x52, 0x22, 0xc3, 0xaa,
-	0xde, 0xd5, 0x7b, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x78, 0x4f, 0x6d, 0x58, 0x60, 0x03, 0x00,
-	0x00,
+	// 605 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbd, 0x6f, 0xd3, 0x4e,
+	0x18, 0xce, 0x35, 0xfd, 0x35, 0xed, 0x35, 0xd5, 0xaf, 0xb8, 0x55, 0xe5, 0x16, 0xb0, 0xad, 0x5b,
+	0x30, 0x1f, 0xf5, 0x29, 0x61, 0x80, 0x96, 0xa9, 0x61, 0xaa, 0x44, 0xf9, 0x70, 0x24, 0x06, 0x96,
+	0xe8, 0x1c, 0x5f, 0x1d, 0x8b, 0xd8, 0x67, 0xee, 0x2e, 0x51, 0xb3, 0x32, 0x31, 0x22, 0xb1, 0x30,
+	0xf6, 0x8f, 0x40, 0x62, 0x44, 0x6c, 0x1d, 0x2b, 0x58, 0x98, 0x22, 0xd4, 0x32, 0x30, 0xf7, 0x2f,
+	0x40, 0xbe, 0xb3, 0xdb, 0xaa, 0x0d, 0x20, 0x26, 0xbf, 0xf7, 0xbc, 0xcf, 0xfb, 0xf5, 0xbc, 0x77,
+	0x86, 0xae, 0x90, 0x3c, 0x0e, 0x29, 0x8e, 0x53, 0x49, 0x79, 0xb7, 0x47, 0xe2, 0xf4, 0xd5, 0x80,
+	0xf2, 0x11, 0x1e, 0x36, 0x70, 0x42, 0x85, 0x20, 0x11, 0x15, 0x5e, 0xc6, 0x99, 0x64, 0xc6, 0xaa,
+	0x66, 0x7a, 0x17, 0x98, 0xde, 0xb0, 0xb1, 0xb6, 0xda, 0x65, 0x22, 0x61, 0xa2, 0xa3, 0x88, 0x58,
+	0x1f, 0x74, 0xd4, 0xda, 0x72, 0xc4, 0x22, 0xa6, 0xf1, 0xdc, 0x2a, 0xd0, 0x6b, 0x11, 0x63, 0x51,
+	0x9f, 0x62, 0x92, 0xc5, 0x98, 0xa4, 0x29, 0x93, 0x44, 0xc6, 0x2c, 0x2d, 0x63, 0xae, 0x4b, 0x9a,
+	0x86, 0x94, 0x27, 0x71, 0x2a, 0x71, 0x97, 0x8f, 0x32, 0xc9, 0x70, 0xc6, 0x19, 0xdb, 0xd5, 0x6e,
+	0xf4, 0xb9, 0x0a, 0x57, 0x76, 0x44, 0xd4, 0x1e, 0x04, 0x49, 0x2c, 0x9f, 0xe5, 0x3d, 0xf8, 0x54,
+	0x64, 0x2c, 0x15, 0xd4, 0xf0, 0xe0, 0xac, 0xea, 0xac, 0x13, 0x87, 0x26, 0x70, 0x80, 0x3b, 0xd7,
+	0x5a, 0x3a, 0x19, 0xdb, 0xff, 0x8f, 0x48, 0xd2, 0xdf, 0x44, 0xa5, 0x07, 0xf9, 0x35, 0x65, 0x6e,
+	0x87, 0x39, 0x5f, 0x0d, 0x91, 0xf3, 0xa7, 0x2e, 0xf2, 0x4b, 0x0f, 0xf2, 0x6b, 0xca, 0xdc, 0x0e,
+	0x8d, 0x9b, 0x70, 0x86, 0x53, 0x31, 0xe8, 0x4b, 0xb3, 0xea, 0x00, 0xb7, 0xde, 0xba, 0x72, 0x32,
+	0xb6, 0x17, 0x34, 0x5b, 0xe3, 0xc8, 0x2f, 0x08, 0xc6, 0x63, 0x38, 0xa7, 0x9a, 0xee, 0xb0, 0x4c,
+	0x98, 0xd3, 0x0e, 0x70, 0xe7, 0x9b, 0x57, 0xbd, 0xb3, 0xc1, 0x3c, 0x3d, 0x98, 0xf7, 0x34, 0xe7,
+	0x3c, 0xc9, 0x44, 0x6b, 0xf9, 0x64, 0x6c, 0x2f, 0xea, 0x54, 0xa7, 0x71, 0xc8, 0x9f, 0xcd, 0x0a,
+	0x7f, 0x5e, 0xba, 0x47, 0xe3, 0xa8, 0x27, 0xcd, 0xff, 0x1c, 0xe0, 0x56, 0xcf, 0x97, 0xd6, 0x38,
+	0xf2, 0x0b, 0x82, 0xf1, 0x00, 0xd6, 0x77, 0x39, 0x4b, 0x3a, 0x24, 0x0c, 0x39, 0x15, 0xc2, 0x9c,
+	0x51, 0x93, 0x99, 0x5f, 0x3e, 0xac, 0x2f, 0x17, 0xbb, 0xd9, 0xd2, 0x9e, 0xb6, 0xe4, 0x71, 0x1a,
+	0xf9, 0xf3, 0x39, 0xbb, 0x80, 0x8c, 0xe7, 0x70, 0x21, 0x20, 0xb2, 0xdb, 0xeb, 0xe8, 0x39, 0x84,
+	0x59, 0x73, 0xaa, 0xee, 0x7c, 0xf3, 0xb6, 0xf7, 0xdb, 0xf5, 0x7b, 0xad, 0x9c, 0x5f, 0x2e, 0x62,
+	0xd0, 0x97, 0xad, 0xe9, 0x83, 0xb1, 0x5d, 0xf1, 0xeb, 0x2a, 0x8f, 0x86, 0xc4, 0x66, 0xfd, 0xcd,
+	0xbe, 0x5d, 0x79, 0xbf, 0x6f, 0x83, 0x9f, 0xfb, 0x76, 0x05, 0x85, 0x70, 0xf1, 0x62, 0x94, 0xb1,
+	0x72, 0x2a, 0x6e, 0xbe, 0xba, 0xfa, 0xa9, 0x92, 0xf7, 0xcf, 0x2b, 0x39, 0xf5, 0x57, 0x25, 0xcf,
+	0x34, 0x43, 0x4d, 0xb8, 0x72, 0x56, 0xe5, 0x21, 0xe9, 0xf7, 0x03, 0xd2, 0x7d, 0xb9, 0xc5, 0x23,
+	0x61, 0x98, 0xb0, 0x56, 0xce, 0x07, 0x9c, 0xaa, 0x5b, 0xf7, 0xcb, 0x23, 0x72, 0xa0, 0x35, 0xf9,
+	0x72, 0x95, 0xdf, 0xe6, 0x27, 0x00, 0xab, 0x3b, 0x22, 0x32, 0x3e, 0x02, 0xb8, 0x34, 0xe9, 0x12,
+	0x36, 0xfe, 0x20, 0xd5, 0xe4, 0xd4, 0x6b, 0x1b, 0xff, 0x1c, 0x52, 0x7e, 0x51, 0xf3, 0xf5, 0xd7,
+	0x1f, 0xef, 0xa6, 0xee, 0xa0, 0x1b, 0x97, 0x9e, 0xb0, 0xdc, 0xc3, 0xc3, 0x46, 0x40, 0x25, 0x69,
+	0x60, 0xa1, 0x12, 0x28, 0x78, 0x13, 0xdc, 0x6a, 0xb5, 0x0f, 0x8e, 0x2c, 0x70, 0x78, 0x64, 0x81,
+	0xef, 0x47, 0x16, 0x78, 0x7b, 0x6c, 0x55, 0x0e, 0x8f, 0xad, 0xca, 0xb7, 0x63, 0xab, 0xf2, 0x62,
+	0x23, 0x8a, 0x65, 0x6f, 0x10, 0x78, 0x5d, 0x96, 0xe0, 0xb6, 0x6a, 0x69, 0xfd, 0x11, 0x09, 0x04,
+	0x2e, 0xfe, 0x12, 0xc3, 0xe6, 0x3d, 0xbc, 0x77, 0xb9, 0xd0, 0x28, 0xa3, 0x22, 0x98, 0x51, 0xaf,
+	0xf3, 0xee, 0xaf, 0x01, 0x00, 0x7d, 0x2c, 0xf0, 0x51, 0x52, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -260,6 +369,20 @@ func (m *MsgSubmitQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.BatchResults) > 0 {
+		for iNdEx := len(m.BatchResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.FromAddress) > 0 {
 		i -= len(m.FromAddress)
 		copy(dAtA[i:], m.FromAddress)
@@ -308,6 +431,80 @@ func (m *MsgSubmitQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *BatchQueryResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchQueryResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchQueryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProofOps != nil {
+		{
+			size, err := m.ProofOps.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchQueryCallbackArgs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchQueryCallbackArgs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchQueryCallbackArgs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Results[iNdEx])
+			copy(dAtA[i:], m.Results[iNdEx])
+			i = encodeVarintMessages(dAtA, i, uint64(len(m.Results[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgSubmitQueryResponseResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -371,6 +568,44 @@ func (m *MsgSubmitQueryResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMessages(uint64(l))
 	}
+	if len(m.BatchResults) > 0 {
+		for _, e := range m.BatchResults {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchQueryResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.ProofOps != nil {
+		l = m.ProofOps.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchQueryCallbackArgs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, b := range m.Results {
+			l = len(b)
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -603,6 +838,242 @@ func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchResults = append(m.BatchResults, BatchQueryResult{})
+			if err := m.BatchResults[len(m.BatchResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchQueryResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchQueryResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchQueryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofOps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProofOps == nil {
+				m.ProofOps = &crypto.ProofOps{}
+			}
+			if err := m.ProofOps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchQueryCallbackArgs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchQueryCallbackArgs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchQueryCallbackArgs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, make([]byte, postIndex-iNdEx))
+			copy(m.Results[len(m.Results)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
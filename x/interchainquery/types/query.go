@@ -15,6 +15,48 @@ func (q Query) HasTimedOut(currentBlockTime time.Time) bool {
 
 // Prints an abbreviated query description for logging purposes
 func (q Query) Description() string {
+	if q.IsBatch() {
+		return fmt.Sprintf("QueryId: %s, QueryType: %s, ConnectionId: %s, BatchQueryRequest: %v",
+			q.Id, q.QueryType, q.ConnectionId, q.BatchRequestData)
+	}
 	return fmt.Sprintf("QueryId: %s, QueryType: %s, ConnectionId: %s, QueryRequest: %v",
 		q.Id, q.QueryType, q.ConnectionId, q.RequestData)
 }
+
+// Returns the block time at which the query was submitted, derived from
+// the timeout timestamp and duration
+func (q Query) SubmissionTime() time.Time {
+	return time.Unix(0, utils.UintToInt(q.TimeoutTimestamp)).Add(-q.TimeoutDuration)
+}
+
+// Checks whether the query is over multiple keys from the same store
+func (q Query) IsBatch() bool {
+	return len(q.BatchRequestData) > 0
+}
+
+// Returns the keys that are queried (a single key unless the query is batched)
+func (q Query) GetRequestKeys() [][]byte {
+	if q.IsBatch() {
+		return q.BatchRequestData
+	}
+	return [][]byte{q.RequestData}
+}
+
+// Builds the callback args for a batched query from the result of each key
+func NewBatchQueryCallbackArgs(batchResults []BatchQueryResult) ([]byte, error) {
+	callbackArgs := BatchQueryCallbackArgs{}
+	for _, batchResult := range batchResults {
+		callbackArgs.Results = append(callbackArgs.Results, batchResult.Result)
+	}
+	return callbackArgs.Marshal()
+}
+
+// Unmarshals the callback args of a batched query into the result of each key
+// (in the same order as the query's batch request data)
+func UnmarshalBatchQueryCallbackArgs(args []byte) ([][]byte, error) {
+	var callbackArgs BatchQueryCallbackArgs
+	if err := callbackArgs.Unmarshal(args); err != nil {
+		return nil, err
+	}
+	return callbackArgs.Results, nil
+}
@@ -109,9 +109,255 @@ func (m *QueryPendingQueriesResponse) GetPendingQueries() []Query {
 	return nil
 }
 
+// Summary of the queries that are awaiting a response for a given chain and
+// callback
+type PendingQueryStats struct {
+	ChainId    string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CallbackId string `protobuf:"bytes,2,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	// Number of queries that have not yet received a response
+	NumPending uint64 `protobuf:"varint,3,opt,name=num_pending,json=numPending,proto3" json:"num_pending,omitempty"`
+	// Number of pending queries that are past their timeout
+	NumTimedOut uint64 `protobuf:"varint,4,opt,name=num_timed_out,json=numTimedOut,proto3" json:"num_timed_out,omitempty"`
+	// Time since the oldest pending query was submitted
+	OldestQueryAgeSeconds uint64 `protobuf:"varint,5,opt,name=oldest_query_age_seconds,json=oldestQueryAgeSeconds,proto3" json:"oldest_query_age_seconds,omitempty"`
+}
+
+func (m *PendingQueryStats) Reset()         { *m = PendingQueryStats{} }
+func (m *PendingQueryStats) String() string { return proto.CompactTextString(m) }
+func (*PendingQueryStats) ProtoMessage()    {}
+func (*PendingQueryStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b720c147b9144d5b, []int{2}
+}
+func (m *PendingQueryStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingQueryStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingQueryStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingQueryStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingQueryStats.Merge(m, src)
+}
+func (m *PendingQueryStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingQueryStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingQueryStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingQueryStats proto.InternalMessageInfo
+
+func (m *PendingQueryStats) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *PendingQueryStats) GetCallbackId() string {
+	if m != nil {
+		return m.CallbackId
+	}
+	return ""
+}
+
+func (m *PendingQueryStats) GetNumPending() uint64 {
+	if m != nil {
+		return m.NumPending
+	}
+	return 0
+}
+
+func (m *PendingQueryStats) GetNumTimedOut() uint64 {
+	if m != nil {
+		return m.NumTimedOut
+	}
+	return 0
+}
+
+func (m *PendingQueryStats) GetOldestQueryAgeSeconds() uint64 {
+	if m != nil {
+		return m.OldestQueryAgeSeconds
+	}
+	return 0
+}
+
+type QueryPendingQueryStatsRequest struct {
+}
+
+func (m *QueryPendingQueryStatsRequest) Reset()         { *m = QueryPendingQueryStatsRequest{} }
+func (m *QueryPendingQueryStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingQueryStatsRequest) ProtoMessage()    {}
+func (*QueryPendingQueryStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b720c147b9144d5b, []int{3}
+}
+func (m *QueryPendingQueryStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingQueryStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingQueryStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingQueryStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingQueryStatsRequest.Merge(m, src)
+}
+func (m *QueryPendingQueryStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingQueryStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingQueryStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingQueryStatsRequest proto.InternalMessageInfo
+
+type QueryPendingQueryStatsResponse struct {
+	Stats []PendingQueryStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats"`
+}
+
+func (m *QueryPendingQueryStatsResponse) Reset()         { *m = QueryPendingQueryStatsResponse{} }
+func (m *QueryPendingQueryStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingQueryStatsResponse) ProtoMessage()    {}
+func (*QueryPendingQueryStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b720c147b9144d5b, []int{4}
+}
+func (m *QueryPendingQueryStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingQueryStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingQueryStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingQueryStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingQueryStatsResponse.Merge(m, src)
+}
+func (m *QueryPendingQueryStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingQueryStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingQueryStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingQueryStatsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingQueryStatsResponse) GetStats() []PendingQueryStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+type QueryRecurringQueriesRequest struct {
+}
+
+func (m *QueryRecurringQueriesRequest) Reset()         { *m = QueryRecurringQueriesRequest{} }
+func (m *QueryRecurringQueriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecurringQueriesRequest) ProtoMessage()    {}
+func (*QueryRecurringQueriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b720c147b9144d5b, []int{5}
+}
+func (m *QueryRecurringQueriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecurringQueriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecurringQueriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecurringQueriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecurringQueriesRequest.Merge(m, src)
+}
+func (m *QueryRecurringQueriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecurringQueriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecurringQueriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecurringQueriesRequest proto.InternalMessageInfo
+
+type QueryRecurringQueriesResponse struct {
+	RecurringQueries []RecurringQuery `protobuf:"bytes,1,rep,name=recurring_queries,json=recurringQueries,proto3" json:"recurring_queries"`
+}
+
+func (m *QueryRecurringQueriesResponse) Reset()         { *m = QueryRecurringQueriesResponse{} }
+func (m *QueryRecurringQueriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecurringQueriesResponse) ProtoMessage()    {}
+func (*QueryRecurringQueriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b720c147b9144d5b, []int{6}
+}
+func (m *QueryRecurringQueriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecurringQueriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecurringQueriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecurringQueriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecurringQueriesResponse.Merge(m, src)
+}
+func (m *QueryRecurringQueriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecurringQueriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecurringQueriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecurringQueriesResponse proto.InternalMessageInfo
+
+func (m *QueryRecurringQueriesResponse) GetRecurringQueries() []RecurringQuery {
+	if m != nil {
+		return m.RecurringQueries
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryPendingQueriesRequest)(nil), "stride.interchainquery.v1.QueryPendingQueriesRequest")
 	proto.RegisterType((*QueryPendingQueriesResponse)(nil), "stride.interchainquery.v1.QueryPendingQueriesResponse")
+	proto.RegisterType((*PendingQueryStats)(nil), "stride.interchainquery.v1.PendingQueryStats")
+	proto.RegisterType((*QueryPendingQueryStatsRequest)(nil), "stride.interchainquery.v1.QueryPendingQueryStatsRequest")
+	proto.RegisterType((*QueryPendingQueryStatsResponse)(nil), "stride.interchainquery.v1.QueryPendingQueryStatsResponse")
+	proto.RegisterType((*QueryRecurringQueriesRequest)(nil), "stride.interchainquery.v1.QueryRecurringQueriesRequest")
+	proto.RegisterType((*QueryRecurringQueriesResponse)(nil), "stride.interchainquery.v1.QueryRecurringQueriesResponse")
 }
 
 func init() {
@@ -119,27 +365,43 @@ func init() {
 }
 
 var fileDescriptor_b720c147b9144d5b = []byte{
-	// 314 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2d, 0x2e, 0x29, 0xca,
-	0x4c, 0x49, 0xd5, 0xcf, 0xcc, 0x2b, 0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x2b, 0x2c, 0x4d,
-	0x2d, 0xaa, 0xd4, 0x2f, 0x33, 0xd4, 0x07, 0x33, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x24,
-	0x21, 0xca, 0xf4, 0xd0, 0x94, 0xe9, 0x95, 0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x55,
-	0xe9, 0x83, 0x58, 0x10, 0x0d, 0x52, 0x32, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x89, 0x05,
-	0x99, 0xfa, 0x89, 0x79, 0x79, 0xf9, 0x25, 0x89, 0x25, 0x99, 0xf9, 0x79, 0xc5, 0x50, 0x59, 0x75,
-	0xdc, 0xb6, 0xa6, 0xa7, 0xe6, 0xa5, 0x16, 0x67, 0x42, 0x15, 0x2a, 0xc9, 0x70, 0x49, 0x05, 0x82,
-	0x64, 0x02, 0x52, 0xf3, 0x52, 0x32, 0xf3, 0xd2, 0x41, 0xec, 0xcc, 0xd4, 0xe2, 0xa0, 0xd4, 0xc2,
-	0xd2, 0xd4, 0xe2, 0x12, 0xa5, 0x3c, 0x2e, 0x69, 0xac, 0xb2, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9,
-	0x42, 0xfe, 0x5c, 0xfc, 0x05, 0x10, 0x99, 0xf8, 0x42, 0x88, 0x94, 0x04, 0xa3, 0x02, 0xb3, 0x06,
-	0xb7, 0x91, 0x82, 0x1e, 0x4e, 0xef, 0xe8, 0x81, 0x0d, 0x74, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21,
-	0x88, 0xaf, 0x00, 0xc5, 0x60, 0xa3, 0xb3, 0x8c, 0x5c, 0x3c, 0x60, 0xf9, 0xe0, 0xd4, 0xa2, 0xb2,
-	0xcc, 0xe4, 0x54, 0xa1, 0x3d, 0x8c, 0x5c, 0x7c, 0xa8, 0x96, 0x0b, 0x99, 0x12, 0x32, 0x1b, 0xab,
-	0x57, 0xa4, 0xcc, 0x48, 0xd5, 0x06, 0xf1, 0xa3, 0x92, 0x75, 0xd3, 0xe5, 0x27, 0x93, 0x99, 0x4c,
-	0x85, 0x8c, 0xf5, 0x83, 0xc1, 0xfa, 0x75, 0x7d, 0x12, 0x93, 0x8a, 0xf5, 0x71, 0x04, 0x2f, 0x5a,
-	0x68, 0x38, 0x05, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c,
-	0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x65, 0x7a,
-	0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0x2e, 0x36, 0x83, 0xcb, 0x8c, 0xcc, 0xf5, 0x2b,
-	0x30, 0x8c, 0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xc7, 0x9c, 0x31, 0x20, 0x00, 0x00,
-	0xff, 0xff, 0x61, 0xec, 0xf0, 0x18, 0x5a, 0x02, 0x00, 0x00,
+	// 576 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x6b, 0x13, 0x41,
+	0x18, 0xcd, 0xb6, 0xa9, 0x3f, 0xa6, 0x5a, 0xdb, 0x41, 0x61, 0xbb, 0xa6, 0x9b, 0xb0, 0x20, 0x46,
+	0xd0, 0x5d, 0x9a, 0x12, 0xd3, 0x20, 0x22, 0xf6, 0xa4, 0x20, 0x54, 0x13, 0x4f, 0x22, 0x2c, 0x9b,
+	0xdd, 0x61, 0x3b, 0x9a, 0xcc, 0x6c, 0x76, 0x66, 0x83, 0x7b, 0xf0, 0xe2, 0x5f, 0x20, 0xf8, 0xef,
+	0x78, 0xf4, 0x50, 0x0f, 0x42, 0xc1, 0x8b, 0x27, 0x29, 0x89, 0x7f, 0x88, 0xec, 0xcc, 0x04, 0xcc,
+	0x26, 0xdb, 0x9a, 0xde, 0x26, 0xf3, 0xde, 0xf7, 0xbe, 0xef, 0x7d, 0xf3, 0x36, 0xe0, 0x0e, 0xe3,
+	0x31, 0x0e, 0x90, 0x83, 0x09, 0x47, 0xb1, 0x7f, 0xe4, 0x61, 0x32, 0x4c, 0x50, 0x9c, 0x3a, 0xa3,
+	0x5d, 0x47, 0x1c, 0xec, 0x28, 0xa6, 0x9c, 0xc2, 0x6d, 0x49, 0xb3, 0x73, 0x34, 0x7b, 0xb4, 0x6b,
+	0xdc, 0x0c, 0x69, 0x48, 0x05, 0xcb, 0xc9, 0x4e, 0xb2, 0xc0, 0xa8, 0x84, 0x94, 0x86, 0x7d, 0xe4,
+	0x78, 0x11, 0x76, 0x3c, 0x42, 0x28, 0xf7, 0x38, 0xa6, 0x84, 0x29, 0xf4, 0x6e, 0x71, 0xd7, 0x10,
+	0x11, 0xc4, 0xb0, 0x22, 0x5a, 0x15, 0x60, 0xbc, 0xca, 0x90, 0x97, 0x88, 0x04, 0x98, 0x84, 0xd9,
+	0x19, 0x23, 0xd6, 0x41, 0xc3, 0x04, 0x31, 0x6e, 0x11, 0x70, 0x7b, 0x21, 0xca, 0x22, 0x4a, 0x18,
+	0x82, 0x87, 0xe0, 0x46, 0x24, 0x11, 0x77, 0x28, 0x21, 0x5d, 0xab, 0xad, 0xd6, 0xd7, 0x1b, 0x35,
+	0xbb, 0xd0, 0x8e, 0x2d, 0x04, 0x0f, 0xca, 0xc7, 0xbf, 0xab, 0xa5, 0xce, 0x46, 0x34, 0x23, 0x6c,
+	0xfd, 0xd0, 0xc0, 0xd6, 0x3f, 0xbd, 0xd2, 0x2e, 0xf7, 0x38, 0x83, 0xdb, 0xe0, 0x8a, 0x90, 0x70,
+	0x71, 0xa0, 0x6b, 0x35, 0xad, 0x7e, 0xb5, 0x73, 0x59, 0xfc, 0x7e, 0x1e, 0xc0, 0x2a, 0x58, 0xf7,
+	0xbd, 0x7e, 0xbf, 0xe7, 0xf9, 0xef, 0x33, 0x74, 0x45, 0xa0, 0x60, 0x7a, 0x25, 0x09, 0x24, 0x19,
+	0xb8, 0xaa, 0x8f, 0xbe, 0x5a, 0xd3, 0xea, 0xe5, 0x0e, 0x20, 0xc9, 0x40, 0xb5, 0x81, 0x16, 0xb8,
+	0x9e, 0x11, 0x38, 0x1e, 0xa0, 0xc0, 0xa5, 0x09, 0xd7, 0xcb, 0x82, 0x92, 0x55, 0xbd, 0xce, 0xee,
+	0x0e, 0x13, 0x0e, 0x5b, 0x40, 0xa7, 0xfd, 0x00, 0x31, 0x2e, 0x6c, 0xa6, 0xae, 0x17, 0x22, 0x97,
+	0x21, 0x9f, 0x92, 0x80, 0xe9, 0x6b, 0x82, 0x7e, 0x4b, 0xe2, 0x62, 0xe8, 0xa7, 0x21, 0xea, 0x4a,
+	0xd0, 0xaa, 0x82, 0x9d, 0xfc, 0xfe, 0xa4, 0xa7, 0xe9, 0x82, 0xdf, 0x01, 0xb3, 0x88, 0xa0, 0x76,
+	0xfc, 0x0c, 0xac, 0xb1, 0xec, 0x42, 0x6d, 0xf6, 0xfe, 0x19, 0x9b, 0x9d, 0x13, 0x51, 0x5b, 0x96,
+	0x02, 0x96, 0x09, 0x2a, 0x02, 0xea, 0x20, 0x3f, 0x89, 0xe3, 0xf9, 0xc7, 0xfe, 0x08, 0x76, 0x0a,
+	0x70, 0x35, 0xca, 0x5b, 0xb0, 0x15, 0x4f, 0xb1, 0xdc, 0x83, 0xdf, 0x3b, 0x63, 0xac, 0x19, 0xbd,
+	0xe9, 0xcb, 0x6f, 0xc6, 0xb9, 0x2e, 0x8d, 0xd3, 0x32, 0xb8, 0x26, 0x47, 0x47, 0xf1, 0x08, 0xfb,
+	0x08, 0x7e, 0xd5, 0xc0, 0xc6, 0x6c, 0xf0, 0x60, 0xf3, 0xbc, 0x5c, 0x2d, 0x8c, 0xb1, 0xf1, 0x70,
+	0xd9, 0x32, 0x69, 0xd8, 0x7a, 0xf4, 0xe9, 0xe7, 0x9f, 0x2f, 0x2b, 0x4d, 0xb8, 0xe7, 0x74, 0x45,
+	0xfd, 0x83, 0x17, 0x5e, 0x8f, 0x39, 0x05, 0x9f, 0x56, 0xee, 0x4b, 0x80, 0xdf, 0x17, 0x66, 0x79,
+	0x7f, 0x89, 0x51, 0x66, 0xa2, 0x62, 0xb4, 0x2f, 0x50, 0xa9, 0x7c, 0x3c, 0x11, 0x3e, 0xda, 0xb0,
+	0xb5, 0xac, 0x8f, 0xd4, 0x15, 0xd1, 0x81, 0xdf, 0x34, 0xb0, 0x99, 0x8f, 0x05, 0x6c, 0x9d, 0x37,
+	0x50, 0x41, 0xd0, 0x8c, 0xfd, 0xe5, 0x0b, 0x95, 0x91, 0xc7, 0xc2, 0x48, 0x0b, 0x36, 0xff, 0xc7,
+	0xc8, 0x5c, 0x56, 0x0f, 0xba, 0xc7, 0x63, 0x53, 0x3b, 0x19, 0x9b, 0xda, 0xe9, 0xd8, 0xd4, 0x3e,
+	0x4f, 0xcc, 0xd2, 0xc9, 0xc4, 0x2c, 0xfd, 0x9a, 0x98, 0xa5, 0x37, 0xed, 0x10, 0xf3, 0xa3, 0xa4,
+	0x67, 0xfb, 0x74, 0xb0, 0x48, 0x7a, 0xd4, 0x68, 0x39, 0x1f, 0xe6, 0x1a, 0xf0, 0x34, 0x42, 0xac,
+	0x77, 0x49, 0xfc, 0x91, 0xee, 0xfd, 0x1d, 0x00, 0xb6, 0x03, 0xbf, 0x7d, 0xe9, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -155,6 +417,11 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryServiceClient interface {
 	PendingQueries(ctx context.Context, in *QueryPendingQueriesRequest, opts ...grpc.CallOption) (*QueryPendingQueriesResponse, error)
+	// Queries the number and age of pending queries, grouped by chain and
+	// callback
+	PendingQueryStats(ctx context.Context, in *QueryPendingQueryStatsRequest, opts ...grpc.CallOption) (*QueryPendingQueryStatsResponse, error)
+	// Queries all registered recurring queries
+	RecurringQueries(ctx context.Context, in *QueryRecurringQueriesRequest, opts ...grpc.CallOption) (*QueryRecurringQueriesResponse, error)
 }
 
 type queryServiceClient struct {
@@ -174,9 +441,32 @@ func (c *queryServiceClient) PendingQueries(ctx context.Context, in *QueryPendin
 	return out, nil
 }
 
+func (c *queryServiceClient) PendingQueryStats(ctx context.Context, in *QueryPendingQueryStatsRequest, opts ...grpc.CallOption) (*QueryPendingQueryStatsResponse, error) {
+	out := new(QueryPendingQueryStatsResponse)
+	err := c.cc.Invoke(ctx, "/stride.interchainquery.v1.QueryService/PendingQueryStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) RecurringQueries(ctx context.Context, in *QueryRecurringQueriesRequest, opts ...grpc.CallOption) (*QueryRecurringQueriesResponse, error) {
+	out := new(QueryRecurringQueriesResponse)
+	err := c.cc.Invoke(ctx, "/stride.interchainquery.v1.QueryService/RecurringQueries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServiceServer is the server API for QueryService service.
 type QueryServiceServer interface {
 	PendingQueries(context.Context, *QueryPendingQueriesRequest) (*QueryPendingQueriesResponse, error)
+	// Queries the number and age of pending queries, grouped by chain and
+	// callback
+	PendingQueryStats(context.Context, *QueryPendingQueryStatsRequest) (*QueryPendingQueryStatsResponse, error)
+	// Queries all registered recurring queries
+	RecurringQueries(context.Context, *QueryRecurringQueriesRequest) (*QueryRecurringQueriesResponse, error)
 }
 
 // UnimplementedQueryServiceServer can be embedded to have forward compatible implementations.
@@ -186,6 +476,12 @@ type UnimplementedQueryServiceServer struct {
 func (*UnimplementedQueryServiceServer) PendingQueries(ctx context.Context, req *QueryPendingQueriesRequest) (*QueryPendingQueriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PendingQueries not implemented")
 }
+func (*UnimplementedQueryServiceServer) PendingQueryStats(ctx context.Context, req *QueryPendingQueryStatsRequest) (*QueryPendingQueryStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingQueryStats not implemented")
+}
+func (*UnimplementedQueryServiceServer) RecurringQueries(ctx context.Context, req *QueryRecurringQueriesRequest) (*QueryRecurringQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecurringQueries not implemented")
+}
 
 func RegisterQueryServiceServer(s grpc1.Server, srv QueryServiceServer) {
 	s.RegisterService(&_QueryService_serviceDesc, srv)
@@ -209,6 +505,42 @@ func _QueryService_PendingQueries_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_PendingQueryStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingQueryStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).PendingQueryStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.interchainquery.v1.QueryService/PendingQueryStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).PendingQueryStats(ctx, req.(*QueryPendingQueryStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_RecurringQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecurringQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).RecurringQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.interchainquery.v1.QueryService/RecurringQueries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).RecurringQueries(ctx, req.(*QueryRecurringQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _QueryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.interchainquery.v1.QueryService",
 	HandlerType: (*QueryServiceServer)(nil),
@@ -217,6 +549,14 @@ var _QueryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PendingQueries",
 			Handler:    _QueryService_PendingQueries_Handler,
 		},
+		{
+			MethodName: "PendingQueryStats",
+			Handler:    _QueryService_PendingQueryStats_Handler,
+		},
+		{
+			MethodName: "RecurringQueries",
+			Handler:    _QueryService_RecurringQueries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stride/interchainquery/v1/query.proto",
@@ -282,52 +622,298 @@ func (m *QueryPendingQueriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PendingQueryStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryPendingQueriesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PendingQueryStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingQueryStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.OldestQueryAgeSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OldestQueryAgeSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NumTimedOut != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumTimedOut))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NumPending != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumPending))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CallbackId) > 0 {
+		i -= len(m.CallbackId)
+		copy(dAtA[i:], m.CallbackId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CallbackId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPendingQueriesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPendingQueryStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingQueryStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingQueryStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PendingQueries) > 0 {
-		for _, e := range m.PendingQueries {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryPendingQueryStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryPendingQueryStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QueryPendingQueriesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+
+func (m *QueryPendingQueryStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecurringQueriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecurringQueriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecurringQueriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecurringQueriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecurringQueriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecurringQueriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecurringQueries) > 0 {
+		for iNdEx := len(m.RecurringQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecurringQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPendingQueriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingQueriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingQueries) > 0 {
+		for _, e := range m.PendingQueries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingQueryStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CallbackId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NumPending != 0 {
+		n += 1 + sovQuery(uint64(m.NumPending))
+	}
+	if m.NumTimedOut != 0 {
+		n += 1 + sovQuery(uint64(m.NumTimedOut))
+	}
+	if m.OldestQueryAgeSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.OldestQueryAgeSeconds))
+	}
+	return n
+}
+
+func (m *QueryPendingQueryStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingQueryStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRecurringQueriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRecurringQueriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecurringQueries) > 0 {
+		for _, e := range m.RecurringQueries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPendingQueriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -457,6 +1043,445 @@ func (m *QueryPendingQueriesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PendingQueryStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingQueryStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingQueryStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPending", wireType)
+			}
+			m.NumPending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPending |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumTimedOut", wireType)
+			}
+			m.NumTimedOut = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumTimedOut |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestQueryAgeSeconds", wireType)
+			}
+			m.OldestQueryAgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldestQueryAgeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingQueryStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingQueryStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingQueryStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingQueryStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingQueryStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingQueryStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stats = append(m.Stats, PendingQueryStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecurringQueriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecurringQueriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecurringQueriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecurringQueriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecurringQueriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecurringQueriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringQueries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecurringQueries = append(m.RecurringQueries, RecurringQuery{})
+			if err := m.RecurringQueries[len(m.RecurringQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Returns the timeout used for each issued query, which defaults
+// to the query interval if a max staleness was not specified
+func (rq RecurringQuery) GetTimeoutDuration() time.Duration {
+	if rq.MaxStaleness == 0 {
+		return rq.Interval
+	}
+	return rq.MaxStaleness
+}
+
+// Checks whether the query interval has elapsed since the last submission
+func (rq RecurringQuery) IsDue(currentBlockTime time.Time) bool {
+	return rq.LastSubmissionTime.IsZero() || !currentBlockTime.Before(rq.LastSubmissionTime.Add(rq.Interval))
+}
+
+// Builds the ICQ that's issued at each interval
+func (rq RecurringQuery) ToQuery() Query {
+	return Query{
+		ConnectionId:     rq.ConnectionId,
+		ChainId:          rq.ChainId,
+		QueryType:        rq.QueryType,
+		RequestData:      rq.RequestData,
+		BatchRequestData: rq.BatchRequestData,
+		CallbackModule:   rq.OwnerModule,
+		CallbackId:       rq.CallbackId,
+		CallbackData:     rq.CallbackData,
+		TimeoutPolicy:    rq.TimeoutPolicy,
+		TimeoutDuration:  rq.GetTimeoutDuration(),
+		RecurringQueryId: rq.Id,
+		Transport:        rq.Transport,
+	}
+}
+
+// Validates the fields that are specific to a recurring query
+// (the remaining fields are validated with the issued query)
+func (rq RecurringQuery) ValidateBasic() error {
+	if rq.Id == "" {
+		return errorsmod.Wrapf(ErrInvalidICQRequest, "recurring query id cannot be empty")
+	}
+	if rq.OwnerModule == "" {
+		return errorsmod.Wrapf(ErrInvalidICQRequest, "recurring query owner module cannot be empty")
+	}
+	if rq.Interval <= 0 {
+		return errorsmod.Wrapf(ErrInvalidICQRequest, "recurring query interval must be positive")
+	}
+	if rq.MaxStaleness < 0 {
+		return errorsmod.Wrapf(ErrInvalidICQRequest, "recurring query max staleness cannot be negative")
+	}
+	return nil
+}
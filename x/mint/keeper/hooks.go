@@ -83,6 +83,11 @@ func (k Keeper) Hooks() Hooks {
 	return Hooks{k}
 }
 
+// GetModuleName returns the module name, used by the epochs module for events and telemetry
+func (h Hooks) GetModuleName() string {
+	return types.ModuleName
+}
+
 // epochs hooks.
 func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInfo) {
 	h.k.BeforeEpochStart(ctx, epochInfo)
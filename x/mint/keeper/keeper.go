@@ -145,84 +145,74 @@ func (k Keeper) GetProportions(ctx sdk.Context, mintedCoin sdk.Coin, ratio sdk.D
 	return sdk.NewCoin(mintedCoin.Denom, sdk.NewDecFromInt(mintedCoin.Amount).Mul(ratio).TruncateInt())
 }
 
-const (
-	// strategic reserve address F0
-	StrategicReserveAddress = "stride1alnn79kh0xka0r5h4h82uuaqfhpdmph6rvpf5f"
-)
+// Resolves the address of a distribution recipient
+func (k Keeper) GetRecipientAddress(recipient types.DistributionRecipient) (sdk.AccAddress, error) {
+	switch recipient.RecipientType {
+	case types.RECIPIENT_TYPE_MODULE_ACCOUNT:
+		moduleAddress := k.accountKeeper.GetModuleAddress(recipient.Recipient)
+		if moduleAddress == nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipient.Recipient)
+		}
+		return moduleAddress, nil
+	case types.RECIPIENT_TYPE_ADDRESS:
+		address, err := sdk.AccAddressFromBech32(recipient.Recipient)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "invalid recipient address: %s", recipient.Recipient)
+		}
+		return address, nil
+	case types.RECIPIENT_TYPE_SUBMODULE:
+		return k.GetSubmoduleAddress(recipient.Recipient, types.SubmoduleCommunityNamespaceKey), nil
+	default:
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid recipient type: %d", recipient.RecipientType)
+	}
+}
 
-// DistributeMintedCoins implements distribution of minted coins from mint to external modules.
+// DistributeMintedCoins implements distribution of minted coins from mint to each of the
+// distribution recipients, in proportion to their weight
+// Any remainder from rounding is sent to the last recipient
 func (k Keeper) DistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) error {
-	params := k.GetParams(ctx)
-	proportions := params.DistributionProportions
+	recipients := k.GetParams(ctx).DistributionRecipients
+	if len(recipients) == 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "no distribution recipients specified")
+	}
 
 	k.Logger(ctx).Info(fmt.Sprintf("Distributing minted x/mint rewards: %d coins...", mintedCoin.Amount.Int64()))
 
-	// allocate staking incentives into fee collector account to be moved to on next begin blocker by staking module
-	stakingIncentivesProportions := k.GetProportions(ctx, mintedCoin, proportions.Staking)
-	stakingIncentivesCoins := sdk.NewCoins(stakingIncentivesProportions)
-	k.Logger(ctx).Info(fmt.Sprintf("\t\t\t...staking rewards: %d to %s", stakingIncentivesProportions.Amount.Int64(), k.feeCollectorName))
-
-	// allocate pool allocation ratio to strategic reserve
-	strategicReserveAddress, err := sdk.AccAddressFromBech32(StrategicReserveAddress)
-	if err != nil {
-		return errorsmod.Wrapf(err, "invalid strategic reserve address: %s", StrategicReserveAddress)
-	}
-	strategicReserveProportion := k.GetProportions(ctx, mintedCoin, proportions.StrategicReserve)
-	strategicReserveCoins := sdk.NewCoins(strategicReserveProportion)
-	k.Logger(ctx).Info(fmt.Sprintf("\t\t\t...strategic reserve: %d to %s", strategicReserveProportion.Amount.Int64(), strategicReserveAddress))
-
-	// allocate pool allocation ratio to community growth pool
-	communityPoolGrowthAddress := k.GetSubmoduleAddress(types.CommunityGrowthSubmoduleName, types.SubmoduleCommunityNamespaceKey)
-	communityPoolGrowthProportion := k.GetProportions(ctx, mintedCoin, proportions.CommunityPoolGrowth)
-	communityPoolGrowthCoins := sdk.NewCoins(communityPoolGrowthProportion)
-	k.Logger(ctx).Info(fmt.Sprintf("\t\t\t...community growth: %d to %s", communityPoolGrowthProportion.Amount.Int64(), communityPoolGrowthAddress))
-
-	// allocate pool allocation ratio to security budget pool
-	communityPoolSecurityBudgetAddress := k.GetSubmoduleAddress(types.CommunitySecurityBudgetSubmoduleName, types.SubmoduleCommunityNamespaceKey)
-	communityPoolSecurityBudgetProportion := k.GetProportions(ctx, mintedCoin, proportions.CommunityPoolSecurityBudget)
-	communityPoolSecurityBudgetCoins := sdk.NewCoins(communityPoolSecurityBudgetProportion)
-	k.Logger(ctx).Info(fmt.Sprintf("\t\t\t...community security budget: %d to %s", communityPoolSecurityBudgetProportion.Amount.Int64(), communityPoolSecurityBudgetAddress))
-
-	// remaining tokens to the community growth pool (this should NEVER happen, barring rounding imprecision)
-	remainingCoins := sdk.NewCoins(mintedCoin).
-		Sub(stakingIncentivesCoins...).
-		Sub(strategicReserveCoins...).
-		Sub(communityPoolGrowthCoins...).
-		Sub(communityPoolSecurityBudgetCoins...)
-
-	// check: remaining coins should be less than 5% of minted coins
-	remainingBal := remainingCoins.AmountOf(sdk.DefaultBondDenom)
-	thresh := sdk.NewDec(5).Quo(sdk.NewDec(100))
-	if sdk.NewDecFromInt(remainingBal).Quo(sdk.NewDecFromInt(mintedCoin.Amount)).GT(thresh) {
-		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds,
-			"Failed to divvy up mint module rewards fully -- remaining coins should be LT 5pct of total, instead are %#v/%#v",
-			remainingCoins, remainingBal)
-	}
-
-	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, stakingIncentivesCoins)
-	if err != nil {
-		return err
-	}
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, strategicReserveAddress, strategicReserveCoins)
-	if err != nil {
-		return err
-	}
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, communityPoolGrowthAddress, communityPoolGrowthCoins)
-	if err != nil {
-		return err
-	}
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, communityPoolSecurityBudgetAddress, communityPoolSecurityBudgetCoins)
-	if err != nil {
-		return err
-	}
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, communityPoolGrowthAddress, remainingCoins)
-	if err != nil {
-		return err
+	remainingCoin := mintedCoin
+	for i, recipient := range recipients {
+		recipientAddress, err := k.GetRecipientAddress(recipient)
+		if err != nil {
+			return err
+		}
+
+		recipientCoin := k.GetProportions(ctx, mintedCoin, recipient.Weight)
+		if i == len(recipients)-1 {
+			recipientCoin = remainingCoin
+		}
+		remainingCoin = remainingCoin.Sub(recipientCoin)
+
+		k.Logger(ctx).Info(fmt.Sprintf("\t\t\t...%s: %d to %s", recipient.Recipient, recipientCoin.Amount.Int64(), recipientAddress))
+		if recipientCoin.IsZero() {
+			continue
+		}
+
+		// Module accounts are sent to by name so that blocked module addresses (e.g. the fee collector)
+		// can still receive their portion
+		recipientCoins := sdk.NewCoins(recipientCoin)
+		if recipient.RecipientType == types.RECIPIENT_TYPE_MODULE_ACCOUNT {
+			err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipient.Recipient, recipientCoins)
+		} else {
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddress, recipientCoins)
+		}
+		if err != nil {
+			return errorsmod.Wrapf(err, "unable to distribute minted coins to %s", recipient.Recipient)
+		}
 	}
 
 	// call a hook after the minting and distribution of new coins
-	// see osmosis' pool incentives hooks.go for an example
-	// k.hooks.AfterDistributeMintedCoin(ctx, mintedCoin)
+	if k.hooks != nil {
+		k.hooks.AfterDistributeMintedCoin(ctx, mintedCoin)
+	}
 
 	return nil
 }
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Stride-Labs/stride/v27/app/apptesting"
+	"github.com/Stride-Labs/stride/v27/x/mint/types"
+)
+
+type KeeperTestSuite struct {
+	apptesting.AppTestHelper
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.Setup()
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) TestDistributeMintedCoin() {
+	denom := s.App.MintKeeper.GetParams(s.Ctx).MintDenom
+	address := s.TestAccs[0]
+	feeCollectorAddress := s.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	growthAddress := s.App.MintKeeper.GetSubmoduleAddress(types.CommunityGrowthSubmoduleName, types.SubmoduleCommunityNamespaceKey)
+
+	// Split the mint 1/3 to each recipient - the growth submodule is last and should receive the remainder
+	third := sdk.OneDec().QuoInt64(3)
+	params := s.App.MintKeeper.GetParams(s.Ctx)
+	params.DistributionRecipients = []types.DistributionRecipient{
+		{RecipientType: types.RECIPIENT_TYPE_MODULE_ACCOUNT, Recipient: authtypes.FeeCollectorName, Weight: third},
+		{RecipientType: types.RECIPIENT_TYPE_ADDRESS, Recipient: address.String(), Weight: third},
+		{RecipientType: types.RECIPIENT_TYPE_SUBMODULE, Recipient: types.CommunityGrowthSubmoduleName, Weight: sdk.OneDec().Sub(third).Sub(third)},
+	}
+	s.App.MintKeeper.SetParams(s.Ctx, params)
+
+	initialFeeCollectorBalance := s.App.BankKeeper.GetBalance(s.Ctx, feeCollectorAddress, denom).Amount
+	initialGrowthBalance := s.App.BankKeeper.GetBalance(s.Ctx, growthAddress, denom).Amount
+
+	// Mint and distribute 100 tokens
+	mintedCoin := sdk.NewCoin(denom, sdkmath.NewInt(100))
+	err := s.App.MintKeeper.MintCoins(s.Ctx, sdk.NewCoins(mintedCoin))
+	s.Require().NoError(err, "no error expected when minting")
+
+	err = s.App.MintKeeper.DistributeMintedCoin(s.Ctx, mintedCoin)
+	s.Require().NoError(err, "no error expected when distributing")
+
+	feeCollectorBalance := s.App.BankKeeper.GetBalance(s.Ctx, feeCollectorAddress, denom).Amount
+	s.Require().Equal(int64(33), feeCollectorBalance.Sub(initialFeeCollectorBalance).Int64(), "fee collector balance")
+
+	addressBalance := s.App.BankKeeper.GetBalance(s.Ctx, address, denom).Amount
+	s.Require().Equal(int64(33), addressBalance.Int64(), "address balance")
+
+	growthBalance := s.App.BankKeeper.GetBalance(s.Ctx, growthAddress, denom).Amount
+	s.Require().Equal(int64(34), growthBalance.Sub(initialGrowthBalance).Int64(), "growth submodule balance")
+
+	mintModuleBalance := s.App.BankKeeper.GetBalance(s.Ctx, s.App.AccountKeeper.GetModuleAddress(types.ModuleName), denom)
+	s.Require().Zero(mintModuleBalance.Amount.Int64(), "mint module balance")
+}
+
+func (s *KeeperTestSuite) TestGetRecipientAddress_Invalid() {
+	// Module accounts that don't exist should error
+	_, err := s.App.MintKeeper.GetRecipientAddress(types.DistributionRecipient{
+		RecipientType: types.RECIPIENT_TYPE_MODULE_ACCOUNT,
+		Recipient:     "unknown",
+	})
+	s.Require().ErrorContains(err, "module account unknown does not exist")
+
+	// An unspecified recipient type should error
+	_, err = s.App.MintKeeper.GetRecipientAddress(types.DistributionRecipient{
+		Recipient: authtypes.FeeCollectorName,
+	})
+	s.Require().ErrorContains(err, "invalid recipient type")
+}
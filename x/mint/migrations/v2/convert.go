@@ -0,0 +1,37 @@
+package v2
+
+import (
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	oldminttypes "github.com/Stride-Labs/stride/v27/x/mint/migrations/v2/types"
+	minttypes "github.com/Stride-Labs/stride/v27/x/mint/types"
+)
+
+// Converts the legacy distribution proportions to the equivalent list of recipients
+// The strategic reserve was previously hard-coded in the keeper and is now an address
+// recipient. The community growth pool is last in the list so that it continues to
+// receive any remainder from rounding
+func ConvertToDistributionRecipients(proportions oldminttypes.DistributionProportions) []minttypes.DistributionRecipient {
+	return []minttypes.DistributionRecipient{
+		{
+			RecipientType: minttypes.RECIPIENT_TYPE_MODULE_ACCOUNT,
+			Recipient:     authtypes.FeeCollectorName,
+			Weight:        proportions.Staking,
+		},
+		{
+			RecipientType: minttypes.RECIPIENT_TYPE_ADDRESS,
+			Recipient:     minttypes.StrategicReserveAddress,
+			Weight:        proportions.StrategicReserve,
+		},
+		{
+			RecipientType: minttypes.RECIPIENT_TYPE_SUBMODULE,
+			Recipient:     minttypes.CommunitySecurityBudgetSubmoduleName,
+			Weight:        proportions.CommunityPoolSecurityBudget,
+		},
+		{
+			RecipientType: minttypes.RECIPIENT_TYPE_SUBMODULE,
+			Recipient:     minttypes.CommunityGrowthSubmoduleName,
+			Weight:        proportions.CommunityPoolGrowth,
+		},
+	}
+}
@@ -0,0 +1,48 @@
+package v2
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	oldminttypes "github.com/Stride-Labs/stride/v27/x/mint/migrations/v2/types"
+	minttypes "github.com/Stride-Labs/stride/v27/x/mint/types"
+)
+
+func TestConvertToDistributionRecipients(t *testing.T) {
+	oldProportions := oldminttypes.DistributionProportions{
+		Staking:                     sdk.MustNewDecFromStr("0.1"),
+		CommunityPoolGrowth:         sdk.MustNewDecFromStr("0.2"),
+		CommunityPoolSecurityBudget: sdk.MustNewDecFromStr("0.3"),
+		StrategicReserve:            sdk.MustNewDecFromStr("0.4"),
+	}
+
+	// The community growth pool should be last so that it receives the rounding remainder
+	expectedRecipients := []minttypes.DistributionRecipient{
+		{
+			RecipientType: minttypes.RECIPIENT_TYPE_MODULE_ACCOUNT,
+			Recipient:     authtypes.FeeCollectorName,
+			Weight:        sdk.MustNewDecFromStr("0.1"),
+		},
+		{
+			RecipientType: minttypes.RECIPIENT_TYPE_ADDRESS,
+			Recipient:     minttypes.StrategicReserveAddress,
+			Weight:        sdk.MustNewDecFromStr("0.4"),
+		},
+		{
+			RecipientType: minttypes.RECIPIENT_TYPE_SUBMODULE,
+			Recipient:     minttypes.CommunitySecurityBudgetSubmoduleName,
+			Weight:        sdk.MustNewDecFromStr("0.3"),
+		},
+		{
+			RecipientType: minttypes.RECIPIENT_TYPE_SUBMODULE,
+			Recipient:     minttypes.CommunityGrowthSubmoduleName,
+			Weight:        sdk.MustNewDecFromStr("0.2"),
+		},
+	}
+
+	actualRecipients := ConvertToDistributionRecipients(oldProportions)
+	require.Equal(t, expectedRecipients, actualRecipients)
+}
@@ -0,0 +1,37 @@
+package v2
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	oldminttypes "github.com/Stride-Labs/stride/v27/x/mint/migrations/v2/types"
+	minttypes "github.com/Stride-Labs/stride/v27/x/mint/types"
+)
+
+// Replaces the legacy distribution proportions with the equivalent list of
+// distribution recipients
+func MigrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	// Deserialize with the old data type
+	oldProportionsBz := paramSpace.GetRaw(ctx, oldminttypes.KeyPoolAllocationRatio)
+	if oldProportionsBz == nil {
+		return errors.New("legacy distribution proportions not found")
+	}
+
+	var oldProportions oldminttypes.DistributionProportions
+	if err := codec.NewLegacyAmino().UnmarshalJSON(oldProportionsBz, &oldProportions); err != nil {
+		return errorsmod.Wrapf(err, "unable to unmarshal distribution proportions using old data types")
+	}
+
+	// Convert and validate using the new type
+	recipients := ConvertToDistributionRecipients(oldProportions)
+	if err := minttypes.ValidateDistributionRecipients(recipients); err != nil {
+		return errorsmod.Wrapf(err, "invalid distribution recipients after migration")
+	}
+
+	paramSpace.Set(ctx, minttypes.KeyDistributionRecipients, recipients)
+	return nil
+}
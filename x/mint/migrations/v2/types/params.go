@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Param store key for the legacy distribution proportions
+var KeyPoolAllocationRatio = []byte("PoolAllocationRatio")
+
+// Legacy split of the minted tokens between four fixed destinations
+type DistributionProportions struct {
+	Staking                     sdk.Dec `json:"staking" yaml:"staking"`
+	CommunityPoolGrowth         sdk.Dec `json:"community_pool_growth" yaml:"community_pool"`
+	CommunityPoolSecurityBudget sdk.Dec `json:"community_pool_security_budget" yaml:"community_pool"`
+	StrategicReserve            sdk.Dec `json:"strategic_reserve" yaml:"community_pool"`
+}
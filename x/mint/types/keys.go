@@ -1,5 +1,11 @@
 package types
 
+import (
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	strdburnertypes "github.com/Stride-Labs/stride/v27/x/strdburner/types"
+)
+
 // MinterKey is the key to use for the keeper store.
 var MinterKey = []byte{0x00}
 
@@ -29,4 +35,22 @@ const (
 
 	// key for creating a new module namespace of type "community"
 	SubmoduleCommunityNamespaceKey = "commmunity"
+
+	// strategic reserve address F0
+	StrategicReserveAddress = "stride1alnn79kh0xka0r5h4h82uuaqfhpdmph6rvpf5f"
 )
+
+// Returns true if the name is one of the community submodules created at genesis
+func isCommunitySubmodule(submoduleName string) bool {
+	return submoduleName == CommunityGrowthSubmoduleName ||
+		submoduleName == CommunitySecurityBudgetSubmoduleName ||
+		submoduleName == CommunityUsageSubmoduleName
+}
+
+// Returns true if the name is a module account that is allowed to receive minted tokens
+// Other module accounts (e.g. the bonded pool or distribution module) rely on their
+// balance matching internal accounting, and must not be sent tokens directly
+func isAllowedRecipientModuleAccount(moduleName string) bool {
+	return moduleName == authtypes.FeeCollectorName ||
+		moduleName == strdburnertypes.ModuleName
+}
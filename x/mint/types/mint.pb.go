@@ -24,6 +24,44 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DistributionRecipientType defines how the recipient of a portion of the
+// minted tokens is resolved to an address
+type DistributionRecipientType int32
+
+const (
+	// the recipient type was not set and is invalid
+	RECIPIENT_TYPE_UNSPECIFIED DistributionRecipientType = 0
+	// the recipient is the name of an allowed module account (e.g.
+	// fee_collector)
+	RECIPIENT_TYPE_MODULE_ACCOUNT DistributionRecipientType = 1
+	// the recipient is a bech32 account address
+	RECIPIENT_TYPE_ADDRESS DistributionRecipientType = 2
+	// the recipient is the name of an x/mint community submodule (e.g. growth)
+	RECIPIENT_TYPE_SUBMODULE DistributionRecipientType = 3
+)
+
+var DistributionRecipientType_name = map[int32]string{
+	0: "RECIPIENT_TYPE_UNSPECIFIED",
+	1: "RECIPIENT_TYPE_MODULE_ACCOUNT",
+	2: "RECIPIENT_TYPE_ADDRESS",
+	3: "RECIPIENT_TYPE_SUBMODULE",
+}
+
+var DistributionRecipientType_value = map[string]int32{
+	"RECIPIENT_TYPE_UNSPECIFIED":    0,
+	"RECIPIENT_TYPE_MODULE_ACCOUNT": 1,
+	"RECIPIENT_TYPE_ADDRESS":        2,
+	"RECIPIENT_TYPE_SUBMODULE":      3,
+}
+
+func (x DistributionRecipientType) String() string {
+	return proto.EnumName(DistributionRecipientType_name, int32(x))
+}
+
+func (DistributionRecipientType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5ad5fa4b1fdb702f, []int{0}
+}
+
 // Minter represents the minting state.
 type Minter struct {
 	// current epoch provisions
@@ -63,34 +101,31 @@ func (m *Minter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Minter proto.InternalMessageInfo
 
-// next id: 5
-type DistributionProportions struct {
-	// staking defines the proportion of the minted minted_denom that is to be
-	// allocated as staking rewards.
-	Staking github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=staking,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"staking" yaml:"staking"`
-	// community_pool defines the proportion of the minted mint_denom that is
-	// to be allocated to the community pool: growth.
-	CommunityPoolGrowth github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=community_pool_growth,json=communityPoolGrowth,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool_growth" yaml:"community_pool"`
-	// community_pool defines the proportion of the minted mint_denom that is
-	// to be allocated to the community pool: security budget.
-	CommunityPoolSecurityBudget github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=community_pool_security_budget,json=communityPoolSecurityBudget,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool_security_budget" yaml:"community_pool"`
-	// strategic_reserve defines the proportion of the minted mint_denom that is
-	// to be allocated to the pool: strategic reserve.
-	StrategicReserve github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=strategic_reserve,json=strategicReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"strategic_reserve" yaml:"community_pool"`
+// DistributionRecipient defines a destination for a portion of the minted
+// tokens
+type DistributionRecipient struct {
+	// recipient_type defines how the recipient is resolved to an address
+	RecipientType DistributionRecipientType `protobuf:"varint,1,opt,name=recipient_type,json=recipientType,proto3,enum=stride.mint.v1beta1.DistributionRecipientType" json:"recipient_type,omitempty" yaml:"recipient_type"`
+	// recipient is the module name, address, or submodule name (depending on
+	// the recipient type)
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// weight defines the proportion of the minted mint_denom that is allocated
+	// to the recipient
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
 }
 
-func (m *DistributionProportions) Reset()         { *m = DistributionProportions{} }
-func (m *DistributionProportions) String() string { return proto.CompactTextString(m) }
-func (*DistributionProportions) ProtoMessage()    {}
-func (*DistributionProportions) Descriptor() ([]byte, []int) {
+func (m *DistributionRecipient) Reset()         { *m = DistributionRecipient{} }
+func (m *DistributionRecipient) String() string { return proto.CompactTextString(m) }
+func (*DistributionRecipient) ProtoMessage()    {}
+func (*DistributionRecipient) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5ad5fa4b1fdb702f, []int{1}
 }
-func (m *DistributionProportions) XXX_Unmarshal(b []byte) error {
+func (m *DistributionRecipient) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DistributionProportions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DistributionRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DistributionProportions.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DistributionRecipient.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -100,17 +135,31 @@ func (m *DistributionProportions) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *DistributionProportions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DistributionProportions.Merge(m, src)
+func (m *DistributionRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionRecipient.Merge(m, src)
 }
-func (m *DistributionProportions) XXX_Size() int {
+func (m *DistributionRecipient) XXX_Size() int {
 	return m.Size()
 }
-func (m *DistributionProportions) XXX_DiscardUnknown() {
-	xxx_messageInfo_DistributionProportions.DiscardUnknown(m)
+func (m *DistributionRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionRecipient proto.InternalMessageInfo
+
+func (m *DistributionRecipient) GetRecipientType() DistributionRecipientType {
+	if m != nil {
+		return m.RecipientType
+	}
+	return RECIPIENT_TYPE_UNSPECIFIED
 }
 
-var xxx_messageInfo_DistributionProportions proto.InternalMessageInfo
+func (m *DistributionRecipient) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
 
 // Params holds parameters for the mint module.
 type Params struct {
@@ -124,10 +173,12 @@ type Params struct {
 	ReductionPeriodInEpochs int64 `protobuf:"varint,4,opt,name=reduction_period_in_epochs,json=reductionPeriodInEpochs,proto3" json:"reduction_period_in_epochs,omitempty" yaml:"reduction_period_in_epochs"`
 	// reduction multiplier to execute on each period
 	ReductionFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=reduction_factor,json=reductionFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reduction_factor" yaml:"reduction_factor"`
-	// distribution_proportions defines the proportion of the minted denom
-	DistributionProportions DistributionProportions `protobuf:"bytes,6,opt,name=distribution_proportions,json=distributionProportions,proto3" json:"distribution_proportions"`
 	// start epoch to distribute minting rewards
 	MintingRewardsDistributionStartEpoch int64 `protobuf:"varint,7,opt,name=minting_rewards_distribution_start_epoch,json=mintingRewardsDistributionStartEpoch,proto3" json:"minting_rewards_distribution_start_epoch,omitempty" yaml:"minting_rewards_distribution_start_epoch"`
+	// distribution_recipients defines where the minted denom is sent, with
+	// each recipient receiving a portion of the minted tokens proportional to
+	// its weight
+	DistributionRecipients []DistributionRecipient `protobuf:"bytes,8,rep,name=distribution_recipients,json=distributionRecipients,proto3" json:"distribution_recipients" yaml:"distribution_recipients"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -183,70 +234,74 @@ func (m *Params) GetReductionPeriodInEpochs() int64 {
 	return 0
 }
 
-func (m *Params) GetDistributionProportions() DistributionProportions {
+func (m *Params) GetMintingRewardsDistributionStartEpoch() int64 {
 	if m != nil {
-		return m.DistributionProportions
+		return m.MintingRewardsDistributionStartEpoch
 	}
-	return DistributionProportions{}
+	return 0
 }
 
-func (m *Params) GetMintingRewardsDistributionStartEpoch() int64 {
+func (m *Params) GetDistributionRecipients() []DistributionRecipient {
 	if m != nil {
-		return m.MintingRewardsDistributionStartEpoch
+		return m.DistributionRecipients
 	}
-	return 0
+	return nil
 }
 
 func init() {
+	proto.RegisterEnum("stride.mint.v1beta1.DistributionRecipientType", DistributionRecipientType_name, DistributionRecipientType_value)
 	proto.RegisterType((*Minter)(nil), "stride.mint.v1beta1.Minter")
-	proto.RegisterType((*DistributionProportions)(nil), "stride.mint.v1beta1.DistributionProportions")
+	proto.RegisterType((*DistributionRecipient)(nil), "stride.mint.v1beta1.DistributionRecipient")
 	proto.RegisterType((*Params)(nil), "stride.mint.v1beta1.Params")
 }
 
 func init() { proto.RegisterFile("stride/mint/v1beta1/mint.proto", fileDescriptor_5ad5fa4b1fdb702f) }
 
 var fileDescriptor_5ad5fa4b1fdb702f = []byte{
-	// 633 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0x69, 0x9b, 0xaa, 0x8b, 0x44, 0x8b, 0x4b, 0x89, 0xd5, 0x0a, 0xbb, 0x58, 0x80, 0x7a,
-	0xa0, 0xb6, 0xda, 0x1e, 0x90, 0x7a, 0x42, 0x51, 0x69, 0xa9, 0x04, 0x52, 0x70, 0x6e, 0xbd, 0x58,
-	0xfe, 0xd8, 0x3a, 0xab, 0xc6, 0xbb, 0xd6, 0xee, 0x3a, 0x25, 0x42, 0xe2, 0x0f, 0x94, 0x03, 0x47,
-	0x8e, 0xfc, 0x9c, 0x1e, 0x7b, 0x44, 0x3d, 0x44, 0x28, 0xf9, 0x07, 0xb9, 0x70, 0x45, 0xbb, 0xeb,
-	0x7c, 0x42, 0x24, 0x22, 0x7a, 0x8a, 0xf7, 0xcd, 0xec, 0xbc, 0x97, 0xb7, 0x33, 0x03, 0x4c, 0xc6,
-	0x29, 0x8a, 0xa1, 0x9b, 0x22, 0xcc, 0xdd, 0xd6, 0x5e, 0x08, 0x79, 0xb0, 0x27, 0x0f, 0x4e, 0x46,
-	0x09, 0x27, 0xfa, 0xba, 0x8a, 0x3b, 0x12, 0x2a, 0xe2, 0x9b, 0x8f, 0x12, 0x92, 0x10, 0x19, 0x77,
-	0xc5, 0x97, 0x4a, 0xb5, 0x3f, 0x83, 0xf2, 0x7b, 0x84, 0x39, 0xa4, 0x3a, 0x07, 0x6b, 0x30, 0x23,
-	0x51, 0xc3, 0xcf, 0x28, 0x69, 0x21, 0x86, 0x08, 0x66, 0x86, 0xb6, 0xad, 0xed, 0xac, 0x54, 0x4f,
-	0xaf, 0x3b, 0x56, 0xe9, 0xb6, 0x63, 0xbd, 0x48, 0x10, 0x6f, 0xe4, 0xa1, 0x13, 0x91, 0xd4, 0x8d,
-	0x08, 0x4b, 0x09, 0x2b, 0x7e, 0x76, 0x59, 0x7c, 0xe1, 0xf2, 0x76, 0x06, 0x99, 0x73, 0x04, 0xa3,
-	0x7e, 0xc7, 0xaa, 0xb4, 0x83, 0xb4, 0x79, 0x68, 0x4f, 0xd7, 0xb3, 0xbd, 0x55, 0x09, 0xd5, 0x46,
-	0xc8, 0xaf, 0x05, 0x50, 0x39, 0x42, 0x42, 0x6f, 0x98, 0x73, 0x44, 0x70, 0x8d, 0x92, 0x8c, 0x50,
-	0xf1, 0xc5, 0xf4, 0x33, 0xb0, 0xcc, 0x78, 0x70, 0x81, 0x70, 0x52, 0x08, 0x79, 0x3d, 0xb7, 0x90,
-	0x07, 0x4a, 0x48, 0x51, 0xc6, 0xf6, 0x06, 0x05, 0xf5, 0x4f, 0x60, 0x23, 0x22, 0x69, 0x9a, 0x63,
-	0xc4, 0xdb, 0x7e, 0x46, 0x48, 0xd3, 0x4f, 0x28, 0xb9, 0xe4, 0x0d, 0xe3, 0x9e, 0x64, 0x3a, 0x99,
-	0x9b, 0x69, 0x43, 0x31, 0x4d, 0x16, 0xb5, 0xbd, 0xf5, 0x21, 0x50, 0x23, 0xa4, 0x79, 0x22, 0x39,
-	0xf4, 0x2f, 0x1a, 0x30, 0xa7, 0xd8, 0x19, 0x8c, 0x72, 0x2a, 0x4e, 0x61, 0x1e, 0x27, 0x90, 0x1b,
-	0x0b, 0x77, 0x2b, 0x63, 0x6b, 0x42, 0x46, 0xbd, 0x20, 0xab, 0x4a, 0x2e, 0x9d, 0x83, 0x87, 0x8c,
-	0xd3, 0x80, 0xc3, 0x04, 0x45, 0x3e, 0x85, 0x0c, 0xd2, 0x16, 0x34, 0x16, 0xef, 0x56, 0xc0, 0xda,
-	0x90, 0xc1, 0x53, 0x04, 0xf6, 0xed, 0x12, 0x28, 0xd7, 0x02, 0x1a, 0xa4, 0x4c, 0x7f, 0x02, 0x80,
-	0x68, 0x55, 0x3f, 0x86, 0x98, 0xa4, 0xea, 0xad, 0xbd, 0x15, 0x81, 0x1c, 0x09, 0x40, 0xbf, 0xd2,
-	0x80, 0x91, 0x40, 0x0c, 0x19, 0x62, 0xfe, 0x1f, 0x2d, 0xaa, 0xde, 0xeb, 0xc3, 0xdc, 0x3a, 0x2d,
-	0xa5, 0x73, 0x56, 0x5d, 0xdb, 0x7b, 0x5c, 0x84, 0xde, 0x4c, 0x76, 0xac, 0x7e, 0x3c, 0x98, 0x13,
-	0x14, 0x43, 0xcc, 0xd1, 0x39, 0x82, 0xb4, 0x78, 0xad, 0xad, 0xe9, 0xce, 0x1f, 0x65, 0x0c, 0x3a,
-	0xff, 0x74, 0x88, 0xe8, 0x21, 0xd8, 0xa4, 0x30, 0xce, 0x23, 0xd1, 0xeb, 0x7e, 0x06, 0x29, 0x22,
-	0xb1, 0x8f, 0xb0, 0x12, 0xc2, 0xa4, 0xfd, 0x0b, 0xd5, 0xe7, 0xfd, 0x8e, 0xf5, 0x54, 0x55, 0x9c,
-	0x9d, 0x6b, 0x7b, 0x95, 0x61, 0xb0, 0x26, 0x63, 0xa7, 0x58, 0x8a, 0x66, 0x62, 0xa6, 0x47, 0xf7,
-	0xce, 0x83, 0x88, 0x13, 0x6a, 0x2c, 0xfd, 0xdf, 0x4c, 0x4f, 0xd7, 0xb3, 0xbd, 0xd5, 0x21, 0x74,
-	0x2c, 0x11, 0x3d, 0x05, 0x46, 0x3c, 0x36, 0xd2, 0xc2, 0xd5, 0xc1, 0x4c, 0x1b, 0xe5, 0x6d, 0x6d,
-	0xe7, 0xfe, 0xfe, 0x4b, 0xe7, 0x2f, 0x1b, 0xca, 0x99, 0xb1, 0x07, 0xaa, 0x8b, 0x42, 0xab, 0x57,
-	0x89, 0x67, 0xac, 0x89, 0x2b, 0x0d, 0xec, 0x88, 0x3a, 0x08, 0x27, 0x3e, 0x85, 0x97, 0x01, 0x8d,
-	0x99, 0x3f, 0xc1, 0xcf, 0x78, 0x40, 0xb9, 0x32, 0xcb, 0x58, 0x96, 0xbe, 0x1e, 0xf4, 0x3b, 0x96,
-	0xab, 0xfe, 0xcf, 0xbf, 0xde, 0xb4, 0xbd, 0x67, 0x45, 0xaa, 0xa7, 0x32, 0xc7, 0xd5, 0xd6, 0x45,
-	0x9e, 0xf4, 0xfc, 0x70, 0xf1, 0xdb, 0x77, 0xab, 0x54, 0x7d, 0x7b, 0xdd, 0x35, 0xb5, 0x9b, 0xae,
-	0xa9, 0xfd, 0xec, 0x9a, 0xda, 0xd7, 0x9e, 0x59, 0xba, 0xe9, 0x99, 0xa5, 0x1f, 0x3d, 0xb3, 0x74,
-	0xe6, 0x8c, 0x19, 0x5e, 0x97, 0x26, 0xec, 0xbe, 0x0b, 0x42, 0xe6, 0x16, 0x2b, 0xbd, 0xb5, 0xff,
-	0xca, 0xfd, 0xa8, 0x16, 0xbb, 0x34, 0x3f, 0x2c, 0xcb, 0x3d, 0x7d, 0xf0, 0x3b, 0x00, 0x00, 0xff,
-	0xff, 0xac, 0xd1, 0xd5, 0x15, 0xf4, 0x05, 0x00, 0x00,
+	// 686 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4d, 0x4f, 0xdb, 0x4a,
+	0x14, 0x8d, 0x49, 0x08, 0x30, 0x4f, 0x0f, 0x22, 0xbf, 0x07, 0x98, 0x14, 0x6c, 0xb0, 0x5a, 0x14,
+	0x21, 0x61, 0x0b, 0x58, 0x54, 0x62, 0x47, 0x12, 0x47, 0x4d, 0xc5, 0x47, 0x3a, 0x49, 0x16, 0xed,
+	0xc6, 0x72, 0xe2, 0x21, 0x8c, 0xda, 0x78, 0xac, 0x99, 0x01, 0xca, 0xa6, 0xeb, 0x4a, 0xdd, 0x74,
+	0xd9, 0x55, 0x55, 0xa9, 0x7f, 0x86, 0x25, 0xcb, 0xaa, 0x8b, 0xa8, 0x82, 0x1f, 0x50, 0x29, 0x7f,
+	0xa0, 0xd5, 0x8c, 0x9d, 0x0f, 0x52, 0x22, 0x81, 0xba, 0x4a, 0x7c, 0xee, 0x9d, 0xe3, 0x73, 0xef,
+	0x39, 0x1e, 0xa0, 0x33, 0x4e, 0xb1, 0x8f, 0xec, 0x36, 0x0e, 0xb8, 0x7d, 0xb6, 0xd5, 0x40, 0xdc,
+	0xdb, 0x92, 0x0f, 0x56, 0x48, 0x09, 0x27, 0xea, 0x7f, 0x51, 0xdd, 0x92, 0x50, 0x5c, 0xcf, 0xfe,
+	0xdf, 0x22, 0x2d, 0x22, 0xeb, 0xb6, 0xf8, 0x17, 0xb5, 0x9a, 0xef, 0x40, 0xfa, 0x00, 0x07, 0x1c,
+	0x51, 0x95, 0x83, 0x0c, 0x0a, 0x49, 0xf3, 0xc4, 0x0d, 0x29, 0x39, 0xc3, 0x0c, 0x93, 0x80, 0x69,
+	0xca, 0xaa, 0x92, 0x9b, 0xc9, 0x97, 0x2f, 0x3b, 0x46, 0xe2, 0x7b, 0xc7, 0x58, 0x6f, 0x61, 0x7e,
+	0x72, 0xda, 0xb0, 0x9a, 0xa4, 0x6d, 0x37, 0x09, 0x6b, 0x13, 0x16, 0xff, 0x6c, 0x32, 0xff, 0xb5,
+	0xcd, 0x2f, 0x42, 0xc4, 0xac, 0x22, 0x6a, 0x76, 0x3b, 0xc6, 0xe2, 0x85, 0xd7, 0x7e, 0xb3, 0x6b,
+	0x8e, 0xf2, 0x99, 0x70, 0x4e, 0x42, 0x95, 0x01, 0xf2, 0x53, 0x01, 0xf3, 0x45, 0x2c, 0xf4, 0x36,
+	0x4e, 0x39, 0x26, 0x01, 0x44, 0x4d, 0x1c, 0x62, 0x14, 0x70, 0x35, 0x04, 0xb3, 0xb4, 0xf7, 0xe0,
+	0x0a, 0x72, 0xa9, 0x66, 0x76, 0xdb, 0xb2, 0xee, 0x98, 0xce, 0xba, 0x93, 0xa3, 0x76, 0x11, 0xa2,
+	0xfc, 0x52, 0xb7, 0x63, 0xcc, 0x47, 0x7a, 0x6e, 0xf3, 0x99, 0xf0, 0x5f, 0x3a, 0xdc, 0xa9, 0x2e,
+	0x83, 0x99, 0x3e, 0xa0, 0x4d, 0x88, 0xd1, 0xe1, 0x00, 0x50, 0x4b, 0x20, 0x7d, 0x8e, 0x70, 0xeb,
+	0x84, 0x6b, 0x49, 0xb9, 0x15, 0xeb, 0x61, 0x5b, 0x81, 0xf1, 0x69, 0xf3, 0xd7, 0x24, 0x48, 0x57,
+	0x3c, 0xea, 0xb5, 0x99, 0xba, 0x02, 0x80, 0x18, 0xc2, 0xf5, 0x51, 0x40, 0xda, 0xd1, 0xb2, 0xe1,
+	0x8c, 0x40, 0x8a, 0x02, 0x50, 0x3f, 0x28, 0x40, 0x6b, 0xa1, 0x00, 0x31, 0xcc, 0xdc, 0x3f, 0xac,
+	0x91, 0xfa, 0xf2, 0x2f, 0x1e, 0x6c, 0x8d, 0x11, 0xad, 0x62, 0x1c, 0xaf, 0x09, 0x17, 0xe2, 0x92,
+	0x73, 0xdb, 0x29, 0xb5, 0xd4, 0xcb, 0x07, 0xf6, 0x51, 0xc0, 0xf1, 0x31, 0x46, 0x34, 0xde, 0xc4,
+	0xa3, 0x51, 0xc7, 0x07, 0x1d, 0x3d, 0xc7, 0xcb, 0x7d, 0x44, 0x6d, 0x80, 0x2c, 0x45, 0xfe, 0x69,
+	0x53, 0x38, 0xe5, 0x86, 0x88, 0x62, 0xe2, 0xbb, 0x38, 0x88, 0x84, 0x30, 0x2d, 0xb5, 0xaa, 0xe4,
+	0x92, 0xf9, 0x27, 0xdd, 0x8e, 0xb1, 0xd6, 0xf3, 0x6c, 0x5c, 0xaf, 0x09, 0x17, 0xfb, 0xc5, 0x8a,
+	0xac, 0x95, 0x03, 0x29, 0x9a, 0x89, 0x2c, 0x0f, 0xce, 0x1d, 0x7b, 0x4d, 0x4e, 0xa8, 0x36, 0xf9,
+	0x77, 0x59, 0x1e, 0xe5, 0x33, 0xe1, 0x5c, 0x1f, 0x2a, 0x49, 0x44, 0xf8, 0x95, 0x13, 0xee, 0xe1,
+	0xa0, 0xe5, 0x52, 0x74, 0xee, 0x51, 0x9f, 0xb9, 0xfe, 0x50, 0x2e, 0x5d, 0xc6, 0x3d, 0xca, 0x23,
+	0xf5, 0xda, 0x94, 0x1c, 0x74, 0xa7, 0xdb, 0x31, 0xec, 0xe8, 0x05, 0xf7, 0x3d, 0x69, 0xc2, 0xc7,
+	0x71, 0x2b, 0x8c, 0x3a, 0x87, 0xa3, 0x5f, 0x15, 0x7d, 0x72, 0x09, 0x42, 0xcd, 0xe2, 0x2d, 0x8e,
+	0x7e, 0x94, 0x99, 0x36, 0xbd, 0x9a, 0xcc, 0xfd, 0xb3, 0xbd, 0x71, 0xff, 0x2f, 0x29, 0xbf, 0x2e,
+	0xf6, 0xd6, 0xed, 0x18, 0x7a, 0x24, 0x76, 0x0c, 0xb1, 0x09, 0x17, 0xfc, 0xbb, 0x8e, 0xb3, 0xdd,
+	0xd4, 0xa7, 0x2f, 0x46, 0xe2, 0x79, 0x6a, 0x3a, 0x9d, 0x99, 0xda, 0xf8, 0xac, 0x80, 0xa5, 0xb1,
+	0xdf, 0xab, 0xaa, 0x83, 0x2c, 0x74, 0x0a, 0xe5, 0x4a, 0xd9, 0x39, 0xac, 0xb9, 0xb5, 0x97, 0x15,
+	0xc7, 0xad, 0x1f, 0x56, 0x2b, 0x4e, 0xa1, 0x5c, 0x2a, 0x3b, 0xc5, 0x4c, 0x42, 0x5d, 0x03, 0x2b,
+	0x23, 0xf5, 0x83, 0xa3, 0x62, 0x7d, 0xdf, 0x71, 0xf7, 0x0a, 0x85, 0xa3, 0xfa, 0x61, 0x2d, 0xa3,
+	0xa8, 0x59, 0xb0, 0x30, 0xd2, 0xb2, 0x57, 0x2c, 0x42, 0xa7, 0x5a, 0xcd, 0x4c, 0xa8, 0xcb, 0x40,
+	0x1b, 0xa9, 0x55, 0xeb, 0xf9, 0x88, 0x21, 0x93, 0xcc, 0xa6, 0xde, 0x7f, 0xd5, 0x13, 0xf9, 0x67,
+	0x97, 0xd7, 0xba, 0x72, 0x75, 0xad, 0x2b, 0x3f, 0xae, 0x75, 0xe5, 0xe3, 0x8d, 0x9e, 0xb8, 0xba,
+	0xd1, 0x13, 0xdf, 0x6e, 0xf4, 0xc4, 0x2b, 0x6b, 0x28, 0x36, 0x55, 0xb9, 0xbc, 0xcd, 0x7d, 0xaf,
+	0xc1, 0xec, 0xf8, 0x42, 0x3e, 0xdb, 0x7e, 0x6a, 0xbf, 0x8d, 0xae, 0x65, 0x19, 0xa1, 0x46, 0x5a,
+	0xde, 0xb2, 0x3b, 0xbf, 0x07, 0x00, 0x2b, 0x9e, 0x9c, 0xb5, 0xb2, 0x05, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -282,7 +337,7 @@ func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DistributionProportions) Marshal() (dAtA []byte, err error) {
+func (m *DistributionRecipient) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -292,56 +347,38 @@ func (m *DistributionProportions) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DistributionProportions) MarshalTo(dAtA []byte) (int, error) {
+func (m *DistributionRecipient) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DistributionProportions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DistributionRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.StrategicReserve.Size()
-		i -= size
-		if _, err := m.StrategicReserve.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.CommunityPoolSecurityBudget.Size()
+		size := m.Weight.Size()
 		i -= size
-		if _, err := m.CommunityPoolSecurityBudget.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintMint(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x1a
-	{
-		size := m.CommunityPoolGrowth.Size()
-		i -= size
-		if _, err := m.CommunityPoolGrowth.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Staking.Size()
-		i -= size
-		if _, err := m.Staking.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
+	if m.RecipientType != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.RecipientType))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -365,21 +402,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DistributionRecipients) > 0 {
+		for iNdEx := len(m.DistributionRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.MintingRewardsDistributionStartEpoch != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.MintingRewardsDistributionStartEpoch))
 		i--
 		dAtA[i] = 0x38
 	}
-	{
-		size, err := m.DistributionProportions.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMint(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
 	{
 		size := m.ReductionFactor.Size()
 		i -= size
@@ -444,19 +485,20 @@ func (m *Minter) Size() (n int) {
 	return n
 }
 
-func (m *DistributionProportions) Size() (n int) {
+func (m *DistributionRecipient) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Staking.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.CommunityPoolGrowth.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.CommunityPoolSecurityBudget.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.StrategicReserve.Size()
+	if m.RecipientType != 0 {
+		n += 1 + sovMint(uint64(m.RecipientType))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Weight.Size()
 	n += 1 + l + sovMint(uint64(l))
 	return n
 }
@@ -482,11 +524,15 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.ReductionFactor.Size()
 	n += 1 + l + sovMint(uint64(l))
-	l = m.DistributionProportions.Size()
-	n += 1 + l + sovMint(uint64(l))
 	if m.MintingRewardsDistributionStartEpoch != 0 {
 		n += 1 + sovMint(uint64(m.MintingRewardsDistributionStartEpoch))
 	}
+	if len(m.DistributionRecipients) > 0 {
+		for _, e := range m.DistributionRecipients {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -580,7 +626,7 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
+func (m *DistributionRecipient) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -603,17 +649,17 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DistributionProportions: wiretype end group for non-group")
+			return fmt.Errorf("proto: DistributionRecipient: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DistributionProportions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DistributionRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staking", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientType", wireType)
 			}
-			var stringLen uint64
+			m.RecipientType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -623,29 +669,14 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RecipientType |= DistributionRecipientType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMint
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMint
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Staking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolGrowth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -673,13 +704,11 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommunityPoolGrowth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolSecurityBudget", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -707,41 +736,7 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommunityPoolSecurityBudget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StrategicReserve", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMint
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMint
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMint
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.StrategicReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -946,9 +941,28 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingRewardsDistributionStartEpoch", wireType)
+			}
+			m.MintingRewardsDistributionStartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintingRewardsDistributionStartEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProportions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionRecipients", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -975,29 +989,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DistributionProportions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DistributionRecipients = append(m.DistributionRecipients, DistributionRecipient{})
+			if err := m.DistributionRecipients[len(m.DistributionRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintingRewardsDistributionStartEpoch", wireType)
-			}
-			m.MintingRewardsDistributionStartEpoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMint
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MintingRewardsDistributionStartEpoch |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -10,6 +10,7 @@ import (
 	epochtypes "github.com/Stride-Labs/stride/v27/x/epochs/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -20,9 +21,9 @@ var (
 	KeyEpochIdentifier                      = []byte("EpochIdentifier")
 	KeyReductionPeriodInEpochs              = []byte("ReductionPeriodInEpochs")
 	KeyReductionFactor                      = []byte("ReductionFactor")
-	KeyPoolAllocationRatio                  = []byte("PoolAllocationRatio")
 	KeyDeveloperRewardsReceiver             = []byte("DeveloperRewardsReceiver")
 	KeyMintingRewardsDistributionStartEpoch = []byte("MintingRewardsDistributionStartEpoch")
+	KeyDistributionRecipients               = []byte("DistributionRecipients")
 )
 
 // ParamTable for minting module.
@@ -32,7 +33,7 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 func NewParams(
 	mintDenom string, genesisEpochProvisions sdk.Dec, epochIdentifier string,
-	ReductionFactor sdk.Dec, reductionPeriodInEpochs int64, distrProportions DistributionProportions,
+	ReductionFactor sdk.Dec, reductionPeriodInEpochs int64, distributionRecipients []DistributionRecipient,
 	mintingRewardsDistributionStartEpoch int64,
 ) Params {
 	return Params{
@@ -41,7 +42,7 @@ func NewParams(
 		EpochIdentifier:                      epochIdentifier,
 		ReductionPeriodInEpochs:              reductionPeriodInEpochs,
 		ReductionFactor:                      ReductionFactor,
-		DistributionProportions:              distrProportions,
+		DistributionRecipients:               distributionRecipients,
 		MintingRewardsDistributionStartEpoch: mintingRewardsDistributionStartEpoch,
 	}
 }
@@ -54,11 +55,11 @@ func DefaultParams() Params {
 		EpochIdentifier:         "mint",                                                                     // 1 hour
 		ReductionPeriodInEpochs: 24 * 365,                                                                   // 24hrs*365d = 8760
 		ReductionFactor:         sdk.NewDec(1).QuoInt64(2),
-		DistributionProportions: DistributionProportions{
-			Staking:                     sdk.MustNewDecFromStr("0.2764"),
-			CommunityPoolGrowth:         sdk.MustNewDecFromStr("0.1860"),
-			StrategicReserve:            sdk.MustNewDecFromStr("0.4205"),
-			CommunityPoolSecurityBudget: sdk.MustNewDecFromStr("0.1171"),
+		DistributionRecipients: []DistributionRecipient{
+			{RecipientType: RECIPIENT_TYPE_MODULE_ACCOUNT, Recipient: authtypes.FeeCollectorName, Weight: sdk.MustNewDecFromStr("0.2764")},
+			{RecipientType: RECIPIENT_TYPE_ADDRESS, Recipient: StrategicReserveAddress, Weight: sdk.MustNewDecFromStr("0.4205")},
+			{RecipientType: RECIPIENT_TYPE_SUBMODULE, Recipient: CommunitySecurityBudgetSubmoduleName, Weight: sdk.MustNewDecFromStr("0.1171")},
+			{RecipientType: RECIPIENT_TYPE_SUBMODULE, Recipient: CommunityGrowthSubmoduleName, Weight: sdk.MustNewDecFromStr("0.1860")},
 		},
 		MintingRewardsDistributionStartEpoch: 0,
 	}
@@ -81,7 +82,7 @@ func (p Params) Validate() error {
 	if err := validateReductionFactor(p.ReductionFactor); err != nil {
 		return err
 	}
-	if err := validateDistributionProportions(p.DistributionProportions); err != nil {
+	if err := ValidateDistributionRecipients(p.DistributionRecipients); err != nil {
 		return err
 	}
 	if err := validateMintingRewardsDistributionStartEpoch(p.MintingRewardsDistributionStartEpoch); err != nil {
@@ -105,8 +106,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyEpochIdentifier, &p.EpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
 		paramtypes.NewParamSetPair(KeyReductionPeriodInEpochs, &p.ReductionPeriodInEpochs, validateReductionPeriodInEpochs),
 		paramtypes.NewParamSetPair(KeyReductionFactor, &p.ReductionFactor, validateReductionFactor),
-		paramtypes.NewParamSetPair(KeyPoolAllocationRatio, &p.DistributionProportions, validateDistributionProportions),
 		paramtypes.NewParamSetPair(KeyMintingRewardsDistributionStartEpoch, &p.MintingRewardsDistributionStartEpoch, validateMintingRewardsDistributionStartEpoch),
+		paramtypes.NewParamSetPair(KeyDistributionRecipients, &p.DistributionRecipients, ValidateDistributionRecipients),
 	}
 }
 
@@ -169,32 +170,56 @@ func validateReductionFactor(i interface{}) error {
 	return nil
 }
 
-func validateDistributionProportions(i interface{}) error {
-	v, ok := i.(DistributionProportions)
+// Validates the list of distribution recipients, requiring that each recipient
+// resolves to an address and that the weights sum to 1
+func ValidateDistributionRecipients(i interface{}) error {
+	v, ok := i.([]DistributionRecipient)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	if v.Staking.IsNegative() {
-		return errors.New("staking distribution ratio should not be negative")
-	}
-
-	if v.CommunityPoolGrowth.IsNegative() {
-		return errors.New("community pool growth distribution ratio should not be negative")
-	}
-
-	if v.CommunityPoolSecurityBudget.IsNegative() {
-		return errors.New("community pool growth distribution ratio should not be negative")
-	}
-
-	if v.StrategicReserve.IsNegative() {
-		return errors.New("community pool growth distribution ratio should not be negative")
-	}
-
-	totalProportions := v.Staking.Add(v.CommunityPoolGrowth).Add(v.CommunityPoolSecurityBudget).Add(v.StrategicReserve)
-
-	if !totalProportions.Equal(sdk.NewDec(1)) {
-		return fmt.Errorf("total distributions ratio should be 1, instead got %s", totalProportions.String())
+	if len(v) == 0 {
+		return errors.New("at least one distribution recipient must be specified")
+	}
+
+	totalWeight := sdk.ZeroDec()
+	uniqueRecipients := map[string]bool{}
+	for _, recipient := range v {
+		if strings.TrimSpace(recipient.Recipient) == "" {
+			return errors.New("distribution recipient cannot be blank")
+		}
+
+		switch recipient.RecipientType {
+		case RECIPIENT_TYPE_MODULE_ACCOUNT:
+			if !isAllowedRecipientModuleAccount(recipient.Recipient) {
+				return fmt.Errorf("module account %s is not an allowed distribution recipient", recipient.Recipient)
+			}
+		case RECIPIENT_TYPE_ADDRESS:
+			if _, err := sdk.AccAddressFromBech32(recipient.Recipient); err != nil {
+				return fmt.Errorf("invalid distribution recipient address %s: %s", recipient.Recipient, err.Error())
+			}
+		case RECIPIENT_TYPE_SUBMODULE:
+			if !isCommunitySubmodule(recipient.Recipient) {
+				return fmt.Errorf("unknown distribution recipient submodule: %s", recipient.Recipient)
+			}
+		default:
+			return fmt.Errorf("invalid distribution recipient type: %s", recipient.RecipientType)
+		}
+
+		recipientKey := fmt.Sprintf("%s/%s", recipient.RecipientType, recipient.Recipient)
+		if uniqueRecipients[recipientKey] {
+			return fmt.Errorf("duplicate distribution recipient: %s", recipient.Recipient)
+		}
+		uniqueRecipients[recipientKey] = true
+
+		if recipient.Weight.IsNil() || !recipient.Weight.IsPositive() {
+			return fmt.Errorf("distribution weight for %s must be positive", recipient.Recipient)
+		}
+		totalWeight = totalWeight.Add(recipient.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return fmt.Errorf("total distribution weights should be 1, instead got %s", totalWeight.String())
 	}
 
 	return nil
@@ -0,0 +1,146 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v27/app/apptesting"
+	"github.com/Stride-Labs/stride/v27/x/mint/types"
+)
+
+func TestValidateDistributionRecipients(t *testing.T) {
+	apptesting.SetupConfig()
+
+	validAddress := types.StrategicReserveAddress
+	half := sdk.MustNewDecFromStr("0.5")
+
+	testCases := []struct {
+		name          string
+		recipients    []types.DistributionRecipient
+		expectedError string
+	}{
+		{
+			name:       "default recipients",
+			recipients: types.DefaultParams().DistributionRecipients,
+		},
+		{
+			name: "valid module account and submodule",
+			recipients: []types.DistributionRecipient{
+				{RecipientType: types.RECIPIENT_TYPE_MODULE_ACCOUNT, Recipient: "fee_collector", Weight: half},
+				{RecipientType: types.RECIPIENT_TYPE_SUBMODULE, Recipient: types.CommunityUsageSubmoduleName, Weight: half},
+			},
+		},
+		{
+			name: "valid strd burner module account",
+			recipients: []types.DistributionRecipient{
+				{RecipientType: types.RECIPIENT_TYPE_MODULE_ACCOUNT, Recipient: "strdburner", Weight: half},
+				{RecipientType: types.RECIPIENT_TYPE_ADDRESS, Recipient: validAddress, Weight: half},
+			},
+		},
+		{
+			name:          "no recipients",
+			recipients:    []types.DistributionRecipient{},
+			expectedError: "at least one distribution recipient must be specified",
+		},
+		{
+			name: "blank recipient",
+			recipients: []types.DistributionRecipient{
+				{RecipientType: types.RECIPIENT_TYPE_MODULE_ACCOUNT, Recipient: "", Weight: sdk.OneDec()},
+			},
+			expectedError: "distribution recipient cannot be blank",
+		},
+		{
+			name: "invalid address",
+			recipients: []types.DistributionRecipient{
+				{RecipientType: types.RECIPIENT_TYPE_ADDRESS, Recipient: "invalid", Weight: sdk.OneDec()},
+			},
+			expectedError: "invalid distribution recipient address",
+		},
+		{
+			name: "unknown submodule",
+			recipients: []types.DistributionRecipient{
+				{RecipientType: types.RECIPIENT_TYPE_SUBMODULE, Recipient: "unknown", Weight: sdk.OneDec()},
+			},
+			expectedError: "unknown distribution recipient submodule",
+		},
+		{
+			name: "bonded pool module account",
+			recipients: []types.DistributionRecipient{
+				{RecipientType: types.RECIPIENT_TYPE_MODULE_ACCOUNT, Recipient: "bonded_tokens_pool", Weight: sdk.OneDec()},
+			},
+			expectedError: "module account bonded_tokens_pool is not an allowed distribution recipient",
+		},
+		{
+			name: "distribution module account",
+			recipients: []types.DistributionRecipient{
+				{RecipientType: types.RECIPIENT_TYPE_MODULE_ACCOUNT, Recipient: "distribution", Weight: sdk.OneDec()},
+			},
+			expectedError: "module account distribution is not an allowed distribution recipient",
+		},
+		{
+			name: "unspecified recipient type",
+			recipients: []types.DistributionRecipient{
+				{Recipient: validAddress, Weight: sdk.OneDec()},
+			},
+			expectedError: "invalid distribution recipient type: RECIPIENT_TYPE_UNSPECIFIED",
+		},
+		{
+			name: "invalid recipient type",
+			recipients: []types.DistributionRecipient{
+				{RecipientType: 99, Recipient: validAddress, Weight: sdk.OneDec()},
+			},
+			expectedError: "invalid distribution recipient type",
+		},
+		{
+			name: "duplicate recipient",
+			recipients: []types.DistributionRecipient{
+				{RecipientType: types.RECIPIENT_TYPE_ADDRESS, Recipient: validAddress, Weight: half},
+				{RecipientType: types.RECIPIENT_TYPE_ADDRESS, Recipient: validAddress, Weight: half},
+			},
+			expectedError: "duplicate distribution recipient",
+		},
+		{
+			name: "zero weight",
+			recipients: []types.DistributionRecipient{
+				{RecipientType: types.RECIPIENT_TYPE_ADDRESS, Recipient: validAddress, Weight: sdk.OneDec()},
+				{RecipientType: types.RECIPIENT_TYPE_MODULE_ACCOUNT, Recipient: "fee_collector", Weight: sdk.ZeroDec()},
+			},
+			expectedError: "distribution weight for fee_collector must be positive",
+		},
+		{
+			name: "nil weight",
+			recipients: []types.DistributionRecipient{
+				{RecipientType: types.RECIPIENT_TYPE_ADDRESS, Recipient: validAddress},
+			},
+			expectedError: "must be positive",
+		},
+		{
+			name: "weights sum to less than 1",
+			recipients: []types.DistributionRecipient{
+				{RecipientType: types.RECIPIENT_TYPE_ADDRESS, Recipient: validAddress, Weight: half},
+			},
+			expectedError: "total distribution weights should be 1",
+		},
+		{
+			name: "weights sum to more than 1",
+			recipients: []types.DistributionRecipient{
+				{RecipientType: types.RECIPIENT_TYPE_ADDRESS, Recipient: validAddress, Weight: half},
+				{RecipientType: types.RECIPIENT_TYPE_MODULE_ACCOUNT, Recipient: "fee_collector", Weight: sdk.OneDec()},
+			},
+			expectedError: "total distribution weights should be 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateDistributionRecipients(tc.recipients)
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedError)
+			}
+		})
+	}
+}
@@ -14,7 +14,7 @@ import (
 // Callback after an LSM token is IBC tranferred to the host zone
 //
 //	If successful: mark the LSM Token status as DETOKENIZATION_QUEUE
-//	If failure: mark the LSM Token status as FAILED
+//	If failure: request a retry, and once retries are exhausted, mark the LSM Token status as FAILED
 //	If timeout: revert the LSM Token status back to TRANSFER_QUEUE so it gets resubmitted
 func (k Keeper) LSMTransferCallback(ctx sdk.Context, packet channeltypes.Packet, ackResponse *icacallbackstypes.AcknowledgementResponse, args []byte) error {
 	// Fetch callback args
@@ -36,6 +36,13 @@ func (k Keeper) LSMTransferCallback(ctx sdk.Context, packet channeltypes.Packet,
 		return nil
 	}
 
+	// If the transfer failed and can still be retried, leave the deposit in progress
+	// while the transfer is re-submitted
+	if ackResponse.Status == icacallbackstypes.AckResponseStatus_FAILURE && ackResponse.CanRetry {
+		k.Logger(ctx).Error(utils.LogICACallbackWithHostZone(chainId, IBCCallbacksID_LSMTransfer, "Retrying transfer"))
+		return errorsmod.Wrapf(icacallbackstypes.ErrCallbackRetryable, "LSM transfer of %s failed: %s", deposit.Denom, ackResponse.Error)
+	}
+
 	// If the transfer failed, update the status to FAILED
 	if ackResponse.Status == icacallbackstypes.AckResponseStatus_FAILURE {
 		k.Logger(ctx).Error(utils.LogICACallbackStatusWithHostZone(chainId, IBCCallbacksID_LSMTransfer,
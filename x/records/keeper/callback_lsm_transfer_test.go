@@ -1,8 +1,12 @@
 package keeper_test
 
 import (
+	"time"
+
 	_ "github.com/stretchr/testify/suite"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
 
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
@@ -10,6 +14,7 @@ import (
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
 
 	icacallbackstypes "github.com/Stride-Labs/stride/v27/x/icacallbacks/types"
+	"github.com/Stride-Labs/stride/v27/x/records/keeper"
 	"github.com/Stride-Labs/stride/v27/x/records/types"
 )
 
@@ -100,3 +105,72 @@ func (s *KeeperTestSuite) TestLSMTransferCallback_AckFailed() {
 	s.Require().True(found, "deposit should have been found but was not")
 	s.Require().Equal(types.LSMTokenDeposit_TRANSFER_FAILED.String(), record.Status.String(), "deposit status")
 }
+
+func (s *KeeperTestSuite) TestLSMTransferCallback_AckFailedRetried() {
+	s.CreateTransferChannel(HostChainId)
+	s.SetupLSMTransferCallback()
+
+	deposit, found := s.App.RecordsKeeper.GetLSMTokenDeposit(s.Ctx, HostChainId, LSMTokenDenom)
+	s.Require().True(found, "deposit should have been found")
+	deposit.Amount = sdkmath.NewInt(1000)
+	s.App.RecordsKeeper.SetLSMTokenDeposit(s.Ctx, deposit)
+
+	// Send the LSM token, which registers the callback
+	sender := s.TestAccs[0]
+	receiver := s.TestAccs[1].String()
+	s.FundAccount(sender, sdk.NewCoin(deposit.IbcDenom, deposit.Amount))
+
+	sequence := s.MustGetNextSequenceNumber(transfertypes.PortID, ibctesting.FirstChannelID)
+	err := s.App.RecordsKeeper.IBCTransferLSMToken(s.Ctx, deposit, ibctesting.FirstChannelID, sender.String(), receiver)
+	s.Require().NoError(err, "no error expected when transferring LSM token")
+
+	fullDenomPath := transfertypes.GetPrefixedDenom(transfertypes.PortID, ibctesting.FirstChannelID, LSMTokenDenom)
+	packetData := transfertypes.NewFungibleTokenPacketData(fullDenomPath, deposit.Amount.String(), sender.String(), receiver, "")
+	packet := channeltypes.Packet{
+		SourcePort:    transfertypes.PortID,
+		SourceChannel: ibctesting.FirstChannelID,
+		Sequence:      sequence,
+		Data:          packetData.GetBytes(),
+	}
+
+	// Fail the transfer through the callbacks keeper - the transfer should be queued for retry
+	// and the deposit should remain in progress
+	ackFailure := icacallbackstypes.AcknowledgementResponse{Status: icacallbackstypes.AckResponseStatus_FAILURE}
+	err = s.App.IcacallbacksKeeper.CallRegisteredICACallback(s.Ctx, packet, &ackFailure)
+	s.Require().NoError(err, "no error expected when calling callback")
+
+	record, found := s.App.RecordsKeeper.GetLSMTokenDeposit(s.Ctx, HostChainId, LSMTokenDenom)
+	s.Require().True(found, "deposit should have been found")
+	s.Require().Equal(types.LSMTokenDeposit_TRANSFER_IN_PROGRESS.String(), record.Status.String(), "deposit status after failure")
+
+	retry, found := s.App.IcacallbacksKeeper.GetCallbackRetry(s.Ctx, icacallbackstypes.PacketID(transfertypes.PortID, ibctesting.FirstChannelID, sequence))
+	s.Require().True(found, "retry should have been queued")
+	s.Require().Equal(ibctesting.FirstChannelID, retry.ChannelId, "retry channel")
+
+	// Mimic the refund from the failed ack, and process the retry once the backoff has elapsed
+	s.FundAccount(sender, sdk.NewCoin(deposit.IbcDenom, deposit.Amount))
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Duration(icacallbackstypes.DefaultRetryBackoffSeconds) * time.Second))
+	s.App.IcacallbacksKeeper.ProcessCallbackRetries(s.Ctx)
+
+	s.Require().Equal(sequence+2, s.MustGetNextSequenceNumber(transfertypes.PortID, ibctesting.FirstChannelID), "transfer re-sent")
+	s.Require().Empty(s.App.IcacallbacksKeeper.GetAllCallbackRetry(s.Ctx), "retry removed after submission")
+
+	retryCallbackData, found := s.App.IcacallbacksKeeper.GetCallbackData(s.Ctx, icacallbackstypes.PacketID(transfertypes.PortID, ibctesting.FirstChannelID, sequence+1))
+	s.Require().True(found, "callback data should have been stored for the retry")
+	s.Require().Equal(keeper.IBCCallbacksID_LSMTransfer, retryCallbackData.CallbackId, "retry callback ID")
+	s.Require().Equal(uint64(1), retryCallbackData.RetryAttempts, "retry attempts")
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, sender, deposit.IbcDenom).IsZero(), "tokens re-sent")
+
+	// Once retries are exhausted, a failure should flag the deposit as failed
+	retryCallbackData.RetryAttempts = icacallbackstypes.DefaultMaxRetryAttempts
+	s.App.IcacallbacksKeeper.SetCallbackData(s.Ctx, retryCallbackData)
+
+	packet.Sequence = sequence + 1
+	err = s.App.IcacallbacksKeeper.CallRegisteredICACallback(s.Ctx, packet, &ackFailure)
+	s.Require().NoError(err, "no error expected when calling callback")
+
+	record, found = s.App.RecordsKeeper.GetLSMTokenDeposit(s.Ctx, HostChainId, LSMTokenDenom)
+	s.Require().True(found, "deposit should have been found")
+	s.Require().Equal(types.LSMTokenDeposit_TRANSFER_FAILED.String(), record.Status.String(), "deposit status after retries exhausted")
+	s.Require().Empty(s.App.IcacallbacksKeeper.GetAllCallbackRetry(s.Ctx), "no retry queued after retries exhausted")
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	icacallbackstypes "github.com/Stride-Labs/stride/v27/x/icacallbacks/types"
+	"github.com/Stride-Labs/stride/v27/x/records/types"
 )
 
 const IBCCallbacksID_NativeTransfer = "transfer"
@@ -9,7 +10,15 @@ const IBCCallbacksID_LSMTransfer = "lsm-transfer"
 
 func (k Keeper) Callbacks() icacallbackstypes.ModuleCallbacks {
 	return []icacallbackstypes.ICACallback{
-		{CallbackId: IBCCallbacksID_NativeTransfer, CallbackFunc: icacallbackstypes.ICACallbackFunction(k.TransferCallback)},
-		{CallbackId: IBCCallbacksID_LSMTransfer, CallbackFunc: icacallbackstypes.ICACallbackFunction(k.LSMTransferCallback)},
+		{
+			CallbackId:       IBCCallbacksID_NativeTransfer,
+			CallbackFunc:     icacallbackstypes.ICACallbackFunction(k.TransferCallback),
+			CallbackArgsType: &types.TransferCallback{},
+		},
+		{
+			CallbackId:       IBCCallbacksID_LSMTransfer,
+			CallbackFunc:     icacallbackstypes.ICACallbackFunction(k.LSMTransferCallback),
+			CallbackArgsType: &types.TransferLSMTokenCallback{},
+		},
 	}
 }
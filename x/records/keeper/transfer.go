@@ -47,6 +47,7 @@ func (k Keeper) IBCTransferNativeTokens(ctx sdk.Context, msg *transfertypes.MsgT
 		Sequence:     sequence,
 		CallbackId:   IBCCallbacksID_NativeTransfer,
 		CallbackArgs: marshalledCallbackArgs,
+		CreatedAt:    ctx.BlockTime(),
 	}
 	k.Logger(ctx).Info(utils.LogWithHostZone(depositRecord.HostZoneId, "Storing callback data: %+v", callback))
 	k.ICACallbacksKeeper.SetCallbackData(ctx, callback)
@@ -101,6 +102,7 @@ func (k Keeper) IBCTransferLSMToken(
 		Sequence:     msgTransferResponse.Sequence,
 		CallbackId:   IBCCallbacksID_LSMTransfer,
 		CallbackArgs: callbackArgsBz,
+		CreatedAt:    ctx.BlockTime(),
 	})
 
 	return nil
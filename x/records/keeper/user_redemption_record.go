@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"bytes"
+
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -12,6 +14,9 @@ func (k Keeper) SetUserRedemptionRecord(ctx sdk.Context, userRedemptionRecord ty
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserRedemptionRecordKey))
 	b := k.Cdc.MustMarshal(&userRedemptionRecord)
 	store.Set([]byte(userRedemptionRecord.Id), b)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserRedemptionRecordReceiverIndexKey))
+	indexStore.Set(types.GetUserRedemptionRecordReceiverIndexKey(userRedemptionRecord.Receiver, userRedemptionRecord.Id), []byte{})
 }
 
 // GetUserRedemptionRecord returns a userRedemptionRecord from its id
@@ -27,10 +32,58 @@ func (k Keeper) GetUserRedemptionRecord(ctx sdk.Context, id string) (val types.U
 
 // RemoveUserRedemptionRecord removes a userRedemptionRecord from the store
 func (k Keeper) RemoveUserRedemptionRecord(ctx sdk.Context, id string) {
+	if record, found := k.GetUserRedemptionRecord(ctx, id); found {
+		indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserRedemptionRecordReceiverIndexKey))
+		indexStore.Delete(types.GetUserRedemptionRecordReceiverIndexKey(record.Receiver, id))
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserRedemptionRecordKey))
 	store.Delete([]byte(id))
 }
 
+// GetUserRedemptionRecordsForReceiver returns up to `limit` user redemption records for a receiver
+// (optionally filtered by host zone) using the receiver index, starting after the record ID `startAfter`
+// Also returns the ID of the last record returned if there are more records remaining, or "" otherwise
+func (k Keeper) GetUserRedemptionRecordsForReceiver(
+	ctx sdk.Context,
+	receiver string,
+	chainId string,
+	startAfter string,
+	limit uint64,
+) (records []types.UserRedemptionRecord, nextKey string) {
+	receiverPrefix := types.GetUserRedemptionRecordReceiverPrefix(receiver, chainId)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserRedemptionRecordReceiverIndexKey))
+	receiverStore := prefix.NewStore(indexStore, receiverPrefix)
+
+	// The keys in the receiver store are the record IDs with the receiver prefix stripped
+	var start []byte
+	if startAfter != "" {
+		startKey := types.GetUserRedemptionRecordReceiverIndexKey(receiver, startAfter)
+		if !bytes.HasPrefix(startKey, receiverPrefix) {
+			return records, ""
+		}
+		start = append(bytes.TrimPrefix(startKey, receiverPrefix), 0x00)
+	}
+
+	iterator := receiverStore.Iterator(start, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if uint64(len(records)) == limit {
+			return records, records[len(records)-1].Id
+		}
+
+		recordId := string(append(bytes.TrimPrefix(receiverPrefix, []byte(receiver+"/")), iterator.Key()...))
+		record, found := k.GetUserRedemptionRecord(ctx, recordId)
+		if !found {
+			continue
+		}
+		records = append(records, record)
+	}
+
+	return records, ""
+}
+
 // GetAllUserRedemptionRecord returns all userRedemptionRecord
 func (k Keeper) GetAllUserRedemptionRecord(ctx sdk.Context) (list []types.UserRedemptionRecord) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserRedemptionRecordKey))
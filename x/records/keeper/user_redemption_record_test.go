@@ -56,3 +56,59 @@ func TestUserRedemptionRecordGetAll(t *testing.T) {
 	actual := keeper.GetAllUserRedemptionRecord(ctx)
 	require.Equal(t, len(items), len(actual))
 }
+
+func TestGetUserRedemptionRecordsForReceiver(t *testing.T) {
+	keeper, ctx := keepertest.RecordsKeeper(t)
+
+	receiver := "receiver"
+	records := []types.UserRedemptionRecord{
+		{Id: "chain-0.1.receiver", HostZoneId: "chain-0", EpochNumber: 1, Receiver: receiver},
+		{Id: "chain-0.2.receiver", HostZoneId: "chain-0", EpochNumber: 2, Receiver: receiver},
+		{Id: "chain-0.3.receiver", HostZoneId: "chain-0", EpochNumber: 3, Receiver: receiver},
+		{Id: "chain-00.1.receiver", HostZoneId: "chain-00", EpochNumber: 1, Receiver: receiver},
+		{Id: "chain-1.1.receiver", HostZoneId: "chain-1", EpochNumber: 1, Receiver: receiver},
+		{Id: "chain-0.1.other", HostZoneId: "chain-0", EpochNumber: 1, Receiver: "other"},
+	}
+	for _, record := range records {
+		record.NativeTokenAmount = sdkmath.ZeroInt()
+		record.StTokenAmount = sdkmath.ZeroInt()
+		keeper.SetUserRedemptionRecord(ctx, record)
+	}
+
+	getIds := func(records []types.UserRedemptionRecord) (ids []string) {
+		for _, record := range records {
+			ids = append(ids, record.Id)
+		}
+		return ids
+	}
+
+	// All records for the receiver
+	actual, nextKey := keeper.GetUserRedemptionRecordsForReceiver(ctx, receiver, "", "", 10)
+	require.Equal(t, []string{
+		"chain-0.1.receiver", "chain-0.2.receiver", "chain-0.3.receiver", "chain-00.1.receiver", "chain-1.1.receiver",
+	}, getIds(actual), "all records")
+	require.Empty(t, nextKey, "no next key when all records are returned")
+
+	// Filtered by host zone - chain-00 should not be included in the chain-0 results
+	actual, nextKey = keeper.GetUserRedemptionRecordsForReceiver(ctx, receiver, "chain-0", "", 10)
+	require.Equal(t, []string{"chain-0.1.receiver", "chain-0.2.receiver", "chain-0.3.receiver"}, getIds(actual), "host zone records")
+	require.Empty(t, nextKey, "no next key for host zone records")
+
+	// Paginated
+	actual, nextKey = keeper.GetUserRedemptionRecordsForReceiver(ctx, receiver, "chain-0", "", 2)
+	require.Equal(t, []string{"chain-0.1.receiver", "chain-0.2.receiver"}, getIds(actual), "first page")
+	require.Equal(t, "chain-0.2.receiver", nextKey, "next key after first page")
+
+	actual, nextKey = keeper.GetUserRedemptionRecordsForReceiver(ctx, receiver, "chain-0", nextKey, 2)
+	require.Equal(t, []string{"chain-0.3.receiver"}, getIds(actual), "second page")
+	require.Empty(t, nextKey, "no next key after last page")
+
+	// Start key from a different host zone
+	actual, _ = keeper.GetUserRedemptionRecordsForReceiver(ctx, receiver, "chain-0", "chain-1.1.receiver", 2)
+	require.Empty(t, actual, "no records when start key is from a different host zone")
+
+	// Removed records should be removed from the index
+	keeper.RemoveUserRedemptionRecord(ctx, "chain-1.1.receiver")
+	actual, _ = keeper.GetUserRedemptionRecordsForReceiver(ctx, receiver, "chain-1", "", 10)
+	require.Empty(t, actual, "removed record")
+}
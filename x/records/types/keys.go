@@ -38,8 +38,26 @@ func GetLSMTokenDepositKey(chainId, denom string) []byte {
 const (
 	UserRedemptionRecordKey      = "UserRedemptionRecord-value-"
 	UserRedemptionRecordCountKey = "UserRedemptionRecord-count-"
+	// Secondary index of user redemption records keyed by receiver
+	UserRedemptionRecordReceiverIndexKey = "UserRedemptionRecord-receiver-"
 )
 
+// Builds the prefix of the receiver index for a given receiver, and optionally host zone,
+// as {receiver}/ or {receiver}/{chain_id}.
+// Since the record ID starts with the chain ID, the index can be filtered by host zone
+func GetUserRedemptionRecordReceiverPrefix(receiver, chainId string) []byte {
+	prefix := receiver + "/"
+	if chainId != "" {
+		prefix += chainId + "."
+	}
+	return []byte(prefix)
+}
+
+// Builds the receiver index key for a user redemption record as {receiver}/{record_id}
+func GetUserRedemptionRecordReceiverIndexKey(receiver, recordId string) []byte {
+	return []byte(receiver + "/" + recordId)
+}
+
 const (
 	EpochUnbondingRecordKey      = "EpochUnbondingRecord-value-"
 	EpochUnbondingRecordCountKey = "EpochUnbondingRecord-count-"
@@ -263,6 +263,8 @@ type DepositRecord struct {
 	DepositEpochNumber      uint64                                 `protobuf:"varint,7,opt,name=deposit_epoch_number,json=depositEpochNumber,proto3" json:"deposit_epoch_number,omitempty"`
 	Source                  DepositRecord_Source                   `protobuf:"varint,8,opt,name=source,proto3,enum=stride.records.DepositRecord_Source" json:"source,omitempty"`
 	DelegationTxsInProgress uint64                                 `protobuf:"varint,9,opt,name=delegation_txs_in_progress,json=delegationTxsInProgress,proto3" json:"delegation_txs_in_progress,omitempty"`
+	// Index of the stakeibc delegation shard that the record is staked from
+	DelegationShardIndex uint32 `protobuf:"varint,10,opt,name=delegation_shard_index,json=delegationShardIndex,proto3" json:"delegation_shard_index,omitempty"`
 }
 
 func (m *DepositRecord) Reset()         { *m = DepositRecord{} }
@@ -347,6 +349,13 @@ func (m *DepositRecord) GetDelegationTxsInProgress() uint64 {
 	return 0
 }
 
+func (m *DepositRecord) GetDelegationShardIndex() uint32 {
+	if m != nil {
+		return m.DelegationShardIndex
+	}
+	return 0
+}
+
 type HostZoneUnbonding struct {
 	StTokenAmount             github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=st_token_amount,json=stTokenAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"st_token_amount"`
 	NativeTokenAmount         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=native_token_amount,json=nativeTokenAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"native_token_amount"`
@@ -359,6 +368,8 @@ type HostZoneUnbonding struct {
 	UnbondingTime             uint64                                 `protobuf:"varint,5,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
 	Status                    HostZoneUnbonding_Status               `protobuf:"varint,6,opt,name=status,proto3,enum=stride.records.HostZoneUnbonding_Status" json:"status,omitempty"`
 	UserRedemptionRecords     []string                               `protobuf:"bytes,7,rep,name=user_redemption_records,json=userRedemptionRecords,proto3" json:"user_redemption_records,omitempty"`
+	// Index of the stakeibc delegation shard that the record is unbonded from
+	DelegationShardIndex uint32 `protobuf:"varint,12,opt,name=delegation_shard_index,json=delegationShardIndex,proto3" json:"delegation_shard_index,omitempty"`
 }
 
 func (m *HostZoneUnbonding) Reset()         { *m = HostZoneUnbonding{} }
@@ -436,6 +447,13 @@ func (m *HostZoneUnbonding) GetUserRedemptionRecords() []string {
 	return nil
 }
 
+func (m *HostZoneUnbonding) GetDelegationShardIndex() uint32 {
+	if m != nil {
+		return m.DelegationShardIndex
+	}
+	return 0
+}
+
 type EpochUnbondingRecord struct {
 	EpochNumber        uint64               `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
 	HostZoneUnbondings []*HostZoneUnbonding `protobuf:"bytes,3,rep,name=host_zone_unbondings,json=hostZoneUnbondings,proto3" json:"host_zone_unbondings,omitempty"`
@@ -604,78 +622,79 @@ func init() {
 func init() { proto.RegisterFile("stride/records/records.proto", fileDescriptor_295ee594cc85d8ca) }
 
 var fileDescriptor_295ee594cc85d8ca = []byte{
-	// 1128 bytes of a gzipped FileDescriptorProto
+	// 1152 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
-	0x13, 0x36, 0x25, 0x5a, 0x1f, 0xe3, 0x58, 0x96, 0xd7, 0xca, 0x6b, 0x59, 0x6f, 0xa2, 0x28, 0x42,
-	0x13, 0x08, 0x28, 0x42, 0x35, 0x2e, 0xd0, 0x02, 0x6d, 0xd1, 0x56, 0xb2, 0x68, 0x87, 0x89, 0x22,
-	0xbb, 0x2b, 0xba, 0x69, 0x7c, 0xe8, 0x82, 0x12, 0xb7, 0x12, 0x61, 0x8b, 0x2b, 0x70, 0x49, 0xc3,
-	0xed, 0xbd, 0xf7, 0x1e, 0x7a, 0xea, 0xb9, 0xc7, 0x5e, 0xfb, 0x1f, 0x72, 0x2a, 0x72, 0x2c, 0x7a,
-	0x08, 0x0a, 0xfb, 0x8f, 0x14, 0x5c, 0x52, 0x14, 0x45, 0xc7, 0x09, 0xea, 0xe6, 0x24, 0xf1, 0x99,
-	0xd9, 0x99, 0xd9, 0x99, 0x67, 0x66, 0x16, 0x6e, 0x71, 0xd7, 0xb1, 0x4c, 0xda, 0x74, 0xe8, 0x90,
-	0x39, 0x26, 0x9f, 0xfd, 0x2a, 0x53, 0x87, 0xb9, 0x0c, 0x15, 0x02, 0xa9, 0x12, 0xa2, 0x95, 0xea,
-	0x90, 0xf1, 0x09, 0xe3, 0xcd, 0x81, 0xc1, 0x69, 0xf3, 0xf4, 0xe1, 0x80, 0xba, 0xc6, 0xc3, 0xe6,
-	0x90, 0x59, 0x76, 0xa0, 0x5f, 0x29, 0x8d, 0xd8, 0x88, 0x89, 0xbf, 0x4d, 0xff, 0x5f, 0x80, 0xd6,
-	0x7f, 0x4c, 0x43, 0xe9, 0x90, 0x53, 0x07, 0x53, 0x93, 0x4e, 0xa6, 0xae, 0xc5, 0x6c, 0x2c, 0xec,
-	0xa1, 0x02, 0xa4, 0x2c, 0xb3, 0x2c, 0xd5, 0xa4, 0x46, 0x1e, 0xa7, 0x2c, 0x13, 0x55, 0x20, 0xe7,
-	0xd0, 0x21, 0xb5, 0x4e, 0xa9, 0x53, 0x4e, 0x0b, 0x34, 0xfa, 0x46, 0xdf, 0xc2, 0x86, 0x6d, 0xb8,
-	0xd6, 0x29, 0x25, 0x2e, 0x3b, 0xa6, 0x36, 0x31, 0x26, 0xcc, 0xb3, 0xdd, 0xb2, 0xec, 0xab, 0xb5,
-	0x95, 0x17, 0xaf, 0xee, 0x2c, 0xfd, 0xf5, 0xea, 0xce, 0xfd, 0x91, 0xe5, 0x8e, 0xbd, 0x81, 0x32,
-	0x64, 0x93, 0x66, 0x18, 0x6a, 0xf0, 0xf3, 0x80, 0x9b, 0xc7, 0x4d, 0xf7, 0xfb, 0x29, 0xe5, 0x8a,
-	0x66, 0xbb, 0x78, 0x3d, 0x30, 0xa5, 0xfb, 0x96, 0x5a, 0xc2, 0x10, 0x2a, 0xc1, 0xb2, 0x49, 0x6d,
-	0x36, 0x29, 0x2f, 0x0b, 0xc7, 0xc1, 0x07, 0xaa, 0xc1, 0x8d, 0x31, 0xe3, 0x2e, 0xf9, 0x81, 0xd9,
-	0x94, 0x58, 0x66, 0x39, 0x23, 0x84, 0xe0, 0x63, 0x47, 0xcc, 0xa6, 0x9a, 0x89, 0xee, 0xc2, 0x0d,
-	0x3a, 0x65, 0xc3, 0x31, 0xb1, 0xbd, 0xc9, 0x80, 0x3a, 0xe5, 0x6c, 0x4d, 0x6a, 0xc8, 0x78, 0x45,
-	0x60, 0x3d, 0x01, 0xa1, 0x06, 0x14, 0x87, 0x27, 0x86, 0x35, 0x21, 0x16, 0x27, 0x53, 0x6a, 0x9b,
-	0x96, 0x3d, 0x2a, 0xe7, 0x6a, 0x52, 0x23, 0x87, 0x0b, 0x02, 0xd7, 0xf8, 0x41, 0x80, 0xa2, 0xaf,
-	0x61, 0x8d, 0xbb, 0x8b, 0x17, 0xcc, 0x5f, 0xeb, 0x82, 0xab, 0xdc, 0x8d, 0x5d, 0xee, 0xb1, 0x9c,
-	0x4b, 0x15, 0xd3, 0xf5, 0x5f, 0x65, 0x58, 0xed, 0xd0, 0x29, 0xe3, 0x96, 0x7b, 0xa9, 0x00, 0xb2,
-	0x28, 0xc0, 0x2e, 0x64, 0x42, 0xb7, 0xa9, 0x6b, 0xb9, 0x0d, 0x4f, 0xcf, 0x93, 0x99, 0x7e, 0x53,
-	0x32, 0xe5, 0x4b, 0xc9, 0xfc, 0x0c, 0x32, 0xdc, 0x35, 0x5c, 0x8f, 0x8b, 0x44, 0x17, 0xb6, 0xdf,
-	0x53, 0x16, 0x09, 0xa8, 0x2c, 0x84, 0xaf, 0xf4, 0x85, 0x2e, 0x0e, 0xcf, 0xa0, 0x0f, 0xa0, 0x64,
-	0x06, 0x72, 0xf2, 0x9a, 0x92, 0xa0, 0x50, 0xa6, 0xc6, 0x2a, 0xe3, 0xfb, 0x63, 0x9e, 0x33, 0xa4,
-	0xa2, 0x1e, 0x6f, 0xf7, 0x27, 0x74, 0x71, 0x78, 0x06, 0x7d, 0x0a, 0x15, 0x93, 0x9e, 0xd0, 0x91,
-	0xe1, 0x53, 0x9a, 0xb8, 0x67, 0x9c, 0x58, 0x36, 0x99, 0x3a, 0x6c, 0xe4, 0x50, 0xce, 0x45, 0xe1,
-	0x64, 0xbc, 0x39, 0xd7, 0xd0, 0xcf, 0xb8, 0x66, 0x1f, 0x84, 0xe2, 0xfa, 0x18, 0x32, 0x41, 0xf8,
-	0x08, 0x41, 0x41, 0xc7, 0xad, 0x5e, 0x7f, 0x57, 0xc5, 0xe4, 0xab, 0x43, 0xf5, 0x50, 0x2d, 0x2e,
-	0xa1, 0x32, 0x94, 0x22, 0x4c, 0xeb, 0x91, 0x03, 0xbc, 0xbf, 0x87, 0xd5, 0x7e, 0xbf, 0x98, 0x42,
-	0x25, 0x28, 0x76, 0xd4, 0xae, 0xba, 0xd7, 0xd2, 0xb5, 0xfd, 0x5e, 0xa8, 0x2f, 0xa1, 0x0a, 0xfc,
-	0x2f, 0x86, 0xc6, 0x4f, 0xa4, 0xeb, 0x0d, 0xc8, 0x04, 0x81, 0x23, 0x80, 0x4c, 0x5f, 0xc7, 0x5a,
-	0xc7, 0xf7, 0x80, 0xa0, 0xf0, 0x4c, 0xd3, 0x1f, 0x75, 0x70, 0xeb, 0x59, 0xab, 0x4b, 0xb4, 0x9d,
-	0x56, 0x51, 0x7a, 0x2c, 0xe7, 0x96, 0x8b, 0x99, 0xfa, 0x6f, 0x59, 0x58, 0x7f, 0x14, 0xd6, 0xe4,
-	0xd0, 0x1e, 0xb0, 0x2b, 0xa9, 0x29, 0xbd, 0x03, 0x6a, 0x5e, 0xd5, 0xd7, 0xa9, 0x77, 0xd5, 0xd7,
-	0xcf, 0x61, 0x7d, 0x16, 0x37, 0x27, 0x2e, 0x23, 0x03, 0xcf, 0xb1, 0x45, 0xb5, 0xff, 0xbd, 0xf5,
-	0x42, 0x18, 0x39, 0xd7, 0x59, 0xdb, 0x73, 0x6c, 0x44, 0x61, 0x33, 0x1e, 0xba, 0x30, 0xef, 0x89,
-	0x84, 0x5d, 0xb3, 0x6b, 0x4b, 0xb1, 0xf0, 0xb9, 0xce, 0x82, 0xe4, 0xa3, 0xef, 0x60, 0x53, 0x8c,
-	0x09, 0x63, 0x70, 0x42, 0xc9, 0x82, 0xc3, 0x32, 0x5c, 0xcb, 0xcd, 0xcd, 0xc8, 0x5c, 0x2f, 0xe6,
-	0x0f, 0x7d, 0x01, 0xb7, 0x3c, 0xfb, 0x0d, 0x84, 0x5e, 0x11, 0x84, 0xde, 0x8a, 0xeb, 0x2c, 0x50,
-	0xfa, 0xda, 0x5d, 0x7f, 0x0f, 0x0a, 0xde, 0x8c, 0x67, 0xc4, 0xb5, 0x26, 0x54, 0xcc, 0x60, 0x19,
-	0xaf, 0x46, 0xa8, 0x6e, 0x4d, 0x28, 0xfa, 0x32, 0x31, 0x1c, 0x1a, 0xc9, 0x66, 0xbd, 0x44, 0xda,
-	0xe4, 0x80, 0xf8, 0x08, 0x36, 0x3d, 0x4e, 0x1d, 0xe2, 0x44, 0x8b, 0x88, 0x84, 0x67, 0xcb, 0xd9,
-	0x5a, 0xba, 0x91, 0xc7, 0x37, 0xbd, 0xd7, 0xac, 0x29, 0x5e, 0xff, 0x45, 0x8a, 0x9a, 0x75, 0x03,
-	0xd6, 0x0e, 0x7b, 0xed, 0xfd, 0x5e, 0x47, 0xeb, 0xed, 0x45, 0xdd, 0xba, 0x05, 0x37, 0xe7, 0xe0,
-	0x42, 0xf3, 0x2d, 0x8a, 0xb0, 0xaa, 0xe3, 0xe7, 0xe1, 0xa9, 0x65, 0xb4, 0x09, 0x1b, 0xea, 0x37,
-	0x9a, 0x4e, 0x12, 0xcd, 0x2f, 0xa1, 0xdb, 0xb0, 0xb5, 0x28, 0x88, 0x9b, 0x94, 0xd1, 0x2a, 0xe4,
-	0x77, 0xba, 0x2d, 0xed, 0x69, 0xab, 0xdd, 0x55, 0x8b, 0xa9, 0xfa, 0xcf, 0x12, 0x94, 0xc4, 0x4c,
-	0x8b, 0xae, 0x1d, 0x0e, 0xf7, 0xe4, 0x66, 0x92, 0x2e, 0x6f, 0xa6, 0x3e, 0x94, 0xe6, 0xb5, 0x89,
-	0xb2, 0xcd, 0xcb, 0xe9, 0x5a, 0xba, 0xb1, 0xb2, 0x7d, 0xf7, 0xad, 0x09, 0xc6, 0x68, 0x9c, 0x84,
-	0x78, 0xb8, 0x6c, 0xfe, 0x90, 0x61, 0xad, 0xdb, 0x7f, 0x2a, 0xb8, 0x15, 0x4e, 0x51, 0x74, 0x1b,
-	0x60, 0x36, 0xa0, 0xa3, 0xbd, 0x9f, 0x0f, 0x11, 0xcd, 0x44, 0x5b, 0x90, 0x1b, 0x8e, 0x0d, 0xcb,
-	0xf6, 0x85, 0xa2, 0xff, 0x71, 0x56, 0x7c, 0x6b, 0xe6, 0x15, 0xd4, 0xfa, 0x3f, 0xe4, 0xad, 0xc1,
-	0x90, 0x04, 0x92, 0x80, 0x57, 0x39, 0x6b, 0x30, 0xec, 0x08, 0xe1, 0x3d, 0x28, 0x70, 0xd7, 0x38,
-	0xa6, 0x0e, 0x31, 0x4c, 0x53, 0x10, 0x38, 0xd8, 0xec, 0xab, 0x01, 0xda, 0x0a, 0x40, 0xf4, 0x3e,
-	0xac, 0x9f, 0x1a, 0x27, 0x96, 0x69, 0xb8, 0x6c, 0xae, 0x19, 0xac, 0xf9, 0x62, 0x24, 0x98, 0x29,
-	0xcf, 0xf7, 0x63, 0xf6, 0x3f, 0xed, 0xc7, 0x4f, 0x20, 0x37, 0x1b, 0x4a, 0x62, 0x16, 0xad, 0x6c,
-	0x6f, 0x29, 0xc1, 0x01, 0xc5, 0x7f, 0x5a, 0x29, 0xe1, 0xd3, 0x4a, 0xd9, 0x61, 0x96, 0xdd, 0x96,
-	0x7d, 0x27, 0x38, 0x1b, 0x0e, 0x1f, 0xf4, 0x79, 0xd4, 0x06, 0x79, 0xd1, 0x06, 0xf7, 0x93, 0x55,
-	0x4a, 0x64, 0x3d, 0xd1, 0x04, 0xf5, 0xdf, 0x17, 0xc8, 0xdc, 0x51, 0x0f, 0xf6, 0xfb, 0x9a, 0x4e,
-	0x0e, 0x54, 0x41, 0xd1, 0x60, 0x31, 0x5c, 0x62, 0xe4, 0xd5, 0xeb, 0x68, 0x03, 0xd6, 0x22, 0xc9,
-	0x6e, 0x4b, 0xeb, 0xaa, 0x9d, 0x62, 0xda, 0x57, 0xef, 0xa8, 0xfa, 0xfe, 0x13, 0xb5, 0xa7, 0x1d,
-	0xc5, 0xf7, 0x94, 0x8c, 0xaa, 0x50, 0x49, 0x48, 0xe2, 0xe6, 0x96, 0xfd, 0x76, 0x49, 0xc8, 0x43,
-	0xa3, 0x99, 0xf6, 0x93, 0x17, 0xe7, 0x55, 0xe9, 0xe5, 0x79, 0x55, 0xfa, 0xfb, 0xbc, 0x2a, 0xfd,
-	0x74, 0x51, 0x5d, 0x7a, 0x79, 0x51, 0x5d, 0xfa, 0xf3, 0xa2, 0xba, 0x74, 0xf4, 0x30, 0x96, 0xfd,
-	0xbe, 0xc8, 0xc5, 0x83, 0xae, 0x31, 0xe0, 0xcd, 0xf0, 0x69, 0x7b, 0xba, 0xfd, 0x71, 0xf3, 0x2c,
-	0x7a, 0xe0, 0x8a, 0x62, 0x0c, 0x32, 0xe2, 0x65, 0xfa, 0xe1, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x9d, 0xf3, 0xd3, 0xf3, 0xff, 0x0a, 0x00, 0x00,
+	0x13, 0x36, 0x25, 0x5a, 0x1f, 0xe3, 0x48, 0x96, 0xd7, 0x4a, 0x4c, 0xeb, 0x4d, 0x14, 0x45, 0x78,
+	0x13, 0x08, 0x28, 0x42, 0x35, 0x6e, 0xd1, 0x02, 0x6d, 0xd1, 0x56, 0x8a, 0x68, 0x87, 0x89, 0x22,
+	0xbb, 0x14, 0xdd, 0x34, 0x3e, 0x74, 0x41, 0x89, 0x5b, 0x89, 0xb0, 0xc5, 0x15, 0xb8, 0xa4, 0xe1,
+	0xf6, 0xde, 0x7b, 0x0f, 0x3d, 0xf5, 0x7f, 0xf4, 0x3f, 0xe4, 0x54, 0xe4, 0x58, 0xe4, 0x10, 0x14,
+	0xf6, 0x1f, 0x29, 0xb8, 0xa4, 0x28, 0x8a, 0x8e, 0xdd, 0x42, 0xcd, 0x49, 0xe2, 0xf3, 0xcc, 0xce,
+	0xec, 0xce, 0x37, 0xdc, 0x66, 0xae, 0x63, 0x99, 0xa4, 0xe9, 0x90, 0x21, 0x75, 0x4c, 0x36, 0xfb,
+	0x95, 0xa7, 0x0e, 0x75, 0x29, 0x2a, 0x06, 0xac, 0x1c, 0xa2, 0x95, 0xea, 0x90, 0xb2, 0x09, 0x65,
+	0xcd, 0x81, 0xc1, 0x48, 0xf3, 0xf4, 0xd1, 0x80, 0xb8, 0xc6, 0xa3, 0xe6, 0x90, 0x5a, 0x76, 0x20,
+	0x5f, 0x29, 0x8f, 0xe8, 0x88, 0xf2, 0xbf, 0x4d, 0xff, 0x5f, 0x80, 0xd6, 0x7f, 0x4e, 0x43, 0xf9,
+	0x90, 0x11, 0x47, 0x23, 0x26, 0x99, 0x4c, 0x5d, 0x8b, 0xda, 0x1a, 0xd7, 0x87, 0x8a, 0x90, 0xb2,
+	0x4c, 0x49, 0xa8, 0x09, 0x8d, 0xbc, 0x96, 0xb2, 0x4c, 0x54, 0x81, 0x9c, 0x43, 0x86, 0xc4, 0x3a,
+	0x25, 0x8e, 0x94, 0xe6, 0x68, 0xf4, 0x8d, 0xbe, 0x87, 0x4d, 0xdb, 0x70, 0xad, 0x53, 0x82, 0x5d,
+	0x7a, 0x4c, 0x6c, 0x6c, 0x4c, 0xa8, 0x67, 0xbb, 0x92, 0xe8, 0x8b, 0xb5, 0xe5, 0x57, 0x6f, 0xef,
+	0xae, 0xbc, 0x79, 0x7b, 0xf7, 0xc1, 0xc8, 0x72, 0xc7, 0xde, 0x40, 0x1e, 0xd2, 0x49, 0x33, 0xbc,
+	0x6a, 0xf0, 0xf3, 0x90, 0x99, 0xc7, 0x4d, 0xf7, 0xc7, 0x29, 0x61, 0xb2, 0x6a, 0xbb, 0xda, 0x46,
+	0xa0, 0x4a, 0xf7, 0x35, 0xb5, 0xb8, 0x22, 0x54, 0x86, 0x55, 0x93, 0xd8, 0x74, 0x22, 0xad, 0x72,
+	0xc3, 0xc1, 0x07, 0xaa, 0xc1, 0x8d, 0x31, 0x65, 0x2e, 0xfe, 0x89, 0xda, 0x04, 0x5b, 0xa6, 0x94,
+	0xe1, 0x24, 0xf8, 0xd8, 0x11, 0xb5, 0x89, 0x6a, 0xa2, 0x7b, 0x70, 0x83, 0x4c, 0xe9, 0x70, 0x8c,
+	0x6d, 0x6f, 0x32, 0x20, 0x8e, 0x94, 0xad, 0x09, 0x0d, 0x51, 0x5b, 0xe3, 0x58, 0x8f, 0x43, 0xa8,
+	0x01, 0xa5, 0xe1, 0x89, 0x61, 0x4d, 0xb0, 0xc5, 0xf0, 0x94, 0xd8, 0xa6, 0x65, 0x8f, 0xa4, 0x5c,
+	0x4d, 0x68, 0xe4, 0xb4, 0x22, 0xc7, 0x55, 0x76, 0x10, 0xa0, 0xe8, 0x5b, 0x58, 0x67, 0xee, 0xe2,
+	0x03, 0xf3, 0x4b, 0x3d, 0xb0, 0xc0, 0xdc, 0xd8, 0xe3, 0x9e, 0x8a, 0xb9, 0x54, 0x29, 0x5d, 0x7f,
+	0x23, 0x42, 0xa1, 0x43, 0xa6, 0x94, 0x59, 0xee, 0xa5, 0x00, 0x88, 0x3c, 0x00, 0xbb, 0x90, 0x09,
+	0xcd, 0xa6, 0x96, 0x32, 0x9b, 0x31, 0x12, 0xce, 0x4c, 0x5f, 0xe7, 0x4c, 0xf1, 0x92, 0x33, 0xbf,
+	0x80, 0x0c, 0x73, 0x0d, 0xd7, 0x63, 0xdc, 0xd1, 0xc5, 0x9d, 0xff, 0xcb, 0x8b, 0x09, 0x28, 0x2f,
+	0x5c, 0x5f, 0xee, 0x73, 0x59, 0x2d, 0x3c, 0x83, 0x3e, 0x84, 0xb2, 0x19, 0xf0, 0xf8, 0x1d, 0x21,
+	0x41, 0x21, 0xa7, 0xc4, 0x22, 0xe3, 0xdb, 0xa3, 0x9e, 0x33, 0x24, 0x52, 0xee, 0x5f, 0xd9, 0xe3,
+	0xb2, 0x5a, 0x78, 0x06, 0x7d, 0x0e, 0x15, 0x93, 0x9c, 0x90, 0x91, 0xe1, 0xa7, 0x34, 0x76, 0xcf,
+	0x18, 0xb6, 0x6c, 0x3c, 0x75, 0xe8, 0xc8, 0x21, 0x8c, 0xf1, 0xc0, 0x89, 0xda, 0xd6, 0x5c, 0x42,
+	0x3f, 0x63, 0xaa, 0x7d, 0x10, 0xd2, 0xe8, 0x63, 0xb8, 0x15, 0x3b, 0xcc, 0xc6, 0x86, 0x63, 0x62,
+	0xcb, 0x36, 0xc9, 0x99, 0x04, 0x35, 0xa1, 0x51, 0xd0, 0xca, 0x73, 0xb6, 0xef, 0x93, 0xaa, 0xcf,
+	0xd5, 0xc7, 0x90, 0x09, 0x1e, 0x8d, 0x10, 0x14, 0x75, 0xad, 0xd5, 0xeb, 0xef, 0x2a, 0x1a, 0xfe,
+	0xe6, 0x50, 0x39, 0x54, 0x4a, 0x2b, 0x48, 0x82, 0x72, 0x84, 0xa9, 0x3d, 0x7c, 0xa0, 0xed, 0xef,
+	0x69, 0x4a, 0xbf, 0x5f, 0x4a, 0xa1, 0x32, 0x94, 0x3a, 0x4a, 0x57, 0xd9, 0x6b, 0xe9, 0xea, 0x7e,
+	0x2f, 0x94, 0x17, 0x50, 0x05, 0x6e, 0xc5, 0xd0, 0xf8, 0x89, 0x74, 0xbd, 0x01, 0x99, 0xe0, 0xb9,
+	0x08, 0x20, 0xd3, 0xd7, 0x35, 0xb5, 0xe3, 0x5b, 0x40, 0x50, 0x7c, 0xa1, 0xea, 0x4f, 0x3a, 0x5a,
+	0xeb, 0x45, 0xab, 0x8b, 0xd5, 0xc7, 0xad, 0x92, 0xf0, 0x54, 0xcc, 0xad, 0x96, 0x32, 0xf5, 0xf3,
+	0x2c, 0x6c, 0x3c, 0x09, 0x23, 0x79, 0x68, 0x0f, 0xe8, 0x95, 0x09, 0x2d, 0xbc, 0x87, 0x84, 0xbe,
+	0xaa, 0x1b, 0xa4, 0xde, 0x57, 0x37, 0x78, 0x09, 0x1b, 0xb3, 0x7b, 0x33, 0xec, 0x52, 0x3c, 0xf0,
+	0x1c, 0x5b, 0xca, 0x2d, 0xa5, 0xbd, 0x18, 0xde, 0x9c, 0xe9, 0xb4, 0xed, 0x39, 0x36, 0x22, 0xb0,
+	0x15, 0xbf, 0x3a, 0x57, 0xef, 0x71, 0x87, 0x2d, 0x59, 0xeb, 0xe5, 0xd8, 0xf5, 0x99, 0x4e, 0x03,
+	0xe7, 0xa3, 0x1f, 0x60, 0x8b, 0x37, 0x17, 0x63, 0x70, 0x42, 0xf0, 0x82, 0x41, 0x09, 0x96, 0x32,
+	0x73, 0x33, 0x52, 0xd7, 0x8b, 0xd9, 0x43, 0x5f, 0xc1, 0x6d, 0xcf, 0xbe, 0xa6, 0x0c, 0xd6, 0x78,
+	0x19, 0x6c, 0x7b, 0xf6, 0x55, 0x85, 0xb0, 0x6c, 0xaf, 0xb8, 0x0f, 0x45, 0x6f, 0x96, 0x67, 0xd8,
+	0xb5, 0x26, 0x84, 0x77, 0x6e, 0x51, 0x2b, 0x44, 0xa8, 0x6e, 0x4d, 0x08, 0xfa, 0x3a, 0xd1, 0x52,
+	0x1a, 0xc9, 0x12, 0xbf, 0x94, 0xb4, 0xc9, 0xb6, 0xf2, 0x09, 0x6c, 0x79, 0x8c, 0x38, 0xd8, 0x89,
+	0xc6, 0x17, 0x0e, 0xcf, 0x4a, 0xd9, 0x5a, 0xba, 0x91, 0xd7, 0x6e, 0x7a, 0xef, 0x18, 0x6e, 0xd7,
+	0x55, 0xf8, 0x8d, 0x6b, 0x2a, 0xfc, 0x37, 0x21, 0x2a, 0xf1, 0x4d, 0x58, 0x3f, 0xec, 0xb5, 0xf7,
+	0x7b, 0x1d, 0xb5, 0xb7, 0x17, 0xd5, 0xf8, 0x36, 0xdc, 0x9c, 0x83, 0x0b, 0x25, 0xbb, 0x48, 0x69,
+	0x8a, 0xae, 0xbd, 0x0c, 0x4f, 0xad, 0xa2, 0x2d, 0xd8, 0x54, 0xbe, 0x53, 0x75, 0x9c, 0x68, 0x19,
+	0x02, 0xba, 0x03, 0xdb, 0x8b, 0x44, 0x5c, 0xa5, 0x88, 0x0a, 0x90, 0x7f, 0xdc, 0x6d, 0xa9, 0xcf,
+	0x5b, 0xed, 0xae, 0x52, 0x4a, 0xd5, 0x7f, 0x15, 0xa0, 0xcc, 0xfb, 0x67, 0xe4, 0xac, 0x70, 0x90,
+	0x24, 0xa7, 0xa0, 0x70, 0x79, 0x0a, 0xf6, 0xa1, 0x3c, 0x8f, 0x68, 0x14, 0x23, 0x26, 0xa5, 0x6b,
+	0xe9, 0xc6, 0xda, 0xce, 0xbd, 0x7f, 0x0c, 0x8b, 0x86, 0xc6, 0x49, 0x88, 0x85, 0x83, 0xed, 0x0f,
+	0x11, 0xd6, 0xbb, 0xfd, 0xe7, 0x3c, 0x23, 0xc3, 0x8e, 0x8d, 0xee, 0x00, 0xcc, 0x86, 0x41, 0xb4,
+	0x63, 0xe4, 0x43, 0x44, 0x35, 0xd1, 0x36, 0xe4, 0x86, 0x63, 0xc3, 0xb2, 0x7d, 0x92, 0x77, 0x0d,
+	0x2d, 0xcb, 0xbf, 0x55, 0xf3, 0x8a, 0x84, 0xfc, 0x1f, 0xe4, 0xad, 0xc1, 0x10, 0x07, 0x4c, 0x90,
+	0x8d, 0x39, 0x6b, 0x30, 0xec, 0x70, 0xf2, 0x3e, 0x14, 0x99, 0x6b, 0x1c, 0x13, 0x07, 0x1b, 0xa6,
+	0xc9, 0xd3, 0x3e, 0xd8, 0x22, 0x0a, 0x01, 0xda, 0x0a, 0x40, 0xf4, 0x01, 0x6c, 0x9c, 0x1a, 0x27,
+	0x96, 0x69, 0xb8, 0x74, 0x2e, 0x19, 0xac, 0x14, 0xa5, 0x88, 0x98, 0x09, 0xcf, 0x67, 0x71, 0xf6,
+	0x3f, 0xcd, 0xe2, 0xcf, 0x20, 0x37, 0x6b, 0x65, 0xbc, 0x83, 0xad, 0xed, 0x6c, 0xcb, 0xc1, 0x01,
+	0xd9, 0x5f, 0xe3, 0xe4, 0x70, 0x8d, 0x93, 0x1f, 0x53, 0xcb, 0x6e, 0x8b, 0xbe, 0x11, 0x2d, 0x1b,
+	0xb6, 0x2c, 0xf4, 0x65, 0x54, 0x3c, 0x79, 0x5e, 0x3c, 0x0f, 0x92, 0x51, 0x4a, 0x78, 0x3d, 0x51,
+	0x3a, 0xf5, 0xdf, 0x17, 0x92, 0xb9, 0xa3, 0x1c, 0xec, 0xf7, 0x55, 0x1d, 0x1f, 0x28, 0x3c, 0x45,
+	0x83, 0x71, 0x72, 0x29, 0x23, 0xaf, 0x1e, 0x62, 0x9b, 0xb0, 0x1e, 0x31, 0xbb, 0x2d, 0xb5, 0xab,
+	0x74, 0x4a, 0x69, 0x5f, 0xbc, 0xa3, 0xe8, 0xfb, 0xcf, 0x94, 0x9e, 0x7a, 0x14, 0x9f, 0x6e, 0x22,
+	0xaa, 0x42, 0x25, 0xc1, 0xc4, 0xd5, 0xad, 0xfa, 0xe5, 0x92, 0xe0, 0x43, 0xa5, 0x99, 0xf6, 0xb3,
+	0x57, 0xe7, 0x55, 0xe1, 0xf5, 0x79, 0x55, 0xf8, 0xeb, 0xbc, 0x2a, 0xfc, 0x72, 0x51, 0x5d, 0x79,
+	0x7d, 0x51, 0x5d, 0xf9, 0xf3, 0xa2, 0xba, 0x72, 0xf4, 0x28, 0xe6, 0xfd, 0x3e, 0xf7, 0xc5, 0xc3,
+	0xae, 0x31, 0x60, 0xcd, 0x70, 0x8d, 0x3e, 0xdd, 0xf9, 0xb4, 0x79, 0x16, 0x2d, 0xd3, 0x3c, 0x18,
+	0x83, 0x0c, 0xdf, 0x82, 0x3f, 0xfa, 0x7b, 0x00, 0x27, 0x0f, 0x49, 0x79, 0x6b, 0x0b, 0x00, 0x00,
 }
 
 func (m *UserRedemptionRecord) Marshal() (dAtA []byte, err error) {
@@ -784,6 +803,11 @@ func (m *DepositRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DelegationShardIndex != 0 {
+		i = encodeVarintRecords(dAtA, i, uint64(m.DelegationShardIndex))
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.DelegationTxsInProgress != 0 {
 		i = encodeVarintRecords(dAtA, i, uint64(m.DelegationTxsInProgress))
 		i--
@@ -856,6 +880,11 @@ func (m *HostZoneUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DelegationShardIndex != 0 {
+		i = encodeVarintRecords(dAtA, i, uint64(m.DelegationShardIndex))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.UndelegationTxsInProgress != 0 {
 		i = encodeVarintRecords(dAtA, i, uint64(m.UndelegationTxsInProgress))
 		i--
@@ -1156,6 +1185,9 @@ func (m *DepositRecord) Size() (n int) {
 	if m.DelegationTxsInProgress != 0 {
 		n += 1 + sovRecords(uint64(m.DelegationTxsInProgress))
 	}
+	if m.DelegationShardIndex != 0 {
+		n += 1 + sovRecords(uint64(m.DelegationShardIndex))
+	}
 	return n
 }
 
@@ -1198,6 +1230,9 @@ func (m *HostZoneUnbonding) Size() (n int) {
 	if m.UndelegationTxsInProgress != 0 {
 		n += 1 + sovRecords(uint64(m.UndelegationTxsInProgress))
 	}
+	if m.DelegationShardIndex != 0 {
+		n += 1 + sovRecords(uint64(m.DelegationShardIndex))
+	}
 	return n
 }
 
@@ -1772,6 +1807,25 @@ func (m *DepositRecord) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationShardIndex", wireType)
+			}
+			m.DelegationShardIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationShardIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRecords(dAtA[iNdEx:])
@@ -2145,6 +2199,25 @@ func (m *HostZoneUnbonding) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationShardIndex", wireType)
+			}
+			m.DelegationShardIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationShardIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRecords(dAtA[iNdEx:])
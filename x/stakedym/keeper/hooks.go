@@ -7,6 +7,7 @@ import (
 
 	"github.com/Stride-Labs/stride/v27/utils"
 	epochstypes "github.com/Stride-Labs/stride/v27/x/epochs/types"
+	"github.com/Stride-Labs/stride/v27/x/stakedym/types"
 )
 
 // This module has the following epochly triggers
@@ -82,6 +83,11 @@ func (k Keeper) Hooks() Hooks {
 	return Hooks{k}
 }
 
+// GetModuleName returns the module name, used by the epochs module for events and telemetry
+func (h Hooks) GetModuleName() string {
+	return types.ModuleName
+}
+
 func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInfo) {
 	h.k.BeforeEpochStart(ctx, epochInfo)
 }
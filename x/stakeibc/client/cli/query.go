@@ -32,6 +32,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdShowEpochTracker())
 	cmd.AddCommand(CmdNextPacketSequence())
 	cmd.AddCommand(CmdListTradeRoutes())
+	cmd.AddCommand(CmdListTradeRecords())
 
 	return cmd
 }
@@ -317,3 +318,34 @@ func CmdListTradeRoutes() *cobra.Command {
 
 	return cmd
 }
+
+func CmdListTradeRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-trade-records [reward-denom] [host-denom]",
+		Short: "list the swaps executed along trade routes, optionally filtered by route",
+		Args:  cobra.RangeArgs(0, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryTradeRecordsRequest{}
+			if len(args) > 0 {
+				params.RewardDenom = args[0]
+			}
+			if len(args) > 1 {
+				params.HostDenom = args[1]
+			}
+			res, err := queryClient.TradeRecords(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -26,6 +26,7 @@ const (
 	FlagCommunityPoolTreasuryAddress = "community-pool-treasury-address"
 	FlagMaxMessagesPerIcaTx          = "max-messages-per-ica-tx"
 	FlagLegacy                       = "legacy"
+	FlagDelegationShardIndex         = "delegation-shard-index"
 )
 
 var DefaultRelativePacketTimeoutTimestamp = cast.ToUint64((time.Duration(10) * time.Minute).Nanoseconds())
@@ -56,6 +57,7 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdClearBalance())
 	cmd.AddCommand(CmdUpdateInnerRedemptionRateBounds())
 	cmd.AddCommand(CmdResumeHostZone())
+	cmd.AddCommand(CmdRegisterDelegationShard())
 	cmd.AddCommand(CmdSetCommunityPoolRebate())
 	cmd.AddCommand(CmdToggleTradeController())
 
@@ -623,6 +625,11 @@ func CmdCalibrateDelegation() *cobra.Command {
 			argChainId := args[0]
 			argValoper := args[1]
 
+			delegationShardIndex, err := cmd.Flags().GetUint32(FlagDelegationShardIndex)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -632,6 +639,7 @@ func CmdCalibrateDelegation() *cobra.Command {
 				clientCtx.GetFromAddress().String(),
 				argChainId,
 				argValoper,
+				delegationShardIndex,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -640,6 +648,7 @@ func CmdCalibrateDelegation() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint32(FlagDelegationShardIndex, 0, "Delegation shard to calibrate (0 for the main delegation ICA)")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -743,6 +752,35 @@ func CmdResumeHostZone() *cobra.Command {
 	return cmd
 }
 
+func CmdRegisterDelegationShard() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-delegation-shard [chainid]",
+		Short: "Registers an additional delegation ICA on a host zone",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChainId := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterDelegationShard(
+				clientCtx.GetFromAddress().String(),
+				argChainId,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdSetCommunityPoolRebate() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "set-rebate [chain-id] [rebate-rate] [liquid-staked-sttoken-amount]",
@@ -19,6 +19,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, tradeRoute := range genState.TradeRoutes {
 		k.SetTradeRoute(ctx, tradeRoute)
 	}
+	for _, tradeRecord := range genState.TradeRecords {
+		k.SetTradeRecord(ctx, tradeRecord)
+	}
+	k.SetTradeRecordCount(ctx, genState.TradeRecordCount)
 
 	k.SetParams(ctx, genState.Params)
 }
@@ -31,6 +35,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.HostZoneList = k.GetAllHostZone(ctx)
 	genesis.EpochTrackerList = k.GetAllEpochTracker(ctx)
 	genesis.TradeRoutes = k.GetAllTradeRoutes(ctx)
+	genesis.TradeRecords = k.GetAllTradeRecords(ctx)
+	genesis.TradeRecordCount = k.GetTradeRecordCount(ctx)
 
 	return genesis
 }
@@ -19,25 +19,10 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 	for _, hz := range k.GetAllHostZone(ctx) {
 		rrSafe, err := k.IsRedemptionRateWithinSafetyBounds(ctx, hz)
 		if !rrSafe {
-			hz.Halted = true
-			k.SetHostZone(ctx, hz)
-
-			// set rate limit on stAsset
-			stDenom := types.StAssetDenomFromHostZoneDenom(hz.HostDenom)
-			k.RatelimitKeeper.AddDenomToBlacklist(ctx, stDenom)
-
 			k.Logger(ctx).Error(fmt.Sprintf("[INVARIANT BROKEN!!!] %s's RR is %s. ERR: %v", hz.GetChainId(), hz.RedemptionRate.String(), err.Error()))
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(
-					types.EventTypeHostZoneHalt,
-					sdk.NewAttribute(types.AttributeKeyHostZone, hz.ChainId),
-					sdk.NewAttribute(types.AttributeKeyRedemptionRate, hz.RedemptionRate.String()),
-				),
-			)
+			k.HaltHostZone(ctx, hz)
 		}
 	}
-
-	k.AssertStrideAndDayEpochRelationship(ctx)
 }
 
 func (k Keeper) EndBlocker(ctx sdk.Context) {
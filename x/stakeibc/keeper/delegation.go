@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/Stride-Labs/stride/v27/utils"
+	epochstypes "github.com/Stride-Labs/stride/v27/x/epochs/types"
 	recordstypes "github.com/Stride-Labs/stride/v27/x/records/types"
 	"github.com/Stride-Labs/stride/v27/x/stakeibc/types"
 )
@@ -21,6 +22,12 @@ func (k Keeper) GetDelegationICAMessages(
 	hostZone types.HostZone,
 	depositRecord recordstypes.DepositRecord,
 ) (msgs []proto.Message, delegations []*types.SplitDelegation, err error) {
+	// The record is staked from the delegation shard that the deposit was sent to
+	delegatorAddress, err := k.GetDelegationShardAddress(hostZone, depositRecord.DelegationShardIndex)
+	if err != nil {
+		return msgs, delegations, err
+	}
+
 	// Construct the transaction
 	targetDelegationsByValidator, err := k.GetTargetValAmtsForHostZone(ctx, hostZone, depositRecord.Amount)
 	if err != nil {
@@ -35,7 +42,7 @@ func (k Keeper) GetDelegationICAMessages(
 		}
 
 		msgs = append(msgs, &stakingtypes.MsgDelegate{
-			DelegatorAddress: hostZone.DelegationIcaAddress,
+			DelegatorAddress: delegatorAddress,
 			ValidatorAddress: validator.Address,
 			Amount:           sdk.NewCoin(hostZone.HostDenom, validatorAmount),
 		})
@@ -74,17 +81,26 @@ func (k Keeper) BatchSubmitDelegationICAMessages(
 
 		// Store the callback data
 		delegateCallback := types.DelegateCallback{
-			HostZoneId:       hostZone.ChainId,
-			DepositRecordId:  depositRecord.Id,
-			SplitDelegations: delegationsBatch,
+			HostZoneId:           hostZone.ChainId,
+			DepositRecordId:      depositRecord.Id,
+			SplitDelegations:     delegationsBatch,
+			DelegationShardIndex: depositRecord.DelegationShardIndex,
 		}
 		marshalledCallbackArgs, err := proto.Marshal(&delegateCallback)
 		if err != nil {
 			return 0, err
 		}
 
-		// Send the transaction through SubmitTx
-		_, err = k.SubmitTxsStrideEpoch(ctx, hostZone.ConnectionId, msgBatch, types.ICAAccountType_DELEGATION, ICACallbackID_Delegate, marshalledCallbackArgs)
+		// Send the transaction from the record's delegation shard
+		_, err = k.SubmitDelegationShardTxsEpoch(
+			ctx,
+			hostZone,
+			depositRecord.DelegationShardIndex,
+			msgBatch,
+			epochstypes.STRIDE_EPOCH,
+			ICACallbackID_Delegate,
+			marshalledCallbackArgs,
+		)
 		if err != nil {
 			return 0, errorsmod.Wrapf(err, "failed to submit delegation ICAs on %s. Messages: %s", hostZone.ChainId, msgs)
 		}
@@ -133,6 +149,13 @@ func (k Keeper) StakeExistingDepositsOnHostZones(ctx sdk.Context, epochNumber ui
 			continue
 		}
 
+		delegateInterval := k.GetHostZoneEpochCadence(ctx, hostZone).DelegateInterval
+		if epochNumber%delegateInterval != 0 {
+			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+				"Host does not stake deposits this epoch (Interval: %d, Epoch: %d)", delegateInterval, epochNumber))
+			continue
+		}
+
 		if hostZone.DelegationIcaAddress == "" {
 			k.Logger(ctx).Error(fmt.Sprintf("[StakeExistingDepositsOnHostZones] no delegation account found for %s", hostZone.ChainId))
 			continue
@@ -172,10 +195,17 @@ func (k Keeper) StakeExistingDepositsOnHostZones(ctx sdk.Context, epochNumber ui
 }
 
 // Delegates accrued staking rewards for reinvestment
-func (k Keeper) ReinvestRewards(ctx sdk.Context) {
+func (k Keeper) ReinvestRewards(ctx sdk.Context, epochNumber uint64) {
 	k.Logger(ctx).Info("Reinvesting tokens...")
 
 	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+		reinvestInterval := k.GetHostZoneEpochCadence(ctx, hostZone).ReinvestInterval
+		if epochNumber%reinvestInterval != 0 {
+			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+				"Host does not reinvest this epoch (Interval: %d, Epoch: %d)", reinvestInterval, epochNumber))
+			continue
+		}
+
 		// only process host zones once withdrawal accounts are registered
 		if hostZone.WithdrawalIcaAddress == "" {
 			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Withdrawal account not registered for host zone"))
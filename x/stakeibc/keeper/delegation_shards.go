@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/Stride-Labs/stride/v27/utils"
+	"github.com/Stride-Labs/stride/v27/x/stakeibc/types"
+)
+
+// Returns the ICA address of a delegation shard, erroring if the shard
+// is not registered or the ICA channel has not been opened yet
+func (k Keeper) GetDelegationShardAddress(hostZone types.HostZone, shardIndex uint32) (string, error) {
+	address, found := hostZone.GetDelegationShardAddress(shardIndex)
+	if !found {
+		return "", errorsmod.Wrapf(types.ErrDelegationShardNotFound, "shard %d not found on %s", shardIndex, hostZone.ChainId)
+	}
+	if address == "" {
+		return "", errorsmod.Wrapf(types.ErrICAAccountNotFound, "no delegation account found for shard %d on %s",
+			shardIndex, hostZone.ChainId)
+	}
+	return address, nil
+}
+
+// Returns the total delegated from each delegation shard, keyed by shard index
+func (k Keeper) GetDelegationShardTotals(hostZone types.HostZone) map[uint32]sdkmath.Int {
+	shardTotals := map[uint32]sdkmath.Int{}
+	for _, shardIndex := range hostZone.GetDelegationShardIndexes() {
+		shardTotal := sdkmath.ZeroInt()
+		for _, validator := range hostZone.Validators {
+			shardTotal = shardTotal.Add(validator.GetShardDelegation(shardIndex))
+		}
+		shardTotals[shardIndex] = shardTotal
+	}
+	return shardTotals
+}
+
+// Returns the delegation shard that new deposits should be sent to
+// Deposits are sent to the shard with the smallest total delegation (ignoring shards
+// whose ICA has not yet been opened), which keeps the shards roughly balanced
+func (k Keeper) GetDelegationShardForDeposit(hostZone types.HostZone) (shardIndex uint32, address string, err error) {
+	shardTotals := k.GetDelegationShardTotals(hostZone)
+
+	var minTotal sdkmath.Int
+	for _, index := range hostZone.GetDelegationShardIndexes() {
+		shardAddress, _ := hostZone.GetDelegationShardAddress(index)
+		if shardAddress == "" {
+			continue
+		}
+		if address == "" || shardTotals[index].LT(minTotal) {
+			shardIndex = index
+			address = shardAddress
+			minTotal = shardTotals[index]
+		}
+	}
+
+	if address == "" {
+		return 0, "", errorsmod.Wrapf(types.ErrICAAccountNotFound, "no delegation account found for %s", hostZone.ChainId)
+	}
+	return shardIndex, address, nil
+}
+
+// Returns the delegation shard with the largest delegation to a given validator
+// Ties are broken by the lower shard index
+func (k Keeper) GetLargestDelegationShardForValidator(hostZone types.HostZone, validator types.Validator) uint32 {
+	largestShardIndex := uint32(0)
+	largestDelegation := validator.GetShardDelegation(0)
+	for _, shardIndex := range hostZone.GetDelegationShardIndexes() {
+		shardDelegation := validator.GetShardDelegation(shardIndex)
+		if shardDelegation.GT(largestDelegation) {
+			largestShardIndex = shardIndex
+			largestDelegation = shardDelegation
+		}
+	}
+	return largestShardIndex
+}
+
+// Given a list of redelegations across the host zone, determines which delegation shard
+// should submit each redelegation
+// Each redelegation is sourced from the shards with delegations to the source validator,
+// in order of shard index, and split across multiple shards if needed
+// Returns a mapping of shard index to that shard's redelegations
+func (k Keeper) SplitRebalancingsByShard(
+	hostZone types.HostZone,
+	rebalancings []*types.Rebalancing,
+) (shardRebalancings map[uint32][]*types.Rebalancing, err error) {
+	// Track the remaining delegation for each shard/validator pair as redelegations are assigned
+	remainingShardDelegations := map[uint32]map[string]sdkmath.Int{}
+	for _, shardIndex := range hostZone.GetDelegationShardIndexes() {
+		remainingShardDelegations[shardIndex] = map[string]sdkmath.Int{}
+		for _, validator := range hostZone.Validators {
+			remainingShardDelegations[shardIndex][validator.Address] = validator.GetShardDelegation(shardIndex)
+		}
+	}
+
+	shardRebalancings = map[uint32][]*types.Rebalancing{}
+	for _, rebalancing := range rebalancings {
+		remainingAmount := rebalancing.Amt
+		for _, shardIndex := range hostZone.GetDelegationShardIndexes() {
+			if remainingAmount.IsZero() {
+				break
+			}
+
+			available := remainingShardDelegations[shardIndex][rebalancing.SrcValidator]
+			if !available.IsPositive() {
+				continue
+			}
+			shardAmount := sdkmath.MinInt(available, remainingAmount)
+
+			remainingShardDelegations[shardIndex][rebalancing.SrcValidator] = available.Sub(shardAmount)
+			remainingAmount = remainingAmount.Sub(shardAmount)
+
+			shardRebalancings[shardIndex] = append(shardRebalancings[shardIndex], &types.Rebalancing{
+				SrcValidator: rebalancing.SrcValidator,
+				DstValidator: rebalancing.DstValidator,
+				Amt:          shardAmount,
+			})
+		}
+
+		if !remainingAmount.IsZero() {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+				"insufficient delegation across shards to redelegate %v from %s", rebalancing.Amt, rebalancing.SrcValidator)
+		}
+	}
+
+	return shardRebalancings, nil
+}
+
+// Submits an ICA from a delegation shard, with a timeout at the end of the given epoch
+func (k Keeper) SubmitDelegationShardTxsEpoch(
+	ctx sdk.Context,
+	hostZone types.HostZone,
+	shardIndex uint32,
+	msgs []proto.Message,
+	epochType string,
+	callbackId string,
+	callbackArgs []byte,
+) (uint64, error) {
+	timeoutNanos, err := k.GetICATimeoutNanos(ctx, epochType)
+	if err != nil {
+		return 0, errorsmod.Wrapf(err, "failed to get ICA timeout nanos for epochType %s", epochType)
+	}
+
+	chainId, err := k.GetChainIdFromConnectionId(ctx, hostZone.ConnectionId)
+	if err != nil {
+		return 0, err
+	}
+	owner := types.FormatDelegationShardICAOwner(chainId, shardIndex)
+	sequence, err := k.SubmitICATxWithCallback(ctx, hostZone.ConnectionId, owner, msgs, timeoutNanos, callbackId, callbackArgs)
+	if err != nil {
+		return 0, errorsmod.Wrapf(err, "failed to submit ICA from delegation shard %d", shardIndex)
+	}
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Submitted ICA from delegation shard %d", shardIndex))
+	return sequence, nil
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/Stride-Labs/stride/v27/x/stakeibc/types"
+)
+
+// Helper function to build a validator with delegations across shards
+// The shard 0 delegation is the remainder of the total
+func newShardedValidator(address string, shardDelegations ...int64) *types.Validator {
+	validator := types.Validator{Address: address, Delegation: sdkmath.ZeroInt()}
+	for shardIndex, delegation := range shardDelegations {
+		validator.AddShardDelegation(uint32(shardIndex), sdkmath.NewInt(delegation))
+	}
+	return &validator
+}
+
+func (s *KeeperTestSuite) TestGetDelegationShardForDeposit() {
+	hostZone := types.HostZone{
+		ChainId:              HostChainId,
+		DelegationIcaAddress: "shard0",
+		DelegationShards: []types.DelegationShard{
+			{Index: 1, IcaAddress: "shard1"},
+			{Index: 2, IcaAddress: "shard2"},
+			{Index: 3}, // not yet opened
+		},
+		Validators: []*types.Validator{
+			newShardedValidator("valA", 100, 50, 80),
+			newShardedValidator("valB", 100, 20, 80),
+		},
+	}
+
+	// Shard totals: 0 => 200, 1 => 70, 2 => 160, 3 => 0
+	// Shard 3 is the smallest but it's not open yet, so shard 1 should be selected
+	shardIndex, address, err := s.App.StakeibcKeeper.GetDelegationShardForDeposit(hostZone)
+	s.Require().NoError(err, "no error expected when getting shard")
+	s.Require().Equal(uint32(1), shardIndex, "shard index")
+	s.Require().Equal("shard1", address, "shard address")
+
+	// Once shard 3 is open, it should be selected
+	hostZone.DelegationShards[2].IcaAddress = "shard3"
+	shardIndex, address, err = s.App.StakeibcKeeper.GetDelegationShardForDeposit(hostZone)
+	s.Require().NoError(err, "no error expected when getting shard after opening")
+	s.Require().Equal(uint32(3), shardIndex, "shard index after opening")
+	s.Require().Equal("shard3", address, "shard address after opening")
+
+	// With no shards, the main delegation account should be used
+	hostZone.DelegationShards = []types.DelegationShard{}
+	shardIndex, address, err = s.App.StakeibcKeeper.GetDelegationShardForDeposit(hostZone)
+	s.Require().NoError(err, "no error expected when getting shard with no additional shards")
+	s.Require().Equal(uint32(0), shardIndex, "shard index with no additional shards")
+	s.Require().Equal("shard0", address, "shard address with no additional shards")
+
+	// If no accounts are opened, it should error
+	hostZone.DelegationIcaAddress = ""
+	_, _, err = s.App.StakeibcKeeper.GetDelegationShardForDeposit(hostZone)
+	s.Require().ErrorContains(err, "no delegation account found for GAIA")
+}
+
+func (s *KeeperTestSuite) TestGetLargestDelegationShardForValidator() {
+	hostZone := types.HostZone{
+		DelegationShards: []types.DelegationShard{{Index: 1}, {Index: 2}},
+	}
+
+	testCases := []struct {
+		validator     *types.Validator
+		expectedShard uint32
+	}{
+		{validator: newShardedValidator("valA", 100, 50, 80), expectedShard: 0},
+		{validator: newShardedValidator("valB", 10, 50, 80), expectedShard: 2},
+		{validator: newShardedValidator("valC", 10, 80, 80), expectedShard: 1}, // tie broken by lower index
+		{validator: newShardedValidator("valD"), expectedShard: 0},             // no delegations
+	}
+
+	for _, tc := range testCases {
+		actualShard := s.App.StakeibcKeeper.GetLargestDelegationShardForValidator(hostZone, *tc.validator)
+		s.Require().Equal(tc.expectedShard, actualShard, "shard for %s", tc.validator.Address)
+	}
+}
+
+func (s *KeeperTestSuite) TestSplitRebalancingsByShard() {
+	hostZone := types.HostZone{
+		DelegationShards: []types.DelegationShard{{Index: 1}, {Index: 2}},
+		Validators: []*types.Validator{
+			newShardedValidator("valA", 100, 0, 50),
+			newShardedValidator("valB", 0, 30, 0),
+			newShardedValidator("valC"),
+		},
+	}
+
+	// valA -> valC for 120 should be split as 100 from shard 0 and 20 from shard 2
+	// valA -> valB for 20 should come from the remaining 30 on shard 2
+	// valB -> valC for 30 should come entirely from shard 1
+	rebalancings := []*types.Rebalancing{
+		{SrcValidator: "valA", DstValidator: "valC", Amt: sdkmath.NewInt(120)},
+		{SrcValidator: "valA", DstValidator: "valB", Amt: sdkmath.NewInt(20)},
+		{SrcValidator: "valB", DstValidator: "valC", Amt: sdkmath.NewInt(30)},
+	}
+	expectedShardRebalancings := map[uint32][]*types.Rebalancing{
+		0: {
+			{SrcValidator: "valA", DstValidator: "valC", Amt: sdkmath.NewInt(100)},
+		},
+		1: {
+			{SrcValidator: "valB", DstValidator: "valC", Amt: sdkmath.NewInt(30)},
+		},
+		2: {
+			{SrcValidator: "valA", DstValidator: "valC", Amt: sdkmath.NewInt(20)},
+			{SrcValidator: "valA", DstValidator: "valB", Amt: sdkmath.NewInt(20)},
+		},
+	}
+
+	actualShardRebalancings, err := s.App.StakeibcKeeper.SplitRebalancingsByShard(hostZone, rebalancings)
+	s.Require().NoError(err, "no error expected when splitting rebalancings")
+	s.Require().Equal(expectedShardRebalancings, actualShardRebalancings, "shard rebalancings")
+
+	// Attempt to redelegate more than is delegated to valB across all shards
+	invalidRebalancings := []*types.Rebalancing{
+		{SrcValidator: "valB", DstValidator: "valC", Amt: sdkmath.NewInt(31)},
+	}
+	_, err = s.App.StakeibcKeeper.SplitRebalancingsByShard(hostZone, invalidRebalancings)
+	s.Require().ErrorContains(err, "insufficient delegation across shards to redelegate 31 from valB")
+}
@@ -95,6 +95,26 @@ func (k Keeper) UpdateEpochTracker(ctx sdk.Context, epochInfo epochstypes.EpochI
 	return epochNumber, nil
 }
 
+// Returns the number of stride epochs in each day epoch, derived from the epoch durations
+// Falls back to the default if either epoch tracker has not been initialized
+func (k Keeper) GetStrideEpochsPerDayEpoch(ctx sdk.Context) uint64 {
+	strideEpoch, found := k.GetEpochTracker(ctx, epochstypes.STRIDE_EPOCH)
+	if !found || strideEpoch.Duration == 0 {
+		return DefaultStrideEpochsPerDayEpoch
+	}
+	dayEpoch, found := k.GetEpochTracker(ctx, epochstypes.DAY_EPOCH)
+	if !found || dayEpoch.Duration == 0 {
+		return DefaultStrideEpochsPerDayEpoch
+	}
+
+	// If the stride epoch is longer than the day epoch, treat each stride epoch as a day
+	strideEpochsPerDayEpoch := dayEpoch.Duration / strideEpoch.Duration
+	if strideEpochsPerDayEpoch == 0 {
+		return 1
+	}
+	return strideEpochsPerDayEpoch
+}
+
 // helper to get what share of the curr epoch we're through
 func (k Keeper) GetStrideEpochElapsedShare(ctx sdk.Context) (sdk.Dec, error) {
 	// Get the current stride epoch
@@ -164,3 +164,36 @@ func (s *KeeperTestSuite) TestEpochElapsedShare_Failed_BlockTimeOutsideEpoch() {
 	_, err := s.App.StakeibcKeeper.GetStrideEpochElapsedShare(s.Ctx)
 	s.Require().ErrorContains(err, "is not within current epoch")
 }
+
+func (s *KeeperTestSuite) TestGetStrideEpochsPerDayEpoch() {
+	// Without the epoch trackers, it should fall back to the default
+	s.Require().Equal(keeper.DefaultStrideEpochsPerDayEpoch, s.App.StakeibcKeeper.GetStrideEpochsPerDayEpoch(s.Ctx),
+		"default without epoch trackers")
+
+	testCases := []struct {
+		strideEpochDuration uint64
+		dayEpochDuration    uint64
+		expected            uint64
+	}{
+		{strideEpochDuration: 6, dayEpochDuration: 24, expected: 4},
+		{strideEpochDuration: 1, dayEpochDuration: 24, expected: 24},
+		{strideEpochDuration: 7, dayEpochDuration: 24, expected: 3},  // rounded down
+		{strideEpochDuration: 48, dayEpochDuration: 24, expected: 1}, // stride epoch longer than a day
+		{strideEpochDuration: 0, dayEpochDuration: 24, expected: 4},  // uninitialized stride epoch
+		{strideEpochDuration: 6, dayEpochDuration: 0, expected: 4},   // uninitialized day epoch
+	}
+	for _, tc := range testCases {
+		s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, types.EpochTracker{
+			EpochIdentifier: epochtypes.STRIDE_EPOCH,
+			Duration:        tc.strideEpochDuration,
+		})
+		s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, types.EpochTracker{
+			EpochIdentifier: epochtypes.DAY_EPOCH,
+			Duration:        tc.dayEpochDuration,
+		})
+
+		actual := s.App.StakeibcKeeper.GetStrideEpochsPerDayEpoch(s.Ctx)
+		s.Require().Equal(tc.expected, actual, "stride epochs per day with durations %d and %d",
+			tc.strideEpochDuration, tc.dayEpochDuration)
+	}
+}
@@ -221,6 +221,27 @@ func (k Keeper) AllTradeRoutes(c context.Context, req *types.QueryAllTradeRoutes
 	return &types.QueryAllTradeRoutesResponse{TradeRoutes: routes}, nil
 }
 
+func (k Keeper) TradeRecords(c context.Context, req *types.QueryTradeRecordsRequest) (*types.QueryTradeRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// Optionally filter by the reward and host denom of the route
+	tradeRecords := []types.TradeRecord{}
+	for _, tradeRecord := range k.GetAllTradeRecords(ctx) {
+		if req.RewardDenom != "" && tradeRecord.RewardDenom != req.RewardDenom {
+			continue
+		}
+		if req.HostDenom != "" && tradeRecord.HostDenom != req.HostDenom {
+			continue
+		}
+		tradeRecords = append(tradeRecords, tradeRecord)
+	}
+
+	return &types.QueryTradeRecordsResponse{TradeRecords: tradeRecords}, nil
+}
+
 // InterchainAccountFromAddress implements the Query/InterchainAccountFromAddress gRPC method
 func (k Keeper) InterchainAccountFromAddress(goCtx context.Context, req *types.QueryInterchainAccountFromAddressRequest) (*types.QueryInterchainAccountFromAddressResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
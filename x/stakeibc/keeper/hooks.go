@@ -12,7 +12,8 @@ import (
 	"github.com/Stride-Labs/stride/v27/x/stakeibc/types"
 )
 
-const StrideEpochsPerDayEpoch = uint64(4)
+// The number of stride epochs in a day epoch, used if the epoch trackers have not been initialized
+const DefaultStrideEpochsPerDayEpoch = uint64(4)
 
 func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInfo) {
 	// Update the stakeibc epoch tracker
@@ -34,12 +35,6 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInf
 
 	// Stride Epoch - Process Deposits and Delegations
 	if epochInfo.Identifier == epochstypes.STRIDE_EPOCH {
-		// Get cadence intervals
-		redemptionRateInterval := k.GetParam(ctx, types.KeyRedemptionRateInterval)
-		depositInterval := k.GetParam(ctx, types.KeyDepositInterval)
-		delegationInterval := k.GetParam(ctx, types.KeyDelegateInterval)
-		reinvestInterval := k.GetParam(ctx, types.KeyReinvestInterval)
-
 		// Claim accrued staking rewards at the beginning of the epoch
 		k.ClaimAccruedStakingRewards(ctx)
 
@@ -50,34 +45,27 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInf
 		// TODO: move this to an external function that anyone can call, so that we don't have to call it every epoch
 		k.SetWithdrawalAddress(ctx)
 
+		// Each of the following tasks is only run on host zones whose cadence for
+		// that task lines up with the current epoch
+
 		// Update the redemption rate
-		if epochNumber%redemptionRateInterval == 0 {
-			k.UpdateRedemptionRates(ctx, depositRecords)
-		}
+		k.UpdateRedemptionRates(ctx, epochNumber, depositRecords)
 
 		// Transfer deposited funds from the controller account to the delegation account on the host zone
-		if epochNumber%depositInterval == 0 {
-			k.TransferExistingDepositsToHostZones(ctx, epochNumber, depositRecords)
-		}
+		k.TransferExistingDepositsToHostZones(ctx, epochNumber, depositRecords)
 
 		// Delegate tokens from the delegation account
-		if epochNumber%delegationInterval == 0 {
-			k.StakeExistingDepositsOnHostZones(ctx, epochNumber, depositRecords)
-		}
+		k.StakeExistingDepositsOnHostZones(ctx, epochNumber, depositRecords)
 
 		// Reinvest staking rewards
-		if epochNumber%reinvestInterval == 0 { // allow a few blocks from UpdateUndelegatedBal to avoid conflicts
-			k.ReinvestRewards(ctx)
-		}
+		k.ReinvestRewards(ctx, epochNumber)
 
 		// Rebalance stake according to validator weights
-		// This should only be run once per day, but it should not be run on a stride epoch that
+		// By default, this is run once per day, but it should not be run on a stride epoch that
 		//   overlaps the day epoch, otherwise the unbondings could cause a redelegation to fail
 		// On mainnet, the stride epoch overlaps the day epoch when `epochNumber % 4 == 1`,
 		//   so this will trigger the epoch before the unbonding
-		if epochNumber%StrideEpochsPerDayEpoch == 0 {
-			k.RebalanceAllHostZones(ctx)
-		}
+		k.RebalanceAllHostZones(ctx, epochNumber)
 
 		// Check previous epochs to see if unbondings finished, and sends the relevant tokens
 		// to the redemption account
@@ -107,6 +95,11 @@ func (k Keeper) Hooks() Hooks {
 	return Hooks{k}
 }
 
+// GetModuleName returns the module name, used by the epochs module for events and telemetry
+func (h Hooks) GetModuleName() string {
+	return types.ModuleName
+}
+
 // epochs hooks
 func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInfo) {
 	h.k.BeforeEpochStart(ctx, epochInfo)
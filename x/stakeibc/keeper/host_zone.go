@@ -49,6 +49,28 @@ func (k Keeper) GetActiveHostZone(ctx sdk.Context, chainId string) (hostZone typ
 	return hostZone, nil
 }
 
+// Returns the cadence of each epochly task on the host zone, with any interval that
+// was not overridden on the host zone falling back to the module-wide default
+func (k Keeper) GetHostZoneEpochCadence(ctx sdk.Context, hostZone types.HostZone) types.EpochCadence {
+	cadence := hostZone.EpochCadence
+	if cadence.DepositInterval == 0 {
+		cadence.DepositInterval = k.GetParam(ctx, types.KeyDepositInterval)
+	}
+	if cadence.DelegateInterval == 0 {
+		cadence.DelegateInterval = k.GetParam(ctx, types.KeyDelegateInterval)
+	}
+	if cadence.ReinvestInterval == 0 {
+		cadence.ReinvestInterval = k.GetParam(ctx, types.KeyReinvestInterval)
+	}
+	if cadence.RedemptionRateInterval == 0 {
+		cadence.RedemptionRateInterval = k.GetParam(ctx, types.KeyRedemptionRateInterval)
+	}
+	if cadence.RebalanceInterval == 0 {
+		cadence.RebalanceInterval = k.GetStrideEpochsPerDayEpoch(ctx)
+	}
+	return cadence
+}
+
 // GetHostZoneFromHostDenom returns a HostZone from a HostDenom
 func (k Keeper) GetHostZoneFromHostDenom(ctx sdk.Context, denom string) (*types.HostZone, error) {
 	var matchZone types.HostZone
@@ -174,6 +196,9 @@ func (k Keeper) UnregisterHostZone(ctx sdk.Context, chainId string) error {
 	// Remove whitelisted address pairs from rate limit module
 	rewardCollectorAddress := k.AccountKeeper.GetModuleAccount(ctx, types.RewardCollectorName).GetAddress()
 	k.RatelimitKeeper.RemoveWhitelistedAddressPair(ctx, hostZone.DepositAddress, hostZone.DelegationIcaAddress)
+	for _, shard := range hostZone.DelegationShards {
+		k.RatelimitKeeper.RemoveWhitelistedAddressPair(ctx, hostZone.DepositAddress, shard.IcaAddress)
+	}
 	k.RatelimitKeeper.RemoveWhitelistedAddressPair(ctx, hostZone.FeeIcaAddress, rewardCollectorAddress.String())
 	k.RatelimitKeeper.RemoveWhitelistedAddressPair(ctx, hostZone.CommunityPoolDepositIcaAddress, hostZone.CommunityPoolStakeHoldingAddress)
 	k.RatelimitKeeper.RemoveWhitelistedAddressPair(ctx, hostZone.CommunityPoolDepositIcaAddress, hostZone.CommunityPoolRedeemHoldingAddress)
@@ -206,6 +231,24 @@ func (k Keeper) GetAllActiveHostZone(ctx sdk.Context) (list []types.HostZone) {
 	return
 }
 
+// Halts a host zone and blacklists its stToken in the rate limit module,
+// which blocks all stToken transfers until the zone is resumed
+func (k Keeper) HaltHostZone(ctx sdk.Context, hostZone types.HostZone) {
+	hostZone.Halted = true
+	k.SetHostZone(ctx, hostZone)
+
+	stDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
+	k.RatelimitKeeper.AddDenomToBlacklist(ctx, stDenom)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHostZoneHalt,
+			sdk.NewAttribute(types.AttributeKeyHostZone, hostZone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyRedemptionRate, hostZone.RedemptionRate.String()),
+		),
+	)
+}
+
 // Validate whether a denom is a supported liquid staking token
 func (k Keeper) CheckIsStToken(ctx sdk.Context, denom string) bool {
 	for _, hostZone := range k.GetAllHostZone(ctx) {
@@ -26,6 +26,8 @@ func createNHostZone(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.Host
 		items[i].MaxRedemptionRate = sdk.NewDecWithPrec(15, 1)
 		items[i].MinInnerRedemptionRate = sdk.NewDecWithPrec(5, 1)
 		items[i].MaxInnerRedemptionRate = sdk.NewDecWithPrec(15, 1)
+		items[i].MaxRedemptionRateChangePerEpoch = sdk.ZeroDec()
+		items[i].MaxRedemptionRateChangePerDay = sdk.ZeroDec()
 		items[i].TotalDelegations = sdkmath.ZeroInt()
 		keeper.SetHostZone(ctx, items[i])
 	}
@@ -183,6 +185,45 @@ func (s *KeeperTestSuite) TestGetHostZoneFromTransferChannelID() {
 	s.Require().False(found, "fake channel should not be found")
 }
 
+func (s *KeeperTestSuite) TestGetHostZoneEpochCadence() {
+	// Set the module-wide defaults
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx)
+	params.DepositInterval = 1
+	params.DelegateInterval = 2
+	params.ReinvestInterval = 3
+	params.RedemptionRateInterval = 4
+	s.App.StakeibcKeeper.SetParams(s.Ctx, params)
+
+	// Without any overrides, all intervals should use the defaults
+	// The epoch trackers have not been set, so the rebalance interval uses the default epochs per day
+	expectedCadence := types.EpochCadence{
+		DepositInterval:        1,
+		DelegateInterval:       2,
+		ReinvestInterval:       3,
+		RedemptionRateInterval: 4,
+		RebalanceInterval:      keeper.DefaultStrideEpochsPerDayEpoch,
+	}
+	actualCadence := s.App.StakeibcKeeper.GetHostZoneEpochCadence(s.Ctx, types.HostZone{})
+	s.Require().Equal(expectedCadence, actualCadence, "cadence without overrides")
+
+	// Override some of the intervals on the host zone
+	hostZone := types.HostZone{
+		EpochCadence: types.EpochCadence{
+			DelegateInterval:  6,
+			RebalanceInterval: 24,
+		},
+	}
+	expectedCadence = types.EpochCadence{
+		DepositInterval:        1,
+		DelegateInterval:       6,
+		ReinvestInterval:       3,
+		RedemptionRateInterval: 4,
+		RebalanceInterval:      24,
+	}
+	actualCadence = s.App.StakeibcKeeper.GetHostZoneEpochCadence(s.Ctx, hostZone)
+	s.Require().Equal(expectedCadence, actualCadence, "cadence with overrides")
+}
+
 // Helper function to check the validator's slash query progress and checkpoint after it was incremented
 func (s *KeeperTestSuite) checkValidatorSlashQueryProgress(address string, expectedProgress, expectedCheckpoint sdkmath.Int) {
 	actualHostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
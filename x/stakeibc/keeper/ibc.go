@@ -13,6 +13,7 @@ import (
 
 	ratelimittypes "github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
 
+	"github.com/Stride-Labs/stride/v27/utils"
 	"github.com/Stride-Labs/stride/v27/x/stakeibc/types"
 )
 
@@ -108,7 +109,14 @@ func (k Keeper) StoreHostZoneIcaAddress(ctx sdk.Context, chainId, portId, addres
 	case portId == communityPoolReturnPortID:
 		hostZone.CommunityPoolReturnIcaAddress = address
 	default:
-		k.Logger(ctx).Info(fmt.Sprintf("portId %s has an associated host zone, but does not match any ICA accounts", portId))
+		// Check if the port matches one of the additional delegation shards
+		shardFound, err := k.StoreDelegationShardIcaAddress(ctx, &hostZone, portId, address)
+		if err != nil {
+			return err
+		}
+		if !shardFound {
+			k.Logger(ctx).Info(fmt.Sprintf("portId %s has an associated host zone, but does not match any ICA accounts", portId))
+		}
 		return nil
 	}
 
@@ -158,6 +166,35 @@ func (k Keeper) StoreHostZoneIcaAddress(ctx sdk.Context, chainId, portId, addres
 	return nil
 }
 
+// Checks if the port matches one of the host zone's additional delegation shards, and if so,
+// stores the ICA address on the shard and whitelists epochly transfers to the shard
+func (k Keeper) StoreDelegationShardIcaAddress(ctx sdk.Context, hostZone *types.HostZone, portId, address string) (found bool, err error) {
+	for i, shard := range hostZone.DelegationShards {
+		shardOwner := types.FormatDelegationShardICAOwner(hostZone.ChainId, shard.Index)
+		shardPortId, err := icatypes.NewControllerPortID(shardOwner)
+		if err != nil {
+			return false, err
+		}
+		if portId != shardPortId {
+			continue
+		}
+
+		k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "ICA Address %s found for delegation shard %d", address, shard.Index))
+		hostZone.DelegationShards[i].IcaAddress = address
+		k.SetHostZone(ctx, *hostZone)
+
+		// Epochly transfers go from the deposit address to the delegation shard
+		k.RatelimitKeeper.SetWhitelistedAddressPair(ctx, ratelimittypes.WhitelistedAddressPair{
+			Sender:   hostZone.DepositAddress,
+			Receiver: address,
+		})
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
 // Checks if the port matches an ICA account on the trade route, and if so, stores the
 // relevant ICA address on the trade route
 func (k Keeper) StoreTradeRouteIcaAddress(ctx sdk.Context, callbackChainId, callbackPortId, address string) error {
@@ -192,6 +229,20 @@ func (k Keeper) StoreTradeRouteIcaAddress(ctx sdk.Context, callbackChainId, call
 			route.TradeAccount.Address = address
 		}
 
+		// Check the trade accounts on any additional legs of the route
+		for i, leg := range route.AdditionalLegs {
+			legOwner := route.GetTradeLegICAOwner(i+1, leg.TradeAccount)
+			legPortId, err := icatypes.NewControllerPortID(legOwner)
+			if err != nil {
+				return err
+			}
+
+			if leg.TradeAccount.ChainId == callbackChainId && callbackPortId == legPortId {
+				k.Logger(ctx).Info(fmt.Sprintf("ICA Address %s found for Trade ICA (leg %d) on %s", address, i+1, route.Description()))
+				route.AdditionalLegs[i].TradeAccount.Address = address
+			}
+		}
+
 		k.SetTradeRoute(ctx, route)
 	}
 
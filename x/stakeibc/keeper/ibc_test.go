@@ -206,6 +206,40 @@ func (s *KeeperTestSuite) TestStoreHostZoneIcaAddress() {
 	}
 }
 
+func (s *KeeperTestSuite) TestStoreHostZoneIcaAddress_DelegationShard() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{
+		ChainId:              HostChainId,
+		DepositAddress:       DepositAddress,
+		DelegationIcaAddress: "delegation",
+		DelegationShards:     []types.DelegationShard{{Index: 1}, {Index: 2}},
+	})
+
+	// Store the address for the second shard
+	owner := types.FormatDelegationShardICAOwner(HostChainId, 2)
+	portId, _ := icatypes.NewControllerPortID(owner)
+	err := s.App.StakeibcKeeper.StoreHostZoneIcaAddress(s.Ctx, HostChainId, portId, "shard2")
+	s.Require().NoError(err, "no error expected when storing shard address")
+
+	// Confirm only the second shard was updated
+	hostZone := s.MustGetHostZone(HostChainId)
+	s.Require().Equal("delegation", hostZone.DelegationIcaAddress, "main delegation address")
+	s.Require().Equal("", hostZone.DelegationShards[0].IcaAddress, "shard 1 address")
+	s.Require().Equal("shard2", hostZone.DelegationShards[1].IcaAddress, "shard 2 address")
+
+	// Confirm the deposit address -> shard transfer was whitelisted
+	isWhitelisted := s.App.RatelimitKeeper.IsAddressPairWhitelisted(s.Ctx, DepositAddress, "shard2")
+	s.Require().True(isWhitelisted, "deposit -> shard 2")
+
+	// A port for an unregistered shard should be ignored
+	owner = types.FormatDelegationShardICAOwner(HostChainId, 3)
+	portId, _ = icatypes.NewControllerPortID(owner)
+	err = s.App.StakeibcKeeper.StoreHostZoneIcaAddress(s.Ctx, HostChainId, portId, "shard3")
+	s.Require().NoError(err, "no error expected when storing unregistered shard address")
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Equal("", hostZone.DelegationShards[0].IcaAddress, "shard 1 address after unregistered shard")
+}
+
 // ------------------------------------------
 //      	StoreTradeRouteIcaAddress
 // ------------------------------------------
@@ -292,6 +326,39 @@ func (s *KeeperTestSuite) TestStoreTradeRouteIcaAddress() {
 	s.checkTradeRouteAddressStored(-1) // checks no matches
 }
 
+func (s *KeeperTestSuite) TestStoreTradeRouteIcaAddress_AdditionalLeg() {
+	legChainId := "trade-1"
+	tradeRoute := types.TradeRoute{
+		RewardDenomOnRewardZone: RewardDenom,
+		HostDenomOnHostZone:     HostDenom,
+		TradeAccount: types.ICAAccount{
+			ChainId: HostChainId,
+			Type:    types.ICAAccountType_CONVERTER_TRADE,
+		},
+		AdditionalLegs: []types.TradeLeg{{
+			TradeAccount: types.ICAAccount{
+				ChainId: legChainId,
+				Type:    types.ICAAccountType_CONVERTER_TRADE,
+			},
+		}},
+	}
+	s.App.StakeibcKeeper.SetTradeRoute(s.Ctx, tradeRoute)
+
+	// Build the port from the leg's ICA owner
+	owner := tradeRoute.GetTradeLegICAOwner(1, tradeRoute.AdditionalLegs[0].TradeAccount)
+	portId, err := icatypes.NewControllerPortID(owner)
+	s.Require().NoError(err, "no error expected when building port")
+
+	err = s.App.StakeibcKeeper.StoreTradeRouteIcaAddress(s.Ctx, legChainId, portId, "leg-address")
+	s.Require().NoError(err, "no error expected when storing leg ICA address")
+
+	// Only the additional leg's address should be stored
+	actualRoute, found := s.App.StakeibcKeeper.GetTradeRoute(s.Ctx, RewardDenom, HostDenom)
+	s.Require().True(found, "trade route should have been found")
+	s.Require().Equal("leg-address", actualRoute.AdditionalLegs[0].TradeAccount.Address, "leg trade address")
+	s.Require().Equal("", actualRoute.TradeAccount.Address, "first leg trade address")
+}
+
 // ------------------------------------------
 //         GetLightClientTime
 // ------------------------------------------
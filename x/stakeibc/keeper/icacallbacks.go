@@ -2,6 +2,7 @@ package keeper
 
 import (
 	icacallbackstypes "github.com/Stride-Labs/stride/v27/x/icacallbacks/types"
+	"github.com/Stride-Labs/stride/v27/x/stakeibc/types"
 )
 
 const (
@@ -12,16 +13,50 @@ const (
 	ICACallbackID_Redemption = "redemption"
 	ICACallbackID_Rebalance  = "rebalance"
 	ICACallbackID_Detokenize = "detokenize"
+	ICACallbackID_Swap       = "swap"
 )
 
 func (k Keeper) Callbacks() icacallbackstypes.ModuleCallbacks {
 	return []icacallbackstypes.ICACallback{
-		{CallbackId: ICACallbackID_Delegate, CallbackFunc: icacallbackstypes.ICACallbackFunction(k.DelegateCallback)},
-		{CallbackId: ICACallbackID_Claim, CallbackFunc: icacallbackstypes.ICACallbackFunction(k.ClaimCallback)},
-		{CallbackId: ICACallbackID_Undelegate, CallbackFunc: icacallbackstypes.ICACallbackFunction(k.UndelegateCallback)},
-		{CallbackId: ICACallbackID_Reinvest, CallbackFunc: icacallbackstypes.ICACallbackFunction(k.ReinvestCallback)},
-		{CallbackId: ICACallbackID_Redemption, CallbackFunc: icacallbackstypes.ICACallbackFunction(k.RedemptionCallback)},
-		{CallbackId: ICACallbackID_Rebalance, CallbackFunc: icacallbackstypes.ICACallbackFunction(k.RebalanceCallback)},
-		{CallbackId: ICACallbackID_Detokenize, CallbackFunc: icacallbackstypes.ICACallbackFunction(k.DetokenizeCallback)},
+		{
+			CallbackId:       ICACallbackID_Delegate,
+			CallbackFunc:     icacallbackstypes.ICACallbackFunction(k.DelegateCallback),
+			CallbackArgsType: &types.DelegateCallback{},
+		},
+		{
+			CallbackId:       ICACallbackID_Claim,
+			CallbackFunc:     icacallbackstypes.ICACallbackFunction(k.ClaimCallback),
+			CallbackArgsType: &types.ClaimCallback{},
+		},
+		{
+			CallbackId:       ICACallbackID_Undelegate,
+			CallbackFunc:     icacallbackstypes.ICACallbackFunction(k.UndelegateCallback),
+			CallbackArgsType: &types.UndelegateCallback{},
+		},
+		{
+			CallbackId:       ICACallbackID_Reinvest,
+			CallbackFunc:     icacallbackstypes.ICACallbackFunction(k.ReinvestCallback),
+			CallbackArgsType: &types.ReinvestCallback{},
+		},
+		{
+			CallbackId:       ICACallbackID_Redemption,
+			CallbackFunc:     icacallbackstypes.ICACallbackFunction(k.RedemptionCallback),
+			CallbackArgsType: &types.RedemptionCallback{},
+		},
+		{
+			CallbackId:       ICACallbackID_Rebalance,
+			CallbackFunc:     icacallbackstypes.ICACallbackFunction(k.RebalanceCallback),
+			CallbackArgsType: &types.RebalanceCallback{},
+		},
+		{
+			CallbackId:       ICACallbackID_Detokenize,
+			CallbackFunc:     icacallbackstypes.ICACallbackFunction(k.DetokenizeCallback),
+			CallbackArgsType: &types.DetokenizeSharesCallback{},
+		},
+		{
+			CallbackId:       ICACallbackID_Swap,
+			CallbackFunc:     icacallbackstypes.ICACallbackFunction(k.SwapCallback),
+			CallbackArgsType: &types.SwapCallback{},
+		},
 	}
 }
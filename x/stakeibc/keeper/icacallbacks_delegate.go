@@ -93,7 +93,8 @@ func (k Keeper) DelegateCallback(ctx sdk.Context, packet channeltypes.Packet, ac
 
 	// Update delegations on the validators and host zone
 	for _, splitDelegation := range delegateCallback.SplitDelegations {
-		err := k.AddDelegationToValidator(ctx, &hostZone, splitDelegation.Validator, splitDelegation.Amount, ICACallbackID_Delegate)
+		err := k.AddShardDelegationToValidator(ctx, &hostZone, delegateCallback.DelegationShardIndex,
+			splitDelegation.Validator, splitDelegation.Amount, ICACallbackID_Delegate)
 		if err != nil {
 			return errorsmod.Wrapf(err, "Failed to add delegation to validator")
 		}
@@ -17,7 +17,7 @@ import (
 // ICACallback after an LSM token is detokenized into native stake
 //
 //	If successful: Remove the token deposit from the store and incremenet the validator delegation
-//	If failure: flag the deposit as DETOKENIZATION_FAILED
+//	If failure: request a retry, and once retries are exhausted, flag the deposit as DETOKENIZATION_FAILED
 //	If timeout: do nothing
 //	  - A timeout will force the channel closed, and once the channel is restored,
 //	    the ICA will get resubmitted
@@ -31,6 +31,13 @@ func (k Keeper) DetokenizeCallback(ctx sdk.Context, packet channeltypes.Packet,
 	deposit := detokenizeCallback.Deposit
 	k.Logger(ctx).Info(utils.LogICACallbackWithHostZone(chainId, ICACallbackID_Detokenize, "Starting detokenize callback"))
 
+	// If the ICA failed and can still be retried, leave the deposit and the validator's
+	// delegation change in progress, since the retry re-submits the same ICA
+	if ackResponse.Status == icacallbackstypes.AckResponseStatus_FAILURE && ackResponse.CanRetry {
+		k.Logger(ctx).Error(utils.LogICACallbackWithHostZone(chainId, ICACallbackID_Detokenize, "Retrying detokenization"))
+		return errorsmod.Wrapf(icacallbackstypes.ErrCallbackRetryable, "detokenization of %s failed: %s", deposit.Denom, ackResponse.Error)
+	}
+
 	// Regardless of failure/success/timeout, indicate that this ICA has completed
 	hostZone, found := k.GetHostZone(ctx, chainId)
 	if !found {
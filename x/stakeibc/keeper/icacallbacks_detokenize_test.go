@@ -1,14 +1,18 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 
 	icacallbackstypes "github.com/Stride-Labs/stride/v27/x/icacallbacks/types"
 	icacallbacktypes "github.com/Stride-Labs/stride/v27/x/icacallbacks/types"
 	recordstypes "github.com/Stride-Labs/stride/v27/x/records/types"
+	"github.com/Stride-Labs/stride/v27/x/stakeibc/keeper"
 	"github.com/Stride-Labs/stride/v27/x/stakeibc/types"
 )
 
@@ -160,3 +164,73 @@ func (s *KeeperTestSuite) TestDetokenizeCallback_AckFailure() {
 	s.Require().True(found, "host zone should have been found")
 	s.Require().Equal(0, int(hostZone.Validators[0].DelegationChangesInProgress), "delegation change in progress")
 }
+
+func (s *KeeperTestSuite) TestDetokenizeCallback_AckFailureRetried() {
+	owner := types.FormatHostZoneICAOwner(HostChainId, types.ICAAccountType_DELEGATION)
+	channelId, portId := s.CreateICAChannel(owner)
+
+	// Register the callback for a detokenization sent on the delegation ICA
+	tc := s.SetupTestDetokenizeCallback(icacallbackstypes.AckResponseStatus_FAILURE)
+
+	data, err := icatypes.SerializeCosmosTx(s.App.AppCodec(), []proto.Message{&types.MsgRedeemTokensForShares{
+		DelegatorAddress: "delegator",
+		Amount:           sdk.NewCoin(LSMTokenBaseDenom, sdkmath.NewInt(1000)),
+	}})
+	s.Require().NoError(err, "no error expected when serializing ICA tx")
+	packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data}
+
+	sequence := s.MustGetNextSequenceNumber(portId, channelId)
+	packet := channeltypes.Packet{
+		SourcePort:    portId,
+		SourceChannel: channelId,
+		Sequence:      sequence,
+		Data:          packetData.GetBytes(),
+	}
+	s.App.IcacallbacksKeeper.SetCallbackData(s.Ctx, icacallbackstypes.CallbackData{
+		CallbackKey:  icacallbackstypes.PacketID(portId, channelId, sequence),
+		PortId:       portId,
+		ChannelId:    channelId,
+		Sequence:     sequence,
+		CallbackId:   keeper.ICACallbackID_Detokenize,
+		CallbackArgs: tc.callbackBz,
+	})
+
+	// Fail the ICA through the callbacks keeper - it should be queued for retry without
+	// touching the deposit or the delegation change in progress
+	err = s.App.IcacallbacksKeeper.CallRegisteredICACallback(s.Ctx, packet, tc.ackResponse)
+	s.Require().NoError(err, "no error expected during callback")
+
+	deposit, found := s.App.RecordsKeeper.GetLSMTokenDeposit(s.Ctx, HostChainId, LSMTokenBaseDenom)
+	s.Require().True(found, "deposit should not have been removed")
+	s.Require().Equal(recordstypes.LSMTokenDeposit_DETOKENIZATION_IN_PROGRESS.String(), deposit.Status.String(), "deposit status after failure")
+
+	hostZone := s.MustGetHostZone(HostChainId)
+	s.Require().Equal(1, int(hostZone.Validators[0].DelegationChangesInProgress), "delegation change in progress after failure")
+
+	_, found = s.App.IcacallbacksKeeper.GetCallbackRetry(s.Ctx, icacallbackstypes.PacketID(portId, channelId, sequence))
+	s.Require().True(found, "retry should have been queued")
+
+	// Once the backoff has elapsed, the ICA should be re-submitted on the same channel
+	// (the original packet was never actually sent, so the retry re-uses its sequence number)
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Duration(icacallbackstypes.DefaultRetryBackoffSeconds) * time.Second))
+	s.App.IcacallbacksKeeper.ProcessCallbackRetries(s.Ctx)
+	s.Require().Equal(sequence+1, s.MustGetNextSequenceNumber(portId, channelId), "ICA re-submitted")
+
+	retryCallbackData, found := s.App.IcacallbacksKeeper.GetCallbackData(s.Ctx, icacallbackstypes.PacketID(portId, channelId, sequence))
+	s.Require().True(found, "callback data should have been stored for the retry")
+	s.Require().Equal(keeper.ICACallbackID_Detokenize, retryCallbackData.CallbackId, "retry callback ID")
+
+	// Once retries are exhausted, the failure should flag the deposit and complete the delegation change
+	retryCallbackData.RetryAttempts = icacallbackstypes.DefaultMaxRetryAttempts
+	s.App.IcacallbacksKeeper.SetCallbackData(s.Ctx, retryCallbackData)
+
+	err = s.App.IcacallbacksKeeper.CallRegisteredICACallback(s.Ctx, packet, tc.ackResponse)
+	s.Require().NoError(err, "no error expected during callback")
+
+	deposit, found = s.App.RecordsKeeper.GetLSMTokenDeposit(s.Ctx, HostChainId, LSMTokenBaseDenom)
+	s.Require().True(found, "deposit should not have been removed")
+	s.Require().Equal(recordstypes.LSMTokenDeposit_DETOKENIZATION_FAILED.String(), deposit.Status.String(), "deposit status after retries exhausted")
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Equal(0, int(hostZone.Validators[0].DelegationChangesInProgress), "delegation change in progress after retries exhausted")
+}
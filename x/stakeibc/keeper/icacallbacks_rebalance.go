@@ -99,8 +99,9 @@ func (k Keeper) RebalanceCallback(ctx sdk.Context, packet channeltypes.Packet, a
 
 		// Decrement the delegation from the source validator and increment the delegation
 		// for the destination validator
-		valAddrMap[srcValidator].Delegation = valAddrMap[srcValidator].Delegation.Sub(rebalancing.Amt)
-		valAddrMap[dstValidator].Delegation = valAddrMap[dstValidator].Delegation.Add(rebalancing.Amt)
+		shardIndex := rebalanceCallback.DelegationShardIndex
+		valAddrMap[srcValidator].AddShardDelegation(shardIndex, rebalancing.Amt.Neg())
+		valAddrMap[dstValidator].AddShardDelegation(shardIndex, rebalancing.Amt)
 
 		k.Logger(ctx).Info(utils.LogICACallbackWithHostZone(chainId, ICACallbackID_Rebalance,
 			"  Decrementing delegation on %s by %v", srcValidator, rebalancing.Amt))
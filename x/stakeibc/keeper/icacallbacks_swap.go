@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/Stride-Labs/stride/v27/utils"
+	icacallbackstypes "github.com/Stride-Labs/stride/v27/x/icacallbacks/types"
+	"github.com/Stride-Labs/stride/v27/x/stakeibc/types"
+)
+
+// ICACallback after reward tokens are swapped for host tokens in the trade ICA
+//
+//	If successful: Record the amount of host tokens received and the realized price
+//	If failure/timeout: Flag the trade record as FAILED
+//	  - The reward tokens remain in the trade ICA and will be swapped in the next epoch
+func (k Keeper) SwapCallback(ctx sdk.Context, packet channeltypes.Packet, ackResponse *icacallbackstypes.AcknowledgementResponse, args []byte) error {
+	// Fetch callback args
+	swapCallback := types.SwapCallback{}
+	if err := proto.Unmarshal(args, &swapCallback); err != nil {
+		return errorsmod.Wrapf(types.ErrUnmarshalFailure, "unable to unmarshal swap callback: %s", err.Error())
+	}
+
+	tradeRecord, found := k.GetTradeRecord(ctx, swapCallback.TradeRecordId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrTradeRecordNotFound, "trade record %d not found", swapCallback.TradeRecordId)
+	}
+	routeId := types.GetTradeRouteId(tradeRecord.RewardDenom, tradeRecord.HostDenom)
+	k.Logger(ctx).Info(utils.LogICACallbackWithHostZone(routeId, ICACallbackID_Swap, "Starting swap callback"))
+
+	// The swap is no longer in flight, so the next swap on the leg can be submitted
+	k.RemovePendingSwap(ctx, routeId, tradeRecord.LegIndex)
+
+	// If the ICA timed out or failed, flag the trade as failed
+	if ackResponse.Status == icacallbackstypes.AckResponseStatus_TIMEOUT ||
+		ackResponse.Status == icacallbackstypes.AckResponseStatus_FAILURE {
+		k.Logger(ctx).Error(utils.LogICACallbackStatusWithHostZone(routeId, ICACallbackID_Swap, ackResponse.Status, packet))
+
+		tradeRecord.Status = types.TradeRecord_FAILED
+		k.SetTradeRecord(ctx, tradeRecord)
+		return nil
+	}
+
+	k.Logger(ctx).Info(utils.LogICACallbackStatusWithHostZone(routeId, ICACallbackID_Swap,
+		icacallbackstypes.AckResponseStatus_SUCCESS, packet))
+
+	// Determine the number of host tokens received from the swap
+	if len(ackResponse.MsgResponses) != 1 {
+		return fmt.Errorf("Invalid number of messages (%d) in swap response: %v",
+			len(ackResponse.MsgResponses), ackResponse.MsgResponses)
+	}
+	var swapResponse types.MsgSwapExactAmountInResponse
+	if err := proto.Unmarshal(ackResponse.MsgResponses[0], &swapResponse); err != nil {
+		return err
+	}
+
+	// Record the output and the realized price for auditing
+	tradeRecord.TokenOutAmount = swapResponse.TokenOutAmount
+	if tradeRecord.TokenInAmount.IsPositive() {
+		tradeRecord.RealizedPrice = sdk.NewDecFromInt(swapResponse.TokenOutAmount).Quo(sdk.NewDecFromInt(tradeRecord.TokenInAmount))
+	}
+	tradeRecord.Status = types.TradeRecord_SUCCEEDED
+	k.SetTradeRecord(ctx, tradeRecord)
+
+	return nil
+}
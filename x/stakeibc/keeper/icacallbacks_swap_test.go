@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	icacallbackstypes "github.com/Stride-Labs/stride/v27/x/icacallbacks/types"
+	"github.com/Stride-Labs/stride/v27/x/stakeibc/types"
+)
+
+type SwapCallbackTestCase struct {
+	callbackBz  []byte
+	ackResponse *icacallbackstypes.AcknowledgementResponse
+}
+
+// Helper function to setup the swap ICA callback test
+// Stores a pending trade record and returns the serialized callback args and ack
+func (s *KeeperTestSuite) SetupSwapCallback(ackStatus icacallbackstypes.AckResponseStatus) SwapCallbackTestCase {
+	tradeRecordId := s.App.StakeibcKeeper.AppendTradeRecord(s.Ctx, types.TradeRecord{
+		RewardDenom:       RewardDenom,
+		HostDenom:         HostDenom,
+		TokenInAmount:     sdkmath.NewInt(1000),
+		MinTokenOutAmount: sdkmath.NewInt(1900),
+		TokenOutAmount:    sdkmath.ZeroInt(),
+		OraclePrice:       sdk.NewDec(2),
+		RealizedPrice:     sdk.ZeroDec(),
+		Status:            types.TradeRecord_PENDING,
+	})
+	s.App.StakeibcKeeper.SetPendingSwap(s.Ctx, types.GetTradeRouteId(RewardDenom, HostDenom), 0, tradeRecordId)
+
+	callbackBz, err := proto.Marshal(&types.SwapCallback{TradeRecordId: tradeRecordId})
+	s.Require().NoError(err, "no error expected when marshalling callback args")
+
+	// Message response includes the amount received from the swap
+	swapResponseBz, err := proto.Marshal(&types.MsgSwapExactAmountInResponse{
+		TokenOutAmount: sdkmath.NewInt(1950),
+	})
+	s.Require().NoError(err, "no error expected when marshaling swap response")
+
+	ackResponse := &icacallbackstypes.AcknowledgementResponse{
+		Status:       ackStatus,
+		MsgResponses: [][]byte{swapResponseBz},
+	}
+
+	return SwapCallbackTestCase{
+		callbackBz:  callbackBz,
+		ackResponse: ackResponse,
+	}
+}
+
+func (s *KeeperTestSuite) TestSwapCallback_Successful() {
+	tc := s.SetupSwapCallback(icacallbackstypes.AckResponseStatus_SUCCESS)
+
+	err := s.App.StakeibcKeeper.SwapCallback(s.Ctx, channeltypes.Packet{}, tc.ackResponse, tc.callbackBz)
+	s.Require().NoError(err, "no error expected during callback")
+
+	// Confirm the trade record was updated with the output and realized price
+	tradeRecord, found := s.App.StakeibcKeeper.GetTradeRecord(s.Ctx, 0)
+	s.Require().True(found, "trade record should have been found")
+	s.Require().Equal(types.TradeRecord_SUCCEEDED, tradeRecord.Status, "trade record status")
+	s.Require().Equal(int64(1950), tradeRecord.TokenOutAmount.Int64(), "trade record token out")
+	s.Require().Equal(sdk.MustNewDecFromStr("1.95"), tradeRecord.RealizedPrice, "trade record realized price")
+	s.checkPendingSwapCleared()
+}
+
+// Helper function to confirm the pending swap was cleared from the leg after the callback
+func (s *KeeperTestSuite) checkPendingSwapCleared() {
+	_, found := s.App.StakeibcKeeper.GetPendingSwap(s.Ctx, types.GetTradeRouteId(RewardDenom, HostDenom), 0)
+	s.Require().False(found, "pending swap should have been cleared")
+}
+
+func (s *KeeperTestSuite) TestSwapCallback_AckFailure() {
+	tc := s.SetupSwapCallback(icacallbackstypes.AckResponseStatus_FAILURE)
+
+	err := s.App.StakeibcKeeper.SwapCallback(s.Ctx, channeltypes.Packet{}, tc.ackResponse, tc.callbackBz)
+	s.Require().NoError(err, "no error expected during callback")
+
+	tradeRecord, found := s.App.StakeibcKeeper.GetTradeRecord(s.Ctx, 0)
+	s.Require().True(found, "trade record should have been found")
+	s.Require().Equal(types.TradeRecord_FAILED, tradeRecord.Status, "trade record status")
+	s.Require().Equal(int64(0), tradeRecord.TokenOutAmount.Int64(), "trade record token out")
+	s.checkPendingSwapCleared()
+}
+
+func (s *KeeperTestSuite) TestSwapCallback_AckTimeout() {
+	tc := s.SetupSwapCallback(icacallbackstypes.AckResponseStatus_TIMEOUT)
+
+	err := s.App.StakeibcKeeper.SwapCallback(s.Ctx, channeltypes.Packet{}, tc.ackResponse, tc.callbackBz)
+	s.Require().NoError(err, "no error expected during callback")
+
+	tradeRecord, found := s.App.StakeibcKeeper.GetTradeRecord(s.Ctx, 0)
+	s.Require().True(found, "trade record should have been found")
+	s.Require().Equal(types.TradeRecord_FAILED, tradeRecord.Status, "trade record status")
+	s.checkPendingSwapCleared()
+}
+
+func (s *KeeperTestSuite) TestSwapCallback_InvalidCallbackArgs() {
+	tc := s.SetupSwapCallback(icacallbackstypes.AckResponseStatus_SUCCESS)
+
+	err := s.App.StakeibcKeeper.SwapCallback(s.Ctx, channeltypes.Packet{}, tc.ackResponse, []byte{1, 2, 3})
+	s.Require().ErrorContains(err, "unable to unmarshal swap callback")
+}
+
+func (s *KeeperTestSuite) TestSwapCallback_TradeRecordNotFound() {
+	tc := s.SetupSwapCallback(icacallbackstypes.AckResponseStatus_SUCCESS)
+
+	invalidCallbackBz, err := proto.Marshal(&types.SwapCallback{TradeRecordId: 100})
+	s.Require().NoError(err, "no error expected when marshalling callback args")
+
+	err = s.App.StakeibcKeeper.SwapCallback(s.Ctx, channeltypes.Packet{}, tc.ackResponse, invalidCallbackBz)
+	s.Require().ErrorContains(err, "trade record not found")
+}
@@ -25,7 +25,8 @@ import (
 //	If timeout:
 //	  * Does nothing
 //	If failure:
-//	  * Sets epoch unbonding record status to RETRY
+//	  * Requests a retry of the ICA from the icacallbacks module
+//	  * Once retries are exhausted, sets epoch unbonding record status to RETRY
 func (k Keeper) UndelegateCallback(ctx sdk.Context, packet channeltypes.Packet, ackResponse *icacallbackstypes.AcknowledgementResponse, args []byte) error {
 	// Fetch callback args
 	var undelegateCallback types.UndelegateCallback
@@ -43,6 +44,14 @@ func (k Keeper) UndelegateCallback(ctx sdk.Context, packet channeltypes.Packet,
 		return errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "Host zone not found: %s", undelegateCallback.HostZoneId)
 	}
 
+	// If the ICA failed and can still be retried, leave the unbonding records and the validators'
+	// delegation changes in progress, since the retry re-submits the same ICA
+	if ackResponse.Status == icacallbackstypes.AckResponseStatus_FAILURE && ackResponse.CanRetry {
+		k.Logger(ctx).Error(utils.LogICACallbackWithHostZone(chainId, ICACallbackID_Undelegate, "Retrying undelegation"))
+		return errorsmod.Wrapf(icacallbackstypes.ErrCallbackRetryable, "undelegation for epochs %v failed: %s",
+			undelegateCallback.EpochUnbondingRecordIds, ackResponse.Error)
+	}
+
 	// Mark that the ICA completed on the validators and host zone unbonding records
 	if err := k.MarkUndelegationAckReceived(ctx, hostZone, undelegateCallback); err != nil {
 		return err
@@ -56,8 +65,8 @@ func (k Keeper) UndelegateCallback(ctx sdk.Context, packet channeltypes.Packet,
 		return nil
 	}
 
-	// Check for a failed transaction (ack error)
-	// Set the status to RETRY_QUEUE if it fails
+	// Check for a failed transaction (ack error) that can no longer be retried
+	// Set the status to RETRY_QUEUE so the unbonding is re-attempted in the next unbonding epoch
 	if ackResponse.Status == icacallbackstypes.AckResponseStatus_FAILURE {
 		k.Logger(ctx).Error(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_Undelegate,
 			icacallbackstypes.AckResponseStatus_FAILURE, packet))
@@ -163,7 +172,8 @@ func (k Keeper) HandleFailedUndelegation(ctx sdk.Context, chainId string, epochN
 func (k Keeper) UpdateDelegationBalances(ctx sdk.Context, hostZone types.HostZone, undelegateCallback types.UndelegateCallback) error {
 	// Undelegate from each validator and update host zone staked balance, if successful
 	for _, undelegation := range undelegateCallback.SplitUndelegations {
-		err := k.AddDelegationToValidator(ctx, &hostZone, undelegation.Validator, undelegation.NativeTokenAmount.Neg(), ICACallbackID_Undelegate)
+		err := k.AddShardDelegationToValidator(ctx, &hostZone, undelegateCallback.DelegationShardIndex,
+			undelegation.Validator, undelegation.NativeTokenAmount.Neg(), ICACallbackID_Undelegate)
 		if err != nil {
 			return err
 		}
@@ -271,6 +271,36 @@ func (s *KeeperTestSuite) TestUndelegateCallback_AckFailure() {
 	s.checkStateIfUndelegateCallbackFailed(tc, invalidArgs.ackResponse.Status)
 }
 
+func (s *KeeperTestSuite) TestUndelegateCallback_AckFailureRetried() {
+	tc := s.SetupUndelegateCallback()
+
+	// If the failed ICA can still be retried, a retry should be requested
+	invalidArgs := tc.validArgs
+	invalidArgs.ackResponse.Status = icacallbacktypes.AckResponseStatus_FAILURE
+	invalidArgs.ackResponse.CanRetry = true
+
+	err := s.App.StakeibcKeeper.UndelegateCallback(s.Ctx, invalidArgs.packet, invalidArgs.ackResponse, invalidArgs.args)
+	s.Require().ErrorIs(err, icacallbacktypes.ErrCallbackRetryable, "retry should be requested")
+
+	// The delegation changes and undelegations should still be in progress since the ICA will be re-submitted
+	hostZone := s.MustGetHostZone(HostChainId)
+	s.Require().Equal(1, int(hostZone.Validators[0].DelegationChangesInProgress), "val1 delegation changes in progress")
+	s.Require().Equal(1, int(hostZone.Validators[1].DelegationChangesInProgress), "val2 delegation changes in progress")
+	s.Require().Equal(tc.initialState.totalDelegations, hostZone.TotalDelegations, "total delegations")
+
+	for _, epochNumber := range tc.initialState.epochNumbers {
+		hzu := s.MustGetHostZoneUnbonding(epochNumber, HostChainId)
+		s.Require().Equal(recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS, hzu.Status, "hzu status")
+		s.Require().Equal(1, int(hzu.UndelegationTxsInProgress), "hzu undelegations in progress")
+	}
+
+	// Once retries are exhausted, the records should fall back to the retry queue
+	invalidArgs.ackResponse.CanRetry = false
+	err = s.App.StakeibcKeeper.UndelegateCallback(s.Ctx, invalidArgs.packet, invalidArgs.ackResponse, invalidArgs.args)
+	s.Require().NoError(err, "undelegate callback succeeds once retries are exhausted")
+	s.checkStateIfUndelegateCallbackFailed(tc, invalidArgs.ackResponse.Status)
+}
+
 func (s *KeeperTestSuite) TestUndelegateCallback_WrongCallbackArgs() {
 	tc := s.SetupUndelegateCallback()
 
@@ -11,10 +11,12 @@ const (
 	ICQCallbackID_FeeBalance              = "feebalance"
 	ICQCallbackID_Delegation              = "delegation"
 	ICQCallbackID_Validator               = "validator"
+	ICQCallbackID_Validators              = "validators"
 	ICQCallbackID_Calibrate               = "calibrate"
 	ICQCallbackID_CommunityPoolIcaBalance = "communitypoolicabalance"
 	ICQCallbackID_WithdrawalRewardBalance = "withdrawalrewardbalance"
 	ICQCallbackID_TradeConvertedBalance   = "tradeconvertedbalance"
+	ICQCallbackID_TradeRewardBalance      = "traderewardbalance"
 )
 
 // ICQCallbacks wrapper struct for stakeibc keeper
@@ -51,8 +53,10 @@ func (c ICQCallbacks) RegisterICQCallbacks() icqtypes.QueryCallbacks {
 		AddICQCallback(ICQCallbackID_FeeBalance, ICQCallback(FeeBalanceCallback)).
 		AddICQCallback(ICQCallbackID_Delegation, ICQCallback(DelegatorSharesCallback)).
 		AddICQCallback(ICQCallbackID_Validator, ICQCallback(ValidatorSharesToTokensRateCallback)).
+		AddICQCallback(ICQCallbackID_Validators, ICQCallback(ValidatorsSharesToTokensRatesCallback)).
 		AddICQCallback(ICQCallbackID_Calibrate, ICQCallback(CalibrateDelegationCallback)).
 		AddICQCallback(ICQCallbackID_CommunityPoolIcaBalance, ICQCallback(CommunityPoolIcaBalanceCallback)).
 		AddICQCallback(ICQCallbackID_WithdrawalRewardBalance, ICQCallback(WithdrawalRewardBalanceCallback)).
-		AddICQCallback(ICQCallbackID_TradeConvertedBalance, ICQCallback(TradeConvertedBalanceCallback))
+		AddICQCallback(ICQCallbackID_TradeConvertedBalance, ICQCallback(TradeConvertedBalanceCallback)).
+		AddICQCallback(ICQCallbackID_TradeRewardBalance, ICQCallback(TradeRewardBalanceCallback))
 }
@@ -4,6 +4,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/Stride-Labs/stride/v27/utils"
 	icqtypes "github.com/Stride-Labs/stride/v27/x/interchainquery/types"
@@ -44,6 +45,13 @@ func CalibrateDelegationCallback(k Keeper, ctx sdk.Context, args []byte, query i
 	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_Calibrate, "Query response - Delegator: %s, Validator: %s, Shares: %v",
 		queriedDelegation.DelegatorAddress, queriedDelegation.ValidatorAddress, queriedDelegation.Shares))
 
+	// Unmarshal the callback data containing the delegation shard that was queried
+	var callbackData types.CalibrateDelegationQueryCallback
+	if err := proto.Unmarshal(query.CallbackData, &callbackData); err != nil {
+		return errorsmod.Wrapf(err, "unable to unmarshal calibrate delegation callback data")
+	}
+	shardIndex := callbackData.DelegationShardIndex
+
 	// Grab the validator object from the hostZone using the address returned from the query
 	validator, valIndex, found := GetValidatorFromAddress(hostZone.Validators, queriedDelegation.ValidatorAddress)
 	if !found {
@@ -53,11 +61,12 @@ func CalibrateDelegationCallback(k Keeper, ctx sdk.Context, args []byte, query i
 	// Calculate the number of tokens delegated (using the internal sharesToTokensRate)
 	// note: truncateInt per https://github.com/cosmos/cosmos-sdk/blob/cb31043d35bad90c4daa923bb109f38fd092feda/x/staking/types/validator.go#L431
 	delegatedTokens := queriedDelegation.Shares.Mul(validator.SharesToTokensRate).TruncateInt()
+	shardDelegation := validator.GetShardDelegation(shardIndex)
 	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_Calibrate,
-		"Previous Delegation: %v, Current Delegation: %v", validator.Delegation, delegatedTokens))
+		"Shard %d - Previous Delegation: %v, Current Delegation: %v", shardIndex, shardDelegation, delegatedTokens))
 
 	// Confirm the validator has actually been slashed
-	if delegatedTokens.Equal(validator.Delegation) {
+	if delegatedTokens.Equal(shardDelegation) {
 		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_Calibrate, "Validator delegation is correct"))
 		return nil
 	}
@@ -65,13 +74,13 @@ func CalibrateDelegationCallback(k Keeper, ctx sdk.Context, args []byte, query i
 	// if the delegation change is more than the calibration threshold constant,
 	// return nil so the query submission succeeds
 	// Note: There should be no stateful changes above this line
-	delegationChange := validator.Delegation.Sub(delegatedTokens)
+	delegationChange := shardDelegation.Sub(delegatedTokens)
 	if delegationChange.Abs().GT(CalibrationThreshold) {
 		k.Logger(ctx).Error(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_Calibrate,
 			"Delegation change is GT CalibrationThreshold, failing calibration callback"))
 		return nil
 	}
-	validator.Delegation = validator.Delegation.Sub(delegationChange)
+	validator.AddShardDelegation(shardIndex, delegationChange.Neg())
 	hostZone.TotalDelegations = hostZone.TotalDelegations.Sub(delegationChange)
 
 	hostZone.Validators[valIndex] = &validator
@@ -74,6 +74,7 @@ func DelegatorSharesCallback(k Keeper, ctx sdk.Context, args []byte, query icqty
 		// Store the updated validator delegation amount
 		callbackDataBz, err := proto.Marshal(&types.DelegatorSharesQueryCallback{
 			InitialValidatorDelegation: currInternalDelegation,
+			DelegationShardIndex:       callbackData.DelegationShardIndex,
 		})
 		if err != nil {
 			return errorsmod.Wrapf(err, "unable to marshal delegator shares callback data")
@@ -93,7 +94,8 @@ func DelegatorSharesCallback(k Keeper, ctx sdk.Context, args []byte, query icqty
 	k.SetHostZone(ctx, hostZone)
 
 	// Confirm the validator was slashed by looking at the number of tokens associated with the delegation
-	validatorWasSlashed, delegatedTokens, err := k.CheckForSlash(ctx, hostZone, valIndex, queriedDelegation)
+	shardIndex := callbackData.DelegationShardIndex
+	validatorWasSlashed, delegatedTokens, err := k.CheckForSlash(ctx, hostZone, valIndex, shardIndex, queriedDelegation)
 	if err != nil {
 		return err
 	}
@@ -103,7 +105,7 @@ func DelegatorSharesCallback(k Keeper, ctx sdk.Context, args []byte, query icqty
 	}
 
 	// If the validator was slashed and the query did not overlap any ICAs, update the internal record keeping
-	if err := k.SlashValidatorOnHostZone(ctx, hostZone, valIndex, delegatedTokens); err != nil {
+	if err := k.SlashValidatorOnHostZone(ctx, hostZone, valIndex, shardIndex, delegatedTokens); err != nil {
 		return err
 	}
 
@@ -157,6 +159,8 @@ func (k Keeper) CheckDelegationChangedDuringQuery(
 
 // Check if a slash occured by comparing the validator's sharesToTokens rate and delegator shares
 // from the query responses (tokens = shares * sharesToTokensRate)
+// The queried delegation is from a single delegation shard, so it is compared against
+// that shard's portion of the validator's delegation
 //
 // If the change in delegation only differs by a small precision error, it was likely
 // due to an decimal -> int truncation that occurs during unbonding. In this case, still update the validator
@@ -166,19 +170,21 @@ func (k Keeper) CheckForSlash(
 	ctx sdk.Context,
 	hostZone types.HostZone,
 	valIndex int64,
+	shardIndex uint32,
 	queriedDelegation stakingtypes.Delegation,
 ) (validatorWasSlashed bool, delegatedTokens sdkmath.Int, err error) {
 	chainId := hostZone.ChainId
 	validator := hostZone.Validators[valIndex]
+	shardDelegation := validator.GetShardDelegation(shardIndex)
 
 	// Calculate the number of tokens delegated (using the internal sharesToTokensRate)
 	// note: truncateInt per https://github.com/cosmos/cosmos-sdk/blob/cb31043d35bad90c4daa923bb109f38fd092feda/x/staking/types/validator.go#L431
 	delegatedTokens = queriedDelegation.Shares.Mul(validator.SharesToTokensRate).TruncateInt()
 	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_Delegation,
-		"Previous Delegation: %v, Current Delegation: %v", validator.Delegation, delegatedTokens))
+		"Shard %d - Previous Delegation: %v, Current Delegation: %v", shardIndex, shardDelegation, delegatedTokens))
 
 	// Confirm the validator has actually been slashed
-	if delegatedTokens.Equal(validator.Delegation) {
+	if delegatedTokens.Equal(shardDelegation) {
 		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_Delegation, "Validator was not slashed"))
 		return false, delegatedTokens, nil
 	}
@@ -186,10 +192,10 @@ func (k Keeper) CheckForSlash(
 	// If the true delegation is slightly higher than our record keeping, this could be due to float imprecision
 	// Correct record keeping accordingly
 	precisionErrorThreshold := sdkmath.NewInt(1000)
-	precisionError := delegatedTokens.Sub(validator.Delegation)
+	precisionError := delegatedTokens.Sub(shardDelegation)
 	if precisionError.IsPositive() && precisionError.LTE(precisionErrorThreshold) {
 		// Update the validator on the host zone
-		validator.Delegation = validator.Delegation.Add(precisionError)
+		validator.AddShardDelegation(shardIndex, precisionError)
 		hostZone.TotalDelegations = hostZone.TotalDelegations.Add(precisionError)
 
 		hostZone.Validators[valIndex] = validator
@@ -202,7 +208,7 @@ func (k Keeper) CheckForSlash(
 	}
 
 	// If the delegation returned from the query is much higher than our record keeping, exit with an error
-	if delegatedTokens.GT(validator.Delegation) {
+	if delegatedTokens.GT(shardDelegation) {
 		return false, delegatedTokens, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
 			"Validator (%s) tokens returned from query is greater than the Delegation", validator.Address)
 	}
@@ -211,33 +217,53 @@ func (k Keeper) CheckForSlash(
 }
 
 // Update the accounting on the host zone and validator to record the slash
+// The slash percentage is determined from the queried delegation shard, and since a slash
+// affects every delegator to the validator equally, the same percentage is applied to each shard
 // NOTE: we assume any decrease in delegation amt that's not tracked via records is a slash
-func (k Keeper) SlashValidatorOnHostZone(ctx sdk.Context, hostZone types.HostZone, valIndex int64, delegatedTokens sdkmath.Int) error {
+func (k Keeper) SlashValidatorOnHostZone(
+	ctx sdk.Context,
+	hostZone types.HostZone,
+	valIndex int64,
+	shardIndex uint32,
+	delegatedTokens sdkmath.Int,
+) error {
 	chainId := hostZone.ChainId
 	validator := hostZone.Validators[valIndex]
+	shardDelegation := validator.GetShardDelegation(shardIndex)
 
-	// There is a check upstream to verify that validator.Delegation is not 0
+	// There is a check upstream to verify that the shard delegation is not 0
 	// This check is to explicitly avoid a division by zero error
-	if validator.Delegation.IsZero() {
+	if shardDelegation.IsZero() {
 		return errorsmod.Wrapf(types.ErrDivisionByZero, "Zero Delegation has caused division by zero from validator, %+v", validator)
 	}
 
 	// Get slash percentage
-	slashAmount := validator.Delegation.Sub(delegatedTokens)
-	slashPct := sdk.NewDecFromInt(slashAmount).Quo(sdk.NewDecFromInt(validator.Delegation))
+	shardSlashAmount := shardDelegation.Sub(delegatedTokens)
+	slashPct := sdk.NewDecFromInt(shardSlashAmount).Quo(sdk.NewDecFromInt(shardDelegation))
 	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_Delegation,
-		"Validator was slashed! Validator: %s, Delegator: %s, Delegation in State: %v, Delegation from ICQ %v, Slash Amount: %v, Slash Pct: %v",
-		validator.Address, hostZone.DelegationIcaAddress, validator.Delegation, delegatedTokens, slashAmount, slashPct))
+		"Validator was slashed! Validator: %s, Shard: %d, Delegation in State: %v, Delegation from ICQ %v, Slash Amount: %v, Slash Pct: %v",
+		validator.Address, shardIndex, shardDelegation, delegatedTokens, shardSlashAmount, slashPct))
 
 	// Update the validator weight and delegation reflect to reflect the slash
 	weight, err := cast.ToInt64E(validator.Weight)
 	if err != nil {
 		return errorsmod.Wrapf(types.ErrIntCast, "unable to convert validator weight to int64, err: %s", err.Error())
 	}
-	weightAdjustment := sdk.NewDecFromInt(delegatedTokens).Quo(sdk.NewDecFromInt(validator.Delegation))
+	weightAdjustment := sdk.NewDecFromInt(delegatedTokens).Quo(sdk.NewDecFromInt(shardDelegation))
 
 	validator.Weight = sdk.NewDec(weight).Mul(weightAdjustment).TruncateInt().Uint64()
-	validator.Delegation = validator.Delegation.Sub(slashAmount)
+
+	// Apply the slash to each shard's delegation, using the queried amount for the queried shard
+	slashAmount := sdkmath.ZeroInt()
+	for _, index := range hostZone.GetDelegationShardIndexes() {
+		delegationBeforeSlash := validator.GetShardDelegation(index)
+		delegationAfterSlash := delegatedTokens
+		if index != shardIndex {
+			delegationAfterSlash = sdk.NewDecFromInt(delegationBeforeSlash).Mul(weightAdjustment).TruncateInt()
+		}
+		validator.AddShardDelegation(index, delegationAfterSlash.Sub(delegationBeforeSlash))
+		slashAmount = slashAmount.Add(delegationBeforeSlash.Sub(delegationAfterSlash))
+	}
 
 	// Update the validator on the host zone
 	hostZone.TotalDelegations = hostZone.TotalDelegations.Sub(slashAmount)
@@ -16,7 +16,8 @@ import (
 
 // TradeConvertedBalanceCallback is a callback handler for TradeConvertedBalance queries.
 // The query response will return the trade account balance for a converted (foreign ibc) denom
-// If the balance is non-zero, ICA MsgSends are submitted to transfer the discovered balance back to hostZone
+// If the balance is non-zero, ICA MsgTransfers are submitted to transfer the discovered balance to the next
+// trade leg, or, from the final leg, back to hostZone
 //
 // Note: for now, to get proofs in your ICQs, you need to query the entire store on the host zone! e.g. "store/bank/key"
 func TradeConvertedBalanceCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
@@ -43,6 +44,11 @@ func TradeConvertedBalanceCallback(k Keeper, ctx sdk.Context, args []byte, query
 		return types.ErrTradeRouteNotFound.Wrapf("trade route from %s to %s not found",
 			tradeRouteCallback.RewardDenom, tradeRouteCallback.HostDenom)
 	}
+	legIndex := int(tradeRouteCallback.LegIndex)
+	leg, found := tradeRoute.GetTradeLeg(legIndex)
+	if !found {
+		return types.ErrTradeRouteNotFound.Wrapf("trade leg %d not found for %s", legIndex, tradeRoute.Description())
+	}
 
 	// Confirm the balance is greater than zero, or else exit with no further action
 	if tradeConvertedBalanceAmount.LTE(sdkmath.ZeroInt()) {
@@ -51,14 +57,15 @@ func TradeConvertedBalanceCallback(k Keeper, ctx sdk.Context, args []byte, query
 		return nil
 	}
 
-	// Using ICA commands on the trade address, transfer the found converted tokens from the trade zone to the host zone
-	if err := k.TransferConvertedTokensTradeToHost(ctx, tradeConvertedBalanceAmount, tradeRoute); err != nil {
-		return errorsmod.Wrapf(err, "initiating transfer of converted tokens to back to host zone failed")
+	// Using ICA commands on the trade address, transfer the found converted tokens from the trade zone to
+	// the next leg's trade zone, or, if this is the final leg, back to the host zone
+	if err := k.TransferConvertedTokens(ctx, tradeConvertedBalanceAmount, tradeRoute, legIndex); err != nil {
+		return errorsmod.Wrapf(err, "initiating transfer of converted tokens from leg %d failed", legIndex)
 	}
 
 	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_TradeConvertedBalance,
-		"Sending discovered converted tokens %v %s from tradeZone back to hostZone",
-		tradeConvertedBalanceAmount, tradeRoute.HostDenomOnTradeZone))
+		"Sending discovered converted tokens %v %s from leg %d tradeZone (final leg: %v)",
+		tradeConvertedBalanceAmount, leg.OutputDenomOnTradeZone, legIndex, tradeRoute.IsFinalTradeLeg(legIndex)))
 
 	return nil
 }
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/gogoproto/proto"
+
+	icqkeeper "github.com/Stride-Labs/stride/v27/x/interchainquery/keeper"
+
+	"github.com/Stride-Labs/stride/v27/utils"
+	icqtypes "github.com/Stride-Labs/stride/v27/x/interchainquery/types"
+	"github.com/Stride-Labs/stride/v27/x/stakeibc/types"
+)
+
+// TradeRewardBalanceCallback is a callback handler for TradeRewardBalance queries.
+// The query response will return the leg's trade account balance for the input (foreign ibc) denom
+// If the balance is non-zero, an ICA MsgSwapExactAmountIn is submitted to swap the balance for the leg's
+// output tokens (host tokens on the final leg), with a minimum output derived from the oracle price
+//
+// Note: for now, to get proofs in your ICQs, you need to query the entire store on the host zone! e.g. "store/bank/key"
+func TradeRewardBalanceCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, ICQCallbackID_TradeRewardBalance,
+		"Starting trade reward balance callback, QueryId: %vs, QueryType: %s, Connection: %s", query.Id, query.QueryType, query.ConnectionId))
+
+	chainId := query.ChainId // should be the tradeZoneId
+
+	// Unmarshal the query response args to determine the balance
+	tradeRewardBalanceAmount, err := icqkeeper.UnmarshalAmountFromBalanceQuery(k.cdc, args)
+	if err != nil {
+		return errorsmod.Wrap(err, "unable to determine balance from query response")
+	}
+
+	// Unmarshal the callback data containing the tradeRoute we are on
+	var tradeRouteCallback types.TradeRouteCallback
+	if err := proto.Unmarshal(query.CallbackData, &tradeRouteCallback); err != nil {
+		return errorsmod.Wrapf(err, "unable to unmarshal trade reward balance callback data")
+	}
+
+	// Lookup the trade route from the keys in the callback
+	tradeRoute, found := k.GetTradeRoute(ctx, tradeRouteCallback.RewardDenom, tradeRouteCallback.HostDenom)
+	if !found {
+		return types.ErrTradeRouteNotFound.Wrapf("trade route from %s to %s not found",
+			tradeRouteCallback.RewardDenom, tradeRouteCallback.HostDenom)
+	}
+	legIndex := int(tradeRouteCallback.LegIndex)
+	leg, found := tradeRoute.GetTradeLeg(legIndex)
+	if !found {
+		return types.ErrTradeRouteNotFound.Wrapf("trade leg %d not found for %s", legIndex, tradeRoute.Description())
+	}
+
+	// Confirm the balance is greater than zero, or else exit with no further action
+	if tradeRewardBalanceAmount.LTE(sdkmath.ZeroInt()) {
+		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_TradeRewardBalance,
+			"Not enough balance of reward tokens yet, balance: %v", tradeRewardBalanceAmount))
+		return nil
+	}
+
+	// Using ICA commands on the trade address, swap the reward tokens for host tokens
+	if err := k.SwapRewardTokens(ctx, tradeRewardBalanceAmount, tradeRoute, legIndex); err != nil {
+		return errorsmod.Wrapf(err, "initiating swap of reward tokens failed")
+	}
+
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_TradeRewardBalance,
+		"Swapping discovered reward tokens %v %s for %s (leg %d)",
+		tradeRewardBalanceAmount, leg.InputDenomOnTradeZone, leg.OutputDenomOnTradeZone, legIndex))
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	icqtypes "github.com/Stride-Labs/stride/v27/x/interchainquery/types"
+	"github.com/Stride-Labs/stride/v27/x/stakeibc/keeper"
+	"github.com/Stride-Labs/stride/v27/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) SetupTradeRewardBalanceCallbackTestCase() BalanceQueryCallbackTestCase {
+	route, channelId, portId := s.SetupSwapRewardTokensTestCase()
+
+	// Build query object and serialized query response
+	balance := sdkmath.NewInt(500)
+	callbackDataBz, _ := proto.Marshal(&types.TradeRouteCallback{
+		RewardDenom: RewardDenom,
+		HostDenom:   HostDenom,
+	})
+	query := icqtypes.Query{CallbackData: callbackDataBz}
+	queryResponse := s.CreateBalanceQueryResponse(balance.Int64(), route.RewardDenomOnTradeZone)
+
+	return BalanceQueryCallbackTestCase{
+		TradeRoute: route,
+		Balance:    balance,
+		Response: ICQCallbackArgs{
+			Query:        query,
+			CallbackArgs: queryResponse,
+		},
+		ChannelID: channelId,
+		PortID:    portId,
+	}
+}
+
+// Verify that a normal TradeRewardBalanceCallback fires off the swap ICA and records the trade
+func (s *KeeperTestSuite) TestTradeRewardBalanceCallback_Successful() {
+	tc := s.SetupTradeRewardBalanceCallbackTestCase()
+
+	// Check that the ICA was submitted from within the ICQ callback
+	s.CheckICATxSubmitted(tc.PortID, tc.ChannelID, func() error {
+		return keeper.TradeRewardBalanceCallback(s.App.StakeibcKeeper, s.Ctx, tc.Response.CallbackArgs, tc.Response.Query)
+	})
+
+	// Check that the trade was recorded
+	tradeRecords := s.App.StakeibcKeeper.GetAllTradeRecords(s.Ctx)
+	s.Require().Len(tradeRecords, 1, "one trade record should have been created")
+	s.Require().Equal(tc.Balance.Int64(), tradeRecords[0].TokenInAmount.Int64(), "trade record token in")
+}
+
+func (s *KeeperTestSuite) TestTradeRewardBalanceCallback_ZeroBalance() {
+	tc := s.SetupTradeRewardBalanceCallbackTestCase()
+
+	// Replace the query response with a coin that has a zero amount
+	tc.Response.CallbackArgs = s.CreateBalanceQueryResponse(0, tc.TradeRoute.RewardDenomOnTradeZone)
+
+	s.CheckICATxNotSubmitted(tc.PortID, tc.ChannelID, func() error {
+		return keeper.TradeRewardBalanceCallback(s.App.StakeibcKeeper, s.Ctx, tc.Response.CallbackArgs, tc.Response.Query)
+	})
+}
+
+func (s *KeeperTestSuite) TestTradeRewardBalanceCallback_StaleOracle() {
+	tc := s.SetupTradeRewardBalanceCallbackTestCase()
+
+	// Make the oracle price stale so the swap is refused
+	s.setRewardOraclePrice(sdk.NewDec(2), s.Ctx.BlockTime().Add(-time.Hour*24*365))
+
+	err := keeper.TradeRewardBalanceCallback(s.App.StakeibcKeeper, s.Ctx, tc.Response.CallbackArgs, tc.Response.Query)
+	s.Require().ErrorContains(err, "oracle price unavailable")
+}
+
+func (s *KeeperTestSuite) TestTradeRewardBalanceCallback_InvalidArgs() {
+	tc := s.SetupTradeRewardBalanceCallbackTestCase()
+
+	// Submit callback with invalid callback args (so that it can't unmarshal into a coin)
+	invalidArgs := []byte("random bytes")
+
+	err := keeper.TradeRewardBalanceCallback(s.App.StakeibcKeeper, s.Ctx, invalidArgs, tc.Response.Query)
+	s.Require().ErrorContains(err, "unable to determine balance from query response")
+}
+
+func (s *KeeperTestSuite) TestTradeRewardBalanceCallback_TradeRouteNotFound() {
+	tc := s.SetupTradeRewardBalanceCallbackTestCase()
+
+	// Update the callback data so that it keys to a trade route that doesn't exist
+	invalidCallbackDataBz, _ := proto.Marshal(&types.TradeRouteCallback{
+		RewardDenom: RewardDenom,
+		HostDenom:   "different-host-denom",
+	})
+	invalidQuery := tc.Response.Query
+	invalidQuery.CallbackData = invalidCallbackDataBz
+
+	err := keeper.TradeRewardBalanceCallback(s.App.StakeibcKeeper, s.Ctx, tc.Response.CallbackArgs, invalidQuery)
+	s.Require().ErrorContains(err, "trade route not found")
+}
@@ -81,6 +81,70 @@ func ValidatorSharesToTokensRateCallback(k Keeper, ctx sdk.Context, args []byte,
 	return nil
 }
 
+// ValidatorsSharesToTokensRatesCallback is the callback handler for a batched validator query
+// that was submitted when validators were added
+// Each validator's sharesToTokens rate is read from the same host height, and the rates are
+// applied together, so that either every validator in the batch is processed or none are
+// If a validator was slashed, a delegator shares query is issued for that validator
+func ValidatorsSharesToTokensRatesCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, ICQCallbackID_Validators,
+		"Starting batched validator sharesToTokens rate callback, QueryId: %vs, QueryType: %s, Connection: %s",
+		query.Id, query.QueryType, query.ConnectionId))
+
+	// Confirm host exists
+	chainId := query.ChainId
+	if _, found := k.GetHostZone(ctx, chainId); !found {
+		return errorsmod.Wrapf(types.ErrHostZoneNotFound, "no registered zone for queried chain ID (%s)", chainId)
+	}
+
+	// If the query timed out, do nothing
+	if query.HasTimedOut(ctx.BlockTime()) {
+		return nil
+	}
+
+	results, err := icqtypes.UnmarshalBatchQueryCallbackArgs(args)
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to unmarshal batched query response")
+	}
+
+	for _, result := range results {
+		// An empty result indicates the validator does not exist on the host
+		if len(result) == 0 {
+			k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_Validators,
+				"Validator not found on host, skipping"))
+			continue
+		}
+
+		queriedValidator := stakingtypes.Validator{}
+		if err := k.cdc.Unmarshal(result, &queriedValidator); err != nil {
+			return errorsmod.Wrapf(err, "unable to unmarshal query response into Validator type")
+		}
+		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_Validators,
+			"Query response - Validator: %s, Jailed: %v, Tokens: %v, Shares: %v",
+			queriedValidator.OperatorAddress, queriedValidator.Jailed, queriedValidator.Tokens, queriedValidator.DelegatorShares))
+
+		// The host zone is re-read for each validator since it's updated with each rate
+		hostZone, found := k.GetHostZone(ctx, chainId)
+		if !found {
+			return errorsmod.Wrapf(types.ErrHostZoneNotFound, "no registered zone for queried chain ID (%s)", chainId)
+		}
+
+		validatorWasSlashed, err := k.CheckIfValidatorWasSlashed(ctx, hostZone, queriedValidator)
+		if err != nil {
+			return err
+		}
+
+		if validatorWasSlashed {
+			hostZone, _ = k.GetHostZone(ctx, chainId)
+			if err := k.SubmitDelegationICQ(ctx, hostZone, queriedValidator.OperatorAddress); err != nil {
+				return errorsmod.Wrapf(err, "Failed to submit ICQ validator delegations")
+			}
+		}
+	}
+
+	return nil
+}
+
 // Determines if the validator was slashed by comparing the validator sharesToTokens rate from the query response
 // with the sharesToTokens rate stored on the validator
 func (k Keeper) CheckIfValidatorWasSlashed(
@@ -525,3 +525,60 @@ func (s *KeeperTestSuite) TestValidatorSharesToTokensRateCallback_DelegationQuer
 	err := keeper.ValidatorSharesToTokensRateCallback(s.App.StakeibcKeeper, s.Ctx, tc.validArgs.callbackArgs, tc.validArgs.query)
 	s.Require().ErrorContains(err, "Failed to submit ICQ validator delegations")
 }
+
+// Helper function to build the callback args for a batched validator query, with the validator
+// from the single query test case followed by a validator that does not exist on the host
+func (s *KeeperTestSuite) getBatchValidatorCallbackArgs(tc ValidatorICQCallbackTestCase) []byte {
+	callbackArgs, err := icqtypes.NewBatchQueryCallbackArgs([]icqtypes.BatchQueryResult{
+		{Result: tc.validArgs.callbackArgs},
+		{Result: []byte{}},
+	})
+	s.Require().NoError(err, "no error expected when building batch callback args")
+	return callbackArgs
+}
+
+func (s *KeeperTestSuite) TestValidatorsSharesToTokensRatesCallback_Successful_NoSlash() {
+	tc := s.SetupValidatorICQCallback(false, false)
+	callbackArgs := s.getBatchValidatorCallbackArgs(tc)
+
+	err := keeper.ValidatorsSharesToTokensRatesCallback(s.App.StakeibcKeeper, s.Ctx, callbackArgs, tc.validArgs.query)
+	s.Require().NoError(err, "batched validator sharesToTokens rate callback error")
+
+	s.checkValidatorSharesToTokensRate(tc.initialState.validator.SharesToTokensRate)
+	s.checkDelegatorSharesQueryNotSubmitted()
+}
+
+func (s *KeeperTestSuite) TestValidatorsSharesToTokensRatesCallback_Successful_Slash() {
+	tc := s.SetupValidatorICQCallback(true, false)
+	callbackArgs := s.getBatchValidatorCallbackArgs(tc)
+
+	err := keeper.ValidatorsSharesToTokensRatesCallback(s.App.StakeibcKeeper, s.Ctx, callbackArgs, tc.validArgs.query)
+	s.Require().NoError(err, "batched validator sharesToTokens rate callback error")
+
+	s.checkValidatorSharesToTokensRate(tc.sharesToTokensRateIfSlashed)
+	s.checkDelegatorSharesQuerySubmitted(tc)
+}
+
+func (s *KeeperTestSuite) TestValidatorsSharesToTokensRatesCallback_TimedOut() {
+	tc := s.SetupValidatorICQCallback(true, false)
+	callbackArgs := s.getBatchValidatorCallbackArgs(tc)
+
+	timedOutQuery := tc.validArgs.query
+	timedOutQuery.TimeoutTimestamp = uint64(s.Ctx.BlockTime().Add(-time.Minute).UnixNano())
+
+	err := keeper.ValidatorsSharesToTokensRatesCallback(s.App.StakeibcKeeper, s.Ctx, callbackArgs, timedOutQuery)
+	s.Require().NoError(err, "batched validator sharesToTokens rate callback error")
+
+	s.checkValidatorSharesToTokensRate(tc.initialState.validator.SharesToTokensRate)
+	s.checkDelegatorSharesQueryNotSubmitted()
+}
+
+func (s *KeeperTestSuite) TestValidatorsSharesToTokensRatesCallback_InvalidCallbackArgs() {
+	tc := s.SetupValidatorICQCallback(false, false)
+
+	invalidArgs, err := icqtypes.NewBatchQueryCallbackArgs([]icqtypes.BatchQueryResult{{Result: []byte("random bytes")}})
+	s.Require().NoError(err, "no error expected when building batch callback args")
+
+	err = keeper.ValidatorsSharesToTokensRatesCallback(s.App.StakeibcKeeper, s.Ctx, invalidArgs, tc.validArgs.query)
+	s.Require().ErrorContains(err, "unable to unmarshal query response into Validator type")
+}
@@ -33,7 +33,14 @@ func WithdrawalHostBalanceCallback(k Keeper, ctx sdk.Context, args []byte, query
 	}
 
 	// Unmarshal the query response args to determine the balance
-	withdrawalBalanceAmount, err := icqkeeper.UnmarshalAmountFromBalanceQuery(k.cdc, args)
+	// Queries sent over async-icq return a gRPC balance response rather than the raw store value
+	var withdrawalBalanceAmount sdkmath.Int
+	var err error
+	if query.Transport == icqtypes.QueryTransport_ASYNC_ICQ_PACKET {
+		withdrawalBalanceAmount, err = icqkeeper.UnmarshalAmountFromBalanceGRPCResponse(k.cdc, args)
+	} else {
+		withdrawalBalanceAmount, err = icqkeeper.UnmarshalAmountFromBalanceQuery(k.cdc, args)
+	}
 	if err != nil {
 		return errorsmod.Wrap(err, "unable to determine balance from query response")
 	}
@@ -5,6 +5,7 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
 
 	epochtypes "github.com/Stride-Labs/stride/v27/x/epochs/types"
@@ -127,6 +128,29 @@ func (s *KeeperTestSuite) TestWithdrawalHostBalanceCallback_Successful() {
 	s.Require().Equal(endSequence, startSequence+1, "sequence number after reinvestment")
 }
 
+func (s *KeeperTestSuite) TestWithdrawalHostBalanceCallback_AsyncICQResponse() {
+	tc := s.SetupWithdrawalHostBalanceCallbackTest()
+
+	// Queries sent over async-icq return a gRPC balance response instead of the raw store value
+	balance := sdk.NewCoin(Atom, sdkmath.NewInt(tc.initialState.withdrawalBalance))
+	queryResponse, err := (&banktypes.QueryBalanceResponse{Balance: &balance}).Marshal()
+	s.Require().NoError(err)
+
+	query := tc.validArgs.query
+	query.Transport = icqtypes.QueryTransport_ASYNC_ICQ_PACKET
+
+	err = keeper.WithdrawalHostBalanceCallback(s.App.StakeibcKeeper, s.Ctx, queryResponse, query)
+	s.Require().NoError(err)
+
+	// Confirm the reinvestment was submitted for the balance in the gRPC response
+	allCallbackData := s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx)
+	s.Require().Len(allCallbackData, 1, "number of callbacks found")
+
+	callbackArgs, err := s.App.StakeibcKeeper.UnmarshalReinvestCallbackArgs(s.Ctx, allCallbackData[0].CallbackArgs)
+	s.Require().NoError(err, "unmarshalling callback args")
+	s.Require().Equal(tc.expectedReinvestment, callbackArgs.ReinvestAmount, "reinvestment coin in callback args")
+}
+
 func (s *KeeperTestSuite) TestWithdrawalHostBalanceCallback_EmptyCallbackArgs() {
 	tc := s.SetupWithdrawalHostBalanceCallbackTest()
 
@@ -50,6 +50,23 @@ func (k Keeper) SetWithdrawalAddressOnHost(ctx sdk.Context, hostZone types.HostZ
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "Failed to SubmitTxs for %s, %s, %s", hostZone.ConnectionId, hostZone.ChainId, msgs)
 	}
 
+	// Set the withdrawal address on each additional delegation shard that has been opened
+	for _, shard := range hostZone.DelegationShards {
+		if shard.IcaAddress == "" {
+			continue
+		}
+		shardMsgs := []proto.Message{
+			&distributiontypes.MsgSetWithdrawAddress{
+				DelegatorAddress: shard.IcaAddress,
+				WithdrawAddress:  hostZone.WithdrawalIcaAddress,
+			},
+		}
+		_, err := k.SubmitDelegationShardTxsEpoch(ctx, hostZone, shard.Index, shardMsgs, epochstypes.STRIDE_EPOCH, "", nil)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "Failed to SubmitTxs for %s, %s, %s", hostZone.ConnectionId, hostZone.ChainId, shardMsgs)
+		}
+	}
+
 	return nil
 }
 
@@ -64,6 +81,27 @@ func (k Keeper) ClaimAccruedStakingRewardsOnHost(ctx sdk.Context, hostZone types
 	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Withdrawal Address: %s, Delegator Address: %s",
 		hostZone.WithdrawalIcaAddress, hostZone.DelegationIcaAddress))
 
+	// Claim rewards from each delegation shard that has been opened
+	for _, shardIndex := range hostZone.GetDelegationShardIndexes() {
+		delegatorAddress, _ := hostZone.GetDelegationShardAddress(shardIndex)
+		if delegatorAddress == "" {
+			continue
+		}
+		if err := k.ClaimAccruedStakingRewardsFromShard(ctx, hostZone, shardIndex, delegatorAddress); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Submits ICAs to withdraw rewards from each validator that the delegation shard has delegated to
+func (k Keeper) ClaimAccruedStakingRewardsFromShard(
+	ctx sdk.Context,
+	hostZone types.HostZone,
+	shardIndex uint32,
+	delegatorAddress string,
+) error {
 	validators := hostZone.Validators
 
 	// Build multi-message transaction to withdraw rewards from each validator
@@ -78,18 +116,18 @@ func (k Keeper) ClaimAccruedStakingRewardsOnHost(ctx sdk.Context, hostZone types
 		// Iterate over the items within the batch
 		for _, val := range batch {
 			// skip withdrawing rewards
-			if val.Delegation.IsZero() {
+			if val.GetShardDelegation(shardIndex).IsZero() {
 				continue
 			}
 			msg := &distributiontypes.MsgWithdrawDelegatorReward{
-				DelegatorAddress: hostZone.DelegationIcaAddress,
+				DelegatorAddress: delegatorAddress,
 				ValidatorAddress: val.Address,
 			}
 			msgs = append(msgs, msg)
 		}
 
 		if len(msgs) > 0 {
-			_, err := k.SubmitTxsStrideEpoch(ctx, hostZone.ConnectionId, msgs, types.ICAAccountType_DELEGATION, "", nil)
+			_, err := k.SubmitDelegationShardTxsEpoch(ctx, hostZone, shardIndex, msgs, epochstypes.STRIDE_EPOCH, "", nil)
 			if err != nil {
 				return errorsmod.Wrapf(err, "Failed to SubmitTxs for %s, %s, %s", hostZone.ConnectionId, hostZone.ChainId, msgs)
 			}
@@ -166,6 +204,25 @@ func (k Keeper) SubmitTxs(
 		return 0, err
 	}
 	owner := types.FormatHostZoneICAOwner(chainId, icaAccountType)
+	return k.SubmitICATxWithCallback(ctx, connectionId, owner, msgs, timeoutTimestamp, callbackId, callbackArgs)
+}
+
+// SubmitICATxWithCallback submits an ICA transaction from the ICA with the given owner
+// and stores the callback data so that the callback is invoked on the ack
+// Unlike SubmitTxs, the ICA is not required to be a host zone ICA (e.g. it can be a trade ICA)
+func (k Keeper) SubmitICATxWithCallback(
+	ctx sdk.Context,
+	connectionId string,
+	owner string,
+	msgs []proto.Message,
+	timeoutTimestamp uint64,
+	callbackId string,
+	callbackArgs []byte,
+) (uint64, error) {
+	chainId, err := k.GetChainIdFromConnectionId(ctx, connectionId)
+	if err != nil {
+		return 0, err
+	}
 	portID, err := icatypes.NewControllerPortID(owner)
 	if err != nil {
 		return 0, err
@@ -212,6 +269,7 @@ func (k Keeper) SubmitTxs(
 			Sequence:     sequence,
 			CallbackId:   callbackId,
 			CallbackArgs: callbackArgs,
+			CreatedAt:    ctx.BlockTime(),
 		}
 		k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Storing callback data: %+v", callback))
 		k.ICACallbacksKeeper.SetCallbackData(ctx, callback)
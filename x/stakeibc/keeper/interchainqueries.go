@@ -77,9 +77,54 @@ func (k Keeper) SubmitValidatorSharesToTokensRateICQ(
 	return nil
 }
 
+// Submits a single batched ICQ for the sharesToTokens rate of each of the given validators,
+// so that every rate is read from the same host height and processed together in one callback
+// This is triggered when validators are added, so we can be conservative with the timeout
+func (k Keeper) QueryValidatorsSharesToTokensRates(ctx sdk.Context, chainId string, validatorAddresses []string) error {
+	k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Submitting batched ICQ for sharesToTokens rate of %d validators", len(validatorAddresses)))
+
+	// Confirm the host zone exists
+	hostZone, found := k.GetHostZone(ctx, chainId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidHostZone, "Host zone not found (%s)", chainId)
+	}
+
+	// Encode each validator address to form the query keys
+	queryKeys := [][]byte{}
+	for _, validatorAddress := range validatorAddresses {
+		if !strings.Contains(validatorAddress, hostZone.Bech32Prefix) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "validator operator address must match the host zone bech32 prefix")
+		}
+		_, validatorAddressBz, err := bech32.DecodeAndConvert(validatorAddress)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid validator operator address, could not decode (%s)", err.Error())
+		}
+		queryKeys = append(queryKeys, stakingtypes.GetValidatorKey(validatorAddressBz))
+	}
+
+	query := icqtypes.Query{
+		ChainId:          hostZone.ChainId,
+		ConnectionId:     hostZone.ConnectionId,
+		QueryType:        icqtypes.STAKING_STORE_QUERY_WITH_PROOF,
+		BatchRequestData: queryKeys,
+		CallbackModule:   types.ModuleName,
+		CallbackId:       ICQCallbackID_Validators,
+		TimeoutDuration:  time.Hour * 24,
+		TimeoutPolicy:    icqtypes.TimeoutPolicy_REJECT_QUERY_RESPONSE,
+	}
+	if err := k.InterchainQueryKeeper.SubmitICQRequest(ctx, query, true); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Error submitting batched ICQ for validator sharesToTokens rates, error %s", err.Error()))
+		return err
+	}
+	return nil
+}
+
 // Submits an ICQ to get a validator's delegations
 // This is called after the validator's sharesToTokens rate is determined
 // The timeoutDuration parameter represents the length of the timeout (not to be confused with an actual timestamp)
+//
+// If the host zone has multiple delegation shards, the delegation from the shard with the largest
+// stake on the validator is queried
 func (k Keeper) SubmitDelegationICQ(ctx sdk.Context, hostZone types.HostZone, validatorAddress string) error {
 	if hostZone.DelegationIcaAddress == "" {
 		return errorsmod.Wrapf(types.ErrICAAccountNotFound, "no delegation address found for %s", hostZone.ChainId)
@@ -88,6 +133,11 @@ func (k Keeper) SubmitDelegationICQ(ctx sdk.Context, hostZone types.HostZone, va
 	if !found {
 		return errorsmod.Wrapf(types.ErrValidatorNotFound, "no registered validator for address (%s)", validatorAddress)
 	}
+	shardIndex := k.GetLargestDelegationShardForValidator(hostZone, validator)
+	delegatorAddress, err := k.GetDelegationShardAddress(hostZone, shardIndex)
+	if err != nil {
+		return err
+	}
 
 	// Only submit the query if there's not already one in progress
 	if validator.SlashQueryInProgress {
@@ -101,7 +151,7 @@ func (k Keeper) SubmitDelegationICQ(ctx sdk.Context, hostZone types.HostZone, va
 	if err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid validator address, could not decode (%s)", err.Error())
 	}
-	_, delegatorAddressBz, err := bech32.DecodeAndConvert(hostZone.DelegationIcaAddress)
+	_, delegatorAddressBz, err := bech32.DecodeAndConvert(delegatorAddress)
 	if err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid delegator address, could not decode (%s)", err.Error())
 	}
@@ -111,6 +161,7 @@ func (k Keeper) SubmitDelegationICQ(ctx sdk.Context, hostZone types.HostZone, va
 	// while the query was in flight
 	callbackData := types.DelegatorSharesQueryCallback{
 		InitialValidatorDelegation: validator.Delegation,
+		DelegationShardIndex:       shardIndex,
 	}
 	callbackDataBz, err := proto.Marshal(&callbackData)
 	if err != nil {
@@ -145,9 +196,10 @@ func (k Keeper) SubmitDelegationICQ(ctx sdk.Context, hostZone types.HostZone, va
 // Submits an ICQ to get a validator's delegations
 // This is called after the validator's sharesToTokens rate is determined
 // The timeoutDuration parameter represents the length of the timeout (not to be confused with an actual timestamp)
-func (k Keeper) SubmitCalibrationICQ(ctx sdk.Context, hostZone types.HostZone, validatorAddress string) error {
-	if hostZone.DelegationIcaAddress == "" {
-		return errorsmod.Wrapf(types.ErrICAAccountNotFound, "no delegation address found for %s", hostZone.ChainId)
+func (k Keeper) SubmitCalibrationICQ(ctx sdk.Context, hostZone types.HostZone, validatorAddress string, shardIndex uint32) error {
+	delegatorAddress, err := k.GetDelegationShardAddress(hostZone, shardIndex)
+	if err != nil {
+		return err
 	}
 
 	// ensure the validator is in the set for this host
@@ -161,12 +213,21 @@ func (k Keeper) SubmitCalibrationICQ(ctx sdk.Context, hostZone types.HostZone, v
 	if err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid validator address, could not decode (%s)", err.Error())
 	}
-	_, delegatorAddressBz, err := bech32.DecodeAndConvert(hostZone.DelegationIcaAddress)
+	_, delegatorAddressBz, err := bech32.DecodeAndConvert(delegatorAddress)
 	if err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid delegator address, could not decode (%s)", err.Error())
 	}
 	queryData := stakingtypes.GetDelegationKey(delegatorAddressBz, validatorAddressBz)
 
+	// Store the shard in the callback data so the callback knows which delegation was queried
+	callbackData := types.CalibrateDelegationQueryCallback{
+		DelegationShardIndex: shardIndex,
+	}
+	callbackDataBz, err := proto.Marshal(&callbackData)
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to marshal calibrate delegation callback data")
+	}
+
 	// Submit delegator shares ICQ
 	query := icqtypes.Query{
 		ChainId:         hostZone.ChainId,
@@ -175,7 +236,7 @@ func (k Keeper) SubmitCalibrationICQ(ctx sdk.Context, hostZone types.HostZone, v
 		RequestData:     queryData,
 		CallbackModule:  types.ModuleName,
 		CallbackId:      ICQCallbackID_Calibrate,
-		CallbackData:    []byte{},
+		CallbackData:    callbackDataBz,
 		TimeoutDuration: time.Hour,
 		TimeoutPolicy:   icqtypes.TimeoutPolicy_RETRY_QUERY_REQUEST,
 	}
@@ -222,6 +283,19 @@ func (k Keeper) SubmitWithdrawalHostBalanceICQ(ctx sdk.Context, hostZone types.H
 		TimeoutDuration: timeoutDuration,
 		TimeoutPolicy:   icqtypes.TimeoutPolicy_REJECT_QUERY_RESPONSE,
 	}
+
+	// If an async-icq channel has been opened to the host, send the query over the channel
+	// as a gRPC balance query instead of relying on a relayer to submit a proof
+	if _, found := k.InterchainQueryKeeper.GetICQChannel(ctx, hostZone.ConnectionId); found {
+		requestData, err := icqtypes.NewBalanceGRPCQueryRequest(hostZone.WithdrawalIcaAddress, hostZone.HostDenom)
+		if err != nil {
+			return err
+		}
+		query.QueryType = icqtypes.BANK_BALANCE_GRPC_QUERY
+		query.RequestData = requestData
+		query.Transport = icqtypes.QueryTransport_ASYNC_ICQ_PACKET
+	}
+
 	if err := k.InterchainQueryKeeper.SubmitICQRequest(ctx, query, false); err != nil {
 		k.Logger(ctx).Error(fmt.Sprintf("Error querying for withdrawal balance, error: %s", err.Error()))
 		return err
@@ -4,10 +4,14 @@ import (
 	"fmt"
 
 	sdkmath "cosmossdk.io/math"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/gogoproto/proto"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
 	_ "github.com/stretchr/testify/suite"
 
+	icqtypes "github.com/Stride-Labs/stride/v27/x/interchainquery/types"
 	"github.com/Stride-Labs/stride/v27/x/stakeibc/types"
 )
 
@@ -175,3 +179,68 @@ func (s *KeeperTestSuite) TestSubmitDelegationICQ_MissingConnectionId() {
 	err := s.App.StakeibcKeeper.SubmitDelegationICQ(s.Ctx, hostZone, ValAddress)
 	s.Require().ErrorContains(err, "connection-id cannot be empty")
 }
+
+// -----------------------------------------------------------
+//	           SubmitWithdrawalHostBalanceICQ
+// -----------------------------------------------------------
+
+// Mocks out an open async-icq channel on the host zone's connection
+func (s *KeeperTestSuite) MockAsyncICQChannel(connectionId string) {
+	channelId := "channel-10"
+	channel := channeltypes.NewChannel(
+		channeltypes.OPEN,
+		channeltypes.UNORDERED,
+		channeltypes.NewCounterparty("icqhost", "channel-20"),
+		[]string{connectionId},
+		icqtypes.Version,
+	)
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, icqtypes.PortID, channelId, channel)
+	s.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(s.Ctx, icqtypes.PortID, channelId, 1)
+
+	capabilityPath := host.ChannelCapabilityPath(icqtypes.PortID, channelId)
+	channelCap, err := s.App.ScopedInterchainqueryKeeper.NewCapability(s.Ctx, capabilityPath)
+	s.Require().NoError(err, "no error expected when creating channel capability")
+	err = s.App.ScopedIBCKeeper.ClaimCapability(s.Ctx, channelCap, capabilityPath)
+	s.Require().NoError(err, "no error expected when claiming channel capability")
+
+	s.App.InterchainqueryKeeper.SetICQChannel(s.Ctx, connectionId, channelId)
+}
+
+func (s *KeeperTestSuite) TestSubmitWithdrawalHostBalanceICQ_RelayerTransport() {
+	s.CreateTransferChannel(HostChainId)
+	tc := s.SetupWithdrawalHostBalanceCallbackTest()
+
+	err := s.App.StakeibcKeeper.SubmitWithdrawalHostBalanceICQ(s.Ctx, tc.initialState.hostZone)
+	s.Require().NoError(err, "no error expected when submitting query")
+
+	// Without an async-icq channel, the query should be a proof query of the bank store
+	queries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
+	s.Require().Len(queries, 1, "one query should have been created")
+	s.Require().Equal(icqtypes.BANK_STORE_QUERY_WITH_PROOF, queries[0].QueryType, "query type")
+	s.Require().Equal(icqtypes.QueryTransport_RELAYER_EVENT, queries[0].Transport, "query transport")
+	s.Require().False(queries[0].RequestSent, "request sent")
+}
+
+func (s *KeeperTestSuite) TestSubmitWithdrawalHostBalanceICQ_AsyncICQTransport() {
+	s.CreateTransferChannel(HostChainId)
+	tc := s.SetupWithdrawalHostBalanceCallbackTest()
+	hostZone := tc.initialState.hostZone
+
+	s.MockAsyncICQChannel(hostZone.ConnectionId)
+
+	err := s.App.StakeibcKeeper.SubmitWithdrawalHostBalanceICQ(s.Ctx, hostZone)
+	s.Require().NoError(err, "no error expected when submitting query")
+
+	// With an async-icq channel, the query should be a gRPC balance query sent in a packet
+	queries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
+	s.Require().Len(queries, 1, "one query should have been created")
+	s.Require().Equal(icqtypes.BANK_BALANCE_GRPC_QUERY, queries[0].QueryType, "query type")
+	s.Require().Equal(icqtypes.QueryTransport_ASYNC_ICQ_PACKET, queries[0].Transport, "query transport")
+	s.Require().True(queries[0].RequestSent, "request sent")
+
+	var request banktypes.QueryBalanceRequest
+	err = request.Unmarshal(queries[0].RequestData)
+	s.Require().NoError(err, "no error expected when unmarshaling request data")
+	s.Require().Equal(hostZone.WithdrawalIcaAddress, request.Address, "request address")
+	s.Require().Equal(hostZone.HostDenom, request.Denom, "request denom")
+}
@@ -6,7 +6,6 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	epochtypes "github.com/Stride-Labs/stride/v27/x/epochs/types"
 	"github.com/Stride-Labs/stride/v27/x/stakeibc/types"
 )
 
@@ -23,22 +22,6 @@ func AllInvariants(k Keeper) sdk.Invariant {
 	}
 }
 
-// TODO: Consider removing stride and day epochs completely and using a single hourly epoch
-// Confirm the number of stride epochs in 1 day epoch
-func (k Keeper) AssertStrideAndDayEpochRelationship(ctx sdk.Context) {
-	strideEpoch, found := k.GetEpochTracker(ctx, epochtypes.STRIDE_EPOCH)
-	if !found || strideEpoch.Duration == 0 {
-		return
-	}
-	dayEpoch, found := k.GetEpochTracker(ctx, epochtypes.DAY_EPOCH)
-	if !found || dayEpoch.Duration == 0 {
-		return
-	}
-	if dayEpoch.Duration/strideEpoch.Duration != StrideEpochsPerDayEpoch {
-		panic("The stride epoch must be 1/4th the length of the day epoch")
-	}
-}
-
 // TODO [cleanup]: Update to be CheckRedemptionRateWithinSafetyBound and only throw an error (instead of a bool)
 // safety check: ensure the redemption rate is NOT below our min safety threshold && NOT above our max safety threshold on host zone
 func (k Keeper) IsRedemptionRateWithinSafetyBounds(ctx sdk.Context, zone types.HostZone) (bool, error) {
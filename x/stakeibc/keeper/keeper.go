@@ -42,6 +42,7 @@ type (
 		hooks                 types.StakeIBCHooks
 		RatelimitKeeper       types.RatelimitKeeper
 		ICAOracleKeeper       types.ICAOracleKeeper
+		ICQOracleKeeper       types.ICQOracleKeeper
 		ConsumerKeeper        types.ConsumerKeeper
 	}
 )
@@ -62,6 +63,7 @@ func NewKeeper(
 	ICACallbacksKeeper icacallbackskeeper.Keeper,
 	RatelimitKeeper types.RatelimitKeeper,
 	icaOracleKeeper types.ICAOracleKeeper,
+	icqOracleKeeper types.ICQOracleKeeper,
 	ConsumerKeeper types.ConsumerKeeper,
 ) Keeper {
 	// set KeyTable if it has not already been set
@@ -85,6 +87,7 @@ func NewKeeper(
 		ICACallbacksKeeper:    ICACallbacksKeeper,
 		RatelimitKeeper:       RatelimitKeeper,
 		ICAOracleKeeper:       icaOracleKeeper,
+		ICQOracleKeeper:       icqOracleKeeper,
 		ConsumerKeeper:        ConsumerKeeper,
 	}
 }
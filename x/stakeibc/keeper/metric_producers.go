@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icaoracletypes "github.com/Stride-Labs/stride/v27/x/icaoracle/types"
+	"github.com/Stride-Labs/stride/v27/x/stakeibc/types"
+)
+
+const (
+	MetricProducerID_TVL            = "stakeibc_tvl"
+	MetricProducerID_StTokenSupply  = "stakeibc_sttoken_supply"
+	MetricProducerID_UnbondingQueue = "stakeibc_unbonding_queue"
+	MetricProducerID_StakingAPR     = "stakeibc_staking_apr"
+)
+
+// Used to annualize the redemption rate growth when estimating the staking APR
+const SecondsPerYear = 365 * 24 * 60 * 60
+
+// Metric producers that push host zone metrics to the oracles
+// The cadence and target oracles for each producer are configured through governance in icaoracle
+func (k Keeper) MetricProducers() icaoracletypes.ModuleMetricProducers {
+	return icaoracletypes.ModuleMetricProducers{
+		MetricProducerID_TVL:            k.ProduceTVLMetrics,
+		MetricProducerID_StTokenSupply:  k.ProduceStTokenSupplyMetrics,
+		MetricProducerID_UnbondingQueue: k.ProduceUnbondingQueueMetrics,
+		MetricProducerID_StakingAPR:     k.ProduceStakingAPRMetrics,
+	}
+}
+
+// Builds the metric for a host zone
+// Metric keys are of format: {stToken}_{metricType}
+func (k Keeper) buildHostZoneMetric(hostZone types.HostZone, metricType string, value string) (icaoracletypes.MetricUpdate, error) {
+	stDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
+	attributes, err := json.Marshal(icaoracletypes.StTokenMetricAttributes{
+		SttokenDenom: stDenom,
+		HostZoneId:   hostZone.ChainId,
+	})
+	if err != nil {
+		return icaoracletypes.MetricUpdate{}, err
+	}
+
+	return icaoracletypes.MetricUpdate{
+		Key:        fmt.Sprintf("%s_%s", stDenom, metricType),
+		Value:      value,
+		MetricType: metricType,
+		Attributes: string(attributes),
+	}, nil
+}
+
+// Builds a metric for each active host zone, with the value determined by the provided function
+func (k Keeper) buildHostZoneMetrics(
+	ctx sdk.Context,
+	metricType string,
+	getValue func(hostZone types.HostZone) sdkmath.Int,
+) ([]icaoracletypes.MetricUpdate, error) {
+	metrics := []icaoracletypes.MetricUpdate{}
+	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+		metric, err := k.buildHostZoneMetric(hostZone, metricType, getValue(hostZone).String())
+		if err != nil {
+			return nil, err
+		}
+		metrics = append(metrics, metric)
+	}
+	return metrics, nil
+}
+
+// Produces the total delegated native tokens for each host zone
+func (k Keeper) ProduceTVLMetrics(ctx sdk.Context) ([]icaoracletypes.MetricUpdate, error) {
+	return k.buildHostZoneMetrics(ctx, icaoracletypes.MetricType_TVL, func(hostZone types.HostZone) sdkmath.Int {
+		return hostZone.TotalDelegations
+	})
+}
+
+// Produces the total supply of each stToken
+func (k Keeper) ProduceStTokenSupplyMetrics(ctx sdk.Context) ([]icaoracletypes.MetricUpdate, error) {
+	return k.buildHostZoneMetrics(ctx, icaoracletypes.MetricType_StTokenSupply, func(hostZone types.HostZone) sdkmath.Int {
+		stDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
+		return k.bankKeeper.GetSupply(ctx, stDenom).Amount
+	})
+}
+
+// Produces the total native tokens that are queued to be unbonded for each host zone
+func (k Keeper) ProduceUnbondingQueueMetrics(ctx sdk.Context) ([]icaoracletypes.MetricUpdate, error) {
+	return k.buildHostZoneMetrics(ctx, icaoracletypes.MetricType_UnbondingQueue, func(hostZone types.HostZone) sdkmath.Int {
+		_, queuedRecords := k.GetQueuedHostZoneUnbondingRecords(ctx, hostZone.ChainId)
+		return k.GetTotalUnbondAmount(queuedRecords)
+	})
+}
+
+// Estimates the staking APR of a host zone by annualizing the growth of the redemption rate
+// across the snapshots recorded in the last day
+// Returns false if there are not enough snapshots to estimate the APR
+func (k Keeper) GetStakingAPR(ctx sdk.Context, hostZone types.HostZone) (apr sdk.Dec, found bool) {
+	history := k.GetRecentRedemptionRateHistory(ctx, hostZone)
+	if len(history) < 2 {
+		return sdk.ZeroDec(), false
+	}
+
+	oldest, newest := history[0], history[len(history)-1]
+	elapsed := newest.Time.Sub(oldest.Time)
+	if elapsed < time.Second || oldest.RedemptionRate.IsNil() || !oldest.RedemptionRate.IsPositive() {
+		return sdk.ZeroDec(), false
+	}
+
+	growth := newest.RedemptionRate.Quo(oldest.RedemptionRate).Sub(sdk.OneDec())
+	periodsPerYear := sdk.NewDec(SecondsPerYear).QuoInt64(int64(elapsed / time.Second))
+	return growth.Mul(periodsPerYear), true
+}
+
+// Produces the estimated staking APR for each host zone
+// Host zones without enough recent redemption rate history are skipped
+func (k Keeper) ProduceStakingAPRMetrics(ctx sdk.Context) ([]icaoracletypes.MetricUpdate, error) {
+	metrics := []icaoracletypes.MetricUpdate{}
+	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+		apr, found := k.GetStakingAPR(ctx, hostZone)
+		if !found {
+			continue
+		}
+
+		metric, err := k.buildHostZoneMetric(hostZone, icaoracletypes.MetricType_StakingAPR, apr.String())
+		if err != nil {
+			return nil, err
+		}
+		metrics = append(metrics, metric)
+	}
+	return metrics, nil
+}
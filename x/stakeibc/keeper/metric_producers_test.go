@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icaoracletypes "github.com/Stride-Labs/stride/v27/x/icaoracle/types"
+	recordtypes "github.com/Stride-Labs/stride/v27/x/records/types"
+	"github.com/Stride-Labs/stride/v27/x/stakeibc/types"
+)
+
+// Creates an active host zone and a halted host zone, and returns the expected attributes
+// of the metrics for the active host zone
+func (s *KeeperTestSuite) SetupMetricProducers() string {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{
+		ChainId:          HostChainId,
+		HostDenom:        Atom,
+		TotalDelegations: sdkmath.NewInt(1000),
+	})
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{
+		ChainId:          OsmoChainId,
+		HostDenom:        Osmo,
+		TotalDelegations: sdkmath.NewInt(2000),
+		Halted:           true,
+	})
+
+	attributes, err := json.Marshal(icaoracletypes.StTokenMetricAttributes{
+		SttokenDenom: StAtom,
+		HostZoneId:   HostChainId,
+	})
+	s.Require().NoError(err)
+	return string(attributes)
+}
+
+func (s *KeeperTestSuite) TestProduceTVLMetrics() {
+	attributes := s.SetupMetricProducers()
+
+	metrics, err := s.App.StakeibcKeeper.ProduceTVLMetrics(s.Ctx)
+	s.Require().NoError(err, "no error expected when producing TVL metrics")
+	s.Require().Equal([]icaoracletypes.MetricUpdate{{
+		Key:        "stuatom_tvl",
+		Value:      "1000",
+		MetricType: icaoracletypes.MetricType_TVL,
+		Attributes: attributes,
+	}}, metrics, "TVL metrics")
+}
+
+func (s *KeeperTestSuite) TestProduceStTokenSupplyMetrics() {
+	attributes := s.SetupMetricProducers()
+	s.FundAccount(s.TestAccs[0], sdk.NewInt64Coin(StAtom, 500))
+
+	metrics, err := s.App.StakeibcKeeper.ProduceStTokenSupplyMetrics(s.Ctx)
+	s.Require().NoError(err, "no error expected when producing stToken supply metrics")
+	s.Require().Equal([]icaoracletypes.MetricUpdate{{
+		Key:        "stuatom_sttoken_supply",
+		Value:      "500",
+		MetricType: icaoracletypes.MetricType_StTokenSupply,
+		Attributes: attributes,
+	}}, metrics, "stToken supply metrics")
+}
+
+func (s *KeeperTestSuite) TestProduceUnbondingQueueMetrics() {
+	attributes := s.SetupMetricProducers()
+
+	// Only the records that are queued for unbonding should be included
+	epochUnbondingRecords := []recordtypes.EpochUnbondingRecord{
+		{
+			EpochNumber: 1,
+			HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+				{
+					HostZoneId:           HostChainId,
+					Status:               recordtypes.HostZoneUnbonding_UNBONDING_QUEUE,
+					StTokenAmount:        sdkmath.NewInt(10),
+					NativeTokensToUnbond: sdkmath.NewInt(10),
+				},
+			},
+		},
+		{
+			EpochNumber: 2,
+			HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+				{
+					HostZoneId:           HostChainId,
+					Status:               recordtypes.HostZoneUnbonding_UNBONDING_RETRY_QUEUE,
+					StTokenAmount:        sdkmath.NewInt(20),
+					NativeTokensToUnbond: sdkmath.NewInt(20),
+				},
+			},
+		},
+		{
+			EpochNumber: 3,
+			HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+				{
+					HostZoneId:           HostChainId,
+					Status:               recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS,
+					StTokenAmount:        sdkmath.NewInt(40),
+					NativeTokensToUnbond: sdkmath.NewInt(40),
+				},
+			},
+		},
+	}
+	for _, epochUnbondingRecord := range epochUnbondingRecords {
+		s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, epochUnbondingRecord)
+	}
+
+	metrics, err := s.App.StakeibcKeeper.ProduceUnbondingQueueMetrics(s.Ctx)
+	s.Require().NoError(err, "no error expected when producing unbonding queue metrics")
+	s.Require().Equal([]icaoracletypes.MetricUpdate{{
+		Key:        "stuatom_unbonding_queue",
+		Value:      "30",
+		MetricType: icaoracletypes.MetricType_UnbondingQueue,
+		Attributes: attributes,
+	}}, metrics, "unbonding queue metrics")
+}
+
+func (s *KeeperTestSuite) TestGetStakingAPR() {
+	now := time.Now().UTC()
+	s.Ctx = s.Ctx.WithBlockTime(now)
+
+	testCases := []struct {
+		name        string
+		history     []types.RedemptionRateSnapshot
+		expectedAPR sdk.Dec
+		expectFound bool
+	}{
+		{
+			name: "growth over half a day",
+			history: []types.RedemptionRateSnapshot{
+				{RedemptionRate: sdk.MustNewDecFromStr("1.0"), Time: now.Add(-12 * time.Hour)},
+				{RedemptionRate: sdk.MustNewDecFromStr("1.0005"), Time: now.Add(-6 * time.Hour)},
+				{RedemptionRate: sdk.MustNewDecFromStr("1.001"), Time: now},
+			},
+			expectedAPR: sdk.MustNewDecFromStr("0.73"), // 0.1% growth, 730 half days per year
+			expectFound: true,
+		},
+		{
+			name: "snapshots older than a day are ignored",
+			history: []types.RedemptionRateSnapshot{
+				{RedemptionRate: sdk.MustNewDecFromStr("0.5"), Time: now.Add(-48 * time.Hour)},
+				{RedemptionRate: sdk.MustNewDecFromStr("1.0"), Time: now.Add(-12 * time.Hour)},
+				{RedemptionRate: sdk.MustNewDecFromStr("1.001"), Time: now},
+			},
+			expectedAPR: sdk.MustNewDecFromStr("0.73"),
+			expectFound: true,
+		},
+		{
+			name: "only one recent snapshot",
+			history: []types.RedemptionRateSnapshot{
+				{RedemptionRate: sdk.MustNewDecFromStr("1.0"), Time: now.Add(-48 * time.Hour)},
+				{RedemptionRate: sdk.MustNewDecFromStr("1.001"), Time: now},
+			},
+			expectFound: false,
+		},
+		{
+			name: "snapshots at the same time",
+			history: []types.RedemptionRateSnapshot{
+				{RedemptionRate: sdk.MustNewDecFromStr("1.0"), Time: now},
+				{RedemptionRate: sdk.MustNewDecFromStr("1.001"), Time: now},
+			},
+			expectFound: false,
+		},
+		{
+			name:        "no history",
+			expectFound: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			hostZone := types.HostZone{ChainId: HostChainId, RedemptionRateHistory: tc.history}
+			apr, found := s.App.StakeibcKeeper.GetStakingAPR(s.Ctx, hostZone)
+			s.Require().Equal(tc.expectFound, found, "found")
+			if tc.expectFound {
+				s.Require().Equal(tc.expectedAPR, apr, "apr")
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestProduceStakingAPRMetrics() {
+	attributes := s.SetupMetricProducers()
+
+	now := time.Now().UTC()
+	s.Ctx = s.Ctx.WithBlockTime(now)
+
+	// Add redemption rate history to the active host zone
+	hostZone := s.MustGetHostZone(HostChainId)
+	hostZone.RedemptionRateHistory = []types.RedemptionRateSnapshot{
+		{RedemptionRate: sdk.MustNewDecFromStr("1.0"), Time: now.Add(-12 * time.Hour)},
+		{RedemptionRate: sdk.MustNewDecFromStr("1.001"), Time: now},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	// Add a second active host zone without enough history, which should be skipped
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{
+		ChainId:   "evmos_9001-2",
+		HostDenom: "aevmos",
+		RedemptionRateHistory: []types.RedemptionRateSnapshot{
+			{RedemptionRate: sdk.MustNewDecFromStr("1.0"), Time: now},
+		},
+	})
+
+	metrics, err := s.App.StakeibcKeeper.ProduceStakingAPRMetrics(s.Ctx)
+	s.Require().NoError(err, "no error expected when producing staking APR metrics")
+	s.Require().Equal([]icaoracletypes.MetricUpdate{{
+		Key:        "stuatom_staking_apr",
+		Value:      sdk.MustNewDecFromStr("0.73").String(),
+		MetricType: icaoracletypes.MetricType_StakingAPR,
+		Attributes: attributes,
+	}}, metrics, "staking APR metrics")
+}
@@ -3,7 +3,6 @@ package keeper
 import (
 	"context"
 	"fmt"
-	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -58,6 +57,36 @@ func (ms msgServer) UpdateHostZoneParams(goCtx context.Context, msg *types.MsgUp
 		maxMessagesPerTx = DefaultMaxMessagesPerIcaTx
 	}
 	hostZone.MaxMessagesPerIcaTx = maxMessagesPerTx
+
+	// Velocity limits are only updated if specified (a zero value disables the check)
+	if msg.MaxRedemptionRateChangePerEpoch != nil {
+		hostZone.MaxRedemptionRateChangePerEpoch = *msg.MaxRedemptionRateChangePerEpoch
+	}
+	if msg.MaxRedemptionRateChangePerDay != nil {
+		hostZone.MaxRedemptionRateChangePerDay = *msg.MaxRedemptionRateChangePerDay
+	}
+
+	// If the cadence is reset, every interval falls back to the default unless it's specified below
+	if msg.ResetEpochCadence {
+		hostZone.EpochCadence = types.EpochCadence{}
+	}
+
+	// Only the intervals that were specified are updated
+	if msg.EpochCadence != nil {
+		hostZone.EpochCadence = hostZone.EpochCadence.Merge(*msg.EpochCadence)
+
+		// The rebalance must not line up with the day epoch, otherwise the unbondings could cause
+		// the redelegations to fail
+		// Requiring a multiple of the default interval keeps it on the same stride epoch as the default
+		strideEpochsPerDayEpoch := ms.Keeper.GetStrideEpochsPerDayEpoch(ctx)
+		rebalanceInterval := hostZone.EpochCadence.RebalanceInterval
+		if rebalanceInterval != 0 && rebalanceInterval%strideEpochsPerDayEpoch != 0 {
+			return nil, types.ErrInvalidEpochCadence.Wrapf(
+				"rebalance interval (%d) must be a multiple of the number of stride epochs per day (%d)",
+				rebalanceInterval, strideEpochsPerDayEpoch)
+		}
+	}
+
 	ms.Keeper.SetHostZone(ctx, hostZone)
 
 	return &types.MsgUpdateHostZoneParamsResponse{}, nil
@@ -66,15 +95,17 @@ func (ms msgServer) UpdateHostZoneParams(goCtx context.Context, msg *types.MsgUp
 func (k msgServer) AddValidators(goCtx context.Context, msg *types.MsgAddValidators) (*types.MsgAddValidatorsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	validatorAddresses := []string{}
 	for _, validator := range msg.Validators {
 		if err := k.AddValidatorToHostZone(ctx, msg.HostZone, *validator, false); err != nil {
 			return nil, err
 		}
+		validatorAddresses = append(validatorAddresses, validator.Address)
+	}
 
-		// Query and store the validator's sharesToTokens rate
-		if err := k.QueryValidatorSharesToTokensRate(ctx, msg.HostZone, validator.Address); err != nil {
-			return nil, err
-		}
+	// Query and store the sharesToTokens rate of each new validator in a single batched query
+	if err := k.QueryValidatorsSharesToTokensRates(ctx, msg.HostZone, validatorAddresses); err != nil {
+		return nil, err
 	}
 
 	// Confirm none of the validator's exceed the weight cap
@@ -413,6 +444,24 @@ func (ms msgServer) CreateTradeRoute(goCtx context.Context, msg *types.MsgCreate
 		return nil, errorsmod.Wrapf(err, "unable to register the trade ICA account")
 	}
 
+	// Register a trade ICA for each additional leg of the route
+	additionalLegs := []types.TradeLeg{}
+	for i, legConfig := range msg.AdditionalLegs {
+		legId := types.GetTradeLegId(tradeRouteId, i+1)
+		legICA, err := ms.Keeper.RegisterTradeRouteICAAccount(ctx, legId, legConfig.StrideToTradeConnectionId, tradeICAType)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "unable to register the trade ICA account for leg %d", i+1)
+		}
+
+		additionalLegs = append(additionalLegs, types.TradeLeg{
+			TradeAccount:           legICA,
+			InputDenomOnTradeZone:  legConfig.InputDenomOnTrade,
+			OutputDenomOnTradeZone: legConfig.OutputDenomOnTrade,
+			InboundChannelId:       legConfig.InboundTransferChannelId,
+			SwapConfig:             legConfig.SwapConfig,
+		})
+	}
+
 	// Finally build and store the main trade route
 	tradeRoute := types.TradeRoute{
 		RewardDenomOnHostZone:   msg.RewardDenomOnHost,
@@ -430,6 +479,8 @@ func (ms msgServer) CreateTradeRoute(goCtx context.Context, msg *types.MsgCreate
 		TradeToHostChannelId:   msg.TradeToHostTransferChannelId,
 
 		MinTransferAmount: msg.MinTransferAmount,
+
+		AdditionalLegs: additionalLegs,
 	}
 
 	ms.Keeper.SetTradeRoute(ctx, tradeRoute)
@@ -505,7 +556,29 @@ func (ms msgServer) UpdateTradeRoute(goCtx context.Context, msg *types.MsgUpdate
 			"no trade route for rewardDenom %s and hostDenom %s", msg.RewardDenom, msg.HostDenom)
 	}
 
+	// If swap configs were provided for the additional legs, there must be one for each leg
+	if len(msg.AdditionalLegSwapConfigs) > 0 {
+		if len(msg.AdditionalLegSwapConfigs) != len(route.AdditionalLegs) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidSwapConfig,
+				"expected %d additional leg swap configs, got %d", len(route.AdditionalLegs), len(msg.AdditionalLegSwapConfigs))
+		}
+		for i := range route.AdditionalLegs {
+			swapConfig := msg.AdditionalLegSwapConfigs[i]
+			route.AdditionalLegs[i].SwapConfig = &swapConfig
+		}
+	}
+
 	route.MinTransferAmount = msg.MinTransferAmount
+	route.SwapConfig = msg.SwapConfig
+
+	// If the route is switching to on-chain swaps, revoke the trade controller's permissions
+	if route.SwapConfig != nil && route.TradeController != "" {
+		err := ms.Keeper.SubmitTradeAuthzTx(ctx, &route, types.AuthzPermissionChange_REVOKE, route.TradeController, route.TradeControllerLegacy)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "unable to revoke trade controller permissions")
+		}
+	}
+
 	ms.Keeper.SetTradeRoute(ctx, route)
 
 	return &types.MsgUpdateTradeRouteResponse{}, nil
@@ -544,8 +617,8 @@ func (k msgServer) RestoreInterchainAccount(goCtx context.Context, msg *types.Ms
 		return nil, errorsmod.Wrapf(err, "unable to register account for owner %s", msg.AccountOwner)
 	}
 
-	// If we're restoring a delegation account, we also have to reset record state
-	if msg.AccountOwner == types.FormatHostZoneICAOwner(msg.ChainId, types.ICAAccountType_DELEGATION) {
+	// If we're restoring a delegation account (or delegation shard), we also have to reset record state
+	if shardIndex, isDelegationShard := types.ParseDelegationShardICAOwner(msg.ChainId, msg.AccountOwner); isDelegationShard {
 		hostZone, found := k.GetHostZone(ctx, msg.ChainId)
 		if !found {
 			return nil, types.ErrHostZoneNotFound.Wrapf("delegation ICA supplied, but no associated host zone")
@@ -561,8 +634,10 @@ func (k msgServer) RestoreInterchainAccount(goCtx context.Context, msg *types.Ms
 		// revert DELEGATION_IN_PROGRESS records for the closed ICA channel (so that they can be staked)
 		depositRecords := k.RecordsKeeper.GetAllDepositRecord(ctx)
 		for _, depositRecord := range depositRecords {
-			// only revert records for the select host zone
-			if depositRecord.HostZoneId == hostZone.ChainId && depositRecord.Status == recordtypes.DepositRecord_DELEGATION_IN_PROGRESS {
+			// only revert records for the select host zone and delegation shard
+			if depositRecord.HostZoneId == hostZone.ChainId &&
+				depositRecord.DelegationShardIndex == shardIndex &&
+				depositRecord.Status == recordtypes.DepositRecord_DELEGATION_IN_PROGRESS {
 				depositRecord.Status = recordtypes.DepositRecord_DELEGATION_QUEUE
 				depositRecord.DelegationTxsInProgress = 0
 
@@ -580,6 +655,9 @@ func (k msgServer) RestoreInterchainAccount(goCtx context.Context, msg *types.Ms
 				k.Logger(ctx).Info(fmt.Sprintf("No HostZoneUnbonding found for chainId: %s, epoch: %d", hostZone.ChainId, epochUnbondingRecord.EpochNumber))
 				continue
 			}
+			if hostZoneUnbonding.DelegationShardIndex != shardIndex {
+				continue
+			}
 
 			// Reset the number of undelegation txs in progress
 			hostZoneUnbonding.UndelegationTxsInProgress = 0
@@ -606,10 +684,13 @@ func (k msgServer) RestoreInterchainAccount(goCtx context.Context, msg *types.Ms
 		}
 
 		// Revert all pending LSM Detokenizations from status DETOKENIZATION_IN_PROGRESS to status DETOKENIZATION_QUEUE
-		pendingDeposits := k.RecordsKeeper.GetLSMDepositsForHostZoneWithStatus(ctx, hostZone.ChainId, recordtypes.LSMTokenDeposit_DETOKENIZATION_IN_PROGRESS)
-		for _, lsmDeposit := range pendingDeposits {
-			k.Logger(ctx).Info(fmt.Sprintf("Setting LSMTokenDeposit %s to status DETOKENIZATION_QUEUE", lsmDeposit.Denom))
-			k.RecordsKeeper.UpdateLSMTokenDepositStatus(ctx, lsmDeposit, recordtypes.LSMTokenDeposit_DETOKENIZATION_QUEUE)
+		// LSM detokenizations are always processed from the main delegation ICA
+		if shardIndex == 0 {
+			pendingDeposits := k.RecordsKeeper.GetLSMDepositsForHostZoneWithStatus(ctx, hostZone.ChainId, recordtypes.LSMTokenDeposit_DETOKENIZATION_IN_PROGRESS)
+			for _, lsmDeposit := range pendingDeposits {
+				k.Logger(ctx).Info(fmt.Sprintf("Setting LSMTokenDeposit %s to status DETOKENIZATION_QUEUE", lsmDeposit.Denom))
+				k.RecordsKeeper.UpdateLSMTokenDepositStatus(ctx, lsmDeposit, recordtypes.LSMTokenDeposit_DETOKENIZATION_QUEUE)
+			}
 		}
 	}
 
@@ -668,7 +749,7 @@ func (k msgServer) CalibrateDelegation(goCtx context.Context, msg *types.MsgCali
 		return nil, types.ErrHostZoneNotFound
 	}
 
-	if err := k.SubmitCalibrationICQ(ctx, hostZone, msg.Valoper); err != nil {
+	if err := k.SubmitCalibrationICQ(ctx, hostZone, msg.Valoper, msg.DelegationShardIndex); err != nil {
 		k.Logger(ctx).Error(fmt.Sprintf("Error submitting ICQ for delegation, error : %s", err.Error()))
 		return nil, err
 	}
@@ -739,6 +820,44 @@ func (k msgServer) ResumeHostZone(goCtx context.Context, msg *types.MsgResumeHos
 	return &types.MsgResumeHostZoneResponse{}, nil
 }
 
+// Registers an additional delegation ICA (shard) on a host zone
+// The ICA address is stored on the host zone once the channel is opened
+func (k msgServer) RegisterDelegationShard(goCtx context.Context, msg *types.MsgRegisterDelegationShard) (*types.MsgRegisterDelegationShardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hostZone, found := k.GetHostZone(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrHostZoneNotFound, "host zone %s not found", msg.ChainId)
+	}
+
+	// Get ConnectionEnd (for counterparty connection)
+	connectionEnd, found := k.IBCKeeper.ConnectionKeeper.GetConnection(ctx, hostZone.ConnectionId)
+	if !found {
+		return nil, errorsmod.Wrapf(connectiontypes.ErrConnectionNotFound, "connection %s not found", hostZone.ConnectionId)
+	}
+	counterpartyConnection := connectionEnd.Counterparty
+
+	appVersion := string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
+		Version:                icatypes.Version,
+		ControllerConnectionId: hostZone.ConnectionId,
+		HostConnectionId:       counterpartyConnection.ConnectionId,
+		Encoding:               icatypes.EncodingProtobuf,
+		TxType:                 icatypes.TxTypeSDKMultiMsg,
+	}))
+
+	// Shard 0 is the main delegation ICA, so additional shards are indexed from 1
+	shardIndex := uint32(len(hostZone.DelegationShards) + 1)
+	shardOwner := types.FormatDelegationShardICAOwner(hostZone.ChainId, shardIndex)
+	if err := k.ICAControllerKeeper.RegisterInterchainAccountWithOrdering(ctx, hostZone.ConnectionId, shardOwner, appVersion, channeltypes.ORDERED); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to register delegation shard %d ICA", shardIndex)
+	}
+
+	hostZone.DelegationShards = append(hostZone.DelegationShards, types.DelegationShard{Index: shardIndex})
+	k.SetHostZone(ctx, hostZone)
+
+	return &types.MsgRegisterDelegationShardResponse{}, nil
+}
+
 // Registers or updates a community pool rebate, configuring the rebate percentage and liquid stake amount
 func (k msgServer) SetCommunityPoolRebate(
 	goCtx context.Context,
@@ -789,32 +908,11 @@ func (k msgServer) ToggleTradeController(
 		return nil, types.ErrTradeRouteNotFound.Wrapf("trade route not found for chain ID %s", msg.ChainId)
 	}
 
-	// Build the authz message that grants or revokes trade permissions to the specified address
-	authzMsg, err := k.BuildTradeAuthzMsg(ctx, tradeRoute, msg.PermissionChange, msg.Address, msg.Legacy)
-	if err != nil {
-		return nil, err
-	}
-
-	// Build the ICA channel owner from the trade route
-	tradeRouteAccountOwner := types.FormatTradeRouteICAOwnerFromRouteId(
-		msg.ChainId,
-		tradeRoute.GetRouteId(),
-		types.ICAAccountType_CONVERTER_TRADE,
-	)
-
-	// Submit the ICA tx from the trade ICA account
-	// Timeout the ICA at 1 hour
-	timeoutTimestamp := utils.IntToUint(ctx.BlockTime().Add(time.Hour).UnixNano())
-	err = k.SubmitICATxWithoutCallback(
-		ctx,
-		tradeRoute.TradeAccount.ConnectionId,
-		tradeRouteAccountOwner,
-		authzMsg,
-		timeoutTimestamp,
-	)
-	if err != nil {
+	// Grant or revoke the permissions from the trade ICA
+	if err := k.SubmitTradeAuthzTx(ctx, &tradeRoute, msg.PermissionChange, msg.Address, msg.Legacy); err != nil {
 		return nil, err
 	}
+	k.SetTradeRoute(ctx, tradeRoute)
 
 	return &types.MsgToggleTradeControllerResponse{}, nil
 }
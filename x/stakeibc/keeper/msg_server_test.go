@@ -63,6 +63,142 @@ func (s *KeeperTestSuite) TestUpdateHostZoneParams() {
 	// Check that the max messages was updated
 	hostZone = s.MustGetHostZone(HostChainId)
 	s.Require().Equal(keeper.DefaultMaxMessagesPerIcaTx, hostZone.MaxMessagesPerIcaTx, "max messages")
+	s.Require().Equal(sdk.ZeroDec(), hostZone.MaxRedemptionRateChangePerEpoch, "max change per epoch")
+	s.Require().Equal(sdk.ZeroDec(), hostZone.MaxRedemptionRateChangePerDay, "max change per day")
+
+	// Update it again, this time with velocity limits
+	maxChangePerEpoch := sdk.MustNewDecFromStr("0.01")
+	maxChangePerDay := sdk.MustNewDecFromStr("0.02")
+	validUpdateMsg = types.MsgUpdateHostZoneParams{
+		Authority:                       Authority,
+		ChainId:                         HostChainId,
+		MaxMessagesPerIcaTx:             updatedMessages,
+		MaxRedemptionRateChangePerEpoch: &maxChangePerEpoch,
+		MaxRedemptionRateChangePerDay:   &maxChangePerDay,
+	}
+	_, err = s.GetMsgServer().UpdateHostZoneParams(sdk.WrapSDKContext(s.Ctx), &validUpdateMsg)
+	s.Require().NoError(err, "no error expected when updating velocity limits")
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Equal(maxChangePerEpoch, hostZone.MaxRedemptionRateChangePerEpoch, "max change per epoch")
+	s.Require().Equal(maxChangePerDay, hostZone.MaxRedemptionRateChangePerDay, "max change per day")
+
+	// Update only the max change per day, the per epoch limit should be unchanged
+	updatedMaxChangePerDay := sdk.MustNewDecFromStr("0.03")
+	validUpdateMsg = types.MsgUpdateHostZoneParams{
+		Authority:                     Authority,
+		ChainId:                       HostChainId,
+		MaxMessagesPerIcaTx:           updatedMessages,
+		MaxRedemptionRateChangePerDay: &updatedMaxChangePerDay,
+	}
+	_, err = s.GetMsgServer().UpdateHostZoneParams(sdk.WrapSDKContext(s.Ctx), &validUpdateMsg)
+	s.Require().NoError(err, "no error expected when partially updating velocity limits")
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Equal(maxChangePerEpoch, hostZone.MaxRedemptionRateChangePerEpoch, "max change per epoch after partial update")
+	s.Require().Equal(updatedMaxChangePerDay, hostZone.MaxRedemptionRateChangePerDay, "max change per day after partial update")
+
+	// Explicitly setting a limit to zero should disable it
+	zeroChange := sdk.ZeroDec()
+	validUpdateMsg = types.MsgUpdateHostZoneParams{
+		Authority:                       Authority,
+		ChainId:                         HostChainId,
+		MaxMessagesPerIcaTx:             updatedMessages,
+		MaxRedemptionRateChangePerEpoch: &zeroChange,
+	}
+	_, err = s.GetMsgServer().UpdateHostZoneParams(sdk.WrapSDKContext(s.Ctx), &validUpdateMsg)
+	s.Require().NoError(err, "no error expected when disabling velocity limit")
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Equal(sdk.ZeroDec(), hostZone.MaxRedemptionRateChangePerEpoch, "max change per epoch after disabling")
+	s.Require().Equal(updatedMaxChangePerDay, hostZone.MaxRedemptionRateChangePerDay, "max change per day after disabling")
+
+	// Update it again, overriding some of the epoch cadences
+	epochCadence := types.EpochCadence{
+		DepositInterval:   2,
+		RebalanceInterval: 24,
+	}
+	validUpdateMsg = types.MsgUpdateHostZoneParams{
+		Authority:           Authority,
+		ChainId:             HostChainId,
+		MaxMessagesPerIcaTx: updatedMessages,
+		EpochCadence:        &epochCadence,
+	}
+	_, err = s.GetMsgServer().UpdateHostZoneParams(sdk.WrapSDKContext(s.Ctx), &validUpdateMsg)
+	s.Require().NoError(err, "no error expected when updating epoch cadence")
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Equal(epochCadence, hostZone.EpochCadence, "epoch cadence")
+
+	// Update only the reinvest interval, the other intervals should be unchanged
+	validUpdateMsg = types.MsgUpdateHostZoneParams{
+		Authority:           Authority,
+		ChainId:             HostChainId,
+		MaxMessagesPerIcaTx: updatedMessages,
+		EpochCadence:        &types.EpochCadence{ReinvestInterval: 3},
+	}
+	_, err = s.GetMsgServer().UpdateHostZoneParams(sdk.WrapSDKContext(s.Ctx), &validUpdateMsg)
+	s.Require().NoError(err, "no error expected when partially updating epoch cadence")
+
+	expectedCadence := types.EpochCadence{
+		DepositInterval:   2,
+		ReinvestInterval:  3,
+		RebalanceInterval: 24,
+	}
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Equal(expectedCadence, hostZone.EpochCadence, "epoch cadence after partial update")
+
+	// Omitting the cadence entirely should also leave it unchanged
+	validUpdateMsg = types.MsgUpdateHostZoneParams{
+		Authority:           Authority,
+		ChainId:             HostChainId,
+		MaxMessagesPerIcaTx: updatedMessages,
+	}
+	_, err = s.GetMsgServer().UpdateHostZoneParams(sdk.WrapSDKContext(s.Ctx), &validUpdateMsg)
+	s.Require().NoError(err, "no error expected when updating without a cadence")
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Equal(expectedCadence, hostZone.EpochCadence, "epoch cadence after omitting cadence")
+
+	// Reset the cadence while overriding only the deposit interval, the other intervals
+	// should fall back to the defaults
+	validUpdateMsg = types.MsgUpdateHostZoneParams{
+		Authority:           Authority,
+		ChainId:             HostChainId,
+		MaxMessagesPerIcaTx: updatedMessages,
+		EpochCadence:        &types.EpochCadence{DepositInterval: 4},
+		ResetEpochCadence:   true,
+	}
+	_, err = s.GetMsgServer().UpdateHostZoneParams(sdk.WrapSDKContext(s.Ctx), &validUpdateMsg)
+	s.Require().NoError(err, "no error expected when resetting epoch cadence with an override")
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Equal(types.EpochCadence{DepositInterval: 4}, hostZone.EpochCadence, "epoch cadence after reset with override")
+
+	// Reset the cadence without any overrides, every interval should fall back to the default
+	validUpdateMsg = types.MsgUpdateHostZoneParams{
+		Authority:           Authority,
+		ChainId:             HostChainId,
+		MaxMessagesPerIcaTx: updatedMessages,
+		ResetEpochCadence:   true,
+	}
+	_, err = s.GetMsgServer().UpdateHostZoneParams(sdk.WrapSDKContext(s.Ctx), &validUpdateMsg)
+	s.Require().NoError(err, "no error expected when resetting epoch cadence")
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Equal(types.EpochCadence{}, hostZone.EpochCadence, "epoch cadence after reset")
+
+	// A rebalance interval that's not a multiple of the stride epochs per day could line up
+	// with the day epoch, and should be rejected
+	strideEpochsPerDay := s.App.StakeibcKeeper.GetStrideEpochsPerDayEpoch(s.Ctx)
+	invalidCadenceMsg := types.MsgUpdateHostZoneParams{
+		Authority:           Authority,
+		ChainId:             HostChainId,
+		MaxMessagesPerIcaTx: updatedMessages,
+		EpochCadence:        &types.EpochCadence{RebalanceInterval: strideEpochsPerDay + 1},
+	}
+	_, err = s.GetMsgServer().UpdateHostZoneParams(sdk.WrapSDKContext(s.Ctx), &invalidCadenceMsg)
+	s.Require().ErrorContains(err, "rebalance interval")
 
 	// Attempt it again with an invalid chain ID, it should fail
 	invalidUpdateMsg := types.MsgUpdateHostZoneParams{
@@ -182,16 +318,17 @@ func (s *KeeperTestSuite) TestAddValidators_Successful() {
 		s.Require().Equal(*tc.expectedValidators[i], *hostZone.Validators[i], "validators %d", i)
 	}
 
-	// Confirm ICQs were submitted
+	// Confirm a single batched ICQ was submitted for all validators
 	queries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
-	s.Require().Len(queries, 3)
+	s.Require().Len(queries, 1)
+	s.Require().Equal(keeper.ICQCallbackID_Validators, queries[0].CallbackId, "query callback ID")
 
-	// Map the query responses to the validator names to get the names of the validators that
+	// Map the query keys to the validator names to get the names of the validators that
 	// were queried
 	queriedValidators := []string{}
-	for i, query := range queries {
-		validator, ok := tc.validatorQueryDataToName[string(query.RequestData)]
-		s.Require().True(ok, "query from response %d does not match any expected requests", i)
+	for i, queryKey := range queries[0].BatchRequestData {
+		validator, ok := tc.validatorQueryDataToName[string(queryKey)]
+		s.Require().True(ok, "query key %d does not match any expected requests", i)
 		queriedValidators = append(queriedValidators, validator)
 	}
 
@@ -1334,6 +1471,7 @@ func (s *KeeperTestSuite) submitCreateTradeRouteAndValidate(msg types.MsgCreateT
 	s.Require().Equal(expectedRoute.TradeToHostChannelId, actualRoute.TradeToHostChannelId, "trade route trade to host")
 
 	s.Require().Equal(expectedRoute.MinTransferAmount, actualRoute.MinTransferAmount, "trade route min transfer amount")
+	s.Require().Equal(expectedRoute.AdditionalLegs, actualRoute.AdditionalLegs, "trade route additional legs")
 }
 
 // Tests a successful trade route creation
@@ -1342,6 +1480,45 @@ func (s *KeeperTestSuite) TestCreateTradeRoute_Success() {
 	s.submitCreateTradeRouteAndValidate(msg, expectedRoute)
 }
 
+// Tests a successful trade route creation with an additional trade leg
+func (s *KeeperTestSuite) TestCreateTradeRoute_Success_AdditionalLegs() {
+	msg, expectedRoute := s.SetupTestCreateTradeRoute()
+
+	// Mock out a connection for the second trade zone
+	legChainId := "trade-1"
+	legConnectionId := "connection-3"
+	s.MockClientAndConnection(legChainId, "07-tendermint-2", legConnectionId)
+
+	swapConfig := types.SwapConfig{
+		PoolId:              5,
+		RewardDenomOnStride: "ibc/intermediate-on-stride",
+		HostDenomOnStride:   "ibc/host-on-stride",
+		MaxSlippage:         sdk.MustNewDecFromStr("0.05"),
+		MaxSwapAmount:       sdkmath.ZeroInt(),
+	}
+	msg.AdditionalLegs = []types.TradeLegConfig{{
+		StrideToTradeConnectionId: legConnectionId,
+		InboundTransferChannelId:  "channel-400",
+		InputDenomOnTrade:         "ibc/intermediate-on-trade-1",
+		OutputDenomOnTrade:        "ibc/host-on-trade-1",
+		SwapConfig:                &swapConfig,
+	}}
+
+	expectedRoute.AdditionalLegs = []types.TradeLeg{{
+		TradeAccount: types.ICAAccount{
+			ChainId:      legChainId,
+			Type:         types.ICAAccountType_CONVERTER_TRADE,
+			ConnectionId: legConnectionId,
+		},
+		InputDenomOnTradeZone:  "ibc/intermediate-on-trade-1",
+		OutputDenomOnTradeZone: "ibc/host-on-trade-1",
+		InboundChannelId:       "channel-400",
+		SwapConfig:             &swapConfig,
+	}}
+
+	s.submitCreateTradeRouteAndValidate(msg, expectedRoute)
+}
+
 // Tests trying to create a route from an invalid authority
 func (s *KeeperTestSuite) TestCreateTradeRoute_Failure_Authority() {
 	msg, _ := s.SetupTestCreateTradeRoute()
@@ -1459,6 +1636,24 @@ func (s *KeeperTestSuite) TestUpdateTradeRoute() {
 	// Update the route and confirm the changes persisted
 	s.submitUpdateTradeRouteAndValidate(msg, expectedRoute)
 
+	// Add a swap config to the route and confirm it persisted
+	swapConfig := types.SwapConfig{
+		PoolId:              1,
+		RewardDenomOnStride: "ibc/reward",
+		HostDenomOnStride:   "ibc/host",
+		MaxSlippage:         sdk.MustNewDecFromStr("0.05"),
+		MaxSwapAmount:       sdkmath.NewInt(1000),
+	}
+	msgWithSwapConfig := msg
+	msgWithSwapConfig.SwapConfig = &swapConfig
+
+	expectedRoute.SwapConfig = &swapConfig
+	s.submitUpdateTradeRouteAndValidate(msgWithSwapConfig, expectedRoute)
+
+	// Remove the swap config and confirm the route was reverted
+	expectedRoute.SwapConfig = nil
+	s.submitUpdateTradeRouteAndValidate(msg, expectedRoute)
+
 	// Test that an error is thrown if the correct authority is not specified
 	invalidMsg := msg
 	invalidMsg.Authority = "not-gov-address"
@@ -1474,6 +1669,104 @@ func (s *KeeperTestSuite) TestUpdateTradeRoute() {
 	s.Require().ErrorContains(err, "trade route not found")
 }
 
+func (s *KeeperTestSuite) TestUpdateTradeRoute_RevokeTradeController() {
+	tradeICAOwner := types.FormatTradeRouteICAOwner(HostChainId, RewardDenom, HostDenom, types.ICAAccountType_CONVERTER_TRADE)
+	channelId, portId := s.CreateICAChannel(tradeICAOwner)
+
+	// Create a trade route that has granted permissions to a trade controller
+	initialRoute := types.TradeRoute{
+		RewardDenomOnRewardZone: RewardDenom,
+		HostDenomOnHostZone:     HostDenom,
+		TradeAccount: types.ICAAccount{
+			ChainId:      HostChainId,
+			ConnectionId: ibctesting.FirstConnectionID,
+		},
+		TradeController: "trade-controller",
+	}
+	s.App.StakeibcKeeper.SetTradeRoute(s.Ctx, initialRoute)
+
+	// Updating the route without a swap config should not revoke the grant
+	msg := types.MsgUpdateTradeRoute{
+		Authority:         Authority,
+		RewardDenom:       RewardDenom,
+		HostDenom:         HostDenom,
+		MinTransferAmount: sdkmath.NewInt(100),
+	}
+	startSequence := s.MustGetNextSequenceNumber(portId, channelId)
+	_, err := s.GetMsgServer().UpdateTradeRoute(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when updating trade route")
+
+	endSequence := s.MustGetNextSequenceNumber(portId, channelId)
+	s.Require().Equal(startSequence, endSequence, "no ICA should have been submitted")
+
+	// Switching to on-chain swaps should revoke the grant
+	msg.SwapConfig = &types.SwapConfig{
+		PoolId:              1,
+		RewardDenomOnStride: "ibc/reward",
+		HostDenomOnStride:   "ibc/host",
+		MaxSlippage:         sdk.MustNewDecFromStr("0.05"),
+		MaxSwapAmount:       sdkmath.NewInt(1000),
+	}
+	s.CheckICATxSubmitted(portId, channelId, func() error {
+		_, err := s.GetMsgServer().UpdateTradeRoute(sdk.WrapSDKContext(s.Ctx), &msg)
+		return err
+	})
+
+	actualRoute, found := s.App.StakeibcKeeper.GetTradeRoute(s.Ctx, RewardDenom, HostDenom)
+	s.Require().True(found, "trade route should have been found")
+	s.Require().Empty(actualRoute.TradeController, "trade controller should have been cleared")
+	s.Require().Equal(msg.SwapConfig, actualRoute.SwapConfig, "swap config")
+
+	// Once revoked, subsequent updates should not submit another revoke
+	startSequence = s.MustGetNextSequenceNumber(portId, channelId)
+	_, err = s.GetMsgServer().UpdateTradeRoute(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when updating trade route again")
+
+	endSequence = s.MustGetNextSequenceNumber(portId, channelId)
+	s.Require().Equal(startSequence, endSequence, "no ICA should have been submitted after revoking")
+}
+
+func (s *KeeperTestSuite) TestUpdateTradeRoute_AdditionalLegSwapConfigs() {
+	// Create a trade route with one additional leg
+	initialRoute := types.TradeRoute{
+		RewardDenomOnRewardZone: RewardDenom,
+		HostDenomOnHostZone:     HostDenom,
+		AdditionalLegs: []types.TradeLeg{{
+			InputDenomOnTradeZone:  "ibc/intermediate-on-trade-1",
+			OutputDenomOnTradeZone: "ibc/host-on-trade-1",
+		}},
+	}
+	s.App.StakeibcKeeper.SetTradeRoute(s.Ctx, initialRoute)
+
+	legSwapConfig := types.SwapConfig{
+		PoolId:              2,
+		RewardDenomOnStride: "ibc/intermediate",
+		HostDenomOnStride:   "ibc/host",
+		MaxSlippage:         sdk.MustNewDecFromStr("0.05"),
+		MaxSwapAmount:       sdkmath.NewInt(1000),
+	}
+	msg := types.MsgUpdateTradeRoute{
+		Authority:                Authority,
+		RewardDenom:              RewardDenom,
+		HostDenom:                HostDenom,
+		MinTransferAmount:        sdkmath.NewInt(100),
+		AdditionalLegSwapConfigs: []types.SwapConfig{legSwapConfig},
+	}
+
+	_, err := s.GetMsgServer().UpdateTradeRoute(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when updating leg swap configs")
+
+	actualRoute, found := s.App.StakeibcKeeper.GetTradeRoute(s.Ctx, RewardDenom, HostDenom)
+	s.Require().True(found, "trade route should have been found")
+	s.Require().Equal(&legSwapConfig, actualRoute.AdditionalLegs[0].SwapConfig, "leg swap config")
+
+	// Updating with a different number of leg configs than legs should fail
+	invalidMsg := msg
+	invalidMsg.AdditionalLegSwapConfigs = []types.SwapConfig{legSwapConfig, legSwapConfig}
+	_, err = s.GetMsgServer().UpdateTradeRoute(sdk.WrapSDKContext(s.Ctx), &invalidMsg)
+	s.Require().ErrorContains(err, "expected 1 additional leg swap configs, got 2")
+}
+
 // ----------------------------------------------------
 //	           RestoreInterchainAccount
 // ----------------------------------------------------
@@ -2060,6 +2353,44 @@ func (s *KeeperTestSuite) TestResumeHostZone_UnhaltedZones() {
 	s.Require().Error(err, "host zone GAIA is not halted")
 }
 
+// ----------------------------------------------------
+//	           RegisterDelegationShard
+// ----------------------------------------------------
+
+func (s *KeeperTestSuite) TestRegisterDelegationShard() {
+	connectionId := "connection-0"
+	s.MockClientAndConnection(HostChainId, "07-tendermint-0", connectionId)
+
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{
+		ChainId:      HostChainId,
+		ConnectionId: connectionId,
+	})
+
+	// Register two shards, they should be indexed starting from 1
+	msg := types.MsgRegisterDelegationShard{ChainId: HostChainId}
+	for _, expectedIndex := range []uint32{1, 2} {
+		_, err := s.GetMsgServer().RegisterDelegationShard(s.Ctx, &msg)
+		s.Require().NoError(err, "no error expected when registering shard %d", expectedIndex)
+
+		hostZone := s.MustGetHostZone(HostChainId)
+		s.Require().Len(hostZone.DelegationShards, int(expectedIndex), "number of shards")
+		s.Require().Equal(expectedIndex, hostZone.DelegationShards[expectedIndex-1].Index, "shard index")
+		s.Require().Empty(hostZone.DelegationShards[expectedIndex-1].IcaAddress, "shard address before channel opened")
+	}
+
+	// Attempt to register a shard on a host zone that doesn't exist
+	_, err := s.GetMsgServer().RegisterDelegationShard(s.Ctx, &types.MsgRegisterDelegationShard{ChainId: "fake_chain"})
+	s.Require().ErrorContains(err, "host zone fake_chain not found")
+
+	// Remove the connection and attempt to register again
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{
+		ChainId:      HostChainId,
+		ConnectionId: "connection-1",
+	})
+	_, err = s.GetMsgServer().RegisterDelegationShard(s.Ctx, &msg)
+	s.Require().ErrorContains(err, "connection connection-1 not found")
+}
+
 // ----------------------------------------------------
 //	           SetCommunityPoolRebate
 // ----------------------------------------------------
@@ -2150,6 +2481,11 @@ func (s *KeeperTestSuite) TestToggleTradeController() {
 		return err
 	})
 
+	// Confirm the trade controller was recorded on the route
+	updatedRoute, found := s.App.StakeibcKeeper.GetTradeRoute(s.Ctx, RewardDenom, HostDenom)
+	s.Require().True(found, "trade route should have been found")
+	s.Require().Equal(tradeControllerAddress, updatedRoute.TradeController, "trade controller after grant")
+
 	// Test revoking permissions
 	revokeMsg := types.MsgToggleTradeController{
 		ChainId:          HostChainId,
@@ -2161,6 +2497,11 @@ func (s *KeeperTestSuite) TestToggleTradeController() {
 		return err
 	})
 
+	// Confirm the trade controller was cleared
+	updatedRoute, found = s.App.StakeibcKeeper.GetTradeRoute(s.Ctx, RewardDenom, HostDenom)
+	s.Require().True(found, "trade route should have been found")
+	s.Require().Empty(updatedRoute.TradeController, "trade controller after revoke")
+
 	// Test with an invalid chain ID - it should fail because the trade route cant be found
 	invalidMsg := &types.MsgToggleTradeController{ChainId: "invalid-chain"}
 	_, err := s.GetMsgServer().ToggleTradeController(s.Ctx, invalidMsg)
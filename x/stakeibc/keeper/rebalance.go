@@ -29,8 +29,11 @@ type RebalanceValidatorDelegationChange struct {
 // This is required when accepting LSM LiquidStakes as the distribution of stake
 // from the LSM Tokens will be inconsistend with the host zone's validator set
 //
+// Rebalancing is only checked on stride epochs that line up with the host zone's
+// rebalance interval
+//
 // Note: this cannot be run more than once in a single unbonding period
-func (k Keeper) RebalanceAllHostZones(ctx sdk.Context) {
+func (k Keeper) RebalanceAllHostZones(ctx sdk.Context, epochNumber uint64) {
 	dayEpoch, found := k.GetEpochTracker(ctx, epochstypes.DAY_EPOCH)
 	if !found {
 		k.Logger(ctx).Error("Unable to get day epoch tracker")
@@ -38,6 +41,13 @@ func (k Keeper) RebalanceAllHostZones(ctx sdk.Context) {
 	}
 
 	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+		rebalanceInterval := k.GetHostZoneEpochCadence(ctx, hostZone).RebalanceInterval
+		if epochNumber%rebalanceInterval != 0 {
+			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+				"Host does not check rebalancing this epoch (Interval: %d, Epoch: %d)", rebalanceInterval, epochNumber))
+			continue
+		}
+
 		// We add 1 to the UnbondingPeriod to avoid any race conditions
 		// In particular, you can only rebalance _away_ from a validator once per UnbondingPeriod
 		// Roughly half the time, a rebalance message will get sent a few seconds _before_
@@ -75,7 +85,48 @@ func (k Keeper) RebalanceDelegationsForHostZone(ctx sdk.Context, chainId string)
 		return errorsmod.Wrapf(err, "unable to get validator deltas for host zone %s", chainId)
 	}
 
-	msgs, rebalancings := k.GetRebalanceICAMessages(hostZone, valDeltaList)
+	_, rebalancings := k.GetRebalanceICAMessages(hostZone, valDeltaList)
+
+	// Determine which delegation shard should submit each redelegation
+	shardRebalancings, err := k.SplitRebalancingsByShard(hostZone, rebalancings)
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to split rebalancings across delegation shards for %s", chainId)
+	}
+
+	for _, shardIndex := range hostZone.GetDelegationShardIndexes() {
+		if err := k.SubmitShardRebalanceICAs(ctx, &hostZone, shardIndex, shardRebalancings[shardIndex]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Submits the redelegations for a single delegation shard, in batches
+func (k Keeper) SubmitShardRebalanceICAs(
+	ctx sdk.Context,
+	hostZone *types.HostZone,
+	shardIndex uint32,
+	rebalancings []*types.Rebalancing,
+) error {
+	if len(rebalancings) == 0 {
+		return nil
+	}
+
+	delegatorAddress, err := k.GetDelegationShardAddress(*hostZone, shardIndex)
+	if err != nil {
+		return err
+	}
+
+	msgs := []proto.Message{}
+	for _, rebalancing := range rebalancings {
+		msgs = append(msgs, &stakingtypes.MsgBeginRedelegate{
+			DelegatorAddress:    delegatorAddress,
+			ValidatorSrcAddress: rebalancing.SrcValidator,
+			ValidatorDstAddress: rebalancing.DstValidator,
+			Amount:              sdk.NewCoin(hostZone.HostDenom, rebalancing.Amt),
+		})
+	}
 
 	for start := 0; start < len(msgs); start += RebalanceIcaBatchSize {
 		end := start + RebalanceIcaBatchSize
@@ -88,8 +139,9 @@ func (k Keeper) RebalanceDelegationsForHostZone(ctx sdk.Context, chainId string)
 
 		// marshall the callback
 		rebalanceCallback := types.RebalanceCallback{
-			HostZoneId:   hostZone.ChainId,
-			Rebalancings: rebalancingsBatch,
+			HostZoneId:           hostZone.ChainId,
+			Rebalancings:         rebalancingsBatch,
+			DelegationShardIndex: shardIndex,
 		}
 		rebalanceCallbackBz, err := k.MarshalRebalanceCallbackArgs(ctx, rebalanceCallback)
 		if err != nil {
@@ -97,11 +149,12 @@ func (k Keeper) RebalanceDelegationsForHostZone(ctx sdk.Context, chainId string)
 		}
 
 		// Submit the rebalance ICA
-		_, err = k.SubmitTxsStrideEpoch(
+		_, err = k.SubmitDelegationShardTxsEpoch(
 			ctx,
-			hostZone.ConnectionId,
+			*hostZone,
+			shardIndex,
 			msgsBatch,
-			types.ICAAccountType_DELEGATION,
+			epochstypes.STRIDE_EPOCH,
 			ICACallbackID_Rebalance,
 			rebalanceCallbackBz,
 		)
@@ -111,14 +164,14 @@ func (k Keeper) RebalanceDelegationsForHostZone(ctx sdk.Context, chainId string)
 
 		// flag the delegation change in progress on each validator
 		for _, rebalancing := range rebalancingsBatch {
-			if err := k.IncrementValidatorDelegationChangesInProgress(&hostZone, rebalancing.SrcValidator); err != nil {
+			if err := k.IncrementValidatorDelegationChangesInProgress(hostZone, rebalancing.SrcValidator); err != nil {
 				return err
 			}
-			if err := k.IncrementValidatorDelegationChangesInProgress(&hostZone, rebalancing.DstValidator); err != nil {
+			if err := k.IncrementValidatorDelegationChangesInProgress(hostZone, rebalancing.DstValidator); err != nil {
 				return err
 			}
 		}
-		k.SetHostZone(ctx, hostZone)
+		k.SetHostZone(ctx, *hostZone)
 	}
 
 	return nil
@@ -3,7 +3,9 @@ package keeper
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -13,6 +15,9 @@ import (
 	"github.com/Stride-Labs/stride/v27/x/stakeibc/types"
 )
 
+// The length of the rolling window used to enforce the per-day redemption rate velocity limit
+const RedemptionRateHistoryWindow = 24 * time.Hour
+
 // Updates the redemption rate for each host zone
 // At a high level, the redemption rate is equal to the amount of native tokens locked divided by the stTokens in existence.
 // The equation is broken down further into the following sub-components:
@@ -27,11 +32,17 @@ import (
 //
 //	Redemption Rate =
 //	(Deposit Account Balance + Undelegated Balance + Tokenized Delegation + Native Delegation) / (stToken Supply)
-func (k Keeper) UpdateRedemptionRates(ctx sdk.Context, depositRecords []recordstypes.DepositRecord) {
+func (k Keeper) UpdateRedemptionRates(ctx sdk.Context, epochNumber uint64, depositRecords []recordstypes.DepositRecord) {
 	k.Logger(ctx).Info("Updating Redemption Rates...")
 
-	// Update the redemption rate for each host zone
+	// Update the redemption rate for each host zone that's scheduled to update this epoch
 	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+		redemptionRateInterval := k.GetHostZoneEpochCadence(ctx, hostZone).RedemptionRateInterval
+		if epochNumber%redemptionRateInterval != 0 {
+			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+				"Host does not update redemption rate this epoch (Interval: %d, Epoch: %d)", redemptionRateInterval, epochNumber))
+			continue
+		}
 		k.UpdateRedemptionRateForHostZone(ctx, hostZone, depositRecords)
 	}
 }
@@ -63,11 +74,35 @@ func (k Keeper) UpdateRedemptionRateForHostZone(ctx sdk.Context, hostZone types.
 	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
 		"New Redemption Rate: %v (vs Prev Rate: %v)", redemptionRate, hostZone.RedemptionRate))
 
+	// Check how quickly the rate is moving against the previous rate and the last day of history
+	velocityErr := k.CheckRedemptionRateVelocity(ctx, hostZone, redemptionRate)
+
 	// Update the host zone
 	hostZone.LastRedemptionRate = hostZone.RedemptionRate
 	hostZone.RedemptionRate = redemptionRate
+	hostZone.RedemptionRateHistory = append(
+		k.GetRecentRedemptionRateHistory(ctx, hostZone),
+		types.RedemptionRateSnapshot{RedemptionRate: redemptionRate, Time: ctx.BlockTime()},
+	)
 	k.SetHostZone(ctx, hostZone)
 
+	// If the redemption rate moved too quickly, halt the zone and exit so the rate is not pushed to the oracle
+	if velocityErr != nil {
+		k.Logger(ctx).Error(utils.LogWithHostZone(hostZone.ChainId,
+			"Redemption rate velocity limit exceeded, halting zone: %s", velocityErr.Error()))
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRedemptionRateVelocityExceeded,
+				sdk.NewAttribute(types.AttributeKeyHostZone, hostZone.ChainId),
+				sdk.NewAttribute(types.AttributeKeyRedemptionRate, redemptionRate.String()),
+				sdk.NewAttribute(types.AttributeKeyLastRedemptionRate, hostZone.LastRedemptionRate.String()),
+				sdk.NewAttribute(types.AttributeKeyError, velocityErr.Error()),
+			),
+		)
+		k.HaltHostZone(ctx, hostZone)
+		return
+	}
+
 	// If the redemption rate is outside of safety bounds, exit so the redemption rate is not pushed to the oracle
 	redemptionRateSafe, _ := k.IsRedemptionRateWithinSafetyBounds(ctx, hostZone)
 	if !redemptionRateSafe {
@@ -81,6 +116,59 @@ func (k Keeper) UpdateRedemptionRateForHostZone(ctx sdk.Context, hostZone types.
 	}
 }
 
+// Returns the redemption rate snapshots from the host zone that were recorded within
+// the last day (relative to the current block time)
+func (k Keeper) GetRecentRedemptionRateHistory(ctx sdk.Context, hostZone types.HostZone) []types.RedemptionRateSnapshot {
+	windowStart := ctx.BlockTime().Add(-RedemptionRateHistoryWindow)
+
+	recentHistory := []types.RedemptionRateSnapshot{}
+	for _, snapshot := range hostZone.RedemptionRateHistory {
+		if snapshot.Time.After(windowStart) {
+			recentHistory = append(recentHistory, snapshot)
+		}
+	}
+	return recentHistory
+}
+
+// Confirms the new redemption rate has not moved too quickly, relative to either
+// the previous rate (per epoch limit) or any rate from the last day (per day limit)
+// Each check is skipped if the associated limit is not set on the host zone
+func (k Keeper) CheckRedemptionRateVelocity(ctx sdk.Context, hostZone types.HostZone, newRedemptionRate sdk.Dec) error {
+	maxChangePerEpoch := hostZone.MaxRedemptionRateChangePerEpoch
+	if !maxChangePerEpoch.IsNil() && maxChangePerEpoch.IsPositive() {
+		change, ok := GetRelativeRateChange(hostZone.RedemptionRate, newRedemptionRate)
+		if ok && change.GT(maxChangePerEpoch) {
+			return errorsmod.Wrapf(types.ErrRedemptionRateVelocityExceeded,
+				"redemption rate moved from %v to %v (%v), exceeding the max change per epoch of %v",
+				hostZone.RedemptionRate, newRedemptionRate, change, maxChangePerEpoch)
+		}
+	}
+
+	maxChangePerDay := hostZone.MaxRedemptionRateChangePerDay
+	if !maxChangePerDay.IsNil() && maxChangePerDay.IsPositive() {
+		for _, snapshot := range k.GetRecentRedemptionRateHistory(ctx, hostZone) {
+			change, ok := GetRelativeRateChange(snapshot.RedemptionRate, newRedemptionRate)
+			if ok && change.GT(maxChangePerDay) {
+				return errorsmod.Wrapf(types.ErrRedemptionRateVelocityExceeded,
+					"redemption rate moved from %v (at %v) to %v (%v), exceeding the max change per day of %v",
+					snapshot.RedemptionRate, snapshot.Time, newRedemptionRate, change, maxChangePerDay)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Returns the absolute change between two rates, relative to the previous rate
+// (i.e. |new - prev| / prev)
+// Returns false if the previous rate was never set, in which case there's nothing to compare against
+func GetRelativeRateChange(previousRate, newRate sdk.Dec) (change sdk.Dec, ok bool) {
+	if previousRate.IsNil() || !previousRate.IsPositive() {
+		return sdk.ZeroDec(), false
+	}
+	return newRate.Sub(previousRate).Abs().Quo(previousRate), true
+}
+
 // Determine the deposit account balance, representing native tokens that have been deposited
 // from liquid stakes, but have not yet been transferred to the host
 func (k Keeper) GetDepositAccountBalance(chainId string, depositRecords []recordstypes.DepositRecord) sdk.Dec {
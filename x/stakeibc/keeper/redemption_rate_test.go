@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"math/rand"
 	"strconv"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -85,13 +86,37 @@ func (s *KeeperTestSuite) TestUpdateRedemptionRatesSuccessful() {
 		initialRedemptionRate: sdk.NewDec(1),
 	})
 
-	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, depositRecords)
+	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, 1, depositRecords)
 
 	// 2 + 3 + 4 + 5 / 10 = 14 / 10 = 1.4
 	expectedNewRate := sdk.MustNewDecFromStr("1.4")
 	s.checkRedemptionRateAfterUpdate(expectedNewRate)
 }
 
+func (s *KeeperTestSuite) TestUpdateRedemptionRate_EpochCadence() {
+	depositRecords := s.SetupUpdateRedemptionRates(UpdateRedemptionRateTestCase{
+		totalDelegation:       sdkmath.NewInt(2),
+		undelegatedBal:        sdkmath.NewInt(3),
+		justDepositedNative:   sdkmath.NewInt(4),
+		justDepositedLSM:      sdkmath.NewInt(5),
+		stSupply:              sdkmath.NewInt(10),
+		initialRedemptionRate: sdk.NewDec(1),
+	})
+
+	// Override the host zone so that the redemption rate is only updated every 3 epochs
+	hostZone := s.MustGetHostZone(HostChainId)
+	hostZone.EpochCadence.RedemptionRateInterval = 3
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	// The rate should not be updated on an epoch that's not a multiple of the interval
+	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, 2, depositRecords)
+	s.checkRedemptionRateAfterUpdate(sdk.NewDec(1))
+
+	// But it should be updated on the next epoch
+	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, 3, depositRecords)
+	s.checkRedemptionRateAfterUpdate(sdk.MustNewDecFromStr("1.4"))
+}
+
 func (s *KeeperTestSuite) TestUpdateRedemptionRate_ZeroStAssets() {
 	depositRecords := s.SetupUpdateRedemptionRates(UpdateRedemptionRateTestCase{
 		totalDelegation:       sdkmath.NewInt(2),
@@ -102,7 +127,7 @@ func (s *KeeperTestSuite) TestUpdateRedemptionRate_ZeroStAssets() {
 		initialRedemptionRate: sdk.NewDec(1),
 	})
 
-	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, depositRecords)
+	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, 1, depositRecords)
 
 	expectedRedemptionRate := sdk.NewDec(1)
 	s.checkRedemptionRateAfterUpdate(expectedRedemptionRate)
@@ -118,7 +143,7 @@ func (s *KeeperTestSuite) TestUpdateRedemptionRate_ZeroNativeAssets() {
 		initialRedemptionRate: sdk.NewDec(1),
 	})
 
-	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, depositRecords)
+	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, 1, depositRecords)
 
 	expectedRedemptionRate := sdk.ZeroDec()
 	s.checkRedemptionRateAfterUpdate(expectedRedemptionRate)
@@ -141,7 +166,7 @@ func (s *KeeperTestSuite) TestUpdateRedemptionRate_NoDepositAccountRecords() {
 			filteredRecords = append(filteredRecords, record)
 		}
 	}
-	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, filteredRecords)
+	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, 1, filteredRecords)
 
 	// 3 + 4 + 6 / 10 = 13 / 10 = 1.3
 	expectedNewRate := sdk.MustNewDecFromStr("1.3")
@@ -165,7 +190,7 @@ func (s *KeeperTestSuite) TestUpdateRedemptionRate_NoStakeDepositRecords() {
 			filteredRecords = append(filteredRecords, record)
 		}
 	}
-	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, filteredRecords)
+	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, 1, filteredRecords)
 
 	// 3 + 5 + 6 / 10 = 14 / 10 = 1.4
 	expectedNewRate := sdk.MustNewDecFromStr("1.4")
@@ -182,7 +207,7 @@ func (s *KeeperTestSuite) TestUpdateRedemptionRate_NoTotalDelegation() {
 		initialRedemptionRate: sdk.NewDec(1),
 	})
 
-	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, depositRecords)
+	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, 1, depositRecords)
 
 	// 3 + 4 + 5 / 10 = 12 / 10 = 1.2
 	expectedNewRate := sdk.MustNewDecFromStr("1.2")
@@ -209,13 +234,168 @@ func (s *KeeperTestSuite) TestUpdateRedemptionRate_RandomInitialRedemptionRate()
 		initialRedemptionRate: initialRedemptionRate,
 	})
 
-	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, depositRecords)
+	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, 1, depositRecords)
 
 	// 2 + 3 + 4 + 5 / 10 = 14 / 10 = 1.4
 	expectedNewRate := sdk.MustNewDecFromStr("1.4")
 	s.checkRedemptionRateAfterUpdate(expectedNewRate)
 }
 
+func (s *KeeperTestSuite) TestUpdateRedemptionRate_VelocityLimitExceeded() {
+	depositRecords := s.SetupUpdateRedemptionRates(UpdateRedemptionRateTestCase{
+		totalDelegation:       sdkmath.NewInt(2),
+		undelegatedBal:        sdkmath.NewInt(3),
+		justDepositedNative:   sdkmath.NewInt(4),
+		justDepositedLSM:      sdkmath.NewInt(5),
+		stSupply:              sdkmath.NewInt(10),
+		initialRedemptionRate: sdk.MustNewDecFromStr("1.3"),
+	})
+
+	// Set a 5% velocity limit - the rate will move from 1.3 to 1.4 (~7.7%)
+	hostZone := s.MustGetHostZone(HostChainId)
+	hostZone.MaxRedemptionRateChangePerEpoch = sdk.MustNewDecFromStr("0.05")
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, 1, depositRecords)
+
+	// The rate should still be updated, but the zone should be halted and the stToken blacklisted
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Equal(sdk.MustNewDecFromStr("1.4"), hostZone.RedemptionRate, "redemption rate")
+	s.Require().Equal(sdk.MustNewDecFromStr("1.3"), hostZone.LastRedemptionRate, "last redemption rate")
+	s.Require().True(hostZone.Halted, "host zone should be halted")
+	s.Require().True(s.App.RatelimitKeeper.IsDenomBlacklisted(s.Ctx, StAtom), "stToken should be blacklisted")
+
+	// The new rate should have been added to the history
+	s.Require().Len(hostZone.RedemptionRateHistory, 1, "history length")
+	s.Require().Equal(sdk.MustNewDecFromStr("1.4"), hostZone.RedemptionRateHistory[0].RedemptionRate, "history rate")
+}
+
+func (s *KeeperTestSuite) TestUpdateRedemptionRate_VelocityLimitNotExceeded() {
+	depositRecords := s.SetupUpdateRedemptionRates(UpdateRedemptionRateTestCase{
+		totalDelegation:       sdkmath.NewInt(2),
+		undelegatedBal:        sdkmath.NewInt(3),
+		justDepositedNative:   sdkmath.NewInt(4),
+		justDepositedLSM:      sdkmath.NewInt(5),
+		stSupply:              sdkmath.NewInt(10),
+		initialRedemptionRate: sdk.MustNewDecFromStr("1.3"),
+	})
+
+	// Set a 10% velocity limit - the rate will move from 1.3 to 1.4 (~7.7%)
+	hostZone := s.MustGetHostZone(HostChainId)
+	hostZone.MaxRedemptionRateChangePerEpoch = sdk.MustNewDecFromStr("0.1")
+	hostZone.MaxRedemptionRateChangePerDay = sdk.MustNewDecFromStr("0.1")
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, 1, depositRecords)
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Equal(sdk.MustNewDecFromStr("1.4"), hostZone.RedemptionRate, "redemption rate")
+	s.Require().False(hostZone.Halted, "host zone should not be halted")
+	s.Require().False(s.App.RatelimitKeeper.IsDenomBlacklisted(s.Ctx, StAtom), "stToken should not be blacklisted")
+}
+
+func (s *KeeperTestSuite) TestCheckRedemptionRateVelocity() {
+	blockTime := s.Ctx.BlockTime()
+	s.Ctx = s.Ctx.WithBlockTime(blockTime)
+
+	history := []types.RedemptionRateSnapshot{
+		// Outside of the 24 hour window - should be ignored
+		{RedemptionRate: sdk.MustNewDecFromStr("1.0"), Time: blockTime.Add(-25 * time.Hour)},
+		// Within the window
+		{RedemptionRate: sdk.MustNewDecFromStr("1.10"), Time: blockTime.Add(-18 * time.Hour)},
+		{RedemptionRate: sdk.MustNewDecFromStr("1.12"), Time: blockTime.Add(-12 * time.Hour)},
+		{RedemptionRate: sdk.MustNewDecFromStr("1.14"), Time: blockTime.Add(-6 * time.Hour)},
+	}
+
+	testCases := []struct {
+		name              string
+		maxChangePerEpoch sdk.Dec
+		maxChangePerDay   sdk.Dec
+		newRate           sdk.Dec
+		expectedError     string
+	}{
+		{
+			name:    "no limits set",
+			newRate: sdk.MustNewDecFromStr("5.0"),
+		},
+		{
+			name:              "zero limits",
+			maxChangePerEpoch: sdk.ZeroDec(),
+			maxChangePerDay:   sdk.ZeroDec(),
+			newRate:           sdk.MustNewDecFromStr("5.0"),
+		},
+		{
+			name:              "within per epoch limit",
+			maxChangePerEpoch: sdk.MustNewDecFromStr("0.05"),
+			newRate:           sdk.MustNewDecFromStr("1.19"), // 4.4% from 1.14
+		},
+		{
+			name:              "exceeds per epoch limit on increase",
+			maxChangePerEpoch: sdk.MustNewDecFromStr("0.05"),
+			newRate:           sdk.MustNewDecFromStr("1.20"), // 5.3% from 1.14
+			expectedError:     "exceeding the max change per epoch",
+		},
+		{
+			name:              "exceeds per epoch limit on decrease",
+			maxChangePerEpoch: sdk.MustNewDecFromStr("0.05"),
+			newRate:           sdk.MustNewDecFromStr("1.08"), // 5.3% from 1.14
+			expectedError:     "exceeding the max change per epoch",
+		},
+		{
+			name:            "within per day limit",
+			maxChangePerDay: sdk.MustNewDecFromStr("0.05"),
+			newRate:         sdk.MustNewDecFromStr("1.15"), // 4.5% from 1.10
+		},
+		{
+			name:            "exceeds per day limit",
+			maxChangePerDay: sdk.MustNewDecFromStr("0.05"),
+			newRate:         sdk.MustNewDecFromStr("1.16"), // 5.5% from 1.10
+			expectedError:   "exceeding the max change per day",
+		},
+		{
+			name:            "exceeds per day limit after dip",
+			maxChangePerDay: sdk.MustNewDecFromStr("0.05"),
+			newRate:         sdk.MustNewDecFromStr("1.06"), // 5.4% from 1.12
+			expectedError:   "exceeding the max change per day",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			hostZone := types.HostZone{
+				ChainId:                         HostChainId,
+				RedemptionRate:                  sdk.MustNewDecFromStr("1.14"),
+				MaxRedemptionRateChangePerEpoch: tc.maxChangePerEpoch,
+				MaxRedemptionRateChangePerDay:   tc.maxChangePerDay,
+				RedemptionRateHistory:           history,
+			}
+
+			err := s.App.StakeibcKeeper.CheckRedemptionRateVelocity(s.Ctx, hostZone, tc.newRate)
+			if tc.expectedError == "" {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorContains(err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGetRecentRedemptionRateHistory() {
+	blockTime := s.Ctx.BlockTime()
+
+	hostZone := types.HostZone{
+		RedemptionRateHistory: []types.RedemptionRateSnapshot{
+			{RedemptionRate: sdk.MustNewDecFromStr("1.0"), Time: blockTime.Add(-30 * time.Hour)},
+			{RedemptionRate: sdk.MustNewDecFromStr("1.1"), Time: blockTime.Add(-24 * time.Hour)},
+			{RedemptionRate: sdk.MustNewDecFromStr("1.2"), Time: blockTime.Add(-23 * time.Hour)},
+			{RedemptionRate: sdk.MustNewDecFromStr("1.3"), Time: blockTime.Add(-1 * time.Hour)},
+		},
+	}
+
+	recentHistory := s.App.StakeibcKeeper.GetRecentRedemptionRateHistory(s.Ctx, hostZone)
+	s.Require().Equal(hostZone.RedemptionRateHistory[2:], recentHistory, "recent history")
+}
+
 // Tests GetDepositAccountBalance and GetUndelegatedBalance
 func (s *KeeperTestSuite) TestGetRedemptionRate_DepositRecords() {
 	// Build combinations of transfer deposit records
@@ -10,14 +10,16 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/Stride-Labs/stride/v27/utils"
+	epochstypes "github.com/Stride-Labs/stride/v27/x/epochs/types"
 	recordstypes "github.com/Stride-Labs/stride/v27/x/records/types"
 	"github.com/Stride-Labs/stride/v27/x/stakeibc/types"
 )
 
-// Gets the total unbonded amount for the host zone that has finished unbonding
+// Gets the total unbonded amount for the host zone that has finished unbonding from the given delegation shard
 func (k Keeper) GetTotalRedemptionSweepAmountAndRecordIds(
 	ctx sdk.Context,
 	chainId string,
+	shardIndex uint32,
 	hostBlockTime uint64,
 ) (totalSweepAmount sdkmath.Int, unbondingRecordIds []uint64) {
 	// Sum the total unbonded amount for each unbonding record
@@ -25,7 +27,7 @@ func (k Keeper) GetTotalRedemptionSweepAmountAndRecordIds(
 	for _, epochUnbondingRecord := range k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx) {
 		// Get all the unbondings associated with the epoch + host zone pair
 		hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochUnbondingRecord.EpochNumber, chainId)
-		if !found {
+		if !found || hostZoneUnbonding.DelegationShardIndex != shardIndex {
 			continue
 		}
 
@@ -52,7 +54,7 @@ func (k Keeper) GetTotalRedemptionSweepAmountAndRecordIds(
 	return totalSweepAmount, unbondingRecordIds
 }
 
-// Batch transfers any unbonded tokens from the delegation account to the redemption account
+// Batch transfers any unbonded tokens from the delegation accounts to the redemption account
 func (k Keeper) SweepUnbondedTokensForHostZone(ctx sdk.Context, hostZone types.HostZone) error {
 	chainId := hostZone.ChainId
 	k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Sweeping unbonded tokens"))
@@ -71,21 +73,41 @@ func (k Keeper) SweepUnbondedTokensForHostZone(ctx sdk.Context, hostZone types.H
 		return errorsmod.Wrapf(err, "could not get light client block time for host zone")
 	}
 
+	// Tokens are swept from each delegation shard separately, since each shard holds
+	// the tokens from its own unbondings
+	for _, shardIndex := range hostZone.GetDelegationShardIndexes() {
+		if err := k.SweepUnbondedTokensFromShard(ctx, hostZone, shardIndex, hostBlockTime); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Batch transfers any unbonded tokens from a delegation shard to the redemption account
+func (k Keeper) SweepUnbondedTokensFromShard(ctx sdk.Context, hostZone types.HostZone, shardIndex uint32, hostBlockTime uint64) error {
+	chainId := hostZone.ChainId
+
 	// Determine the total unbonded amount that has finished unbonding
-	totalSweepAmount, epochUnbondingRecordIds := k.GetTotalRedemptionSweepAmountAndRecordIds(ctx, chainId, hostBlockTime)
+	totalSweepAmount, epochUnbondingRecordIds := k.GetTotalRedemptionSweepAmountAndRecordIds(ctx, chainId, shardIndex, hostBlockTime)
 
 	// If we have any amount to sweep, then we can send the ICA call to sweep them
 	if totalSweepAmount.LTE(sdkmath.ZeroInt()) {
-		k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "No tokens ready for sweep"))
+		k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "No tokens ready for sweep from shard %d", shardIndex))
 		return nil
 	}
-	k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Batch transferring %v to host zone", totalSweepAmount))
+	k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Batch transferring %v from shard %d to host zone", totalSweepAmount, shardIndex))
+
+	delegationAddress, err := k.GetDelegationShardAddress(hostZone, shardIndex)
+	if err != nil {
+		return err
+	}
 
 	// Build transfer message to transfer from the delegation account to redemption account
 	sweepCoin := sdk.NewCoin(hostZone.HostDenom, totalSweepAmount)
 	msgs := []proto.Message{
 		&banktypes.MsgSend{
-			FromAddress: hostZone.DelegationIcaAddress,
+			FromAddress: delegationAddress,
 			ToAddress:   hostZone.RedemptionIcaAddress,
 			Amount:      sdk.NewCoins(sweepCoin),
 		},
@@ -96,6 +118,7 @@ func (k Keeper) SweepUnbondedTokensForHostZone(ctx sdk.Context, hostZone types.H
 	redemptionCallback := types.RedemptionCallback{
 		HostZoneId:              chainId,
 		EpochUnbondingRecordIds: epochUnbondingRecordIds,
+		DelegationShardIndex:    shardIndex,
 	}
 	marshalledCallbackArgs, err := proto.Marshal(&redemptionCallback)
 	if err != nil {
@@ -103,7 +126,15 @@ func (k Keeper) SweepUnbondedTokensForHostZone(ctx sdk.Context, hostZone types.H
 	}
 
 	// Send the bank send ICA
-	_, err = k.SubmitTxsStrideEpoch(ctx, hostZone.ConnectionId, msgs, types.ICAAccountType_DELEGATION, ICACallbackID_Redemption, marshalledCallbackArgs)
+	_, err = k.SubmitDelegationShardTxsEpoch(
+		ctx,
+		hostZone,
+		shardIndex,
+		msgs,
+		epochstypes.STRIDE_EPOCH,
+		ICACallbackID_Redemption,
+		marshalledCallbackArgs,
+	)
 	if err != nil {
 		return errorsmod.Wrapf(err, "unable to submit redemption ICA for %s", chainId)
 	}
@@ -350,7 +350,7 @@ func (s *KeeperTestSuite) TestGetTotalRedemptionSweepAmountAndRecordsIds() {
 	expectedRecordIds := []uint64{1, 2, 6}
 
 	hostBlockTimeNano := uint64(hostBlockTime.UnixNano())
-	actualUnbondAmount, actualRecordIds := s.App.StakeibcKeeper.GetTotalRedemptionSweepAmountAndRecordIds(s.Ctx, HostChainId, hostBlockTimeNano)
+	actualUnbondAmount, actualRecordIds := s.App.StakeibcKeeper.GetTotalRedemptionSweepAmountAndRecordIds(s.Ctx, HostChainId, 0, hostBlockTimeNano)
 	s.Require().Equal(expectedUnbondAmount, actualUnbondAmount.Int64(), "unbonded amount")
 	s.Require().Equal(expectedRecordIds, actualRecordIds, "epoch unbonding record IDs")
 }
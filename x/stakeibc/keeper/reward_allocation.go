@@ -10,7 +10,9 @@ import (
 )
 
 // AuctionOffRewardCollectorBalance transfers all balances from the reward collector module account
-// to the auction module account. If the reward collector has no balance, it does nothing.
+// to the auction module account, and then calls the AfterRewardCollectorAuctioned hook so that
+// auctions can be provisioned for any new denoms. If the reward collector has no balance,
+// nothing is transferred, but the hook is still called.
 func (k Keeper) AuctionOffRewardCollectorBalance(ctx sdk.Context) {
 	k.Logger(ctx).Info("Auctioning reward collector balance")
 
@@ -19,11 +21,14 @@ func (k Keeper) AuctionOffRewardCollectorBalance(ctx sdk.Context) {
 	rewardCollectorBalances := k.bankKeeper.GetAllBalances(ctx, rewardCollectorAddress)
 	if rewardCollectorBalances.Empty() {
 		k.Logger(ctx).Info("No rewards to auction from RewardCollector")
-		return
+	} else {
+		err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.RewardCollectorName, auctiontypes.ModuleName, rewardCollectorBalances)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Cannot send rewards from RewardCollector to Auction module: %s", err))
+		}
 	}
 
-	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.RewardCollectorName, auctiontypes.ModuleName, rewardCollectorBalances)
-	if err != nil {
-		k.Logger(ctx).Error(fmt.Sprintf("Cannot send rewards from RewardCollector to Auction module: %s", err))
+	if k.hooks != nil {
+		k.hooks.AfterRewardCollectorAuctioned(ctx)
 	}
 }
@@ -55,7 +55,10 @@ type RewardsSplit struct {
 //
 //  1. Epochly check the reward denom balance in the withdrawal address
 //     on callback, send all this reward denom from withdrawl ICA to trade ICA on the trade zone (OSMOSIS)
-//  2. Off-chain swaps of reward denom to host denom
+//  2. Swaps of reward denom to host denom, either:
+//     a. Off-chain by the trade controller via authz, or
+//     b. If the route has a swap config, epochly check the reward denom balance in the trade ICA
+//     on callback, swap the balance from the trade ICA with a minimum output derived from the icqoracle price
 //  3. Epochly check the host denom balance in trade ICA
 //     on callback, transfer these host denom tokens from trade ICA to withdrawal ICA on original host zone
 //
@@ -147,6 +150,13 @@ func (k Keeper) BuildTradeAuthzMsg(
 
 	switch permissionChange {
 	case types.AuthzPermissionChange_GRANT:
+		// If the route has a swap config, the swap is executed by stride with an oracle-enforced
+		// minimum output, and trade permissions should not be handed off to an external account
+		if tradeRoute.SwapConfig != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidSwapConfig,
+				"unable to grant trade permissions for %s since swaps are executed on-chain", tradeRoute.Description())
+		}
+
 		authorization := authz.NewGenericAuthorization(messageTypeUrl)
 		expiration := ctx.BlockTime().Add(time.Hour * 24 * 365 * 100) // 100 years
 
@@ -174,6 +184,55 @@ func (k Keeper) BuildTradeAuthzMsg(
 	return authzMsg, nil
 }
 
+// Submits an ICA tx from the trade ICA to grant or revoke authz trade permissions for an address,
+// and records the current trade controller on the route
+// The caller is responsible for storing the updated trade route
+func (k Keeper) SubmitTradeAuthzTx(
+	ctx sdk.Context,
+	tradeRoute *types.TradeRoute,
+	permissionChange types.AuthzPermissionChange,
+	address string,
+	legacy bool,
+) error {
+	// Build the authz message that grants or revokes trade permissions to the specified address
+	authzMsg, err := k.BuildTradeAuthzMsg(ctx, *tradeRoute, permissionChange, address, legacy)
+	if err != nil {
+		return err
+	}
+
+	// Build the ICA channel owner from the trade route
+	tradeRouteAccountOwner := types.FormatTradeRouteICAOwnerFromRouteId(
+		tradeRoute.TradeAccount.ChainId,
+		tradeRoute.GetRouteId(),
+		types.ICAAccountType_CONVERTER_TRADE,
+	)
+
+	// Submit the ICA tx from the trade ICA account
+	// Timeout the ICA at 1 hour
+	timeoutTimestamp := utils.IntToUint(ctx.BlockTime().Add(time.Hour).UnixNano())
+	err = k.SubmitICATxWithoutCallback(
+		ctx,
+		tradeRoute.TradeAccount.ConnectionId,
+		tradeRouteAccountOwner,
+		authzMsg,
+		timeoutTimestamp,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Track the controller so the grant can be revoked if the route switches to on-chain swaps
+	if permissionChange == types.AuthzPermissionChange_GRANT {
+		tradeRoute.TradeController = address
+		tradeRoute.TradeControllerLegacy = legacy
+	} else if tradeRoute.TradeController == address {
+		tradeRoute.TradeController = ""
+		tradeRoute.TradeControllerLegacy = false
+	}
+
+	return nil
+}
+
 // Builds a PFM transfer message to send reward tokens from the host zone,
 // through the reward zone (to unwind) and finally to the trade zone
 func (k Keeper) BuildHostToTradeTransferMsg(
@@ -276,7 +335,19 @@ func (k Keeper) TransferRewardTokensHostToTrade(ctx sdk.Context, amount sdkmath.
 }
 
 // ICA tx to kick off transfering the converted tokens back from tradeZone to the hostZone withdrawal ICA
+// If the route has multiple legs, the tokens are sent from the final leg's trade zone
 func (k Keeper) TransferConvertedTokensTradeToHost(ctx sdk.Context, amount sdkmath.Int, route types.TradeRoute) error {
+	return k.TransferConvertedTokens(ctx, amount, route, len(route.AdditionalLegs))
+}
+
+// ICA tx to kick off transfering the converted tokens from a leg's trade ICA to either the next leg's
+// trade ICA or, if it's the final leg, back to the hostZone withdrawal ICA
+func (k Keeper) TransferConvertedTokens(ctx sdk.Context, amount sdkmath.Int, route types.TradeRoute, legIndex int) error {
+	leg, found := route.GetTradeLeg(legIndex)
+	if !found {
+		return errorsmod.Wrapf(types.ErrTradeRouteNotFound, "trade leg %d not found for %s", legIndex, route.Description())
+	}
+
 	// Timeout for ica tx and the transfer msgs is at end of epoch
 	strideEpochTracker, found := k.GetEpochTracker(ctx, epochstypes.STRIDE_EPOCH)
 	if !found {
@@ -284,15 +355,30 @@ func (k Keeper) TransferConvertedTokensTradeToHost(ctx sdk.Context, amount sdkma
 	}
 	timeout := uint64(strideEpochTracker.NextEpochStartTime)
 
-	convertedDenom := route.HostDenomOnTradeZone
+	convertedDenom := leg.OutputDenomOnTradeZone
 	sendTokens := sdk.NewCoin(convertedDenom, amount)
 
-	// Validate ICAs were registered
-	tradeIcaAddress := route.TradeAccount.Address
-	withdrawlIcaAddress := route.HostAccount.Address
-	if withdrawlIcaAddress == "" {
-		return errorsmod.Wrapf(types.ErrICAAccountNotFound, "no host account found for %s", route.Description())
+	// Determine the destination of the transfer - either the host zone or the next leg
+	var receiverAddress, sourceChannelId, destinationChainId string
+	if route.IsFinalTradeLeg(legIndex) {
+		receiverAddress = route.HostAccount.Address
+		sourceChannelId = route.TradeToHostChannelId // channel on tradeZone for transfers to hostZone
+		destinationChainId = route.HostAccount.ChainId
+		if receiverAddress == "" {
+			return errorsmod.Wrapf(types.ErrICAAccountNotFound, "no host account found for %s", route.Description())
+		}
+	} else {
+		nextLeg := route.AdditionalLegs[legIndex]
+		receiverAddress = nextLeg.TradeAccount.Address
+		sourceChannelId = nextLeg.InboundChannelId // channel on this leg's zone for transfers to the next leg's zone
+		destinationChainId = nextLeg.TradeAccount.ChainId
+		if receiverAddress == "" {
+			return errorsmod.Wrapf(types.ErrICAAccountNotFound, "no trade account found for leg %d of %s", legIndex+1, route.Description())
+		}
 	}
+
+	// Validate ICAs were registered
+	tradeIcaAddress := leg.TradeAccount.Address
 	if tradeIcaAddress == "" {
 		return errorsmod.Wrapf(types.ErrICAAccountNotFound, "no trade account found for %s", route.Description())
 	}
@@ -300,22 +386,22 @@ func (k Keeper) TransferConvertedTokensTradeToHost(ctx sdk.Context, amount sdkma
 	var msgs []proto.Message
 	msgs = append(msgs, &transfertypes.MsgTransfer{
 		SourcePort:       transfertypes.PortID,
-		SourceChannel:    route.TradeToHostChannelId, // channel on tradeZone for transfers to hostZone
+		SourceChannel:    sourceChannelId,
 		Token:            sendTokens,
 		Sender:           tradeIcaAddress,
-		Receiver:         withdrawlIcaAddress,
+		Receiver:         receiverAddress,
 		TimeoutTimestamp: timeout,
 		Memo:             "",
 	})
 
 	hostZoneId := route.HostAccount.ChainId
-	tradeZoneId := route.TradeAccount.ChainId
+	tradeZoneId := leg.TradeAccount.ChainId
 	k.Logger(ctx).Info(utils.LogWithHostZone(hostZoneId,
-		"Preparing MsgTransfer of %+v from %s to %s", sendTokens, tradeZoneId, hostZoneId))
+		"Preparing MsgTransfer of %+v from %s to %s", sendTokens, tradeZoneId, destinationChainId))
 
-	// Send the ICA tx to kick off transfer from hostZone through rewardZone to the tradeZone (no callbacks)
-	tradeAccount := route.TradeAccount
-	tradeOwner := types.FormatTradeRouteICAOwnerFromRouteId(tradeAccount.ChainId, route.GetRouteId(), tradeAccount.Type)
+	// Send the ICA tx to kick off transfer from the trade zone (no callbacks)
+	tradeAccount := leg.TradeAccount
+	tradeOwner := route.GetTradeLegICAOwner(legIndex, tradeAccount)
 	err := k.SubmitICATxWithoutCallback(ctx, tradeAccount.ConnectionId, tradeOwner, msgs, timeout)
 	if err != nil {
 		return errorsmod.Wrapf(err, "Failed to submit ICA tx, Messages: %+v", msgs)
@@ -324,6 +410,157 @@ func (k Keeper) TransferConvertedTokensTradeToHost(ctx sdk.Context, amount sdkma
 	return nil
 }
 
+// Calculates the minimum number of host tokens that must be returned from a swap of reward tokens
+// The min output is derived from the icqoracle price of the reward token (denominated in the host token),
+// discounted by the route's max slippage
+// Returns an error if there is no recent oracle price
+func (k Keeper) GetMinSwapTokenOutAmount(
+	ctx sdk.Context,
+	amountIn sdkmath.Int,
+	swapConfig types.SwapConfig,
+) (minTokenOut sdkmath.Int, oraclePrice sdk.Dec, err error) {
+	// Fetch the price of the reward token in terms of the host token
+	// This will error if the price is stale
+	oraclePrice, err = k.ICQOracleKeeper.GetTokenPriceForQuoteDenom(ctx,
+		swapConfig.RewardDenomOnStride, swapConfig.HostDenomOnStride)
+	if err != nil {
+		return minTokenOut, oraclePrice, errorsmod.Wrapf(types.ErrOraclePriceUnavailable, err.Error())
+	}
+	if !oraclePrice.IsPositive() {
+		return minTokenOut, oraclePrice, errorsmod.Wrapf(types.ErrOraclePriceUnavailable,
+			"invalid price for %s in terms of %s: %v", swapConfig.RewardDenomOnStride, swapConfig.HostDenomOnStride, oraclePrice)
+	}
+
+	// minOut = amountIn * price * (1 - maxSlippage)
+	minTokenOut = sdk.NewDecFromInt(amountIn).
+		Mul(oraclePrice).
+		Mul(sdk.OneDec().Sub(swapConfig.MaxSlippage)).
+		TruncateInt()
+
+	return minTokenOut, oraclePrice, nil
+}
+
+// Builds a swap message to sell the input tokens in a leg's trade ICA for the leg's output tokens
+// (for single leg routes, this is the reward token and host token respectively)
+// The swap amount is capped at the leg's max swap amount and the min output is enforced
+// by the oracle price
+// Returns the swap message and a pending trade record to track the swap
+func (k Keeper) BuildSwapMsg(
+	ctx sdk.Context,
+	inputAmount sdkmath.Int,
+	route types.TradeRoute,
+	legIndex int,
+) (msg types.MsgSwapExactAmountIn, tradeRecord types.TradeRecord, err error) {
+	leg, found := route.GetTradeLeg(legIndex)
+	if !found {
+		return msg, tradeRecord, errorsmod.Wrapf(types.ErrTradeRouteNotFound,
+			"trade leg %d not found for %s", legIndex, route.Description())
+	}
+	if leg.SwapConfig == nil {
+		return msg, tradeRecord, errorsmod.Wrapf(types.ErrInvalidSwapConfig,
+			"no swap config for leg %d of %s", legIndex, route.Description())
+	}
+	swapConfig := *leg.SwapConfig
+
+	tradeIcaAddress := leg.TradeAccount.Address
+	if tradeIcaAddress == "" {
+		return msg, tradeRecord, errorsmod.Wrapf(types.ErrICAAccountNotFound, "no trade account found for %s", route.Description())
+	}
+
+	// Build the hops of the swap - if no hops were specified, the swap is a single hop through the pool
+	hops := []types.SwapAmountInRoute{}
+	for _, hop := range swapConfig.Hops {
+		hops = append(hops, types.SwapAmountInRoute{PoolId: hop.PoolId, TokenOutDenom: hop.TokenOutDenom})
+	}
+	if len(hops) == 0 {
+		hops = append(hops, types.SwapAmountInRoute{PoolId: swapConfig.PoolId, TokenOutDenom: leg.OutputDenomOnTradeZone})
+	}
+	if finalDenom := hops[len(hops)-1].TokenOutDenom; finalDenom != leg.OutputDenomOnTradeZone {
+		return msg, tradeRecord, errorsmod.Wrapf(types.ErrInvalidSwapConfig,
+			"final hop outputs %s, but leg %d of %s expects %s", finalDenom, legIndex, route.Description(), leg.OutputDenomOnTradeZone)
+	}
+
+	// If there's a max swap amount, cap the trade size (the remainder will be swapped in subsequent epochs)
+	swapAmount := inputAmount
+	if swapConfig.MaxSwapAmount.IsPositive() {
+		swapAmount = sdkmath.MinInt(inputAmount, swapConfig.MaxSwapAmount)
+	}
+
+	minTokenOut, oraclePrice, err := k.GetMinSwapTokenOutAmount(ctx, swapAmount, swapConfig)
+	if err != nil {
+		return msg, tradeRecord, err
+	}
+
+	msg = types.MsgSwapExactAmountIn{
+		Sender:            tradeIcaAddress,
+		Routes:            hops,
+		TokenIn:           sdk.NewCoin(leg.InputDenomOnTradeZone, swapAmount),
+		TokenOutMinAmount: minTokenOut,
+	}
+
+	tradeRecord = types.TradeRecord{
+		RewardDenom:       route.RewardDenomOnRewardZone,
+		HostDenom:         route.HostDenomOnHostZone,
+		TokenInAmount:     swapAmount,
+		MinTokenOutAmount: minTokenOut,
+		TokenOutAmount:    sdkmath.ZeroInt(),
+		OraclePrice:       oraclePrice,
+		RealizedPrice:     sdk.ZeroDec(),
+		SubmittedTime:     ctx.BlockTime(),
+		Status:            types.TradeRecord_PENDING,
+		LegIndex:          uint32(legIndex),
+	}
+
+	return msg, tradeRecord, nil
+}
+
+// ICA tx to swap the input tokens in a leg's trade ICA (e.g. reward tokens) for the leg's output tokens
+// The swap is rejected on the trade zone if the output falls below the oracle-implied minimum
+// Only one swap can be in flight per leg, so a new swap is not submitted until the previous ICA is acknowledged
+func (k Keeper) SwapRewardTokens(ctx sdk.Context, inputAmount sdkmath.Int, route types.TradeRoute, legIndex int) error {
+	routeId := route.GetRouteId()
+	if tradeRecordId, pending := k.GetPendingSwap(ctx, routeId, uint32(legIndex)); pending {
+		return errorsmod.Wrapf(types.ErrSwapPending, "leg %d of %s is awaiting the ack of trade %d",
+			legIndex, route.Description(), tradeRecordId)
+	}
+
+	// Timeout for ica tx is at the end of the epoch
+	strideEpochTracker, found := k.GetEpochTracker(ctx, epochstypes.STRIDE_EPOCH)
+	if !found {
+		return errorsmod.Wrap(types.ErrEpochNotFound, epochstypes.STRIDE_EPOCH)
+	}
+	timeout := uint64(strideEpochTracker.NextEpochStartTime)
+
+	msg, tradeRecord, err := k.BuildSwapMsg(ctx, inputAmount, route, legIndex)
+	if err != nil {
+		return err
+	}
+	msgs := []proto.Message{&msg}
+
+	// Store the pending trade record so the result of the swap can be audited
+	// The record is removed if the ICA cannot be submitted
+	tradeRecordId := k.AppendTradeRecord(ctx, tradeRecord)
+
+	callbackArgsBz, err := proto.Marshal(&types.SwapCallback{TradeRecordId: tradeRecordId})
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to marshal swap callback args")
+	}
+
+	tradeAccount := route.GetTradeLegs()[legIndex].TradeAccount
+	k.Logger(ctx).Info(utils.LogWithHostZone(tradeAccount.ChainId,
+		"Preparing MsgSwapExactAmountIn of %v with min output %v", msg.TokenIn, msg.TokenOutMinAmount))
+
+	tradeOwner := route.GetTradeLegICAOwner(legIndex, tradeAccount)
+	_, err = k.SubmitICATxWithCallback(ctx, tradeAccount.ConnectionId, tradeOwner, msgs, timeout, ICACallbackID_Swap, callbackArgsBz)
+	if err != nil {
+		k.RemoveTradeRecord(ctx, tradeRecordId)
+		return errorsmod.Wrapf(err, "Failed to submit ICA tx, Messages: %+v", msgs)
+	}
+	k.SetPendingSwap(ctx, routeId, uint32(legIndex), tradeRecordId)
+
+	return nil
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////
 // ICQ calls for remote ICA balances
 // There is a single trade zone
@@ -381,9 +618,70 @@ func (k Keeper) WithdrawalRewardBalanceQuery(ctx sdk.Context, route types.TradeR
 	return nil
 }
 
-// Kick off ICQ for how many converted tokens are in the trade ICA associated with this host zone
-func (k Keeper) TradeConvertedBalanceQuery(ctx sdk.Context, route types.TradeRoute) error {
-	tradeAccount := route.TradeAccount
+// Kick off ICQ for how many input tokens (e.g. reward tokens) are in a leg's trade ICA, to be swapped
+// for the leg's output tokens
+// This is only used for legs that have a swap config
+func (k Keeper) TradeRewardBalanceQuery(ctx sdk.Context, route types.TradeRoute, legIndex int) error {
+	leg, found := route.GetTradeLeg(legIndex)
+	if !found {
+		return errorsmod.Wrapf(types.ErrTradeRouteNotFound, "trade leg %d not found for %s", legIndex, route.Description())
+	}
+	tradeAccount := leg.TradeAccount
+	k.Logger(ctx).Info(utils.LogWithHostZone(tradeAccount.ChainId, "Submitting ICQ for reward denom in trade ICA account"))
+
+	// Encode the trade account address for the query request
+	// The query request consists of the trade account address and reward denom
+	_, tradeAddressBz, err := bech32.DecodeAndConvert(tradeAccount.Address)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid trade account address (%s), could not decode", tradeAccount.Address)
+	}
+	queryData := append(bankTypes.CreateAccountBalancesPrefix(tradeAddressBz), []byte(leg.InputDenomOnTradeZone)...)
+
+	// Timeout query at end of epoch
+	strideEpochTracker, found := k.GetEpochTracker(ctx, epochstypes.STRIDE_EPOCH)
+	if !found {
+		return errorsmod.Wrap(types.ErrEpochNotFound, epochstypes.STRIDE_EPOCH)
+	}
+	timeout := time.Unix(0, utils.UintToInt(strideEpochTracker.NextEpochStartTime))
+	timeoutDuration := timeout.Sub(ctx.BlockTime())
+
+	// We need the trade route keys in the callback to look up the tradeRoute struct
+	callbackData := types.TradeRouteCallback{
+		RewardDenom: route.RewardDenomOnRewardZone,
+		HostDenom:   route.HostDenomOnHostZone,
+		LegIndex:    uint32(legIndex),
+	}
+	callbackDataBz, err := proto.Marshal(&callbackData)
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to marshal trade route as callback data")
+	}
+
+	// Submit the ICQ for the trade account balance
+	query := icqtypes.Query{
+		ChainId:         tradeAccount.ChainId,
+		ConnectionId:    tradeAccount.ConnectionId,
+		QueryType:       icqtypes.BANK_STORE_QUERY_WITH_PROOF,
+		RequestData:     queryData,
+		CallbackModule:  types.ModuleName,
+		CallbackId:      ICQCallbackID_TradeRewardBalance,
+		CallbackData:    callbackDataBz,
+		TimeoutDuration: timeoutDuration,
+		TimeoutPolicy:   icqtypes.TimeoutPolicy_REJECT_QUERY_RESPONSE,
+	}
+	if err := k.InterchainQueryKeeper.SubmitICQRequest(ctx, query, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Kick off ICQ for how many converted tokens are in a leg's trade ICA associated with this host zone
+func (k Keeper) TradeConvertedBalanceQuery(ctx sdk.Context, route types.TradeRoute, legIndex int) error {
+	leg, found := route.GetTradeLeg(legIndex)
+	if !found {
+		return errorsmod.Wrapf(types.ErrTradeRouteNotFound, "trade leg %d not found for %s", legIndex, route.Description())
+	}
+	tradeAccount := leg.TradeAccount
 	k.Logger(ctx).Info(utils.LogWithHostZone(tradeAccount.ChainId, "Submitting ICQ for converted denom in trade ICA account"))
 
 	// Encode the trade account address for the query request
@@ -393,7 +691,7 @@ func (k Keeper) TradeConvertedBalanceQuery(ctx sdk.Context, route types.TradeRou
 	if err != nil {
 		return errorsmod.Wrapf(err, "invalid trade account address (%s), could not decode", tradeAccount.Address)
 	}
-	queryData := append(bankTypes.CreateAccountBalancesPrefix(tradeAddressBz), []byte(route.HostDenomOnTradeZone)...)
+	queryData := append(bankTypes.CreateAccountBalancesPrefix(tradeAddressBz), []byte(leg.OutputDenomOnTradeZone)...)
 
 	// Timeout query at end of epoch
 	strideEpochTracker, found := k.GetEpochTracker(ctx, epochstypes.STRIDE_EPOCH)
@@ -407,6 +705,7 @@ func (k Keeper) TradeConvertedBalanceQuery(ctx sdk.Context, route types.TradeRou
 	callbackData := types.TradeRouteCallback{
 		RewardDenom: route.RewardDenomOnRewardZone,
 		HostDenom:   route.HostDenomOnHostZone,
+		LegIndex:    uint32(legIndex),
 	}
 	callbackDataBz, err := proto.Marshal(&callbackData)
 	if err != nil {
@@ -436,17 +735,27 @@ func (k Keeper) TradeConvertedBalanceQuery(ctx sdk.Context, route types.TradeRou
 //
 // The current design assumes foreign reward tokens start and end in the hostZone withdrawal address
 // Step 1: transfer reward tokens to trade chain
-// Step 2: (off-chain) perform the swap in small batches
-// Step 3: return the swapped tokens to the withdrawal ICA on hostZone
+// Step 2: perform the swap in small batches, either off-chain via authz, or, if the route has a
+// swap config, from the trade ICA with an oracle-enforced minimum output
+// Step 3: transfer the swapped tokens to the next leg's trade ICA (for multi-venue routes), or, from
+// the final leg, return the swapped tokens to the withdrawal ICA on hostZone
 func (k Keeper) TransferAllRewardTokens(ctx sdk.Context) {
 	for _, route := range k.GetAllTradeRoutes(ctx) {
 		// Step 1: ICQ reward balance on hostZone, transfer funds with unwinding to trade chain
 		if err := k.WithdrawalRewardBalanceQuery(ctx, route); err != nil {
 			k.Logger(ctx).Error(fmt.Sprintf("Unable to submit query for reward balance in withdrawal ICA: %s", err))
 		}
-		// Step 3: ICQ converted tokens in trade ICA, transfer funds back to hostZone withdrawal ICA
-		if err := k.TradeConvertedBalanceQuery(ctx, route); err != nil {
-			k.Logger(ctx).Error(fmt.Sprintf("Unable to submit query for converted balance in trade ICA: %s", err))
+		for legIndex, leg := range route.GetTradeLegs() {
+			// Step 2: ICQ input balance in the leg's trade ICA, swap funds for the leg's output tokens
+			if leg.SwapConfig != nil {
+				if err := k.TradeRewardBalanceQuery(ctx, route, legIndex); err != nil {
+					k.Logger(ctx).Error(fmt.Sprintf("Unable to submit query for reward balance in trade ICA (leg %d): %s", legIndex, err))
+				}
+			}
+			// Step 3: ICQ converted tokens in the leg's trade ICA, transfer funds to the next leg or back to hostZone
+			if err := k.TradeConvertedBalanceQuery(ctx, route, legIndex); err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("Unable to submit query for converted balance in trade ICA (leg %d): %s", legIndex, err))
+			}
 		}
 	}
 }
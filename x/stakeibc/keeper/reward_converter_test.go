@@ -13,6 +13,7 @@ import (
 
 	"github.com/Stride-Labs/stride/v27/utils"
 	epochtypes "github.com/Stride-Labs/stride/v27/x/epochs/types"
+	icqoracletypes "github.com/Stride-Labs/stride/v27/x/icqoracle/types"
 	icqtypes "github.com/Stride-Labs/stride/v27/x/interchainquery/types"
 	"github.com/Stride-Labs/stride/v27/x/stakeibc/keeper"
 	"github.com/Stride-Labs/stride/v27/x/stakeibc/types"
@@ -384,6 +385,331 @@ func (s *KeeperTestSuite) TestBuildTradeAuthzMsg() {
 
 }
 
+func (s *KeeperTestSuite) TestBuildTradeAuthzMsg_SwapConfig() {
+	// Create a route that has swaps executed on-chain
+	tradeRoute := types.TradeRoute{
+		RewardDenomOnRewardZone: RewardDenom,
+		HostDenomOnHostZone:     HostDenom,
+		TradeAccount: types.ICAAccount{
+			Address: "trade_ica",
+		},
+		SwapConfig: &types.SwapConfig{PoolId: 1},
+	}
+
+	// Granting permissions should fail
+	_, err := s.App.StakeibcKeeper.BuildTradeAuthzMsg(s.Ctx, tradeRoute, types.AuthzPermissionChange_GRANT, "grantee", false)
+	s.Require().ErrorContains(err, "unable to grant trade permissions")
+
+	// But revoking should still be allowed
+	msgs, err := s.App.StakeibcKeeper.BuildTradeAuthzMsg(s.Ctx, tradeRoute, types.AuthzPermissionChange_REVOKE, "grantee", false)
+	s.Require().NoError(err, "no error expected when revoking permissions")
+	s.Require().Len(msgs, 1, "there should be one message")
+}
+
+// --------------------------------------------------------------
+//                   Swap Reward Tokens
+// --------------------------------------------------------------
+
+const (
+	RewardDenomOnStride = "ibc/reward_on_stride"
+	HostDenomOnStride   = "ibc/host_on_stride"
+)
+
+// Helper function to store an oracle price of the reward token in terms of the host token
+func (s *KeeperTestSuite) setRewardOraclePrice(price sdk.Dec, lastResponseTime time.Time) {
+	s.App.ICQOracleKeeper.SetTokenPrice(s.Ctx, icqoracletypes.TokenPrice{
+		BaseDenom:        RewardDenomOnStride,
+		QuoteDenom:       HostDenomOnStride,
+		OsmosisPoolId:    1,
+		SpotPrice:        price,
+		LastResponseTime: lastResponseTime,
+	})
+}
+
+// Creates a trade route with a swap config and a registered trade ICA
+func (s *KeeperTestSuite) SetupSwapRewardTokensTestCase() (route types.TradeRoute, channelId, portId string) {
+	owner := types.FormatTradeRouteICAOwner(HostChainId, RewardDenom, HostDenom, types.ICAAccountType_CONVERTER_TRADE)
+	channelId, portId = s.CreateICAChannel(owner)
+
+	route = types.TradeRoute{
+		RewardDenomOnRewardZone: RewardDenom,
+		HostDenomOnHostZone:     HostDenom,
+		RewardDenomOnTradeZone:  "ibc/reward_on_trade",
+		HostDenomOnTradeZone:    "ibc/host_on_trade",
+
+		TradeAccount: types.ICAAccount{
+			ChainId:      HostChainId,
+			Address:      "trade_address",
+			ConnectionId: ibctesting.FirstConnectionID,
+			Type:         types.ICAAccountType_CONVERTER_TRADE,
+		},
+
+		SwapConfig: &types.SwapConfig{
+			PoolId:              1,
+			RewardDenomOnStride: RewardDenomOnStride,
+			HostDenomOnStride:   HostDenomOnStride,
+			MaxSlippage:         sdk.MustNewDecFromStr("0.05"),
+			MaxSwapAmount:       sdkmath.NewInt(1000),
+		},
+	}
+	s.App.StakeibcKeeper.SetTradeRoute(s.Ctx, route)
+
+	// Create epoch tracker to dictate timeout
+	s.CreateEpochForICATimeout(epochtypes.STRIDE_EPOCH, time.Second*10)
+
+	// Store an oracle price of 2 host tokens per reward token
+	s.setRewardOraclePrice(sdk.NewDec(2), s.Ctx.BlockTime())
+
+	return route, channelId, portId
+}
+
+func (s *KeeperTestSuite) TestGetMinSwapTokenOutAmount() {
+	swapConfig := types.SwapConfig{
+		RewardDenomOnStride: RewardDenomOnStride,
+		HostDenomOnStride:   HostDenomOnStride,
+		MaxSlippage:         sdk.MustNewDecFromStr("0.05"),
+	}
+
+	// Without a price, it should fail
+	_, _, err := s.App.StakeibcKeeper.GetMinSwapTokenOutAmount(s.Ctx, sdkmath.NewInt(1000), swapConfig)
+	s.Require().ErrorContains(err, "oracle price unavailable")
+
+	// With a recent price, the min output should be the value at the oracle price, less slippage
+	// 1000 * 2.5 * (1 - 0.05) = 2375
+	s.setRewardOraclePrice(sdk.MustNewDecFromStr("2.5"), s.Ctx.BlockTime())
+	minTokenOut, price, err := s.App.StakeibcKeeper.GetMinSwapTokenOutAmount(s.Ctx, sdkmath.NewInt(1000), swapConfig)
+	s.Require().NoError(err, "no error expected with recent price")
+	s.Require().Equal(sdk.MustNewDecFromStr("2.5"), price, "oracle price")
+	s.Require().Equal(int64(2375), minTokenOut.Int64(), "min token out")
+
+	// With a stale price, it should fail
+	staleTime := s.Ctx.BlockTime().Add(-time.Hour * 24 * 365)
+	s.setRewardOraclePrice(sdk.MustNewDecFromStr("2.5"), staleTime)
+	_, _, err = s.App.StakeibcKeeper.GetMinSwapTokenOutAmount(s.Ctx, sdkmath.NewInt(1000), swapConfig)
+	s.Require().ErrorContains(err, "oracle price unavailable")
+
+	// With a price of zero, it should fail
+	s.setRewardOraclePrice(sdk.ZeroDec(), s.Ctx.BlockTime())
+	_, _, err = s.App.StakeibcKeeper.GetMinSwapTokenOutAmount(s.Ctx, sdkmath.NewInt(1000), swapConfig)
+	s.Require().ErrorContains(err, "oracle price unavailable")
+}
+
+func (s *KeeperTestSuite) TestBuildSwapMsg() {
+	route, _, _ := s.SetupSwapRewardTokensTestCase()
+
+	// Build a swap below the max swap amount - the full amount should be swapped
+	// Min out: 500 * 2 * 0.95 = 950
+	msg, tradeRecord, err := s.App.StakeibcKeeper.BuildSwapMsg(s.Ctx, sdkmath.NewInt(500), route, 0)
+	s.Require().NoError(err, "no error expected when building swap msg")
+
+	expectedMsg := types.MsgSwapExactAmountIn{
+		Sender: "trade_address",
+		Routes: []types.SwapAmountInRoute{{
+			PoolId:        1,
+			TokenOutDenom: "ibc/host_on_trade",
+		}},
+		TokenIn:           sdk.NewCoin("ibc/reward_on_trade", sdkmath.NewInt(500)),
+		TokenOutMinAmount: sdkmath.NewInt(950),
+	}
+	s.Require().Equal(expectedMsg, msg, "swap msg")
+
+	s.Require().Equal(RewardDenom, tradeRecord.RewardDenom, "trade record reward denom")
+	s.Require().Equal(HostDenom, tradeRecord.HostDenom, "trade record host denom")
+	s.Require().Equal(int64(500), tradeRecord.TokenInAmount.Int64(), "trade record token in")
+	s.Require().Equal(int64(950), tradeRecord.MinTokenOutAmount.Int64(), "trade record min token out")
+	s.Require().Equal(sdk.NewDec(2), tradeRecord.OraclePrice, "trade record oracle price")
+	s.Require().Equal(types.TradeRecord_PENDING, tradeRecord.Status, "trade record status")
+
+	// Build a swap above the max swap amount - it should be capped
+	// Min out: 1000 * 2 * 0.95 = 1900
+	msg, tradeRecord, err = s.App.StakeibcKeeper.BuildSwapMsg(s.Ctx, sdkmath.NewInt(5000), route, 0)
+	s.Require().NoError(err, "no error expected when building capped swap msg")
+	s.Require().Equal(int64(1000), msg.TokenIn.Amount.Int64(), "capped swap amount")
+	s.Require().Equal(int64(1900), msg.TokenOutMinAmount.Int64(), "capped min token out")
+	s.Require().Equal(int64(1000), tradeRecord.TokenInAmount.Int64(), "capped trade record token in")
+
+	// With no max swap amount, the full balance should be swapped
+	uncappedRoute := route
+	uncappedSwapConfig := *route.SwapConfig
+	uncappedSwapConfig.MaxSwapAmount = sdkmath.ZeroInt()
+	uncappedRoute.SwapConfig = &uncappedSwapConfig
+	msg, _, err = s.App.StakeibcKeeper.BuildSwapMsg(s.Ctx, sdkmath.NewInt(5000), uncappedRoute, 0)
+	s.Require().NoError(err, "no error expected when building uncapped swap msg")
+	s.Require().Equal(int64(5000), msg.TokenIn.Amount.Int64(), "uncapped swap amount")
+
+	// Without a swap config, it should fail
+	invalidRoute := route
+	invalidRoute.SwapConfig = nil
+	_, _, err = s.App.StakeibcKeeper.BuildSwapMsg(s.Ctx, sdkmath.NewInt(500), invalidRoute, 0)
+	s.Require().ErrorContains(err, "no swap config")
+
+	// Without a trade account, it should fail
+	invalidRoute = route
+	invalidRoute.TradeAccount.Address = ""
+	_, _, err = s.App.StakeibcKeeper.BuildSwapMsg(s.Ctx, sdkmath.NewInt(500), invalidRoute, 0)
+	s.Require().ErrorContains(err, "no trade account found")
+}
+
+func (s *KeeperTestSuite) TestBuildSwapMsg_MultiHop() {
+	route, _, _ := s.SetupSwapRewardTokensTestCase()
+
+	// Route the swap through an intermediate denom
+	multiHopSwapConfig := *route.SwapConfig
+	multiHopSwapConfig.Hops = []types.SwapHop{
+		{PoolId: 2, TokenOutDenom: "ibc/intermediate_on_trade"},
+		{PoolId: 3, TokenOutDenom: "ibc/host_on_trade"},
+	}
+	route.SwapConfig = &multiHopSwapConfig
+
+	msg, _, err := s.App.StakeibcKeeper.BuildSwapMsg(s.Ctx, sdkmath.NewInt(500), route, 0)
+	s.Require().NoError(err, "no error expected when building multi-hop swap msg")
+
+	expectedRoutes := []types.SwapAmountInRoute{
+		{PoolId: 2, TokenOutDenom: "ibc/intermediate_on_trade"},
+		{PoolId: 3, TokenOutDenom: "ibc/host_on_trade"},
+	}
+	s.Require().Equal(expectedRoutes, msg.Routes, "swap routes")
+	s.Require().Equal(int64(950), msg.TokenOutMinAmount.Int64(), "min token out")
+
+	// If the final hop does not output the host denom, it should fail
+	multiHopSwapConfig.Hops = []types.SwapHop{{PoolId: 2, TokenOutDenom: "ibc/intermediate_on_trade"}}
+	route.SwapConfig = &multiHopSwapConfig
+	_, _, err = s.App.StakeibcKeeper.BuildSwapMsg(s.Ctx, sdkmath.NewInt(500), route, 0)
+	s.Require().ErrorContains(err, "final hop outputs ibc/intermediate_on_trade")
+}
+
+func (s *KeeperTestSuite) TestBuildSwapMsg_AdditionalLeg() {
+	route, _, _ := s.SetupSwapRewardTokensTestCase()
+
+	// Add a second leg that swaps the first leg's output for the host token
+	// The second leg re-uses the reward/host oracle price for simplicity
+	route.HostDenomOnTradeZone = "ibc/intermediate_on_trade"
+	legSwapConfig := *route.SwapConfig
+	legSwapConfig.PoolId = 7
+	route.AdditionalLegs = []types.TradeLeg{{
+		TradeAccount: types.ICAAccount{
+			ChainId:      "trade-2",
+			Address:      "trade_address_2",
+			ConnectionId: "connection-2",
+			Type:         types.ICAAccountType_CONVERTER_TRADE,
+		},
+		InputDenomOnTradeZone:  "ibc/intermediate_on_trade_2",
+		OutputDenomOnTradeZone: "ibc/host_on_trade_2",
+		InboundChannelId:       "channel-2",
+		SwapConfig:             &legSwapConfig,
+	}}
+
+	msg, tradeRecord, err := s.App.StakeibcKeeper.BuildSwapMsg(s.Ctx, sdkmath.NewInt(500), route, 1)
+	s.Require().NoError(err, "no error expected when building swap msg for second leg")
+
+	expectedMsg := types.MsgSwapExactAmountIn{
+		Sender: "trade_address_2",
+		Routes: []types.SwapAmountInRoute{{
+			PoolId:        7,
+			TokenOutDenom: "ibc/host_on_trade_2",
+		}},
+		TokenIn:           sdk.NewCoin("ibc/intermediate_on_trade_2", sdkmath.NewInt(500)),
+		TokenOutMinAmount: sdkmath.NewInt(950),
+	}
+	s.Require().Equal(expectedMsg, msg, "swap msg")
+	s.Require().Equal(uint32(1), tradeRecord.LegIndex, "trade record leg index")
+
+	// Building a swap for a leg that does not exist should fail
+	_, _, err = s.App.StakeibcKeeper.BuildSwapMsg(s.Ctx, sdkmath.NewInt(500), route, 2)
+	s.Require().ErrorContains(err, "trade leg 2 not found")
+}
+
+func (s *KeeperTestSuite) TestSwapRewardTokens_Success() {
+	route, channelId, portId := s.SetupSwapRewardTokensTestCase()
+
+	// Confirm the ICA was submitted
+	s.CheckICATxSubmitted(portId, channelId, func() error {
+		return s.App.StakeibcKeeper.SwapRewardTokens(s.Ctx, sdkmath.NewInt(500), route, 0)
+	})
+
+	// Confirm a pending trade record was stored
+	tradeRecords := s.App.StakeibcKeeper.GetAllTradeRecords(s.Ctx)
+	s.Require().Len(tradeRecords, 1, "one trade record should have been created")
+	s.Require().Equal(uint64(0), tradeRecords[0].Id, "trade record id")
+	s.Require().Equal(types.TradeRecord_PENDING, tradeRecords[0].Status, "trade record status")
+	s.Require().Equal(uint64(1), s.App.StakeibcKeeper.GetTradeRecordCount(s.Ctx), "trade record count")
+
+	// Confirm the callback data was stored with the trade record id
+	callbackData := s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx)
+	s.Require().Len(callbackData, 1, "callback data should have been stored")
+	s.Require().Equal(keeper.ICACallbackID_Swap, callbackData[0].CallbackId, "callback id")
+
+	var swapCallback types.SwapCallback
+	err := proto.Unmarshal(callbackData[0].CallbackArgs, &swapCallback)
+	s.Require().NoError(err, "no error expected when unmarshalling callback args")
+	s.Require().Equal(uint64(0), swapCallback.TradeRecordId, "callback trade record id")
+
+	// Confirm the swap is flagged as pending on the leg
+	pendingTradeRecordId, found := s.App.StakeibcKeeper.GetPendingSwap(s.Ctx, route.GetRouteId(), 0)
+	s.Require().True(found, "pending swap should have been stored")
+	s.Require().Equal(uint64(0), pendingTradeRecordId, "pending swap trade record id")
+}
+
+func (s *KeeperTestSuite) TestSwapRewardTokens_SwapPending() {
+	route, channelId, portId := s.SetupSwapRewardTokensTestCase()
+
+	// Submit the first swap
+	err := s.App.StakeibcKeeper.SwapRewardTokens(s.Ctx, sdkmath.NewInt(500), route, 0)
+	s.Require().NoError(err, "no error expected when submitting the first swap")
+
+	// Attempt to submit another swap before the first is acknowledged, it should be refused
+	startSequence := s.MustGetNextSequenceNumber(portId, channelId)
+	err = s.App.StakeibcKeeper.SwapRewardTokens(s.Ctx, sdkmath.NewInt(500), route, 0)
+	s.Require().ErrorIs(err, types.ErrSwapPending)
+
+	endSequence := s.MustGetNextSequenceNumber(portId, channelId)
+	s.Require().Equal(startSequence, endSequence, "no ICA should have been submitted")
+	s.Require().Len(s.App.StakeibcKeeper.GetAllTradeRecords(s.Ctx), 1, "no new trade record should have been created")
+
+	// Once the pending swap is cleared, the next swap can be submitted
+	s.App.StakeibcKeeper.RemovePendingSwap(s.Ctx, route.GetRouteId(), 0)
+	s.CheckICATxSubmitted(portId, channelId, func() error {
+		return s.App.StakeibcKeeper.SwapRewardTokens(s.Ctx, sdkmath.NewInt(500), route, 0)
+	})
+}
+
+func (s *KeeperTestSuite) TestSwapRewardTokens_StaleOracle() {
+	route, channelId, portId := s.SetupSwapRewardTokensTestCase()
+
+	// Make the oracle price stale, the swap should be refused
+	s.setRewardOraclePrice(sdk.NewDec(2), s.Ctx.BlockTime().Add(-time.Hour*24*365))
+
+	startSequence := s.MustGetNextSequenceNumber(portId, channelId)
+	err := s.App.StakeibcKeeper.SwapRewardTokens(s.Ctx, sdkmath.NewInt(500), route, 0)
+	s.Require().ErrorContains(err, "oracle price unavailable")
+
+	endSequence := s.MustGetNextSequenceNumber(portId, channelId)
+	s.Require().Equal(startSequence, endSequence, "no ICA should have been submitted")
+	s.Require().Empty(s.App.StakeibcKeeper.GetAllTradeRecords(s.Ctx), "no trade record should have been created")
+}
+
+func (s *KeeperTestSuite) TestSwapRewardTokens_FailedToSubmitICA() {
+	route, _, _ := s.SetupSwapRewardTokensTestCase()
+
+	// Point the trade account at an invalid connection so the ICA fails
+	route.TradeAccount.ConnectionId = "bad-connection"
+	err := s.App.StakeibcKeeper.SwapRewardTokens(s.Ctx, sdkmath.NewInt(500), route, 0)
+	s.Require().ErrorContains(err, "Failed to submit ICA tx")
+
+	// The pending trade record should have been removed
+	s.Require().Empty(s.App.StakeibcKeeper.GetAllTradeRecords(s.Ctx), "no trade record should remain")
+	_, found := s.App.StakeibcKeeper.GetPendingSwap(s.Ctx, route.GetRouteId(), 0)
+	s.Require().False(found, "no pending swap should be stored")
+}
+
+func (s *KeeperTestSuite) TestSwapRewardTokens_EpochNotFound() {
+	route, _, _ := s.SetupSwapRewardTokensTestCase()
+
+	s.App.StakeibcKeeper.RemoveEpochTracker(s.Ctx, epochtypes.STRIDE_EPOCH)
+	err := s.App.StakeibcKeeper.SwapRewardTokens(s.Ctx, sdkmath.NewInt(500), route, 0)
+	s.Require().ErrorContains(err, "epoch not found")
+}
+
 // --------------------------------------------------------------
 //                   Transfer Host to Trade
 // --------------------------------------------------------------
@@ -598,6 +924,66 @@ func (s *KeeperTestSuite) TestTransferConvertedTokensTradeToHost() {
 	s.Require().ErrorContains(err, "no trade account found")
 }
 
+func (s *KeeperTestSuite) TestTransferConvertedTokens_NextLeg() {
+	transferAmount := sdkmath.NewInt(1000)
+
+	// Register a trade ICA account for the first leg
+	owner := types.FormatTradeRouteICAOwner(HostChainId, RewardDenom, HostDenom, types.ICAAccountType_CONVERTER_TRADE)
+	channelId, portId := s.CreateICAChannel(owner)
+
+	// Create trade route with a second leg to receive the first leg's output
+	route := types.TradeRoute{
+		RewardDenomOnRewardZone: RewardDenom,
+		HostDenomOnHostZone:     HostDenom,
+
+		HostDenomOnTradeZone: "ibc/intermediate-on-trade",
+		TradeToHostChannelId: "channel-1",
+		HostAccount: types.ICAAccount{
+			Address: "host_address",
+		},
+		TradeAccount: types.ICAAccount{
+			ChainId:      HostChainId,
+			Address:      "trade_address",
+			ConnectionId: ibctesting.FirstConnectionID,
+			Type:         types.ICAAccountType_CONVERTER_TRADE,
+		},
+		AdditionalLegs: []types.TradeLeg{{
+			TradeAccount: types.ICAAccount{
+				ChainId: "trade-2",
+				Address: "trade_address_2",
+				Type:    types.ICAAccountType_CONVERTER_TRADE,
+			},
+			InputDenomOnTradeZone:  "ibc/intermediate-on-trade-2",
+			OutputDenomOnTradeZone: "ibc/host-on-trade-2",
+			InboundChannelId:       "channel-2",
+		}},
+	}
+	s.App.StakeibcKeeper.SetTradeRoute(s.Ctx, route)
+
+	// Create epoch tracker to dictate timeout
+	s.CreateEpochForICATimeout(epochtypes.STRIDE_EPOCH, time.Second*10)
+
+	// Confirm the sequence number was incremented after a successful send from the first leg
+	startSequence := s.MustGetNextSequenceNumber(portId, channelId)
+
+	err := s.App.StakeibcKeeper.TransferConvertedTokens(s.Ctx, transferAmount, route, 0)
+	s.Require().NoError(err, "no error expected when transfering tokens to the next leg")
+
+	endSequence := s.MustGetNextSequenceNumber(portId, channelId)
+	s.Require().Equal(startSequence+1, endSequence, "sequence number should have incremented from transfer")
+
+	// Attempt to send without the next leg's ICA address - it should fail
+	invalidRoute := route
+	invalidRoute.AdditionalLegs = []types.TradeLeg{route.AdditionalLegs[0]}
+	invalidRoute.AdditionalLegs[0].TradeAccount.Address = ""
+	err = s.App.StakeibcKeeper.TransferConvertedTokens(s.Ctx, transferAmount, invalidRoute, 0)
+	s.Require().ErrorContains(err, "no trade account found for leg 1")
+
+	// Attempt to send from a leg that does not exist - it should fail
+	err = s.App.StakeibcKeeper.TransferConvertedTokens(s.Ctx, transferAmount, route, 2)
+	s.Require().ErrorContains(err, "trade leg 2 not found")
+}
+
 // --------------------------------------------------------------
 //            Trade Route ICQ Test Helpers
 // --------------------------------------------------------------
@@ -742,7 +1128,7 @@ func (s *KeeperTestSuite) SetupTradeConvertedBalanceQueryTestCase() (route types
 func (s *KeeperTestSuite) TestTradeConvertedBalanceQuery_Successful() {
 	route, timeoutDuration := s.SetupTradeConvertedBalanceQueryTestCase()
 
-	err := s.App.StakeibcKeeper.TradeConvertedBalanceQuery(s.Ctx, route)
+	err := s.App.StakeibcKeeper.TradeConvertedBalanceQuery(s.Ctx, route, 0)
 	s.Require().NoError(err, "no error expected when querying balance")
 
 	// Validate fields from ICQ submission
@@ -767,7 +1153,7 @@ func (s *KeeperTestSuite) TestTradeConvertedBalanceQuery_Failure_InvalidAccountA
 	// Change the trade ICA account address to be invalid
 	tradeRoute.TradeAccount.Address = "invalid_address"
 
-	err := s.App.StakeibcKeeper.TradeConvertedBalanceQuery(s.Ctx, tradeRoute)
+	err := s.App.StakeibcKeeper.TradeConvertedBalanceQuery(s.Ctx, tradeRoute, 0)
 	s.Require().ErrorContains(err, "invalid trade account address")
 }
 
@@ -778,7 +1164,7 @@ func (s *KeeperTestSuite) TestTradeConvertedBalanceQuery_Failure_MissingEpoch()
 	// Remove the stride epoch so the test fails
 	s.App.StakeibcKeeper.RemoveEpochTracker(s.Ctx, epochtypes.STRIDE_EPOCH)
 
-	err := s.App.StakeibcKeeper.TradeConvertedBalanceQuery(s.Ctx, tradeRoute)
+	err := s.App.StakeibcKeeper.TradeConvertedBalanceQuery(s.Ctx, tradeRoute, 0)
 	s.Require().ErrorContains(err, "stride_epoch: epoch not found")
 }
 
@@ -789,6 +1175,55 @@ func (s *KeeperTestSuite) TestTradeConvertedBalanceQuery_FailedQuerySubmission()
 	// Change the trade ICA connection id to be invalid
 	tradeRoute.TradeAccount.ConnectionId = "invalid_connection"
 
-	err := s.App.StakeibcKeeper.TradeConvertedBalanceQuery(s.Ctx, tradeRoute)
+	err := s.App.StakeibcKeeper.TradeConvertedBalanceQuery(s.Ctx, tradeRoute, 0)
 	s.Require().ErrorContains(err, "invalid connection-id (invalid_connection)")
 }
+
+// --------------------------------------------------------------
+//               Trade Account - Reward Balance Query
+// --------------------------------------------------------------
+
+// Tests a successful TradeRewardBalanceQuery
+func (s *KeeperTestSuite) TestTradeRewardBalanceQuery_Successful() {
+	route, timeoutDuration := s.SetupTradeConvertedBalanceQueryTestCase()
+	route.RewardDenomOnTradeZone = "ibc/reward_on_trade"
+
+	err := s.App.StakeibcKeeper.TradeRewardBalanceQuery(s.Ctx, route, 0)
+	s.Require().NoError(err, "no error expected when querying balance")
+
+	// Validate fields from ICQ submission
+	expectedRequestData := s.GetBankStoreKeyPrefix(StrideICAAddress, route.RewardDenomOnTradeZone)
+
+	query := s.ValidateQuerySubmission(
+		icqtypes.BANK_STORE_QUERY_WITH_PROOF,
+		expectedRequestData,
+		keeper.ICQCallbackID_TradeRewardBalance,
+		timeoutDuration,
+		icqtypes.TimeoutPolicy_REJECT_QUERY_RESPONSE,
+	)
+
+	s.validateAddressAndDenomInRequest(query.RequestData, route.TradeAccount.Address, route.RewardDenomOnTradeZone)
+	s.validateTradeRouteQueryCallback(query.CallbackData)
+}
+
+// Tests a TradeRewardBalanceQuery that fails due to an invalid account address
+func (s *KeeperTestSuite) TestTradeRewardBalanceQuery_Failure_InvalidAccountAddress() {
+	tradeRoute, _ := s.SetupTradeConvertedBalanceQueryTestCase()
+
+	// Change the trade ICA account address to be invalid
+	tradeRoute.TradeAccount.Address = "invalid_address"
+
+	err := s.App.StakeibcKeeper.TradeRewardBalanceQuery(s.Ctx, tradeRoute, 0)
+	s.Require().ErrorContains(err, "invalid trade account address")
+}
+
+// Tests a TradeRewardBalanceQuery that fails due to a missing epoch tracker
+func (s *KeeperTestSuite) TestTradeRewardBalanceQuery_Failure_MissingEpoch() {
+	tradeRoute, _ := s.SetupTradeConvertedBalanceQueryTestCase()
+
+	// Remove the stride epoch so the test fails
+	s.App.StakeibcKeeper.RemoveEpochTracker(s.Ctx, epochtypes.STRIDE_EPOCH)
+
+	err := s.App.StakeibcKeeper.TradeRewardBalanceQuery(s.Ctx, tradeRoute, 0)
+	s.Require().ErrorContains(err, "epoch not found")
+}
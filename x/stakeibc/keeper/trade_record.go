@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v27/x/stakeibc/types"
+)
+
+// GetTradeRecordCount returns the total number of trade records
+func (k Keeper) GetTradeRecordCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefix(types.TradeRecordCountKey))
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetTradeRecordCount sets the total number of trade records
+func (k Keeper) SetTradeRecordCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(types.KeyPrefix(types.TradeRecordCountKey), bz)
+}
+
+// AppendTradeRecord stores a trade record with a new id and increments the count
+func (k Keeper) AppendTradeRecord(ctx sdk.Context, tradeRecord types.TradeRecord) uint64 {
+	count := k.GetTradeRecordCount(ctx)
+
+	tradeRecord.Id = count
+	k.SetTradeRecord(ctx, tradeRecord)
+
+	k.SetTradeRecordCount(ctx, count+1)
+
+	return count
+}
+
+// SetTradeRecord set a specific trade record in the store
+func (k Keeper) SetTradeRecord(ctx sdk.Context, tradeRecord types.TradeRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TradeRecordKeyPrefix))
+	b := k.cdc.MustMarshal(&tradeRecord)
+	store.Set(types.TradeRecordKey(tradeRecord.Id), b)
+}
+
+// GetTradeRecord returns a trade record from its id
+func (k Keeper) GetTradeRecord(ctx sdk.Context, id uint64) (val types.TradeRecord, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TradeRecordKeyPrefix))
+	b := store.Get(types.TradeRecordKey(id))
+	if len(b) == 0 {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveTradeRecord removes a trade record from the store
+func (k Keeper) RemoveTradeRecord(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TradeRecordKeyPrefix))
+	store.Delete(types.TradeRecordKey(id))
+}
+
+// SetPendingSwap flags that a trade route leg has an in-flight swap, tracked by its trade record
+func (k Keeper) SetPendingSwap(ctx sdk.Context, routeId string, legIndex uint32, tradeRecordId uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingSwapKeyPrefix))
+	store.Set(types.PendingSwapKey(routeId, legIndex), types.TradeRecordKey(tradeRecordId))
+}
+
+// GetPendingSwap returns the trade record id of the in-flight swap on a trade route leg
+func (k Keeper) GetPendingSwap(ctx sdk.Context, routeId string, legIndex uint32) (tradeRecordId uint64, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingSwapKeyPrefix))
+	bz := store.Get(types.PendingSwapKey(routeId, legIndex))
+	if len(bz) == 0 {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(bz), true
+}
+
+// RemovePendingSwap clears the in-flight swap on a trade route leg
+func (k Keeper) RemovePendingSwap(ctx sdk.Context, routeId string, legIndex uint32) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingSwapKeyPrefix))
+	store.Delete(types.PendingSwapKey(routeId, legIndex))
+}
+
+// GetAllTradeRecords returns all trade records
+func (k Keeper) GetAllTradeRecords(ctx sdk.Context) (list []types.TradeRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TradeRecordKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TradeRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v27/x/stakeibc/types"
+)
+
+// Helper function to create trade records across two routes
+func (s *KeeperTestSuite) CreateTradeRecords() (records []types.TradeRecord) {
+	for _, hostDenom := range []string{"host-denom-1", "host-denom-2", "host-denom-1"} {
+		record := types.TradeRecord{
+			RewardDenom:       RewardDenom,
+			HostDenom:         hostDenom,
+			TokenInAmount:     sdkmath.NewInt(1000),
+			MinTokenOutAmount: sdkmath.NewInt(900),
+			TokenOutAmount:    sdkmath.ZeroInt(),
+			OraclePrice:       sdk.OneDec(),
+			RealizedPrice:     sdk.ZeroDec(),
+			SubmittedTime:     s.Ctx.BlockTime(),
+		}
+		record.Id = s.App.StakeibcKeeper.AppendTradeRecord(s.Ctx, record)
+		records = append(records, record)
+	}
+	return records
+}
+
+func (s *KeeperTestSuite) TestGetTradeRecord() {
+	records := s.CreateTradeRecords()
+	for i, expectedRecord := range records {
+		actualRecord, found := s.App.StakeibcKeeper.GetTradeRecord(s.Ctx, uint64(i))
+		s.Require().True(found, "trade record %d should have been found", i)
+		s.Require().Equal(expectedRecord, actualRecord, "trade record %d", i)
+	}
+
+	_, found := s.App.StakeibcKeeper.GetTradeRecord(s.Ctx, uint64(len(records)))
+	s.Require().False(found, "trade record should not have been found")
+	s.Require().Equal(uint64(len(records)), s.App.StakeibcKeeper.GetTradeRecordCount(s.Ctx), "trade record count")
+}
+
+func (s *KeeperTestSuite) TestQueryTradeRecords() {
+	records := s.CreateTradeRecords()
+
+	// Query without a filter
+	resp, err := s.App.StakeibcKeeper.TradeRecords(sdk.WrapSDKContext(s.Ctx), &types.QueryTradeRecordsRequest{})
+	s.Require().NoError(err, "no error expected when querying all trade records")
+	s.Require().Equal(records, resp.TradeRecords, "all trade records")
+
+	// Query filtered by the route
+	resp, err = s.App.StakeibcKeeper.TradeRecords(sdk.WrapSDKContext(s.Ctx), &types.QueryTradeRecordsRequest{
+		RewardDenom: RewardDenom,
+		HostDenom:   "host-denom-1",
+	})
+	s.Require().NoError(err, "no error expected when querying trade records by route")
+	s.Require().Equal([]types.TradeRecord{records[0], records[2]}, resp.TradeRecords, "filtered trade records")
+}
@@ -54,11 +54,27 @@ func (k Keeper) TransferExistingDepositsToHostZones(ctx sdk.Context, epochNumber
 			continue
 		}
 
+		depositInterval := k.GetHostZoneEpochCadence(ctx, hostZone).DepositInterval
+		if epochNumber%depositInterval != 0 {
+			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+				"Host does not transfer deposits this epoch (Interval: %d, Epoch: %d)", depositInterval, epochNumber))
+			continue
+		}
+
 		if hostZone.DelegationIcaAddress == "" {
 			k.Logger(ctx).Error(fmt.Sprintf("[TransferExistingDepositsToHostZones] Zone %s is missing a delegation address!", hostZone.ChainId))
 			continue
 		}
 
+		// Determine which delegation shard the deposit should be sent to
+		// The shard is stored on the record so that it is later staked from the same account
+		shardIndex, delegationAddress, err := k.GetDelegationShardForDeposit(hostZone)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("[TransferExistingDepositsToHostZones] %s", err.Error()))
+			continue
+		}
+		depositRecord.DelegationShardIndex = shardIndex
+
 		k.Logger(ctx).Info(utils.LogWithHostZone(depositRecord.HostZoneId, "Transferring %v%s", depositRecord.Amount, hostZone.HostDenom))
 		transferCoin := sdk.NewCoin(hostZone.IbcDenom, depositRecord.Amount)
 
@@ -73,7 +89,7 @@ func (k Keeper) TransferExistingDepositsToHostZones(ctx sdk.Context, epochNumber
 			hostZone.TransferChannelId,
 			transferCoin,
 			hostZone.DepositAddress,
-			hostZone.DelegationIcaAddress,
+			delegationAddress,
 			clienttypes.Height{},
 			timeoutTimestamp,
 			"",
@@ -81,10 +97,10 @@ func (k Keeper) TransferExistingDepositsToHostZones(ctx sdk.Context, epochNumber
 		k.Logger(ctx).Info(utils.LogWithHostZone(depositRecord.HostZoneId, "Transfer Msg: %+v", msg))
 
 		// transfer the deposit record and update its status to TRANSFER_IN_PROGRESS
-		err := k.RecordsKeeper.IBCTransferNativeTokens(ctx, msg, depositRecord)
+		err = k.RecordsKeeper.IBCTransferNativeTokens(ctx, msg, depositRecord)
 		if err != nil {
 			k.Logger(ctx).Error(fmt.Sprintf("[TransferExistingDepositsToHostZones] Failed to initiate IBC transfer to host zone, HostZone: %v, Channel: %v, Amount: %v, ModuleAddress: %v, DelegateAddress: %v, Timeout: %v",
-				hostZone.ChainId, hostZone.TransferChannelId, transferCoin, hostZone.DepositAddress, hostZone.DelegationIcaAddress, timeoutTimestamp))
+				hostZone.ChainId, hostZone.TransferChannelId, transferCoin, hostZone.DepositAddress, delegationAddress, timeoutTimestamp))
 			k.Logger(ctx).Error(fmt.Sprintf("[TransferExistingDepositsToHostZones] err {%s}", err.Error()))
 			continue
 		}
@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/Stride-Labs/stride/v27/utils"
+	epochstypes "github.com/Stride-Labs/stride/v27/x/epochs/types"
 	recordstypes "github.com/Stride-Labs/stride/v27/x/records/types"
 	"github.com/Stride-Labs/stride/v27/x/stakeibc/types"
 )
@@ -193,6 +194,77 @@ func (k Keeper) GetValidatorUnbondCapacity(
 	return validatorCapacities
 }
 
+// Determines the unbond capacity of each validator from a delegation shard, given the total amount
+// that's being unbonded from the host zone
+//
+// Each validator can only unbond up to the difference between their current delegation and their balanced
+// delegation after the unbonding, and no more than the shard's delegation to the validator
+func (k Keeper) GetShardUnbondCapacity(
+	ctx sdk.Context,
+	hostZone types.HostZone,
+	shardIndex uint32,
+	totalNativeUnbondAmount sdkmath.Int,
+) (validatorUnbondCapacity []ValidatorUnbondCapacity, err error) {
+	// Determine the total eligible unbond amount - excluding delegations to validators with a slash query in progress
+	totalValidDelegationBeforeUnbonding := sdkmath.ZeroInt()
+	for _, validator := range hostZone.Validators {
+		if !validator.SlashQueryInProgress {
+			totalValidDelegationBeforeUnbonding = totalValidDelegationBeforeUnbonding.Add(validator.Delegation)
+		}
+	}
+
+	// Determine the ideal balanced delegation for each validator after the unbonding
+	//   (as if we were to unbond and then rebalance)
+	delegationAfterUnbonding := totalValidDelegationBeforeUnbonding.Sub(totalNativeUnbondAmount)
+	balancedDelegationsAfterUnbonding, err := k.GetTargetValAmtsForHostZone(ctx, hostZone, delegationAfterUnbonding)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "unable to get target val amounts for host zone %s", hostZone.ChainId)
+	}
+
+	// Determine the unbond capacity for each validator
+	// Each validator can only unbond up to the difference between their current delegation and their balanced delegation
+	// The validator's current delegation will be above their balanced delegation if they've received LSM Liquid Stakes
+	//   (which is only rebalanced once per unbonding period)
+	validatorUnbondCapacity = k.GetValidatorUnbondCapacity(ctx, hostZone.Validators, balancedDelegationsAfterUnbonding)
+	validatorUnbondCapacity = k.LimitUnbondCapacityToShard(hostZone.Validators, shardIndex, validatorUnbondCapacity)
+
+	return validatorUnbondCapacity, nil
+}
+
+// Returns the total unbond capacity across each validator
+func GetTotalUnbondCapacity(validatorUnbondCapacity []ValidatorUnbondCapacity) sdkmath.Int {
+	totalCapacity := sdkmath.ZeroInt()
+	for _, validatorCapacity := range validatorUnbondCapacity {
+		totalCapacity = totalCapacity.Add(validatorCapacity.Capacity)
+	}
+	return totalCapacity
+}
+
+// Limits each validator's unbond capacity to the delegation from the shard that's unbonding
+// If the host zone only has one delegation shard, the capacities are unchanged
+// Validators without any delegation from the shard are removed from the list
+func (k Keeper) LimitUnbondCapacityToShard(
+	validators []*types.Validator,
+	shardIndex uint32,
+	validatorCapacities []ValidatorUnbondCapacity,
+) (shardCapacities []ValidatorUnbondCapacity) {
+	shardDelegations := map[string]sdkmath.Int{}
+	for _, validator := range validators {
+		shardDelegations[validator.Address] = validator.GetShardDelegation(shardIndex)
+	}
+
+	for _, validatorCapacity := range validatorCapacities {
+		shardDelegation, ok := shardDelegations[validatorCapacity.ValidatorAddress]
+		if !ok || !shardDelegation.IsPositive() {
+			continue
+		}
+		validatorCapacity.Capacity = sdkmath.MinInt(validatorCapacity.Capacity, shardDelegation)
+		shardCapacities = append(shardCapacities, validatorCapacity)
+	}
+
+	return shardCapacities
+}
+
 // Sort validators by the ratio of the ideal balanced delegation to their current delegation
 // This will sort the validator's by how proportionally unbalanced they are
 //
@@ -249,9 +321,16 @@ func SortUnbondingCapacityByPriority(validatorUnbondCapacity []ValidatorUnbondCa
 // Returns the list of messages and the callback data for the ICA
 func (k Keeper) GetUnbondingICAMessages(
 	hostZone types.HostZone,
+	shardIndex uint32,
 	totalUnbondAmount sdkmath.Int,
 	prioritizedUnbondCapacity []ValidatorUnbondCapacity,
 ) (msgs []proto.Message, unbondings []*types.SplitUndelegation, err error) {
+	delegatorAddress, found := hostZone.GetDelegationShardAddress(shardIndex)
+	if !found || delegatorAddress == "" {
+		return msgs, unbondings, errorsmod.Wrapf(types.ErrICAAccountNotFound,
+			"no delegation account found for shard %d on %s", shardIndex, hostZone.ChainId)
+	}
+
 	// Loop through each validator and unbond as much as possible
 	remainingUnbondAmount := totalUnbondAmount
 	for _, validatorCapacity := range prioritizedUnbondCapacity {
@@ -280,7 +359,7 @@ func (k Keeper) GetUnbondingICAMessages(
 	// Build the undelegate ICA messages from the splits
 	for _, unbonding := range unbondings {
 		msgs = append(msgs, &stakingtypes.MsgUndelegate{
-			DelegatorAddress: hostZone.DelegationIcaAddress,
+			DelegatorAddress: delegatorAddress,
 			ValidatorAddress: unbonding.Validator,
 			Amount:           sdk.NewCoin(hostZone.HostDenom, unbonding.NativeTokenAmount),
 		})
@@ -299,6 +378,7 @@ func (k Keeper) GetUnbondingICAMessages(
 func (k Keeper) BatchSubmitUndelegateICAMessages(
 	ctx sdk.Context,
 	hostZone types.HostZone,
+	shardIndex uint32,
 	epochUnbondingRecordIds []uint64,
 	msgs []proto.Message,
 	unbondings []*types.SplitUndelegation,
@@ -319,6 +399,7 @@ func (k Keeper) BatchSubmitUndelegateICAMessages(
 			HostZoneId:              hostZone.ChainId,
 			SplitUndelegations:      unbondingsBatch,
 			EpochUnbondingRecordIds: epochUnbondingRecordIds,
+			DelegationShardIndex:    shardIndex,
 		}
 		callbackArgsBz, err := proto.Marshal(&undelegateCallback)
 		if err != nil {
@@ -326,11 +407,12 @@ func (k Keeper) BatchSubmitUndelegateICAMessages(
 		}
 
 		// Submit the undelegation ICA
-		if _, err := k.SubmitTxsDayEpoch(
+		if _, err := k.SubmitDelegationShardTxsEpoch(
 			ctx,
-			hostZone.ConnectionId,
+			hostZone,
+			shardIndex,
 			msgsBatch,
-			types.ICAAccountType_DELEGATION,
+			epochstypes.DAY_EPOCH,
 			ICACallbackID_Undelegate,
 			callbackArgsBz,
 		); err != nil {
@@ -360,7 +442,10 @@ func (k Keeper) BatchSubmitUndelegateICAMessages(
 //
 // Context: Over time, as LSM Liquid stakes are accepted, the total stake managed by the protocol becomes unbalanced
 // as liquid stakes are not aligned with the validator weights. This is only rebalanced once per unbonding period
-func (k Keeper) UnbondFromHostZone(ctx sdk.Context, hostZone types.HostZone) (err error) {
+//
+// The undelegations are submitted from the given delegation shard, and each validator's capacity is
+// limited to the shard's delegation
+func (k Keeper) UnbondFromHostZone(ctx sdk.Context, hostZone types.HostZone, shardIndex uint32) (err error) {
 	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
 		"Preparing MsgUndelegates from the delegation account to each validator"))
 
@@ -389,27 +474,29 @@ func (k Keeper) UnbondFromHostZone(ctx sdk.Context, hostZone types.HostZone) (er
 		return nil
 	}
 
-	// Determine the total eligible unbond amount - excluding delegations to validators with a slash query in progress
-	totalValidDelegationBeforeUnbonding := sdkmath.ZeroInt()
-	for _, validator := range hostZone.Validators {
-		if !validator.SlashQueryInProgress {
-			totalValidDelegationBeforeUnbonding = totalValidDelegationBeforeUnbonding.Add(validator.Delegation)
-		}
-	}
-
-	// Determine the ideal balanced delegation for each validator after the unbonding
-	//   (as if we were to unbond and then rebalance)
-	delegationAfterUnbonding := totalValidDelegationBeforeUnbonding.Sub(totalNativeUnbondAmount)
-	balancedDelegationsAfterUnbonding, err := k.GetTargetValAmtsForHostZone(ctx, hostZone, delegationAfterUnbonding)
+	// Determine the unbond capacity from the shard
+	// If the host zone has multiple delegation shards and this shard cannot cover the full unbonding,
+	// the most recent records are left in the queue so that they're unbonded from the next shard
+	// in the rotation
+	validatorUnbondCapacity, err := k.GetShardUnbondCapacity(ctx, hostZone, shardIndex, totalNativeUnbondAmount)
 	if err != nil {
-		return errorsmod.Wrapf(err, "unable to get target val amounts for host zone %s", hostZone.ChainId)
+		return err
 	}
+	hasMultipleShards := len(hostZone.GetUnbondingShardIndexes()) > 1
+	for hasMultipleShards && len(epochUnbondingRecordIds) > 1 && GetTotalUnbondCapacity(validatorUnbondCapacity).LT(totalNativeUnbondAmount) {
+		deferredEpochNumber := epochUnbondingRecordIds[len(epochUnbondingRecordIds)-1]
+		k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+			"Insufficient unbond capacity on shard %d, deferring epoch %d to the next shard", shardIndex, deferredEpochNumber))
 
-	// Determine the unbond capacity for each validator
-	// Each validator can only unbond up to the difference between their current delegation and their balanced delegation
-	// The validator's current delegation will be above their balanced delegation if they've received LSM Liquid Stakes
-	//   (which is only rebalanced once per unbonding period)
-	validatorUnbondCapacity := k.GetValidatorUnbondCapacity(ctx, hostZone.Validators, balancedDelegationsAfterUnbonding)
+		epochUnbondingRecordIds = epochUnbondingRecordIds[:len(epochUnbondingRecordIds)-1]
+		delete(epochNumbersToHostZoneUnbondings, deferredEpochNumber)
+		totalNativeUnbondAmount = k.GetTotalUnbondAmount(epochNumbersToHostZoneUnbondings)
+
+		validatorUnbondCapacity, err = k.GetShardUnbondCapacity(ctx, hostZone, shardIndex, totalNativeUnbondAmount)
+		if err != nil {
+			return err
+		}
+	}
 	if len(validatorUnbondCapacity) == 0 {
 		return fmt.Errorf("there are no validators on %s with sufficient unbond capacity", hostZone.ChainId)
 	}
@@ -426,6 +513,7 @@ func (k Keeper) UnbondFromHostZone(ctx sdk.Context, hostZone types.HostZone) (er
 	undelegateBatchSize := int(utils.UintToInt(hostZone.MaxMessagesPerIcaTx))
 	msgs, unbondings, err := k.GetUnbondingICAMessages(
 		hostZone,
+		shardIndex,
 		totalNativeUnbondAmount,
 		prioritizedUnbondCapacity,
 	)
@@ -442,6 +530,7 @@ func (k Keeper) UnbondFromHostZone(ctx sdk.Context, hostZone types.HostZone) (er
 	numTxsSubmitted, err := k.BatchSubmitUndelegateICAMessages(
 		ctx,
 		hostZone,
+		shardIndex,
 		epochUnbondingRecordIds,
 		msgs,
 		unbondings,
@@ -456,6 +545,7 @@ func (k Keeper) UnbondFromHostZone(ctx sdk.Context, hostZone types.HostZone) (er
 		hostZoneUnbonding := epochNumbersToHostZoneUnbondings[epochNumber]
 		hostZoneUnbonding.Status = recordstypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS
 		hostZoneUnbonding.UndelegationTxsInProgress += numTxsSubmitted
+		hostZoneUnbonding.DelegationShardIndex = shardIndex
 		err := k.RecordsKeeper.SetHostZoneUnbondingRecord(ctx, epochNumber, hostZone.ChainId, hostZoneUnbonding)
 		if err != nil {
 			return err
@@ -483,9 +573,12 @@ func (k Keeper) InitiateAllHostZoneUnbondings(ctx sdk.Context, dayNumber uint64)
 			continue
 		}
 
+		// Unbondings rotate through each delegation shard
+		shardIndex := hostZone.GetUnbondingShardIndex(dayNumber)
+
 		// Get host zone unbonding message by summing up the unbonding records
 		err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
-			return k.UnbondFromHostZone(ctx, hostZone)
+			return k.UnbondFromHostZone(ctx, hostZone, shardIndex)
 		})
 		if err != nil {
 			k.Logger(ctx).Error(fmt.Sprintf("Error initiating host zone unbondings for host zone %s: %s", hostZone.ChainId, err.Error()))
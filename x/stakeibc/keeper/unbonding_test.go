@@ -117,7 +117,7 @@ func (s *KeeperTestSuite) SetupTestUnbondFromHostZone(
 // holds the expected unbondings for each validator
 func (s *KeeperTestSuite) CheckUnbondingMessages(tc UnbondingTestCase, expectedUnbondings []ValidatorUnbonding) {
 	// Trigger unbonding
-	err := s.App.StakeibcKeeper.UnbondFromHostZone(s.Ctx, tc.hostZone)
+	err := s.App.StakeibcKeeper.UnbondFromHostZone(s.Ctx, tc.hostZone, 0)
 	s.Require().NoError(err, "no error expected when calling unbond from host")
 
 	// Check that sequence number incremented from a sent ICA
@@ -402,13 +402,49 @@ func (s *KeeperTestSuite) TestUnbondFromHostZone_Successful_RefreshedNativeAmoun
 	s.CheckUnbondingMessages(tc, expectedUnbondings)
 }
 
+func (s *KeeperTestSuite) TestUnbondFromHostZone_Successful_DeferRecordsToNextShard() {
+	// Total Stake: 1000 (60 on shard 0, 940 on shard 1)
+	// Unbond Amount: 100 across two records of 50
+	//
+	// Unbonding both records would require 100 from shard 0, but it only has 60,
+	// so only the first record should be unbonded and the second should stay queued
+	totalStake := sdkmath.NewInt(1000)
+	totalWeight := int64(100)
+	validators := []*types.Validator{
+		{Address: "valA", Weight: 100, Delegation: totalStake, ShardDelegations: []types.ShardDelegation{
+			{ShardIndex: 1, Delegation: sdkmath.NewInt(940)},
+		}},
+	}
+	expectedUnbondings := []ValidatorUnbonding{
+		{Validator: "valA", UnbondAmount: sdkmath.NewInt(50)},
+	}
+
+	tc := s.SetupTestUnbondFromHostZone(totalWeight, totalStake, sdkmath.NewInt(100), validators)
+
+	tc.hostZone.DelegationShards = []types.DelegationShard{{Index: 1, IcaAddress: "cosmos_SHARD_1"}}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, tc.hostZone)
+
+	tc.totalUnbondAmount = sdkmath.NewInt(50)
+	tc.expectedUnbondingRecordIds = []uint64{1}
+	s.CheckUnbondingMessages(tc, expectedUnbondings)
+
+	// Confirm the first record is unbonding from shard 0 and the second is still queued
+	firstRecord, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, 1, HostChainId)
+	s.Require().True(found, "first host zone unbonding record should have been found")
+	s.Require().Equal(recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS, firstRecord.Status, "first record status")
+
+	secondRecord, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, 2, HostChainId)
+	s.Require().True(found, "second host zone unbonding record should have been found")
+	s.Require().Equal(recordtypes.HostZoneUnbonding_UNBONDING_QUEUE, secondRecord.Status, "second record status")
+}
+
 func (s *KeeperTestSuite) TestUnbondFromHostZone_NoDelegationAccount() {
 	// Call unbond on a host zone without a delegation account - it should error
 	invalidHostZone := types.HostZone{
 		ChainId:              HostChainId,
 		DelegationIcaAddress: "",
 	}
-	err := s.App.StakeibcKeeper.UnbondFromHostZone(s.Ctx, invalidHostZone)
+	err := s.App.StakeibcKeeper.UnbondFromHostZone(s.Ctx, invalidHostZone, 0)
 	s.Require().ErrorContains(err, "no delegation account found for GAIA: ICA acccount not found on host zone")
 }
 
@@ -419,7 +455,7 @@ func (s *KeeperTestSuite) TestUnbondFromHostZone_ZeroUnbondAmount() {
 	tc := s.SetupTestUnbondFromHostZone(totalWeight, totalStake, totalUnbondAmount, []*types.Validator{})
 
 	// Call unbond - it should NOT error since the unbond amount was 0 - but it should short circuit
-	err := s.App.StakeibcKeeper.UnbondFromHostZone(s.Ctx, tc.hostZone)
+	err := s.App.StakeibcKeeper.UnbondFromHostZone(s.Ctx, tc.hostZone, 0)
 	s.Require().Nil(err, "unbond should not have thrown an error - it should have simply ignored the host zone")
 
 	// Confirm no ICAs were sent
@@ -441,7 +477,7 @@ func (s *KeeperTestSuite) TestUnbondFromHostZone_ZeroValidatorWeights() {
 	tc := s.SetupTestUnbondFromHostZone(totalWeight, totalStake, totalUnbondAmount, validators)
 
 	// Call unbond - it should fail
-	err := s.App.StakeibcKeeper.UnbondFromHostZone(s.Ctx, tc.hostZone)
+	err := s.App.StakeibcKeeper.UnbondFromHostZone(s.Ctx, tc.hostZone, 0)
 	s.Require().ErrorContains(err, "No non-zero validators found for host zone")
 }
 
@@ -458,7 +494,7 @@ func (s *KeeperTestSuite) TestUnbondFromHostZone_InsufficientDelegations() {
 	tc := s.SetupTestUnbondFromHostZone(totalWeight, totalStake, totalUnbondAmount, validators)
 
 	// Call unbond - it should fail
-	err := s.App.StakeibcKeeper.UnbondFromHostZone(s.Ctx, tc.hostZone)
+	err := s.App.StakeibcKeeper.UnbondFromHostZone(s.Ctx, tc.hostZone, 0)
 	s.Require().ErrorContains(err, "Cannot calculate target delegation if final amount is less than or equal to zero")
 }
 
@@ -474,7 +510,7 @@ func (s *KeeperTestSuite) TestUnbondFromHostZone_ICAFailed() {
 	invalidHostZone := tc.hostZone
 	invalidHostZone.ConnectionId = ""
 
-	err := s.App.StakeibcKeeper.UnbondFromHostZone(s.Ctx, invalidHostZone)
+	err := s.App.StakeibcKeeper.UnbondFromHostZone(s.Ctx, invalidHostZone, 0)
 	s.Require().ErrorContains(err, "unable to submit unbonding ICA for GAIA")
 }
 
@@ -891,6 +927,57 @@ func (s *KeeperTestSuite) TestGetValidatorUnbondCapacity() {
 	}
 }
 
+func (s *KeeperTestSuite) TestLimitUnbondCapacityToShard() {
+	// valA: 100 total, 40 on shard 1 (60 on shard 0)
+	// valB: 200 total, none on shard 1
+	// valC: 300 total, 300 on shard 1 (0 on shard 0)
+	validators := []*types.Validator{
+		{Address: "valA", Delegation: sdkmath.NewInt(100), ShardDelegations: []types.ShardDelegation{
+			{ShardIndex: 1, Delegation: sdkmath.NewInt(40)},
+		}},
+		{Address: "valB", Delegation: sdkmath.NewInt(200)},
+		{Address: "valC", Delegation: sdkmath.NewInt(300), ShardDelegations: []types.ShardDelegation{
+			{ShardIndex: 1, Delegation: sdkmath.NewInt(300)},
+		}},
+	}
+	capacities := []keeper.ValidatorUnbondCapacity{
+		{ValidatorAddress: "valA", Capacity: sdkmath.NewInt(50)},
+		{ValidatorAddress: "valB", Capacity: sdkmath.NewInt(150)},
+		{ValidatorAddress: "valC", Capacity: sdkmath.NewInt(100)},
+	}
+
+	testCases := []struct {
+		shardIndex         uint32
+		expectedCapacities map[string]int64
+	}{
+		{
+			// valA capped at 60, valB unchanged, valC has nothing on shard 0
+			shardIndex:         0,
+			expectedCapacities: map[string]int64{"valA": 50, "valB": 150},
+		},
+		{
+			// valA capped at 40, valB has nothing on shard 1, valC unchanged
+			shardIndex:         1,
+			expectedCapacities: map[string]int64{"valA": 40, "valC": 100},
+		},
+		{
+			// No delegations on shard 2
+			shardIndex:         2,
+			expectedCapacities: map[string]int64{},
+		},
+	}
+
+	for _, tc := range testCases {
+		actualCapacities := s.App.StakeibcKeeper.LimitUnbondCapacityToShard(validators, tc.shardIndex, capacities)
+		s.Require().Len(actualCapacities, len(tc.expectedCapacities), "number of capacities for shard %d", tc.shardIndex)
+		for _, actual := range actualCapacities {
+			expected, ok := tc.expectedCapacities[actual.ValidatorAddress]
+			s.Require().True(ok, "validator %s should not be included for shard %d", actual.ValidatorAddress, tc.shardIndex)
+			s.Require().Equal(expected, actual.Capacity.Int64(), "capacity for %s on shard %d", actual.ValidatorAddress, tc.shardIndex)
+		}
+	}
+}
+
 func (s *KeeperTestSuite) TestSortUnbondingCapacityByPriority() {
 	// First we define what the ideal list will look like after sorting
 	expectedSortedCapacities := []keeper.ValidatorUnbondCapacity{
@@ -1110,6 +1197,7 @@ func (s *KeeperTestSuite) TestGetUnbondingICAMessages() {
 			// Get the unbonding ICA messages for the test case
 			actualMessages, actualSplits, actualError := s.App.StakeibcKeeper.GetUnbondingICAMessages(
 				hostZone,
+				0,
 				tc.totalUnbondAmount,
 				validatorCapacities,
 			)
@@ -1205,6 +1293,7 @@ func (s *KeeperTestSuite) TestBatchSubmitUndelegateICAMessages() {
 	numTxsSubmitted, err := s.App.StakeibcKeeper.BatchSubmitUndelegateICAMessages(
 		s.Ctx,
 		hostZone,
+		0,
 		epochUnbondingRecordIds,
 		undelegateMsgs,
 		unbondings,
@@ -1227,6 +1316,7 @@ func (s *KeeperTestSuite) TestBatchSubmitUndelegateICAMessages() {
 	_, err = s.App.StakeibcKeeper.BatchSubmitUndelegateICAMessages(
 		s.Ctx,
 		invalidHostZone,
+		0,
 		epochUnbondingRecordIds,
 		undelegateMsgs,
 		unbondings,
@@ -117,18 +117,35 @@ func (k Keeper) AddDelegationToValidator(
 	validatorAddress string,
 	amount sdkmath.Int,
 	callbackId string,
+) error {
+	return k.AddShardDelegationToValidator(ctx, hostZone, 0, validatorAddress, amount, callbackId)
+}
+
+// Updates a validator's delegation from a given delegation shard, as well as the validator's
+// total delegation and the corresponding total delegation on the host zone
+// Note: This modifies the original host zone struct. The calling function must Set this host zone
+// for changes to persist
+func (k Keeper) AddShardDelegationToValidator(
+	ctx sdk.Context,
+	hostZone *types.HostZone,
+	shardIndex uint32,
+	validatorAddress string,
+	amount sdkmath.Int,
+	callbackId string,
 ) error {
 	for _, validator := range hostZone.Validators {
 		if validator.Address == validatorAddress {
+			shardDelegation := validator.GetShardDelegation(shardIndex)
 			k.Logger(ctx).Info(utils.LogICACallbackWithHostZone(hostZone.ChainId, callbackId,
-				"  Validator %s, Current Delegation: %v, Delegation Change: %v", validator.Address, validator.Delegation, amount))
+				"  Validator %s, Shard %d, Current Delegation: %v, Current Shard Delegation: %v, Delegation Change: %v",
+				validator.Address, shardIndex, validator.Delegation, shardDelegation, amount))
 
 			// If the delegation change is negative, make sure it wont cause the delegation to fall below zero
 			if amount.IsNegative() {
-				if amount.Abs().GT(validator.Delegation) {
+				if amount.Abs().GT(shardDelegation) {
 					return errorsmod.Wrapf(types.ErrValidatorDelegationChg,
-						"Delegation change (%v) is greater than validator (%s) delegation %v",
-						amount.Abs(), validatorAddress, validator.Delegation)
+						"Delegation change (%v) is greater than validator (%s) delegation %v from shard %d",
+						amount.Abs(), validatorAddress, shardDelegation, shardIndex)
 				}
 				if amount.Abs().GT(hostZone.TotalDelegations) {
 					return errorsmod.Wrapf(types.ErrValidatorDelegationChg,
@@ -137,7 +154,7 @@ func (k Keeper) AddDelegationToValidator(
 				}
 			}
 
-			validator.Delegation = validator.Delegation.Add(amount)
+			validator.AddShardDelegation(shardIndex, amount)
 			hostZone.TotalDelegations = hostZone.TotalDelegations.Add(amount)
 
 			return nil
@@ -117,9 +117,10 @@ func (m *SplitUndelegation) GetValidator() string {
 }
 
 type DelegateCallback struct {
-	HostZoneId       string             `protobuf:"bytes,1,opt,name=host_zone_id,json=hostZoneId,proto3" json:"host_zone_id,omitempty"`
-	DepositRecordId  uint64             `protobuf:"varint,2,opt,name=deposit_record_id,json=depositRecordId,proto3" json:"deposit_record_id,omitempty"`
-	SplitDelegations []*SplitDelegation `protobuf:"bytes,3,rep,name=split_delegations,json=splitDelegations,proto3" json:"split_delegations,omitempty"`
+	HostZoneId           string             `protobuf:"bytes,1,opt,name=host_zone_id,json=hostZoneId,proto3" json:"host_zone_id,omitempty"`
+	DepositRecordId      uint64             `protobuf:"varint,2,opt,name=deposit_record_id,json=depositRecordId,proto3" json:"deposit_record_id,omitempty"`
+	SplitDelegations     []*SplitDelegation `protobuf:"bytes,3,rep,name=split_delegations,json=splitDelegations,proto3" json:"split_delegations,omitempty"`
+	DelegationShardIndex uint32             `protobuf:"varint,4,opt,name=delegation_shard_index,json=delegationShardIndex,proto3" json:"delegation_shard_index,omitempty"`
 }
 
 func (m *DelegateCallback) Reset()         { *m = DelegateCallback{} }
@@ -176,6 +177,13 @@ func (m *DelegateCallback) GetSplitDelegations() []*SplitDelegation {
 	return nil
 }
 
+func (m *DelegateCallback) GetDelegationShardIndex() uint32 {
+	if m != nil {
+		return m.DelegationShardIndex
+	}
+	return 0
+}
+
 type ClaimCallback struct {
 	UserRedemptionRecordId string `protobuf:"bytes,1,opt,name=user_redemption_record_id,json=userRedemptionRecordId,proto3" json:"user_redemption_record_id,omitempty"`
 	ChainId                string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
@@ -292,6 +300,7 @@ type UndelegateCallback struct {
 	HostZoneId              string               `protobuf:"bytes,1,opt,name=host_zone_id,json=hostZoneId,proto3" json:"host_zone_id,omitempty"`
 	SplitUndelegations      []*SplitUndelegation `protobuf:"bytes,2,rep,name=split_undelegations,json=splitUndelegations,proto3" json:"split_undelegations,omitempty"`
 	EpochUnbondingRecordIds []uint64             `protobuf:"varint,3,rep,packed,name=epoch_unbonding_record_ids,json=epochUnbondingRecordIds,proto3" json:"epoch_unbonding_record_ids,omitempty"`
+	DelegationShardIndex    uint32               `protobuf:"varint,4,opt,name=delegation_shard_index,json=delegationShardIndex,proto3" json:"delegation_shard_index,omitempty"`
 }
 
 func (m *UndelegateCallback) Reset()         { *m = UndelegateCallback{} }
@@ -348,9 +357,17 @@ func (m *UndelegateCallback) GetEpochUnbondingRecordIds() []uint64 {
 	return nil
 }
 
+func (m *UndelegateCallback) GetDelegationShardIndex() uint32 {
+	if m != nil {
+		return m.DelegationShardIndex
+	}
+	return 0
+}
+
 type RedemptionCallback struct {
 	HostZoneId              string   `protobuf:"bytes,1,opt,name=host_zone_id,json=hostZoneId,proto3" json:"host_zone_id,omitempty"`
 	EpochUnbondingRecordIds []uint64 `protobuf:"varint,2,rep,packed,name=epoch_unbonding_record_ids,json=epochUnbondingRecordIds,proto3" json:"epoch_unbonding_record_ids,omitempty"`
+	DelegationShardIndex    uint32   `protobuf:"varint,3,opt,name=delegation_shard_index,json=delegationShardIndex,proto3" json:"delegation_shard_index,omitempty"`
 }
 
 func (m *RedemptionCallback) Reset()         { *m = RedemptionCallback{} }
@@ -400,6 +417,13 @@ func (m *RedemptionCallback) GetEpochUnbondingRecordIds() []uint64 {
 	return nil
 }
 
+func (m *RedemptionCallback) GetDelegationShardIndex() uint32 {
+	if m != nil {
+		return m.DelegationShardIndex
+	}
+	return 0
+}
+
 type Rebalancing struct {
 	SrcValidator string                                 `protobuf:"bytes,1,opt,name=src_validator,json=srcValidator,proto3" json:"src_validator,omitempty"`
 	DstValidator string                                 `protobuf:"bytes,2,opt,name=dst_validator,json=dstValidator,proto3" json:"dst_validator,omitempty"`
@@ -454,8 +478,9 @@ func (m *Rebalancing) GetDstValidator() string {
 }
 
 type RebalanceCallback struct {
-	HostZoneId   string         `protobuf:"bytes,1,opt,name=host_zone_id,json=hostZoneId,proto3" json:"host_zone_id,omitempty"`
-	Rebalancings []*Rebalancing `protobuf:"bytes,2,rep,name=rebalancings,proto3" json:"rebalancings,omitempty"`
+	HostZoneId           string         `protobuf:"bytes,1,opt,name=host_zone_id,json=hostZoneId,proto3" json:"host_zone_id,omitempty"`
+	Rebalancings         []*Rebalancing `protobuf:"bytes,2,rep,name=rebalancings,proto3" json:"rebalancings,omitempty"`
+	DelegationShardIndex uint32         `protobuf:"varint,3,opt,name=delegation_shard_index,json=delegationShardIndex,proto3" json:"delegation_shard_index,omitempty"`
 }
 
 func (m *RebalanceCallback) Reset()         { *m = RebalanceCallback{} }
@@ -505,6 +530,13 @@ func (m *RebalanceCallback) GetRebalancings() []*Rebalancing {
 	return nil
 }
 
+func (m *RebalanceCallback) GetDelegationShardIndex() uint32 {
+	if m != nil {
+		return m.DelegationShardIndex
+	}
+	return 0
+}
+
 type DetokenizeSharesCallback struct {
 	Deposit *types1.LSMTokenDeposit `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit,omitempty"`
 }
@@ -656,6 +688,8 @@ func (m *ValidatorSharesToTokensQueryCallback) GetLsmLiquidStake() *LSMLiquidSta
 type DelegatorSharesQueryCallback struct {
 	// Validator delegation at the time the query is submitted
 	InitialValidatorDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=initial_validator_delegation,json=initialValidatorDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"initial_validator_delegation"`
+	// Delegation shard whose delegation was queried
+	DelegationShardIndex uint32 `protobuf:"varint,2,opt,name=delegation_shard_index,json=delegationShardIndex,proto3" json:"delegation_shard_index,omitempty"`
 }
 
 func (m *DelegatorSharesQueryCallback) Reset()         { *m = DelegatorSharesQueryCallback{} }
@@ -691,6 +725,58 @@ func (m *DelegatorSharesQueryCallback) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DelegatorSharesQueryCallback proto.InternalMessageInfo
 
+func (m *DelegatorSharesQueryCallback) GetDelegationShardIndex() uint32 {
+	if m != nil {
+		return m.DelegationShardIndex
+	}
+	return 0
+}
+
+type CalibrateDelegationQueryCallback struct {
+	// Delegation shard whose delegation was queried
+	DelegationShardIndex uint32 `protobuf:"varint,1,opt,name=delegation_shard_index,json=delegationShardIndex,proto3" json:"delegation_shard_index,omitempty"`
+}
+
+func (m *CalibrateDelegationQueryCallback) Reset()         { *m = CalibrateDelegationQueryCallback{} }
+func (m *CalibrateDelegationQueryCallback) String() string { return proto.CompactTextString(m) }
+func (*CalibrateDelegationQueryCallback) ProtoMessage()    {}
+func (*CalibrateDelegationQueryCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41c99b09b96a5ac, []int{13}
+}
+func (m *CalibrateDelegationQueryCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CalibrateDelegationQueryCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CalibrateDelegationQueryCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CalibrateDelegationQueryCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CalibrateDelegationQueryCallback.Merge(m, src)
+}
+func (m *CalibrateDelegationQueryCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *CalibrateDelegationQueryCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_CalibrateDelegationQueryCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CalibrateDelegationQueryCallback proto.InternalMessageInfo
+
+func (m *CalibrateDelegationQueryCallback) GetDelegationShardIndex() uint32 {
+	if m != nil {
+		return m.DelegationShardIndex
+	}
+	return 0
+}
+
 type CommunityPoolBalanceQueryCallback struct {
 	IcaType ICAAccountType `protobuf:"varint,1,opt,name=ica_type,json=icaType,proto3,enum=stride.stakeibc.ICAAccountType" json:"ica_type,omitempty"`
 	Denom   string         `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -700,7 +786,7 @@ func (m *CommunityPoolBalanceQueryCallback) Reset()         { *m = CommunityPool
 func (m *CommunityPoolBalanceQueryCallback) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolBalanceQueryCallback) ProtoMessage()    {}
 func (*CommunityPoolBalanceQueryCallback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41c99b09b96a5ac, []int{13}
+	return fileDescriptor_f41c99b09b96a5ac, []int{14}
 }
 func (m *CommunityPoolBalanceQueryCallback) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -746,13 +832,14 @@ func (m *CommunityPoolBalanceQueryCallback) GetDenom() string {
 type TradeRouteCallback struct {
 	RewardDenom string `protobuf:"bytes,1,opt,name=reward_denom,json=rewardDenom,proto3" json:"reward_denom,omitempty"`
 	HostDenom   string `protobuf:"bytes,2,opt,name=host_denom,json=hostDenom,proto3" json:"host_denom,omitempty"`
+	LegIndex    uint32 `protobuf:"varint,3,opt,name=leg_index,json=legIndex,proto3" json:"leg_index,omitempty"`
 }
 
 func (m *TradeRouteCallback) Reset()         { *m = TradeRouteCallback{} }
 func (m *TradeRouteCallback) String() string { return proto.CompactTextString(m) }
 func (*TradeRouteCallback) ProtoMessage()    {}
 func (*TradeRouteCallback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41c99b09b96a5ac, []int{14}
+	return fileDescriptor_f41c99b09b96a5ac, []int{15}
 }
 func (m *TradeRouteCallback) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -795,6 +882,57 @@ func (m *TradeRouteCallback) GetHostDenom() string {
 	return ""
 }
 
+func (m *TradeRouteCallback) GetLegIndex() uint32 {
+	if m != nil {
+		return m.LegIndex
+	}
+	return 0
+}
+
+type SwapCallback struct {
+	TradeRecordId uint64 `protobuf:"varint,1,opt,name=trade_record_id,json=tradeRecordId,proto3" json:"trade_record_id,omitempty"`
+}
+
+func (m *SwapCallback) Reset()         { *m = SwapCallback{} }
+func (m *SwapCallback) String() string { return proto.CompactTextString(m) }
+func (*SwapCallback) ProtoMessage()    {}
+func (*SwapCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41c99b09b96a5ac, []int{16}
+}
+func (m *SwapCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapCallback.Merge(m, src)
+}
+func (m *SwapCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapCallback proto.InternalMessageInfo
+
+func (m *SwapCallback) GetTradeRecordId() uint64 {
+	if m != nil {
+		return m.TradeRecordId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*SplitDelegation)(nil), "stride.stakeibc.SplitDelegation")
 	proto.RegisterType((*SplitUndelegation)(nil), "stride.stakeibc.SplitUndelegation")
@@ -809,75 +947,83 @@ func init() {
 	proto.RegisterType((*LSMLiquidStake)(nil), "stride.stakeibc.LSMLiquidStake")
 	proto.RegisterType((*ValidatorSharesToTokensQueryCallback)(nil), "stride.stakeibc.ValidatorSharesToTokensQueryCallback")
 	proto.RegisterType((*DelegatorSharesQueryCallback)(nil), "stride.stakeibc.DelegatorSharesQueryCallback")
+	proto.RegisterType((*CalibrateDelegationQueryCallback)(nil), "stride.stakeibc.CalibrateDelegationQueryCallback")
 	proto.RegisterType((*CommunityPoolBalanceQueryCallback)(nil), "stride.stakeibc.CommunityPoolBalanceQueryCallback")
 	proto.RegisterType((*TradeRouteCallback)(nil), "stride.stakeibc.TradeRouteCallback")
+	proto.RegisterType((*SwapCallback)(nil), "stride.stakeibc.SwapCallback")
 }
 
 func init() { proto.RegisterFile("stride/stakeibc/callbacks.proto", fileDescriptor_f41c99b09b96a5ac) }
 
 var fileDescriptor_f41c99b09b96a5ac = []byte{
-	// 975 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x36, 0xad, 0x34, 0xb6, 0xc7, 0xff, 0x4c, 0xd0, 0xca, 0x82, 0x2a, 0xd9, 0x4c, 0xd1, 0x06,
-	0x05, 0x42, 0x22, 0x2e, 0xd0, 0xdf, 0x4b, 0x6c, 0x19, 0x45, 0x05, 0xc8, 0x45, 0x4b, 0xd9, 0x39,
-	0xe4, 0x50, 0x62, 0x49, 0x2e, 0xa4, 0x85, 0xc9, 0x5d, 0x85, 0xbb, 0x94, 0xeb, 0x3c, 0x41, 0x8f,
-	0xe9, 0xb1, 0x8f, 0xd0, 0x5e, 0xfa, 0x04, 0xbd, 0xfb, 0x98, 0x63, 0xd1, 0x43, 0x5a, 0xd8, 0x2f,
-	0x52, 0xec, 0x0f, 0x29, 0x4a, 0x4e, 0x82, 0x08, 0x39, 0x91, 0x9c, 0x9d, 0x9f, 0x6f, 0xe6, 0x9b,
-	0x19, 0x2e, 0xb4, 0xb9, 0xc8, 0x48, 0x8c, 0x3d, 0x2e, 0xd0, 0x19, 0x26, 0x61, 0xe4, 0x45, 0x28,
-	0x49, 0x42, 0x14, 0x9d, 0x71, 0x77, 0x94, 0x31, 0xc1, 0xec, 0x4d, 0xad, 0xe0, 0x16, 0x0a, 0x8d,
-	0x56, 0xc4, 0x78, 0xca, 0xb8, 0x17, 0x22, 0x8e, 0xbd, 0xf1, 0xc3, 0x10, 0x0b, 0xf4, 0xd0, 0x8b,
-	0x18, 0xa1, 0xda, 0xa0, 0x71, 0x77, 0xc0, 0x06, 0x4c, 0xbd, 0x7a, 0xf2, 0xcd, 0x48, 0x9b, 0x26,
-	0x4e, 0x86, 0x23, 0x96, 0xc5, 0xbc, 0x78, 0x9a, 0xd3, 0x1b, 0x28, 0x86, 0x8c, 0x8b, 0xe0, 0x19,
-	0xa3, 0xd8, 0x28, 0xec, 0xcd, 0x2a, 0x90, 0x08, 0x05, 0x28, 0x8a, 0x58, 0x4e, 0xc5, 0xeb, 0x7c,
-	0x8c, 0x51, 0x42, 0x62, 0x24, 0x58, 0xa6, 0x15, 0x9c, 0x73, 0xd8, 0xec, 0x8f, 0x12, 0x22, 0x8e,
-	0x70, 0x82, 0x07, 0x48, 0x10, 0x46, 0xed, 0x26, 0xac, 0x94, 0x5a, 0x75, 0x6b, 0xd7, 0xba, 0xbf,
-	0xe2, 0x4f, 0x04, 0xf6, 0xb7, 0x70, 0x1b, 0xa5, 0x32, 0x42, 0x7d, 0x51, 0x1e, 0x1d, 0xba, 0x97,
-	0x2f, 0xdb, 0x0b, 0xff, 0xbc, 0x6c, 0x7f, 0x3c, 0x20, 0x62, 0x98, 0x87, 0x6e, 0xc4, 0x52, 0xcf,
-	0x14, 0x43, 0x3f, 0x1e, 0xf0, 0xf8, 0xcc, 0x13, 0x17, 0x23, 0xcc, 0xdd, 0x2e, 0x15, 0xbe, 0xb1,
-	0x76, 0x7e, 0xb5, 0x60, 0x5b, 0x45, 0x3e, 0xa5, 0xf1, 0xdb, 0xc6, 0xfe, 0x09, 0xee, 0x50, 0x24,
-	0xc8, 0x18, 0x07, 0x82, 0x9d, 0x61, 0x1a, 0xbc, 0x13, 0x90, 0x6d, 0xed, 0xea, 0x44, 0x7a, 0x3a,
-	0xd0, 0x98, 0xfe, 0xb4, 0x60, 0xcb, 0x14, 0x02, 0x77, 0x0c, 0xe5, 0xf6, 0x2e, 0xac, 0x95, 0x85,
-	0x0f, 0x48, 0x6c, 0x50, 0x81, 0x94, 0x3d, 0x61, 0x14, 0x77, 0x63, 0xfb, 0x53, 0xd8, 0x8e, 0xf1,
-	0x88, 0x71, 0x22, 0x02, 0xcd, 0xa0, 0x54, 0x93, 0xa0, 0x6e, 0xf9, 0x9b, 0xe6, 0xc0, 0x57, 0xf2,
-	0x6e, 0x6c, 0x1f, 0xc3, 0x36, 0x97, 0x59, 0x07, 0x93, 0xa4, 0x79, 0xbd, 0xb6, 0x5b, 0xbb, 0xbf,
-	0xba, 0xbf, 0xeb, 0xce, 0x74, 0x95, 0x3b, 0xc3, 0x8c, 0xbf, 0xc5, 0xa7, 0x05, 0xdc, 0xf9, 0xc5,
-	0x82, 0xf5, 0x4e, 0x82, 0x48, 0x5a, 0xc2, 0xfd, 0x0a, 0x76, 0x72, 0x8e, 0xb3, 0x20, 0xc3, 0x31,
-	0x4e, 0x47, 0x52, 0xab, 0x02, 0x4a, 0x63, 0x7f, 0x5f, 0x2a, 0xf8, 0xe5, 0x79, 0x89, 0x6d, 0x07,
-	0x96, 0xa3, 0x21, 0x22, 0xb4, 0x80, 0xbf, 0xe2, 0x2f, 0xa9, 0xef, 0x6e, 0x6c, 0xef, 0xc1, 0x1a,
-	0x1e, 0xb1, 0x68, 0x18, 0xd0, 0x3c, 0x0d, 0x71, 0x56, 0xaf, 0xa9, 0xec, 0x56, 0x95, 0xec, 0x7b,
-	0x25, 0x72, 0x7e, 0xb7, 0x60, 0xcb, 0xc7, 0x84, 0x8e, 0x31, 0x17, 0x25, 0x1a, 0x0e, 0x9b, 0x99,
-	0x91, 0x15, 0x6c, 0x49, 0x0c, 0xab, 0xfb, 0x3b, 0xae, 0x26, 0xc5, 0x95, 0x13, 0xe3, 0x9a, 0x89,
-	0x71, 0x3b, 0x8c, 0xd0, 0x43, 0x4f, 0x12, 0xf9, 0xc7, 0xbf, 0xed, 0x4f, 0xde, 0x82, 0x48, 0x69,
-	0xe0, 0x6f, 0x14, 0x21, 0x34, 0x8d, 0x37, 0x18, 0xab, 0xcd, 0x32, 0xe6, 0x5c, 0x5a, 0x60, 0x97,
-	0x7d, 0x37, 0x0f, 0xd5, 0x7d, 0xb8, 0xa3, 0xe9, 0xcb, 0x69, 0x95, 0xc0, 0x45, 0x45, 0xa0, 0xf3,
-	0x6a, 0x02, 0xab, 0x0d, 0xee, 0xdb, 0x7c, 0x56, 0xc4, 0xed, 0x6f, 0xa0, 0xa1, 0x8b, 0x9b, 0xd3,
-	0x90, 0xd1, 0x98, 0xd0, 0xc1, 0x84, 0x32, 0xdd, 0x1c, 0xb7, 0xfc, 0x0f, 0x94, 0xc6, 0x69, 0xa1,
-	0x50, 0x70, 0xc6, 0x1d, 0x0e, 0xf6, 0x84, 0xca, 0x39, 0x32, 0x79, 0x73, 0xd0, 0xc5, 0x37, 0x07,
-	0xfd, 0xcd, 0x82, 0x55, 0x1f, 0x87, 0x28, 0x41, 0x34, 0x22, 0x74, 0x60, 0xdf, 0x83, 0x75, 0x9e,
-	0x45, 0xc1, 0xec, 0xe8, 0xae, 0xf1, 0x2c, 0x7a, 0x5c, 0x4e, 0xef, 0x3d, 0x58, 0x8f, 0xb9, 0xa8,
-	0x28, 0xe9, 0x1e, 0x5b, 0x8b, 0xb9, 0x98, 0x28, 0x3d, 0x82, 0x1a, 0x4a, 0x85, 0xa6, 0x6c, 0xee,
-	0x91, 0x96, 0xa6, 0xce, 0x39, 0x6c, 0x17, 0xd0, 0xe6, 0x61, 0xf6, 0x11, 0xac, 0x65, 0x93, 0x8c,
-	0x0a, 0x4a, 0x9b, 0x37, 0x28, 0xad, 0xa4, 0xed, 0x4f, 0x59, 0x38, 0xa7, 0x50, 0x3f, 0xc2, 0x6a,
-	0x31, 0x91, 0x67, 0xb8, 0x3f, 0x44, 0x19, 0xe6, 0x95, 0xa9, 0x5c, 0x32, 0x9b, 0xc0, 0xf4, 0x7f,
-	0xbb, 0x70, 0x5c, 0xec, 0xfc, 0x5e, 0xff, 0x58, 0xad, 0xa2, 0x23, 0xb3, 0x30, 0x0a, 0x7d, 0xe7,
-	0x2f, 0x0b, 0x36, 0x7a, 0xfd, 0xe3, 0x1e, 0x79, 0x9a, 0x93, 0xb8, 0x2f, 0x61, 0xbc, 0x83, 0x37,
-	0xfb, 0x73, 0x58, 0x29, 0x0b, 0xa1, 0x08, 0x90, 0xa3, 0x38, 0x9b, 0xe3, 0x77, 0xa6, 0x2c, 0xfe,
-	0x72, 0x51, 0x20, 0xfb, 0xcb, 0xea, 0x62, 0xae, 0x29, 0xbb, 0xc6, 0x0d, 0xbb, 0x92, 0xc6, 0xca,
-	0xd2, 0x76, 0x9e, 0xc2, 0x47, 0xa5, 0x5c, 0x57, 0xe5, 0x84, 0x29, 0x6c, 0xfc, 0xc7, 0x1c, 0x67,
-	0x17, 0x65, 0x89, 0xba, 0xb0, 0x95, 0xf0, 0x34, 0x48, 0x54, 0x9e, 0x81, 0xf2, 0x39, 0x9b, 0x5d,
-	0x19, 0x68, 0xba, 0x1e, 0xfe, 0x46, 0xc2, 0xd3, 0xca, 0xb7, 0xf3, 0xdc, 0x82, 0xa6, 0xd9, 0x92,
-	0x45, 0xcc, 0xe9, 0x58, 0x23, 0x68, 0x12, 0x4a, 0x04, 0x41, 0xc9, 0xa4, 0x1d, 0x2b, 0x1b, 0x59,
-	0xb7, 0xc7, 0xdc, 0xed, 0xd7, 0x30, 0x3e, 0xcb, 0x74, 0x27, 0x9b, 0xda, 0xc9, 0x61, 0xaf, 0xc3,
-	0xd2, 0x34, 0xa7, 0x44, 0x5c, 0xfc, 0xc0, 0x58, 0x72, 0xa8, 0x1b, 0x74, 0x1a, 0xd6, 0xd7, 0xb0,
-	0x2c, 0x7f, 0xe1, 0xd2, 0xa3, 0x82, 0xb0, 0xf1, 0x8a, 0xd4, 0xbb, 0x9d, 0x83, 0x03, 0xfd, 0x8b,
-	0x3f, 0xb9, 0x18, 0x61, 0x7f, 0x89, 0x44, 0x48, 0xbe, 0xd8, 0x77, 0xe1, 0xbd, 0x18, 0x53, 0x96,
-	0x9a, 0xa9, 0xd2, 0x1f, 0xce, 0x63, 0xb0, 0x4f, 0x32, 0x14, 0x63, 0x9f, 0xe5, 0x95, 0x3d, 0xb7,
-	0x27, 0x7b, 0xfd, 0x1c, 0x65, 0x71, 0xa0, 0x4d, 0xf4, 0x34, 0xac, 0x6a, 0xd9, 0x91, 0x14, 0xd9,
-	0x1f, 0x82, 0x1a, 0x8e, 0xa0, 0xea, 0x53, 0x75, 0x8e, 0x3a, 0x3e, 0xec, 0x5d, 0x5e, 0xb5, 0xac,
-	0x17, 0x57, 0x2d, 0xeb, 0xbf, 0xab, 0x96, 0xf5, 0xfc, 0xba, 0xb5, 0xf0, 0xe2, 0xba, 0xb5, 0xf0,
-	0xf7, 0x75, 0x6b, 0xe1, 0xc9, 0x7e, 0xa5, 0x58, 0x7d, 0x85, 0xfd, 0x41, 0x0f, 0x85, 0xdc, 0x33,
-	0x17, 0x91, 0xf1, 0xfe, 0x17, 0xde, 0xcf, 0x93, 0xeb, 0x88, 0x2a, 0x5e, 0x78, 0x5b, 0xdd, 0x45,
-	0x3e, 0xfb, 0x3f, 0x00, 0x00, 0xff, 0xff, 0xa2, 0xae, 0x1b, 0xb2, 0x78, 0x09, 0x00, 0x00,
+	// 1071 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x5b, 0x4f, 0x24, 0x45,
+	0x14, 0xa6, 0x19, 0x5c, 0xe0, 0x30, 0xdc, 0x7a, 0xc9, 0x3a, 0x20, 0x0e, 0xc3, 0x68, 0x56, 0x62,
+	0xb2, 0xdd, 0x59, 0x34, 0xeb, 0xed, 0x65, 0x61, 0x88, 0x71, 0x12, 0x30, 0xda, 0x03, 0xc6, 0xec,
+	0x83, 0x9d, 0xea, 0xee, 0xca, 0x50, 0xa1, 0xbb, 0x6a, 0xb6, 0xab, 0x1a, 0x96, 0xfd, 0x05, 0x3e,
+	0xea, 0xa3, 0x3f, 0x61, 0x4d, 0xfc, 0x09, 0xbe, 0x9a, 0x7d, 0xdc, 0x47, 0xe3, 0xc3, 0x6a, 0x20,
+	0xfe, 0x0d, 0x63, 0xea, 0xd2, 0x97, 0x19, 0x16, 0xb2, 0xb0, 0x4f, 0x33, 0x7d, 0xea, 0xdc, 0xbf,
+	0xef, 0x9c, 0x2a, 0x58, 0xe3, 0x22, 0x25, 0x11, 0x76, 0xb9, 0x40, 0x47, 0x98, 0x04, 0xa1, 0x1b,
+	0xa2, 0x38, 0x0e, 0x50, 0x78, 0xc4, 0x9d, 0x41, 0xca, 0x04, 0xb3, 0xe7, 0xb5, 0x82, 0x93, 0x2b,
+	0xac, 0x34, 0x43, 0xc6, 0x13, 0xc6, 0xdd, 0x00, 0x71, 0xec, 0x1e, 0xdf, 0x0f, 0xb0, 0x40, 0xf7,
+	0xdd, 0x90, 0x11, 0xaa, 0x0d, 0x56, 0x96, 0xfa, 0xac, 0xcf, 0xd4, 0x5f, 0x57, 0xfe, 0x33, 0xd2,
+	0x55, 0x13, 0x27, 0xc5, 0x21, 0x4b, 0x23, 0x9e, 0xff, 0x9a, 0xd3, 0x0b, 0x59, 0x1c, 0x32, 0x2e,
+	0xfc, 0xa7, 0x8c, 0x62, 0xa3, 0xb0, 0x3e, 0xaa, 0x40, 0x42, 0xe4, 0xa3, 0x30, 0x64, 0x19, 0x15,
+	0x97, 0xf9, 0x38, 0x46, 0x31, 0x89, 0x90, 0x60, 0xa9, 0x56, 0x68, 0x9f, 0xc0, 0x7c, 0x6f, 0x10,
+	0x13, 0xb1, 0x83, 0x63, 0xdc, 0x47, 0x82, 0x30, 0x6a, 0xaf, 0xc2, 0x74, 0xa1, 0xd5, 0xb0, 0x5a,
+	0xd6, 0xc6, 0xb4, 0x57, 0x0a, 0xec, 0x2f, 0xe1, 0x16, 0x4a, 0x64, 0x84, 0xc6, 0xb8, 0x3c, 0xda,
+	0x76, 0x9e, 0xbf, 0x5c, 0x1b, 0xfb, 0xeb, 0xe5, 0xda, 0xdd, 0x3e, 0x11, 0x87, 0x59, 0xe0, 0x84,
+	0x2c, 0x71, 0x4d, 0x33, 0xf4, 0xcf, 0x3d, 0x1e, 0x1d, 0xb9, 0xe2, 0x74, 0x80, 0xb9, 0xd3, 0xa5,
+	0xc2, 0x33, 0xd6, 0xed, 0x9f, 0x2d, 0x58, 0x54, 0x91, 0x0f, 0x68, 0xf4, 0xba, 0xb1, 0x7f, 0x80,
+	0xdb, 0x14, 0x09, 0x72, 0x8c, 0x7d, 0xc1, 0x8e, 0x30, 0xf5, 0xdf, 0x28, 0x91, 0x45, 0xed, 0x6a,
+	0x5f, 0x7a, 0xda, 0xd2, 0x39, 0xfd, 0x6b, 0xc1, 0x82, 0x69, 0x04, 0xee, 0x18, 0xc8, 0xed, 0x16,
+	0xd4, 0x8b, 0xc6, 0xfb, 0x24, 0x32, 0x59, 0x81, 0x94, 0x3d, 0x62, 0x14, 0x77, 0x23, 0xfb, 0x43,
+	0x58, 0x8c, 0xf0, 0x80, 0x71, 0x22, 0x7c, 0x8d, 0xa0, 0x54, 0x93, 0x49, 0x4d, 0x78, 0xf3, 0xe6,
+	0xc0, 0x53, 0xf2, 0x6e, 0x64, 0xef, 0xc1, 0x22, 0x97, 0x55, 0xfb, 0x65, 0xd1, 0xbc, 0x51, 0x6b,
+	0xd5, 0x36, 0x66, 0x36, 0x5b, 0xce, 0x08, 0xab, 0x9c, 0x11, 0x64, 0xbc, 0x05, 0x3e, 0x2c, 0xe0,
+	0xf6, 0xc7, 0x70, 0xa7, 0x74, 0xe4, 0xf3, 0x43, 0x24, 0x83, 0xd3, 0x08, 0x3f, 0x69, 0x4c, 0xb4,
+	0xac, 0x8d, 0x59, 0x6f, 0xa9, 0x3c, 0xed, 0xc9, 0xc3, 0xae, 0x3c, 0x6b, 0xff, 0x68, 0xc1, 0x6c,
+	0x27, 0x46, 0x24, 0x29, 0x8a, 0xfc, 0x0c, 0x96, 0x33, 0x8e, 0x53, 0x3f, 0xc5, 0x11, 0x4e, 0x06,
+	0xca, 0x59, 0x59, 0x8a, 0xae, 0xf8, 0x8e, 0x54, 0xf0, 0x8a, 0xf3, 0xa2, 0xa2, 0x65, 0x98, 0x0a,
+	0x0f, 0x11, 0xa1, 0x79, 0xd1, 0xd3, 0xde, 0xa4, 0xfa, 0xee, 0x46, 0xf6, 0x3a, 0xd4, 0xf1, 0x80,
+	0x85, 0x87, 0x3e, 0xcd, 0x92, 0x00, 0xa7, 0x8d, 0x9a, 0xea, 0xc9, 0x8c, 0x92, 0x7d, 0xad, 0x44,
+	0xed, 0x67, 0x16, 0x2c, 0x78, 0x98, 0xd0, 0x63, 0xcc, 0x45, 0x91, 0x0d, 0x87, 0xf9, 0xd4, 0xc8,
+	0x72, 0x8c, 0x65, 0x0e, 0x33, 0x9b, 0xcb, 0x8e, 0x86, 0xd2, 0x91, 0x73, 0xe6, 0x98, 0x39, 0x73,
+	0x3a, 0x8c, 0xd0, 0x6d, 0x57, 0xc2, 0xff, 0xeb, 0xdf, 0x6b, 0x1f, 0xbc, 0x06, 0xfc, 0xd2, 0xc0,
+	0x9b, 0xcb, 0x43, 0x68, 0xf0, 0x2f, 0xe0, 0x5c, 0x1b, 0xc5, 0xb9, 0xfd, 0x9f, 0x05, 0x76, 0xc1,
+	0xd6, 0xeb, 0x10, 0xa4, 0x07, 0xb7, 0x35, 0xe8, 0x19, 0xad, 0xc2, 0x3e, 0xae, 0x60, 0x6f, 0xbf,
+	0x1a, 0xf6, 0xea, 0x58, 0x78, 0x36, 0x1f, 0x15, 0x71, 0xfb, 0x0b, 0x58, 0xd1, 0xcd, 0xcd, 0x68,
+	0xc0, 0x68, 0x44, 0x68, 0xbf, 0x84, 0x4c, 0x53, 0x6a, 0xc2, 0x7b, 0x5b, 0x69, 0x1c, 0xe4, 0x0a,
+	0x39, 0x66, 0x37, 0xe5, 0xcd, 0x33, 0x0b, 0xec, 0x92, 0x01, 0xd7, 0x68, 0xc0, 0xd5, 0xb9, 0x8e,
+	0xdf, 0x34, 0xd7, 0xda, 0x15, 0xb9, 0xfe, 0x62, 0xc1, 0x8c, 0x87, 0x03, 0x14, 0x23, 0x1a, 0x12,
+	0xda, 0xb7, 0xdf, 0x83, 0x59, 0x9e, 0x86, 0xfe, 0xe8, 0x76, 0xa9, 0xf3, 0x34, 0xfc, 0x2e, 0x97,
+	0x49, 0xa5, 0x88, 0x8b, 0x8a, 0x92, 0x26, 0x74, 0x3d, 0xe2, 0xa2, 0x54, 0x7a, 0x08, 0x35, 0x94,
+	0x88, 0x46, 0xed, 0x46, 0x5b, 0x47, 0x9a, 0xb6, 0x7f, 0xb3, 0x60, 0x31, 0xcf, 0xed, 0x3a, 0x3c,
+	0x7a, 0x08, 0xf5, 0xb4, 0x2c, 0x29, 0x27, 0xd0, 0xea, 0x05, 0x02, 0x55, 0xea, 0xf6, 0x86, 0x2c,
+	0x6e, 0xd8, 0xcb, 0x03, 0x68, 0xec, 0x60, 0xb5, 0x72, 0xc9, 0x53, 0x2c, 0xe5, 0x98, 0x57, 0x36,
+	0xc7, 0xa4, 0xd9, 0x71, 0x66, 0x46, 0xd7, 0xf2, 0x74, 0xf2, 0xdb, 0x6c, 0xb7, 0xb7, 0xa7, 0x96,
+	0xec, 0x8e, 0x59, 0x85, 0xb9, 0x7e, 0xfb, 0x77, 0x0b, 0xe6, 0x76, 0x7b, 0x7b, 0xbb, 0xe4, 0x71,
+	0x46, 0xa2, 0x9e, 0x4c, 0xfe, 0x0d, 0xbc, 0xd9, 0x0f, 0x60, 0xba, 0x68, 0x5f, 0x63, 0xdc, 0xac,
+	0x8b, 0xd1, 0xce, 0x7c, 0x65, 0x9a, 0xe9, 0x4d, 0xe5, 0x6d, 0xb5, 0x3f, 0xad, 0x5e, 0x39, 0x35,
+	0x65, 0xb7, 0x72, 0xc1, 0xae, 0x40, 0xbf, 0x72, 0x1d, 0xb5, 0x1f, 0xc3, 0xfb, 0x85, 0x5c, 0x77,
+	0x65, 0x9f, 0xa9, 0xdc, 0xf8, 0xb7, 0x19, 0x4e, 0x4f, 0x8b, 0x16, 0x75, 0x61, 0x21, 0xe6, 0x89,
+	0x1f, 0xab, 0x3a, 0x7d, 0xe5, 0x73, 0xb4, 0xba, 0x22, 0xd0, 0x70, 0x3f, 0xbc, 0xb9, 0x98, 0x27,
+	0x95, 0xef, 0xf6, 0x1f, 0x16, 0xac, 0x9a, 0xfd, 0x9f, 0xc7, 0x1c, 0x8e, 0x35, 0x80, 0x55, 0x42,
+	0x89, 0x20, 0x28, 0x2e, 0x59, 0x5c, 0xb9, 0x6b, 0x1a, 0xd6, 0x8d, 0x58, 0xbb, 0x62, 0x7c, 0x16,
+	0xe5, 0x56, 0x9e, 0x0b, 0x97, 0x53, 0x6a, 0xfc, 0x0a, 0x4a, 0x7d, 0x0f, 0xad, 0x0e, 0x8a, 0x49,
+	0x90, 0x22, 0x81, 0x4b, 0x67, 0xc3, 0xb5, 0x5c, 0xee, 0xd9, 0xba, 0xc2, 0x73, 0x06, 0xeb, 0x1d,
+	0x96, 0x24, 0x19, 0x25, 0xe2, 0xf4, 0x1b, 0xc6, 0xe2, 0x6d, 0x3d, 0x66, 0xc3, 0xae, 0x3f, 0x87,
+	0x29, 0xf9, 0x58, 0x92, 0x15, 0x2a, 0x67, 0x73, 0xaf, 0x80, 0xa2, 0xdb, 0xd9, 0xda, 0xd2, 0x8f,
+	0xa9, 0xfd, 0xd3, 0x01, 0xf6, 0x26, 0x49, 0x88, 0xe4, 0x1f, 0x7b, 0x09, 0xde, 0x8a, 0x30, 0x65,
+	0x89, 0x59, 0x0e, 0xfa, 0xa3, 0xcd, 0xc1, 0xde, 0x4f, 0x51, 0x84, 0x3d, 0x96, 0x55, 0xee, 0x86,
+	0x75, 0x39, 0xb1, 0x27, 0x32, 0x71, 0x6d, 0xa2, 0x67, 0x7a, 0x46, 0xcb, 0x76, 0xa4, 0xc8, 0x7e,
+	0x17, 0xd4, 0x88, 0xfb, 0x55, 0x9f, 0x8a, 0xc9, 0xfa, 0xf8, 0x1d, 0x98, 0x8e, 0x71, 0x7f, 0x68,
+	0x48, 0xa7, 0x62, 0xdc, 0xd7, 0xb5, 0x3e, 0x80, 0x7a, 0xef, 0x04, 0x0d, 0x8a, 0x70, 0x77, 0x61,
+	0x5e, 0xc8, 0x24, 0x46, 0x2e, 0xef, 0x09, 0x6f, 0x56, 0x89, 0xf3, 0x9d, 0xba, 0xbd, 0xfb, 0xfc,
+	0xac, 0x69, 0xbd, 0x38, 0x6b, 0x5a, 0xff, 0x9c, 0x35, 0xad, 0x9f, 0xce, 0x9b, 0x63, 0x2f, 0xce,
+	0x9b, 0x63, 0x7f, 0x9e, 0x37, 0xc7, 0x1e, 0x6d, 0x56, 0x18, 0xd1, 0x53, 0x0d, 0xb9, 0xb7, 0x8b,
+	0x02, 0xee, 0x9a, 0x77, 0xe4, 0xf1, 0xe6, 0x27, 0xee, 0x93, 0xf2, 0x35, 0xa9, 0x18, 0x12, 0xdc,
+	0x52, 0x4f, 0xc9, 0x8f, 0xfe, 0x1f, 0x00, 0x94, 0xe8, 0x6a, 0x61, 0x37, 0x0b, 0x00, 0x00,
 }
 
 func (m *SplitDelegation) Marshal() (dAtA []byte, err error) {
@@ -980,6 +1126,11 @@ func (m *DelegateCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DelegationShardIndex != 0 {
+		i = encodeVarintCallbacks(dAtA, i, uint64(m.DelegationShardIndex))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.SplitDelegations) > 0 {
 		for iNdEx := len(m.SplitDelegations) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1111,6 +1262,11 @@ func (m *UndelegateCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DelegationShardIndex != 0 {
+		i = encodeVarintCallbacks(dAtA, i, uint64(m.DelegationShardIndex))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.EpochUnbondingRecordIds) > 0 {
 		dAtA3 := make([]byte, len(m.EpochUnbondingRecordIds)*10)
 		var j2 int
@@ -1173,6 +1329,11 @@ func (m *RedemptionCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DelegationShardIndex != 0 {
+		i = encodeVarintCallbacks(dAtA, i, uint64(m.DelegationShardIndex))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.EpochUnbondingRecordIds) > 0 {
 		dAtA5 := make([]byte, len(m.EpochUnbondingRecordIds)*10)
 		var j4 int
@@ -1268,6 +1429,11 @@ func (m *RebalanceCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DelegationShardIndex != 0 {
+		i = encodeVarintCallbacks(dAtA, i, uint64(m.DelegationShardIndex))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Rebalancings) > 0 {
 		for iNdEx := len(m.Rebalancings) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1441,6 +1607,11 @@ func (m *DelegatorSharesQueryCallback) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.DelegationShardIndex != 0 {
+		i = encodeVarintCallbacks(dAtA, i, uint64(m.DelegationShardIndex))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size := m.InitialValidatorDelegation.Size()
 		i -= size
@@ -1454,6 +1625,34 @@ func (m *DelegatorSharesQueryCallback) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *CalibrateDelegationQueryCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CalibrateDelegationQueryCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CalibrateDelegationQueryCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DelegationShardIndex != 0 {
+		i = encodeVarintCallbacks(dAtA, i, uint64(m.DelegationShardIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CommunityPoolBalanceQueryCallback) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1509,6 +1708,11 @@ func (m *TradeRouteCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LegIndex != 0 {
+		i = encodeVarintCallbacks(dAtA, i, uint64(m.LegIndex))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.HostDenom) > 0 {
 		i -= len(m.HostDenom)
 		copy(dAtA[i:], m.HostDenom)
@@ -1526,6 +1730,34 @@ func (m *TradeRouteCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SwapCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TradeRecordId != 0 {
+		i = encodeVarintCallbacks(dAtA, i, uint64(m.TradeRecordId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCallbacks(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCallbacks(v)
 	base := offset
@@ -1586,6 +1818,9 @@ func (m *DelegateCallback) Size() (n int) {
 			n += 1 + l + sovCallbacks(uint64(l))
 		}
 	}
+	if m.DelegationShardIndex != 0 {
+		n += 1 + sovCallbacks(uint64(m.DelegationShardIndex))
+	}
 	return n
 }
 
@@ -1647,6 +1882,9 @@ func (m *UndelegateCallback) Size() (n int) {
 		}
 		n += 1 + sovCallbacks(uint64(l)) + l
 	}
+	if m.DelegationShardIndex != 0 {
+		n += 1 + sovCallbacks(uint64(m.DelegationShardIndex))
+	}
 	return n
 }
 
@@ -1667,6 +1905,9 @@ func (m *RedemptionCallback) Size() (n int) {
 		}
 		n += 1 + sovCallbacks(uint64(l)) + l
 	}
+	if m.DelegationShardIndex != 0 {
+		n += 1 + sovCallbacks(uint64(m.DelegationShardIndex))
+	}
 	return n
 }
 
@@ -1705,6 +1946,9 @@ func (m *RebalanceCallback) Size() (n int) {
 			n += 1 + l + sovCallbacks(uint64(l))
 		}
 	}
+	if m.DelegationShardIndex != 0 {
+		n += 1 + sovCallbacks(uint64(m.DelegationShardIndex))
+	}
 	return n
 }
 
@@ -1763,6 +2007,21 @@ func (m *DelegatorSharesQueryCallback) Size() (n int) {
 	_ = l
 	l = m.InitialValidatorDelegation.Size()
 	n += 1 + l + sovCallbacks(uint64(l))
+	if m.DelegationShardIndex != 0 {
+		n += 1 + sovCallbacks(uint64(m.DelegationShardIndex))
+	}
+	return n
+}
+
+func (m *CalibrateDelegationQueryCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DelegationShardIndex != 0 {
+		n += 1 + sovCallbacks(uint64(m.DelegationShardIndex))
+	}
 	return n
 }
 
@@ -1796,6 +2055,21 @@ func (m *TradeRouteCallback) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCallbacks(uint64(l))
 	}
+	if m.LegIndex != 0 {
+		n += 1 + sovCallbacks(uint64(m.LegIndex))
+	}
+	return n
+}
+
+func (m *SwapCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradeRecordId != 0 {
+		n += 1 + sovCallbacks(uint64(m.TradeRecordId))
+	}
 	return n
 }
 
@@ -2151,6 +2425,25 @@ func (m *DelegateCallback) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationShardIndex", wireType)
+			}
+			m.DelegationShardIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationShardIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCallbacks(dAtA[iNdEx:])
@@ -2591,6 +2884,25 @@ func (m *UndelegateCallback) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field EpochUnbondingRecordIds", wireType)
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationShardIndex", wireType)
+			}
+			m.DelegationShardIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationShardIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCallbacks(dAtA[iNdEx:])
@@ -2749,6 +3061,25 @@ func (m *RedemptionCallback) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field EpochUnbondingRecordIds", wireType)
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationShardIndex", wireType)
+			}
+			m.DelegationShardIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationShardIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCallbacks(dAtA[iNdEx:])
@@ -3013,6 +3344,25 @@ func (m *RebalanceCallback) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationShardIndex", wireType)
+			}
+			m.DelegationShardIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationShardIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCallbacks(dAtA[iNdEx:])
@@ -3427,6 +3777,94 @@ func (m *DelegatorSharesQueryCallback) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationShardIndex", wireType)
+			}
+			m.DelegationShardIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationShardIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCallbacks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CalibrateDelegationQueryCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCallbacks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CalibrateDelegationQueryCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CalibrateDelegationQueryCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationShardIndex", wireType)
+			}
+			m.DelegationShardIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationShardIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCallbacks(dAtA[iNdEx:])
@@ -3642,6 +4080,94 @@ func (m *TradeRouteCallback) Unmarshal(dAtA []byte) error {
 			}
 			m.HostDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegIndex", wireType)
+			}
+			m.LegIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LegIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCallbacks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCallbacks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeRecordId", wireType)
+			}
+			m.TradeRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCallbacks(dAtA[iNdEx:])
@@ -70,4 +70,11 @@ var (
 	ErrInvalidDelegationsInProgress        = errorsmod.Register(ModuleName, 1563, "invalid delegation changes in progress")
 	ErrInvalidUndelegationsInProgress      = errorsmod.Register(ModuleName, 1564, "invalid undelegation changes in progress")
 	ErrRedemptionsDisabled                 = errorsmod.Register(ModuleName, 1565, "redemptions disabled")
+	ErrRedemptionRateVelocityExceeded      = errorsmod.Register(ModuleName, 1566, "redemption rate velocity exceeded")
+	ErrInvalidSwapConfig                   = errorsmod.Register(ModuleName, 1567, "invalid swap config")
+	ErrTradeRecordNotFound                 = errorsmod.Register(ModuleName, 1568, "trade record not found")
+	ErrOraclePriceUnavailable              = errorsmod.Register(ModuleName, 1569, "oracle price unavailable")
+	ErrDelegationShardNotFound             = errorsmod.Register(ModuleName, 1570, "delegation shard not found")
+	ErrInvalidEpochCadence                 = errorsmod.Register(ModuleName, 1571, "invalid epoch cadence")
+	ErrSwapPending                         = errorsmod.Register(ModuleName, 1572, "swap pending")
 )
@@ -21,6 +21,7 @@ const (
 	EventTypeValidatorSlash                    = "validator_slash"
 	EventTypeUndelegation                      = "undelegation"
 	EventTypeRedemptionSweep                   = "redemption_sweep"
+	EventTypeRedemptionRateVelocityExceeded    = "redemption_rate_velocity_exceeded"
 
 	AttributeKeyHostZone         = "host_zone"
 	AttributeKeyConnectionId     = "connection_id"
@@ -30,7 +31,8 @@ const (
 	AttributeKeyRedeemAmount     = "redeem_amount"
 	AttributeKeySourceAddress    = "source"
 
-	AttributeKeyRedemptionRate = "redemption_rate"
+	AttributeKeyRedemptionRate     = "redemption_rate"
+	AttributeKeyLastRedemptionRate = "last_redemption_rate"
 
 	AttributeKeyLiquidStaker       = "liquid_staker"
 	AttributeKeyRedeemer           = "redeemer"
@@ -1,6 +1,7 @@
 package types
 
 import (
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -41,12 +42,17 @@ type BankKeeper interface {
 // StakeIBCHooks event hooks for stakeibc
 type StakeIBCHooks interface {
 	AfterLiquidStake(ctx sdk.Context, addr sdk.AccAddress) // Must be called after liquid stake is completed
+	AfterRewardCollectorAuctioned(ctx sdk.Context)         // Must be called after the reward collector balance is sent to the auction module
 }
 
 type ICAOracleKeeper interface {
 	QueueMetricUpdate(ctx sdk.Context, key, value, metricType, attributes string)
 }
 
+type ICQOracleKeeper interface {
+	GetTokenPriceForQuoteDenom(ctx sdk.Context, baseDenom string, quoteDenom string) (price sdkmath.LegacyDec, err error)
+}
+
 type RatelimitKeeper interface {
 	AddDenomToBlacklist(ctx sdk.Context, denom string)
 	RemoveDenomFromBlacklist(ctx sdk.Context, denom string)
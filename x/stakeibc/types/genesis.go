@@ -46,5 +46,17 @@ func (gs GenesisState) Validate() error {
 		epochTrackerIndexMap[index] = struct{}{}
 	}
 
+	// Check for duplicated id in tradeRecords, and that each id is below the count
+	tradeRecordIdMap := make(map[uint64]struct{})
+	for _, elem := range gs.TradeRecords {
+		if _, ok := tradeRecordIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for tradeRecord: %d", elem.Id)
+		}
+		if elem.Id >= gs.TradeRecordCount {
+			return fmt.Errorf("tradeRecord id %d should be lower than tradeRecordCount %d", elem.Id, gs.TradeRecordCount)
+		}
+		tradeRecordIdMap[elem.Id] = struct{}{}
+	}
+
 	return gs.Params.Validate()
 }
@@ -30,6 +30,8 @@ type GenesisState struct {
 	HostZoneList     []HostZone     `protobuf:"bytes,5,rep,name=host_zone_list,json=hostZoneList,proto3" json:"host_zone_list"`
 	EpochTrackerList []EpochTracker `protobuf:"bytes,10,rep,name=epoch_tracker_list,json=epochTrackerList,proto3" json:"epoch_tracker_list"`
 	TradeRoutes      []TradeRoute   `protobuf:"bytes,12,rep,name=trade_routes,json=tradeRoutes,proto3" json:"trade_routes"`
+	TradeRecords     []TradeRecord  `protobuf:"bytes,13,rep,name=trade_records,json=tradeRecords,proto3" json:"trade_records"`
+	TradeRecordCount uint64         `protobuf:"varint,14,opt,name=trade_record_count,json=tradeRecordCount,proto3" json:"trade_record_count,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -100,6 +102,20 @@ func (m *GenesisState) GetTradeRoutes() []TradeRoute {
 	return nil
 }
 
+func (m *GenesisState) GetTradeRecords() []TradeRecord {
+	if m != nil {
+		return m.TradeRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTradeRecordCount() uint64 {
+	if m != nil {
+		return m.TradeRecordCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "stride.stakeibc.GenesisState")
 }
@@ -107,31 +123,34 @@ func init() {
 func init() { proto.RegisterFile("stride/stakeibc/genesis.proto", fileDescriptor_dea81129ed6fb77a) }
 
 var fileDescriptor_dea81129ed6fb77a = []byte{
-	// 383 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xcf, 0x6f, 0xa2, 0x40,
-	0x14, 0xc7, 0x41, 0x47, 0xc4, 0x81, 0xec, 0x12, 0xb2, 0x89, 0xac, 0xbb, 0xa2, 0xbb, 0x7b, 0xf1,
-	0xb2, 0x90, 0xb0, 0xd9, 0xf4, 0x6e, 0x6a, 0xda, 0x12, 0x0f, 0xad, 0x7a, 0xf2, 0x42, 0xf8, 0x31,
-	0x01, 0x62, 0x75, 0xc8, 0xcc, 0xd8, 0xb4, 0xfd, 0x2b, 0xfa, 0x67, 0x79, 0xf4, 0xd8, 0x43, 0xd3,
-	0x34, 0xfa, 0x8f, 0x34, 0xc0, 0xd4, 0x58, 0xbc, 0xf1, 0xde, 0xf7, 0x93, 0x0f, 0x6f, 0xde, 0x83,
-	0x5d, 0xca, 0x48, 0x1a, 0x21, 0x9b, 0x32, 0x7f, 0x81, 0xd2, 0x20, 0xb4, 0x63, 0xb4, 0x42, 0x34,
-	0xa5, 0x56, 0x46, 0x30, 0xc3, 0xfa, 0xd7, 0x32, 0xb6, 0x3e, 0xe2, 0xce, 0xb7, 0x18, 0xc7, 0xb8,
-	0xc8, 0xec, 0xfc, 0xab, 0xc4, 0x3a, 0x7f, 0xaa, 0x16, 0x94, 0xe1, 0x30, 0xf1, 0x18, 0xf1, 0xc3,
-	0x05, 0x22, 0x1c, 0xea, 0x55, 0xa1, 0x04, 0x53, 0xe6, 0x3d, 0xe2, 0x15, 0xe2, 0xc0, 0xcf, 0x2a,
-	0x90, 0xf9, 0xc4, 0x5f, 0xf2, 0x51, 0x3a, 0xbf, 0xaa, 0x29, 0x23, 0x7e, 0x84, 0x3c, 0x82, 0xd7,
-	0x8c, 0x0b, 0x7e, 0xbf, 0xd4, 0xa0, 0x7a, 0x51, 0xce, 0x3f, 0x65, 0x3e, 0x43, 0xfa, 0x7f, 0x28,
-	0x95, 0x0e, 0x43, 0xec, 0x8b, 0x03, 0xc5, 0x69, 0x5b, 0x95, 0xf7, 0x58, 0xd7, 0x45, 0x3c, 0x04,
-	0x9b, 0xd7, 0x9e, 0x30, 0xe1, 0xb0, 0xde, 0x86, 0xcd, 0x0c, 0x13, 0xe6, 0xa5, 0x91, 0x51, 0xeb,
-	0x8b, 0x83, 0xd6, 0x44, 0xca, 0xcb, 0xab, 0x48, 0x1f, 0xc1, 0x2f, 0x87, 0xa1, 0xbd, 0xdb, 0x94,
-	0x32, 0xa3, 0xd1, 0xaf, 0x0f, 0x14, 0xe7, 0xfb, 0x89, 0xf7, 0x12, 0x53, 0x36, 0xc7, 0x2b, 0xc4,
-	0xcd, 0x6a, 0xc2, 0xeb, 0x71, 0x4a, 0x99, 0x7e, 0x03, 0xf5, 0x4f, 0x0b, 0x2a, 0x55, 0xb0, 0x50,
-	0x75, 0x4f, 0x54, 0xa3, 0x1c, 0x9d, 0x95, 0x24, 0xd7, 0x69, 0xe8, 0xa8, 0x57, 0x28, 0xcf, 0xa1,
-	0x7a, 0xb4, 0x0f, 0x6a, 0xa8, 0x85, 0xec, 0xc7, 0x89, 0x6c, 0x96, 0x43, 0x93, 0x9c, 0xe1, 0x2a,
-	0x85, 0x1d, 0x3a, 0xd4, 0x05, 0x72, 0x5d, 0x03, 0x2e, 0x90, 0x81, 0xd6, 0x70, 0x81, 0x2c, 0x69,
-	0x4d, 0x17, 0xc8, 0x2d, 0x0d, 0xba, 0x40, 0x56, 0x34, 0x75, 0x38, 0xde, 0xec, 0x4c, 0x71, 0xbb,
-	0x33, 0xc5, 0xb7, 0x9d, 0x29, 0x3e, 0xed, 0x4d, 0x61, 0xbb, 0x37, 0x85, 0xe7, 0xbd, 0x29, 0xcc,
-	0x9d, 0x38, 0x65, 0xc9, 0x3a, 0xb0, 0x42, 0xbc, 0xb4, 0xa7, 0xc5, 0x1f, 0xff, 0x8e, 0xfd, 0x80,
-	0xda, 0xfc, 0x64, 0x77, 0xce, 0x99, 0x7d, 0x7f, 0x74, 0xb8, 0x87, 0x0c, 0xd1, 0x40, 0x2a, 0x6e,
-	0xf6, 0xef, 0x3d, 0x00, 0x00, 0xff, 0xff, 0xba, 0x27, 0x14, 0x84, 0x82, 0x02, 0x00, 0x00,
+	// 425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x6f, 0x94, 0x40,
+	0x18, 0xc6, 0x17, 0x77, 0xba, 0xa5, 0x03, 0xad, 0x64, 0x62, 0x52, 0x5c, 0x5b, 0x8a, 0x7a, 0xe1,
+	0xa0, 0x90, 0x60, 0x8c, 0xf7, 0x6a, 0x53, 0x25, 0x7b, 0x50, 0xda, 0x53, 0x2f, 0x84, 0x3f, 0x13,
+	0x20, 0xb5, 0x0c, 0x99, 0x79, 0xd7, 0xa8, 0x9f, 0xc2, 0x8f, 0xd5, 0x63, 0x13, 0x2f, 0x9e, 0x8c,
+	0xd9, 0xfd, 0x22, 0x86, 0x61, 0x5a, 0x91, 0x4d, 0x6f, 0x33, 0xef, 0xf3, 0xcb, 0x2f, 0xcf, 0x4c,
+	0x5e, 0x7c, 0x28, 0x80, 0xd7, 0x05, 0x0d, 0x04, 0xa4, 0x97, 0xb4, 0xce, 0xf2, 0xa0, 0xa4, 0x0d,
+	0x15, 0xb5, 0xf0, 0x5b, 0xce, 0x80, 0x91, 0x87, 0x7d, 0xec, 0xdf, 0xc6, 0xf3, 0x47, 0x25, 0x2b,
+	0x99, 0xcc, 0x82, 0xee, 0xd4, 0x63, 0xf3, 0xe7, 0x63, 0x0b, 0x6d, 0x59, 0x5e, 0x25, 0xc0, 0xd3,
+	0xfc, 0x92, 0x72, 0x05, 0x1d, 0x8d, 0xa1, 0x8a, 0x09, 0x48, 0xbe, 0xb3, 0x86, 0x2a, 0xe0, 0x60,
+	0x0c, 0xb4, 0x29, 0x4f, 0xaf, 0x54, 0x95, 0xf9, 0xd3, 0x71, 0x0a, 0x3c, 0x2d, 0x68, 0xc2, 0xd9,
+	0x12, 0x94, 0xe0, 0xd9, 0xcf, 0x29, 0x36, 0x4f, 0xfb, 0xfe, 0x67, 0x90, 0x02, 0x25, 0xaf, 0xf1,
+	0xac, 0x77, 0xd8, 0x9a, 0xab, 0x79, 0x46, 0xb8, 0xef, 0x8f, 0xde, 0xe3, 0x7f, 0x94, 0xf1, 0x31,
+	0xba, 0xfe, 0x7d, 0x34, 0x89, 0x15, 0x4c, 0xf6, 0xf1, 0x76, 0xcb, 0x38, 0x24, 0x75, 0x61, 0x3f,
+	0x70, 0x35, 0x6f, 0x27, 0x9e, 0x75, 0xd7, 0x0f, 0x05, 0x39, 0xc1, 0x7b, 0x77, 0xa5, 0x93, 0xcf,
+	0xb5, 0x00, 0x7b, 0xcb, 0x9d, 0x7a, 0x46, 0xf8, 0x78, 0xc3, 0xfb, 0x9e, 0x09, 0xb8, 0x60, 0x0d,
+	0x55, 0x66, 0xb3, 0x52, 0xf7, 0x45, 0x2d, 0x80, 0x7c, 0xc2, 0xe4, 0xbf, 0x0f, 0xea, 0x55, 0x58,
+	0xaa, 0x0e, 0x37, 0x54, 0x27, 0x1d, 0x7a, 0xde, 0x93, 0x4a, 0x67, 0xd1, 0xc1, 0x4c, 0x2a, 0xdf,
+	0x61, 0x73, 0xf0, 0x1f, 0xc2, 0x36, 0xa5, 0xec, 0xc9, 0x86, 0xec, 0xbc, 0x83, 0xe2, 0x8e, 0x51,
+	0x2a, 0x03, 0xee, 0x26, 0x82, 0x9c, 0xe2, 0x5d, 0x65, 0xa1, 0x39, 0xe3, 0x85, 0xb0, 0x77, 0xa5,
+	0xe6, 0xe0, 0x1e, 0x8d, 0x84, 0x6e, 0x5f, 0x08, 0xff, 0x46, 0x82, 0xbc, 0xc0, 0x64, 0x28, 0x4a,
+	0x72, 0xb6, 0x6c, 0xc0, 0xde, 0x73, 0x35, 0x0f, 0xc5, 0xd6, 0x80, 0x7c, 0xdb, 0xcd, 0x23, 0xa4,
+	0x4f, 0x2d, 0x14, 0x21, 0x1d, 0x59, 0x5b, 0x11, 0xd2, 0x67, 0xd6, 0x76, 0x84, 0xf4, 0x1d, 0x0b,
+	0x47, 0x48, 0x37, 0x2c, 0xf3, 0x78, 0x71, 0xbd, 0x72, 0xb4, 0x9b, 0x95, 0xa3, 0xfd, 0x59, 0x39,
+	0xda, 0x8f, 0xb5, 0x33, 0xb9, 0x59, 0x3b, 0x93, 0x5f, 0x6b, 0x67, 0x72, 0x11, 0x96, 0x35, 0x54,
+	0xcb, 0xcc, 0xcf, 0xd9, 0x55, 0x70, 0x26, 0x1b, 0xbe, 0x5c, 0xa4, 0x99, 0x08, 0xd4, 0xa6, 0x7c,
+	0x09, 0xdf, 0x04, 0x5f, 0x07, 0xfb, 0xf2, 0xad, 0xa5, 0x22, 0x9b, 0xc9, 0x55, 0x79, 0xf5, 0x77,
+	0x00, 0x76, 0xcf, 0xd6, 0xa1, 0xf9, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -154,6 +173,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TradeRecordCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TradeRecordCount))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.TradeRecords) > 0 {
+		for iNdEx := len(m.TradeRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TradeRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if len(m.TradeRoutes) > 0 {
 		for iNdEx := len(m.TradeRoutes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -257,6 +295,15 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TradeRecords) > 0 {
+		for _, e := range m.TradeRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TradeRecordCount != 0 {
+		n += 1 + sovGenesis(uint64(m.TradeRecordCount))
+	}
 	return n
 }
 
@@ -462,6 +509,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradeRecords = append(m.TradeRecords, TradeRecord{})
+			if err := m.TradeRecords[len(m.TradeRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeRecordCount", wireType)
+			}
+			m.TradeRecordCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeRecordCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
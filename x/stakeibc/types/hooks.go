@@ -16,3 +16,9 @@ func (h MultiStakeIBCHooks) AfterLiquidStake(ctx sdk.Context, addr sdk.AccAddres
 		h[i].AfterLiquidStake(ctx, addr)
 	}
 }
+
+func (h MultiStakeIBCHooks) AfterRewardCollectorAuctioned(ctx sdk.Context) {
+	for i := range h {
+		h[i].AfterRewardCollectorAuctioned(ctx)
+	}
+}
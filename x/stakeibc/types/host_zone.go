@@ -19,8 +19,88 @@ const (
 //
 // We calculate this by dividing the period by 7 and then adding 1 as a buffer
 // Ex: If our unbonding period is 21 days, we issue an undelegation every 4th day
+//
+// The limit applies to each delegator, so if the host zone has multiple delegation
+// shards, unbondings rotate through each shard and the 7 entries are multiplied
+// by the number of shards that are able to unbond
+// Ex: If our unbonding period is 21 days and there are 3 shards, we issue an
+// undelegation every other day, and each shard unbonds every 6th day
 func (h HostZone) GetUnbondingFrequency() uint64 {
-	return (h.UnbondingPeriod / MaxUnbondingEntries) + 1
+	numUnbondingShards := uint64(len(h.GetUnbondingShardIndexes()))
+	return (h.UnbondingPeriod / (MaxUnbondingEntries * numUnbondingShards)) + 1
+}
+
+// Returns the delegation shard that should unbond on a given day
+// Shards with a registered ICA are rotated through on each unbonding day
+func (h HostZone) GetUnbondingShardIndex(dayNumber uint64) uint32 {
+	unbondingShardIndexes := h.GetUnbondingShardIndexes()
+	unbondingDay := dayNumber / h.GetUnbondingFrequency()
+	return unbondingShardIndexes[unbondingDay%uint64(len(unbondingShardIndexes))]
+}
+
+// Returns the indexes of the delegation shards that take part in the unbonding rotation
+// Shards are skipped until their ICA has been registered
+// If no ICA has been registered yet, the main delegation ICA is returned
+func (h HostZone) GetUnbondingShardIndexes() []uint32 {
+	shardIndexes := []uint32{}
+	for _, shardIndex := range h.GetDelegationShardIndexes() {
+		if address, _ := h.GetDelegationShardAddress(shardIndex); address != "" {
+			shardIndexes = append(shardIndexes, shardIndex)
+		}
+	}
+	if len(shardIndexes) == 0 {
+		return []uint32{0}
+	}
+	return shardIndexes
+}
+
+// Returns the number of delegation ICAs on the host zone, including the main delegation ICA
+func (h HostZone) GetNumDelegationShards() uint64 {
+	return uint64(len(h.DelegationShards)) + 1
+}
+
+// Returns the ICA address of a delegation shard
+// Shard 0 is the main delegation ICA
+// The address may be empty if the shard was registered but the channel has not opened yet
+func (h HostZone) GetDelegationShardAddress(shardIndex uint32) (address string, found bool) {
+	if shardIndex == 0 {
+		return h.DelegationIcaAddress, true
+	}
+	for _, shard := range h.DelegationShards {
+		if shard.Index == shardIndex {
+			return shard.IcaAddress, true
+		}
+	}
+	return "", false
+}
+
+// Returns the indexes of all delegation shards, including the main delegation ICA
+func (h HostZone) GetDelegationShardIndexes() []uint32 {
+	shardIndexes := []uint32{0}
+	for _, shard := range h.DelegationShards {
+		shardIndexes = append(shardIndexes, shard.Index)
+	}
+	return shardIndexes
+}
+
+// Returns a copy of the cadence with each of the non-zero intervals from the update applied
+func (c EpochCadence) Merge(update EpochCadence) EpochCadence {
+	if update.DepositInterval != 0 {
+		c.DepositInterval = update.DepositInterval
+	}
+	if update.DelegateInterval != 0 {
+		c.DelegateInterval = update.DelegateInterval
+	}
+	if update.ReinvestInterval != 0 {
+		c.ReinvestInterval = update.ReinvestInterval
+	}
+	if update.RedemptionRateInterval != 0 {
+		c.RedemptionRateInterval = update.RedemptionRateInterval
+	}
+	if update.RebalanceInterval != 0 {
+		c.RebalanceInterval = update.RebalanceInterval
+	}
+	return c
 }
 
 // Gets the rebate struct if it exists on the host zone
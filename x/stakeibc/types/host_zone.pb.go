@@ -9,15 +9,19 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -67,6 +71,197 @@ func (m *CommunityPoolRebate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolRebate proto.InternalMessageInfo
 
+// RedemptionRateSnapshot records the redemption rate at the time of an update
+// and is used to measure how quickly the rate is moving
+type RedemptionRateSnapshot struct {
+	// The redemption rate after the update
+	RedemptionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=redemption_rate,json=redemptionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"redemption_rate"`
+	// Block time of the update
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *RedemptionRateSnapshot) Reset()         { *m = RedemptionRateSnapshot{} }
+func (m *RedemptionRateSnapshot) String() string { return proto.CompactTextString(m) }
+func (*RedemptionRateSnapshot) ProtoMessage()    {}
+func (*RedemptionRateSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f81bf5b42c61245a, []int{1}
+}
+func (m *RedemptionRateSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedemptionRateSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedemptionRateSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedemptionRateSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedemptionRateSnapshot.Merge(m, src)
+}
+func (m *RedemptionRateSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedemptionRateSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedemptionRateSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedemptionRateSnapshot proto.InternalMessageInfo
+
+func (m *RedemptionRateSnapshot) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// An additional delegation ICA on the host zone
+// Delegations can be spread across multiple delegation ICAs (shards) so that the
+// per-delegator unbonding and redelegation entry limits on the host are not hit
+// Shard 0 is always the host zone's main delegation ICA (delegation_ica_address)
+type DelegationShard struct {
+	// Index of the shard (1 or greater)
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// ICA Address of the shard's delegation account
+	IcaAddress string `protobuf:"bytes,2,opt,name=ica_address,json=icaAddress,proto3" json:"ica_address,omitempty"`
+}
+
+func (m *DelegationShard) Reset()         { *m = DelegationShard{} }
+func (m *DelegationShard) String() string { return proto.CompactTextString(m) }
+func (*DelegationShard) ProtoMessage()    {}
+func (*DelegationShard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f81bf5b42c61245a, []int{2}
+}
+func (m *DelegationShard) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationShard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationShard.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegationShard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationShard.Merge(m, src)
+}
+func (m *DelegationShard) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationShard) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationShard.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationShard proto.InternalMessageInfo
+
+func (m *DelegationShard) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *DelegationShard) GetIcaAddress() string {
+	if m != nil {
+		return m.IcaAddress
+	}
+	return ""
+}
+
+// The cadence, in stride epochs, of each of the epochly tasks for a host zone
+// A zero value for any interval falls back to the module-wide default
+type EpochCadence struct {
+	// Number of stride epochs between transfers of deposits to the host zone
+	DepositInterval uint64 `protobuf:"varint,1,opt,name=deposit_interval,json=depositInterval,proto3" json:"deposit_interval,omitempty"`
+	// Number of stride epochs between delegations of transferred deposits
+	DelegateInterval uint64 `protobuf:"varint,2,opt,name=delegate_interval,json=delegateInterval,proto3" json:"delegate_interval,omitempty"`
+	// Number of stride epochs between reinvestments of staking rewards
+	ReinvestInterval uint64 `protobuf:"varint,3,opt,name=reinvest_interval,json=reinvestInterval,proto3" json:"reinvest_interval,omitempty"`
+	// Number of stride epochs between redemption rate updates
+	RedemptionRateInterval uint64 `protobuf:"varint,4,opt,name=redemption_rate_interval,json=redemptionRateInterval,proto3" json:"redemption_rate_interval,omitempty"`
+	// Number of stride epochs between rebalance checks
+	// Defaults to the number of stride epochs in a day epoch
+	RebalanceInterval uint64 `protobuf:"varint,5,opt,name=rebalance_interval,json=rebalanceInterval,proto3" json:"rebalance_interval,omitempty"`
+}
+
+func (m *EpochCadence) Reset()         { *m = EpochCadence{} }
+func (m *EpochCadence) String() string { return proto.CompactTextString(m) }
+func (*EpochCadence) ProtoMessage()    {}
+func (*EpochCadence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f81bf5b42c61245a, []int{3}
+}
+func (m *EpochCadence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochCadence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochCadence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochCadence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochCadence.Merge(m, src)
+}
+func (m *EpochCadence) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochCadence) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochCadence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochCadence proto.InternalMessageInfo
+
+func (m *EpochCadence) GetDepositInterval() uint64 {
+	if m != nil {
+		return m.DepositInterval
+	}
+	return 0
+}
+
+func (m *EpochCadence) GetDelegateInterval() uint64 {
+	if m != nil {
+		return m.DelegateInterval
+	}
+	return 0
+}
+
+func (m *EpochCadence) GetReinvestInterval() uint64 {
+	if m != nil {
+		return m.ReinvestInterval
+	}
+	return 0
+}
+
+func (m *EpochCadence) GetRedemptionRateInterval() uint64 {
+	if m != nil {
+		return m.RedemptionRateInterval
+	}
+	return 0
+}
+
+func (m *EpochCadence) GetRebalanceInterval() uint64 {
+	if m != nil {
+		return m.RebalanceInterval
+	}
+	return 0
+}
+
 // Core data structure to track liquid staking zones
 type HostZone struct {
 	// Chain ID of the host zone
@@ -93,6 +288,9 @@ type HostZone struct {
 	FeeIcaAddress string `protobuf:"bytes,23,opt,name=fee_ica_address,json=feeIcaAddress,proto3" json:"fee_ica_address,omitempty"`
 	// ICA Address on the host zone responsible for staking and unstaking
 	DelegationIcaAddress string `protobuf:"bytes,24,opt,name=delegation_ica_address,json=delegationIcaAddress,proto3" json:"delegation_ica_address,omitempty"`
+	// Additional delegation ICAs, beyond the main delegation ICA, that stake on
+	// behalf of the host zone
+	DelegationShards []DelegationShard `protobuf:"bytes,41,rep,name=delegation_shards,json=delegationShards,proto3" json:"delegation_shards"`
 	// ICA Address that receives unstaked tokens after they've finished unbonding
 	RedemptionIcaAddress string `protobuf:"bytes,25,opt,name=redemption_ica_address,json=redemptionIcaAddress,proto3" json:"redemption_ica_address,omitempty"`
 	// ICA Address that receives tokens from a community pool to liquid stake or
@@ -132,11 +330,27 @@ type HostZone struct {
 	// The max minner redemption rate bound - controlled by the admin
 	// If the redemption rate exceeds this bound, the host zone is halted
 	MaxInnerRedemptionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,29,opt,name=max_inner_redemption_rate,json=maxInnerRedemptionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_inner_redemption_rate"`
+	// The max relative change in the redemption rate between two consecutive
+	// updates (e.g. 0.01 for 1%) - controlled by governance
+	// If the change exceeds this amount, the host zone is halted
+	// A zero value disables the check
+	MaxRedemptionRateChangePerEpoch github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,38,opt,name=max_redemption_rate_change_per_epoch,json=maxRedemptionRateChangePerEpoch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_redemption_rate_change_per_epoch"`
+	// The max relative change in the redemption rate over a rolling 24 hour
+	// window - controlled by governance
+	// If the change exceeds this amount, the host zone is halted
+	// A zero value disables the check
+	MaxRedemptionRateChangePerDay github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,39,opt,name=max_redemption_rate_change_per_day,json=maxRedemptionRateChangePerDay,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_redemption_rate_change_per_day"`
+	// Redemption rates from the updates in the last 24 hours, used to enforce
+	// the daily rate of change limit
+	RedemptionRateHistory []RedemptionRateSnapshot `protobuf:"bytes,40,rep,name=redemption_rate_history,json=redemptionRateHistory,proto3" json:"redemption_rate_history"`
 	// The max number of messages that can be sent in a delegation
 	// or undelegation ICA tx
 	MaxMessagesPerIcaTx uint64 `protobuf:"varint,36,opt,name=max_messages_per_ica_tx,json=maxMessagesPerIcaTx,proto3" json:"max_messages_per_ica_tx,omitempty"`
 	// Indicates whether redemptions are allowed through this module
 	RedemptionsEnabled bool `protobuf:"varint,37,opt,name=redemptions_enabled,json=redemptionsEnabled,proto3" json:"redemptions_enabled,omitempty"`
+	// The cadence of the epochly tasks for the host zone - controlled by
+	// governance
+	EpochCadence EpochCadence `protobuf:"bytes,42,opt,name=epoch_cadence,json=epochCadence,proto3" json:"epoch_cadence"`
 	// An optional fee rebate
 	// If there is no rebate for the host zone, this will be nil
 	CommunityPoolRebate *CommunityPoolRebate `protobuf:"bytes,34,opt,name=community_pool_rebate,json=communityPoolRebate,proto3" json:"community_pool_rebate,omitempty"`
@@ -150,7 +364,7 @@ func (m *HostZone) Reset()         { *m = HostZone{} }
 func (m *HostZone) String() string { return proto.CompactTextString(m) }
 func (*HostZone) ProtoMessage()    {}
 func (*HostZone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f81bf5b42c61245a, []int{1}
+	return fileDescriptor_f81bf5b42c61245a, []int{4}
 }
 func (m *HostZone) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -263,6 +477,13 @@ func (m *HostZone) GetDelegationIcaAddress() string {
 	return ""
 }
 
+func (m *HostZone) GetDelegationShards() []DelegationShard {
+	if m != nil {
+		return m.DelegationShards
+	}
+	return nil
+}
+
 func (m *HostZone) GetRedemptionIcaAddress() string {
 	if m != nil {
 		return m.RedemptionIcaAddress
@@ -305,6 +526,13 @@ func (m *HostZone) GetCommunityPoolTreasuryAddress() string {
 	return ""
 }
 
+func (m *HostZone) GetRedemptionRateHistory() []RedemptionRateSnapshot {
+	if m != nil {
+		return m.RedemptionRateHistory
+	}
+	return nil
+}
+
 func (m *HostZone) GetMaxMessagesPerIcaTx() uint64 {
 	if m != nil {
 		return m.MaxMessagesPerIcaTx
@@ -319,6 +547,13 @@ func (m *HostZone) GetRedemptionsEnabled() bool {
 	return false
 }
 
+func (m *HostZone) GetEpochCadence() EpochCadence {
+	if m != nil {
+		return m.EpochCadence
+	}
+	return EpochCadence{}
+}
+
 func (m *HostZone) GetCommunityPoolRebate() *CommunityPoolRebate {
 	if m != nil {
 		return m.CommunityPoolRebate
@@ -342,76 +577,100 @@ func (m *HostZone) GetHalted() bool {
 
 func init() {
 	proto.RegisterType((*CommunityPoolRebate)(nil), "stride.stakeibc.CommunityPoolRebate")
+	proto.RegisterType((*RedemptionRateSnapshot)(nil), "stride.stakeibc.RedemptionRateSnapshot")
+	proto.RegisterType((*DelegationShard)(nil), "stride.stakeibc.DelegationShard")
+	proto.RegisterType((*EpochCadence)(nil), "stride.stakeibc.EpochCadence")
 	proto.RegisterType((*HostZone)(nil), "stride.stakeibc.HostZone")
 }
 
 func init() { proto.RegisterFile("stride/stakeibc/host_zone.proto", fileDescriptor_f81bf5b42c61245a) }
 
 var fileDescriptor_f81bf5b42c61245a = []byte{
-	// 999 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x41, 0x6f, 0xdb, 0x36,
-	0x14, 0xc7, 0xe3, 0xd6, 0x4d, 0x15, 0xa6, 0x89, 0x65, 0x39, 0x49, 0x95, 0xb4, 0x71, 0x9c, 0x34,
-	0x1d, 0xbc, 0x43, 0x6c, 0xc0, 0x1d, 0x50, 0x60, 0xd8, 0x61, 0x69, 0x33, 0xa0, 0x36, 0xb2, 0x2e,
-	0x50, 0x82, 0x61, 0xe8, 0x0e, 0x02, 0x25, 0xbe, 0xd8, 0x5c, 0x24, 0xd2, 0x23, 0xe9, 0xc6, 0xd9,
-	0xa7, 0xd8, 0x87, 0xe9, 0x87, 0xe8, 0xb1, 0xe8, 0xa9, 0xd8, 0xa1, 0x18, 0x92, 0xcf, 0xb0, 0xfb,
-	0x20, 0x4a, 0xb2, 0x65, 0x2b, 0x83, 0xd1, 0xc1, 0x27, 0x8b, 0xef, 0x3d, 0xfe, 0x7f, 0x7c, 0xe6,
-	0xd3, 0xd3, 0x43, 0x3b, 0x52, 0x09, 0x4a, 0xa0, 0x29, 0x15, 0xbe, 0x00, 0xea, 0xf9, 0xcd, 0x1e,
-	0x97, 0xca, 0xfd, 0x83, 0x33, 0x68, 0xf4, 0x05, 0x57, 0xdc, 0x2a, 0xc5, 0x01, 0x8d, 0x34, 0x60,
-	0x6b, 0xd3, 0xe7, 0x32, 0xe4, 0xd2, 0xd5, 0xee, 0x66, 0xbc, 0x88, 0x63, 0xb7, 0xd6, 0xba, 0xbc,
-	0xcb, 0x63, 0x7b, 0xf4, 0x94, 0x58, 0x73, 0x88, 0xb7, 0x38, 0xa0, 0x04, 0x2b, 0x2e, 0xe2, 0x80,
-	0xbd, 0x4f, 0x05, 0x54, 0x79, 0xc9, 0xc3, 0x70, 0xc0, 0xa8, 0xba, 0x3a, 0xe1, 0x3c, 0x70, 0xc0,
-	0xc3, 0x0a, 0xac, 0x9f, 0xd0, 0xb2, 0xd0, 0x4f, 0xae, 0xc0, 0x0a, 0xec, 0x42, 0xad, 0x50, 0x5f,
-	0x7a, 0xd1, 0x78, 0xff, 0x79, 0x67, 0xe1, 0xaf, 0xcf, 0x3b, 0x5f, 0x75, 0xa9, 0xea, 0x0d, 0xbc,
-	0x86, 0xcf, 0xc3, 0xe4, 0x10, 0xc9, 0xcf, 0x81, 0x24, 0x17, 0x4d, 0x75, 0xd5, 0x07, 0xd9, 0x38,
-	0x02, 0xdf, 0x41, 0xb1, 0x84, 0x13, 0x09, 0xf6, 0xd1, 0x76, 0x40, 0x7f, 0x1f, 0x50, 0xe2, 0xea,
-	0xb3, 0x44, 0x3f, 0xae, 0xe2, 0x17, 0xc0, 0x5c, 0x1c, 0xf2, 0x01, 0x53, 0xf6, 0x9d, 0x2f, 0x46,
-	0xb4, 0x99, 0x72, 0x36, 0x63, 0xd1, 0x53, 0xad, 0x79, 0xaa, 0xce, 0x22, 0xc5, 0x43, 0x2d, 0xb8,
-	0xf7, 0x4f, 0x19, 0x19, 0xaf, 0xb8, 0x54, 0x6f, 0x38, 0x03, 0x6b, 0x13, 0x19, 0x7e, 0x0f, 0x53,
-	0xe6, 0x52, 0x12, 0x27, 0xe3, 0xdc, 0xd7, 0xeb, 0x36, 0xb1, 0xf6, 0xd0, 0x03, 0x0f, 0xfc, 0xde,
-	0xb3, 0x56, 0x5f, 0xc0, 0x39, 0x1d, 0xda, 0x65, 0xed, 0x9e, 0xb0, 0x59, 0x4f, 0xd0, 0x8a, 0xcf,
-	0x19, 0x03, 0x5f, 0x51, 0xae, 0x35, 0xee, 0xc4, 0x41, 0x63, 0x63, 0x9b, 0x58, 0x0d, 0x54, 0x51,
-	0x02, 0x33, 0x79, 0x0e, 0xc2, 0xf5, 0x7b, 0x98, 0x31, 0x08, 0xa2, 0xd0, 0x07, 0x3a, 0xb4, 0x9c,
-	0xba, 0x5e, 0xc6, 0x9e, 0x36, 0xb1, 0x1e, 0xa1, 0x25, 0xea, 0xf9, 0x2e, 0x01, 0xc6, 0x43, 0xdb,
-	0xd0, 0x51, 0x06, 0xf5, 0xfc, 0xa3, 0x68, 0x6d, 0x6d, 0x23, 0xa4, 0xcb, 0x21, 0xf6, 0x2e, 0x69,
-	0xef, 0x52, 0x64, 0x89, 0xdd, 0x5f, 0x23, 0x73, 0xc0, 0x3c, 0xce, 0x08, 0x65, 0x5d, 0xb7, 0x0f,
-	0x82, 0x72, 0x62, 0x6f, 0xd5, 0x0a, 0xf5, 0xa2, 0x53, 0x1a, 0xd9, 0x4f, 0xb4, 0xd9, 0xfa, 0x16,
-	0xa1, 0xd1, 0xad, 0x4b, 0xfb, 0x6e, 0xed, 0x6e, 0x7d, 0xb9, 0xb5, 0xd5, 0x98, 0x2a, 0xad, 0xc6,
-	0xcf, 0x69, 0x88, 0x93, 0x89, 0xb6, 0x0e, 0x51, 0x89, 0x40, 0x9f, 0x4b, 0xaa, 0x5c, 0x4c, 0x88,
-	0x00, 0x29, 0x6d, 0x4b, 0xdf, 0x93, 0xfd, 0xf1, 0xdd, 0xc1, 0x5a, 0x52, 0x80, 0x87, 0xb1, 0xe7,
-	0x54, 0x09, 0xca, 0xba, 0xce, 0x6a, 0xb2, 0x21, 0xb1, 0x5a, 0xaf, 0xd1, 0xc6, 0x25, 0x55, 0x3d,
-	0x22, 0xf0, 0x25, 0x0e, 0x5c, 0xea, 0xe3, 0x91, 0xd2, 0xc6, 0x0c, 0xa5, 0xb5, 0xf1, 0xbe, 0xb6,
-	0x8f, 0x53, 0xbd, 0xef, 0x51, 0xe9, 0x1c, 0x60, 0x42, 0xe8, 0xe1, 0x0c, 0xa1, 0x95, 0x73, 0x80,
-	0x8c, 0xc2, 0x6b, 0xb4, 0x41, 0x20, 0x80, 0x2e, 0x8e, 0x2f, 0x33, 0x23, 0x64, 0xcf, 0x3a, 0xd1,
-	0x78, 0xdf, 0xa4, 0x9e, 0x00, 0x02, 0x61, 0x3f, 0xa7, 0xb7, 0x39, 0x4b, 0x6f, 0xbc, 0x2f, 0xa3,
-	0x47, 0xd0, 0x9e, 0x9f, 0xbe, 0x92, 0x6e, 0x9f, 0xf3, 0xc0, 0x4d, 0xef, 0x20, 0xab, 0x5d, 0x9d,
-	0xa1, 0x5d, 0xf5, 0xb3, 0xaf, 0xf5, 0x51, 0xac, 0x90, 0xa1, 0x78, 0x68, 0x77, 0x8a, 0x22, 0x40,
-	0x0d, 0xc4, 0x64, 0x02, 0x3b, 0x33, 0x20, 0xdb, 0xfe, 0x64, 0xef, 0x88, 0x04, 0x32, 0x8c, 0x1e,
-	0xda, 0x9f, 0x62, 0xe8, 0x7a, 0x73, 0x7b, 0x3c, 0xd0, 0x85, 0x9b, 0x62, 0x6a, 0x33, 0x30, 0xb5,
-	0x09, 0x8c, 0x7e, 0xd9, 0x5f, 0xc5, 0x12, 0x29, 0xe9, 0x37, 0xf4, 0x34, 0x97, 0x0d, 0x01, 0x08,
-	0x73, 0xa8, 0xdd, 0x19, 0xa8, 0xdd, 0xa9, 0x8c, 0x22, 0x91, 0x29, 0x96, 0x8b, 0x76, 0xa6, 0x58,
-	0x4a, 0x00, 0x96, 0x03, 0x71, 0x35, 0xa2, 0x3c, 0x99, 0x41, 0x79, 0x3c, 0x41, 0x39, 0x4b, 0xb6,
-	0xa7, 0x80, 0x5f, 0x51, 0x59, 0x71, 0x85, 0xa3, 0x7b, 0x4f, 0xcb, 0x4d, 0xda, 0x2b, 0xff, 0xab,
-	0x3f, 0x9a, 0x5a, 0xe8, 0x68, 0xac, 0x63, 0x31, 0xb4, 0x16, 0x60, 0xa9, 0xdc, 0x4c, 0xc9, 0xea,
-	0x16, 0x8f, 0xb4, 0xfe, 0x77, 0x5f, 0xd6, 0xe2, 0x3f, 0xbe, 0x3b, 0x40, 0x49, 0x82, 0x51, 0xc3,
-	0xb7, 0x22, 0x65, 0x67, 0x24, 0xac, 0x1b, 0x3f, 0xa0, 0xd2, 0x34, 0x6a, 0x79, 0x0e, 0xa8, 0x55,
-	0x31, 0x89, 0x09, 0x50, 0x25, 0xa4, 0x2c, 0x97, 0xd5, 0xda, 0x1c, 0x50, 0xe5, 0x90, 0x32, 0x27,
-	0x4f, 0xc3, 0xc3, 0x1c, 0x6d, 0x7d, 0x2e, 0x34, 0x3c, 0x9c, 0xa2, 0x5d, 0xa2, 0xcd, 0x28, 0x37,
-	0xca, 0x18, 0x88, 0x1c, 0xf3, 0xf1, 0x1c, 0x98, 0x1b, 0x21, 0x65, 0xed, 0x48, 0xfd, 0x16, 0x30,
-	0x1e, 0xfe, 0x07, 0x78, 0x7b, 0x2e, 0x60, 0x3c, 0xbc, 0x0d, 0xfc, 0x0d, 0x7a, 0x18, 0x81, 0x43,
-	0x90, 0x12, 0x77, 0x41, 0x46, 0x5f, 0x38, 0xdd, 0x97, 0xd4, 0xd0, 0xde, 0xd7, 0x5f, 0xb9, 0xe8,
-	0xef, 0xff, 0x31, 0xf1, 0x9e, 0x80, 0x68, 0xfb, 0xf8, 0x6c, 0x68, 0x35, 0x51, 0x65, 0x7c, 0x48,
-	0xe9, 0x02, 0xc3, 0x5e, 0x00, 0xc4, 0x7e, 0x5a, 0x2b, 0xd4, 0x0d, 0xc7, 0xca, 0xb8, 0x7e, 0x88,
-	0x3d, 0xd6, 0x2f, 0x68, 0x3d, 0xd7, 0x35, 0xa2, 0x89, 0xc5, 0xde, 0xab, 0x15, 0xea, 0xcb, 0xad,
-	0xfd, 0xdc, 0x57, 0xf2, 0x96, 0x51, 0xc9, 0xa9, 0xf8, 0xb7, 0xcc, 0x4f, 0xcf, 0x91, 0x1d, 0xc8,
-	0xd0, 0xcd, 0x8e, 0x3c, 0xa3, 0xf3, 0x3c, 0xd2, 0xe7, 0x59, 0x0f, 0x64, 0x78, 0x3c, 0x1e, 0x5e,
-	0xd2, 0x23, 0x6d, 0xa0, 0xc5, 0x1e, 0x0e, 0x14, 0x10, 0xbb, 0xa2, 0xc3, 0x92, 0x55, 0xa7, 0x68,
-	0x14, 0xcd, 0x7b, 0x9d, 0xa2, 0x71, 0xcf, 0x5c, 0xec, 0x14, 0x8d, 0x45, 0xf3, 0x7e, 0xa7, 0x68,
-	0xdc, 0x37, 0x8d, 0x4e, 0xd1, 0x58, 0x35, 0x4b, 0x9d, 0xa2, 0x51, 0x32, 0xcd, 0x4e, 0xd1, 0x30,
-	0xcd, 0xf2, 0x8b, 0xe3, 0xf7, 0xd7, 0xd5, 0xc2, 0x87, 0xeb, 0x6a, 0xe1, 0xef, 0xeb, 0x6a, 0xe1,
-	0xcf, 0x9b, 0xea, 0xc2, 0x87, 0x9b, 0xea, 0xc2, 0xa7, 0x9b, 0xea, 0xc2, 0x9b, 0x56, 0xe6, 0x8e,
-	0x4e, 0x75, 0x66, 0x07, 0xc7, 0xd8, 0x93, 0xcd, 0x64, 0x48, 0x7c, 0xdb, 0x7a, 0xde, 0x1c, 0x8e,
-	0x47, 0x45, 0x7d, 0x67, 0xde, 0xa2, 0x9e, 0x13, 0x9f, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x07,
-	0x10, 0x49, 0x01, 0xad, 0x0a, 0x00, 0x00,
+	// 1331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0x13, 0x47,
+	0x14, 0x8f, 0xc1, 0x09, 0x9b, 0x97, 0x84, 0xd8, 0x93, 0xc4, 0x6c, 0x02, 0xb1, 0x83, 0x09, 0xff,
+	0x5a, 0xc5, 0x96, 0x42, 0x25, 0x68, 0xd5, 0x43, 0x49, 0x82, 0x14, 0x5b, 0x94, 0x46, 0xeb, 0xa8,
+	0xaa, 0xe8, 0x61, 0x35, 0xde, 0x7d, 0xb1, 0xa7, 0xec, 0xce, 0xb8, 0x3b, 0xe3, 0xe0, 0xf4, 0x0b,
+	0xf4, 0xd0, 0x0b, 0x52, 0xbf, 0x0a, 0x97, 0x7e, 0x03, 0x8e, 0x88, 0x13, 0xea, 0x81, 0x56, 0xf0,
+	0x35, 0x7a, 0xa8, 0x76, 0x76, 0xd7, 0x5e, 0xdb, 0x41, 0x16, 0x95, 0x7b, 0xda, 0x9d, 0xf7, 0xe7,
+	0xf7, 0x7b, 0x6f, 0xe6, 0xcd, 0xbc, 0x19, 0x28, 0x49, 0x15, 0x30, 0x17, 0xab, 0x52, 0xd1, 0x67,
+	0xc8, 0x9a, 0x4e, 0xb5, 0x2d, 0xa4, 0xb2, 0x7f, 0x11, 0x1c, 0x2b, 0x9d, 0x40, 0x28, 0x41, 0x96,
+	0x23, 0x83, 0x4a, 0x62, 0xb0, 0xb1, 0xee, 0x08, 0xe9, 0x0b, 0x69, 0x6b, 0x75, 0x35, 0x1a, 0x44,
+	0xb6, 0x1b, 0xab, 0x2d, 0xd1, 0x12, 0x91, 0x3c, 0xfc, 0x8b, 0xa5, 0xa5, 0x96, 0x10, 0x2d, 0x0f,
+	0xab, 0x7a, 0xd4, 0xec, 0x9e, 0x54, 0x15, 0xf3, 0x51, 0x2a, 0xea, 0x77, 0x12, 0x83, 0xd1, 0x18,
+	0x4e, 0xa9, 0xc7, 0x5c, 0xaa, 0x44, 0x10, 0x19, 0x94, 0xdf, 0x66, 0x60, 0x65, 0x5f, 0xf8, 0x7e,
+	0x97, 0x33, 0x75, 0x76, 0x24, 0x84, 0x67, 0x61, 0x93, 0x2a, 0x24, 0xdf, 0xc1, 0x42, 0xa0, 0xff,
+	0xec, 0x80, 0x2a, 0x34, 0x33, 0x5b, 0x99, 0x3b, 0xf3, 0x7b, 0x95, 0x57, 0xef, 0x4a, 0x33, 0x7f,
+	0xbe, 0x2b, 0xdd, 0x6a, 0x31, 0xd5, 0xee, 0x36, 0x2b, 0x8e, 0xf0, 0xe3, 0x28, 0xe3, 0xcf, 0x8e,
+	0x74, 0x9f, 0x55, 0xd5, 0x59, 0x07, 0x65, 0xe5, 0x00, 0x1d, 0x0b, 0x22, 0x08, 0x2b, 0x04, 0xec,
+	0xc0, 0xa6, 0xc7, 0x7e, 0xee, 0x32, 0xd7, 0xd6, 0xb1, 0x84, 0x1f, 0x5b, 0x89, 0x67, 0xc8, 0x6d,
+	0xea, 0x8b, 0x2e, 0x57, 0xe6, 0x85, 0x4f, 0xa6, 0xa8, 0x71, 0x65, 0xad, 0x47, 0xa0, 0x0d, 0x8d,
+	0xd9, 0x50, 0xc7, 0x21, 0xe2, 0x43, 0x0d, 0x58, 0xfe, 0x23, 0x03, 0x05, 0x0b, 0x5d, 0xf4, 0x3b,
+	0x8a, 0x09, 0x1e, 0x06, 0xd1, 0xe0, 0xb4, 0x23, 0xdb, 0x42, 0x11, 0x84, 0xe5, 0xa0, 0xaf, 0x49,
+	0x67, 0xf8, 0xf5, 0xa7, 0x65, 0xf8, 0xe6, 0xe5, 0x0e, 0xc4, 0xcb, 0x14, 0xe6, 0x7b, 0x39, 0x18,
+	0xa2, 0x23, 0x0f, 0x20, 0x1b, 0x2e, 0x88, 0x4e, 0x6d, 0x61, 0x77, 0xa3, 0x12, 0xad, 0x56, 0x25,
+	0x59, 0xad, 0xca, 0x71, 0xb2, 0x5a, 0x7b, 0x46, 0xc8, 0xfb, 0xe2, 0xaf, 0x52, 0xc6, 0xd2, 0x1e,
+	0xe5, 0x26, 0x2c, 0x1f, 0xa0, 0x87, 0x2d, 0x1a, 0x62, 0x35, 0xda, 0x34, 0x70, 0xc9, 0x2a, 0xcc,
+	0x32, 0xee, 0x62, 0x4f, 0x47, 0xba, 0x64, 0x45, 0x03, 0xf2, 0x25, 0x2c, 0x30, 0x87, 0xda, 0xd4,
+	0x75, 0x03, 0x94, 0x32, 0x9e, 0x44, 0xf3, 0xcd, 0xcb, 0x9d, 0xd5, 0x38, 0xae, 0x87, 0x91, 0xa6,
+	0xa1, 0x02, 0xc6, 0x5b, 0x16, 0x30, 0x87, 0xc6, 0x92, 0xf2, 0x3f, 0x19, 0x58, 0x7c, 0xd4, 0x11,
+	0x4e, 0x7b, 0x9f, 0xba, 0xc8, 0x1d, 0x24, 0x77, 0x21, 0xe7, 0x62, 0x47, 0x48, 0xa6, 0x6c, 0xc6,
+	0x15, 0x06, 0xa7, 0xd4, 0xd3, 0x64, 0x59, 0x6b, 0x39, 0x96, 0xd7, 0x62, 0x31, 0xf9, 0x1c, 0xf2,
+	0x6e, 0x14, 0x1f, 0x0e, 0x6c, 0x2f, 0x68, 0xdb, 0x5c, 0xa2, 0x48, 0x1b, 0x07, 0xc8, 0xf8, 0x29,
+	0xca, 0x14, 0xf0, 0xc5, 0xc8, 0x38, 0x51, 0xf4, 0x8d, 0x1f, 0x80, 0x39, 0xb2, 0x34, 0x03, 0x9f,
+	0xac, 0xf6, 0x29, 0x0c, 0xcf, 0x72, 0xdf, 0x73, 0x07, 0x48, 0x58, 0x6f, 0x1e, 0xe5, 0x4e, 0xca,
+	0x67, 0x56, 0xfb, 0xe4, 0xfb, 0x9a, 0xc4, 0xbc, 0xfc, 0x7b, 0x01, 0x8c, 0x43, 0x21, 0xd5, 0x53,
+	0xc1, 0x91, 0xac, 0x83, 0xe1, 0xb4, 0x29, 0xe3, 0x36, 0x73, 0xa3, 0x4a, 0xb0, 0x2e, 0xe9, 0x71,
+	0xcd, 0x25, 0x65, 0x58, 0x6c, 0xa2, 0xd3, 0xbe, 0xb7, 0xdb, 0x09, 0xf0, 0x84, 0xf5, 0xcc, 0xbc,
+	0x56, 0x0f, 0xc9, 0xc8, 0x0d, 0x58, 0x72, 0x04, 0xe7, 0xe8, 0xe8, 0xa0, 0x99, 0x1b, 0xad, 0x83,
+	0xb5, 0x38, 0x10, 0xd6, 0x5c, 0x52, 0x81, 0x15, 0x15, 0x50, 0x2e, 0x4f, 0x30, 0xb0, 0x9d, 0x36,
+	0xe5, 0x1c, 0xbd, 0xd0, 0x74, 0x51, 0x9b, 0xe6, 0x13, 0xd5, 0x7e, 0xa4, 0xa9, 0xb9, 0xe4, 0x2a,
+	0xcc, 0xb3, 0xa6, 0x63, 0xbb, 0xc8, 0x85, 0x6f, 0x1a, 0xda, 0xca, 0x60, 0x4d, 0xe7, 0x20, 0x1c,
+	0x93, 0x4d, 0x00, 0x7d, 0x9c, 0x44, 0xda, 0x79, 0xad, 0x9d, 0x0f, 0x25, 0x91, 0xfa, 0x2e, 0xe4,
+	0xba, 0xbc, 0x29, 0xb8, 0xcb, 0x78, 0xcb, 0xee, 0x60, 0xc0, 0x84, 0x6b, 0x6e, 0x44, 0x4b, 0xd9,
+	0x97, 0x1f, 0x69, 0x31, 0xf9, 0x0a, 0xa0, 0x7f, 0x28, 0x48, 0xf3, 0xe2, 0xd6, 0x45, 0x5d, 0xaa,
+	0x23, 0x47, 0x53, 0xe5, 0xfb, 0xc4, 0xc4, 0x4a, 0x59, 0x93, 0x87, 0x90, 0x54, 0x46, 0xbf, 0x02,
+	0xc9, 0x84, 0x0a, 0xbc, 0x1c, 0x3b, 0xc4, 0x52, 0xf2, 0x04, 0x0a, 0xcf, 0x99, 0x6a, 0xbb, 0x01,
+	0x7d, 0x4e, 0x3d, 0x3b, 0x5d, 0xcb, 0x85, 0x09, 0x48, 0xab, 0x03, 0xbf, 0x5a, 0xbf, 0xaa, 0xc9,
+	0x37, 0xb0, 0x7c, 0x82, 0x38, 0x04, 0x74, 0x65, 0x02, 0xd0, 0xd2, 0x09, 0x62, 0x0a, 0xe1, 0x09,
+	0x14, 0xdc, 0xfe, 0xde, 0x1b, 0x02, 0x32, 0x27, 0x45, 0x34, 0xf0, 0x4b, 0xe1, 0x35, 0x20, 0x9f,
+	0xc2, 0x93, 0xe1, 0x66, 0x96, 0xe6, 0x5d, 0x3d, 0xcf, 0x5b, 0x63, 0xf3, 0x3c, 0xb2, 0xeb, 0xf7,
+	0xb2, 0xe1, 0xc1, 0xd0, 0xdf, 0x53, 0x89, 0x58, 0x07, 0x99, 0xda, 0x26, 0xe9, 0x20, 0xd7, 0x27,
+	0x05, 0x39, 0xf0, 0x4b, 0x05, 0xe9, 0x42, 0xd9, 0x49, 0xda, 0x80, 0xdd, 0x11, 0xc2, 0xb3, 0xfb,
+	0x47, 0x41, 0x0a, 0xbb, 0x38, 0x01, 0xbb, 0xe8, 0xa4, 0x5b, 0xc9, 0x41, 0x7c, 0x68, 0x0c, 0x58,
+	0x9a, 0x70, 0x7d, 0x84, 0x25, 0x40, 0xd5, 0x0d, 0x86, 0x13, 0x28, 0x4d, 0x20, 0xd9, 0x74, 0x86,
+	0xfb, 0x55, 0x08, 0x90, 0xe2, 0x68, 0xc3, 0xf6, 0x08, 0x87, 0x9e, 0x5c, 0xbb, 0x2d, 0x3c, 0xbd,
+	0x1b, 0x12, 0x9a, 0xad, 0x09, 0x34, 0x5b, 0x43, 0x34, 0xba, 0xc1, 0x1c, 0x46, 0x10, 0x09, 0xd3,
+	0x4f, 0x70, 0x73, 0x2c, 0x1b, 0x17, 0xd1, 0x1f, 0xa3, 0xba, 0x3e, 0x81, 0xea, 0xfa, 0x48, 0x46,
+	0x21, 0xc8, 0x08, 0x97, 0x0d, 0xa5, 0x11, 0x2e, 0x15, 0x20, 0x95, 0xdd, 0xe0, 0xac, 0xcf, 0x72,
+	0x63, 0x02, 0xcb, 0xb5, 0x21, 0x96, 0xe3, 0xd8, 0x3d, 0x21, 0xf8, 0x11, 0xf2, 0x4a, 0x28, 0xea,
+	0xd9, 0x83, 0x52, 0x93, 0xe6, 0xd2, 0x7f, 0xea, 0xc9, 0x39, 0x0d, 0x34, 0xa8, 0x64, 0x49, 0x38,
+	0xac, 0x7a, 0x54, 0x2a, 0x7b, 0xb4, 0xe9, 0xc2, 0x14, 0x9a, 0x2e, 0x09, 0x91, 0x87, 0xfb, 0xfc,
+	0x79, 0xfd, 0x7d, 0xe1, 0x7f, 0xe8, 0xef, 0x1e, 0xac, 0xf8, 0x8c, 0x8f, 0x65, 0xb5, 0x3a, 0x05,
+	0xaa, 0xbc, 0xcf, 0xb8, 0x35, 0xce, 0x46, 0x7b, 0x63, 0x6c, 0x6b, 0x53, 0x61, 0xa3, 0xbd, 0x11,
+	0xb6, 0xe7, 0xb0, 0x1e, 0xe6, 0xc6, 0x38, 0xc7, 0x60, 0x8c, 0xf3, 0xda, 0x14, 0x38, 0x0b, 0x3e,
+	0xe3, 0xb5, 0x10, 0xfd, 0x1c, 0x62, 0xda, 0xfb, 0x08, 0xf1, 0xe6, 0x54, 0x88, 0x69, 0xef, 0x3c,
+	0xe2, 0xdf, 0x32, 0xb0, 0x7d, 0xce, 0x04, 0xeb, 0x5e, 0xdd, 0xc2, 0xb0, 0x8b, 0xda, 0x18, 0x5e,
+	0x97, 0xcc, 0x5b, 0x53, 0x08, 0xa2, 0x34, 0x36, 0xe3, 0xfb, 0x9a, 0xe6, 0x08, 0x03, 0x7d, 0x27,
+	0x23, 0xbf, 0x66, 0xa0, 0x3c, 0x21, 0x1a, 0x97, 0x9e, 0x99, 0xb7, 0xa7, 0x10, 0xcb, 0xe6, 0xc7,
+	0x63, 0x39, 0xa0, 0x67, 0x04, 0xe1, 0xca, 0x68, 0x10, 0x6d, 0x26, 0x95, 0x08, 0xce, 0xcc, 0x3b,
+	0xba, 0x8b, 0xdd, 0x1e, 0xeb, 0x62, 0xe7, 0x5f, 0xbb, 0xe3, 0x66, 0xb6, 0x36, 0xbc, 0x8b, 0x0e,
+	0x23, 0x2c, 0xf2, 0x05, 0x5c, 0x09, 0xf3, 0xf5, 0x51, 0x4a, 0xda, 0x42, 0xa9, 0x33, 0x0c, 0xdb,
+	0x82, 0xea, 0x99, 0xdb, 0xfa, 0xe6, 0x12, 0x56, 0xff, 0xb7, 0xb1, 0xf6, 0x08, 0x83, 0x9a, 0x43,
+	0x8f, 0x7b, 0xa4, 0x0a, 0x2b, 0x03, 0x38, 0x69, 0x23, 0xa7, 0x4d, 0x0f, 0x5d, 0xf3, 0xe6, 0x56,
+	0xe6, 0x8e, 0x61, 0x91, 0x94, 0xea, 0x51, 0xa4, 0x21, 0x87, 0xb0, 0xa4, 0x57, 0xd1, 0x76, 0xa2,
+	0x5b, 0xaf, 0xf9, 0x99, 0xbe, 0x9c, 0x6f, 0x8e, 0xe5, 0x90, 0xbe, 0x1a, 0xc7, 0x91, 0x2f, 0x62,
+	0x4a, 0x46, 0x7e, 0x80, 0xb5, 0xb1, 0xe3, 0x3f, 0x7c, 0xee, 0x98, 0x65, 0x8d, 0xb8, 0x3d, 0x86,
+	0x78, 0xce, 0x3b, 0xcb, 0x5a, 0x71, 0xc6, 0x85, 0xe4, 0x3e, 0x98, 0x9e, 0xf4, 0xed, 0xf4, 0x7b,
+	0xa9, 0x9f, 0xd9, 0x55, 0x9d, 0xd9, 0x9a, 0x27, 0xfd, 0xc7, 0x83, 0x97, 0x4f, 0x92, 0x5c, 0x01,
+	0xe6, 0xda, 0xd4, 0x53, 0xe8, 0x9a, 0x2b, 0xda, 0x2c, 0x1e, 0xd5, 0xb3, 0x46, 0x36, 0x37, 0x5b,
+	0xcf, 0x1a, 0xb3, 0xb9, 0xb9, 0x7a, 0xd6, 0x98, 0xcb, 0x5d, 0xaa, 0x67, 0x8d, 0x4b, 0x39, 0xa3,
+	0x9e, 0x35, 0x2e, 0xe7, 0x96, 0xeb, 0x59, 0x63, 0x39, 0x97, 0xab, 0x67, 0x8d, 0x5c, 0x2e, 0xbf,
+	0xf7, 0xf8, 0xd5, 0xfb, 0x62, 0xe6, 0xf5, 0xfb, 0x62, 0xe6, 0xef, 0xf7, 0xc5, 0xcc, 0x8b, 0x0f,
+	0xc5, 0x99, 0xd7, 0x1f, 0x8a, 0x33, 0x6f, 0x3f, 0x14, 0x67, 0x9e, 0xee, 0xa6, 0x6a, 0xab, 0xa1,
+	0x33, 0xdb, 0x79, 0x4c, 0x9b, 0xb2, 0x1a, 0xbf, 0x30, 0x4f, 0x77, 0xef, 0x57, 0x7b, 0x83, 0x77,
+	0xa6, 0xae, 0xb5, 0xe6, 0x9c, 0x7e, 0xea, 0xdc, 0xfb, 0x77, 0x00, 0x37, 0x71, 0x49, 0x5a, 0x0b,
+	0x0f, 0x00, 0x00,
 }
 
 func (m *CommunityPoolRebate) Marshal() (dAtA []byte, err error) {
@@ -457,6 +716,130 @@ func (m *CommunityPoolRebate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RedemptionRateSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedemptionRateSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedemptionRateSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintHostZone(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.RedemptionRate.Size()
+		i -= size
+		if _, err := m.RedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationShard) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationShard) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationShard) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IcaAddress) > 0 {
+		i -= len(m.IcaAddress)
+		copy(dAtA[i:], m.IcaAddress)
+		i = encodeVarintHostZone(dAtA, i, uint64(len(m.IcaAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochCadence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochCadence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochCadence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RebalanceInterval != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.RebalanceInterval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RedemptionRateInterval != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.RedemptionRateInterval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReinvestInterval != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.ReinvestInterval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DelegateInterval != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.DelegateInterval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DepositInterval != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.DepositInterval))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *HostZone) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -477,6 +860,74 @@ func (m *HostZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.EpochCadence.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xd2
+	if len(m.DelegationShards) > 0 {
+		for iNdEx := len(m.DelegationShards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationShards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHostZone(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.RedemptionRateHistory) > 0 {
+		for iNdEx := len(m.RedemptionRateHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedemptionRateHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHostZone(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	{
+		size := m.MaxRedemptionRateChangePerDay.Size()
+		i -= size
+		if _, err := m.MaxRedemptionRateChangePerDay.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xba
+	{
+		size := m.MaxRedemptionRateChangePerEpoch.Size()
+		i -= size
+		if _, err := m.MaxRedemptionRateChangePerEpoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xb2
 	if m.RedemptionsEnabled {
 		i--
 		if m.RedemptionsEnabled {
@@ -794,14 +1245,67 @@ func (m *CommunityPoolRebate) Size() (n int) {
 	return n
 }
 
-func (m *HostZone) Size() (n int) {
+func (m *RedemptionRateSnapshot) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
+	l = m.RedemptionRate.Size()
+	n += 1 + l + sovHostZone(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovHostZone(uint64(l))
+	return n
+}
+
+func (m *DelegationShard) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovHostZone(uint64(m.Index))
+	}
+	l = len(m.IcaAddress)
+	if l > 0 {
+		n += 1 + l + sovHostZone(uint64(l))
+	}
+	return n
+}
+
+func (m *EpochCadence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DepositInterval != 0 {
+		n += 1 + sovHostZone(uint64(m.DepositInterval))
+	}
+	if m.DelegateInterval != 0 {
+		n += 1 + sovHostZone(uint64(m.DelegateInterval))
+	}
+	if m.ReinvestInterval != 0 {
+		n += 1 + sovHostZone(uint64(m.ReinvestInterval))
+	}
+	if m.RedemptionRateInterval != 0 {
+		n += 1 + sovHostZone(uint64(m.RedemptionRateInterval))
+	}
+	if m.RebalanceInterval != 0 {
+		n += 1 + sovHostZone(uint64(m.RebalanceInterval))
+	}
+	return n
+}
+
+func (m *HostZone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
 		n += 1 + l + sovHostZone(uint64(l))
 	}
 	l = len(m.ConnectionId)
@@ -903,16 +1407,370 @@ func (m *HostZone) Size() (n int) {
 	if m.RedemptionsEnabled {
 		n += 3
 	}
-	return n
-}
+	l = m.MaxRedemptionRateChangePerEpoch.Size()
+	n += 2 + l + sovHostZone(uint64(l))
+	l = m.MaxRedemptionRateChangePerDay.Size()
+	n += 2 + l + sovHostZone(uint64(l))
+	if len(m.RedemptionRateHistory) > 0 {
+		for _, e := range m.RedemptionRateHistory {
+			l = e.Size()
+			n += 2 + l + sovHostZone(uint64(l))
+		}
+	}
+	if len(m.DelegationShards) > 0 {
+		for _, e := range m.DelegationShards {
+			l = e.Size()
+			n += 2 + l + sovHostZone(uint64(l))
+		}
+	}
+	l = m.EpochCadence.Size()
+	n += 2 + l + sovHostZone(uint64(l))
+	return n
+}
+
+func sovHostZone(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHostZone(x uint64) (n int) {
+	return sovHostZone(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CommunityPoolRebate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHostZone
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolRebate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolRebate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebateRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RebateRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStakedStTokenAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidStakedStTokenAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHostZone(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedemptionRateSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHostZone
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedemptionRateSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedemptionRateSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHostZone(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationShard) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHostZone
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationShard: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationShard: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IcaAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHostZone(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovHostZone(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozHostZone(x uint64) (n int) {
-	return sovHostZone(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *CommunityPoolRebate) Unmarshal(dAtA []byte) error {
+func (m *EpochCadence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -935,17 +1793,17 @@ func (m *CommunityPoolRebate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommunityPoolRebate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochCadence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommunityPoolRebate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochCadence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RebateRate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositInterval", wireType)
 			}
-			var stringLen uint64
+			m.DepositInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHostZone
@@ -955,31 +1813,35 @@ func (m *CommunityPoolRebate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DepositInterval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthHostZone
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthHostZone
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateInterval", wireType)
 			}
-			if err := m.RebateRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DelegateInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegateInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStakedStTokenAmount", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReinvestInterval", wireType)
 			}
-			var stringLen uint64
+			m.ReinvestInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHostZone
@@ -989,26 +1851,49 @@ func (m *CommunityPoolRebate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ReinvestInterval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthHostZone
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRateInterval", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthHostZone
+			m.RedemptionRateInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedemptionRateInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalanceInterval", wireType)
 			}
-			if err := m.LiquidStakedStTokenAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RebalanceInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RebalanceInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHostZone(dAtA[iNdEx:])
@@ -1977,6 +2862,175 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.RedemptionsEnabled = bool(v != 0)
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedemptionRateChangePerEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRedemptionRateChangePerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedemptionRateChangePerDay", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRedemptionRateChangePerDay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRateHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedemptionRateHistory = append(m.RedemptionRateHistory, RedemptionRateSnapshot{})
+			if err := m.RedemptionRateHistory[len(m.RedemptionRateHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationShards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationShards = append(m.DelegationShards, DelegationShard{})
+			if err := m.DelegationShards[len(m.DelegationShards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochCadence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochCadence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHostZone(dAtA[iNdEx:])
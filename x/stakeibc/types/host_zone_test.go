@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"fmt"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
@@ -61,6 +62,140 @@ func TestHostZoneUnbondingFrequency(t *testing.T) {
 	}
 }
 
+func TestHostZoneUnbondingFrequency_DelegationShards(t *testing.T) {
+	testCases := []struct {
+		unbondingPeriod    uint64
+		numShards          int
+		unbondingFrequency uint64
+	}{
+		{
+			unbondingPeriod:    21,
+			numShards:          1,
+			unbondingFrequency: 4,
+		},
+		{
+			unbondingPeriod:    21,
+			numShards:          2,
+			unbondingFrequency: 2,
+		},
+		{
+			unbondingPeriod:    21,
+			numShards:          3,
+			unbondingFrequency: 2,
+		},
+		{
+			unbondingPeriod:    21,
+			numShards:          4,
+			unbondingFrequency: 1,
+		},
+		{
+			unbondingPeriod:    14,
+			numShards:          2,
+			unbondingFrequency: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		hostZone := types.HostZone{
+			UnbondingPeriod:      tc.unbondingPeriod,
+			DelegationIcaAddress: "delegation",
+		}
+		for i := 1; i < tc.numShards; i++ {
+			hostZone.DelegationShards = append(hostZone.DelegationShards, types.DelegationShard{
+				Index:      uint32(i),
+				IcaAddress: fmt.Sprintf("shard-%d", i),
+			})
+		}
+		require.Equal(t, tc.unbondingFrequency, hostZone.GetUnbondingFrequency(),
+			"unbonding frequency for period %d with %d shards", tc.unbondingPeriod, tc.numShards)
+
+		// Shards without an ICA should not count towards the frequency
+		hostZone.DelegationShards = append(hostZone.DelegationShards, types.DelegationShard{Index: uint32(tc.numShards)})
+		require.Equal(t, tc.unbondingFrequency, hostZone.GetUnbondingFrequency(),
+			"unbonding frequency for period %d with %d shards and an unregistered shard", tc.unbondingPeriod, tc.numShards)
+
+		// Confirm each shard unbonds at most 7 times per unbonding period
+		shardUnbondingCadence := tc.unbondingFrequency * uint64(tc.numShards)
+		require.Greater(t, shardUnbondingCadence*types.MaxUnbondingEntries, tc.unbondingPeriod,
+			"shard unbonding cadence for period %d with %d shards", tc.unbondingPeriod, tc.numShards)
+	}
+}
+
+func TestGetUnbondingShardIndex(t *testing.T) {
+	// Unbonding period of 21 with 3 shards means an unbonding every other day
+	hostZone := types.HostZone{
+		UnbondingPeriod:      21,
+		DelegationIcaAddress: "delegation",
+		DelegationShards: []types.DelegationShard{
+			{Index: 1, IcaAddress: "shard-1"},
+			{Index: 2, IcaAddress: "shard-2"},
+		},
+	}
+
+	expectedShardsByDay := map[uint64]uint32{
+		0:  0,
+		2:  1,
+		4:  2,
+		6:  0,
+		8:  1,
+		10: 2,
+	}
+	for dayNumber, expectedShard := range expectedShardsByDay {
+		require.Equal(t, expectedShard, hostZone.GetUnbondingShardIndex(dayNumber), "shard for day %d", dayNumber)
+	}
+
+	// Shards without an ICA should be skipped
+	// With 2 registered shards, the frequency is every 2nd day
+	hostZone.DelegationShards = []types.DelegationShard{
+		{Index: 1},
+		{Index: 2, IcaAddress: "shard-2"},
+	}
+	expectedRegisteredShardsByDay := map[uint64]uint32{
+		0: 0,
+		2: 2,
+		4: 0,
+		6: 2,
+	}
+	for dayNumber, expectedShard := range expectedRegisteredShardsByDay {
+		require.Equal(t, expectedShard, hostZone.GetUnbondingShardIndex(dayNumber),
+			"shard for day %d with an unregistered shard", dayNumber)
+	}
+
+	// With only the main delegation ICA, shard 0 should always be returned
+	hostZone.DelegationShards = []types.DelegationShard{}
+	for dayNumber := range expectedShardsByDay {
+		require.Zero(t, hostZone.GetUnbondingShardIndex(dayNumber), "shard for day %d without shards", dayNumber)
+	}
+}
+
+func TestGetDelegationShardAddress(t *testing.T) {
+	hostZone := types.HostZone{
+		DelegationIcaAddress: "delegation",
+		DelegationShards: []types.DelegationShard{
+			{Index: 1, IcaAddress: "shard-1"},
+			{Index: 2},
+		},
+	}
+
+	address, found := hostZone.GetDelegationShardAddress(0)
+	require.True(t, found, "shard 0 should be found")
+	require.Equal(t, "delegation", address, "shard 0 address")
+
+	address, found = hostZone.GetDelegationShardAddress(1)
+	require.True(t, found, "shard 1 should be found")
+	require.Equal(t, "shard-1", address, "shard 1 address")
+
+	address, found = hostZone.GetDelegationShardAddress(2)
+	require.True(t, found, "shard 2 should be found")
+	require.Empty(t, address, "shard 2 address should not be set yet")
+
+	_, found = hostZone.GetDelegationShardAddress(3)
+	require.False(t, found, "shard 3 should not be found")
+
+	require.Equal(t, []uint32{0, 1, 2}, hostZone.GetDelegationShardIndexes(), "shard indexes")
+	require.Equal(t, uint64(3), hostZone.GetNumDelegationShards(), "number of shards")
+}
+
 func TestSafelyGetCommunityPoolRebate(t *testing.T) {
 	chainId := "chain-0"
 
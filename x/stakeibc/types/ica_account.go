@@ -1,12 +1,44 @@
 package types
 
-import fmt "fmt"
+import (
+	fmt "fmt"
+	"strconv"
+	"strings"
+)
 
 // Helper function to build the host zone ICA owner in the form "{chainId}.{ICA_TYPE}"
 func FormatHostZoneICAOwner(chainId string, accountType ICAAccountType) (result string) {
 	return chainId + "." + accountType.String()
 }
 
+// Helper function to build the ICA owner for a delegation shard
+// Shard 0 is the main delegation ICA, in the form "{chainId}.DELEGATION"
+// Additional shards are in the form "{chainId}.DELEGATION.{shardIndex}"
+func FormatDelegationShardICAOwner(chainId string, shardIndex uint32) string {
+	if shardIndex == 0 {
+		return FormatHostZoneICAOwner(chainId, ICAAccountType_DELEGATION)
+	}
+	return fmt.Sprintf("%s.%s.%d", chainId, ICAAccountType_DELEGATION.String(), shardIndex)
+}
+
+// Helper function to determine if an ICA owner belongs to one of the host zone's
+// delegation shards, and if so, returns the shard index
+func ParseDelegationShardICAOwner(chainId string, owner string) (shardIndex uint32, isDelegationShard bool) {
+	if owner == FormatHostZoneICAOwner(chainId, ICAAccountType_DELEGATION) {
+		return 0, true
+	}
+
+	shardPrefix := FormatHostZoneICAOwner(chainId, ICAAccountType_DELEGATION) + "."
+	if !strings.HasPrefix(owner, shardPrefix) {
+		return 0, false
+	}
+	index, err := strconv.ParseUint(strings.TrimPrefix(owner, shardPrefix), 10, 32)
+	if err != nil || index == 0 {
+		return 0, false
+	}
+	return uint32(index), true
+}
+
 // Helper function to build the ICA owner for a trade route ICA
 // in the form "{chainId}.{rewardDenom}-{hostDenom}.{ICA_TYPE}"
 func FormatTradeRouteICAOwner(chainId, rewardDenom, hostDenom string, icaAccountType ICAAccountType) string {
@@ -79,3 +79,38 @@ func TestFormatTradeRouteICAOwner(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatDelegationShardICAOwner(t *testing.T) {
+	chainId := "chain-0"
+
+	require.Equal(t, "chain-0.DELEGATION", types.FormatDelegationShardICAOwner(chainId, 0), "shard 0")
+	require.Equal(t, "chain-0.DELEGATION.1", types.FormatDelegationShardICAOwner(chainId, 1), "shard 1")
+	require.Equal(t, "chain-0.DELEGATION.12", types.FormatDelegationShardICAOwner(chainId, 12), "shard 12")
+}
+
+func TestParseDelegationShardICAOwner(t *testing.T) {
+	chainId := "chain-0"
+
+	testCases := []struct {
+		owner              string
+		expectedShardIndex uint32
+		expectedIsShard    bool
+	}{
+		{owner: "chain-0.DELEGATION", expectedShardIndex: 0, expectedIsShard: true},
+		{owner: "chain-0.DELEGATION.1", expectedShardIndex: 1, expectedIsShard: true},
+		{owner: "chain-0.DELEGATION.12", expectedShardIndex: 12, expectedIsShard: true},
+		{owner: "chain-0.DELEGATION.0", expectedIsShard: false},
+		{owner: "chain-0.DELEGATION.X", expectedIsShard: false},
+		{owner: "chain-0.WITHDRAWAL", expectedIsShard: false},
+		{owner: "chain-1.DELEGATION", expectedIsShard: false},
+		{owner: "chain-1.DELEGATION.1", expectedIsShard: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.owner, func(t *testing.T) {
+			shardIndex, isShard := types.ParseDelegationShardICAOwner(chainId, tc.owner)
+			require.Equal(t, tc.expectedIsShard, isShard, "is delegation shard")
+			require.Equal(t, tc.expectedShardIndex, shardIndex, "shard index")
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package types
 
+import "encoding/binary"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "stakeibc"
@@ -47,6 +49,20 @@ func EpochTrackerKey(epochIdentifier string) []byte {
 	return key
 }
 
+// TradeRecordKey returns the store key to retrieve a TradeRecord from its id
+func TradeRecordKey(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// PendingSwapKey returns the store key of the pending swap on a trade route leg
+func PendingSwapKey(routeId string, legIndex uint32) []byte {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, legIndex)
+	return append([]byte(routeId+"/"), bz...)
+}
+
 // Definition for the store key format based on tradeRoute start and end denoms
 func TradeRouteKeyFromDenoms(rewardDenom, hostDenom string) (key []byte) {
 	return []byte(rewardDenom + "-" + hostDenom)
@@ -61,4 +77,13 @@ const (
 
 	// TradeRoute keys prefix to retrieve all TradeZones
 	TradeRouteKeyPrefix = "TradeRoute-value-"
+
+	// TradeRecord keys prefix the audit records of each swap along a trade route
+	TradeRecordKeyPrefix = "TradeRecord-value-"
+
+	// TradeRecordCountKey stores the number of trade records, used to assign record ids
+	TradeRecordCountKey = "TradeRecord-count-"
+
+	// PendingSwap keys map each trade route leg to the trade record of its in-flight swap
+	PendingSwapKeyPrefix = "PendingSwap-value-"
 )
@@ -12,11 +12,12 @@ const TypeMsgCalibrateDelegation = "calibrate_delegation"
 
 var _ sdk.Msg = &MsgCalibrateDelegation{}
 
-func NewMsgCalibrateDelegation(creator string, chainid string, valoper string) *MsgCalibrateDelegation {
+func NewMsgCalibrateDelegation(creator string, chainid string, valoper string, delegationShardIndex uint32) *MsgCalibrateDelegation {
 	return &MsgCalibrateDelegation{
-		Creator: creator,
-		ChainId: chainid,
-		Valoper: valoper,
+		Creator:              creator,
+		ChainId:              chainid,
+		Valoper:              valoper,
+		DelegationShardIndex: delegationShardIndex,
 	}
 }
 
@@ -90,6 +90,12 @@ func (msg *MsgCreateTradeRoute) ValidateBasic() error {
 		return errors.New("min transfer amount must be greater than or equal to zero")
 	}
 
+	for i, leg := range msg.AdditionalLegs {
+		if err := leg.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid additional leg %d", i)
+		}
+	}
+
 	return nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
@@ -119,6 +120,46 @@ func TestMsgCreateTradeRoute(t *testing.T) {
 	invalidMessage = validMessage
 	invalidMessage.MinTransferAmount = sdkmath.OneInt().Neg()
 	require.ErrorContains(t, invalidMessage.ValidateBasic(), "min transfer amount must be greater than or equal to zero")
+
+	// Add a valid additional leg - confirm valid
+	validLeg := types.TradeLegConfig{
+		StrideToTradeConnectionId: validConnectionId1,
+		InboundTransferChannelId:  validTransferChannelId1,
+		InputDenomOnTrade:         validIBCDenom,
+		OutputDenomOnTrade:        validIBCDenom,
+		SwapConfig: &types.SwapConfig{
+			PoolId:              1,
+			RewardDenomOnStride: validIBCDenom,
+			HostDenomOnStride:   validIBCDenom,
+			MaxSlippage:         sdk.MustNewDecFromStr("0.05"),
+			MaxSwapAmount:       sdkmath.ZeroInt(),
+		},
+	}
+	validMessageWithLeg := validMessage
+	validMessageWithLeg.AdditionalLegs = []types.TradeLegConfig{validLeg}
+	require.NoError(t, validMessageWithLeg.ValidateBasic(), "valid message with additional leg")
+
+	// Set invalid additional legs - confirm invalid
+	invalidLeg := validLeg
+	invalidLeg.StrideToTradeConnectionId = "connection-X"
+	invalidMessage = validMessage
+	invalidMessage.AdditionalLegs = []types.TradeLegConfig{invalidLeg}
+	require.ErrorContains(t, invalidMessage.ValidateBasic(), "invalid trade leg connection-id")
+
+	invalidLeg = validLeg
+	invalidLeg.InboundTransferChannelId = "channel-"
+	invalidMessage.AdditionalLegs = []types.TradeLegConfig{invalidLeg}
+	require.ErrorContains(t, invalidMessage.ValidateBasic(), "invalid trade leg inbound channel-id")
+
+	invalidLeg = validLeg
+	invalidLeg.OutputDenomOnTrade = "not-ibc-denom"
+	invalidMessage.AdditionalLegs = []types.TradeLegConfig{invalidLeg}
+	require.ErrorContains(t, invalidMessage.ValidateBasic(), "invalid trade leg output denom")
+
+	invalidLeg = validLeg
+	invalidLeg.SwapConfig = nil
+	invalidMessage.AdditionalLegs = []types.TradeLegConfig{invalidLeg}
+	require.ErrorContains(t, invalidMessage.ValidateBasic(), "missing trade leg swap config")
 }
 
 func TestValidateConnectionId(t *testing.T) {
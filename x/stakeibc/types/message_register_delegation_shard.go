@@ -0,0 +1,55 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/v27/utils"
+)
+
+const TypeMsgRegisterDelegationShard = "register_delegation_shard"
+
+var _ sdk.Msg = &MsgRegisterDelegationShard{}
+
+func NewMsgRegisterDelegationShard(creator string, chainId string) *MsgRegisterDelegationShard {
+	return &MsgRegisterDelegationShard{
+		Creator: creator,
+		ChainId: chainId,
+	}
+}
+
+func (msg *MsgRegisterDelegationShard) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRegisterDelegationShard) Type() string {
+	return TypeMsgRegisterDelegationShard
+}
+
+func (msg *MsgRegisterDelegationShard) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRegisterDelegationShard) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRegisterDelegationShard) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := utils.ValidateAdminAddress(msg.Creator); err != nil {
+		return err
+	}
+	if msg.ChainId == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "chain ID must be specified")
+	}
+	return nil
+}
@@ -40,5 +40,11 @@ func (msg *MsgUpdateHostZoneParams) ValidateBasic() error {
 	if msg.ChainId == "" {
 		return errors.New("chain ID must be specified")
 	}
+	if msg.MaxRedemptionRateChangePerEpoch != nil && msg.MaxRedemptionRateChangePerEpoch.IsNegative() {
+		return errors.New("max redemption rate change per epoch cannot be negative")
+	}
+	if msg.MaxRedemptionRateChangePerDay != nil && msg.MaxRedemptionRateChangePerDay.IsNegative() {
+		return errors.New("max redemption rate change per day cannot be negative")
+	}
 	return nil
 }
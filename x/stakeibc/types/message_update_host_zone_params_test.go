@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
@@ -11,6 +12,11 @@ import (
 	"github.com/Stride-Labs/stride/v27/x/stakeibc/types"
 )
 
+func newDecPointer(value string) *sdk.Dec {
+	dec := sdk.MustNewDecFromStr(value)
+	return &dec
+}
+
 func TestMsgUpdateHostZoneParams(t *testing.T) {
 	apptesting.SetupConfig()
 
@@ -48,6 +54,34 @@ func TestMsgUpdateHostZoneParams(t *testing.T) {
 			},
 			err: "chain ID must be specified",
 		},
+		{
+			name: "successful message with velocity limits",
+			msg: types.MsgUpdateHostZoneParams{
+				Authority:                       authority,
+				ChainId:                         validChainId,
+				MaxMessagesPerIcaTx:             30,
+				MaxRedemptionRateChangePerEpoch: newDecPointer("0.01"),
+				MaxRedemptionRateChangePerDay:   newDecPointer("0.02"),
+			},
+		},
+		{
+			name: "negative max change per epoch",
+			msg: types.MsgUpdateHostZoneParams{
+				Authority:                       authority,
+				ChainId:                         validChainId,
+				MaxRedemptionRateChangePerEpoch: newDecPointer("-0.01"),
+			},
+			err: "max redemption rate change per epoch cannot be negative",
+		},
+		{
+			name: "negative max change per day",
+			msg: types.MsgUpdateHostZoneParams{
+				Authority:                     authority,
+				ChainId:                       validChainId,
+				MaxRedemptionRateChangePerDay: newDecPointer("-0.01"),
+			},
+			err: "max redemption rate change per day cannot be negative",
+		},
 	}
 
 	for _, test := range tests {
@@ -51,5 +51,16 @@ func (msg *MsgUpdateTradeRoute) ValidateBasic() error {
 		return errors.New("min transfer amount must be greater than or equal to zero")
 	}
 
+	if msg.SwapConfig != nil {
+		if err := msg.SwapConfig.Validate(); err != nil {
+			return err
+		}
+	}
+	for i, swapConfig := range msg.AdditionalLegSwapConfigs {
+		if err := swapConfig.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid swap config for additional leg %d", i)
+		}
+	}
+
 	return nil
 }
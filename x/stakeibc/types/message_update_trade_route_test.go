@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
@@ -19,6 +20,13 @@ func TestMsgUpdateTradeRoute(t *testing.T) {
 
 	validDenom := "denom"
 	validMinTransferAmount := sdkmath.NewInt(100)
+	validSwapConfig := types.SwapConfig{
+		PoolId:              1,
+		RewardDenomOnStride: "ibc/reward",
+		HostDenomOnStride:   "ibc/host",
+		MaxSlippage:         sdk.MustNewDecFromStr("0.05"),
+		MaxSwapAmount:       sdkmath.ZeroInt(),
+	}
 
 	tests := []struct {
 		name string
@@ -83,6 +91,155 @@ func TestMsgUpdateTradeRoute(t *testing.T) {
 			},
 			err: "min transfer amount must be greater than or equal to zero",
 		},
+		{
+			name: "successful message with swap config",
+			msg: types.MsgUpdateTradeRoute{
+				Authority:         authority,
+				HostDenom:         validDenom,
+				RewardDenom:       validDenom,
+				MinTransferAmount: validMinTransferAmount,
+				SwapConfig:        &validSwapConfig,
+			},
+		},
+		{
+			name: "invalid swap config - missing pool id",
+			msg: types.MsgUpdateTradeRoute{
+				Authority:         authority,
+				HostDenom:         validDenom,
+				RewardDenom:       validDenom,
+				MinTransferAmount: validMinTransferAmount,
+				SwapConfig: &types.SwapConfig{
+					RewardDenomOnStride: validSwapConfig.RewardDenomOnStride,
+					HostDenomOnStride:   validSwapConfig.HostDenomOnStride,
+					MaxSlippage:         validSwapConfig.MaxSlippage,
+					MaxSwapAmount:       validSwapConfig.MaxSwapAmount,
+				},
+			},
+			err: "pool id must be specified",
+		},
+		{
+			name: "invalid swap config - missing reward denom",
+			msg: types.MsgUpdateTradeRoute{
+				Authority:         authority,
+				HostDenom:         validDenom,
+				RewardDenom:       validDenom,
+				MinTransferAmount: validMinTransferAmount,
+				SwapConfig: &types.SwapConfig{
+					PoolId:            validSwapConfig.PoolId,
+					HostDenomOnStride: validSwapConfig.HostDenomOnStride,
+					MaxSlippage:       validSwapConfig.MaxSlippage,
+					MaxSwapAmount:     validSwapConfig.MaxSwapAmount,
+				},
+			},
+			err: "missing reward denom on stride",
+		},
+		{
+			name: "invalid swap config - missing host denom",
+			msg: types.MsgUpdateTradeRoute{
+				Authority:         authority,
+				HostDenom:         validDenom,
+				RewardDenom:       validDenom,
+				MinTransferAmount: validMinTransferAmount,
+				SwapConfig: &types.SwapConfig{
+					PoolId:              validSwapConfig.PoolId,
+					RewardDenomOnStride: validSwapConfig.RewardDenomOnStride,
+					MaxSlippage:         validSwapConfig.MaxSlippage,
+					MaxSwapAmount:       validSwapConfig.MaxSwapAmount,
+				},
+			},
+			err: "missing host denom on stride",
+		},
+		{
+			name: "invalid swap config - slippage of one",
+			msg: types.MsgUpdateTradeRoute{
+				Authority:         authority,
+				HostDenom:         validDenom,
+				RewardDenom:       validDenom,
+				MinTransferAmount: validMinTransferAmount,
+				SwapConfig: &types.SwapConfig{
+					PoolId:              validSwapConfig.PoolId,
+					RewardDenomOnStride: validSwapConfig.RewardDenomOnStride,
+					HostDenomOnStride:   validSwapConfig.HostDenomOnStride,
+					MaxSlippage:         sdk.OneDec(),
+					MaxSwapAmount:       validSwapConfig.MaxSwapAmount,
+				},
+			},
+			err: "max slippage must be between 0 and 1",
+		},
+		{
+			name: "invalid swap config - negative max swap amount",
+			msg: types.MsgUpdateTradeRoute{
+				Authority:         authority,
+				HostDenom:         validDenom,
+				RewardDenom:       validDenom,
+				MinTransferAmount: validMinTransferAmount,
+				SwapConfig: &types.SwapConfig{
+					PoolId:              validSwapConfig.PoolId,
+					RewardDenomOnStride: validSwapConfig.RewardDenomOnStride,
+					HostDenomOnStride:   validSwapConfig.HostDenomOnStride,
+					MaxSlippage:         validSwapConfig.MaxSlippage,
+					MaxSwapAmount:       sdkmath.OneInt().Neg(),
+				},
+			},
+			err: "max swap amount must be greater than or equal to zero",
+		},
+		{
+			name: "successful message with multi-hop swap config",
+			msg: types.MsgUpdateTradeRoute{
+				Authority:         authority,
+				HostDenom:         validDenom,
+				RewardDenom:       validDenom,
+				MinTransferAmount: validMinTransferAmount,
+				SwapConfig: &types.SwapConfig{
+					RewardDenomOnStride: validSwapConfig.RewardDenomOnStride,
+					HostDenomOnStride:   validSwapConfig.HostDenomOnStride,
+					MaxSlippage:         validSwapConfig.MaxSlippage,
+					MaxSwapAmount:       validSwapConfig.MaxSwapAmount,
+					Hops: []types.SwapHop{
+						{PoolId: 1, TokenOutDenom: "ibc/intermediate"},
+						{PoolId: 2, TokenOutDenom: "ibc/host"},
+					},
+				},
+			},
+		},
+		{
+			name: "invalid swap config - hop missing pool id",
+			msg: types.MsgUpdateTradeRoute{
+				Authority:         authority,
+				HostDenom:         validDenom,
+				RewardDenom:       validDenom,
+				MinTransferAmount: validMinTransferAmount,
+				SwapConfig: &types.SwapConfig{
+					RewardDenomOnStride: validSwapConfig.RewardDenomOnStride,
+					HostDenomOnStride:   validSwapConfig.HostDenomOnStride,
+					MaxSlippage:         validSwapConfig.MaxSlippage,
+					MaxSwapAmount:       validSwapConfig.MaxSwapAmount,
+					Hops:                []types.SwapHop{{PoolId: 0, TokenOutDenom: "ibc/host"}},
+				},
+			},
+			err: "pool id must be specified for hop 0",
+		},
+		{
+			name: "successful message with additional leg swap configs",
+			msg: types.MsgUpdateTradeRoute{
+				Authority:                authority,
+				HostDenom:                validDenom,
+				RewardDenom:              validDenom,
+				MinTransferAmount:        validMinTransferAmount,
+				AdditionalLegSwapConfigs: []types.SwapConfig{validSwapConfig},
+			},
+		},
+		{
+			name: "invalid additional leg swap config",
+			msg: types.MsgUpdateTradeRoute{
+				Authority:                authority,
+				HostDenom:                validDenom,
+				RewardDenom:              validDenom,
+				MinTransferAmount:        validMinTransferAmount,
+				AdditionalLegSwapConfigs: []types.SwapConfig{{}},
+			},
+			err: "invalid swap config for additional leg 0",
+		},
 	}
 
 	for _, test := range tests {
@@ -1011,6 +1011,104 @@ func (m *QueryAllTradeRoutesResponse) GetTradeRoutes() []TradeRoute {
 	return nil
 }
 
+type QueryTradeRecordsRequest struct {
+	// Optional reward denom of the route in it's native form (e.g. usdc)
+	RewardDenom string `protobuf:"bytes,1,opt,name=reward_denom,json=rewardDenom,proto3" json:"reward_denom,omitempty"`
+	// Optional host denom of the route in it's native form (e.g. dydx)
+	HostDenom string `protobuf:"bytes,2,opt,name=host_denom,json=hostDenom,proto3" json:"host_denom,omitempty"`
+}
+
+func (m *QueryTradeRecordsRequest) Reset()         { *m = QueryTradeRecordsRequest{} }
+func (m *QueryTradeRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTradeRecordsRequest) ProtoMessage()    {}
+func (*QueryTradeRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{22}
+}
+func (m *QueryTradeRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTradeRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTradeRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTradeRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTradeRecordsRequest.Merge(m, src)
+}
+func (m *QueryTradeRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTradeRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTradeRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTradeRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryTradeRecordsRequest) GetRewardDenom() string {
+	if m != nil {
+		return m.RewardDenom
+	}
+	return ""
+}
+
+func (m *QueryTradeRecordsRequest) GetHostDenom() string {
+	if m != nil {
+		return m.HostDenom
+	}
+	return ""
+}
+
+type QueryTradeRecordsResponse struct {
+	TradeRecords []TradeRecord `protobuf:"bytes,1,rep,name=trade_records,json=tradeRecords,proto3" json:"trade_records"`
+}
+
+func (m *QueryTradeRecordsResponse) Reset()         { *m = QueryTradeRecordsResponse{} }
+func (m *QueryTradeRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTradeRecordsResponse) ProtoMessage()    {}
+func (*QueryTradeRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{23}
+}
+func (m *QueryTradeRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTradeRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTradeRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTradeRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTradeRecordsResponse.Merge(m, src)
+}
+func (m *QueryTradeRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTradeRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTradeRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTradeRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryTradeRecordsResponse) GetTradeRecords() []TradeRecord {
+	if m != nil {
+		return m.TradeRecords
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryInterchainAccountFromAddressRequest)(nil), "stride.stakeibc.QueryInterchainAccountFromAddressRequest")
 	proto.RegisterType((*QueryInterchainAccountFromAddressResponse)(nil), "stride.stakeibc.QueryInterchainAccountFromAddressResponse")
@@ -1034,90 +1132,97 @@ func init() {
 	proto.RegisterType((*QueryAddressUnbondingsResponse)(nil), "stride.stakeibc.QueryAddressUnbondingsResponse")
 	proto.RegisterType((*QueryAllTradeRoutes)(nil), "stride.stakeibc.QueryAllTradeRoutes")
 	proto.RegisterType((*QueryAllTradeRoutesResponse)(nil), "stride.stakeibc.QueryAllTradeRoutesResponse")
+	proto.RegisterType((*QueryTradeRecordsRequest)(nil), "stride.stakeibc.QueryTradeRecordsRequest")
+	proto.RegisterType((*QueryTradeRecordsResponse)(nil), "stride.stakeibc.QueryTradeRecordsResponse")
 }
 
 func init() { proto.RegisterFile("stride/stakeibc/query.proto", fileDescriptor_494b786fe66f2b80) }
 
 var fileDescriptor_494b786fe66f2b80 = []byte{
-	// 1238 bytes of a gzipped FileDescriptorProto
+	// 1327 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x97, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0xc7, 0xb3, 0x6d, 0x9a, 0xa6, 0x27, 0x69, 0x43, 0x87, 0x40, 0x9c, 0x4d, 0xe2, 0x90, 0x69,
-	0x68, 0x3e, 0x9a, 0x7a, 0x89, 0x53, 0x40, 0x8d, 0xa8, 0xc0, 0x11, 0x6d, 0x63, 0x14, 0x50, 0x70,
-	0x4b, 0x85, 0xca, 0x85, 0x35, 0xde, 0x1d, 0xec, 0x55, 0xd7, 0x3b, 0xee, 0xee, 0x38, 0x24, 0x44,
-	0x56, 0x25, 0x1e, 0x00, 0x55, 0x20, 0x84, 0xc4, 0x5d, 0x11, 0x17, 0x5c, 0xf3, 0x14, 0xbd, 0xa3,
-	0x12, 0x37, 0x5c, 0x45, 0x28, 0xe1, 0x09, 0xfa, 0x04, 0x68, 0x67, 0x67, 0xd7, 0xeb, 0xfd, 0x30,
-	0x4e, 0xef, 0x76, 0x67, 0xce, 0xc7, 0x6f, 0xcf, 0x99, 0x39, 0x7f, 0x1b, 0x66, 0x5c, 0xee, 0x98,
-	0x06, 0xd5, 0x5c, 0x4e, 0x1e, 0x51, 0xb3, 0xa6, 0x6b, 0x8f, 0xdb, 0xd4, 0x39, 0x28, 0xb4, 0x1c,
-	0xc6, 0x19, 0x9a, 0xf0, 0x37, 0x0b, 0xc1, 0xa6, 0xba, 0xaa, 0x33, 0xb7, 0xc9, 0x5c, 0xad, 0x46,
-	0x5c, 0xea, 0x5b, 0x6a, 0x7b, 0xeb, 0x35, 0xca, 0xc9, 0xba, 0xd6, 0x22, 0x75, 0xd3, 0x26, 0xdc,
-	0x64, 0xb6, 0xef, 0xac, 0x4e, 0xd6, 0x59, 0x9d, 0x89, 0x47, 0xcd, 0x7b, 0x92, 0xab, 0xb3, 0x75,
-	0xc6, 0xea, 0x16, 0xd5, 0x48, 0xcb, 0xd4, 0x88, 0x6d, 0x33, 0x2e, 0x5c, 0x5c, 0xb9, 0xbb, 0x14,
-	0xa7, 0x21, 0x86, 0xe1, 0x50, 0xd7, 0xad, 0xb6, 0xed, 0x1a, 0xb3, 0x0d, 0xd3, 0xae, 0x4b, 0xc3,
-	0x2b, 0x71, 0x43, 0xda, 0x62, 0x7a, 0xa3, 0xca, 0x1d, 0xa2, 0x3f, 0xa2, 0x8e, 0x34, 0x9a, 0x8f,
-	0x1b, 0x35, 0x98, 0xcb, 0xab, 0xdf, 0x32, 0x9b, 0x06, 0x30, 0x71, 0x83, 0x16, 0x71, 0x48, 0x33,
-	0x80, 0x59, 0x88, 0xef, 0x72, 0x87, 0x18, 0xb4, 0xea, 0xb0, 0x36, 0xa7, 0x59, 0x19, 0xf6, 0x88,
-	0x65, 0x1a, 0x84, 0x33, 0x89, 0x80, 0x9f, 0xc0, 0xf2, 0xe7, 0x5e, 0x99, 0xca, 0x36, 0xa7, 0x8e,
-	0xde, 0x20, 0xa6, 0x5d, 0xd2, 0x75, 0xd6, 0xb6, 0xf9, 0x1d, 0x87, 0x35, 0x4b, 0xfe, 0xc7, 0x55,
-	0xe8, 0xe3, 0x36, 0x75, 0x39, 0x9a, 0x84, 0x73, 0xec, 0x1b, 0x9b, 0x3a, 0x39, 0xe5, 0x2d, 0x65,
-	0xf9, 0x42, 0xc5, 0x7f, 0x41, 0xb7, 0xe0, 0xa2, 0xce, 0x6c, 0x9b, 0xea, 0x5e, 0x9d, 0xaa, 0xa6,
-	0x91, 0x3b, 0xe3, 0xed, 0x6e, 0xe5, 0x5e, 0x1e, 0xcd, 0x4f, 0x1e, 0x90, 0xa6, 0xb5, 0x89, 0x7b,
-	0xb6, 0x71, 0x65, 0xbc, 0xfb, 0x5e, 0x36, 0xf0, 0x53, 0x05, 0x56, 0x06, 0x20, 0x70, 0x5b, 0xcc,
-	0x76, 0x29, 0xd2, 0x41, 0x35, 0x43, 0xbb, 0x2a, 0xf1, 0x0d, 0xab, 0xb2, 0x09, 0x3e, 0xd7, 0xd6,
-	0xdb, 0x2f, 0x8f, 0xe6, 0x17, 0xfc, 0xcc, 0xd9, 0xb6, 0xb8, 0x92, 0x33, 0xe3, 0x09, 0x65, 0x32,
-	0x3c, 0x09, 0x48, 0x10, 0xed, 0x8a, 0x62, 0xcb, 0xaf, 0xc7, 0x3b, 0xf0, 0x7a, 0xcf, 0xaa, 0x24,
-	0x7a, 0x17, 0x46, 0xfc, 0xa6, 0x88, 0xec, 0x63, 0xc5, 0xa9, 0x42, 0xec, 0x4c, 0x16, 0x7c, 0x87,
-	0xad, 0xe1, 0xe7, 0x47, 0xf3, 0x43, 0x15, 0x69, 0x8c, 0xdf, 0x83, 0x69, 0x11, 0xed, 0x2e, 0xe5,
-	0x0f, 0x82, 0x96, 0x84, 0x85, 0x9e, 0x86, 0x51, 0x1f, 0xda, 0x34, 0x64, 0xad, 0xcf, 0x8b, 0xf7,
-	0xb2, 0x81, 0xbf, 0x04, 0x35, 0xcd, 0x4f, 0xc2, 0x6c, 0x02, 0x84, 0x0d, 0xf6, 0x80, 0xce, 0x2e,
-	0x8f, 0x15, 0xd5, 0x04, 0x50, 0xe8, 0x58, 0x89, 0x58, 0xe3, 0x1b, 0x30, 0x15, 0x44, 0xde, 0x66,
-	0x2e, 0x7f, 0xc8, 0x6c, 0x3a, 0x10, 0x4f, 0x2e, 0xe9, 0x25, 0x69, 0x3e, 0x80, 0x0b, 0xe1, 0x81,
-	0x96, 0xd5, 0x99, 0x4e, 0xc0, 0x04, 0x5e, 0xb2, 0x3e, 0xa3, 0x0d, 0xf9, 0x8e, 0x89, 0xe4, 0x29,
-	0x59, 0x56, 0x9c, 0xe7, 0x0e, 0x40, 0xf7, 0x36, 0xcb, 0xc8, 0x57, 0x0b, 0xfe, 0xd5, 0x2f, 0x78,
-	0x57, 0xbf, 0xe0, 0x0f, 0x09, 0x79, 0xf5, 0x0b, 0xbb, 0xa4, 0x1e, 0xf8, 0x56, 0x22, 0x9e, 0xf8,
-	0x99, 0x22, 0xe9, 0x7b, 0x72, 0xa4, 0xd3, 0x9f, 0x3d, 0x15, 0x3d, 0xba, 0xdb, 0x83, 0x78, 0x46,
-	0x20, 0x2e, 0xfd, 0x2f, 0xa2, 0x9f, 0xba, 0x87, 0x51, 0x93, 0x07, 0xe5, 0x53, 0x66, 0xb4, 0x2d,
-	0x1a, 0xbb, 0x91, 0x08, 0x86, 0x6d, 0xd2, 0xa4, 0xb2, 0x29, 0xe2, 0x19, 0xbf, 0x23, 0x4f, 0x48,
-	0xcc, 0x41, 0x7e, 0x15, 0x82, 0x61, 0xef, 0x06, 0x04, 0x1e, 0xde, 0x33, 0xde, 0x86, 0x99, 0xa0,
-	0x87, 0xb7, 0xbd, 0x29, 0x75, 0xdf, 0x1f, 0x52, 0x41, 0x92, 0x15, 0x78, 0xcd, 0x1f, 0x5e, 0xa6,
-	0x41, 0x6d, 0x6e, 0x7e, 0x6d, 0x86, 0x13, 0x60, 0x42, 0xac, 0x97, 0xc3, 0x65, 0xdc, 0x80, 0xd9,
-	0xf4, 0x48, 0x32, 0xfb, 0x36, 0x5c, 0xec, 0x99, 0x83, 0xb2, 0x77, 0x73, 0x89, 0xba, 0x46, 0xbd,
-	0x65, 0x6d, 0xc7, 0x69, 0x64, 0x0d, 0xcf, 0x49, 0xe6, 0x92, 0x65, 0xa5, 0x30, 0x87, 0x20, 0x89,
-	0xed, 0x6c, 0x90, 0xb3, 0xaf, 0x06, 0xf2, 0x15, 0x2c, 0x04, 0x9f, 0xfc, 0x19, 0xdd, 0xe7, 0xbb,
-	0xde, 0x2a, 0xbf, 0xe7, 0x61, 0xd8, 0x7a, 0x78, 0x60, 0xe7, 0x00, 0xf4, 0x06, 0xb1, 0x6d, 0x6a,
-	0x75, 0xaf, 0xd0, 0x05, 0xb9, 0x52, 0x36, 0xd0, 0x14, 0x9c, 0x6f, 0x31, 0x87, 0x87, 0xc3, 0xb3,
-	0x32, 0xe2, 0xbd, 0x96, 0x0d, 0xfc, 0x11, 0xe0, 0x7e, 0xc1, 0xe5, 0xc7, 0xa8, 0x30, 0xea, 0xca,
-	0x35, 0x11, 0x7b, 0xb8, 0x12, 0xbe, 0xe3, 0x22, 0xbc, 0xe9, 0x17, 0xc2, 0x3f, 0x07, 0x5f, 0x04,
-	0x32, 0xe5, 0xa2, 0x1c, 0x9c, 0xef, 0x99, 0x9b, 0x95, 0xe0, 0x15, 0xef, 0x43, 0x3e, 0xdd, 0x27,
-	0xcc, 0xf8, 0x00, 0x50, 0x42, 0xf8, 0x82, 0x79, 0xb3, 0x90, 0xa8, 0x61, 0x3c, 0x8e, 0xac, 0xe3,
-	0x65, 0x12, 0x8f, 0x8f, 0xdf, 0x90, 0x33, 0xb6, 0x64, 0x59, 0xf7, 0x3d, 0x2d, 0xab, 0x78, 0x52,
-	0xe6, 0x62, 0xbd, 0xdb, 0xec, 0xc8, 0x72, 0x48, 0xf3, 0x31, 0x8c, 0x47, 0x94, 0x2f, 0xe0, 0x98,
-	0x49, 0x70, 0x74, 0x7d, 0x25, 0xc1, 0x18, 0xef, 0x46, 0x2b, 0x7e, 0x7f, 0x09, 0xce, 0x89, 0x2c,
-	0xe8, 0x09, 0x8c, 0xf8, 0x33, 0x1b, 0x5d, 0x49, 0xc4, 0x48, 0x0a, 0x83, 0xba, 0xd8, 0xdf, 0xc8,
-	0x87, 0xc4, 0xab, 0xdf, 0xfd, 0xf5, 0xef, 0x8f, 0x67, 0x16, 0x11, 0xd6, 0xee, 0x09, 0x6b, 0x8b,
-	0xd4, 0x5c, 0x2d, 0x5d, 0xde, 0xd1, 0x33, 0x05, 0xa0, 0x3b, 0xdd, 0xd1, 0x6a, 0x7a, 0x82, 0x34,
-	0xe9, 0x50, 0xaf, 0x0d, 0x64, 0x2b, 0x99, 0x36, 0x05, 0xd3, 0x0d, 0x54, 0x94, 0x4c, 0xd7, 0x77,
-	0xd2, 0xa0, 0xba, 0x1a, 0xa1, 0x1d, 0x06, 0x32, 0xd0, 0x41, 0xbf, 0x28, 0x30, 0x1a, 0x4c, 0x3f,
-	0xb4, 0x9c, 0x99, 0x35, 0x36, 0xba, 0xd5, 0x95, 0x01, 0x2c, 0x25, 0xdd, 0x4d, 0x41, 0xb7, 0x81,
-	0xd6, 0xfb, 0xd2, 0x85, 0x33, 0x3a, 0x0a, 0xf7, 0x83, 0x02, 0x63, 0x41, 0xbc, 0x92, 0x65, 0x65,
-	0xf1, 0x25, 0xa5, 0x25, 0x8b, 0x2f, 0x45, 0x20, 0x70, 0x41, 0xf0, 0x2d, 0xa3, 0xab, 0x83, 0xf1,
-	0xa1, 0xdf, 0x14, 0xb8, 0xd8, 0x33, 0x94, 0xb3, 0x1a, 0x9b, 0x36, 0xea, 0xb3, 0x1a, 0x9b, 0x3a,
-	0xe5, 0x07, 0x6c, 0x6c, 0x53, 0xf8, 0x06, 0xbf, 0x88, 0xb4, 0x43, 0x4f, 0x3e, 0x3a, 0xe8, 0x27,
-	0x05, 0x66, 0xfb, 0xfd, 0x16, 0x43, 0x37, 0xd3, 0x49, 0x06, 0xf8, 0x05, 0xa9, 0x6e, 0xbe, 0x8a,
-	0xab, 0xbc, 0xe5, 0x7f, 0x28, 0x30, 0x1e, 0x9d, 0xc6, 0x68, 0x2d, 0xf3, 0x28, 0xa5, 0x28, 0x82,
-	0x7a, 0x7d, 0x40, 0x6b, 0x59, 0xc1, 0xdb, 0xa2, 0x82, 0x1f, 0xa2, 0x5b, 0x7d, 0x2b, 0xd8, 0xa3,
-	0x21, 0xda, 0x61, 0x5c, 0x26, 0x3b, 0xe8, 0x57, 0x05, 0x26, 0xa2, 0xf1, 0xbd, 0xc3, 0xb8, 0x96,
-	0x79, 0xc4, 0x4e, 0xc1, 0x9d, 0x21, 0x6c, 0xb8, 0x28, 0xb8, 0xd7, 0xd0, 0xea, 0xe0, 0xdc, 0xe8,
-	0x4f, 0x05, 0x50, 0x52, 0x5e, 0x50, 0x31, 0xb3, 0x62, 0x99, 0x42, 0xa7, 0x6e, 0x9c, 0xca, 0x47,
-	0x32, 0xef, 0x0a, 0xe6, 0x4f, 0xd0, 0x76, 0x5f, 0x66, 0x9b, 0xee, 0xf3, 0x6a, 0x4b, 0x44, 0xa8,
-	0x06, 0xf2, 0x26, 0xee, 0xbc, 0x94, 0xd5, 0x8e, 0x76, 0x28, 0x45, 0xb4, 0x83, 0x7e, 0x57, 0xe0,
-	0x72, 0x52, 0xf1, 0x96, 0x32, 0x4a, 0x19, 0x37, 0x54, 0xb5, 0x01, 0x0d, 0x4f, 0x39, 0xaa, 0xba,
-	0x52, 0xa9, 0x1d, 0xca, 0x4b, 0xd7, 0x41, 0x3f, 0x2b, 0x70, 0xa9, 0x57, 0xd7, 0xd0, 0x62, 0x66,
-	0xcb, 0x23, 0x56, 0xea, 0xda, 0x20, 0x56, 0x21, 0xe1, 0xba, 0x20, 0xbc, 0x86, 0x56, 0xfa, 0x12,
-	0x46, 0x65, 0x74, 0x6b, 0xe7, 0xf9, 0x71, 0x5e, 0x79, 0x71, 0x9c, 0x57, 0xfe, 0x39, 0xce, 0x2b,
-	0x4f, 0x4f, 0xf2, 0x43, 0x2f, 0x4e, 0xf2, 0x43, 0x7f, 0x9f, 0xe4, 0x87, 0x1e, 0x16, 0xeb, 0x26,
-	0x6f, 0xb4, 0x6b, 0x05, 0x9d, 0x35, 0xd3, 0xc2, 0xed, 0x15, 0xdf, 0xd7, 0xf6, 0x23, 0x41, 0x0f,
-	0x5a, 0xd4, 0xad, 0x8d, 0x88, 0xff, 0x9b, 0x1b, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x82, 0x1b,
-	0x3a, 0xce, 0xd0, 0x0f, 0x00, 0x00,
+	0x1b, 0xc7, 0xb3, 0x6d, 0x9a, 0xa6, 0x4f, 0x92, 0x37, 0x6f, 0x87, 0x40, 0x9d, 0x4d, 0xea, 0x90,
+	0x69, 0x69, 0x93, 0x34, 0xf5, 0x12, 0xb7, 0x80, 0x1a, 0x51, 0x41, 0xa2, 0x7e, 0x24, 0xa8, 0xa0,
+	0xe0, 0x96, 0x0a, 0x15, 0x24, 0x6b, 0xbc, 0x3b, 0xd8, 0xab, 0xae, 0x77, 0xdc, 0xdd, 0x71, 0x9b,
+	0x12, 0x45, 0x95, 0xb8, 0x82, 0x0a, 0x84, 0x10, 0x9c, 0x15, 0x71, 0x80, 0x38, 0xe4, 0x2a, 0x7a,
+	0x46, 0x25, 0x4e, 0x38, 0xaa, 0x50, 0xc3, 0x15, 0xf4, 0x0a, 0xd0, 0xce, 0xcc, 0xae, 0xd7, 0xfb,
+	0x61, 0xd6, 0x3d, 0xf3, 0xce, 0x3c, 0x1f, 0xbf, 0x79, 0x66, 0xe6, 0xf9, 0x8f, 0x61, 0xce, 0xe7,
+	0x9e, 0x6d, 0x51, 0xc3, 0xe7, 0xe4, 0x2e, 0xb5, 0x1b, 0xa6, 0x71, 0xaf, 0x4b, 0xbd, 0x87, 0x95,
+	0x8e, 0xc7, 0x38, 0x43, 0xd3, 0x72, 0xb2, 0x12, 0x4e, 0xea, 0x2b, 0x26, 0xf3, 0xdb, 0xcc, 0x37,
+	0x1a, 0xc4, 0xa7, 0xd2, 0xd2, 0xb8, 0xbf, 0xd6, 0xa0, 0x9c, 0xac, 0x19, 0x1d, 0xd2, 0xb4, 0x5d,
+	0xc2, 0x6d, 0xe6, 0x4a, 0x67, 0x7d, 0xa6, 0xc9, 0x9a, 0x4c, 0xfc, 0x34, 0x82, 0x5f, 0x6a, 0x74,
+	0xbe, 0xc9, 0x58, 0xd3, 0xa1, 0x06, 0xe9, 0xd8, 0x06, 0x71, 0x5d, 0xc6, 0x85, 0x8b, 0xaf, 0x66,
+	0xcf, 0x26, 0x69, 0x88, 0x65, 0x79, 0xd4, 0xf7, 0xeb, 0x5d, 0xb7, 0xc1, 0x5c, 0xcb, 0x76, 0x9b,
+	0xca, 0xf0, 0x54, 0xd2, 0x90, 0x76, 0x98, 0xd9, 0xaa, 0x73, 0x8f, 0x98, 0x77, 0xa9, 0xa7, 0x8c,
+	0x16, 0x92, 0x46, 0x2d, 0xe6, 0xf3, 0xfa, 0xd7, 0xcc, 0xa5, 0x21, 0x4c, 0xd2, 0xa0, 0x43, 0x3c,
+	0xd2, 0x0e, 0x61, 0x16, 0x93, 0xb3, 0xdc, 0x23, 0x16, 0xad, 0x7b, 0xac, 0xcb, 0x69, 0x5e, 0x86,
+	0xfb, 0xc4, 0xb1, 0x2d, 0xc2, 0x99, 0x42, 0xc0, 0x8f, 0x60, 0xe9, 0xd3, 0xa0, 0x4c, 0xdb, 0x2e,
+	0xa7, 0x9e, 0xd9, 0x22, 0xb6, 0xbb, 0x61, 0x9a, 0xac, 0xeb, 0xf2, 0x6b, 0x1e, 0x6b, 0x6f, 0xc8,
+	0xc5, 0xd5, 0xe8, 0xbd, 0x2e, 0xf5, 0x39, 0x9a, 0x81, 0x23, 0xec, 0x81, 0x4b, 0xbd, 0x92, 0xf6,
+	0xa6, 0xb6, 0x74, 0xac, 0x26, 0x3f, 0xd0, 0x65, 0x98, 0x32, 0x99, 0xeb, 0x52, 0x33, 0xa8, 0x53,
+	0xdd, 0xb6, 0x4a, 0x87, 0x82, 0xd9, 0xcd, 0xd2, 0xcb, 0xe7, 0x0b, 0x33, 0x0f, 0x49, 0xdb, 0x59,
+	0xc7, 0x7d, 0xd3, 0xb8, 0x36, 0xd9, 0xfb, 0xde, 0xb6, 0xf0, 0x63, 0x0d, 0x96, 0x0b, 0x10, 0xf8,
+	0x1d, 0xe6, 0xfa, 0x14, 0x99, 0xa0, 0xdb, 0x91, 0x5d, 0x9d, 0x48, 0xc3, 0xba, 0xda, 0x04, 0xc9,
+	0xb5, 0xf9, 0xd6, 0xcb, 0xe7, 0x0b, 0x8b, 0x32, 0x73, 0xbe, 0x2d, 0xae, 0x95, 0xec, 0x64, 0x42,
+	0x95, 0x0c, 0xcf, 0x00, 0x12, 0x44, 0x3b, 0xa2, 0xd8, 0x6a, 0xf5, 0xf8, 0x06, 0xbc, 0xd6, 0x37,
+	0xaa, 0x88, 0xde, 0x81, 0x31, 0xb9, 0x29, 0x22, 0xfb, 0x44, 0xf5, 0x44, 0x25, 0x71, 0x26, 0x2b,
+	0xd2, 0x61, 0x73, 0xf4, 0xe9, 0xf3, 0x85, 0x91, 0x9a, 0x32, 0xc6, 0xef, 0xc2, 0xac, 0x88, 0x76,
+	0x9d, 0xf2, 0xdb, 0xe1, 0x96, 0x44, 0x85, 0x9e, 0x85, 0x71, 0x09, 0x6d, 0x5b, 0xaa, 0xd6, 0x47,
+	0xc5, 0xf7, 0xb6, 0x85, 0x3f, 0x07, 0x3d, 0xcb, 0x4f, 0xc1, 0xac, 0x03, 0x44, 0x1b, 0x1c, 0x00,
+	0x1d, 0x5e, 0x9a, 0xa8, 0xea, 0x29, 0xa0, 0xc8, 0xb1, 0x16, 0xb3, 0xc6, 0x17, 0xe1, 0x44, 0x18,
+	0x79, 0x8b, 0xf9, 0xfc, 0x0e, 0x73, 0x69, 0x21, 0x9e, 0x52, 0xda, 0x4b, 0xd1, 0xbc, 0x0f, 0xc7,
+	0xa2, 0x03, 0xad, 0xaa, 0x33, 0x9b, 0x82, 0x09, 0xbd, 0x54, 0x7d, 0xc6, 0x5b, 0xea, 0x1b, 0x13,
+	0xc5, 0xb3, 0xe1, 0x38, 0x49, 0x9e, 0x6b, 0x00, 0xbd, 0xdb, 0xac, 0x22, 0x9f, 0xa9, 0xc8, 0xab,
+	0x5f, 0x09, 0xae, 0x7e, 0x45, 0x36, 0x09, 0x75, 0xf5, 0x2b, 0x3b, 0xa4, 0x19, 0xfa, 0xd6, 0x62,
+	0x9e, 0xf8, 0x89, 0x06, 0xa5, 0x74, 0x8e, 0x6c, 0xfa, 0xc3, 0x43, 0xd1, 0xa3, 0xeb, 0x7d, 0x88,
+	0x87, 0x04, 0xe2, 0xd9, 0xff, 0x44, 0x94, 0xa9, 0xfb, 0x18, 0x0d, 0x75, 0x50, 0x3e, 0x66, 0x56,
+	0xd7, 0xa1, 0x89, 0x1b, 0x89, 0x60, 0xd4, 0x25, 0x6d, 0xaa, 0x36, 0x45, 0xfc, 0xc6, 0x6f, 0x83,
+	0x9e, 0xe5, 0xa0, 0x56, 0x85, 0x60, 0x34, 0xb8, 0x01, 0xa1, 0x47, 0xf0, 0x1b, 0x6f, 0xc1, 0x5c,
+	0xb8, 0x87, 0x57, 0x83, 0x2e, 0x75, 0x4b, 0x36, 0xa9, 0x30, 0xc9, 0x32, 0xfc, 0x5f, 0x36, 0x2f,
+	0xdb, 0xa2, 0x2e, 0xb7, 0xbf, 0xb2, 0xa3, 0x0e, 0x30, 0x2d, 0xc6, 0xb7, 0xa3, 0x61, 0xdc, 0x82,
+	0xf9, 0xec, 0x48, 0x2a, 0xfb, 0x16, 0x4c, 0xf5, 0xf5, 0x41, 0xb5, 0x77, 0x27, 0x53, 0x75, 0x8d,
+	0x7b, 0xab, 0xda, 0x4e, 0xd2, 0xd8, 0x18, 0x3e, 0xa9, 0x98, 0x37, 0x1c, 0x27, 0x83, 0x39, 0x02,
+	0x49, 0x4d, 0xe7, 0x83, 0x1c, 0x7e, 0x35, 0x90, 0x2f, 0x60, 0x31, 0x5c, 0xf2, 0x27, 0x74, 0x97,
+	0xef, 0x04, 0xa3, 0xfc, 0x66, 0x80, 0xe1, 0x9a, 0xd1, 0x81, 0x3d, 0x09, 0x60, 0xb6, 0x88, 0xeb,
+	0x52, 0xa7, 0x77, 0x85, 0x8e, 0xa9, 0x91, 0x6d, 0x0b, 0x9d, 0x80, 0xa3, 0x1d, 0xe6, 0xf1, 0xa8,
+	0x79, 0xd6, 0xc6, 0x82, 0xcf, 0x6d, 0x0b, 0x7f, 0x08, 0x78, 0x50, 0x70, 0xb5, 0x18, 0x1d, 0xc6,
+	0x7d, 0x35, 0x26, 0x62, 0x8f, 0xd6, 0xa2, 0x6f, 0x5c, 0x85, 0x37, 0x64, 0x21, 0xe4, 0x39, 0xf8,
+	0x2c, 0x94, 0x29, 0x1f, 0x95, 0xe0, 0x68, 0x5f, 0xdf, 0xac, 0x85, 0x9f, 0x78, 0x17, 0xca, 0xd9,
+	0x3e, 0x51, 0xc6, 0xdb, 0x80, 0x52, 0xc2, 0x17, 0xf6, 0x9b, 0xc5, 0x54, 0x0d, 0x93, 0x71, 0x54,
+	0x1d, 0x8f, 0x93, 0x64, 0x7c, 0xfc, 0xba, 0xea, 0xb1, 0x1b, 0x8e, 0x73, 0xcb, 0x23, 0x16, 0xad,
+	0xb1, 0x2e, 0xa7, 0x3e, 0x36, 0x61, 0x2e, 0x63, 0x38, 0xa2, 0xb9, 0x02, 0x93, 0x31, 0xe5, 0x0b,
+	0x39, 0xe6, 0x52, 0x1c, 0x3d, 0x5f, 0x45, 0x30, 0xc1, 0x63, 0x49, 0xbe, 0x54, 0xbd, 0x40, 0x5a,
+	0x51, 0x93, 0x79, 0x56, 0x74, 0xcf, 0x16, 0x61, 0xd2, 0xa3, 0x0f, 0x88, 0x67, 0xd5, 0x2d, 0xea,
+	0xb2, 0xb6, 0x2a, 0xd8, 0x84, 0x1c, 0xbb, 0x12, 0x0c, 0x05, 0x5b, 0x2c, 0xda, 0x85, 0x34, 0x90,
+	0xdb, 0x28, 0x1a, 0x88, 0x98, 0xc6, 0x16, 0xcc, 0x66, 0x44, 0x57, 0x0b, 0xb8, 0x0e, 0x53, 0x6a,
+	0x01, 0x72, 0x42, 0xad, 0x60, 0x3e, 0x67, 0x05, 0xc2, 0x28, 0x3c, 0x8c, 0x3c, 0x16, 0xb0, 0xfa,
+	0xdb, 0x34, 0x1c, 0x11, 0x69, 0xd0, 0x23, 0x18, 0x93, 0xba, 0x83, 0x4e, 0xa5, 0xa2, 0xa4, 0xc5,
+	0x4d, 0x3f, 0x3d, 0xd8, 0x48, 0x72, 0xe2, 0x95, 0x6f, 0xfe, 0xfc, 0xe7, 0xbb, 0x43, 0xa7, 0x11,
+	0x36, 0x6e, 0x0a, 0x6b, 0x87, 0x34, 0x7c, 0x23, 0xfb, 0x89, 0x82, 0x9e, 0x68, 0x00, 0x3d, 0x85,
+	0x42, 0x2b, 0xd9, 0x09, 0xb2, 0xe4, 0x4f, 0x3f, 0x57, 0xc8, 0x56, 0x31, 0xad, 0x0b, 0xa6, 0x8b,
+	0xa8, 0xaa, 0x98, 0xce, 0xdf, 0xc8, 0x82, 0xea, 0xe9, 0x9c, 0xb1, 0x17, 0x4a, 0xd9, 0x3e, 0xfa,
+	0x49, 0x83, 0xf1, 0xb0, 0x83, 0xa3, 0xa5, 0xdc, 0xac, 0x09, 0xf9, 0xd1, 0x97, 0x0b, 0x58, 0x2a,
+	0xba, 0x4b, 0x82, 0xee, 0x02, 0x5a, 0x1b, 0x48, 0x17, 0xe9, 0x4c, 0x1c, 0xee, 0x5b, 0x0d, 0x26,
+	0xc2, 0x78, 0x1b, 0x8e, 0x93, 0xc7, 0x97, 0x96, 0x47, 0x7d, 0xb9, 0x80, 0xa5, 0xe2, 0xab, 0x08,
+	0xbe, 0x25, 0x74, 0xa6, 0x18, 0x1f, 0xfa, 0x45, 0x83, 0xa9, 0x3e, 0x61, 0xc9, 0xdb, 0xd8, 0x2c,
+	0xb9, 0xd2, 0xcf, 0x15, 0xb2, 0x1d, 0x6a, 0x63, 0xdb, 0xc2, 0x37, 0x7c, 0xd5, 0x19, 0x7b, 0x81,
+	0x04, 0xee, 0xa3, 0xef, 0x35, 0x98, 0x1f, 0xf4, 0x9e, 0x44, 0x97, 0xb2, 0x49, 0x0a, 0xbc, 0x82,
+	0xf5, 0xf5, 0x57, 0x71, 0x55, 0x17, 0xfd, 0x77, 0x0d, 0x26, 0xe3, 0x8a, 0x82, 0x56, 0x73, 0x8f,
+	0x52, 0x86, 0xaa, 0xe9, 0xe7, 0x0b, 0x5a, 0xab, 0x0a, 0x5e, 0x15, 0x15, 0xfc, 0x00, 0x5d, 0x1e,
+	0x58, 0xc1, 0x3e, 0x1d, 0x34, 0xf6, 0x92, 0x52, 0xbf, 0x8f, 0x7e, 0xd6, 0x60, 0x3a, 0x1e, 0x3f,
+	0x38, 0x8c, 0xab, 0xb9, 0x47, 0x6c, 0x08, 0xee, 0x1c, 0x71, 0xc6, 0x55, 0xc1, 0xbd, 0x8a, 0x56,
+	0x8a, 0x73, 0xa3, 0x3f, 0x34, 0x40, 0x69, 0x89, 0x44, 0xd5, 0xdc, 0x8a, 0xe5, 0x8a, 0xb5, 0x7e,
+	0x61, 0x28, 0x1f, 0xc5, 0xbc, 0x23, 0x98, 0x3f, 0x42, 0x5b, 0x03, 0x99, 0x5d, 0xba, 0xcb, 0xeb,
+	0x1d, 0x11, 0xa1, 0x1e, 0x4a, 0xb4, 0xb1, 0xa7, 0x1e, 0x02, 0xc1, 0xad, 0x37, 0xf6, 0xd4, 0x43,
+	0x60, 0x1f, 0xfd, 0xaa, 0xc1, 0xf1, 0xb4, 0x6a, 0x9f, 0xcd, 0x29, 0x65, 0xd2, 0x50, 0x37, 0x0a,
+	0x1a, 0x0e, 0xd9, 0xaa, 0x7a, 0x72, 0x6f, 0xec, 0xa9, 0x4b, 0xb7, 0x8f, 0x7e, 0xd0, 0xe0, 0x7f,
+	0xfd, 0xda, 0x8c, 0x4e, 0xe7, 0x6e, 0x79, 0xcc, 0x4a, 0x5f, 0x2d, 0x62, 0x15, 0x11, 0xae, 0x09,
+	0xc2, 0x73, 0x68, 0x79, 0x20, 0x61, 0xfc, 0x29, 0x80, 0x7e, 0xd4, 0x60, 0x32, 0x2e, 0xb9, 0x28,
+	0xa7, 0x37, 0x66, 0x88, 0xbe, 0xbe, 0x52, 0xc4, 0x74, 0xa8, 0x23, 0xdb, 0x27, 0xf2, 0x9b, 0x37,
+	0x9e, 0xbe, 0x28, 0x6b, 0xcf, 0x5e, 0x94, 0xb5, 0xbf, 0x5f, 0x94, 0xb5, 0xc7, 0x07, 0xe5, 0x91,
+	0x67, 0x07, 0xe5, 0x91, 0xbf, 0x0e, 0xca, 0x23, 0x77, 0xaa, 0x4d, 0x9b, 0xb7, 0xba, 0x8d, 0x8a,
+	0xc9, 0xda, 0x59, 0xf1, 0xee, 0x57, 0xdf, 0x33, 0x76, 0x63, 0x51, 0x1f, 0x76, 0xa8, 0xdf, 0x18,
+	0x13, 0xff, 0xe7, 0x2f, 0xfc, 0x3b, 0x00, 0x5e, 0x78, 0x03, 0xd2, 0x30, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1155,6 +1260,9 @@ type QueryClient interface {
 	AddressUnbondings(ctx context.Context, in *QueryAddressUnbondings, opts ...grpc.CallOption) (*QueryAddressUnbondingsResponse, error)
 	// Queries all trade routes
 	AllTradeRoutes(ctx context.Context, in *QueryAllTradeRoutes, opts ...grpc.CallOption) (*QueryAllTradeRoutesResponse, error)
+	// Queries the audit trail of swaps executed along trade routes,
+	// optionally filtered by route
+	TradeRecords(ctx context.Context, in *QueryTradeRecordsRequest, opts ...grpc.CallOption) (*QueryTradeRecordsResponse, error)
 }
 
 type queryClient struct {
@@ -1264,6 +1372,15 @@ func (c *queryClient) AllTradeRoutes(ctx context.Context, in *QueryAllTradeRoute
 	return out, nil
 }
 
+func (c *queryClient) TradeRecords(ctx context.Context, in *QueryTradeRecordsRequest, opts ...grpc.CallOption) (*QueryTradeRecordsResponse, error) {
+	out := new(QueryTradeRecordsResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/TradeRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -1289,6 +1406,9 @@ type QueryServer interface {
 	AddressUnbondings(context.Context, *QueryAddressUnbondings) (*QueryAddressUnbondingsResponse, error)
 	// Queries all trade routes
 	AllTradeRoutes(context.Context, *QueryAllTradeRoutes) (*QueryAllTradeRoutesResponse, error)
+	// Queries the audit trail of swaps executed along trade routes,
+	// optionally filtered by route
+	TradeRecords(context.Context, *QueryTradeRecordsRequest) (*QueryTradeRecordsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1328,6 +1448,9 @@ func (*UnimplementedQueryServer) AddressUnbondings(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) AllTradeRoutes(ctx context.Context, req *QueryAllTradeRoutes) (*QueryAllTradeRoutesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllTradeRoutes not implemented")
 }
+func (*UnimplementedQueryServer) TradeRecords(ctx context.Context, req *QueryTradeRecordsRequest) (*QueryTradeRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradeRecords not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1531,6 +1654,24 @@ func _Query_AllTradeRoutes_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TradeRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTradeRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TradeRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/TradeRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TradeRecords(ctx, req.(*QueryTradeRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.stakeibc.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1579,6 +1720,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllTradeRoutes",
 			Handler:    _Query_AllTradeRoutes_Handler,
 		},
+		{
+			MethodName: "TradeRecords",
+			Handler:    _Query_TradeRecords_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stride/stakeibc/query.proto",
@@ -2296,6 +2441,80 @@ func (m *QueryAllTradeRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryTradeRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTradeRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTradeRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HostDenom) > 0 {
+		i -= len(m.HostDenom)
+		copy(dAtA[i:], m.HostDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.HostDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RewardDenom) > 0 {
+		i -= len(m.RewardDenom)
+		copy(dAtA[i:], m.RewardDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RewardDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTradeRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTradeRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTradeRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TradeRecords) > 0 {
+		for iNdEx := len(m.TradeRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TradeRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2596,6 +2815,38 @@ func (m *QueryAllTradeRoutesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryTradeRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RewardDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.HostDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTradeRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TradeRecords) > 0 {
+		for _, e := range m.TradeRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4414,6 +4665,204 @@ func (m *QueryAllTradeRoutesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryTradeRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTradeRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTradeRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTradeRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTradeRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTradeRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradeRecords = append(m.TradeRecords, TradeRecord{})
+			if err := m.TradeRecords[len(m.TradeRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
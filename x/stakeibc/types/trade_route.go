@@ -1,6 +1,11 @@
 package types
 
-import fmt "fmt"
+import (
+	fmt "fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // Builds the store key (as a string) from the reward and host denom's
 func GetTradeRouteId(rewardDenom, hostDenom string) string {
@@ -17,7 +22,100 @@ func (t TradeRoute) GetKey() []byte {
 	return TradeRouteKeyFromDenoms(t.RewardDenomOnRewardZone, t.HostDenomOnHostZone)
 }
 
+// Builds the ID used to identify a given leg of a trade route (e.g. in the ICA owner)
+// The first leg shares the route's ID for backwards compatibility
+func GetTradeLegId(tradeRouteId string, legIndex int) string {
+	if legIndex == 0 {
+		return tradeRouteId
+	}
+	return fmt.Sprintf("%s-leg%d", tradeRouteId, legIndex)
+}
+
+// Returns the ordered list of all legs in the trade route, where the first leg
+// is built from the trade zone fields on the route and the remaining legs are the
+// route's additional legs
+func (t TradeRoute) GetTradeLegs() []TradeLeg {
+	firstLeg := TradeLeg{
+		TradeAccount:           t.TradeAccount,
+		InputDenomOnTradeZone:  t.RewardDenomOnTradeZone,
+		OutputDenomOnTradeZone: t.HostDenomOnTradeZone,
+		InboundChannelId:       t.RewardToTradeChannelId,
+		SwapConfig:             t.SwapConfig,
+	}
+	return append([]TradeLeg{firstLeg}, t.AdditionalLegs...)
+}
+
+// Returns the trade leg at the given index, or false if it does not exist
+func (t TradeRoute) GetTradeLeg(legIndex int) (leg TradeLeg, found bool) {
+	legs := t.GetTradeLegs()
+	if legIndex < 0 || legIndex >= len(legs) {
+		return leg, false
+	}
+	return legs[legIndex], true
+}
+
+// Returns true if the leg at the given index is the final leg in the route
+func (t TradeRoute) IsFinalTradeLeg(legIndex int) bool {
+	return legIndex == len(t.AdditionalLegs)
+}
+
+// Builds the ICA owner of the trade account for the leg at the given index
+func (t TradeRoute) GetTradeLegICAOwner(legIndex int, tradeAccount ICAAccount) string {
+	legId := GetTradeLegId(t.GetRouteId(), legIndex)
+	return FormatTradeRouteICAOwnerFromRouteId(tradeAccount.ChainId, legId, tradeAccount.Type)
+}
+
 // Human readable description for logging
 func (t TradeRoute) Description() string {
 	return fmt.Sprintf("TradeRoute from %s to %s", t.RewardDenomOnRewardZone, t.HostDenomOnHostZone)
 }
+
+// Validates the swap configuration of a trade route
+func (c SwapConfig) Validate() error {
+	if c.PoolId == 0 && len(c.Hops) == 0 {
+		return errorsmod.Wrap(ErrInvalidSwapConfig, "pool id must be specified")
+	}
+	for i, hop := range c.Hops {
+		if hop.PoolId == 0 {
+			return errorsmod.Wrapf(ErrInvalidSwapConfig, "pool id must be specified for hop %d", i)
+		}
+		if hop.TokenOutDenom == "" {
+			return errorsmod.Wrapf(ErrInvalidSwapConfig, "missing token out denom for hop %d", i)
+		}
+	}
+	if c.RewardDenomOnStride == "" {
+		return errorsmod.Wrap(ErrInvalidSwapConfig, "missing reward denom on stride")
+	}
+	if c.HostDenomOnStride == "" {
+		return errorsmod.Wrap(ErrInvalidSwapConfig, "missing host denom on stride")
+	}
+	if c.MaxSlippage.IsNil() || c.MaxSlippage.IsNegative() || c.MaxSlippage.GTE(sdk.OneDec()) {
+		return errorsmod.Wrap(ErrInvalidSwapConfig, "max slippage must be between 0 and 1")
+	}
+	if c.MaxSwapAmount.IsNil() || c.MaxSwapAmount.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidSwapConfig, "max swap amount must be greater than or equal to zero")
+	}
+	return nil
+}
+
+// Validates the configuration of an additional trade leg
+func (c TradeLegConfig) Validate() error {
+	if err := ValidateConnectionId(c.StrideToTradeConnectionId); err != nil {
+		return errorsmod.Wrap(err, "invalid trade leg connection-id")
+	}
+	if err := ValidateChannelId(c.InboundTransferChannelId); err != nil {
+		return errorsmod.Wrap(err, "invalid trade leg inbound channel-id")
+	}
+	if err := ValidateDenom(c.InputDenomOnTrade, true); err != nil {
+		return errorsmod.Wrap(err, "invalid trade leg input denom")
+	}
+	if err := ValidateDenom(c.OutputDenomOnTrade, true); err != nil {
+		return errorsmod.Wrap(err, "invalid trade leg output denom")
+	}
+
+	// Additional legs can only be traded on-chain, so a swap config is required
+	if c.SwapConfig == nil {
+		return errorsmod.Wrap(ErrInvalidSwapConfig, "missing trade leg swap config")
+	}
+	return c.SwapConfig.Validate()
+}
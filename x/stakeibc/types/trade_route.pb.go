@@ -9,15 +9,19 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +29,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type TradeRecord_Status int32
+
+const (
+	// The swap ICA has been submitted and is awaiting an ack
+	TradeRecord_PENDING TradeRecord_Status = 0
+	// The swap completed on the trade zone
+	TradeRecord_SUCCEEDED TradeRecord_Status = 1
+	// The swap failed or timed out
+	TradeRecord_FAILED TradeRecord_Status = 2
+)
+
+var TradeRecord_Status_name = map[int32]string{
+	0: "PENDING",
+	1: "SUCCEEDED",
+	2: "FAILED",
+}
+
+var TradeRecord_Status_value = map[string]int32{
+	"PENDING":   0,
+	"SUCCEEDED": 1,
+	"FAILED":    2,
+}
+
+func (x TradeRecord_Status) String() string {
+	return proto.EnumName(TradeRecord_Status_name, int32(x))
+}
+
+func (TradeRecord_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c252b142ecf88017, []int{4, 0}
+}
+
 // Deprecated, this configuration is no longer needed since swaps
 // are executed off-chain via authz
 //
@@ -98,6 +133,339 @@ func (m *TradeConfig) GetPriceUpdateTimestamp() uint64 {
 	return 0
 }
 
+// A single hop in a multi-hop swap, through the given pool on the trade zone
+type SwapHop struct {
+	// Pool ID on the trade zone
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Denom (on the trade zone) that is output from this hop
+	TokenOutDenom string `protobuf:"bytes,2,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty"`
+}
+
+func (m *SwapHop) Reset()         { *m = SwapHop{} }
+func (m *SwapHop) String() string { return proto.CompactTextString(m) }
+func (*SwapHop) ProtoMessage()    {}
+func (*SwapHop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c252b142ecf88017, []int{1}
+}
+func (m *SwapHop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapHop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapHop.Merge(m, src)
+}
+func (m *SwapHop) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapHop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapHop proto.InternalMessageInfo
+
+func (m *SwapHop) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *SwapHop) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+// Configuration for swaps that are executed by Stride from the trade ICA
+// (instead of off-chain through an authz grant)
+// The minimum output of each swap is derived from the icqoracle price of the
+// reward token, denominated in the host token
+type SwapConfig struct {
+	// Pool ID on the trade zone used for a single-hop swap
+	// Ignored if hops are specified
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Denom of the swap's input token on Stride, used to look up the oracle price
+	// (this is the reward token, unless the swap is an intermediate trade leg)
+	RewardDenomOnStride string `protobuf:"bytes,2,opt,name=reward_denom_on_stride,json=rewardDenomOnStride,proto3" json:"reward_denom_on_stride,omitempty"`
+	// Denom of the swap's output token on Stride, used to look up the oracle price
+	// (this is the host token, unless the swap is an intermediate trade leg)
+	HostDenomOnStride string `protobuf:"bytes,3,opt,name=host_denom_on_stride,json=hostDenomOnStride,proto3" json:"host_denom_on_stride,omitempty"`
+	// Max deviation from the oracle price that is tolerated in the swap
+	// "0.05" means the output from the swap can be no less than 95% of the
+	// value implied by the oracle price
+	MaxSlippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_slippage,json=maxSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slippage"`
+	// Max amount of reward tokens that can be sold in a single swap
+	// Any remaining balance is swapped in the following epochs
+	// If zero, the full balance is swapped at once
+	MaxSwapAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=max_swap_amount,json=maxSwapAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_swap_amount"`
+	// Optional ordered list of hops for swaps that have no direct pool
+	// The final hop must output the trade leg's output denom
+	Hops []SwapHop `protobuf:"bytes,6,rep,name=hops,proto3" json:"hops"`
+}
+
+func (m *SwapConfig) Reset()         { *m = SwapConfig{} }
+func (m *SwapConfig) String() string { return proto.CompactTextString(m) }
+func (*SwapConfig) ProtoMessage()    {}
+func (*SwapConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c252b142ecf88017, []int{2}
+}
+func (m *SwapConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapConfig.Merge(m, src)
+}
+func (m *SwapConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapConfig proto.InternalMessageInfo
+
+func (m *SwapConfig) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *SwapConfig) GetRewardDenomOnStride() string {
+	if m != nil {
+		return m.RewardDenomOnStride
+	}
+	return ""
+}
+
+func (m *SwapConfig) GetHostDenomOnStride() string {
+	if m != nil {
+		return m.HostDenomOnStride
+	}
+	return ""
+}
+
+func (m *SwapConfig) GetHops() []SwapHop {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+// An additional leg of a trade route, used when the swap from the reward
+// denom to the host denom requires trading across multiple venues (i.e.
+// multiple trade zones)
+// The output of the previous leg is transferred to this leg's trade ICA,
+// swapped, and then sent onwards to the next leg (or back to the host zone
+// if this is the final leg)
+type TradeLeg struct {
+	// ICAAccount on the leg's trade zone responsible for executing the swap
+	TradeAccount ICAAccount `protobuf:"bytes,1,opt,name=trade_account,json=tradeAccount,proto3" json:"trade_account"`
+	// ibc denom of the leg's input token on the leg's trade zone
+	InputDenomOnTradeZone string `protobuf:"bytes,2,opt,name=input_denom_on_trade_zone,json=inputDenomOnTradeZone,proto3" json:"input_denom_on_trade_zone,omitempty"`
+	// ibc denom of the leg's output token on the leg's trade zone
+	OutputDenomOnTradeZone string `protobuf:"bytes,3,opt,name=output_denom_on_trade_zone,json=outputDenomOnTradeZone,proto3" json:"output_denom_on_trade_zone,omitempty"`
+	// Channel responsible for the transfer of the previous leg's output to this
+	// leg. This is the channel ID on the previous leg's trade zone
+	InboundChannelId string `protobuf:"bytes,4,opt,name=inbound_channel_id,json=inboundChannelId,proto3" json:"inbound_channel_id,omitempty"`
+	// Configuration of the swap executed on this leg
+	SwapConfig *SwapConfig `protobuf:"bytes,5,opt,name=swap_config,json=swapConfig,proto3" json:"swap_config,omitempty"`
+}
+
+func (m *TradeLeg) Reset()         { *m = TradeLeg{} }
+func (m *TradeLeg) String() string { return proto.CompactTextString(m) }
+func (*TradeLeg) ProtoMessage()    {}
+func (*TradeLeg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c252b142ecf88017, []int{3}
+}
+func (m *TradeLeg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradeLeg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradeLeg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TradeLeg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeLeg.Merge(m, src)
+}
+func (m *TradeLeg) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradeLeg) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradeLeg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradeLeg proto.InternalMessageInfo
+
+func (m *TradeLeg) GetTradeAccount() ICAAccount {
+	if m != nil {
+		return m.TradeAccount
+	}
+	return ICAAccount{}
+}
+
+func (m *TradeLeg) GetInputDenomOnTradeZone() string {
+	if m != nil {
+		return m.InputDenomOnTradeZone
+	}
+	return ""
+}
+
+func (m *TradeLeg) GetOutputDenomOnTradeZone() string {
+	if m != nil {
+		return m.OutputDenomOnTradeZone
+	}
+	return ""
+}
+
+func (m *TradeLeg) GetInboundChannelId() string {
+	if m != nil {
+		return m.InboundChannelId
+	}
+	return ""
+}
+
+func (m *TradeLeg) GetSwapConfig() *SwapConfig {
+	if m != nil {
+		return m.SwapConfig
+	}
+	return nil
+}
+
+// TradeRecord stores an audit trail of each swap executed from a trade ICA
+type TradeRecord struct {
+	// Unique identifier of the trade
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The reward denom of the route in it's native form (e.g. usdc)
+	RewardDenom string `protobuf:"bytes,2,opt,name=reward_denom,json=rewardDenom,proto3" json:"reward_denom,omitempty"`
+	// The host zone's denom in it's native form (e.g. dydx)
+	HostDenom string `protobuf:"bytes,3,opt,name=host_denom,json=hostDenom,proto3" json:"host_denom,omitempty"`
+	// Amount of reward tokens sold in the swap
+	TokenInAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_in_amount"`
+	// Minimum amount of host tokens that the swap was allowed to return
+	MinTokenOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=min_token_out_amount,json=minTokenOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_token_out_amount"`
+	// Amount of host tokens returned from the swap (set after the ack)
+	TokenOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_amount"`
+	// Oracle price of the reward token denominated in the host token at the
+	// time the swap was submitted
+	OraclePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=oracle_price,json=oraclePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"oracle_price"`
+	// Price realized in the swap (token_out_amount / token_in_amount)
+	RealizedPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=realized_price,json=realizedPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"realized_price"`
+	// Block time when the swap was submitted
+	SubmittedTime time.Time `protobuf:"bytes,9,opt,name=submitted_time,json=submittedTime,proto3,stdtime" json:"submitted_time"`
+	// Status of the swap
+	Status TradeRecord_Status `protobuf:"varint,10,opt,name=status,proto3,enum=stride.stakeibc.TradeRecord_Status" json:"status,omitempty"`
+	// Index of the trade leg that executed the swap (0 is the first leg)
+	LegIndex uint32 `protobuf:"varint,11,opt,name=leg_index,json=legIndex,proto3" json:"leg_index,omitempty"`
+}
+
+func (m *TradeRecord) Reset()         { *m = TradeRecord{} }
+func (m *TradeRecord) String() string { return proto.CompactTextString(m) }
+func (*TradeRecord) ProtoMessage()    {}
+func (*TradeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c252b142ecf88017, []int{4}
+}
+func (m *TradeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TradeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeRecord.Merge(m, src)
+}
+func (m *TradeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradeRecord proto.InternalMessageInfo
+
+func (m *TradeRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TradeRecord) GetRewardDenom() string {
+	if m != nil {
+		return m.RewardDenom
+	}
+	return ""
+}
+
+func (m *TradeRecord) GetHostDenom() string {
+	if m != nil {
+		return m.HostDenom
+	}
+	return ""
+}
+
+func (m *TradeRecord) GetSubmittedTime() time.Time {
+	if m != nil {
+		return m.SubmittedTime
+	}
+	return time.Time{}
+}
+
+func (m *TradeRecord) GetStatus() TradeRecord_Status {
+	if m != nil {
+		return m.Status
+	}
+	return TradeRecord_PENDING
+}
+
+func (m *TradeRecord) GetLegIndex() uint32 {
+	if m != nil {
+		return m.LegIndex
+	}
+	return 0
+}
+
 // TradeRoute represents a round trip including info on transfer and how to do
 // the swap. It makes the assumption that the reward token is always foreign to
 // the host so therefore the first two hops are to unwind the ibc denom enroute
@@ -113,6 +481,7 @@ type TradeRoute struct {
 	// ibc denom of the reward on the trade chain, input to the swap
 	RewardDenomOnTradeZone string `protobuf:"bytes,3,opt,name=reward_denom_on_trade_zone,json=rewardDenomOnTradeZone,proto3" json:"reward_denom_on_trade_zone,omitempty"`
 	// ibc of the host denom on the trade chain, output from the swap
+	// If the route has additional legs, this is the output of the first leg
 	HostDenomOnTradeZone string `protobuf:"bytes,4,opt,name=host_denom_on_trade_zone,json=hostDenomOnTradeZone,proto3" json:"host_denom_on_trade_zone,omitempty"`
 	// should be the same as the native host denom on the host chain
 	HostDenomOnHostZone string `protobuf:"bytes,5,opt,name=host_denom_on_host_zone,json=hostDenomOnHostZone,proto3" json:"host_denom_on_host_zone,omitempty"`
@@ -133,6 +502,8 @@ type TradeRoute struct {
 	RewardToTradeChannelId string `protobuf:"bytes,10,opt,name=reward_to_trade_channel_id,json=rewardToTradeChannelId,proto3" json:"reward_to_trade_channel_id,omitempty"`
 	// Channel responsible for the transfer of host tokens from the trade
 	// zone, back to the host zone. This is the channel ID on the trade zone side
+	// If the route has additional legs, this is the channel on the final leg's
+	// trade zone
 	TradeToHostChannelId string `protobuf:"bytes,11,opt,name=trade_to_host_channel_id,json=tradeToHostChannelId,proto3" json:"trade_to_host_channel_id,omitempty"`
 	// Minimum amount of reward token that must be accumulated before
 	// the tokens are transferred to the trade ICA
@@ -143,13 +514,27 @@ type TradeRoute struct {
 	// specifies the configuration needed to execute the swap
 	// such as pool_id, slippage, min trade amount, etc.
 	TradeConfig TradeConfig `protobuf:"bytes,12,opt,name=trade_config,json=tradeConfig,proto3" json:"trade_config"` // Deprecated: Do not use.
+	// Optional configuration to have Stride execute the swap from the trade ICA
+	// with an oracle-enforced minimum output
+	// If nil, the swap is executed off-chain by the trade controller via authz
+	SwapConfig *SwapConfig `protobuf:"bytes,14,opt,name=swap_config,json=swapConfig,proto3" json:"swap_config,omitempty"`
+	// Optional ordered list of additional trade legs, each on their own trade
+	// zone, that are executed after the swap on the first trade zone
+	AdditionalLegs []TradeLeg `protobuf:"bytes,15,rep,name=additional_legs,json=additionalLegs,proto3" json:"additional_legs"`
+	// Address that currently holds authz trade permissions on the trade ICA
+	// (empty if no permissions have been granted)
+	// The grant is revoked when a swap config is added to the route
+	TradeController string `protobuf:"bytes,16,opt,name=trade_controller,json=tradeController,proto3" json:"trade_controller,omitempty"`
+	// Whether the trade controller's grant was for the legacy osmosis swap
+	// message
+	TradeControllerLegacy bool `protobuf:"varint,17,opt,name=trade_controller_legacy,json=tradeControllerLegacy,proto3" json:"trade_controller_legacy,omitempty"`
 }
 
 func (m *TradeRoute) Reset()         { *m = TradeRoute{} }
 func (m *TradeRoute) String() string { return proto.CompactTextString(m) }
 func (*TradeRoute) ProtoMessage()    {}
 func (*TradeRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c252b142ecf88017, []int{1}
+	return fileDescriptor_c252b142ecf88017, []int{5}
 }
 func (m *TradeRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -263,59 +648,126 @@ func (m *TradeRoute) GetTradeConfig() TradeConfig {
 	return TradeConfig{}
 }
 
+func (m *TradeRoute) GetSwapConfig() *SwapConfig {
+	if m != nil {
+		return m.SwapConfig
+	}
+	return nil
+}
+
+func (m *TradeRoute) GetAdditionalLegs() []TradeLeg {
+	if m != nil {
+		return m.AdditionalLegs
+	}
+	return nil
+}
+
+func (m *TradeRoute) GetTradeController() string {
+	if m != nil {
+		return m.TradeController
+	}
+	return ""
+}
+
+func (m *TradeRoute) GetTradeControllerLegacy() bool {
+	if m != nil {
+		return m.TradeControllerLegacy
+	}
+	return false
+}
+
 func init() {
+	proto.RegisterEnum("stride.stakeibc.TradeRecord_Status", TradeRecord_Status_name, TradeRecord_Status_value)
 	proto.RegisterType((*TradeConfig)(nil), "stride.stakeibc.TradeConfig")
+	proto.RegisterType((*SwapHop)(nil), "stride.stakeibc.SwapHop")
+	proto.RegisterType((*SwapConfig)(nil), "stride.stakeibc.SwapConfig")
+	proto.RegisterType((*TradeLeg)(nil), "stride.stakeibc.TradeLeg")
+	proto.RegisterType((*TradeRecord)(nil), "stride.stakeibc.TradeRecord")
 	proto.RegisterType((*TradeRoute)(nil), "stride.stakeibc.TradeRoute")
 }
 
 func init() { proto.RegisterFile("stride/stakeibc/trade_route.proto", fileDescriptor_c252b142ecf88017) }
 
 var fileDescriptor_c252b142ecf88017 = []byte{
-	// 697 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xdf, 0x4e, 0x13, 0x4f,
-	0x14, 0xee, 0x02, 0xbf, 0xf2, 0xeb, 0xb4, 0x95, 0xb8, 0x20, 0x2c, 0xc5, 0x14, 0xe4, 0xc2, 0x70,
-	0x43, 0x9b, 0x20, 0x41, 0x42, 0xb8, 0x29, 0x34, 0x4a, 0x13, 0x12, 0xcd, 0x52, 0xbd, 0xc0, 0xc4,
-	0xc9, 0x74, 0x77, 0x28, 0x1b, 0xba, 0x73, 0x36, 0x3b, 0x53, 0x5b, 0x7d, 0x0a, 0x1f, 0xc6, 0x87,
-	0xe0, 0x92, 0x78, 0x65, 0x4c, 0x24, 0x06, 0x2e, 0x7c, 0x0d, 0x33, 0x67, 0x76, 0x61, 0x0b, 0x5e,
-	0x20, 0xf1, 0xaa, 0x9d, 0x39, 0xe7, 0xfb, 0xce, 0x9f, 0x6f, 0xce, 0x59, 0xf2, 0x44, 0xaa, 0x38,
-	0xf0, 0x79, 0x5d, 0x2a, 0x76, 0xc2, 0x83, 0x8e, 0x57, 0x57, 0x31, 0xf3, 0x39, 0x8d, 0xa1, 0xaf,
-	0x78, 0x2d, 0x8a, 0x41, 0x81, 0x3d, 0x65, 0x5c, 0x6a, 0xa9, 0x4b, 0x65, 0xde, 0x03, 0x19, 0x82,
-	0xa4, 0x68, 0xae, 0x9b, 0x83, 0xf1, 0xad, 0xcc, 0x74, 0xa1, 0x0b, 0xe6, 0x5e, 0xff, 0x4b, 0x6e,
-	0x6f, 0x05, 0x09, 0x3c, 0x46, 0x99, 0xe7, 0x41, 0x5f, 0x28, 0xe3, 0xb2, 0xfc, 0x6b, 0x9c, 0x14,
-	0xdb, 0x3a, 0xf4, 0x2e, 0x88, 0xa3, 0xa0, 0x6b, 0xcf, 0x91, 0xc9, 0x08, 0xa0, 0x47, 0x03, 0xdf,
-	0xb1, 0x96, 0xac, 0x95, 0x09, 0x37, 0xaf, 0x8f, 0x2d, 0xdf, 0x7e, 0x47, 0x88, 0x1c, 0xb0, 0x88,
-	0x46, 0x71, 0xe0, 0x71, 0x67, 0x6c, 0xc9, 0x5a, 0x29, 0xec, 0x6c, 0x9f, 0x9e, 0x2f, 0xe6, 0xbe,
-	0x9f, 0x2f, 0x3e, 0xed, 0x06, 0xea, 0xb8, 0xdf, 0xa9, 0x79, 0x10, 0x26, 0x69, 0x25, 0x3f, 0xab,
-	0xd2, 0x3f, 0xa9, 0xab, 0x8f, 0x11, 0x97, 0xb5, 0x26, 0xf7, 0xbe, 0x7e, 0x59, 0x25, 0x49, 0xd6,
-	0x4d, 0xee, 0xb9, 0x05, 0xcd, 0xf7, 0x5a, 0xd3, 0xd9, 0xeb, 0x64, 0x16, 0x79, 0x69, 0x3f, 0xf2,
-	0x99, 0xe2, 0x54, 0x05, 0x21, 0x97, 0x8a, 0x85, 0x91, 0x33, 0x8e, 0x49, 0xcc, 0xa0, 0xf5, 0x0d,
-	0x1a, 0xdb, 0xa9, 0xcd, 0x1e, 0x92, 0x4a, 0xc8, 0x86, 0x94, 0xf5, 0x7a, 0x30, 0xe0, 0x3e, 0xc5,
-	0xf4, 0x7a, 0x20, 0x25, 0x8d, 0x99, 0xe2, 0xce, 0xc4, 0x3f, 0x48, 0x71, 0x36, 0x64, 0xc3, 0x86,
-	0xa1, 0x3f, 0x18, 0xb0, 0x68, 0x1f, 0xa4, 0x74, 0x99, 0xe2, 0xf6, 0x5b, 0x32, 0x15, 0x06, 0xc2,
-	0x44, 0x64, 0xa1, 0x6e, 0xa7, 0xf3, 0x1f, 0x86, 0xab, 0xfd, 0x45, 0xb8, 0x96, 0x50, 0x6e, 0x39,
-	0x0c, 0x84, 0x66, 0x6e, 0x20, 0x09, 0xf2, 0xb2, 0xe1, 0x08, 0x6f, 0xfe, 0x9e, 0xbc, 0x6c, 0x78,
-	0xcd, 0xbb, 0x35, 0xe6, 0x58, 0xcb, 0x3f, 0xf2, 0x84, 0xa0, 0xd2, 0xae, 0x7e, 0x63, 0xf6, 0x26,
-	0x99, 0x8f, 0xf9, 0x80, 0xc5, 0x3e, 0xf5, 0xb9, 0x80, 0x90, 0x82, 0xa0, 0xc7, 0x20, 0x15, 0xfd,
-	0x04, 0x82, 0xa3, 0xf4, 0x05, 0xf7, 0x91, 0x71, 0x68, 0x6a, 0xfb, 0x2b, 0xb1, 0x07, 0x52, 0x1d,
-	0x82, 0xe0, 0xf6, 0x36, 0x59, 0xb8, 0x89, 0x4c, 0xce, 0x88, 0xc5, 0xa7, 0xe1, 0xce, 0x8d, 0x60,
-	0x5d, 0x3c, 0x20, 0x7a, 0x8b, 0x54, 0x6e, 0xa2, 0xcd, 0xd3, 0x47, 0xf0, 0x38, 0x82, 0x67, 0x47,
-	0xc0, 0x98, 0x34, 0x62, 0x37, 0x88, 0x83, 0x39, 0xfe, 0x09, 0x89, 0x72, 0xbb, 0x33, 0xda, 0x7e,
-	0x0b, 0xb7, 0x4e, 0xe6, 0x46, 0x71, 0xd7, 0x95, 0xa2, 0x6c, 0xee, 0x74, 0x06, 0x76, 0x55, 0x67,
-	0x93, 0x94, 0xd0, 0x2f, 0x19, 0x18, 0x54, 0xa2, 0xb8, 0xb6, 0x50, 0xbb, 0x31, 0x96, 0xb5, 0xd6,
-	0x6e, 0xa3, 0x61, 0x5c, 0x76, 0x26, 0xb4, 0x4c, 0x6e, 0x51, 0xc3, 0x92, 0x2b, 0x7b, 0x8f, 0x3c,
-	0x48, 0xea, 0x4d, 0x79, 0x26, 0xef, 0xca, 0x53, 0x36, 0xc0, 0x94, 0xe9, 0x05, 0x29, 0x9b, 0x7a,
-	0x53, 0xa2, 0xff, 0xef, 0x4a, 0x54, 0x42, 0x5c, 0xca, 0xb3, 0x49, 0xe6, 0xb1, 0x2e, 0x05, 0xa9,
-	0x6e, 0xde, 0x31, 0x13, 0x82, 0xe3, 0xd0, 0x17, 0x8c, 0xf2, 0xda, 0xa1, 0x0d, 0x46, 0xb6, 0x5d,
-	0x63, 0x6d, 0xf9, 0x19, 0xed, 0x14, 0x24, 0xbd, 0xcf, 0x40, 0x49, 0x56, 0xbb, 0x36, 0x98, 0xad,
-	0x72, 0x85, 0xdd, 0x20, 0x8e, 0x41, 0x28, 0x30, 0xed, 0xcf, 0x20, 0x8b, 0x46, 0x3b, 0xb4, 0xb7,
-	0x41, 0x0b, 0x70, 0x8d, 0x7b, 0x4f, 0xa6, 0xf5, 0xa8, 0xa9, 0x98, 0x09, 0x79, 0xc4, 0xe3, 0x74,
-	0x2c, 0xca, 0xf7, 0x1a, 0x8b, 0x87, 0x61, 0xa0, 0x9f, 0x05, 0x32, 0x25, 0x23, 0xf7, 0x92, 0x94,
-	0x92, 0x4a, 0x70, 0x01, 0x3a, 0x25, 0x6c, 0xea, 0xe3, 0x5b, 0x4d, 0xcd, 0x2c, 0xc9, 0x9d, 0xbc,
-	0x0e, 0xeb, 0x58, 0x6e, 0x51, 0x65, 0x2e, 0xf7, 0x4f, 0x2f, 0xaa, 0xd6, 0xd9, 0x45, 0xd5, 0xfa,
-	0x79, 0x51, 0xb5, 0x3e, 0x5f, 0x56, 0x73, 0x67, 0x97, 0xd5, 0xdc, 0xb7, 0xcb, 0x6a, 0xee, 0x70,
-	0x2d, 0x93, 0xdd, 0x01, 0xd2, 0xae, 0xee, 0xb3, 0x8e, 0xac, 0x27, 0xdb, 0xf9, 0xc3, 0xda, 0xf3,
-	0xfa, 0x30, 0xf3, 0x21, 0xd0, 0xd9, 0x76, 0xf2, 0xb8, 0x9e, 0x9f, 0xfd, 0x0e, 0x00, 0x00, 0xff,
-	0xff, 0x97, 0x50, 0xab, 0x4d, 0x28, 0x06, 0x00, 0x00,
+	// 1234 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdf, 0x6e, 0x13, 0xc7,
+	0x17, 0xce, 0x3a, 0x89, 0x63, 0x1f, 0xff, 0x89, 0x19, 0x42, 0xb2, 0x84, 0xdf, 0x2f, 0x31, 0xae,
+	0x84, 0x5c, 0xa9, 0xd8, 0x95, 0x41, 0xb4, 0xa2, 0xdc, 0x24, 0x71, 0x20, 0x6e, 0x2d, 0x40, 0x1b,
+	0x53, 0x55, 0x54, 0xea, 0x6a, 0xbc, 0x3b, 0x6c, 0x56, 0xec, 0xee, 0xac, 0x76, 0x67, 0x1b, 0xc3,
+	0x53, 0xd0, 0x77, 0xe1, 0x21, 0xb8, 0x44, 0xbd, 0xaa, 0x7a, 0x41, 0x2b, 0xb8, 0xe8, 0x2b, 0xf4,
+	0xb2, 0x9a, 0x3f, 0xeb, 0x5d, 0xdb, 0xa0, 0x42, 0x9a, 0xab, 0x64, 0xe6, 0x9c, 0xef, 0x3b, 0x73,
+	0xe6, 0x9c, 0xf9, 0xce, 0x1a, 0xae, 0xc6, 0x2c, 0x72, 0x6d, 0xd2, 0x8d, 0x19, 0x7e, 0x4a, 0xdc,
+	0xb1, 0xd5, 0x65, 0x11, 0xb6, 0x89, 0x19, 0xd1, 0x84, 0x91, 0x4e, 0x18, 0x51, 0x46, 0xd1, 0xba,
+	0x74, 0xe9, 0xa4, 0x2e, 0xdb, 0x97, 0x2d, 0x1a, 0xfb, 0x34, 0x36, 0x85, 0xb9, 0x2b, 0x17, 0xd2,
+	0x77, 0x7b, 0xc3, 0xa1, 0x0e, 0x95, 0xfb, 0xfc, 0x3f, 0xb5, 0xbb, 0xeb, 0x50, 0xea, 0x78, 0xa4,
+	0x2b, 0x56, 0xe3, 0xe4, 0x49, 0x97, 0xb9, 0x3e, 0x89, 0x19, 0xf6, 0x43, 0xe5, 0xb0, 0x70, 0x0a,
+	0xd7, 0xc2, 0x26, 0xb6, 0x2c, 0x9a, 0x04, 0x4c, 0xba, 0xb4, 0xfe, 0x5a, 0x86, 0xca, 0x88, 0x9f,
+	0xed, 0x80, 0x06, 0x4f, 0x5c, 0x07, 0x6d, 0xc1, 0x5a, 0x48, 0xa9, 0x67, 0xba, 0xb6, 0xae, 0x35,
+	0xb5, 0xf6, 0x8a, 0x51, 0xe4, 0xcb, 0x81, 0x8d, 0x7e, 0x04, 0x88, 0x4f, 0x71, 0x68, 0x86, 0x91,
+	0x6b, 0x11, 0xbd, 0xd0, 0xd4, 0xda, 0xe5, 0xfd, 0x3b, 0xaf, 0xde, 0xec, 0x2e, 0xfd, 0xfe, 0x66,
+	0xf7, 0x9a, 0xe3, 0xb2, 0x93, 0x64, 0xdc, 0xb1, 0xa8, 0xaf, 0xce, 0xad, 0xfe, 0x5c, 0x8f, 0xed,
+	0xa7, 0x5d, 0xf6, 0x2c, 0x24, 0x71, 0xa7, 0x4f, 0xac, 0x5f, 0x5f, 0x5e, 0x07, 0x95, 0x56, 0x9f,
+	0x58, 0x46, 0x99, 0xf3, 0x3d, 0xe4, 0x74, 0xe8, 0x26, 0x6c, 0x0a, 0x5e, 0x33, 0x09, 0x6d, 0xcc,
+	0x88, 0x39, 0x4d, 0x44, 0x5f, 0x16, 0x87, 0xd8, 0x10, 0xd6, 0x47, 0xc2, 0x38, 0x4a, 0x6d, 0x68,
+	0x02, 0xdb, 0x3e, 0x9e, 0x98, 0xd8, 0xf3, 0xe8, 0x29, 0xb1, 0x4d, 0x71, 0x3c, 0x8f, 0xc6, 0xb1,
+	0x19, 0x61, 0x46, 0xf4, 0x95, 0x73, 0x38, 0xe2, 0xa6, 0x8f, 0x27, 0x7b, 0x92, 0xfe, 0xf8, 0x14,
+	0x87, 0x43, 0x1a, 0xc7, 0x06, 0x66, 0x04, 0x7d, 0x0f, 0xeb, 0xbe, 0x1b, 0xc8, 0x88, 0xd8, 0xe7,
+	0xd7, 0xa9, 0xaf, 0x8a, 0x70, 0x9d, 0x4f, 0x08, 0x37, 0x08, 0x98, 0x51, 0xf3, 0xdd, 0x80, 0x33,
+	0xef, 0x09, 0x12, 0xc1, 0x8b, 0x27, 0x33, 0xbc, 0xc5, 0x33, 0xf2, 0xe2, 0x49, 0xc6, 0x7b, 0xbb,
+	0xa0, 0x6b, 0xad, 0x6f, 0x61, 0x8d, 0xef, 0x1c, 0xd1, 0xf0, 0xc3, 0x45, 0xbe, 0x06, 0xeb, 0x8c,
+	0x3e, 0x25, 0x81, 0x49, 0x13, 0x66, 0xda, 0x24, 0xa0, 0xbe, 0xac, 0xb4, 0x51, 0x13, 0xdb, 0x0f,
+	0x12, 0xd6, 0xe7, 0x9b, 0xad, 0xbf, 0x0b, 0x00, 0x9c, 0xec, 0xdf, 0x9a, 0xe6, 0x06, 0x6c, 0x46,
+	0xe4, 0x14, 0x47, 0xb6, 0x24, 0x33, 0x69, 0x60, 0xca, 0x96, 0x54, 0xb4, 0x17, 0xa5, 0x55, 0x90,
+	0x3e, 0x08, 0x8e, 0x85, 0x09, 0x75, 0x61, 0xe3, 0x84, 0xc6, 0x6c, 0x01, 0xb2, 0x2c, 0x20, 0x17,
+	0xb8, 0x6d, 0x16, 0x60, 0x42, 0x55, 0xdc, 0x9a, 0xe7, 0x86, 0x21, 0x76, 0xce, 0xa7, 0xf2, 0x15,
+	0x7e, 0x81, 0x8a, 0xf0, 0x7d, 0x65, 0x59, 0x3d, 0x87, 0xb2, 0xa0, 0x1e, 0xac, 0x9c, 0xd0, 0x30,
+	0xd6, 0x8b, 0xcd, 0xe5, 0x76, 0xa5, 0xa7, 0x77, 0xe6, 0x14, 0xa1, 0xa3, 0xea, 0xb5, 0xbf, 0xc2,
+	0xc3, 0x18, 0xc2, 0xb7, 0xf5, 0xb2, 0x00, 0x25, 0xf1, 0x60, 0x87, 0xc4, 0x41, 0x77, 0xa1, 0x26,
+	0x85, 0x45, 0x3d, 0x6a, 0x71, 0xfd, 0x95, 0xde, 0x95, 0x05, 0xa6, 0xc1, 0xc1, 0xde, 0x9e, 0x74,
+	0x51, 0x64, 0x55, 0x81, 0x53, 0x7b, 0xe8, 0x6b, 0xb8, 0xec, 0x06, 0x61, 0x92, 0xbb, 0x73, 0x49,
+	0xfb, 0x9c, 0x06, 0x69, 0xa9, 0x2e, 0x09, 0x07, 0x75, 0xf1, 0xe2, 0x00, 0x8f, 0x69, 0x40, 0xd0,
+	0x6d, 0xd8, 0xa6, 0x09, 0xfb, 0x10, 0x54, 0x96, 0x6c, 0x53, 0x7a, 0x2c, 0x60, 0xbf, 0x00, 0xe4,
+	0x06, 0x63, 0x9a, 0x04, 0xb6, 0x69, 0x9d, 0xe0, 0x20, 0x20, 0xa2, 0x83, 0x44, 0xf5, 0x8c, 0x86,
+	0xb2, 0x1c, 0x48, 0xc3, 0xc0, 0x46, 0x77, 0xa0, 0x22, 0x0a, 0x60, 0x89, 0x9e, 0xd3, 0x57, 0x3f,
+	0x90, 0x69, 0xd6, 0x96, 0x06, 0xc4, 0xd3, 0xff, 0x5b, 0xbf, 0x14, 0x95, 0xce, 0x19, 0xc4, 0xa2,
+	0x91, 0x8d, 0xea, 0x50, 0x98, 0x76, 0x6b, 0xc1, 0xb5, 0xd1, 0x55, 0xa8, 0xe6, 0x3b, 0x55, 0x25,
+	0x5d, 0xc9, 0xf5, 0x27, 0xfa, 0x3f, 0x40, 0xd6, 0x97, 0x2a, 0xb5, 0xf2, 0xb4, 0x1b, 0x79, 0x93,
+	0xc8, 0xb7, 0xe3, 0x06, 0x69, 0x93, 0xac, 0x9c, 0xad, 0x49, 0x04, 0xcd, 0x20, 0x50, 0x4d, 0x62,
+	0xc2, 0x06, 0xd7, 0x9a, 0xec, 0x5d, 0xfe, 0xa7, 0x0e, 0xbc, 0xe0, 0xbb, 0xc1, 0x48, 0xbd, 0x65,
+	0x15, 0xe0, 0x07, 0x68, 0x2c, 0x90, 0x9f, 0x4d, 0x75, 0xea, 0x6c, 0x96, 0xd9, 0x84, 0x2a, 0x8d,
+	0xb0, 0xe5, 0x11, 0x35, 0x35, 0xd6, 0xce, 0xe3, 0x61, 0x4a, 0x46, 0x39, 0x37, 0x2c, 0xa8, 0x47,
+	0x04, 0x7b, 0xee, 0x73, 0x62, 0xab, 0x10, 0xa5, 0x73, 0x08, 0x51, 0x4b, 0x39, 0x65, 0x90, 0xef,
+	0xa0, 0x1e, 0x27, 0x63, 0xdf, 0x65, 0x8c, 0xd8, 0x62, 0x32, 0xe9, 0x65, 0xd1, 0x7b, 0xdb, 0x1d,
+	0x39, 0x7f, 0x3b, 0xe9, 0xfc, 0xed, 0x4c, 0x47, 0xd3, 0x7e, 0x89, 0x1f, 0xe0, 0xc5, 0x1f, 0xbb,
+	0x9a, 0x51, 0x9b, 0x62, 0xb9, 0x15, 0x7d, 0x03, 0xc5, 0x98, 0x61, 0x96, 0xc4, 0x3a, 0x34, 0xb5,
+	0x76, 0xbd, 0xf7, 0xd9, 0x42, 0x03, 0xe7, 0xba, 0xb4, 0x73, 0x2c, 0x5c, 0x0d, 0x05, 0x41, 0x57,
+	0xa0, 0xec, 0x11, 0xc7, 0x74, 0x03, 0x9b, 0x4c, 0xf4, 0x4a, 0x53, 0x6b, 0xd7, 0x8c, 0x92, 0x47,
+	0x9c, 0x01, 0x5f, 0xb7, 0xbe, 0x84, 0xa2, 0x74, 0x47, 0x15, 0x58, 0x7b, 0x78, 0x78, 0xbf, 0x3f,
+	0xb8, 0x7f, 0xaf, 0xb1, 0x84, 0x6a, 0x50, 0x3e, 0x7e, 0x74, 0x70, 0x70, 0x78, 0xd8, 0x3f, 0xec,
+	0x37, 0x34, 0x04, 0x50, 0xbc, 0xbb, 0x37, 0x18, 0x1e, 0xf6, 0x1b, 0x85, 0xd6, 0xab, 0x12, 0x80,
+	0x8c, 0xc6, 0x3f, 0x4b, 0xb8, 0x08, 0xcc, 0x8b, 0xb5, 0xe8, 0x77, 0xf1, 0x92, 0x35, 0x29, 0x02,
+	0x33, 0x7a, 0x7d, 0x44, 0x63, 0x26, 0x1e, 0xf2, 0x1d, 0xb8, 0x32, 0x8f, 0x54, 0xeb, 0x9c, 0x80,
+	0x6c, 0xcd, 0x60, 0x0d, 0xb1, 0x48, 0x25, 0x64, 0x1e, 0xbd, 0x28, 0x21, 0x33, 0xe0, 0x4c, 0x42,
+	0x6e, 0x81, 0x3e, 0x3b, 0x2b, 0x72, 0x48, 0x29, 0x24, 0x1b, 0xb9, 0x79, 0x91, 0xe1, 0x6e, 0xc2,
+	0xd6, 0x2c, 0x2e, 0xcb, 0x74, 0x55, 0x4e, 0xa6, 0x1c, 0x6c, 0x9a, 0x67, 0x1f, 0xaa, 0xc2, 0x2f,
+	0x55, 0xdb, 0xe2, 0xc7, 0xaa, 0x6d, 0x85, 0xc3, 0xd4, 0x16, 0x3a, 0x82, 0xba, 0xcc, 0x66, 0xca,
+	0xb3, 0xf6, 0xb1, 0x3c, 0x35, 0x09, 0x4c, 0x99, 0x16, 0xe4, 0xbf, 0x74, 0x66, 0xf9, 0x17, 0x79,
+	0x31, 0x9a, 0xd6, 0x2d, 0xa7, 0xc7, 0x65, 0x59, 0x79, 0xee, 0x30, 0xa2, 0xb2, 0x6c, 0x99, 0x28,
+	0x67, 0xb5, 0x63, 0x54, 0xdd, 0x7d, 0x0e, 0x0a, 0xf9, 0xda, 0x8d, 0xa8, 0xfc, 0xce, 0x9c, 0x62,
+	0x6f, 0x81, 0x2e, 0x11, 0x8c, 0xca, 0xeb, 0xcf, 0x21, 0x2b, 0xb2, 0x76, 0xc2, 0x3e, 0xa2, 0xbc,
+	0x00, 0x19, 0xee, 0x27, 0xb8, 0x28, 0x04, 0x31, 0xc2, 0x41, 0xfc, 0x84, 0x44, 0xa9, 0x64, 0xd5,
+	0xce, 0xae, 0x87, 0x8a, 0x49, 0xa9, 0xd6, 0x3d, 0xa8, 0xaa, 0x4c, 0xe4, 0xa4, 0xa9, 0x8a, 0x4b,
+	0xfd, 0xdf, 0xfb, 0x1f, 0xaa, 0x1c, 0x2f, 0xfb, 0x45, 0x1e, 0x56, 0xd7, 0x8c, 0x0a, 0xcb, 0x36,
+	0xe7, 0x27, 0x56, 0xfd, 0x93, 0x26, 0x16, 0x3a, 0x82, 0x75, 0x6c, 0xdb, 0x2e, 0x73, 0x69, 0x80,
+	0x3d, 0xd3, 0x23, 0x4e, 0xac, 0xaf, 0x8b, 0xef, 0x84, 0xcb, 0xef, 0x3f, 0xc9, 0x90, 0x38, 0xaa,
+	0xb8, 0xf5, 0x0c, 0x37, 0x24, 0x4e, 0x8c, 0x3e, 0x87, 0xc6, 0x34, 0x21, 0x16, 0x51, 0xcf, 0x23,
+	0x91, 0xde, 0x10, 0x17, 0xbc, 0x9e, 0x1e, 0x57, 0x6d, 0xa3, 0x5b, 0xb0, 0x35, 0xef, 0xca, 0x43,
+	0x63, 0xeb, 0x99, 0x7e, 0xa1, 0xa9, 0xb5, 0x4b, 0xc6, 0xa5, 0x39, 0xc4, 0x50, 0x18, 0xf7, 0x87,
+	0xaf, 0xde, 0xee, 0x68, 0xaf, 0xdf, 0xee, 0x68, 0x7f, 0xbe, 0xdd, 0xd1, 0x5e, 0xbc, 0xdb, 0x59,
+	0x7a, 0xfd, 0x6e, 0x67, 0xe9, 0xb7, 0x77, 0x3b, 0x4b, 0x8f, 0x7b, 0xb9, 0x42, 0xc8, 0xef, 0xb5,
+	0xeb, 0x43, 0x3c, 0x8e, 0xbb, 0xea, 0xa7, 0xc9, 0xcf, 0xbd, 0xaf, 0xba, 0x93, 0xdc, 0xcf, 0x24,
+	0x5e, 0x98, 0x71, 0x51, 0x28, 0xea, 0x8d, 0x7f, 0x06, 0x00, 0x45, 0x62, 0x49, 0x69, 0x46, 0x0d,
+	0x00, 0x00,
 }
 
 func (m *TradeConfig) Marshal() (dAtA []byte, err error) {
@@ -391,7 +843,7 @@ func (m *TradeConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TradeRoute) Marshal() (dAtA []byte, err error) {
+func (m *SwapHop) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -401,42 +853,376 @@ func (m *TradeRoute) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TradeRoute) MarshalTo(dAtA []byte) (int, error) {
+func (m *SwapHop) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TradeRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SwapHop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintTradeRoute(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTradeRoute(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTradeRoute(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	{
-		size := m.MinTransferAmount.Size()
+		size := m.MaxSwapAmount.Size()
 		i -= size
-		if _, err := m.MinTransferAmount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxSwapAmount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTradeRoute(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x6a
+	dAtA[i] = 0x2a
 	{
-		size, err := m.TradeConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTradeRoute(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x62
-	if len(m.TradeToHostChannelId) > 0 {
-		i -= len(m.TradeToHostChannelId)
-		copy(dAtA[i:], m.TradeToHostChannelId)
-		i = encodeVarintTradeRoute(dAtA, i, uint64(len(m.TradeToHostChannelId)))
+	dAtA[i] = 0x22
+	if len(m.HostDenomOnStride) > 0 {
+		i -= len(m.HostDenomOnStride)
+		copy(dAtA[i:], m.HostDenomOnStride)
+		i = encodeVarintTradeRoute(dAtA, i, uint64(len(m.HostDenomOnStride)))
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0x1a
+	}
+	if len(m.RewardDenomOnStride) > 0 {
+		i -= len(m.RewardDenomOnStride)
+		copy(dAtA[i:], m.RewardDenomOnStride)
+		i = encodeVarintTradeRoute(dAtA, i, uint64(len(m.RewardDenomOnStride)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTradeRoute(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TradeLeg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradeLeg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradeLeg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SwapConfig != nil {
+		{
+			size, err := m.SwapConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTradeRoute(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.InboundChannelId) > 0 {
+		i -= len(m.InboundChannelId)
+		copy(dAtA[i:], m.InboundChannelId)
+		i = encodeVarintTradeRoute(dAtA, i, uint64(len(m.InboundChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OutputDenomOnTradeZone) > 0 {
+		i -= len(m.OutputDenomOnTradeZone)
+		copy(dAtA[i:], m.OutputDenomOnTradeZone)
+		i = encodeVarintTradeRoute(dAtA, i, uint64(len(m.OutputDenomOnTradeZone)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.InputDenomOnTradeZone) > 0 {
+		i -= len(m.InputDenomOnTradeZone)
+		copy(dAtA[i:], m.InputDenomOnTradeZone)
+		i = encodeVarintTradeRoute(dAtA, i, uint64(len(m.InputDenomOnTradeZone)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.TradeAccount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTradeRoute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TradeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LegIndex != 0 {
+		i = encodeVarintTradeRoute(dAtA, i, uint64(m.LegIndex))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Status != 0 {
+		i = encodeVarintTradeRoute(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x50
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmittedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmittedTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTradeRoute(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.RealizedPrice.Size()
+		i -= size
+		if _, err := m.RealizedPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTradeRoute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.OraclePrice.Size()
+		i -= size
+		if _, err := m.OraclePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTradeRoute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTradeRoute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinTokenOutAmount.Size()
+		i -= size
+		if _, err := m.MinTokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTradeRoute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TokenInAmount.Size()
+		i -= size
+		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTradeRoute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.HostDenom) > 0 {
+		i -= len(m.HostDenom)
+		copy(dAtA[i:], m.HostDenom)
+		i = encodeVarintTradeRoute(dAtA, i, uint64(len(m.HostDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RewardDenom) > 0 {
+		i -= len(m.RewardDenom)
+		copy(dAtA[i:], m.RewardDenom)
+		i = encodeVarintTradeRoute(dAtA, i, uint64(len(m.RewardDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTradeRoute(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TradeRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradeRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradeRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TradeControllerLegacy {
+		i--
+		if m.TradeControllerLegacy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.TradeController) > 0 {
+		i -= len(m.TradeController)
+		copy(dAtA[i:], m.TradeController)
+		i = encodeVarintTradeRoute(dAtA, i, uint64(len(m.TradeController)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.AdditionalLegs) > 0 {
+		for iNdEx := len(m.AdditionalLegs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalLegs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTradeRoute(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.SwapConfig != nil {
+		{
+			size, err := m.SwapConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTradeRoute(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	{
+		size := m.MinTransferAmount.Size()
+		i -= size
+		if _, err := m.MinTransferAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTradeRoute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size, err := m.TradeConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTradeRoute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.TradeToHostChannelId) > 0 {
+		i -= len(m.TradeToHostChannelId)
+		copy(dAtA[i:], m.TradeToHostChannelId)
+		i = encodeVarintTradeRoute(dAtA, i, uint64(len(m.TradeToHostChannelId)))
+		i--
+		dAtA[i] = 0x5a
 	}
 	if len(m.RewardToTradeChannelId) > 0 {
 		i -= len(m.RewardToTradeChannelId)
@@ -554,97 +1340,1051 @@ func (m *TradeConfig) Size() (n int) {
 	return n
 }
 
-func (m *TradeRoute) Size() (n int) {
+func (m *SwapHop) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RewardDenomOnHostZone)
-	if l > 0 {
-		n += 1 + l + sovTradeRoute(uint64(l))
+	if m.PoolId != 0 {
+		n += 1 + sovTradeRoute(uint64(m.PoolId))
 	}
-	l = len(m.RewardDenomOnRewardZone)
+	l = len(m.TokenOutDenom)
 	if l > 0 {
 		n += 1 + l + sovTradeRoute(uint64(l))
 	}
-	l = len(m.RewardDenomOnTradeZone)
-	if l > 0 {
-		n += 1 + l + sovTradeRoute(uint64(l))
+	return n
+}
+
+func (m *SwapConfig) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.HostDenomOnTradeZone)
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTradeRoute(uint64(m.PoolId))
+	}
+	l = len(m.RewardDenomOnStride)
 	if l > 0 {
 		n += 1 + l + sovTradeRoute(uint64(l))
 	}
-	l = len(m.HostDenomOnHostZone)
+	l = len(m.HostDenomOnStride)
 	if l > 0 {
 		n += 1 + l + sovTradeRoute(uint64(l))
 	}
-	l = m.HostAccount.Size()
+	l = m.MaxSlippage.Size()
 	n += 1 + l + sovTradeRoute(uint64(l))
-	l = m.RewardAccount.Size()
+	l = m.MaxSwapAmount.Size()
 	n += 1 + l + sovTradeRoute(uint64(l))
+	if len(m.Hops) > 0 {
+		for _, e := range m.Hops {
+			l = e.Size()
+			n += 1 + l + sovTradeRoute(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TradeLeg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = m.TradeAccount.Size()
 	n += 1 + l + sovTradeRoute(uint64(l))
-	l = len(m.HostToRewardChannelId)
+	l = len(m.InputDenomOnTradeZone)
 	if l > 0 {
 		n += 1 + l + sovTradeRoute(uint64(l))
 	}
-	l = len(m.RewardToTradeChannelId)
+	l = len(m.OutputDenomOnTradeZone)
 	if l > 0 {
 		n += 1 + l + sovTradeRoute(uint64(l))
 	}
-	l = len(m.TradeToHostChannelId)
+	l = len(m.InboundChannelId)
 	if l > 0 {
 		n += 1 + l + sovTradeRoute(uint64(l))
 	}
-	l = m.TradeConfig.Size()
-	n += 1 + l + sovTradeRoute(uint64(l))
-	l = m.MinTransferAmount.Size()
-	n += 1 + l + sovTradeRoute(uint64(l))
+	if m.SwapConfig != nil {
+		l = m.SwapConfig.Size()
+		n += 1 + l + sovTradeRoute(uint64(l))
+	}
 	return n
 }
 
-func sovTradeRoute(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTradeRoute(x uint64) (n int) {
-	return sovTradeRoute(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *TradeConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTradeRoute
+func (m *TradeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTradeRoute(uint64(m.Id))
+	}
+	l = len(m.RewardDenom)
+	if l > 0 {
+		n += 1 + l + sovTradeRoute(uint64(l))
+	}
+	l = len(m.HostDenom)
+	if l > 0 {
+		n += 1 + l + sovTradeRoute(uint64(l))
+	}
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovTradeRoute(uint64(l))
+	l = m.MinTokenOutAmount.Size()
+	n += 1 + l + sovTradeRoute(uint64(l))
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovTradeRoute(uint64(l))
+	l = m.OraclePrice.Size()
+	n += 1 + l + sovTradeRoute(uint64(l))
+	l = m.RealizedPrice.Size()
+	n += 1 + l + sovTradeRoute(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmittedTime)
+	n += 1 + l + sovTradeRoute(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovTradeRoute(uint64(m.Status))
+	}
+	if m.LegIndex != 0 {
+		n += 1 + sovTradeRoute(uint64(m.LegIndex))
+	}
+	return n
+}
+
+func (m *TradeRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RewardDenomOnHostZone)
+	if l > 0 {
+		n += 1 + l + sovTradeRoute(uint64(l))
+	}
+	l = len(m.RewardDenomOnRewardZone)
+	if l > 0 {
+		n += 1 + l + sovTradeRoute(uint64(l))
+	}
+	l = len(m.RewardDenomOnTradeZone)
+	if l > 0 {
+		n += 1 + l + sovTradeRoute(uint64(l))
+	}
+	l = len(m.HostDenomOnTradeZone)
+	if l > 0 {
+		n += 1 + l + sovTradeRoute(uint64(l))
+	}
+	l = len(m.HostDenomOnHostZone)
+	if l > 0 {
+		n += 1 + l + sovTradeRoute(uint64(l))
+	}
+	l = m.HostAccount.Size()
+	n += 1 + l + sovTradeRoute(uint64(l))
+	l = m.RewardAccount.Size()
+	n += 1 + l + sovTradeRoute(uint64(l))
+	l = m.TradeAccount.Size()
+	n += 1 + l + sovTradeRoute(uint64(l))
+	l = len(m.HostToRewardChannelId)
+	if l > 0 {
+		n += 1 + l + sovTradeRoute(uint64(l))
+	}
+	l = len(m.RewardToTradeChannelId)
+	if l > 0 {
+		n += 1 + l + sovTradeRoute(uint64(l))
+	}
+	l = len(m.TradeToHostChannelId)
+	if l > 0 {
+		n += 1 + l + sovTradeRoute(uint64(l))
+	}
+	l = m.TradeConfig.Size()
+	n += 1 + l + sovTradeRoute(uint64(l))
+	l = m.MinTransferAmount.Size()
+	n += 1 + l + sovTradeRoute(uint64(l))
+	if m.SwapConfig != nil {
+		l = m.SwapConfig.Size()
+		n += 1 + l + sovTradeRoute(uint64(l))
+	}
+	if len(m.AdditionalLegs) > 0 {
+		for _, e := range m.AdditionalLegs {
+			l = e.Size()
+			n += 1 + l + sovTradeRoute(uint64(l))
+		}
+	}
+	l = len(m.TradeController)
+	if l > 0 {
+		n += 2 + l + sovTradeRoute(uint64(l))
+	}
+	if m.TradeControllerLegacy {
+		n += 3
+	}
+	return n
+}
+
+func sovTradeRoute(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTradeRoute(x uint64) (n int) {
+	return sovTradeRoute(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TradeConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTradeRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradeConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradeConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceUpdateTimestamp", wireType)
+			}
+			m.PriceUpdateTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceUpdateTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAllowedSwapLossRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAllowedSwapLossRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSwapAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSwapAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTradeRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapHop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTradeRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapHop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapHop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTradeRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTradeRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenomOnStride", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardDenomOnStride = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostDenomOnStride", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostDenomOnStride = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSwapAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hops = append(m.Hops, SwapHop{})
+			if err := m.Hops[len(m.Hops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTradeRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TradeLeg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTradeRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradeLeg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradeLeg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TradeAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputDenomOnTradeZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InputDenomOnTradeZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputDenomOnTradeZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputDenomOnTradeZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SwapConfig == nil {
+				m.SwapConfig = &SwapConfig{}
+			}
+			if err := m.SwapConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTradeRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TradeRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTradeRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradeRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeRoute
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeRoute
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TradeConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TradeConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.PoolId = 0
+			m.RewardDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTradeRoute
@@ -654,14 +2394,27 @@ func (m *TradeConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -689,15 +2442,15 @@ func (m *TradeConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SwapPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceUpdateTimestamp", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTokenOutAmount", wireType)
 			}
-			m.PriceUpdateTimestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTradeRoute
@@ -707,14 +2460,29 @@ func (m *TradeConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PriceUpdateTimestamp |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinTokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAllowedSwapLossRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -742,13 +2510,13 @@ func (m *TradeConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxAllowedSwapLossRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -776,13 +2544,13 @@ func (m *TradeConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSwapAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OraclePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -810,10 +2578,81 @@ func (m *TradeConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxSwapAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RealizedPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SubmittedTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TradeRecord_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegIndex", wireType)
+			}
+			m.LegIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LegIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTradeRoute(dAtA[iNdEx:])
@@ -1286,6 +3125,128 @@ func (m *TradeRoute) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SwapConfig == nil {
+				m.SwapConfig = &SwapConfig{}
+			}
+			if err := m.SwapConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalLegs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalLegs = append(m.AdditionalLegs, TradeLeg{})
+			if err := m.AdditionalLegs[len(m.AdditionalLegs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeController", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradeController = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeControllerLegacy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TradeControllerLegacy = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTradeRoute(dAtA[iNdEx:])
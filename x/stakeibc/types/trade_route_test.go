@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v27/x/stakeibc/types"
+)
+
+func TestGetTradeLegs(t *testing.T) {
+	swapConfig := types.SwapConfig{PoolId: 1}
+	route := types.TradeRoute{
+		RewardDenomOnRewardZone: "ureward",
+		HostDenomOnHostZone:     "uhost",
+		RewardDenomOnTradeZone:  "ibc/reward-on-trade",
+		HostDenomOnTradeZone:    "ibc/intermediate-on-trade",
+		RewardToTradeChannelId:  "channel-1",
+		TradeAccount: types.ICAAccount{
+			ChainId: "trade-0",
+			Type:    types.ICAAccountType_CONVERTER_TRADE,
+		},
+		SwapConfig: &swapConfig,
+	}
+
+	// With no additional legs, the route should only have the first leg
+	expectedFirstLeg := types.TradeLeg{
+		TradeAccount:           route.TradeAccount,
+		InputDenomOnTradeZone:  "ibc/reward-on-trade",
+		OutputDenomOnTradeZone: "ibc/intermediate-on-trade",
+		InboundChannelId:       "channel-1",
+		SwapConfig:             &swapConfig,
+	}
+	require.Equal(t, []types.TradeLeg{expectedFirstLeg}, route.GetTradeLegs(), "single leg")
+	require.True(t, route.IsFinalTradeLeg(0), "first leg should be final")
+
+	// Add an additional leg
+	secondLeg := types.TradeLeg{
+		TradeAccount: types.ICAAccount{
+			ChainId: "trade-1",
+			Type:    types.ICAAccountType_CONVERTER_TRADE,
+		},
+		InputDenomOnTradeZone:  "ibc/intermediate-on-trade-1",
+		OutputDenomOnTradeZone: "ibc/host-on-trade-1",
+		InboundChannelId:       "channel-2",
+	}
+	route.AdditionalLegs = []types.TradeLeg{secondLeg}
+	require.Equal(t, []types.TradeLeg{expectedFirstLeg, secondLeg}, route.GetTradeLegs(), "multiple legs")
+	require.False(t, route.IsFinalTradeLeg(0), "first leg should not be final")
+	require.True(t, route.IsFinalTradeLeg(1), "second leg should be final")
+
+	leg, found := route.GetTradeLeg(1)
+	require.True(t, found, "second leg should be found")
+	require.Equal(t, secondLeg, leg, "second leg")
+
+	_, found = route.GetTradeLeg(2)
+	require.False(t, found, "third leg should not be found")
+
+	// The first leg's ICA owner should be unchanged from single leg routes
+	require.Equal(t, "trade-0.ureward-uhost.CONVERTER_TRADE", route.GetTradeLegICAOwner(0, route.TradeAccount), "first leg owner")
+	require.Equal(t, "trade-1.ureward-uhost-leg1.CONVERTER_TRADE", route.GetTradeLegICAOwner(1, secondLeg.TradeAccount), "second leg owner")
+}
@@ -1459,9 +1459,10 @@ func (m *MsgUpdateValidatorSharesExchRateResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgUpdateValidatorSharesExchRateResponse proto.InternalMessageInfo
 
 type MsgCalibrateDelegation struct {
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Valoper string `protobuf:"bytes,3,opt,name=valoper,proto3" json:"valoper,omitempty"`
+	Creator              string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId              string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Valoper              string `protobuf:"bytes,3,opt,name=valoper,proto3" json:"valoper,omitempty"`
+	DelegationShardIndex uint32 `protobuf:"varint,4,opt,name=delegation_shard_index,json=delegationShardIndex,proto3" json:"delegation_shard_index,omitempty"`
 }
 
 func (m *MsgCalibrateDelegation) Reset()         { *m = MsgCalibrateDelegation{} }
@@ -1518,6 +1519,13 @@ func (m *MsgCalibrateDelegation) GetValoper() string {
 	return ""
 }
 
+func (m *MsgCalibrateDelegation) GetDelegationShardIndex() uint32 {
+	if m != nil {
+		return m.DelegationShardIndex
+	}
+	return 0
+}
+
 type MsgCalibrateDelegationResponse struct {
 }
 
@@ -1642,6 +1650,95 @@ func (m *MsgResumeHostZoneResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgResumeHostZoneResponse proto.InternalMessageInfo
 
+// Registers an additional delegation ICA (shard) on a host zone
+type MsgRegisterDelegationShard struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *MsgRegisterDelegationShard) Reset()         { *m = MsgRegisterDelegationShard{} }
+func (m *MsgRegisterDelegationShard) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterDelegationShard) ProtoMessage()    {}
+func (*MsgRegisterDelegationShard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{33}
+}
+func (m *MsgRegisterDelegationShard) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterDelegationShard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterDelegationShard.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterDelegationShard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterDelegationShard.Merge(m, src)
+}
+func (m *MsgRegisterDelegationShard) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterDelegationShard) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterDelegationShard.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterDelegationShard proto.InternalMessageInfo
+
+func (m *MsgRegisterDelegationShard) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRegisterDelegationShard) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+type MsgRegisterDelegationShardResponse struct {
+}
+
+func (m *MsgRegisterDelegationShardResponse) Reset()         { *m = MsgRegisterDelegationShardResponse{} }
+func (m *MsgRegisterDelegationShardResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterDelegationShardResponse) ProtoMessage()    {}
+func (*MsgRegisterDelegationShardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{34}
+}
+func (m *MsgRegisterDelegationShardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterDelegationShardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterDelegationShardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterDelegationShardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterDelegationShardResponse.Merge(m, src)
+}
+func (m *MsgRegisterDelegationShardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterDelegationShardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterDelegationShardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterDelegationShardResponse proto.InternalMessageInfo
+
 // Creates a new trade route
 type MsgCreateTradeRoute struct {
 	// authority is the address that controls the module (defaults to x/gov unless
@@ -1690,13 +1787,17 @@ type MsgCreateTradeRoute struct {
 	// Minimum amount of reward token that must be accumulated before
 	// the tokens are transferred to the trade ICA
 	MinTransferAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,17,opt,name=min_transfer_amount,json=minTransferAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_transfer_amount"`
+	// Optional additional legs for routes that trade across multiple venues
+	// If provided, trade_to_host_transfer_channel_id should be the channel from
+	// the final leg's trade zone back to the host zone
+	AdditionalLegs []TradeLegConfig `protobuf:"bytes,18,rep,name=additional_legs,json=additionalLegs,proto3" json:"additional_legs"`
 }
 
 func (m *MsgCreateTradeRoute) Reset()         { *m = MsgCreateTradeRoute{} }
 func (m *MsgCreateTradeRoute) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateTradeRoute) ProtoMessage()    {}
 func (*MsgCreateTradeRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{33}
+	return fileDescriptor_9b7e09c9ad51cd54, []int{35}
 }
 func (m *MsgCreateTradeRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1825,6 +1926,95 @@ func (m *MsgCreateTradeRoute) GetMaxAllowedSwapLossRate() string {
 	return ""
 }
 
+func (m *MsgCreateTradeRoute) GetAdditionalLegs() []TradeLegConfig {
+	if m != nil {
+		return m.AdditionalLegs
+	}
+	return nil
+}
+
+// Configuration of an additional trade leg when creating a trade route
+type TradeLegConfig struct {
+	// Connection ID between stride and the leg's trade zone
+	StrideToTradeConnectionId string `protobuf:"bytes,1,opt,name=stride_to_trade_connection_id,json=strideToTradeConnectionId,proto3" json:"stride_to_trade_connection_id,omitempty"`
+	// Transfer channel on the previous leg's trade zone to this leg's trade zone
+	InboundTransferChannelId string `protobuf:"bytes,2,opt,name=inbound_transfer_channel_id,json=inboundTransferChannelId,proto3" json:"inbound_transfer_channel_id,omitempty"`
+	// ibc denom of the leg's input token on the leg's trade zone
+	InputDenomOnTrade string `protobuf:"bytes,3,opt,name=input_denom_on_trade,json=inputDenomOnTrade,proto3" json:"input_denom_on_trade,omitempty"`
+	// ibc denom of the leg's output token on the leg's trade zone
+	OutputDenomOnTrade string `protobuf:"bytes,4,opt,name=output_denom_on_trade,json=outputDenomOnTrade,proto3" json:"output_denom_on_trade,omitempty"`
+	// Configuration of the swap executed on this leg
+	SwapConfig *SwapConfig `protobuf:"bytes,5,opt,name=swap_config,json=swapConfig,proto3" json:"swap_config,omitempty"`
+}
+
+func (m *TradeLegConfig) Reset()         { *m = TradeLegConfig{} }
+func (m *TradeLegConfig) String() string { return proto.CompactTextString(m) }
+func (*TradeLegConfig) ProtoMessage()    {}
+func (*TradeLegConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{36}
+}
+func (m *TradeLegConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradeLegConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradeLegConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TradeLegConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeLegConfig.Merge(m, src)
+}
+func (m *TradeLegConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradeLegConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradeLegConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradeLegConfig proto.InternalMessageInfo
+
+func (m *TradeLegConfig) GetStrideToTradeConnectionId() string {
+	if m != nil {
+		return m.StrideToTradeConnectionId
+	}
+	return ""
+}
+
+func (m *TradeLegConfig) GetInboundTransferChannelId() string {
+	if m != nil {
+		return m.InboundTransferChannelId
+	}
+	return ""
+}
+
+func (m *TradeLegConfig) GetInputDenomOnTrade() string {
+	if m != nil {
+		return m.InputDenomOnTrade
+	}
+	return ""
+}
+
+func (m *TradeLegConfig) GetOutputDenomOnTrade() string {
+	if m != nil {
+		return m.OutputDenomOnTrade
+	}
+	return ""
+}
+
+func (m *TradeLegConfig) GetSwapConfig() *SwapConfig {
+	if m != nil {
+		return m.SwapConfig
+	}
+	return nil
+}
+
 type MsgCreateTradeRouteResponse struct {
 }
 
@@ -1832,7 +2022,7 @@ func (m *MsgCreateTradeRouteResponse) Reset()         { *m = MsgCreateTradeRoute
 func (m *MsgCreateTradeRouteResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateTradeRouteResponse) ProtoMessage()    {}
 func (*MsgCreateTradeRouteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{34}
+	return fileDescriptor_9b7e09c9ad51cd54, []int{37}
 }
 func (m *MsgCreateTradeRouteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1876,7 +2066,7 @@ func (m *MsgDeleteTradeRoute) Reset()         { *m = MsgDeleteTradeRoute{} }
 func (m *MsgDeleteTradeRoute) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteTradeRoute) ProtoMessage()    {}
 func (*MsgDeleteTradeRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{35}
+	return fileDescriptor_9b7e09c9ad51cd54, []int{38}
 }
 func (m *MsgDeleteTradeRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1933,7 +2123,7 @@ func (m *MsgDeleteTradeRouteResponse) Reset()         { *m = MsgDeleteTradeRoute
 func (m *MsgDeleteTradeRouteResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteTradeRouteResponse) ProtoMessage()    {}
 func (*MsgDeleteTradeRouteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{36}
+	return fileDescriptor_9b7e09c9ad51cd54, []int{39}
 }
 func (m *MsgDeleteTradeRouteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1995,13 +2185,20 @@ type MsgUpdateTradeRoute struct {
 	// Minimum amount of reward token that must be accumulated before
 	// the tokens are transferred to the trade ICA
 	MinTransferAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,17,opt,name=min_transfer_amount,json=minTransferAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_transfer_amount"`
+	// Optional configuration to have Stride execute the swap with an
+	// oracle-enforced minimum output
+	// If not provided, the swap is left to the off-chain trade controller
+	SwapConfig *SwapConfig `protobuf:"bytes,18,opt,name=swap_config,json=swapConfig,proto3" json:"swap_config,omitempty"`
+	// Optional updated swap configs for each of the route's additional legs
+	// If provided, there must be one config for each leg
+	AdditionalLegSwapConfigs []SwapConfig `protobuf:"bytes,19,rep,name=additional_leg_swap_configs,json=additionalLegSwapConfigs,proto3" json:"additional_leg_swap_configs"`
 }
 
 func (m *MsgUpdateTradeRoute) Reset()         { *m = MsgUpdateTradeRoute{} }
 func (m *MsgUpdateTradeRoute) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateTradeRoute) ProtoMessage()    {}
 func (*MsgUpdateTradeRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{37}
+	return fileDescriptor_9b7e09c9ad51cd54, []int{40}
 }
 func (m *MsgUpdateTradeRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2067,6 +2264,20 @@ func (m *MsgUpdateTradeRoute) GetMaxAllowedSwapLossRate() string {
 	return ""
 }
 
+func (m *MsgUpdateTradeRoute) GetSwapConfig() *SwapConfig {
+	if m != nil {
+		return m.SwapConfig
+	}
+	return nil
+}
+
+func (m *MsgUpdateTradeRoute) GetAdditionalLegSwapConfigs() []SwapConfig {
+	if m != nil {
+		return m.AdditionalLegSwapConfigs
+	}
+	return nil
+}
+
 type MsgUpdateTradeRouteResponse struct {
 }
 
@@ -2074,7 +2285,7 @@ func (m *MsgUpdateTradeRouteResponse) Reset()         { *m = MsgUpdateTradeRoute
 func (m *MsgUpdateTradeRouteResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateTradeRouteResponse) ProtoMessage()    {}
 func (*MsgUpdateTradeRouteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{38}
+	return fileDescriptor_9b7e09c9ad51cd54, []int{41}
 }
 func (m *MsgUpdateTradeRouteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2121,7 +2332,7 @@ func (m *MsgSetCommunityPoolRebate) Reset()         { *m = MsgSetCommunityPoolRe
 func (m *MsgSetCommunityPoolRebate) String() string { return proto.CompactTextString(m) }
 func (*MsgSetCommunityPoolRebate) ProtoMessage()    {}
 func (*MsgSetCommunityPoolRebate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{39}
+	return fileDescriptor_9b7e09c9ad51cd54, []int{42}
 }
 func (m *MsgSetCommunityPoolRebate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2171,7 +2382,7 @@ func (m *MsgSetCommunityPoolRebateResponse) Reset()         { *m = MsgSetCommuni
 func (m *MsgSetCommunityPoolRebateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetCommunityPoolRebateResponse) ProtoMessage()    {}
 func (*MsgSetCommunityPoolRebateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{40}
+	return fileDescriptor_9b7e09c9ad51cd54, []int{43}
 }
 func (m *MsgSetCommunityPoolRebateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2218,7 +2429,7 @@ func (m *MsgToggleTradeController) Reset()         { *m = MsgToggleTradeControll
 func (m *MsgToggleTradeController) String() string { return proto.CompactTextString(m) }
 func (*MsgToggleTradeController) ProtoMessage()    {}
 func (*MsgToggleTradeController) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{41}
+	return fileDescriptor_9b7e09c9ad51cd54, []int{44}
 }
 func (m *MsgToggleTradeController) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2289,7 +2500,7 @@ func (m *MsgToggleTradeControllerResponse) Reset()         { *m = MsgToggleTrade
 func (m *MsgToggleTradeControllerResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgToggleTradeControllerResponse) ProtoMessage()    {}
 func (*MsgToggleTradeControllerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{42}
+	return fileDescriptor_9b7e09c9ad51cd54, []int{45}
 }
 func (m *MsgToggleTradeControllerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2327,13 +2538,27 @@ type MsgUpdateHostZoneParams struct {
 	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	// Max messages that can be sent in a single ICA message
 	MaxMessagesPerIcaTx uint64 `protobuf:"varint,3,opt,name=max_messages_per_ica_tx,json=maxMessagesPerIcaTx,proto3" json:"max_messages_per_ica_tx,omitempty"`
+	// Max relative change in the redemption rate between two updates before
+	// the host zone is halted (zero disables the check, and omitting it leaves
+	// the current value unchanged)
+	MaxRedemptionRateChangePerEpoch *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_redemption_rate_change_per_epoch,json=maxRedemptionRateChangePerEpoch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_redemption_rate_change_per_epoch,omitempty"`
+	// Max relative change in the redemption rate over a rolling 24 hour window
+	// before the host zone is halted (zero disables the check, and omitting it
+	// leaves the current value unchanged)
+	MaxRedemptionRateChangePerDay *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_redemption_rate_change_per_day,json=maxRedemptionRateChangePerDay,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_redemption_rate_change_per_day,omitempty"`
+	// Cadence of the epochly tasks for the host zone
+	// Only the non-zero intervals are applied, and the rest are left unchanged
+	EpochCadence *EpochCadence `protobuf:"bytes,6,opt,name=epoch_cadence,json=epochCadence,proto3" json:"epoch_cadence,omitempty"`
+	// If true, each interval is reset to the module-wide default before the
+	// epoch_cadence is applied (so omitted intervals fall back to the default)
+	ResetEpochCadence bool `protobuf:"varint,7,opt,name=reset_epoch_cadence,json=resetEpochCadence,proto3" json:"reset_epoch_cadence,omitempty"`
 }
 
 func (m *MsgUpdateHostZoneParams) Reset()         { *m = MsgUpdateHostZoneParams{} }
 func (m *MsgUpdateHostZoneParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateHostZoneParams) ProtoMessage()    {}
 func (*MsgUpdateHostZoneParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{43}
+	return fileDescriptor_9b7e09c9ad51cd54, []int{46}
 }
 func (m *MsgUpdateHostZoneParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2383,6 +2608,20 @@ func (m *MsgUpdateHostZoneParams) GetMaxMessagesPerIcaTx() uint64 {
 	return 0
 }
 
+func (m *MsgUpdateHostZoneParams) GetEpochCadence() *EpochCadence {
+	if m != nil {
+		return m.EpochCadence
+	}
+	return nil
+}
+
+func (m *MsgUpdateHostZoneParams) GetResetEpochCadence() bool {
+	if m != nil {
+		return m.ResetEpochCadence
+	}
+	return false
+}
+
 type MsgUpdateHostZoneParamsResponse struct {
 }
 
@@ -2390,7 +2629,7 @@ func (m *MsgUpdateHostZoneParamsResponse) Reset()         { *m = MsgUpdateHostZo
 func (m *MsgUpdateHostZoneParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateHostZoneParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateHostZoneParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{44}
+	return fileDescriptor_9b7e09c9ad51cd54, []int{47}
 }
 func (m *MsgUpdateHostZoneParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2454,7 +2693,10 @@ func init() {
 	proto.RegisterType((*MsgCalibrateDelegationResponse)(nil), "stride.stakeibc.MsgCalibrateDelegationResponse")
 	proto.RegisterType((*MsgResumeHostZone)(nil), "stride.stakeibc.MsgResumeHostZone")
 	proto.RegisterType((*MsgResumeHostZoneResponse)(nil), "stride.stakeibc.MsgResumeHostZoneResponse")
+	proto.RegisterType((*MsgRegisterDelegationShard)(nil), "stride.stakeibc.MsgRegisterDelegationShard")
+	proto.RegisterType((*MsgRegisterDelegationShardResponse)(nil), "stride.stakeibc.MsgRegisterDelegationShardResponse")
 	proto.RegisterType((*MsgCreateTradeRoute)(nil), "stride.stakeibc.MsgCreateTradeRoute")
+	proto.RegisterType((*TradeLegConfig)(nil), "stride.stakeibc.TradeLegConfig")
 	proto.RegisterType((*MsgCreateTradeRouteResponse)(nil), "stride.stakeibc.MsgCreateTradeRouteResponse")
 	proto.RegisterType((*MsgDeleteTradeRoute)(nil), "stride.stakeibc.MsgDeleteTradeRoute")
 	proto.RegisterType((*MsgDeleteTradeRouteResponse)(nil), "stride.stakeibc.MsgDeleteTradeRouteResponse")
@@ -2471,174 +2713,195 @@ func init() {
 func init() { proto.RegisterFile("stride/stakeibc/tx.proto", fileDescriptor_9b7e09c9ad51cd54) }
 
 var fileDescriptor_9b7e09c9ad51cd54 = []byte{
-	// 2663 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcb, 0x6f, 0x1c, 0xc7,
-	0xd1, 0xe7, 0xf2, 0xcd, 0x22, 0x29, 0x2e, 0x87, 0x0f, 0x2d, 0x97, 0x26, 0x97, 0x1c, 0xfa, 0xf3,
-	0x47, 0xd3, 0xe6, 0xae, 0x48, 0x2a, 0x31, 0xb2, 0x76, 0x82, 0x90, 0x94, 0xe2, 0x30, 0x16, 0x25,
-	0x61, 0x48, 0x4b, 0x86, 0x00, 0x67, 0xd2, 0x3b, 0xd3, 0x5a, 0x0e, 0x34, 0x8f, 0xcd, 0xcc, 0x2c,
-	0xb9, 0xf4, 0x21, 0x08, 0x72, 0x4a, 0x02, 0x04, 0x09, 0x10, 0xe4, 0x6a, 0xf8, 0x90, 0x53, 0x4e,
-	0x0e, 0xa0, 0x3f, 0x20, 0x47, 0x03, 0xb9, 0x38, 0x02, 0xf2, 0x40, 0x0e, 0x4c, 0x20, 0x05, 0x70,
-	0x10, 0x23, 0x40, 0xa0, 0x43, 0xce, 0x41, 0x77, 0xcf, 0xf4, 0xce, 0xcc, 0xf6, 0xec, 0x92, 0xcc,
-	0x1a, 0xd1, 0x45, 0xd4, 0x54, 0x57, 0xd7, 0xe3, 0xd7, 0x5d, 0xd5, 0x5d, 0xd5, 0x0b, 0x39, 0xcf,
-	0x77, 0x0d, 0x1d, 0x97, 0x3c, 0x1f, 0x3d, 0xc2, 0x46, 0x45, 0x2b, 0xf9, 0x8d, 0x62, 0xcd, 0x75,
-	0x7c, 0x47, 0x9a, 0x60, 0x23, 0xc5, 0x70, 0x24, 0x3f, 0x89, 0x2c, 0xc3, 0x76, 0x4a, 0xf4, 0x5f,
-	0xc6, 0x93, 0x9f, 0xd3, 0x1c, 0xcf, 0x72, 0x3c, 0x95, 0x7e, 0x95, 0xd8, 0x47, 0x30, 0xb4, 0xc8,
-	0xbe, 0x4a, 0x15, 0xe4, 0xe1, 0xd2, 0xf1, 0x46, 0x05, 0xfb, 0x68, 0xa3, 0xa4, 0x39, 0x86, 0x1d,
-	0x8c, 0x5f, 0x0d, 0xc6, 0x2d, 0xaf, 0x5a, 0x3a, 0xde, 0x20, 0x7f, 0x82, 0x81, 0xe9, 0xaa, 0x53,
-	0x75, 0x98, 0x40, 0xf2, 0xbf, 0x80, 0x5a, 0x48, 0xda, 0x79, 0x8c, 0x4c, 0x43, 0x47, 0xbe, 0xe3,
-	0x32, 0x06, 0xf9, 0xc3, 0x3e, 0x90, 0xf7, 0xbd, 0xea, 0xbb, 0x35, 0x1d, 0xf9, 0x78, 0xcf, 0xb6,
-	0xb1, 0xab, 0x60, 0x1d, 0x5b, 0x35, 0xdf, 0x70, 0x6c, 0x05, 0xf9, 0x78, 0xc7, 0xa9, 0xdb, 0xba,
-	0x27, 0x6d, 0xc2, 0x90, 0xe6, 0x62, 0x32, 0x2f, 0x97, 0x59, 0xca, 0xac, 0x8e, 0xec, 0xe4, 0x9e,
-	0x3c, 0x5e, 0x9f, 0x0e, 0x2c, 0xdf, 0xd6, 0x75, 0x17, 0x7b, 0xde, 0x81, 0xef, 0x1a, 0x76, 0x55,
-	0x09, 0x19, 0xa5, 0x39, 0x18, 0xd6, 0x8e, 0x90, 0x61, 0xab, 0x86, 0x9e, 0xeb, 0x25, 0x93, 0x94,
-	0x21, 0xfa, 0xbd, 0xa7, 0x4b, 0x27, 0x30, 0x67, 0x91, 0x01, 0xa2, 0x4f, 0x75, 0xb9, 0x42, 0xd5,
-	0x45, 0x3e, 0xce, 0xf5, 0x51, 0x05, 0x6f, 0x7d, 0x72, 0x56, 0xe8, 0xf9, 0xf3, 0x59, 0xe1, 0x95,
-	0xaa, 0xe1, 0x1f, 0xd5, 0x2b, 0x45, 0xcd, 0xb1, 0x02, 0xa4, 0x82, 0x3f, 0xeb, 0x9e, 0xfe, 0xa8,
-	0xe4, 0x9f, 0xd6, 0xb0, 0x57, 0xbc, 0x81, 0xb5, 0x27, 0x8f, 0xd7, 0x21, 0x30, 0xe7, 0x06, 0xd6,
-	0x94, 0x59, 0xcb, 0xb0, 0x05, 0xde, 0x50, 0xc5, 0xa8, 0x91, 0xa2, 0xb8, 0xbf, 0x2b, 0x8a, 0x51,
-	0x43, 0xa0, 0xb8, 0xfc, 0xc6, 0x0f, 0x3e, 0xfb, 0x78, 0x2d, 0x84, 0xe6, 0xc7, 0x9f, 0x7d, 0xbc,
-	0xf6, 0x0a, 0x5f, 0x12, 0x0e, 0xbf, 0x08, 0x79, 0xf9, 0x75, 0x58, 0xeb, 0xbc, 0x3e, 0x0a, 0xf6,
-	0x6a, 0x8e, 0xed, 0x61, 0xf9, 0xf7, 0x19, 0xb8, 0xb2, 0xef, 0x55, 0x6f, 0x19, 0xdf, 0xad, 0x1b,
-	0xfa, 0x01, 0xd1, 0x70, 0xa9, 0xa5, 0xfb, 0x06, 0x0c, 0x22, 0xcb, 0xa9, 0xdb, 0x3e, 0x5b, 0xb8,
-	0x9d, 0xe2, 0x05, 0x30, 0xd9, 0xb3, 0x7d, 0x25, 0x98, 0x2d, 0x2d, 0x00, 0x1c, 0x39, 0x9e, 0xaf,
-	0xea, 0xd8, 0x76, 0x2c, 0xb6, 0xb0, 0xca, 0x08, 0xa1, 0xdc, 0x20, 0x84, 0xf2, 0x6a, 0x12, 0x94,
-	0xab, 0x51, 0x50, 0x22, 0x4e, 0xc8, 0xdf, 0xcf, 0xc0, 0x6c, 0x9c, 0x14, 0xba, 0x2c, 0x3d, 0x84,
-	0x61, 0xcf, 0x57, 0x7d, 0xe7, 0x11, 0xb6, 0xa9, 0x83, 0xa3, 0x9b, 0x73, 0xc5, 0xc0, 0x3b, 0x12,
-	0x44, 0xc5, 0x20, 0x88, 0x8a, 0xbb, 0x8e, 0x61, 0xef, 0x5c, 0x23, 0x8e, 0xfc, 0xea, 0x2f, 0x85,
-	0xd5, 0x73, 0x38, 0x42, 0x26, 0x78, 0xca, 0x90, 0xe7, 0x1f, 0x12, 0xd9, 0xf2, 0xe7, 0x19, 0x98,
-	0x24, 0x26, 0x1c, 0xec, 0xbf, 0x28, 0xe8, 0xae, 0xc3, 0x94, 0xe9, 0x59, 0xcc, 0x75, 0xd5, 0xa8,
-	0x68, 0x31, 0x98, 0xb3, 0xa6, 0x67, 0x51, 0xc3, 0xf7, 0x2a, 0x1a, 0x43, 0xfb, 0xb5, 0x24, 0xda,
-	0xf9, 0x18, 0xda, 0x31, 0xbf, 0xe4, 0xdb, 0x30, 0xd7, 0x42, 0xe4, 0x90, 0x6f, 0xc0, 0xb4, 0xef,
-	0x22, 0xdb, 0x43, 0x1a, 0x0d, 0x1e, 0xcd, 0xb1, 0x6a, 0x26, 0xf6, 0x31, 0x45, 0x60, 0x58, 0x99,
-	0x8a, 0x8c, 0xed, 0x06, 0x43, 0xf2, 0x3f, 0x33, 0x30, 0xb1, 0xef, 0x55, 0x77, 0x4d, 0x8c, 0xdc,
-	0x1d, 0x64, 0x22, 0x5b, 0xc3, 0xdd, 0x4e, 0x2a, 0x4d, 0x58, 0xfb, 0xfe, 0x2b, 0x58, 0x73, 0x40,
-	0x44, 0xda, 0x36, 0x36, 0x59, 0x46, 0x50, 0xc2, 0xcf, 0xf2, 0xab, 0x49, 0x04, 0x73, 0x51, 0x04,
-	0xa3, 0xbe, 0xc9, 0x73, 0x70, 0x35, 0x41, 0xe2, 0x31, 0xfa, 0xa3, 0x5e, 0x1a, 0xa3, 0x24, 0x8e,
-	0xb1, 0xf5, 0xbf, 0xdf, 0x45, 0xf3, 0x40, 0x23, 0x52, 0xfd, 0xc0, 0xb1, 0x83, 0xdc, 0xab, 0x0c,
-	0x13, 0xc2, 0x03, 0xc7, 0xc6, 0xd2, 0x75, 0x18, 0x76, 0xb1, 0x86, 0x8d, 0x63, 0xec, 0x06, 0xe9,
-	0x31, 0xdd, 0x32, 0xce, 0xd9, 0x21, 0xae, 0x23, 0x8e, 0xcb, 0x39, 0x1a, 0xd6, 0x11, 0x0a, 0x47,
-	0xe9, 0xdf, 0x83, 0x30, 0x45, 0x87, 0xaa, 0x86, 0xe7, 0x63, 0xf7, 0x9b, 0xa1, 0x45, 0x5f, 0x85,
-	0x71, 0xcd, 0xb1, 0x6d, 0xcc, 0xb6, 0x5e, 0xb8, 0x0b, 0x76, 0x72, 0xcf, 0xcf, 0x0a, 0xd3, 0xa7,
-	0xc8, 0x32, 0xcb, 0x72, 0x6c, 0x58, 0x56, 0xc6, 0x9a, 0xdf, 0x7b, 0xba, 0x24, 0xc3, 0x58, 0x05,
-	0x6b, 0x47, 0x5b, 0x9b, 0x35, 0x17, 0x3f, 0x34, 0x1a, 0xb9, 0x31, 0xea, 0x70, 0x8c, 0x26, 0x5d,
-	0x8f, 0x65, 0x2d, 0xe6, 0xf6, 0xcc, 0xf3, 0xb3, 0xc2, 0x24, 0x93, 0xdf, 0x1c, 0x93, 0x23, 0xc9,
-	0x4c, 0xda, 0x80, 0x91, 0x66, 0x0c, 0x0e, 0xd0, 0x49, 0xd3, 0xcf, 0xcf, 0x0a, 0x59, 0x36, 0x89,
-	0x0f, 0xc9, 0xca, 0xb0, 0x11, 0x44, 0x64, 0x74, 0xd9, 0x07, 0xcf, 0xbb, 0xec, 0xb7, 0x81, 0xc5,
-	0xd7, 0x43, 0xec, 0xaa, 0xc1, 0xbe, 0x24, 0x28, 0x00, 0x9d, 0xbf, 0xf8, 0xfc, 0xac, 0x90, 0x67,
-	0x0a, 0x05, 0x4c, 0xb2, 0x32, 0x19, 0x52, 0x77, 0x19, 0x91, 0x46, 0x4d, 0xb6, 0x6e, 0x57, 0x1c,
-	0x5b, 0x37, 0xec, 0xaa, 0x5a, 0xc3, 0xae, 0xe1, 0xe8, 0xb9, 0xd1, 0xa5, 0xcc, 0x6a, 0xff, 0xce,
-	0xfc, 0xf3, 0xb3, 0xc2, 0x55, 0x26, 0x2c, 0xc9, 0x21, 0x2b, 0x13, 0x9c, 0x74, 0x97, 0x52, 0x24,
-	0x13, 0xa6, 0xc8, 0x91, 0x9e, 0x3c, 0x53, 0xc7, 0xbb, 0x70, 0xa6, 0x4e, 0x5a, 0x86, 0x9d, 0x38,
-	0xc7, 0x89, 0x36, 0xd4, 0x68, 0xd1, 0x76, 0xa5, 0x2b, 0xda, 0x50, 0x23, 0xa1, 0xed, 0x0d, 0xc8,
-	0x91, 0x44, 0x6b, 0xd2, 0x54, 0xa8, 0xd2, 0xbd, 0xac, 0x62, 0x1b, 0x55, 0x4c, 0xac, 0xe7, 0x26,
-	0x68, 0xce, 0x9b, 0x31, 0x3d, 0x2b, 0x92, 0x29, 0x6f, 0xb2, 0x41, 0xe9, 0x26, 0x14, 0x34, 0xc7,
-	0xb2, 0xea, 0xb6, 0xe1, 0x9f, 0xaa, 0x35, 0xc7, 0x31, 0x55, 0xdf, 0xc5, 0xc8, 0xab, 0xbb, 0xa7,
-	0x2a, 0x62, 0xcb, 0x9b, 0xcb, 0xd2, 0x0d, 0xf8, 0x12, 0x67, 0xbb, 0xeb, 0x38, 0xe6, 0x61, 0xc0,
-	0x14, 0x6c, 0x01, 0xe9, 0x3a, 0x5c, 0x25, 0xde, 0x5a, 0xd8, 0xf3, 0x50, 0x15, 0x7b, 0x64, 0x11,
-	0x54, 0x43, 0x43, 0xaa, 0xdf, 0xc8, 0x4d, 0x92, 0xa5, 0x52, 0x08, 0x18, 0xfb, 0xc1, 0xe8, 0x5d,
-	0xec, 0xee, 0x69, 0xe8, 0xb0, 0x51, 0xfe, 0xd2, 0x0f, 0x3f, 0x2a, 0xf4, 0xfc, 0xfd, 0xa3, 0x42,
-	0x4f, 0x32, 0x1a, 0x5f, 0x8a, 0x47, 0x63, 0x3c, 0xc0, 0xe4, 0x05, 0x98, 0x17, 0x90, 0x79, 0x5c,
-	0x9e, 0x65, 0xe8, 0xc9, 0xb0, 0x6b, 0x22, 0xc3, 0x7a, 0xd7, 0xd6, 0xb1, 0x89, 0xab, 0xc8, 0xc7,
-	0x3a, 0x3d, 0x6a, 0x2e, 0x77, 0x4f, 0x5c, 0x82, 0x31, 0x9e, 0x80, 0x9a, 0x69, 0x1d, 0xc2, 0x1c,
-	0xb4, 0xa7, 0x4b, 0xd3, 0x30, 0x80, 0x6b, 0x8e, 0x76, 0x44, 0xd3, 0x53, 0xbf, 0xc2, 0x3e, 0xa4,
-	0x7c, 0x24, 0x37, 0x0d, 0xb0, 0xbc, 0xc5, 0x33, 0xd0, 0x56, 0xd2, 0x67, 0x39, 0x9e, 0xa9, 0x45,
-	0xc6, 0x7f, 0xab, 0x7f, 0xb8, 0x3f, 0x3b, 0x20, 0xaf, 0xc0, 0x72, 0x2a, 0x0b, 0x47, 0xe1, 0x37,
-	0x99, 0x20, 0x71, 0x55, 0x58, 0x72, 0xbf, 0x17, 0x5e, 0xab, 0x2f, 0x07, 0x41, 0x2c, 0x07, 0xf7,
-	0x26, 0x72, 0xf0, 0x0a, 0x8c, 0xdb, 0x75, 0x4b, 0x75, 0x43, 0x5d, 0x01, 0x0a, 0x63, 0x76, 0xdd,
-	0xe2, 0xfa, 0xcb, 0xd7, 0x92, 0x0e, 0x17, 0xe2, 0x8b, 0xdc, 0x62, 0xa7, 0xbc, 0x04, 0x8b, 0xe2,
-	0x11, 0xee, 0xe4, 0x6f, 0x33, 0x90, 0xdd, 0xf7, 0xaa, 0xdb, 0xba, 0xfe, 0x45, 0xba, 0x57, 0x06,
-	0xe0, 0x45, 0x89, 0x97, 0xeb, 0x5b, 0xea, 0x5b, 0x1d, 0xdd, 0xcc, 0x17, 0x13, 0x55, 0x54, 0x91,
-	0x5b, 0xa0, 0x44, 0xb8, 0xcb, 0x6b, 0x49, 0xaf, 0xe7, 0xa2, 0x5e, 0xc7, 0x0c, 0x97, 0xf3, 0x90,
-	0x4b, 0xd2, 0xb8, 0xa7, 0xef, 0xc3, 0x04, 0xa7, 0xde, 0xc7, 0x46, 0xf5, 0xc8, 0x27, 0x7e, 0x86,
-	0x21, 0xda, 0xd1, 0xcf, 0x80, 0x51, 0x9a, 0x85, 0xc1, 0x13, 0x3a, 0x9b, 0x3a, 0xd9, 0xaf, 0x04,
-	0x5f, 0xf2, 0xbf, 0x82, 0x98, 0x39, 0x42, 0x76, 0x15, 0x27, 0x14, 0x7d, 0x01, 0x88, 0xee, 0xc3,
-	0x24, 0xc7, 0x48, 0x65, 0x26, 0x84, 0xc0, 0x2e, 0xa5, 0x03, 0xcb, 0xcc, 0x51, 0xb2, 0xc7, 0x09,
-	0xfb, 0x3a, 0xc5, 0x92, 0xd0, 0xa9, 0x30, 0x8a, 0x84, 0x83, 0x1c, 0xf6, 0xdf, 0x65, 0x40, 0xda,
-	0xf7, 0xaa, 0x37, 0x30, 0xb9, 0x22, 0x72, 0xae, 0xee, 0x03, 0xf2, 0x16, 0x0c, 0x1f, 0x23, 0x93,
-	0xa6, 0xdc, 0xe0, 0x6e, 0xb8, 0xfc, 0xe4, 0xf1, 0xfa, 0x42, 0x20, 0x91, 0x2b, 0x4e, 0x88, 0x3e,
-	0x46, 0x26, 0xa1, 0x94, 0x5f, 0x4f, 0xfa, 0x3f, 0x1f, 0xf5, 0x3f, 0x61, 0xbc, 0xfc, 0x12, 0xe4,
-	0x5b, 0xa9, 0xdc, 0xe3, 0x7f, 0x64, 0x82, 0xec, 0xea, 0xf9, 0x8e, 0x8b, 0xf7, 0x6c, 0x1f, 0xbb,
-	0xf4, 0xfa, 0xba, 0xad, 0x69, 0xf4, 0x32, 0xd6, 0xe5, 0x2b, 0xf1, 0x4a, 0xf2, 0xb2, 0xc4, 0xee,
-	0x77, 0xf1, 0x2b, 0xd1, 0x0a, 0x8c, 0x23, 0xa6, 0x5e, 0x75, 0x4e, 0xec, 0xf0, 0xa2, 0xa7, 0x8c,
-	0x05, 0xc4, 0x3b, 0x84, 0x56, 0xde, 0x4c, 0x82, 0xb0, 0x1c, 0xcf, 0x2f, 0x02, 0x7f, 0xe4, 0xff,
-	0x83, 0x95, 0x36, 0xbe, 0x72, 0x4c, 0x3e, 0x0c, 0x4f, 0x14, 0xc7, 0xc3, 0x37, 0x58, 0xbe, 0x25,
-	0x95, 0x03, 0xbb, 0xa1, 0x74, 0x19, 0x91, 0x0e, 0x7e, 0x08, 0x6d, 0xe0, 0x27, 0x82, 0xc8, 0x3e,
-	0xee, 0xc5, 0xdf, 0x32, 0xb0, 0xc4, 0x0b, 0x75, 0xbe, 0xf0, 0x07, 0x47, 0xc8, 0xc5, 0xde, 0xcd,
-	0x86, 0x76, 0x44, 0x2f, 0x12, 0x5d, 0x5e, 0xde, 0x37, 0x81, 0x6c, 0x52, 0xa7, 0x86, 0x2f, 0xb8,
-	0xad, 0xc9, 0x8c, 0xf2, 0xf5, 0x24, 0x12, 0x2b, 0xad, 0x1d, 0x89, 0x7b, 0xc8, 0x8c, 0x7b, 0x20,
-	0xaf, 0xc1, 0x6a, 0x27, 0x2f, 0x39, 0x24, 0x7f, 0x60, 0x87, 0xe4, 0x2e, 0x32, 0x8d, 0x0a, 0xb9,
-	0x9e, 0x35, 0xc1, 0x7b, 0xa1, 0x80, 0x68, 0x7f, 0x74, 0x0a, 0xac, 0x0f, 0x8e, 0x4e, 0xc1, 0x08,
-	0x77, 0xfd, 0xa7, 0xac, 0x59, 0xa0, 0x60, 0xaf, 0x6e, 0x61, 0x5e, 0xbb, 0x74, 0x79, 0x2f, 0xb7,
-	0x2f, 0xe8, 0xe3, 0xba, 0xe5, 0x79, 0x1a, 0x64, 0x71, 0x22, 0x37, 0xf7, 0xf3, 0x61, 0x5a, 0x6c,
-	0xed, 0x12, 0x51, 0xf8, 0xd0, 0x45, 0x3a, 0x56, 0x9c, 0xba, 0x8f, 0xa5, 0x2f, 0xc3, 0x08, 0xaa,
-	0xfb, 0x47, 0x8e, 0x6b, 0xf8, 0xa7, 0x1d, 0x4d, 0x6e, 0xb2, 0x4a, 0x32, 0x8c, 0xd3, 0x6c, 0x9c,
-	0xb0, 0x7c, 0x94, 0x10, 0x77, 0x83, 0x35, 0xdb, 0x81, 0x45, 0x76, 0x16, 0xa9, 0xbe, 0xa3, 0xba,
-	0xf8, 0x04, 0xb9, 0xba, 0x2a, 0x4a, 0x56, 0x79, 0xc6, 0x75, 0xe8, 0x28, 0x94, 0x67, 0x37, 0x9a,
-	0xba, 0xbe, 0x0e, 0x0b, 0x4d, 0x19, 0x3e, 0xb1, 0x3b, 0x21, 0x82, 0xa5, 0xb2, 0xb9, 0x50, 0x04,
-	0x75, 0x2d, 0x26, 0x61, 0x0f, 0x58, 0x3d, 0xd7, 0xb4, 0x41, 0x54, 0x5d, 0xb1, 0xeb, 0xe5, 0x02,
-	0xe1, 0x0c, 0xed, 0x38, 0x6c, 0xa9, 0xa4, 0xde, 0x81, 0x95, 0x50, 0x44, 0x68, 0x8c, 0x48, 0x16,
-	0xad, 0xf4, 0x94, 0x45, 0xc6, 0x1a, 0x98, 0xd4, 0x2a, 0xec, 0x6d, 0x58, 0x0e, 0x44, 0x38, 0x2a,
-	0x33, 0x50, 0x20, 0x6a, 0x88, 0xd5, 0x0e, 0x94, 0xf1, 0xd0, 0x21, 0xab, 0xda, 0x2a, 0xa8, 0x04,
-	0xd3, 0x81, 0x55, 0xb4, 0xfc, 0x54, 0x1d, 0x9b, 0xca, 0xcb, 0x0d, 0xd3, 0xb9, 0x93, 0x6c, 0x8c,
-	0x96, 0xa3, 0x77, 0x6c, 0x22, 0x41, 0xda, 0x82, 0xd9, 0xe4, 0x04, 0xf6, 0x9d, 0x1b, 0xa1, 0x53,
-	0xa6, 0x62, 0x53, 0x18, 0x18, 0xd2, 0x06, 0xcc, 0x24, 0x27, 0x51, 0xab, 0x58, 0x5d, 0xaa, 0x48,
-	0xb1, 0x39, 0xd4, 0x65, 0x69, 0x1d, 0xa6, 0x9a, 0x95, 0x74, 0x73, 0xc2, 0x28, 0xeb, 0x5e, 0xf1,
-	0xba, 0x3a, 0x64, 0x7f, 0x0d, 0xa4, 0x38, 0x3b, 0xf5, 0x82, 0x95, 0xef, 0x13, 0x11, 0x6e, 0xea,
-	0xc3, 0x3c, 0x0c, 0xd1, 0x6a, 0xcb, 0xd0, 0x69, 0x01, 0xda, 0xbf, 0xd3, 0x9b, 0xcb, 0x28, 0x83,
-	0x84, 0xb4, 0xa7, 0x4b, 0x5f, 0x83, 0x3c, 0xa9, 0xa6, 0x90, 0x69, 0x3a, 0x27, 0x58, 0x57, 0xbd,
-	0x13, 0x54, 0x53, 0x4d, 0xc7, 0xf3, 0xa2, 0x25, 0x24, 0xe1, 0x9f, 0xb5, 0x50, 0x63, 0x9b, 0x31,
-	0x1d, 0x9c, 0xa0, 0xda, 0x2d, 0xc7, 0xf3, 0x68, 0x12, 0xbf, 0x07, 0x13, 0xa4, 0xd2, 0xa5, 0xf3,
-	0x82, 0x0e, 0xcc, 0xc4, 0xa5, 0x3a, 0x30, 0xe3, 0x96, 0x61, 0x13, 0xc9, 0xdb, 0xac, 0x11, 0x43,
-	0xe4, 0xa2, 0x46, 0x4c, 0x6e, 0xf6, 0x92, 0x72, 0x51, 0x23, 0x22, 0xf7, 0xdb, 0xac, 0x32, 0xe7,
-	0x1b, 0x28, 0x90, 0x3d, 0x79, 0x29, 0xd9, 0xa4, 0x16, 0x0f, 0x37, 0x19, 0x93, 0x5f, 0x2e, 0x91,
-	0x34, 0xd4, 0x0c, 0xfe, 0x96, 0x0a, 0x33, 0x99, 0x55, 0x82, 0x0a, 0x33, 0x49, 0x8e, 0xd6, 0x56,
-	0x53, 0xfc, 0x0a, 0xd5, 0x85, 0x64, 0xb4, 0x0c, 0x63, 0xd1, 0xbd, 0x19, 0xe6, 0xa2, 0xc8, 0x96,
-	0xec, 0xd4, 0xa7, 0xee, 0xe4, 0x61, 0xd2, 0xd4, 0xc0, 0xc3, 0x24, 0x99, 0x7b, 0xf8, 0xeb, 0x7e,
-	0xea, 0x21, 0x3b, 0x45, 0x5f, 0x04, 0x0f, 0xa3, 0x01, 0xd3, 0x7f, 0xc1, 0x80, 0x19, 0xe8, 0x18,
-	0x30, 0xef, 0xb5, 0x06, 0x0c, 0x6b, 0x77, 0x5d, 0xbb, 0xd8, 0xe6, 0xcb, 0x65, 0x92, 0x21, 0xf3,
-	0x5e, 0x6b, 0xc8, 0x0c, 0x5d, 0x5a, 0xf2, 0x0b, 0x15, 0x34, 0xc9, 0xbd, 0x11, 0x6c, 0xa9, 0x24,
-	0x99, 0x6f, 0xa9, 0xa7, 0xbd, 0xf4, 0x7c, 0x3f, 0xc0, 0xfe, 0x6e, 0xb4, 0x93, 0x44, 0xca, 0xfb,
-	0xee, 0xdf, 0x3b, 0xef, 0xc0, 0xa8, 0x4b, 0x05, 0x47, 0x1f, 0xec, 0x8a, 0x17, 0xeb, 0xba, 0x29,
-	0xc0, 0x44, 0xd0, 0x1d, 0x52, 0x83, 0x85, 0x68, 0x73, 0x8d, 0xfc, 0x09, 0x9e, 0x35, 0x02, 0xdc,
-	0xfb, 0x2f, 0x85, 0xfb, 0x9c, 0xd9, 0x6c, 0xc9, 0xe9, 0x07, 0xec, 0x1d, 0x27, 0xc0, 0xbf, 0x7d,
-	0x51, 0x2b, 0x86, 0x31, 0x28, 0x04, 0xc4, 0x83, 0x7c, 0x25, 0x7e, 0xd9, 0x4b, 0x1b, 0x0d, 0x87,
-	0x4e, 0xb5, 0x6a, 0xe2, 0xf0, 0xc2, 0xe1, 0xbb, 0x8e, 0x69, 0x62, 0xb7, 0xdb, 0x0b, 0x71, 0x00,
-	0x93, 0x35, 0xec, 0x5a, 0x86, 0xe7, 0xd1, 0x77, 0x18, 0x5a, 0x6d, 0xd3, 0xe5, 0xb8, 0xb2, 0xf9,
-	0x4a, 0x4b, 0xa5, 0xbf, 0x5d, 0xf7, 0x8f, 0x3e, 0xb8, 0xcb, 0xd9, 0x59, 0x6d, 0xae, 0x64, 0x6b,
-	0x09, 0x8a, 0x94, 0x6b, 0x76, 0x3e, 0x82, 0xf7, 0x8f, 0x48, 0x7f, 0x83, 0xdc, 0x74, 0xb5, 0x53,
-	0x1a, 0xf4, 0xc3, 0x4a, 0xf0, 0xd5, 0xa1, 0xa8, 0x12, 0x22, 0x21, 0xcb, 0xb4, 0x5c, 0x12, 0x8e,
-	0x71, 0x28, 0xff, 0x98, 0xa1, 0xaf, 0x28, 0x6c, 0xd3, 0x87, 0x97, 0xd6, 0xbb, 0xc8, 0x45, 0x96,
-	0x77, 0xe9, 0x5c, 0xd9, 0x06, 0xcd, 0x36, 0x6d, 0xd6, 0xbe, 0xf4, 0x36, 0xeb, 0x56, 0x6b, 0x24,
-	0x2f, 0xb5, 0x46, 0x72, 0xdc, 0x7a, 0x79, 0x19, 0x0a, 0x29, 0x43, 0xa1, 0xf3, 0x6b, 0x45, 0x98,
-	0x11, 0xae, 0x98, 0x34, 0x02, 0x03, 0x6f, 0x2b, 0xdb, 0xb7, 0x0f, 0xb3, 0x3d, 0x12, 0xc0, 0xa0,
-	0x72, 0xf3, 0xde, 0x9d, 0x77, 0x6e, 0x66, 0x33, 0x9b, 0xbf, 0x98, 0x82, 0xbe, 0x7d, 0xaf, 0x2a,
-	0xdd, 0x87, 0xd1, 0xe8, 0x03, 0x65, 0xa1, 0x65, 0x1f, 0xc4, 0xdf, 0x51, 0xf3, 0xff, 0xdf, 0x81,
-	0x81, 0xbf, 0xfa, 0x7d, 0x07, 0xae, 0x24, 0x1e, 0x3f, 0x65, 0xe1, 0xd4, 0x18, 0x4f, 0x7e, 0xad,
-	0x33, 0x0f, 0xd7, 0x70, 0x1f, 0x46, 0xa3, 0xaf, 0x62, 0x42, 0xd3, 0x23, 0x0c, 0x62, 0xd3, 0x05,
-	0x8f, 0x49, 0xd2, 0x43, 0xc8, 0xb6, 0x3c, 0x24, 0xbd, 0x2c, 0x9e, 0x1c, 0xe7, 0xca, 0xbf, 0x7e,
-	0x1e, 0x2e, 0xae, 0xa7, 0x01, 0xb3, 0x29, 0x8d, 0x71, 0x21, 0x0c, 0x62, 0xde, 0xfc, 0xe6, 0xf9,
-	0x79, 0xb9, 0x66, 0x07, 0xa6, 0x44, 0xcd, 0xe8, 0x14, 0x84, 0x5a, 0x18, 0xf3, 0xa5, 0x73, 0x32,
-	0x72, 0x85, 0xef, 0xc3, 0x78, 0xbc, 0x31, 0xbc, 0x2c, 0x92, 0x10, 0x63, 0xc9, 0xbf, 0xda, 0x91,
-	0x85, 0x8b, 0x3f, 0x81, 0x19, 0x61, 0xf3, 0x30, 0x05, 0x48, 0x61, 0x9f, 0x31, 0x05, 0xc8, 0xb6,
-	0x3d, 0x49, 0x49, 0x83, 0x89, 0x64, 0x3f, 0x72, 0x45, 0x24, 0x26, 0xc1, 0x94, 0x7f, 0xed, 0x1c,
-	0x4c, 0x5c, 0xc9, 0xf7, 0x20, 0x97, 0xda, 0x02, 0x4c, 0xd9, 0x71, 0x62, 0xee, 0xfc, 0xf5, 0x8b,
-	0x70, 0xc7, 0xf7, 0xa9, 0xb0, 0xdd, 0x96, 0xb2, 0x4f, 0x45, 0xbc, 0x69, 0xfb, 0xb4, 0x5d, 0x9b,
-	0x4c, 0xfa, 0x49, 0x06, 0x16, 0xda, 0xf7, 0xc8, 0x36, 0x44, 0x52, 0xdb, 0x4e, 0xc9, 0x7f, 0xe5,
-	0xc2, 0x53, 0xa2, 0x71, 0x23, 0xea, 0x4f, 0x09, 0xe3, 0x46, 0xc0, 0x28, 0x8e, 0x9b, 0x36, 0x9d,
-	0x21, 0xe9, 0x01, 0x8c, 0xc5, 0x7e, 0x04, 0xb1, 0x24, 0x06, 0xb1, 0xc9, 0x91, 0x5f, 0xed, 0xc4,
-	0xc1, 0x65, 0xff, 0x3c, 0x03, 0x85, 0x4e, 0xbf, 0xe4, 0xda, 0x4a, 0xc7, 0x2a, 0x75, 0x52, 0xfe,
-	0xcd, 0x4b, 0x4c, 0x8a, 0x9e, 0x1b, 0x89, 0x3e, 0x98, 0x9c, 0xb2, 0x69, 0x23, 0x3c, 0xe2, 0x73,
-	0x43, 0xdc, 0xbe, 0x22, 0xe9, 0xbd, 0xa5, 0x75, 0x25, 0x4c, 0xef, 0x49, 0x2e, 0x71, 0x7a, 0x4f,
-	0xab, 0x4c, 0x89, 0x9e, 0x96, 0xaa, 0xf4, 0xe5, 0xf4, 0xb8, 0xef, 0xa4, 0x27, 0xad, 0x3e, 0x24,
-	0x7a, 0x5a, 0x6a, 0xc3, 0x97, 0xd3, 0x97, 0xa0, 0x93, 0x9e, 0xb4, 0xa2, 0x81, 0xa4, 0x81, 0x94,
-	0x82, 0x41, 0x88, 0xbe, 0x98, 0x57, 0x9c, 0x06, 0xda, 0x5f, 0x92, 0xa5, 0x3a, 0xcc, 0x88, 0x2f,
-	0xc8, 0xc2, 0x23, 0x42, 0xc8, 0x9a, 0xdf, 0x38, 0x37, 0x2b, 0x57, 0xeb, 0xc2, 0xb4, 0xf0, 0x32,
-	0xb9, 0x9a, 0x0e, 0x5b, 0x9c, 0x33, 0x7f, 0xed, 0xbc, 0x9c, 0xa1, 0xce, 0x9d, 0x5b, 0x9f, 0x3c,
-	0x5d, 0xcc, 0x7c, 0xfa, 0x74, 0x31, 0xf3, 0xd7, 0xa7, 0x8b, 0x99, 0x9f, 0x3d, 0x5b, 0xec, 0xf9,
-	0xf4, 0xd9, 0x62, 0xcf, 0x9f, 0x9e, 0x2d, 0xf6, 0x3c, 0xd8, 0x8c, 0x94, 0x31, 0x07, 0x54, 0xea,
-	0xfa, 0x2d, 0x54, 0xf1, 0x4a, 0xc1, 0x6f, 0x36, 0x8f, 0x37, 0xdf, 0x28, 0x35, 0x22, 0xbf, 0x30,
-	0x25, 0x65, 0x4d, 0x65, 0x90, 0xfe, 0x6c, 0x73, 0xeb, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6f,
-	0xf1, 0x17, 0x9a, 0x81, 0x2a, 0x00, 0x00,
+	// 2994 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x5d, 0x6c, 0x24, 0x47,
+	0xb5, 0xf6, 0xf8, 0xdf, 0xc7, 0xf6, 0xda, 0x6e, 0xff, 0xb5, 0xdb, 0xb1, 0xc7, 0x6e, 0xef, 0x4d,
+	0x1c, 0xef, 0xae, 0x67, 0xed, 0xdd, 0x7b, 0x73, 0xaf, 0x93, 0x5c, 0xe1, 0x9f, 0x25, 0x98, 0xac,
+	0x77, 0x57, 0x6d, 0x67, 0x13, 0x45, 0x0a, 0x9d, 0x9a, 0xee, 0xda, 0x71, 0x2b, 0x3d, 0xdd, 0x43,
+	0x57, 0x8f, 0x3d, 0x0e, 0x12, 0x42, 0xf0, 0xc0, 0x8f, 0x40, 0x20, 0x78, 0x8f, 0x82, 0xc4, 0x13,
+	0x4f, 0x91, 0xc8, 0x1b, 0x2f, 0x3c, 0x46, 0xe2, 0x25, 0x44, 0x42, 0x42, 0x20, 0x19, 0xb4, 0x41,
+	0x0a, 0x02, 0x21, 0xa1, 0x7d, 0xe0, 0x19, 0x55, 0x55, 0x77, 0x4d, 0x77, 0x4f, 0xb7, 0xdb, 0x76,
+	0x26, 0x90, 0x97, 0xf5, 0x76, 0xd5, 0x57, 0xe7, 0xaf, 0xce, 0x39, 0x55, 0xe7, 0xd4, 0x80, 0x4c,
+	0x7c, 0xcf, 0x32, 0x71, 0x89, 0xf8, 0xe8, 0x2d, 0x6c, 0x95, 0x8d, 0x92, 0xdf, 0x58, 0xad, 0x79,
+	0xae, 0xef, 0x4a, 0x23, 0x7c, 0x66, 0x35, 0x9c, 0x51, 0xc6, 0x50, 0xd5, 0x72, 0xdc, 0x12, 0xfb,
+	0x97, 0x63, 0x94, 0x19, 0xc3, 0x25, 0x55, 0x97, 0xe8, 0xec, 0xab, 0xc4, 0x3f, 0x82, 0xa9, 0x79,
+	0xfe, 0x55, 0x2a, 0x23, 0x82, 0x4b, 0x47, 0x6b, 0x65, 0xec, 0xa3, 0xb5, 0x92, 0xe1, 0x5a, 0x4e,
+	0x30, 0x3f, 0x1d, 0xcc, 0x57, 0x49, 0xa5, 0x74, 0xb4, 0x46, 0xff, 0x04, 0x13, 0x13, 0x15, 0xb7,
+	0xe2, 0x72, 0x82, 0xf4, 0x7f, 0xc1, 0x68, 0x31, 0x29, 0xe7, 0xa1, 0x4b, 0x7c, 0xfd, 0x6d, 0xd7,
+	0xc1, 0x01, 0x60, 0xb1, 0x45, 0x11, 0x0f, 0x99, 0x58, 0xf7, 0xdc, 0xba, 0x8f, 0xb3, 0x68, 0x1c,
+	0x21, 0xdb, 0x32, 0x91, 0xef, 0x7a, 0x1c, 0xa0, 0xbe, 0xd3, 0x05, 0xea, 0x1e, 0xa9, 0xbc, 0x52,
+	0x33, 0x91, 0x8f, 0x77, 0x1d, 0x07, 0x7b, 0x1a, 0x36, 0x71, 0xb5, 0xe6, 0x5b, 0xae, 0xa3, 0x21,
+	0x1f, 0x6f, 0xb9, 0x75, 0xc7, 0x24, 0xd2, 0x3a, 0xf4, 0x19, 0x1e, 0xa6, 0xeb, 0xe4, 0xc2, 0x42,
+	0x61, 0x79, 0x60, 0x4b, 0xfe, 0xe8, 0xfd, 0x1b, 0x13, 0x81, 0xf6, 0x9b, 0xa6, 0xe9, 0x61, 0x42,
+	0xf6, 0x7d, 0xcf, 0x72, 0x2a, 0x5a, 0x08, 0x94, 0x66, 0xa0, 0xdf, 0x38, 0x44, 0x96, 0xa3, 0x5b,
+	0xa6, 0xdc, 0x49, 0x17, 0x69, 0x7d, 0xec, 0x7b, 0xd7, 0x94, 0x8e, 0x61, 0xa6, 0x4a, 0x27, 0x28,
+	0x3f, 0xdd, 0x13, 0x0c, 0x75, 0x0f, 0xf9, 0x58, 0xee, 0x62, 0x0c, 0x5e, 0xf8, 0xe0, 0xb4, 0xd8,
+	0xf1, 0xfb, 0xd3, 0xe2, 0xd3, 0x15, 0xcb, 0x3f, 0xac, 0x97, 0x57, 0x0d, 0xb7, 0x1a, 0x58, 0x3b,
+	0xf8, 0x73, 0x83, 0x98, 0x6f, 0x95, 0xfc, 0x93, 0x1a, 0x26, 0xab, 0x3b, 0xd8, 0xf8, 0xe8, 0xfd,
+	0x1b, 0x10, 0x88, 0xb3, 0x83, 0x0d, 0x6d, 0xaa, 0x6a, 0x39, 0x29, 0xda, 0x30, 0xc6, 0xa8, 0x91,
+	0xc1, 0xb8, 0xbb, 0x2d, 0x8c, 0x51, 0x23, 0x85, 0xf1, 0xc6, 0x73, 0xdf, 0xfc, 0xe4, 0xbd, 0x95,
+	0xd0, 0x34, 0xdf, 0xfb, 0xe4, 0xbd, 0x95, 0xa7, 0xc5, 0x96, 0x08, 0xf3, 0xa7, 0x59, 0x5e, 0xbd,
+	0x0e, 0x2b, 0xf9, 0xfb, 0xa3, 0x61, 0x52, 0x73, 0x1d, 0x82, 0xd5, 0xdf, 0x16, 0xe0, 0xca, 0x1e,
+	0xa9, 0xdc, 0xb5, 0xbe, 0x5a, 0xb7, 0xcc, 0x7d, 0xca, 0xe1, 0x52, 0x5b, 0xf7, 0x45, 0xe8, 0x45,
+	0x55, 0xb7, 0xee, 0xf8, 0x7c, 0xe3, 0xb6, 0x56, 0x2f, 0x60, 0x93, 0x5d, 0xc7, 0xd7, 0x82, 0xd5,
+	0xd2, 0x1c, 0x00, 0x73, 0x5a, 0x13, 0x3b, 0x6e, 0x95, 0x6f, 0xac, 0x36, 0x40, 0x47, 0x76, 0xe8,
+	0xc0, 0xc6, 0x72, 0xd2, 0x28, 0xd3, 0x51, 0xa3, 0x44, 0x94, 0x50, 0xbf, 0x51, 0x80, 0xa9, 0xf8,
+	0x50, 0xa8, 0xb2, 0xf4, 0x08, 0xfa, 0x89, 0xaf, 0xfb, 0xee, 0x5b, 0xd8, 0x61, 0x0a, 0x0e, 0xae,
+	0xcf, 0xac, 0x06, 0xda, 0xd1, 0x40, 0x5c, 0x0d, 0x02, 0x71, 0x75, 0xdb, 0xb5, 0x9c, 0xad, 0x9b,
+	0x54, 0x91, 0x9f, 0xff, 0xb1, 0xb8, 0x7c, 0x0e, 0x45, 0xe8, 0x02, 0xa2, 0xf5, 0x11, 0xff, 0x80,
+	0xd2, 0x56, 0xff, 0x56, 0x80, 0x31, 0x2a, 0xc2, 0xfe, 0xde, 0xe7, 0xc5, 0xba, 0x37, 0x60, 0xdc,
+	0x26, 0x55, 0xae, 0xba, 0x6e, 0x95, 0x8d, 0x98, 0x99, 0x47, 0x6d, 0x52, 0x65, 0x82, 0xef, 0x96,
+	0x0d, 0x6e, 0xed, 0x6b, 0x49, 0x6b, 0x2b, 0x31, 0x6b, 0xc7, 0xf4, 0x52, 0xef, 0xc1, 0x4c, 0xcb,
+	0xa0, 0x30, 0xf9, 0x1a, 0x4c, 0xf8, 0x1e, 0x72, 0x08, 0x32, 0x58, 0xf0, 0x18, 0x6e, 0xb5, 0x66,
+	0x63, 0x1f, 0x33, 0x0b, 0xf4, 0x6b, 0xe3, 0x91, 0xb9, 0xed, 0x60, 0x4a, 0xfd, 0x7b, 0x01, 0x46,
+	0xf6, 0x48, 0x65, 0xdb, 0xc6, 0xc8, 0xdb, 0x42, 0x36, 0x72, 0x0c, 0xdc, 0xee, 0xa4, 0xd2, 0x34,
+	0x6b, 0xd7, 0xa7, 0x32, 0xab, 0x0c, 0x94, 0xa4, 0xe3, 0x60, 0x5b, 0xee, 0x16, 0x1c, 0xe8, 0xe7,
+	0xc6, 0xb3, 0x49, 0x0b, 0xca, 0x51, 0x0b, 0x46, 0x75, 0x53, 0x67, 0x60, 0x3a, 0x31, 0x24, 0x62,
+	0xf4, 0xbb, 0x9d, 0x2c, 0x46, 0x69, 0x1c, 0xe3, 0xea, 0x7f, 0xde, 0x8b, 0x66, 0x61, 0x40, 0x1c,
+	0x2c, 0x81, 0xef, 0xf4, 0xd3, 0x81, 0xd7, 0x5d, 0x07, 0x4b, 0xb7, 0xa1, 0xdf, 0xc3, 0x06, 0xb6,
+	0x8e, 0xb0, 0x27, 0x77, 0xe7, 0x48, 0x26, 0x90, 0x39, 0x71, 0x1d, 0x51, 0x5c, 0x95, 0x61, 0x2a,
+	0x3e, 0x22, 0xac, 0xf4, 0xcf, 0x5e, 0x18, 0x67, 0x53, 0x15, 0x8b, 0xf8, 0xd8, 0xfb, 0x52, 0x28,
+	0xd1, 0x8b, 0x30, 0x6c, 0xb8, 0x8e, 0x83, 0xb9, 0xeb, 0x85, 0x5e, 0xb0, 0x25, 0x3f, 0x39, 0x2d,
+	0x4e, 0x9c, 0xa0, 0xaa, 0xbd, 0xa1, 0xc6, 0xa6, 0x55, 0x6d, 0xa8, 0xf9, 0xbd, 0x6b, 0x4a, 0x2a,
+	0x0c, 0x95, 0xb1, 0x71, 0x78, 0x6b, 0xbd, 0xe6, 0xe1, 0x47, 0x56, 0x43, 0x1e, 0x62, 0x0a, 0xc7,
+	0xc6, 0xa4, 0xdb, 0xb1, 0xac, 0xc5, 0xd5, 0x9e, 0x7c, 0x72, 0x5a, 0x1c, 0xe3, 0xf4, 0x9b, 0x73,
+	0x6a, 0x24, 0x99, 0x49, 0x6b, 0x30, 0xd0, 0x8c, 0xc1, 0x1e, 0xb6, 0x68, 0xe2, 0xc9, 0x69, 0x71,
+	0x94, 0x2f, 0x12, 0x53, 0xaa, 0xd6, 0x6f, 0x05, 0x11, 0x19, 0xdd, 0xf6, 0xde, 0xf3, 0x6e, 0xfb,
+	0x3d, 0xe0, 0xf1, 0xf5, 0x08, 0x7b, 0x7a, 0xe0, 0x97, 0xd4, 0x0a, 0xc0, 0xd6, 0xcf, 0x3f, 0x39,
+	0x2d, 0x2a, 0x9c, 0x61, 0x0a, 0x48, 0xd5, 0xc6, 0xc2, 0xd1, 0x6d, 0x3e, 0xc8, 0xa2, 0x66, 0xb4,
+	0xee, 0x94, 0x5d, 0xc7, 0xb4, 0x9c, 0x8a, 0x5e, 0xc3, 0x9e, 0xe5, 0x9a, 0xf2, 0xe0, 0x42, 0x61,
+	0xb9, 0x7b, 0x6b, 0xf6, 0xc9, 0x69, 0x71, 0x9a, 0x13, 0x4b, 0x22, 0x54, 0x6d, 0x44, 0x0c, 0x3d,
+	0x60, 0x23, 0x92, 0x0d, 0xe3, 0xf4, 0x48, 0x4f, 0x9e, 0xa9, 0xc3, 0x6d, 0x38, 0x53, 0xc7, 0xaa,
+	0x96, 0x93, 0x38, 0xc7, 0x29, 0x37, 0xd4, 0x68, 0xe1, 0x76, 0xa5, 0x2d, 0xdc, 0x50, 0x23, 0xc1,
+	0xed, 0x39, 0x90, 0x69, 0xa2, 0xb5, 0x59, 0x2a, 0xd4, 0x99, 0x2f, 0xeb, 0xd8, 0x41, 0x65, 0x1b,
+	0x9b, 0xf2, 0x08, 0xcb, 0x79, 0x93, 0x36, 0xa9, 0x46, 0x32, 0xe5, 0x1d, 0x3e, 0x29, 0xdd, 0x81,
+	0xa2, 0xe1, 0x56, 0xab, 0x75, 0xc7, 0xf2, 0x4f, 0xf4, 0x9a, 0xeb, 0xda, 0xba, 0xef, 0x61, 0x44,
+	0xea, 0xde, 0x89, 0x8e, 0xf8, 0xf6, 0xca, 0xa3, 0xcc, 0x01, 0x9f, 0x12, 0xb0, 0x07, 0xae, 0x6b,
+	0x1f, 0x04, 0xa0, 0xc0, 0x05, 0xa4, 0xdb, 0x30, 0x4d, 0xb5, 0xad, 0x62, 0x42, 0x50, 0x05, 0x13,
+	0xba, 0x09, 0xba, 0x65, 0x20, 0xdd, 0x6f, 0xc8, 0x63, 0x74, 0xab, 0x34, 0x6a, 0x8c, 0xbd, 0x60,
+	0xf6, 0x01, 0xf6, 0x76, 0x0d, 0x74, 0xd0, 0xd8, 0xf8, 0xef, 0xef, 0xbc, 0x5b, 0xec, 0xf8, 0xcb,
+	0xbb, 0xc5, 0x8e, 0x64, 0x34, 0x3e, 0x15, 0x8f, 0xc6, 0x78, 0x80, 0xa9, 0x73, 0x30, 0x9b, 0x32,
+	0x2c, 0xe2, 0xf2, 0xb4, 0xc0, 0x4e, 0x86, 0x6d, 0x1b, 0x59, 0xd5, 0x57, 0x1c, 0x13, 0xdb, 0xb8,
+	0x82, 0x7c, 0x6c, 0xb2, 0xa3, 0xe6, 0x72, 0xf7, 0xc4, 0x05, 0x18, 0x12, 0x09, 0xa8, 0x99, 0xd6,
+	0x21, 0xcc, 0x41, 0xbb, 0xa6, 0x34, 0x01, 0x3d, 0xb8, 0xe6, 0x1a, 0x87, 0x2c, 0x3d, 0x75, 0x6b,
+	0xfc, 0x43, 0x52, 0x22, 0xb9, 0xa9, 0x87, 0xe7, 0x2d, 0x91, 0x81, 0x6e, 0x25, 0x75, 0x56, 0xe3,
+	0x99, 0x3a, 0x4d, 0xf8, 0x2f, 0x77, 0xf7, 0x77, 0x8f, 0xf6, 0xa8, 0x4b, 0xb0, 0x98, 0x09, 0x11,
+	0x56, 0xf8, 0x55, 0x21, 0x48, 0x5c, 0x65, 0x9e, 0xdc, 0x1f, 0x86, 0xd7, 0xea, 0xcb, 0x99, 0x20,
+	0x96, 0x83, 0x3b, 0x13, 0x39, 0x78, 0x09, 0x86, 0x9d, 0x7a, 0x55, 0xf7, 0x42, 0x5e, 0x81, 0x15,
+	0x86, 0x9c, 0x7a, 0x55, 0xf0, 0xdf, 0xb8, 0x99, 0x54, 0xb8, 0x18, 0xdf, 0xe4, 0x16, 0x39, 0xd5,
+	0x05, 0x98, 0x4f, 0x9f, 0x11, 0x4a, 0xfe, 0xba, 0x00, 0xa3, 0x7b, 0xa4, 0xb2, 0x69, 0x9a, 0x9f,
+	0xa5, 0x7a, 0x1b, 0x00, 0xa2, 0x28, 0x21, 0x72, 0xd7, 0x42, 0xd7, 0xf2, 0xe0, 0xba, 0xb2, 0x9a,
+	0xa8, 0xc4, 0x56, 0x85, 0x04, 0x5a, 0x04, 0xbd, 0xb1, 0x92, 0xd4, 0x7a, 0x26, 0xaa, 0x75, 0x4c,
+	0x70, 0x55, 0x01, 0x39, 0x39, 0x26, 0x34, 0x7d, 0x03, 0x46, 0xc4, 0xe8, 0xab, 0xd8, 0xaa, 0x1c,
+	0xfa, 0x54, 0xcf, 0x30, 0x44, 0x73, 0xf5, 0x0c, 0x80, 0xd2, 0x14, 0xf4, 0x1e, 0xb3, 0xd5, 0x4c,
+	0xc9, 0x6e, 0x2d, 0xf8, 0x52, 0xff, 0x11, 0xc4, 0xcc, 0x21, 0x72, 0x2a, 0x38, 0xc1, 0xe8, 0x33,
+	0xb0, 0xe8, 0x1e, 0x8c, 0x09, 0x1b, 0xe9, 0x5c, 0x84, 0xd0, 0xb0, 0x0b, 0xd9, 0x86, 0xe5, 0xe2,
+	0x68, 0xa3, 0x47, 0x09, 0xf9, 0xf2, 0x62, 0x29, 0x55, 0xa9, 0x30, 0x8a, 0x52, 0x27, 0x85, 0xd9,
+	0x7f, 0x53, 0x00, 0x69, 0x8f, 0x54, 0x76, 0x30, 0xbd, 0x22, 0x0a, 0x54, 0xfb, 0x0d, 0xf2, 0x02,
+	0xf4, 0x1f, 0x21, 0x9b, 0xa5, 0xdc, 0xe0, 0x6e, 0xb8, 0xf8, 0xd1, 0xfb, 0x37, 0xe6, 0x02, 0x8a,
+	0x82, 0x71, 0x82, 0xf4, 0x11, 0xb2, 0xe9, 0xc8, 0xc6, 0xf5, 0xa4, 0xfe, 0xb3, 0x51, 0xfd, 0x13,
+	0xc2, 0xab, 0x4f, 0x81, 0xd2, 0x3a, 0x2a, 0x34, 0xfe, 0x6b, 0x21, 0xc8, 0xae, 0xc4, 0x77, 0x3d,
+	0xbc, 0xeb, 0xf8, 0xd8, 0x63, 0xd7, 0xd7, 0x4d, 0xc3, 0x60, 0x97, 0xb1, 0x36, 0x5f, 0x89, 0x97,
+	0x92, 0x97, 0x25, 0x7e, 0xbf, 0x8b, 0x5f, 0x89, 0x96, 0x60, 0x18, 0x71, 0xf6, 0xba, 0x7b, 0xec,
+	0x84, 0x17, 0x3d, 0x6d, 0x28, 0x18, 0xbc, 0x4f, 0xc7, 0x36, 0xd6, 0x93, 0x46, 0x58, 0x8c, 0xe7,
+	0x97, 0x14, 0x7d, 0xd4, 0xff, 0x82, 0xa5, 0x33, 0x74, 0x15, 0x36, 0x79, 0x27, 0x3c, 0x51, 0x5c,
+	0x82, 0x77, 0x78, 0xbe, 0xa5, 0x95, 0x03, 0xbf, 0xa1, 0xb4, 0xd9, 0x22, 0x39, 0x7a, 0xa4, 0xca,
+	0x20, 0x4e, 0x84, 0x34, 0xf9, 0x84, 0x16, 0x7f, 0x2e, 0xc0, 0x82, 0x28, 0xd4, 0xc5, 0xc6, 0xef,
+	0x1f, 0x22, 0x0f, 0x93, 0x3b, 0x0d, 0xe3, 0x90, 0x5d, 0x24, 0xda, 0xbc, 0xbd, 0xcf, 0x03, 0x75,
+	0x52, 0xb7, 0x86, 0x2f, 0xe8, 0xd6, 0x74, 0xc5, 0xc6, 0xed, 0xa4, 0x25, 0x96, 0x5a, 0x3b, 0x12,
+	0x0f, 0x91, 0x1d, 0xd7, 0x40, 0x5d, 0x81, 0xe5, 0x3c, 0x2d, 0x85, 0x49, 0xbe, 0xdf, 0xc9, 0x0e,
+	0xc9, 0x6d, 0x64, 0x5b, 0x65, 0x0f, 0xf9, 0x11, 0xe3, 0x7d, 0x9e, 0x0c, 0x21, 0xdd, 0x86, 0x29,
+	0x53, 0x48, 0xa6, 0x93, 0x43, 0xe4, 0x99, 0xba, 0xe5, 0x98, 0xb8, 0xc1, 0x02, 0x61, 0x58, 0x9b,
+	0x68, 0xce, 0x52, 0x45, 0xcd, 0x5d, 0x3a, 0x97, 0x73, 0xe0, 0xa6, 0xe8, 0x1c, 0x1c, 0xb8, 0x29,
+	0x33, 0xc2, 0x60, 0x3f, 0xe4, 0x2d, 0x06, 0x0d, 0x93, 0x7a, 0x15, 0x8b, 0x8a, 0xa7, 0xcd, 0x11,
+	0x70, 0x76, 0x1b, 0x20, 0xce, 0x5b, 0x9d, 0x85, 0x99, 0x96, 0x41, 0x21, 0xee, 0x4f, 0x0b, 0xa0,
+	0x44, 0xae, 0x8a, 0x3b, 0x71, 0x33, 0xb5, 0x5b, 0xee, 0xb3, 0xfd, 0x35, 0x43, 0x08, 0xf5, 0x2a,
+	0xa8, 0xd9, 0xb3, 0x42, 0x93, 0x5f, 0x0e, 0xb0, 0x62, 0x73, 0x9b, 0x12, 0xc7, 0x07, 0x1e, 0x32,
+	0xb1, 0xe6, 0xd6, 0x7d, 0x2c, 0xfd, 0x0f, 0x0c, 0xa0, 0xba, 0x7f, 0xe8, 0x7a, 0x96, 0x7f, 0x92,
+	0xab, 0x44, 0x13, 0x2a, 0xa9, 0x30, 0xcc, 0x4e, 0xa3, 0x84, 0x2e, 0x83, 0x74, 0x70, 0x3b, 0xf0,
+	0xd9, 0x2d, 0x98, 0xe7, 0x67, 0xb1, 0xee, 0xbb, 0xba, 0x87, 0x8f, 0xa9, 0xdb, 0xa5, 0x25, 0x6b,
+	0x85, 0xa3, 0x0e, 0x5c, 0x8d, 0x61, 0xb6, 0xa3, 0xa9, 0xfb, 0x0b, 0x30, 0xd7, 0xa4, 0xc1, 0xbb,
+	0xbf, 0x71, 0x12, 0x3c, 0x95, 0xcf, 0x84, 0x24, 0x98, 0x6a, 0x31, 0x0a, 0xbb, 0xc0, 0xeb, 0xd9,
+	0xa6, 0x0c, 0x69, 0xd5, 0x25, 0xbf, 0x5e, 0xcf, 0x51, 0x64, 0x28, 0xc7, 0x41, 0x4b, 0x25, 0xf9,
+	0x32, 0x2c, 0x85, 0x24, 0x42, 0x61, 0xd2, 0x68, 0xb1, 0x4a, 0x57, 0x9b, 0xe7, 0xd0, 0x40, 0xa4,
+	0x56, 0x62, 0x2f, 0xc1, 0x62, 0x40, 0xc2, 0xd5, 0xb9, 0x80, 0x29, 0xa4, 0xfa, 0x78, 0xed, 0xc4,
+	0x80, 0x07, 0x2e, 0xf5, 0xcf, 0x56, 0x42, 0x25, 0x98, 0x08, 0xa4, 0x62, 0xe5, 0xb7, 0xee, 0x3a,
+	0x8c, 0x9e, 0xdc, 0xcf, 0xd6, 0x8e, 0xf1, 0x39, 0x56, 0x8e, 0xdf, 0x77, 0x28, 0x05, 0xe9, 0x16,
+	0x4c, 0x25, 0x17, 0xf0, 0x6f, 0x79, 0x80, 0x2d, 0x19, 0x8f, 0x2d, 0xe1, 0xc6, 0x90, 0xd6, 0x60,
+	0x32, 0xb9, 0x88, 0x49, 0xc5, 0xeb, 0x72, 0x4d, 0x8a, 0xad, 0x61, 0x2a, 0xd3, 0xee, 0x5d, 0xb3,
+	0x93, 0xd0, 0x5c, 0x30, 0xc8, 0xbb, 0x77, 0xa2, 0xaf, 0x10, 0xc2, 0xaf, 0x81, 0x14, 0x87, 0x33,
+	0x2d, 0x78, 0xfb, 0x62, 0x24, 0x82, 0x66, 0x3a, 0xcc, 0x42, 0x1f, 0xab, 0x36, 0x2d, 0x93, 0x15,
+	0xe0, 0xdd, 0x5b, 0x9d, 0x72, 0x41, 0xeb, 0xa5, 0x43, 0xbb, 0xa6, 0xf4, 0xff, 0xa0, 0xd0, 0x6a,
+	0x12, 0xd9, 0xb6, 0x7b, 0x8c, 0x4d, 0x9d, 0x1c, 0xa3, 0x9a, 0x6e, 0xbb, 0x84, 0x44, 0x4b, 0x68,
+	0x8a, 0xa7, 0xad, 0xec, 0x4d, 0x0e, 0xda, 0x3f, 0x46, 0xb5, 0xbb, 0x2e, 0x21, 0xec, 0x10, 0x7b,
+	0x08, 0x23, 0xb4, 0xd2, 0x67, 0xeb, 0x82, 0x0e, 0xd4, 0xc8, 0xa5, 0x3a, 0x50, 0xc3, 0x55, 0xcb,
+	0xa1, 0x94, 0x37, 0x19, 0x11, 0x46, 0x17, 0x35, 0x62, 0x74, 0x47, 0x2f, 0x49, 0x17, 0x35, 0x22,
+	0x74, 0xbf, 0xc2, 0x3b, 0x13, 0xc2, 0x81, 0x02, 0xda, 0x63, 0x97, 0xa2, 0x4d, 0x7b, 0x11, 0xa1,
+	0x93, 0x05, 0xf4, 0xef, 0xc1, 0x08, 0x32, 0x4d, 0x8b, 0x06, 0x14, 0xb2, 0x75, 0x1b, 0x57, 0x88,
+	0x2c, 0xb1, 0xcb, 0x76, 0xb1, 0xe5, 0xb2, 0xcd, 0xb6, 0xf2, 0x2e, 0xae, 0x6c, 0xbb, 0xce, 0x23,
+	0xab, 0xb2, 0xd5, 0x4d, 0x99, 0x6b, 0x57, 0x9a, 0xab, 0xef, 0xe2, 0x0a, 0xd9, 0x28, 0xd1, 0x44,
+	0xd7, 0x4c, 0x26, 0x2d, 0x15, 0x7b, 0x32, 0x4b, 0xa9, 0xbf, 0xe8, 0x84, 0x2b, 0x71, 0xca, 0xf9,
+	0x89, 0xa1, 0x90, 0x97, 0x18, 0x5e, 0x84, 0x59, 0xcb, 0x29, 0xd3, 0xd7, 0x85, 0xd4, 0xd0, 0xe3,
+	0x09, 0x4d, 0x0e, 0x20, 0xa9, 0x61, 0x67, 0x39, 0xb5, 0x7a, 0x8b, 0x7b, 0xf3, 0x9c, 0x36, 0xc6,
+	0xe6, 0x62, 0xfe, 0xbd, 0x06, 0x93, 0x6e, 0xdd, 0x4f, 0x59, 0xc1, 0x53, 0x98, 0xc4, 0x27, 0x63,
+	0x4b, 0x5e, 0x80, 0x41, 0xe6, 0x2c, 0x06, 0xd3, 0x99, 0x25, 0xa9, 0xc1, 0xf5, 0xd9, 0x16, 0xa3,
+	0x53, 0x57, 0xe0, 0x66, 0xd1, 0x80, 0x88, 0xff, 0x07, 0x7d, 0x8e, 0xa4, 0x31, 0xa3, 0x15, 0xfe,
+	0xb8, 0xb8, 0xc8, 0xb7, 0xe1, 0x48, 0x58, 0x84, 0xa1, 0x68, 0x86, 0x08, 0x4f, 0x84, 0x48, 0x62,
+	0xc8, 0x7b, 0x2d, 0xc9, 0xf3, 0x8b, 0xa4, 0xa8, 0x81, 0x86, 0xc9, 0xe1, 0x66, 0xf5, 0xd5, 0x03,
+	0xe3, 0xe2, 0x2e, 0xf7, 0x79, 0xd0, 0x30, 0x9a, 0xb6, 0xba, 0x2f, 0x98, 0xb6, 0x7a, 0x72, 0xd3,
+	0xd6, 0x6b, 0xad, 0x69, 0x8b, 0x37, 0x5d, 0x6f, 0x5e, 0x2c, 0x05, 0xc8, 0x85, 0x64, 0xe2, 0x7a,
+	0xad, 0x35, 0x71, 0xf5, 0x5d, 0x9a, 0xf2, 0xbf, 0x35, 0x75, 0x25, 0x22, 0x48, 0xba, 0x50, 0x04,
+	0x49, 0x6f, 0xc2, 0x6c, 0x3c, 0xf1, 0xe9, 0x11, 0x62, 0x44, 0x1e, 0x5f, 0xe8, 0xca, 0xa1, 0x16,
+	0x24, 0x40, 0x39, 0x96, 0x00, 0x9b, 0xd3, 0xf9, 0xa9, 0x30, 0xe9, 0xbb, 0x81, 0xcb, 0x27, 0x87,
+	0x85, 0xcb, 0x3f, 0xee, 0x64, 0xf7, 0xd9, 0x7d, 0xec, 0x6f, 0x47, 0xfb, 0xad, 0xb4, 0x09, 0xd6,
+	0xfe, 0xea, 0xec, 0x3e, 0x0c, 0x7a, 0x8c, 0x70, 0xf4, 0x59, 0x7b, 0xf5, 0x62, 0xbd, 0x69, 0x0d,
+	0x38, 0x09, 0xe6, 0xc1, 0x35, 0x98, 0x8b, 0xb6, 0xa0, 0xe9, 0x9f, 0xe0, 0xf1, 0x2f, 0xf0, 0x8b,
+	0xee, 0x4b, 0xf9, 0xc5, 0x8c, 0xdd, 0x6c, 0x5c, 0x9b, 0xfb, 0xfc, 0xb5, 0x93, 0xfb, 0x47, 0x4e,
+	0xeb, 0x27, 0xdd, 0x8c, 0x41, 0xb9, 0x9c, 0x3e, 0x29, 0x76, 0xe2, 0x67, 0x9d, 0xac, 0x1d, 0x77,
+	0xe0, 0x56, 0x2a, 0x36, 0x0e, 0x4f, 0x1f, 0xdf, 0x73, 0x6d, 0x1b, 0x7b, 0xed, 0xde, 0x88, 0x7d,
+	0x18, 0xab, 0x61, 0xaf, 0x6a, 0x11, 0xc2, 0x5e, 0x2b, 0x59, 0x4f, 0x8a, 0x6d, 0xc7, 0x95, 0xf5,
+	0xa7, 0x5b, 0xbc, 0x73, 0xb3, 0xee, 0x1f, 0xbe, 0xfd, 0x40, 0xc0, 0x79, 0x07, 0x4b, 0x1b, 0xad,
+	0x25, 0x46, 0xe8, 0x2b, 0x61, 0xd8, 0x1f, 0x0c, 0x5e, 0x09, 0x23, 0x5d, 0x40, 0x5a, 0x65, 0x18,
+	0x27, 0x2c, 0x29, 0xf5, 0x6b, 0xc1, 0x57, 0x4e, 0xeb, 0x21, 0xd5, 0x12, 0xaa, 0x0a, 0x0b, 0x59,
+	0x73, 0xc2, 0x94, 0x7f, 0xe8, 0x86, 0x69, 0xe1, 0xf4, 0x61, 0x91, 0xf6, 0x00, 0x79, 0xa8, 0x4a,
+	0x2e, 0x9d, 0xcb, 0xcf, 0xb0, 0xe6, 0x19, 0x8f, 0x11, 0x5d, 0x99, 0x8f, 0x11, 0xd2, 0xb7, 0x0b,
+	0x70, 0x35, 0xe5, 0xc5, 0x26, 0xd8, 0x0d, 0x46, 0x84, 0xb7, 0xf8, 0xb9, 0x0f, 0xff, 0xef, 0xa5,
+	0x9f, 0x6f, 0x8a, 0x2d, 0xcf, 0x37, 0x7c, 0xc3, 0x1e, 0x60, 0xef, 0x0e, 0x65, 0x20, 0x7d, 0xab,
+	0x00, 0x6a, 0x8e, 0x24, 0x26, 0x3a, 0x91, 0x7b, 0x3e, 0xa5, 0x1c, 0x73, 0xd9, 0x72, 0xec, 0xa0,
+	0x13, 0x69, 0x0b, 0x86, 0x99, 0xbe, 0xba, 0x81, 0x4c, 0x4c, 0x9b, 0xfa, 0xbd, 0x2c, 0xf7, 0xce,
+	0xb5, 0xf8, 0x23, 0x13, 0x7a, 0x9b, 0x83, 0xb4, 0x21, 0x1c, 0xf9, 0x92, 0x56, 0x61, 0xdc, 0xc3,
+	0x04, 0xfb, 0x7a, 0x9c, 0x52, 0x1f, 0xf3, 0xba, 0x31, 0x36, 0x15, 0x5d, 0xcd, 0xa3, 0x39, 0x9e,
+	0x4d, 0x17, 0x5a, 0xb3, 0x69, 0xdc, 0x83, 0xd4, 0x45, 0x28, 0x66, 0x4c, 0x85, 0x0e, 0xb8, 0xb2,
+	0x0a, 0x93, 0xa9, 0x51, 0x23, 0x0d, 0x40, 0xcf, 0x4b, 0xda, 0xe6, 0xbd, 0x83, 0xd1, 0x0e, 0x09,
+	0xa0, 0x57, 0xbb, 0xf3, 0xf0, 0xfe, 0xcb, 0x77, 0x46, 0x0b, 0xeb, 0x3f, 0x9e, 0x80, 0xae, 0x3d,
+	0x52, 0x91, 0x5e, 0x85, 0xc1, 0xe8, 0x4f, 0x29, 0x5a, 0xaf, 0xcb, 0xf1, 0x5f, 0x7c, 0x28, 0xcf,
+	0xe4, 0x00, 0x42, 0x81, 0xa4, 0x37, 0xe1, 0x4a, 0xe2, 0x67, 0x1a, 0x6a, 0xea, 0xd2, 0x18, 0x46,
+	0x59, 0xc9, 0xc7, 0x08, 0x0e, 0xaf, 0xc2, 0x60, 0xf4, 0xfd, 0x3e, 0x55, 0xf4, 0x08, 0x40, 0x79,
+	0x26, 0x07, 0x10, 0xf9, 0x35, 0xcb, 0x68, 0xcb, 0x93, 0xf7, 0xd5, 0xf4, 0xc5, 0x71, 0x94, 0x72,
+	0xfd, 0x3c, 0x28, 0xc1, 0xa7, 0x01, 0x53, 0x19, 0x4f, 0x78, 0xa9, 0x66, 0x48, 0xc7, 0x2a, 0xeb,
+	0xe7, 0xc7, 0x0a, 0xce, 0x2e, 0x8c, 0xa7, 0x3d, 0x9b, 0x65, 0x58, 0xa8, 0x05, 0xa8, 0x94, 0xce,
+	0x09, 0x14, 0x0c, 0xdf, 0x80, 0xe1, 0xf8, 0x13, 0xd6, 0x62, 0x1a, 0x85, 0x18, 0x44, 0x79, 0x36,
+	0x17, 0x22, 0xc8, 0x1f, 0xc3, 0x64, 0xea, 0x33, 0x47, 0x86, 0x21, 0xd3, 0xa0, 0x59, 0x86, 0x3c,
+	0xf3, 0xf5, 0x44, 0x32, 0x60, 0x24, 0xf9, 0x72, 0xb2, 0x94, 0x46, 0x26, 0x01, 0x52, 0xae, 0x9d,
+	0x03, 0x24, 0x98, 0x7c, 0x1d, 0xe4, 0xcc, 0xc7, 0x8a, 0x0c, 0x8f, 0x4b, 0x47, 0x2b, 0xb7, 0x2f,
+	0x82, 0x8e, 0xfb, 0x69, 0xea, 0xc3, 0x40, 0x86, 0x9f, 0xa6, 0x61, 0x95, 0xf5, 0xf3, 0x63, 0x05,
+	0xe7, 0x1f, 0x14, 0x60, 0xee, 0xec, 0x6e, 0xfe, 0x5a, 0x1a, 0xd5, 0x33, 0x97, 0x28, 0xff, 0x77,
+	0xe1, 0x25, 0xd1, 0xb8, 0x49, 0xeb, 0xa4, 0xa7, 0xc6, 0x4d, 0x0a, 0x50, 0x29, 0x9d, 0x13, 0x28,
+	0x18, 0xbe, 0x0e, 0x43, 0xb1, 0x9f, 0x6b, 0x2d, 0xa4, 0x1b, 0xb1, 0x89, 0x50, 0x96, 0xf3, 0x10,
+	0x82, 0xf6, 0x4f, 0x0a, 0x50, 0xcc, 0xfb, 0xcd, 0xe9, 0xad, 0x6c, 0x5b, 0x65, 0x2e, 0x52, 0x9e,
+	0xbf, 0xc4, 0xa2, 0xe8, 0xb9, 0x91, 0xe8, 0xbd, 0xab, 0x19, 0x4e, 0x1b, 0xc1, 0x28, 0x2b, 0xf9,
+	0x18, 0xc1, 0xe1, 0x6b, 0x30, 0x9d, 0xd5, 0x2e, 0xbf, 0x76, 0x56, 0xfe, 0x4e, 0x80, 0x95, 0x5b,
+	0x17, 0x00, 0x47, 0xcf, 0x96, 0x96, 0x0e, 0x77, 0xea, 0xd9, 0x92, 0x44, 0x29, 0xd7, 0xcf, 0x83,
+	0x8a, 0xf2, 0x69, 0x69, 0x9b, 0x5c, 0xcd, 0x4e, 0x3a, 0x79, 0x7c, 0xb2, 0x1a, 0x18, 0x94, 0x4f,
+	0x4b, 0xf3, 0xe2, 0x6a, 0xf6, 0xfe, 0xe7, 0xf1, 0xc9, 0xaa, 0x1a, 0x69, 0x0e, 0xca, 0xa8, 0x18,
+	0x53, 0xb7, 0x3e, 0x1d, 0xab, 0xac, 0x9f, 0x1f, 0x2b, 0x38, 0xd7, 0x61, 0x32, 0xbd, 0x42, 0x4a,
+	0x3d, 0x9f, 0x52, 0xa1, 0xca, 0xda, 0xb9, 0xa1, 0x82, 0xad, 0x07, 0x13, 0xa9, 0xd5, 0xc4, 0x72,
+	0xb6, 0xd9, 0xe2, 0x48, 0xe5, 0xe6, 0x79, 0x91, 0x21, 0xcf, 0xad, 0xbb, 0x1f, 0x3c, 0x9e, 0x2f,
+	0x7c, 0xf8, 0x78, 0xbe, 0xf0, 0xa7, 0xc7, 0xf3, 0x85, 0x1f, 0x7d, 0x3c, 0xdf, 0xf1, 0xe1, 0xc7,
+	0xf3, 0x1d, 0xbf, 0xfb, 0x78, 0xbe, 0xe3, 0xf5, 0xf5, 0xc8, 0xfd, 0x7b, 0x9f, 0x51, 0xbd, 0x71,
+	0x17, 0x95, 0x49, 0x89, 0x73, 0x28, 0x1d, 0xad, 0x3f, 0x57, 0x6a, 0x44, 0x7e, 0x03, 0x4f, 0xef,
+	0xe3, 0xe5, 0x5e, 0xf6, 0xeb, 0xf6, 0x5b, 0xff, 0x1a, 0x00, 0x43, 0xd2, 0x57, 0x6c, 0xec, 0x2f,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2669,6 +2932,7 @@ type MsgClient interface {
 	ClearBalance(ctx context.Context, in *MsgClearBalance, opts ...grpc.CallOption) (*MsgClearBalanceResponse, error)
 	UpdateInnerRedemptionRateBounds(ctx context.Context, in *MsgUpdateInnerRedemptionRateBounds, opts ...grpc.CallOption) (*MsgUpdateInnerRedemptionRateBoundsResponse, error)
 	ResumeHostZone(ctx context.Context, in *MsgResumeHostZone, opts ...grpc.CallOption) (*MsgResumeHostZoneResponse, error)
+	RegisterDelegationShard(ctx context.Context, in *MsgRegisterDelegationShard, opts ...grpc.CallOption) (*MsgRegisterDelegationShardResponse, error)
 	CreateTradeRoute(ctx context.Context, in *MsgCreateTradeRoute, opts ...grpc.CallOption) (*MsgCreateTradeRouteResponse, error)
 	DeleteTradeRoute(ctx context.Context, in *MsgDeleteTradeRoute, opts ...grpc.CallOption) (*MsgDeleteTradeRouteResponse, error)
 	UpdateTradeRoute(ctx context.Context, in *MsgUpdateTradeRoute, opts ...grpc.CallOption) (*MsgUpdateTradeRouteResponse, error)
@@ -2829,6 +3093,15 @@ func (c *msgClient) ResumeHostZone(ctx context.Context, in *MsgResumeHostZone, o
 	return out, nil
 }
 
+func (c *msgClient) RegisterDelegationShard(ctx context.Context, in *MsgRegisterDelegationShard, opts ...grpc.CallOption) (*MsgRegisterDelegationShardResponse, error) {
+	out := new(MsgRegisterDelegationShardResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/RegisterDelegationShard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CreateTradeRoute(ctx context.Context, in *MsgCreateTradeRoute, opts ...grpc.CallOption) (*MsgCreateTradeRouteResponse, error) {
 	out := new(MsgCreateTradeRouteResponse)
 	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/CreateTradeRoute", in, out, opts...)
@@ -2901,6 +3174,7 @@ type MsgServer interface {
 	ClearBalance(context.Context, *MsgClearBalance) (*MsgClearBalanceResponse, error)
 	UpdateInnerRedemptionRateBounds(context.Context, *MsgUpdateInnerRedemptionRateBounds) (*MsgUpdateInnerRedemptionRateBoundsResponse, error)
 	ResumeHostZone(context.Context, *MsgResumeHostZone) (*MsgResumeHostZoneResponse, error)
+	RegisterDelegationShard(context.Context, *MsgRegisterDelegationShard) (*MsgRegisterDelegationShardResponse, error)
 	CreateTradeRoute(context.Context, *MsgCreateTradeRoute) (*MsgCreateTradeRouteResponse, error)
 	DeleteTradeRoute(context.Context, *MsgDeleteTradeRoute) (*MsgDeleteTradeRouteResponse, error)
 	UpdateTradeRoute(context.Context, *MsgUpdateTradeRoute) (*MsgUpdateTradeRouteResponse, error)
@@ -2961,6 +3235,9 @@ func (*UnimplementedMsgServer) UpdateInnerRedemptionRateBounds(ctx context.Conte
 func (*UnimplementedMsgServer) ResumeHostZone(ctx context.Context, req *MsgResumeHostZone) (*MsgResumeHostZoneResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResumeHostZone not implemented")
 }
+func (*UnimplementedMsgServer) RegisterDelegationShard(ctx context.Context, req *MsgRegisterDelegationShard) (*MsgRegisterDelegationShardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDelegationShard not implemented")
+}
 func (*UnimplementedMsgServer) CreateTradeRoute(ctx context.Context, req *MsgCreateTradeRoute) (*MsgCreateTradeRouteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTradeRoute not implemented")
 }
@@ -3272,6 +3549,24 @@ func _Msg_ResumeHostZone_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterDelegationShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterDelegationShard)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterDelegationShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/RegisterDelegationShard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterDelegationShard(ctx, req.(*MsgRegisterDelegationShard))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CreateTradeRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCreateTradeRoute)
 	if err := dec(in); err != nil {
@@ -3448,6 +3743,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ResumeHostZone",
 			Handler:    _Msg_ResumeHostZone_Handler,
 		},
+		{
+			MethodName: "RegisterDelegationShard",
+			Handler:    _Msg_RegisterDelegationShard_Handler,
+		},
 		{
 			MethodName: "CreateTradeRoute",
 			Handler:    _Msg_CreateTradeRoute_Handler,
@@ -4618,6 +4917,11 @@ func (m *MsgCalibrateDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.DelegationShardIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DelegationShardIndex))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Valoper) > 0 {
 		i -= len(m.Valoper)
 		copy(dAtA[i:], m.Valoper)
@@ -4725,6 +5029,66 @@ func (m *MsgResumeHostZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRegisterDelegationShard) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterDelegationShard) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterDelegationShard) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterDelegationShardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterDelegationShardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterDelegationShardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgCreateTradeRoute) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4745,6 +5109,22 @@ func (m *MsgCreateTradeRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AdditionalLegs) > 0 {
+		for iNdEx := len(m.AdditionalLegs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalLegs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
 	{
 		size := m.MinTransferAmount.Size()
 		i -= size
@@ -4878,7 +5258,7 @@ func (m *MsgCreateTradeRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateTradeRouteResponse) Marshal() (dAtA []byte, err error) {
+func (m *TradeLegConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4888,21 +5268,84 @@ func (m *MsgCreateTradeRouteResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateTradeRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TradeLegConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateTradeRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TradeLegConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgDeleteTradeRoute) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+	if m.SwapConfig != nil {
+		{
+			size, err := m.SwapConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OutputDenomOnTrade) > 0 {
+		i -= len(m.OutputDenomOnTrade)
+		copy(dAtA[i:], m.OutputDenomOnTrade)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OutputDenomOnTrade)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.InputDenomOnTrade) > 0 {
+		i -= len(m.InputDenomOnTrade)
+		copy(dAtA[i:], m.InputDenomOnTrade)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InputDenomOnTrade)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.InboundTransferChannelId) > 0 {
+		i -= len(m.InboundTransferChannelId)
+		copy(dAtA[i:], m.InboundTransferChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InboundTransferChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StrideToTradeConnectionId) > 0 {
+		i -= len(m.StrideToTradeConnectionId)
+		copy(dAtA[i:], m.StrideToTradeConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StrideToTradeConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateTradeRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTradeRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTradeRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteTradeRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
@@ -4988,6 +5431,36 @@ func (m *MsgUpdateTradeRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AdditionalLegSwapConfigs) > 0 {
+		for iNdEx := len(m.AdditionalLegSwapConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalLegSwapConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.SwapConfig != nil {
+		{
+			size, err := m.SwapConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
 	{
 		size := m.MinTransferAmount.Size()
 		i -= size
@@ -5261,6 +5734,52 @@ func (m *MsgUpdateHostZoneParams) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.ResetEpochCadence {
+		i--
+		if m.ResetEpochCadence {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EpochCadence != nil {
+		{
+			size, err := m.EpochCadence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaxRedemptionRateChangePerDay != nil {
+		{
+			size := m.MaxRedemptionRateChangePerDay.Size()
+			i -= size
+			if _, err := m.MaxRedemptionRateChangePerDay.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MaxRedemptionRateChangePerEpoch != nil {
+		{
+			size := m.MaxRedemptionRateChangePerEpoch.Size()
+			i -= size
+			if _, err := m.MaxRedemptionRateChangePerEpoch.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.MaxMessagesPerIcaTx != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.MaxMessagesPerIcaTx))
 		i--
@@ -5811,6 +6330,9 @@ func (m *MsgCalibrateDelegation) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.DelegationShardIndex != 0 {
+		n += 1 + sovTx(uint64(m.DelegationShardIndex))
+	}
 	return n
 }
 
@@ -5849,6 +6371,32 @@ func (m *MsgResumeHostZoneResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRegisterDelegationShard) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterDelegationShardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgCreateTradeRoute) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5916,6 +6464,41 @@ func (m *MsgCreateTradeRoute) Size() (n int) {
 	n += 2 + l + sovTx(uint64(l))
 	l = m.MinTransferAmount.Size()
 	n += 2 + l + sovTx(uint64(l))
+	if len(m.AdditionalLegs) > 0 {
+		for _, e := range m.AdditionalLegs {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TradeLegConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StrideToTradeConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InboundTransferChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InputDenomOnTrade)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OutputDenomOnTrade)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SwapConfig != nil {
+		l = m.SwapConfig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -5989,6 +6572,16 @@ func (m *MsgUpdateTradeRoute) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.MinTransferAmount.Size()
 	n += 2 + l + sovTx(uint64(l))
+	if m.SwapConfig != nil {
+		l = m.SwapConfig.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if len(m.AdditionalLegSwapConfigs) > 0 {
+		for _, e := range m.AdditionalLegSwapConfigs {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -6084,6 +6677,21 @@ func (m *MsgUpdateHostZoneParams) Size() (n int) {
 	if m.MaxMessagesPerIcaTx != 0 {
 		n += 1 + sovTx(uint64(m.MaxMessagesPerIcaTx))
 	}
+	if m.MaxRedemptionRateChangePerEpoch != nil {
+		l = m.MaxRedemptionRateChangePerEpoch.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxRedemptionRateChangePerDay != nil {
+		l = m.MaxRedemptionRateChangePerDay.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EpochCadence != nil {
+		l = m.EpochCadence.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ResetEpochCadence {
+		n += 2
+	}
 	return n
 }
 
@@ -9497,6 +10105,25 @@ func (m *MsgCalibrateDelegation) Unmarshal(dAtA []byte) error {
 			}
 			m.Valoper = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationShardIndex", wireType)
+			}
+			m.DelegationShardIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationShardIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9732,7 +10359,7 @@ func (m *MsgResumeHostZoneResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateTradeRoute) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterDelegationShard) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9755,15 +10382,15 @@ func (m *MsgCreateTradeRoute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateTradeRoute: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterDelegationShard: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateTradeRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterDelegationShard: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9791,11 +10418,11 @@ func (m *MsgCreateTradeRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9823,52 +10450,216 @@ func (m *MsgCreateTradeRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostChainId = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StrideToRewardConnectionId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterDelegationShardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StrideToRewardConnectionId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StrideToTradeConnectionId", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterDelegationShardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterDelegationShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateTradeRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTradeRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTradeRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrideToRewardConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StrideToRewardConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrideToTradeConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
 				b := dAtA[iNdEx]
 				iNdEx++
 				stringLen |= uint64(b&0x7F) << shift
@@ -10108,14 +10899,283 @@ func (m *MsgCreateTradeRoute) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostDenomOnTrade = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostDenomOnHost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostDenomOnHost = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAllowedSwapLossRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxAllowedSwapLossRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSwapAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSwapAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTransferAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinTransferAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalLegs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalLegs = append(m.AdditionalLegs, TradeLegConfig{})
+			if err := m.AdditionalLegs[len(m.AdditionalLegs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TradeLegConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostDenomOnTrade = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradeLegConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradeLegConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostDenomOnHost", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StrideToTradeConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10143,30 +11203,11 @@ func (m *MsgCreateTradeRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostDenomOnHost = string(dAtA[iNdEx:postIndex])
+			m.StrideToTradeConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAllowedSwapLossRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundTransferChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10194,11 +11235,11 @@ func (m *MsgCreateTradeRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxAllowedSwapLossRate = string(dAtA[iNdEx:postIndex])
+			m.InboundTransferChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InputDenomOnTrade", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10226,13 +11267,11 @@ func (m *MsgCreateTradeRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSwapAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.InputDenomOnTrade = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputDenomOnTrade", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10260,15 +11299,13 @@ func (m *MsgCreateTradeRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxSwapAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OutputDenomOnTrade = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinTransferAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10278,23 +11315,25 @@ func (m *MsgCreateTradeRoute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinTransferAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SwapConfig == nil {
+				m.SwapConfig = &SwapConfig{}
+			}
+			if err := m.SwapConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10843,6 +11882,76 @@ func (m *MsgUpdateTradeRoute) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SwapConfig == nil {
+				m.SwapConfig = &SwapConfig{}
+			}
+			if err := m.SwapConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalLegSwapConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalLegSwapConfigs = append(m.AdditionalLegSwapConfigs, SwapConfig{})
+			if err := m.AdditionalLegSwapConfigs[len(m.AdditionalLegSwapConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11493,6 +12602,134 @@ func (m *MsgUpdateHostZoneParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedemptionRateChangePerEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxRedemptionRateChangePerEpoch = &v
+			if err := m.MaxRedemptionRateChangePerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedemptionRateChangePerDay", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxRedemptionRateChangePerDay = &v
+			if err := m.MaxRedemptionRateChangePerDay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochCadence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EpochCadence == nil {
+				m.EpochCadence = &EpochCadence{}
+			}
+			if err := m.EpochCadence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetEpochCadence", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ResetEpochCadence = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
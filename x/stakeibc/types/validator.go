@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+)
+
+// Returns the amount delegated to the validator from a given delegation shard
+// Only the additional shards are tracked explicitly - the main delegation ICA (shard 0)
+// holds the remainder of the validator's delegation
+func (v Validator) GetShardDelegation(shardIndex uint32) sdkmath.Int {
+	if shardIndex == 0 {
+		additionalShardsDelegation := sdkmath.ZeroInt()
+		for _, shardDelegation := range v.ShardDelegations {
+			additionalShardsDelegation = additionalShardsDelegation.Add(shardDelegation.Delegation)
+		}
+		return v.Delegation.Sub(additionalShardsDelegation)
+	}
+
+	for _, shardDelegation := range v.ShardDelegations {
+		if shardDelegation.ShardIndex == shardIndex {
+			return shardDelegation.Delegation
+		}
+	}
+	return sdkmath.ZeroInt()
+}
+
+// Updates the validator's delegation from a given delegation shard
+// The total delegation is updated as well
+func (v *Validator) AddShardDelegation(shardIndex uint32, amount sdkmath.Int) {
+	v.Delegation = v.Delegation.Add(amount)
+	if shardIndex == 0 {
+		return
+	}
+
+	for i, shardDelegation := range v.ShardDelegations {
+		if shardDelegation.ShardIndex == shardIndex {
+			v.ShardDelegations[i].Delegation = shardDelegation.Delegation.Add(amount)
+			return
+		}
+	}
+	v.ShardDelegations = append(v.ShardDelegations, ShardDelegation{
+		ShardIndex: shardIndex,
+		Delegation: amount,
+	})
+}
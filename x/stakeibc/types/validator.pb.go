@@ -25,6 +25,53 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// The portion of a validator's delegation that is held by an additional
+// delegation shard
+type ShardDelegation struct {
+	ShardIndex uint32                                 `protobuf:"varint,1,opt,name=shard_index,json=shardIndex,proto3" json:"shard_index,omitempty"`
+	Delegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=delegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegation"`
+}
+
+func (m *ShardDelegation) Reset()         { *m = ShardDelegation{} }
+func (m *ShardDelegation) String() string { return proto.CompactTextString(m) }
+func (*ShardDelegation) ProtoMessage()    {}
+func (*ShardDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d2f32e16bd6ab8f, []int{0}
+}
+func (m *ShardDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShardDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShardDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardDelegation.Merge(m, src)
+}
+func (m *ShardDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardDelegation proto.InternalMessageInfo
+
+func (m *ShardDelegation) GetShardIndex() uint32 {
+	if m != nil {
+		return m.ShardIndex
+	}
+	return 0
+}
+
 type Validator struct {
 	Name                        string                                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Address                     string                                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -35,13 +82,17 @@ type Validator struct {
 	SharesToTokensRate          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=shares_to_tokens_rate,json=sharesToTokensRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares_to_tokens_rate"`
 	DelegationChangesInProgress int64                                  `protobuf:"varint,11,opt,name=delegation_changes_in_progress,json=delegationChangesInProgress,proto3" json:"delegation_changes_in_progress,omitempty"`
 	SlashQueryInProgress        bool                                   `protobuf:"varint,13,opt,name=slash_query_in_progress,json=slashQueryInProgress,proto3" json:"slash_query_in_progress,omitempty"`
+	// Delegations from each additional delegation shard
+	// The delegation from the main delegation ICA (shard 0) is the remainder of
+	// the total delegation
+	ShardDelegations []ShardDelegation `protobuf:"bytes,14,rep,name=shard_delegations,json=shardDelegations,proto3" json:"shard_delegations"`
 }
 
 func (m *Validator) Reset()         { *m = Validator{} }
 func (m *Validator) String() string { return proto.CompactTextString(m) }
 func (*Validator) ProtoMessage()    {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5d2f32e16bd6ab8f, []int{0}
+	return fileDescriptor_5d2f32e16bd6ab8f, []int{1}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -105,44 +156,94 @@ func (m *Validator) GetSlashQueryInProgress() bool {
 	return false
 }
 
+func (m *Validator) GetShardDelegations() []ShardDelegation {
+	if m != nil {
+		return m.ShardDelegations
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterType((*ShardDelegation)(nil), "stride.stakeibc.ShardDelegation")
 	proto.RegisterType((*Validator)(nil), "stride.stakeibc.Validator")
 }
 
 func init() { proto.RegisterFile("stride/stakeibc/validator.proto", fileDescriptor_5d2f32e16bd6ab8f) }
 
 var fileDescriptor_5d2f32e16bd6ab8f = []byte{
-	// 475 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0x1b, 0x96, 0x76, 0xa9, 0x01, 0x51, 0x59, 0x65, 0x64, 0x03, 0xa5, 0x15, 0x07, 0xd4,
-	0x4b, 0x1b, 0xa9, 0x08, 0x71, 0xe1, 0xc2, 0xba, 0xcb, 0xaa, 0x09, 0x41, 0x56, 0x71, 0xe0, 0x12,
-	0xb9, 0xce, 0xa7, 0xc4, 0x4a, 0x6b, 0x17, 0xdb, 0x1b, 0xec, 0x2d, 0xb8, 0xf1, 0x22, 0x7b, 0x88,
-	0x1d, 0xa7, 0x9d, 0x10, 0x87, 0x09, 0xb5, 0x2f, 0x82, 0x62, 0xa7, 0x4b, 0xae, 0xdb, 0x29, 0xf6,
-	0xe7, 0xbf, 0x7f, 0xff, 0x7c, 0xff, 0xe4, 0x43, 0x3d, 0xa5, 0x25, 0x4b, 0x20, 0x54, 0x9a, 0xe4,
-	0xc0, 0xe6, 0x34, 0x3c, 0x27, 0x0b, 0x96, 0x10, 0x2d, 0xe4, 0x68, 0x25, 0x85, 0x16, 0xf8, 0x99,
-	0x15, 0x8c, 0xb6, 0x82, 0x83, 0x7d, 0x2a, 0xd4, 0x52, 0xa8, 0xd8, 0x1c, 0x87, 0x76, 0x63, 0xb5,
-	0x07, 0xdd, 0x54, 0xa4, 0xc2, 0xd6, 0x8b, 0x95, 0xad, 0xbe, 0xfe, 0xdd, 0x44, 0xed, 0xaf, 0x5b,
-	0x2a, 0xc6, 0xc8, 0xe5, 0x64, 0x09, 0xbe, 0xd3, 0x77, 0x06, 0xed, 0xc8, 0xac, 0xf1, 0x18, 0xed,
-	0x92, 0x24, 0x91, 0xa0, 0x94, 0xff, 0xa8, 0x28, 0x1f, 0xfa, 0x37, 0x97, 0xc3, 0x6e, 0x89, 0xfe,
-	0x68, 0x4f, 0x4e, 0xb5, 0x64, 0x3c, 0x8d, 0xb6, 0x42, 0xbc, 0x87, 0x5a, 0x3f, 0x80, 0xa5, 0x99,
-	0xf6, 0x5b, 0x7d, 0x67, 0xe0, 0x46, 0xe5, 0x0e, 0x7f, 0x42, 0x28, 0x81, 0x05, 0xa4, 0x44, 0x33,
-	0xc1, 0xfd, 0xa6, 0xc1, 0x8d, 0xae, 0x6e, 0x7b, 0x8d, 0xbf, 0xb7, 0xbd, 0x37, 0x29, 0xd3, 0xd9,
-	0xd9, 0x7c, 0x44, 0xc5, 0xb2, 0x7c, 0xf1, 0xf2, 0x31, 0x54, 0x49, 0x1e, 0xea, 0x8b, 0x15, 0xa8,
-	0xd1, 0x31, 0xd7, 0x51, 0x8d, 0x80, 0x05, 0x7a, 0xa5, 0x16, 0x44, 0x65, 0xf1, 0xf7, 0x33, 0x90,
-	0x17, 0x45, 0xd7, 0x69, 0xe1, 0x1f, 0x6b, 0x49, 0x68, 0x0e, 0xd2, 0x6f, 0x3f, 0xc8, 0x61, 0xdf,
-	0x30, 0xbf, 0x14, 0xc8, 0xcf, 0x25, 0x71, 0x66, 0x81, 0x38, 0x41, 0x7b, 0x75, 0x43, 0x9a, 0x01,
-	0xcd, 0x57, 0x82, 0x71, 0xed, 0x3f, 0x79, 0x90, 0x55, 0xb7, 0xb2, 0x9a, 0xdc, 0xb1, 0xb0, 0x40,
-	0xcf, 0x55, 0x46, 0x24, 0xa8, 0x58, 0x8b, 0x58, 0x8b, 0x1c, 0xb8, 0x8a, 0x25, 0xd1, 0xe0, 0x23,
-	0x63, 0xf2, 0xe1, 0x1e, 0x26, 0x47, 0x40, 0x6f, 0x2e, 0x87, 0xa8, 0xfc, 0x5c, 0x47, 0x40, 0x23,
-	0x6c, 0xd1, 0x33, 0x31, 0x33, 0xe0, 0x88, 0x68, 0xc0, 0x13, 0x14, 0x54, 0xa9, 0xc6, 0x34, 0x23,
-	0x3c, 0x05, 0x15, 0x33, 0x7e, 0x97, 0xa8, 0xff, 0xb8, 0xef, 0x0c, 0x76, 0xa2, 0x97, 0x95, 0x6a,
-	0x62, 0x45, 0xc7, 0x7c, 0x1b, 0x11, 0x7e, 0x87, 0x5e, 0xd4, 0xb3, 0xa9, 0xdf, 0x7e, 0xda, 0x77,
-	0x06, 0x5e, 0xbd, 0xd9, 0xea, 0xda, 0xd4, 0xf5, 0x76, 0x3a, 0xee, 0xd4, 0xf5, 0xdc, 0x4e, 0x73,
-	0xea, 0x7a, 0xbb, 0x1d, 0x6f, 0xea, 0x7a, 0x5e, 0xa7, 0x7d, 0x78, 0x72, 0xb5, 0x0e, 0x9c, 0xeb,
-	0x75, 0xe0, 0xfc, 0x5b, 0x07, 0xce, 0xaf, 0x4d, 0xd0, 0xb8, 0xde, 0x04, 0x8d, 0x3f, 0x9b, 0xa0,
-	0xf1, 0x6d, 0x5c, 0xeb, 0xfb, 0xd4, 0x0c, 0xc0, 0xf0, 0x84, 0xcc, 0x55, 0x58, 0x4e, 0xcb, 0xf9,
-	0xf8, 0x7d, 0xf8, 0xb3, 0x9a, 0x19, 0x93, 0xc3, 0xbc, 0x65, 0x7e, 0xf7, 0xb7, 0xff, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0xcd, 0x0d, 0x4d, 0xa0, 0x53, 0x03, 0x00, 0x00,
+	// 539 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0x6d, 0x68, 0xda, 0xa5, 0x2e, 0x63, 0xc5, 0x2a, 0x23, 0x1b, 0x28, 0x8d, 0x76, 0x40, 0xb9,
+	0x34, 0x91, 0x8a, 0x10, 0x17, 0x2e, 0xb4, 0xbd, 0xb4, 0x9a, 0x10, 0xa4, 0x15, 0x07, 0x2e, 0x91,
+	0x9b, 0x58, 0x49, 0xd4, 0xd6, 0x2e, 0xb6, 0x37, 0xb6, 0x2b, 0xbf, 0x80, 0x1f, 0xb3, 0x1f, 0xb1,
+	0xe3, 0xb4, 0x13, 0xe2, 0x30, 0xa1, 0xf6, 0xca, 0x8f, 0x40, 0xb1, 0xd3, 0x36, 0xf4, 0xc6, 0x76,
+	0x8a, 0xfd, 0xfc, 0xfc, 0x9e, 0xbf, 0xe7, 0x7c, 0x06, 0x2d, 0x2e, 0x58, 0x1a, 0x61, 0x8f, 0x0b,
+	0x34, 0xc5, 0xe9, 0x24, 0xf4, 0xce, 0xd1, 0x2c, 0x8d, 0x90, 0xa0, 0xcc, 0x5d, 0x30, 0x2a, 0x28,
+	0x3c, 0x50, 0x04, 0x77, 0x4d, 0x38, 0x3e, 0x0a, 0x29, 0x9f, 0x53, 0x1e, 0xc8, 0x65, 0x4f, 0x4d,
+	0x14, 0xf7, 0xb8, 0x19, 0xd3, 0x98, 0x2a, 0x3c, 0x1b, 0x29, 0xf4, 0xe4, 0xbb, 0x06, 0x0e, 0x46,
+	0x09, 0x62, 0x51, 0x1f, 0xcf, 0x70, 0x8c, 0x44, 0x4a, 0x09, 0x6c, 0x81, 0x3a, 0xcf, 0xa0, 0x20,
+	0x25, 0x11, 0xbe, 0x30, 0x35, 0x5b, 0x73, 0xf6, 0x7d, 0x20, 0xa1, 0x41, 0x86, 0xc0, 0x0f, 0x00,
+	0x44, 0x1b, 0xba, 0xf9, 0xc8, 0xd6, 0x9c, 0x5a, 0xd7, 0xbd, 0xbe, 0x6b, 0x95, 0x7e, 0xdd, 0xb5,
+	0x5e, 0xc5, 0xa9, 0x48, 0xce, 0x26, 0x6e, 0x48, 0xe7, 0xb9, 0x7f, 0xfe, 0x69, 0xf3, 0x68, 0xea,
+	0x89, 0xcb, 0x05, 0xe6, 0xee, 0x80, 0x08, 0xbf, 0xa0, 0x70, 0xf2, 0xa7, 0x02, 0x6a, 0x9f, 0xd7,
+	0xa5, 0x41, 0x08, 0x74, 0x82, 0xe6, 0x58, 0xfa, 0xd6, 0x7c, 0x39, 0x86, 0x1d, 0xb0, 0x87, 0xa2,
+	0x88, 0x61, 0xce, 0x73, 0x3b, 0xf3, 0xf6, 0xaa, 0xdd, 0xcc, 0xeb, 0x7b, 0xaf, 0x56, 0x46, 0x82,
+	0xa5, 0x24, 0xf6, 0xd7, 0x44, 0x78, 0x08, 0xaa, 0xdf, 0x70, 0x1a, 0x27, 0xc2, 0xac, 0xda, 0x9a,
+	0xa3, 0xfb, 0xf9, 0x6c, 0xe7, 0xf4, 0x95, 0x87, 0x9e, 0x1e, 0x52, 0xf0, 0x92, 0xcf, 0x10, 0x4f,
+	0x82, 0xaf, 0x67, 0x98, 0x5d, 0x66, 0xd1, 0xc7, 0x99, 0x7f, 0x20, 0x18, 0x0a, 0xa7, 0x98, 0x99,
+	0xb5, 0x7b, 0x39, 0x1c, 0x49, 0xcd, 0x4f, 0x99, 0xe4, 0xc7, 0x5c, 0x71, 0xac, 0x04, 0x61, 0x04,
+	0x0e, 0x8b, 0x86, 0x61, 0x82, 0xc3, 0xe9, 0x82, 0xa6, 0x44, 0x98, 0x8f, 0xef, 0x65, 0xd5, 0xdc,
+	0x5a, 0xf5, 0x36, 0x5a, 0x90, 0x82, 0x67, 0xd9, 0x95, 0x63, 0x1e, 0x08, 0x1a, 0x08, 0x3a, 0xc5,
+	0x84, 0x07, 0x0c, 0x09, 0x6c, 0x02, 0x69, 0xf2, 0xee, 0x3f, 0x4c, 0xfa, 0x38, 0xbc, 0xbd, 0x6a,
+	0x83, 0xfc, 0xba, 0xfa, 0x38, 0xf4, 0xa1, 0x92, 0x1e, 0xd3, 0xb1, 0x14, 0xf6, 0x91, 0xc0, 0xb0,
+	0x07, 0xac, 0x6d, 0xaa, 0x41, 0x98, 0x20, 0x12, 0x63, 0x1e, 0xa4, 0x64, 0x93, 0xa8, 0x59, 0xb7,
+	0x35, 0xa7, 0xec, 0xbf, 0xd8, 0xb2, 0x7a, 0x8a, 0x34, 0x20, 0xeb, 0x88, 0xe0, 0x1b, 0xf0, 0xbc,
+	0x98, 0x4d, 0x71, 0xf7, 0xbe, 0xad, 0x39, 0x46, 0xb1, 0xd8, 0xc2, 0xb6, 0x11, 0x78, 0xaa, 0x7e,
+	0xf9, 0xad, 0x36, 0x37, 0x9f, 0xd8, 0x65, 0xa7, 0xde, 0xb1, 0xdd, 0x9d, 0x26, 0x73, 0x77, 0xfa,
+	0xa5, 0xab, 0x67, 0x51, 0xf8, 0x0d, 0xfe, 0x2f, 0xcc, 0x87, 0xba, 0x51, 0x6e, 0xe8, 0x43, 0xdd,
+	0xd0, 0x1b, 0x95, 0xa1, 0x6e, 0xec, 0x35, 0x8c, 0xa1, 0x6e, 0x18, 0x8d, 0x5a, 0xf7, 0xf4, 0x7a,
+	0x69, 0x69, 0x37, 0x4b, 0x4b, 0xfb, 0xbd, 0xb4, 0xb4, 0x1f, 0x2b, 0xab, 0x74, 0xb3, 0xb2, 0x4a,
+	0x3f, 0x57, 0x56, 0xe9, 0x4b, 0xa7, 0x10, 0xe6, 0x48, 0xba, 0xb6, 0x4f, 0xd1, 0x84, 0x7b, 0xf9,
+	0x3b, 0x70, 0xde, 0x79, 0xeb, 0x5d, 0x6c, 0x5f, 0x03, 0x19, 0xee, 0xa4, 0x2a, 0x1b, 0xf9, 0xf5,
+	0xdf, 0x01, 0x00, 0x19, 0x75, 0x05, 0x69, 0x2d, 0x04, 0x00, 0x00,
+}
+
+func (m *ShardDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Delegation.Size()
+		i -= size
+		if _, err := m.Delegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintValidator(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ShardIndex != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.ShardIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *Validator) Marshal() (dAtA []byte, err error) {
@@ -165,6 +266,20 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ShardDelegations) > 0 {
+		for iNdEx := len(m.ShardDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShardDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintValidator(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
 	if m.SlashQueryInProgress {
 		i--
 		if m.SlashQueryInProgress {
@@ -253,6 +368,20 @@ func encodeVarintValidator(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *ShardDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardIndex != 0 {
+		n += 1 + sovValidator(uint64(m.ShardIndex))
+	}
+	l = m.Delegation.Size()
+	n += 1 + l + sovValidator(uint64(l))
+	return n
+}
+
 func (m *Validator) Size() (n int) {
 	if m == nil {
 		return 0
@@ -284,6 +413,12 @@ func (m *Validator) Size() (n int) {
 	if m.SlashQueryInProgress {
 		n += 2
 	}
+	if len(m.ShardDelegations) > 0 {
+		for _, e := range m.ShardDelegations {
+			l = e.Size()
+			n += 1 + l + sovValidator(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -293,6 +428,109 @@ func sovValidator(x uint64) (n int) {
 func sozValidator(x uint64) (n int) {
 	return sovValidator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *ShardDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardIndex", wireType)
+			}
+			m.ShardIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Validator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -580,6 +818,40 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.SlashQueryInProgress = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShardDelegations = append(m.ShardDelegations, ShardDelegation{})
+			if err := m.ShardDelegations[len(m.ShardDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipValidator(dAtA[iNdEx:])
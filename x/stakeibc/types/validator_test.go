@@ -0,0 +1,49 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v27/x/stakeibc/types"
+)
+
+func TestGetShardDelegation(t *testing.T) {
+	validator := types.Validator{
+		Delegation: sdkmath.NewInt(100),
+		ShardDelegations: []types.ShardDelegation{
+			{ShardIndex: 1, Delegation: sdkmath.NewInt(30)},
+			{ShardIndex: 2, Delegation: sdkmath.NewInt(20)},
+		},
+	}
+
+	require.Equal(t, sdkmath.NewInt(50), validator.GetShardDelegation(0), "shard 0 delegation")
+	require.Equal(t, sdkmath.NewInt(30), validator.GetShardDelegation(1), "shard 1 delegation")
+	require.Equal(t, sdkmath.NewInt(20), validator.GetShardDelegation(2), "shard 2 delegation")
+	require.Equal(t, sdkmath.ZeroInt(), validator.GetShardDelegation(3), "shard 3 delegation")
+}
+
+func TestAddShardDelegation(t *testing.T) {
+	validator := types.Validator{
+		Delegation: sdkmath.NewInt(100),
+	}
+
+	// Adding to shard 0 should only update the total
+	validator.AddShardDelegation(0, sdkmath.NewInt(10))
+	require.Equal(t, sdkmath.NewInt(110), validator.Delegation, "total delegation after shard 0 add")
+	require.Empty(t, validator.ShardDelegations, "no shard delegations after shard 0 add")
+
+	// Adding to a new shard should create an entry
+	validator.AddShardDelegation(1, sdkmath.NewInt(40))
+	require.Equal(t, sdkmath.NewInt(150), validator.Delegation, "total delegation after shard 1 add")
+	require.Equal(t, sdkmath.NewInt(40), validator.GetShardDelegation(1), "shard 1 delegation after add")
+	require.Equal(t, sdkmath.NewInt(110), validator.GetShardDelegation(0), "shard 0 delegation after shard 1 add")
+
+	// Removing from an existing shard should update the entry
+	validator.AddShardDelegation(1, sdkmath.NewInt(-15))
+	require.Equal(t, sdkmath.NewInt(135), validator.Delegation, "total delegation after shard 1 remove")
+	require.Equal(t, sdkmath.NewInt(25), validator.GetShardDelegation(1), "shard 1 delegation after remove")
+	require.Equal(t, sdkmath.NewInt(110), validator.GetShardDelegation(0), "shard 0 delegation after shard 1 remove")
+	require.Len(t, validator.ShardDelegations, 1, "number of shard delegations")
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 
 	"github.com/Stride-Labs/stride/v27/x/strdburner/types"
 )
@@ -24,6 +25,8 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		CmdQueryStrdBurnerAddress(),
 		CmdQueryStrdBurnerTotalBurned(),
+		CmdQueryBurnsByAddress(),
+		CmdQueryBurnHistory(),
 	)
 
 	return cmd
@@ -72,3 +75,60 @@ func CmdQueryStrdBurnerTotalBurned() *cobra.Command {
 	}
 	return cmd
 }
+
+func CmdQueryBurnsByAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burns-by-address [address]",
+		Short: "Query the total amount of STRD burned by an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBurnsByAddressRequest{
+				Address: args[0],
+			}
+			res, err := queryClient.BurnsByAddress(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	return cmd
+}
+
+func CmdQueryBurnHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn-history",
+		Short: "Query the amount of STRD burned each day",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryBurnHistoryRequest{
+				Pagination: pageReq,
+			}
+			res, err := queryClient.BurnHistory(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "burn-history")
+
+	return cmd
+}
@@ -10,7 +10,7 @@ func (k Keeper) EndBlocker(ctx sdk.Context) {
 	strdBurnerAddress := k.GetStrdBurnerAddress()
 
 	// Get STRD balance
-	strdBalance := k.bankKeeper.GetBalance(ctx, strdBurnerAddress, "ustrd")
+	strdBalance := k.bankKeeper.GetBalance(ctx, strdBurnerAddress, types.StrdDenom)
 
 	// Exit early if nothing to burn
 	if strdBalance.IsZero() {
@@ -24,16 +24,7 @@ func (k Keeper) EndBlocker(ctx sdk.Context) {
 		return
 	}
 
-	// Update TotalStrdBurned
-	currentTotalBurned := k.GetTotalStrdBurned(ctx)
-	newTotalBurned := currentTotalBurned.Add(strdBalance.Amount)
-	k.SetTotalStrdBurned(ctx, newTotalBurned)
-
-	// Emit burn event
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeBurn,
-			sdk.NewAttribute(types.AttributeAmount, strdBalance.String()),
-		),
-	)
+	// Update the burn totals and emit the burn event
+	// Since the tokens were sent directly to the module account, the burn is unattributed
+	k.RecordBurn(ctx, "", strdBalance.Amount, types.BURN_SOURCE_UNATTRIBUTED)
 }
@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/v27/x/strdburner/types"
+)
+
+// Burns STRD from the given account (or module account), attributing the burn
+// to that address and the given source
+func (k Keeper) Burn(ctx sdk.Context, burner sdk.AccAddress, amount math.Int, source types.BurnSource) error {
+	if amount.IsNil() || !amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "burn amount must be positive, got %v", amount)
+	}
+
+	burnCoins := sdk.NewCoins(sdk.NewCoin(types.StrdDenom, amount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, burner, types.ModuleName, burnCoins); err != nil {
+		return errorsmod.Wrapf(err, "unable to transfer %v from %s to the strdburner", burnCoins, burner)
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnCoins); err != nil {
+		return errorsmod.Wrapf(err, "unable to burn %v", burnCoins)
+	}
+
+	k.RecordBurn(ctx, burner.String(), amount, source)
+
+	return nil
+}
+
+// Updates each of the burn totals after STRD has been burned, and emits a burn event
+// If the burn is not attributed to an address (e.g. tokens sent directly to the module account),
+// the burner should be left empty
+func (k Keeper) RecordBurn(ctx sdk.Context, burner string, amount math.Int, source types.BurnSource) {
+	k.SetTotalStrdBurned(ctx, k.GetTotalStrdBurned(ctx).Add(amount))
+	k.SetSourceBurned(ctx, source, k.GetSourceBurned(ctx, source).Add(amount))
+
+	day := types.GetBurnDay(ctx.BlockTime())
+	k.SetDailyBurned(ctx, day, k.GetDailyBurned(ctx, day).Add(amount))
+
+	if burner != "" {
+		k.SetAddressBurned(ctx, burner, k.GetAddressBurned(ctx, burner).Add(amount))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurn,
+			sdk.NewAttribute(types.AttributeAmount, sdk.NewCoin(types.StrdDenom, amount).String()),
+			sdk.NewAttribute(types.AttributeBurner, burner),
+			sdk.NewAttribute(types.AttributeSource, source.String()),
+		),
+	)
+}
+
+// Stores the total amount of STRD burned by an address
+func (k Keeper) SetAddressBurned(ctx sdk.Context, address string, amount math.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressBurnsPrefix)
+	store.Set([]byte(address), sdk.Uint64ToBigEndian(amount.Uint64()))
+}
+
+// Returns the total amount of STRD burned by an address
+func (k Keeper) GetAddressBurned(ctx sdk.Context, address string) math.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressBurnsPrefix)
+	bz := store.Get([]byte(address))
+	if bz == nil {
+		return math.ZeroInt()
+	}
+	return math.NewIntFromUint64(sdk.BigEndianToUint64(bz))
+}
+
+// Returns the burn totals for all addresses
+func (k Keeper) GetAllAddressBurns(ctx sdk.Context) []types.AddressBurn {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressBurnsPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	addressBurns := []types.AddressBurn{}
+	for ; iterator.Valid(); iterator.Next() {
+		addressBurns = append(addressBurns, types.AddressBurn{
+			Address:     string(iterator.Key()),
+			TotalBurned: math.NewIntFromUint64(sdk.BigEndianToUint64(iterator.Value())),
+		})
+	}
+	return addressBurns
+}
+
+// Stores the total amount of STRD burned from a source
+func (k Keeper) SetSourceBurned(ctx sdk.Context, source types.BurnSource, amount math.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SourceBurnsPrefix)
+	store.Set(types.SourceBurnKey(source), sdk.Uint64ToBigEndian(amount.Uint64()))
+}
+
+// Returns the total amount of STRD burned from a source
+func (k Keeper) GetSourceBurned(ctx sdk.Context, source types.BurnSource) math.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SourceBurnsPrefix)
+	bz := store.Get(types.SourceBurnKey(source))
+	if bz == nil {
+		return math.ZeroInt()
+	}
+	return math.NewIntFromUint64(sdk.BigEndianToUint64(bz))
+}
+
+// Returns the burn totals for all sources that have had burns
+func (k Keeper) GetAllSourceBurns(ctx sdk.Context) []types.SourceBurn {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SourceBurnsPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	sourceBurns := []types.SourceBurn{}
+	for ; iterator.Valid(); iterator.Next() {
+		sourceBurns = append(sourceBurns, types.SourceBurn{
+			Source:      types.BurnSource(sdk.BigEndianToUint64(iterator.Key())),
+			TotalBurned: math.NewIntFromUint64(sdk.BigEndianToUint64(iterator.Value())),
+		})
+	}
+	return sourceBurns
+}
+
+// Stores the amount of STRD burned on a given day
+func (k Keeper) SetDailyBurned(ctx sdk.Context, day int64, amount math.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DailyBurnsPrefix)
+	store.Set(types.DailyBurnKey(day), sdk.Uint64ToBigEndian(amount.Uint64()))
+}
+
+// Returns the amount of STRD burned on a given day
+func (k Keeper) GetDailyBurned(ctx sdk.Context, day int64) math.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DailyBurnsPrefix)
+	bz := store.Get(types.DailyBurnKey(day))
+	if bz == nil {
+		return math.ZeroInt()
+	}
+	return math.NewIntFromUint64(sdk.BigEndianToUint64(bz))
+}
+
+// Returns the amount burned each day, in chronological order
+func (k Keeper) GetAllDailyBurns(ctx sdk.Context) []types.DailyBurn {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DailyBurnsPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	dailyBurns := []types.DailyBurn{}
+	for ; iterator.Valid(); iterator.Next() {
+		dailyBurns = append(dailyBurns, types.NewDailyBurn(iterator.Key(), iterator.Value()))
+	}
+	return dailyBurns
+}
@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v27/x/strdburner/types"
+)
+
+func (s *KeeperTestSuite) TestBurn() {
+	burner := s.TestAccs[0]
+	initialBalance := sdkmath.NewInt(1000)
+	s.FundAccount(burner, sdk.NewCoin(types.StrdDenom, initialBalance))
+
+	initialSupply := s.App.BankKeeper.GetSupply(s.Ctx, types.StrdDenom).Amount
+
+	// Burn part of the balance
+	burnAmount := sdkmath.NewInt(400)
+	err := s.App.StrdBurnerKeeper.Burn(s.Ctx, burner, burnAmount, types.BURN_SOURCE_USER)
+	s.Require().NoError(err, "no error expected when burning")
+
+	// Confirm the tokens were burned
+	s.Require().Equal(initialBalance.Sub(burnAmount), s.App.BankKeeper.GetBalance(s.Ctx, burner, types.StrdDenom).Amount, "burner balance")
+	s.Require().Equal(initialSupply.Sub(burnAmount), s.App.BankKeeper.GetSupply(s.Ctx, types.StrdDenom).Amount, "supply")
+	s.Require().Zero(s.App.BankKeeper.GetBalance(s.Ctx, s.App.StrdBurnerKeeper.GetStrdBurnerAddress(), types.StrdDenom).Amount.Int64(),
+		"module balance")
+
+	// Confirm each of the totals were updated
+	s.Require().Equal(burnAmount, s.App.StrdBurnerKeeper.GetTotalStrdBurned(s.Ctx), "total burned")
+	s.Require().Equal(burnAmount, s.App.StrdBurnerKeeper.GetAddressBurned(s.Ctx, burner.String()), "address burned")
+	s.Require().Equal(burnAmount, s.App.StrdBurnerKeeper.GetSourceBurned(s.Ctx, types.BURN_SOURCE_USER), "user source burned")
+	s.Require().Equal(burnAmount, s.App.StrdBurnerKeeper.GetDailyBurned(s.Ctx, types.GetBurnDay(s.Ctx.BlockTime())), "daily burned")
+
+	// Attempt to burn more than the remaining balance
+	err = s.App.StrdBurnerKeeper.Burn(s.Ctx, burner, sdkmath.NewInt(1000), types.BURN_SOURCE_USER)
+	s.Require().ErrorContains(err, "unable to transfer")
+
+	// Attempt to burn a zero amount
+	err = s.App.StrdBurnerKeeper.Burn(s.Ctx, burner, sdkmath.ZeroInt(), types.BURN_SOURCE_USER)
+	s.Require().ErrorContains(err, "burn amount must be positive")
+}
+
+func (s *KeeperTestSuite) TestRecordBurn() {
+	burnerA := s.TestAccs[0].String()
+	burnerB := s.TestAccs[1].String()
+
+	dayOneTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	dayTwoTime := time.Date(2024, 1, 2, 1, 0, 0, 0, time.UTC)
+
+	// Record burns across multiple addresses, sources and days
+	s.Ctx = s.Ctx.WithBlockTime(dayOneTime)
+	s.App.StrdBurnerKeeper.RecordBurn(s.Ctx, burnerA, sdkmath.NewInt(10), types.BURN_SOURCE_USER)
+	s.App.StrdBurnerKeeper.RecordBurn(s.Ctx, burnerA, sdkmath.NewInt(20), types.BURN_SOURCE_AUCTION)
+	s.App.StrdBurnerKeeper.RecordBurn(s.Ctx, "", sdkmath.NewInt(30), types.BURN_SOURCE_UNATTRIBUTED)
+
+	s.Ctx = s.Ctx.WithBlockTime(dayTwoTime)
+	s.App.StrdBurnerKeeper.RecordBurn(s.Ctx, burnerB, sdkmath.NewInt(40), types.BURN_SOURCE_MINT_STREAM)
+	s.App.StrdBurnerKeeper.RecordBurn(s.Ctx, burnerA, sdkmath.NewInt(50), types.BURN_SOURCE_USER)
+
+	// Check the total
+	s.Require().Equal(sdkmath.NewInt(150), s.App.StrdBurnerKeeper.GetTotalStrdBurned(s.Ctx), "total burned")
+
+	// Check the per-address totals (unattributed burns should not be recorded)
+	expectedAddressBurns := []types.AddressBurn{
+		{Address: burnerA, TotalBurned: sdkmath.NewInt(80)},
+		{Address: burnerB, TotalBurned: sdkmath.NewInt(40)},
+	}
+	s.Require().ElementsMatch(expectedAddressBurns, s.App.StrdBurnerKeeper.GetAllAddressBurns(s.Ctx), "address burns")
+
+	// Check the per-source totals
+	expectedSourceBurns := []types.SourceBurn{
+		{Source: types.BURN_SOURCE_UNATTRIBUTED, TotalBurned: sdkmath.NewInt(30)},
+		{Source: types.BURN_SOURCE_USER, TotalBurned: sdkmath.NewInt(60)},
+		{Source: types.BURN_SOURCE_AUCTION, TotalBurned: sdkmath.NewInt(20)},
+		{Source: types.BURN_SOURCE_MINT_STREAM, TotalBurned: sdkmath.NewInt(40)},
+	}
+	s.Require().Equal(expectedSourceBurns, s.App.StrdBurnerKeeper.GetAllSourceBurns(s.Ctx), "source burns")
+
+	// Check the daily time series
+	expectedDailyBurns := []types.DailyBurn{
+		{Date: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), TotalBurned: sdkmath.NewInt(60)},
+		{Date: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), TotalBurned: sdkmath.NewInt(90)},
+	}
+	s.Require().Equal(expectedDailyBurns, s.App.StrdBurnerKeeper.GetAllDailyBurns(s.Ctx), "daily burns")
+
+	// Check the burn events
+	s.CheckEventValueEmitted(types.EventTypeBurn, types.AttributeBurner, burnerB)
+	s.CheckEventValueEmitted(types.EventTypeBurn, types.AttributeSource, types.BURN_SOURCE_MINT_STREAM.String())
+}
@@ -13,11 +13,25 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 
 	// Set Total STRD Burned
 	k.SetTotalStrdBurned(ctx, genState.TotalUstrdBurned)
+
+	// Set the burn breakdowns
+	for _, addressBurn := range genState.AddressBurns {
+		k.SetAddressBurned(ctx, addressBurn.Address, addressBurn.TotalBurned)
+	}
+	for _, sourceBurn := range genState.SourceBurns {
+		k.SetSourceBurned(ctx, sourceBurn.Source, sourceBurn.TotalBurned)
+	}
+	for _, dailyBurn := range genState.DailyBurns {
+		k.SetDailyBurned(ctx, types.GetBurnDay(dailyBurn.Date), dailyBurn.TotalBurned)
+	}
 }
 
 // Export's module state into genesis file
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.TotalUstrdBurned = k.GetTotalStrdBurned(ctx)
+	genesis.AddressBurns = k.GetAllAddressBurns(ctx)
+	genesis.SourceBurns = k.GetAllSourceBurns(ctx)
+	genesis.DailyBurns = k.GetAllDailyBurns(ctx)
 	return genesis
 }
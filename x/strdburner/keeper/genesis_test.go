@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/Stride-Labs/stride/v27/x/strdburner/types"
+)
+
+func (s *KeeperTestSuite) TestGenesis() {
+	genesisState := types.GenesisState{
+		TotalUstrdBurned: sdkmath.NewInt(600),
+		AddressBurns: []types.AddressBurn{
+			{Address: s.TestAccs[0].String(), TotalBurned: sdkmath.NewInt(100)},
+			{Address: s.TestAccs[1].String(), TotalBurned: sdkmath.NewInt(200)},
+		},
+		SourceBurns: []types.SourceBurn{
+			{Source: types.BURN_SOURCE_UNATTRIBUTED, TotalBurned: sdkmath.NewInt(300)},
+			{Source: types.BURN_SOURCE_USER, TotalBurned: sdkmath.NewInt(300)},
+		},
+		DailyBurns: []types.DailyBurn{
+			{Date: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), TotalBurned: sdkmath.NewInt(400)},
+			{Date: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), TotalBurned: sdkmath.NewInt(200)},
+		},
+	}
+	s.Require().NoError(genesisState.Validate(), "no error expected when validating genesis")
+
+	s.App.StrdBurnerKeeper.InitGenesis(s.Ctx, genesisState)
+	exported := s.App.StrdBurnerKeeper.ExportGenesis(s.Ctx)
+
+	s.Require().Equal(genesisState.TotalUstrdBurned, exported.TotalUstrdBurned, "total burned")
+	s.Require().ElementsMatch(genesisState.AddressBurns, exported.AddressBurns, "address burns")
+	s.Require().Equal(genesisState.SourceBurns, exported.SourceBurns, "source burns")
+	s.Require().Equal(genesisState.DailyBurns, exported.DailyBurns, "daily burns")
+}
+
+func (s *KeeperTestSuite) TestValidateGenesis() {
+	validAddress := s.TestAccs[0].String()
+
+	testCases := []struct {
+		name          string
+		genesisState  types.GenesisState
+		expectedError string
+	}{
+		{
+			name:         "default genesis",
+			genesisState: *types.DefaultGenesis(),
+		},
+		{
+			name: "invalid address",
+			genesisState: types.GenesisState{
+				TotalUstrdBurned: sdkmath.ZeroInt(),
+				AddressBurns:     []types.AddressBurn{{Address: "invalid", TotalBurned: sdkmath.NewInt(1)}},
+			},
+			expectedError: "invalid burner address",
+		},
+		{
+			name: "duplicate address",
+			genesisState: types.GenesisState{
+				TotalUstrdBurned: sdkmath.ZeroInt(),
+				AddressBurns: []types.AddressBurn{
+					{Address: validAddress, TotalBurned: sdkmath.NewInt(1)},
+					{Address: validAddress, TotalBurned: sdkmath.NewInt(2)},
+				},
+			},
+			expectedError: "duplicate burner address",
+		},
+		{
+			name: "invalid source",
+			genesisState: types.GenesisState{
+				TotalUstrdBurned: sdkmath.ZeroInt(),
+				SourceBurns:      []types.SourceBurn{{Source: types.BurnSource(99), TotalBurned: sdkmath.NewInt(1)}},
+			},
+			expectedError: "invalid burn source",
+		},
+		{
+			name: "duplicate day",
+			genesisState: types.GenesisState{
+				TotalUstrdBurned: sdkmath.ZeroInt(),
+				DailyBurns: []types.DailyBurn{
+					{Date: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), TotalBurned: sdkmath.NewInt(1)},
+					{Date: time.Date(2024, 1, 1, 6, 0, 0, 0, time.UTC), TotalBurned: sdkmath.NewInt(2)},
+				},
+			},
+			expectedError: "duplicate daily burn",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			err := tc.genesisState.Validate()
+			if tc.expectedError == "" {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorContains(err, tc.expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v27/x/strdburner/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// Burns STRD from the signer's account, attributing the burn to the signer
+func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	burner, err := sdk.AccAddressFromBech32(msg.Burner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.Burn(ctx, burner, msg.Amount, types.BURN_SOURCE_USER); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnResponse{}, nil
+}
@@ -0,0 +1,28 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v27/x/strdburner/keeper"
+	"github.com/Stride-Labs/stride/v27/x/strdburner/types"
+)
+
+func (s *KeeperTestSuite) TestMsgBurn() {
+	msgServer := keeper.NewMsgServerImpl(s.App.StrdBurnerKeeper)
+
+	burner := s.TestAccs[0]
+	s.FundAccount(burner, sdk.NewCoin(types.StrdDenom, sdkmath.NewInt(1000)))
+
+	// Burn from the user's account
+	_, err := msgServer.Burn(s.Ctx, types.NewMsgBurn(burner.String(), sdkmath.NewInt(600)))
+	s.Require().NoError(err, "no error expected when burning")
+
+	s.Require().Equal(sdkmath.NewInt(400), s.App.BankKeeper.GetBalance(s.Ctx, burner, types.StrdDenom).Amount, "burner balance")
+	s.Require().Equal(sdkmath.NewInt(600), s.App.StrdBurnerKeeper.GetAddressBurned(s.Ctx, burner.String()), "address burned")
+	s.Require().Equal(sdkmath.NewInt(600), s.App.StrdBurnerKeeper.GetSourceBurned(s.Ctx, types.BURN_SOURCE_USER), "user source burned")
+
+	// Attempt to burn more than the account's balance
+	_, err = msgServer.Burn(s.Ctx, types.NewMsgBurn(burner.String(), sdkmath.NewInt(600)))
+	s.Require().ErrorContains(err, "insufficient funds")
+}
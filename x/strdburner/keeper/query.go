@@ -3,7 +3,11 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/Stride-Labs/stride/v27/x/strdburner/types"
 )
@@ -18,7 +22,47 @@ func (k Keeper) StrdBurnerAddress(goCtx context.Context, req *types.QueryStrdBur
 }
 
 func (k Keeper) TotalStrdBurned(goCtx context.Context, req *types.QueryTotalStrdBurnedRequest) (*types.QueryTotalStrdBurnedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
 	return &types.QueryTotalStrdBurnedResponse{
-		TotalBurned: k.GetTotalStrdBurned(sdk.UnwrapSDKContext(goCtx)),
+		TotalBurned: k.GetTotalStrdBurned(ctx),
+		SourceBurns: k.GetAllSourceBurns(ctx),
+	}, nil
+}
+
+// Queries the total amount of STRD burned by an address
+func (k Keeper) BurnsByAddress(goCtx context.Context, req *types.QueryBurnsByAddressRequest) (*types.QueryBurnsByAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	return &types.QueryBurnsByAddressResponse{
+		TotalBurned: k.GetAddressBurned(sdk.UnwrapSDKContext(goCtx), req.Address),
+	}, nil
+}
+
+// Queries the amount of STRD burned each day, in chronological order
+func (k Keeper) BurnHistory(goCtx context.Context, req *types.QueryBurnHistoryRequest) (*types.QueryBurnHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DailyBurnsPrefix)
+
+	dailyBurns := []types.DailyBurn{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		dailyBurns = append(dailyBurns, types.NewDailyBurn(key, value))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBurnHistoryResponse{
+		DailyBurns: dailyBurns,
+		Pagination: pageRes,
 	}, nil
 }
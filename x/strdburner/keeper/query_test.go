@@ -1,8 +1,11 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/Stride-Labs/stride/v27/x/strdburner/types"
 )
@@ -26,3 +29,65 @@ func (s *KeeperTestSuite) TestQueryTotalStrdBurned() {
 	s.Require().NoError(err, "no error expected when querying total strd burned")
 	s.Require().Equal(expectedAmount, resp.TotalBurned, "total burned amount")
 }
+
+func (s *KeeperTestSuite) TestQueryTotalStrdBurned_SourceBreakdown() {
+	s.App.StrdBurnerKeeper.RecordBurn(s.Ctx, "", sdkmath.NewInt(100), types.BURN_SOURCE_UNATTRIBUTED)
+	s.App.StrdBurnerKeeper.RecordBurn(s.Ctx, s.TestAccs[0].String(), sdkmath.NewInt(200), types.BURN_SOURCE_AUCTION)
+
+	resp, err := s.App.StrdBurnerKeeper.TotalStrdBurned(sdk.WrapSDKContext(s.Ctx), &types.QueryTotalStrdBurnedRequest{})
+	s.Require().NoError(err, "no error expected when querying total strd burned")
+	s.Require().Equal(sdkmath.NewInt(300), resp.TotalBurned, "total burned amount")
+	s.Require().Equal([]types.SourceBurn{
+		{Source: types.BURN_SOURCE_UNATTRIBUTED, TotalBurned: sdkmath.NewInt(100)},
+		{Source: types.BURN_SOURCE_AUCTION, TotalBurned: sdkmath.NewInt(200)},
+	}, resp.SourceBurns, "source burns")
+}
+
+func (s *KeeperTestSuite) TestQueryBurnsByAddress() {
+	burner := s.TestAccs[0].String()
+	s.App.StrdBurnerKeeper.RecordBurn(s.Ctx, burner, sdkmath.NewInt(100), types.BURN_SOURCE_USER)
+	s.App.StrdBurnerKeeper.RecordBurn(s.Ctx, burner, sdkmath.NewInt(200), types.BURN_SOURCE_AUCTION)
+
+	// Address with burns
+	req := &types.QueryBurnsByAddressRequest{Address: burner}
+	resp, err := s.App.StrdBurnerKeeper.BurnsByAddress(sdk.WrapSDKContext(s.Ctx), req)
+	s.Require().NoError(err, "no error expected when querying burns by address")
+	s.Require().Equal(sdkmath.NewInt(300), resp.TotalBurned, "total burned by address")
+
+	// Address without burns
+	req = &types.QueryBurnsByAddressRequest{Address: s.TestAccs[1].String()}
+	resp, err = s.App.StrdBurnerKeeper.BurnsByAddress(sdk.WrapSDKContext(s.Ctx), req)
+	s.Require().NoError(err, "no error expected when querying address without burns")
+	s.Require().Zero(resp.TotalBurned.Int64(), "total burned by address without burns")
+
+	// Invalid address
+	req = &types.QueryBurnsByAddressRequest{Address: "invalid_address"}
+	_, err = s.App.StrdBurnerKeeper.BurnsByAddress(sdk.WrapSDKContext(s.Ctx), req)
+	s.Require().ErrorContains(err, "invalid address")
+}
+
+func (s *KeeperTestSuite) TestQueryBurnHistory() {
+	// Record a burn on each of three consecutive days
+	startTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		ctx := s.Ctx.WithBlockTime(startTime.Add(time.Duration(i) * 24 * time.Hour))
+		s.App.StrdBurnerKeeper.RecordBurn(ctx, "", sdkmath.NewInt(int64(i+1)*100), types.BURN_SOURCE_UNATTRIBUTED)
+	}
+
+	// Query the first page
+	req := &types.QueryBurnHistoryRequest{Pagination: &query.PageRequest{Limit: 2}}
+	resp, err := s.App.StrdBurnerKeeper.BurnHistory(sdk.WrapSDKContext(s.Ctx), req)
+	s.Require().NoError(err, "no error expected when querying burn history")
+	s.Require().Equal([]types.DailyBurn{
+		{Date: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), TotalBurned: sdkmath.NewInt(100)},
+		{Date: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), TotalBurned: sdkmath.NewInt(200)},
+	}, resp.DailyBurns, "first page")
+
+	// Query the next page
+	req = &types.QueryBurnHistoryRequest{Pagination: &query.PageRequest{Key: resp.Pagination.NextKey}}
+	resp, err = s.App.StrdBurnerKeeper.BurnHistory(sdk.WrapSDKContext(s.Ctx), req)
+	s.Require().NoError(err, "no error expected when querying second page of burn history")
+	s.Require().Equal([]types.DailyBurn{
+		{Date: time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC), TotalBurned: sdkmath.NewInt(300)},
+	}, resp.DailyBurns, "second page")
+}
@@ -5,4 +5,6 @@ const (
 	EventTypeBurn = "burn"
 
 	AttributeAmount = "amount"
+	AttributeBurner = "burner"
+	AttributeSource = "source"
 )
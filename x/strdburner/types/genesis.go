@@ -4,12 +4,16 @@ import (
 	"fmt"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		TotalUstrdBurned: math.ZeroInt(),
+		AddressBurns:     []AddressBurn{},
+		SourceBurns:      []SourceBurn{},
+		DailyBurns:       []DailyBurn{},
 	}
 }
 
@@ -17,7 +21,47 @@ func DefaultGenesis() *GenesisState {
 func (gs GenesisState) Validate() error {
 	if gs.TotalUstrdBurned.IsNil() {
 		return fmt.Errorf("GenesisState.TotalUstrdBurned cannot be nil")
-	} else {
-		return nil
 	}
+
+	addresses := map[string]bool{}
+	for _, addressBurn := range gs.AddressBurns {
+		if _, err := sdk.AccAddressFromBech32(addressBurn.Address); err != nil {
+			return fmt.Errorf("invalid burner address %s: %w", addressBurn.Address, err)
+		}
+		if addresses[addressBurn.Address] {
+			return fmt.Errorf("duplicate burner address %s", addressBurn.Address)
+		}
+		if addressBurn.TotalBurned.IsNil() || addressBurn.TotalBurned.IsNegative() {
+			return fmt.Errorf("total burned for address %s cannot be nil or negative", addressBurn.Address)
+		}
+		addresses[addressBurn.Address] = true
+	}
+
+	sources := map[BurnSource]bool{}
+	for _, sourceBurn := range gs.SourceBurns {
+		if _, ok := BurnSource_name[int32(sourceBurn.Source)]; !ok {
+			return fmt.Errorf("invalid burn source %d", sourceBurn.Source)
+		}
+		if sources[sourceBurn.Source] {
+			return fmt.Errorf("duplicate burn source %s", sourceBurn.Source)
+		}
+		if sourceBurn.TotalBurned.IsNil() || sourceBurn.TotalBurned.IsNegative() {
+			return fmt.Errorf("total burned for source %s cannot be nil or negative", sourceBurn.Source)
+		}
+		sources[sourceBurn.Source] = true
+	}
+
+	days := map[int64]bool{}
+	for _, dailyBurn := range gs.DailyBurns {
+		day := GetBurnDay(dailyBurn.Date)
+		if days[day] {
+			return fmt.Errorf("duplicate daily burn for %s", dailyBurn.Date)
+		}
+		if dailyBurn.TotalBurned.IsNil() || dailyBurn.TotalBurned.IsNegative() {
+			return fmt.Errorf("total burned for %s cannot be nil or negative", dailyBurn.Date)
+		}
+		days[day] = true
+	}
+
+	return nil
 }
@@ -28,6 +28,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// Total amount of ustrd burned
 	TotalUstrdBurned cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=total_ustrd_burned,json=totalUstrdBurned,proto3,customtype=cosmossdk.io/math.Int" json:"total_ustrd_burned"`
+	// Total amount of ustrd burned by each address
+	AddressBurns []AddressBurn `protobuf:"bytes,10,rep,name=address_burns,json=addressBurns,proto3" json:"address_burns"`
+	// Total amount of ustrd burned from each source
+	SourceBurns []SourceBurn `protobuf:"bytes,11,rep,name=source_burns,json=sourceBurns,proto3" json:"source_burns"`
+	// Amount of ustrd burned each day
+	DailyBurns []DailyBurn `protobuf:"bytes,12,rep,name=daily_burns,json=dailyBurns,proto3" json:"daily_burns"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -63,6 +69,27 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+func (m *GenesisState) GetAddressBurns() []AddressBurn {
+	if m != nil {
+		return m.AddressBurns
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSourceBurns() []SourceBurn {
+	if m != nil {
+		return m.SourceBurns
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDailyBurns() []DailyBurn {
+	if m != nil {
+		return m.DailyBurns
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "stride.strdburner.GenesisState")
 }
@@ -70,21 +97,28 @@ func init() {
 func init() { proto.RegisterFile("stride/strdburner/genesis.proto", fileDescriptor_003ecc60d66895bb) }
 
 var fileDescriptor_003ecc60d66895bb = []byte{
-	// 223 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2f, 0x2e, 0x29, 0xca,
-	0x4c, 0x49, 0xd5, 0x2f, 0x2e, 0x29, 0x4a, 0x49, 0x2a, 0x2d, 0xca, 0x4b, 0x2d, 0xd2, 0x4f, 0x4f,
-	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0x28, 0xd0,
-	0x43, 0x28, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xea, 0x83, 0x58, 0x10, 0x85, 0x4a,
-	0xd1, 0x5c, 0x3c, 0xee, 0x10, 0x9d, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0xde, 0x5c, 0x42, 0x25,
-	0xf9, 0x25, 0x89, 0x39, 0xf1, 0xa5, 0x20, 0xad, 0xf1, 0x60, 0xbd, 0x29, 0x12, 0x9c, 0x0a, 0x8c,
-	0x1a, 0x9c, 0x4e, 0xb2, 0x27, 0xee, 0xc9, 0x33, 0xdc, 0xba, 0x27, 0x2f, 0x9a, 0x9c, 0x5f, 0x9c,
-	0x9b, 0x5f, 0x5c, 0x9c, 0x92, 0xad, 0x97, 0x99, 0xaf, 0x9f, 0x9b, 0x58, 0x92, 0xa1, 0xe7, 0x99,
-	0x57, 0x12, 0x24, 0x00, 0xd6, 0x18, 0x0a, 0xd2, 0xe7, 0x04, 0xd6, 0xe6, 0xe4, 0x77, 0xe2, 0x91,
-	0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1,
-	0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x26, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a,
-	0xc9, 0xf9, 0xb9, 0xfa, 0xc1, 0x60, 0xa7, 0xea, 0xfa, 0x24, 0x26, 0x15, 0xeb, 0x43, 0xfd, 0x55,
-	0x66, 0x64, 0xae, 0x5f, 0x81, 0xec, 0xbb, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x9b,
-	0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9e, 0x16, 0xe4, 0xa2, 0xff, 0x00, 0x00, 0x00,
+	// 321 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x41, 0x4b, 0x32, 0x41,
+	0x1c, 0xc6, 0x77, 0xdf, 0x37, 0x02, 0x67, 0x37, 0xa8, 0xa1, 0x40, 0x24, 0x47, 0xf1, 0xe4, 0xa5,
+	0x19, 0xb0, 0xa0, 0x73, 0x5b, 0x14, 0x52, 0x74, 0x50, 0xba, 0x74, 0x91, 0x59, 0x67, 0x58, 0x97,
+	0xd4, 0x91, 0xf9, 0xcf, 0x46, 0x7e, 0x8b, 0xbe, 0x4b, 0x5f, 0xc2, 0xa3, 0xc7, 0xe8, 0x20, 0xa1,
+	0x5f, 0x24, 0x66, 0xd6, 0x72, 0x41, 0x6f, 0x7f, 0x9e, 0xfd, 0x3d, 0xbf, 0x65, 0x78, 0x50, 0x0d,
+	0x8c, 0x4e, 0x85, 0x64, 0x60, 0xb4, 0x88, 0x33, 0x3d, 0x96, 0x9a, 0x25, 0x72, 0x2c, 0x21, 0x05,
+	0x3a, 0xd1, 0xca, 0x28, 0x7c, 0x94, 0x03, 0x74, 0x03, 0x54, 0x8e, 0x13, 0x95, 0x28, 0xf7, 0x95,
+	0xd9, 0x2b, 0x07, 0x2b, 0x8d, 0x6d, 0xd3, 0xe6, 0xcc, 0x99, 0xc6, 0xc7, 0x3f, 0x14, 0xde, 0xe5,
+	0xfa, 0xae, 0xe1, 0x46, 0xe2, 0x7b, 0x84, 0x8d, 0x32, 0x7c, 0xd8, 0xcb, 0x2c, 0xdb, 0x73, 0xb0,
+	0x28, 0x97, 0xea, 0x7e, 0xb3, 0x14, 0x55, 0x67, 0x8b, 0x9a, 0xf7, 0xb5, 0xa8, 0x9d, 0xf4, 0x15,
+	0x8c, 0x14, 0x80, 0x78, 0xa1, 0xa9, 0x62, 0x23, 0x6e, 0x06, 0xb4, 0x3d, 0x36, 0x9d, 0x43, 0x57,
+	0x7c, 0xb2, 0xbd, 0xc8, 0xd5, 0x70, 0x1b, 0x1d, 0x70, 0x21, 0xb4, 0x04, 0x70, 0x22, 0x28, 0xa3,
+	0xfa, 0xff, 0x66, 0xd0, 0x22, 0x74, 0xeb, 0x09, 0xf4, 0x2a, 0xe7, 0x6c, 0x31, 0xda, 0xb3, 0xff,
+	0xe9, 0x84, 0x7c, 0x13, 0x01, 0xbe, 0x45, 0x21, 0xa8, 0x4c, 0xf7, 0xe5, 0xda, 0x14, 0x38, 0x53,
+	0x75, 0x87, 0xa9, 0xeb, 0xb0, 0x82, 0x28, 0x80, 0xbf, 0x04, 0xf0, 0x35, 0x0a, 0x04, 0x4f, 0x87,
+	0xd3, 0xb5, 0x26, 0x74, 0x9a, 0xd3, 0x1d, 0x9a, 0x1b, 0x4b, 0x15, 0x2c, 0x48, 0xfc, 0x06, 0x10,
+	0x3d, 0xce, 0x96, 0xc4, 0x9f, 0x2f, 0x89, 0xff, 0xbd, 0x24, 0xfe, 0xfb, 0x8a, 0x78, 0xf3, 0x15,
+	0xf1, 0x3e, 0x57, 0xc4, 0x7b, 0xbe, 0x48, 0x52, 0x33, 0xc8, 0x62, 0xda, 0x57, 0x23, 0xd6, 0x75,
+	0xce, 0xb3, 0x07, 0x1e, 0x03, 0x5b, 0x4f, 0xf1, 0xda, 0xba, 0x64, 0x6f, 0xc5, 0x41, 0xcc, 0x74,
+	0x22, 0x21, 0xde, 0x77, 0x63, 0x9c, 0xff, 0x0c, 0x00, 0xdd, 0x66, 0x9b, 0xb7, 0xfc, 0x01, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -107,6 +141,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DailyBurns) > 0 {
+		for iNdEx := len(m.DailyBurns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DailyBurns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.SourceBurns) > 0 {
+		for iNdEx := len(m.SourceBurns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceBurns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.AddressBurns) > 0 {
+		for iNdEx := len(m.AddressBurns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressBurns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	{
 		size := m.TotalUstrdBurned.Size()
 		i -= size
@@ -139,6 +215,24 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.TotalUstrdBurned.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AddressBurns) > 0 {
+		for _, e := range m.AddressBurns {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SourceBurns) > 0 {
+		for _, e := range m.SourceBurns {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DailyBurns) > 0 {
+		for _, e := range m.DailyBurns {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -211,6 +305,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressBurns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressBurns = append(m.AddressBurns, AddressBurn{})
+			if err := m.AddressBurns[len(m.AddressBurns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceBurns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceBurns = append(m.SourceBurns, SourceBurn{})
+			if err := m.SourceBurns[len(m.SourceBurns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyBurns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DailyBurns = append(m.DailyBurns, DailyBurn{})
+			if err := m.DailyBurns[len(m.DailyBurns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
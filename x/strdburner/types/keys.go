@@ -1,5 +1,12 @@
 package types
 
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	ModuleName = "strdburner"
 
@@ -10,4 +17,42 @@ const (
 	RouterKey = ModuleName
 
 	TotalStrdBurnedKey = "total_burned"
+
+	StrdDenom = "ustrd"
+)
+
+var (
+	AddressBurnsPrefix = []byte("address_burns")
+	SourceBurnsPrefix  = []byte("source_burns")
+	DailyBurnsPrefix   = []byte("daily_burns")
 )
+
+const secondsPerDay = 24 * 60 * 60
+
+// Returns the index of the (UTC) day that the given time falls in, used to bucket burns
+func GetBurnDay(t time.Time) int64 {
+	return t.Unix() / secondsPerDay
+}
+
+// Returns the start time of the (UTC) day with the given index
+func GetBurnDayStartTime(day int64) time.Time {
+	return time.Unix(day*secondsPerDay, 0).UTC()
+}
+
+// Returns the store key for the daily burn total of the given day
+func DailyBurnKey(day int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(day))
+}
+
+// Returns the store key for the burn total of the given source
+func SourceBurnKey(source BurnSource) []byte {
+	return sdk.Uint64ToBigEndian(uint64(source))
+}
+
+// Builds a DailyBurn from the raw key and value of a daily burn store entry
+func NewDailyBurn(key, value []byte) DailyBurn {
+	return DailyBurn{
+		Date:        GetBurnDayStartTime(int64(sdk.BigEndianToUint64(key))),
+		TotalBurned: math.NewIntFromUint64(sdk.BigEndianToUint64(value)),
+	}
+}
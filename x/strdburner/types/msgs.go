@@ -0,0 +1,60 @@
+package types
+
+import (
+	"errors"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+const (
+	TypeMsgBurn = "burn"
+)
+
+var (
+	_ sdk.Msg = &MsgBurn{}
+
+	// Implement legacy interface for ledger support
+	_ legacytx.LegacyMsg = &MsgBurn{}
+)
+
+// ----------------------------------------------
+//               MsgBurn
+// ----------------------------------------------
+
+func NewMsgBurn(burner string, amount sdkmath.Int) *MsgBurn {
+	return &MsgBurn{
+		Burner: burner,
+		Amount: amount,
+	}
+}
+
+func (msg MsgBurn) Type() string {
+	return TypeMsgBurn
+}
+
+func (msg MsgBurn) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBurn) GetSigners() []sdk.AccAddress {
+	burner, _ := sdk.AccAddressFromBech32(msg.Burner)
+	return []sdk.AccAddress{burner}
+}
+
+func (msg *MsgBurn) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBurn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Burner); err != nil {
+		return err
+	}
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return errors.New("burn amount must be positive")
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -157,6 +158,8 @@ var xxx_messageInfo_QueryTotalStrdBurnedRequest proto.InternalMessageInfo
 // RPC method
 type QueryTotalStrdBurnedResponse struct {
 	TotalBurned cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=total_burned,json=totalBurned,proto3,customtype=cosmossdk.io/math.Int" json:"total_burned"`
+	// Breakdown of the total by burn source
+	SourceBurns []SourceBurn `protobuf:"bytes,2,rep,name=source_burns,json=sourceBurns,proto3" json:"source_burns"`
 }
 
 func (m *QueryTotalStrdBurnedResponse) Reset()         { *m = QueryTotalStrdBurnedResponse{} }
@@ -192,43 +195,253 @@ func (m *QueryTotalStrdBurnedResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryTotalStrdBurnedResponse proto.InternalMessageInfo
 
+func (m *QueryTotalStrdBurnedResponse) GetSourceBurns() []SourceBurn {
+	if m != nil {
+		return m.SourceBurns
+	}
+	return nil
+}
+
+// QueryBurnsByAddressRequest is the request type for the Query/BurnsByAddress
+// RPC method
+type QueryBurnsByAddressRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryBurnsByAddressRequest) Reset()         { *m = QueryBurnsByAddressRequest{} }
+func (m *QueryBurnsByAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnsByAddressRequest) ProtoMessage()    {}
+func (*QueryBurnsByAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76c45869caa19016, []int{4}
+}
+func (m *QueryBurnsByAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnsByAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnsByAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnsByAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnsByAddressRequest.Merge(m, src)
+}
+func (m *QueryBurnsByAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnsByAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnsByAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnsByAddressRequest proto.InternalMessageInfo
+
+func (m *QueryBurnsByAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryBurnsByAddressResponse is the response type for the
+// Query/BurnsByAddress RPC method
+type QueryBurnsByAddressResponse struct {
+	TotalBurned cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=total_burned,json=totalBurned,proto3,customtype=cosmossdk.io/math.Int" json:"total_burned"`
+}
+
+func (m *QueryBurnsByAddressResponse) Reset()         { *m = QueryBurnsByAddressResponse{} }
+func (m *QueryBurnsByAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnsByAddressResponse) ProtoMessage()    {}
+func (*QueryBurnsByAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76c45869caa19016, []int{5}
+}
+func (m *QueryBurnsByAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnsByAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnsByAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnsByAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnsByAddressResponse.Merge(m, src)
+}
+func (m *QueryBurnsByAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnsByAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnsByAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnsByAddressResponse proto.InternalMessageInfo
+
+// QueryBurnHistoryRequest is the request type for the Query/BurnHistory RPC
+// method
+type QueryBurnHistoryRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBurnHistoryRequest) Reset()         { *m = QueryBurnHistoryRequest{} }
+func (m *QueryBurnHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnHistoryRequest) ProtoMessage()    {}
+func (*QueryBurnHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76c45869caa19016, []int{6}
+}
+func (m *QueryBurnHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnHistoryRequest.Merge(m, src)
+}
+func (m *QueryBurnHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryBurnHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBurnHistoryResponse is the response type for the Query/BurnHistory RPC
+// method
+type QueryBurnHistoryResponse struct {
+	DailyBurns []DailyBurn         `protobuf:"bytes,1,rep,name=daily_burns,json=dailyBurns,proto3" json:"daily_burns"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBurnHistoryResponse) Reset()         { *m = QueryBurnHistoryResponse{} }
+func (m *QueryBurnHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnHistoryResponse) ProtoMessage()    {}
+func (*QueryBurnHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76c45869caa19016, []int{7}
+}
+func (m *QueryBurnHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnHistoryResponse.Merge(m, src)
+}
+func (m *QueryBurnHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryBurnHistoryResponse) GetDailyBurns() []DailyBurn {
+	if m != nil {
+		return m.DailyBurns
+	}
+	return nil
+}
+
+func (m *QueryBurnHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryStrdBurnerAddressRequest)(nil), "stride.strdburner.QueryStrdBurnerAddressRequest")
 	proto.RegisterType((*QueryStrdBurnerAddressResponse)(nil), "stride.strdburner.QueryStrdBurnerAddressResponse")
 	proto.RegisterType((*QueryTotalStrdBurnedRequest)(nil), "stride.strdburner.QueryTotalStrdBurnedRequest")
 	proto.RegisterType((*QueryTotalStrdBurnedResponse)(nil), "stride.strdburner.QueryTotalStrdBurnedResponse")
+	proto.RegisterType((*QueryBurnsByAddressRequest)(nil), "stride.strdburner.QueryBurnsByAddressRequest")
+	proto.RegisterType((*QueryBurnsByAddressResponse)(nil), "stride.strdburner.QueryBurnsByAddressResponse")
+	proto.RegisterType((*QueryBurnHistoryRequest)(nil), "stride.strdburner.QueryBurnHistoryRequest")
+	proto.RegisterType((*QueryBurnHistoryResponse)(nil), "stride.strdburner.QueryBurnHistoryResponse")
 }
 
 func init() { proto.RegisterFile("stride/strdburner/query.proto", fileDescriptor_76c45869caa19016) }
 
 var fileDescriptor_76c45869caa19016 = []byte{
-	// 402 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2d, 0x2e, 0x29, 0xca,
-	0x4c, 0x49, 0xd5, 0x2f, 0x2e, 0x29, 0x4a, 0x49, 0x2a, 0x2d, 0xca, 0x4b, 0x2d, 0xd2, 0x2f, 0x2c,
-	0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0x48, 0xeb, 0x21, 0xa4,
-	0xa5, 0x24, 0x93, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xe3, 0xc1, 0x0a, 0xf4, 0x21, 0x1c, 0x88, 0x6a,
-	0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x88, 0x38, 0x88, 0x05, 0x15, 0x95, 0x49, 0xcf, 0xcf, 0x4f,
-	0xcf, 0x49, 0xd5, 0x4f, 0x2c, 0xc8, 0xd4, 0x4f, 0xcc, 0xcb, 0xcb, 0x2f, 0x49, 0x2c, 0xc9, 0xcc,
-	0xcf, 0x83, 0xea, 0x51, 0x92, 0xe7, 0x92, 0x0d, 0x04, 0x59, 0x18, 0x5c, 0x52, 0x94, 0xe2, 0x04,
-	0xb6, 0xc1, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x38, 0x28, 0xb5, 0xb0, 0x34, 0xb5, 0xb8, 0x44,
-	0x29, 0x84, 0x4b, 0x0e, 0x97, 0x82, 0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54, 0x21, 0x23, 0x2e, 0xf6,
-	0x44, 0x88, 0x90, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xc4, 0xa5, 0x2d, 0xba, 0x22, 0x50,
-	0x97, 0x41, 0x15, 0x07, 0x97, 0x14, 0x65, 0xe6, 0xa5, 0x07, 0xc1, 0x14, 0x2a, 0xc9, 0x72, 0x49,
-	0x83, 0x4d, 0x0d, 0xc9, 0x2f, 0x49, 0xcc, 0x81, 0x1b, 0x9d, 0x02, 0xb3, 0x34, 0x81, 0x4b, 0x06,
-	0xbb, 0x34, 0xd4, 0x4a, 0x07, 0x2e, 0x9e, 0x12, 0x90, 0x54, 0x3c, 0x38, 0x50, 0x52, 0xa0, 0xf6,
-	0xca, 0x9e, 0xb8, 0x27, 0xcf, 0x70, 0xeb, 0x9e, 0xbc, 0x28, 0xc4, 0xee, 0xe2, 0x94, 0x6c, 0xbd,
-	0xcc, 0x7c, 0xfd, 0xdc, 0xc4, 0x92, 0x0c, 0x3d, 0xcf, 0xbc, 0x92, 0x20, 0x6e, 0xb0, 0x16, 0x88,
-	0x49, 0x46, 0x47, 0x98, 0xb8, 0x58, 0xc1, 0x56, 0x08, 0xcd, 0x61, 0xe4, 0x12, 0xc4, 0xf0, 0x9c,
-	0x90, 0x81, 0x1e, 0x46, 0xd0, 0xeb, 0xe1, 0x0d, 0x28, 0x29, 0x43, 0x12, 0x74, 0x40, 0xbc, 0xa1,
-	0xa4, 0xd4, 0x74, 0xf9, 0xc9, 0x64, 0x26, 0x19, 0x21, 0x29, 0x7d, 0xcc, 0x64, 0x00, 0x0d, 0x29,
-	0xa1, 0xd9, 0x8c, 0x5c, 0xfc, 0x68, 0xc1, 0x20, 0xa4, 0x87, 0xcb, 0x2a, 0xec, 0xc1, 0x29, 0xa5,
-	0x4f, 0xb4, 0x7a, 0xa8, 0xc3, 0xd4, 0xc1, 0x0e, 0x53, 0x14, 0x92, 0xc7, 0xe2, 0x30, 0xe4, 0x80,
-	0x77, 0xf2, 0x3b, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27,
-	0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x93, 0xf4, 0xcc,
-	0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0x60, 0xb0, 0x21, 0xba, 0x3e, 0x89, 0x49,
-	0xc5, 0x30, 0x03, 0xcb, 0x8c, 0xcc, 0xf5, 0x2b, 0x50, 0x8c, 0xad, 0x2c, 0x48, 0x2d, 0x4e, 0x62,
-	0x03, 0xa7, 0x4a, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9d, 0x0b, 0xd4, 0x2d, 0x18, 0x03,
-	0x00, 0x00,
+	// 634 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xe3, 0x50, 0x40, 0xac, 0x2b, 0x50, 0x57, 0x45, 0x04, 0x37, 0x71, 0x8a, 0x85, 0x68,
+	0x15, 0x94, 0x5d, 0x12, 0x90, 0xb8, 0x42, 0x40, 0x05, 0x24, 0x84, 0x4a, 0xd2, 0x13, 0x97, 0x68,
+	0x1d, 0xaf, 0x1c, 0x8b, 0xc4, 0x9b, 0x7a, 0x37, 0x15, 0x11, 0xe2, 0xc2, 0x0b, 0x80, 0xc4, 0x11,
+	0x1e, 0x80, 0x07, 0xe0, 0x21, 0x7a, 0xac, 0xe0, 0x82, 0x38, 0x54, 0x28, 0xe1, 0x11, 0x78, 0x00,
+	0xe4, 0xdd, 0x75, 0x3e, 0x88, 0x53, 0x02, 0xe2, 0x14, 0xef, 0xcc, 0x7f, 0x66, 0x7e, 0x3b, 0x3b,
+	0x13, 0x50, 0xe0, 0x22, 0x0a, 0x3c, 0x8a, 0xb9, 0x88, 0x3c, 0xb7, 0x1f, 0x85, 0x34, 0xc2, 0xfb,
+	0x7d, 0x1a, 0x0d, 0x50, 0x2f, 0x62, 0x82, 0xc1, 0x35, 0xe5, 0x46, 0x13, 0xb7, 0x55, 0x6a, 0x31,
+	0xde, 0x65, 0x1c, 0xbb, 0x84, 0x53, 0xa5, 0xc5, 0x07, 0x15, 0x97, 0x0a, 0x52, 0xc1, 0x3d, 0xe2,
+	0x07, 0x21, 0x11, 0x01, 0x0b, 0x55, 0xb8, 0x75, 0x59, 0x69, 0x9b, 0xf2, 0x84, 0xd5, 0x41, 0xbb,
+	0xd6, 0x7d, 0xe6, 0x33, 0x65, 0x8f, 0xbf, 0xb4, 0x35, 0xef, 0x33, 0xe6, 0x77, 0x28, 0x26, 0xbd,
+	0x00, 0x93, 0x30, 0x64, 0x42, 0x66, 0x4b, 0x62, 0x9c, 0x79, 0xd8, 0xc9, 0xa7, 0xd2, 0x38, 0x45,
+	0x50, 0x78, 0x1a, 0x43, 0x35, 0x44, 0xe4, 0xd5, 0xa4, 0xe3, 0xae, 0xe7, 0x45, 0x94, 0xf3, 0x3a,
+	0xdd, 0xef, 0x53, 0x2e, 0x9c, 0x3d, 0x60, 0x2f, 0x12, 0xf0, 0x1e, 0x0b, 0x39, 0x85, 0x55, 0x70,
+	0x96, 0x28, 0x53, 0xce, 0xd8, 0x34, 0xb6, 0xcf, 0xd5, 0x72, 0x9f, 0x3f, 0x95, 0xd7, 0x35, 0xbd,
+	0x16, 0x37, 0x44, 0x14, 0x84, 0x7e, 0x3d, 0x11, 0x3a, 0x05, 0xb0, 0x21, 0xb3, 0xee, 0x31, 0x41,
+	0x3a, 0xe3, 0xd4, 0x5e, 0x52, 0xf4, 0xa3, 0x01, 0xf2, 0xe9, 0x7e, 0x5d, 0xf3, 0x0e, 0x58, 0x15,
+	0xb1, 0xab, 0x29, 0x2f, 0xe3, 0xe9, 0xc2, 0x85, 0xc3, 0xe3, 0x62, 0xe6, 0xdb, 0x71, 0xf1, 0xa2,
+	0x2a, 0xce, 0xbd, 0xe7, 0x28, 0x60, 0xb8, 0x4b, 0x44, 0x1b, 0x3d, 0x0a, 0x45, 0xdd, 0x94, 0x21,
+	0x2a, 0x13, 0xdc, 0x01, 0xab, 0x9c, 0xf5, 0xa3, 0x16, 0x95, 0x29, 0x78, 0x2e, 0xbb, 0x79, 0x6a,
+	0xdb, 0xac, 0x16, 0xd0, 0xdc, 0x0b, 0xa2, 0x86, 0x94, 0xc5, 0x61, 0xb5, 0x95, 0xb8, 0x40, 0xdd,
+	0xe4, 0x63, 0x0b, 0x77, 0x76, 0x81, 0x25, 0x49, 0xe5, 0xa9, 0x36, 0x98, 0xed, 0xde, 0x3f, 0xf5,
+	0xa6, 0x09, 0x36, 0x52, 0x33, 0xfe, 0xaf, 0xab, 0x3b, 0x04, 0x5c, 0x1a, 0x17, 0x78, 0x18, 0x70,
+	0xc1, 0xa2, 0x41, 0xc2, 0xbb, 0x03, 0xc0, 0x64, 0x2a, 0x65, 0x6a, 0xb3, 0x7a, 0x0d, 0x69, 0xde,
+	0x78, 0x84, 0x91, 0x1a, 0x77, 0x3d, 0xc2, 0x68, 0x97, 0xf8, 0x54, 0xc7, 0xd6, 0xa7, 0x22, 0xe3,
+	0x07, 0xcc, 0xcd, 0xd7, 0xd0, 0x37, 0xb8, 0x07, 0x4c, 0x8f, 0x04, 0x9d, 0x81, 0xee, 0xbc, 0x21,
+	0x3b, 0x9f, 0x4f, 0xe9, 0xfc, 0xfd, 0x58, 0x35, 0xd5, 0x78, 0xe0, 0x25, 0x06, 0x0e, 0x1f, 0xcc,
+	0x90, 0x66, 0x25, 0xe9, 0xd6, 0x1f, 0x49, 0x15, 0xc1, 0x34, 0x6a, 0xf5, 0xe7, 0x0a, 0x38, 0x2d,
+	0x51, 0xe1, 0x07, 0x03, 0xac, 0xcd, 0x8d, 0x39, 0xbc, 0x91, 0x02, 0x76, 0xe2, 0xca, 0x58, 0x95,
+	0xbf, 0x88, 0x50, 0x40, 0x8e, 0xf3, 0xfa, 0xcb, 0x8f, 0x77, 0xd9, 0x3c, 0xb4, 0xf0, 0xfc, 0xce,
+	0xea, 0xb9, 0x80, 0xef, 0x0d, 0x70, 0xe1, 0xb7, 0x7d, 0x80, 0x68, 0x51, 0xa9, 0xf4, 0xc5, 0xb2,
+	0xf0, 0xd2, 0x7a, 0x0d, 0xb6, 0x25, 0xc1, 0xae, 0xc0, 0x62, 0x0a, 0xd8, 0xf4, 0x18, 0xc6, 0x74,
+	0xe7, 0x67, 0x27, 0x16, 0x96, 0x17, 0x15, 0x4b, 0xdd, 0x15, 0x0b, 0x2d, 0x2b, 0xd7, 0x68, 0x25,
+	0x89, 0x76, 0x15, 0x3a, 0x29, 0x68, 0xf1, 0x0f, 0xc7, 0x2f, 0x75, 0xeb, 0x5e, 0xc1, 0x37, 0x06,
+	0x30, 0xa7, 0x46, 0x11, 0x96, 0x4e, 0xaa, 0x35, 0xbb, 0x13, 0xd6, 0xf5, 0xa5, 0xb4, 0x4b, 0xf4,
+	0x2b, 0xfe, 0x69, 0xb6, 0x55, 0x40, 0xed, 0xc9, 0xe1, 0xd0, 0x36, 0x8e, 0x86, 0xb6, 0xf1, 0x7d,
+	0x68, 0x1b, 0x6f, 0x47, 0x76, 0xe6, 0x68, 0x64, 0x67, 0xbe, 0x8e, 0xec, 0xcc, 0xb3, 0x5b, 0x7e,
+	0x20, 0xda, 0x7d, 0x17, 0xb5, 0x58, 0x17, 0x37, 0x64, 0x92, 0xf2, 0x63, 0xe2, 0xf2, 0x24, 0xe1,
+	0x41, 0xf5, 0x36, 0x7e, 0x31, 0xf3, 0x0c, 0x83, 0x1e, 0xe5, 0xee, 0x19, 0xf9, 0x7f, 0x7e, 0xf3,
+	0xd7, 0x00, 0xc6, 0x1c, 0xf9, 0x85, 0xa2, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -247,6 +460,10 @@ type QueryClient interface {
 	StrdBurnerAddress(ctx context.Context, in *QueryStrdBurnerAddressRequest, opts ...grpc.CallOption) (*QueryStrdBurnerAddressResponse, error)
 	// StrdBurnerAddress queries the address of the strdburner module
 	TotalStrdBurned(ctx context.Context, in *QueryTotalStrdBurnedRequest, opts ...grpc.CallOption) (*QueryTotalStrdBurnedResponse, error)
+	// BurnsByAddress queries the total amount of STRD burned by an address
+	BurnsByAddress(ctx context.Context, in *QueryBurnsByAddressRequest, opts ...grpc.CallOption) (*QueryBurnsByAddressResponse, error)
+	// BurnHistory queries the amount of STRD burned each day
+	BurnHistory(ctx context.Context, in *QueryBurnHistoryRequest, opts ...grpc.CallOption) (*QueryBurnHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -275,12 +492,34 @@ func (c *queryClient) TotalStrdBurned(ctx context.Context, in *QueryTotalStrdBur
 	return out, nil
 }
 
+func (c *queryClient) BurnsByAddress(ctx context.Context, in *QueryBurnsByAddressRequest, opts ...grpc.CallOption) (*QueryBurnsByAddressResponse, error) {
+	out := new(QueryBurnsByAddressResponse)
+	err := c.cc.Invoke(ctx, "/stride.strdburner.Query/BurnsByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BurnHistory(ctx context.Context, in *QueryBurnHistoryRequest, opts ...grpc.CallOption) (*QueryBurnHistoryResponse, error) {
+	out := new(QueryBurnHistoryResponse)
+	err := c.cc.Invoke(ctx, "/stride.strdburner.Query/BurnHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// StrdBurnerAddress queries the address of the strdburner module
 	StrdBurnerAddress(context.Context, *QueryStrdBurnerAddressRequest) (*QueryStrdBurnerAddressResponse, error)
 	// StrdBurnerAddress queries the address of the strdburner module
 	TotalStrdBurned(context.Context, *QueryTotalStrdBurnedRequest) (*QueryTotalStrdBurnedResponse, error)
+	// BurnsByAddress queries the total amount of STRD burned by an address
+	BurnsByAddress(context.Context, *QueryBurnsByAddressRequest) (*QueryBurnsByAddressResponse, error)
+	// BurnHistory queries the amount of STRD burned each day
+	BurnHistory(context.Context, *QueryBurnHistoryRequest) (*QueryBurnHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -293,6 +532,12 @@ func (*UnimplementedQueryServer) StrdBurnerAddress(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) TotalStrdBurned(ctx context.Context, req *QueryTotalStrdBurnedRequest) (*QueryTotalStrdBurnedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalStrdBurned not implemented")
 }
+func (*UnimplementedQueryServer) BurnsByAddress(ctx context.Context, req *QueryBurnsByAddressRequest) (*QueryBurnsByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnsByAddress not implemented")
+}
+func (*UnimplementedQueryServer) BurnHistory(ctx context.Context, req *QueryBurnHistoryRequest) (*QueryBurnHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -334,6 +579,42 @@ func _Query_TotalStrdBurned_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BurnsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.strdburner.Query/BurnsByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnsByAddress(ctx, req.(*QueryBurnsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurnHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.strdburner.Query/BurnHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnHistory(ctx, req.(*QueryBurnHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.strdburner.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -346,6 +627,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TotalStrdBurned",
 			Handler:    _Query_TotalStrdBurned_Handler,
 		},
+		{
+			MethodName: "BurnsByAddress",
+			Handler:    _Query_BurnsByAddress_Handler,
+		},
+		{
+			MethodName: "BurnHistory",
+			Handler:    _Query_BurnHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stride/strdburner/query.proto",
@@ -447,6 +736,20 @@ func (m *QueryTotalStrdBurnedResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if len(m.SourceBurns) > 0 {
+		for iNdEx := len(m.SourceBurns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceBurns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size := m.TotalBurned.Size()
 		i -= size
@@ -460,68 +763,277 @@ func (m *QueryTotalStrdBurnedResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryBurnsByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryStrdBurnerAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryBurnsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryStrdBurnerAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBurnsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalStrdBurnedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBurnsByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTotalStrdBurnedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBurnsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.TotalBurned.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	{
+		size := m.TotalBurned.Size()
+		i -= size
+		if _, err := m.TotalBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryBurnHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryBurnHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QueryStrdBurnerAddressRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+
+func (m *QueryBurnHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DailyBurns) > 0 {
+		for iNdEx := len(m.DailyBurns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DailyBurns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryStrdBurnerAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStrdBurnerAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalStrdBurnedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalStrdBurnedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalBurned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.SourceBurns) > 0 {
+		for _, e := range m.SourceBurns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBurnsByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurnsByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalBurned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBurnHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurnHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DailyBurns) > 0 {
+		for _, e := range m.DailyBurns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryStrdBurnerAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -764,6 +1276,412 @@ func (m *QueryTotalStrdBurnedResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceBurns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceBurns = append(m.SourceBurns, SourceBurn{})
+			if err := m.SourceBurns[len(m.SourceBurns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnsByAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnsByAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnsByAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnsByAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyBurns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DailyBurns = append(m.DailyBurns, DailyBurn{})
+			if err := m.DailyBurns[len(m.DailyBurns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
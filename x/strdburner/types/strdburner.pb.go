@@ -0,0 +1,853 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stride/strdburner/strdburner.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Origin of burned STRD
+type BurnSource int32
+
+const (
+	// STRD sent directly to the module account without attribution
+	BURN_SOURCE_UNATTRIBUTED BurnSource = 0
+	// STRD burned by an account through MsgBurn
+	BURN_SOURCE_USER BurnSource = 1
+	// Auction proceeds that were paid to the strdburner, attributed to the
+	// auction module account
+	BURN_SOURCE_AUCTION BurnSource = 2
+	// The portion of each mint streamed to the strdburner, attributed to the
+	// community growth account
+	BURN_SOURCE_MINT_STREAM BurnSource = 3
+	// Expired airdrop rewards clawed back to the strdburner, attributed to the
+	// airdrop's distributor
+	BURN_SOURCE_AIRDROP_CLAWBACK BurnSource = 4
+)
+
+var BurnSource_name = map[int32]string{
+	0: "BURN_SOURCE_UNATTRIBUTED",
+	1: "BURN_SOURCE_USER",
+	2: "BURN_SOURCE_AUCTION",
+	3: "BURN_SOURCE_MINT_STREAM",
+	4: "BURN_SOURCE_AIRDROP_CLAWBACK",
+}
+
+var BurnSource_value = map[string]int32{
+	"BURN_SOURCE_UNATTRIBUTED":     0,
+	"BURN_SOURCE_USER":             1,
+	"BURN_SOURCE_AUCTION":          2,
+	"BURN_SOURCE_MINT_STREAM":      3,
+	"BURN_SOURCE_AIRDROP_CLAWBACK": 4,
+}
+
+func (x BurnSource) String() string {
+	return proto.EnumName(BurnSource_name, int32(x))
+}
+
+func (BurnSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d3a8ac9541b44ee2, []int{0}
+}
+
+// Total amount of STRD burned by a given address
+type AddressBurn struct {
+	Address     string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	TotalBurned cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_burned,json=totalBurned,proto3,customtype=cosmossdk.io/math.Int" json:"total_burned"`
+}
+
+func (m *AddressBurn) Reset()         { *m = AddressBurn{} }
+func (m *AddressBurn) String() string { return proto.CompactTextString(m) }
+func (*AddressBurn) ProtoMessage()    {}
+func (*AddressBurn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3a8ac9541b44ee2, []int{0}
+}
+func (m *AddressBurn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressBurn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressBurn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressBurn.Merge(m, src)
+}
+func (m *AddressBurn) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressBurn) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressBurn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressBurn proto.InternalMessageInfo
+
+func (m *AddressBurn) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// Total amount of STRD burned from a given source
+type SourceBurn struct {
+	Source      BurnSource            `protobuf:"varint,1,opt,name=source,proto3,enum=stride.strdburner.BurnSource" json:"source,omitempty"`
+	TotalBurned cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_burned,json=totalBurned,proto3,customtype=cosmossdk.io/math.Int" json:"total_burned"`
+}
+
+func (m *SourceBurn) Reset()         { *m = SourceBurn{} }
+func (m *SourceBurn) String() string { return proto.CompactTextString(m) }
+func (*SourceBurn) ProtoMessage()    {}
+func (*SourceBurn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3a8ac9541b44ee2, []int{1}
+}
+func (m *SourceBurn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SourceBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SourceBurn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SourceBurn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceBurn.Merge(m, src)
+}
+func (m *SourceBurn) XXX_Size() int {
+	return m.Size()
+}
+func (m *SourceBurn) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceBurn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourceBurn proto.InternalMessageInfo
+
+func (m *SourceBurn) GetSource() BurnSource {
+	if m != nil {
+		return m.Source
+	}
+	return BURN_SOURCE_UNATTRIBUTED
+}
+
+// Total amount of STRD burned on a given day (UTC)
+type DailyBurn struct {
+	// Start of the day
+	Date        time.Time             `protobuf:"bytes,1,opt,name=date,proto3,stdtime" json:"date"`
+	TotalBurned cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_burned,json=totalBurned,proto3,customtype=cosmossdk.io/math.Int" json:"total_burned"`
+}
+
+func (m *DailyBurn) Reset()         { *m = DailyBurn{} }
+func (m *DailyBurn) String() string { return proto.CompactTextString(m) }
+func (*DailyBurn) ProtoMessage()    {}
+func (*DailyBurn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3a8ac9541b44ee2, []int{2}
+}
+func (m *DailyBurn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DailyBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DailyBurn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DailyBurn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DailyBurn.Merge(m, src)
+}
+func (m *DailyBurn) XXX_Size() int {
+	return m.Size()
+}
+func (m *DailyBurn) XXX_DiscardUnknown() {
+	xxx_messageInfo_DailyBurn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DailyBurn proto.InternalMessageInfo
+
+func (m *DailyBurn) GetDate() time.Time {
+	if m != nil {
+		return m.Date
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("stride.strdburner.BurnSource", BurnSource_name, BurnSource_value)
+	proto.RegisterType((*AddressBurn)(nil), "stride.strdburner.AddressBurn")
+	proto.RegisterType((*SourceBurn)(nil), "stride.strdburner.SourceBurn")
+	proto.RegisterType((*DailyBurn)(nil), "stride.strdburner.DailyBurn")
+}
+
+func init() {
+	proto.RegisterFile("stride/strdburner/strdburner.proto", fileDescriptor_d3a8ac9541b44ee2)
+}
+
+var fileDescriptor_d3a8ac9541b44ee2 = []byte{
+	// 438 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0x8d, 0x47, 0x35, 0xd8, 0x57, 0x84, 0x42, 0x18, 0x5a, 0x55, 0xb6, 0x74, 0xea, 0x09, 0x21,
+	0x61, 0x4b, 0x05, 0x04, 0x47, 0xe2, 0xb6, 0x87, 0x88, 0x2d, 0x45, 0x4e, 0x22, 0x24, 0x2e, 0x51,
+	0xd2, 0x84, 0x2c, 0xa2, 0xa9, 0x2b, 0xdb, 0x41, 0xec, 0x07, 0x20, 0x38, 0xee, 0x17, 0x70, 0xe1,
+	0xcf, 0xec, 0xb8, 0x23, 0xe2, 0x30, 0x50, 0xfb, 0x47, 0x50, 0x9d, 0x55, 0x6b, 0xc5, 0x91, 0xdd,
+	0xfc, 0xf2, 0xde, 0xd3, 0x7b, 0x7e, 0x31, 0x74, 0xa5, 0x12, 0x45, 0x9a, 0x11, 0xa9, 0x44, 0x9a,
+	0x54, 0x62, 0x9a, 0x89, 0xb5, 0x23, 0x9e, 0x09, 0xae, 0xb8, 0x75, 0xbf, 0xd6, 0xe0, 0x6b, 0xa2,
+	0xbd, 0x9b, 0xf3, 0x9c, 0x6b, 0x96, 0x2c, 0x4f, 0xb5, 0xb0, 0xdd, 0xc9, 0x39, 0xcf, 0x27, 0x19,
+	0xd1, 0x28, 0xa9, 0x3e, 0x10, 0x55, 0x94, 0x99, 0x54, 0x71, 0x39, 0xab, 0x05, 0xdd, 0x02, 0x9a,
+	0x4e, 0x9a, 0x8a, 0x4c, 0x4a, 0x5a, 0x89, 0xa9, 0xd5, 0x82, 0xdb, 0x71, 0x0d, 0x5b, 0xe8, 0x10,
+	0x3d, 0xde, 0x61, 0x2b, 0x68, 0xbd, 0x86, 0xbb, 0x8a, 0xab, 0x78, 0x12, 0xe9, 0xbc, 0xb4, 0xb5,
+	0xb5, 0xa4, 0xe9, 0xc1, 0xf9, 0x65, 0xc7, 0xf8, 0x75, 0xd9, 0x79, 0x38, 0xe6, 0xb2, 0xe4, 0x52,
+	0xa6, 0x1f, 0x71, 0xc1, 0x49, 0x19, 0xab, 0x13, 0xec, 0x4e, 0x15, 0x6b, 0x6a, 0x0b, 0xd5, 0x8e,
+	0xee, 0x17, 0x04, 0xe0, 0xf3, 0x4a, 0x8c, 0x33, 0x1d, 0xf5, 0x02, 0xb6, 0xa5, 0x46, 0x3a, 0xe9,
+	0x5e, 0xef, 0x00, 0xff, 0x73, 0x29, 0xbc, 0x14, 0xd6, 0x16, 0x76, 0x25, 0xbe, 0x81, 0x1e, 0x5f,
+	0x11, 0xec, 0x0c, 0xe2, 0x62, 0x72, 0xaa, 0x6b, 0xbc, 0x82, 0x46, 0x1a, 0xab, 0xba, 0x44, 0xb3,
+	0xd7, 0xc6, 0xf5, 0x60, 0x78, 0x35, 0x18, 0x0e, 0x56, 0x83, 0xd1, 0x3b, 0xcb, 0x8c, 0xb3, 0xdf,
+	0x1d, 0xc4, 0xb4, 0xe3, 0xff, 0x9b, 0x3c, 0xf9, 0x8e, 0x00, 0xae, 0xaf, 0x68, 0xed, 0x43, 0x8b,
+	0x86, 0xcc, 0x8b, 0xfc, 0x51, 0xc8, 0xfa, 0xc3, 0x28, 0xf4, 0x9c, 0x20, 0x60, 0x2e, 0x0d, 0x83,
+	0xe1, 0xc0, 0x34, 0xac, 0x5d, 0x30, 0x37, 0x58, 0x7f, 0xc8, 0x4c, 0x64, 0xed, 0xc1, 0x83, 0xf5,
+	0xaf, 0x4e, 0xd8, 0x0f, 0xdc, 0x91, 0x67, 0x6e, 0x59, 0x8f, 0x60, 0x6f, 0x9d, 0x38, 0x76, 0xbd,
+	0x20, 0xf2, 0x03, 0x36, 0x74, 0x8e, 0xcd, 0x5b, 0xd6, 0x21, 0xec, 0x6f, 0xb8, 0x5c, 0x36, 0x60,
+	0xa3, 0xb7, 0x51, 0xff, 0xc8, 0x79, 0x47, 0x9d, 0xfe, 0x1b, 0xb3, 0xd1, 0x6e, 0x7c, 0xfb, 0x61,
+	0x1b, 0xd4, 0x3b, 0x9f, 0xdb, 0xe8, 0x62, 0x6e, 0xa3, 0x3f, 0x73, 0x1b, 0x9d, 0x2d, 0x6c, 0xe3,
+	0x62, 0x61, 0x1b, 0x3f, 0x17, 0xb6, 0xf1, 0xfe, 0x79, 0x5e, 0xa8, 0x93, 0x2a, 0xc1, 0x63, 0x5e,
+	0x12, 0x5f, 0xff, 0xb7, 0xa7, 0x47, 0x71, 0x22, 0xc9, 0xd5, 0xe3, 0xfd, 0xd4, 0x7b, 0x49, 0x3e,
+	0xaf, 0x3f, 0x61, 0x75, 0x3a, 0xcb, 0x64, 0xb2, 0xad, 0x67, 0x7d, 0xf6, 0x77, 0x00, 0x63, 0xaf,
+	0xa7, 0x33, 0xe4, 0x02, 0x00, 0x00,
+}
+
+func (m *AddressBurn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressBurn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalBurned.Size()
+		i -= size
+		if _, err := m.TotalBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStrdburner(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintStrdburner(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SourceBurn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SourceBurn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SourceBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalBurned.Size()
+		i -= size
+		if _, err := m.TotalBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStrdburner(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Source != 0 {
+		i = encodeVarintStrdburner(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DailyBurn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DailyBurn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DailyBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalBurned.Size()
+		i -= size
+		if _, err := m.TotalBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStrdburner(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Date, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Date):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintStrdburner(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStrdburner(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStrdburner(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AddressBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovStrdburner(uint64(l))
+	}
+	l = m.TotalBurned.Size()
+	n += 1 + l + sovStrdburner(uint64(l))
+	return n
+}
+
+func (m *SourceBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Source != 0 {
+		n += 1 + sovStrdburner(uint64(m.Source))
+	}
+	l = m.TotalBurned.Size()
+	n += 1 + l + sovStrdburner(uint64(l))
+	return n
+}
+
+func (m *DailyBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Date)
+	n += 1 + l + sovStrdburner(uint64(l))
+	l = m.TotalBurned.Size()
+	n += 1 + l + sovStrdburner(uint64(l))
+	return n
+}
+
+func sovStrdburner(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStrdburner(x uint64) (n int) {
+	return sovStrdburner(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AddressBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStrdburner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStrdburner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStrdburner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStrdburner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStrdburner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStrdburner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStrdburner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStrdburner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStrdburner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SourceBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStrdburner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SourceBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SourceBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStrdburner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= BurnSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStrdburner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStrdburner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStrdburner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStrdburner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStrdburner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DailyBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStrdburner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DailyBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DailyBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStrdburner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStrdburner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStrdburner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Date, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStrdburner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStrdburner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStrdburner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStrdburner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStrdburner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStrdburner(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStrdburner
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStrdburner
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStrdburner
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStrdburner
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStrdburner
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStrdburner
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStrdburner        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStrdburner          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStrdburner = fmt.Errorf("proto: unexpected end of group")
+)